@@ -0,0 +1,104 @@
+// Command coa changes the open sessions of a client on the NAS they are connected through, with
+// RFC 5176 Change-of-Authorization and Disconnect requests.
+//
+//	go run ./cmd/coa sessions -username alice
+//	go run ./cmd/coa boost -username alice -profile turbo
+//	go run ./cmd/coa reset -username alice
+//	go run ./cmd/coa disconnect -username alice
+//
+// boost gives the client's sessions the speed of another RADIUS group without moving them into it,
+// so it lasts until they reconnect or are reset to the speed of their own group. Plan changes,
+// throttling and suspensions are pushed by billing on their own once radius.coa is enabled.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	username := flags.String("username", "", "client username")
+	profile := flags.String("profile", "", "RADIUS group whose speed to give the sessions")
+	_ = flags.Parse(os.Args[2:])
+
+	switch command {
+	case "sessions", "reset", "disconnect":
+		if *username == "" {
+			usage()
+		}
+	case "boost":
+		if *username == "" || *profile == "" {
+			usage()
+		}
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	ctx := context.Background()
+	if command != "sessions" && !c.CoA.Enabled() {
+		log.Fatal("radius.coa is not enabled")
+	}
+
+	switch command {
+	case "sessions":
+		sessions, err := c.CoA.Sessions(ctx, *username)
+		if err != nil {
+			log.Fatalf("could not list sessions: %v", err)
+		}
+		if err := writeJSON(os.Stdout, sessions); err != nil {
+			log.Fatalf("could not write sessions: %v", err)
+		}
+	case "boost":
+		if err := c.CoA.ApplyProfile(ctx, *username, *profile); err != nil {
+			log.Fatalf("could not boost %s: %v", *username, err)
+		}
+		log.Printf("%s: sessions given the speed of %s until they reconnect", *username, *profile)
+	case "reset":
+		group, err := radiusrepo.NewRadiusRepo(c.ORM).Group(ctx, *username)
+		if err != nil {
+			log.Fatalf("could not load group: %v", err)
+		}
+		if group == "" {
+			log.Fatalf("%s is not in a RADIUS group", *username)
+		}
+		if err := c.CoA.ApplyProfile(ctx, *username, group); err != nil {
+			log.Fatalf("could not reset %s: %v", *username, err)
+		}
+		log.Printf("%s: sessions reset to the speed of %s", *username, group)
+	case "disconnect":
+		if err := c.CoA.DisconnectUser(ctx, *username); err != nil {
+			log.Fatalf("could not disconnect %s: %v", *username, err)
+		}
+		log.Printf("%s: sessions disconnected", *username)
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: coa sessions -username name")
+	fmt.Fprintln(os.Stderr, "       coa boost -username name -profile group")
+	fmt.Fprintln(os.Stderr, "       coa reset -username name")
+	fmt.Fprintln(os.Stderr, "       coa disconnect -username name")
+	os.Exit(1)
+}
@@ -0,0 +1,156 @@
+// Command coupons manages the coupons clients can redeem when renewing or changing their package.
+//
+//	go run ./cmd/coupons create -code EID25 -type percent -value 25 -expires 2026-04-30 -max-uses 500 -by alice
+//	go run ./cmd/coupons create -code DHAKA100 -type fixed -value 100 -plans 3,4 -districts Dhaka,Gazipur -by alice
+//	go run ./cmd/coupons list
+//	go run ./cmd/coupons disable -code EID25
+//
+// Dates are read in the server's local time and a coupon expires at the start of its expiry date.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/coupon"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	code := flags.String("code", "", "coupon code clients enter")
+	description := flags.String("description", "", "what the coupon is for")
+	discountType := flags.String("type", "percent", "percent or fixed")
+	value := flags.Float64("value", 0, "percentage or amount taken off")
+	starts := flags.String("starts", "", "first day the coupon can be used, YYYY-MM-DD")
+	expires := flags.String("expires", "", "day the coupon stops working, YYYY-MM-DD")
+	maxUses := flags.Int("max-uses", 0, "redemptions across all clients, 0 for no limit")
+	perClient := flags.Int("per-client", 1, "redemptions per client, 0 for no limit")
+	plans := flags.String("plans", "", "comma separated package IDs the coupon applies to, any when empty")
+	districts := flags.String("districts", "", "comma separated client districts the coupon applies to, any when empty")
+	by := flags.String("by", "", "name of the operator creating the coupon")
+	_ = flags.Parse(os.Args[2:])
+
+	switch command {
+	case "list":
+	case "create":
+		if *code == "" || *by == "" || *value <= 0 {
+			usage()
+		}
+	case "disable":
+		if *code == "" {
+			usage()
+		}
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+	ctx := context.Background()
+
+	switch command {
+	case "list":
+		coupons, err := billingRepo.ListCoupons(ctx)
+		if err != nil {
+			log.Fatalf("could not list coupons: %v", err)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(coupons); err != nil {
+			log.Fatalf("could not write coupons: %v", err)
+		}
+
+	case "create":
+		input := billingrepo.CouponInput{
+			Code:             *code,
+			Description:      *description,
+			DiscountType:     coupon.DiscountType(*discountType),
+			Value:            *value,
+			MaxUses:          *maxUses,
+			MaxUsesPerClient: *perClient,
+			Districts:        splitList(*districts),
+			CreatedBy:        *by,
+		}
+		var err error
+		if input.StartsAt, err = parseDate(*starts); err != nil {
+			log.Fatalf("invalid -starts: %v", err)
+		}
+		if input.ExpiresAt, err = parseDate(*expires); err != nil {
+			log.Fatalf("invalid -expires: %v", err)
+		}
+		for _, p := range splitList(*plans) {
+			id, err := strconv.Atoi(p)
+			if err != nil {
+				log.Fatalf("invalid package ID %q in -plans", p)
+			}
+			input.PlanIDs = append(input.PlanIDs, id)
+		}
+
+		created, err := billingRepo.CreateCoupon(ctx, input)
+		switch {
+		case errors.Is(err, billingrepo.ErrCouponExists):
+			log.Fatalf("coupon %s already exists", billingrepo.NormalizeCouponCode(*code))
+		case errors.Is(err, billingrepo.ErrInvalidAmount):
+			log.Fatal("a coupon needs a code and a value above zero, and percentages cannot exceed 100")
+		case err != nil:
+			log.Fatalf("could not create coupon: %v", err)
+		}
+		log.Printf("created coupon %s", created.Code)
+
+	case "disable":
+		err := billingRepo.DisableCoupon(ctx, *code)
+		switch {
+		case errors.Is(err, billingrepo.ErrCouponNotFound):
+			log.Fatalf("coupon %s does not exist", billingrepo.NormalizeCouponCode(*code))
+		case err != nil:
+			log.Fatalf("could not disable coupon: %v", err)
+		}
+		log.Printf("disabled coupon %s", billingrepo.NormalizeCouponCode(*code))
+	}
+}
+
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: coupons list")
+	fmt.Fprintln(os.Stderr, "       coupons create -code CODE -type percent|fixed -value N -by operator [-description text]")
+	fmt.Fprintln(os.Stderr, "              [-starts YYYY-MM-DD] [-expires YYYY-MM-DD] [-max-uses N] [-per-client N]")
+	fmt.Fprintln(os.Stderr, "              [-plans 1,2] [-districts Dhaka,Gazipur]")
+	fmt.Fprintln(os.Stderr, "       coupons disable -code CODE")
+	os.Exit(1)
+}
@@ -0,0 +1,119 @@
+// Command dunning shows support the payment reminders clients were sent and are due.
+//
+//	go run ./cmd/dunning log -username alice
+//	go run ./cmd/dunning due [-day 2026-03-01]
+//
+// log lists the reminders sent to a client, newest first, with the channels each one reached. due
+// previews the reminders the worker would find due at the start of -day, or now by default. It
+// includes steps already sent, which the worker skips.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/tasks"
+)
+
+// dueReminder is a reminder as shown by due
+type dueReminder struct {
+	Username  string    `json:"username"`
+	Kind      string    `json:"kind"`
+	Reference string    `json:"reference"`
+	DueAt     time.Time `json:"due_at"`
+	Offset    int       `json:"offset_days"`
+	Level     string    `json:"level"`
+	Amount    float64   `json:"amount"`
+	Subject   string    `json:"subject"`
+	Message   string    `json:"message"`
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	username := flags.String("username", "", "client username")
+	limit := flags.Int("limit", 50, "number of reminders to list")
+	dayFlag := flags.String("day", "", "day as YYYY-MM-DD")
+	_ = flags.Parse(os.Args[2:])
+
+	day := time.Now()
+	if *dayFlag != "" {
+		var err error
+		if day, err = time.ParseInLocation("2006-01-02", *dayFlag, time.Local); err != nil {
+			log.Fatalf("invalid -day: %v", err)
+		}
+	}
+
+	switch command {
+	case "log":
+		if *username == "" || *limit <= 0 {
+			usage()
+		}
+	case "due":
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+	ctx := context.Background()
+
+	switch command {
+	case "log":
+		steps, err := billingRepo.ClientDunning(ctx, *username, *limit)
+		if err != nil {
+			log.Fatalf("could not list reminders: %v", err)
+		}
+		if err := writeJSON(os.Stdout, steps); err != nil {
+			log.Fatalf("could not write reminders: %v", err)
+		}
+	case "due":
+		notices, err := billingRepo.DueDunning(ctx, day, c.Config.Billing.Dunning.Offsets)
+		if err != nil {
+			log.Fatalf("could not list due reminders: %v", err)
+		}
+		due := make([]dueReminder, 0, len(notices))
+		for _, n := range notices {
+			subject, message := tasks.DunningMessage(n, c.Config.Billing.Currency)
+			due = append(due, dueReminder{
+				Username:  n.Client.Username,
+				Kind:      n.Kind.String(),
+				Reference: n.Reference,
+				DueAt:     n.DueAt,
+				Offset:    n.Offset,
+				Level:     n.Level.String(),
+				Amount:    n.Amount,
+				Subject:   subject,
+				Message:   message,
+			})
+		}
+		if err := writeJSON(os.Stdout, due); err != nil {
+			log.Fatalf("could not write due reminders: %v", err)
+		}
+		log.Printf("%d reminders due", len(due))
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: dunning log -username name [-limit n]")
+	fmt.Fprintln(os.Stderr, "       dunning due [-day YYYY-MM-DD]")
+	os.Exit(1)
+}
@@ -0,0 +1,123 @@
+// Command ledger checks client balances against their transaction history.
+//
+//	go run ./cmd/ledger check [-client username] [-out report.json]
+//	go run ./cmd/ledger repair -report report.json [-apply]
+//
+// check writes a JSON report and exits with status 2 if it found any issues. repair reads a
+// report that has been reviewed, with any issue that should not be repaired removed, and prints
+// the ADJUSTMENT entries it would write for each balance drift. Nothing is written without -apply.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "check":
+		flags := flag.NewFlagSet("check", flag.ExitOnError)
+		client := flags.String("client", "", "only check this client's username")
+		out := flags.String("out", "", "write the report to this file instead of stdout")
+		_ = flags.Parse(os.Args[2:])
+		os.Exit(check(*client, *out))
+	case "repair":
+		flags := flag.NewFlagSet("repair", flag.ExitOnError)
+		in := flags.String("report", "", "reviewed report written by check")
+		apply := flags.Bool("apply", false, "write the correcting entries instead of only listing them")
+		_ = flags.Parse(os.Args[2:])
+		if *in == "" {
+			usage()
+		}
+		repair(*in, *apply)
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: ledger check [-client username] [-out report.json]")
+	fmt.Fprintln(os.Stderr, "       ledger repair -report report.json [-apply]")
+	os.Exit(1)
+}
+
+func check(client, out string) int {
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+
+	var usernames []string
+	if client != "" {
+		usernames = append(usernames, client)
+	}
+	report, err := billingRepo.CheckLedger(context.Background(), usernames...)
+	if err != nil {
+		log.Fatalf("ledger check failed: %v", err)
+	}
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			log.Fatalf("could not create report: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := writeJSON(w, report); err != nil {
+		log.Fatalf("could not write report: %v", err)
+	}
+
+	log.Printf("checked %d clients and %d transactions, %d issues for %d clients",
+		report.ClientsChecked, report.TxnsChecked, len(report.Issues), report.ClientsWithIssues)
+	if len(report.Issues) > 0 {
+		return 2
+	}
+	return 0
+}
+
+func repair(in string, apply bool) {
+	f, err := os.Open(in)
+	if err != nil {
+		log.Fatalf("could not open report: %v", err)
+	}
+	var report billingrepo.LedgerReport
+	err = json.NewDecoder(f).Decode(&report)
+	f.Close()
+	if err != nil {
+		log.Fatalf("could not read report: %v", err)
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+
+	repairs, err := billingRepo.RepairLedger(context.Background(), &report, apply)
+	if werr := writeJSON(os.Stdout, repairs); werr != nil {
+		log.Printf("could not write repairs: %v", werr)
+	}
+	if err != nil {
+		log.Fatalf("ledger repair stopped: %v", err)
+	}
+	if !apply {
+		log.Printf("dry run: %d adjustments planned, run again with -apply to write them", len(repairs))
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -0,0 +1,231 @@
+// Command manualpay lets the billing team verify the bKash and Nagad payments clients sent to the
+// merchant numbers and reported in the portal.
+//
+//	go run ./cmd/manualpay list [-status pending]
+//	go run ./cmd/manualpay verify -ref MAN-... -by alice [-note "..."]
+//	go run ./cmd/manualpay reject -ref MAN-... -by alice -note "..."
+//	go run ./cmd/manualpay import -provider bkash -file statement.csv -by alice
+//
+// import reads a merchant statement with the columns trx_id, amount, sender and optionally date
+// (YYYY-MM-DD or YYYY-MM-DD HH:MM:SS), in any order. Pending payments whose TrxID, amount and sender
+// number are on the statement are completed; those the statement disagrees with are marked
+// mismatched for review. The client is notified whenever a payment is completed or rejected.
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	status := flags.String("status", "", "only list payments in this status")
+	ref := flags.String("ref", "", "transaction reference of the manual payment")
+	by := flags.String("by", "", "name of the reviewer")
+	note := flags.String("note", "", "note shown to the client")
+	provider := flags.String("provider", "", "bkash or nagad")
+	file := flags.String("file", "", "statement CSV to import")
+	_ = flags.Parse(os.Args[2:])
+
+	switch command {
+	case "list":
+	case "verify":
+		if *ref == "" || *by == "" {
+			usage()
+		}
+	case "reject":
+		if *ref == "" || *by == "" || *note == "" {
+			usage()
+		}
+	case "import":
+		if *provider == "" || *file == "" || *by == "" {
+			usage()
+		}
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+	ctx := context.Background()
+	currency := c.Config.Billing.Currency
+
+	switch command {
+	case "list":
+		var statuses []manualpayment.Status
+		if *status != "" {
+			statuses = append(statuses, manualpayment.Status(*status))
+		}
+		payments, err := billingRepo.ListManualPayments(ctx, statuses...)
+		if err != nil {
+			log.Fatalf("could not list manual payments: %v", err)
+		}
+		writeJSON(payments)
+
+	case "verify", "reject":
+		review := billingrepo.ManualPaymentReview{Reviewer: *by, Note: *note}
+		var (
+			payment *ent.ManualPayment
+			err     error
+		)
+		if command == "verify" {
+			payment, err = billingRepo.VerifyManualPayment(ctx, *ref, review)
+		} else {
+			payment, err = billingRepo.RejectManualPayment(ctx, *ref, review)
+		}
+		switch {
+		case errors.Is(err, billingrepo.ErrManualPaymentNotFound):
+			log.Fatalf("manual payment %s does not exist or is no longer pending", *ref)
+		case err != nil:
+			log.Fatalf("could not %s manual payment %s: %v", command, *ref, err)
+		}
+		log.Printf("manual payment %s is now %s", payment.TransactionRef, payment.Status)
+		notify(c, payment, currency)
+
+	case "import":
+		f, err := os.Open(*file)
+		if err != nil {
+			log.Fatalf("could not open %s: %v", *file, err)
+		}
+		defer f.Close()
+		entries, err := readStatement(f)
+		if err != nil {
+			log.Fatalf("could not read %s: %v", *file, err)
+		}
+
+		result, err := billingRepo.ImportStatement(ctx, statemententry.Provider(*provider), entries, *by)
+		if err != nil {
+			log.Fatalf("could not import statement: %v", err)
+		}
+		for _, payment := range result.Matched {
+			notify(c, payment, currency)
+		}
+		writeJSON(result)
+		log.Printf("imported %d entries (%d already imported), completed %d payments, %d need review",
+			result.Imported, result.Duplicates, len(result.Matched), len(result.Mismatched))
+	}
+}
+
+// readStatement reads the entries of a statement CSV, locating the columns by their header
+func readStatement(r io.Reader) ([]billingrepo.StatementEntryInput, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("the file is empty")
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	trxCol, ok := columns["trx_id"]
+	if !ok {
+		return nil, errors.New("missing trx_id column")
+	}
+	amountCol, ok := columns["amount"]
+	if !ok {
+		return nil, errors.New("missing amount column")
+	}
+	senderCol, ok := columns["sender"]
+	if !ok {
+		return nil, errors.New("missing sender column")
+	}
+	cell := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	entries := make([]billingrepo.StatementEntryInput, 0, len(records)-1)
+	for n, record := range records[1:] {
+		line := n + 2
+		amount, err := strconv.ParseFloat(strings.ReplaceAll(record[amountCol], ",", ""), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount %q", line, record[amountCol])
+		}
+		entry := billingrepo.StatementEntryInput{
+			TrxID:        record[trxCol],
+			Amount:       amount,
+			SenderNumber: record[senderCol],
+		}
+		if date := cell(record, "date"); date != "" {
+			t, err := parseStatementDate(date)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid date %q", line, date)
+			}
+			entry.ReceivedAt = &t
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func parseStatementDate(value string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, time.Local)
+	if err != nil {
+		return time.ParseInLocation("2006-01-02", value, time.Local)
+	}
+	return t, nil
+}
+
+func writeJSON(v any) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		log.Fatalf("could not write output: %v", err)
+	}
+}
+
+func notify(c *services.Container, payment *ent.ManualPayment, currency string) {
+	smsSender, err := notifierrepo.NewSMSSender(
+		c.ORM, c.Config.Phone.Region, c.Config.Phone.SenderID, c.Config.Phone.ValidationCodeExpirationMinutes)
+	if err != nil {
+		log.Printf("sms notifications to clients are disabled: %v", err)
+		smsSender = nil
+	}
+	ctx := context.Background()
+	client, err := c.ORM.ClientUser.Query().Where(clientuser.UsernameEQ(payment.ClientUsername)).Only(ctx)
+	if err != nil {
+		log.Printf("could not load client %s to notify: %v", payment.ClientUsername, err)
+		return
+	}
+	subject, message := billingrepo.ManualPaymentNotice(payment, currency)
+	if err := notifierrepo.NewClientNotifier(c.Mail, smsSender).NotifyClient(ctx, client, subject, message); err != nil {
+		log.Printf("could not notify client %s: %v", payment.ClientUsername, err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: manualpay list [-status pending|completed|failed]")
+	fmt.Fprintln(os.Stderr, "       manualpay verify -ref MAN-... -by reviewer [-note text]")
+	fmt.Fprintln(os.Stderr, "       manualpay reject -ref MAN-... -by reviewer -note text")
+	fmt.Fprintln(os.Stderr, "       manualpay import -provider bkash|nagad -file statement.csv -by reviewer")
+	os.Exit(1)
+}
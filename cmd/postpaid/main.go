@@ -0,0 +1,138 @@
+// Command postpaid manages clients who are billed monthly after use instead of paying up front.
+//
+//	go run ./cmd/postpaid mode -username alice -mode postpaid -limit 5000 -by bob
+//	go run ./cmd/postpaid mode -username alice -mode prepaid -by bob
+//	go run ./cmd/postpaid addon -username alice -name "Static IP" -amount 300 -by bob
+//	go run ./cmd/postpaid remove-addon -id 12
+//	go run ./cmd/postpaid invoices -username alice
+//	go run ./cmd/postpaid run [-day 2026-03-01]
+//
+// A postpaid client may be charged down to minus their credit limit. run invoices every postpaid
+// client for the month before -day, today by default, and handles overdue invoices; the worker does
+// the same on billing.postpaid.schedule. A client can only go back to prepaid once nothing is due.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	username := flags.String("username", "", "client username")
+	mode := flags.String("mode", "", "prepaid or postpaid")
+	limit := flags.Float64("limit", 0, "credit limit of a postpaid client")
+	name := flags.String("name", "", "add-on name, e.g. Static IP")
+	amount := flags.Float64("amount", 0, "monthly charge of the add-on")
+	id := flags.Int("id", 0, "add-on id")
+	by := flags.String("by", "", "name of the operator making the change")
+	dayFlag := flags.String("day", "", "day as YYYY-MM-DD")
+	_ = flags.Parse(os.Args[2:])
+
+	day := time.Now()
+	if *dayFlag != "" {
+		var err error
+		if day, err = time.ParseInLocation("2006-01-02", *dayFlag, time.Local); err != nil {
+			log.Fatalf("invalid -day: %v", err)
+		}
+	}
+
+	switch command {
+	case "mode":
+		if *username == "" || *by == "" || clientuser.BillingModeValidator(clientuser.BillingMode(*mode)) != nil || *limit < 0 {
+			usage()
+		}
+	case "addon":
+		if *username == "" || *name == "" || *amount <= 0 || *by == "" {
+			usage()
+		}
+	case "remove-addon":
+		if *id <= 0 {
+			usage()
+		}
+	case "invoices":
+		if *username == "" {
+			usage()
+		}
+	case "run":
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+	ctx := context.Background()
+
+	switch command {
+	case "mode":
+		client, err := billingRepo.SetBillingMode(ctx, *username, clientuser.BillingMode(*mode), *limit, *by)
+		if err != nil {
+			log.Fatalf("could not change billing mode: %v", err)
+		}
+		log.Printf("%s is now %s with a credit limit of %.2f", client.Username, client.BillingMode, client.CreditLimit)
+	case "addon":
+		addon, err := billingRepo.AddAddon(ctx, *username, *name, *amount, *by)
+		if err != nil {
+			log.Fatalf("could not add add-on: %v", err)
+		}
+		log.Printf("added add-on %d: %s at %.2f a month", addon.ID, addon.Name, addon.Amount)
+	case "remove-addon":
+		if err := billingRepo.RemoveAddon(ctx, *id); err != nil {
+			log.Fatalf("could not remove add-on: %v", err)
+		}
+		log.Printf("removed add-on %d", *id)
+	case "invoices":
+		invoices, err := billingRepo.ClientInvoices(ctx, *username, 24)
+		if err != nil {
+			log.Fatalf("could not list invoices: %v", err)
+		}
+		if err := writeJSON(os.Stdout, invoices); err != nil {
+			log.Fatalf("could not write invoices: %v", err)
+		}
+	case "run":
+		summary, err := billingRepo.RunPostpaidBilling(ctx, day, billingrepo.PostpaidPolicy{
+			DueDays:          c.Config.Billing.Postpaid.DueDays,
+			SuspendAfterDays: c.Config.Billing.Postpaid.SuspendAfterDays,
+			SuspendedProfile: c.Config.Billing.Grace.SuspendedProfile,
+		})
+		if err != nil {
+			log.Fatalf("postpaid billing failed: %v", err)
+		}
+		if err := writeJSON(os.Stdout, summary); err != nil {
+			log.Fatalf("could not write summary: %v", err)
+		}
+		log.Printf("issued %d invoices for %s totalling %.2f, %d overdue, %d suspended, %d failed",
+			summary.Issued, summary.Period.Format("January 2006"), summary.Billed, summary.Overdue, summary.Suspended, summary.Failed)
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: postpaid mode -username name -mode prepaid|postpaid [-limit amount] -by operator")
+	fmt.Fprintln(os.Stderr, "       postpaid addon -username name -name label -amount amount -by operator")
+	fmt.Fprintln(os.Stderr, "       postpaid remove-addon -id id")
+	fmt.Fprintln(os.Stderr, "       postpaid invoices -username name")
+	fmt.Fprintln(os.Stderr, "       postpaid run [-day YYYY-MM-DD]")
+	os.Exit(1)
+}
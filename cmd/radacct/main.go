@@ -0,0 +1,63 @@
+// Command radacct is an RFC 2866 accounting server that writes the Start, Interim-Update and Stop
+// records of the configured NASes to radacct, for networks that do not run FreeRADIUS accounting.
+//
+//	go run ./cmd/radacct [-listen :1813]
+//
+// It listens on radius.accounting.address unless -listen is given. With radius.accounting.publish
+// set, every session change is also published to the radacct topic and the client's own topic, for
+// live updates over SSE. Do not run it next to FreeRADIUS accounting for the same NASes.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/accounting"
+	"github.com/mikestefanello/pagoda/pkg/repos/pubsub"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	flags := flag.NewFlagSet("radacct", flag.ExitOnError)
+	listen := flags.String("listen", "", "UDP address to listen on")
+	_ = flags.Parse(os.Args[1:])
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+
+	addr := c.Config.Radius.Accounting.Address
+	if *listen != "" {
+		addr = *listen
+	}
+
+	var publisher accounting.Publisher
+	if c.Config.Radius.Accounting.Publish {
+		cache, err := services.NewCacheClient(c.Config)
+		if err != nil {
+			log.Fatalf("could not connect to the cache: %v", err)
+		}
+		defer cache.Close()
+		publisher = pubsub.NewRedisPubSubClient(cache.Client)
+	}
+
+	server, err := accounting.NewServer(c.ORM, c.Config.Radius, publisher)
+	if err != nil {
+		log.Fatalf("invalid radius configuration: %v", err)
+	}
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		log.Fatalf("could not listen on %s: %v", addr, err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	log.Printf("accounting on %s for %d nas", conn.LocalAddr(), len(c.Config.Radius.NAS))
+	if err := server.Serve(ctx, conn); err != nil {
+		log.Fatalf("accounting server stopped: %v", err)
+	}
+}
@@ -0,0 +1,142 @@
+// Command refunds lets the billing team review the refund requests clients open in the portal.
+//
+//	go run ./cmd/refunds list [-status requested]
+//	go run ./cmd/refunds approve -id 12 -by alice [-note "..."]
+//	go run ./cmd/refunds reject -id 12 -by alice -note "..."
+//	go run ./cmd/refunds pay -id 12 -by alice -ref BKASH-TRX-ID
+//
+// A request moves from requested to approved to paid, or to rejected before it is paid. The
+// client is notified of every decision.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	status := flags.String("status", "", "only list requests in this status")
+	id := flags.Int("id", 0, "refund request id")
+	by := flags.String("by", "", "name of the reviewer")
+	note := flags.String("note", "", "note shown to the client")
+	ref := flags.String("ref", "", "reference of the payout sent to the client")
+	_ = flags.Parse(os.Args[2:])
+
+	switch command {
+	case "list":
+	case "approve", "reject", "pay":
+		if *id == 0 || *by == "" {
+			usage()
+		}
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+	ctx := context.Background()
+
+	if command == "list" {
+		var statuses []refundrequest.Status
+		if *status != "" {
+			statuses = append(statuses, refundrequest.Status(*status))
+		}
+		requests, err := billingRepo.ListRefunds(ctx, statuses...)
+		if err != nil {
+			log.Fatalf("could not list refund requests: %v", err)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(requests); err != nil {
+			log.Fatalf("could not write refund requests: %v", err)
+		}
+		return
+	}
+
+	review := billingrepo.RefundReview{Reviewer: *by, Note: *note, PayoutRef: *ref}
+
+	var (
+		request *ent.RefundRequest
+		err     error
+		subject string
+		message string
+	)
+	switch command {
+	case "approve":
+		request, err = billingRepo.ApproveRefund(ctx, *id, review)
+		subject = "Your refund was approved"
+		message = "Your refund of %.2f %s was approved and will be sent to %s %s shortly."
+	case "reject":
+		if *note == "" {
+			log.Fatal("please give the client a reason with -note")
+		}
+		request, err = billingRepo.RejectRefund(ctx, *id, review)
+		subject = "Your refund request was declined"
+		message = "Your refund request of %.2f %s to %s %s was declined."
+	case "pay":
+		request, err = billingRepo.MarkRefundPaid(ctx, *id, review)
+		subject = "Your refund was sent"
+		message = "Your refund of %.2f %s was sent to %s %s."
+	}
+	switch {
+	case errors.Is(err, billingrepo.ErrRefundTransition):
+		log.Fatalf("refund request %d cannot be moved that way, check its status with list", *id)
+	case err != nil:
+		log.Fatalf("could not %s refund request %d: %v", command, *id, err)
+	}
+	log.Printf("refund request %d is now %s", request.ID, request.Status)
+
+	message = fmt.Sprintf(message, request.Amount, c.Config.Billing.Currency, request.PayoutMethod, request.PayoutAccount)
+	if request.PayoutRef != "" {
+		message += fmt.Sprintf(" Reference: %s.", request.PayoutRef)
+	}
+	if request.ReviewNote != "" {
+		message += " " + request.ReviewNote
+	}
+	notify(c, request.ClientUsername, subject, message)
+}
+
+func notify(c *services.Container, username, subject, message string) {
+	smsSender, err := notifierrepo.NewSMSSender(
+		c.ORM, c.Config.Phone.Region, c.Config.Phone.SenderID, c.Config.Phone.ValidationCodeExpirationMinutes)
+	if err != nil {
+		log.Printf("sms notifications to clients are disabled: %v", err)
+		smsSender = nil
+	}
+	ctx := context.Background()
+	client, err := c.ORM.ClientUser.Query().Where(clientuser.UsernameEQ(username)).Only(ctx)
+	if err != nil {
+		log.Printf("could not load client %s to notify: %v", username, err)
+		return
+	}
+	if err := notifierrepo.NewClientNotifier(c.Mail, smsSender).NotifyClient(ctx, client, subject, message); err != nil {
+		log.Printf("could not notify client %s: %v", username, err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: refunds list [-status requested|approved|paid|rejected]")
+	fmt.Fprintln(os.Stderr, "       refunds approve -id N -by reviewer [-note text]")
+	fmt.Fprintln(os.Stderr, "       refunds reject -id N -by reviewer -note text")
+	fmt.Fprintln(os.Stderr, "       refunds pay -id N -by reviewer -ref payout-reference")
+	os.Exit(1)
+}
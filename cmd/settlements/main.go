@@ -0,0 +1,141 @@
+// Command settlements imports the settlement files of the payment gateways and reconciles them with
+// client transactions, the way finance closes the day.
+//
+//	go run ./cmd/settlements import -gateway sslcommerz -file settlement.csv -by alice [-day 2026-03-01]
+//	go run ./cmd/settlements report [-day 2026-03-01] [-out report.json]
+//
+// A settlement file needs a transaction reference and an amount column; gross, fee, date and the
+// gateway's own reference are read when present (see billingrepo.ParseSettlementCSV for the headers
+// recognised). Rows without a date are taken to be settled on -day, today by default. report covers
+// yesterday by default and exits with status 2 if any settled row is unmatched or mismatched.
+//
+// The worker does the same every day for files dropped into billing.settlement.inbox.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	gateway := flags.String("gateway", "", "bkash, nagad, sslcommerz or stripe")
+	file := flags.String("file", "", "settlement CSV to import")
+	by := flags.String("by", "", "name of the operator importing the file")
+	dayFlag := flags.String("day", "", "day as YYYY-MM-DD")
+	out := flags.String("out", "", "write the report to this file instead of stdout")
+	_ = flags.Parse(os.Args[2:])
+
+	day := time.Now()
+	if command == "report" {
+		day = day.AddDate(0, 0, -1)
+	}
+	if *dayFlag != "" {
+		var err error
+		if day, err = time.ParseInLocation("2006-01-02", *dayFlag, time.Local); err != nil {
+			log.Fatalf("invalid -day: %v", err)
+		}
+	}
+
+	switch command {
+	case "import":
+		if *file == "" || *by == "" || settlementrow.GatewayValidator(settlementrow.Gateway(*gateway)) != nil {
+			usage()
+		}
+		importFile(settlementrow.Gateway(*gateway), *file, *by, day)
+	case "report":
+		os.Exit(report(day, *out))
+	default:
+		usage()
+	}
+}
+
+func importFile(gateway settlementrow.Gateway, file, by string, day time.Time) {
+	f, err := os.Open(file)
+	if err != nil {
+		log.Fatalf("could not open %s: %v", file, err)
+	}
+	rows, err := billingrepo.ParseSettlementCSV(f, day)
+	f.Close()
+	if errors.Is(err, billingrepo.ErrSettlementFile) {
+		log.Fatalf("%s has no transaction reference or amount column", file)
+	} else if err != nil {
+		log.Fatalf("could not read %s: %v", file, err)
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+
+	result, err := billingRepo.ImportSettlement(context.Background(), gateway, rows, filepath.Base(file), by)
+	if err != nil {
+		log.Fatalf("could not import settlement: %v", err)
+	}
+	if err := writeJSON(os.Stdout, result); err != nil {
+		log.Fatalf("could not write result: %v", err)
+	}
+	log.Printf("imported %d rows (%d already imported), %d matched, %d exceptions",
+		result.Imported, result.Duplicates, result.Matched, len(result.Exceptions))
+}
+
+func report(day time.Time, out string) int {
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+
+	report, err := billingRepo.SettlementReport(context.Background(), day)
+	if err != nil {
+		log.Fatalf("could not reconcile %s: %v", day.Format("2006-01-02"), err)
+	}
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			log.Fatalf("could not create report: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := writeJSON(w, report); err != nil {
+		log.Fatalf("could not write report: %v", err)
+	}
+
+	for _, g := range report.Gateways {
+		log.Printf("%s: collected %.2f in %d payments, settled %.2f in %d rows, fees %.2f, net %.2f, %d exceptions, %d unsettled",
+			g.Gateway, g.Collected, g.CollectedCount, g.Settled, g.SettledCount, g.Fees, g.Net, g.Exceptions, g.Unsettled)
+	}
+	if len(report.Exceptions) > 0 {
+		return 2
+	}
+	return 0
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: settlements import -gateway bkash|nagad|sslcommerz|stripe -file settlement.csv -by operator [-day YYYY-MM-DD]")
+	fmt.Fprintln(os.Stderr, "       settlements report [-day YYYY-MM-DD] [-out report.json]")
+	os.Exit(1)
+}
@@ -0,0 +1,75 @@
+// Command tax reports the tax charged to clients, for filing returns.
+//
+//	go run ./cmd/tax summary [-month 2026-03]
+//
+// summary totals the net, tax and gross charged in -month, the current one by default, by service
+// and rate. Prepaid charges count in the month they were made and postpaid invoices in the month
+// they were issued. Balance top-ups are not taxed and are left out.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	monthFlag := flags.String("month", "", "month as YYYY-MM")
+	_ = flags.Parse(os.Args[2:])
+
+	month := time.Now()
+	if *monthFlag != "" {
+		var err error
+		if month, err = time.ParseInLocation("2006-01", *monthFlag, time.Local); err != nil {
+			log.Fatalf("invalid -month: %v", err)
+		}
+	}
+
+	switch command {
+	case "summary":
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+	ctx := context.Background()
+
+	switch command {
+	case "summary":
+		summary, err := billingRepo.MonthlyTaxSummary(ctx, month)
+		if err != nil {
+			log.Fatalf("could not build tax summary: %v", err)
+		}
+		if err := writeJSON(os.Stdout, summary); err != nil {
+			log.Fatalf("could not write tax summary: %v", err)
+		}
+		log.Printf("%s: %.2f %s on %.2f %s of net charges",
+			summary.Month.Format("January 2006"), summary.Tax, c.Config.Billing.Tax.Name, summary.Net, c.Config.Billing.Currency)
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: tax summary [-month YYYY-MM]")
+	os.Exit(1)
+}
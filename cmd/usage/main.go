@@ -0,0 +1,89 @@
+// Command usage rolls radacct up into per client traffic and reports on it.
+//
+//	go run ./cmd/usage rollup [-since 720h]
+//	go run ./cmd/usage report [-month 2026-03] [-limit 20]
+//
+// rollup rolls up every session that is open or had a record in -since, the configured lookback by
+// default. Run it with a long -since once to fill the usage tables from existing radacct rows;
+// rolling a session up again adds nothing. report lists the clients with the most traffic in
+// -month, the current one by default.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	since := flags.Duration("since", 0, "roll up sessions with a record this far back")
+	monthFlag := flags.String("month", "", "month as YYYY-MM")
+	limit := flags.Int("limit", 20, "clients to list, 0 for all")
+	_ = flags.Parse(os.Args[2:])
+
+	month := time.Now()
+	if *monthFlag != "" {
+		var err error
+		if month, err = time.ParseInLocation("2006-01", *monthFlag, time.Local); err != nil {
+			log.Fatalf("invalid -month: %v", err)
+		}
+	}
+	month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Local)
+
+	switch command {
+	case "rollup", "report":
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	usageRepo := usagerepo.NewUsageRepo(c.ORM)
+	ctx := context.Background()
+
+	switch command {
+	case "rollup":
+		if *since == 0 {
+			*since = c.Config.Radius.Usage.Lookback
+		}
+		count, err := usageRepo.RollupSince(ctx, time.Now().Add(-*since))
+		if err != nil {
+			log.Printf("some sessions could not be rolled up: %v", err)
+		}
+		log.Printf("rolled up %d sessions", count)
+	case "report":
+		top, err := usageRepo.Top(ctx, month, month.AddDate(0, 1, 0), *limit)
+		if err != nil {
+			log.Fatalf("could not build usage report: %v", err)
+		}
+		if err := writeJSON(os.Stdout, top); err != nil {
+			log.Fatalf("could not write usage report: %v", err)
+		}
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: usage rollup [-since duration]")
+	fmt.Fprintln(os.Stderr, "       usage report [-month YYYY-MM] [-limit n]")
+	os.Exit(1)
+}
@@ -0,0 +1,207 @@
+// Command vendors manages the resellers who activate and renew clients on our network and pay for
+// them from a prepaid wallet.
+//
+//	go run ./cmd/vendors list
+//	go run ./cmd/vendors add -id 3 -name "Rahim Net" -company rahimnet [-mobile 017...]
+//	go run ./cmd/vendors topup -vendor 3 -amount 20000 -by bob [-note "bank deposit"]
+//	go run ./cmd/vendors adjust -vendor 3 -amount -500 -note "double top-up" -by bob
+//	go run ./cmd/vendors commission -package 4 [-vendor 3] -type percent -value 10 -by bob
+//	go run ./cmd/vendors commissions [-vendor 3]
+//	go run ./cmd/vendors renew -vendor 3 -username alice -by bob
+//	go run ./cmd/vendors statement -vendor 3 [-month 2026-03]
+//
+// A vendor's -id is the vendor_id their clients already carry. renew activates or renews a client
+// of the vendor for a cycle, charging the vendor's wallet the package price less their commission:
+// their own rule for the package if they have one, otherwise the package's default set without
+// -vendor. statement totals the wallet over a month, the current one by default.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	id := flags.Int("id", 0, "vendor id, as in clients.vendor_id")
+	name := flags.String("name", "", "vendor name")
+	company := flags.String("company", "", "company the vendor belongs to, as in clients.c_name")
+	mobile := flags.String("mobile", "", "vendor mobile number")
+	vendorID := flags.Int("vendor", 0, "vendor id")
+	amount := flags.Float64("amount", 0, "amount to add to the wallet")
+	note := flags.String("note", "", "note recorded on the wallet transaction")
+	packageID := flags.Int("package", 0, "package id")
+	commissionType := flags.String("type", "", "percent or fixed")
+	value := flags.Float64("value", 0, "commission percentage or amount")
+	username := flags.String("username", "", "client username")
+	by := flags.String("by", "", "name of the operator making the change")
+	monthFlag := flags.String("month", "", "month as YYYY-MM")
+	_ = flags.Parse(os.Args[2:])
+
+	month := time.Now()
+	if *monthFlag != "" {
+		var err error
+		if month, err = time.ParseInLocation("2006-01", *monthFlag, time.Local); err != nil {
+			log.Fatalf("invalid -month: %v", err)
+		}
+	}
+
+	switch command {
+	case "list":
+	case "add":
+		if *id <= 0 || *name == "" || *company == "" {
+			usage()
+		}
+	case "topup":
+		if *vendorID <= 0 || *amount <= 0 || *by == "" {
+			usage()
+		}
+	case "adjust":
+		if *vendorID <= 0 || *amount == 0 || *note == "" || *by == "" {
+			usage()
+		}
+	case "commission":
+		if *packageID <= 0 || *vendorID < 0 || *by == "" ||
+			vendorcommission.CommissionTypeValidator(vendorcommission.CommissionType(*commissionType)) != nil {
+			usage()
+		}
+	case "commissions":
+	case "renew":
+		if *vendorID <= 0 || *username == "" || *by == "" {
+			usage()
+		}
+	case "statement":
+		if *vendorID <= 0 {
+			usage()
+		}
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+	ctx := context.Background()
+
+	switch command {
+	case "list":
+		vendors, err := billingRepo.ListVendors(ctx)
+		if err != nil {
+			log.Fatalf("could not list vendors: %v", err)
+		}
+		if err := writeJSON(os.Stdout, vendors); err != nil {
+			log.Fatalf("could not write vendors: %v", err)
+		}
+	case "add":
+		v, err := billingRepo.CreateVendor(ctx, billingrepo.VendorInput{
+			ID:           *id,
+			Name:         *name,
+			CName:        *company,
+			MobileNumber: *mobile,
+		})
+		if err != nil {
+			log.Fatalf("could not add vendor: %v", err)
+		}
+		log.Printf("added vendor %d: %s", v.ID, v.Name)
+	case "topup":
+		txn, err := billingRepo.TopUpVendor(ctx, *vendorID, *amount, *note, *by)
+		if err != nil {
+			log.Fatalf("could not top up vendor: %v", err)
+		}
+		log.Printf("%s: added %.2f, wallet balance %.2f", txn.TransactionRef, txn.Amount, txn.TotalBalance)
+	case "adjust":
+		txn, err := billingRepo.AdjustVendor(ctx, *vendorID, *amount, *note, *by)
+		if err != nil {
+			log.Fatalf("could not adjust vendor: %v", err)
+		}
+		log.Printf("%s: adjusted by %.2f, wallet balance %.2f", txn.TransactionRef, txn.Amount, txn.TotalBalance)
+	case "commission":
+		input := billingrepo.CommissionInput{
+			PackageID: *packageID,
+			Type:      vendorcommission.CommissionType(*commissionType),
+			Value:     *value,
+			UpdatedBy: *by,
+		}
+		if *vendorID > 0 {
+			input.VendorID = vendorID
+		}
+		rule, err := billingRepo.SetCommission(ctx, input)
+		if err != nil {
+			log.Fatalf("could not set commission: %v", err)
+		}
+		if err := writeJSON(os.Stdout, rule); err != nil {
+			log.Fatalf("could not write commission: %v", err)
+		}
+	case "commissions":
+		rules, err := billingRepo.ListCommissions(ctx, *vendorID)
+		if err != nil {
+			log.Fatalf("could not list commissions: %v", err)
+		}
+		if err := writeJSON(os.Stdout, rules); err != nil {
+			log.Fatalf("could not write commissions: %v", err)
+		}
+	case "renew":
+		client, err := c.ORM.ClientUser.Query().
+			Where(clientuser.UsernameEQ(*username)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			log.Fatalf("client %q not found", *username)
+		} else if err != nil {
+			log.Fatalf("could not load client: %v", err)
+		}
+		renewal, err := billingRepo.RenewByVendor(ctx, *vendorID, client.ID, *by)
+		if err != nil {
+			log.Fatalf("could not renew client: %v", err)
+		}
+		log.Printf("%s: %s, vendor paid %.2f with %.2f commission, wallet balance %.2f",
+			renewal.Txn.TransactionRef, renewal.Txn.Description, -renewal.VendorTxn.Amount,
+			renewal.VendorTxn.Commission, renewal.VendorTxn.TotalBalance)
+	case "statement":
+		from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Local)
+		statement, err := billingRepo.VendorStatementFor(ctx, *vendorID, from, from.AddDate(0, 1, 0))
+		if err != nil {
+			log.Fatalf("could not build statement: %v", err)
+		}
+		if err := writeJSON(os.Stdout, statement); err != nil {
+			log.Fatalf("could not write statement: %v", err)
+		}
+		log.Printf("%s: %d activations and %d renewals, %.2f charged with %.2f commission, balance %.2f to %.2f",
+			from.Format("January 2006"), statement.Activations, statement.Renewals, statement.Charges,
+			statement.Commission, statement.Opening, statement.Closing)
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: vendors list")
+	fmt.Fprintln(os.Stderr, "       vendors add -id id -name name -company c_name [-mobile number]")
+	fmt.Fprintln(os.Stderr, "       vendors topup -vendor id -amount amount -by operator [-note text]")
+	fmt.Fprintln(os.Stderr, "       vendors adjust -vendor id -amount amount -note reason -by operator")
+	fmt.Fprintln(os.Stderr, "       vendors commission -package id [-vendor id] -type percent|fixed -value value -by operator")
+	fmt.Fprintln(os.Stderr, "       vendors commissions [-vendor id]")
+	fmt.Fprintln(os.Stderr, "       vendors renew -vendor id -username name -by operator")
+	fmt.Fprintln(os.Stderr, "       vendors statement -vendor id [-month YYYY-MM]")
+	os.Exit(1)
+}
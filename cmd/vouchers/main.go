@@ -0,0 +1,185 @@
+// Command vouchers generates and manages the prepaid recharge cards sold by retail agents.
+//
+//	go run ./cmd/vouchers generate -name "Eid 500" -amount 500 -count 1000 -by alice -out eid-500.csv
+//	go run ./cmd/vouchers list
+//	go run ./cmd/vouchers export -batch 3 -out batch-3.csv
+//	go run ./cmd/vouchers disable -batch 3
+//
+// generate writes the only copy of the PINs to -out for printing; only their hashes are stored, so
+// keep that file safe and delete it once the cards are printed. export lists a batch's serials and
+// their status without PINs.
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	name := flags.String("name", "", "name of the batch")
+	amount := flags.Float64("amount", 0, "denomination of every voucher")
+	count := flags.Int("count", 0, "number of vouchers to generate")
+	expires := flags.String("expires", "", "day the vouchers stop working, YYYY-MM-DD")
+	by := flags.String("by", "", "name of the operator generating the batch")
+	batchID := flags.Int("batch", 0, "batch id")
+	out := flags.String("out", "", "CSV file to write")
+	_ = flags.Parse(os.Args[2:])
+
+	switch command {
+	case "list":
+	case "generate":
+		if *name == "" || *amount <= 0 || *count <= 0 || *by == "" || *out == "" {
+			usage()
+		}
+	case "export":
+		if *batchID == 0 || *out == "" {
+			usage()
+		}
+	case "disable":
+		if *batchID == 0 {
+			usage()
+		}
+	default:
+		usage()
+	}
+
+	c := services.NewContainer()
+	defer c.Shutdown()
+	billingRepo := c.Billing
+	ctx := context.Background()
+
+	switch command {
+	case "list":
+		batches, err := billingRepo.ListVoucherBatches(ctx)
+		if err != nil {
+			log.Fatalf("could not list voucher batches: %v", err)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(batches); err != nil {
+			log.Fatalf("could not write voucher batches: %v", err)
+		}
+
+	case "generate":
+		input := billingrepo.VoucherBatchInput{
+			Name:      *name,
+			Amount:    *amount,
+			Count:     *count,
+			CreatedBy: *by,
+		}
+		if *expires != "" {
+			t, err := time.ParseInLocation("2006-01-02", *expires, time.Local)
+			if err != nil {
+				log.Fatalf("invalid -expires: %v", err)
+			}
+			input.ExpiresAt = &t
+		}
+
+		// Refuse to overwrite a file, it may hold the PINs of another batch
+		f, err := os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			log.Fatalf("could not create %s: %v", *out, err)
+		}
+		defer f.Close()
+
+		batch, vouchers, err := billingRepo.GenerateVouchers(ctx, input)
+		if errors.Is(err, billingrepo.ErrVoucherBatch) {
+			log.Fatal("a batch needs a name, an amount above zero and between 1 and 10000 vouchers")
+		} else if err != nil {
+			log.Fatalf("could not generate vouchers: %v", err)
+		}
+
+		rows := [][]string{{"serial", "pin", "amount", "currency", "expires"}}
+		for _, v := range vouchers {
+			expiry := ""
+			if v.ExpiresAt != nil {
+				expiry = v.ExpiresAt.Format("2006-01-02")
+			}
+			rows = append(rows, []string{
+				v.Serial,
+				billingrepo.FormatVoucherPIN(v.PIN),
+				strconv.FormatFloat(v.Amount, 'f', 2, 64),
+				c.Config.Billing.Currency,
+				expiry,
+			})
+		}
+		if err := writeCSV(f, rows); err != nil {
+			log.Fatalf("batch %d was created but its PINs could not be written, disable it: %v", batch.ID, err)
+		}
+		log.Printf("generated batch %d with %d vouchers of %.2f, PINs written to %s", batch.ID, len(vouchers), batch.Amount, *out)
+
+	case "export":
+		vouchers, err := billingRepo.BatchVouchers(ctx, *batchID)
+		if err != nil {
+			log.Fatalf("could not load batch %d: %v", *batchID, err)
+		}
+		rows := [][]string{{"serial", "amount", "status", "expires", "redeemed_by", "redeemed_at", "transaction_ref"}}
+		for _, v := range vouchers {
+			expiry, redeemed := "", ""
+			if v.ExpiresAt != nil {
+				expiry = v.ExpiresAt.Format("2006-01-02")
+			}
+			if v.RedeemedAt != nil {
+				redeemed = v.RedeemedAt.Format(time.RFC3339)
+			}
+			rows = append(rows, []string{
+				v.Serial,
+				strconv.FormatFloat(v.Amount, 'f', 2, 64),
+				string(v.Status),
+				expiry,
+				v.RedeemedBy,
+				redeemed,
+				v.TransactionRef,
+			})
+		}
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("could not create %s: %v", *out, err)
+		}
+		defer f.Close()
+		if err := writeCSV(f, rows); err != nil {
+			log.Fatalf("could not write %s: %v", *out, err)
+		}
+		log.Printf("exported %d vouchers of batch %d to %s", len(vouchers), *batchID, *out)
+
+	case "disable":
+		n, err := billingRepo.DisableVoucherBatch(ctx, *batchID)
+		if err != nil {
+			log.Fatalf("could not disable batch %d: %v", *batchID, err)
+		}
+		log.Printf("disabled %d unused vouchers of batch %d", n, *batchID)
+	}
+}
+
+func writeCSV(f *os.File, rows [][]string) error {
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(rows); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: vouchers list")
+	fmt.Fprintln(os.Stderr, "       vouchers generate -name text -amount N -count N -by operator -out file.csv [-expires YYYY-MM-DD]")
+	fmt.Fprintln(os.Stderr, "       vouchers export -batch N -out file.csv")
+	fmt.Fprintln(os.Stderr, "       vouchers disable -batch N")
+	os.Exit(1)
+}
@@ -3,10 +3,14 @@ package main
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
 	"github.com/mikestefanello/pagoda/pkg/routing/routes"
 	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/mikestefanello/pagoda/pkg/tasks"
@@ -63,12 +67,141 @@ func main() {
 		c.ORM, c.Config.App.OperationalConstants.DeleteStaleNotificationAfterDays,
 	)
 
+	billingRepo := c.Billing
+	smsSender, err := notifierrepo.NewSMSSender(
+		c.ORM, c.Config.Phone.Region, c.Config.Phone.SenderID, c.Config.Phone.ValidationCodeExpirationMinutes)
+	if err != nil {
+		log.Printf("sms notifications to clients are disabled: %v", err)
+		smsSender = nil
+	}
+	// Push notifications are only sent when the container sets up the notifier
+	clientNotifier := notifierrepo.NewClientNotifier(c.Mail, smsSender).WithPush(c.ORM, c.Notifier)
+	autoRenewPackagesProcessor := tasks.NewAutoRenewPackagesProcessor(
+		billingRepo, clientNotifier, c.Config.Billing.AutoRenewal.Window,
+	)
+	enforceGracePeriodsProcessor := tasks.NewEnforceGracePeriodsProcessor(
+		billingRepo, clientNotifier, billingrepo.GracePolicy{
+			WarningDays:      c.Config.Billing.Grace.WarningDays,
+			ThrottleProfile:  c.Config.Billing.Grace.ThrottleProfile,
+			SuspendedProfile: c.Config.Billing.Grace.SuspendedProfile,
+		},
+	)
+	postpaidBillingProcessor := tasks.NewPostpaidBillingProcessor(
+		billingRepo, clientNotifier, billingrepo.PostpaidPolicy{
+			DueDays:          c.Config.Billing.Postpaid.DueDays,
+			SuspendAfterDays: c.Config.Billing.Postpaid.SuspendAfterDays,
+			SuspendedProfile: c.Config.Billing.Grace.SuspendedProfile,
+		}, c.Config.Billing.Currency,
+	)
+	sendDunningRemindersProcessor := tasks.NewSendDunningRemindersProcessor(
+		billingRepo, clientNotifier, c.Config.Billing.Dunning.Offsets, c.Config.Billing.Currency,
+	)
+	checkLedgerProcessor := tasks.NewCheckLedgerProcessor(billingRepo)
+	reconcileSettlementsProcessor := tasks.NewReconcileSettlementsProcessor(
+		billingRepo, c.Config.Billing.Settlement.Inbox, c.Config.Billing.Settlement.ReportDir,
+	)
+	rollupUsageProcessor := tasks.NewRollupUsageProcessor(
+		usagerepo.NewUsageRepo(c.ORM), c.Config.Radius.Usage.Lookback,
+	)
+
 	// Map task types to the handlers
 	mux := asynq.NewServeMux()
 	mux.Handle(tasks.TypeEmailSubscriptionConfirmation, emailSubscriptionConfirmationProcessor)
 	mux.Handle(tasks.TypeEmailUpdates, emailUpdateProcessor)
 	mux.Handle(tasks.TypeDeactivateExpiredSubscriptions, deactivateExpiredSubscriptionsProcessor)
 	mux.Handle(tasks.TypeDeleteStaleNotifications, deleteStaleNotificationsProcessor)
+	mux.Handle(tasks.TypeAutoRenewPackages, autoRenewPackagesProcessor)
+	mux.Handle(tasks.TypeEnforceGracePeriods, enforceGracePeriodsProcessor)
+	mux.Handle(tasks.TypePostpaidBilling, postpaidBillingProcessor)
+	mux.Handle(tasks.TypeSendDunningReminders, sendDunningRemindersProcessor)
+	mux.Handle(tasks.TypeCheckLedger, checkLedgerProcessor)
+	mux.Handle(tasks.TypeReconcileSettlements, reconcileSettlementsProcessor)
+	mux.Handle(tasks.TypeRollupUsage, rollupUsageProcessor)
+
+	// Register the periodic tasks and start the scheduler that queues them
+	taskClient := services.NewTaskClient(c.Config)
+	if schedule := c.Config.Billing.AutoRenewal.Schedule; schedule != "" {
+		err := taskClient.New(tasks.TypeAutoRenewPackages).
+			Periodic(schedule).
+			Queue("critical").
+			Timeout(30 * time.Minute).
+			Retain(7 * 24 * time.Hour).
+			Save()
+		if err != nil {
+			log.Fatalf("could not schedule package auto renewal: %v", err)
+		}
+	}
+	if schedule := c.Config.Billing.Grace.Schedule; schedule != "" {
+		err := taskClient.New(tasks.TypeEnforceGracePeriods).
+			Periodic(schedule).
+			Queue("critical").
+			Timeout(30 * time.Minute).
+			Retain(7 * 24 * time.Hour).
+			Save()
+		if err != nil {
+			log.Fatalf("could not schedule grace period enforcement: %v", err)
+		}
+	}
+	if schedule := c.Config.Billing.Postpaid.Schedule; schedule != "" {
+		err := taskClient.New(tasks.TypePostpaidBilling).
+			Periodic(schedule).
+			Queue("critical").
+			Timeout(time.Hour).
+			Retain(30 * 24 * time.Hour).
+			Save()
+		if err != nil {
+			log.Fatalf("could not schedule postpaid billing: %v", err)
+		}
+	}
+	if schedule := c.Config.Billing.Dunning.Schedule; schedule != "" {
+		err := taskClient.New(tasks.TypeSendDunningReminders).
+			Periodic(schedule).
+			Queue("default").
+			Timeout(30 * time.Minute).
+			Retain(7 * 24 * time.Hour).
+			Save()
+		if err != nil {
+			log.Fatalf("could not schedule dunning reminders: %v", err)
+		}
+	}
+	if schedule := c.Config.Billing.LedgerCheck.Schedule; schedule != "" {
+		err := taskClient.New(tasks.TypeCheckLedger).
+			Periodic(schedule).
+			Queue("low").
+			Timeout(time.Hour).
+			Retain(30 * 24 * time.Hour).
+			Save()
+		if err != nil {
+			log.Fatalf("could not schedule ledger check: %v", err)
+		}
+	}
+	if schedule := c.Config.Billing.Settlement.Schedule; schedule != "" {
+		err := taskClient.New(tasks.TypeReconcileSettlements).
+			Periodic(schedule).
+			Queue("low").
+			Timeout(time.Hour).
+			Retain(30 * 24 * time.Hour).
+			Save()
+		if err != nil {
+			log.Fatalf("could not schedule settlement reconciliation: %v", err)
+		}
+	}
+	if schedule := c.Config.Radius.Usage.Schedule; schedule != "" {
+		err := taskClient.New(tasks.TypeRollupUsage).
+			Periodic(schedule).
+			Queue("default").
+			Timeout(30 * time.Minute).
+			Retain(24 * time.Hour).
+			Save()
+		if err != nil {
+			log.Fatalf("could not schedule usage rollup: %v", err)
+		}
+	}
+	go func() {
+		if err := taskClient.StartScheduler(); err != nil {
+			log.Fatalf("could not run task scheduler: %v", err)
+		}
+	}()
 
 	// Start the worker server
 	if err := srv.Run(mux); err != nil {
@@ -71,6 +71,8 @@ type (
 		Phone       PhoneConfig
 		Recommender RecommenderConfig
 		Storage     StorageConfig
+		Billing     BillingConfig
+		Radius      RadiusConfig
 	}
 
 	// HTTPConfig stores HTTP configuration
@@ -91,34 +93,34 @@ type (
 
 	// AppConfig stores application configuration
 	AppConfig struct {
-		Name          app
-		SupportEmail  string
-		Environment   environment
-		EncryptionKey string
-		Timeout       time.Duration
-		OperationalConstants             OperationalConstants
-		VapidPublicKey                   string
-		VapidPrivateKey                  string
-		SentryDsn                        string
-		TestSentryUrl                    string
-		PublicStripeKey                  string
-		PrivateStripeKey                 string
-		StripeWebhookSecret              string
-		AppEncryptionKey                 string
-		FirebaseBase64AccessKeys         string
-		FirebaseJSONAccessKeys           []byte
+		Name                     app
+		SupportEmail             string
+		Environment              environment
+		EncryptionKey            string
+		Timeout                  time.Duration
+		OperationalConstants     OperationalConstants
+		VapidPublicKey           string
+		VapidPrivateKey          string
+		SentryDsn                string
+		TestSentryUrl            string
+		PublicStripeKey          string
+		PrivateStripeKey         string
+		StripeWebhookSecret      string
+		AppEncryptionKey         string
+		FirebaseBase64AccessKeys string
+		FirebaseJSONAccessKeys   []byte
 	}
 
 	OperationalConstants struct {
-		NewsletterSignupEnabled                           bool
-		NotifEmojiDebounceTime                            time.Duration
-		MinAnswerLen                                      int
-		PaymentsEnabled                                   bool
-		ProTrialTimespanInDays                            int
-		ProductProCode                                    string
-		ProductProPrice                                   float32
-		PaymentFailedGracePeriodInDays                    int
-		DeleteStaleNotificationAfterDays                  int
+		NewsletterSignupEnabled          bool
+		NotifEmojiDebounceTime           time.Duration
+		MinAnswerLen                     int
+		PaymentsEnabled                  bool
+		ProTrialTimespanInDays           int
+		ProductProCode                   string
+		ProductProPrice                  float32
+		PaymentFailedGracePeriodInDays   int
+		DeleteStaleNotificationAfterDays int
 	}
 
 	// CacheConfig stores the cache configuration
@@ -183,6 +185,226 @@ type (
 		ProfilePhotoMaxFileSizeMB int64
 		PhotosMaxFileSizeMB       int64
 	}
+
+	// BillingConfig stores the configuration for ISP client billing
+	BillingConfig struct {
+		Currency string
+		MinTopUp float64
+		MaxTopUp float64
+		// CycleDays is how long one renewal of a package lasts
+		CycleDays   int
+		AutoRenewal struct {
+			// Schedule is how often the worker looks for packages to renew, in cron form or "@every 1h"
+			Schedule string
+			// Window is how long before expiry a package is renewed
+			Window time.Duration
+		}
+		// Grace is how clients are handled between package expiry and the end of the grace period
+		// set on their package, after which they are suspended
+		Grace struct {
+			// Schedule is how often the worker moves expired clients through the grace stages
+			Schedule string
+			// WarningDays is how long into the grace period clients keep full speed
+			WarningDays int
+			// ThrottleProfile is the RADIUS group throttled clients are moved to, left alone when empty
+			ThrottleProfile string
+			// SuspendedProfile is the RADIUS group suspended clients are moved to, prepaid and postpaid
+			// alike, left alone when empty. Their expiry is in the past either way, so RADIUS rejects them.
+			SuspendedProfile string
+		}
+		// Postpaid is how clients billed after use are invoiced
+		Postpaid struct {
+			// Schedule is how often the worker issues last month's invoices and handles overdue ones
+			Schedule string
+			// DueDays is how long after the end of the billed month an invoice is due
+			DueDays int
+			// SuspendAfterDays is how long after the due date a client with an unpaid invoice is suspended
+			SuspendAfterDays int
+		}
+		// Dunning is the schedule of reminders sent around a prepaid package expiry or a postpaid
+		// invoice due date, until the client pays
+		Dunning struct {
+			// Schedule is how often the worker sends the reminders that are due
+			Schedule string
+			// Offsets are the days relative to the due date reminders are sent on, negative before it.
+			// Reminders after the due date are firmer and the last one is a final notice.
+			Offsets []int
+		}
+		// AdvancePayment lists the bundles of cycles clients can prepay at a discount
+		AdvancePayment struct {
+			Bundles []struct {
+				Cycles int
+				// Discount is a percentage taken off the price of the bundle
+				Discount float64
+			}
+		}
+		// Transfer limits balance transfers between clients
+		Transfer struct {
+			MinAmount float64
+			MaxAmount float64
+			// DailyAmount and DailyCount cap what one client can send per calendar day
+			DailyAmount float64
+			DailyCount  int
+			// CodeExpiry is how long the one-time code sent to confirm a transfer stays valid
+			CodeExpiry  time.Duration
+			MaxAttempts int
+		}
+		// PayForClient is the public page where anyone can pay for a client, by username or with a
+		// payment link the client shares from their dashboard
+		PayForClient struct {
+			// LinkExpiry is how long a shared payment link stays valid
+			LinkExpiry time.Duration
+			// MaxRequests limits how many lookups and payments one IP address may make within Window
+			MaxRequests int
+			Window      time.Duration
+		}
+		// Voucher limits how many invalid recharge card PINs a client may enter within Window
+		Voucher struct {
+			MaxAttempts int
+			Window      time.Duration
+		}
+		// ManualPayment lists the merchant numbers clients can send money to and then report the
+		// TrxID of. A provider is only offered when its number is set.
+		ManualPayment struct {
+			BkashNumber string
+			NagadNumber string
+		}
+		// Settlement is where the worker picks up gateway settlement files and leaves the daily
+		// reconciliation reports
+		Settlement struct {
+			// Schedule is how often the worker imports new files and reconciles the previous day
+			Schedule string
+			// Inbox holds a folder per gateway, e.g. inbox/bkash, that settlement CSV files are
+			// dropped into. Imported files are moved to an imported folder next to them.
+			Inbox string
+			// ReportDir receives a JSON report per day, left out when empty
+			ReportDir string
+		}
+		LedgerCheck struct {
+			// Schedule is how often the worker checks client balances against their transactions
+			Schedule string
+		}
+		Gateways PaymentGatewaysConfig
+		Tax      TaxConfig
+		// Branding is printed on receipts and invoices
+		Branding struct {
+			Name    string
+			Address string
+			Phone   string
+			Email   string
+			Website string
+			// LogoPath is a local PNG or JPEG file, left out when empty
+			LogoPath string
+			Footer   string
+		}
+	}
+
+	// TaxConfig stores the VAT charged on the services clients are billed for. A service without a
+	// rule is not taxed, and balance top-ups and transfers never are.
+	TaxConfig struct {
+		// Name is printed on receipts and invoices, e.g. VAT
+		Name string
+		// RegistrationNumber is our VAT registration (BIN), printed on receipts when set
+		RegistrationNumber string
+		Rules              []TaxRuleConfig
+	}
+
+	// TaxRuleConfig is the tax on one type of service
+	TaxRuleConfig struct {
+		// Service is what the rule applies to: internet for packages, addon for postpaid add-ons
+		Service string
+		// Rate is a percentage
+		Rate float64
+		// Inclusive rules take the tax out of the price, exclusive ones add it on top
+		Inclusive bool
+	}
+
+	// PaymentGatewaysConfig stores the credentials of each payment gateway clients can top up through.
+	// A gateway is only offered to clients when it is enabled.
+	PaymentGatewaysConfig struct {
+		Bkash struct {
+			Enabled   bool
+			BaseURL   string
+			AppKey    string
+			AppSecret string
+			Username  string
+			Password  string
+		}
+		Nagad struct {
+			Enabled            bool
+			BaseURL            string
+			MerchantID         string
+			MerchantNumber     string
+			MerchantPrivateKey string
+			PGPublicKey        string
+		}
+		SSLCommerz struct {
+			Enabled       bool
+			BaseURL       string
+			StoreID       string
+			StorePassword string
+		}
+		Stripe struct {
+			Enabled       bool
+			SecretKey     string
+			WebhookSecret string
+		}
+		// Fake is a local stand-in gateway that approves every payment. It is off in the shared config
+		// and only switched on per machine with PAGODA_BILLING_GATEWAYS_FAKE_ENABLED and
+		// PAGODA_BILLING_GATEWAYS_FAKE_SECRET. It is never registered in production.
+		Fake struct {
+			Enabled bool
+			Secret  string
+		}
+	}
+
+	// RadiusConfig stores the configuration for talking to the NASes clients connect through
+	RadiusConfig struct {
+		NAS []NASConfig
+		// CoA pushes plan changes, suspensions and speed boosts to open sessions with RFC 5176
+		// Change-of-Authorization and Disconnect requests, instead of waiting for clients to reconnect
+		CoA CoAConfig
+		// Accounting is the RFC 2866 listener run by cmd/radacct, for networks without FreeRADIUS
+		// accounting
+		Accounting AccountingConfig
+		// Usage rolls radacct up into traffic per client per hour and per day
+		Usage UsageConfig
+	}
+
+	// NASConfig is a NAS clients connect through and the secret it shares with us
+	NASConfig struct {
+		// Address is the NAS-IP-Address it reports in accounting
+		Address string
+		Secret  string
+		// CoAPort is the port it listens for CoA requests on, CoA.Port when not set
+		CoAPort int
+	}
+
+	// CoAConfig stores the dynamic authorization client configuration
+	CoAConfig struct {
+		Enabled bool
+		// Port is the port NASes listen for requests on, 3799 when not set here or on the NAS
+		Port int
+		// Timeout is how long to wait for a NAS to answer before resending, Retries times
+		Timeout time.Duration
+		Retries int
+	}
+
+	// AccountingConfig stores the accounting listener configuration
+	AccountingConfig struct {
+		// Address is the UDP address to listen on, e.g. ":1813"
+		Address string
+		// Publish sends session events to subscribers through the cache, which must be reachable
+		Publish bool
+	}
+
+	// UsageConfig stores the usage roll-up configuration
+	UsageConfig struct {
+		// Schedule is how often the worker rolls up sessions written by FreeRADIUS
+		Schedule string
+		// Lookback is how far back a roll-up looks for stopped sessions, so one missed run is caught up
+		Lookback time.Duration
+	}
 )
 
 // GetConfig loads and returns configuration
@@ -203,6 +425,10 @@ func GetConfig() (Config, error) {
 		return c, err
 	}
 
+	// The fake payment gateway can only be switched on from the environment, in any environment
+	_ = viper.BindEnv("billing.gateways.fake.enabled", "PAGODA_BILLING_GATEWAYS_FAKE_ENABLED")
+	_ = viper.BindEnv("billing.gateways.fake.secret", "PAGODA_BILLING_GATEWAYS_FAKE_SECRET")
+
 	// Unmarshal the config
 	if err := viper.Unmarshal(&c); err != nil {
 		return c, err
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+)
+
+// BalanceTransfer is the model entity for the BalanceTransfer schema.
+type BalanceTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FromUsername holds the value of the "from_username" field.
+	FromUsername string `json:"from_username,omitempty"`
+	// ToUsername holds the value of the "to_username" field.
+	ToUsername string `json:"to_username,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// SHA-256 of the one-time code sent to the sender
+	CodeHash string `json:"-"`
+	// Wrong codes entered so far
+	Attempts int `json:"attempts,omitempty"`
+	// Status holds the value of the "status" field.
+	Status balancetransfer.Status `json:"status,omitempty"`
+	// transaction_ref of the sender's TRANSFER_REFUND
+	DebitRef string `json:"debit_ref,omitempty"`
+	// transaction_ref of the recipient's TRANSFER_RECEIVED
+	CreditRef string `json:"credit_ref,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balancetransfer.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case balancetransfer.FieldID, balancetransfer.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case balancetransfer.FieldFromUsername, balancetransfer.FieldToUsername, balancetransfer.FieldCodeHash, balancetransfer.FieldStatus, balancetransfer.FieldDebitRef, balancetransfer.FieldCreditRef:
+			values[i] = new(sql.NullString)
+		case balancetransfer.FieldExpiresAt, balancetransfer.FieldCompletedAt, balancetransfer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceTransfer fields.
+func (bt *BalanceTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balancetransfer.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bt.ID = int(value.Int64)
+		case balancetransfer.FieldFromUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_username", values[i])
+			} else if value.Valid {
+				bt.FromUsername = value.String
+			}
+		case balancetransfer.FieldToUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_username", values[i])
+			} else if value.Valid {
+				bt.ToUsername = value.String
+			}
+		case balancetransfer.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				bt.Amount = value.Float64
+			}
+		case balancetransfer.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				bt.CodeHash = value.String
+			}
+		case balancetransfer.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				bt.Attempts = int(value.Int64)
+			}
+		case balancetransfer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				bt.Status = balancetransfer.Status(value.String)
+			}
+		case balancetransfer.FieldDebitRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field debit_ref", values[i])
+			} else if value.Valid {
+				bt.DebitRef = value.String
+			}
+		case balancetransfer.FieldCreditRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_ref", values[i])
+			} else if value.Valid {
+				bt.CreditRef = value.String
+			}
+		case balancetransfer.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				bt.ExpiresAt = value.Time
+			}
+		case balancetransfer.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				bt.CompletedAt = new(time.Time)
+				*bt.CompletedAt = value.Time
+			}
+		case balancetransfer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				bt.CreatedAt = value.Time
+			}
+		default:
+			bt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BalanceTransfer.
+// This includes values selected through modifiers, order, etc.
+func (bt *BalanceTransfer) Value(name string) (ent.Value, error) {
+	return bt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BalanceTransfer.
+// Note that you need to call BalanceTransfer.Unwrap() before calling this method if this BalanceTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bt *BalanceTransfer) Update() *BalanceTransferUpdateOne {
+	return NewBalanceTransferClient(bt.config).UpdateOne(bt)
+}
+
+// Unwrap unwraps the BalanceTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bt *BalanceTransfer) Unwrap() *BalanceTransfer {
+	_tx, ok := bt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BalanceTransfer is not a transactional entity")
+	}
+	bt.config.driver = _tx.drv
+	return bt
+}
+
+// String implements the fmt.Stringer.
+func (bt *BalanceTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bt.ID))
+	builder.WriteString("from_username=")
+	builder.WriteString(bt.FromUsername)
+	builder.WriteString(", ")
+	builder.WriteString("to_username=")
+	builder.WriteString(bt.ToUsername)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", bt.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", bt.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", bt.Status))
+	builder.WriteString(", ")
+	builder.WriteString("debit_ref=")
+	builder.WriteString(bt.DebitRef)
+	builder.WriteString(", ")
+	builder.WriteString("credit_ref=")
+	builder.WriteString(bt.CreditRef)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(bt.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := bt.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(bt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceTransfers is a parsable slice of BalanceTransfer.
+type BalanceTransfers []*BalanceTransfer
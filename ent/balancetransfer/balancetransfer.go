@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancetransfer
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the balancetransfer type in the database.
+	Label = "balance_transfer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFromUsername holds the string denoting the from_username field in the database.
+	FieldFromUsername = "from_username"
+	// FieldToUsername holds the string denoting the to_username field in the database.
+	FieldToUsername = "to_username"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCodeHash holds the string denoting the code_hash field in the database.
+	FieldCodeHash = "code_hash"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDebitRef holds the string denoting the debit_ref field in the database.
+	FieldDebitRef = "debit_ref"
+	// FieldCreditRef holds the string denoting the credit_ref field in the database.
+	FieldCreditRef = "credit_ref"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the balancetransfer in the database.
+	Table = "balance_transfers"
+)
+
+// Columns holds all SQL columns for balancetransfer fields.
+var Columns = []string{
+	FieldID,
+	FieldFromUsername,
+	FieldToUsername,
+	FieldAmount,
+	FieldCodeHash,
+	FieldAttempts,
+	FieldStatus,
+	FieldDebitRef,
+	FieldCreditRef,
+	FieldExpiresAt,
+	FieldCompletedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FromUsernameValidator is a validator for the "from_username" field. It is called by the builders before save.
+	FromUsernameValidator func(string) error
+	// ToUsernameValidator is a validator for the "to_username" field. It is called by the builders before save.
+	ToUsernameValidator func(string) error
+	// CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	CodeHashValidator func(string) error
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DebitRefValidator is a validator for the "debit_ref" field. It is called by the builders before save.
+	DebitRefValidator func(string) error
+	// CreditRefValidator is a validator for the "credit_ref" field. It is called by the builders before save.
+	CreditRefValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+	StatusExpired   Status = "expired"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusCompleted, StatusCancelled, StatusExpired:
+		return nil
+	default:
+		return fmt.Errorf("balancetransfer: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the BalanceTransfer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFromUsername orders the results by the from_username field.
+func ByFromUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromUsername, opts...).ToFunc()
+}
+
+// ByToUsername orders the results by the to_username field.
+func ByToUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToUsername, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByCodeHash orders the results by the code_hash field.
+func ByCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeHash, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDebitRef orders the results by the debit_ref field.
+func ByDebitRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDebitRef, opts...).ToFunc()
+}
+
+// ByCreditRef orders the results by the credit_ref field.
+func ByCreditRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditRef, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,695 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancetransfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// FromUsername applies equality check predicate on the "from_username" field. It's identical to FromUsernameEQ.
+func FromUsername(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldFromUsername, v))
+}
+
+// ToUsername applies equality check predicate on the "to_username" field. It's identical to ToUsernameEQ.
+func ToUsername(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldToUsername, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldAmount, v))
+}
+
+// CodeHash applies equality check predicate on the "code_hash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldAttempts, v))
+}
+
+// DebitRef applies equality check predicate on the "debit_ref" field. It's identical to DebitRefEQ.
+func DebitRef(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldDebitRef, v))
+}
+
+// CreditRef applies equality check predicate on the "credit_ref" field. It's identical to CreditRefEQ.
+func CreditRef(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldCreditRef, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FromUsernameEQ applies the EQ predicate on the "from_username" field.
+func FromUsernameEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldFromUsername, v))
+}
+
+// FromUsernameNEQ applies the NEQ predicate on the "from_username" field.
+func FromUsernameNEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldFromUsername, v))
+}
+
+// FromUsernameIn applies the In predicate on the "from_username" field.
+func FromUsernameIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldFromUsername, vs...))
+}
+
+// FromUsernameNotIn applies the NotIn predicate on the "from_username" field.
+func FromUsernameNotIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldFromUsername, vs...))
+}
+
+// FromUsernameGT applies the GT predicate on the "from_username" field.
+func FromUsernameGT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldFromUsername, v))
+}
+
+// FromUsernameGTE applies the GTE predicate on the "from_username" field.
+func FromUsernameGTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldFromUsername, v))
+}
+
+// FromUsernameLT applies the LT predicate on the "from_username" field.
+func FromUsernameLT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldFromUsername, v))
+}
+
+// FromUsernameLTE applies the LTE predicate on the "from_username" field.
+func FromUsernameLTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldFromUsername, v))
+}
+
+// FromUsernameContains applies the Contains predicate on the "from_username" field.
+func FromUsernameContains(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContains(FieldFromUsername, v))
+}
+
+// FromUsernameHasPrefix applies the HasPrefix predicate on the "from_username" field.
+func FromUsernameHasPrefix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasPrefix(FieldFromUsername, v))
+}
+
+// FromUsernameHasSuffix applies the HasSuffix predicate on the "from_username" field.
+func FromUsernameHasSuffix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasSuffix(FieldFromUsername, v))
+}
+
+// FromUsernameEqualFold applies the EqualFold predicate on the "from_username" field.
+func FromUsernameEqualFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEqualFold(FieldFromUsername, v))
+}
+
+// FromUsernameContainsFold applies the ContainsFold predicate on the "from_username" field.
+func FromUsernameContainsFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContainsFold(FieldFromUsername, v))
+}
+
+// ToUsernameEQ applies the EQ predicate on the "to_username" field.
+func ToUsernameEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldToUsername, v))
+}
+
+// ToUsernameNEQ applies the NEQ predicate on the "to_username" field.
+func ToUsernameNEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldToUsername, v))
+}
+
+// ToUsernameIn applies the In predicate on the "to_username" field.
+func ToUsernameIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldToUsername, vs...))
+}
+
+// ToUsernameNotIn applies the NotIn predicate on the "to_username" field.
+func ToUsernameNotIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldToUsername, vs...))
+}
+
+// ToUsernameGT applies the GT predicate on the "to_username" field.
+func ToUsernameGT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldToUsername, v))
+}
+
+// ToUsernameGTE applies the GTE predicate on the "to_username" field.
+func ToUsernameGTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldToUsername, v))
+}
+
+// ToUsernameLT applies the LT predicate on the "to_username" field.
+func ToUsernameLT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldToUsername, v))
+}
+
+// ToUsernameLTE applies the LTE predicate on the "to_username" field.
+func ToUsernameLTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldToUsername, v))
+}
+
+// ToUsernameContains applies the Contains predicate on the "to_username" field.
+func ToUsernameContains(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContains(FieldToUsername, v))
+}
+
+// ToUsernameHasPrefix applies the HasPrefix predicate on the "to_username" field.
+func ToUsernameHasPrefix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasPrefix(FieldToUsername, v))
+}
+
+// ToUsernameHasSuffix applies the HasSuffix predicate on the "to_username" field.
+func ToUsernameHasSuffix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasSuffix(FieldToUsername, v))
+}
+
+// ToUsernameEqualFold applies the EqualFold predicate on the "to_username" field.
+func ToUsernameEqualFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEqualFold(FieldToUsername, v))
+}
+
+// ToUsernameContainsFold applies the ContainsFold predicate on the "to_username" field.
+func ToUsernameContainsFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContainsFold(FieldToUsername, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldAmount, v))
+}
+
+// CodeHashEQ applies the EQ predicate on the "code_hash" field.
+func CodeHashEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "code_hash" field.
+func CodeHashNEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "code_hash" field.
+func CodeHashIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldCodeHash, vs...))
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "code_hash" field.
+func CodeHashNotIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldCodeHash, vs...))
+}
+
+// CodeHashGT applies the GT predicate on the "code_hash" field.
+func CodeHashGT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldCodeHash, v))
+}
+
+// CodeHashGTE applies the GTE predicate on the "code_hash" field.
+func CodeHashGTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldCodeHash, v))
+}
+
+// CodeHashLT applies the LT predicate on the "code_hash" field.
+func CodeHashLT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldCodeHash, v))
+}
+
+// CodeHashLTE applies the LTE predicate on the "code_hash" field.
+func CodeHashLTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldCodeHash, v))
+}
+
+// CodeHashContains applies the Contains predicate on the "code_hash" field.
+func CodeHashContains(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContains(FieldCodeHash, v))
+}
+
+// CodeHashHasPrefix applies the HasPrefix predicate on the "code_hash" field.
+func CodeHashHasPrefix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasPrefix(FieldCodeHash, v))
+}
+
+// CodeHashHasSuffix applies the HasSuffix predicate on the "code_hash" field.
+func CodeHashHasSuffix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasSuffix(FieldCodeHash, v))
+}
+
+// CodeHashEqualFold applies the EqualFold predicate on the "code_hash" field.
+func CodeHashEqualFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEqualFold(FieldCodeHash, v))
+}
+
+// CodeHashContainsFold applies the ContainsFold predicate on the "code_hash" field.
+func CodeHashContainsFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContainsFold(FieldCodeHash, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldAttempts, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DebitRefEQ applies the EQ predicate on the "debit_ref" field.
+func DebitRefEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldDebitRef, v))
+}
+
+// DebitRefNEQ applies the NEQ predicate on the "debit_ref" field.
+func DebitRefNEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldDebitRef, v))
+}
+
+// DebitRefIn applies the In predicate on the "debit_ref" field.
+func DebitRefIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldDebitRef, vs...))
+}
+
+// DebitRefNotIn applies the NotIn predicate on the "debit_ref" field.
+func DebitRefNotIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldDebitRef, vs...))
+}
+
+// DebitRefGT applies the GT predicate on the "debit_ref" field.
+func DebitRefGT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldDebitRef, v))
+}
+
+// DebitRefGTE applies the GTE predicate on the "debit_ref" field.
+func DebitRefGTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldDebitRef, v))
+}
+
+// DebitRefLT applies the LT predicate on the "debit_ref" field.
+func DebitRefLT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldDebitRef, v))
+}
+
+// DebitRefLTE applies the LTE predicate on the "debit_ref" field.
+func DebitRefLTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldDebitRef, v))
+}
+
+// DebitRefContains applies the Contains predicate on the "debit_ref" field.
+func DebitRefContains(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContains(FieldDebitRef, v))
+}
+
+// DebitRefHasPrefix applies the HasPrefix predicate on the "debit_ref" field.
+func DebitRefHasPrefix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasPrefix(FieldDebitRef, v))
+}
+
+// DebitRefHasSuffix applies the HasSuffix predicate on the "debit_ref" field.
+func DebitRefHasSuffix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasSuffix(FieldDebitRef, v))
+}
+
+// DebitRefIsNil applies the IsNil predicate on the "debit_ref" field.
+func DebitRefIsNil() predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIsNull(FieldDebitRef))
+}
+
+// DebitRefNotNil applies the NotNil predicate on the "debit_ref" field.
+func DebitRefNotNil() predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotNull(FieldDebitRef))
+}
+
+// DebitRefEqualFold applies the EqualFold predicate on the "debit_ref" field.
+func DebitRefEqualFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEqualFold(FieldDebitRef, v))
+}
+
+// DebitRefContainsFold applies the ContainsFold predicate on the "debit_ref" field.
+func DebitRefContainsFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContainsFold(FieldDebitRef, v))
+}
+
+// CreditRefEQ applies the EQ predicate on the "credit_ref" field.
+func CreditRefEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldCreditRef, v))
+}
+
+// CreditRefNEQ applies the NEQ predicate on the "credit_ref" field.
+func CreditRefNEQ(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldCreditRef, v))
+}
+
+// CreditRefIn applies the In predicate on the "credit_ref" field.
+func CreditRefIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldCreditRef, vs...))
+}
+
+// CreditRefNotIn applies the NotIn predicate on the "credit_ref" field.
+func CreditRefNotIn(vs ...string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldCreditRef, vs...))
+}
+
+// CreditRefGT applies the GT predicate on the "credit_ref" field.
+func CreditRefGT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldCreditRef, v))
+}
+
+// CreditRefGTE applies the GTE predicate on the "credit_ref" field.
+func CreditRefGTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldCreditRef, v))
+}
+
+// CreditRefLT applies the LT predicate on the "credit_ref" field.
+func CreditRefLT(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldCreditRef, v))
+}
+
+// CreditRefLTE applies the LTE predicate on the "credit_ref" field.
+func CreditRefLTE(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldCreditRef, v))
+}
+
+// CreditRefContains applies the Contains predicate on the "credit_ref" field.
+func CreditRefContains(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContains(FieldCreditRef, v))
+}
+
+// CreditRefHasPrefix applies the HasPrefix predicate on the "credit_ref" field.
+func CreditRefHasPrefix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasPrefix(FieldCreditRef, v))
+}
+
+// CreditRefHasSuffix applies the HasSuffix predicate on the "credit_ref" field.
+func CreditRefHasSuffix(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldHasSuffix(FieldCreditRef, v))
+}
+
+// CreditRefIsNil applies the IsNil predicate on the "credit_ref" field.
+func CreditRefIsNil() predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIsNull(FieldCreditRef))
+}
+
+// CreditRefNotNil applies the NotNil predicate on the "credit_ref" field.
+func CreditRefNotNil() predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotNull(FieldCreditRef))
+}
+
+// CreditRefEqualFold applies the EqualFold predicate on the "credit_ref" field.
+func CreditRefEqualFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEqualFold(FieldCreditRef, v))
+}
+
+// CreditRefContainsFold applies the ContainsFold predicate on the "credit_ref" field.
+func CreditRefContainsFold(v string) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldContainsFold(FieldCreditRef, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BalanceTransfer) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BalanceTransfer) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BalanceTransfer) predicate.BalanceTransfer {
+	return predicate.BalanceTransfer(sql.NotPredicates(p))
+}
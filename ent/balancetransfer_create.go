@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+)
+
+// BalanceTransferCreate is the builder for creating a BalanceTransfer entity.
+type BalanceTransferCreate struct {
+	config
+	mutation *BalanceTransferMutation
+	hooks    []Hook
+}
+
+// SetFromUsername sets the "from_username" field.
+func (btc *BalanceTransferCreate) SetFromUsername(s string) *BalanceTransferCreate {
+	btc.mutation.SetFromUsername(s)
+	return btc
+}
+
+// SetToUsername sets the "to_username" field.
+func (btc *BalanceTransferCreate) SetToUsername(s string) *BalanceTransferCreate {
+	btc.mutation.SetToUsername(s)
+	return btc
+}
+
+// SetAmount sets the "amount" field.
+func (btc *BalanceTransferCreate) SetAmount(f float64) *BalanceTransferCreate {
+	btc.mutation.SetAmount(f)
+	return btc
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (btc *BalanceTransferCreate) SetCodeHash(s string) *BalanceTransferCreate {
+	btc.mutation.SetCodeHash(s)
+	return btc
+}
+
+// SetAttempts sets the "attempts" field.
+func (btc *BalanceTransferCreate) SetAttempts(i int) *BalanceTransferCreate {
+	btc.mutation.SetAttempts(i)
+	return btc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (btc *BalanceTransferCreate) SetNillableAttempts(i *int) *BalanceTransferCreate {
+	if i != nil {
+		btc.SetAttempts(*i)
+	}
+	return btc
+}
+
+// SetStatus sets the "status" field.
+func (btc *BalanceTransferCreate) SetStatus(b balancetransfer.Status) *BalanceTransferCreate {
+	btc.mutation.SetStatus(b)
+	return btc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (btc *BalanceTransferCreate) SetNillableStatus(b *balancetransfer.Status) *BalanceTransferCreate {
+	if b != nil {
+		btc.SetStatus(*b)
+	}
+	return btc
+}
+
+// SetDebitRef sets the "debit_ref" field.
+func (btc *BalanceTransferCreate) SetDebitRef(s string) *BalanceTransferCreate {
+	btc.mutation.SetDebitRef(s)
+	return btc
+}
+
+// SetNillableDebitRef sets the "debit_ref" field if the given value is not nil.
+func (btc *BalanceTransferCreate) SetNillableDebitRef(s *string) *BalanceTransferCreate {
+	if s != nil {
+		btc.SetDebitRef(*s)
+	}
+	return btc
+}
+
+// SetCreditRef sets the "credit_ref" field.
+func (btc *BalanceTransferCreate) SetCreditRef(s string) *BalanceTransferCreate {
+	btc.mutation.SetCreditRef(s)
+	return btc
+}
+
+// SetNillableCreditRef sets the "credit_ref" field if the given value is not nil.
+func (btc *BalanceTransferCreate) SetNillableCreditRef(s *string) *BalanceTransferCreate {
+	if s != nil {
+		btc.SetCreditRef(*s)
+	}
+	return btc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (btc *BalanceTransferCreate) SetExpiresAt(t time.Time) *BalanceTransferCreate {
+	btc.mutation.SetExpiresAt(t)
+	return btc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (btc *BalanceTransferCreate) SetCompletedAt(t time.Time) *BalanceTransferCreate {
+	btc.mutation.SetCompletedAt(t)
+	return btc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (btc *BalanceTransferCreate) SetNillableCompletedAt(t *time.Time) *BalanceTransferCreate {
+	if t != nil {
+		btc.SetCompletedAt(*t)
+	}
+	return btc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (btc *BalanceTransferCreate) SetCreatedAt(t time.Time) *BalanceTransferCreate {
+	btc.mutation.SetCreatedAt(t)
+	return btc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (btc *BalanceTransferCreate) SetNillableCreatedAt(t *time.Time) *BalanceTransferCreate {
+	if t != nil {
+		btc.SetCreatedAt(*t)
+	}
+	return btc
+}
+
+// Mutation returns the BalanceTransferMutation object of the builder.
+func (btc *BalanceTransferCreate) Mutation() *BalanceTransferMutation {
+	return btc.mutation
+}
+
+// Save creates the BalanceTransfer in the database.
+func (btc *BalanceTransferCreate) Save(ctx context.Context) (*BalanceTransfer, error) {
+	btc.defaults()
+	return withHooks(ctx, btc.sqlSave, btc.mutation, btc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (btc *BalanceTransferCreate) SaveX(ctx context.Context) *BalanceTransfer {
+	v, err := btc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (btc *BalanceTransferCreate) Exec(ctx context.Context) error {
+	_, err := btc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btc *BalanceTransferCreate) ExecX(ctx context.Context) {
+	if err := btc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (btc *BalanceTransferCreate) defaults() {
+	if _, ok := btc.mutation.Attempts(); !ok {
+		v := balancetransfer.DefaultAttempts
+		btc.mutation.SetAttempts(v)
+	}
+	if _, ok := btc.mutation.Status(); !ok {
+		v := balancetransfer.DefaultStatus
+		btc.mutation.SetStatus(v)
+	}
+	if _, ok := btc.mutation.CreatedAt(); !ok {
+		v := balancetransfer.DefaultCreatedAt()
+		btc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (btc *BalanceTransferCreate) check() error {
+	if _, ok := btc.mutation.FromUsername(); !ok {
+		return &ValidationError{Name: "from_username", err: errors.New(`ent: missing required field "BalanceTransfer.from_username"`)}
+	}
+	if v, ok := btc.mutation.FromUsername(); ok {
+		if err := balancetransfer.FromUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "from_username", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.from_username": %w`, err)}
+		}
+	}
+	if _, ok := btc.mutation.ToUsername(); !ok {
+		return &ValidationError{Name: "to_username", err: errors.New(`ent: missing required field "BalanceTransfer.to_username"`)}
+	}
+	if v, ok := btc.mutation.ToUsername(); ok {
+		if err := balancetransfer.ToUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "to_username", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.to_username": %w`, err)}
+		}
+	}
+	if _, ok := btc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BalanceTransfer.amount"`)}
+	}
+	if _, ok := btc.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "BalanceTransfer.code_hash"`)}
+	}
+	if v, ok := btc.mutation.CodeHash(); ok {
+		if err := balancetransfer.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := btc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "BalanceTransfer.attempts"`)}
+	}
+	if _, ok := btc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BalanceTransfer.status"`)}
+	}
+	if v, ok := btc.mutation.Status(); ok {
+		if err := balancetransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.status": %w`, err)}
+		}
+	}
+	if v, ok := btc.mutation.DebitRef(); ok {
+		if err := balancetransfer.DebitRefValidator(v); err != nil {
+			return &ValidationError{Name: "debit_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.debit_ref": %w`, err)}
+		}
+	}
+	if v, ok := btc.mutation.CreditRef(); ok {
+		if err := balancetransfer.CreditRefValidator(v); err != nil {
+			return &ValidationError{Name: "credit_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.credit_ref": %w`, err)}
+		}
+	}
+	if _, ok := btc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "BalanceTransfer.expires_at"`)}
+	}
+	if _, ok := btc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BalanceTransfer.created_at"`)}
+	}
+	return nil
+}
+
+func (btc *BalanceTransferCreate) sqlSave(ctx context.Context) (*BalanceTransfer, error) {
+	if err := btc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := btc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, btc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	btc.mutation.id = &_node.ID
+	btc.mutation.done = true
+	return _node, nil
+}
+
+func (btc *BalanceTransferCreate) createSpec() (*BalanceTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceTransfer{config: btc.config}
+		_spec = sqlgraph.NewCreateSpec(balancetransfer.Table, sqlgraph.NewFieldSpec(balancetransfer.FieldID, field.TypeInt))
+	)
+	if value, ok := btc.mutation.FromUsername(); ok {
+		_spec.SetField(balancetransfer.FieldFromUsername, field.TypeString, value)
+		_node.FromUsername = value
+	}
+	if value, ok := btc.mutation.ToUsername(); ok {
+		_spec.SetField(balancetransfer.FieldToUsername, field.TypeString, value)
+		_node.ToUsername = value
+	}
+	if value, ok := btc.mutation.Amount(); ok {
+		_spec.SetField(balancetransfer.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := btc.mutation.CodeHash(); ok {
+		_spec.SetField(balancetransfer.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := btc.mutation.Attempts(); ok {
+		_spec.SetField(balancetransfer.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := btc.mutation.Status(); ok {
+		_spec.SetField(balancetransfer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := btc.mutation.DebitRef(); ok {
+		_spec.SetField(balancetransfer.FieldDebitRef, field.TypeString, value)
+		_node.DebitRef = value
+	}
+	if value, ok := btc.mutation.CreditRef(); ok {
+		_spec.SetField(balancetransfer.FieldCreditRef, field.TypeString, value)
+		_node.CreditRef = value
+	}
+	if value, ok := btc.mutation.ExpiresAt(); ok {
+		_spec.SetField(balancetransfer.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := btc.mutation.CompletedAt(); ok {
+		_spec.SetField(balancetransfer.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := btc.mutation.CreatedAt(); ok {
+		_spec.SetField(balancetransfer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// BalanceTransferCreateBulk is the builder for creating many BalanceTransfer entities in bulk.
+type BalanceTransferCreateBulk struct {
+	config
+	err      error
+	builders []*BalanceTransferCreate
+}
+
+// Save creates the BalanceTransfer entities in the database.
+func (btcb *BalanceTransferCreateBulk) Save(ctx context.Context) ([]*BalanceTransfer, error) {
+	if btcb.err != nil {
+		return nil, btcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(btcb.builders))
+	nodes := make([]*BalanceTransfer, len(btcb.builders))
+	mutators := make([]Mutator, len(btcb.builders))
+	for i := range btcb.builders {
+		func(i int, root context.Context) {
+			builder := btcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, btcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, btcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, btcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btcb *BalanceTransferCreateBulk) SaveX(ctx context.Context) []*BalanceTransfer {
+	v, err := btcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (btcb *BalanceTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := btcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btcb *BalanceTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := btcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
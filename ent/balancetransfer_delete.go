@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// BalanceTransferDelete is the builder for deleting a BalanceTransfer entity.
+type BalanceTransferDelete struct {
+	config
+	hooks    []Hook
+	mutation *BalanceTransferMutation
+}
+
+// Where appends a list predicates to the BalanceTransferDelete builder.
+func (btd *BalanceTransferDelete) Where(ps ...predicate.BalanceTransfer) *BalanceTransferDelete {
+	btd.mutation.Where(ps...)
+	return btd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (btd *BalanceTransferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, btd.sqlExec, btd.mutation, btd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btd *BalanceTransferDelete) ExecX(ctx context.Context) int {
+	n, err := btd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (btd *BalanceTransferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(balancetransfer.Table, sqlgraph.NewFieldSpec(balancetransfer.FieldID, field.TypeInt))
+	if ps := btd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, btd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	btd.mutation.done = true
+	return affected, err
+}
+
+// BalanceTransferDeleteOne is the builder for deleting a single BalanceTransfer entity.
+type BalanceTransferDeleteOne struct {
+	btd *BalanceTransferDelete
+}
+
+// Where appends a list predicates to the BalanceTransferDelete builder.
+func (btdo *BalanceTransferDeleteOne) Where(ps ...predicate.BalanceTransfer) *BalanceTransferDeleteOne {
+	btdo.btd.mutation.Where(ps...)
+	return btdo
+}
+
+// Exec executes the deletion query.
+func (btdo *BalanceTransferDeleteOne) Exec(ctx context.Context) error {
+	n, err := btdo.btd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{balancetransfer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btdo *BalanceTransferDeleteOne) ExecX(ctx context.Context) {
+	if err := btdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
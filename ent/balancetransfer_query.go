@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// BalanceTransferQuery is the builder for querying BalanceTransfer entities.
+type BalanceTransferQuery struct {
+	config
+	ctx        *QueryContext
+	order      []balancetransfer.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BalanceTransfer
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BalanceTransferQuery builder.
+func (btq *BalanceTransferQuery) Where(ps ...predicate.BalanceTransfer) *BalanceTransferQuery {
+	btq.predicates = append(btq.predicates, ps...)
+	return btq
+}
+
+// Limit the number of records to be returned by this query.
+func (btq *BalanceTransferQuery) Limit(limit int) *BalanceTransferQuery {
+	btq.ctx.Limit = &limit
+	return btq
+}
+
+// Offset to start from.
+func (btq *BalanceTransferQuery) Offset(offset int) *BalanceTransferQuery {
+	btq.ctx.Offset = &offset
+	return btq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (btq *BalanceTransferQuery) Unique(unique bool) *BalanceTransferQuery {
+	btq.ctx.Unique = &unique
+	return btq
+}
+
+// Order specifies how the records should be ordered.
+func (btq *BalanceTransferQuery) Order(o ...balancetransfer.OrderOption) *BalanceTransferQuery {
+	btq.order = append(btq.order, o...)
+	return btq
+}
+
+// First returns the first BalanceTransfer entity from the query.
+// Returns a *NotFoundError when no BalanceTransfer was found.
+func (btq *BalanceTransferQuery) First(ctx context.Context) (*BalanceTransfer, error) {
+	nodes, err := btq.Limit(1).All(setContextOp(ctx, btq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{balancetransfer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (btq *BalanceTransferQuery) FirstX(ctx context.Context) *BalanceTransfer {
+	node, err := btq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BalanceTransfer ID from the query.
+// Returns a *NotFoundError when no BalanceTransfer ID was found.
+func (btq *BalanceTransferQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = btq.Limit(1).IDs(setContextOp(ctx, btq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{balancetransfer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (btq *BalanceTransferQuery) FirstIDX(ctx context.Context) int {
+	id, err := btq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BalanceTransfer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BalanceTransfer entity is found.
+// Returns a *NotFoundError when no BalanceTransfer entities are found.
+func (btq *BalanceTransferQuery) Only(ctx context.Context) (*BalanceTransfer, error) {
+	nodes, err := btq.Limit(2).All(setContextOp(ctx, btq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{balancetransfer.Label}
+	default:
+		return nil, &NotSingularError{balancetransfer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (btq *BalanceTransferQuery) OnlyX(ctx context.Context) *BalanceTransfer {
+	node, err := btq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BalanceTransfer ID in the query.
+// Returns a *NotSingularError when more than one BalanceTransfer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (btq *BalanceTransferQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = btq.Limit(2).IDs(setContextOp(ctx, btq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{balancetransfer.Label}
+	default:
+		err = &NotSingularError{balancetransfer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (btq *BalanceTransferQuery) OnlyIDX(ctx context.Context) int {
+	id, err := btq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BalanceTransfers.
+func (btq *BalanceTransferQuery) All(ctx context.Context) ([]*BalanceTransfer, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryAll)
+	if err := btq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BalanceTransfer, *BalanceTransferQuery]()
+	return withInterceptors[[]*BalanceTransfer](ctx, btq, qr, btq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (btq *BalanceTransferQuery) AllX(ctx context.Context) []*BalanceTransfer {
+	nodes, err := btq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BalanceTransfer IDs.
+func (btq *BalanceTransferQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if btq.ctx.Unique == nil && btq.path != nil {
+		btq.Unique(true)
+	}
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryIDs)
+	if err = btq.Select(balancetransfer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (btq *BalanceTransferQuery) IDsX(ctx context.Context) []int {
+	ids, err := btq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (btq *BalanceTransferQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryCount)
+	if err := btq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, btq, querierCount[*BalanceTransferQuery](), btq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (btq *BalanceTransferQuery) CountX(ctx context.Context) int {
+	count, err := btq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (btq *BalanceTransferQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryExist)
+	switch _, err := btq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (btq *BalanceTransferQuery) ExistX(ctx context.Context) bool {
+	exist, err := btq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BalanceTransferQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (btq *BalanceTransferQuery) Clone() *BalanceTransferQuery {
+	if btq == nil {
+		return nil
+	}
+	return &BalanceTransferQuery{
+		config:     btq.config,
+		ctx:        btq.ctx.Clone(),
+		order:      append([]balancetransfer.OrderOption{}, btq.order...),
+		inters:     append([]Interceptor{}, btq.inters...),
+		predicates: append([]predicate.BalanceTransfer{}, btq.predicates...),
+		// clone intermediate query.
+		sql:  btq.sql.Clone(),
+		path: btq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		FromUsername string `json:"from_username,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BalanceTransfer.Query().
+//		GroupBy(balancetransfer.FieldFromUsername).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (btq *BalanceTransferQuery) GroupBy(field string, fields ...string) *BalanceTransferGroupBy {
+	btq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BalanceTransferGroupBy{build: btq}
+	grbuild.flds = &btq.ctx.Fields
+	grbuild.label = balancetransfer.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		FromUsername string `json:"from_username,omitempty"`
+//	}
+//
+//	client.BalanceTransfer.Query().
+//		Select(balancetransfer.FieldFromUsername).
+//		Scan(ctx, &v)
+func (btq *BalanceTransferQuery) Select(fields ...string) *BalanceTransferSelect {
+	btq.ctx.Fields = append(btq.ctx.Fields, fields...)
+	sbuild := &BalanceTransferSelect{BalanceTransferQuery: btq}
+	sbuild.label = balancetransfer.Label
+	sbuild.flds, sbuild.scan = &btq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BalanceTransferSelect configured with the given aggregations.
+func (btq *BalanceTransferQuery) Aggregate(fns ...AggregateFunc) *BalanceTransferSelect {
+	return btq.Select().Aggregate(fns...)
+}
+
+func (btq *BalanceTransferQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range btq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, btq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range btq.ctx.Fields {
+		if !balancetransfer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if btq.path != nil {
+		prev, err := btq.path(ctx)
+		if err != nil {
+			return err
+		}
+		btq.sql = prev
+	}
+	return nil
+}
+
+func (btq *BalanceTransferQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BalanceTransfer, error) {
+	var (
+		nodes = []*BalanceTransfer{}
+		_spec = btq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BalanceTransfer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BalanceTransfer{config: btq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, btq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (btq *BalanceTransferQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := btq.querySpec()
+	_spec.Node.Columns = btq.ctx.Fields
+	if len(btq.ctx.Fields) > 0 {
+		_spec.Unique = btq.ctx.Unique != nil && *btq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, btq.driver, _spec)
+}
+
+func (btq *BalanceTransferQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(balancetransfer.Table, balancetransfer.Columns, sqlgraph.NewFieldSpec(balancetransfer.FieldID, field.TypeInt))
+	_spec.From = btq.sql
+	if unique := btq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if btq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := btq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancetransfer.FieldID)
+		for i := range fields {
+			if fields[i] != balancetransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := btq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := btq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := btq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := btq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (btq *BalanceTransferQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(btq.driver.Dialect())
+	t1 := builder.Table(balancetransfer.Table)
+	columns := btq.ctx.Fields
+	if len(columns) == 0 {
+		columns = balancetransfer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if btq.sql != nil {
+		selector = btq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if btq.ctx.Unique != nil && *btq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range btq.predicates {
+		p(selector)
+	}
+	for _, p := range btq.order {
+		p(selector)
+	}
+	if offset := btq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := btq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BalanceTransferGroupBy is the group-by builder for BalanceTransfer entities.
+type BalanceTransferGroupBy struct {
+	selector
+	build *BalanceTransferQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (btgb *BalanceTransferGroupBy) Aggregate(fns ...AggregateFunc) *BalanceTransferGroupBy {
+	btgb.fns = append(btgb.fns, fns...)
+	return btgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (btgb *BalanceTransferGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, btgb.build.ctx, ent.OpQueryGroupBy)
+	if err := btgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BalanceTransferQuery, *BalanceTransferGroupBy](ctx, btgb.build, btgb, btgb.build.inters, v)
+}
+
+func (btgb *BalanceTransferGroupBy) sqlScan(ctx context.Context, root *BalanceTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(btgb.fns))
+	for _, fn := range btgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*btgb.flds)+len(btgb.fns))
+		for _, f := range *btgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*btgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := btgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BalanceTransferSelect is the builder for selecting fields of BalanceTransfer entities.
+type BalanceTransferSelect struct {
+	*BalanceTransferQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bts *BalanceTransferSelect) Aggregate(fns ...AggregateFunc) *BalanceTransferSelect {
+	bts.fns = append(bts.fns, fns...)
+	return bts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bts *BalanceTransferSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bts.ctx, ent.OpQuerySelect)
+	if err := bts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BalanceTransferQuery, *BalanceTransferSelect](ctx, bts.BalanceTransferQuery, bts, bts.inters, v)
+}
+
+func (bts *BalanceTransferSelect) sqlScan(ctx context.Context, root *BalanceTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bts.fns))
+	for _, fn := range bts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// BalanceTransferUpdate is the builder for updating BalanceTransfer entities.
+type BalanceTransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceTransferMutation
+}
+
+// Where appends a list predicates to the BalanceTransferUpdate builder.
+func (btu *BalanceTransferUpdate) Where(ps ...predicate.BalanceTransfer) *BalanceTransferUpdate {
+	btu.mutation.Where(ps...)
+	return btu
+}
+
+// SetFromUsername sets the "from_username" field.
+func (btu *BalanceTransferUpdate) SetFromUsername(s string) *BalanceTransferUpdate {
+	btu.mutation.SetFromUsername(s)
+	return btu
+}
+
+// SetNillableFromUsername sets the "from_username" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableFromUsername(s *string) *BalanceTransferUpdate {
+	if s != nil {
+		btu.SetFromUsername(*s)
+	}
+	return btu
+}
+
+// SetToUsername sets the "to_username" field.
+func (btu *BalanceTransferUpdate) SetToUsername(s string) *BalanceTransferUpdate {
+	btu.mutation.SetToUsername(s)
+	return btu
+}
+
+// SetNillableToUsername sets the "to_username" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableToUsername(s *string) *BalanceTransferUpdate {
+	if s != nil {
+		btu.SetToUsername(*s)
+	}
+	return btu
+}
+
+// SetAmount sets the "amount" field.
+func (btu *BalanceTransferUpdate) SetAmount(f float64) *BalanceTransferUpdate {
+	btu.mutation.ResetAmount()
+	btu.mutation.SetAmount(f)
+	return btu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableAmount(f *float64) *BalanceTransferUpdate {
+	if f != nil {
+		btu.SetAmount(*f)
+	}
+	return btu
+}
+
+// AddAmount adds f to the "amount" field.
+func (btu *BalanceTransferUpdate) AddAmount(f float64) *BalanceTransferUpdate {
+	btu.mutation.AddAmount(f)
+	return btu
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (btu *BalanceTransferUpdate) SetCodeHash(s string) *BalanceTransferUpdate {
+	btu.mutation.SetCodeHash(s)
+	return btu
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableCodeHash(s *string) *BalanceTransferUpdate {
+	if s != nil {
+		btu.SetCodeHash(*s)
+	}
+	return btu
+}
+
+// SetAttempts sets the "attempts" field.
+func (btu *BalanceTransferUpdate) SetAttempts(i int) *BalanceTransferUpdate {
+	btu.mutation.ResetAttempts()
+	btu.mutation.SetAttempts(i)
+	return btu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableAttempts(i *int) *BalanceTransferUpdate {
+	if i != nil {
+		btu.SetAttempts(*i)
+	}
+	return btu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (btu *BalanceTransferUpdate) AddAttempts(i int) *BalanceTransferUpdate {
+	btu.mutation.AddAttempts(i)
+	return btu
+}
+
+// SetStatus sets the "status" field.
+func (btu *BalanceTransferUpdate) SetStatus(b balancetransfer.Status) *BalanceTransferUpdate {
+	btu.mutation.SetStatus(b)
+	return btu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableStatus(b *balancetransfer.Status) *BalanceTransferUpdate {
+	if b != nil {
+		btu.SetStatus(*b)
+	}
+	return btu
+}
+
+// SetDebitRef sets the "debit_ref" field.
+func (btu *BalanceTransferUpdate) SetDebitRef(s string) *BalanceTransferUpdate {
+	btu.mutation.SetDebitRef(s)
+	return btu
+}
+
+// SetNillableDebitRef sets the "debit_ref" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableDebitRef(s *string) *BalanceTransferUpdate {
+	if s != nil {
+		btu.SetDebitRef(*s)
+	}
+	return btu
+}
+
+// ClearDebitRef clears the value of the "debit_ref" field.
+func (btu *BalanceTransferUpdate) ClearDebitRef() *BalanceTransferUpdate {
+	btu.mutation.ClearDebitRef()
+	return btu
+}
+
+// SetCreditRef sets the "credit_ref" field.
+func (btu *BalanceTransferUpdate) SetCreditRef(s string) *BalanceTransferUpdate {
+	btu.mutation.SetCreditRef(s)
+	return btu
+}
+
+// SetNillableCreditRef sets the "credit_ref" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableCreditRef(s *string) *BalanceTransferUpdate {
+	if s != nil {
+		btu.SetCreditRef(*s)
+	}
+	return btu
+}
+
+// ClearCreditRef clears the value of the "credit_ref" field.
+func (btu *BalanceTransferUpdate) ClearCreditRef() *BalanceTransferUpdate {
+	btu.mutation.ClearCreditRef()
+	return btu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (btu *BalanceTransferUpdate) SetExpiresAt(t time.Time) *BalanceTransferUpdate {
+	btu.mutation.SetExpiresAt(t)
+	return btu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableExpiresAt(t *time.Time) *BalanceTransferUpdate {
+	if t != nil {
+		btu.SetExpiresAt(*t)
+	}
+	return btu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (btu *BalanceTransferUpdate) SetCompletedAt(t time.Time) *BalanceTransferUpdate {
+	btu.mutation.SetCompletedAt(t)
+	return btu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (btu *BalanceTransferUpdate) SetNillableCompletedAt(t *time.Time) *BalanceTransferUpdate {
+	if t != nil {
+		btu.SetCompletedAt(*t)
+	}
+	return btu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (btu *BalanceTransferUpdate) ClearCompletedAt() *BalanceTransferUpdate {
+	btu.mutation.ClearCompletedAt()
+	return btu
+}
+
+// Mutation returns the BalanceTransferMutation object of the builder.
+func (btu *BalanceTransferUpdate) Mutation() *BalanceTransferMutation {
+	return btu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (btu *BalanceTransferUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, btu.sqlSave, btu.mutation, btu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btu *BalanceTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := btu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (btu *BalanceTransferUpdate) Exec(ctx context.Context) error {
+	_, err := btu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btu *BalanceTransferUpdate) ExecX(ctx context.Context) {
+	if err := btu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (btu *BalanceTransferUpdate) check() error {
+	if v, ok := btu.mutation.FromUsername(); ok {
+		if err := balancetransfer.FromUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "from_username", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.from_username": %w`, err)}
+		}
+	}
+	if v, ok := btu.mutation.ToUsername(); ok {
+		if err := balancetransfer.ToUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "to_username", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.to_username": %w`, err)}
+		}
+	}
+	if v, ok := btu.mutation.CodeHash(); ok {
+		if err := balancetransfer.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.code_hash": %w`, err)}
+		}
+	}
+	if v, ok := btu.mutation.Status(); ok {
+		if err := balancetransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.status": %w`, err)}
+		}
+	}
+	if v, ok := btu.mutation.DebitRef(); ok {
+		if err := balancetransfer.DebitRefValidator(v); err != nil {
+			return &ValidationError{Name: "debit_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.debit_ref": %w`, err)}
+		}
+	}
+	if v, ok := btu.mutation.CreditRef(); ok {
+		if err := balancetransfer.CreditRefValidator(v); err != nil {
+			return &ValidationError{Name: "credit_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.credit_ref": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (btu *BalanceTransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := btu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancetransfer.Table, balancetransfer.Columns, sqlgraph.NewFieldSpec(balancetransfer.FieldID, field.TypeInt))
+	if ps := btu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := btu.mutation.FromUsername(); ok {
+		_spec.SetField(balancetransfer.FieldFromUsername, field.TypeString, value)
+	}
+	if value, ok := btu.mutation.ToUsername(); ok {
+		_spec.SetField(balancetransfer.FieldToUsername, field.TypeString, value)
+	}
+	if value, ok := btu.mutation.Amount(); ok {
+		_spec.SetField(balancetransfer.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := btu.mutation.AddedAmount(); ok {
+		_spec.AddField(balancetransfer.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := btu.mutation.CodeHash(); ok {
+		_spec.SetField(balancetransfer.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := btu.mutation.Attempts(); ok {
+		_spec.SetField(balancetransfer.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := btu.mutation.AddedAttempts(); ok {
+		_spec.AddField(balancetransfer.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := btu.mutation.Status(); ok {
+		_spec.SetField(balancetransfer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := btu.mutation.DebitRef(); ok {
+		_spec.SetField(balancetransfer.FieldDebitRef, field.TypeString, value)
+	}
+	if btu.mutation.DebitRefCleared() {
+		_spec.ClearField(balancetransfer.FieldDebitRef, field.TypeString)
+	}
+	if value, ok := btu.mutation.CreditRef(); ok {
+		_spec.SetField(balancetransfer.FieldCreditRef, field.TypeString, value)
+	}
+	if btu.mutation.CreditRefCleared() {
+		_spec.ClearField(balancetransfer.FieldCreditRef, field.TypeString)
+	}
+	if value, ok := btu.mutation.ExpiresAt(); ok {
+		_spec.SetField(balancetransfer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := btu.mutation.CompletedAt(); ok {
+		_spec.SetField(balancetransfer.FieldCompletedAt, field.TypeTime, value)
+	}
+	if btu.mutation.CompletedAtCleared() {
+		_spec.ClearField(balancetransfer.FieldCompletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, btu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancetransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	btu.mutation.done = true
+	return n, nil
+}
+
+// BalanceTransferUpdateOne is the builder for updating a single BalanceTransfer entity.
+type BalanceTransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceTransferMutation
+}
+
+// SetFromUsername sets the "from_username" field.
+func (btuo *BalanceTransferUpdateOne) SetFromUsername(s string) *BalanceTransferUpdateOne {
+	btuo.mutation.SetFromUsername(s)
+	return btuo
+}
+
+// SetNillableFromUsername sets the "from_username" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableFromUsername(s *string) *BalanceTransferUpdateOne {
+	if s != nil {
+		btuo.SetFromUsername(*s)
+	}
+	return btuo
+}
+
+// SetToUsername sets the "to_username" field.
+func (btuo *BalanceTransferUpdateOne) SetToUsername(s string) *BalanceTransferUpdateOne {
+	btuo.mutation.SetToUsername(s)
+	return btuo
+}
+
+// SetNillableToUsername sets the "to_username" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableToUsername(s *string) *BalanceTransferUpdateOne {
+	if s != nil {
+		btuo.SetToUsername(*s)
+	}
+	return btuo
+}
+
+// SetAmount sets the "amount" field.
+func (btuo *BalanceTransferUpdateOne) SetAmount(f float64) *BalanceTransferUpdateOne {
+	btuo.mutation.ResetAmount()
+	btuo.mutation.SetAmount(f)
+	return btuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableAmount(f *float64) *BalanceTransferUpdateOne {
+	if f != nil {
+		btuo.SetAmount(*f)
+	}
+	return btuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (btuo *BalanceTransferUpdateOne) AddAmount(f float64) *BalanceTransferUpdateOne {
+	btuo.mutation.AddAmount(f)
+	return btuo
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (btuo *BalanceTransferUpdateOne) SetCodeHash(s string) *BalanceTransferUpdateOne {
+	btuo.mutation.SetCodeHash(s)
+	return btuo
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableCodeHash(s *string) *BalanceTransferUpdateOne {
+	if s != nil {
+		btuo.SetCodeHash(*s)
+	}
+	return btuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (btuo *BalanceTransferUpdateOne) SetAttempts(i int) *BalanceTransferUpdateOne {
+	btuo.mutation.ResetAttempts()
+	btuo.mutation.SetAttempts(i)
+	return btuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableAttempts(i *int) *BalanceTransferUpdateOne {
+	if i != nil {
+		btuo.SetAttempts(*i)
+	}
+	return btuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (btuo *BalanceTransferUpdateOne) AddAttempts(i int) *BalanceTransferUpdateOne {
+	btuo.mutation.AddAttempts(i)
+	return btuo
+}
+
+// SetStatus sets the "status" field.
+func (btuo *BalanceTransferUpdateOne) SetStatus(b balancetransfer.Status) *BalanceTransferUpdateOne {
+	btuo.mutation.SetStatus(b)
+	return btuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableStatus(b *balancetransfer.Status) *BalanceTransferUpdateOne {
+	if b != nil {
+		btuo.SetStatus(*b)
+	}
+	return btuo
+}
+
+// SetDebitRef sets the "debit_ref" field.
+func (btuo *BalanceTransferUpdateOne) SetDebitRef(s string) *BalanceTransferUpdateOne {
+	btuo.mutation.SetDebitRef(s)
+	return btuo
+}
+
+// SetNillableDebitRef sets the "debit_ref" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableDebitRef(s *string) *BalanceTransferUpdateOne {
+	if s != nil {
+		btuo.SetDebitRef(*s)
+	}
+	return btuo
+}
+
+// ClearDebitRef clears the value of the "debit_ref" field.
+func (btuo *BalanceTransferUpdateOne) ClearDebitRef() *BalanceTransferUpdateOne {
+	btuo.mutation.ClearDebitRef()
+	return btuo
+}
+
+// SetCreditRef sets the "credit_ref" field.
+func (btuo *BalanceTransferUpdateOne) SetCreditRef(s string) *BalanceTransferUpdateOne {
+	btuo.mutation.SetCreditRef(s)
+	return btuo
+}
+
+// SetNillableCreditRef sets the "credit_ref" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableCreditRef(s *string) *BalanceTransferUpdateOne {
+	if s != nil {
+		btuo.SetCreditRef(*s)
+	}
+	return btuo
+}
+
+// ClearCreditRef clears the value of the "credit_ref" field.
+func (btuo *BalanceTransferUpdateOne) ClearCreditRef() *BalanceTransferUpdateOne {
+	btuo.mutation.ClearCreditRef()
+	return btuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (btuo *BalanceTransferUpdateOne) SetExpiresAt(t time.Time) *BalanceTransferUpdateOne {
+	btuo.mutation.SetExpiresAt(t)
+	return btuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableExpiresAt(t *time.Time) *BalanceTransferUpdateOne {
+	if t != nil {
+		btuo.SetExpiresAt(*t)
+	}
+	return btuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (btuo *BalanceTransferUpdateOne) SetCompletedAt(t time.Time) *BalanceTransferUpdateOne {
+	btuo.mutation.SetCompletedAt(t)
+	return btuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (btuo *BalanceTransferUpdateOne) SetNillableCompletedAt(t *time.Time) *BalanceTransferUpdateOne {
+	if t != nil {
+		btuo.SetCompletedAt(*t)
+	}
+	return btuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (btuo *BalanceTransferUpdateOne) ClearCompletedAt() *BalanceTransferUpdateOne {
+	btuo.mutation.ClearCompletedAt()
+	return btuo
+}
+
+// Mutation returns the BalanceTransferMutation object of the builder.
+func (btuo *BalanceTransferUpdateOne) Mutation() *BalanceTransferMutation {
+	return btuo.mutation
+}
+
+// Where appends a list predicates to the BalanceTransferUpdate builder.
+func (btuo *BalanceTransferUpdateOne) Where(ps ...predicate.BalanceTransfer) *BalanceTransferUpdateOne {
+	btuo.mutation.Where(ps...)
+	return btuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (btuo *BalanceTransferUpdateOne) Select(field string, fields ...string) *BalanceTransferUpdateOne {
+	btuo.fields = append([]string{field}, fields...)
+	return btuo
+}
+
+// Save executes the query and returns the updated BalanceTransfer entity.
+func (btuo *BalanceTransferUpdateOne) Save(ctx context.Context) (*BalanceTransfer, error) {
+	return withHooks(ctx, btuo.sqlSave, btuo.mutation, btuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btuo *BalanceTransferUpdateOne) SaveX(ctx context.Context) *BalanceTransfer {
+	node, err := btuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (btuo *BalanceTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := btuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btuo *BalanceTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := btuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (btuo *BalanceTransferUpdateOne) check() error {
+	if v, ok := btuo.mutation.FromUsername(); ok {
+		if err := balancetransfer.FromUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "from_username", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.from_username": %w`, err)}
+		}
+	}
+	if v, ok := btuo.mutation.ToUsername(); ok {
+		if err := balancetransfer.ToUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "to_username", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.to_username": %w`, err)}
+		}
+	}
+	if v, ok := btuo.mutation.CodeHash(); ok {
+		if err := balancetransfer.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.code_hash": %w`, err)}
+		}
+	}
+	if v, ok := btuo.mutation.Status(); ok {
+		if err := balancetransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.status": %w`, err)}
+		}
+	}
+	if v, ok := btuo.mutation.DebitRef(); ok {
+		if err := balancetransfer.DebitRefValidator(v); err != nil {
+			return &ValidationError{Name: "debit_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.debit_ref": %w`, err)}
+		}
+	}
+	if v, ok := btuo.mutation.CreditRef(); ok {
+		if err := balancetransfer.CreditRefValidator(v); err != nil {
+			return &ValidationError{Name: "credit_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceTransfer.credit_ref": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (btuo *BalanceTransferUpdateOne) sqlSave(ctx context.Context) (_node *BalanceTransfer, err error) {
+	if err := btuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancetransfer.Table, balancetransfer.Columns, sqlgraph.NewFieldSpec(balancetransfer.FieldID, field.TypeInt))
+	id, ok := btuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := btuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancetransfer.FieldID)
+		for _, f := range fields {
+			if !balancetransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balancetransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := btuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := btuo.mutation.FromUsername(); ok {
+		_spec.SetField(balancetransfer.FieldFromUsername, field.TypeString, value)
+	}
+	if value, ok := btuo.mutation.ToUsername(); ok {
+		_spec.SetField(balancetransfer.FieldToUsername, field.TypeString, value)
+	}
+	if value, ok := btuo.mutation.Amount(); ok {
+		_spec.SetField(balancetransfer.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := btuo.mutation.AddedAmount(); ok {
+		_spec.AddField(balancetransfer.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := btuo.mutation.CodeHash(); ok {
+		_spec.SetField(balancetransfer.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := btuo.mutation.Attempts(); ok {
+		_spec.SetField(balancetransfer.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := btuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(balancetransfer.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := btuo.mutation.Status(); ok {
+		_spec.SetField(balancetransfer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := btuo.mutation.DebitRef(); ok {
+		_spec.SetField(balancetransfer.FieldDebitRef, field.TypeString, value)
+	}
+	if btuo.mutation.DebitRefCleared() {
+		_spec.ClearField(balancetransfer.FieldDebitRef, field.TypeString)
+	}
+	if value, ok := btuo.mutation.CreditRef(); ok {
+		_spec.SetField(balancetransfer.FieldCreditRef, field.TypeString, value)
+	}
+	if btuo.mutation.CreditRefCleared() {
+		_spec.ClearField(balancetransfer.FieldCreditRef, field.TypeString)
+	}
+	if value, ok := btuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(balancetransfer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := btuo.mutation.CompletedAt(); ok {
+		_spec.SetField(balancetransfer.FieldCompletedAt, field.TypeTime, value)
+	}
+	if btuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(balancetransfer.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &BalanceTransfer{config: btuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, btuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancetransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	btuo.mutation.done = true
+	return _node, nil
+}
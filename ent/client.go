@@ -15,29 +15,57 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+	"github.com/mikestefanello/pagoda/ent/clientaddon"
 	"github.com/mikestefanello/pagoda/ent/clienttxn"
 	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/coupon"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
 	"github.com/mikestefanello/pagoda/ent/emailsubscription"
 	"github.com/mikestefanello/pagoda/ent/emailsubscriptiontype"
 	"github.com/mikestefanello/pagoda/ent/emojis"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
 	"github.com/mikestefanello/pagoda/ent/filestorage"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/imagesize"
 	"github.com/mikestefanello/pagoda/ent/invitation"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
 	"github.com/mikestefanello/pagoda/ent/lastseenonline"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
 	"github.com/mikestefanello/pagoda/ent/packageplan"
+	"github.com/mikestefanello/pagoda/ent/payrequest"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/profile"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
 	"github.com/mikestefanello/pagoda/ent/radacct"
+	"github.com/mikestefanello/pagoda/ent/radcheck"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+	"github.com/mikestefanello/pagoda/ent/radreply"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
 	"github.com/mikestefanello/pagoda/ent/ticket"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/vendor"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -45,10 +73,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// BalanceTransfer is the client for interacting with the BalanceTransfer builders.
+	BalanceTransfer *BalanceTransferClient
+	// ClientAddon is the client for interacting with the ClientAddon builders.
+	ClientAddon *ClientAddonClient
 	// ClientTxn is the client for interacting with the ClientTxn builders.
 	ClientTxn *ClientTxnClient
 	// ClientUser is the client for interacting with the ClientUser builders.
 	ClientUser *ClientUserClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// CouponRedemption is the client for interacting with the CouponRedemption builders.
+	CouponRedemption *CouponRedemptionClient
+	// DunningStep is the client for interacting with the DunningStep builders.
+	DunningStep *DunningStepClient
 	// EmailSubscription is the client for interacting with the EmailSubscription builders.
 	EmailSubscription *EmailSubscriptionClient
 	// EmailSubscriptionType is the client for interacting with the EmailSubscriptionType builders.
@@ -59,14 +97,22 @@ type Client struct {
 	FCMSubscriptions *FCMSubscriptionsClient
 	// FileStorage is the client for interacting with the FileStorage builders.
 	FileStorage *FileStorageClient
+	// GracePeriod is the client for interacting with the GracePeriod builders.
+	GracePeriod *GracePeriodClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
 	// ImageSize is the client for interacting with the ImageSize builders.
 	ImageSize *ImageSizeClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceLine is the client for interacting with the InvoiceLine builders.
+	InvoiceLine *InvoiceLineClient
 	// LastSeenOnline is the client for interacting with the LastSeenOnline builders.
 	LastSeenOnline *LastSeenOnlineClient
+	// ManualPayment is the client for interacting with the ManualPayment builders.
+	ManualPayment *ManualPaymentClient
 	// MonthlySubscription is the client for interacting with the MonthlySubscription builders.
 	MonthlySubscription *MonthlySubscriptionClient
 	// Notification is the client for interacting with the Notification builders.
@@ -77,6 +123,8 @@ type Client struct {
 	NotificationTime *NotificationTimeClient
 	// PackagePlan is the client for interacting with the PackagePlan builders.
 	PackagePlan *PackagePlanClient
+	// PayRequest is the client for interacting with the PayRequest builders.
+	PayRequest *PayRequestClient
 	// PhoneVerificationCode is the client for interacting with the PhoneVerificationCode builders.
 	PhoneVerificationCode *PhoneVerificationCodeClient
 	// Profile is the client for interacting with the Profile builders.
@@ -85,12 +133,44 @@ type Client struct {
 	PwaPushSubscription *PwaPushSubscriptionClient
 	// RadAcct is the client for interacting with the RadAcct builders.
 	RadAcct *RadAcctClient
+	// RadCheck is the client for interacting with the RadCheck builders.
+	RadCheck *RadCheckClient
+	// RadGroupReply is the client for interacting with the RadGroupReply builders.
+	RadGroupReply *RadGroupReplyClient
+	// RadReply is the client for interacting with the RadReply builders.
+	RadReply *RadReplyClient
+	// RadUserGroup is the client for interacting with the RadUserGroup builders.
+	RadUserGroup *RadUserGroupClient
+	// RefundRequest is the client for interacting with the RefundRequest builders.
+	RefundRequest *RefundRequestClient
 	// SentEmail is the client for interacting with the SentEmail builders.
 	SentEmail *SentEmailClient
+	// SettlementRow is the client for interacting with the SettlementRow builders.
+	SettlementRow *SettlementRowClient
+	// StatementEntry is the client for interacting with the StatementEntry builders.
+	StatementEntry *StatementEntryClient
 	// Ticket is the client for interacting with the Ticket builders.
 	Ticket *TicketClient
+	// UsageCursor is the client for interacting with the UsageCursor builders.
+	UsageCursor *UsageCursorClient
+	// UsageDaily is the client for interacting with the UsageDaily builders.
+	UsageDaily *UsageDailyClient
+	// UsageHourly is the client for interacting with the UsageHourly builders.
+	UsageHourly *UsageHourlyClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Vendor is the client for interacting with the Vendor builders.
+	Vendor *VendorClient
+	// VendorCommission is the client for interacting with the VendorCommission builders.
+	VendorCommission *VendorCommissionClient
+	// VendorTxn is the client for interacting with the VendorTxn builders.
+	VendorTxn *VendorTxnClient
+	// Voucher is the client for interacting with the Voucher builders.
+	Voucher *VoucherClient
+	// VoucherAttempt is the client for interacting with the VoucherAttempt builders.
+	VoucherAttempt *VoucherAttemptClient
+	// VoucherBatch is the client for interacting with the VoucherBatch builders.
+	VoucherBatch *VoucherBatchClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -102,29 +182,55 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.BalanceTransfer = NewBalanceTransferClient(c.config)
+	c.ClientAddon = NewClientAddonClient(c.config)
 	c.ClientTxn = NewClientTxnClient(c.config)
 	c.ClientUser = NewClientUserClient(c.config)
+	c.Coupon = NewCouponClient(c.config)
+	c.CouponRedemption = NewCouponRedemptionClient(c.config)
+	c.DunningStep = NewDunningStepClient(c.config)
 	c.EmailSubscription = NewEmailSubscriptionClient(c.config)
 	c.EmailSubscriptionType = NewEmailSubscriptionTypeClient(c.config)
 	c.Emojis = NewEmojisClient(c.config)
 	c.FCMSubscriptions = NewFCMSubscriptionsClient(c.config)
 	c.FileStorage = NewFileStorageClient(c.config)
+	c.GracePeriod = NewGracePeriodClient(c.config)
 	c.Image = NewImageClient(c.config)
 	c.ImageSize = NewImageSizeClient(c.config)
 	c.Invitation = NewInvitationClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
+	c.InvoiceLine = NewInvoiceLineClient(c.config)
 	c.LastSeenOnline = NewLastSeenOnlineClient(c.config)
+	c.ManualPayment = NewManualPaymentClient(c.config)
 	c.MonthlySubscription = NewMonthlySubscriptionClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
 	c.NotificationPermission = NewNotificationPermissionClient(c.config)
 	c.NotificationTime = NewNotificationTimeClient(c.config)
 	c.PackagePlan = NewPackagePlanClient(c.config)
+	c.PayRequest = NewPayRequestClient(c.config)
 	c.PhoneVerificationCode = NewPhoneVerificationCodeClient(c.config)
 	c.Profile = NewProfileClient(c.config)
 	c.PwaPushSubscription = NewPwaPushSubscriptionClient(c.config)
 	c.RadAcct = NewRadAcctClient(c.config)
+	c.RadCheck = NewRadCheckClient(c.config)
+	c.RadGroupReply = NewRadGroupReplyClient(c.config)
+	c.RadReply = NewRadReplyClient(c.config)
+	c.RadUserGroup = NewRadUserGroupClient(c.config)
+	c.RefundRequest = NewRefundRequestClient(c.config)
 	c.SentEmail = NewSentEmailClient(c.config)
+	c.SettlementRow = NewSettlementRowClient(c.config)
+	c.StatementEntry = NewStatementEntryClient(c.config)
 	c.Ticket = NewTicketClient(c.config)
+	c.UsageCursor = NewUsageCursorClient(c.config)
+	c.UsageDaily = NewUsageDailyClient(c.config)
+	c.UsageHourly = NewUsageHourlyClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.Vendor = NewVendorClient(c.config)
+	c.VendorCommission = NewVendorCommissionClient(c.config)
+	c.VendorTxn = NewVendorTxnClient(c.config)
+	c.Voucher = NewVoucherClient(c.config)
+	c.VoucherAttempt = NewVoucherAttemptClient(c.config)
+	c.VoucherBatch = NewVoucherBatchClient(c.config)
 }
 
 type (
@@ -217,29 +323,55 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		BalanceTransfer:        NewBalanceTransferClient(cfg),
+		ClientAddon:            NewClientAddonClient(cfg),
 		ClientTxn:              NewClientTxnClient(cfg),
 		ClientUser:             NewClientUserClient(cfg),
+		Coupon:                 NewCouponClient(cfg),
+		CouponRedemption:       NewCouponRedemptionClient(cfg),
+		DunningStep:            NewDunningStepClient(cfg),
 		EmailSubscription:      NewEmailSubscriptionClient(cfg),
 		EmailSubscriptionType:  NewEmailSubscriptionTypeClient(cfg),
 		Emojis:                 NewEmojisClient(cfg),
 		FCMSubscriptions:       NewFCMSubscriptionsClient(cfg),
 		FileStorage:            NewFileStorageClient(cfg),
+		GracePeriod:            NewGracePeriodClient(cfg),
 		Image:                  NewImageClient(cfg),
 		ImageSize:              NewImageSizeClient(cfg),
 		Invitation:             NewInvitationClient(cfg),
+		Invoice:                NewInvoiceClient(cfg),
+		InvoiceLine:            NewInvoiceLineClient(cfg),
 		LastSeenOnline:         NewLastSeenOnlineClient(cfg),
+		ManualPayment:          NewManualPaymentClient(cfg),
 		MonthlySubscription:    NewMonthlySubscriptionClient(cfg),
 		Notification:           NewNotificationClient(cfg),
 		NotificationPermission: NewNotificationPermissionClient(cfg),
 		NotificationTime:       NewNotificationTimeClient(cfg),
 		PackagePlan:            NewPackagePlanClient(cfg),
+		PayRequest:             NewPayRequestClient(cfg),
 		PhoneVerificationCode:  NewPhoneVerificationCodeClient(cfg),
 		Profile:                NewProfileClient(cfg),
 		PwaPushSubscription:    NewPwaPushSubscriptionClient(cfg),
 		RadAcct:                NewRadAcctClient(cfg),
+		RadCheck:               NewRadCheckClient(cfg),
+		RadGroupReply:          NewRadGroupReplyClient(cfg),
+		RadReply:               NewRadReplyClient(cfg),
+		RadUserGroup:           NewRadUserGroupClient(cfg),
+		RefundRequest:          NewRefundRequestClient(cfg),
 		SentEmail:              NewSentEmailClient(cfg),
+		SettlementRow:          NewSettlementRowClient(cfg),
+		StatementEntry:         NewStatementEntryClient(cfg),
 		Ticket:                 NewTicketClient(cfg),
+		UsageCursor:            NewUsageCursorClient(cfg),
+		UsageDaily:             NewUsageDailyClient(cfg),
+		UsageHourly:            NewUsageHourlyClient(cfg),
 		User:                   NewUserClient(cfg),
+		Vendor:                 NewVendorClient(cfg),
+		VendorCommission:       NewVendorCommissionClient(cfg),
+		VendorTxn:              NewVendorTxnClient(cfg),
+		Voucher:                NewVoucherClient(cfg),
+		VoucherAttempt:         NewVoucherAttemptClient(cfg),
+		VoucherBatch:           NewVoucherBatchClient(cfg),
 	}, nil
 }
 
@@ -259,36 +391,62 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                    ctx,
 		config:                 cfg,
+		BalanceTransfer:        NewBalanceTransferClient(cfg),
+		ClientAddon:            NewClientAddonClient(cfg),
 		ClientTxn:              NewClientTxnClient(cfg),
 		ClientUser:             NewClientUserClient(cfg),
+		Coupon:                 NewCouponClient(cfg),
+		CouponRedemption:       NewCouponRedemptionClient(cfg),
+		DunningStep:            NewDunningStepClient(cfg),
 		EmailSubscription:      NewEmailSubscriptionClient(cfg),
 		EmailSubscriptionType:  NewEmailSubscriptionTypeClient(cfg),
 		Emojis:                 NewEmojisClient(cfg),
 		FCMSubscriptions:       NewFCMSubscriptionsClient(cfg),
 		FileStorage:            NewFileStorageClient(cfg),
+		GracePeriod:            NewGracePeriodClient(cfg),
 		Image:                  NewImageClient(cfg),
 		ImageSize:              NewImageSizeClient(cfg),
 		Invitation:             NewInvitationClient(cfg),
+		Invoice:                NewInvoiceClient(cfg),
+		InvoiceLine:            NewInvoiceLineClient(cfg),
 		LastSeenOnline:         NewLastSeenOnlineClient(cfg),
+		ManualPayment:          NewManualPaymentClient(cfg),
 		MonthlySubscription:    NewMonthlySubscriptionClient(cfg),
 		Notification:           NewNotificationClient(cfg),
 		NotificationPermission: NewNotificationPermissionClient(cfg),
 		NotificationTime:       NewNotificationTimeClient(cfg),
 		PackagePlan:            NewPackagePlanClient(cfg),
+		PayRequest:             NewPayRequestClient(cfg),
 		PhoneVerificationCode:  NewPhoneVerificationCodeClient(cfg),
 		Profile:                NewProfileClient(cfg),
 		PwaPushSubscription:    NewPwaPushSubscriptionClient(cfg),
 		RadAcct:                NewRadAcctClient(cfg),
+		RadCheck:               NewRadCheckClient(cfg),
+		RadGroupReply:          NewRadGroupReplyClient(cfg),
+		RadReply:               NewRadReplyClient(cfg),
+		RadUserGroup:           NewRadUserGroupClient(cfg),
+		RefundRequest:          NewRefundRequestClient(cfg),
 		SentEmail:              NewSentEmailClient(cfg),
+		SettlementRow:          NewSettlementRowClient(cfg),
+		StatementEntry:         NewStatementEntryClient(cfg),
 		Ticket:                 NewTicketClient(cfg),
+		UsageCursor:            NewUsageCursorClient(cfg),
+		UsageDaily:             NewUsageDailyClient(cfg),
+		UsageHourly:            NewUsageHourlyClient(cfg),
 		User:                   NewUserClient(cfg),
+		Vendor:                 NewVendorClient(cfg),
+		VendorCommission:       NewVendorCommissionClient(cfg),
+		VendorTxn:              NewVendorTxnClient(cfg),
+		Voucher:                NewVoucherClient(cfg),
+		VoucherAttempt:         NewVoucherAttemptClient(cfg),
+		VoucherBatch:           NewVoucherBatchClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ClientTxn.
+//		BalanceTransfer.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -311,12 +469,17 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ClientTxn, c.ClientUser, c.EmailSubscription, c.EmailSubscriptionType,
-		c.Emojis, c.FCMSubscriptions, c.FileStorage, c.Image, c.ImageSize,
-		c.Invitation, c.LastSeenOnline, c.MonthlySubscription, c.Notification,
-		c.NotificationPermission, c.NotificationTime, c.PackagePlan,
+		c.BalanceTransfer, c.ClientAddon, c.ClientTxn, c.ClientUser, c.Coupon,
+		c.CouponRedemption, c.DunningStep, c.EmailSubscription,
+		c.EmailSubscriptionType, c.Emojis, c.FCMSubscriptions, c.FileStorage,
+		c.GracePeriod, c.Image, c.ImageSize, c.Invitation, c.Invoice, c.InvoiceLine,
+		c.LastSeenOnline, c.ManualPayment, c.MonthlySubscription, c.Notification,
+		c.NotificationPermission, c.NotificationTime, c.PackagePlan, c.PayRequest,
 		c.PhoneVerificationCode, c.Profile, c.PwaPushSubscription, c.RadAcct,
-		c.SentEmail, c.Ticket, c.User,
+		c.RadCheck, c.RadGroupReply, c.RadReply, c.RadUserGroup, c.RefundRequest,
+		c.SentEmail, c.SettlementRow, c.StatementEntry, c.Ticket, c.UsageCursor,
+		c.UsageDaily, c.UsageHourly, c.User, c.Vendor, c.VendorCommission, c.VendorTxn,
+		c.Voucher, c.VoucherAttempt, c.VoucherBatch,
 	} {
 		n.Use(hooks...)
 	}
@@ -326,12 +489,17 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ClientTxn, c.ClientUser, c.EmailSubscription, c.EmailSubscriptionType,
-		c.Emojis, c.FCMSubscriptions, c.FileStorage, c.Image, c.ImageSize,
-		c.Invitation, c.LastSeenOnline, c.MonthlySubscription, c.Notification,
-		c.NotificationPermission, c.NotificationTime, c.PackagePlan,
+		c.BalanceTransfer, c.ClientAddon, c.ClientTxn, c.ClientUser, c.Coupon,
+		c.CouponRedemption, c.DunningStep, c.EmailSubscription,
+		c.EmailSubscriptionType, c.Emojis, c.FCMSubscriptions, c.FileStorage,
+		c.GracePeriod, c.Image, c.ImageSize, c.Invitation, c.Invoice, c.InvoiceLine,
+		c.LastSeenOnline, c.ManualPayment, c.MonthlySubscription, c.Notification,
+		c.NotificationPermission, c.NotificationTime, c.PackagePlan, c.PayRequest,
 		c.PhoneVerificationCode, c.Profile, c.PwaPushSubscription, c.RadAcct,
-		c.SentEmail, c.Ticket, c.User,
+		c.RadCheck, c.RadGroupReply, c.RadReply, c.RadUserGroup, c.RefundRequest,
+		c.SentEmail, c.SettlementRow, c.StatementEntry, c.Ticket, c.UsageCursor,
+		c.UsageDaily, c.UsageHourly, c.User, c.Vendor, c.VendorCommission, c.VendorTxn,
+		c.Voucher, c.VoucherAttempt, c.VoucherBatch,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -340,10 +508,20 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BalanceTransferMutation:
+		return c.BalanceTransfer.mutate(ctx, m)
+	case *ClientAddonMutation:
+		return c.ClientAddon.mutate(ctx, m)
 	case *ClientTxnMutation:
 		return c.ClientTxn.mutate(ctx, m)
 	case *ClientUserMutation:
 		return c.ClientUser.mutate(ctx, m)
+	case *CouponMutation:
+		return c.Coupon.mutate(ctx, m)
+	case *CouponRedemptionMutation:
+		return c.CouponRedemption.mutate(ctx, m)
+	case *DunningStepMutation:
+		return c.DunningStep.mutate(ctx, m)
 	case *EmailSubscriptionMutation:
 		return c.EmailSubscription.mutate(ctx, m)
 	case *EmailSubscriptionTypeMutation:
@@ -354,14 +532,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.FCMSubscriptions.mutate(ctx, m)
 	case *FileStorageMutation:
 		return c.FileStorage.mutate(ctx, m)
+	case *GracePeriodMutation:
+		return c.GracePeriod.mutate(ctx, m)
 	case *ImageMutation:
 		return c.Image.mutate(ctx, m)
 	case *ImageSizeMutation:
 		return c.ImageSize.mutate(ctx, m)
 	case *InvitationMutation:
 		return c.Invitation.mutate(ctx, m)
+	case *InvoiceMutation:
+		return c.Invoice.mutate(ctx, m)
+	case *InvoiceLineMutation:
+		return c.InvoiceLine.mutate(ctx, m)
 	case *LastSeenOnlineMutation:
 		return c.LastSeenOnline.mutate(ctx, m)
+	case *ManualPaymentMutation:
+		return c.ManualPayment.mutate(ctx, m)
 	case *MonthlySubscriptionMutation:
 		return c.MonthlySubscription.mutate(ctx, m)
 	case *NotificationMutation:
@@ -372,6 +558,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.NotificationTime.mutate(ctx, m)
 	case *PackagePlanMutation:
 		return c.PackagePlan.mutate(ctx, m)
+	case *PayRequestMutation:
+		return c.PayRequest.mutate(ctx, m)
 	case *PhoneVerificationCodeMutation:
 		return c.PhoneVerificationCode.mutate(ctx, m)
 	case *ProfileMutation:
@@ -380,17 +568,315 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PwaPushSubscription.mutate(ctx, m)
 	case *RadAcctMutation:
 		return c.RadAcct.mutate(ctx, m)
+	case *RadCheckMutation:
+		return c.RadCheck.mutate(ctx, m)
+	case *RadGroupReplyMutation:
+		return c.RadGroupReply.mutate(ctx, m)
+	case *RadReplyMutation:
+		return c.RadReply.mutate(ctx, m)
+	case *RadUserGroupMutation:
+		return c.RadUserGroup.mutate(ctx, m)
+	case *RefundRequestMutation:
+		return c.RefundRequest.mutate(ctx, m)
 	case *SentEmailMutation:
 		return c.SentEmail.mutate(ctx, m)
+	case *SettlementRowMutation:
+		return c.SettlementRow.mutate(ctx, m)
+	case *StatementEntryMutation:
+		return c.StatementEntry.mutate(ctx, m)
 	case *TicketMutation:
 		return c.Ticket.mutate(ctx, m)
+	case *UsageCursorMutation:
+		return c.UsageCursor.mutate(ctx, m)
+	case *UsageDailyMutation:
+		return c.UsageDaily.mutate(ctx, m)
+	case *UsageHourlyMutation:
+		return c.UsageHourly.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *VendorMutation:
+		return c.Vendor.mutate(ctx, m)
+	case *VendorCommissionMutation:
+		return c.VendorCommission.mutate(ctx, m)
+	case *VendorTxnMutation:
+		return c.VendorTxn.mutate(ctx, m)
+	case *VoucherMutation:
+		return c.Voucher.mutate(ctx, m)
+	case *VoucherAttemptMutation:
+		return c.VoucherAttempt.mutate(ctx, m)
+	case *VoucherBatchMutation:
+		return c.VoucherBatch.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// BalanceTransferClient is a client for the BalanceTransfer schema.
+type BalanceTransferClient struct {
+	config
+}
+
+// NewBalanceTransferClient returns a client for the BalanceTransfer from the given config.
+func NewBalanceTransferClient(c config) *BalanceTransferClient {
+	return &BalanceTransferClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `balancetransfer.Hooks(f(g(h())))`.
+func (c *BalanceTransferClient) Use(hooks ...Hook) {
+	c.hooks.BalanceTransfer = append(c.hooks.BalanceTransfer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `balancetransfer.Intercept(f(g(h())))`.
+func (c *BalanceTransferClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BalanceTransfer = append(c.inters.BalanceTransfer, interceptors...)
+}
+
+// Create returns a builder for creating a BalanceTransfer entity.
+func (c *BalanceTransferClient) Create() *BalanceTransferCreate {
+	mutation := newBalanceTransferMutation(c.config, OpCreate)
+	return &BalanceTransferCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BalanceTransfer entities.
+func (c *BalanceTransferClient) CreateBulk(builders ...*BalanceTransferCreate) *BalanceTransferCreateBulk {
+	return &BalanceTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BalanceTransferClient) MapCreateBulk(slice any, setFunc func(*BalanceTransferCreate, int)) *BalanceTransferCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BalanceTransferCreateBulk{err: fmt.Errorf("calling to BalanceTransferClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BalanceTransferCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BalanceTransferCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BalanceTransfer.
+func (c *BalanceTransferClient) Update() *BalanceTransferUpdate {
+	mutation := newBalanceTransferMutation(c.config, OpUpdate)
+	return &BalanceTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BalanceTransferClient) UpdateOne(bt *BalanceTransfer) *BalanceTransferUpdateOne {
+	mutation := newBalanceTransferMutation(c.config, OpUpdateOne, withBalanceTransfer(bt))
+	return &BalanceTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BalanceTransferClient) UpdateOneID(id int) *BalanceTransferUpdateOne {
+	mutation := newBalanceTransferMutation(c.config, OpUpdateOne, withBalanceTransferID(id))
+	return &BalanceTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BalanceTransfer.
+func (c *BalanceTransferClient) Delete() *BalanceTransferDelete {
+	mutation := newBalanceTransferMutation(c.config, OpDelete)
+	return &BalanceTransferDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BalanceTransferClient) DeleteOne(bt *BalanceTransfer) *BalanceTransferDeleteOne {
+	return c.DeleteOneID(bt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BalanceTransferClient) DeleteOneID(id int) *BalanceTransferDeleteOne {
+	builder := c.Delete().Where(balancetransfer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BalanceTransferDeleteOne{builder}
+}
+
+// Query returns a query builder for BalanceTransfer.
+func (c *BalanceTransferClient) Query() *BalanceTransferQuery {
+	return &BalanceTransferQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBalanceTransfer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BalanceTransfer entity by its id.
+func (c *BalanceTransferClient) Get(ctx context.Context, id int) (*BalanceTransfer, error) {
+	return c.Query().Where(balancetransfer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BalanceTransferClient) GetX(ctx context.Context, id int) *BalanceTransfer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BalanceTransferClient) Hooks() []Hook {
+	return c.hooks.BalanceTransfer
+}
+
+// Interceptors returns the client interceptors.
+func (c *BalanceTransferClient) Interceptors() []Interceptor {
+	return c.inters.BalanceTransfer
+}
+
+func (c *BalanceTransferClient) mutate(ctx context.Context, m *BalanceTransferMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BalanceTransferCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BalanceTransferUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BalanceTransferUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BalanceTransferDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BalanceTransfer mutation op: %q", m.Op())
+	}
+}
+
+// ClientAddonClient is a client for the ClientAddon schema.
+type ClientAddonClient struct {
+	config
+}
+
+// NewClientAddonClient returns a client for the ClientAddon from the given config.
+func NewClientAddonClient(c config) *ClientAddonClient {
+	return &ClientAddonClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `clientaddon.Hooks(f(g(h())))`.
+func (c *ClientAddonClient) Use(hooks ...Hook) {
+	c.hooks.ClientAddon = append(c.hooks.ClientAddon, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `clientaddon.Intercept(f(g(h())))`.
+func (c *ClientAddonClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClientAddon = append(c.inters.ClientAddon, interceptors...)
+}
+
+// Create returns a builder for creating a ClientAddon entity.
+func (c *ClientAddonClient) Create() *ClientAddonCreate {
+	mutation := newClientAddonMutation(c.config, OpCreate)
+	return &ClientAddonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClientAddon entities.
+func (c *ClientAddonClient) CreateBulk(builders ...*ClientAddonCreate) *ClientAddonCreateBulk {
+	return &ClientAddonCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClientAddonClient) MapCreateBulk(slice any, setFunc func(*ClientAddonCreate, int)) *ClientAddonCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClientAddonCreateBulk{err: fmt.Errorf("calling to ClientAddonClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClientAddonCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClientAddonCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClientAddon.
+func (c *ClientAddonClient) Update() *ClientAddonUpdate {
+	mutation := newClientAddonMutation(c.config, OpUpdate)
+	return &ClientAddonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClientAddonClient) UpdateOne(ca *ClientAddon) *ClientAddonUpdateOne {
+	mutation := newClientAddonMutation(c.config, OpUpdateOne, withClientAddon(ca))
+	return &ClientAddonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClientAddonClient) UpdateOneID(id int) *ClientAddonUpdateOne {
+	mutation := newClientAddonMutation(c.config, OpUpdateOne, withClientAddonID(id))
+	return &ClientAddonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClientAddon.
+func (c *ClientAddonClient) Delete() *ClientAddonDelete {
+	mutation := newClientAddonMutation(c.config, OpDelete)
+	return &ClientAddonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClientAddonClient) DeleteOne(ca *ClientAddon) *ClientAddonDeleteOne {
+	return c.DeleteOneID(ca.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClientAddonClient) DeleteOneID(id int) *ClientAddonDeleteOne {
+	builder := c.Delete().Where(clientaddon.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClientAddonDeleteOne{builder}
+}
+
+// Query returns a query builder for ClientAddon.
+func (c *ClientAddonClient) Query() *ClientAddonQuery {
+	return &ClientAddonQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClientAddon},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClientAddon entity by its id.
+func (c *ClientAddonClient) Get(ctx context.Context, id int) (*ClientAddon, error) {
+	return c.Query().Where(clientaddon.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClientAddonClient) GetX(ctx context.Context, id int) *ClientAddon {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ClientAddonClient) Hooks() []Hook {
+	return c.hooks.ClientAddon
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClientAddonClient) Interceptors() []Interceptor {
+	return c.inters.ClientAddon
+}
+
+func (c *ClientAddonClient) mutate(ctx context.Context, m *ClientAddonMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClientAddonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClientAddonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClientAddonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClientAddonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ClientAddon mutation op: %q", m.Op())
+	}
+}
+
 // ClientTxnClient is a client for the ClientTxn schema.
 type ClientTxnClient struct {
 	config
@@ -657,107 +1143,107 @@ func (c *ClientUserClient) mutate(ctx context.Context, m *ClientUserMutation) (V
 	}
 }
 
-// EmailSubscriptionClient is a client for the EmailSubscription schema.
-type EmailSubscriptionClient struct {
+// CouponClient is a client for the Coupon schema.
+type CouponClient struct {
 	config
 }
 
-// NewEmailSubscriptionClient returns a client for the EmailSubscription from the given config.
-func NewEmailSubscriptionClient(c config) *EmailSubscriptionClient {
-	return &EmailSubscriptionClient{config: c}
+// NewCouponClient returns a client for the Coupon from the given config.
+func NewCouponClient(c config) *CouponClient {
+	return &CouponClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailsubscription.Hooks(f(g(h())))`.
-func (c *EmailSubscriptionClient) Use(hooks ...Hook) {
-	c.hooks.EmailSubscription = append(c.hooks.EmailSubscription, hooks...)
+// A call to `Use(f, g, h)` equals to `coupon.Hooks(f(g(h())))`.
+func (c *CouponClient) Use(hooks ...Hook) {
+	c.hooks.Coupon = append(c.hooks.Coupon, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailsubscription.Intercept(f(g(h())))`.
-func (c *EmailSubscriptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailSubscription = append(c.inters.EmailSubscription, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `coupon.Intercept(f(g(h())))`.
+func (c *CouponClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Coupon = append(c.inters.Coupon, interceptors...)
 }
 
-// Create returns a builder for creating a EmailSubscription entity.
-func (c *EmailSubscriptionClient) Create() *EmailSubscriptionCreate {
-	mutation := newEmailSubscriptionMutation(c.config, OpCreate)
-	return &EmailSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Coupon entity.
+func (c *CouponClient) Create() *CouponCreate {
+	mutation := newCouponMutation(c.config, OpCreate)
+	return &CouponCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailSubscription entities.
-func (c *EmailSubscriptionClient) CreateBulk(builders ...*EmailSubscriptionCreate) *EmailSubscriptionCreateBulk {
-	return &EmailSubscriptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Coupon entities.
+func (c *CouponClient) CreateBulk(builders ...*CouponCreate) *CouponCreateBulk {
+	return &CouponCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailSubscriptionClient) MapCreateBulk(slice any, setFunc func(*EmailSubscriptionCreate, int)) *EmailSubscriptionCreateBulk {
+func (c *CouponClient) MapCreateBulk(slice any, setFunc func(*CouponCreate, int)) *CouponCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailSubscriptionCreateBulk{err: fmt.Errorf("calling to EmailSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CouponCreateBulk{err: fmt.Errorf("calling to CouponClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailSubscriptionCreate, rv.Len())
+	builders := make([]*CouponCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailSubscriptionCreateBulk{config: c.config, builders: builders}
+	return &CouponCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailSubscription.
-func (c *EmailSubscriptionClient) Update() *EmailSubscriptionUpdate {
-	mutation := newEmailSubscriptionMutation(c.config, OpUpdate)
-	return &EmailSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Coupon.
+func (c *CouponClient) Update() *CouponUpdate {
+	mutation := newCouponMutation(c.config, OpUpdate)
+	return &CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailSubscriptionClient) UpdateOne(es *EmailSubscription) *EmailSubscriptionUpdateOne {
-	mutation := newEmailSubscriptionMutation(c.config, OpUpdateOne, withEmailSubscription(es))
-	return &EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponClient) UpdateOne(co *Coupon) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCoupon(co))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailSubscriptionClient) UpdateOneID(id int) *EmailSubscriptionUpdateOne {
-	mutation := newEmailSubscriptionMutation(c.config, OpUpdateOne, withEmailSubscriptionID(id))
-	return &EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponClient) UpdateOneID(id int) *CouponUpdateOne {
+	mutation := newCouponMutation(c.config, OpUpdateOne, withCouponID(id))
+	return &CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailSubscription.
-func (c *EmailSubscriptionClient) Delete() *EmailSubscriptionDelete {
-	mutation := newEmailSubscriptionMutation(c.config, OpDelete)
-	return &EmailSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Coupon.
+func (c *CouponClient) Delete() *CouponDelete {
+	mutation := newCouponMutation(c.config, OpDelete)
+	return &CouponDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailSubscriptionClient) DeleteOne(es *EmailSubscription) *EmailSubscriptionDeleteOne {
-	return c.DeleteOneID(es.ID)
+func (c *CouponClient) DeleteOne(co *Coupon) *CouponDeleteOne {
+	return c.DeleteOneID(co.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailSubscriptionClient) DeleteOneID(id int) *EmailSubscriptionDeleteOne {
-	builder := c.Delete().Where(emailsubscription.ID(id))
+func (c *CouponClient) DeleteOneID(id int) *CouponDeleteOne {
+	builder := c.Delete().Where(coupon.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailSubscriptionDeleteOne{builder}
+	return &CouponDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailSubscription.
-func (c *EmailSubscriptionClient) Query() *EmailSubscriptionQuery {
-	return &EmailSubscriptionQuery{
+// Query returns a query builder for Coupon.
+func (c *CouponClient) Query() *CouponQuery {
+	return &CouponQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailSubscription},
+		ctx:    &QueryContext{Type: TypeCoupon},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailSubscription entity by its id.
-func (c *EmailSubscriptionClient) Get(ctx context.Context, id int) (*EmailSubscription, error) {
-	return c.Query().Where(emailsubscription.ID(id)).Only(ctx)
+// Get returns a Coupon entity by its id.
+func (c *CouponClient) Get(ctx context.Context, id int) (*Coupon, error) {
+	return c.Query().Where(coupon.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailSubscriptionClient) GetX(ctx context.Context, id int) *EmailSubscription {
+func (c *CouponClient) GetX(ctx context.Context, id int) *Coupon {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -765,148 +1251,132 @@ func (c *EmailSubscriptionClient) GetX(ctx context.Context, id int) *EmailSubscr
 	return obj
 }
 
-// QuerySubscriptions queries the subscriptions edge of a EmailSubscription.
-func (c *EmailSubscriptionClient) QuerySubscriptions(es *EmailSubscription) *EmailSubscriptionTypeQuery {
-	query := (&EmailSubscriptionTypeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := es.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(emailsubscription.Table, emailsubscription.FieldID, id),
-			sqlgraph.To(emailsubscriptiontype.Table, emailsubscriptiontype.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, emailsubscription.SubscriptionsTable, emailsubscription.SubscriptionsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(es.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *EmailSubscriptionClient) Hooks() []Hook {
-	return c.hooks.EmailSubscription
+func (c *CouponClient) Hooks() []Hook {
+	return c.hooks.Coupon
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailSubscriptionClient) Interceptors() []Interceptor {
-	return c.inters.EmailSubscription
+func (c *CouponClient) Interceptors() []Interceptor {
+	return c.inters.Coupon
 }
 
-func (c *EmailSubscriptionClient) mutate(ctx context.Context, m *EmailSubscriptionMutation) (Value, error) {
+func (c *CouponClient) mutate(ctx context.Context, m *CouponMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CouponDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailSubscription mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Coupon mutation op: %q", m.Op())
 	}
 }
 
-// EmailSubscriptionTypeClient is a client for the EmailSubscriptionType schema.
-type EmailSubscriptionTypeClient struct {
+// CouponRedemptionClient is a client for the CouponRedemption schema.
+type CouponRedemptionClient struct {
 	config
 }
 
-// NewEmailSubscriptionTypeClient returns a client for the EmailSubscriptionType from the given config.
-func NewEmailSubscriptionTypeClient(c config) *EmailSubscriptionTypeClient {
-	return &EmailSubscriptionTypeClient{config: c}
+// NewCouponRedemptionClient returns a client for the CouponRedemption from the given config.
+func NewCouponRedemptionClient(c config) *CouponRedemptionClient {
+	return &CouponRedemptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailsubscriptiontype.Hooks(f(g(h())))`.
-func (c *EmailSubscriptionTypeClient) Use(hooks ...Hook) {
-	c.hooks.EmailSubscriptionType = append(c.hooks.EmailSubscriptionType, hooks...)
+// A call to `Use(f, g, h)` equals to `couponredemption.Hooks(f(g(h())))`.
+func (c *CouponRedemptionClient) Use(hooks ...Hook) {
+	c.hooks.CouponRedemption = append(c.hooks.CouponRedemption, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailsubscriptiontype.Intercept(f(g(h())))`.
-func (c *EmailSubscriptionTypeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailSubscriptionType = append(c.inters.EmailSubscriptionType, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `couponredemption.Intercept(f(g(h())))`.
+func (c *CouponRedemptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CouponRedemption = append(c.inters.CouponRedemption, interceptors...)
 }
 
-// Create returns a builder for creating a EmailSubscriptionType entity.
-func (c *EmailSubscriptionTypeClient) Create() *EmailSubscriptionTypeCreate {
-	mutation := newEmailSubscriptionTypeMutation(c.config, OpCreate)
-	return &EmailSubscriptionTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CouponRedemption entity.
+func (c *CouponRedemptionClient) Create() *CouponRedemptionCreate {
+	mutation := newCouponRedemptionMutation(c.config, OpCreate)
+	return &CouponRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailSubscriptionType entities.
-func (c *EmailSubscriptionTypeClient) CreateBulk(builders ...*EmailSubscriptionTypeCreate) *EmailSubscriptionTypeCreateBulk {
-	return &EmailSubscriptionTypeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CouponRedemption entities.
+func (c *CouponRedemptionClient) CreateBulk(builders ...*CouponRedemptionCreate) *CouponRedemptionCreateBulk {
+	return &CouponRedemptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailSubscriptionTypeClient) MapCreateBulk(slice any, setFunc func(*EmailSubscriptionTypeCreate, int)) *EmailSubscriptionTypeCreateBulk {
+func (c *CouponRedemptionClient) MapCreateBulk(slice any, setFunc func(*CouponRedemptionCreate, int)) *CouponRedemptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailSubscriptionTypeCreateBulk{err: fmt.Errorf("calling to EmailSubscriptionTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CouponRedemptionCreateBulk{err: fmt.Errorf("calling to CouponRedemptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailSubscriptionTypeCreate, rv.Len())
+	builders := make([]*CouponRedemptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailSubscriptionTypeCreateBulk{config: c.config, builders: builders}
+	return &CouponRedemptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailSubscriptionType.
-func (c *EmailSubscriptionTypeClient) Update() *EmailSubscriptionTypeUpdate {
-	mutation := newEmailSubscriptionTypeMutation(c.config, OpUpdate)
-	return &EmailSubscriptionTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CouponRedemption.
+func (c *CouponRedemptionClient) Update() *CouponRedemptionUpdate {
+	mutation := newCouponRedemptionMutation(c.config, OpUpdate)
+	return &CouponRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailSubscriptionTypeClient) UpdateOne(est *EmailSubscriptionType) *EmailSubscriptionTypeUpdateOne {
-	mutation := newEmailSubscriptionTypeMutation(c.config, OpUpdateOne, withEmailSubscriptionType(est))
-	return &EmailSubscriptionTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponRedemptionClient) UpdateOne(cr *CouponRedemption) *CouponRedemptionUpdateOne {
+	mutation := newCouponRedemptionMutation(c.config, OpUpdateOne, withCouponRedemption(cr))
+	return &CouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailSubscriptionTypeClient) UpdateOneID(id int) *EmailSubscriptionTypeUpdateOne {
-	mutation := newEmailSubscriptionTypeMutation(c.config, OpUpdateOne, withEmailSubscriptionTypeID(id))
-	return &EmailSubscriptionTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CouponRedemptionClient) UpdateOneID(id int) *CouponRedemptionUpdateOne {
+	mutation := newCouponRedemptionMutation(c.config, OpUpdateOne, withCouponRedemptionID(id))
+	return &CouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailSubscriptionType.
-func (c *EmailSubscriptionTypeClient) Delete() *EmailSubscriptionTypeDelete {
-	mutation := newEmailSubscriptionTypeMutation(c.config, OpDelete)
-	return &EmailSubscriptionTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CouponRedemption.
+func (c *CouponRedemptionClient) Delete() *CouponRedemptionDelete {
+	mutation := newCouponRedemptionMutation(c.config, OpDelete)
+	return &CouponRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailSubscriptionTypeClient) DeleteOne(est *EmailSubscriptionType) *EmailSubscriptionTypeDeleteOne {
-	return c.DeleteOneID(est.ID)
+func (c *CouponRedemptionClient) DeleteOne(cr *CouponRedemption) *CouponRedemptionDeleteOne {
+	return c.DeleteOneID(cr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailSubscriptionTypeClient) DeleteOneID(id int) *EmailSubscriptionTypeDeleteOne {
-	builder := c.Delete().Where(emailsubscriptiontype.ID(id))
+func (c *CouponRedemptionClient) DeleteOneID(id int) *CouponRedemptionDeleteOne {
+	builder := c.Delete().Where(couponredemption.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailSubscriptionTypeDeleteOne{builder}
+	return &CouponRedemptionDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailSubscriptionType.
-func (c *EmailSubscriptionTypeClient) Query() *EmailSubscriptionTypeQuery {
-	return &EmailSubscriptionTypeQuery{
+// Query returns a query builder for CouponRedemption.
+func (c *CouponRedemptionClient) Query() *CouponRedemptionQuery {
+	return &CouponRedemptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailSubscriptionType},
+		ctx:    &QueryContext{Type: TypeCouponRedemption},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailSubscriptionType entity by its id.
-func (c *EmailSubscriptionTypeClient) Get(ctx context.Context, id int) (*EmailSubscriptionType, error) {
-	return c.Query().Where(emailsubscriptiontype.ID(id)).Only(ctx)
+// Get returns a CouponRedemption entity by its id.
+func (c *CouponRedemptionClient) Get(ctx context.Context, id int) (*CouponRedemption, error) {
+	return c.Query().Where(couponredemption.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailSubscriptionTypeClient) GetX(ctx context.Context, id int) *EmailSubscriptionType {
+func (c *CouponRedemptionClient) GetX(ctx context.Context, id int) *CouponRedemption {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -914,148 +1384,132 @@ func (c *EmailSubscriptionTypeClient) GetX(ctx context.Context, id int) *EmailSu
 	return obj
 }
 
-// QuerySubscriber queries the subscriber edge of a EmailSubscriptionType.
-func (c *EmailSubscriptionTypeClient) QuerySubscriber(est *EmailSubscriptionType) *EmailSubscriptionQuery {
-	query := (&EmailSubscriptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := est.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(emailsubscriptiontype.Table, emailsubscriptiontype.FieldID, id),
-			sqlgraph.To(emailsubscription.Table, emailsubscription.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, emailsubscriptiontype.SubscriberTable, emailsubscriptiontype.SubscriberPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(est.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *EmailSubscriptionTypeClient) Hooks() []Hook {
-	return c.hooks.EmailSubscriptionType
+func (c *CouponRedemptionClient) Hooks() []Hook {
+	return c.hooks.CouponRedemption
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailSubscriptionTypeClient) Interceptors() []Interceptor {
-	return c.inters.EmailSubscriptionType
+func (c *CouponRedemptionClient) Interceptors() []Interceptor {
+	return c.inters.CouponRedemption
 }
 
-func (c *EmailSubscriptionTypeClient) mutate(ctx context.Context, m *EmailSubscriptionTypeMutation) (Value, error) {
+func (c *CouponRedemptionClient) mutate(ctx context.Context, m *CouponRedemptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailSubscriptionTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponRedemptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailSubscriptionTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponRedemptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailSubscriptionTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CouponRedemptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailSubscriptionTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CouponRedemptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailSubscriptionType mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CouponRedemption mutation op: %q", m.Op())
 	}
 }
 
-// EmojisClient is a client for the Emojis schema.
-type EmojisClient struct {
+// DunningStepClient is a client for the DunningStep schema.
+type DunningStepClient struct {
 	config
 }
 
-// NewEmojisClient returns a client for the Emojis from the given config.
-func NewEmojisClient(c config) *EmojisClient {
-	return &EmojisClient{config: c}
+// NewDunningStepClient returns a client for the DunningStep from the given config.
+func NewDunningStepClient(c config) *DunningStepClient {
+	return &DunningStepClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emojis.Hooks(f(g(h())))`.
-func (c *EmojisClient) Use(hooks ...Hook) {
-	c.hooks.Emojis = append(c.hooks.Emojis, hooks...)
+// A call to `Use(f, g, h)` equals to `dunningstep.Hooks(f(g(h())))`.
+func (c *DunningStepClient) Use(hooks ...Hook) {
+	c.hooks.DunningStep = append(c.hooks.DunningStep, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emojis.Intercept(f(g(h())))`.
-func (c *EmojisClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Emojis = append(c.inters.Emojis, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `dunningstep.Intercept(f(g(h())))`.
+func (c *DunningStepClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DunningStep = append(c.inters.DunningStep, interceptors...)
 }
 
-// Create returns a builder for creating a Emojis entity.
-func (c *EmojisClient) Create() *EmojisCreate {
-	mutation := newEmojisMutation(c.config, OpCreate)
-	return &EmojisCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DunningStep entity.
+func (c *DunningStepClient) Create() *DunningStepCreate {
+	mutation := newDunningStepMutation(c.config, OpCreate)
+	return &DunningStepCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Emojis entities.
-func (c *EmojisClient) CreateBulk(builders ...*EmojisCreate) *EmojisCreateBulk {
-	return &EmojisCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DunningStep entities.
+func (c *DunningStepClient) CreateBulk(builders ...*DunningStepCreate) *DunningStepCreateBulk {
+	return &DunningStepCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmojisClient) MapCreateBulk(slice any, setFunc func(*EmojisCreate, int)) *EmojisCreateBulk {
+func (c *DunningStepClient) MapCreateBulk(slice any, setFunc func(*DunningStepCreate, int)) *DunningStepCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmojisCreateBulk{err: fmt.Errorf("calling to EmojisClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DunningStepCreateBulk{err: fmt.Errorf("calling to DunningStepClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmojisCreate, rv.Len())
+	builders := make([]*DunningStepCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmojisCreateBulk{config: c.config, builders: builders}
+	return &DunningStepCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Emojis.
-func (c *EmojisClient) Update() *EmojisUpdate {
-	mutation := newEmojisMutation(c.config, OpUpdate)
-	return &EmojisUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DunningStep.
+func (c *DunningStepClient) Update() *DunningStepUpdate {
+	mutation := newDunningStepMutation(c.config, OpUpdate)
+	return &DunningStepUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmojisClient) UpdateOne(e *Emojis) *EmojisUpdateOne {
-	mutation := newEmojisMutation(c.config, OpUpdateOne, withEmojis(e))
-	return &EmojisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DunningStepClient) UpdateOne(ds *DunningStep) *DunningStepUpdateOne {
+	mutation := newDunningStepMutation(c.config, OpUpdateOne, withDunningStep(ds))
+	return &DunningStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmojisClient) UpdateOneID(id int) *EmojisUpdateOne {
-	mutation := newEmojisMutation(c.config, OpUpdateOne, withEmojisID(id))
-	return &EmojisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DunningStepClient) UpdateOneID(id int) *DunningStepUpdateOne {
+	mutation := newDunningStepMutation(c.config, OpUpdateOne, withDunningStepID(id))
+	return &DunningStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Emojis.
-func (c *EmojisClient) Delete() *EmojisDelete {
-	mutation := newEmojisMutation(c.config, OpDelete)
-	return &EmojisDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DunningStep.
+func (c *DunningStepClient) Delete() *DunningStepDelete {
+	mutation := newDunningStepMutation(c.config, OpDelete)
+	return &DunningStepDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmojisClient) DeleteOne(e *Emojis) *EmojisDeleteOne {
-	return c.DeleteOneID(e.ID)
+func (c *DunningStepClient) DeleteOne(ds *DunningStep) *DunningStepDeleteOne {
+	return c.DeleteOneID(ds.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmojisClient) DeleteOneID(id int) *EmojisDeleteOne {
-	builder := c.Delete().Where(emojis.ID(id))
+func (c *DunningStepClient) DeleteOneID(id int) *DunningStepDeleteOne {
+	builder := c.Delete().Where(dunningstep.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmojisDeleteOne{builder}
+	return &DunningStepDeleteOne{builder}
 }
 
-// Query returns a query builder for Emojis.
-func (c *EmojisClient) Query() *EmojisQuery {
-	return &EmojisQuery{
+// Query returns a query builder for DunningStep.
+func (c *DunningStepClient) Query() *DunningStepQuery {
+	return &DunningStepQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmojis},
+		ctx:    &QueryContext{Type: TypeDunningStep},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Emojis entity by its id.
-func (c *EmojisClient) Get(ctx context.Context, id int) (*Emojis, error) {
-	return c.Query().Where(emojis.ID(id)).Only(ctx)
+// Get returns a DunningStep entity by its id.
+func (c *DunningStepClient) Get(ctx context.Context, id int) (*DunningStep, error) {
+	return c.Query().Where(dunningstep.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmojisClient) GetX(ctx context.Context, id int) *Emojis {
+func (c *DunningStepClient) GetX(ctx context.Context, id int) *DunningStep {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1064,131 +1518,131 @@ func (c *EmojisClient) GetX(ctx context.Context, id int) *Emojis {
 }
 
 // Hooks returns the client hooks.
-func (c *EmojisClient) Hooks() []Hook {
-	return c.hooks.Emojis
+func (c *DunningStepClient) Hooks() []Hook {
+	return c.hooks.DunningStep
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmojisClient) Interceptors() []Interceptor {
-	return c.inters.Emojis
+func (c *DunningStepClient) Interceptors() []Interceptor {
+	return c.inters.DunningStep
 }
 
-func (c *EmojisClient) mutate(ctx context.Context, m *EmojisMutation) (Value, error) {
+func (c *DunningStepClient) mutate(ctx context.Context, m *DunningStepMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmojisCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DunningStepCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmojisUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DunningStepUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmojisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DunningStepUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmojisDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DunningStepDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Emojis mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DunningStep mutation op: %q", m.Op())
 	}
 }
 
-// FCMSubscriptionsClient is a client for the FCMSubscriptions schema.
-type FCMSubscriptionsClient struct {
+// EmailSubscriptionClient is a client for the EmailSubscription schema.
+type EmailSubscriptionClient struct {
 	config
 }
 
-// NewFCMSubscriptionsClient returns a client for the FCMSubscriptions from the given config.
-func NewFCMSubscriptionsClient(c config) *FCMSubscriptionsClient {
-	return &FCMSubscriptionsClient{config: c}
+// NewEmailSubscriptionClient returns a client for the EmailSubscription from the given config.
+func NewEmailSubscriptionClient(c config) *EmailSubscriptionClient {
+	return &EmailSubscriptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `fcmsubscriptions.Hooks(f(g(h())))`.
-func (c *FCMSubscriptionsClient) Use(hooks ...Hook) {
-	c.hooks.FCMSubscriptions = append(c.hooks.FCMSubscriptions, hooks...)
+// A call to `Use(f, g, h)` equals to `emailsubscription.Hooks(f(g(h())))`.
+func (c *EmailSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.EmailSubscription = append(c.hooks.EmailSubscription, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `fcmsubscriptions.Intercept(f(g(h())))`.
-func (c *FCMSubscriptionsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FCMSubscriptions = append(c.inters.FCMSubscriptions, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailsubscription.Intercept(f(g(h())))`.
+func (c *EmailSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSubscription = append(c.inters.EmailSubscription, interceptors...)
 }
 
-// Create returns a builder for creating a FCMSubscriptions entity.
-func (c *FCMSubscriptionsClient) Create() *FCMSubscriptionsCreate {
-	mutation := newFCMSubscriptionsMutation(c.config, OpCreate)
-	return &FCMSubscriptionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailSubscription entity.
+func (c *EmailSubscriptionClient) Create() *EmailSubscriptionCreate {
+	mutation := newEmailSubscriptionMutation(c.config, OpCreate)
+	return &EmailSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FCMSubscriptions entities.
-func (c *FCMSubscriptionsClient) CreateBulk(builders ...*FCMSubscriptionsCreate) *FCMSubscriptionsCreateBulk {
-	return &FCMSubscriptionsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailSubscription entities.
+func (c *EmailSubscriptionClient) CreateBulk(builders ...*EmailSubscriptionCreate) *EmailSubscriptionCreateBulk {
+	return &EmailSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FCMSubscriptionsClient) MapCreateBulk(slice any, setFunc func(*FCMSubscriptionsCreate, int)) *FCMSubscriptionsCreateBulk {
+func (c *EmailSubscriptionClient) MapCreateBulk(slice any, setFunc func(*EmailSubscriptionCreate, int)) *EmailSubscriptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FCMSubscriptionsCreateBulk{err: fmt.Errorf("calling to FCMSubscriptionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailSubscriptionCreateBulk{err: fmt.Errorf("calling to EmailSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FCMSubscriptionsCreate, rv.Len())
+	builders := make([]*EmailSubscriptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FCMSubscriptionsCreateBulk{config: c.config, builders: builders}
+	return &EmailSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FCMSubscriptions.
-func (c *FCMSubscriptionsClient) Update() *FCMSubscriptionsUpdate {
-	mutation := newFCMSubscriptionsMutation(c.config, OpUpdate)
-	return &FCMSubscriptionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailSubscription.
+func (c *EmailSubscriptionClient) Update() *EmailSubscriptionUpdate {
+	mutation := newEmailSubscriptionMutation(c.config, OpUpdate)
+	return &EmailSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FCMSubscriptionsClient) UpdateOne(fs *FCMSubscriptions) *FCMSubscriptionsUpdateOne {
-	mutation := newFCMSubscriptionsMutation(c.config, OpUpdateOne, withFCMSubscriptions(fs))
-	return &FCMSubscriptionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSubscriptionClient) UpdateOne(es *EmailSubscription) *EmailSubscriptionUpdateOne {
+	mutation := newEmailSubscriptionMutation(c.config, OpUpdateOne, withEmailSubscription(es))
+	return &EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FCMSubscriptionsClient) UpdateOneID(id int) *FCMSubscriptionsUpdateOne {
-	mutation := newFCMSubscriptionsMutation(c.config, OpUpdateOne, withFCMSubscriptionsID(id))
-	return &FCMSubscriptionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSubscriptionClient) UpdateOneID(id int) *EmailSubscriptionUpdateOne {
+	mutation := newEmailSubscriptionMutation(c.config, OpUpdateOne, withEmailSubscriptionID(id))
+	return &EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FCMSubscriptions.
-func (c *FCMSubscriptionsClient) Delete() *FCMSubscriptionsDelete {
-	mutation := newFCMSubscriptionsMutation(c.config, OpDelete)
-	return &FCMSubscriptionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailSubscription.
+func (c *EmailSubscriptionClient) Delete() *EmailSubscriptionDelete {
+	mutation := newEmailSubscriptionMutation(c.config, OpDelete)
+	return &EmailSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FCMSubscriptionsClient) DeleteOne(fs *FCMSubscriptions) *FCMSubscriptionsDeleteOne {
-	return c.DeleteOneID(fs.ID)
+func (c *EmailSubscriptionClient) DeleteOne(es *EmailSubscription) *EmailSubscriptionDeleteOne {
+	return c.DeleteOneID(es.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FCMSubscriptionsClient) DeleteOneID(id int) *FCMSubscriptionsDeleteOne {
-	builder := c.Delete().Where(fcmsubscriptions.ID(id))
+func (c *EmailSubscriptionClient) DeleteOneID(id int) *EmailSubscriptionDeleteOne {
+	builder := c.Delete().Where(emailsubscription.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FCMSubscriptionsDeleteOne{builder}
+	return &EmailSubscriptionDeleteOne{builder}
 }
 
-// Query returns a query builder for FCMSubscriptions.
-func (c *FCMSubscriptionsClient) Query() *FCMSubscriptionsQuery {
-	return &FCMSubscriptionsQuery{
+// Query returns a query builder for EmailSubscription.
+func (c *EmailSubscriptionClient) Query() *EmailSubscriptionQuery {
+	return &EmailSubscriptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFCMSubscriptions},
+		ctx:    &QueryContext{Type: TypeEmailSubscription},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FCMSubscriptions entity by its id.
-func (c *FCMSubscriptionsClient) Get(ctx context.Context, id int) (*FCMSubscriptions, error) {
-	return c.Query().Where(fcmsubscriptions.ID(id)).Only(ctx)
+// Get returns a EmailSubscription entity by its id.
+func (c *EmailSubscriptionClient) Get(ctx context.Context, id int) (*EmailSubscription, error) {
+	return c.Query().Where(emailsubscription.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FCMSubscriptionsClient) GetX(ctx context.Context, id int) *FCMSubscriptions {
+func (c *EmailSubscriptionClient) GetX(ctx context.Context, id int) *EmailSubscription {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1196,148 +1650,148 @@ func (c *FCMSubscriptionsClient) GetX(ctx context.Context, id int) *FCMSubscript
 	return obj
 }
 
-// QueryProfile queries the profile edge of a FCMSubscriptions.
-func (c *FCMSubscriptionsClient) QueryProfile(fs *FCMSubscriptions) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QuerySubscriptions queries the subscriptions edge of a EmailSubscription.
+func (c *EmailSubscriptionClient) QuerySubscriptions(es *EmailSubscription) *EmailSubscriptionTypeQuery {
+	query := (&EmailSubscriptionTypeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := fs.ID
+		id := es.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(fcmsubscriptions.Table, fcmsubscriptions.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, fcmsubscriptions.ProfileTable, fcmsubscriptions.ProfileColumn),
+			sqlgraph.From(emailsubscription.Table, emailsubscription.FieldID, id),
+			sqlgraph.To(emailsubscriptiontype.Table, emailsubscriptiontype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, emailsubscription.SubscriptionsTable, emailsubscription.SubscriptionsPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(fs.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(es.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FCMSubscriptionsClient) Hooks() []Hook {
-	return c.hooks.FCMSubscriptions
+func (c *EmailSubscriptionClient) Hooks() []Hook {
+	return c.hooks.EmailSubscription
 }
 
 // Interceptors returns the client interceptors.
-func (c *FCMSubscriptionsClient) Interceptors() []Interceptor {
-	return c.inters.FCMSubscriptions
+func (c *EmailSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.EmailSubscription
 }
 
-func (c *FCMSubscriptionsClient) mutate(ctx context.Context, m *FCMSubscriptionsMutation) (Value, error) {
+func (c *EmailSubscriptionClient) mutate(ctx context.Context, m *EmailSubscriptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FCMSubscriptionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FCMSubscriptionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FCMSubscriptionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FCMSubscriptionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmailSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FCMSubscriptions mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EmailSubscription mutation op: %q", m.Op())
 	}
 }
 
-// FileStorageClient is a client for the FileStorage schema.
-type FileStorageClient struct {
+// EmailSubscriptionTypeClient is a client for the EmailSubscriptionType schema.
+type EmailSubscriptionTypeClient struct {
 	config
 }
 
-// NewFileStorageClient returns a client for the FileStorage from the given config.
-func NewFileStorageClient(c config) *FileStorageClient {
-	return &FileStorageClient{config: c}
+// NewEmailSubscriptionTypeClient returns a client for the EmailSubscriptionType from the given config.
+func NewEmailSubscriptionTypeClient(c config) *EmailSubscriptionTypeClient {
+	return &EmailSubscriptionTypeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `filestorage.Hooks(f(g(h())))`.
-func (c *FileStorageClient) Use(hooks ...Hook) {
-	c.hooks.FileStorage = append(c.hooks.FileStorage, hooks...)
+// A call to `Use(f, g, h)` equals to `emailsubscriptiontype.Hooks(f(g(h())))`.
+func (c *EmailSubscriptionTypeClient) Use(hooks ...Hook) {
+	c.hooks.EmailSubscriptionType = append(c.hooks.EmailSubscriptionType, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `filestorage.Intercept(f(g(h())))`.
-func (c *FileStorageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FileStorage = append(c.inters.FileStorage, interceptors...)
-}
-
-// Create returns a builder for creating a FileStorage entity.
-func (c *FileStorageClient) Create() *FileStorageCreate {
-	mutation := newFileStorageMutation(c.config, OpCreate)
-	return &FileStorageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// A call to `Intercept(f, g, h)` equals to `emailsubscriptiontype.Intercept(f(g(h())))`.
+func (c *EmailSubscriptionTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSubscriptionType = append(c.inters.EmailSubscriptionType, interceptors...)
 }
 
-// CreateBulk returns a builder for creating a bulk of FileStorage entities.
-func (c *FileStorageClient) CreateBulk(builders ...*FileStorageCreate) *FileStorageCreateBulk {
-	return &FileStorageCreateBulk{config: c.config, builders: builders}
+// Create returns a builder for creating a EmailSubscriptionType entity.
+func (c *EmailSubscriptionTypeClient) Create() *EmailSubscriptionTypeCreate {
+	mutation := newEmailSubscriptionTypeMutation(c.config, OpCreate)
+	return &EmailSubscriptionTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailSubscriptionType entities.
+func (c *EmailSubscriptionTypeClient) CreateBulk(builders ...*EmailSubscriptionTypeCreate) *EmailSubscriptionTypeCreateBulk {
+	return &EmailSubscriptionTypeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FileStorageClient) MapCreateBulk(slice any, setFunc func(*FileStorageCreate, int)) *FileStorageCreateBulk {
+func (c *EmailSubscriptionTypeClient) MapCreateBulk(slice any, setFunc func(*EmailSubscriptionTypeCreate, int)) *EmailSubscriptionTypeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FileStorageCreateBulk{err: fmt.Errorf("calling to FileStorageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailSubscriptionTypeCreateBulk{err: fmt.Errorf("calling to EmailSubscriptionTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FileStorageCreate, rv.Len())
+	builders := make([]*EmailSubscriptionTypeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FileStorageCreateBulk{config: c.config, builders: builders}
+	return &EmailSubscriptionTypeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FileStorage.
-func (c *FileStorageClient) Update() *FileStorageUpdate {
-	mutation := newFileStorageMutation(c.config, OpUpdate)
-	return &FileStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailSubscriptionType.
+func (c *EmailSubscriptionTypeClient) Update() *EmailSubscriptionTypeUpdate {
+	mutation := newEmailSubscriptionTypeMutation(c.config, OpUpdate)
+	return &EmailSubscriptionTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FileStorageClient) UpdateOne(fs *FileStorage) *FileStorageUpdateOne {
-	mutation := newFileStorageMutation(c.config, OpUpdateOne, withFileStorage(fs))
-	return &FileStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSubscriptionTypeClient) UpdateOne(est *EmailSubscriptionType) *EmailSubscriptionTypeUpdateOne {
+	mutation := newEmailSubscriptionTypeMutation(c.config, OpUpdateOne, withEmailSubscriptionType(est))
+	return &EmailSubscriptionTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FileStorageClient) UpdateOneID(id int) *FileStorageUpdateOne {
-	mutation := newFileStorageMutation(c.config, OpUpdateOne, withFileStorageID(id))
-	return &FileStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailSubscriptionTypeClient) UpdateOneID(id int) *EmailSubscriptionTypeUpdateOne {
+	mutation := newEmailSubscriptionTypeMutation(c.config, OpUpdateOne, withEmailSubscriptionTypeID(id))
+	return &EmailSubscriptionTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FileStorage.
-func (c *FileStorageClient) Delete() *FileStorageDelete {
-	mutation := newFileStorageMutation(c.config, OpDelete)
-	return &FileStorageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailSubscriptionType.
+func (c *EmailSubscriptionTypeClient) Delete() *EmailSubscriptionTypeDelete {
+	mutation := newEmailSubscriptionTypeMutation(c.config, OpDelete)
+	return &EmailSubscriptionTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FileStorageClient) DeleteOne(fs *FileStorage) *FileStorageDeleteOne {
-	return c.DeleteOneID(fs.ID)
+func (c *EmailSubscriptionTypeClient) DeleteOne(est *EmailSubscriptionType) *EmailSubscriptionTypeDeleteOne {
+	return c.DeleteOneID(est.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FileStorageClient) DeleteOneID(id int) *FileStorageDeleteOne {
-	builder := c.Delete().Where(filestorage.ID(id))
+func (c *EmailSubscriptionTypeClient) DeleteOneID(id int) *EmailSubscriptionTypeDeleteOne {
+	builder := c.Delete().Where(emailsubscriptiontype.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FileStorageDeleteOne{builder}
+	return &EmailSubscriptionTypeDeleteOne{builder}
 }
 
-// Query returns a query builder for FileStorage.
-func (c *FileStorageClient) Query() *FileStorageQuery {
-	return &FileStorageQuery{
+// Query returns a query builder for EmailSubscriptionType.
+func (c *EmailSubscriptionTypeClient) Query() *EmailSubscriptionTypeQuery {
+	return &EmailSubscriptionTypeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFileStorage},
+		ctx:    &QueryContext{Type: TypeEmailSubscriptionType},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FileStorage entity by its id.
-func (c *FileStorageClient) Get(ctx context.Context, id int) (*FileStorage, error) {
-	return c.Query().Where(filestorage.ID(id)).Only(ctx)
+// Get returns a EmailSubscriptionType entity by its id.
+func (c *EmailSubscriptionTypeClient) Get(ctx context.Context, id int) (*EmailSubscriptionType, error) {
+	return c.Query().Where(emailsubscriptiontype.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FileStorageClient) GetX(ctx context.Context, id int) *FileStorage {
+func (c *EmailSubscriptionTypeClient) GetX(ctx context.Context, id int) *EmailSubscriptionType {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1345,132 +1799,148 @@ func (c *FileStorageClient) GetX(ctx context.Context, id int) *FileStorage {
 	return obj
 }
 
+// QuerySubscriber queries the subscriber edge of a EmailSubscriptionType.
+func (c *EmailSubscriptionTypeClient) QuerySubscriber(est *EmailSubscriptionType) *EmailSubscriptionQuery {
+	query := (&EmailSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := est.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(emailsubscriptiontype.Table, emailsubscriptiontype.FieldID, id),
+			sqlgraph.To(emailsubscription.Table, emailsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, emailsubscriptiontype.SubscriberTable, emailsubscriptiontype.SubscriberPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(est.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *FileStorageClient) Hooks() []Hook {
-	return c.hooks.FileStorage
+func (c *EmailSubscriptionTypeClient) Hooks() []Hook {
+	return c.hooks.EmailSubscriptionType
 }
 
 // Interceptors returns the client interceptors.
-func (c *FileStorageClient) Interceptors() []Interceptor {
-	return c.inters.FileStorage
+func (c *EmailSubscriptionTypeClient) Interceptors() []Interceptor {
+	return c.inters.EmailSubscriptionType
 }
 
-func (c *FileStorageClient) mutate(ctx context.Context, m *FileStorageMutation) (Value, error) {
+func (c *EmailSubscriptionTypeClient) mutate(ctx context.Context, m *EmailSubscriptionTypeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FileStorageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSubscriptionTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FileStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSubscriptionTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FileStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailSubscriptionTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FileStorageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmailSubscriptionTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown FileStorage mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EmailSubscriptionType mutation op: %q", m.Op())
 	}
 }
 
-// ImageClient is a client for the Image schema.
-type ImageClient struct {
+// EmojisClient is a client for the Emojis schema.
+type EmojisClient struct {
 	config
 }
 
-// NewImageClient returns a client for the Image from the given config.
-func NewImageClient(c config) *ImageClient {
-	return &ImageClient{config: c}
+// NewEmojisClient returns a client for the Emojis from the given config.
+func NewEmojisClient(c config) *EmojisClient {
+	return &EmojisClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `image.Hooks(f(g(h())))`.
-func (c *ImageClient) Use(hooks ...Hook) {
-	c.hooks.Image = append(c.hooks.Image, hooks...)
+// A call to `Use(f, g, h)` equals to `emojis.Hooks(f(g(h())))`.
+func (c *EmojisClient) Use(hooks ...Hook) {
+	c.hooks.Emojis = append(c.hooks.Emojis, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `image.Intercept(f(g(h())))`.
-func (c *ImageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Image = append(c.inters.Image, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emojis.Intercept(f(g(h())))`.
+func (c *EmojisClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Emojis = append(c.inters.Emojis, interceptors...)
 }
 
-// Create returns a builder for creating a Image entity.
-func (c *ImageClient) Create() *ImageCreate {
-	mutation := newImageMutation(c.config, OpCreate)
-	return &ImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Emojis entity.
+func (c *EmojisClient) Create() *EmojisCreate {
+	mutation := newEmojisMutation(c.config, OpCreate)
+	return &EmojisCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Image entities.
-func (c *ImageClient) CreateBulk(builders ...*ImageCreate) *ImageCreateBulk {
-	return &ImageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Emojis entities.
+func (c *EmojisClient) CreateBulk(builders ...*EmojisCreate) *EmojisCreateBulk {
+	return &EmojisCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ImageClient) MapCreateBulk(slice any, setFunc func(*ImageCreate, int)) *ImageCreateBulk {
+func (c *EmojisClient) MapCreateBulk(slice any, setFunc func(*EmojisCreate, int)) *EmojisCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ImageCreateBulk{err: fmt.Errorf("calling to ImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmojisCreateBulk{err: fmt.Errorf("calling to EmojisClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ImageCreate, rv.Len())
+	builders := make([]*EmojisCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ImageCreateBulk{config: c.config, builders: builders}
+	return &EmojisCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Image.
-func (c *ImageClient) Update() *ImageUpdate {
-	mutation := newImageMutation(c.config, OpUpdate)
-	return &ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Emojis.
+func (c *EmojisClient) Update() *EmojisUpdate {
+	mutation := newEmojisMutation(c.config, OpUpdate)
+	return &EmojisUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ImageClient) UpdateOne(i *Image) *ImageUpdateOne {
-	mutation := newImageMutation(c.config, OpUpdateOne, withImage(i))
-	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmojisClient) UpdateOne(e *Emojis) *EmojisUpdateOne {
+	mutation := newEmojisMutation(c.config, OpUpdateOne, withEmojis(e))
+	return &EmojisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ImageClient) UpdateOneID(id int) *ImageUpdateOne {
-	mutation := newImageMutation(c.config, OpUpdateOne, withImageID(id))
-	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmojisClient) UpdateOneID(id int) *EmojisUpdateOne {
+	mutation := newEmojisMutation(c.config, OpUpdateOne, withEmojisID(id))
+	return &EmojisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Image.
-func (c *ImageClient) Delete() *ImageDelete {
-	mutation := newImageMutation(c.config, OpDelete)
-	return &ImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Emojis.
+func (c *EmojisClient) Delete() *EmojisDelete {
+	mutation := newEmojisMutation(c.config, OpDelete)
+	return &EmojisDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ImageClient) DeleteOne(i *Image) *ImageDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *EmojisClient) DeleteOne(e *Emojis) *EmojisDeleteOne {
+	return c.DeleteOneID(e.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ImageClient) DeleteOneID(id int) *ImageDeleteOne {
-	builder := c.Delete().Where(image.ID(id))
+func (c *EmojisClient) DeleteOneID(id int) *EmojisDeleteOne {
+	builder := c.Delete().Where(emojis.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ImageDeleteOne{builder}
+	return &EmojisDeleteOne{builder}
 }
 
-// Query returns a query builder for Image.
-func (c *ImageClient) Query() *ImageQuery {
-	return &ImageQuery{
+// Query returns a query builder for Emojis.
+func (c *EmojisClient) Query() *EmojisQuery {
+	return &EmojisQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeImage},
+		ctx:    &QueryContext{Type: TypeEmojis},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Image entity by its id.
-func (c *ImageClient) Get(ctx context.Context, id int) (*Image, error) {
-	return c.Query().Where(image.ID(id)).Only(ctx)
+// Get returns a Emojis entity by its id.
+func (c *EmojisClient) Get(ctx context.Context, id int) (*Emojis, error) {
+	return c.Query().Where(emojis.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
+func (c *EmojisClient) GetX(ctx context.Context, id int) *Emojis {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1478,148 +1948,132 @@ func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
 	return obj
 }
 
-// QuerySizes queries the sizes edge of a Image.
-func (c *ImageClient) QuerySizes(i *Image) *ImageSizeQuery {
-	query := (&ImageSizeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(image.Table, image.FieldID, id),
-			sqlgraph.To(imagesize.Table, imagesize.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, image.SizesTable, image.SizesColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ImageClient) Hooks() []Hook {
-	return c.hooks.Image
+func (c *EmojisClient) Hooks() []Hook {
+	return c.hooks.Emojis
 }
 
 // Interceptors returns the client interceptors.
-func (c *ImageClient) Interceptors() []Interceptor {
-	return c.inters.Image
+func (c *EmojisClient) Interceptors() []Interceptor {
+	return c.inters.Emojis
 }
 
-func (c *ImageClient) mutate(ctx context.Context, m *ImageMutation) (Value, error) {
+func (c *EmojisClient) mutate(ctx context.Context, m *EmojisMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmojisCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmojisUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmojisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmojisDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Image mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Emojis mutation op: %q", m.Op())
 	}
 }
 
-// ImageSizeClient is a client for the ImageSize schema.
-type ImageSizeClient struct {
+// FCMSubscriptionsClient is a client for the FCMSubscriptions schema.
+type FCMSubscriptionsClient struct {
 	config
 }
 
-// NewImageSizeClient returns a client for the ImageSize from the given config.
-func NewImageSizeClient(c config) *ImageSizeClient {
-	return &ImageSizeClient{config: c}
+// NewFCMSubscriptionsClient returns a client for the FCMSubscriptions from the given config.
+func NewFCMSubscriptionsClient(c config) *FCMSubscriptionsClient {
+	return &FCMSubscriptionsClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `imagesize.Hooks(f(g(h())))`.
-func (c *ImageSizeClient) Use(hooks ...Hook) {
-	c.hooks.ImageSize = append(c.hooks.ImageSize, hooks...)
+// A call to `Use(f, g, h)` equals to `fcmsubscriptions.Hooks(f(g(h())))`.
+func (c *FCMSubscriptionsClient) Use(hooks ...Hook) {
+	c.hooks.FCMSubscriptions = append(c.hooks.FCMSubscriptions, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `imagesize.Intercept(f(g(h())))`.
-func (c *ImageSizeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ImageSize = append(c.inters.ImageSize, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `fcmsubscriptions.Intercept(f(g(h())))`.
+func (c *FCMSubscriptionsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FCMSubscriptions = append(c.inters.FCMSubscriptions, interceptors...)
 }
 
-// Create returns a builder for creating a ImageSize entity.
-func (c *ImageSizeClient) Create() *ImageSizeCreate {
-	mutation := newImageSizeMutation(c.config, OpCreate)
-	return &ImageSizeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FCMSubscriptions entity.
+func (c *FCMSubscriptionsClient) Create() *FCMSubscriptionsCreate {
+	mutation := newFCMSubscriptionsMutation(c.config, OpCreate)
+	return &FCMSubscriptionsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ImageSize entities.
-func (c *ImageSizeClient) CreateBulk(builders ...*ImageSizeCreate) *ImageSizeCreateBulk {
-	return &ImageSizeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FCMSubscriptions entities.
+func (c *FCMSubscriptionsClient) CreateBulk(builders ...*FCMSubscriptionsCreate) *FCMSubscriptionsCreateBulk {
+	return &FCMSubscriptionsCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ImageSizeClient) MapCreateBulk(slice any, setFunc func(*ImageSizeCreate, int)) *ImageSizeCreateBulk {
+func (c *FCMSubscriptionsClient) MapCreateBulk(slice any, setFunc func(*FCMSubscriptionsCreate, int)) *FCMSubscriptionsCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ImageSizeCreateBulk{err: fmt.Errorf("calling to ImageSizeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FCMSubscriptionsCreateBulk{err: fmt.Errorf("calling to FCMSubscriptionsClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ImageSizeCreate, rv.Len())
+	builders := make([]*FCMSubscriptionsCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ImageSizeCreateBulk{config: c.config, builders: builders}
+	return &FCMSubscriptionsCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ImageSize.
-func (c *ImageSizeClient) Update() *ImageSizeUpdate {
-	mutation := newImageSizeMutation(c.config, OpUpdate)
-	return &ImageSizeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FCMSubscriptions.
+func (c *FCMSubscriptionsClient) Update() *FCMSubscriptionsUpdate {
+	mutation := newFCMSubscriptionsMutation(c.config, OpUpdate)
+	return &FCMSubscriptionsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ImageSizeClient) UpdateOne(is *ImageSize) *ImageSizeUpdateOne {
-	mutation := newImageSizeMutation(c.config, OpUpdateOne, withImageSize(is))
-	return &ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FCMSubscriptionsClient) UpdateOne(fs *FCMSubscriptions) *FCMSubscriptionsUpdateOne {
+	mutation := newFCMSubscriptionsMutation(c.config, OpUpdateOne, withFCMSubscriptions(fs))
+	return &FCMSubscriptionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ImageSizeClient) UpdateOneID(id int) *ImageSizeUpdateOne {
-	mutation := newImageSizeMutation(c.config, OpUpdateOne, withImageSizeID(id))
-	return &ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FCMSubscriptionsClient) UpdateOneID(id int) *FCMSubscriptionsUpdateOne {
+	mutation := newFCMSubscriptionsMutation(c.config, OpUpdateOne, withFCMSubscriptionsID(id))
+	return &FCMSubscriptionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ImageSize.
-func (c *ImageSizeClient) Delete() *ImageSizeDelete {
-	mutation := newImageSizeMutation(c.config, OpDelete)
-	return &ImageSizeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FCMSubscriptions.
+func (c *FCMSubscriptionsClient) Delete() *FCMSubscriptionsDelete {
+	mutation := newFCMSubscriptionsMutation(c.config, OpDelete)
+	return &FCMSubscriptionsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ImageSizeClient) DeleteOne(is *ImageSize) *ImageSizeDeleteOne {
-	return c.DeleteOneID(is.ID)
+func (c *FCMSubscriptionsClient) DeleteOne(fs *FCMSubscriptions) *FCMSubscriptionsDeleteOne {
+	return c.DeleteOneID(fs.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ImageSizeClient) DeleteOneID(id int) *ImageSizeDeleteOne {
-	builder := c.Delete().Where(imagesize.ID(id))
+func (c *FCMSubscriptionsClient) DeleteOneID(id int) *FCMSubscriptionsDeleteOne {
+	builder := c.Delete().Where(fcmsubscriptions.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ImageSizeDeleteOne{builder}
+	return &FCMSubscriptionsDeleteOne{builder}
 }
 
-// Query returns a query builder for ImageSize.
-func (c *ImageSizeClient) Query() *ImageSizeQuery {
-	return &ImageSizeQuery{
+// Query returns a query builder for FCMSubscriptions.
+func (c *FCMSubscriptionsClient) Query() *FCMSubscriptionsQuery {
+	return &FCMSubscriptionsQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeImageSize},
+		ctx:    &QueryContext{Type: TypeFCMSubscriptions},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ImageSize entity by its id.
-func (c *ImageSizeClient) Get(ctx context.Context, id int) (*ImageSize, error) {
-	return c.Query().Where(imagesize.ID(id)).Only(ctx)
+// Get returns a FCMSubscriptions entity by its id.
+func (c *FCMSubscriptionsClient) Get(ctx context.Context, id int) (*FCMSubscriptions, error) {
+	return c.Query().Where(fcmsubscriptions.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ImageSizeClient) GetX(ctx context.Context, id int) *ImageSize {
+func (c *FCMSubscriptionsClient) GetX(ctx context.Context, id int) *FCMSubscriptions {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1627,164 +2081,148 @@ func (c *ImageSizeClient) GetX(ctx context.Context, id int) *ImageSize {
 	return obj
 }
 
-// QueryFile queries the file edge of a ImageSize.
-func (c *ImageSizeClient) QueryFile(is *ImageSize) *FileStorageQuery {
-	query := (&FileStorageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := is.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(imagesize.Table, imagesize.FieldID, id),
-			sqlgraph.To(filestorage.Table, filestorage.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, imagesize.FileTable, imagesize.FileColumn),
-		)
-		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryImage queries the image edge of a ImageSize.
-func (c *ImageSizeClient) QueryImage(is *ImageSize) *ImageQuery {
-	query := (&ImageClient{config: c.config}).Query()
+// QueryProfile queries the profile edge of a FCMSubscriptions.
+func (c *FCMSubscriptionsClient) QueryProfile(fs *FCMSubscriptions) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := is.ID
+		id := fs.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(imagesize.Table, imagesize.FieldID, id),
-			sqlgraph.To(image.Table, image.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, imagesize.ImageTable, imagesize.ImageColumn),
+			sqlgraph.From(fcmsubscriptions.Table, fcmsubscriptions.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fcmsubscriptions.ProfileTable, fcmsubscriptions.ProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(fs.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ImageSizeClient) Hooks() []Hook {
-	return c.hooks.ImageSize
+func (c *FCMSubscriptionsClient) Hooks() []Hook {
+	return c.hooks.FCMSubscriptions
 }
 
 // Interceptors returns the client interceptors.
-func (c *ImageSizeClient) Interceptors() []Interceptor {
-	return c.inters.ImageSize
+func (c *FCMSubscriptionsClient) Interceptors() []Interceptor {
+	return c.inters.FCMSubscriptions
 }
 
-func (c *ImageSizeClient) mutate(ctx context.Context, m *ImageSizeMutation) (Value, error) {
+func (c *FCMSubscriptionsClient) mutate(ctx context.Context, m *FCMSubscriptionsMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ImageSizeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FCMSubscriptionsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ImageSizeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FCMSubscriptionsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FCMSubscriptionsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ImageSizeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FCMSubscriptionsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ImageSize mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown FCMSubscriptions mutation op: %q", m.Op())
 	}
 }
 
-// InvitationClient is a client for the Invitation schema.
-type InvitationClient struct {
+// FileStorageClient is a client for the FileStorage schema.
+type FileStorageClient struct {
 	config
 }
 
-// NewInvitationClient returns a client for the Invitation from the given config.
-func NewInvitationClient(c config) *InvitationClient {
-	return &InvitationClient{config: c}
+// NewFileStorageClient returns a client for the FileStorage from the given config.
+func NewFileStorageClient(c config) *FileStorageClient {
+	return &FileStorageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
-func (c *InvitationClient) Use(hooks ...Hook) {
-	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+// A call to `Use(f, g, h)` equals to `filestorage.Hooks(f(g(h())))`.
+func (c *FileStorageClient) Use(hooks ...Hook) {
+	c.hooks.FileStorage = append(c.hooks.FileStorage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
-func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `filestorage.Intercept(f(g(h())))`.
+func (c *FileStorageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileStorage = append(c.inters.FileStorage, interceptors...)
 }
 
-// Create returns a builder for creating a Invitation entity.
-func (c *InvitationClient) Create() *InvitationCreate {
-	mutation := newInvitationMutation(c.config, OpCreate)
-	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FileStorage entity.
+func (c *FileStorageClient) Create() *FileStorageCreate {
+	mutation := newFileStorageMutation(c.config, OpCreate)
+	return &FileStorageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Invitation entities.
-func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FileStorage entities.
+func (c *FileStorageClient) CreateBulk(builders ...*FileStorageCreate) *FileStorageCreateBulk {
+	return &FileStorageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+func (c *FileStorageClient) MapCreateBulk(slice any, setFunc func(*FileStorageCreate, int)) *FileStorageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FileStorageCreateBulk{err: fmt.Errorf("calling to FileStorageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*InvitationCreate, rv.Len())
+	builders := make([]*FileStorageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &InvitationCreateBulk{config: c.config, builders: builders}
+	return &FileStorageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Invitation.
-func (c *InvitationClient) Update() *InvitationUpdate {
-	mutation := newInvitationMutation(c.config, OpUpdate)
-	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FileStorage.
+func (c *FileStorageClient) Update() *FileStorageUpdate {
+	mutation := newFileStorageMutation(c.config, OpUpdate)
+	return &FileStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FileStorageClient) UpdateOne(fs *FileStorage) *FileStorageUpdateOne {
+	mutation := newFileStorageMutation(c.config, OpUpdateOne, withFileStorage(fs))
+	return &FileStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *InvitationClient) UpdateOneID(id int) *InvitationUpdateOne {
-	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
-	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FileStorageClient) UpdateOneID(id int) *FileStorageUpdateOne {
+	mutation := newFileStorageMutation(c.config, OpUpdateOne, withFileStorageID(id))
+	return &FileStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Invitation.
-func (c *InvitationClient) Delete() *InvitationDelete {
-	mutation := newInvitationMutation(c.config, OpDelete)
-	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FileStorage.
+func (c *FileStorageClient) Delete() *FileStorageDelete {
+	mutation := newFileStorageMutation(c.config, OpDelete)
+	return &FileStorageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *FileStorageClient) DeleteOne(fs *FileStorage) *FileStorageDeleteOne {
+	return c.DeleteOneID(fs.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *InvitationClient) DeleteOneID(id int) *InvitationDeleteOne {
-	builder := c.Delete().Where(invitation.ID(id))
+func (c *FileStorageClient) DeleteOneID(id int) *FileStorageDeleteOne {
+	builder := c.Delete().Where(filestorage.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &InvitationDeleteOne{builder}
+	return &FileStorageDeleteOne{builder}
 }
 
-// Query returns a query builder for Invitation.
-func (c *InvitationClient) Query() *InvitationQuery {
-	return &InvitationQuery{
+// Query returns a query builder for FileStorage.
+func (c *FileStorageClient) Query() *FileStorageQuery {
+	return &FileStorageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeInvitation},
+		ctx:    &QueryContext{Type: TypeFileStorage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Invitation entity by its id.
-func (c *InvitationClient) Get(ctx context.Context, id int) (*Invitation, error) {
-	return c.Query().Where(invitation.ID(id)).Only(ctx)
+// Get returns a FileStorage entity by its id.
+func (c *FileStorageClient) Get(ctx context.Context, id int) (*FileStorage, error) {
+	return c.Query().Where(filestorage.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
+func (c *FileStorageClient) GetX(ctx context.Context, id int) *FileStorage {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1792,148 +2230,132 @@ func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
 	return obj
 }
 
-// QueryInviter queries the inviter edge of a Invitation.
-func (c *InvitationClient) QueryInviter(i *Invitation) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(invitation.Table, invitation.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, invitation.InviterTable, invitation.InviterColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *InvitationClient) Hooks() []Hook {
-	return c.hooks.Invitation
+func (c *FileStorageClient) Hooks() []Hook {
+	return c.hooks.FileStorage
 }
 
 // Interceptors returns the client interceptors.
-func (c *InvitationClient) Interceptors() []Interceptor {
-	return c.inters.Invitation
+func (c *FileStorageClient) Interceptors() []Interceptor {
+	return c.inters.FileStorage
 }
 
-func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+func (c *FileStorageClient) mutate(ctx context.Context, m *FileStorageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FileStorageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FileStorageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&FileStorageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&FileStorageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown FileStorage mutation op: %q", m.Op())
 	}
 }
 
-// LastSeenOnlineClient is a client for the LastSeenOnline schema.
-type LastSeenOnlineClient struct {
+// GracePeriodClient is a client for the GracePeriod schema.
+type GracePeriodClient struct {
 	config
 }
 
-// NewLastSeenOnlineClient returns a client for the LastSeenOnline from the given config.
-func NewLastSeenOnlineClient(c config) *LastSeenOnlineClient {
-	return &LastSeenOnlineClient{config: c}
+// NewGracePeriodClient returns a client for the GracePeriod from the given config.
+func NewGracePeriodClient(c config) *GracePeriodClient {
+	return &GracePeriodClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `lastseenonline.Hooks(f(g(h())))`.
-func (c *LastSeenOnlineClient) Use(hooks ...Hook) {
-	c.hooks.LastSeenOnline = append(c.hooks.LastSeenOnline, hooks...)
+// A call to `Use(f, g, h)` equals to `graceperiod.Hooks(f(g(h())))`.
+func (c *GracePeriodClient) Use(hooks ...Hook) {
+	c.hooks.GracePeriod = append(c.hooks.GracePeriod, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `lastseenonline.Intercept(f(g(h())))`.
-func (c *LastSeenOnlineClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LastSeenOnline = append(c.inters.LastSeenOnline, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `graceperiod.Intercept(f(g(h())))`.
+func (c *GracePeriodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GracePeriod = append(c.inters.GracePeriod, interceptors...)
 }
 
-// Create returns a builder for creating a LastSeenOnline entity.
-func (c *LastSeenOnlineClient) Create() *LastSeenOnlineCreate {
-	mutation := newLastSeenOnlineMutation(c.config, OpCreate)
-	return &LastSeenOnlineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a GracePeriod entity.
+func (c *GracePeriodClient) Create() *GracePeriodCreate {
+	mutation := newGracePeriodMutation(c.config, OpCreate)
+	return &GracePeriodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LastSeenOnline entities.
-func (c *LastSeenOnlineClient) CreateBulk(builders ...*LastSeenOnlineCreate) *LastSeenOnlineCreateBulk {
-	return &LastSeenOnlineCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of GracePeriod entities.
+func (c *GracePeriodClient) CreateBulk(builders ...*GracePeriodCreate) *GracePeriodCreateBulk {
+	return &GracePeriodCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LastSeenOnlineClient) MapCreateBulk(slice any, setFunc func(*LastSeenOnlineCreate, int)) *LastSeenOnlineCreateBulk {
+func (c *GracePeriodClient) MapCreateBulk(slice any, setFunc func(*GracePeriodCreate, int)) *GracePeriodCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LastSeenOnlineCreateBulk{err: fmt.Errorf("calling to LastSeenOnlineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &GracePeriodCreateBulk{err: fmt.Errorf("calling to GracePeriodClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LastSeenOnlineCreate, rv.Len())
+	builders := make([]*GracePeriodCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LastSeenOnlineCreateBulk{config: c.config, builders: builders}
+	return &GracePeriodCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LastSeenOnline.
-func (c *LastSeenOnlineClient) Update() *LastSeenOnlineUpdate {
-	mutation := newLastSeenOnlineMutation(c.config, OpUpdate)
-	return &LastSeenOnlineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for GracePeriod.
+func (c *GracePeriodClient) Update() *GracePeriodUpdate {
+	mutation := newGracePeriodMutation(c.config, OpUpdate)
+	return &GracePeriodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LastSeenOnlineClient) UpdateOne(lso *LastSeenOnline) *LastSeenOnlineUpdateOne {
-	mutation := newLastSeenOnlineMutation(c.config, OpUpdateOne, withLastSeenOnline(lso))
-	return &LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GracePeriodClient) UpdateOne(gp *GracePeriod) *GracePeriodUpdateOne {
+	mutation := newGracePeriodMutation(c.config, OpUpdateOne, withGracePeriod(gp))
+	return &GracePeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LastSeenOnlineClient) UpdateOneID(id int) *LastSeenOnlineUpdateOne {
-	mutation := newLastSeenOnlineMutation(c.config, OpUpdateOne, withLastSeenOnlineID(id))
-	return &LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *GracePeriodClient) UpdateOneID(id int) *GracePeriodUpdateOne {
+	mutation := newGracePeriodMutation(c.config, OpUpdateOne, withGracePeriodID(id))
+	return &GracePeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LastSeenOnline.
-func (c *LastSeenOnlineClient) Delete() *LastSeenOnlineDelete {
-	mutation := newLastSeenOnlineMutation(c.config, OpDelete)
-	return &LastSeenOnlineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for GracePeriod.
+func (c *GracePeriodClient) Delete() *GracePeriodDelete {
+	mutation := newGracePeriodMutation(c.config, OpDelete)
+	return &GracePeriodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LastSeenOnlineClient) DeleteOne(lso *LastSeenOnline) *LastSeenOnlineDeleteOne {
-	return c.DeleteOneID(lso.ID)
+func (c *GracePeriodClient) DeleteOne(gp *GracePeriod) *GracePeriodDeleteOne {
+	return c.DeleteOneID(gp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LastSeenOnlineClient) DeleteOneID(id int) *LastSeenOnlineDeleteOne {
-	builder := c.Delete().Where(lastseenonline.ID(id))
+func (c *GracePeriodClient) DeleteOneID(id int) *GracePeriodDeleteOne {
+	builder := c.Delete().Where(graceperiod.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LastSeenOnlineDeleteOne{builder}
+	return &GracePeriodDeleteOne{builder}
 }
 
-// Query returns a query builder for LastSeenOnline.
-func (c *LastSeenOnlineClient) Query() *LastSeenOnlineQuery {
-	return &LastSeenOnlineQuery{
+// Query returns a query builder for GracePeriod.
+func (c *GracePeriodClient) Query() *GracePeriodQuery {
+	return &GracePeriodQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLastSeenOnline},
+		ctx:    &QueryContext{Type: TypeGracePeriod},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LastSeenOnline entity by its id.
-func (c *LastSeenOnlineClient) Get(ctx context.Context, id int) (*LastSeenOnline, error) {
-	return c.Query().Where(lastseenonline.ID(id)).Only(ctx)
+// Get returns a GracePeriod entity by its id.
+func (c *GracePeriodClient) Get(ctx context.Context, id int) (*GracePeriod, error) {
+	return c.Query().Where(graceperiod.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LastSeenOnlineClient) GetX(ctx context.Context, id int) *LastSeenOnline {
+func (c *GracePeriodClient) GetX(ctx context.Context, id int) *GracePeriod {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1941,148 +2363,132 @@ func (c *LastSeenOnlineClient) GetX(ctx context.Context, id int) *LastSeenOnline
 	return obj
 }
 
-// QueryUser queries the user edge of a LastSeenOnline.
-func (c *LastSeenOnlineClient) QueryUser(lso *LastSeenOnline) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := lso.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(lastseenonline.Table, lastseenonline.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, lastseenonline.UserTable, lastseenonline.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(lso.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *LastSeenOnlineClient) Hooks() []Hook {
-	return c.hooks.LastSeenOnline
+func (c *GracePeriodClient) Hooks() []Hook {
+	return c.hooks.GracePeriod
 }
 
 // Interceptors returns the client interceptors.
-func (c *LastSeenOnlineClient) Interceptors() []Interceptor {
-	return c.inters.LastSeenOnline
+func (c *GracePeriodClient) Interceptors() []Interceptor {
+	return c.inters.GracePeriod
 }
 
-func (c *LastSeenOnlineClient) mutate(ctx context.Context, m *LastSeenOnlineMutation) (Value, error) {
+func (c *GracePeriodClient) mutate(ctx context.Context, m *GracePeriodMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LastSeenOnlineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GracePeriodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LastSeenOnlineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GracePeriodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GracePeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LastSeenOnlineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GracePeriodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LastSeenOnline mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown GracePeriod mutation op: %q", m.Op())
 	}
 }
 
-// MonthlySubscriptionClient is a client for the MonthlySubscription schema.
-type MonthlySubscriptionClient struct {
+// ImageClient is a client for the Image schema.
+type ImageClient struct {
 	config
 }
 
-// NewMonthlySubscriptionClient returns a client for the MonthlySubscription from the given config.
-func NewMonthlySubscriptionClient(c config) *MonthlySubscriptionClient {
-	return &MonthlySubscriptionClient{config: c}
+// NewImageClient returns a client for the Image from the given config.
+func NewImageClient(c config) *ImageClient {
+	return &ImageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `monthlysubscription.Hooks(f(g(h())))`.
-func (c *MonthlySubscriptionClient) Use(hooks ...Hook) {
-	c.hooks.MonthlySubscription = append(c.hooks.MonthlySubscription, hooks...)
+// A call to `Use(f, g, h)` equals to `image.Hooks(f(g(h())))`.
+func (c *ImageClient) Use(hooks ...Hook) {
+	c.hooks.Image = append(c.hooks.Image, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `monthlysubscription.Intercept(f(g(h())))`.
-func (c *MonthlySubscriptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MonthlySubscription = append(c.inters.MonthlySubscription, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `image.Intercept(f(g(h())))`.
+func (c *ImageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Image = append(c.inters.Image, interceptors...)
 }
 
-// Create returns a builder for creating a MonthlySubscription entity.
-func (c *MonthlySubscriptionClient) Create() *MonthlySubscriptionCreate {
-	mutation := newMonthlySubscriptionMutation(c.config, OpCreate)
-	return &MonthlySubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Image entity.
+func (c *ImageClient) Create() *ImageCreate {
+	mutation := newImageMutation(c.config, OpCreate)
+	return &ImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MonthlySubscription entities.
-func (c *MonthlySubscriptionClient) CreateBulk(builders ...*MonthlySubscriptionCreate) *MonthlySubscriptionCreateBulk {
-	return &MonthlySubscriptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Image entities.
+func (c *ImageClient) CreateBulk(builders ...*ImageCreate) *ImageCreateBulk {
+	return &ImageCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MonthlySubscriptionClient) MapCreateBulk(slice any, setFunc func(*MonthlySubscriptionCreate, int)) *MonthlySubscriptionCreateBulk {
+func (c *ImageClient) MapCreateBulk(slice any, setFunc func(*ImageCreate, int)) *ImageCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MonthlySubscriptionCreateBulk{err: fmt.Errorf("calling to MonthlySubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ImageCreateBulk{err: fmt.Errorf("calling to ImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MonthlySubscriptionCreate, rv.Len())
+	builders := make([]*ImageCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MonthlySubscriptionCreateBulk{config: c.config, builders: builders}
+	return &ImageCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MonthlySubscription.
-func (c *MonthlySubscriptionClient) Update() *MonthlySubscriptionUpdate {
-	mutation := newMonthlySubscriptionMutation(c.config, OpUpdate)
-	return &MonthlySubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Image.
+func (c *ImageClient) Update() *ImageUpdate {
+	mutation := newImageMutation(c.config, OpUpdate)
+	return &ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MonthlySubscriptionClient) UpdateOne(ms *MonthlySubscription) *MonthlySubscriptionUpdateOne {
-	mutation := newMonthlySubscriptionMutation(c.config, OpUpdateOne, withMonthlySubscription(ms))
-	return &MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImageClient) UpdateOne(i *Image) *ImageUpdateOne {
+	mutation := newImageMutation(c.config, OpUpdateOne, withImage(i))
+	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MonthlySubscriptionClient) UpdateOneID(id int) *MonthlySubscriptionUpdateOne {
-	mutation := newMonthlySubscriptionMutation(c.config, OpUpdateOne, withMonthlySubscriptionID(id))
-	return &MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImageClient) UpdateOneID(id int) *ImageUpdateOne {
+	mutation := newImageMutation(c.config, OpUpdateOne, withImageID(id))
+	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MonthlySubscription.
-func (c *MonthlySubscriptionClient) Delete() *MonthlySubscriptionDelete {
-	mutation := newMonthlySubscriptionMutation(c.config, OpDelete)
-	return &MonthlySubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Image.
+func (c *ImageClient) Delete() *ImageDelete {
+	mutation := newImageMutation(c.config, OpDelete)
+	return &ImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MonthlySubscriptionClient) DeleteOne(ms *MonthlySubscription) *MonthlySubscriptionDeleteOne {
-	return c.DeleteOneID(ms.ID)
+func (c *ImageClient) DeleteOne(i *Image) *ImageDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MonthlySubscriptionClient) DeleteOneID(id int) *MonthlySubscriptionDeleteOne {
-	builder := c.Delete().Where(monthlysubscription.ID(id))
+func (c *ImageClient) DeleteOneID(id int) *ImageDeleteOne {
+	builder := c.Delete().Where(image.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MonthlySubscriptionDeleteOne{builder}
+	return &ImageDeleteOne{builder}
 }
 
-// Query returns a query builder for MonthlySubscription.
-func (c *MonthlySubscriptionClient) Query() *MonthlySubscriptionQuery {
-	return &MonthlySubscriptionQuery{
+// Query returns a query builder for Image.
+func (c *ImageClient) Query() *ImageQuery {
+	return &ImageQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMonthlySubscription},
+		ctx:    &QueryContext{Type: TypeImage},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MonthlySubscription entity by its id.
-func (c *MonthlySubscriptionClient) Get(ctx context.Context, id int) (*MonthlySubscription, error) {
-	return c.Query().Where(monthlysubscription.ID(id)).Only(ctx)
+// Get returns a Image entity by its id.
+func (c *ImageClient) Get(ctx context.Context, id int) (*Image, error) {
+	return c.Query().Where(image.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MonthlySubscriptionClient) GetX(ctx context.Context, id int) *MonthlySubscription {
+func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2090,164 +2496,148 @@ func (c *MonthlySubscriptionClient) GetX(ctx context.Context, id int) *MonthlySu
 	return obj
 }
 
-// QueryBenefactors queries the benefactors edge of a MonthlySubscription.
-func (c *MonthlySubscriptionClient) QueryBenefactors(ms *MonthlySubscription) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ms.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, monthlysubscription.BenefactorsTable, monthlysubscription.BenefactorsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPayer queries the payer edge of a MonthlySubscription.
-func (c *MonthlySubscriptionClient) QueryPayer(ms *MonthlySubscription) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QuerySizes queries the sizes edge of a Image.
+func (c *ImageClient) QuerySizes(i *Image) *ImageSizeQuery {
+	query := (&ImageSizeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ms.ID
+		id := i.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, monthlysubscription.PayerTable, monthlysubscription.PayerColumn),
+			sqlgraph.From(image.Table, image.FieldID, id),
+			sqlgraph.To(imagesize.Table, imagesize.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, image.SizesTable, image.SizesColumn),
 		)
-		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MonthlySubscriptionClient) Hooks() []Hook {
-	return c.hooks.MonthlySubscription
+func (c *ImageClient) Hooks() []Hook {
+	return c.hooks.Image
 }
 
 // Interceptors returns the client interceptors.
-func (c *MonthlySubscriptionClient) Interceptors() []Interceptor {
-	return c.inters.MonthlySubscription
+func (c *ImageClient) Interceptors() []Interceptor {
+	return c.inters.Image
 }
 
-func (c *MonthlySubscriptionClient) mutate(ctx context.Context, m *MonthlySubscriptionMutation) (Value, error) {
+func (c *ImageClient) mutate(ctx context.Context, m *ImageMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MonthlySubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MonthlySubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MonthlySubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MonthlySubscription mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Image mutation op: %q", m.Op())
 	}
 }
 
-// NotificationClient is a client for the Notification schema.
-type NotificationClient struct {
+// ImageSizeClient is a client for the ImageSize schema.
+type ImageSizeClient struct {
 	config
 }
 
-// NewNotificationClient returns a client for the Notification from the given config.
-func NewNotificationClient(c config) *NotificationClient {
-	return &NotificationClient{config: c}
-}
+// NewImageSizeClient returns a client for the ImageSize from the given config.
+func NewImageSizeClient(c config) *ImageSizeClient {
+	return &ImageSizeClient{config: c}
+}
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
-func (c *NotificationClient) Use(hooks ...Hook) {
-	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+// A call to `Use(f, g, h)` equals to `imagesize.Hooks(f(g(h())))`.
+func (c *ImageSizeClient) Use(hooks ...Hook) {
+	c.hooks.ImageSize = append(c.hooks.ImageSize, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
-func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Notification = append(c.inters.Notification, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `imagesize.Intercept(f(g(h())))`.
+func (c *ImageSizeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImageSize = append(c.inters.ImageSize, interceptors...)
 }
 
-// Create returns a builder for creating a Notification entity.
-func (c *NotificationClient) Create() *NotificationCreate {
-	mutation := newNotificationMutation(c.config, OpCreate)
-	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ImageSize entity.
+func (c *ImageSizeClient) Create() *ImageSizeCreate {
+	mutation := newImageSizeMutation(c.config, OpCreate)
+	return &ImageSizeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Notification entities.
-func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ImageSize entities.
+func (c *ImageSizeClient) CreateBulk(builders ...*ImageSizeCreate) *ImageSizeCreateBulk {
+	return &ImageSizeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+func (c *ImageSizeClient) MapCreateBulk(slice any, setFunc func(*ImageSizeCreate, int)) *ImageSizeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ImageSizeCreateBulk{err: fmt.Errorf("calling to ImageSizeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationCreate, rv.Len())
+	builders := make([]*ImageSizeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationCreateBulk{config: c.config, builders: builders}
+	return &ImageSizeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Notification.
-func (c *NotificationClient) Update() *NotificationUpdate {
-	mutation := newNotificationMutation(c.config, OpUpdate)
-	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ImageSize.
+func (c *ImageSizeClient) Update() *ImageSizeUpdate {
+	mutation := newImageSizeMutation(c.config, OpUpdate)
+	return &ImageSizeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImageSizeClient) UpdateOne(is *ImageSize) *ImageSizeUpdateOne {
+	mutation := newImageSizeMutation(c.config, OpUpdateOne, withImageSize(is))
+	return &ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
-	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
-	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ImageSizeClient) UpdateOneID(id int) *ImageSizeUpdateOne {
+	mutation := newImageSizeMutation(c.config, OpUpdateOne, withImageSizeID(id))
+	return &ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Notification.
-func (c *NotificationClient) Delete() *NotificationDelete {
-	mutation := newNotificationMutation(c.config, OpDelete)
-	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ImageSize.
+func (c *ImageSizeClient) Delete() *ImageSizeDelete {
+	mutation := newImageSizeMutation(c.config, OpDelete)
+	return &ImageSizeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
-	return c.DeleteOneID(n.ID)
+func (c *ImageSizeClient) DeleteOne(is *ImageSize) *ImageSizeDeleteOne {
+	return c.DeleteOneID(is.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
-	builder := c.Delete().Where(notification.ID(id))
+func (c *ImageSizeClient) DeleteOneID(id int) *ImageSizeDeleteOne {
+	builder := c.Delete().Where(imagesize.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationDeleteOne{builder}
+	return &ImageSizeDeleteOne{builder}
 }
 
-// Query returns a query builder for Notification.
-func (c *NotificationClient) Query() *NotificationQuery {
-	return &NotificationQuery{
+// Query returns a query builder for ImageSize.
+func (c *ImageSizeClient) Query() *ImageSizeQuery {
+	return &ImageSizeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotification},
+		ctx:    &QueryContext{Type: TypeImageSize},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Notification entity by its id.
-func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
-	return c.Query().Where(notification.ID(id)).Only(ctx)
+// Get returns a ImageSize entity by its id.
+func (c *ImageSizeClient) Get(ctx context.Context, id int) (*ImageSize, error) {
+	return c.Query().Where(imagesize.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
+func (c *ImageSizeClient) GetX(ctx context.Context, id int) *ImageSize {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2255,149 +2645,164 @@ func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
 	return obj
 }
 
-// QueryProfile queries the profile edge of a Notification.
-func (c *NotificationClient) QueryProfile(n *Notification) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
+// QueryFile queries the file edge of a ImageSize.
+func (c *ImageSizeClient) QueryFile(is *ImageSize) *FileStorageQuery {
+	query := (&FileStorageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := n.ID
+		id := is.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notification.Table, notification.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notification.ProfileTable, notification.ProfileColumn),
+			sqlgraph.From(imagesize.Table, imagesize.FieldID, id),
+			sqlgraph.To(filestorage.Table, filestorage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, imagesize.FileTable, imagesize.FileColumn),
 		)
-		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryImage queries the image edge of a ImageSize.
+func (c *ImageSizeClient) QueryImage(is *ImageSize) *ImageQuery {
+	query := (&ImageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := is.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(imagesize.Table, imagesize.FieldID, id),
+			sqlgraph.To(image.Table, image.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, imagesize.ImageTable, imagesize.ImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationClient) Hooks() []Hook {
-	hooks := c.hooks.Notification
-	return append(hooks[:len(hooks):len(hooks)], notification.Hooks[:]...)
+func (c *ImageSizeClient) Hooks() []Hook {
+	return c.hooks.ImageSize
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationClient) Interceptors() []Interceptor {
-	return c.inters.Notification
+func (c *ImageSizeClient) Interceptors() []Interceptor {
+	return c.inters.ImageSize
 }
 
-func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+func (c *ImageSizeClient) mutate(ctx context.Context, m *ImageSizeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageSizeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageSizeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ImageSizeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ImageSizeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ImageSize mutation op: %q", m.Op())
 	}
 }
 
-// NotificationPermissionClient is a client for the NotificationPermission schema.
-type NotificationPermissionClient struct {
+// InvitationClient is a client for the Invitation schema.
+type InvitationClient struct {
 	config
 }
 
-// NewNotificationPermissionClient returns a client for the NotificationPermission from the given config.
-func NewNotificationPermissionClient(c config) *NotificationPermissionClient {
-	return &NotificationPermissionClient{config: c}
+// NewInvitationClient returns a client for the Invitation from the given config.
+func NewInvitationClient(c config) *InvitationClient {
+	return &InvitationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notificationpermission.Hooks(f(g(h())))`.
-func (c *NotificationPermissionClient) Use(hooks ...Hook) {
-	c.hooks.NotificationPermission = append(c.hooks.NotificationPermission, hooks...)
+// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
+func (c *InvitationClient) Use(hooks ...Hook) {
+	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notificationpermission.Intercept(f(g(h())))`.
-func (c *NotificationPermissionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NotificationPermission = append(c.inters.NotificationPermission, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
+func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
 }
 
-// Create returns a builder for creating a NotificationPermission entity.
-func (c *NotificationPermissionClient) Create() *NotificationPermissionCreate {
-	mutation := newNotificationPermissionMutation(c.config, OpCreate)
-	return &NotificationPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Invitation entity.
+func (c *InvitationClient) Create() *InvitationCreate {
+	mutation := newInvitationMutation(c.config, OpCreate)
+	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NotificationPermission entities.
-func (c *NotificationPermissionClient) CreateBulk(builders ...*NotificationPermissionCreate) *NotificationPermissionCreateBulk {
-	return &NotificationPermissionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Invitation entities.
+func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
+	return &InvitationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationPermissionClient) MapCreateBulk(slice any, setFunc func(*NotificationPermissionCreate, int)) *NotificationPermissionCreateBulk {
+func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationPermissionCreateBulk{err: fmt.Errorf("calling to NotificationPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationPermissionCreate, rv.Len())
+	builders := make([]*InvitationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationPermissionCreateBulk{config: c.config, builders: builders}
+	return &InvitationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NotificationPermission.
-func (c *NotificationPermissionClient) Update() *NotificationPermissionUpdate {
-	mutation := newNotificationPermissionMutation(c.config, OpUpdate)
-	return &NotificationPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Invitation.
+func (c *InvitationClient) Update() *InvitationUpdate {
+	mutation := newInvitationMutation(c.config, OpUpdate)
+	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationPermissionClient) UpdateOne(np *NotificationPermission) *NotificationPermissionUpdateOne {
-	mutation := newNotificationPermissionMutation(c.config, OpUpdateOne, withNotificationPermission(np))
-	return &NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationPermissionClient) UpdateOneID(id int) *NotificationPermissionUpdateOne {
-	mutation := newNotificationPermissionMutation(c.config, OpUpdateOne, withNotificationPermissionID(id))
-	return &NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvitationClient) UpdateOneID(id int) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NotificationPermission.
-func (c *NotificationPermissionClient) Delete() *NotificationPermissionDelete {
-	mutation := newNotificationPermissionMutation(c.config, OpDelete)
-	return &NotificationPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Invitation.
+func (c *InvitationClient) Delete() *InvitationDelete {
+	mutation := newInvitationMutation(c.config, OpDelete)
+	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationPermissionClient) DeleteOne(np *NotificationPermission) *NotificationPermissionDeleteOne {
-	return c.DeleteOneID(np.ID)
+func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationPermissionClient) DeleteOneID(id int) *NotificationPermissionDeleteOne {
-	builder := c.Delete().Where(notificationpermission.ID(id))
+func (c *InvitationClient) DeleteOneID(id int) *InvitationDeleteOne {
+	builder := c.Delete().Where(invitation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationPermissionDeleteOne{builder}
+	return &InvitationDeleteOne{builder}
 }
 
-// Query returns a query builder for NotificationPermission.
-func (c *NotificationPermissionClient) Query() *NotificationPermissionQuery {
-	return &NotificationPermissionQuery{
+// Query returns a query builder for Invitation.
+func (c *InvitationClient) Query() *InvitationQuery {
+	return &InvitationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotificationPermission},
+		ctx:    &QueryContext{Type: TypeInvitation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NotificationPermission entity by its id.
-func (c *NotificationPermissionClient) Get(ctx context.Context, id int) (*NotificationPermission, error) {
-	return c.Query().Where(notificationpermission.ID(id)).Only(ctx)
+// Get returns a Invitation entity by its id.
+func (c *InvitationClient) Get(ctx context.Context, id int) (*Invitation, error) {
+	return c.Query().Where(invitation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationPermissionClient) GetX(ctx context.Context, id int) *NotificationPermission {
+func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2405,148 +2810,148 @@ func (c *NotificationPermissionClient) GetX(ctx context.Context, id int) *Notifi
 	return obj
 }
 
-// QueryProfile queries the profile edge of a NotificationPermission.
-func (c *NotificationPermissionClient) QueryProfile(np *NotificationPermission) *ProfileQuery {
+// QueryInviter queries the inviter edge of a Invitation.
+func (c *InvitationClient) QueryInviter(i *Invitation) *ProfileQuery {
 	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := np.ID
+		id := i.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(notificationpermission.Table, notificationpermission.FieldID, id),
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
 			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notificationpermission.ProfileTable, notificationpermission.ProfileColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, invitation.InviterTable, invitation.InviterColumn),
 		)
-		fromV = sqlgraph.Neighbors(np.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *NotificationPermissionClient) Hooks() []Hook {
-	return c.hooks.NotificationPermission
+func (c *InvitationClient) Hooks() []Hook {
+	return c.hooks.Invitation
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationPermissionClient) Interceptors() []Interceptor {
-	return c.inters.NotificationPermission
+func (c *InvitationClient) Interceptors() []Interceptor {
+	return c.inters.Invitation
 }
 
-func (c *NotificationPermissionClient) mutate(ctx context.Context, m *NotificationPermissionMutation) (Value, error) {
+func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NotificationPermission mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
 	}
 }
 
-// NotificationTimeClient is a client for the NotificationTime schema.
-type NotificationTimeClient struct {
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
 	config
 }
 
-// NewNotificationTimeClient returns a client for the NotificationTime from the given config.
-func NewNotificationTimeClient(c config) *NotificationTimeClient {
-	return &NotificationTimeClient{config: c}
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `notificationtime.Hooks(f(g(h())))`.
-func (c *NotificationTimeClient) Use(hooks ...Hook) {
-	c.hooks.NotificationTime = append(c.hooks.NotificationTime, hooks...)
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `notificationtime.Intercept(f(g(h())))`.
-func (c *NotificationTimeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.NotificationTime = append(c.inters.NotificationTime, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
 }
 
-// Create returns a builder for creating a NotificationTime entity.
-func (c *NotificationTimeClient) Create() *NotificationTimeCreate {
-	mutation := newNotificationTimeMutation(c.config, OpCreate)
-	return &NotificationTimeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of NotificationTime entities.
-func (c *NotificationTimeClient) CreateBulk(builders ...*NotificationTimeCreate) *NotificationTimeCreateBulk {
-	return &NotificationTimeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *NotificationTimeClient) MapCreateBulk(slice any, setFunc func(*NotificationTimeCreate, int)) *NotificationTimeCreateBulk {
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &NotificationTimeCreateBulk{err: fmt.Errorf("calling to NotificationTimeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*NotificationTimeCreate, rv.Len())
+	builders := make([]*InvoiceCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &NotificationTimeCreateBulk{config: c.config, builders: builders}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for NotificationTime.
-func (c *NotificationTimeClient) Update() *NotificationTimeUpdate {
-	mutation := newNotificationTimeMutation(c.config, OpUpdate)
-	return &NotificationTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *NotificationTimeClient) UpdateOne(nt *NotificationTime) *NotificationTimeUpdateOne {
-	mutation := newNotificationTimeMutation(c.config, OpUpdateOne, withNotificationTime(nt))
-	return &NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceClient) UpdateOne(i *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(i))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *NotificationTimeClient) UpdateOneID(id int) *NotificationTimeUpdateOne {
-	mutation := newNotificationTimeMutation(c.config, OpUpdateOne, withNotificationTimeID(id))
-	return &NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceClient) UpdateOneID(id int) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for NotificationTime.
-func (c *NotificationTimeClient) Delete() *NotificationTimeDelete {
-	mutation := newNotificationTimeMutation(c.config, OpDelete)
-	return &NotificationTimeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *NotificationTimeClient) DeleteOne(nt *NotificationTime) *NotificationTimeDeleteOne {
-	return c.DeleteOneID(nt.ID)
+func (c *InvoiceClient) DeleteOne(i *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(i.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *NotificationTimeClient) DeleteOneID(id int) *NotificationTimeDeleteOne {
-	builder := c.Delete().Where(notificationtime.ID(id))
+func (c *InvoiceClient) DeleteOneID(id int) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &NotificationTimeDeleteOne{builder}
+	return &InvoiceDeleteOne{builder}
 }
 
-// Query returns a query builder for NotificationTime.
-func (c *NotificationTimeClient) Query() *NotificationTimeQuery {
-	return &NotificationTimeQuery{
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeNotificationTime},
+		ctx:    &QueryContext{Type: TypeInvoice},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a NotificationTime entity by its id.
-func (c *NotificationTimeClient) Get(ctx context.Context, id int) (*NotificationTime, error) {
-	return c.Query().Where(notificationtime.ID(id)).Only(ctx)
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id int) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *NotificationTimeClient) GetX(ctx context.Context, id int) *NotificationTime {
+func (c *InvoiceClient) GetX(ctx context.Context, id int) *Invoice {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2554,148 +2959,132 @@ func (c *NotificationTimeClient) GetX(ctx context.Context, id int) *Notification
 	return obj
 }
 
-// QueryProfile queries the profile edge of a NotificationTime.
-func (c *NotificationTimeClient) QueryProfile(nt *NotificationTime) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := nt.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(notificationtime.Table, notificationtime.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, notificationtime.ProfileTable, notificationtime.ProfileColumn),
-		)
-		fromV = sqlgraph.Neighbors(nt.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *NotificationTimeClient) Hooks() []Hook {
-	return c.hooks.NotificationTime
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
 }
 
 // Interceptors returns the client interceptors.
-func (c *NotificationTimeClient) Interceptors() []Interceptor {
-	return c.inters.NotificationTime
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
 }
 
-func (c *NotificationTimeClient) mutate(ctx context.Context, m *NotificationTimeMutation) (Value, error) {
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&NotificationTimeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&NotificationTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&NotificationTimeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown NotificationTime mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Invoice mutation op: %q", m.Op())
 	}
 }
 
-// PackagePlanClient is a client for the PackagePlan schema.
-type PackagePlanClient struct {
+// InvoiceLineClient is a client for the InvoiceLine schema.
+type InvoiceLineClient struct {
 	config
 }
 
-// NewPackagePlanClient returns a client for the PackagePlan from the given config.
-func NewPackagePlanClient(c config) *PackagePlanClient {
-	return &PackagePlanClient{config: c}
+// NewInvoiceLineClient returns a client for the InvoiceLine from the given config.
+func NewInvoiceLineClient(c config) *InvoiceLineClient {
+	return &InvoiceLineClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `packageplan.Hooks(f(g(h())))`.
-func (c *PackagePlanClient) Use(hooks ...Hook) {
-	c.hooks.PackagePlan = append(c.hooks.PackagePlan, hooks...)
+// A call to `Use(f, g, h)` equals to `invoiceline.Hooks(f(g(h())))`.
+func (c *InvoiceLineClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceLine = append(c.hooks.InvoiceLine, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `packageplan.Intercept(f(g(h())))`.
-func (c *PackagePlanClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PackagePlan = append(c.inters.PackagePlan, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `invoiceline.Intercept(f(g(h())))`.
+func (c *InvoiceLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvoiceLine = append(c.inters.InvoiceLine, interceptors...)
 }
 
-// Create returns a builder for creating a PackagePlan entity.
-func (c *PackagePlanClient) Create() *PackagePlanCreate {
-	mutation := newPackagePlanMutation(c.config, OpCreate)
-	return &PackagePlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a InvoiceLine entity.
+func (c *InvoiceLineClient) Create() *InvoiceLineCreate {
+	mutation := newInvoiceLineMutation(c.config, OpCreate)
+	return &InvoiceLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PackagePlan entities.
-func (c *PackagePlanClient) CreateBulk(builders ...*PackagePlanCreate) *PackagePlanCreateBulk {
-	return &PackagePlanCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of InvoiceLine entities.
+func (c *InvoiceLineClient) CreateBulk(builders ...*InvoiceLineCreate) *InvoiceLineCreateBulk {
+	return &InvoiceLineCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PackagePlanClient) MapCreateBulk(slice any, setFunc func(*PackagePlanCreate, int)) *PackagePlanCreateBulk {
+func (c *InvoiceLineClient) MapCreateBulk(slice any, setFunc func(*InvoiceLineCreate, int)) *InvoiceLineCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PackagePlanCreateBulk{err: fmt.Errorf("calling to PackagePlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &InvoiceLineCreateBulk{err: fmt.Errorf("calling to InvoiceLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PackagePlanCreate, rv.Len())
+	builders := make([]*InvoiceLineCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PackagePlanCreateBulk{config: c.config, builders: builders}
+	return &InvoiceLineCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PackagePlan.
-func (c *PackagePlanClient) Update() *PackagePlanUpdate {
-	mutation := newPackagePlanMutation(c.config, OpUpdate)
-	return &PackagePlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for InvoiceLine.
+func (c *InvoiceLineClient) Update() *InvoiceLineUpdate {
+	mutation := newInvoiceLineMutation(c.config, OpUpdate)
+	return &InvoiceLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PackagePlanClient) UpdateOne(pp *PackagePlan) *PackagePlanUpdateOne {
-	mutation := newPackagePlanMutation(c.config, OpUpdateOne, withPackagePlan(pp))
-	return &PackagePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceLineClient) UpdateOne(il *InvoiceLine) *InvoiceLineUpdateOne {
+	mutation := newInvoiceLineMutation(c.config, OpUpdateOne, withInvoiceLine(il))
+	return &InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PackagePlanClient) UpdateOneID(id int) *PackagePlanUpdateOne {
-	mutation := newPackagePlanMutation(c.config, OpUpdateOne, withPackagePlanID(id))
-	return &PackagePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *InvoiceLineClient) UpdateOneID(id int) *InvoiceLineUpdateOne {
+	mutation := newInvoiceLineMutation(c.config, OpUpdateOne, withInvoiceLineID(id))
+	return &InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PackagePlan.
-func (c *PackagePlanClient) Delete() *PackagePlanDelete {
-	mutation := newPackagePlanMutation(c.config, OpDelete)
-	return &PackagePlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for InvoiceLine.
+func (c *InvoiceLineClient) Delete() *InvoiceLineDelete {
+	mutation := newInvoiceLineMutation(c.config, OpDelete)
+	return &InvoiceLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PackagePlanClient) DeleteOne(pp *PackagePlan) *PackagePlanDeleteOne {
-	return c.DeleteOneID(pp.ID)
+func (c *InvoiceLineClient) DeleteOne(il *InvoiceLine) *InvoiceLineDeleteOne {
+	return c.DeleteOneID(il.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PackagePlanClient) DeleteOneID(id int) *PackagePlanDeleteOne {
-	builder := c.Delete().Where(packageplan.ID(id))
+func (c *InvoiceLineClient) DeleteOneID(id int) *InvoiceLineDeleteOne {
+	builder := c.Delete().Where(invoiceline.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PackagePlanDeleteOne{builder}
+	return &InvoiceLineDeleteOne{builder}
 }
 
-// Query returns a query builder for PackagePlan.
-func (c *PackagePlanClient) Query() *PackagePlanQuery {
-	return &PackagePlanQuery{
+// Query returns a query builder for InvoiceLine.
+func (c *InvoiceLineClient) Query() *InvoiceLineQuery {
+	return &InvoiceLineQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePackagePlan},
+		ctx:    &QueryContext{Type: TypeInvoiceLine},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PackagePlan entity by its id.
-func (c *PackagePlanClient) Get(ctx context.Context, id int) (*PackagePlan, error) {
-	return c.Query().Where(packageplan.ID(id)).Only(ctx)
+// Get returns a InvoiceLine entity by its id.
+func (c *InvoiceLineClient) Get(ctx context.Context, id int) (*InvoiceLine, error) {
+	return c.Query().Where(invoiceline.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PackagePlanClient) GetX(ctx context.Context, id int) *PackagePlan {
+func (c *InvoiceLineClient) GetX(ctx context.Context, id int) *InvoiceLine {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2704,131 +3093,131 @@ func (c *PackagePlanClient) GetX(ctx context.Context, id int) *PackagePlan {
 }
 
 // Hooks returns the client hooks.
-func (c *PackagePlanClient) Hooks() []Hook {
-	return c.hooks.PackagePlan
+func (c *InvoiceLineClient) Hooks() []Hook {
+	return c.hooks.InvoiceLine
 }
 
 // Interceptors returns the client interceptors.
-func (c *PackagePlanClient) Interceptors() []Interceptor {
-	return c.inters.PackagePlan
+func (c *InvoiceLineClient) Interceptors() []Interceptor {
+	return c.inters.InvoiceLine
 }
 
-func (c *PackagePlanClient) mutate(ctx context.Context, m *PackagePlanMutation) (Value, error) {
+func (c *InvoiceLineClient) mutate(ctx context.Context, m *InvoiceLineMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PackagePlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PackagePlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PackagePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PackagePlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InvoiceLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PackagePlan mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown InvoiceLine mutation op: %q", m.Op())
 	}
 }
 
-// PhoneVerificationCodeClient is a client for the PhoneVerificationCode schema.
-type PhoneVerificationCodeClient struct {
+// LastSeenOnlineClient is a client for the LastSeenOnline schema.
+type LastSeenOnlineClient struct {
 	config
 }
 
-// NewPhoneVerificationCodeClient returns a client for the PhoneVerificationCode from the given config.
-func NewPhoneVerificationCodeClient(c config) *PhoneVerificationCodeClient {
-	return &PhoneVerificationCodeClient{config: c}
+// NewLastSeenOnlineClient returns a client for the LastSeenOnline from the given config.
+func NewLastSeenOnlineClient(c config) *LastSeenOnlineClient {
+	return &LastSeenOnlineClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `phoneverificationcode.Hooks(f(g(h())))`.
-func (c *PhoneVerificationCodeClient) Use(hooks ...Hook) {
-	c.hooks.PhoneVerificationCode = append(c.hooks.PhoneVerificationCode, hooks...)
+// A call to `Use(f, g, h)` equals to `lastseenonline.Hooks(f(g(h())))`.
+func (c *LastSeenOnlineClient) Use(hooks ...Hook) {
+	c.hooks.LastSeenOnline = append(c.hooks.LastSeenOnline, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `phoneverificationcode.Intercept(f(g(h())))`.
-func (c *PhoneVerificationCodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PhoneVerificationCode = append(c.inters.PhoneVerificationCode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `lastseenonline.Intercept(f(g(h())))`.
+func (c *LastSeenOnlineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LastSeenOnline = append(c.inters.LastSeenOnline, interceptors...)
 }
 
-// Create returns a builder for creating a PhoneVerificationCode entity.
-func (c *PhoneVerificationCodeClient) Create() *PhoneVerificationCodeCreate {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpCreate)
-	return &PhoneVerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LastSeenOnline entity.
+func (c *LastSeenOnlineClient) Create() *LastSeenOnlineCreate {
+	mutation := newLastSeenOnlineMutation(c.config, OpCreate)
+	return &LastSeenOnlineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PhoneVerificationCode entities.
-func (c *PhoneVerificationCodeClient) CreateBulk(builders ...*PhoneVerificationCodeCreate) *PhoneVerificationCodeCreateBulk {
-	return &PhoneVerificationCodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LastSeenOnline entities.
+func (c *LastSeenOnlineClient) CreateBulk(builders ...*LastSeenOnlineCreate) *LastSeenOnlineCreateBulk {
+	return &LastSeenOnlineCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PhoneVerificationCodeClient) MapCreateBulk(slice any, setFunc func(*PhoneVerificationCodeCreate, int)) *PhoneVerificationCodeCreateBulk {
+func (c *LastSeenOnlineClient) MapCreateBulk(slice any, setFunc func(*LastSeenOnlineCreate, int)) *LastSeenOnlineCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PhoneVerificationCodeCreateBulk{err: fmt.Errorf("calling to PhoneVerificationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LastSeenOnlineCreateBulk{err: fmt.Errorf("calling to LastSeenOnlineClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PhoneVerificationCodeCreate, rv.Len())
+	builders := make([]*LastSeenOnlineCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PhoneVerificationCodeCreateBulk{config: c.config, builders: builders}
+	return &LastSeenOnlineCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PhoneVerificationCode.
-func (c *PhoneVerificationCodeClient) Update() *PhoneVerificationCodeUpdate {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdate)
-	return &PhoneVerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LastSeenOnline.
+func (c *LastSeenOnlineClient) Update() *LastSeenOnlineUpdate {
+	mutation := newLastSeenOnlineMutation(c.config, OpUpdate)
+	return &LastSeenOnlineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PhoneVerificationCodeClient) UpdateOne(pvc *PhoneVerificationCode) *PhoneVerificationCodeUpdateOne {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdateOne, withPhoneVerificationCode(pvc))
-	return &PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LastSeenOnlineClient) UpdateOne(lso *LastSeenOnline) *LastSeenOnlineUpdateOne {
+	mutation := newLastSeenOnlineMutation(c.config, OpUpdateOne, withLastSeenOnline(lso))
+	return &LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PhoneVerificationCodeClient) UpdateOneID(id int) *PhoneVerificationCodeUpdateOne {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdateOne, withPhoneVerificationCodeID(id))
-	return &PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LastSeenOnlineClient) UpdateOneID(id int) *LastSeenOnlineUpdateOne {
+	mutation := newLastSeenOnlineMutation(c.config, OpUpdateOne, withLastSeenOnlineID(id))
+	return &LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PhoneVerificationCode.
-func (c *PhoneVerificationCodeClient) Delete() *PhoneVerificationCodeDelete {
-	mutation := newPhoneVerificationCodeMutation(c.config, OpDelete)
-	return &PhoneVerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LastSeenOnline.
+func (c *LastSeenOnlineClient) Delete() *LastSeenOnlineDelete {
+	mutation := newLastSeenOnlineMutation(c.config, OpDelete)
+	return &LastSeenOnlineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PhoneVerificationCodeClient) DeleteOne(pvc *PhoneVerificationCode) *PhoneVerificationCodeDeleteOne {
-	return c.DeleteOneID(pvc.ID)
+func (c *LastSeenOnlineClient) DeleteOne(lso *LastSeenOnline) *LastSeenOnlineDeleteOne {
+	return c.DeleteOneID(lso.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PhoneVerificationCodeClient) DeleteOneID(id int) *PhoneVerificationCodeDeleteOne {
-	builder := c.Delete().Where(phoneverificationcode.ID(id))
+func (c *LastSeenOnlineClient) DeleteOneID(id int) *LastSeenOnlineDeleteOne {
+	builder := c.Delete().Where(lastseenonline.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PhoneVerificationCodeDeleteOne{builder}
+	return &LastSeenOnlineDeleteOne{builder}
 }
 
-// Query returns a query builder for PhoneVerificationCode.
-func (c *PhoneVerificationCodeClient) Query() *PhoneVerificationCodeQuery {
-	return &PhoneVerificationCodeQuery{
+// Query returns a query builder for LastSeenOnline.
+func (c *LastSeenOnlineClient) Query() *LastSeenOnlineQuery {
+	return &LastSeenOnlineQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePhoneVerificationCode},
+		ctx:    &QueryContext{Type: TypeLastSeenOnline},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PhoneVerificationCode entity by its id.
-func (c *PhoneVerificationCodeClient) Get(ctx context.Context, id int) (*PhoneVerificationCode, error) {
-	return c.Query().Where(phoneverificationcode.ID(id)).Only(ctx)
+// Get returns a LastSeenOnline entity by its id.
+func (c *LastSeenOnlineClient) Get(ctx context.Context, id int) (*LastSeenOnline, error) {
+	return c.Query().Where(lastseenonline.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PhoneVerificationCodeClient) GetX(ctx context.Context, id int) *PhoneVerificationCode {
+func (c *LastSeenOnlineClient) GetX(ctx context.Context, id int) *LastSeenOnline {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2836,148 +3225,148 @@ func (c *PhoneVerificationCodeClient) GetX(ctx context.Context, id int) *PhoneVe
 	return obj
 }
 
-// QueryProfile queries the profile edge of a PhoneVerificationCode.
-func (c *PhoneVerificationCodeClient) QueryProfile(pvc *PhoneVerificationCode) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pvc.ID
+// QueryUser queries the user edge of a LastSeenOnline.
+func (c *LastSeenOnlineClient) QueryUser(lso *LastSeenOnline) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := lso.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(phoneverificationcode.Table, phoneverificationcode.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, phoneverificationcode.ProfileTable, phoneverificationcode.ProfileColumn),
+			sqlgraph.From(lastseenonline.Table, lastseenonline.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lastseenonline.UserTable, lastseenonline.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(pvc.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(lso.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PhoneVerificationCodeClient) Hooks() []Hook {
-	return c.hooks.PhoneVerificationCode
+func (c *LastSeenOnlineClient) Hooks() []Hook {
+	return c.hooks.LastSeenOnline
 }
 
 // Interceptors returns the client interceptors.
-func (c *PhoneVerificationCodeClient) Interceptors() []Interceptor {
-	return c.inters.PhoneVerificationCode
+func (c *LastSeenOnlineClient) Interceptors() []Interceptor {
+	return c.inters.LastSeenOnline
 }
 
-func (c *PhoneVerificationCodeClient) mutate(ctx context.Context, m *PhoneVerificationCodeMutation) (Value, error) {
+func (c *LastSeenOnlineClient) mutate(ctx context.Context, m *LastSeenOnlineMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PhoneVerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LastSeenOnlineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PhoneVerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LastSeenOnlineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LastSeenOnlineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PhoneVerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LastSeenOnlineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PhoneVerificationCode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LastSeenOnline mutation op: %q", m.Op())
 	}
 }
 
-// ProfileClient is a client for the Profile schema.
-type ProfileClient struct {
+// ManualPaymentClient is a client for the ManualPayment schema.
+type ManualPaymentClient struct {
 	config
 }
 
-// NewProfileClient returns a client for the Profile from the given config.
-func NewProfileClient(c config) *ProfileClient {
-	return &ProfileClient{config: c}
+// NewManualPaymentClient returns a client for the ManualPayment from the given config.
+func NewManualPaymentClient(c config) *ManualPaymentClient {
+	return &ManualPaymentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
-func (c *ProfileClient) Use(hooks ...Hook) {
-	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+// A call to `Use(f, g, h)` equals to `manualpayment.Hooks(f(g(h())))`.
+func (c *ManualPaymentClient) Use(hooks ...Hook) {
+	c.hooks.ManualPayment = append(c.hooks.ManualPayment, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
-func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Profile = append(c.inters.Profile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `manualpayment.Intercept(f(g(h())))`.
+func (c *ManualPaymentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ManualPayment = append(c.inters.ManualPayment, interceptors...)
 }
 
-// Create returns a builder for creating a Profile entity.
-func (c *ProfileClient) Create() *ProfileCreate {
-	mutation := newProfileMutation(c.config, OpCreate)
-	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ManualPayment entity.
+func (c *ManualPaymentClient) Create() *ManualPaymentCreate {
+	mutation := newManualPaymentMutation(c.config, OpCreate)
+	return &ManualPaymentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Profile entities.
-func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ManualPayment entities.
+func (c *ManualPaymentClient) CreateBulk(builders ...*ManualPaymentCreate) *ManualPaymentCreateBulk {
+	return &ManualPaymentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+func (c *ManualPaymentClient) MapCreateBulk(slice any, setFunc func(*ManualPaymentCreate, int)) *ManualPaymentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ManualPaymentCreateBulk{err: fmt.Errorf("calling to ManualPaymentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProfileCreate, rv.Len())
+	builders := make([]*ManualPaymentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProfileCreateBulk{config: c.config, builders: builders}
+	return &ManualPaymentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Profile.
-func (c *ProfileClient) Update() *ProfileUpdate {
-	mutation := newProfileMutation(c.config, OpUpdate)
-	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ManualPayment.
+func (c *ManualPaymentClient) Update() *ManualPaymentUpdate {
+	mutation := newManualPaymentMutation(c.config, OpUpdate)
+	return &ManualPaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProfileClient) UpdateOne(pr *Profile) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(pr))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ManualPaymentClient) UpdateOne(mp *ManualPayment) *ManualPaymentUpdateOne {
+	mutation := newManualPaymentMutation(c.config, OpUpdateOne, withManualPayment(mp))
+	return &ManualPaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProfileClient) UpdateOneID(id int) *ProfileUpdateOne {
-	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
-	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ManualPaymentClient) UpdateOneID(id int) *ManualPaymentUpdateOne {
+	mutation := newManualPaymentMutation(c.config, OpUpdateOne, withManualPaymentID(id))
+	return &ManualPaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Profile.
-func (c *ProfileClient) Delete() *ProfileDelete {
-	mutation := newProfileMutation(c.config, OpDelete)
-	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ManualPayment.
+func (c *ManualPaymentClient) Delete() *ManualPaymentDelete {
+	mutation := newManualPaymentMutation(c.config, OpDelete)
+	return &ManualPaymentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProfileClient) DeleteOne(pr *Profile) *ProfileDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *ManualPaymentClient) DeleteOne(mp *ManualPayment) *ManualPaymentDeleteOne {
+	return c.DeleteOneID(mp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProfileClient) DeleteOneID(id int) *ProfileDeleteOne {
-	builder := c.Delete().Where(profile.ID(id))
+func (c *ManualPaymentClient) DeleteOneID(id int) *ManualPaymentDeleteOne {
+	builder := c.Delete().Where(manualpayment.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProfileDeleteOne{builder}
+	return &ManualPaymentDeleteOne{builder}
 }
 
-// Query returns a query builder for Profile.
-func (c *ProfileClient) Query() *ProfileQuery {
-	return &ProfileQuery{
+// Query returns a query builder for ManualPayment.
+func (c *ManualPaymentClient) Query() *ManualPaymentQuery {
+	return &ManualPaymentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProfile},
+		ctx:    &QueryContext{Type: TypeManualPayment},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Profile entity by its id.
-func (c *ProfileClient) Get(ctx context.Context, id int) (*Profile, error) {
-	return c.Query().Where(profile.ID(id)).Only(ctx)
+// Get returns a ManualPayment entity by its id.
+func (c *ManualPaymentClient) Get(ctx context.Context, id int) (*ManualPayment, error) {
+	return c.Query().Where(manualpayment.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProfileClient) GetX(ctx context.Context, id int) *Profile {
+func (c *ManualPaymentClient) GetX(ctx context.Context, id int) *ManualPayment {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2985,340 +3374,3561 @@ func (c *ProfileClient) GetX(ctx context.Context, id int) *Profile {
 	return obj
 }
 
-// QueryFriends queries the friends edge of a Profile.
-func (c *ProfileClient) QueryFriends(pr *Profile) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, profile.FriendsTable, profile.FriendsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *ManualPaymentClient) Hooks() []Hook {
+	return c.hooks.ManualPayment
 }
 
-// QueryPhotos queries the photos edge of a Profile.
-func (c *ProfileClient) QueryPhotos(pr *Profile) *ImageQuery {
-	query := (&ImageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(image.Table, image.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.PhotosTable, profile.PhotosColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Interceptors returns the client interceptors.
+func (c *ManualPaymentClient) Interceptors() []Interceptor {
+	return c.inters.ManualPayment
 }
 
-// QueryProfileImage queries the profile_image edge of a Profile.
-func (c *ProfileClient) QueryProfileImage(pr *Profile) *ImageQuery {
-	query := (&ImageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(image.Table, image.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, profile.ProfileImageTable, profile.ProfileImageColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+func (c *ManualPaymentClient) mutate(ctx context.Context, m *ManualPaymentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ManualPaymentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ManualPaymentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ManualPaymentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ManualPaymentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ManualPayment mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryNotifications queries the notifications edge of a Profile.
-func (c *ProfileClient) QueryNotifications(pr *Profile) *NotificationQuery {
-	query := (&NotificationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(notification.Table, notification.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationsTable, profile.NotificationsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// MonthlySubscriptionClient is a client for the MonthlySubscription schema.
+type MonthlySubscriptionClient struct {
+	config
 }
 
-// QueryInvitations queries the invitations edge of a Profile.
-func (c *ProfileClient) QueryInvitations(pr *Profile) *InvitationQuery {
-	query := (&InvitationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(invitation.Table, invitation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.InvitationsTable, profile.InvitationsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// NewMonthlySubscriptionClient returns a client for the MonthlySubscription from the given config.
+func NewMonthlySubscriptionClient(c config) *MonthlySubscriptionClient {
+	return &MonthlySubscriptionClient{config: c}
 }
 
-// QueryFcmPushSubscriptions queries the fcm_push_subscriptions edge of a Profile.
-func (c *ProfileClient) QueryFcmPushSubscriptions(pr *Profile) *FCMSubscriptionsQuery {
-	query := (&FCMSubscriptionsClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(fcmsubscriptions.Table, fcmsubscriptions.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.FcmPushSubscriptionsTable, profile.FcmPushSubscriptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `monthlysubscription.Hooks(f(g(h())))`.
+func (c *MonthlySubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.MonthlySubscription = append(c.hooks.MonthlySubscription, hooks...)
 }
 
-// QueryPwaPushSubscriptions queries the pwa_push_subscriptions edge of a Profile.
-func (c *ProfileClient) QueryPwaPushSubscriptions(pr *Profile) *PwaPushSubscriptionQuery {
-	query := (&PwaPushSubscriptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(pwapushsubscription.Table, pwapushsubscription.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.PwaPushSubscriptionsTable, profile.PwaPushSubscriptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `monthlysubscription.Intercept(f(g(h())))`.
+func (c *MonthlySubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MonthlySubscription = append(c.inters.MonthlySubscription, interceptors...)
 }
 
-// QueryNotificationPermissions queries the notification_permissions edge of a Profile.
-func (c *ProfileClient) QueryNotificationPermissions(pr *Profile) *NotificationPermissionQuery {
-	query := (&NotificationPermissionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(notificationpermission.Table, notificationpermission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationPermissionsTable, profile.NotificationPermissionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Create returns a builder for creating a MonthlySubscription entity.
+func (c *MonthlySubscriptionClient) Create() *MonthlySubscriptionCreate {
+	mutation := newMonthlySubscriptionMutation(c.config, OpCreate)
+	return &MonthlySubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryNotificationTimes queries the notification_times edge of a Profile.
-func (c *ProfileClient) QueryNotificationTimes(pr *Profile) *NotificationTimeQuery {
-	query := (&NotificationTimeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(notificationtime.Table, notificationtime.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationTimesTable, profile.NotificationTimesColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// CreateBulk returns a builder for creating a bulk of MonthlySubscription entities.
+func (c *MonthlySubscriptionClient) CreateBulk(builders ...*MonthlySubscriptionCreate) *MonthlySubscriptionCreateBulk {
+	return &MonthlySubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryPhoneVerificationCode queries the phone_verification_code edge of a Profile.
-func (c *ProfileClient) QueryPhoneVerificationCode(pr *Profile) *PhoneVerificationCodeQuery {
-	query := (&PhoneVerificationCodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(phoneverificationcode.Table, phoneverificationcode.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.PhoneVerificationCodeTable, profile.PhoneVerificationCodeColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MonthlySubscriptionClient) MapCreateBulk(slice any, setFunc func(*MonthlySubscriptionCreate, int)) *MonthlySubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MonthlySubscriptionCreateBulk{err: fmt.Errorf("calling to MonthlySubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*MonthlySubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MonthlySubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MonthlySubscription.
+func (c *MonthlySubscriptionClient) Update() *MonthlySubscriptionUpdate {
+	mutation := newMonthlySubscriptionMutation(c.config, OpUpdate)
+	return &MonthlySubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MonthlySubscriptionClient) UpdateOne(ms *MonthlySubscription) *MonthlySubscriptionUpdateOne {
+	mutation := newMonthlySubscriptionMutation(c.config, OpUpdateOne, withMonthlySubscription(ms))
+	return &MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MonthlySubscriptionClient) UpdateOneID(id int) *MonthlySubscriptionUpdateOne {
+	mutation := newMonthlySubscriptionMutation(c.config, OpUpdateOne, withMonthlySubscriptionID(id))
+	return &MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MonthlySubscription.
+func (c *MonthlySubscriptionClient) Delete() *MonthlySubscriptionDelete {
+	mutation := newMonthlySubscriptionMutation(c.config, OpDelete)
+	return &MonthlySubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MonthlySubscriptionClient) DeleteOne(ms *MonthlySubscription) *MonthlySubscriptionDeleteOne {
+	return c.DeleteOneID(ms.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MonthlySubscriptionClient) DeleteOneID(id int) *MonthlySubscriptionDeleteOne {
+	builder := c.Delete().Where(monthlysubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MonthlySubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for MonthlySubscription.
+func (c *MonthlySubscriptionClient) Query() *MonthlySubscriptionQuery {
+	return &MonthlySubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMonthlySubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MonthlySubscription entity by its id.
+func (c *MonthlySubscriptionClient) Get(ctx context.Context, id int) (*MonthlySubscription, error) {
+	return c.Query().Where(monthlysubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MonthlySubscriptionClient) GetX(ctx context.Context, id int) *MonthlySubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBenefactors queries the benefactors edge of a MonthlySubscription.
+func (c *MonthlySubscriptionClient) QueryBenefactors(ms *MonthlySubscription) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ms.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, monthlysubscription.BenefactorsTable, monthlysubscription.BenefactorsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPayer queries the payer edge of a MonthlySubscription.
+func (c *MonthlySubscriptionClient) QueryPayer(ms *MonthlySubscription) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ms.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monthlysubscription.Table, monthlysubscription.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, monthlysubscription.PayerTable, monthlysubscription.PayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MonthlySubscriptionClient) Hooks() []Hook {
+	return c.hooks.MonthlySubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *MonthlySubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.MonthlySubscription
+}
+
+func (c *MonthlySubscriptionClient) mutate(ctx context.Context, m *MonthlySubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MonthlySubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MonthlySubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MonthlySubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MonthlySubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MonthlySubscription mutation op: %q", m.Op())
+	}
+}
+
+// NotificationClient is a client for the Notification schema.
+type NotificationClient struct {
+	config
+}
+
+// NewNotificationClient returns a client for the Notification from the given config.
+func NewNotificationClient(c config) *NotificationClient {
+	return &NotificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notification.Hooks(f(g(h())))`.
+func (c *NotificationClient) Use(hooks ...Hook) {
+	c.hooks.Notification = append(c.hooks.Notification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notification.Intercept(f(g(h())))`.
+func (c *NotificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Notification = append(c.inters.Notification, interceptors...)
+}
+
+// Create returns a builder for creating a Notification entity.
+func (c *NotificationClient) Create() *NotificationCreate {
+	mutation := newNotificationMutation(c.config, OpCreate)
+	return &NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Notification entities.
+func (c *NotificationClient) CreateBulk(builders ...*NotificationCreate) *NotificationCreateBulk {
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationClient) MapCreateBulk(slice any, setFunc func(*NotificationCreate, int)) *NotificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationCreateBulk{err: fmt.Errorf("calling to NotificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Notification.
+func (c *NotificationClient) Update() *NotificationUpdate {
+	mutation := newNotificationMutation(c.config, OpUpdate)
+	return &NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationClient) UpdateOne(n *Notification) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotification(n))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationClient) UpdateOneID(id int) *NotificationUpdateOne {
+	mutation := newNotificationMutation(c.config, OpUpdateOne, withNotificationID(id))
+	return &NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Notification.
+func (c *NotificationClient) Delete() *NotificationDelete {
+	mutation := newNotificationMutation(c.config, OpDelete)
+	return &NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationClient) DeleteOne(n *Notification) *NotificationDeleteOne {
+	return c.DeleteOneID(n.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationClient) DeleteOneID(id int) *NotificationDeleteOne {
+	builder := c.Delete().Where(notification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeleteOne{builder}
+}
+
+// Query returns a query builder for Notification.
+func (c *NotificationClient) Query() *NotificationQuery {
+	return &NotificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Notification entity by its id.
+func (c *NotificationClient) Get(ctx context.Context, id int) (*Notification, error) {
+	return c.Query().Where(notification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationClient) GetX(ctx context.Context, id int) *Notification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a Notification.
+func (c *NotificationClient) QueryProfile(n *Notification) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := n.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notification.Table, notification.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notification.ProfileTable, notification.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(n.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationClient) Hooks() []Hook {
+	hooks := c.hooks.Notification
+	return append(hooks[:len(hooks):len(hooks)], notification.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationClient) Interceptors() []Interceptor {
+	return c.inters.Notification
+}
+
+func (c *NotificationClient) mutate(ctx context.Context, m *NotificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Notification mutation op: %q", m.Op())
+	}
+}
+
+// NotificationPermissionClient is a client for the NotificationPermission schema.
+type NotificationPermissionClient struct {
+	config
+}
+
+// NewNotificationPermissionClient returns a client for the NotificationPermission from the given config.
+func NewNotificationPermissionClient(c config) *NotificationPermissionClient {
+	return &NotificationPermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationpermission.Hooks(f(g(h())))`.
+func (c *NotificationPermissionClient) Use(hooks ...Hook) {
+	c.hooks.NotificationPermission = append(c.hooks.NotificationPermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationpermission.Intercept(f(g(h())))`.
+func (c *NotificationPermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationPermission = append(c.inters.NotificationPermission, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationPermission entity.
+func (c *NotificationPermissionClient) Create() *NotificationPermissionCreate {
+	mutation := newNotificationPermissionMutation(c.config, OpCreate)
+	return &NotificationPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationPermission entities.
+func (c *NotificationPermissionClient) CreateBulk(builders ...*NotificationPermissionCreate) *NotificationPermissionCreateBulk {
+	return &NotificationPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationPermissionClient) MapCreateBulk(slice any, setFunc func(*NotificationPermissionCreate, int)) *NotificationPermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationPermissionCreateBulk{err: fmt.Errorf("calling to NotificationPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationPermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationPermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationPermission.
+func (c *NotificationPermissionClient) Update() *NotificationPermissionUpdate {
+	mutation := newNotificationPermissionMutation(c.config, OpUpdate)
+	return &NotificationPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationPermissionClient) UpdateOne(np *NotificationPermission) *NotificationPermissionUpdateOne {
+	mutation := newNotificationPermissionMutation(c.config, OpUpdateOne, withNotificationPermission(np))
+	return &NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationPermissionClient) UpdateOneID(id int) *NotificationPermissionUpdateOne {
+	mutation := newNotificationPermissionMutation(c.config, OpUpdateOne, withNotificationPermissionID(id))
+	return &NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationPermission.
+func (c *NotificationPermissionClient) Delete() *NotificationPermissionDelete {
+	mutation := newNotificationPermissionMutation(c.config, OpDelete)
+	return &NotificationPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationPermissionClient) DeleteOne(np *NotificationPermission) *NotificationPermissionDeleteOne {
+	return c.DeleteOneID(np.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationPermissionClient) DeleteOneID(id int) *NotificationPermissionDeleteOne {
+	builder := c.Delete().Where(notificationpermission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationPermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationPermission.
+func (c *NotificationPermissionClient) Query() *NotificationPermissionQuery {
+	return &NotificationPermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationPermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationPermission entity by its id.
+func (c *NotificationPermissionClient) Get(ctx context.Context, id int) (*NotificationPermission, error) {
+	return c.Query().Where(notificationpermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationPermissionClient) GetX(ctx context.Context, id int) *NotificationPermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a NotificationPermission.
+func (c *NotificationPermissionClient) QueryProfile(np *NotificationPermission) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := np.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationpermission.Table, notificationpermission.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationpermission.ProfileTable, notificationpermission.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(np.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationPermissionClient) Hooks() []Hook {
+	return c.hooks.NotificationPermission
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationPermissionClient) Interceptors() []Interceptor {
+	return c.inters.NotificationPermission
+}
+
+func (c *NotificationPermissionClient) mutate(ctx context.Context, m *NotificationPermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationPermission mutation op: %q", m.Op())
+	}
+}
+
+// NotificationTimeClient is a client for the NotificationTime schema.
+type NotificationTimeClient struct {
+	config
+}
+
+// NewNotificationTimeClient returns a client for the NotificationTime from the given config.
+func NewNotificationTimeClient(c config) *NotificationTimeClient {
+	return &NotificationTimeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationtime.Hooks(f(g(h())))`.
+func (c *NotificationTimeClient) Use(hooks ...Hook) {
+	c.hooks.NotificationTime = append(c.hooks.NotificationTime, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationtime.Intercept(f(g(h())))`.
+func (c *NotificationTimeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationTime = append(c.inters.NotificationTime, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationTime entity.
+func (c *NotificationTimeClient) Create() *NotificationTimeCreate {
+	mutation := newNotificationTimeMutation(c.config, OpCreate)
+	return &NotificationTimeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationTime entities.
+func (c *NotificationTimeClient) CreateBulk(builders ...*NotificationTimeCreate) *NotificationTimeCreateBulk {
+	return &NotificationTimeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationTimeClient) MapCreateBulk(slice any, setFunc func(*NotificationTimeCreate, int)) *NotificationTimeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationTimeCreateBulk{err: fmt.Errorf("calling to NotificationTimeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationTimeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationTimeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationTime.
+func (c *NotificationTimeClient) Update() *NotificationTimeUpdate {
+	mutation := newNotificationTimeMutation(c.config, OpUpdate)
+	return &NotificationTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationTimeClient) UpdateOne(nt *NotificationTime) *NotificationTimeUpdateOne {
+	mutation := newNotificationTimeMutation(c.config, OpUpdateOne, withNotificationTime(nt))
+	return &NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationTimeClient) UpdateOneID(id int) *NotificationTimeUpdateOne {
+	mutation := newNotificationTimeMutation(c.config, OpUpdateOne, withNotificationTimeID(id))
+	return &NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationTime.
+func (c *NotificationTimeClient) Delete() *NotificationTimeDelete {
+	mutation := newNotificationTimeMutation(c.config, OpDelete)
+	return &NotificationTimeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationTimeClient) DeleteOne(nt *NotificationTime) *NotificationTimeDeleteOne {
+	return c.DeleteOneID(nt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationTimeClient) DeleteOneID(id int) *NotificationTimeDeleteOne {
+	builder := c.Delete().Where(notificationtime.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationTimeDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationTime.
+func (c *NotificationTimeClient) Query() *NotificationTimeQuery {
+	return &NotificationTimeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationTime},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationTime entity by its id.
+func (c *NotificationTimeClient) Get(ctx context.Context, id int) (*NotificationTime, error) {
+	return c.Query().Where(notificationtime.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationTimeClient) GetX(ctx context.Context, id int) *NotificationTime {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a NotificationTime.
+func (c *NotificationTimeClient) QueryProfile(nt *NotificationTime) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := nt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationtime.Table, notificationtime.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationtime.ProfileTable, notificationtime.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(nt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationTimeClient) Hooks() []Hook {
+	return c.hooks.NotificationTime
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationTimeClient) Interceptors() []Interceptor {
+	return c.inters.NotificationTime
+}
+
+func (c *NotificationTimeClient) mutate(ctx context.Context, m *NotificationTimeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationTimeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationTimeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationTime mutation op: %q", m.Op())
+	}
+}
+
+// PackagePlanClient is a client for the PackagePlan schema.
+type PackagePlanClient struct {
+	config
+}
+
+// NewPackagePlanClient returns a client for the PackagePlan from the given config.
+func NewPackagePlanClient(c config) *PackagePlanClient {
+	return &PackagePlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `packageplan.Hooks(f(g(h())))`.
+func (c *PackagePlanClient) Use(hooks ...Hook) {
+	c.hooks.PackagePlan = append(c.hooks.PackagePlan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `packageplan.Intercept(f(g(h())))`.
+func (c *PackagePlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PackagePlan = append(c.inters.PackagePlan, interceptors...)
+}
+
+// Create returns a builder for creating a PackagePlan entity.
+func (c *PackagePlanClient) Create() *PackagePlanCreate {
+	mutation := newPackagePlanMutation(c.config, OpCreate)
+	return &PackagePlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PackagePlan entities.
+func (c *PackagePlanClient) CreateBulk(builders ...*PackagePlanCreate) *PackagePlanCreateBulk {
+	return &PackagePlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PackagePlanClient) MapCreateBulk(slice any, setFunc func(*PackagePlanCreate, int)) *PackagePlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PackagePlanCreateBulk{err: fmt.Errorf("calling to PackagePlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PackagePlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PackagePlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PackagePlan.
+func (c *PackagePlanClient) Update() *PackagePlanUpdate {
+	mutation := newPackagePlanMutation(c.config, OpUpdate)
+	return &PackagePlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PackagePlanClient) UpdateOne(pp *PackagePlan) *PackagePlanUpdateOne {
+	mutation := newPackagePlanMutation(c.config, OpUpdateOne, withPackagePlan(pp))
+	return &PackagePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PackagePlanClient) UpdateOneID(id int) *PackagePlanUpdateOne {
+	mutation := newPackagePlanMutation(c.config, OpUpdateOne, withPackagePlanID(id))
+	return &PackagePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PackagePlan.
+func (c *PackagePlanClient) Delete() *PackagePlanDelete {
+	mutation := newPackagePlanMutation(c.config, OpDelete)
+	return &PackagePlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PackagePlanClient) DeleteOne(pp *PackagePlan) *PackagePlanDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PackagePlanClient) DeleteOneID(id int) *PackagePlanDeleteOne {
+	builder := c.Delete().Where(packageplan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PackagePlanDeleteOne{builder}
+}
+
+// Query returns a query builder for PackagePlan.
+func (c *PackagePlanClient) Query() *PackagePlanQuery {
+	return &PackagePlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePackagePlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PackagePlan entity by its id.
+func (c *PackagePlanClient) Get(ctx context.Context, id int) (*PackagePlan, error) {
+	return c.Query().Where(packageplan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PackagePlanClient) GetX(ctx context.Context, id int) *PackagePlan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PackagePlanClient) Hooks() []Hook {
+	return c.hooks.PackagePlan
+}
+
+// Interceptors returns the client interceptors.
+func (c *PackagePlanClient) Interceptors() []Interceptor {
+	return c.inters.PackagePlan
+}
+
+func (c *PackagePlanClient) mutate(ctx context.Context, m *PackagePlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PackagePlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PackagePlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PackagePlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PackagePlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PackagePlan mutation op: %q", m.Op())
+	}
+}
+
+// PayRequestClient is a client for the PayRequest schema.
+type PayRequestClient struct {
+	config
+}
+
+// NewPayRequestClient returns a client for the PayRequest from the given config.
+func NewPayRequestClient(c config) *PayRequestClient {
+	return &PayRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `payrequest.Hooks(f(g(h())))`.
+func (c *PayRequestClient) Use(hooks ...Hook) {
+	c.hooks.PayRequest = append(c.hooks.PayRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `payrequest.Intercept(f(g(h())))`.
+func (c *PayRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PayRequest = append(c.inters.PayRequest, interceptors...)
+}
+
+// Create returns a builder for creating a PayRequest entity.
+func (c *PayRequestClient) Create() *PayRequestCreate {
+	mutation := newPayRequestMutation(c.config, OpCreate)
+	return &PayRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PayRequest entities.
+func (c *PayRequestClient) CreateBulk(builders ...*PayRequestCreate) *PayRequestCreateBulk {
+	return &PayRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PayRequestClient) MapCreateBulk(slice any, setFunc func(*PayRequestCreate, int)) *PayRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PayRequestCreateBulk{err: fmt.Errorf("calling to PayRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PayRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PayRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PayRequest.
+func (c *PayRequestClient) Update() *PayRequestUpdate {
+	mutation := newPayRequestMutation(c.config, OpUpdate)
+	return &PayRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PayRequestClient) UpdateOne(pr *PayRequest) *PayRequestUpdateOne {
+	mutation := newPayRequestMutation(c.config, OpUpdateOne, withPayRequest(pr))
+	return &PayRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PayRequestClient) UpdateOneID(id int) *PayRequestUpdateOne {
+	mutation := newPayRequestMutation(c.config, OpUpdateOne, withPayRequestID(id))
+	return &PayRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PayRequest.
+func (c *PayRequestClient) Delete() *PayRequestDelete {
+	mutation := newPayRequestMutation(c.config, OpDelete)
+	return &PayRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PayRequestClient) DeleteOne(pr *PayRequest) *PayRequestDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PayRequestClient) DeleteOneID(id int) *PayRequestDeleteOne {
+	builder := c.Delete().Where(payrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PayRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for PayRequest.
+func (c *PayRequestClient) Query() *PayRequestQuery {
+	return &PayRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePayRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PayRequest entity by its id.
+func (c *PayRequestClient) Get(ctx context.Context, id int) (*PayRequest, error) {
+	return c.Query().Where(payrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PayRequestClient) GetX(ctx context.Context, id int) *PayRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PayRequestClient) Hooks() []Hook {
+	return c.hooks.PayRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *PayRequestClient) Interceptors() []Interceptor {
+	return c.inters.PayRequest
+}
+
+func (c *PayRequestClient) mutate(ctx context.Context, m *PayRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PayRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PayRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PayRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PayRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PayRequest mutation op: %q", m.Op())
+	}
+}
+
+// PhoneVerificationCodeClient is a client for the PhoneVerificationCode schema.
+type PhoneVerificationCodeClient struct {
+	config
+}
+
+// NewPhoneVerificationCodeClient returns a client for the PhoneVerificationCode from the given config.
+func NewPhoneVerificationCodeClient(c config) *PhoneVerificationCodeClient {
+	return &PhoneVerificationCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `phoneverificationcode.Hooks(f(g(h())))`.
+func (c *PhoneVerificationCodeClient) Use(hooks ...Hook) {
+	c.hooks.PhoneVerificationCode = append(c.hooks.PhoneVerificationCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `phoneverificationcode.Intercept(f(g(h())))`.
+func (c *PhoneVerificationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PhoneVerificationCode = append(c.inters.PhoneVerificationCode, interceptors...)
+}
+
+// Create returns a builder for creating a PhoneVerificationCode entity.
+func (c *PhoneVerificationCodeClient) Create() *PhoneVerificationCodeCreate {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpCreate)
+	return &PhoneVerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PhoneVerificationCode entities.
+func (c *PhoneVerificationCodeClient) CreateBulk(builders ...*PhoneVerificationCodeCreate) *PhoneVerificationCodeCreateBulk {
+	return &PhoneVerificationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PhoneVerificationCodeClient) MapCreateBulk(slice any, setFunc func(*PhoneVerificationCodeCreate, int)) *PhoneVerificationCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PhoneVerificationCodeCreateBulk{err: fmt.Errorf("calling to PhoneVerificationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PhoneVerificationCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PhoneVerificationCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PhoneVerificationCode.
+func (c *PhoneVerificationCodeClient) Update() *PhoneVerificationCodeUpdate {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdate)
+	return &PhoneVerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PhoneVerificationCodeClient) UpdateOne(pvc *PhoneVerificationCode) *PhoneVerificationCodeUpdateOne {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdateOne, withPhoneVerificationCode(pvc))
+	return &PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PhoneVerificationCodeClient) UpdateOneID(id int) *PhoneVerificationCodeUpdateOne {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpUpdateOne, withPhoneVerificationCodeID(id))
+	return &PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PhoneVerificationCode.
+func (c *PhoneVerificationCodeClient) Delete() *PhoneVerificationCodeDelete {
+	mutation := newPhoneVerificationCodeMutation(c.config, OpDelete)
+	return &PhoneVerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PhoneVerificationCodeClient) DeleteOne(pvc *PhoneVerificationCode) *PhoneVerificationCodeDeleteOne {
+	return c.DeleteOneID(pvc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PhoneVerificationCodeClient) DeleteOneID(id int) *PhoneVerificationCodeDeleteOne {
+	builder := c.Delete().Where(phoneverificationcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PhoneVerificationCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for PhoneVerificationCode.
+func (c *PhoneVerificationCodeClient) Query() *PhoneVerificationCodeQuery {
+	return &PhoneVerificationCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePhoneVerificationCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PhoneVerificationCode entity by its id.
+func (c *PhoneVerificationCodeClient) Get(ctx context.Context, id int) (*PhoneVerificationCode, error) {
+	return c.Query().Where(phoneverificationcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PhoneVerificationCodeClient) GetX(ctx context.Context, id int) *PhoneVerificationCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a PhoneVerificationCode.
+func (c *PhoneVerificationCodeClient) QueryProfile(pvc *PhoneVerificationCode) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pvc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(phoneverificationcode.Table, phoneverificationcode.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, phoneverificationcode.ProfileTable, phoneverificationcode.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pvc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PhoneVerificationCodeClient) Hooks() []Hook {
+	return c.hooks.PhoneVerificationCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *PhoneVerificationCodeClient) Interceptors() []Interceptor {
+	return c.inters.PhoneVerificationCode
+}
+
+func (c *PhoneVerificationCodeClient) mutate(ctx context.Context, m *PhoneVerificationCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PhoneVerificationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PhoneVerificationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PhoneVerificationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PhoneVerificationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PhoneVerificationCode mutation op: %q", m.Op())
+	}
+}
+
+// ProfileClient is a client for the Profile schema.
+type ProfileClient struct {
+	config
+}
+
+// NewProfileClient returns a client for the Profile from the given config.
+func NewProfileClient(c config) *ProfileClient {
+	return &ProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profile.Hooks(f(g(h())))`.
+func (c *ProfileClient) Use(hooks ...Hook) {
+	c.hooks.Profile = append(c.hooks.Profile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profile.Intercept(f(g(h())))`.
+func (c *ProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Profile = append(c.inters.Profile, interceptors...)
+}
+
+// Create returns a builder for creating a Profile entity.
+func (c *ProfileClient) Create() *ProfileCreate {
+	mutation := newProfileMutation(c.config, OpCreate)
+	return &ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Profile entities.
+func (c *ProfileClient) CreateBulk(builders ...*ProfileCreate) *ProfileCreateBulk {
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileClient) MapCreateBulk(slice any, setFunc func(*ProfileCreate, int)) *ProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileCreateBulk{err: fmt.Errorf("calling to ProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Profile.
+func (c *ProfileClient) Update() *ProfileUpdate {
+	mutation := newProfileMutation(c.config, OpUpdate)
+	return &ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileClient) UpdateOne(pr *Profile) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfile(pr))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileClient) UpdateOneID(id int) *ProfileUpdateOne {
+	mutation := newProfileMutation(c.config, OpUpdateOne, withProfileID(id))
+	return &ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Profile.
+func (c *ProfileClient) Delete() *ProfileDelete {
+	mutation := newProfileMutation(c.config, OpDelete)
+	return &ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileClient) DeleteOne(pr *Profile) *ProfileDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileClient) DeleteOneID(id int) *ProfileDeleteOne {
+	builder := c.Delete().Where(profile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for Profile.
+func (c *ProfileClient) Query() *ProfileQuery {
+	return &ProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Profile entity by its id.
+func (c *ProfileClient) Get(ctx context.Context, id int) (*Profile, error) {
+	return c.Query().Where(profile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileClient) GetX(ctx context.Context, id int) *Profile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFriends queries the friends edge of a Profile.
+func (c *ProfileClient) QueryFriends(pr *Profile) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, profile.FriendsTable, profile.FriendsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPhotos queries the photos edge of a Profile.
+func (c *ProfileClient) QueryPhotos(pr *Profile) *ImageQuery {
+	query := (&ImageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(image.Table, image.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.PhotosTable, profile.PhotosColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfileImage queries the profile_image edge of a Profile.
+func (c *ProfileClient) QueryProfileImage(pr *Profile) *ImageQuery {
+	query := (&ImageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(image.Table, image.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, profile.ProfileImageTable, profile.ProfileImageColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotifications queries the notifications edge of a Profile.
+func (c *ProfileClient) QueryNotifications(pr *Profile) *NotificationQuery {
+	query := (&NotificationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(notification.Table, notification.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationsTable, profile.NotificationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a Profile.
+func (c *ProfileClient) QueryInvitations(pr *Profile) *InvitationQuery {
+	query := (&InvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.InvitationsTable, profile.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFcmPushSubscriptions queries the fcm_push_subscriptions edge of a Profile.
+func (c *ProfileClient) QueryFcmPushSubscriptions(pr *Profile) *FCMSubscriptionsQuery {
+	query := (&FCMSubscriptionsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(fcmsubscriptions.Table, fcmsubscriptions.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.FcmPushSubscriptionsTable, profile.FcmPushSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPwaPushSubscriptions queries the pwa_push_subscriptions edge of a Profile.
+func (c *ProfileClient) QueryPwaPushSubscriptions(pr *Profile) *PwaPushSubscriptionQuery {
+	query := (&PwaPushSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(pwapushsubscription.Table, pwapushsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.PwaPushSubscriptionsTable, profile.PwaPushSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationPermissions queries the notification_permissions edge of a Profile.
+func (c *ProfileClient) QueryNotificationPermissions(pr *Profile) *NotificationPermissionQuery {
+	query := (&NotificationPermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(notificationpermission.Table, notificationpermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationPermissionsTable, profile.NotificationPermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotificationTimes queries the notification_times edge of a Profile.
+func (c *ProfileClient) QueryNotificationTimes(pr *Profile) *NotificationTimeQuery {
+	query := (&NotificationTimeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(notificationtime.Table, notificationtime.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.NotificationTimesTable, profile.NotificationTimesColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPhoneVerificationCode queries the phone_verification_code edge of a Profile.
+func (c *ProfileClient) QueryPhoneVerificationCode(pr *Profile) *PhoneVerificationCodeQuery {
+	query := (&PhoneVerificationCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(phoneverificationcode.Table, phoneverificationcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.PhoneVerificationCodeTable, profile.PhoneVerificationCodeColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentEmails queries the sent_emails edge of a Profile.
+func (c *ProfileClient) QuerySentEmails(pr *Profile) *SentEmailQuery {
+	query := (&SentEmailClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(sentemail.Table, sentemail.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, profile.SentEmailsTable, profile.SentEmailsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Profile.
+func (c *ProfileClient) QueryUser(pr *Profile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, profile.UserTable, profile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubscription queries the subscription edge of a Profile.
+func (c *ProfileClient) QuerySubscription(pr *Profile) *MonthlySubscriptionQuery {
+	query := (&MonthlySubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(profile.Table, profile.FieldID, id),
+			sqlgraph.To(monthlysubscription.Table, monthlysubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, profile.SubscriptionTable, profile.SubscriptionPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileClient) Hooks() []Hook {
+	return c.hooks.Profile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileClient) Interceptors() []Interceptor {
+	return c.inters.Profile
+}
+
+func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+	}
+}
+
+// PwaPushSubscriptionClient is a client for the PwaPushSubscription schema.
+type PwaPushSubscriptionClient struct {
+	config
+}
+
+// NewPwaPushSubscriptionClient returns a client for the PwaPushSubscription from the given config.
+func NewPwaPushSubscriptionClient(c config) *PwaPushSubscriptionClient {
+	return &PwaPushSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pwapushsubscription.Hooks(f(g(h())))`.
+func (c *PwaPushSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.PwaPushSubscription = append(c.hooks.PwaPushSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pwapushsubscription.Intercept(f(g(h())))`.
+func (c *PwaPushSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PwaPushSubscription = append(c.inters.PwaPushSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a PwaPushSubscription entity.
+func (c *PwaPushSubscriptionClient) Create() *PwaPushSubscriptionCreate {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpCreate)
+	return &PwaPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PwaPushSubscription entities.
+func (c *PwaPushSubscriptionClient) CreateBulk(builders ...*PwaPushSubscriptionCreate) *PwaPushSubscriptionCreateBulk {
+	return &PwaPushSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PwaPushSubscriptionClient) MapCreateBulk(slice any, setFunc func(*PwaPushSubscriptionCreate, int)) *PwaPushSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PwaPushSubscriptionCreateBulk{err: fmt.Errorf("calling to PwaPushSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PwaPushSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PwaPushSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PwaPushSubscription.
+func (c *PwaPushSubscriptionClient) Update() *PwaPushSubscriptionUpdate {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdate)
+	return &PwaPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PwaPushSubscriptionClient) UpdateOne(pps *PwaPushSubscription) *PwaPushSubscriptionUpdateOne {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdateOne, withPwaPushSubscription(pps))
+	return &PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PwaPushSubscriptionClient) UpdateOneID(id int) *PwaPushSubscriptionUpdateOne {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdateOne, withPwaPushSubscriptionID(id))
+	return &PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PwaPushSubscription.
+func (c *PwaPushSubscriptionClient) Delete() *PwaPushSubscriptionDelete {
+	mutation := newPwaPushSubscriptionMutation(c.config, OpDelete)
+	return &PwaPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PwaPushSubscriptionClient) DeleteOne(pps *PwaPushSubscription) *PwaPushSubscriptionDeleteOne {
+	return c.DeleteOneID(pps.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PwaPushSubscriptionClient) DeleteOneID(id int) *PwaPushSubscriptionDeleteOne {
+	builder := c.Delete().Where(pwapushsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PwaPushSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for PwaPushSubscription.
+func (c *PwaPushSubscriptionClient) Query() *PwaPushSubscriptionQuery {
+	return &PwaPushSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePwaPushSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PwaPushSubscription entity by its id.
+func (c *PwaPushSubscriptionClient) Get(ctx context.Context, id int) (*PwaPushSubscription, error) {
+	return c.Query().Where(pwapushsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PwaPushSubscriptionClient) GetX(ctx context.Context, id int) *PwaPushSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a PwaPushSubscription.
+func (c *PwaPushSubscriptionClient) QueryProfile(pps *PwaPushSubscription) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pps.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pwapushsubscription.Table, pwapushsubscription.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pwapushsubscription.ProfileTable, pwapushsubscription.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(pps.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PwaPushSubscriptionClient) Hooks() []Hook {
+	return c.hooks.PwaPushSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *PwaPushSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.PwaPushSubscription
+}
+
+func (c *PwaPushSubscriptionClient) mutate(ctx context.Context, m *PwaPushSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PwaPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PwaPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PwaPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PwaPushSubscription mutation op: %q", m.Op())
+	}
+}
+
+// RadAcctClient is a client for the RadAcct schema.
+type RadAcctClient struct {
+	config
+}
+
+// NewRadAcctClient returns a client for the RadAcct from the given config.
+func NewRadAcctClient(c config) *RadAcctClient {
+	return &RadAcctClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `radacct.Hooks(f(g(h())))`.
+func (c *RadAcctClient) Use(hooks ...Hook) {
+	c.hooks.RadAcct = append(c.hooks.RadAcct, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `radacct.Intercept(f(g(h())))`.
+func (c *RadAcctClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RadAcct = append(c.inters.RadAcct, interceptors...)
+}
+
+// Create returns a builder for creating a RadAcct entity.
+func (c *RadAcctClient) Create() *RadAcctCreate {
+	mutation := newRadAcctMutation(c.config, OpCreate)
+	return &RadAcctCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RadAcct entities.
+func (c *RadAcctClient) CreateBulk(builders ...*RadAcctCreate) *RadAcctCreateBulk {
+	return &RadAcctCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RadAcctClient) MapCreateBulk(slice any, setFunc func(*RadAcctCreate, int)) *RadAcctCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RadAcctCreateBulk{err: fmt.Errorf("calling to RadAcctClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RadAcctCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RadAcctCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RadAcct.
+func (c *RadAcctClient) Update() *RadAcctUpdate {
+	mutation := newRadAcctMutation(c.config, OpUpdate)
+	return &RadAcctUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RadAcctClient) UpdateOne(ra *RadAcct) *RadAcctUpdateOne {
+	mutation := newRadAcctMutation(c.config, OpUpdateOne, withRadAcct(ra))
+	return &RadAcctUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RadAcctClient) UpdateOneID(id int64) *RadAcctUpdateOne {
+	mutation := newRadAcctMutation(c.config, OpUpdateOne, withRadAcctID(id))
+	return &RadAcctUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RadAcct.
+func (c *RadAcctClient) Delete() *RadAcctDelete {
+	mutation := newRadAcctMutation(c.config, OpDelete)
+	return &RadAcctDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RadAcctClient) DeleteOne(ra *RadAcct) *RadAcctDeleteOne {
+	return c.DeleteOneID(ra.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RadAcctClient) DeleteOneID(id int64) *RadAcctDeleteOne {
+	builder := c.Delete().Where(radacct.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RadAcctDeleteOne{builder}
+}
+
+// Query returns a query builder for RadAcct.
+func (c *RadAcctClient) Query() *RadAcctQuery {
+	return &RadAcctQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRadAcct},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RadAcct entity by its id.
+func (c *RadAcctClient) Get(ctx context.Context, id int64) (*RadAcct, error) {
+	return c.Query().Where(radacct.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RadAcctClient) GetX(ctx context.Context, id int64) *RadAcct {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RadAcctClient) Hooks() []Hook {
+	return c.hooks.RadAcct
+}
+
+// Interceptors returns the client interceptors.
+func (c *RadAcctClient) Interceptors() []Interceptor {
+	return c.inters.RadAcct
+}
+
+func (c *RadAcctClient) mutate(ctx context.Context, m *RadAcctMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RadAcctCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RadAcctUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RadAcctUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RadAcctDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RadAcct mutation op: %q", m.Op())
+	}
+}
+
+// RadCheckClient is a client for the RadCheck schema.
+type RadCheckClient struct {
+	config
+}
+
+// NewRadCheckClient returns a client for the RadCheck from the given config.
+func NewRadCheckClient(c config) *RadCheckClient {
+	return &RadCheckClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `radcheck.Hooks(f(g(h())))`.
+func (c *RadCheckClient) Use(hooks ...Hook) {
+	c.hooks.RadCheck = append(c.hooks.RadCheck, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `radcheck.Intercept(f(g(h())))`.
+func (c *RadCheckClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RadCheck = append(c.inters.RadCheck, interceptors...)
+}
+
+// Create returns a builder for creating a RadCheck entity.
+func (c *RadCheckClient) Create() *RadCheckCreate {
+	mutation := newRadCheckMutation(c.config, OpCreate)
+	return &RadCheckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RadCheck entities.
+func (c *RadCheckClient) CreateBulk(builders ...*RadCheckCreate) *RadCheckCreateBulk {
+	return &RadCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RadCheckClient) MapCreateBulk(slice any, setFunc func(*RadCheckCreate, int)) *RadCheckCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RadCheckCreateBulk{err: fmt.Errorf("calling to RadCheckClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RadCheckCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RadCheckCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RadCheck.
+func (c *RadCheckClient) Update() *RadCheckUpdate {
+	mutation := newRadCheckMutation(c.config, OpUpdate)
+	return &RadCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RadCheckClient) UpdateOne(rc *RadCheck) *RadCheckUpdateOne {
+	mutation := newRadCheckMutation(c.config, OpUpdateOne, withRadCheck(rc))
+	return &RadCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RadCheckClient) UpdateOneID(id int) *RadCheckUpdateOne {
+	mutation := newRadCheckMutation(c.config, OpUpdateOne, withRadCheckID(id))
+	return &RadCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RadCheck.
+func (c *RadCheckClient) Delete() *RadCheckDelete {
+	mutation := newRadCheckMutation(c.config, OpDelete)
+	return &RadCheckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RadCheckClient) DeleteOne(rc *RadCheck) *RadCheckDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RadCheckClient) DeleteOneID(id int) *RadCheckDeleteOne {
+	builder := c.Delete().Where(radcheck.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RadCheckDeleteOne{builder}
+}
+
+// Query returns a query builder for RadCheck.
+func (c *RadCheckClient) Query() *RadCheckQuery {
+	return &RadCheckQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRadCheck},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RadCheck entity by its id.
+func (c *RadCheckClient) Get(ctx context.Context, id int) (*RadCheck, error) {
+	return c.Query().Where(radcheck.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RadCheckClient) GetX(ctx context.Context, id int) *RadCheck {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RadCheckClient) Hooks() []Hook {
+	return c.hooks.RadCheck
+}
+
+// Interceptors returns the client interceptors.
+func (c *RadCheckClient) Interceptors() []Interceptor {
+	return c.inters.RadCheck
+}
+
+func (c *RadCheckClient) mutate(ctx context.Context, m *RadCheckMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RadCheckCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RadCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RadCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RadCheckDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RadCheck mutation op: %q", m.Op())
+	}
+}
+
+// RadGroupReplyClient is a client for the RadGroupReply schema.
+type RadGroupReplyClient struct {
+	config
+}
+
+// NewRadGroupReplyClient returns a client for the RadGroupReply from the given config.
+func NewRadGroupReplyClient(c config) *RadGroupReplyClient {
+	return &RadGroupReplyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `radgroupreply.Hooks(f(g(h())))`.
+func (c *RadGroupReplyClient) Use(hooks ...Hook) {
+	c.hooks.RadGroupReply = append(c.hooks.RadGroupReply, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `radgroupreply.Intercept(f(g(h())))`.
+func (c *RadGroupReplyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RadGroupReply = append(c.inters.RadGroupReply, interceptors...)
+}
+
+// Create returns a builder for creating a RadGroupReply entity.
+func (c *RadGroupReplyClient) Create() *RadGroupReplyCreate {
+	mutation := newRadGroupReplyMutation(c.config, OpCreate)
+	return &RadGroupReplyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RadGroupReply entities.
+func (c *RadGroupReplyClient) CreateBulk(builders ...*RadGroupReplyCreate) *RadGroupReplyCreateBulk {
+	return &RadGroupReplyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RadGroupReplyClient) MapCreateBulk(slice any, setFunc func(*RadGroupReplyCreate, int)) *RadGroupReplyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RadGroupReplyCreateBulk{err: fmt.Errorf("calling to RadGroupReplyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RadGroupReplyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RadGroupReplyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RadGroupReply.
+func (c *RadGroupReplyClient) Update() *RadGroupReplyUpdate {
+	mutation := newRadGroupReplyMutation(c.config, OpUpdate)
+	return &RadGroupReplyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RadGroupReplyClient) UpdateOne(rgr *RadGroupReply) *RadGroupReplyUpdateOne {
+	mutation := newRadGroupReplyMutation(c.config, OpUpdateOne, withRadGroupReply(rgr))
+	return &RadGroupReplyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RadGroupReplyClient) UpdateOneID(id int) *RadGroupReplyUpdateOne {
+	mutation := newRadGroupReplyMutation(c.config, OpUpdateOne, withRadGroupReplyID(id))
+	return &RadGroupReplyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RadGroupReply.
+func (c *RadGroupReplyClient) Delete() *RadGroupReplyDelete {
+	mutation := newRadGroupReplyMutation(c.config, OpDelete)
+	return &RadGroupReplyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RadGroupReplyClient) DeleteOne(rgr *RadGroupReply) *RadGroupReplyDeleteOne {
+	return c.DeleteOneID(rgr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RadGroupReplyClient) DeleteOneID(id int) *RadGroupReplyDeleteOne {
+	builder := c.Delete().Where(radgroupreply.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RadGroupReplyDeleteOne{builder}
+}
+
+// Query returns a query builder for RadGroupReply.
+func (c *RadGroupReplyClient) Query() *RadGroupReplyQuery {
+	return &RadGroupReplyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRadGroupReply},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RadGroupReply entity by its id.
+func (c *RadGroupReplyClient) Get(ctx context.Context, id int) (*RadGroupReply, error) {
+	return c.Query().Where(radgroupreply.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RadGroupReplyClient) GetX(ctx context.Context, id int) *RadGroupReply {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RadGroupReplyClient) Hooks() []Hook {
+	return c.hooks.RadGroupReply
+}
+
+// Interceptors returns the client interceptors.
+func (c *RadGroupReplyClient) Interceptors() []Interceptor {
+	return c.inters.RadGroupReply
+}
+
+func (c *RadGroupReplyClient) mutate(ctx context.Context, m *RadGroupReplyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RadGroupReplyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RadGroupReplyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RadGroupReplyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RadGroupReplyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RadGroupReply mutation op: %q", m.Op())
+	}
+}
+
+// RadReplyClient is a client for the RadReply schema.
+type RadReplyClient struct {
+	config
+}
+
+// NewRadReplyClient returns a client for the RadReply from the given config.
+func NewRadReplyClient(c config) *RadReplyClient {
+	return &RadReplyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `radreply.Hooks(f(g(h())))`.
+func (c *RadReplyClient) Use(hooks ...Hook) {
+	c.hooks.RadReply = append(c.hooks.RadReply, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `radreply.Intercept(f(g(h())))`.
+func (c *RadReplyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RadReply = append(c.inters.RadReply, interceptors...)
+}
+
+// Create returns a builder for creating a RadReply entity.
+func (c *RadReplyClient) Create() *RadReplyCreate {
+	mutation := newRadReplyMutation(c.config, OpCreate)
+	return &RadReplyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RadReply entities.
+func (c *RadReplyClient) CreateBulk(builders ...*RadReplyCreate) *RadReplyCreateBulk {
+	return &RadReplyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RadReplyClient) MapCreateBulk(slice any, setFunc func(*RadReplyCreate, int)) *RadReplyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RadReplyCreateBulk{err: fmt.Errorf("calling to RadReplyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RadReplyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RadReplyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RadReply.
+func (c *RadReplyClient) Update() *RadReplyUpdate {
+	mutation := newRadReplyMutation(c.config, OpUpdate)
+	return &RadReplyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RadReplyClient) UpdateOne(rr *RadReply) *RadReplyUpdateOne {
+	mutation := newRadReplyMutation(c.config, OpUpdateOne, withRadReply(rr))
+	return &RadReplyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RadReplyClient) UpdateOneID(id int) *RadReplyUpdateOne {
+	mutation := newRadReplyMutation(c.config, OpUpdateOne, withRadReplyID(id))
+	return &RadReplyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RadReply.
+func (c *RadReplyClient) Delete() *RadReplyDelete {
+	mutation := newRadReplyMutation(c.config, OpDelete)
+	return &RadReplyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RadReplyClient) DeleteOne(rr *RadReply) *RadReplyDeleteOne {
+	return c.DeleteOneID(rr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RadReplyClient) DeleteOneID(id int) *RadReplyDeleteOne {
+	builder := c.Delete().Where(radreply.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RadReplyDeleteOne{builder}
+}
+
+// Query returns a query builder for RadReply.
+func (c *RadReplyClient) Query() *RadReplyQuery {
+	return &RadReplyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRadReply},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RadReply entity by its id.
+func (c *RadReplyClient) Get(ctx context.Context, id int) (*RadReply, error) {
+	return c.Query().Where(radreply.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RadReplyClient) GetX(ctx context.Context, id int) *RadReply {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RadReplyClient) Hooks() []Hook {
+	return c.hooks.RadReply
+}
+
+// Interceptors returns the client interceptors.
+func (c *RadReplyClient) Interceptors() []Interceptor {
+	return c.inters.RadReply
+}
+
+func (c *RadReplyClient) mutate(ctx context.Context, m *RadReplyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RadReplyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RadReplyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RadReplyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RadReplyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RadReply mutation op: %q", m.Op())
+	}
+}
+
+// RadUserGroupClient is a client for the RadUserGroup schema.
+type RadUserGroupClient struct {
+	config
+}
+
+// NewRadUserGroupClient returns a client for the RadUserGroup from the given config.
+func NewRadUserGroupClient(c config) *RadUserGroupClient {
+	return &RadUserGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `radusergroup.Hooks(f(g(h())))`.
+func (c *RadUserGroupClient) Use(hooks ...Hook) {
+	c.hooks.RadUserGroup = append(c.hooks.RadUserGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `radusergroup.Intercept(f(g(h())))`.
+func (c *RadUserGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RadUserGroup = append(c.inters.RadUserGroup, interceptors...)
+}
+
+// Create returns a builder for creating a RadUserGroup entity.
+func (c *RadUserGroupClient) Create() *RadUserGroupCreate {
+	mutation := newRadUserGroupMutation(c.config, OpCreate)
+	return &RadUserGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RadUserGroup entities.
+func (c *RadUserGroupClient) CreateBulk(builders ...*RadUserGroupCreate) *RadUserGroupCreateBulk {
+	return &RadUserGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RadUserGroupClient) MapCreateBulk(slice any, setFunc func(*RadUserGroupCreate, int)) *RadUserGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RadUserGroupCreateBulk{err: fmt.Errorf("calling to RadUserGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RadUserGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RadUserGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RadUserGroup.
+func (c *RadUserGroupClient) Update() *RadUserGroupUpdate {
+	mutation := newRadUserGroupMutation(c.config, OpUpdate)
+	return &RadUserGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RadUserGroupClient) UpdateOne(rug *RadUserGroup) *RadUserGroupUpdateOne {
+	mutation := newRadUserGroupMutation(c.config, OpUpdateOne, withRadUserGroup(rug))
+	return &RadUserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RadUserGroupClient) UpdateOneID(id int) *RadUserGroupUpdateOne {
+	mutation := newRadUserGroupMutation(c.config, OpUpdateOne, withRadUserGroupID(id))
+	return &RadUserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RadUserGroup.
+func (c *RadUserGroupClient) Delete() *RadUserGroupDelete {
+	mutation := newRadUserGroupMutation(c.config, OpDelete)
+	return &RadUserGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RadUserGroupClient) DeleteOne(rug *RadUserGroup) *RadUserGroupDeleteOne {
+	return c.DeleteOneID(rug.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RadUserGroupClient) DeleteOneID(id int) *RadUserGroupDeleteOne {
+	builder := c.Delete().Where(radusergroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RadUserGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for RadUserGroup.
+func (c *RadUserGroupClient) Query() *RadUserGroupQuery {
+	return &RadUserGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRadUserGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RadUserGroup entity by its id.
+func (c *RadUserGroupClient) Get(ctx context.Context, id int) (*RadUserGroup, error) {
+	return c.Query().Where(radusergroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RadUserGroupClient) GetX(ctx context.Context, id int) *RadUserGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RadUserGroupClient) Hooks() []Hook {
+	return c.hooks.RadUserGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *RadUserGroupClient) Interceptors() []Interceptor {
+	return c.inters.RadUserGroup
+}
+
+func (c *RadUserGroupClient) mutate(ctx context.Context, m *RadUserGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RadUserGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RadUserGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RadUserGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RadUserGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RadUserGroup mutation op: %q", m.Op())
+	}
+}
+
+// RefundRequestClient is a client for the RefundRequest schema.
+type RefundRequestClient struct {
+	config
+}
+
+// NewRefundRequestClient returns a client for the RefundRequest from the given config.
+func NewRefundRequestClient(c config) *RefundRequestClient {
+	return &RefundRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refundrequest.Hooks(f(g(h())))`.
+func (c *RefundRequestClient) Use(hooks ...Hook) {
+	c.hooks.RefundRequest = append(c.hooks.RefundRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refundrequest.Intercept(f(g(h())))`.
+func (c *RefundRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefundRequest = append(c.inters.RefundRequest, interceptors...)
+}
+
+// Create returns a builder for creating a RefundRequest entity.
+func (c *RefundRequestClient) Create() *RefundRequestCreate {
+	mutation := newRefundRequestMutation(c.config, OpCreate)
+	return &RefundRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefundRequest entities.
+func (c *RefundRequestClient) CreateBulk(builders ...*RefundRequestCreate) *RefundRequestCreateBulk {
+	return &RefundRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefundRequestClient) MapCreateBulk(slice any, setFunc func(*RefundRequestCreate, int)) *RefundRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefundRequestCreateBulk{err: fmt.Errorf("calling to RefundRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefundRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefundRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefundRequest.
+func (c *RefundRequestClient) Update() *RefundRequestUpdate {
+	mutation := newRefundRequestMutation(c.config, OpUpdate)
+	return &RefundRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefundRequestClient) UpdateOne(rr *RefundRequest) *RefundRequestUpdateOne {
+	mutation := newRefundRequestMutation(c.config, OpUpdateOne, withRefundRequest(rr))
+	return &RefundRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefundRequestClient) UpdateOneID(id int) *RefundRequestUpdateOne {
+	mutation := newRefundRequestMutation(c.config, OpUpdateOne, withRefundRequestID(id))
+	return &RefundRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefundRequest.
+func (c *RefundRequestClient) Delete() *RefundRequestDelete {
+	mutation := newRefundRequestMutation(c.config, OpDelete)
+	return &RefundRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefundRequestClient) DeleteOne(rr *RefundRequest) *RefundRequestDeleteOne {
+	return c.DeleteOneID(rr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefundRequestClient) DeleteOneID(id int) *RefundRequestDeleteOne {
+	builder := c.Delete().Where(refundrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefundRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for RefundRequest.
+func (c *RefundRequestClient) Query() *RefundRequestQuery {
+	return &RefundRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefundRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefundRequest entity by its id.
+func (c *RefundRequestClient) Get(ctx context.Context, id int) (*RefundRequest, error) {
+	return c.Query().Where(refundrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefundRequestClient) GetX(ctx context.Context, id int) *RefundRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RefundRequestClient) Hooks() []Hook {
+	return c.hooks.RefundRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefundRequestClient) Interceptors() []Interceptor {
+	return c.inters.RefundRequest
+}
+
+func (c *RefundRequestClient) mutate(ctx context.Context, m *RefundRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefundRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefundRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefundRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefundRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefundRequest mutation op: %q", m.Op())
+	}
+}
+
+// SentEmailClient is a client for the SentEmail schema.
+type SentEmailClient struct {
+	config
+}
+
+// NewSentEmailClient returns a client for the SentEmail from the given config.
+func NewSentEmailClient(c config) *SentEmailClient {
+	return &SentEmailClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sentemail.Hooks(f(g(h())))`.
+func (c *SentEmailClient) Use(hooks ...Hook) {
+	c.hooks.SentEmail = append(c.hooks.SentEmail, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sentemail.Intercept(f(g(h())))`.
+func (c *SentEmailClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SentEmail = append(c.inters.SentEmail, interceptors...)
+}
+
+// Create returns a builder for creating a SentEmail entity.
+func (c *SentEmailClient) Create() *SentEmailCreate {
+	mutation := newSentEmailMutation(c.config, OpCreate)
+	return &SentEmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SentEmail entities.
+func (c *SentEmailClient) CreateBulk(builders ...*SentEmailCreate) *SentEmailCreateBulk {
+	return &SentEmailCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SentEmailClient) MapCreateBulk(slice any, setFunc func(*SentEmailCreate, int)) *SentEmailCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SentEmailCreateBulk{err: fmt.Errorf("calling to SentEmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SentEmailCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SentEmailCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SentEmail.
+func (c *SentEmailClient) Update() *SentEmailUpdate {
+	mutation := newSentEmailMutation(c.config, OpUpdate)
+	return &SentEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SentEmailClient) UpdateOne(se *SentEmail) *SentEmailUpdateOne {
+	mutation := newSentEmailMutation(c.config, OpUpdateOne, withSentEmail(se))
+	return &SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SentEmailClient) UpdateOneID(id int) *SentEmailUpdateOne {
+	mutation := newSentEmailMutation(c.config, OpUpdateOne, withSentEmailID(id))
+	return &SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SentEmail.
+func (c *SentEmailClient) Delete() *SentEmailDelete {
+	mutation := newSentEmailMutation(c.config, OpDelete)
+	return &SentEmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SentEmailClient) DeleteOne(se *SentEmail) *SentEmailDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SentEmailClient) DeleteOneID(id int) *SentEmailDeleteOne {
+	builder := c.Delete().Where(sentemail.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SentEmailDeleteOne{builder}
+}
+
+// Query returns a query builder for SentEmail.
+func (c *SentEmailClient) Query() *SentEmailQuery {
+	return &SentEmailQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSentEmail},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SentEmail entity by its id.
+func (c *SentEmailClient) Get(ctx context.Context, id int) (*SentEmail, error) {
+	return c.Query().Where(sentemail.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SentEmailClient) GetX(ctx context.Context, id int) *SentEmail {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProfile queries the profile edge of a SentEmail.
+func (c *SentEmailClient) QueryProfile(se *SentEmail) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sentemail.Table, sentemail.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sentemail.ProfileTable, sentemail.ProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SentEmailClient) Hooks() []Hook {
+	return c.hooks.SentEmail
+}
+
+// Interceptors returns the client interceptors.
+func (c *SentEmailClient) Interceptors() []Interceptor {
+	return c.inters.SentEmail
+}
+
+func (c *SentEmailClient) mutate(ctx context.Context, m *SentEmailMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SentEmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SentEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SentEmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SentEmail mutation op: %q", m.Op())
+	}
+}
+
+// SettlementRowClient is a client for the SettlementRow schema.
+type SettlementRowClient struct {
+	config
+}
+
+// NewSettlementRowClient returns a client for the SettlementRow from the given config.
+func NewSettlementRowClient(c config) *SettlementRowClient {
+	return &SettlementRowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `settlementrow.Hooks(f(g(h())))`.
+func (c *SettlementRowClient) Use(hooks ...Hook) {
+	c.hooks.SettlementRow = append(c.hooks.SettlementRow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `settlementrow.Intercept(f(g(h())))`.
+func (c *SettlementRowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SettlementRow = append(c.inters.SettlementRow, interceptors...)
+}
+
+// Create returns a builder for creating a SettlementRow entity.
+func (c *SettlementRowClient) Create() *SettlementRowCreate {
+	mutation := newSettlementRowMutation(c.config, OpCreate)
+	return &SettlementRowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SettlementRow entities.
+func (c *SettlementRowClient) CreateBulk(builders ...*SettlementRowCreate) *SettlementRowCreateBulk {
+	return &SettlementRowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettlementRowClient) MapCreateBulk(slice any, setFunc func(*SettlementRowCreate, int)) *SettlementRowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettlementRowCreateBulk{err: fmt.Errorf("calling to SettlementRowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettlementRowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettlementRowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SettlementRow.
+func (c *SettlementRowClient) Update() *SettlementRowUpdate {
+	mutation := newSettlementRowMutation(c.config, OpUpdate)
+	return &SettlementRowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettlementRowClient) UpdateOne(sr *SettlementRow) *SettlementRowUpdateOne {
+	mutation := newSettlementRowMutation(c.config, OpUpdateOne, withSettlementRow(sr))
+	return &SettlementRowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettlementRowClient) UpdateOneID(id int) *SettlementRowUpdateOne {
+	mutation := newSettlementRowMutation(c.config, OpUpdateOne, withSettlementRowID(id))
+	return &SettlementRowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SettlementRow.
+func (c *SettlementRowClient) Delete() *SettlementRowDelete {
+	mutation := newSettlementRowMutation(c.config, OpDelete)
+	return &SettlementRowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettlementRowClient) DeleteOne(sr *SettlementRow) *SettlementRowDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettlementRowClient) DeleteOneID(id int) *SettlementRowDeleteOne {
+	builder := c.Delete().Where(settlementrow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettlementRowDeleteOne{builder}
+}
+
+// Query returns a query builder for SettlementRow.
+func (c *SettlementRowClient) Query() *SettlementRowQuery {
+	return &SettlementRowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSettlementRow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SettlementRow entity by its id.
+func (c *SettlementRowClient) Get(ctx context.Context, id int) (*SettlementRow, error) {
+	return c.Query().Where(settlementrow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettlementRowClient) GetX(ctx context.Context, id int) *SettlementRow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettlementRowClient) Hooks() []Hook {
+	return c.hooks.SettlementRow
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettlementRowClient) Interceptors() []Interceptor {
+	return c.inters.SettlementRow
+}
+
+func (c *SettlementRowClient) mutate(ctx context.Context, m *SettlementRowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettlementRowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettlementRowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettlementRowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettlementRowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SettlementRow mutation op: %q", m.Op())
+	}
+}
+
+// StatementEntryClient is a client for the StatementEntry schema.
+type StatementEntryClient struct {
+	config
+}
+
+// NewStatementEntryClient returns a client for the StatementEntry from the given config.
+func NewStatementEntryClient(c config) *StatementEntryClient {
+	return &StatementEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `statemententry.Hooks(f(g(h())))`.
+func (c *StatementEntryClient) Use(hooks ...Hook) {
+	c.hooks.StatementEntry = append(c.hooks.StatementEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `statemententry.Intercept(f(g(h())))`.
+func (c *StatementEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StatementEntry = append(c.inters.StatementEntry, interceptors...)
+}
+
+// Create returns a builder for creating a StatementEntry entity.
+func (c *StatementEntryClient) Create() *StatementEntryCreate {
+	mutation := newStatementEntryMutation(c.config, OpCreate)
+	return &StatementEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StatementEntry entities.
+func (c *StatementEntryClient) CreateBulk(builders ...*StatementEntryCreate) *StatementEntryCreateBulk {
+	return &StatementEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StatementEntryClient) MapCreateBulk(slice any, setFunc func(*StatementEntryCreate, int)) *StatementEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StatementEntryCreateBulk{err: fmt.Errorf("calling to StatementEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StatementEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StatementEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StatementEntry.
+func (c *StatementEntryClient) Update() *StatementEntryUpdate {
+	mutation := newStatementEntryMutation(c.config, OpUpdate)
+	return &StatementEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StatementEntryClient) UpdateOne(se *StatementEntry) *StatementEntryUpdateOne {
+	mutation := newStatementEntryMutation(c.config, OpUpdateOne, withStatementEntry(se))
+	return &StatementEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StatementEntryClient) UpdateOneID(id int) *StatementEntryUpdateOne {
+	mutation := newStatementEntryMutation(c.config, OpUpdateOne, withStatementEntryID(id))
+	return &StatementEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StatementEntry.
+func (c *StatementEntryClient) Delete() *StatementEntryDelete {
+	mutation := newStatementEntryMutation(c.config, OpDelete)
+	return &StatementEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StatementEntryClient) DeleteOne(se *StatementEntry) *StatementEntryDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StatementEntryClient) DeleteOneID(id int) *StatementEntryDeleteOne {
+	builder := c.Delete().Where(statemententry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StatementEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for StatementEntry.
+func (c *StatementEntryClient) Query() *StatementEntryQuery {
+	return &StatementEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStatementEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StatementEntry entity by its id.
+func (c *StatementEntryClient) Get(ctx context.Context, id int) (*StatementEntry, error) {
+	return c.Query().Where(statemententry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StatementEntryClient) GetX(ctx context.Context, id int) *StatementEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StatementEntryClient) Hooks() []Hook {
+	return c.hooks.StatementEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *StatementEntryClient) Interceptors() []Interceptor {
+	return c.inters.StatementEntry
+}
+
+func (c *StatementEntryClient) mutate(ctx context.Context, m *StatementEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StatementEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StatementEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StatementEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StatementEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StatementEntry mutation op: %q", m.Op())
+	}
+}
+
+// TicketClient is a client for the Ticket schema.
+type TicketClient struct {
+	config
+}
+
+// NewTicketClient returns a client for the Ticket from the given config.
+func NewTicketClient(c config) *TicketClient {
+	return &TicketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ticket.Hooks(f(g(h())))`.
+func (c *TicketClient) Use(hooks ...Hook) {
+	c.hooks.Ticket = append(c.hooks.Ticket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ticket.Intercept(f(g(h())))`.
+func (c *TicketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Ticket = append(c.inters.Ticket, interceptors...)
+}
+
+// Create returns a builder for creating a Ticket entity.
+func (c *TicketClient) Create() *TicketCreate {
+	mutation := newTicketMutation(c.config, OpCreate)
+	return &TicketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Ticket entities.
+func (c *TicketClient) CreateBulk(builders ...*TicketCreate) *TicketCreateBulk {
+	return &TicketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TicketClient) MapCreateBulk(slice any, setFunc func(*TicketCreate, int)) *TicketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TicketCreateBulk{err: fmt.Errorf("calling to TicketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TicketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TicketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Ticket.
+func (c *TicketClient) Update() *TicketUpdate {
+	mutation := newTicketMutation(c.config, OpUpdate)
+	return &TicketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TicketClient) UpdateOne(t *Ticket) *TicketUpdateOne {
+	mutation := newTicketMutation(c.config, OpUpdateOne, withTicket(t))
+	return &TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TicketClient) UpdateOneID(id int) *TicketUpdateOne {
+	mutation := newTicketMutation(c.config, OpUpdateOne, withTicketID(id))
+	return &TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Ticket.
+func (c *TicketClient) Delete() *TicketDelete {
+	mutation := newTicketMutation(c.config, OpDelete)
+	return &TicketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TicketClient) DeleteOne(t *Ticket) *TicketDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TicketClient) DeleteOneID(id int) *TicketDeleteOne {
+	builder := c.Delete().Where(ticket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TicketDeleteOne{builder}
+}
+
+// Query returns a query builder for Ticket.
+func (c *TicketClient) Query() *TicketQuery {
+	return &TicketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTicket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Ticket entity by its id.
+func (c *TicketClient) Get(ctx context.Context, id int) (*Ticket, error) {
+	return c.Query().Where(ticket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TicketClient) GetX(ctx context.Context, id int) *Ticket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TicketClient) Hooks() []Hook {
+	return c.hooks.Ticket
+}
+
+// Interceptors returns the client interceptors.
+func (c *TicketClient) Interceptors() []Interceptor {
+	return c.inters.Ticket
+}
+
+func (c *TicketClient) mutate(ctx context.Context, m *TicketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TicketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TicketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TicketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Ticket mutation op: %q", m.Op())
+	}
+}
+
+// UsageCursorClient is a client for the UsageCursor schema.
+type UsageCursorClient struct {
+	config
+}
+
+// NewUsageCursorClient returns a client for the UsageCursor from the given config.
+func NewUsageCursorClient(c config) *UsageCursorClient {
+	return &UsageCursorClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagecursor.Hooks(f(g(h())))`.
+func (c *UsageCursorClient) Use(hooks ...Hook) {
+	c.hooks.UsageCursor = append(c.hooks.UsageCursor, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagecursor.Intercept(f(g(h())))`.
+func (c *UsageCursorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageCursor = append(c.inters.UsageCursor, interceptors...)
+}
+
+// Create returns a builder for creating a UsageCursor entity.
+func (c *UsageCursorClient) Create() *UsageCursorCreate {
+	mutation := newUsageCursorMutation(c.config, OpCreate)
+	return &UsageCursorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageCursor entities.
+func (c *UsageCursorClient) CreateBulk(builders ...*UsageCursorCreate) *UsageCursorCreateBulk {
+	return &UsageCursorCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageCursorClient) MapCreateBulk(slice any, setFunc func(*UsageCursorCreate, int)) *UsageCursorCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageCursorCreateBulk{err: fmt.Errorf("calling to UsageCursorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageCursorCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageCursorCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageCursor.
+func (c *UsageCursorClient) Update() *UsageCursorUpdate {
+	mutation := newUsageCursorMutation(c.config, OpUpdate)
+	return &UsageCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageCursorClient) UpdateOne(uc *UsageCursor) *UsageCursorUpdateOne {
+	mutation := newUsageCursorMutation(c.config, OpUpdateOne, withUsageCursor(uc))
+	return &UsageCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageCursorClient) UpdateOneID(id int) *UsageCursorUpdateOne {
+	mutation := newUsageCursorMutation(c.config, OpUpdateOne, withUsageCursorID(id))
+	return &UsageCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageCursor.
+func (c *UsageCursorClient) Delete() *UsageCursorDelete {
+	mutation := newUsageCursorMutation(c.config, OpDelete)
+	return &UsageCursorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageCursorClient) DeleteOne(uc *UsageCursor) *UsageCursorDeleteOne {
+	return c.DeleteOneID(uc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageCursorClient) DeleteOneID(id int) *UsageCursorDeleteOne {
+	builder := c.Delete().Where(usagecursor.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageCursorDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageCursor.
+func (c *UsageCursorClient) Query() *UsageCursorQuery {
+	return &UsageCursorQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageCursor},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageCursor entity by its id.
+func (c *UsageCursorClient) Get(ctx context.Context, id int) (*UsageCursor, error) {
+	return c.Query().Where(usagecursor.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageCursorClient) GetX(ctx context.Context, id int) *UsageCursor {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UsageCursorClient) Hooks() []Hook {
+	return c.hooks.UsageCursor
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageCursorClient) Interceptors() []Interceptor {
+	return c.inters.UsageCursor
+}
+
+func (c *UsageCursorClient) mutate(ctx context.Context, m *UsageCursorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageCursorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageCursorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageCursorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageCursorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UsageCursor mutation op: %q", m.Op())
+	}
+}
+
+// UsageDailyClient is a client for the UsageDaily schema.
+type UsageDailyClient struct {
+	config
+}
+
+// NewUsageDailyClient returns a client for the UsageDaily from the given config.
+func NewUsageDailyClient(c config) *UsageDailyClient {
+	return &UsageDailyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagedaily.Hooks(f(g(h())))`.
+func (c *UsageDailyClient) Use(hooks ...Hook) {
+	c.hooks.UsageDaily = append(c.hooks.UsageDaily, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagedaily.Intercept(f(g(h())))`.
+func (c *UsageDailyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageDaily = append(c.inters.UsageDaily, interceptors...)
+}
+
+// Create returns a builder for creating a UsageDaily entity.
+func (c *UsageDailyClient) Create() *UsageDailyCreate {
+	mutation := newUsageDailyMutation(c.config, OpCreate)
+	return &UsageDailyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageDaily entities.
+func (c *UsageDailyClient) CreateBulk(builders ...*UsageDailyCreate) *UsageDailyCreateBulk {
+	return &UsageDailyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageDailyClient) MapCreateBulk(slice any, setFunc func(*UsageDailyCreate, int)) *UsageDailyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageDailyCreateBulk{err: fmt.Errorf("calling to UsageDailyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageDailyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageDailyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageDaily.
+func (c *UsageDailyClient) Update() *UsageDailyUpdate {
+	mutation := newUsageDailyMutation(c.config, OpUpdate)
+	return &UsageDailyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageDailyClient) UpdateOne(ud *UsageDaily) *UsageDailyUpdateOne {
+	mutation := newUsageDailyMutation(c.config, OpUpdateOne, withUsageDaily(ud))
+	return &UsageDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageDailyClient) UpdateOneID(id int) *UsageDailyUpdateOne {
+	mutation := newUsageDailyMutation(c.config, OpUpdateOne, withUsageDailyID(id))
+	return &UsageDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageDaily.
+func (c *UsageDailyClient) Delete() *UsageDailyDelete {
+	mutation := newUsageDailyMutation(c.config, OpDelete)
+	return &UsageDailyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageDailyClient) DeleteOne(ud *UsageDaily) *UsageDailyDeleteOne {
+	return c.DeleteOneID(ud.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageDailyClient) DeleteOneID(id int) *UsageDailyDeleteOne {
+	builder := c.Delete().Where(usagedaily.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageDailyDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageDaily.
+func (c *UsageDailyClient) Query() *UsageDailyQuery {
+	return &UsageDailyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageDaily},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageDaily entity by its id.
+func (c *UsageDailyClient) Get(ctx context.Context, id int) (*UsageDaily, error) {
+	return c.Query().Where(usagedaily.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageDailyClient) GetX(ctx context.Context, id int) *UsageDaily {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UsageDailyClient) Hooks() []Hook {
+	return c.hooks.UsageDaily
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageDailyClient) Interceptors() []Interceptor {
+	return c.inters.UsageDaily
+}
+
+func (c *UsageDailyClient) mutate(ctx context.Context, m *UsageDailyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageDailyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageDailyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageDailyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageDailyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UsageDaily mutation op: %q", m.Op())
+	}
+}
+
+// UsageHourlyClient is a client for the UsageHourly schema.
+type UsageHourlyClient struct {
+	config
+}
+
+// NewUsageHourlyClient returns a client for the UsageHourly from the given config.
+func NewUsageHourlyClient(c config) *UsageHourlyClient {
+	return &UsageHourlyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagehourly.Hooks(f(g(h())))`.
+func (c *UsageHourlyClient) Use(hooks ...Hook) {
+	c.hooks.UsageHourly = append(c.hooks.UsageHourly, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagehourly.Intercept(f(g(h())))`.
+func (c *UsageHourlyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageHourly = append(c.inters.UsageHourly, interceptors...)
+}
+
+// Create returns a builder for creating a UsageHourly entity.
+func (c *UsageHourlyClient) Create() *UsageHourlyCreate {
+	mutation := newUsageHourlyMutation(c.config, OpCreate)
+	return &UsageHourlyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageHourly entities.
+func (c *UsageHourlyClient) CreateBulk(builders ...*UsageHourlyCreate) *UsageHourlyCreateBulk {
+	return &UsageHourlyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageHourlyClient) MapCreateBulk(slice any, setFunc func(*UsageHourlyCreate, int)) *UsageHourlyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageHourlyCreateBulk{err: fmt.Errorf("calling to UsageHourlyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageHourlyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageHourlyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageHourly.
+func (c *UsageHourlyClient) Update() *UsageHourlyUpdate {
+	mutation := newUsageHourlyMutation(c.config, OpUpdate)
+	return &UsageHourlyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageHourlyClient) UpdateOne(uh *UsageHourly) *UsageHourlyUpdateOne {
+	mutation := newUsageHourlyMutation(c.config, OpUpdateOne, withUsageHourly(uh))
+	return &UsageHourlyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageHourlyClient) UpdateOneID(id int) *UsageHourlyUpdateOne {
+	mutation := newUsageHourlyMutation(c.config, OpUpdateOne, withUsageHourlyID(id))
+	return &UsageHourlyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageHourly.
+func (c *UsageHourlyClient) Delete() *UsageHourlyDelete {
+	mutation := newUsageHourlyMutation(c.config, OpDelete)
+	return &UsageHourlyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageHourlyClient) DeleteOne(uh *UsageHourly) *UsageHourlyDeleteOne {
+	return c.DeleteOneID(uh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageHourlyClient) DeleteOneID(id int) *UsageHourlyDeleteOne {
+	builder := c.Delete().Where(usagehourly.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageHourlyDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageHourly.
+func (c *UsageHourlyClient) Query() *UsageHourlyQuery {
+	return &UsageHourlyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageHourly},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageHourly entity by its id.
+func (c *UsageHourlyClient) Get(ctx context.Context, id int) (*UsageHourly, error) {
+	return c.Query().Where(usagehourly.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageHourlyClient) GetX(ctx context.Context, id int) *UsageHourly {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UsageHourlyClient) Hooks() []Hook {
+	return c.hooks.UsageHourly
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageHourlyClient) Interceptors() []Interceptor {
+	return c.inters.UsageHourly
+}
+
+func (c *UsageHourlyClient) mutate(ctx context.Context, m *UsageHourlyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageHourlyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageHourlyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageHourlyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageHourlyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UsageHourly mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
 }
 
-// QuerySentEmails queries the sent_emails edge of a Profile.
-func (c *ProfileClient) QuerySentEmails(pr *Profile) *SentEmailQuery {
-	query := (&SentEmailClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(sentemail.Table, sentemail.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, profile.SentEmailsTable, profile.SentEmailsColumn),
-		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryUser queries the user edge of a Profile.
-func (c *ProfileClient) QueryUser(pr *Profile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryProfile queries the profile edge of a User.
+func (c *UserClient) QueryProfile(u *User) *ProfileQuery {
+	query := (&ProfileClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := u.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, profile.UserTable, profile.UserColumn),
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(profile.Table, profile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.ProfileTable, user.ProfileColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QuerySubscription queries the subscription edge of a Profile.
-func (c *ProfileClient) QuerySubscription(pr *Profile) *MonthlySubscriptionQuery {
-	query := (&MonthlySubscriptionClient{config: c.config}).Query()
+// QueryLastSeenAt queries the last_seen_at edge of a User.
+func (c *UserClient) QueryLastSeenAt(u *User) *LastSeenOnlineQuery {
+	query := (&LastSeenOnlineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := u.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(profile.Table, profile.FieldID, id),
-			sqlgraph.To(monthlysubscription.Table, monthlysubscription.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, profile.SubscriptionTable, profile.SubscriptionPrimaryKey...),
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(lastseenonline.Table, lastseenonline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LastSeenAtTable, user.LastSeenAtColumn),
 		)
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProfileClient) Hooks() []Hook {
-	return c.hooks.Profile
+func (c *UserClient) Hooks() []Hook {
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProfileClient) Interceptors() []Interceptor {
-	return c.inters.Profile
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
 }
 
-func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value, error) {
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Profile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
 	}
 }
 
-// PwaPushSubscriptionClient is a client for the PwaPushSubscription schema.
-type PwaPushSubscriptionClient struct {
+// VendorClient is a client for the Vendor schema.
+type VendorClient struct {
 	config
 }
 
-// NewPwaPushSubscriptionClient returns a client for the PwaPushSubscription from the given config.
-func NewPwaPushSubscriptionClient(c config) *PwaPushSubscriptionClient {
-	return &PwaPushSubscriptionClient{config: c}
+// NewVendorClient returns a client for the Vendor from the given config.
+func NewVendorClient(c config) *VendorClient {
+	return &VendorClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pwapushsubscription.Hooks(f(g(h())))`.
-func (c *PwaPushSubscriptionClient) Use(hooks ...Hook) {
-	c.hooks.PwaPushSubscription = append(c.hooks.PwaPushSubscription, hooks...)
+// A call to `Use(f, g, h)` equals to `vendor.Hooks(f(g(h())))`.
+func (c *VendorClient) Use(hooks ...Hook) {
+	c.hooks.Vendor = append(c.hooks.Vendor, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pwapushsubscription.Intercept(f(g(h())))`.
-func (c *PwaPushSubscriptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PwaPushSubscription = append(c.inters.PwaPushSubscription, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `vendor.Intercept(f(g(h())))`.
+func (c *VendorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Vendor = append(c.inters.Vendor, interceptors...)
 }
 
-// Create returns a builder for creating a PwaPushSubscription entity.
-func (c *PwaPushSubscriptionClient) Create() *PwaPushSubscriptionCreate {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpCreate)
-	return &PwaPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Vendor entity.
+func (c *VendorClient) Create() *VendorCreate {
+	mutation := newVendorMutation(c.config, OpCreate)
+	return &VendorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PwaPushSubscription entities.
-func (c *PwaPushSubscriptionClient) CreateBulk(builders ...*PwaPushSubscriptionCreate) *PwaPushSubscriptionCreateBulk {
-	return &PwaPushSubscriptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Vendor entities.
+func (c *VendorClient) CreateBulk(builders ...*VendorCreate) *VendorCreateBulk {
+	return &VendorCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PwaPushSubscriptionClient) MapCreateBulk(slice any, setFunc func(*PwaPushSubscriptionCreate, int)) *PwaPushSubscriptionCreateBulk {
+func (c *VendorClient) MapCreateBulk(slice any, setFunc func(*VendorCreate, int)) *VendorCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PwaPushSubscriptionCreateBulk{err: fmt.Errorf("calling to PwaPushSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &VendorCreateBulk{err: fmt.Errorf("calling to VendorClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PwaPushSubscriptionCreate, rv.Len())
+	builders := make([]*VendorCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PwaPushSubscriptionCreateBulk{config: c.config, builders: builders}
+	return &VendorCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PwaPushSubscription.
-func (c *PwaPushSubscriptionClient) Update() *PwaPushSubscriptionUpdate {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdate)
-	return &PwaPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Vendor.
+func (c *VendorClient) Update() *VendorUpdate {
+	mutation := newVendorMutation(c.config, OpUpdate)
+	return &VendorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PwaPushSubscriptionClient) UpdateOne(pps *PwaPushSubscription) *PwaPushSubscriptionUpdateOne {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdateOne, withPwaPushSubscription(pps))
-	return &PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VendorClient) UpdateOne(v *Vendor) *VendorUpdateOne {
+	mutation := newVendorMutation(c.config, OpUpdateOne, withVendor(v))
+	return &VendorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PwaPushSubscriptionClient) UpdateOneID(id int) *PwaPushSubscriptionUpdateOne {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpUpdateOne, withPwaPushSubscriptionID(id))
-	return &PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VendorClient) UpdateOneID(id int) *VendorUpdateOne {
+	mutation := newVendorMutation(c.config, OpUpdateOne, withVendorID(id))
+	return &VendorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PwaPushSubscription.
-func (c *PwaPushSubscriptionClient) Delete() *PwaPushSubscriptionDelete {
-	mutation := newPwaPushSubscriptionMutation(c.config, OpDelete)
-	return &PwaPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Vendor.
+func (c *VendorClient) Delete() *VendorDelete {
+	mutation := newVendorMutation(c.config, OpDelete)
+	return &VendorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PwaPushSubscriptionClient) DeleteOne(pps *PwaPushSubscription) *PwaPushSubscriptionDeleteOne {
-	return c.DeleteOneID(pps.ID)
+func (c *VendorClient) DeleteOne(v *Vendor) *VendorDeleteOne {
+	return c.DeleteOneID(v.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PwaPushSubscriptionClient) DeleteOneID(id int) *PwaPushSubscriptionDeleteOne {
-	builder := c.Delete().Where(pwapushsubscription.ID(id))
+func (c *VendorClient) DeleteOneID(id int) *VendorDeleteOne {
+	builder := c.Delete().Where(vendor.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PwaPushSubscriptionDeleteOne{builder}
+	return &VendorDeleteOne{builder}
 }
 
-// Query returns a query builder for PwaPushSubscription.
-func (c *PwaPushSubscriptionClient) Query() *PwaPushSubscriptionQuery {
-	return &PwaPushSubscriptionQuery{
+// Query returns a query builder for Vendor.
+func (c *VendorClient) Query() *VendorQuery {
+	return &VendorQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePwaPushSubscription},
+		ctx:    &QueryContext{Type: TypeVendor},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PwaPushSubscription entity by its id.
-func (c *PwaPushSubscriptionClient) Get(ctx context.Context, id int) (*PwaPushSubscription, error) {
-	return c.Query().Where(pwapushsubscription.ID(id)).Only(ctx)
+// Get returns a Vendor entity by its id.
+func (c *VendorClient) Get(ctx context.Context, id int) (*Vendor, error) {
+	return c.Query().Where(vendor.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PwaPushSubscriptionClient) GetX(ctx context.Context, id int) *PwaPushSubscription {
+func (c *VendorClient) GetX(ctx context.Context, id int) *Vendor {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3326,148 +6936,265 @@ func (c *PwaPushSubscriptionClient) GetX(ctx context.Context, id int) *PwaPushSu
 	return obj
 }
 
-// QueryProfile queries the profile edge of a PwaPushSubscription.
-func (c *PwaPushSubscriptionClient) QueryProfile(pps *PwaPushSubscription) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pps.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(pwapushsubscription.Table, pwapushsubscription.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pwapushsubscription.ProfileTable, pwapushsubscription.ProfileColumn),
-		)
-		fromV = sqlgraph.Neighbors(pps.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *VendorClient) Hooks() []Hook {
+	return c.hooks.Vendor
+}
+
+// Interceptors returns the client interceptors.
+func (c *VendorClient) Interceptors() []Interceptor {
+	return c.inters.Vendor
+}
+
+func (c *VendorClient) mutate(ctx context.Context, m *VendorMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VendorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VendorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VendorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VendorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Vendor mutation op: %q", m.Op())
 	}
-	return query
+}
+
+// VendorCommissionClient is a client for the VendorCommission schema.
+type VendorCommissionClient struct {
+	config
+}
+
+// NewVendorCommissionClient returns a client for the VendorCommission from the given config.
+func NewVendorCommissionClient(c config) *VendorCommissionClient {
+	return &VendorCommissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vendorcommission.Hooks(f(g(h())))`.
+func (c *VendorCommissionClient) Use(hooks ...Hook) {
+	c.hooks.VendorCommission = append(c.hooks.VendorCommission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vendorcommission.Intercept(f(g(h())))`.
+func (c *VendorCommissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VendorCommission = append(c.inters.VendorCommission, interceptors...)
+}
+
+// Create returns a builder for creating a VendorCommission entity.
+func (c *VendorCommissionClient) Create() *VendorCommissionCreate {
+	mutation := newVendorCommissionMutation(c.config, OpCreate)
+	return &VendorCommissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VendorCommission entities.
+func (c *VendorCommissionClient) CreateBulk(builders ...*VendorCommissionCreate) *VendorCommissionCreateBulk {
+	return &VendorCommissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VendorCommissionClient) MapCreateBulk(slice any, setFunc func(*VendorCommissionCreate, int)) *VendorCommissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VendorCommissionCreateBulk{err: fmt.Errorf("calling to VendorCommissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VendorCommissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VendorCommissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VendorCommission.
+func (c *VendorCommissionClient) Update() *VendorCommissionUpdate {
+	mutation := newVendorCommissionMutation(c.config, OpUpdate)
+	return &VendorCommissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VendorCommissionClient) UpdateOne(vc *VendorCommission) *VendorCommissionUpdateOne {
+	mutation := newVendorCommissionMutation(c.config, OpUpdateOne, withVendorCommission(vc))
+	return &VendorCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VendorCommissionClient) UpdateOneID(id int) *VendorCommissionUpdateOne {
+	mutation := newVendorCommissionMutation(c.config, OpUpdateOne, withVendorCommissionID(id))
+	return &VendorCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VendorCommission.
+func (c *VendorCommissionClient) Delete() *VendorCommissionDelete {
+	mutation := newVendorCommissionMutation(c.config, OpDelete)
+	return &VendorCommissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VendorCommissionClient) DeleteOne(vc *VendorCommission) *VendorCommissionDeleteOne {
+	return c.DeleteOneID(vc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VendorCommissionClient) DeleteOneID(id int) *VendorCommissionDeleteOne {
+	builder := c.Delete().Where(vendorcommission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VendorCommissionDeleteOne{builder}
+}
+
+// Query returns a query builder for VendorCommission.
+func (c *VendorCommissionClient) Query() *VendorCommissionQuery {
+	return &VendorCommissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVendorCommission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VendorCommission entity by its id.
+func (c *VendorCommissionClient) Get(ctx context.Context, id int) (*VendorCommission, error) {
+	return c.Query().Where(vendorcommission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VendorCommissionClient) GetX(ctx context.Context, id int) *VendorCommission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *PwaPushSubscriptionClient) Hooks() []Hook {
-	return c.hooks.PwaPushSubscription
+func (c *VendorCommissionClient) Hooks() []Hook {
+	return c.hooks.VendorCommission
 }
 
 // Interceptors returns the client interceptors.
-func (c *PwaPushSubscriptionClient) Interceptors() []Interceptor {
-	return c.inters.PwaPushSubscription
+func (c *VendorCommissionClient) Interceptors() []Interceptor {
+	return c.inters.VendorCommission
 }
 
-func (c *PwaPushSubscriptionClient) mutate(ctx context.Context, m *PwaPushSubscriptionMutation) (Value, error) {
+func (c *VendorCommissionClient) mutate(ctx context.Context, m *VendorCommissionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PwaPushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VendorCommissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PwaPushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VendorCommissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PwaPushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VendorCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PwaPushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VendorCommissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PwaPushSubscription mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VendorCommission mutation op: %q", m.Op())
 	}
 }
 
-// RadAcctClient is a client for the RadAcct schema.
-type RadAcctClient struct {
+// VendorTxnClient is a client for the VendorTxn schema.
+type VendorTxnClient struct {
 	config
 }
 
-// NewRadAcctClient returns a client for the RadAcct from the given config.
-func NewRadAcctClient(c config) *RadAcctClient {
-	return &RadAcctClient{config: c}
+// NewVendorTxnClient returns a client for the VendorTxn from the given config.
+func NewVendorTxnClient(c config) *VendorTxnClient {
+	return &VendorTxnClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `radacct.Hooks(f(g(h())))`.
-func (c *RadAcctClient) Use(hooks ...Hook) {
-	c.hooks.RadAcct = append(c.hooks.RadAcct, hooks...)
+// A call to `Use(f, g, h)` equals to `vendortxn.Hooks(f(g(h())))`.
+func (c *VendorTxnClient) Use(hooks ...Hook) {
+	c.hooks.VendorTxn = append(c.hooks.VendorTxn, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `radacct.Intercept(f(g(h())))`.
-func (c *RadAcctClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RadAcct = append(c.inters.RadAcct, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `vendortxn.Intercept(f(g(h())))`.
+func (c *VendorTxnClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VendorTxn = append(c.inters.VendorTxn, interceptors...)
 }
 
-// Create returns a builder for creating a RadAcct entity.
-func (c *RadAcctClient) Create() *RadAcctCreate {
-	mutation := newRadAcctMutation(c.config, OpCreate)
-	return &RadAcctCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a VendorTxn entity.
+func (c *VendorTxnClient) Create() *VendorTxnCreate {
+	mutation := newVendorTxnMutation(c.config, OpCreate)
+	return &VendorTxnCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RadAcct entities.
-func (c *RadAcctClient) CreateBulk(builders ...*RadAcctCreate) *RadAcctCreateBulk {
-	return &RadAcctCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of VendorTxn entities.
+func (c *VendorTxnClient) CreateBulk(builders ...*VendorTxnCreate) *VendorTxnCreateBulk {
+	return &VendorTxnCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RadAcctClient) MapCreateBulk(slice any, setFunc func(*RadAcctCreate, int)) *RadAcctCreateBulk {
+func (c *VendorTxnClient) MapCreateBulk(slice any, setFunc func(*VendorTxnCreate, int)) *VendorTxnCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RadAcctCreateBulk{err: fmt.Errorf("calling to RadAcctClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &VendorTxnCreateBulk{err: fmt.Errorf("calling to VendorTxnClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RadAcctCreate, rv.Len())
+	builders := make([]*VendorTxnCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RadAcctCreateBulk{config: c.config, builders: builders}
+	return &VendorTxnCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RadAcct.
-func (c *RadAcctClient) Update() *RadAcctUpdate {
-	mutation := newRadAcctMutation(c.config, OpUpdate)
-	return &RadAcctUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for VendorTxn.
+func (c *VendorTxnClient) Update() *VendorTxnUpdate {
+	mutation := newVendorTxnMutation(c.config, OpUpdate)
+	return &VendorTxnUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RadAcctClient) UpdateOne(ra *RadAcct) *RadAcctUpdateOne {
-	mutation := newRadAcctMutation(c.config, OpUpdateOne, withRadAcct(ra))
-	return &RadAcctUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VendorTxnClient) UpdateOne(vt *VendorTxn) *VendorTxnUpdateOne {
+	mutation := newVendorTxnMutation(c.config, OpUpdateOne, withVendorTxn(vt))
+	return &VendorTxnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RadAcctClient) UpdateOneID(id int64) *RadAcctUpdateOne {
-	mutation := newRadAcctMutation(c.config, OpUpdateOne, withRadAcctID(id))
-	return &RadAcctUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VendorTxnClient) UpdateOneID(id int) *VendorTxnUpdateOne {
+	mutation := newVendorTxnMutation(c.config, OpUpdateOne, withVendorTxnID(id))
+	return &VendorTxnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
-
-// Delete returns a delete builder for RadAcct.
-func (c *RadAcctClient) Delete() *RadAcctDelete {
-	mutation := newRadAcctMutation(c.config, OpDelete)
-	return &RadAcctDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+
+// Delete returns a delete builder for VendorTxn.
+func (c *VendorTxnClient) Delete() *VendorTxnDelete {
+	mutation := newVendorTxnMutation(c.config, OpDelete)
+	return &VendorTxnDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RadAcctClient) DeleteOne(ra *RadAcct) *RadAcctDeleteOne {
-	return c.DeleteOneID(ra.ID)
+func (c *VendorTxnClient) DeleteOne(vt *VendorTxn) *VendorTxnDeleteOne {
+	return c.DeleteOneID(vt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RadAcctClient) DeleteOneID(id int64) *RadAcctDeleteOne {
-	builder := c.Delete().Where(radacct.ID(id))
+func (c *VendorTxnClient) DeleteOneID(id int) *VendorTxnDeleteOne {
+	builder := c.Delete().Where(vendortxn.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RadAcctDeleteOne{builder}
+	return &VendorTxnDeleteOne{builder}
 }
 
-// Query returns a query builder for RadAcct.
-func (c *RadAcctClient) Query() *RadAcctQuery {
-	return &RadAcctQuery{
+// Query returns a query builder for VendorTxn.
+func (c *VendorTxnClient) Query() *VendorTxnQuery {
+	return &VendorTxnQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRadAcct},
+		ctx:    &QueryContext{Type: TypeVendorTxn},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RadAcct entity by its id.
-func (c *RadAcctClient) Get(ctx context.Context, id int64) (*RadAcct, error) {
-	return c.Query().Where(radacct.ID(id)).Only(ctx)
+// Get returns a VendorTxn entity by its id.
+func (c *VendorTxnClient) Get(ctx context.Context, id int) (*VendorTxn, error) {
+	return c.Query().Where(vendortxn.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RadAcctClient) GetX(ctx context.Context, id int64) *RadAcct {
+func (c *VendorTxnClient) GetX(ctx context.Context, id int) *VendorTxn {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3476,131 +7203,131 @@ func (c *RadAcctClient) GetX(ctx context.Context, id int64) *RadAcct {
 }
 
 // Hooks returns the client hooks.
-func (c *RadAcctClient) Hooks() []Hook {
-	return c.hooks.RadAcct
+func (c *VendorTxnClient) Hooks() []Hook {
+	return c.hooks.VendorTxn
 }
 
 // Interceptors returns the client interceptors.
-func (c *RadAcctClient) Interceptors() []Interceptor {
-	return c.inters.RadAcct
+func (c *VendorTxnClient) Interceptors() []Interceptor {
+	return c.inters.VendorTxn
 }
 
-func (c *RadAcctClient) mutate(ctx context.Context, m *RadAcctMutation) (Value, error) {
+func (c *VendorTxnClient) mutate(ctx context.Context, m *VendorTxnMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RadAcctCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VendorTxnCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RadAcctUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VendorTxnUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RadAcctUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VendorTxnUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RadAcctDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VendorTxnDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown RadAcct mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VendorTxn mutation op: %q", m.Op())
 	}
 }
 
-// SentEmailClient is a client for the SentEmail schema.
-type SentEmailClient struct {
+// VoucherClient is a client for the Voucher schema.
+type VoucherClient struct {
 	config
 }
 
-// NewSentEmailClient returns a client for the SentEmail from the given config.
-func NewSentEmailClient(c config) *SentEmailClient {
-	return &SentEmailClient{config: c}
+// NewVoucherClient returns a client for the Voucher from the given config.
+func NewVoucherClient(c config) *VoucherClient {
+	return &VoucherClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `sentemail.Hooks(f(g(h())))`.
-func (c *SentEmailClient) Use(hooks ...Hook) {
-	c.hooks.SentEmail = append(c.hooks.SentEmail, hooks...)
+// A call to `Use(f, g, h)` equals to `voucher.Hooks(f(g(h())))`.
+func (c *VoucherClient) Use(hooks ...Hook) {
+	c.hooks.Voucher = append(c.hooks.Voucher, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `sentemail.Intercept(f(g(h())))`.
-func (c *SentEmailClient) Intercept(interceptors ...Interceptor) {
-	c.inters.SentEmail = append(c.inters.SentEmail, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `voucher.Intercept(f(g(h())))`.
+func (c *VoucherClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Voucher = append(c.inters.Voucher, interceptors...)
 }
 
-// Create returns a builder for creating a SentEmail entity.
-func (c *SentEmailClient) Create() *SentEmailCreate {
-	mutation := newSentEmailMutation(c.config, OpCreate)
-	return &SentEmailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Voucher entity.
+func (c *VoucherClient) Create() *VoucherCreate {
+	mutation := newVoucherMutation(c.config, OpCreate)
+	return &VoucherCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of SentEmail entities.
-func (c *SentEmailClient) CreateBulk(builders ...*SentEmailCreate) *SentEmailCreateBulk {
-	return &SentEmailCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Voucher entities.
+func (c *VoucherClient) CreateBulk(builders ...*VoucherCreate) *VoucherCreateBulk {
+	return &VoucherCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SentEmailClient) MapCreateBulk(slice any, setFunc func(*SentEmailCreate, int)) *SentEmailCreateBulk {
+func (c *VoucherClient) MapCreateBulk(slice any, setFunc func(*VoucherCreate, int)) *VoucherCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SentEmailCreateBulk{err: fmt.Errorf("calling to SentEmailClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &VoucherCreateBulk{err: fmt.Errorf("calling to VoucherClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SentEmailCreate, rv.Len())
+	builders := make([]*VoucherCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SentEmailCreateBulk{config: c.config, builders: builders}
+	return &VoucherCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for SentEmail.
-func (c *SentEmailClient) Update() *SentEmailUpdate {
-	mutation := newSentEmailMutation(c.config, OpUpdate)
-	return &SentEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Voucher.
+func (c *VoucherClient) Update() *VoucherUpdate {
+	mutation := newVoucherMutation(c.config, OpUpdate)
+	return &VoucherUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SentEmailClient) UpdateOne(se *SentEmail) *SentEmailUpdateOne {
-	mutation := newSentEmailMutation(c.config, OpUpdateOne, withSentEmail(se))
-	return &SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VoucherClient) UpdateOne(v *Voucher) *VoucherUpdateOne {
+	mutation := newVoucherMutation(c.config, OpUpdateOne, withVoucher(v))
+	return &VoucherUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SentEmailClient) UpdateOneID(id int) *SentEmailUpdateOne {
-	mutation := newSentEmailMutation(c.config, OpUpdateOne, withSentEmailID(id))
-	return &SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VoucherClient) UpdateOneID(id int) *VoucherUpdateOne {
+	mutation := newVoucherMutation(c.config, OpUpdateOne, withVoucherID(id))
+	return &VoucherUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for SentEmail.
-func (c *SentEmailClient) Delete() *SentEmailDelete {
-	mutation := newSentEmailMutation(c.config, OpDelete)
-	return &SentEmailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Voucher.
+func (c *VoucherClient) Delete() *VoucherDelete {
+	mutation := newVoucherMutation(c.config, OpDelete)
+	return &VoucherDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SentEmailClient) DeleteOne(se *SentEmail) *SentEmailDeleteOne {
-	return c.DeleteOneID(se.ID)
+func (c *VoucherClient) DeleteOne(v *Voucher) *VoucherDeleteOne {
+	return c.DeleteOneID(v.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SentEmailClient) DeleteOneID(id int) *SentEmailDeleteOne {
-	builder := c.Delete().Where(sentemail.ID(id))
+func (c *VoucherClient) DeleteOneID(id int) *VoucherDeleteOne {
+	builder := c.Delete().Where(voucher.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SentEmailDeleteOne{builder}
+	return &VoucherDeleteOne{builder}
 }
 
-// Query returns a query builder for SentEmail.
-func (c *SentEmailClient) Query() *SentEmailQuery {
-	return &SentEmailQuery{
+// Query returns a query builder for Voucher.
+func (c *VoucherClient) Query() *VoucherQuery {
+	return &VoucherQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSentEmail},
+		ctx:    &QueryContext{Type: TypeVoucher},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a SentEmail entity by its id.
-func (c *SentEmailClient) Get(ctx context.Context, id int) (*SentEmail, error) {
-	return c.Query().Where(sentemail.ID(id)).Only(ctx)
+// Get returns a Voucher entity by its id.
+func (c *VoucherClient) Get(ctx context.Context, id int) (*Voucher, error) {
+	return c.Query().Where(voucher.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SentEmailClient) GetX(ctx context.Context, id int) *SentEmail {
+func (c *VoucherClient) GetX(ctx context.Context, id int) *Voucher {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3608,148 +7335,132 @@ func (c *SentEmailClient) GetX(ctx context.Context, id int) *SentEmail {
 	return obj
 }
 
-// QueryProfile queries the profile edge of a SentEmail.
-func (c *SentEmailClient) QueryProfile(se *SentEmail) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := se.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(sentemail.Table, sentemail.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, sentemail.ProfileTable, sentemail.ProfileColumn),
-		)
-		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *SentEmailClient) Hooks() []Hook {
-	return c.hooks.SentEmail
+func (c *VoucherClient) Hooks() []Hook {
+	return c.hooks.Voucher
 }
 
 // Interceptors returns the client interceptors.
-func (c *SentEmailClient) Interceptors() []Interceptor {
-	return c.inters.SentEmail
+func (c *VoucherClient) Interceptors() []Interceptor {
+	return c.inters.Voucher
 }
 
-func (c *SentEmailClient) mutate(ctx context.Context, m *SentEmailMutation) (Value, error) {
+func (c *VoucherClient) mutate(ctx context.Context, m *VoucherMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SentEmailCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SentEmailUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SentEmailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SentEmailDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VoucherDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown SentEmail mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Voucher mutation op: %q", m.Op())
 	}
 }
 
-// TicketClient is a client for the Ticket schema.
-type TicketClient struct {
+// VoucherAttemptClient is a client for the VoucherAttempt schema.
+type VoucherAttemptClient struct {
 	config
 }
 
-// NewTicketClient returns a client for the Ticket from the given config.
-func NewTicketClient(c config) *TicketClient {
-	return &TicketClient{config: c}
+// NewVoucherAttemptClient returns a client for the VoucherAttempt from the given config.
+func NewVoucherAttemptClient(c config) *VoucherAttemptClient {
+	return &VoucherAttemptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `ticket.Hooks(f(g(h())))`.
-func (c *TicketClient) Use(hooks ...Hook) {
-	c.hooks.Ticket = append(c.hooks.Ticket, hooks...)
+// A call to `Use(f, g, h)` equals to `voucherattempt.Hooks(f(g(h())))`.
+func (c *VoucherAttemptClient) Use(hooks ...Hook) {
+	c.hooks.VoucherAttempt = append(c.hooks.VoucherAttempt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `ticket.Intercept(f(g(h())))`.
-func (c *TicketClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Ticket = append(c.inters.Ticket, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `voucherattempt.Intercept(f(g(h())))`.
+func (c *VoucherAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VoucherAttempt = append(c.inters.VoucherAttempt, interceptors...)
 }
 
-// Create returns a builder for creating a Ticket entity.
-func (c *TicketClient) Create() *TicketCreate {
-	mutation := newTicketMutation(c.config, OpCreate)
-	return &TicketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a VoucherAttempt entity.
+func (c *VoucherAttemptClient) Create() *VoucherAttemptCreate {
+	mutation := newVoucherAttemptMutation(c.config, OpCreate)
+	return &VoucherAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Ticket entities.
-func (c *TicketClient) CreateBulk(builders ...*TicketCreate) *TicketCreateBulk {
-	return &TicketCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of VoucherAttempt entities.
+func (c *VoucherAttemptClient) CreateBulk(builders ...*VoucherAttemptCreate) *VoucherAttemptCreateBulk {
+	return &VoucherAttemptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TicketClient) MapCreateBulk(slice any, setFunc func(*TicketCreate, int)) *TicketCreateBulk {
+func (c *VoucherAttemptClient) MapCreateBulk(slice any, setFunc func(*VoucherAttemptCreate, int)) *VoucherAttemptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TicketCreateBulk{err: fmt.Errorf("calling to TicketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &VoucherAttemptCreateBulk{err: fmt.Errorf("calling to VoucherAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TicketCreate, rv.Len())
+	builders := make([]*VoucherAttemptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TicketCreateBulk{config: c.config, builders: builders}
+	return &VoucherAttemptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Ticket.
-func (c *TicketClient) Update() *TicketUpdate {
-	mutation := newTicketMutation(c.config, OpUpdate)
-	return &TicketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for VoucherAttempt.
+func (c *VoucherAttemptClient) Update() *VoucherAttemptUpdate {
+	mutation := newVoucherAttemptMutation(c.config, OpUpdate)
+	return &VoucherAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TicketClient) UpdateOne(t *Ticket) *TicketUpdateOne {
-	mutation := newTicketMutation(c.config, OpUpdateOne, withTicket(t))
-	return &TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VoucherAttemptClient) UpdateOne(va *VoucherAttempt) *VoucherAttemptUpdateOne {
+	mutation := newVoucherAttemptMutation(c.config, OpUpdateOne, withVoucherAttempt(va))
+	return &VoucherAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TicketClient) UpdateOneID(id int) *TicketUpdateOne {
-	mutation := newTicketMutation(c.config, OpUpdateOne, withTicketID(id))
-	return &TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VoucherAttemptClient) UpdateOneID(id int) *VoucherAttemptUpdateOne {
+	mutation := newVoucherAttemptMutation(c.config, OpUpdateOne, withVoucherAttemptID(id))
+	return &VoucherAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Ticket.
-func (c *TicketClient) Delete() *TicketDelete {
-	mutation := newTicketMutation(c.config, OpDelete)
-	return &TicketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for VoucherAttempt.
+func (c *VoucherAttemptClient) Delete() *VoucherAttemptDelete {
+	mutation := newVoucherAttemptMutation(c.config, OpDelete)
+	return &VoucherAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TicketClient) DeleteOne(t *Ticket) *TicketDeleteOne {
-	return c.DeleteOneID(t.ID)
+func (c *VoucherAttemptClient) DeleteOne(va *VoucherAttempt) *VoucherAttemptDeleteOne {
+	return c.DeleteOneID(va.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TicketClient) DeleteOneID(id int) *TicketDeleteOne {
-	builder := c.Delete().Where(ticket.ID(id))
+func (c *VoucherAttemptClient) DeleteOneID(id int) *VoucherAttemptDeleteOne {
+	builder := c.Delete().Where(voucherattempt.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TicketDeleteOne{builder}
+	return &VoucherAttemptDeleteOne{builder}
 }
 
-// Query returns a query builder for Ticket.
-func (c *TicketClient) Query() *TicketQuery {
-	return &TicketQuery{
+// Query returns a query builder for VoucherAttempt.
+func (c *VoucherAttemptClient) Query() *VoucherAttemptQuery {
+	return &VoucherAttemptQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTicket},
+		ctx:    &QueryContext{Type: TypeVoucherAttempt},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Ticket entity by its id.
-func (c *TicketClient) Get(ctx context.Context, id int) (*Ticket, error) {
-	return c.Query().Where(ticket.ID(id)).Only(ctx)
+// Get returns a VoucherAttempt entity by its id.
+func (c *VoucherAttemptClient) Get(ctx context.Context, id int) (*VoucherAttempt, error) {
+	return c.Query().Where(voucherattempt.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TicketClient) GetX(ctx context.Context, id int) *Ticket {
+func (c *VoucherAttemptClient) GetX(ctx context.Context, id int) *VoucherAttempt {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3758,131 +7469,131 @@ func (c *TicketClient) GetX(ctx context.Context, id int) *Ticket {
 }
 
 // Hooks returns the client hooks.
-func (c *TicketClient) Hooks() []Hook {
-	return c.hooks.Ticket
+func (c *VoucherAttemptClient) Hooks() []Hook {
+	return c.hooks.VoucherAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *TicketClient) Interceptors() []Interceptor {
-	return c.inters.Ticket
+func (c *VoucherAttemptClient) Interceptors() []Interceptor {
+	return c.inters.VoucherAttempt
 }
 
-func (c *TicketClient) mutate(ctx context.Context, m *TicketMutation) (Value, error) {
+func (c *VoucherAttemptClient) mutate(ctx context.Context, m *VoucherAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TicketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TicketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TicketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TicketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VoucherAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Ticket mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VoucherAttempt mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// VoucherBatchClient is a client for the VoucherBatch schema.
+type VoucherBatchClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewVoucherBatchClient returns a client for the VoucherBatch from the given config.
+func NewVoucherBatchClient(c config) *VoucherBatchClient {
+	return &VoucherBatchClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `voucherbatch.Hooks(f(g(h())))`.
+func (c *VoucherBatchClient) Use(hooks ...Hook) {
+	c.hooks.VoucherBatch = append(c.hooks.VoucherBatch, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `voucherbatch.Intercept(f(g(h())))`.
+func (c *VoucherBatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VoucherBatch = append(c.inters.VoucherBatch, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a VoucherBatch entity.
+func (c *VoucherBatchClient) Create() *VoucherBatchCreate {
+	mutation := newVoucherBatchMutation(c.config, OpCreate)
+	return &VoucherBatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of VoucherBatch entities.
+func (c *VoucherBatchClient) CreateBulk(builders ...*VoucherBatchCreate) *VoucherBatchCreateBulk {
+	return &VoucherBatchCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *VoucherBatchClient) MapCreateBulk(slice any, setFunc func(*VoucherBatchCreate, int)) *VoucherBatchCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &VoucherBatchCreateBulk{err: fmt.Errorf("calling to VoucherBatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*VoucherBatchCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &VoucherBatchCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for VoucherBatch.
+func (c *VoucherBatchClient) Update() *VoucherBatchUpdate {
+	mutation := newVoucherBatchMutation(c.config, OpUpdate)
+	return &VoucherBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VoucherBatchClient) UpdateOne(vb *VoucherBatch) *VoucherBatchUpdateOne {
+	mutation := newVoucherBatchMutation(c.config, OpUpdateOne, withVoucherBatch(vb))
+	return &VoucherBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *VoucherBatchClient) UpdateOneID(id int) *VoucherBatchUpdateOne {
+	mutation := newVoucherBatchMutation(c.config, OpUpdateOne, withVoucherBatchID(id))
+	return &VoucherBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for VoucherBatch.
+func (c *VoucherBatchClient) Delete() *VoucherBatchDelete {
+	mutation := newVoucherBatchMutation(c.config, OpDelete)
+	return &VoucherBatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
-	return c.DeleteOneID(u.ID)
+func (c *VoucherBatchClient) DeleteOne(vb *VoucherBatch) *VoucherBatchDeleteOne {
+	return c.DeleteOneID(vb.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *VoucherBatchClient) DeleteOneID(id int) *VoucherBatchDeleteOne {
+	builder := c.Delete().Where(voucherbatch.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &VoucherBatchDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for VoucherBatch.
+func (c *VoucherBatchClient) Query() *VoucherBatchQuery {
+	return &VoucherBatchQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeVoucherBatch},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a VoucherBatch entity by its id.
+func (c *VoucherBatchClient) Get(ctx context.Context, id int) (*VoucherBatch, error) {
+	return c.Query().Where(voucherbatch.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *VoucherBatchClient) GetX(ctx context.Context, id int) *VoucherBatch {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3890,78 +7601,79 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
-// QueryProfile queries the profile edge of a User.
-func (c *UserClient) QueryProfile(u *User) *ProfileQuery {
-	query := (&ProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(profile.Table, profile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, user.ProfileTable, user.ProfileColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLastSeenAt queries the last_seen_at edge of a User.
-func (c *UserClient) QueryLastSeenAt(u *User) *LastSeenOnlineQuery {
-	query := (&LastSeenOnlineClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := u.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(lastseenonline.Table, lastseenonline.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.LastSeenAtTable, user.LastSeenAtColumn),
-		)
-		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	hooks := c.hooks.User
-	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
+func (c *VoucherBatchClient) Hooks() []Hook {
+	return c.hooks.VoucherBatch
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *VoucherBatchClient) Interceptors() []Interceptor {
+	return c.inters.VoucherBatch
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *VoucherBatchClient) mutate(ctx context.Context, m *VoucherBatchMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherBatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherBatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VoucherBatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VoucherBatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VoucherBatch mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ClientTxn, ClientUser, EmailSubscription, EmailSubscriptionType, Emojis,
-		FCMSubscriptions, FileStorage, Image, ImageSize, Invitation, LastSeenOnline,
-		MonthlySubscription, Notification, NotificationPermission, NotificationTime,
-		PackagePlan, PhoneVerificationCode, Profile, PwaPushSubscription, RadAcct,
-		SentEmail, Ticket, User []ent.Hook
+		BalanceTransfer, ClientAddon, ClientTxn, ClientUser, Coupon, CouponRedemption,
+		DunningStep, EmailSubscription, EmailSubscriptionType, Emojis,
+		FCMSubscriptions, FileStorage, GracePeriod, Image, ImageSize, Invitation,
+		Invoice, InvoiceLine, LastSeenOnline, ManualPayment, MonthlySubscription,
+		Notification, NotificationPermission, NotificationTime, PackagePlan,
+		PayRequest, PhoneVerificationCode, Profile, PwaPushSubscription, RadAcct,
+		RadCheck, RadGroupReply, RadReply, RadUserGroup, RefundRequest, SentEmail,
+		SettlementRow, StatementEntry, Ticket, UsageCursor, UsageDaily, UsageHourly,
+		User, Vendor, VendorCommission, VendorTxn, Voucher, VoucherAttempt,
+		VoucherBatch []ent.Hook
 	}
 	inters struct {
-		ClientTxn, ClientUser, EmailSubscription, EmailSubscriptionType, Emojis,
-		FCMSubscriptions, FileStorage, Image, ImageSize, Invitation, LastSeenOnline,
-		MonthlySubscription, Notification, NotificationPermission, NotificationTime,
-		PackagePlan, PhoneVerificationCode, Profile, PwaPushSubscription, RadAcct,
-		SentEmail, Ticket, User []ent.Interceptor
+		BalanceTransfer, ClientAddon, ClientTxn, ClientUser, Coupon, CouponRedemption,
+		DunningStep, EmailSubscription, EmailSubscriptionType, Emojis,
+		FCMSubscriptions, FileStorage, GracePeriod, Image, ImageSize, Invitation,
+		Invoice, InvoiceLine, LastSeenOnline, ManualPayment, MonthlySubscription,
+		Notification, NotificationPermission, NotificationTime, PackagePlan,
+		PayRequest, PhoneVerificationCode, Profile, PwaPushSubscription, RadAcct,
+		RadCheck, RadGroupReply, RadReply, RadUserGroup, RefundRequest, SentEmail,
+		SettlementRow, StatementEntry, Ticket, UsageCursor, UsageDaily, UsageHourly,
+		User, Vendor, VendorCommission, VendorTxn, Voucher, VoucherAttempt,
+		VoucherBatch []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/clientaddon"
+)
+
+// ClientAddon is the model entity for the ClientAddon schema.
+type ClientAddon struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID int `json:"client_id,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientAddon) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clientaddon.FieldActive:
+			values[i] = new(sql.NullBool)
+		case clientaddon.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case clientaddon.FieldID, clientaddon.FieldClientID:
+			values[i] = new(sql.NullInt64)
+		case clientaddon.FieldClientUsername, clientaddon.FieldName, clientaddon.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case clientaddon.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientAddon fields.
+func (ca *ClientAddon) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clientaddon.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ca.ID = int(value.Int64)
+		case clientaddon.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				ca.ClientID = int(value.Int64)
+			}
+		case clientaddon.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				ca.ClientUsername = value.String
+			}
+		case clientaddon.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ca.Name = value.String
+			}
+		case clientaddon.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ca.Amount = value.Float64
+			}
+		case clientaddon.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				ca.Active = value.Bool
+			}
+		case clientaddon.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ca.CreatedBy = value.String
+			}
+		case clientaddon.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ca.CreatedAt = value.Time
+			}
+		default:
+			ca.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClientAddon.
+// This includes values selected through modifiers, order, etc.
+func (ca *ClientAddon) Value(name string) (ent.Value, error) {
+	return ca.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ClientAddon.
+// Note that you need to call ClientAddon.Unwrap() before calling this method if this ClientAddon
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ca *ClientAddon) Update() *ClientAddonUpdateOne {
+	return NewClientAddonClient(ca.config).UpdateOne(ca)
+}
+
+// Unwrap unwraps the ClientAddon entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ca *ClientAddon) Unwrap() *ClientAddon {
+	_tx, ok := ca.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClientAddon is not a transactional entity")
+	}
+	ca.config.driver = _tx.drv
+	return ca
+}
+
+// String implements the fmt.Stringer.
+func (ca *ClientAddon) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientAddon(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ca.ID))
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", ca.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(ca.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ca.Name)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ca.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", ca.Active))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ca.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ca.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientAddons is a parsable slice of ClientAddon.
+type ClientAddons []*ClientAddon
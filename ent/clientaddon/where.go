@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package clientaddon
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLTE(FieldID, id))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientUsername applies equality check predicate on the "client_username" field. It's identical to ClientUsernameEQ.
+func ClientUsername(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldName, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldAmount, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldActive, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v int) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientUsernameEQ applies the EQ predicate on the "client_username" field.
+func ClientUsernameEQ(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameNEQ applies the NEQ predicate on the "client_username" field.
+func ClientUsernameNEQ(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameIn applies the In predicate on the "client_username" field.
+func ClientUsernameIn(vs ...string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameNotIn applies the NotIn predicate on the "client_username" field.
+func ClientUsernameNotIn(vs ...string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNotIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameGT applies the GT predicate on the "client_username" field.
+func ClientUsernameGT(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGT(FieldClientUsername, v))
+}
+
+// ClientUsernameGTE applies the GTE predicate on the "client_username" field.
+func ClientUsernameGTE(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGTE(FieldClientUsername, v))
+}
+
+// ClientUsernameLT applies the LT predicate on the "client_username" field.
+func ClientUsernameLT(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLT(FieldClientUsername, v))
+}
+
+// ClientUsernameLTE applies the LTE predicate on the "client_username" field.
+func ClientUsernameLTE(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLTE(FieldClientUsername, v))
+}
+
+// ClientUsernameContains applies the Contains predicate on the "client_username" field.
+func ClientUsernameContains(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldContains(FieldClientUsername, v))
+}
+
+// ClientUsernameHasPrefix applies the HasPrefix predicate on the "client_username" field.
+func ClientUsernameHasPrefix(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldHasPrefix(FieldClientUsername, v))
+}
+
+// ClientUsernameHasSuffix applies the HasSuffix predicate on the "client_username" field.
+func ClientUsernameHasSuffix(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldHasSuffix(FieldClientUsername, v))
+}
+
+// ClientUsernameEqualFold applies the EqualFold predicate on the "client_username" field.
+func ClientUsernameEqualFold(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEqualFold(FieldClientUsername, v))
+}
+
+// ClientUsernameContainsFold applies the ContainsFold predicate on the "client_username" field.
+func ClientUsernameContainsFold(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldContainsFold(FieldClientUsername, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldContainsFold(FieldName, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLTE(FieldAmount, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNEQ(FieldActive, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ClientAddon) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ClientAddon) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ClientAddon) predicate.ClientAddon {
+	return predicate.ClientAddon(sql.NotPredicates(p))
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/clientaddon"
+)
+
+// ClientAddonCreate is the builder for creating a ClientAddon entity.
+type ClientAddonCreate struct {
+	config
+	mutation *ClientAddonMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (cac *ClientAddonCreate) SetClientID(i int) *ClientAddonCreate {
+	cac.mutation.SetClientID(i)
+	return cac
+}
+
+// SetClientUsername sets the "client_username" field.
+func (cac *ClientAddonCreate) SetClientUsername(s string) *ClientAddonCreate {
+	cac.mutation.SetClientUsername(s)
+	return cac
+}
+
+// SetName sets the "name" field.
+func (cac *ClientAddonCreate) SetName(s string) *ClientAddonCreate {
+	cac.mutation.SetName(s)
+	return cac
+}
+
+// SetAmount sets the "amount" field.
+func (cac *ClientAddonCreate) SetAmount(f float64) *ClientAddonCreate {
+	cac.mutation.SetAmount(f)
+	return cac
+}
+
+// SetActive sets the "active" field.
+func (cac *ClientAddonCreate) SetActive(b bool) *ClientAddonCreate {
+	cac.mutation.SetActive(b)
+	return cac
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (cac *ClientAddonCreate) SetNillableActive(b *bool) *ClientAddonCreate {
+	if b != nil {
+		cac.SetActive(*b)
+	}
+	return cac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cac *ClientAddonCreate) SetCreatedBy(s string) *ClientAddonCreate {
+	cac.mutation.SetCreatedBy(s)
+	return cac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cac *ClientAddonCreate) SetCreatedAt(t time.Time) *ClientAddonCreate {
+	cac.mutation.SetCreatedAt(t)
+	return cac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cac *ClientAddonCreate) SetNillableCreatedAt(t *time.Time) *ClientAddonCreate {
+	if t != nil {
+		cac.SetCreatedAt(*t)
+	}
+	return cac
+}
+
+// Mutation returns the ClientAddonMutation object of the builder.
+func (cac *ClientAddonCreate) Mutation() *ClientAddonMutation {
+	return cac.mutation
+}
+
+// Save creates the ClientAddon in the database.
+func (cac *ClientAddonCreate) Save(ctx context.Context) (*ClientAddon, error) {
+	cac.defaults()
+	return withHooks(ctx, cac.sqlSave, cac.mutation, cac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cac *ClientAddonCreate) SaveX(ctx context.Context) *ClientAddon {
+	v, err := cac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cac *ClientAddonCreate) Exec(ctx context.Context) error {
+	_, err := cac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cac *ClientAddonCreate) ExecX(ctx context.Context) {
+	if err := cac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cac *ClientAddonCreate) defaults() {
+	if _, ok := cac.mutation.Active(); !ok {
+		v := clientaddon.DefaultActive
+		cac.mutation.SetActive(v)
+	}
+	if _, ok := cac.mutation.CreatedAt(); !ok {
+		v := clientaddon.DefaultCreatedAt()
+		cac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cac *ClientAddonCreate) check() error {
+	if _, ok := cac.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "ClientAddon.client_id"`)}
+	}
+	if v, ok := cac.mutation.ClientID(); ok {
+		if err := clientaddon.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.client_id": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.ClientUsername(); !ok {
+		return &ValidationError{Name: "client_username", err: errors.New(`ent: missing required field "ClientAddon.client_username"`)}
+	}
+	if v, ok := cac.mutation.ClientUsername(); ok {
+		if err := clientaddon.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.client_username": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ClientAddon.name"`)}
+	}
+	if v, ok := cac.mutation.Name(); ok {
+		if err := clientaddon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.name": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "ClientAddon.amount"`)}
+	}
+	if v, ok := cac.mutation.Amount(); ok {
+		if err := clientaddon.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.amount": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "ClientAddon.active"`)}
+	}
+	if _, ok := cac.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ClientAddon.created_by"`)}
+	}
+	if v, ok := cac.mutation.CreatedBy(); ok {
+		if err := clientaddon.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.created_by": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ClientAddon.created_at"`)}
+	}
+	return nil
+}
+
+func (cac *ClientAddonCreate) sqlSave(ctx context.Context) (*ClientAddon, error) {
+	if err := cac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cac.mutation.id = &_node.ID
+	cac.mutation.done = true
+	return _node, nil
+}
+
+func (cac *ClientAddonCreate) createSpec() (*ClientAddon, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClientAddon{config: cac.config}
+		_spec = sqlgraph.NewCreateSpec(clientaddon.Table, sqlgraph.NewFieldSpec(clientaddon.FieldID, field.TypeInt))
+	)
+	if value, ok := cac.mutation.ClientID(); ok {
+		_spec.SetField(clientaddon.FieldClientID, field.TypeInt, value)
+		_node.ClientID = value
+	}
+	if value, ok := cac.mutation.ClientUsername(); ok {
+		_spec.SetField(clientaddon.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := cac.mutation.Name(); ok {
+		_spec.SetField(clientaddon.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cac.mutation.Amount(); ok {
+		_spec.SetField(clientaddon.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := cac.mutation.Active(); ok {
+		_spec.SetField(clientaddon.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := cac.mutation.CreatedBy(); ok {
+		_spec.SetField(clientaddon.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := cac.mutation.CreatedAt(); ok {
+		_spec.SetField(clientaddon.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ClientAddonCreateBulk is the builder for creating many ClientAddon entities in bulk.
+type ClientAddonCreateBulk struct {
+	config
+	err      error
+	builders []*ClientAddonCreate
+}
+
+// Save creates the ClientAddon entities in the database.
+func (cacb *ClientAddonCreateBulk) Save(ctx context.Context) ([]*ClientAddon, error) {
+	if cacb.err != nil {
+		return nil, cacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cacb.builders))
+	nodes := make([]*ClientAddon, len(cacb.builders))
+	mutators := make([]Mutator, len(cacb.builders))
+	for i := range cacb.builders {
+		func(i int, root context.Context) {
+			builder := cacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClientAddonMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cacb *ClientAddonCreateBulk) SaveX(ctx context.Context) []*ClientAddon {
+	v, err := cacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cacb *ClientAddonCreateBulk) Exec(ctx context.Context) error {
+	_, err := cacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacb *ClientAddonCreateBulk) ExecX(ctx context.Context) {
+	if err := cacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
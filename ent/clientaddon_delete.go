@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/clientaddon"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ClientAddonDelete is the builder for deleting a ClientAddon entity.
+type ClientAddonDelete struct {
+	config
+	hooks    []Hook
+	mutation *ClientAddonMutation
+}
+
+// Where appends a list predicates to the ClientAddonDelete builder.
+func (cad *ClientAddonDelete) Where(ps ...predicate.ClientAddon) *ClientAddonDelete {
+	cad.mutation.Where(ps...)
+	return cad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cad *ClientAddonDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cad.sqlExec, cad.mutation, cad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cad *ClientAddonDelete) ExecX(ctx context.Context) int {
+	n, err := cad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cad *ClientAddonDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(clientaddon.Table, sqlgraph.NewFieldSpec(clientaddon.FieldID, field.TypeInt))
+	if ps := cad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cad.mutation.done = true
+	return affected, err
+}
+
+// ClientAddonDeleteOne is the builder for deleting a single ClientAddon entity.
+type ClientAddonDeleteOne struct {
+	cad *ClientAddonDelete
+}
+
+// Where appends a list predicates to the ClientAddonDelete builder.
+func (cado *ClientAddonDeleteOne) Where(ps ...predicate.ClientAddon) *ClientAddonDeleteOne {
+	cado.cad.mutation.Where(ps...)
+	return cado
+}
+
+// Exec executes the deletion query.
+func (cado *ClientAddonDeleteOne) Exec(ctx context.Context) error {
+	n, err := cado.cad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{clientaddon.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cado *ClientAddonDeleteOne) ExecX(ctx context.Context) {
+	if err := cado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
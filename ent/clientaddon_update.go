@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/clientaddon"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ClientAddonUpdate is the builder for updating ClientAddon entities.
+type ClientAddonUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClientAddonMutation
+}
+
+// Where appends a list predicates to the ClientAddonUpdate builder.
+func (cau *ClientAddonUpdate) Where(ps ...predicate.ClientAddon) *ClientAddonUpdate {
+	cau.mutation.Where(ps...)
+	return cau
+}
+
+// SetClientID sets the "client_id" field.
+func (cau *ClientAddonUpdate) SetClientID(i int) *ClientAddonUpdate {
+	cau.mutation.ResetClientID()
+	cau.mutation.SetClientID(i)
+	return cau
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (cau *ClientAddonUpdate) SetNillableClientID(i *int) *ClientAddonUpdate {
+	if i != nil {
+		cau.SetClientID(*i)
+	}
+	return cau
+}
+
+// AddClientID adds i to the "client_id" field.
+func (cau *ClientAddonUpdate) AddClientID(i int) *ClientAddonUpdate {
+	cau.mutation.AddClientID(i)
+	return cau
+}
+
+// SetClientUsername sets the "client_username" field.
+func (cau *ClientAddonUpdate) SetClientUsername(s string) *ClientAddonUpdate {
+	cau.mutation.SetClientUsername(s)
+	return cau
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (cau *ClientAddonUpdate) SetNillableClientUsername(s *string) *ClientAddonUpdate {
+	if s != nil {
+		cau.SetClientUsername(*s)
+	}
+	return cau
+}
+
+// SetName sets the "name" field.
+func (cau *ClientAddonUpdate) SetName(s string) *ClientAddonUpdate {
+	cau.mutation.SetName(s)
+	return cau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cau *ClientAddonUpdate) SetNillableName(s *string) *ClientAddonUpdate {
+	if s != nil {
+		cau.SetName(*s)
+	}
+	return cau
+}
+
+// SetAmount sets the "amount" field.
+func (cau *ClientAddonUpdate) SetAmount(f float64) *ClientAddonUpdate {
+	cau.mutation.ResetAmount()
+	cau.mutation.SetAmount(f)
+	return cau
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (cau *ClientAddonUpdate) SetNillableAmount(f *float64) *ClientAddonUpdate {
+	if f != nil {
+		cau.SetAmount(*f)
+	}
+	return cau
+}
+
+// AddAmount adds f to the "amount" field.
+func (cau *ClientAddonUpdate) AddAmount(f float64) *ClientAddonUpdate {
+	cau.mutation.AddAmount(f)
+	return cau
+}
+
+// SetActive sets the "active" field.
+func (cau *ClientAddonUpdate) SetActive(b bool) *ClientAddonUpdate {
+	cau.mutation.SetActive(b)
+	return cau
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (cau *ClientAddonUpdate) SetNillableActive(b *bool) *ClientAddonUpdate {
+	if b != nil {
+		cau.SetActive(*b)
+	}
+	return cau
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cau *ClientAddonUpdate) SetCreatedBy(s string) *ClientAddonUpdate {
+	cau.mutation.SetCreatedBy(s)
+	return cau
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cau *ClientAddonUpdate) SetNillableCreatedBy(s *string) *ClientAddonUpdate {
+	if s != nil {
+		cau.SetCreatedBy(*s)
+	}
+	return cau
+}
+
+// Mutation returns the ClientAddonMutation object of the builder.
+func (cau *ClientAddonUpdate) Mutation() *ClientAddonMutation {
+	return cau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cau *ClientAddonUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cau.sqlSave, cau.mutation, cau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cau *ClientAddonUpdate) SaveX(ctx context.Context) int {
+	affected, err := cau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cau *ClientAddonUpdate) Exec(ctx context.Context) error {
+	_, err := cau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cau *ClientAddonUpdate) ExecX(ctx context.Context) {
+	if err := cau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cau *ClientAddonUpdate) check() error {
+	if v, ok := cau.mutation.ClientID(); ok {
+		if err := clientaddon.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.client_id": %w`, err)}
+		}
+	}
+	if v, ok := cau.mutation.ClientUsername(); ok {
+		if err := clientaddon.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.client_username": %w`, err)}
+		}
+	}
+	if v, ok := cau.mutation.Name(); ok {
+		if err := clientaddon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.name": %w`, err)}
+		}
+	}
+	if v, ok := cau.mutation.Amount(); ok {
+		if err := clientaddon.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.amount": %w`, err)}
+		}
+	}
+	if v, ok := cau.mutation.CreatedBy(); ok {
+		if err := clientaddon.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cau *ClientAddonUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientaddon.Table, clientaddon.Columns, sqlgraph.NewFieldSpec(clientaddon.FieldID, field.TypeInt))
+	if ps := cau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cau.mutation.ClientID(); ok {
+		_spec.SetField(clientaddon.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := cau.mutation.AddedClientID(); ok {
+		_spec.AddField(clientaddon.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := cau.mutation.ClientUsername(); ok {
+		_spec.SetField(clientaddon.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := cau.mutation.Name(); ok {
+		_spec.SetField(clientaddon.FieldName, field.TypeString, value)
+	}
+	if value, ok := cau.mutation.Amount(); ok {
+		_spec.SetField(clientaddon.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.AddedAmount(); ok {
+		_spec.AddField(clientaddon.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.Active(); ok {
+		_spec.SetField(clientaddon.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := cau.mutation.CreatedBy(); ok {
+		_spec.SetField(clientaddon.FieldCreatedBy, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientaddon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cau.mutation.done = true
+	return n, nil
+}
+
+// ClientAddonUpdateOne is the builder for updating a single ClientAddon entity.
+type ClientAddonUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClientAddonMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (cauo *ClientAddonUpdateOne) SetClientID(i int) *ClientAddonUpdateOne {
+	cauo.mutation.ResetClientID()
+	cauo.mutation.SetClientID(i)
+	return cauo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (cauo *ClientAddonUpdateOne) SetNillableClientID(i *int) *ClientAddonUpdateOne {
+	if i != nil {
+		cauo.SetClientID(*i)
+	}
+	return cauo
+}
+
+// AddClientID adds i to the "client_id" field.
+func (cauo *ClientAddonUpdateOne) AddClientID(i int) *ClientAddonUpdateOne {
+	cauo.mutation.AddClientID(i)
+	return cauo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (cauo *ClientAddonUpdateOne) SetClientUsername(s string) *ClientAddonUpdateOne {
+	cauo.mutation.SetClientUsername(s)
+	return cauo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (cauo *ClientAddonUpdateOne) SetNillableClientUsername(s *string) *ClientAddonUpdateOne {
+	if s != nil {
+		cauo.SetClientUsername(*s)
+	}
+	return cauo
+}
+
+// SetName sets the "name" field.
+func (cauo *ClientAddonUpdateOne) SetName(s string) *ClientAddonUpdateOne {
+	cauo.mutation.SetName(s)
+	return cauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cauo *ClientAddonUpdateOne) SetNillableName(s *string) *ClientAddonUpdateOne {
+	if s != nil {
+		cauo.SetName(*s)
+	}
+	return cauo
+}
+
+// SetAmount sets the "amount" field.
+func (cauo *ClientAddonUpdateOne) SetAmount(f float64) *ClientAddonUpdateOne {
+	cauo.mutation.ResetAmount()
+	cauo.mutation.SetAmount(f)
+	return cauo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (cauo *ClientAddonUpdateOne) SetNillableAmount(f *float64) *ClientAddonUpdateOne {
+	if f != nil {
+		cauo.SetAmount(*f)
+	}
+	return cauo
+}
+
+// AddAmount adds f to the "amount" field.
+func (cauo *ClientAddonUpdateOne) AddAmount(f float64) *ClientAddonUpdateOne {
+	cauo.mutation.AddAmount(f)
+	return cauo
+}
+
+// SetActive sets the "active" field.
+func (cauo *ClientAddonUpdateOne) SetActive(b bool) *ClientAddonUpdateOne {
+	cauo.mutation.SetActive(b)
+	return cauo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (cauo *ClientAddonUpdateOne) SetNillableActive(b *bool) *ClientAddonUpdateOne {
+	if b != nil {
+		cauo.SetActive(*b)
+	}
+	return cauo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cauo *ClientAddonUpdateOne) SetCreatedBy(s string) *ClientAddonUpdateOne {
+	cauo.mutation.SetCreatedBy(s)
+	return cauo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cauo *ClientAddonUpdateOne) SetNillableCreatedBy(s *string) *ClientAddonUpdateOne {
+	if s != nil {
+		cauo.SetCreatedBy(*s)
+	}
+	return cauo
+}
+
+// Mutation returns the ClientAddonMutation object of the builder.
+func (cauo *ClientAddonUpdateOne) Mutation() *ClientAddonMutation {
+	return cauo.mutation
+}
+
+// Where appends a list predicates to the ClientAddonUpdate builder.
+func (cauo *ClientAddonUpdateOne) Where(ps ...predicate.ClientAddon) *ClientAddonUpdateOne {
+	cauo.mutation.Where(ps...)
+	return cauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cauo *ClientAddonUpdateOne) Select(field string, fields ...string) *ClientAddonUpdateOne {
+	cauo.fields = append([]string{field}, fields...)
+	return cauo
+}
+
+// Save executes the query and returns the updated ClientAddon entity.
+func (cauo *ClientAddonUpdateOne) Save(ctx context.Context) (*ClientAddon, error) {
+	return withHooks(ctx, cauo.sqlSave, cauo.mutation, cauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cauo *ClientAddonUpdateOne) SaveX(ctx context.Context) *ClientAddon {
+	node, err := cauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cauo *ClientAddonUpdateOne) Exec(ctx context.Context) error {
+	_, err := cauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cauo *ClientAddonUpdateOne) ExecX(ctx context.Context) {
+	if err := cauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cauo *ClientAddonUpdateOne) check() error {
+	if v, ok := cauo.mutation.ClientID(); ok {
+		if err := clientaddon.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.client_id": %w`, err)}
+		}
+	}
+	if v, ok := cauo.mutation.ClientUsername(); ok {
+		if err := clientaddon.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.client_username": %w`, err)}
+		}
+	}
+	if v, ok := cauo.mutation.Name(); ok {
+		if err := clientaddon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.name": %w`, err)}
+		}
+	}
+	if v, ok := cauo.mutation.Amount(); ok {
+		if err := clientaddon.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.amount": %w`, err)}
+		}
+	}
+	if v, ok := cauo.mutation.CreatedBy(); ok {
+		if err := clientaddon.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "ClientAddon.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cauo *ClientAddonUpdateOne) sqlSave(ctx context.Context) (_node *ClientAddon, err error) {
+	if err := cauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientaddon.Table, clientaddon.Columns, sqlgraph.NewFieldSpec(clientaddon.FieldID, field.TypeInt))
+	id, ok := cauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClientAddon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientaddon.FieldID)
+		for _, f := range fields {
+			if !clientaddon.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != clientaddon.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cauo.mutation.ClientID(); ok {
+		_spec.SetField(clientaddon.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := cauo.mutation.AddedClientID(); ok {
+		_spec.AddField(clientaddon.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := cauo.mutation.ClientUsername(); ok {
+		_spec.SetField(clientaddon.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := cauo.mutation.Name(); ok {
+		_spec.SetField(clientaddon.FieldName, field.TypeString, value)
+	}
+	if value, ok := cauo.mutation.Amount(); ok {
+		_spec.SetField(clientaddon.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.AddedAmount(); ok {
+		_spec.AddField(clientaddon.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.Active(); ok {
+		_spec.SetField(clientaddon.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := cauo.mutation.CreatedBy(); ok {
+		_spec.SetField(clientaddon.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &ClientAddon{config: cauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientaddon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cauo.mutation.done = true
+	return _node, nil
+}
@@ -29,8 +29,24 @@ type ClientTxn struct {
 	TotalBalance float64 `json:"total_balance,omitempty"`
 	// PaymentMethod holds the value of the "payment_method" field.
 	PaymentMethod *clienttxn.PaymentMethod `json:"payment_method,omitempty"`
+	// Transaction ID assigned by the payment gateway, if any
+	GatewayRef string `json:"gateway_ref,omitempty"`
 	// ClientUsername holds the value of the "client_username" field.
 	ClientUsername string `json:"client_username,omitempty"`
+	// Taken off the price by a coupon, amount is what was charged after it
+	Discount float64 `json:"discount,omitempty"`
+	// CouponCode holds the value of the "coupon_code" field.
+	CouponCode string `json:"coupon_code,omitempty"`
+	// Amount before tax of a charge for a service, 0 for payments and other balance movements
+	NetAmount float64 `json:"net_amount,omitempty"`
+	// Tax included in amount, which is the gross
+	TaxAmount float64 `json:"tax_amount,omitempty"`
+	// TaxRate holds the value of the "tax_rate" field.
+	TaxRate float64 `json:"tax_rate,omitempty"`
+	// Service a prepaid charge was for. Postpaid invoices mix services, see their lines.
+	ServiceType *clienttxn.ServiceType `json:"service_type,omitempty"`
+	// Days of service a renewal or package change paid for, 0 for other transactions
+	ServiceDays int `json:"service_days,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// TransactionDate holds the value of the "transaction_date" field.
@@ -45,11 +61,11 @@ func (*ClientTxn) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case clienttxn.FieldAmount, clienttxn.FieldTotalBalance:
+		case clienttxn.FieldAmount, clienttxn.FieldTotalBalance, clienttxn.FieldDiscount, clienttxn.FieldNetAmount, clienttxn.FieldTaxAmount, clienttxn.FieldTaxRate:
 			values[i] = new(sql.NullFloat64)
-		case clienttxn.FieldID:
+		case clienttxn.FieldID, clienttxn.FieldServiceDays:
 			values[i] = new(sql.NullInt64)
-		case clienttxn.FieldTransactionRef, clienttxn.FieldType, clienttxn.FieldStatus, clienttxn.FieldPaymentMethod, clienttxn.FieldClientUsername, clienttxn.FieldDescription, clienttxn.FieldCreatedBy:
+		case clienttxn.FieldTransactionRef, clienttxn.FieldType, clienttxn.FieldStatus, clienttxn.FieldPaymentMethod, clienttxn.FieldGatewayRef, clienttxn.FieldClientUsername, clienttxn.FieldCouponCode, clienttxn.FieldServiceType, clienttxn.FieldDescription, clienttxn.FieldCreatedBy:
 			values[i] = new(sql.NullString)
 		case clienttxn.FieldTransactionDate:
 			values[i] = new(sql.NullTime)
@@ -111,12 +127,61 @@ func (ct *ClientTxn) assignValues(columns []string, values []any) error {
 				ct.PaymentMethod = new(clienttxn.PaymentMethod)
 				*ct.PaymentMethod = clienttxn.PaymentMethod(value.String)
 			}
+		case clienttxn.FieldGatewayRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_ref", values[i])
+			} else if value.Valid {
+				ct.GatewayRef = value.String
+			}
 		case clienttxn.FieldClientUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field client_username", values[i])
 			} else if value.Valid {
 				ct.ClientUsername = value.String
 			}
+		case clienttxn.FieldDiscount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount", values[i])
+			} else if value.Valid {
+				ct.Discount = value.Float64
+			}
+		case clienttxn.FieldCouponCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_code", values[i])
+			} else if value.Valid {
+				ct.CouponCode = value.String
+			}
+		case clienttxn.FieldNetAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field net_amount", values[i])
+			} else if value.Valid {
+				ct.NetAmount = value.Float64
+			}
+		case clienttxn.FieldTaxAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value.Valid {
+				ct.TaxAmount = value.Float64
+			}
+		case clienttxn.FieldTaxRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value.Valid {
+				ct.TaxRate = value.Float64
+			}
+		case clienttxn.FieldServiceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field service_type", values[i])
+			} else if value.Valid {
+				ct.ServiceType = new(clienttxn.ServiceType)
+				*ct.ServiceType = clienttxn.ServiceType(value.String)
+			}
+		case clienttxn.FieldServiceDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field service_days", values[i])
+			} else if value.Valid {
+				ct.ServiceDays = int(value.Int64)
+			}
 		case clienttxn.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
@@ -191,9 +256,35 @@ func (ct *ClientTxn) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("gateway_ref=")
+	builder.WriteString(ct.GatewayRef)
+	builder.WriteString(", ")
 	builder.WriteString("client_username=")
 	builder.WriteString(ct.ClientUsername)
 	builder.WriteString(", ")
+	builder.WriteString("discount=")
+	builder.WriteString(fmt.Sprintf("%v", ct.Discount))
+	builder.WriteString(", ")
+	builder.WriteString("coupon_code=")
+	builder.WriteString(ct.CouponCode)
+	builder.WriteString(", ")
+	builder.WriteString("net_amount=")
+	builder.WriteString(fmt.Sprintf("%v", ct.NetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", ct.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", ct.TaxRate))
+	builder.WriteString(", ")
+	if v := ct.ServiceType; v != nil {
+		builder.WriteString("service_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("service_days=")
+	builder.WriteString(fmt.Sprintf("%v", ct.ServiceDays))
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(ct.Description)
 	builder.WriteString(", ")
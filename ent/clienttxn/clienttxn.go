@@ -26,8 +26,24 @@ const (
 	FieldTotalBalance = "total_balance"
 	// FieldPaymentMethod holds the string denoting the payment_method field in the database.
 	FieldPaymentMethod = "payment_method"
+	// FieldGatewayRef holds the string denoting the gateway_ref field in the database.
+	FieldGatewayRef = "gateway_ref"
 	// FieldClientUsername holds the string denoting the client_username field in the database.
 	FieldClientUsername = "client_username"
+	// FieldDiscount holds the string denoting the discount field in the database.
+	FieldDiscount = "discount"
+	// FieldCouponCode holds the string denoting the coupon_code field in the database.
+	FieldCouponCode = "coupon_code"
+	// FieldNetAmount holds the string denoting the net_amount field in the database.
+	FieldNetAmount = "net_amount"
+	// FieldTaxAmount holds the string denoting the tax_amount field in the database.
+	FieldTaxAmount = "tax_amount"
+	// FieldTaxRate holds the string denoting the tax_rate field in the database.
+	FieldTaxRate = "tax_rate"
+	// FieldServiceType holds the string denoting the service_type field in the database.
+	FieldServiceType = "service_type"
+	// FieldServiceDays holds the string denoting the service_days field in the database.
+	FieldServiceDays = "service_days"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
 	// FieldTransactionDate holds the string denoting the transaction_date field in the database.
@@ -47,7 +63,15 @@ var Columns = []string{
 	FieldStatus,
 	FieldTotalBalance,
 	FieldPaymentMethod,
+	FieldGatewayRef,
 	FieldClientUsername,
+	FieldDiscount,
+	FieldCouponCode,
+	FieldNetAmount,
+	FieldTaxAmount,
+	FieldTaxRate,
+	FieldServiceType,
+	FieldServiceDays,
 	FieldDescription,
 	FieldTransactionDate,
 	FieldCreatedBy,
@@ -70,8 +94,22 @@ var (
 	DefaultAmount float64
 	// DefaultTotalBalance holds the default value on creation for the "total_balance" field.
 	DefaultTotalBalance float64
+	// GatewayRefValidator is a validator for the "gateway_ref" field. It is called by the builders before save.
+	GatewayRefValidator func(string) error
 	// ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
 	ClientUsernameValidator func(string) error
+	// DefaultDiscount holds the default value on creation for the "discount" field.
+	DefaultDiscount float64
+	// CouponCodeValidator is a validator for the "coupon_code" field. It is called by the builders before save.
+	CouponCodeValidator func(string) error
+	// DefaultNetAmount holds the default value on creation for the "net_amount" field.
+	DefaultNetAmount float64
+	// DefaultTaxAmount holds the default value on creation for the "tax_amount" field.
+	DefaultTaxAmount float64
+	// DefaultTaxRate holds the default value on creation for the "tax_rate" field.
+	DefaultTaxRate float64
+	// DefaultServiceDays holds the default value on creation for the "service_days" field.
+	DefaultServiceDays int
 	// DefaultTransactionDate holds the default value on creation for the "transaction_date" field.
 	DefaultTransactionDate func() time.Time
 	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
@@ -93,6 +131,9 @@ const (
 	TypeAUTO_RENEWAL      Type = "AUTO_RENEWAL"
 	TypePACKAGE_MIGRATION Type = "PACKAGE_MIGRATION"
 	TypeADVANCE_PAYMENT   Type = "ADVANCE_PAYMENT"
+	TypeRECHARGE          Type = "RECHARGE"
+	TypeADJUSTMENT        Type = "ADJUSTMENT"
+	TypePOSTPAID_INVOICE  Type = "POSTPAID_INVOICE"
 )
 
 func (_type Type) String() string {
@@ -102,7 +143,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeACTIVE, TypeRENEWAL, TypeREFUND, TypeTRANSFER_REFUND, TypeTRANSFER_RECEIVED, TypeAUTO_RENEWAL, TypePACKAGE_MIGRATION, TypeADVANCE_PAYMENT:
+	case TypeACTIVE, TypeRENEWAL, TypeREFUND, TypeTRANSFER_REFUND, TypeTRANSFER_RECEIVED, TypeAUTO_RENEWAL, TypePACKAGE_MIGRATION, TypeADVANCE_PAYMENT, TypeRECHARGE, TypeADJUSTMENT, TypePOSTPAID_INVOICE:
 		return nil
 	default:
 		return fmt.Errorf("clienttxn: invalid enum value for type field: %q", _type)
@@ -171,6 +212,29 @@ func PaymentMethodValidator(pm PaymentMethod) error {
 	}
 }
 
+// ServiceType defines the type for the "service_type" enum field.
+type ServiceType string
+
+// ServiceType values.
+const (
+	ServiceTypeInternet ServiceType = "internet"
+	ServiceTypeAddon    ServiceType = "addon"
+)
+
+func (st ServiceType) String() string {
+	return string(st)
+}
+
+// ServiceTypeValidator is a validator for the "service_type" field enum values. It is called by the builders before save.
+func ServiceTypeValidator(st ServiceType) error {
+	switch st {
+	case ServiceTypeInternet, ServiceTypeAddon:
+		return nil
+	default:
+		return fmt.Errorf("clienttxn: invalid enum value for service_type field: %q", st)
+	}
+}
+
 // OrderOption defines the ordering options for the ClientTxn queries.
 type OrderOption func(*sql.Selector)
 
@@ -209,11 +273,51 @@ func ByPaymentMethod(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPaymentMethod, opts...).ToFunc()
 }
 
+// ByGatewayRef orders the results by the gateway_ref field.
+func ByGatewayRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayRef, opts...).ToFunc()
+}
+
 // ByClientUsername orders the results by the client_username field.
 func ByClientUsername(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClientUsername, opts...).ToFunc()
 }
 
+// ByDiscount orders the results by the discount field.
+func ByDiscount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscount, opts...).ToFunc()
+}
+
+// ByCouponCode orders the results by the coupon_code field.
+func ByCouponCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponCode, opts...).ToFunc()
+}
+
+// ByNetAmount orders the results by the net_amount field.
+func ByNetAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNetAmount, opts...).ToFunc()
+}
+
+// ByTaxAmount orders the results by the tax_amount field.
+func ByTaxAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxAmount, opts...).ToFunc()
+}
+
+// ByTaxRate orders the results by the tax_rate field.
+func ByTaxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTaxRate, opts...).ToFunc()
+}
+
+// ByServiceType orders the results by the service_type field.
+func ByServiceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServiceType, opts...).ToFunc()
+}
+
+// ByServiceDays orders the results by the service_days field.
+func ByServiceDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServiceDays, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
@@ -69,11 +69,46 @@ func TotalBalance(v float64) predicate.ClientTxn {
 	return predicate.ClientTxn(sql.FieldEQ(FieldTotalBalance, v))
 }
 
+// GatewayRef applies equality check predicate on the "gateway_ref" field. It's identical to GatewayRefEQ.
+func GatewayRef(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldGatewayRef, v))
+}
+
 // ClientUsername applies equality check predicate on the "client_username" field. It's identical to ClientUsernameEQ.
 func ClientUsername(v string) predicate.ClientTxn {
 	return predicate.ClientTxn(sql.FieldEQ(FieldClientUsername, v))
 }
 
+// Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
+func Discount(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldDiscount, v))
+}
+
+// CouponCode applies equality check predicate on the "coupon_code" field. It's identical to CouponCodeEQ.
+func CouponCode(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldCouponCode, v))
+}
+
+// NetAmount applies equality check predicate on the "net_amount" field. It's identical to NetAmountEQ.
+func NetAmount(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldNetAmount, v))
+}
+
+// TaxAmount applies equality check predicate on the "tax_amount" field. It's identical to TaxAmountEQ.
+func TaxAmount(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TaxRate applies equality check predicate on the "tax_rate" field. It's identical to TaxRateEQ.
+func TaxRate(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldTaxRate, v))
+}
+
+// ServiceDays applies equality check predicate on the "service_days" field. It's identical to ServiceDaysEQ.
+func ServiceDays(v int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldServiceDays, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.ClientTxn {
 	return predicate.ClientTxn(sql.FieldEQ(FieldDescription, v))
@@ -304,6 +339,81 @@ func PaymentMethodNotNil() predicate.ClientTxn {
 	return predicate.ClientTxn(sql.FieldNotNull(FieldPaymentMethod))
 }
 
+// GatewayRefEQ applies the EQ predicate on the "gateway_ref" field.
+func GatewayRefEQ(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldGatewayRef, v))
+}
+
+// GatewayRefNEQ applies the NEQ predicate on the "gateway_ref" field.
+func GatewayRefNEQ(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNEQ(FieldGatewayRef, v))
+}
+
+// GatewayRefIn applies the In predicate on the "gateway_ref" field.
+func GatewayRefIn(vs ...string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIn(FieldGatewayRef, vs...))
+}
+
+// GatewayRefNotIn applies the NotIn predicate on the "gateway_ref" field.
+func GatewayRefNotIn(vs ...string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotIn(FieldGatewayRef, vs...))
+}
+
+// GatewayRefGT applies the GT predicate on the "gateway_ref" field.
+func GatewayRefGT(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGT(FieldGatewayRef, v))
+}
+
+// GatewayRefGTE applies the GTE predicate on the "gateway_ref" field.
+func GatewayRefGTE(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGTE(FieldGatewayRef, v))
+}
+
+// GatewayRefLT applies the LT predicate on the "gateway_ref" field.
+func GatewayRefLT(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLT(FieldGatewayRef, v))
+}
+
+// GatewayRefLTE applies the LTE predicate on the "gateway_ref" field.
+func GatewayRefLTE(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLTE(FieldGatewayRef, v))
+}
+
+// GatewayRefContains applies the Contains predicate on the "gateway_ref" field.
+func GatewayRefContains(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldContains(FieldGatewayRef, v))
+}
+
+// GatewayRefHasPrefix applies the HasPrefix predicate on the "gateway_ref" field.
+func GatewayRefHasPrefix(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldHasPrefix(FieldGatewayRef, v))
+}
+
+// GatewayRefHasSuffix applies the HasSuffix predicate on the "gateway_ref" field.
+func GatewayRefHasSuffix(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldHasSuffix(FieldGatewayRef, v))
+}
+
+// GatewayRefIsNil applies the IsNil predicate on the "gateway_ref" field.
+func GatewayRefIsNil() predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIsNull(FieldGatewayRef))
+}
+
+// GatewayRefNotNil applies the NotNil predicate on the "gateway_ref" field.
+func GatewayRefNotNil() predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotNull(FieldGatewayRef))
+}
+
+// GatewayRefEqualFold applies the EqualFold predicate on the "gateway_ref" field.
+func GatewayRefEqualFold(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEqualFold(FieldGatewayRef, v))
+}
+
+// GatewayRefContainsFold applies the ContainsFold predicate on the "gateway_ref" field.
+func GatewayRefContainsFold(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldContainsFold(FieldGatewayRef, v))
+}
+
 // ClientUsernameEQ applies the EQ predicate on the "client_username" field.
 func ClientUsernameEQ(v string) predicate.ClientTxn {
 	return predicate.ClientTxn(sql.FieldEQ(FieldClientUsername, v))
@@ -379,6 +489,311 @@ func ClientUsernameContainsFold(v string) predicate.ClientTxn {
 	return predicate.ClientTxn(sql.FieldContainsFold(FieldClientUsername, v))
 }
 
+// DiscountEQ applies the EQ predicate on the "discount" field.
+func DiscountEQ(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldDiscount, v))
+}
+
+// DiscountNEQ applies the NEQ predicate on the "discount" field.
+func DiscountNEQ(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNEQ(FieldDiscount, v))
+}
+
+// DiscountIn applies the In predicate on the "discount" field.
+func DiscountIn(vs ...float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIn(FieldDiscount, vs...))
+}
+
+// DiscountNotIn applies the NotIn predicate on the "discount" field.
+func DiscountNotIn(vs ...float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotIn(FieldDiscount, vs...))
+}
+
+// DiscountGT applies the GT predicate on the "discount" field.
+func DiscountGT(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGT(FieldDiscount, v))
+}
+
+// DiscountGTE applies the GTE predicate on the "discount" field.
+func DiscountGTE(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGTE(FieldDiscount, v))
+}
+
+// DiscountLT applies the LT predicate on the "discount" field.
+func DiscountLT(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLT(FieldDiscount, v))
+}
+
+// DiscountLTE applies the LTE predicate on the "discount" field.
+func DiscountLTE(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLTE(FieldDiscount, v))
+}
+
+// CouponCodeEQ applies the EQ predicate on the "coupon_code" field.
+func CouponCodeEQ(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldCouponCode, v))
+}
+
+// CouponCodeNEQ applies the NEQ predicate on the "coupon_code" field.
+func CouponCodeNEQ(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNEQ(FieldCouponCode, v))
+}
+
+// CouponCodeIn applies the In predicate on the "coupon_code" field.
+func CouponCodeIn(vs ...string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIn(FieldCouponCode, vs...))
+}
+
+// CouponCodeNotIn applies the NotIn predicate on the "coupon_code" field.
+func CouponCodeNotIn(vs ...string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotIn(FieldCouponCode, vs...))
+}
+
+// CouponCodeGT applies the GT predicate on the "coupon_code" field.
+func CouponCodeGT(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGT(FieldCouponCode, v))
+}
+
+// CouponCodeGTE applies the GTE predicate on the "coupon_code" field.
+func CouponCodeGTE(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGTE(FieldCouponCode, v))
+}
+
+// CouponCodeLT applies the LT predicate on the "coupon_code" field.
+func CouponCodeLT(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLT(FieldCouponCode, v))
+}
+
+// CouponCodeLTE applies the LTE predicate on the "coupon_code" field.
+func CouponCodeLTE(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLTE(FieldCouponCode, v))
+}
+
+// CouponCodeContains applies the Contains predicate on the "coupon_code" field.
+func CouponCodeContains(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldContains(FieldCouponCode, v))
+}
+
+// CouponCodeHasPrefix applies the HasPrefix predicate on the "coupon_code" field.
+func CouponCodeHasPrefix(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldHasPrefix(FieldCouponCode, v))
+}
+
+// CouponCodeHasSuffix applies the HasSuffix predicate on the "coupon_code" field.
+func CouponCodeHasSuffix(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldHasSuffix(FieldCouponCode, v))
+}
+
+// CouponCodeIsNil applies the IsNil predicate on the "coupon_code" field.
+func CouponCodeIsNil() predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIsNull(FieldCouponCode))
+}
+
+// CouponCodeNotNil applies the NotNil predicate on the "coupon_code" field.
+func CouponCodeNotNil() predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotNull(FieldCouponCode))
+}
+
+// CouponCodeEqualFold applies the EqualFold predicate on the "coupon_code" field.
+func CouponCodeEqualFold(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEqualFold(FieldCouponCode, v))
+}
+
+// CouponCodeContainsFold applies the ContainsFold predicate on the "coupon_code" field.
+func CouponCodeContainsFold(v string) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldContainsFold(FieldCouponCode, v))
+}
+
+// NetAmountEQ applies the EQ predicate on the "net_amount" field.
+func NetAmountEQ(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldNetAmount, v))
+}
+
+// NetAmountNEQ applies the NEQ predicate on the "net_amount" field.
+func NetAmountNEQ(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNEQ(FieldNetAmount, v))
+}
+
+// NetAmountIn applies the In predicate on the "net_amount" field.
+func NetAmountIn(vs ...float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIn(FieldNetAmount, vs...))
+}
+
+// NetAmountNotIn applies the NotIn predicate on the "net_amount" field.
+func NetAmountNotIn(vs ...float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotIn(FieldNetAmount, vs...))
+}
+
+// NetAmountGT applies the GT predicate on the "net_amount" field.
+func NetAmountGT(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGT(FieldNetAmount, v))
+}
+
+// NetAmountGTE applies the GTE predicate on the "net_amount" field.
+func NetAmountGTE(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGTE(FieldNetAmount, v))
+}
+
+// NetAmountLT applies the LT predicate on the "net_amount" field.
+func NetAmountLT(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLT(FieldNetAmount, v))
+}
+
+// NetAmountLTE applies the LTE predicate on the "net_amount" field.
+func NetAmountLTE(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLTE(FieldNetAmount, v))
+}
+
+// TaxAmountEQ applies the EQ predicate on the "tax_amount" field.
+func TaxAmountEQ(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountNEQ applies the NEQ predicate on the "tax_amount" field.
+func TaxAmountNEQ(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNEQ(FieldTaxAmount, v))
+}
+
+// TaxAmountIn applies the In predicate on the "tax_amount" field.
+func TaxAmountIn(vs ...float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountNotIn applies the NotIn predicate on the "tax_amount" field.
+func TaxAmountNotIn(vs ...float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotIn(FieldTaxAmount, vs...))
+}
+
+// TaxAmountGT applies the GT predicate on the "tax_amount" field.
+func TaxAmountGT(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGT(FieldTaxAmount, v))
+}
+
+// TaxAmountGTE applies the GTE predicate on the "tax_amount" field.
+func TaxAmountGTE(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGTE(FieldTaxAmount, v))
+}
+
+// TaxAmountLT applies the LT predicate on the "tax_amount" field.
+func TaxAmountLT(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLT(FieldTaxAmount, v))
+}
+
+// TaxAmountLTE applies the LTE predicate on the "tax_amount" field.
+func TaxAmountLTE(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLTE(FieldTaxAmount, v))
+}
+
+// TaxRateEQ applies the EQ predicate on the "tax_rate" field.
+func TaxRateEQ(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldTaxRate, v))
+}
+
+// TaxRateNEQ applies the NEQ predicate on the "tax_rate" field.
+func TaxRateNEQ(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNEQ(FieldTaxRate, v))
+}
+
+// TaxRateIn applies the In predicate on the "tax_rate" field.
+func TaxRateIn(vs ...float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIn(FieldTaxRate, vs...))
+}
+
+// TaxRateNotIn applies the NotIn predicate on the "tax_rate" field.
+func TaxRateNotIn(vs ...float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotIn(FieldTaxRate, vs...))
+}
+
+// TaxRateGT applies the GT predicate on the "tax_rate" field.
+func TaxRateGT(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGT(FieldTaxRate, v))
+}
+
+// TaxRateGTE applies the GTE predicate on the "tax_rate" field.
+func TaxRateGTE(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGTE(FieldTaxRate, v))
+}
+
+// TaxRateLT applies the LT predicate on the "tax_rate" field.
+func TaxRateLT(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLT(FieldTaxRate, v))
+}
+
+// TaxRateLTE applies the LTE predicate on the "tax_rate" field.
+func TaxRateLTE(v float64) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLTE(FieldTaxRate, v))
+}
+
+// ServiceTypeEQ applies the EQ predicate on the "service_type" field.
+func ServiceTypeEQ(v ServiceType) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldServiceType, v))
+}
+
+// ServiceTypeNEQ applies the NEQ predicate on the "service_type" field.
+func ServiceTypeNEQ(v ServiceType) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNEQ(FieldServiceType, v))
+}
+
+// ServiceTypeIn applies the In predicate on the "service_type" field.
+func ServiceTypeIn(vs ...ServiceType) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIn(FieldServiceType, vs...))
+}
+
+// ServiceTypeNotIn applies the NotIn predicate on the "service_type" field.
+func ServiceTypeNotIn(vs ...ServiceType) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotIn(FieldServiceType, vs...))
+}
+
+// ServiceTypeIsNil applies the IsNil predicate on the "service_type" field.
+func ServiceTypeIsNil() predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIsNull(FieldServiceType))
+}
+
+// ServiceTypeNotNil applies the NotNil predicate on the "service_type" field.
+func ServiceTypeNotNil() predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotNull(FieldServiceType))
+}
+
+// ServiceDaysEQ applies the EQ predicate on the "service_days" field.
+func ServiceDaysEQ(v int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldEQ(FieldServiceDays, v))
+}
+
+// ServiceDaysNEQ applies the NEQ predicate on the "service_days" field.
+func ServiceDaysNEQ(v int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNEQ(FieldServiceDays, v))
+}
+
+// ServiceDaysIn applies the In predicate on the "service_days" field.
+func ServiceDaysIn(vs ...int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldIn(FieldServiceDays, vs...))
+}
+
+// ServiceDaysNotIn applies the NotIn predicate on the "service_days" field.
+func ServiceDaysNotIn(vs ...int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldNotIn(FieldServiceDays, vs...))
+}
+
+// ServiceDaysGT applies the GT predicate on the "service_days" field.
+func ServiceDaysGT(v int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGT(FieldServiceDays, v))
+}
+
+// ServiceDaysGTE applies the GTE predicate on the "service_days" field.
+func ServiceDaysGTE(v int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldGTE(FieldServiceDays, v))
+}
+
+// ServiceDaysLT applies the LT predicate on the "service_days" field.
+func ServiceDaysLT(v int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLT(FieldServiceDays, v))
+}
+
+// ServiceDaysLTE applies the LTE predicate on the "service_days" field.
+func ServiceDaysLTE(v int) predicate.ClientTxn {
+	return predicate.ClientTxn(sql.FieldLTE(FieldServiceDays, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.ClientTxn {
 	return predicate.ClientTxn(sql.FieldEQ(FieldDescription, v))
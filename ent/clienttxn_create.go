@@ -88,6 +88,20 @@ func (ctc *ClientTxnCreate) SetNillablePaymentMethod(cm *clienttxn.PaymentMethod
 	return ctc
 }
 
+// SetGatewayRef sets the "gateway_ref" field.
+func (ctc *ClientTxnCreate) SetGatewayRef(s string) *ClientTxnCreate {
+	ctc.mutation.SetGatewayRef(s)
+	return ctc
+}
+
+// SetNillableGatewayRef sets the "gateway_ref" field if the given value is not nil.
+func (ctc *ClientTxnCreate) SetNillableGatewayRef(s *string) *ClientTxnCreate {
+	if s != nil {
+		ctc.SetGatewayRef(*s)
+	}
+	return ctc
+}
+
 // SetClientUsername sets the "client_username" field.
 func (ctc *ClientTxnCreate) SetClientUsername(s string) *ClientTxnCreate {
 	ctc.mutation.SetClientUsername(s)
@@ -102,6 +116,104 @@ func (ctc *ClientTxnCreate) SetNillableClientUsername(s *string) *ClientTxnCreat
 	return ctc
 }
 
+// SetDiscount sets the "discount" field.
+func (ctc *ClientTxnCreate) SetDiscount(f float64) *ClientTxnCreate {
+	ctc.mutation.SetDiscount(f)
+	return ctc
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (ctc *ClientTxnCreate) SetNillableDiscount(f *float64) *ClientTxnCreate {
+	if f != nil {
+		ctc.SetDiscount(*f)
+	}
+	return ctc
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (ctc *ClientTxnCreate) SetCouponCode(s string) *ClientTxnCreate {
+	ctc.mutation.SetCouponCode(s)
+	return ctc
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (ctc *ClientTxnCreate) SetNillableCouponCode(s *string) *ClientTxnCreate {
+	if s != nil {
+		ctc.SetCouponCode(*s)
+	}
+	return ctc
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (ctc *ClientTxnCreate) SetNetAmount(f float64) *ClientTxnCreate {
+	ctc.mutation.SetNetAmount(f)
+	return ctc
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (ctc *ClientTxnCreate) SetNillableNetAmount(f *float64) *ClientTxnCreate {
+	if f != nil {
+		ctc.SetNetAmount(*f)
+	}
+	return ctc
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (ctc *ClientTxnCreate) SetTaxAmount(f float64) *ClientTxnCreate {
+	ctc.mutation.SetTaxAmount(f)
+	return ctc
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (ctc *ClientTxnCreate) SetNillableTaxAmount(f *float64) *ClientTxnCreate {
+	if f != nil {
+		ctc.SetTaxAmount(*f)
+	}
+	return ctc
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (ctc *ClientTxnCreate) SetTaxRate(f float64) *ClientTxnCreate {
+	ctc.mutation.SetTaxRate(f)
+	return ctc
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (ctc *ClientTxnCreate) SetNillableTaxRate(f *float64) *ClientTxnCreate {
+	if f != nil {
+		ctc.SetTaxRate(*f)
+	}
+	return ctc
+}
+
+// SetServiceType sets the "service_type" field.
+func (ctc *ClientTxnCreate) SetServiceType(ct clienttxn.ServiceType) *ClientTxnCreate {
+	ctc.mutation.SetServiceType(ct)
+	return ctc
+}
+
+// SetNillableServiceType sets the "service_type" field if the given value is not nil.
+func (ctc *ClientTxnCreate) SetNillableServiceType(ct *clienttxn.ServiceType) *ClientTxnCreate {
+	if ct != nil {
+		ctc.SetServiceType(*ct)
+	}
+	return ctc
+}
+
+// SetServiceDays sets the "service_days" field.
+func (ctc *ClientTxnCreate) SetServiceDays(i int) *ClientTxnCreate {
+	ctc.mutation.SetServiceDays(i)
+	return ctc
+}
+
+// SetNillableServiceDays sets the "service_days" field if the given value is not nil.
+func (ctc *ClientTxnCreate) SetNillableServiceDays(i *int) *ClientTxnCreate {
+	if i != nil {
+		ctc.SetServiceDays(*i)
+	}
+	return ctc
+}
+
 // SetDescription sets the "description" field.
 func (ctc *ClientTxnCreate) SetDescription(s string) *ClientTxnCreate {
 	ctc.mutation.SetDescription(s)
@@ -189,6 +301,26 @@ func (ctc *ClientTxnCreate) defaults() {
 		v := clienttxn.DefaultTotalBalance
 		ctc.mutation.SetTotalBalance(v)
 	}
+	if _, ok := ctc.mutation.Discount(); !ok {
+		v := clienttxn.DefaultDiscount
+		ctc.mutation.SetDiscount(v)
+	}
+	if _, ok := ctc.mutation.NetAmount(); !ok {
+		v := clienttxn.DefaultNetAmount
+		ctc.mutation.SetNetAmount(v)
+	}
+	if _, ok := ctc.mutation.TaxAmount(); !ok {
+		v := clienttxn.DefaultTaxAmount
+		ctc.mutation.SetTaxAmount(v)
+	}
+	if _, ok := ctc.mutation.TaxRate(); !ok {
+		v := clienttxn.DefaultTaxRate
+		ctc.mutation.SetTaxRate(v)
+	}
+	if _, ok := ctc.mutation.ServiceDays(); !ok {
+		v := clienttxn.DefaultServiceDays
+		ctc.mutation.SetServiceDays(v)
+	}
 	if _, ok := ctc.mutation.TransactionDate(); !ok {
 		v := clienttxn.DefaultTransactionDate()
 		ctc.mutation.SetTransactionDate(v)
@@ -232,11 +364,41 @@ func (ctc *ClientTxnCreate) check() error {
 			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.payment_method": %w`, err)}
 		}
 	}
+	if v, ok := ctc.mutation.GatewayRef(); ok {
+		if err := clienttxn.GatewayRefValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_ref", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.gateway_ref": %w`, err)}
+		}
+	}
 	if v, ok := ctc.mutation.ClientUsername(); ok {
 		if err := clienttxn.ClientUsernameValidator(v); err != nil {
 			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.client_username": %w`, err)}
 		}
 	}
+	if _, ok := ctc.mutation.Discount(); !ok {
+		return &ValidationError{Name: "discount", err: errors.New(`ent: missing required field "ClientTxn.discount"`)}
+	}
+	if v, ok := ctc.mutation.CouponCode(); ok {
+		if err := clienttxn.CouponCodeValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_code", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.coupon_code": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.NetAmount(); !ok {
+		return &ValidationError{Name: "net_amount", err: errors.New(`ent: missing required field "ClientTxn.net_amount"`)}
+	}
+	if _, ok := ctc.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "ClientTxn.tax_amount"`)}
+	}
+	if _, ok := ctc.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "ClientTxn.tax_rate"`)}
+	}
+	if v, ok := ctc.mutation.ServiceType(); ok {
+		if err := clienttxn.ServiceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "service_type", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.service_type": %w`, err)}
+		}
+	}
+	if _, ok := ctc.mutation.ServiceDays(); !ok {
+		return &ValidationError{Name: "service_days", err: errors.New(`ent: missing required field "ClientTxn.service_days"`)}
+	}
 	if _, ok := ctc.mutation.TransactionDate(); !ok {
 		return &ValidationError{Name: "transaction_date", err: errors.New(`ent: missing required field "ClientTxn.transaction_date"`)}
 	}
@@ -309,10 +471,42 @@ func (ctc *ClientTxnCreate) createSpec() (*ClientTxn, *sqlgraph.CreateSpec) {
 		_spec.SetField(clienttxn.FieldPaymentMethod, field.TypeEnum, value)
 		_node.PaymentMethod = &value
 	}
+	if value, ok := ctc.mutation.GatewayRef(); ok {
+		_spec.SetField(clienttxn.FieldGatewayRef, field.TypeString, value)
+		_node.GatewayRef = value
+	}
 	if value, ok := ctc.mutation.ClientUsername(); ok {
 		_spec.SetField(clienttxn.FieldClientUsername, field.TypeString, value)
 		_node.ClientUsername = value
 	}
+	if value, ok := ctc.mutation.Discount(); ok {
+		_spec.SetField(clienttxn.FieldDiscount, field.TypeFloat64, value)
+		_node.Discount = value
+	}
+	if value, ok := ctc.mutation.CouponCode(); ok {
+		_spec.SetField(clienttxn.FieldCouponCode, field.TypeString, value)
+		_node.CouponCode = value
+	}
+	if value, ok := ctc.mutation.NetAmount(); ok {
+		_spec.SetField(clienttxn.FieldNetAmount, field.TypeFloat64, value)
+		_node.NetAmount = value
+	}
+	if value, ok := ctc.mutation.TaxAmount(); ok {
+		_spec.SetField(clienttxn.FieldTaxAmount, field.TypeFloat64, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := ctc.mutation.TaxRate(); ok {
+		_spec.SetField(clienttxn.FieldTaxRate, field.TypeFloat64, value)
+		_node.TaxRate = value
+	}
+	if value, ok := ctc.mutation.ServiceType(); ok {
+		_spec.SetField(clienttxn.FieldServiceType, field.TypeEnum, value)
+		_node.ServiceType = &value
+	}
+	if value, ok := ctc.mutation.ServiceDays(); ok {
+		_spec.SetField(clienttxn.FieldServiceDays, field.TypeInt, value)
+		_node.ServiceDays = value
+	}
 	if value, ok := ctc.mutation.Description(); ok {
 		_spec.SetField(clienttxn.FieldDescription, field.TypeString, value)
 		_node.Description = value
@@ -132,6 +132,26 @@ func (ctu *ClientTxnUpdate) ClearPaymentMethod() *ClientTxnUpdate {
 	return ctu
 }
 
+// SetGatewayRef sets the "gateway_ref" field.
+func (ctu *ClientTxnUpdate) SetGatewayRef(s string) *ClientTxnUpdate {
+	ctu.mutation.SetGatewayRef(s)
+	return ctu
+}
+
+// SetNillableGatewayRef sets the "gateway_ref" field if the given value is not nil.
+func (ctu *ClientTxnUpdate) SetNillableGatewayRef(s *string) *ClientTxnUpdate {
+	if s != nil {
+		ctu.SetGatewayRef(*s)
+	}
+	return ctu
+}
+
+// ClearGatewayRef clears the value of the "gateway_ref" field.
+func (ctu *ClientTxnUpdate) ClearGatewayRef() *ClientTxnUpdate {
+	ctu.mutation.ClearGatewayRef()
+	return ctu
+}
+
 // SetClientUsername sets the "client_username" field.
 func (ctu *ClientTxnUpdate) SetClientUsername(s string) *ClientTxnUpdate {
 	ctu.mutation.SetClientUsername(s)
@@ -152,6 +172,151 @@ func (ctu *ClientTxnUpdate) ClearClientUsername() *ClientTxnUpdate {
 	return ctu
 }
 
+// SetDiscount sets the "discount" field.
+func (ctu *ClientTxnUpdate) SetDiscount(f float64) *ClientTxnUpdate {
+	ctu.mutation.ResetDiscount()
+	ctu.mutation.SetDiscount(f)
+	return ctu
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (ctu *ClientTxnUpdate) SetNillableDiscount(f *float64) *ClientTxnUpdate {
+	if f != nil {
+		ctu.SetDiscount(*f)
+	}
+	return ctu
+}
+
+// AddDiscount adds f to the "discount" field.
+func (ctu *ClientTxnUpdate) AddDiscount(f float64) *ClientTxnUpdate {
+	ctu.mutation.AddDiscount(f)
+	return ctu
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (ctu *ClientTxnUpdate) SetCouponCode(s string) *ClientTxnUpdate {
+	ctu.mutation.SetCouponCode(s)
+	return ctu
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (ctu *ClientTxnUpdate) SetNillableCouponCode(s *string) *ClientTxnUpdate {
+	if s != nil {
+		ctu.SetCouponCode(*s)
+	}
+	return ctu
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (ctu *ClientTxnUpdate) ClearCouponCode() *ClientTxnUpdate {
+	ctu.mutation.ClearCouponCode()
+	return ctu
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (ctu *ClientTxnUpdate) SetNetAmount(f float64) *ClientTxnUpdate {
+	ctu.mutation.ResetNetAmount()
+	ctu.mutation.SetNetAmount(f)
+	return ctu
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (ctu *ClientTxnUpdate) SetNillableNetAmount(f *float64) *ClientTxnUpdate {
+	if f != nil {
+		ctu.SetNetAmount(*f)
+	}
+	return ctu
+}
+
+// AddNetAmount adds f to the "net_amount" field.
+func (ctu *ClientTxnUpdate) AddNetAmount(f float64) *ClientTxnUpdate {
+	ctu.mutation.AddNetAmount(f)
+	return ctu
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (ctu *ClientTxnUpdate) SetTaxAmount(f float64) *ClientTxnUpdate {
+	ctu.mutation.ResetTaxAmount()
+	ctu.mutation.SetTaxAmount(f)
+	return ctu
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (ctu *ClientTxnUpdate) SetNillableTaxAmount(f *float64) *ClientTxnUpdate {
+	if f != nil {
+		ctu.SetTaxAmount(*f)
+	}
+	return ctu
+}
+
+// AddTaxAmount adds f to the "tax_amount" field.
+func (ctu *ClientTxnUpdate) AddTaxAmount(f float64) *ClientTxnUpdate {
+	ctu.mutation.AddTaxAmount(f)
+	return ctu
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (ctu *ClientTxnUpdate) SetTaxRate(f float64) *ClientTxnUpdate {
+	ctu.mutation.ResetTaxRate()
+	ctu.mutation.SetTaxRate(f)
+	return ctu
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (ctu *ClientTxnUpdate) SetNillableTaxRate(f *float64) *ClientTxnUpdate {
+	if f != nil {
+		ctu.SetTaxRate(*f)
+	}
+	return ctu
+}
+
+// AddTaxRate adds f to the "tax_rate" field.
+func (ctu *ClientTxnUpdate) AddTaxRate(f float64) *ClientTxnUpdate {
+	ctu.mutation.AddTaxRate(f)
+	return ctu
+}
+
+// SetServiceType sets the "service_type" field.
+func (ctu *ClientTxnUpdate) SetServiceType(ct clienttxn.ServiceType) *ClientTxnUpdate {
+	ctu.mutation.SetServiceType(ct)
+	return ctu
+}
+
+// SetNillableServiceType sets the "service_type" field if the given value is not nil.
+func (ctu *ClientTxnUpdate) SetNillableServiceType(ct *clienttxn.ServiceType) *ClientTxnUpdate {
+	if ct != nil {
+		ctu.SetServiceType(*ct)
+	}
+	return ctu
+}
+
+// ClearServiceType clears the value of the "service_type" field.
+func (ctu *ClientTxnUpdate) ClearServiceType() *ClientTxnUpdate {
+	ctu.mutation.ClearServiceType()
+	return ctu
+}
+
+// SetServiceDays sets the "service_days" field.
+func (ctu *ClientTxnUpdate) SetServiceDays(i int) *ClientTxnUpdate {
+	ctu.mutation.ResetServiceDays()
+	ctu.mutation.SetServiceDays(i)
+	return ctu
+}
+
+// SetNillableServiceDays sets the "service_days" field if the given value is not nil.
+func (ctu *ClientTxnUpdate) SetNillableServiceDays(i *int) *ClientTxnUpdate {
+	if i != nil {
+		ctu.SetServiceDays(*i)
+	}
+	return ctu
+}
+
+// AddServiceDays adds i to the "service_days" field.
+func (ctu *ClientTxnUpdate) AddServiceDays(i int) *ClientTxnUpdate {
+	ctu.mutation.AddServiceDays(i)
+	return ctu
+}
+
 // SetDescription sets the "description" field.
 func (ctu *ClientTxnUpdate) SetDescription(s string) *ClientTxnUpdate {
 	ctu.mutation.SetDescription(s)
@@ -254,11 +419,26 @@ func (ctu *ClientTxnUpdate) check() error {
 			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.payment_method": %w`, err)}
 		}
 	}
+	if v, ok := ctu.mutation.GatewayRef(); ok {
+		if err := clienttxn.GatewayRefValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_ref", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.gateway_ref": %w`, err)}
+		}
+	}
 	if v, ok := ctu.mutation.ClientUsername(); ok {
 		if err := clienttxn.ClientUsernameValidator(v); err != nil {
 			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.client_username": %w`, err)}
 		}
 	}
+	if v, ok := ctu.mutation.CouponCode(); ok {
+		if err := clienttxn.CouponCodeValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_code", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.coupon_code": %w`, err)}
+		}
+	}
+	if v, ok := ctu.mutation.ServiceType(); ok {
+		if err := clienttxn.ServiceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "service_type", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.service_type": %w`, err)}
+		}
+	}
 	if v, ok := ctu.mutation.CreatedBy(); ok {
 		if err := clienttxn.CreatedByValidator(v); err != nil {
 			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.created_by": %w`, err)}
@@ -306,12 +486,60 @@ func (ctu *ClientTxnUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ctu.mutation.PaymentMethodCleared() {
 		_spec.ClearField(clienttxn.FieldPaymentMethod, field.TypeEnum)
 	}
+	if value, ok := ctu.mutation.GatewayRef(); ok {
+		_spec.SetField(clienttxn.FieldGatewayRef, field.TypeString, value)
+	}
+	if ctu.mutation.GatewayRefCleared() {
+		_spec.ClearField(clienttxn.FieldGatewayRef, field.TypeString)
+	}
 	if value, ok := ctu.mutation.ClientUsername(); ok {
 		_spec.SetField(clienttxn.FieldClientUsername, field.TypeString, value)
 	}
 	if ctu.mutation.ClientUsernameCleared() {
 		_spec.ClearField(clienttxn.FieldClientUsername, field.TypeString)
 	}
+	if value, ok := ctu.mutation.Discount(); ok {
+		_spec.SetField(clienttxn.FieldDiscount, field.TypeFloat64, value)
+	}
+	if value, ok := ctu.mutation.AddedDiscount(); ok {
+		_spec.AddField(clienttxn.FieldDiscount, field.TypeFloat64, value)
+	}
+	if value, ok := ctu.mutation.CouponCode(); ok {
+		_spec.SetField(clienttxn.FieldCouponCode, field.TypeString, value)
+	}
+	if ctu.mutation.CouponCodeCleared() {
+		_spec.ClearField(clienttxn.FieldCouponCode, field.TypeString)
+	}
+	if value, ok := ctu.mutation.NetAmount(); ok {
+		_spec.SetField(clienttxn.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ctu.mutation.AddedNetAmount(); ok {
+		_spec.AddField(clienttxn.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ctu.mutation.TaxAmount(); ok {
+		_spec.SetField(clienttxn.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ctu.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(clienttxn.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ctu.mutation.TaxRate(); ok {
+		_spec.SetField(clienttxn.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := ctu.mutation.AddedTaxRate(); ok {
+		_spec.AddField(clienttxn.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := ctu.mutation.ServiceType(); ok {
+		_spec.SetField(clienttxn.FieldServiceType, field.TypeEnum, value)
+	}
+	if ctu.mutation.ServiceTypeCleared() {
+		_spec.ClearField(clienttxn.FieldServiceType, field.TypeEnum)
+	}
+	if value, ok := ctu.mutation.ServiceDays(); ok {
+		_spec.SetField(clienttxn.FieldServiceDays, field.TypeInt, value)
+	}
+	if value, ok := ctu.mutation.AddedServiceDays(); ok {
+		_spec.AddField(clienttxn.FieldServiceDays, field.TypeInt, value)
+	}
 	if value, ok := ctu.mutation.Description(); ok {
 		_spec.SetField(clienttxn.FieldDescription, field.TypeString, value)
 	}
@@ -448,6 +676,26 @@ func (ctuo *ClientTxnUpdateOne) ClearPaymentMethod() *ClientTxnUpdateOne {
 	return ctuo
 }
 
+// SetGatewayRef sets the "gateway_ref" field.
+func (ctuo *ClientTxnUpdateOne) SetGatewayRef(s string) *ClientTxnUpdateOne {
+	ctuo.mutation.SetGatewayRef(s)
+	return ctuo
+}
+
+// SetNillableGatewayRef sets the "gateway_ref" field if the given value is not nil.
+func (ctuo *ClientTxnUpdateOne) SetNillableGatewayRef(s *string) *ClientTxnUpdateOne {
+	if s != nil {
+		ctuo.SetGatewayRef(*s)
+	}
+	return ctuo
+}
+
+// ClearGatewayRef clears the value of the "gateway_ref" field.
+func (ctuo *ClientTxnUpdateOne) ClearGatewayRef() *ClientTxnUpdateOne {
+	ctuo.mutation.ClearGatewayRef()
+	return ctuo
+}
+
 // SetClientUsername sets the "client_username" field.
 func (ctuo *ClientTxnUpdateOne) SetClientUsername(s string) *ClientTxnUpdateOne {
 	ctuo.mutation.SetClientUsername(s)
@@ -468,6 +716,151 @@ func (ctuo *ClientTxnUpdateOne) ClearClientUsername() *ClientTxnUpdateOne {
 	return ctuo
 }
 
+// SetDiscount sets the "discount" field.
+func (ctuo *ClientTxnUpdateOne) SetDiscount(f float64) *ClientTxnUpdateOne {
+	ctuo.mutation.ResetDiscount()
+	ctuo.mutation.SetDiscount(f)
+	return ctuo
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (ctuo *ClientTxnUpdateOne) SetNillableDiscount(f *float64) *ClientTxnUpdateOne {
+	if f != nil {
+		ctuo.SetDiscount(*f)
+	}
+	return ctuo
+}
+
+// AddDiscount adds f to the "discount" field.
+func (ctuo *ClientTxnUpdateOne) AddDiscount(f float64) *ClientTxnUpdateOne {
+	ctuo.mutation.AddDiscount(f)
+	return ctuo
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (ctuo *ClientTxnUpdateOne) SetCouponCode(s string) *ClientTxnUpdateOne {
+	ctuo.mutation.SetCouponCode(s)
+	return ctuo
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (ctuo *ClientTxnUpdateOne) SetNillableCouponCode(s *string) *ClientTxnUpdateOne {
+	if s != nil {
+		ctuo.SetCouponCode(*s)
+	}
+	return ctuo
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (ctuo *ClientTxnUpdateOne) ClearCouponCode() *ClientTxnUpdateOne {
+	ctuo.mutation.ClearCouponCode()
+	return ctuo
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (ctuo *ClientTxnUpdateOne) SetNetAmount(f float64) *ClientTxnUpdateOne {
+	ctuo.mutation.ResetNetAmount()
+	ctuo.mutation.SetNetAmount(f)
+	return ctuo
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (ctuo *ClientTxnUpdateOne) SetNillableNetAmount(f *float64) *ClientTxnUpdateOne {
+	if f != nil {
+		ctuo.SetNetAmount(*f)
+	}
+	return ctuo
+}
+
+// AddNetAmount adds f to the "net_amount" field.
+func (ctuo *ClientTxnUpdateOne) AddNetAmount(f float64) *ClientTxnUpdateOne {
+	ctuo.mutation.AddNetAmount(f)
+	return ctuo
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (ctuo *ClientTxnUpdateOne) SetTaxAmount(f float64) *ClientTxnUpdateOne {
+	ctuo.mutation.ResetTaxAmount()
+	ctuo.mutation.SetTaxAmount(f)
+	return ctuo
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (ctuo *ClientTxnUpdateOne) SetNillableTaxAmount(f *float64) *ClientTxnUpdateOne {
+	if f != nil {
+		ctuo.SetTaxAmount(*f)
+	}
+	return ctuo
+}
+
+// AddTaxAmount adds f to the "tax_amount" field.
+func (ctuo *ClientTxnUpdateOne) AddTaxAmount(f float64) *ClientTxnUpdateOne {
+	ctuo.mutation.AddTaxAmount(f)
+	return ctuo
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (ctuo *ClientTxnUpdateOne) SetTaxRate(f float64) *ClientTxnUpdateOne {
+	ctuo.mutation.ResetTaxRate()
+	ctuo.mutation.SetTaxRate(f)
+	return ctuo
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (ctuo *ClientTxnUpdateOne) SetNillableTaxRate(f *float64) *ClientTxnUpdateOne {
+	if f != nil {
+		ctuo.SetTaxRate(*f)
+	}
+	return ctuo
+}
+
+// AddTaxRate adds f to the "tax_rate" field.
+func (ctuo *ClientTxnUpdateOne) AddTaxRate(f float64) *ClientTxnUpdateOne {
+	ctuo.mutation.AddTaxRate(f)
+	return ctuo
+}
+
+// SetServiceType sets the "service_type" field.
+func (ctuo *ClientTxnUpdateOne) SetServiceType(ct clienttxn.ServiceType) *ClientTxnUpdateOne {
+	ctuo.mutation.SetServiceType(ct)
+	return ctuo
+}
+
+// SetNillableServiceType sets the "service_type" field if the given value is not nil.
+func (ctuo *ClientTxnUpdateOne) SetNillableServiceType(ct *clienttxn.ServiceType) *ClientTxnUpdateOne {
+	if ct != nil {
+		ctuo.SetServiceType(*ct)
+	}
+	return ctuo
+}
+
+// ClearServiceType clears the value of the "service_type" field.
+func (ctuo *ClientTxnUpdateOne) ClearServiceType() *ClientTxnUpdateOne {
+	ctuo.mutation.ClearServiceType()
+	return ctuo
+}
+
+// SetServiceDays sets the "service_days" field.
+func (ctuo *ClientTxnUpdateOne) SetServiceDays(i int) *ClientTxnUpdateOne {
+	ctuo.mutation.ResetServiceDays()
+	ctuo.mutation.SetServiceDays(i)
+	return ctuo
+}
+
+// SetNillableServiceDays sets the "service_days" field if the given value is not nil.
+func (ctuo *ClientTxnUpdateOne) SetNillableServiceDays(i *int) *ClientTxnUpdateOne {
+	if i != nil {
+		ctuo.SetServiceDays(*i)
+	}
+	return ctuo
+}
+
+// AddServiceDays adds i to the "service_days" field.
+func (ctuo *ClientTxnUpdateOne) AddServiceDays(i int) *ClientTxnUpdateOne {
+	ctuo.mutation.AddServiceDays(i)
+	return ctuo
+}
+
 // SetDescription sets the "description" field.
 func (ctuo *ClientTxnUpdateOne) SetDescription(s string) *ClientTxnUpdateOne {
 	ctuo.mutation.SetDescription(s)
@@ -583,11 +976,26 @@ func (ctuo *ClientTxnUpdateOne) check() error {
 			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.payment_method": %w`, err)}
 		}
 	}
+	if v, ok := ctuo.mutation.GatewayRef(); ok {
+		if err := clienttxn.GatewayRefValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_ref", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.gateway_ref": %w`, err)}
+		}
+	}
 	if v, ok := ctuo.mutation.ClientUsername(); ok {
 		if err := clienttxn.ClientUsernameValidator(v); err != nil {
 			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.client_username": %w`, err)}
 		}
 	}
+	if v, ok := ctuo.mutation.CouponCode(); ok {
+		if err := clienttxn.CouponCodeValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_code", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.coupon_code": %w`, err)}
+		}
+	}
+	if v, ok := ctuo.mutation.ServiceType(); ok {
+		if err := clienttxn.ServiceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "service_type", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.service_type": %w`, err)}
+		}
+	}
 	if v, ok := ctuo.mutation.CreatedBy(); ok {
 		if err := clienttxn.CreatedByValidator(v); err != nil {
 			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "ClientTxn.created_by": %w`, err)}
@@ -652,12 +1060,60 @@ func (ctuo *ClientTxnUpdateOne) sqlSave(ctx context.Context) (_node *ClientTxn,
 	if ctuo.mutation.PaymentMethodCleared() {
 		_spec.ClearField(clienttxn.FieldPaymentMethod, field.TypeEnum)
 	}
+	if value, ok := ctuo.mutation.GatewayRef(); ok {
+		_spec.SetField(clienttxn.FieldGatewayRef, field.TypeString, value)
+	}
+	if ctuo.mutation.GatewayRefCleared() {
+		_spec.ClearField(clienttxn.FieldGatewayRef, field.TypeString)
+	}
 	if value, ok := ctuo.mutation.ClientUsername(); ok {
 		_spec.SetField(clienttxn.FieldClientUsername, field.TypeString, value)
 	}
 	if ctuo.mutation.ClientUsernameCleared() {
 		_spec.ClearField(clienttxn.FieldClientUsername, field.TypeString)
 	}
+	if value, ok := ctuo.mutation.Discount(); ok {
+		_spec.SetField(clienttxn.FieldDiscount, field.TypeFloat64, value)
+	}
+	if value, ok := ctuo.mutation.AddedDiscount(); ok {
+		_spec.AddField(clienttxn.FieldDiscount, field.TypeFloat64, value)
+	}
+	if value, ok := ctuo.mutation.CouponCode(); ok {
+		_spec.SetField(clienttxn.FieldCouponCode, field.TypeString, value)
+	}
+	if ctuo.mutation.CouponCodeCleared() {
+		_spec.ClearField(clienttxn.FieldCouponCode, field.TypeString)
+	}
+	if value, ok := ctuo.mutation.NetAmount(); ok {
+		_spec.SetField(clienttxn.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ctuo.mutation.AddedNetAmount(); ok {
+		_spec.AddField(clienttxn.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ctuo.mutation.TaxAmount(); ok {
+		_spec.SetField(clienttxn.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ctuo.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(clienttxn.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ctuo.mutation.TaxRate(); ok {
+		_spec.SetField(clienttxn.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := ctuo.mutation.AddedTaxRate(); ok {
+		_spec.AddField(clienttxn.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := ctuo.mutation.ServiceType(); ok {
+		_spec.SetField(clienttxn.FieldServiceType, field.TypeEnum, value)
+	}
+	if ctuo.mutation.ServiceTypeCleared() {
+		_spec.ClearField(clienttxn.FieldServiceType, field.TypeEnum)
+	}
+	if value, ok := ctuo.mutation.ServiceDays(); ok {
+		_spec.SetField(clienttxn.FieldServiceDays, field.TypeInt, value)
+	}
+	if value, ok := ctuo.mutation.AddedServiceDays(); ok {
+		_spec.AddField(clienttxn.FieldServiceDays, field.TypeInt, value)
+	}
 	if value, ok := ctuo.mutation.Description(); ok {
 		_spec.SetField(clienttxn.FieldDescription, field.TypeString, value)
 	}
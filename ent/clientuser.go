@@ -31,8 +31,14 @@ type ClientUser struct {
 	Photo string `json:"photo,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
-	// Balance holds the value of the "balance" field.
+	// Negative only for postpaid clients, whose debt is billed monthly
 	Balance float64 `json:"balance,omitempty"`
+	// BillingMode holds the value of the "billing_mode" field.
+	BillingMode clientuser.BillingMode `json:"billing_mode,omitempty"`
+	// How far below zero the balance of a postpaid client may be charged
+	CreditLimit float64 `json:"credit_limit,omitempty"`
+	// When the client switched to postpaid, their first invoice is prorated from then
+	PostpaidSince *time.Time `json:"postpaid_since,omitempty"`
 	// AddressLine1 holds the value of the "address_line1" field.
 	AddressLine1 string `json:"address_line1,omitempty"`
 	// AddressLine2 holds the value of the "address_line2" field.
@@ -47,7 +53,7 @@ type ClientUser struct {
 	UnionName string `json:"union_name,omitempty"`
 	// Zip holds the value of the "zip" field.
 	Zip string `json:"zip,omitempty"`
-	// Status holds the value of the "status" field.
+	// warning, throttled and suspended are the stages of the grace period after expiry
 	Status clientuser.Status `json:"status,omitempty"`
 	// PaymentDate holds the value of the "payment_date" field.
 	PaymentDate *time.Time `json:"payment_date,omitempty"`
@@ -83,13 +89,13 @@ func (*ClientUser) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case clientuser.FieldAutoRenew:
 			values[i] = new(sql.NullBool)
-		case clientuser.FieldBalance:
+		case clientuser.FieldBalance, clientuser.FieldCreditLimit:
 			values[i] = new(sql.NullFloat64)
 		case clientuser.FieldID, clientuser.FieldVendorID:
 			values[i] = new(sql.NullInt64)
-		case clientuser.FieldName, clientuser.FieldUsername, clientuser.FieldPassword, clientuser.FieldMobileNumber, clientuser.FieldEmail, clientuser.FieldPhoto, clientuser.FieldDescription, clientuser.FieldAddressLine1, clientuser.FieldAddressLine2, clientuser.FieldCity, clientuser.FieldDistrict, clientuser.FieldUpazila, clientuser.FieldUnionName, clientuser.FieldZip, clientuser.FieldStatus, clientuser.FieldPaymentType, clientuser.FieldCName, clientuser.FieldPackagePool, clientuser.FieldUserProfile, clientuser.FieldNextUserProfile, clientuser.FieldCreatedBy, clientuser.FieldUpdatedBy:
+		case clientuser.FieldName, clientuser.FieldUsername, clientuser.FieldPassword, clientuser.FieldMobileNumber, clientuser.FieldEmail, clientuser.FieldPhoto, clientuser.FieldDescription, clientuser.FieldBillingMode, clientuser.FieldAddressLine1, clientuser.FieldAddressLine2, clientuser.FieldCity, clientuser.FieldDistrict, clientuser.FieldUpazila, clientuser.FieldUnionName, clientuser.FieldZip, clientuser.FieldStatus, clientuser.FieldPaymentType, clientuser.FieldCName, clientuser.FieldPackagePool, clientuser.FieldUserProfile, clientuser.FieldNextUserProfile, clientuser.FieldCreatedBy, clientuser.FieldUpdatedBy:
 			values[i] = new(sql.NullString)
-		case clientuser.FieldPaymentDate, clientuser.FieldCreatedDate, clientuser.FieldUpdatedDate:
+		case clientuser.FieldPostpaidSince, clientuser.FieldPaymentDate, clientuser.FieldCreatedDate, clientuser.FieldUpdatedDate:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -160,6 +166,25 @@ func (cu *ClientUser) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				cu.Balance = value.Float64
 			}
+		case clientuser.FieldBillingMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_mode", values[i])
+			} else if value.Valid {
+				cu.BillingMode = clientuser.BillingMode(value.String)
+			}
+		case clientuser.FieldCreditLimit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_limit", values[i])
+			} else if value.Valid {
+				cu.CreditLimit = value.Float64
+			}
+		case clientuser.FieldPostpaidSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field postpaid_since", values[i])
+			} else if value.Valid {
+				cu.PostpaidSince = new(time.Time)
+				*cu.PostpaidSince = value.Time
+			}
 		case clientuser.FieldAddressLine1:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field address_line1", values[i])
@@ -341,6 +366,17 @@ func (cu *ClientUser) String() string {
 	builder.WriteString("balance=")
 	builder.WriteString(fmt.Sprintf("%v", cu.Balance))
 	builder.WriteString(", ")
+	builder.WriteString("billing_mode=")
+	builder.WriteString(fmt.Sprintf("%v", cu.BillingMode))
+	builder.WriteString(", ")
+	builder.WriteString("credit_limit=")
+	builder.WriteString(fmt.Sprintf("%v", cu.CreditLimit))
+	builder.WriteString(", ")
+	if v := cu.PostpaidSince; v != nil {
+		builder.WriteString("postpaid_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("address_line1=")
 	builder.WriteString(cu.AddressLine1)
 	builder.WriteString(", ")
@@ -30,6 +30,12 @@ const (
 	FieldDescription = "description"
 	// FieldBalance holds the string denoting the balance field in the database.
 	FieldBalance = "balance"
+	// FieldBillingMode holds the string denoting the billing_mode field in the database.
+	FieldBillingMode = "billing_mode"
+	// FieldCreditLimit holds the string denoting the credit_limit field in the database.
+	FieldCreditLimit = "credit_limit"
+	// FieldPostpaidSince holds the string denoting the postpaid_since field in the database.
+	FieldPostpaidSince = "postpaid_since"
 	// FieldAddressLine1 holds the string denoting the address_line1 field in the database.
 	FieldAddressLine1 = "address_line1"
 	// FieldAddressLine2 holds the string denoting the address_line2 field in the database.
@@ -85,6 +91,9 @@ var Columns = []string{
 	FieldPhoto,
 	FieldDescription,
 	FieldBalance,
+	FieldBillingMode,
+	FieldCreditLimit,
+	FieldPostpaidSince,
 	FieldAddressLine1,
 	FieldAddressLine2,
 	FieldCity,
@@ -132,8 +141,10 @@ var (
 	PhotoValidator func(string) error
 	// DefaultBalance holds the default value on creation for the "balance" field.
 	DefaultBalance float64
-	// BalanceValidator is a validator for the "balance" field. It is called by the builders before save.
-	BalanceValidator func(float64) error
+	// DefaultCreditLimit holds the default value on creation for the "credit_limit" field.
+	DefaultCreditLimit float64
+	// CreditLimitValidator is a validator for the "credit_limit" field. It is called by the builders before save.
+	CreditLimitValidator func(float64) error
 	// AddressLine1Validator is a validator for the "address_line1" field. It is called by the builders before save.
 	AddressLine1Validator func(string) error
 	// AddressLine2Validator is a validator for the "address_line2" field. It is called by the builders before save.
@@ -174,6 +185,32 @@ var (
 	IDValidator func(int) error
 )
 
+// BillingMode defines the type for the "billing_mode" enum field.
+type BillingMode string
+
+// BillingModePrepaid is the default value of the BillingMode enum.
+const DefaultBillingMode = BillingModePrepaid
+
+// BillingMode values.
+const (
+	BillingModePrepaid  BillingMode = "prepaid"
+	BillingModePostpaid BillingMode = "postpaid"
+)
+
+func (bm BillingMode) String() string {
+	return string(bm)
+}
+
+// BillingModeValidator is a validator for the "billing_mode" field enum values. It is called by the builders before save.
+func BillingModeValidator(bm BillingMode) error {
+	switch bm {
+	case BillingModePrepaid, BillingModePostpaid:
+		return nil
+	default:
+		return fmt.Errorf("clientuser: invalid enum value for billing_mode field: %q", bm)
+	}
+}
+
 // Status defines the type for the "status" enum field.
 type Status string
 
@@ -182,8 +219,11 @@ const DefaultStatus = StatusInactive
 
 // Status values.
 const (
-	StatusActive   Status = "active"
-	StatusInactive Status = "inactive"
+	StatusActive    Status = "active"
+	StatusInactive  Status = "inactive"
+	StatusWarning   Status = "warning"
+	StatusThrottled Status = "throttled"
+	StatusSuspended Status = "suspended"
 )
 
 func (s Status) String() string {
@@ -193,7 +233,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusActive, StatusInactive:
+	case StatusActive, StatusInactive, StatusWarning, StatusThrottled, StatusSuspended:
 		return nil
 	default:
 		return fmt.Errorf("clientuser: invalid enum value for status field: %q", s)
@@ -248,6 +288,21 @@ func ByBalance(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBalance, opts...).ToFunc()
 }
 
+// ByBillingMode orders the results by the billing_mode field.
+func ByBillingMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBillingMode, opts...).ToFunc()
+}
+
+// ByCreditLimit orders the results by the credit_limit field.
+func ByCreditLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditLimit, opts...).ToFunc()
+}
+
+// ByPostpaidSince orders the results by the postpaid_since field.
+func ByPostpaidSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostpaidSince, opts...).ToFunc()
+}
+
 // ByAddressLine1 orders the results by the address_line1 field.
 func ByAddressLine1(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAddressLine1, opts...).ToFunc()
@@ -94,6 +94,16 @@ func Balance(v float64) predicate.ClientUser {
 	return predicate.ClientUser(sql.FieldEQ(FieldBalance, v))
 }
 
+// CreditLimit applies equality check predicate on the "credit_limit" field. It's identical to CreditLimitEQ.
+func CreditLimit(v float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// PostpaidSince applies equality check predicate on the "postpaid_since" field. It's identical to PostpaidSinceEQ.
+func PostpaidSince(v time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldEQ(FieldPostpaidSince, v))
+}
+
 // AddressLine1 applies equality check predicate on the "address_line1" field. It's identical to AddressLine1EQ.
 func AddressLine1(v string) predicate.ClientUser {
 	return predicate.ClientUser(sql.FieldEQ(FieldAddressLine1, v))
@@ -704,6 +714,116 @@ func BalanceLTE(v float64) predicate.ClientUser {
 	return predicate.ClientUser(sql.FieldLTE(FieldBalance, v))
 }
 
+// BillingModeEQ applies the EQ predicate on the "billing_mode" field.
+func BillingModeEQ(v BillingMode) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldEQ(FieldBillingMode, v))
+}
+
+// BillingModeNEQ applies the NEQ predicate on the "billing_mode" field.
+func BillingModeNEQ(v BillingMode) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldNEQ(FieldBillingMode, v))
+}
+
+// BillingModeIn applies the In predicate on the "billing_mode" field.
+func BillingModeIn(vs ...BillingMode) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldIn(FieldBillingMode, vs...))
+}
+
+// BillingModeNotIn applies the NotIn predicate on the "billing_mode" field.
+func BillingModeNotIn(vs ...BillingMode) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldNotIn(FieldBillingMode, vs...))
+}
+
+// CreditLimitEQ applies the EQ predicate on the "credit_limit" field.
+func CreditLimitEQ(v float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitNEQ applies the NEQ predicate on the "credit_limit" field.
+func CreditLimitNEQ(v float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldNEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitIn applies the In predicate on the "credit_limit" field.
+func CreditLimitIn(vs ...float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitNotIn applies the NotIn predicate on the "credit_limit" field.
+func CreditLimitNotIn(vs ...float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldNotIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitGT applies the GT predicate on the "credit_limit" field.
+func CreditLimitGT(v float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldGT(FieldCreditLimit, v))
+}
+
+// CreditLimitGTE applies the GTE predicate on the "credit_limit" field.
+func CreditLimitGTE(v float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldGTE(FieldCreditLimit, v))
+}
+
+// CreditLimitLT applies the LT predicate on the "credit_limit" field.
+func CreditLimitLT(v float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldLT(FieldCreditLimit, v))
+}
+
+// CreditLimitLTE applies the LTE predicate on the "credit_limit" field.
+func CreditLimitLTE(v float64) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldLTE(FieldCreditLimit, v))
+}
+
+// PostpaidSinceEQ applies the EQ predicate on the "postpaid_since" field.
+func PostpaidSinceEQ(v time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldEQ(FieldPostpaidSince, v))
+}
+
+// PostpaidSinceNEQ applies the NEQ predicate on the "postpaid_since" field.
+func PostpaidSinceNEQ(v time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldNEQ(FieldPostpaidSince, v))
+}
+
+// PostpaidSinceIn applies the In predicate on the "postpaid_since" field.
+func PostpaidSinceIn(vs ...time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldIn(FieldPostpaidSince, vs...))
+}
+
+// PostpaidSinceNotIn applies the NotIn predicate on the "postpaid_since" field.
+func PostpaidSinceNotIn(vs ...time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldNotIn(FieldPostpaidSince, vs...))
+}
+
+// PostpaidSinceGT applies the GT predicate on the "postpaid_since" field.
+func PostpaidSinceGT(v time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldGT(FieldPostpaidSince, v))
+}
+
+// PostpaidSinceGTE applies the GTE predicate on the "postpaid_since" field.
+func PostpaidSinceGTE(v time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldGTE(FieldPostpaidSince, v))
+}
+
+// PostpaidSinceLT applies the LT predicate on the "postpaid_since" field.
+func PostpaidSinceLT(v time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldLT(FieldPostpaidSince, v))
+}
+
+// PostpaidSinceLTE applies the LTE predicate on the "postpaid_since" field.
+func PostpaidSinceLTE(v time.Time) predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldLTE(FieldPostpaidSince, v))
+}
+
+// PostpaidSinceIsNil applies the IsNil predicate on the "postpaid_since" field.
+func PostpaidSinceIsNil() predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldIsNull(FieldPostpaidSince))
+}
+
+// PostpaidSinceNotNil applies the NotNil predicate on the "postpaid_since" field.
+func PostpaidSinceNotNil() predicate.ClientUser {
+	return predicate.ClientUser(sql.FieldNotNull(FieldPostpaidSince))
+}
+
 // AddressLine1EQ applies the EQ predicate on the "address_line1" field.
 func AddressLine1EQ(v string) predicate.ClientUser {
 	return predicate.ClientUser(sql.FieldEQ(FieldAddressLine1, v))
@@ -92,6 +92,48 @@ func (cuc *ClientUserCreate) SetNillableBalance(f *float64) *ClientUserCreate {
 	return cuc
 }
 
+// SetBillingMode sets the "billing_mode" field.
+func (cuc *ClientUserCreate) SetBillingMode(cm clientuser.BillingMode) *ClientUserCreate {
+	cuc.mutation.SetBillingMode(cm)
+	return cuc
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (cuc *ClientUserCreate) SetNillableBillingMode(cm *clientuser.BillingMode) *ClientUserCreate {
+	if cm != nil {
+		cuc.SetBillingMode(*cm)
+	}
+	return cuc
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (cuc *ClientUserCreate) SetCreditLimit(f float64) *ClientUserCreate {
+	cuc.mutation.SetCreditLimit(f)
+	return cuc
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (cuc *ClientUserCreate) SetNillableCreditLimit(f *float64) *ClientUserCreate {
+	if f != nil {
+		cuc.SetCreditLimit(*f)
+	}
+	return cuc
+}
+
+// SetPostpaidSince sets the "postpaid_since" field.
+func (cuc *ClientUserCreate) SetPostpaidSince(t time.Time) *ClientUserCreate {
+	cuc.mutation.SetPostpaidSince(t)
+	return cuc
+}
+
+// SetNillablePostpaidSince sets the "postpaid_since" field if the given value is not nil.
+func (cuc *ClientUserCreate) SetNillablePostpaidSince(t *time.Time) *ClientUserCreate {
+	if t != nil {
+		cuc.SetPostpaidSince(*t)
+	}
+	return cuc
+}
+
 // SetAddressLine1 sets the "address_line1" field.
 func (cuc *ClientUserCreate) SetAddressLine1(s string) *ClientUserCreate {
 	cuc.mutation.SetAddressLine1(s)
@@ -393,6 +435,14 @@ func (cuc *ClientUserCreate) defaults() {
 		v := clientuser.DefaultBalance
 		cuc.mutation.SetBalance(v)
 	}
+	if _, ok := cuc.mutation.BillingMode(); !ok {
+		v := clientuser.DefaultBillingMode
+		cuc.mutation.SetBillingMode(v)
+	}
+	if _, ok := cuc.mutation.CreditLimit(); !ok {
+		v := clientuser.DefaultCreditLimit
+		cuc.mutation.SetCreditLimit(v)
+	}
 	if _, ok := cuc.mutation.Status(); !ok {
 		v := clientuser.DefaultStatus
 		cuc.mutation.SetStatus(v)
@@ -457,9 +507,20 @@ func (cuc *ClientUserCreate) check() error {
 	if _, ok := cuc.mutation.Balance(); !ok {
 		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "ClientUser.balance"`)}
 	}
-	if v, ok := cuc.mutation.Balance(); ok {
-		if err := clientuser.BalanceValidator(v); err != nil {
-			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "ClientUser.balance": %w`, err)}
+	if _, ok := cuc.mutation.BillingMode(); !ok {
+		return &ValidationError{Name: "billing_mode", err: errors.New(`ent: missing required field "ClientUser.billing_mode"`)}
+	}
+	if v, ok := cuc.mutation.BillingMode(); ok {
+		if err := clientuser.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "ClientUser.billing_mode": %w`, err)}
+		}
+	}
+	if _, ok := cuc.mutation.CreditLimit(); !ok {
+		return &ValidationError{Name: "credit_limit", err: errors.New(`ent: missing required field "ClientUser.credit_limit"`)}
+	}
+	if v, ok := cuc.mutation.CreditLimit(); ok {
+		if err := clientuser.CreditLimitValidator(v); err != nil {
+			return &ValidationError{Name: "credit_limit", err: fmt.Errorf(`ent: validator failed for field "ClientUser.credit_limit": %w`, err)}
 		}
 	}
 	if v, ok := cuc.mutation.AddressLine1(); ok {
@@ -629,6 +690,18 @@ func (cuc *ClientUserCreate) createSpec() (*ClientUser, *sqlgraph.CreateSpec) {
 		_spec.SetField(clientuser.FieldBalance, field.TypeFloat64, value)
 		_node.Balance = value
 	}
+	if value, ok := cuc.mutation.BillingMode(); ok {
+		_spec.SetField(clientuser.FieldBillingMode, field.TypeEnum, value)
+		_node.BillingMode = value
+	}
+	if value, ok := cuc.mutation.CreditLimit(); ok {
+		_spec.SetField(clientuser.FieldCreditLimit, field.TypeFloat64, value)
+		_node.CreditLimit = value
+	}
+	if value, ok := cuc.mutation.PostpaidSince(); ok {
+		_spec.SetField(clientuser.FieldPostpaidSince, field.TypeTime, value)
+		_node.PostpaidSince = &value
+	}
 	if value, ok := cuc.mutation.AddressLine1(); ok {
 		_spec.SetField(clientuser.FieldAddressLine1, field.TypeString, value)
 		_node.AddressLine1 = value
@@ -159,6 +159,61 @@ func (cuu *ClientUserUpdate) AddBalance(f float64) *ClientUserUpdate {
 	return cuu
 }
 
+// SetBillingMode sets the "billing_mode" field.
+func (cuu *ClientUserUpdate) SetBillingMode(cm clientuser.BillingMode) *ClientUserUpdate {
+	cuu.mutation.SetBillingMode(cm)
+	return cuu
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (cuu *ClientUserUpdate) SetNillableBillingMode(cm *clientuser.BillingMode) *ClientUserUpdate {
+	if cm != nil {
+		cuu.SetBillingMode(*cm)
+	}
+	return cuu
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (cuu *ClientUserUpdate) SetCreditLimit(f float64) *ClientUserUpdate {
+	cuu.mutation.ResetCreditLimit()
+	cuu.mutation.SetCreditLimit(f)
+	return cuu
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (cuu *ClientUserUpdate) SetNillableCreditLimit(f *float64) *ClientUserUpdate {
+	if f != nil {
+		cuu.SetCreditLimit(*f)
+	}
+	return cuu
+}
+
+// AddCreditLimit adds f to the "credit_limit" field.
+func (cuu *ClientUserUpdate) AddCreditLimit(f float64) *ClientUserUpdate {
+	cuu.mutation.AddCreditLimit(f)
+	return cuu
+}
+
+// SetPostpaidSince sets the "postpaid_since" field.
+func (cuu *ClientUserUpdate) SetPostpaidSince(t time.Time) *ClientUserUpdate {
+	cuu.mutation.SetPostpaidSince(t)
+	return cuu
+}
+
+// SetNillablePostpaidSince sets the "postpaid_since" field if the given value is not nil.
+func (cuu *ClientUserUpdate) SetNillablePostpaidSince(t *time.Time) *ClientUserUpdate {
+	if t != nil {
+		cuu.SetPostpaidSince(*t)
+	}
+	return cuu
+}
+
+// ClearPostpaidSince clears the value of the "postpaid_since" field.
+func (cuu *ClientUserUpdate) ClearPostpaidSince() *ClientUserUpdate {
+	cuu.mutation.ClearPostpaidSince()
+	return cuu
+}
+
 // SetAddressLine1 sets the "address_line1" field.
 func (cuu *ClientUserUpdate) SetAddressLine1(s string) *ClientUserUpdate {
 	cuu.mutation.SetAddressLine1(s)
@@ -581,9 +636,14 @@ func (cuu *ClientUserUpdate) check() error {
 			return &ValidationError{Name: "photo", err: fmt.Errorf(`ent: validator failed for field "ClientUser.photo": %w`, err)}
 		}
 	}
-	if v, ok := cuu.mutation.Balance(); ok {
-		if err := clientuser.BalanceValidator(v); err != nil {
-			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "ClientUser.balance": %w`, err)}
+	if v, ok := cuu.mutation.BillingMode(); ok {
+		if err := clientuser.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "ClientUser.billing_mode": %w`, err)}
+		}
+	}
+	if v, ok := cuu.mutation.CreditLimit(); ok {
+		if err := clientuser.CreditLimitValidator(v); err != nil {
+			return &ValidationError{Name: "credit_limit", err: fmt.Errorf(`ent: validator failed for field "ClientUser.credit_limit": %w`, err)}
 		}
 	}
 	if v, ok := cuu.mutation.AddressLine1(); ok {
@@ -714,6 +774,21 @@ func (cuu *ClientUserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cuu.mutation.AddedBalance(); ok {
 		_spec.AddField(clientuser.FieldBalance, field.TypeFloat64, value)
 	}
+	if value, ok := cuu.mutation.BillingMode(); ok {
+		_spec.SetField(clientuser.FieldBillingMode, field.TypeEnum, value)
+	}
+	if value, ok := cuu.mutation.CreditLimit(); ok {
+		_spec.SetField(clientuser.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := cuu.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(clientuser.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := cuu.mutation.PostpaidSince(); ok {
+		_spec.SetField(clientuser.FieldPostpaidSince, field.TypeTime, value)
+	}
+	if cuu.mutation.PostpaidSinceCleared() {
+		_spec.ClearField(clientuser.FieldPostpaidSince, field.TypeTime)
+	}
 	if value, ok := cuu.mutation.AddressLine1(); ok {
 		_spec.SetField(clientuser.FieldAddressLine1, field.TypeString, value)
 	}
@@ -967,6 +1042,61 @@ func (cuuo *ClientUserUpdateOne) AddBalance(f float64) *ClientUserUpdateOne {
 	return cuuo
 }
 
+// SetBillingMode sets the "billing_mode" field.
+func (cuuo *ClientUserUpdateOne) SetBillingMode(cm clientuser.BillingMode) *ClientUserUpdateOne {
+	cuuo.mutation.SetBillingMode(cm)
+	return cuuo
+}
+
+// SetNillableBillingMode sets the "billing_mode" field if the given value is not nil.
+func (cuuo *ClientUserUpdateOne) SetNillableBillingMode(cm *clientuser.BillingMode) *ClientUserUpdateOne {
+	if cm != nil {
+		cuuo.SetBillingMode(*cm)
+	}
+	return cuuo
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (cuuo *ClientUserUpdateOne) SetCreditLimit(f float64) *ClientUserUpdateOne {
+	cuuo.mutation.ResetCreditLimit()
+	cuuo.mutation.SetCreditLimit(f)
+	return cuuo
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (cuuo *ClientUserUpdateOne) SetNillableCreditLimit(f *float64) *ClientUserUpdateOne {
+	if f != nil {
+		cuuo.SetCreditLimit(*f)
+	}
+	return cuuo
+}
+
+// AddCreditLimit adds f to the "credit_limit" field.
+func (cuuo *ClientUserUpdateOne) AddCreditLimit(f float64) *ClientUserUpdateOne {
+	cuuo.mutation.AddCreditLimit(f)
+	return cuuo
+}
+
+// SetPostpaidSince sets the "postpaid_since" field.
+func (cuuo *ClientUserUpdateOne) SetPostpaidSince(t time.Time) *ClientUserUpdateOne {
+	cuuo.mutation.SetPostpaidSince(t)
+	return cuuo
+}
+
+// SetNillablePostpaidSince sets the "postpaid_since" field if the given value is not nil.
+func (cuuo *ClientUserUpdateOne) SetNillablePostpaidSince(t *time.Time) *ClientUserUpdateOne {
+	if t != nil {
+		cuuo.SetPostpaidSince(*t)
+	}
+	return cuuo
+}
+
+// ClearPostpaidSince clears the value of the "postpaid_since" field.
+func (cuuo *ClientUserUpdateOne) ClearPostpaidSince() *ClientUserUpdateOne {
+	cuuo.mutation.ClearPostpaidSince()
+	return cuuo
+}
+
 // SetAddressLine1 sets the "address_line1" field.
 func (cuuo *ClientUserUpdateOne) SetAddressLine1(s string) *ClientUserUpdateOne {
 	cuuo.mutation.SetAddressLine1(s)
@@ -1402,9 +1532,14 @@ func (cuuo *ClientUserUpdateOne) check() error {
 			return &ValidationError{Name: "photo", err: fmt.Errorf(`ent: validator failed for field "ClientUser.photo": %w`, err)}
 		}
 	}
-	if v, ok := cuuo.mutation.Balance(); ok {
-		if err := clientuser.BalanceValidator(v); err != nil {
-			return &ValidationError{Name: "balance", err: fmt.Errorf(`ent: validator failed for field "ClientUser.balance": %w`, err)}
+	if v, ok := cuuo.mutation.BillingMode(); ok {
+		if err := clientuser.BillingModeValidator(v); err != nil {
+			return &ValidationError{Name: "billing_mode", err: fmt.Errorf(`ent: validator failed for field "ClientUser.billing_mode": %w`, err)}
+		}
+	}
+	if v, ok := cuuo.mutation.CreditLimit(); ok {
+		if err := clientuser.CreditLimitValidator(v); err != nil {
+			return &ValidationError{Name: "credit_limit", err: fmt.Errorf(`ent: validator failed for field "ClientUser.credit_limit": %w`, err)}
 		}
 	}
 	if v, ok := cuuo.mutation.AddressLine1(); ok {
@@ -1552,6 +1687,21 @@ func (cuuo *ClientUserUpdateOne) sqlSave(ctx context.Context) (_node *ClientUser
 	if value, ok := cuuo.mutation.AddedBalance(); ok {
 		_spec.AddField(clientuser.FieldBalance, field.TypeFloat64, value)
 	}
+	if value, ok := cuuo.mutation.BillingMode(); ok {
+		_spec.SetField(clientuser.FieldBillingMode, field.TypeEnum, value)
+	}
+	if value, ok := cuuo.mutation.CreditLimit(); ok {
+		_spec.SetField(clientuser.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := cuuo.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(clientuser.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := cuuo.mutation.PostpaidSince(); ok {
+		_spec.SetField(clientuser.FieldPostpaidSince, field.TypeTime, value)
+	}
+	if cuuo.mutation.PostpaidSinceCleared() {
+		_spec.ClearField(clientuser.FieldPostpaidSince, field.TypeTime)
+	}
 	if value, ok := cuuo.mutation.AddressLine1(); ok {
 		_spec.SetField(clientuser.FieldAddressLine1, field.TypeString, value)
 	}
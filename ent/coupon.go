@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/coupon"
+)
+
+// Coupon is the model entity for the Coupon schema.
+type Coupon struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Stored in upper case, clients may enter it in any case
+	Code string `json:"code,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// DiscountType holds the value of the "discount_type" field.
+	DiscountType coupon.DiscountType `json:"discount_type,omitempty"`
+	// Percentage off for percent coupons, amount off for fixed ones
+	Value float64 `json:"value,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Redemptions allowed across all clients, 0 for no limit
+	MaxUses int `json:"max_uses,omitempty"`
+	// Redemptions allowed per client, 0 for no limit
+	MaxUsesPerClient int `json:"max_uses_per_client,omitempty"`
+	// UsedCount holds the value of the "used_count" field.
+	UsedCount int `json:"used_count,omitempty"`
+	// Packages the coupon applies to, any package when empty
+	PlanIds []int `json:"plan_ids,omitempty"`
+	// Client districts the coupon applies to, any district when empty
+	Districts []string `json:"districts,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Coupon) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldPlanIds, coupon.FieldDistricts:
+			values[i] = new([]byte)
+		case coupon.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case coupon.FieldValue:
+			values[i] = new(sql.NullFloat64)
+		case coupon.FieldID, coupon.FieldMaxUses, coupon.FieldMaxUsesPerClient, coupon.FieldUsedCount:
+			values[i] = new(sql.NullInt64)
+		case coupon.FieldCode, coupon.FieldDescription, coupon.FieldDiscountType, coupon.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case coupon.FieldStartsAt, coupon.FieldExpiresAt, coupon.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Coupon fields.
+func (c *Coupon) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case coupon.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case coupon.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				c.Code = value.String
+			}
+		case coupon.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				c.Description = value.String
+			}
+		case coupon.FieldDiscountType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_type", values[i])
+			} else if value.Valid {
+				c.DiscountType = coupon.DiscountType(value.String)
+			}
+		case coupon.FieldValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				c.Value = value.Float64
+			}
+		case coupon.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				c.StartsAt = new(time.Time)
+				*c.StartsAt = value.Time
+			}
+		case coupon.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				c.ExpiresAt = new(time.Time)
+				*c.ExpiresAt = value.Time
+			}
+		case coupon.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				c.MaxUses = int(value.Int64)
+			}
+		case coupon.FieldMaxUsesPerClient:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses_per_client", values[i])
+			} else if value.Valid {
+				c.MaxUsesPerClient = int(value.Int64)
+			}
+		case coupon.FieldUsedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field used_count", values[i])
+			} else if value.Valid {
+				c.UsedCount = int(value.Int64)
+			}
+		case coupon.FieldPlanIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.PlanIds); err != nil {
+					return fmt.Errorf("unmarshal field plan_ids: %w", err)
+				}
+			}
+		case coupon.FieldDistricts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field districts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Districts); err != nil {
+					return fmt.Errorf("unmarshal field districts: %w", err)
+				}
+			}
+		case coupon.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				c.IsActive = value.Bool
+			}
+		case coupon.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				c.CreatedBy = value.String
+			}
+		case coupon.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the Coupon.
+// This includes values selected through modifiers, order, etc.
+func (c *Coupon) GetValue(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Coupon.
+// Note that you need to call Coupon.Unwrap() before calling this method if this Coupon
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Coupon) Update() *CouponUpdateOne {
+	return NewCouponClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Coupon entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Coupon) Unwrap() *Coupon {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Coupon is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Coupon) String() string {
+	var builder strings.Builder
+	builder.WriteString("Coupon(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("code=")
+	builder.WriteString(c.Code)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(c.Description)
+	builder.WriteString(", ")
+	builder.WriteString("discount_type=")
+	builder.WriteString(fmt.Sprintf("%v", c.DiscountType))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", c.Value))
+	builder.WriteString(", ")
+	if v := c.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := c.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", c.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("max_uses_per_client=")
+	builder.WriteString(fmt.Sprintf("%v", c.MaxUsesPerClient))
+	builder.WriteString(", ")
+	builder.WriteString("used_count=")
+	builder.WriteString(fmt.Sprintf("%v", c.UsedCount))
+	builder.WriteString(", ")
+	builder.WriteString("plan_ids=")
+	builder.WriteString(fmt.Sprintf("%v", c.PlanIds))
+	builder.WriteString(", ")
+	builder.WriteString("districts=")
+	builder.WriteString(fmt.Sprintf("%v", c.Districts))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", c.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(c.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Coupons is a parsable slice of Coupon.
+type Coupons []*Coupon
@@ -0,0 +1,857 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/coupon"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// CouponUpdate is the builder for updating Coupon entities.
+type CouponUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CouponMutation
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (cu *CouponUpdate) Where(ps ...predicate.Coupon) *CouponUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetCode sets the "code" field.
+func (cu *CouponUpdate) SetCode(s string) *CouponUpdate {
+	cu.mutation.SetCode(s)
+	return cu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableCode(s *string) *CouponUpdate {
+	if s != nil {
+		cu.SetCode(*s)
+	}
+	return cu
+}
+
+// SetDescription sets the "description" field.
+func (cu *CouponUpdate) SetDescription(s string) *CouponUpdate {
+	cu.mutation.SetDescription(s)
+	return cu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableDescription(s *string) *CouponUpdate {
+	if s != nil {
+		cu.SetDescription(*s)
+	}
+	return cu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cu *CouponUpdate) ClearDescription() *CouponUpdate {
+	cu.mutation.ClearDescription()
+	return cu
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (cu *CouponUpdate) SetDiscountType(ct coupon.DiscountType) *CouponUpdate {
+	cu.mutation.SetDiscountType(ct)
+	return cu
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableDiscountType(ct *coupon.DiscountType) *CouponUpdate {
+	if ct != nil {
+		cu.SetDiscountType(*ct)
+	}
+	return cu
+}
+
+// SetValue sets the "value" field.
+func (cu *CouponUpdate) SetValue(f float64) *CouponUpdate {
+	cu.mutation.ResetValue()
+	cu.mutation.SetValue(f)
+	return cu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableValue(f *float64) *CouponUpdate {
+	if f != nil {
+		cu.SetValue(*f)
+	}
+	return cu
+}
+
+// AddValue adds f to the "value" field.
+func (cu *CouponUpdate) AddValue(f float64) *CouponUpdate {
+	cu.mutation.AddValue(f)
+	return cu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (cu *CouponUpdate) SetStartsAt(t time.Time) *CouponUpdate {
+	cu.mutation.SetStartsAt(t)
+	return cu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableStartsAt(t *time.Time) *CouponUpdate {
+	if t != nil {
+		cu.SetStartsAt(*t)
+	}
+	return cu
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (cu *CouponUpdate) ClearStartsAt() *CouponUpdate {
+	cu.mutation.ClearStartsAt()
+	return cu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cu *CouponUpdate) SetExpiresAt(t time.Time) *CouponUpdate {
+	cu.mutation.SetExpiresAt(t)
+	return cu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableExpiresAt(t *time.Time) *CouponUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
+	return cu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cu *CouponUpdate) ClearExpiresAt() *CouponUpdate {
+	cu.mutation.ClearExpiresAt()
+	return cu
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (cu *CouponUpdate) SetMaxUses(i int) *CouponUpdate {
+	cu.mutation.ResetMaxUses()
+	cu.mutation.SetMaxUses(i)
+	return cu
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableMaxUses(i *int) *CouponUpdate {
+	if i != nil {
+		cu.SetMaxUses(*i)
+	}
+	return cu
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (cu *CouponUpdate) AddMaxUses(i int) *CouponUpdate {
+	cu.mutation.AddMaxUses(i)
+	return cu
+}
+
+// SetMaxUsesPerClient sets the "max_uses_per_client" field.
+func (cu *CouponUpdate) SetMaxUsesPerClient(i int) *CouponUpdate {
+	cu.mutation.ResetMaxUsesPerClient()
+	cu.mutation.SetMaxUsesPerClient(i)
+	return cu
+}
+
+// SetNillableMaxUsesPerClient sets the "max_uses_per_client" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableMaxUsesPerClient(i *int) *CouponUpdate {
+	if i != nil {
+		cu.SetMaxUsesPerClient(*i)
+	}
+	return cu
+}
+
+// AddMaxUsesPerClient adds i to the "max_uses_per_client" field.
+func (cu *CouponUpdate) AddMaxUsesPerClient(i int) *CouponUpdate {
+	cu.mutation.AddMaxUsesPerClient(i)
+	return cu
+}
+
+// SetUsedCount sets the "used_count" field.
+func (cu *CouponUpdate) SetUsedCount(i int) *CouponUpdate {
+	cu.mutation.ResetUsedCount()
+	cu.mutation.SetUsedCount(i)
+	return cu
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableUsedCount(i *int) *CouponUpdate {
+	if i != nil {
+		cu.SetUsedCount(*i)
+	}
+	return cu
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (cu *CouponUpdate) AddUsedCount(i int) *CouponUpdate {
+	cu.mutation.AddUsedCount(i)
+	return cu
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (cu *CouponUpdate) SetPlanIds(i []int) *CouponUpdate {
+	cu.mutation.SetPlanIds(i)
+	return cu
+}
+
+// AppendPlanIds appends i to the "plan_ids" field.
+func (cu *CouponUpdate) AppendPlanIds(i []int) *CouponUpdate {
+	cu.mutation.AppendPlanIds(i)
+	return cu
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (cu *CouponUpdate) ClearPlanIds() *CouponUpdate {
+	cu.mutation.ClearPlanIds()
+	return cu
+}
+
+// SetDistricts sets the "districts" field.
+func (cu *CouponUpdate) SetDistricts(s []string) *CouponUpdate {
+	cu.mutation.SetDistricts(s)
+	return cu
+}
+
+// AppendDistricts appends s to the "districts" field.
+func (cu *CouponUpdate) AppendDistricts(s []string) *CouponUpdate {
+	cu.mutation.AppendDistricts(s)
+	return cu
+}
+
+// ClearDistricts clears the value of the "districts" field.
+func (cu *CouponUpdate) ClearDistricts() *CouponUpdate {
+	cu.mutation.ClearDistricts()
+	return cu
+}
+
+// SetIsActive sets the "is_active" field.
+func (cu *CouponUpdate) SetIsActive(b bool) *CouponUpdate {
+	cu.mutation.SetIsActive(b)
+	return cu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableIsActive(b *bool) *CouponUpdate {
+	if b != nil {
+		cu.SetIsActive(*b)
+	}
+	return cu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cu *CouponUpdate) SetCreatedBy(s string) *CouponUpdate {
+	cu.mutation.SetCreatedBy(s)
+	return cu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableCreatedBy(s *string) *CouponUpdate {
+	if s != nil {
+		cu.SetCreatedBy(*s)
+	}
+	return cu
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cu *CouponUpdate) Mutation() *CouponMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CouponUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CouponUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CouponUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CouponUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CouponUpdate) check() error {
+	if v, ok := cu.mutation.Code(); ok {
+		if err := coupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Coupon.code": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.Description(); ok {
+		if err := coupon.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Coupon.description": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_type": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.CreatedBy(); ok {
+		if err := coupon.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Coupon.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CouponUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Code(); ok {
+		_spec.SetField(coupon.FieldCode, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Description(); ok {
+		_spec.SetField(coupon.FieldDescription, field.TypeString, value)
+	}
+	if cu.mutation.DescriptionCleared() {
+		_spec.ClearField(coupon.FieldDescription, field.TypeString)
+	}
+	if value, ok := cu.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.Value(); ok {
+		_spec.SetField(coupon.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedValue(); ok {
+		_spec.AddField(coupon.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.StartsAt(); ok {
+		_spec.SetField(coupon.FieldStartsAt, field.TypeTime, value)
+	}
+	if cu.mutation.StartsAtCleared() {
+		_spec.ClearField(coupon.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.ExpiresAt(); ok {
+		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(coupon.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.MaxUses(); ok {
+		_spec.SetField(coupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(coupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.MaxUsesPerClient(); ok {
+		_spec.SetField(coupon.FieldMaxUsesPerClient, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedMaxUsesPerClient(); ok {
+		_spec.AddField(coupon.FieldMaxUsesPerClient, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.UsedCount(); ok {
+		_spec.SetField(coupon.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedUsedCount(); ok {
+		_spec.AddField(coupon.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.PlanIds(); ok {
+		_spec.SetField(coupon.FieldPlanIds, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedPlanIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coupon.FieldPlanIds, value)
+		})
+	}
+	if cu.mutation.PlanIdsCleared() {
+		_spec.ClearField(coupon.FieldPlanIds, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.Districts(); ok {
+		_spec.SetField(coupon.FieldDistricts, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedDistricts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coupon.FieldDistricts, value)
+		})
+	}
+	if cu.mutation.DistrictsCleared() {
+		_spec.ClearField(coupon.FieldDistricts, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.IsActive(); ok {
+		_spec.SetField(coupon.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.CreatedBy(); ok {
+		_spec.SetField(coupon.FieldCreatedBy, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CouponUpdateOne is the builder for updating a single Coupon entity.
+type CouponUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CouponMutation
+}
+
+// SetCode sets the "code" field.
+func (cuo *CouponUpdateOne) SetCode(s string) *CouponUpdateOne {
+	cuo.mutation.SetCode(s)
+	return cuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableCode(s *string) *CouponUpdateOne {
+	if s != nil {
+		cuo.SetCode(*s)
+	}
+	return cuo
+}
+
+// SetDescription sets the "description" field.
+func (cuo *CouponUpdateOne) SetDescription(s string) *CouponUpdateOne {
+	cuo.mutation.SetDescription(s)
+	return cuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableDescription(s *string) *CouponUpdateOne {
+	if s != nil {
+		cuo.SetDescription(*s)
+	}
+	return cuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (cuo *CouponUpdateOne) ClearDescription() *CouponUpdateOne {
+	cuo.mutation.ClearDescription()
+	return cuo
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (cuo *CouponUpdateOne) SetDiscountType(ct coupon.DiscountType) *CouponUpdateOne {
+	cuo.mutation.SetDiscountType(ct)
+	return cuo
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableDiscountType(ct *coupon.DiscountType) *CouponUpdateOne {
+	if ct != nil {
+		cuo.SetDiscountType(*ct)
+	}
+	return cuo
+}
+
+// SetValue sets the "value" field.
+func (cuo *CouponUpdateOne) SetValue(f float64) *CouponUpdateOne {
+	cuo.mutation.ResetValue()
+	cuo.mutation.SetValue(f)
+	return cuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableValue(f *float64) *CouponUpdateOne {
+	if f != nil {
+		cuo.SetValue(*f)
+	}
+	return cuo
+}
+
+// AddValue adds f to the "value" field.
+func (cuo *CouponUpdateOne) AddValue(f float64) *CouponUpdateOne {
+	cuo.mutation.AddValue(f)
+	return cuo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (cuo *CouponUpdateOne) SetStartsAt(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetStartsAt(t)
+	return cuo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableStartsAt(t *time.Time) *CouponUpdateOne {
+	if t != nil {
+		cuo.SetStartsAt(*t)
+	}
+	return cuo
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (cuo *CouponUpdateOne) ClearStartsAt() *CouponUpdateOne {
+	cuo.mutation.ClearStartsAt()
+	return cuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cuo *CouponUpdateOne) SetExpiresAt(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
+	return cuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableExpiresAt(t *time.Time) *CouponUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
+	return cuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cuo *CouponUpdateOne) ClearExpiresAt() *CouponUpdateOne {
+	cuo.mutation.ClearExpiresAt()
+	return cuo
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (cuo *CouponUpdateOne) SetMaxUses(i int) *CouponUpdateOne {
+	cuo.mutation.ResetMaxUses()
+	cuo.mutation.SetMaxUses(i)
+	return cuo
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableMaxUses(i *int) *CouponUpdateOne {
+	if i != nil {
+		cuo.SetMaxUses(*i)
+	}
+	return cuo
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (cuo *CouponUpdateOne) AddMaxUses(i int) *CouponUpdateOne {
+	cuo.mutation.AddMaxUses(i)
+	return cuo
+}
+
+// SetMaxUsesPerClient sets the "max_uses_per_client" field.
+func (cuo *CouponUpdateOne) SetMaxUsesPerClient(i int) *CouponUpdateOne {
+	cuo.mutation.ResetMaxUsesPerClient()
+	cuo.mutation.SetMaxUsesPerClient(i)
+	return cuo
+}
+
+// SetNillableMaxUsesPerClient sets the "max_uses_per_client" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableMaxUsesPerClient(i *int) *CouponUpdateOne {
+	if i != nil {
+		cuo.SetMaxUsesPerClient(*i)
+	}
+	return cuo
+}
+
+// AddMaxUsesPerClient adds i to the "max_uses_per_client" field.
+func (cuo *CouponUpdateOne) AddMaxUsesPerClient(i int) *CouponUpdateOne {
+	cuo.mutation.AddMaxUsesPerClient(i)
+	return cuo
+}
+
+// SetUsedCount sets the "used_count" field.
+func (cuo *CouponUpdateOne) SetUsedCount(i int) *CouponUpdateOne {
+	cuo.mutation.ResetUsedCount()
+	cuo.mutation.SetUsedCount(i)
+	return cuo
+}
+
+// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableUsedCount(i *int) *CouponUpdateOne {
+	if i != nil {
+		cuo.SetUsedCount(*i)
+	}
+	return cuo
+}
+
+// AddUsedCount adds i to the "used_count" field.
+func (cuo *CouponUpdateOne) AddUsedCount(i int) *CouponUpdateOne {
+	cuo.mutation.AddUsedCount(i)
+	return cuo
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (cuo *CouponUpdateOne) SetPlanIds(i []int) *CouponUpdateOne {
+	cuo.mutation.SetPlanIds(i)
+	return cuo
+}
+
+// AppendPlanIds appends i to the "plan_ids" field.
+func (cuo *CouponUpdateOne) AppendPlanIds(i []int) *CouponUpdateOne {
+	cuo.mutation.AppendPlanIds(i)
+	return cuo
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (cuo *CouponUpdateOne) ClearPlanIds() *CouponUpdateOne {
+	cuo.mutation.ClearPlanIds()
+	return cuo
+}
+
+// SetDistricts sets the "districts" field.
+func (cuo *CouponUpdateOne) SetDistricts(s []string) *CouponUpdateOne {
+	cuo.mutation.SetDistricts(s)
+	return cuo
+}
+
+// AppendDistricts appends s to the "districts" field.
+func (cuo *CouponUpdateOne) AppendDistricts(s []string) *CouponUpdateOne {
+	cuo.mutation.AppendDistricts(s)
+	return cuo
+}
+
+// ClearDistricts clears the value of the "districts" field.
+func (cuo *CouponUpdateOne) ClearDistricts() *CouponUpdateOne {
+	cuo.mutation.ClearDistricts()
+	return cuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (cuo *CouponUpdateOne) SetIsActive(b bool) *CouponUpdateOne {
+	cuo.mutation.SetIsActive(b)
+	return cuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableIsActive(b *bool) *CouponUpdateOne {
+	if b != nil {
+		cuo.SetIsActive(*b)
+	}
+	return cuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cuo *CouponUpdateOne) SetCreatedBy(s string) *CouponUpdateOne {
+	cuo.mutation.SetCreatedBy(s)
+	return cuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableCreatedBy(s *string) *CouponUpdateOne {
+	if s != nil {
+		cuo.SetCreatedBy(*s)
+	}
+	return cuo
+}
+
+// Mutation returns the CouponMutation object of the builder.
+func (cuo *CouponUpdateOne) Mutation() *CouponMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CouponUpdate builder.
+func (cuo *CouponUpdateOne) Where(ps ...predicate.Coupon) *CouponUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CouponUpdateOne) Select(field string, fields ...string) *CouponUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Coupon entity.
+func (cuo *CouponUpdateOne) Save(ctx context.Context) (*Coupon, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CouponUpdateOne) SaveX(ctx context.Context) *Coupon {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CouponUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CouponUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CouponUpdateOne) check() error {
+	if v, ok := cuo.mutation.Code(); ok {
+		if err := coupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Coupon.code": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.Description(); ok {
+		if err := coupon.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Coupon.description": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_type": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.CreatedBy(); ok {
+		if err := coupon.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Coupon.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CouponUpdateOne) sqlSave(ctx context.Context) (_node *Coupon, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(coupon.Table, coupon.Columns, sqlgraph.NewFieldSpec(coupon.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Coupon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, coupon.FieldID)
+		for _, f := range fields {
+			if !coupon.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != coupon.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Code(); ok {
+		_spec.SetField(coupon.FieldCode, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Description(); ok {
+		_spec.SetField(coupon.FieldDescription, field.TypeString, value)
+	}
+	if cuo.mutation.DescriptionCleared() {
+		_spec.ClearField(coupon.FieldDescription, field.TypeString)
+	}
+	if value, ok := cuo.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.Value(); ok {
+		_spec.SetField(coupon.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedValue(); ok {
+		_spec.AddField(coupon.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.StartsAt(); ok {
+		_spec.SetField(coupon.FieldStartsAt, field.TypeTime, value)
+	}
+	if cuo.mutation.StartsAtCleared() {
+		_spec.ClearField(coupon.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(coupon.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.MaxUses(); ok {
+		_spec.SetField(coupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(coupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.MaxUsesPerClient(); ok {
+		_spec.SetField(coupon.FieldMaxUsesPerClient, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedMaxUsesPerClient(); ok {
+		_spec.AddField(coupon.FieldMaxUsesPerClient, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.UsedCount(); ok {
+		_spec.SetField(coupon.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedUsedCount(); ok {
+		_spec.AddField(coupon.FieldUsedCount, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.PlanIds(); ok {
+		_spec.SetField(coupon.FieldPlanIds, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedPlanIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coupon.FieldPlanIds, value)
+		})
+	}
+	if cuo.mutation.PlanIdsCleared() {
+		_spec.ClearField(coupon.FieldPlanIds, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.Districts(); ok {
+		_spec.SetField(coupon.FieldDistricts, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedDistricts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, coupon.FieldDistricts, value)
+		})
+	}
+	if cuo.mutation.DistrictsCleared() {
+		_spec.ClearField(coupon.FieldDistricts, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.IsActive(); ok {
+		_spec.SetField(coupon.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.CreatedBy(); ok {
+		_spec.SetField(coupon.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &Coupon{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{coupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+)
+
+// CouponRedemption is the model entity for the CouponRedemption schema.
+type CouponRedemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CouponID holds the value of the "coupon_id" field.
+	CouponID int `json:"coupon_id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID int `json:"client_id,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// transaction_ref of the ClientTxn the discount was applied to
+	TransactionRef string `json:"transaction_ref,omitempty"`
+	// Discount holds the value of the "discount" field.
+	Discount float64 `json:"discount,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CouponRedemption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case couponredemption.FieldDiscount:
+			values[i] = new(sql.NullFloat64)
+		case couponredemption.FieldID, couponredemption.FieldCouponID, couponredemption.FieldClientID:
+			values[i] = new(sql.NullInt64)
+		case couponredemption.FieldCode, couponredemption.FieldClientUsername, couponredemption.FieldTransactionRef:
+			values[i] = new(sql.NullString)
+		case couponredemption.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CouponRedemption fields.
+func (cr *CouponRedemption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case couponredemption.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cr.ID = int(value.Int64)
+		case couponredemption.FieldCouponID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field coupon_id", values[i])
+			} else if value.Valid {
+				cr.CouponID = int(value.Int64)
+			}
+		case couponredemption.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				cr.Code = value.String
+			}
+		case couponredemption.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				cr.ClientID = int(value.Int64)
+			}
+		case couponredemption.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				cr.ClientUsername = value.String
+			}
+		case couponredemption.FieldTransactionRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_ref", values[i])
+			} else if value.Valid {
+				cr.TransactionRef = value.String
+			}
+		case couponredemption.FieldDiscount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount", values[i])
+			} else if value.Valid {
+				cr.Discount = value.Float64
+			}
+		case couponredemption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cr.CreatedAt = value.Time
+			}
+		default:
+			cr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CouponRedemption.
+// This includes values selected through modifiers, order, etc.
+func (cr *CouponRedemption) Value(name string) (ent.Value, error) {
+	return cr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CouponRedemption.
+// Note that you need to call CouponRedemption.Unwrap() before calling this method if this CouponRedemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CouponRedemption) Update() *CouponRedemptionUpdateOne {
+	return NewCouponRedemptionClient(cr.config).UpdateOne(cr)
+}
+
+// Unwrap unwraps the CouponRedemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *CouponRedemption) Unwrap() *CouponRedemption {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CouponRedemption is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CouponRedemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("CouponRedemption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("coupon_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.CouponID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(cr.Code)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(cr.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_ref=")
+	builder.WriteString(cr.TransactionRef)
+	builder.WriteString(", ")
+	builder.WriteString("discount=")
+	builder.WriteString(fmt.Sprintf("%v", cr.Discount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CouponRedemptions is a parsable slice of CouponRedemption.
+type CouponRedemptions []*CouponRedemption
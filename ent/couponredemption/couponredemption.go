@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package couponredemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the couponredemption type in the database.
+	Label = "coupon_redemption"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCouponID holds the string denoting the coupon_id field in the database.
+	FieldCouponID = "coupon_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldClientUsername holds the string denoting the client_username field in the database.
+	FieldClientUsername = "client_username"
+	// FieldTransactionRef holds the string denoting the transaction_ref field in the database.
+	FieldTransactionRef = "transaction_ref"
+	// FieldDiscount holds the string denoting the discount field in the database.
+	FieldDiscount = "discount"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the couponredemption in the database.
+	Table = "coupon_redemptions"
+)
+
+// Columns holds all SQL columns for couponredemption fields.
+var Columns = []string{
+	FieldID,
+	FieldCouponID,
+	FieldCode,
+	FieldClientID,
+	FieldClientUsername,
+	FieldTransactionRef,
+	FieldDiscount,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CouponIDValidator is a validator for the "coupon_id" field. It is called by the builders before save.
+	CouponIDValidator func(int) error
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ClientIDValidator func(int) error
+	// ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	ClientUsernameValidator func(string) error
+	// TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	TransactionRefValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CouponRedemption queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCouponID orders the results by the coupon_id field.
+func ByCouponID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCouponID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByClientUsername orders the results by the client_username field.
+func ByClientUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientUsername, opts...).ToFunc()
+}
+
+// ByTransactionRef orders the results by the transaction_ref field.
+func ByTransactionRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionRef, opts...).ToFunc()
+}
+
+// ByDiscount orders the results by the discount field.
+func ByDiscount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscount, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package couponredemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// CouponID applies equality check predicate on the "coupon_id" field. It's identical to CouponIDEQ.
+func CouponID(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCouponID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCode, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientUsername applies equality check predicate on the "client_username" field. It's identical to ClientUsernameEQ.
+func ClientUsername(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// TransactionRef applies equality check predicate on the "transaction_ref" field. It's identical to TransactionRefEQ.
+func TransactionRef(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
+func Discount(v float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldDiscount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CouponIDEQ applies the EQ predicate on the "coupon_id" field.
+func CouponIDEQ(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCouponID, v))
+}
+
+// CouponIDNEQ applies the NEQ predicate on the "coupon_id" field.
+func CouponIDNEQ(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldCouponID, v))
+}
+
+// CouponIDIn applies the In predicate on the "coupon_id" field.
+func CouponIDIn(vs ...int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldCouponID, vs...))
+}
+
+// CouponIDNotIn applies the NotIn predicate on the "coupon_id" field.
+func CouponIDNotIn(vs ...int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldCouponID, vs...))
+}
+
+// CouponIDGT applies the GT predicate on the "coupon_id" field.
+func CouponIDGT(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldCouponID, v))
+}
+
+// CouponIDGTE applies the GTE predicate on the "coupon_id" field.
+func CouponIDGTE(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldCouponID, v))
+}
+
+// CouponIDLT applies the LT predicate on the "coupon_id" field.
+func CouponIDLT(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldCouponID, v))
+}
+
+// CouponIDLTE applies the LTE predicate on the "coupon_id" field.
+func CouponIDLTE(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldCouponID, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldContainsFold(FieldCode, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v int) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientUsernameEQ applies the EQ predicate on the "client_username" field.
+func ClientUsernameEQ(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameNEQ applies the NEQ predicate on the "client_username" field.
+func ClientUsernameNEQ(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameIn applies the In predicate on the "client_username" field.
+func ClientUsernameIn(vs ...string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameNotIn applies the NotIn predicate on the "client_username" field.
+func ClientUsernameNotIn(vs ...string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameGT applies the GT predicate on the "client_username" field.
+func ClientUsernameGT(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldClientUsername, v))
+}
+
+// ClientUsernameGTE applies the GTE predicate on the "client_username" field.
+func ClientUsernameGTE(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldClientUsername, v))
+}
+
+// ClientUsernameLT applies the LT predicate on the "client_username" field.
+func ClientUsernameLT(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldClientUsername, v))
+}
+
+// ClientUsernameLTE applies the LTE predicate on the "client_username" field.
+func ClientUsernameLTE(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldClientUsername, v))
+}
+
+// ClientUsernameContains applies the Contains predicate on the "client_username" field.
+func ClientUsernameContains(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldContains(FieldClientUsername, v))
+}
+
+// ClientUsernameHasPrefix applies the HasPrefix predicate on the "client_username" field.
+func ClientUsernameHasPrefix(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldHasPrefix(FieldClientUsername, v))
+}
+
+// ClientUsernameHasSuffix applies the HasSuffix predicate on the "client_username" field.
+func ClientUsernameHasSuffix(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldHasSuffix(FieldClientUsername, v))
+}
+
+// ClientUsernameEqualFold applies the EqualFold predicate on the "client_username" field.
+func ClientUsernameEqualFold(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEqualFold(FieldClientUsername, v))
+}
+
+// ClientUsernameContainsFold applies the ContainsFold predicate on the "client_username" field.
+func ClientUsernameContainsFold(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldContainsFold(FieldClientUsername, v))
+}
+
+// TransactionRefEQ applies the EQ predicate on the "transaction_ref" field.
+func TransactionRefEQ(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefNEQ applies the NEQ predicate on the "transaction_ref" field.
+func TransactionRefNEQ(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefIn applies the In predicate on the "transaction_ref" field.
+func TransactionRefIn(vs ...string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefNotIn applies the NotIn predicate on the "transaction_ref" field.
+func TransactionRefNotIn(vs ...string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefGT applies the GT predicate on the "transaction_ref" field.
+func TransactionRefGT(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldTransactionRef, v))
+}
+
+// TransactionRefGTE applies the GTE predicate on the "transaction_ref" field.
+func TransactionRefGTE(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldTransactionRef, v))
+}
+
+// TransactionRefLT applies the LT predicate on the "transaction_ref" field.
+func TransactionRefLT(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldTransactionRef, v))
+}
+
+// TransactionRefLTE applies the LTE predicate on the "transaction_ref" field.
+func TransactionRefLTE(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldTransactionRef, v))
+}
+
+// TransactionRefContains applies the Contains predicate on the "transaction_ref" field.
+func TransactionRefContains(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldContains(FieldTransactionRef, v))
+}
+
+// TransactionRefHasPrefix applies the HasPrefix predicate on the "transaction_ref" field.
+func TransactionRefHasPrefix(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldHasPrefix(FieldTransactionRef, v))
+}
+
+// TransactionRefHasSuffix applies the HasSuffix predicate on the "transaction_ref" field.
+func TransactionRefHasSuffix(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldHasSuffix(FieldTransactionRef, v))
+}
+
+// TransactionRefEqualFold applies the EqualFold predicate on the "transaction_ref" field.
+func TransactionRefEqualFold(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEqualFold(FieldTransactionRef, v))
+}
+
+// TransactionRefContainsFold applies the ContainsFold predicate on the "transaction_ref" field.
+func TransactionRefContainsFold(v string) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldContainsFold(FieldTransactionRef, v))
+}
+
+// DiscountEQ applies the EQ predicate on the "discount" field.
+func DiscountEQ(v float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldDiscount, v))
+}
+
+// DiscountNEQ applies the NEQ predicate on the "discount" field.
+func DiscountNEQ(v float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldDiscount, v))
+}
+
+// DiscountIn applies the In predicate on the "discount" field.
+func DiscountIn(vs ...float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldDiscount, vs...))
+}
+
+// DiscountNotIn applies the NotIn predicate on the "discount" field.
+func DiscountNotIn(vs ...float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldDiscount, vs...))
+}
+
+// DiscountGT applies the GT predicate on the "discount" field.
+func DiscountGT(v float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldDiscount, v))
+}
+
+// DiscountGTE applies the GTE predicate on the "discount" field.
+func DiscountGTE(v float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldDiscount, v))
+}
+
+// DiscountLT applies the LT predicate on the "discount" field.
+func DiscountLT(v float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldDiscount, v))
+}
+
+// DiscountLTE applies the LTE predicate on the "discount" field.
+func DiscountLTE(v float64) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldDiscount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CouponRedemption) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CouponRedemption) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CouponRedemption) predicate.CouponRedemption {
+	return predicate.CouponRedemption(sql.NotPredicates(p))
+}
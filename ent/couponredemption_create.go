@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+)
+
+// CouponRedemptionCreate is the builder for creating a CouponRedemption entity.
+type CouponRedemptionCreate struct {
+	config
+	mutation *CouponRedemptionMutation
+	hooks    []Hook
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (crc *CouponRedemptionCreate) SetCouponID(i int) *CouponRedemptionCreate {
+	crc.mutation.SetCouponID(i)
+	return crc
+}
+
+// SetCode sets the "code" field.
+func (crc *CouponRedemptionCreate) SetCode(s string) *CouponRedemptionCreate {
+	crc.mutation.SetCode(s)
+	return crc
+}
+
+// SetClientID sets the "client_id" field.
+func (crc *CouponRedemptionCreate) SetClientID(i int) *CouponRedemptionCreate {
+	crc.mutation.SetClientID(i)
+	return crc
+}
+
+// SetClientUsername sets the "client_username" field.
+func (crc *CouponRedemptionCreate) SetClientUsername(s string) *CouponRedemptionCreate {
+	crc.mutation.SetClientUsername(s)
+	return crc
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (crc *CouponRedemptionCreate) SetTransactionRef(s string) *CouponRedemptionCreate {
+	crc.mutation.SetTransactionRef(s)
+	return crc
+}
+
+// SetDiscount sets the "discount" field.
+func (crc *CouponRedemptionCreate) SetDiscount(f float64) *CouponRedemptionCreate {
+	crc.mutation.SetDiscount(f)
+	return crc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (crc *CouponRedemptionCreate) SetCreatedAt(t time.Time) *CouponRedemptionCreate {
+	crc.mutation.SetCreatedAt(t)
+	return crc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (crc *CouponRedemptionCreate) SetNillableCreatedAt(t *time.Time) *CouponRedemptionCreate {
+	if t != nil {
+		crc.SetCreatedAt(*t)
+	}
+	return crc
+}
+
+// Mutation returns the CouponRedemptionMutation object of the builder.
+func (crc *CouponRedemptionCreate) Mutation() *CouponRedemptionMutation {
+	return crc.mutation
+}
+
+// Save creates the CouponRedemption in the database.
+func (crc *CouponRedemptionCreate) Save(ctx context.Context) (*CouponRedemption, error) {
+	crc.defaults()
+	return withHooks(ctx, crc.sqlSave, crc.mutation, crc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CouponRedemptionCreate) SaveX(ctx context.Context) *CouponRedemption {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *CouponRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *CouponRedemptionCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (crc *CouponRedemptionCreate) defaults() {
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		v := couponredemption.DefaultCreatedAt()
+		crc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *CouponRedemptionCreate) check() error {
+	if _, ok := crc.mutation.CouponID(); !ok {
+		return &ValidationError{Name: "coupon_id", err: errors.New(`ent: missing required field "CouponRedemption.coupon_id"`)}
+	}
+	if v, ok := crc.mutation.CouponID(); ok {
+		if err := couponredemption.CouponIDValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_id", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.coupon_id": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "CouponRedemption.code"`)}
+	}
+	if v, ok := crc.mutation.Code(); ok {
+		if err := couponredemption.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.code": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "CouponRedemption.client_id"`)}
+	}
+	if v, ok := crc.mutation.ClientID(); ok {
+		if err := couponredemption.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.client_id": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.ClientUsername(); !ok {
+		return &ValidationError{Name: "client_username", err: errors.New(`ent: missing required field "CouponRedemption.client_username"`)}
+	}
+	if v, ok := crc.mutation.ClientUsername(); ok {
+		if err := couponredemption.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.client_username": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.TransactionRef(); !ok {
+		return &ValidationError{Name: "transaction_ref", err: errors.New(`ent: missing required field "CouponRedemption.transaction_ref"`)}
+	}
+	if v, ok := crc.mutation.TransactionRef(); ok {
+		if err := couponredemption.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.transaction_ref": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.Discount(); !ok {
+		return &ValidationError{Name: "discount", err: errors.New(`ent: missing required field "CouponRedemption.discount"`)}
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CouponRedemption.created_at"`)}
+	}
+	return nil
+}
+
+func (crc *CouponRedemptionCreate) sqlSave(ctx context.Context) (*CouponRedemption, error) {
+	if err := crc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	crc.mutation.id = &_node.ID
+	crc.mutation.done = true
+	return _node, nil
+}
+
+func (crc *CouponRedemptionCreate) createSpec() (*CouponRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CouponRedemption{config: crc.config}
+		_spec = sqlgraph.NewCreateSpec(couponredemption.Table, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeInt))
+	)
+	if value, ok := crc.mutation.CouponID(); ok {
+		_spec.SetField(couponredemption.FieldCouponID, field.TypeInt, value)
+		_node.CouponID = value
+	}
+	if value, ok := crc.mutation.Code(); ok {
+		_spec.SetField(couponredemption.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := crc.mutation.ClientID(); ok {
+		_spec.SetField(couponredemption.FieldClientID, field.TypeInt, value)
+		_node.ClientID = value
+	}
+	if value, ok := crc.mutation.ClientUsername(); ok {
+		_spec.SetField(couponredemption.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := crc.mutation.TransactionRef(); ok {
+		_spec.SetField(couponredemption.FieldTransactionRef, field.TypeString, value)
+		_node.TransactionRef = value
+	}
+	if value, ok := crc.mutation.Discount(); ok {
+		_spec.SetField(couponredemption.FieldDiscount, field.TypeFloat64, value)
+		_node.Discount = value
+	}
+	if value, ok := crc.mutation.CreatedAt(); ok {
+		_spec.SetField(couponredemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// CouponRedemptionCreateBulk is the builder for creating many CouponRedemption entities in bulk.
+type CouponRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*CouponRedemptionCreate
+}
+
+// Save creates the CouponRedemption entities in the database.
+func (crcb *CouponRedemptionCreateBulk) Save(ctx context.Context) ([]*CouponRedemption, error) {
+	if crcb.err != nil {
+		return nil, crcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*CouponRedemption, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CouponRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *CouponRedemptionCreateBulk) SaveX(ctx context.Context) []*CouponRedemption {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *CouponRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *CouponRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
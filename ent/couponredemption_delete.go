@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// CouponRedemptionDelete is the builder for deleting a CouponRedemption entity.
+type CouponRedemptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CouponRedemptionMutation
+}
+
+// Where appends a list predicates to the CouponRedemptionDelete builder.
+func (crd *CouponRedemptionDelete) Where(ps ...predicate.CouponRedemption) *CouponRedemptionDelete {
+	crd.mutation.Where(ps...)
+	return crd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (crd *CouponRedemptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, crd.sqlExec, crd.mutation, crd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crd *CouponRedemptionDelete) ExecX(ctx context.Context) int {
+	n, err := crd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (crd *CouponRedemptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(couponredemption.Table, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeInt))
+	if ps := crd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, crd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	crd.mutation.done = true
+	return affected, err
+}
+
+// CouponRedemptionDeleteOne is the builder for deleting a single CouponRedemption entity.
+type CouponRedemptionDeleteOne struct {
+	crd *CouponRedemptionDelete
+}
+
+// Where appends a list predicates to the CouponRedemptionDelete builder.
+func (crdo *CouponRedemptionDeleteOne) Where(ps ...predicate.CouponRedemption) *CouponRedemptionDeleteOne {
+	crdo.crd.mutation.Where(ps...)
+	return crdo
+}
+
+// Exec executes the deletion query.
+func (crdo *CouponRedemptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := crdo.crd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{couponredemption.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crdo *CouponRedemptionDeleteOne) ExecX(ctx context.Context) {
+	if err := crdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// CouponRedemptionQuery is the builder for querying CouponRedemption entities.
+type CouponRedemptionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []couponredemption.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CouponRedemption
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CouponRedemptionQuery builder.
+func (crq *CouponRedemptionQuery) Where(ps ...predicate.CouponRedemption) *CouponRedemptionQuery {
+	crq.predicates = append(crq.predicates, ps...)
+	return crq
+}
+
+// Limit the number of records to be returned by this query.
+func (crq *CouponRedemptionQuery) Limit(limit int) *CouponRedemptionQuery {
+	crq.ctx.Limit = &limit
+	return crq
+}
+
+// Offset to start from.
+func (crq *CouponRedemptionQuery) Offset(offset int) *CouponRedemptionQuery {
+	crq.ctx.Offset = &offset
+	return crq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (crq *CouponRedemptionQuery) Unique(unique bool) *CouponRedemptionQuery {
+	crq.ctx.Unique = &unique
+	return crq
+}
+
+// Order specifies how the records should be ordered.
+func (crq *CouponRedemptionQuery) Order(o ...couponredemption.OrderOption) *CouponRedemptionQuery {
+	crq.order = append(crq.order, o...)
+	return crq
+}
+
+// First returns the first CouponRedemption entity from the query.
+// Returns a *NotFoundError when no CouponRedemption was found.
+func (crq *CouponRedemptionQuery) First(ctx context.Context) (*CouponRedemption, error) {
+	nodes, err := crq.Limit(1).All(setContextOp(ctx, crq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{couponredemption.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (crq *CouponRedemptionQuery) FirstX(ctx context.Context) *CouponRedemption {
+	node, err := crq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CouponRedemption ID from the query.
+// Returns a *NotFoundError when no CouponRedemption ID was found.
+func (crq *CouponRedemptionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = crq.Limit(1).IDs(setContextOp(ctx, crq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{couponredemption.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (crq *CouponRedemptionQuery) FirstIDX(ctx context.Context) int {
+	id, err := crq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CouponRedemption entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CouponRedemption entity is found.
+// Returns a *NotFoundError when no CouponRedemption entities are found.
+func (crq *CouponRedemptionQuery) Only(ctx context.Context) (*CouponRedemption, error) {
+	nodes, err := crq.Limit(2).All(setContextOp(ctx, crq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{couponredemption.Label}
+	default:
+		return nil, &NotSingularError{couponredemption.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (crq *CouponRedemptionQuery) OnlyX(ctx context.Context) *CouponRedemption {
+	node, err := crq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CouponRedemption ID in the query.
+// Returns a *NotSingularError when more than one CouponRedemption ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (crq *CouponRedemptionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = crq.Limit(2).IDs(setContextOp(ctx, crq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{couponredemption.Label}
+	default:
+		err = &NotSingularError{couponredemption.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (crq *CouponRedemptionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := crq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CouponRedemptions.
+func (crq *CouponRedemptionQuery) All(ctx context.Context) ([]*CouponRedemption, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryAll)
+	if err := crq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CouponRedemption, *CouponRedemptionQuery]()
+	return withInterceptors[[]*CouponRedemption](ctx, crq, qr, crq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (crq *CouponRedemptionQuery) AllX(ctx context.Context) []*CouponRedemption {
+	nodes, err := crq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CouponRedemption IDs.
+func (crq *CouponRedemptionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if crq.ctx.Unique == nil && crq.path != nil {
+		crq.Unique(true)
+	}
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryIDs)
+	if err = crq.Select(couponredemption.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (crq *CouponRedemptionQuery) IDsX(ctx context.Context) []int {
+	ids, err := crq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (crq *CouponRedemptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryCount)
+	if err := crq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, crq, querierCount[*CouponRedemptionQuery](), crq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (crq *CouponRedemptionQuery) CountX(ctx context.Context) int {
+	count, err := crq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (crq *CouponRedemptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, crq.ctx, ent.OpQueryExist)
+	switch _, err := crq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (crq *CouponRedemptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := crq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CouponRedemptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (crq *CouponRedemptionQuery) Clone() *CouponRedemptionQuery {
+	if crq == nil {
+		return nil
+	}
+	return &CouponRedemptionQuery{
+		config:     crq.config,
+		ctx:        crq.ctx.Clone(),
+		order:      append([]couponredemption.OrderOption{}, crq.order...),
+		inters:     append([]Interceptor{}, crq.inters...),
+		predicates: append([]predicate.CouponRedemption{}, crq.predicates...),
+		// clone intermediate query.
+		sql:  crq.sql.Clone(),
+		path: crq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CouponID int `json:"coupon_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CouponRedemption.Query().
+//		GroupBy(couponredemption.FieldCouponID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (crq *CouponRedemptionQuery) GroupBy(field string, fields ...string) *CouponRedemptionGroupBy {
+	crq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CouponRedemptionGroupBy{build: crq}
+	grbuild.flds = &crq.ctx.Fields
+	grbuild.label = couponredemption.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CouponID int `json:"coupon_id,omitempty"`
+//	}
+//
+//	client.CouponRedemption.Query().
+//		Select(couponredemption.FieldCouponID).
+//		Scan(ctx, &v)
+func (crq *CouponRedemptionQuery) Select(fields ...string) *CouponRedemptionSelect {
+	crq.ctx.Fields = append(crq.ctx.Fields, fields...)
+	sbuild := &CouponRedemptionSelect{CouponRedemptionQuery: crq}
+	sbuild.label = couponredemption.Label
+	sbuild.flds, sbuild.scan = &crq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CouponRedemptionSelect configured with the given aggregations.
+func (crq *CouponRedemptionQuery) Aggregate(fns ...AggregateFunc) *CouponRedemptionSelect {
+	return crq.Select().Aggregate(fns...)
+}
+
+func (crq *CouponRedemptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range crq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, crq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range crq.ctx.Fields {
+		if !couponredemption.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if crq.path != nil {
+		prev, err := crq.path(ctx)
+		if err != nil {
+			return err
+		}
+		crq.sql = prev
+	}
+	return nil
+}
+
+func (crq *CouponRedemptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CouponRedemption, error) {
+	var (
+		nodes = []*CouponRedemption{}
+		_spec = crq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CouponRedemption).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CouponRedemption{config: crq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, crq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (crq *CouponRedemptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := crq.querySpec()
+	_spec.Node.Columns = crq.ctx.Fields
+	if len(crq.ctx.Fields) > 0 {
+		_spec.Unique = crq.ctx.Unique != nil && *crq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, crq.driver, _spec)
+}
+
+func (crq *CouponRedemptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(couponredemption.Table, couponredemption.Columns, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeInt))
+	_spec.From = crq.sql
+	if unique := crq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if crq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := crq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, couponredemption.FieldID)
+		for i := range fields {
+			if fields[i] != couponredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := crq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := crq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (crq *CouponRedemptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(crq.driver.Dialect())
+	t1 := builder.Table(couponredemption.Table)
+	columns := crq.ctx.Fields
+	if len(columns) == 0 {
+		columns = couponredemption.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if crq.sql != nil {
+		selector = crq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if crq.ctx.Unique != nil && *crq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range crq.predicates {
+		p(selector)
+	}
+	for _, p := range crq.order {
+		p(selector)
+	}
+	if offset := crq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := crq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CouponRedemptionGroupBy is the group-by builder for CouponRedemption entities.
+type CouponRedemptionGroupBy struct {
+	selector
+	build *CouponRedemptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (crgb *CouponRedemptionGroupBy) Aggregate(fns ...AggregateFunc) *CouponRedemptionGroupBy {
+	crgb.fns = append(crgb.fns, fns...)
+	return crgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crgb *CouponRedemptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crgb.build.ctx, ent.OpQueryGroupBy)
+	if err := crgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CouponRedemptionQuery, *CouponRedemptionGroupBy](ctx, crgb.build, crgb, crgb.build.inters, v)
+}
+
+func (crgb *CouponRedemptionGroupBy) sqlScan(ctx context.Context, root *CouponRedemptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(crgb.fns))
+	for _, fn := range crgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*crgb.flds)+len(crgb.fns))
+		for _, f := range *crgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*crgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CouponRedemptionSelect is the builder for selecting fields of CouponRedemption entities.
+type CouponRedemptionSelect struct {
+	*CouponRedemptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (crs *CouponRedemptionSelect) Aggregate(fns ...AggregateFunc) *CouponRedemptionSelect {
+	crs.fns = append(crs.fns, fns...)
+	return crs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (crs *CouponRedemptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, crs.ctx, ent.OpQuerySelect)
+	if err := crs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CouponRedemptionQuery, *CouponRedemptionSelect](ctx, crs.CouponRedemptionQuery, crs, crs.inters, v)
+}
+
+func (crs *CouponRedemptionSelect) sqlScan(ctx context.Context, root *CouponRedemptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(crs.fns))
+	for _, fn := range crs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*crs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := crs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// CouponRedemptionUpdate is the builder for updating CouponRedemption entities.
+type CouponRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CouponRedemptionMutation
+}
+
+// Where appends a list predicates to the CouponRedemptionUpdate builder.
+func (cru *CouponRedemptionUpdate) Where(ps ...predicate.CouponRedemption) *CouponRedemptionUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (cru *CouponRedemptionUpdate) SetCouponID(i int) *CouponRedemptionUpdate {
+	cru.mutation.ResetCouponID()
+	cru.mutation.SetCouponID(i)
+	return cru
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (cru *CouponRedemptionUpdate) SetNillableCouponID(i *int) *CouponRedemptionUpdate {
+	if i != nil {
+		cru.SetCouponID(*i)
+	}
+	return cru
+}
+
+// AddCouponID adds i to the "coupon_id" field.
+func (cru *CouponRedemptionUpdate) AddCouponID(i int) *CouponRedemptionUpdate {
+	cru.mutation.AddCouponID(i)
+	return cru
+}
+
+// SetCode sets the "code" field.
+func (cru *CouponRedemptionUpdate) SetCode(s string) *CouponRedemptionUpdate {
+	cru.mutation.SetCode(s)
+	return cru
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (cru *CouponRedemptionUpdate) SetNillableCode(s *string) *CouponRedemptionUpdate {
+	if s != nil {
+		cru.SetCode(*s)
+	}
+	return cru
+}
+
+// SetClientID sets the "client_id" field.
+func (cru *CouponRedemptionUpdate) SetClientID(i int) *CouponRedemptionUpdate {
+	cru.mutation.ResetClientID()
+	cru.mutation.SetClientID(i)
+	return cru
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (cru *CouponRedemptionUpdate) SetNillableClientID(i *int) *CouponRedemptionUpdate {
+	if i != nil {
+		cru.SetClientID(*i)
+	}
+	return cru
+}
+
+// AddClientID adds i to the "client_id" field.
+func (cru *CouponRedemptionUpdate) AddClientID(i int) *CouponRedemptionUpdate {
+	cru.mutation.AddClientID(i)
+	return cru
+}
+
+// SetClientUsername sets the "client_username" field.
+func (cru *CouponRedemptionUpdate) SetClientUsername(s string) *CouponRedemptionUpdate {
+	cru.mutation.SetClientUsername(s)
+	return cru
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (cru *CouponRedemptionUpdate) SetNillableClientUsername(s *string) *CouponRedemptionUpdate {
+	if s != nil {
+		cru.SetClientUsername(*s)
+	}
+	return cru
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (cru *CouponRedemptionUpdate) SetTransactionRef(s string) *CouponRedemptionUpdate {
+	cru.mutation.SetTransactionRef(s)
+	return cru
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (cru *CouponRedemptionUpdate) SetNillableTransactionRef(s *string) *CouponRedemptionUpdate {
+	if s != nil {
+		cru.SetTransactionRef(*s)
+	}
+	return cru
+}
+
+// SetDiscount sets the "discount" field.
+func (cru *CouponRedemptionUpdate) SetDiscount(f float64) *CouponRedemptionUpdate {
+	cru.mutation.ResetDiscount()
+	cru.mutation.SetDiscount(f)
+	return cru
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (cru *CouponRedemptionUpdate) SetNillableDiscount(f *float64) *CouponRedemptionUpdate {
+	if f != nil {
+		cru.SetDiscount(*f)
+	}
+	return cru
+}
+
+// AddDiscount adds f to the "discount" field.
+func (cru *CouponRedemptionUpdate) AddDiscount(f float64) *CouponRedemptionUpdate {
+	cru.mutation.AddDiscount(f)
+	return cru
+}
+
+// Mutation returns the CouponRedemptionMutation object of the builder.
+func (cru *CouponRedemptionUpdate) Mutation() *CouponRedemptionMutation {
+	return cru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *CouponRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cru.sqlSave, cru.mutation, cru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *CouponRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *CouponRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *CouponRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cru *CouponRedemptionUpdate) check() error {
+	if v, ok := cru.mutation.CouponID(); ok {
+		if err := couponredemption.CouponIDValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_id", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.coupon_id": %w`, err)}
+		}
+	}
+	if v, ok := cru.mutation.Code(); ok {
+		if err := couponredemption.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.code": %w`, err)}
+		}
+	}
+	if v, ok := cru.mutation.ClientID(); ok {
+		if err := couponredemption.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.client_id": %w`, err)}
+		}
+	}
+	if v, ok := cru.mutation.ClientUsername(); ok {
+		if err := couponredemption.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.client_username": %w`, err)}
+		}
+	}
+	if v, ok := cru.mutation.TransactionRef(); ok {
+		if err := couponredemption.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.transaction_ref": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cru *CouponRedemptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(couponredemption.Table, couponredemption.Columns, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeInt))
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.CouponID(); ok {
+		_spec.SetField(couponredemption.FieldCouponID, field.TypeInt, value)
+	}
+	if value, ok := cru.mutation.AddedCouponID(); ok {
+		_spec.AddField(couponredemption.FieldCouponID, field.TypeInt, value)
+	}
+	if value, ok := cru.mutation.Code(); ok {
+		_spec.SetField(couponredemption.FieldCode, field.TypeString, value)
+	}
+	if value, ok := cru.mutation.ClientID(); ok {
+		_spec.SetField(couponredemption.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := cru.mutation.AddedClientID(); ok {
+		_spec.AddField(couponredemption.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := cru.mutation.ClientUsername(); ok {
+		_spec.SetField(couponredemption.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := cru.mutation.TransactionRef(); ok {
+		_spec.SetField(couponredemption.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := cru.mutation.Discount(); ok {
+		_spec.SetField(couponredemption.FieldDiscount, field.TypeFloat64, value)
+	}
+	if value, ok := cru.mutation.AddedDiscount(); ok {
+		_spec.AddField(couponredemption.FieldDiscount, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{couponredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cru.mutation.done = true
+	return n, nil
+}
+
+// CouponRedemptionUpdateOne is the builder for updating a single CouponRedemption entity.
+type CouponRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CouponRedemptionMutation
+}
+
+// SetCouponID sets the "coupon_id" field.
+func (cruo *CouponRedemptionUpdateOne) SetCouponID(i int) *CouponRedemptionUpdateOne {
+	cruo.mutation.ResetCouponID()
+	cruo.mutation.SetCouponID(i)
+	return cruo
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (cruo *CouponRedemptionUpdateOne) SetNillableCouponID(i *int) *CouponRedemptionUpdateOne {
+	if i != nil {
+		cruo.SetCouponID(*i)
+	}
+	return cruo
+}
+
+// AddCouponID adds i to the "coupon_id" field.
+func (cruo *CouponRedemptionUpdateOne) AddCouponID(i int) *CouponRedemptionUpdateOne {
+	cruo.mutation.AddCouponID(i)
+	return cruo
+}
+
+// SetCode sets the "code" field.
+func (cruo *CouponRedemptionUpdateOne) SetCode(s string) *CouponRedemptionUpdateOne {
+	cruo.mutation.SetCode(s)
+	return cruo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (cruo *CouponRedemptionUpdateOne) SetNillableCode(s *string) *CouponRedemptionUpdateOne {
+	if s != nil {
+		cruo.SetCode(*s)
+	}
+	return cruo
+}
+
+// SetClientID sets the "client_id" field.
+func (cruo *CouponRedemptionUpdateOne) SetClientID(i int) *CouponRedemptionUpdateOne {
+	cruo.mutation.ResetClientID()
+	cruo.mutation.SetClientID(i)
+	return cruo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (cruo *CouponRedemptionUpdateOne) SetNillableClientID(i *int) *CouponRedemptionUpdateOne {
+	if i != nil {
+		cruo.SetClientID(*i)
+	}
+	return cruo
+}
+
+// AddClientID adds i to the "client_id" field.
+func (cruo *CouponRedemptionUpdateOne) AddClientID(i int) *CouponRedemptionUpdateOne {
+	cruo.mutation.AddClientID(i)
+	return cruo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (cruo *CouponRedemptionUpdateOne) SetClientUsername(s string) *CouponRedemptionUpdateOne {
+	cruo.mutation.SetClientUsername(s)
+	return cruo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (cruo *CouponRedemptionUpdateOne) SetNillableClientUsername(s *string) *CouponRedemptionUpdateOne {
+	if s != nil {
+		cruo.SetClientUsername(*s)
+	}
+	return cruo
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (cruo *CouponRedemptionUpdateOne) SetTransactionRef(s string) *CouponRedemptionUpdateOne {
+	cruo.mutation.SetTransactionRef(s)
+	return cruo
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (cruo *CouponRedemptionUpdateOne) SetNillableTransactionRef(s *string) *CouponRedemptionUpdateOne {
+	if s != nil {
+		cruo.SetTransactionRef(*s)
+	}
+	return cruo
+}
+
+// SetDiscount sets the "discount" field.
+func (cruo *CouponRedemptionUpdateOne) SetDiscount(f float64) *CouponRedemptionUpdateOne {
+	cruo.mutation.ResetDiscount()
+	cruo.mutation.SetDiscount(f)
+	return cruo
+}
+
+// SetNillableDiscount sets the "discount" field if the given value is not nil.
+func (cruo *CouponRedemptionUpdateOne) SetNillableDiscount(f *float64) *CouponRedemptionUpdateOne {
+	if f != nil {
+		cruo.SetDiscount(*f)
+	}
+	return cruo
+}
+
+// AddDiscount adds f to the "discount" field.
+func (cruo *CouponRedemptionUpdateOne) AddDiscount(f float64) *CouponRedemptionUpdateOne {
+	cruo.mutation.AddDiscount(f)
+	return cruo
+}
+
+// Mutation returns the CouponRedemptionMutation object of the builder.
+func (cruo *CouponRedemptionUpdateOne) Mutation() *CouponRedemptionMutation {
+	return cruo.mutation
+}
+
+// Where appends a list predicates to the CouponRedemptionUpdate builder.
+func (cruo *CouponRedemptionUpdateOne) Where(ps ...predicate.CouponRedemption) *CouponRedemptionUpdateOne {
+	cruo.mutation.Where(ps...)
+	return cruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *CouponRedemptionUpdateOne) Select(field string, fields ...string) *CouponRedemptionUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated CouponRedemption entity.
+func (cruo *CouponRedemptionUpdateOne) Save(ctx context.Context) (*CouponRedemption, error) {
+	return withHooks(ctx, cruo.sqlSave, cruo.mutation, cruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *CouponRedemptionUpdateOne) SaveX(ctx context.Context) *CouponRedemption {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *CouponRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *CouponRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cruo *CouponRedemptionUpdateOne) check() error {
+	if v, ok := cruo.mutation.CouponID(); ok {
+		if err := couponredemption.CouponIDValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_id", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.coupon_id": %w`, err)}
+		}
+	}
+	if v, ok := cruo.mutation.Code(); ok {
+		if err := couponredemption.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.code": %w`, err)}
+		}
+	}
+	if v, ok := cruo.mutation.ClientID(); ok {
+		if err := couponredemption.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.client_id": %w`, err)}
+		}
+	}
+	if v, ok := cruo.mutation.ClientUsername(); ok {
+		if err := couponredemption.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.client_username": %w`, err)}
+		}
+	}
+	if v, ok := cruo.mutation.TransactionRef(); ok {
+		if err := couponredemption.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "CouponRedemption.transaction_ref": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cruo *CouponRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *CouponRedemption, err error) {
+	if err := cruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(couponredemption.Table, couponredemption.Columns, sqlgraph.NewFieldSpec(couponredemption.FieldID, field.TypeInt))
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CouponRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, couponredemption.FieldID)
+		for _, f := range fields {
+			if !couponredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != couponredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.CouponID(); ok {
+		_spec.SetField(couponredemption.FieldCouponID, field.TypeInt, value)
+	}
+	if value, ok := cruo.mutation.AddedCouponID(); ok {
+		_spec.AddField(couponredemption.FieldCouponID, field.TypeInt, value)
+	}
+	if value, ok := cruo.mutation.Code(); ok {
+		_spec.SetField(couponredemption.FieldCode, field.TypeString, value)
+	}
+	if value, ok := cruo.mutation.ClientID(); ok {
+		_spec.SetField(couponredemption.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := cruo.mutation.AddedClientID(); ok {
+		_spec.AddField(couponredemption.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := cruo.mutation.ClientUsername(); ok {
+		_spec.SetField(couponredemption.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := cruo.mutation.TransactionRef(); ok {
+		_spec.SetField(couponredemption.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := cruo.mutation.Discount(); ok {
+		_spec.SetField(couponredemption.FieldDiscount, field.TypeFloat64, value)
+	}
+	if value, ok := cruo.mutation.AddedDiscount(); ok {
+		_spec.AddField(couponredemption.FieldDiscount, field.TypeFloat64, value)
+	}
+	_node = &CouponRedemption{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{couponredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cruo.mutation.done = true
+	return _node, nil
+}
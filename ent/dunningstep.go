@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
+)
+
+// DunningStep is the model entity for the DunningStep schema.
+type DunningStep struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID int `json:"client_id,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind dunningstep.Kind `json:"kind,omitempty"`
+	// Expiry cycle or invoice number the reminder is about
+	Reference string `json:"reference,omitempty"`
+	// Package expiry or invoice due date the step is counted from
+	DueAt time.Time `json:"due_at,omitempty"`
+	// Day of the step relative to due_at, negative before it
+	OffsetDays int `json:"offset_days,omitempty"`
+	// Level holds the value of the "level" field.
+	Level dunningstep.Level `json:"level,omitempty"`
+	// Amount the client was asked to pay
+	Amount float64 `json:"amount,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// Status holds the value of the "status" field.
+	Status dunningstep.Status `json:"status,omitempty"`
+	// Comma separated channels the reminder reached, e.g. email,sms,push
+	Channels string `json:"channels,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt       *time.Time `json:"sent_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DunningStep) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dunningstep.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case dunningstep.FieldID, dunningstep.FieldClientID, dunningstep.FieldOffsetDays:
+			values[i] = new(sql.NullInt64)
+		case dunningstep.FieldClientUsername, dunningstep.FieldKind, dunningstep.FieldReference, dunningstep.FieldLevel, dunningstep.FieldSubject, dunningstep.FieldMessage, dunningstep.FieldStatus, dunningstep.FieldChannels, dunningstep.FieldError:
+			values[i] = new(sql.NullString)
+		case dunningstep.FieldDueAt, dunningstep.FieldCreatedAt, dunningstep.FieldSentAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DunningStep fields.
+func (ds *DunningStep) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dunningstep.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ds.ID = int(value.Int64)
+		case dunningstep.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				ds.ClientID = int(value.Int64)
+			}
+		case dunningstep.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				ds.ClientUsername = value.String
+			}
+		case dunningstep.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ds.Kind = dunningstep.Kind(value.String)
+			}
+		case dunningstep.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				ds.Reference = value.String
+			}
+		case dunningstep.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				ds.DueAt = value.Time
+			}
+		case dunningstep.FieldOffsetDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset_days", values[i])
+			} else if value.Valid {
+				ds.OffsetDays = int(value.Int64)
+			}
+		case dunningstep.FieldLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				ds.Level = dunningstep.Level(value.String)
+			}
+		case dunningstep.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ds.Amount = value.Float64
+			}
+		case dunningstep.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				ds.Subject = value.String
+			}
+		case dunningstep.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				ds.Message = value.String
+			}
+		case dunningstep.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ds.Status = dunningstep.Status(value.String)
+			}
+		case dunningstep.FieldChannels:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channels", values[i])
+			} else if value.Valid {
+				ds.Channels = value.String
+			}
+		case dunningstep.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				ds.Error = value.String
+			}
+		case dunningstep.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ds.CreatedAt = value.Time
+			}
+		case dunningstep.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				ds.SentAt = new(time.Time)
+				*ds.SentAt = value.Time
+			}
+		default:
+			ds.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DunningStep.
+// This includes values selected through modifiers, order, etc.
+func (ds *DunningStep) Value(name string) (ent.Value, error) {
+	return ds.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DunningStep.
+// Note that you need to call DunningStep.Unwrap() before calling this method if this DunningStep
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ds *DunningStep) Update() *DunningStepUpdateOne {
+	return NewDunningStepClient(ds.config).UpdateOne(ds)
+}
+
+// Unwrap unwraps the DunningStep entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ds *DunningStep) Unwrap() *DunningStep {
+	_tx, ok := ds.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DunningStep is not a transactional entity")
+	}
+	ds.config.driver = _tx.drv
+	return ds
+}
+
+// String implements the fmt.Stringer.
+func (ds *DunningStep) String() string {
+	var builder strings.Builder
+	builder.WriteString("DunningStep(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ds.ID))
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", ds.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(ds.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(ds.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("due_at=")
+	builder.WriteString(ds.DueAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("offset_days=")
+	builder.WriteString(fmt.Sprintf("%v", ds.OffsetDays))
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Level))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(ds.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(ds.Message)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ds.Status))
+	builder.WriteString(", ")
+	builder.WriteString("channels=")
+	builder.WriteString(ds.Channels)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(ds.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ds.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ds.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DunningSteps is a parsable slice of DunningStep.
+type DunningSteps []*DunningStep
@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dunningstep
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldID, id))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientUsername applies equality check predicate on the "client_username" field. It's identical to ClientUsernameEQ.
+func ClientUsername(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldReference, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldDueAt, v))
+}
+
+// OffsetDays applies equality check predicate on the "offset_days" field. It's identical to OffsetDaysEQ.
+func OffsetDays(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldOffsetDays, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldAmount, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldSubject, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldMessage, v))
+}
+
+// Channels applies equality check predicate on the "channels" field. It's identical to ChannelsEQ.
+func Channels(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldChannels, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldSentAt, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientUsernameEQ applies the EQ predicate on the "client_username" field.
+func ClientUsernameEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameNEQ applies the NEQ predicate on the "client_username" field.
+func ClientUsernameNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameIn applies the In predicate on the "client_username" field.
+func ClientUsernameIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameNotIn applies the NotIn predicate on the "client_username" field.
+func ClientUsernameNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameGT applies the GT predicate on the "client_username" field.
+func ClientUsernameGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldClientUsername, v))
+}
+
+// ClientUsernameGTE applies the GTE predicate on the "client_username" field.
+func ClientUsernameGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldClientUsername, v))
+}
+
+// ClientUsernameLT applies the LT predicate on the "client_username" field.
+func ClientUsernameLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldClientUsername, v))
+}
+
+// ClientUsernameLTE applies the LTE predicate on the "client_username" field.
+func ClientUsernameLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldClientUsername, v))
+}
+
+// ClientUsernameContains applies the Contains predicate on the "client_username" field.
+func ClientUsernameContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldClientUsername, v))
+}
+
+// ClientUsernameHasPrefix applies the HasPrefix predicate on the "client_username" field.
+func ClientUsernameHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldClientUsername, v))
+}
+
+// ClientUsernameHasSuffix applies the HasSuffix predicate on the "client_username" field.
+func ClientUsernameHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldClientUsername, v))
+}
+
+// ClientUsernameEqualFold applies the EqualFold predicate on the "client_username" field.
+func ClientUsernameEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldClientUsername, v))
+}
+
+// ClientUsernameContainsFold applies the ContainsFold predicate on the "client_username" field.
+func ClientUsernameContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldClientUsername, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldReference, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldDueAt, v))
+}
+
+// OffsetDaysEQ applies the EQ predicate on the "offset_days" field.
+func OffsetDaysEQ(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldOffsetDays, v))
+}
+
+// OffsetDaysNEQ applies the NEQ predicate on the "offset_days" field.
+func OffsetDaysNEQ(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldOffsetDays, v))
+}
+
+// OffsetDaysIn applies the In predicate on the "offset_days" field.
+func OffsetDaysIn(vs ...int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldOffsetDays, vs...))
+}
+
+// OffsetDaysNotIn applies the NotIn predicate on the "offset_days" field.
+func OffsetDaysNotIn(vs ...int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldOffsetDays, vs...))
+}
+
+// OffsetDaysGT applies the GT predicate on the "offset_days" field.
+func OffsetDaysGT(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldOffsetDays, v))
+}
+
+// OffsetDaysGTE applies the GTE predicate on the "offset_days" field.
+func OffsetDaysGTE(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldOffsetDays, v))
+}
+
+// OffsetDaysLT applies the LT predicate on the "offset_days" field.
+func OffsetDaysLT(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldOffsetDays, v))
+}
+
+// OffsetDaysLTE applies the LTE predicate on the "offset_days" field.
+func OffsetDaysLTE(v int) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldOffsetDays, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v Level) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v Level) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...Level) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...Level) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldAmount, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ChannelsEQ applies the EQ predicate on the "channels" field.
+func ChannelsEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldChannels, v))
+}
+
+// ChannelsNEQ applies the NEQ predicate on the "channels" field.
+func ChannelsNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldChannels, v))
+}
+
+// ChannelsIn applies the In predicate on the "channels" field.
+func ChannelsIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldChannels, vs...))
+}
+
+// ChannelsNotIn applies the NotIn predicate on the "channels" field.
+func ChannelsNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldChannels, vs...))
+}
+
+// ChannelsGT applies the GT predicate on the "channels" field.
+func ChannelsGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldChannels, v))
+}
+
+// ChannelsGTE applies the GTE predicate on the "channels" field.
+func ChannelsGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldChannels, v))
+}
+
+// ChannelsLT applies the LT predicate on the "channels" field.
+func ChannelsLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldChannels, v))
+}
+
+// ChannelsLTE applies the LTE predicate on the "channels" field.
+func ChannelsLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldChannels, v))
+}
+
+// ChannelsContains applies the Contains predicate on the "channels" field.
+func ChannelsContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldChannels, v))
+}
+
+// ChannelsHasPrefix applies the HasPrefix predicate on the "channels" field.
+func ChannelsHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldChannels, v))
+}
+
+// ChannelsHasSuffix applies the HasSuffix predicate on the "channels" field.
+func ChannelsHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldChannels, v))
+}
+
+// ChannelsIsNil applies the IsNil predicate on the "channels" field.
+func ChannelsIsNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIsNull(FieldChannels))
+}
+
+// ChannelsNotNil applies the NotNil predicate on the "channels" field.
+func ChannelsNotNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotNull(FieldChannels))
+}
+
+// ChannelsEqualFold applies the EqualFold predicate on the "channels" field.
+func ChannelsEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldChannels, v))
+}
+
+// ChannelsContainsFold applies the ContainsFold predicate on the "channels" field.
+func ChannelsContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldChannels, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.DunningStep {
+	return predicate.DunningStep(sql.FieldNotNull(FieldSentAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DunningStep) predicate.DunningStep {
+	return predicate.DunningStep(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DunningStep) predicate.DunningStep {
+	return predicate.DunningStep(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DunningStep) predicate.DunningStep {
+	return predicate.DunningStep(sql.NotPredicates(p))
+}
@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
+)
+
+// DunningStepCreate is the builder for creating a DunningStep entity.
+type DunningStepCreate struct {
+	config
+	mutation *DunningStepMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (dsc *DunningStepCreate) SetClientID(i int) *DunningStepCreate {
+	dsc.mutation.SetClientID(i)
+	return dsc
+}
+
+// SetClientUsername sets the "client_username" field.
+func (dsc *DunningStepCreate) SetClientUsername(s string) *DunningStepCreate {
+	dsc.mutation.SetClientUsername(s)
+	return dsc
+}
+
+// SetKind sets the "kind" field.
+func (dsc *DunningStepCreate) SetKind(d dunningstep.Kind) *DunningStepCreate {
+	dsc.mutation.SetKind(d)
+	return dsc
+}
+
+// SetReference sets the "reference" field.
+func (dsc *DunningStepCreate) SetReference(s string) *DunningStepCreate {
+	dsc.mutation.SetReference(s)
+	return dsc
+}
+
+// SetDueAt sets the "due_at" field.
+func (dsc *DunningStepCreate) SetDueAt(t time.Time) *DunningStepCreate {
+	dsc.mutation.SetDueAt(t)
+	return dsc
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (dsc *DunningStepCreate) SetOffsetDays(i int) *DunningStepCreate {
+	dsc.mutation.SetOffsetDays(i)
+	return dsc
+}
+
+// SetLevel sets the "level" field.
+func (dsc *DunningStepCreate) SetLevel(d dunningstep.Level) *DunningStepCreate {
+	dsc.mutation.SetLevel(d)
+	return dsc
+}
+
+// SetAmount sets the "amount" field.
+func (dsc *DunningStepCreate) SetAmount(f float64) *DunningStepCreate {
+	dsc.mutation.SetAmount(f)
+	return dsc
+}
+
+// SetSubject sets the "subject" field.
+func (dsc *DunningStepCreate) SetSubject(s string) *DunningStepCreate {
+	dsc.mutation.SetSubject(s)
+	return dsc
+}
+
+// SetMessage sets the "message" field.
+func (dsc *DunningStepCreate) SetMessage(s string) *DunningStepCreate {
+	dsc.mutation.SetMessage(s)
+	return dsc
+}
+
+// SetStatus sets the "status" field.
+func (dsc *DunningStepCreate) SetStatus(d dunningstep.Status) *DunningStepCreate {
+	dsc.mutation.SetStatus(d)
+	return dsc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dsc *DunningStepCreate) SetNillableStatus(d *dunningstep.Status) *DunningStepCreate {
+	if d != nil {
+		dsc.SetStatus(*d)
+	}
+	return dsc
+}
+
+// SetChannels sets the "channels" field.
+func (dsc *DunningStepCreate) SetChannels(s string) *DunningStepCreate {
+	dsc.mutation.SetChannels(s)
+	return dsc
+}
+
+// SetNillableChannels sets the "channels" field if the given value is not nil.
+func (dsc *DunningStepCreate) SetNillableChannels(s *string) *DunningStepCreate {
+	if s != nil {
+		dsc.SetChannels(*s)
+	}
+	return dsc
+}
+
+// SetError sets the "error" field.
+func (dsc *DunningStepCreate) SetError(s string) *DunningStepCreate {
+	dsc.mutation.SetError(s)
+	return dsc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dsc *DunningStepCreate) SetNillableError(s *string) *DunningStepCreate {
+	if s != nil {
+		dsc.SetError(*s)
+	}
+	return dsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dsc *DunningStepCreate) SetCreatedAt(t time.Time) *DunningStepCreate {
+	dsc.mutation.SetCreatedAt(t)
+	return dsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dsc *DunningStepCreate) SetNillableCreatedAt(t *time.Time) *DunningStepCreate {
+	if t != nil {
+		dsc.SetCreatedAt(*t)
+	}
+	return dsc
+}
+
+// SetSentAt sets the "sent_at" field.
+func (dsc *DunningStepCreate) SetSentAt(t time.Time) *DunningStepCreate {
+	dsc.mutation.SetSentAt(t)
+	return dsc
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (dsc *DunningStepCreate) SetNillableSentAt(t *time.Time) *DunningStepCreate {
+	if t != nil {
+		dsc.SetSentAt(*t)
+	}
+	return dsc
+}
+
+// Mutation returns the DunningStepMutation object of the builder.
+func (dsc *DunningStepCreate) Mutation() *DunningStepMutation {
+	return dsc.mutation
+}
+
+// Save creates the DunningStep in the database.
+func (dsc *DunningStepCreate) Save(ctx context.Context) (*DunningStep, error) {
+	dsc.defaults()
+	return withHooks(ctx, dsc.sqlSave, dsc.mutation, dsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dsc *DunningStepCreate) SaveX(ctx context.Context) *DunningStep {
+	v, err := dsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dsc *DunningStepCreate) Exec(ctx context.Context) error {
+	_, err := dsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsc *DunningStepCreate) ExecX(ctx context.Context) {
+	if err := dsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dsc *DunningStepCreate) defaults() {
+	if _, ok := dsc.mutation.Status(); !ok {
+		v := dunningstep.DefaultStatus
+		dsc.mutation.SetStatus(v)
+	}
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		v := dunningstep.DefaultCreatedAt()
+		dsc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsc *DunningStepCreate) check() error {
+	if _, ok := dsc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "DunningStep.client_id"`)}
+	}
+	if v, ok := dsc.mutation.ClientID(); ok {
+		if err := dunningstep.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "DunningStep.client_id": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.ClientUsername(); !ok {
+		return &ValidationError{Name: "client_username", err: errors.New(`ent: missing required field "DunningStep.client_username"`)}
+	}
+	if v, ok := dsc.mutation.ClientUsername(); ok {
+		if err := dunningstep.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "DunningStep.client_username": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "DunningStep.kind"`)}
+	}
+	if v, ok := dsc.mutation.Kind(); ok {
+		if err := dunningstep.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DunningStep.kind": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.Reference(); !ok {
+		return &ValidationError{Name: "reference", err: errors.New(`ent: missing required field "DunningStep.reference"`)}
+	}
+	if v, ok := dsc.mutation.Reference(); ok {
+		if err := dunningstep.ReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "DunningStep.reference": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.DueAt(); !ok {
+		return &ValidationError{Name: "due_at", err: errors.New(`ent: missing required field "DunningStep.due_at"`)}
+	}
+	if _, ok := dsc.mutation.OffsetDays(); !ok {
+		return &ValidationError{Name: "offset_days", err: errors.New(`ent: missing required field "DunningStep.offset_days"`)}
+	}
+	if _, ok := dsc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "DunningStep.level"`)}
+	}
+	if v, ok := dsc.mutation.Level(); ok {
+		if err := dunningstep.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "DunningStep.level": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "DunningStep.amount"`)}
+	}
+	if _, ok := dsc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "DunningStep.subject"`)}
+	}
+	if v, ok := dsc.mutation.Subject(); ok {
+		if err := dunningstep.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "DunningStep.subject": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "DunningStep.message"`)}
+	}
+	if _, ok := dsc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DunningStep.status"`)}
+	}
+	if v, ok := dsc.mutation.Status(); ok {
+		if err := dunningstep.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DunningStep.status": %w`, err)}
+		}
+	}
+	if v, ok := dsc.mutation.Channels(); ok {
+		if err := dunningstep.ChannelsValidator(v); err != nil {
+			return &ValidationError{Name: "channels", err: fmt.Errorf(`ent: validator failed for field "DunningStep.channels": %w`, err)}
+		}
+	}
+	if v, ok := dsc.mutation.Error(); ok {
+		if err := dunningstep.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "DunningStep.error": %w`, err)}
+		}
+	}
+	if _, ok := dsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DunningStep.created_at"`)}
+	}
+	return nil
+}
+
+func (dsc *DunningStepCreate) sqlSave(ctx context.Context) (*DunningStep, error) {
+	if err := dsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dsc.mutation.id = &_node.ID
+	dsc.mutation.done = true
+	return _node, nil
+}
+
+func (dsc *DunningStepCreate) createSpec() (*DunningStep, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DunningStep{config: dsc.config}
+		_spec = sqlgraph.NewCreateSpec(dunningstep.Table, sqlgraph.NewFieldSpec(dunningstep.FieldID, field.TypeInt))
+	)
+	if value, ok := dsc.mutation.ClientID(); ok {
+		_spec.SetField(dunningstep.FieldClientID, field.TypeInt, value)
+		_node.ClientID = value
+	}
+	if value, ok := dsc.mutation.ClientUsername(); ok {
+		_spec.SetField(dunningstep.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := dsc.mutation.Kind(); ok {
+		_spec.SetField(dunningstep.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := dsc.mutation.Reference(); ok {
+		_spec.SetField(dunningstep.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := dsc.mutation.DueAt(); ok {
+		_spec.SetField(dunningstep.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = value
+	}
+	if value, ok := dsc.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+		_node.OffsetDays = value
+	}
+	if value, ok := dsc.mutation.Level(); ok {
+		_spec.SetField(dunningstep.FieldLevel, field.TypeEnum, value)
+		_node.Level = value
+	}
+	if value, ok := dsc.mutation.Amount(); ok {
+		_spec.SetField(dunningstep.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := dsc.mutation.Subject(); ok {
+		_spec.SetField(dunningstep.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := dsc.mutation.Message(); ok {
+		_spec.SetField(dunningstep.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := dsc.mutation.Status(); ok {
+		_spec.SetField(dunningstep.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dsc.mutation.Channels(); ok {
+		_spec.SetField(dunningstep.FieldChannels, field.TypeString, value)
+		_node.Channels = value
+	}
+	if value, ok := dsc.mutation.Error(); ok {
+		_spec.SetField(dunningstep.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := dsc.mutation.CreatedAt(); ok {
+		_spec.SetField(dunningstep.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dsc.mutation.SentAt(); ok {
+		_spec.SetField(dunningstep.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	return _node, _spec
+}
+
+// DunningStepCreateBulk is the builder for creating many DunningStep entities in bulk.
+type DunningStepCreateBulk struct {
+	config
+	err      error
+	builders []*DunningStepCreate
+}
+
+// Save creates the DunningStep entities in the database.
+func (dscb *DunningStepCreateBulk) Save(ctx context.Context) ([]*DunningStep, error) {
+	if dscb.err != nil {
+		return nil, dscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dscb.builders))
+	nodes := make([]*DunningStep, len(dscb.builders))
+	mutators := make([]Mutator, len(dscb.builders))
+	for i := range dscb.builders {
+		func(i int, root context.Context) {
+			builder := dscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DunningStepMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dscb *DunningStepCreateBulk) SaveX(ctx context.Context) []*DunningStep {
+	v, err := dscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dscb *DunningStepCreateBulk) Exec(ctx context.Context) error {
+	_, err := dscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dscb *DunningStepCreateBulk) ExecX(ctx context.Context) {
+	if err := dscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
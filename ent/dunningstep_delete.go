@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// DunningStepDelete is the builder for deleting a DunningStep entity.
+type DunningStepDelete struct {
+	config
+	hooks    []Hook
+	mutation *DunningStepMutation
+}
+
+// Where appends a list predicates to the DunningStepDelete builder.
+func (dsd *DunningStepDelete) Where(ps ...predicate.DunningStep) *DunningStepDelete {
+	dsd.mutation.Where(ps...)
+	return dsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dsd *DunningStepDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dsd.sqlExec, dsd.mutation, dsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsd *DunningStepDelete) ExecX(ctx context.Context) int {
+	n, err := dsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dsd *DunningStepDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dunningstep.Table, sqlgraph.NewFieldSpec(dunningstep.FieldID, field.TypeInt))
+	if ps := dsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dsd.mutation.done = true
+	return affected, err
+}
+
+// DunningStepDeleteOne is the builder for deleting a single DunningStep entity.
+type DunningStepDeleteOne struct {
+	dsd *DunningStepDelete
+}
+
+// Where appends a list predicates to the DunningStepDelete builder.
+func (dsdo *DunningStepDeleteOne) Where(ps ...predicate.DunningStep) *DunningStepDeleteOne {
+	dsdo.dsd.mutation.Where(ps...)
+	return dsdo
+}
+
+// Exec executes the deletion query.
+func (dsdo *DunningStepDeleteOne) Exec(ctx context.Context) error {
+	n, err := dsdo.dsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dunningstep.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsdo *DunningStepDeleteOne) ExecX(ctx context.Context) {
+	if err := dsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
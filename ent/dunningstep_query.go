@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// DunningStepQuery is the builder for querying DunningStep entities.
+type DunningStepQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dunningstep.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DunningStep
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DunningStepQuery builder.
+func (dsq *DunningStepQuery) Where(ps ...predicate.DunningStep) *DunningStepQuery {
+	dsq.predicates = append(dsq.predicates, ps...)
+	return dsq
+}
+
+// Limit the number of records to be returned by this query.
+func (dsq *DunningStepQuery) Limit(limit int) *DunningStepQuery {
+	dsq.ctx.Limit = &limit
+	return dsq
+}
+
+// Offset to start from.
+func (dsq *DunningStepQuery) Offset(offset int) *DunningStepQuery {
+	dsq.ctx.Offset = &offset
+	return dsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dsq *DunningStepQuery) Unique(unique bool) *DunningStepQuery {
+	dsq.ctx.Unique = &unique
+	return dsq
+}
+
+// Order specifies how the records should be ordered.
+func (dsq *DunningStepQuery) Order(o ...dunningstep.OrderOption) *DunningStepQuery {
+	dsq.order = append(dsq.order, o...)
+	return dsq
+}
+
+// First returns the first DunningStep entity from the query.
+// Returns a *NotFoundError when no DunningStep was found.
+func (dsq *DunningStepQuery) First(ctx context.Context) (*DunningStep, error) {
+	nodes, err := dsq.Limit(1).All(setContextOp(ctx, dsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dunningstep.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dsq *DunningStepQuery) FirstX(ctx context.Context) *DunningStep {
+	node, err := dsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DunningStep ID from the query.
+// Returns a *NotFoundError when no DunningStep ID was found.
+func (dsq *DunningStepQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dsq.Limit(1).IDs(setContextOp(ctx, dsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dunningstep.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dsq *DunningStepQuery) FirstIDX(ctx context.Context) int {
+	id, err := dsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DunningStep entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DunningStep entity is found.
+// Returns a *NotFoundError when no DunningStep entities are found.
+func (dsq *DunningStepQuery) Only(ctx context.Context) (*DunningStep, error) {
+	nodes, err := dsq.Limit(2).All(setContextOp(ctx, dsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dunningstep.Label}
+	default:
+		return nil, &NotSingularError{dunningstep.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dsq *DunningStepQuery) OnlyX(ctx context.Context) *DunningStep {
+	node, err := dsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DunningStep ID in the query.
+// Returns a *NotSingularError when more than one DunningStep ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dsq *DunningStepQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dsq.Limit(2).IDs(setContextOp(ctx, dsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dunningstep.Label}
+	default:
+		err = &NotSingularError{dunningstep.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dsq *DunningStepQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DunningSteps.
+func (dsq *DunningStepQuery) All(ctx context.Context) ([]*DunningStep, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryAll)
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DunningStep, *DunningStepQuery]()
+	return withInterceptors[[]*DunningStep](ctx, dsq, qr, dsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dsq *DunningStepQuery) AllX(ctx context.Context) []*DunningStep {
+	nodes, err := dsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DunningStep IDs.
+func (dsq *DunningStepQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dsq.ctx.Unique == nil && dsq.path != nil {
+		dsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryIDs)
+	if err = dsq.Select(dunningstep.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dsq *DunningStepQuery) IDsX(ctx context.Context) []int {
+	ids, err := dsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dsq *DunningStepQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryCount)
+	if err := dsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dsq, querierCount[*DunningStepQuery](), dsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dsq *DunningStepQuery) CountX(ctx context.Context) int {
+	count, err := dsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dsq *DunningStepQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dsq.ctx, ent.OpQueryExist)
+	switch _, err := dsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dsq *DunningStepQuery) ExistX(ctx context.Context) bool {
+	exist, err := dsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DunningStepQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dsq *DunningStepQuery) Clone() *DunningStepQuery {
+	if dsq == nil {
+		return nil
+	}
+	return &DunningStepQuery{
+		config:     dsq.config,
+		ctx:        dsq.ctx.Clone(),
+		order:      append([]dunningstep.OrderOption{}, dsq.order...),
+		inters:     append([]Interceptor{}, dsq.inters...),
+		predicates: append([]predicate.DunningStep{}, dsq.predicates...),
+		// clone intermediate query.
+		sql:  dsq.sql.Clone(),
+		path: dsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID int `json:"client_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DunningStep.Query().
+//		GroupBy(dunningstep.FieldClientID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dsq *DunningStepQuery) GroupBy(field string, fields ...string) *DunningStepGroupBy {
+	dsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DunningStepGroupBy{build: dsq}
+	grbuild.flds = &dsq.ctx.Fields
+	grbuild.label = dunningstep.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID int `json:"client_id,omitempty"`
+//	}
+//
+//	client.DunningStep.Query().
+//		Select(dunningstep.FieldClientID).
+//		Scan(ctx, &v)
+func (dsq *DunningStepQuery) Select(fields ...string) *DunningStepSelect {
+	dsq.ctx.Fields = append(dsq.ctx.Fields, fields...)
+	sbuild := &DunningStepSelect{DunningStepQuery: dsq}
+	sbuild.label = dunningstep.Label
+	sbuild.flds, sbuild.scan = &dsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DunningStepSelect configured with the given aggregations.
+func (dsq *DunningStepQuery) Aggregate(fns ...AggregateFunc) *DunningStepSelect {
+	return dsq.Select().Aggregate(fns...)
+}
+
+func (dsq *DunningStepQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dsq.ctx.Fields {
+		if !dunningstep.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dsq.path != nil {
+		prev, err := dsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dsq.sql = prev
+	}
+	return nil
+}
+
+func (dsq *DunningStepQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DunningStep, error) {
+	var (
+		nodes = []*DunningStep{}
+		_spec = dsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DunningStep).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DunningStep{config: dsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dsq *DunningStepQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dsq.querySpec()
+	_spec.Node.Columns = dsq.ctx.Fields
+	if len(dsq.ctx.Fields) > 0 {
+		_spec.Unique = dsq.ctx.Unique != nil && *dsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dsq.driver, _spec)
+}
+
+func (dsq *DunningStepQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dunningstep.Table, dunningstep.Columns, sqlgraph.NewFieldSpec(dunningstep.FieldID, field.TypeInt))
+	_spec.From = dsq.sql
+	if unique := dsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dunningstep.FieldID)
+		for i := range fields {
+			if fields[i] != dunningstep.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dsq *DunningStepQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dsq.driver.Dialect())
+	t1 := builder.Table(dunningstep.Table)
+	columns := dsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dunningstep.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dsq.sql != nil {
+		selector = dsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dsq.ctx.Unique != nil && *dsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dsq.predicates {
+		p(selector)
+	}
+	for _, p := range dsq.order {
+		p(selector)
+	}
+	if offset := dsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DunningStepGroupBy is the group-by builder for DunningStep entities.
+type DunningStepGroupBy struct {
+	selector
+	build *DunningStepQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dsgb *DunningStepGroupBy) Aggregate(fns ...AggregateFunc) *DunningStepGroupBy {
+	dsgb.fns = append(dsgb.fns, fns...)
+	return dsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dsgb *DunningStepGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DunningStepQuery, *DunningStepGroupBy](ctx, dsgb.build, dsgb, dsgb.build.inters, v)
+}
+
+func (dsgb *DunningStepGroupBy) sqlScan(ctx context.Context, root *DunningStepQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dsgb.fns))
+	for _, fn := range dsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dsgb.flds)+len(dsgb.fns))
+		for _, f := range *dsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DunningStepSelect is the builder for selecting fields of DunningStep entities.
+type DunningStepSelect struct {
+	*DunningStepQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dss *DunningStepSelect) Aggregate(fns ...AggregateFunc) *DunningStepSelect {
+	dss.fns = append(dss.fns, fns...)
+	return dss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dss *DunningStepSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dss.ctx, ent.OpQuerySelect)
+	if err := dss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DunningStepQuery, *DunningStepSelect](ctx, dss.DunningStepQuery, dss, dss.inters, v)
+}
+
+func (dss *DunningStepSelect) sqlScan(ctx context.Context, root *DunningStepQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dss.fns))
+	for _, fn := range dss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,872 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// DunningStepUpdate is the builder for updating DunningStep entities.
+type DunningStepUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DunningStepMutation
+}
+
+// Where appends a list predicates to the DunningStepUpdate builder.
+func (dsu *DunningStepUpdate) Where(ps ...predicate.DunningStep) *DunningStepUpdate {
+	dsu.mutation.Where(ps...)
+	return dsu
+}
+
+// SetClientID sets the "client_id" field.
+func (dsu *DunningStepUpdate) SetClientID(i int) *DunningStepUpdate {
+	dsu.mutation.ResetClientID()
+	dsu.mutation.SetClientID(i)
+	return dsu
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableClientID(i *int) *DunningStepUpdate {
+	if i != nil {
+		dsu.SetClientID(*i)
+	}
+	return dsu
+}
+
+// AddClientID adds i to the "client_id" field.
+func (dsu *DunningStepUpdate) AddClientID(i int) *DunningStepUpdate {
+	dsu.mutation.AddClientID(i)
+	return dsu
+}
+
+// SetClientUsername sets the "client_username" field.
+func (dsu *DunningStepUpdate) SetClientUsername(s string) *DunningStepUpdate {
+	dsu.mutation.SetClientUsername(s)
+	return dsu
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableClientUsername(s *string) *DunningStepUpdate {
+	if s != nil {
+		dsu.SetClientUsername(*s)
+	}
+	return dsu
+}
+
+// SetKind sets the "kind" field.
+func (dsu *DunningStepUpdate) SetKind(d dunningstep.Kind) *DunningStepUpdate {
+	dsu.mutation.SetKind(d)
+	return dsu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableKind(d *dunningstep.Kind) *DunningStepUpdate {
+	if d != nil {
+		dsu.SetKind(*d)
+	}
+	return dsu
+}
+
+// SetReference sets the "reference" field.
+func (dsu *DunningStepUpdate) SetReference(s string) *DunningStepUpdate {
+	dsu.mutation.SetReference(s)
+	return dsu
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableReference(s *string) *DunningStepUpdate {
+	if s != nil {
+		dsu.SetReference(*s)
+	}
+	return dsu
+}
+
+// SetDueAt sets the "due_at" field.
+func (dsu *DunningStepUpdate) SetDueAt(t time.Time) *DunningStepUpdate {
+	dsu.mutation.SetDueAt(t)
+	return dsu
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableDueAt(t *time.Time) *DunningStepUpdate {
+	if t != nil {
+		dsu.SetDueAt(*t)
+	}
+	return dsu
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (dsu *DunningStepUpdate) SetOffsetDays(i int) *DunningStepUpdate {
+	dsu.mutation.ResetOffsetDays()
+	dsu.mutation.SetOffsetDays(i)
+	return dsu
+}
+
+// SetNillableOffsetDays sets the "offset_days" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableOffsetDays(i *int) *DunningStepUpdate {
+	if i != nil {
+		dsu.SetOffsetDays(*i)
+	}
+	return dsu
+}
+
+// AddOffsetDays adds i to the "offset_days" field.
+func (dsu *DunningStepUpdate) AddOffsetDays(i int) *DunningStepUpdate {
+	dsu.mutation.AddOffsetDays(i)
+	return dsu
+}
+
+// SetLevel sets the "level" field.
+func (dsu *DunningStepUpdate) SetLevel(d dunningstep.Level) *DunningStepUpdate {
+	dsu.mutation.SetLevel(d)
+	return dsu
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableLevel(d *dunningstep.Level) *DunningStepUpdate {
+	if d != nil {
+		dsu.SetLevel(*d)
+	}
+	return dsu
+}
+
+// SetAmount sets the "amount" field.
+func (dsu *DunningStepUpdate) SetAmount(f float64) *DunningStepUpdate {
+	dsu.mutation.ResetAmount()
+	dsu.mutation.SetAmount(f)
+	return dsu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableAmount(f *float64) *DunningStepUpdate {
+	if f != nil {
+		dsu.SetAmount(*f)
+	}
+	return dsu
+}
+
+// AddAmount adds f to the "amount" field.
+func (dsu *DunningStepUpdate) AddAmount(f float64) *DunningStepUpdate {
+	dsu.mutation.AddAmount(f)
+	return dsu
+}
+
+// SetSubject sets the "subject" field.
+func (dsu *DunningStepUpdate) SetSubject(s string) *DunningStepUpdate {
+	dsu.mutation.SetSubject(s)
+	return dsu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableSubject(s *string) *DunningStepUpdate {
+	if s != nil {
+		dsu.SetSubject(*s)
+	}
+	return dsu
+}
+
+// SetMessage sets the "message" field.
+func (dsu *DunningStepUpdate) SetMessage(s string) *DunningStepUpdate {
+	dsu.mutation.SetMessage(s)
+	return dsu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableMessage(s *string) *DunningStepUpdate {
+	if s != nil {
+		dsu.SetMessage(*s)
+	}
+	return dsu
+}
+
+// SetStatus sets the "status" field.
+func (dsu *DunningStepUpdate) SetStatus(d dunningstep.Status) *DunningStepUpdate {
+	dsu.mutation.SetStatus(d)
+	return dsu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableStatus(d *dunningstep.Status) *DunningStepUpdate {
+	if d != nil {
+		dsu.SetStatus(*d)
+	}
+	return dsu
+}
+
+// SetChannels sets the "channels" field.
+func (dsu *DunningStepUpdate) SetChannels(s string) *DunningStepUpdate {
+	dsu.mutation.SetChannels(s)
+	return dsu
+}
+
+// SetNillableChannels sets the "channels" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableChannels(s *string) *DunningStepUpdate {
+	if s != nil {
+		dsu.SetChannels(*s)
+	}
+	return dsu
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (dsu *DunningStepUpdate) ClearChannels() *DunningStepUpdate {
+	dsu.mutation.ClearChannels()
+	return dsu
+}
+
+// SetError sets the "error" field.
+func (dsu *DunningStepUpdate) SetError(s string) *DunningStepUpdate {
+	dsu.mutation.SetError(s)
+	return dsu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableError(s *string) *DunningStepUpdate {
+	if s != nil {
+		dsu.SetError(*s)
+	}
+	return dsu
+}
+
+// ClearError clears the value of the "error" field.
+func (dsu *DunningStepUpdate) ClearError() *DunningStepUpdate {
+	dsu.mutation.ClearError()
+	return dsu
+}
+
+// SetSentAt sets the "sent_at" field.
+func (dsu *DunningStepUpdate) SetSentAt(t time.Time) *DunningStepUpdate {
+	dsu.mutation.SetSentAt(t)
+	return dsu
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (dsu *DunningStepUpdate) SetNillableSentAt(t *time.Time) *DunningStepUpdate {
+	if t != nil {
+		dsu.SetSentAt(*t)
+	}
+	return dsu
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (dsu *DunningStepUpdate) ClearSentAt() *DunningStepUpdate {
+	dsu.mutation.ClearSentAt()
+	return dsu
+}
+
+// Mutation returns the DunningStepMutation object of the builder.
+func (dsu *DunningStepUpdate) Mutation() *DunningStepMutation {
+	return dsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dsu *DunningStepUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, dsu.sqlSave, dsu.mutation, dsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsu *DunningStepUpdate) SaveX(ctx context.Context) int {
+	affected, err := dsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dsu *DunningStepUpdate) Exec(ctx context.Context) error {
+	_, err := dsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsu *DunningStepUpdate) ExecX(ctx context.Context) {
+	if err := dsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsu *DunningStepUpdate) check() error {
+	if v, ok := dsu.mutation.ClientID(); ok {
+		if err := dunningstep.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "DunningStep.client_id": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.ClientUsername(); ok {
+		if err := dunningstep.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "DunningStep.client_username": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.Kind(); ok {
+		if err := dunningstep.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DunningStep.kind": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.Reference(); ok {
+		if err := dunningstep.ReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "DunningStep.reference": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.Level(); ok {
+		if err := dunningstep.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "DunningStep.level": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.Subject(); ok {
+		if err := dunningstep.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "DunningStep.subject": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.Status(); ok {
+		if err := dunningstep.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DunningStep.status": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.Channels(); ok {
+		if err := dunningstep.ChannelsValidator(v); err != nil {
+			return &ValidationError{Name: "channels", err: fmt.Errorf(`ent: validator failed for field "DunningStep.channels": %w`, err)}
+		}
+	}
+	if v, ok := dsu.mutation.Error(); ok {
+		if err := dunningstep.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "DunningStep.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dsu *DunningStepUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dunningstep.Table, dunningstep.Columns, sqlgraph.NewFieldSpec(dunningstep.FieldID, field.TypeInt))
+	if ps := dsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsu.mutation.ClientID(); ok {
+		_spec.SetField(dunningstep.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.AddedClientID(); ok {
+		_spec.AddField(dunningstep.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.ClientUsername(); ok {
+		_spec.SetField(dunningstep.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.Kind(); ok {
+		_spec.SetField(dunningstep.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := dsu.mutation.Reference(); ok {
+		_spec.SetField(dunningstep.FieldReference, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.DueAt(); ok {
+		_spec.SetField(dunningstep.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := dsu.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.AddedOffsetDays(); ok {
+		_spec.AddField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := dsu.mutation.Level(); ok {
+		_spec.SetField(dunningstep.FieldLevel, field.TypeEnum, value)
+	}
+	if value, ok := dsu.mutation.Amount(); ok {
+		_spec.SetField(dunningstep.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsu.mutation.AddedAmount(); ok {
+		_spec.AddField(dunningstep.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsu.mutation.Subject(); ok {
+		_spec.SetField(dunningstep.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.Message(); ok {
+		_spec.SetField(dunningstep.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := dsu.mutation.Status(); ok {
+		_spec.SetField(dunningstep.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dsu.mutation.Channels(); ok {
+		_spec.SetField(dunningstep.FieldChannels, field.TypeString, value)
+	}
+	if dsu.mutation.ChannelsCleared() {
+		_spec.ClearField(dunningstep.FieldChannels, field.TypeString)
+	}
+	if value, ok := dsu.mutation.Error(); ok {
+		_spec.SetField(dunningstep.FieldError, field.TypeString, value)
+	}
+	if dsu.mutation.ErrorCleared() {
+		_spec.ClearField(dunningstep.FieldError, field.TypeString)
+	}
+	if value, ok := dsu.mutation.SentAt(); ok {
+		_spec.SetField(dunningstep.FieldSentAt, field.TypeTime, value)
+	}
+	if dsu.mutation.SentAtCleared() {
+		_spec.ClearField(dunningstep.FieldSentAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningstep.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dsu.mutation.done = true
+	return n, nil
+}
+
+// DunningStepUpdateOne is the builder for updating a single DunningStep entity.
+type DunningStepUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DunningStepMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (dsuo *DunningStepUpdateOne) SetClientID(i int) *DunningStepUpdateOne {
+	dsuo.mutation.ResetClientID()
+	dsuo.mutation.SetClientID(i)
+	return dsuo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableClientID(i *int) *DunningStepUpdateOne {
+	if i != nil {
+		dsuo.SetClientID(*i)
+	}
+	return dsuo
+}
+
+// AddClientID adds i to the "client_id" field.
+func (dsuo *DunningStepUpdateOne) AddClientID(i int) *DunningStepUpdateOne {
+	dsuo.mutation.AddClientID(i)
+	return dsuo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (dsuo *DunningStepUpdateOne) SetClientUsername(s string) *DunningStepUpdateOne {
+	dsuo.mutation.SetClientUsername(s)
+	return dsuo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableClientUsername(s *string) *DunningStepUpdateOne {
+	if s != nil {
+		dsuo.SetClientUsername(*s)
+	}
+	return dsuo
+}
+
+// SetKind sets the "kind" field.
+func (dsuo *DunningStepUpdateOne) SetKind(d dunningstep.Kind) *DunningStepUpdateOne {
+	dsuo.mutation.SetKind(d)
+	return dsuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableKind(d *dunningstep.Kind) *DunningStepUpdateOne {
+	if d != nil {
+		dsuo.SetKind(*d)
+	}
+	return dsuo
+}
+
+// SetReference sets the "reference" field.
+func (dsuo *DunningStepUpdateOne) SetReference(s string) *DunningStepUpdateOne {
+	dsuo.mutation.SetReference(s)
+	return dsuo
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableReference(s *string) *DunningStepUpdateOne {
+	if s != nil {
+		dsuo.SetReference(*s)
+	}
+	return dsuo
+}
+
+// SetDueAt sets the "due_at" field.
+func (dsuo *DunningStepUpdateOne) SetDueAt(t time.Time) *DunningStepUpdateOne {
+	dsuo.mutation.SetDueAt(t)
+	return dsuo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableDueAt(t *time.Time) *DunningStepUpdateOne {
+	if t != nil {
+		dsuo.SetDueAt(*t)
+	}
+	return dsuo
+}
+
+// SetOffsetDays sets the "offset_days" field.
+func (dsuo *DunningStepUpdateOne) SetOffsetDays(i int) *DunningStepUpdateOne {
+	dsuo.mutation.ResetOffsetDays()
+	dsuo.mutation.SetOffsetDays(i)
+	return dsuo
+}
+
+// SetNillableOffsetDays sets the "offset_days" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableOffsetDays(i *int) *DunningStepUpdateOne {
+	if i != nil {
+		dsuo.SetOffsetDays(*i)
+	}
+	return dsuo
+}
+
+// AddOffsetDays adds i to the "offset_days" field.
+func (dsuo *DunningStepUpdateOne) AddOffsetDays(i int) *DunningStepUpdateOne {
+	dsuo.mutation.AddOffsetDays(i)
+	return dsuo
+}
+
+// SetLevel sets the "level" field.
+func (dsuo *DunningStepUpdateOne) SetLevel(d dunningstep.Level) *DunningStepUpdateOne {
+	dsuo.mutation.SetLevel(d)
+	return dsuo
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableLevel(d *dunningstep.Level) *DunningStepUpdateOne {
+	if d != nil {
+		dsuo.SetLevel(*d)
+	}
+	return dsuo
+}
+
+// SetAmount sets the "amount" field.
+func (dsuo *DunningStepUpdateOne) SetAmount(f float64) *DunningStepUpdateOne {
+	dsuo.mutation.ResetAmount()
+	dsuo.mutation.SetAmount(f)
+	return dsuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableAmount(f *float64) *DunningStepUpdateOne {
+	if f != nil {
+		dsuo.SetAmount(*f)
+	}
+	return dsuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (dsuo *DunningStepUpdateOne) AddAmount(f float64) *DunningStepUpdateOne {
+	dsuo.mutation.AddAmount(f)
+	return dsuo
+}
+
+// SetSubject sets the "subject" field.
+func (dsuo *DunningStepUpdateOne) SetSubject(s string) *DunningStepUpdateOne {
+	dsuo.mutation.SetSubject(s)
+	return dsuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableSubject(s *string) *DunningStepUpdateOne {
+	if s != nil {
+		dsuo.SetSubject(*s)
+	}
+	return dsuo
+}
+
+// SetMessage sets the "message" field.
+func (dsuo *DunningStepUpdateOne) SetMessage(s string) *DunningStepUpdateOne {
+	dsuo.mutation.SetMessage(s)
+	return dsuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableMessage(s *string) *DunningStepUpdateOne {
+	if s != nil {
+		dsuo.SetMessage(*s)
+	}
+	return dsuo
+}
+
+// SetStatus sets the "status" field.
+func (dsuo *DunningStepUpdateOne) SetStatus(d dunningstep.Status) *DunningStepUpdateOne {
+	dsuo.mutation.SetStatus(d)
+	return dsuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableStatus(d *dunningstep.Status) *DunningStepUpdateOne {
+	if d != nil {
+		dsuo.SetStatus(*d)
+	}
+	return dsuo
+}
+
+// SetChannels sets the "channels" field.
+func (dsuo *DunningStepUpdateOne) SetChannels(s string) *DunningStepUpdateOne {
+	dsuo.mutation.SetChannels(s)
+	return dsuo
+}
+
+// SetNillableChannels sets the "channels" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableChannels(s *string) *DunningStepUpdateOne {
+	if s != nil {
+		dsuo.SetChannels(*s)
+	}
+	return dsuo
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (dsuo *DunningStepUpdateOne) ClearChannels() *DunningStepUpdateOne {
+	dsuo.mutation.ClearChannels()
+	return dsuo
+}
+
+// SetError sets the "error" field.
+func (dsuo *DunningStepUpdateOne) SetError(s string) *DunningStepUpdateOne {
+	dsuo.mutation.SetError(s)
+	return dsuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableError(s *string) *DunningStepUpdateOne {
+	if s != nil {
+		dsuo.SetError(*s)
+	}
+	return dsuo
+}
+
+// ClearError clears the value of the "error" field.
+func (dsuo *DunningStepUpdateOne) ClearError() *DunningStepUpdateOne {
+	dsuo.mutation.ClearError()
+	return dsuo
+}
+
+// SetSentAt sets the "sent_at" field.
+func (dsuo *DunningStepUpdateOne) SetSentAt(t time.Time) *DunningStepUpdateOne {
+	dsuo.mutation.SetSentAt(t)
+	return dsuo
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (dsuo *DunningStepUpdateOne) SetNillableSentAt(t *time.Time) *DunningStepUpdateOne {
+	if t != nil {
+		dsuo.SetSentAt(*t)
+	}
+	return dsuo
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (dsuo *DunningStepUpdateOne) ClearSentAt() *DunningStepUpdateOne {
+	dsuo.mutation.ClearSentAt()
+	return dsuo
+}
+
+// Mutation returns the DunningStepMutation object of the builder.
+func (dsuo *DunningStepUpdateOne) Mutation() *DunningStepMutation {
+	return dsuo.mutation
+}
+
+// Where appends a list predicates to the DunningStepUpdate builder.
+func (dsuo *DunningStepUpdateOne) Where(ps ...predicate.DunningStep) *DunningStepUpdateOne {
+	dsuo.mutation.Where(ps...)
+	return dsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dsuo *DunningStepUpdateOne) Select(field string, fields ...string) *DunningStepUpdateOne {
+	dsuo.fields = append([]string{field}, fields...)
+	return dsuo
+}
+
+// Save executes the query and returns the updated DunningStep entity.
+func (dsuo *DunningStepUpdateOne) Save(ctx context.Context) (*DunningStep, error) {
+	return withHooks(ctx, dsuo.sqlSave, dsuo.mutation, dsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dsuo *DunningStepUpdateOne) SaveX(ctx context.Context) *DunningStep {
+	node, err := dsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dsuo *DunningStepUpdateOne) Exec(ctx context.Context) error {
+	_, err := dsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dsuo *DunningStepUpdateOne) ExecX(ctx context.Context) {
+	if err := dsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dsuo *DunningStepUpdateOne) check() error {
+	if v, ok := dsuo.mutation.ClientID(); ok {
+		if err := dunningstep.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "DunningStep.client_id": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.ClientUsername(); ok {
+		if err := dunningstep.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "DunningStep.client_username": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.Kind(); ok {
+		if err := dunningstep.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DunningStep.kind": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.Reference(); ok {
+		if err := dunningstep.ReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "reference", err: fmt.Errorf(`ent: validator failed for field "DunningStep.reference": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.Level(); ok {
+		if err := dunningstep.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "DunningStep.level": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.Subject(); ok {
+		if err := dunningstep.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "DunningStep.subject": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.Status(); ok {
+		if err := dunningstep.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DunningStep.status": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.Channels(); ok {
+		if err := dunningstep.ChannelsValidator(v); err != nil {
+			return &ValidationError{Name: "channels", err: fmt.Errorf(`ent: validator failed for field "DunningStep.channels": %w`, err)}
+		}
+	}
+	if v, ok := dsuo.mutation.Error(); ok {
+		if err := dunningstep.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`ent: validator failed for field "DunningStep.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dsuo *DunningStepUpdateOne) sqlSave(ctx context.Context) (_node *DunningStep, err error) {
+	if err := dsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dunningstep.Table, dunningstep.Columns, sqlgraph.NewFieldSpec(dunningstep.FieldID, field.TypeInt))
+	id, ok := dsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DunningStep.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dunningstep.FieldID)
+		for _, f := range fields {
+			if !dunningstep.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dunningstep.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dsuo.mutation.ClientID(); ok {
+		_spec.SetField(dunningstep.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.AddedClientID(); ok {
+		_spec.AddField(dunningstep.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.ClientUsername(); ok {
+		_spec.SetField(dunningstep.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.Kind(); ok {
+		_spec.SetField(dunningstep.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := dsuo.mutation.Reference(); ok {
+		_spec.SetField(dunningstep.FieldReference, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.DueAt(); ok {
+		_spec.SetField(dunningstep.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := dsuo.mutation.OffsetDays(); ok {
+		_spec.SetField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.AddedOffsetDays(); ok {
+		_spec.AddField(dunningstep.FieldOffsetDays, field.TypeInt, value)
+	}
+	if value, ok := dsuo.mutation.Level(); ok {
+		_spec.SetField(dunningstep.FieldLevel, field.TypeEnum, value)
+	}
+	if value, ok := dsuo.mutation.Amount(); ok {
+		_spec.SetField(dunningstep.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsuo.mutation.AddedAmount(); ok {
+		_spec.AddField(dunningstep.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := dsuo.mutation.Subject(); ok {
+		_spec.SetField(dunningstep.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.Message(); ok {
+		_spec.SetField(dunningstep.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := dsuo.mutation.Status(); ok {
+		_spec.SetField(dunningstep.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dsuo.mutation.Channels(); ok {
+		_spec.SetField(dunningstep.FieldChannels, field.TypeString, value)
+	}
+	if dsuo.mutation.ChannelsCleared() {
+		_spec.ClearField(dunningstep.FieldChannels, field.TypeString)
+	}
+	if value, ok := dsuo.mutation.Error(); ok {
+		_spec.SetField(dunningstep.FieldError, field.TypeString, value)
+	}
+	if dsuo.mutation.ErrorCleared() {
+		_spec.ClearField(dunningstep.FieldError, field.TypeString)
+	}
+	if value, ok := dsuo.mutation.SentAt(); ok {
+		_spec.SetField(dunningstep.FieldSentAt, field.TypeTime, value)
+	}
+	if dsuo.mutation.SentAtCleared() {
+		_spec.ClearField(dunningstep.FieldSentAt, field.TypeTime)
+	}
+	_node = &DunningStep{config: dsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dunningstep.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dsuo.mutation.done = true
+	return _node, nil
+}
@@ -12,29 +12,55 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+	"github.com/mikestefanello/pagoda/ent/clientaddon"
 	"github.com/mikestefanello/pagoda/ent/clienttxn"
 	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/coupon"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
 	"github.com/mikestefanello/pagoda/ent/emailsubscription"
 	"github.com/mikestefanello/pagoda/ent/emailsubscriptiontype"
 	"github.com/mikestefanello/pagoda/ent/emojis"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
 	"github.com/mikestefanello/pagoda/ent/filestorage"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/imagesize"
 	"github.com/mikestefanello/pagoda/ent/invitation"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
 	"github.com/mikestefanello/pagoda/ent/lastseenonline"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
 	"github.com/mikestefanello/pagoda/ent/packageplan"
+	"github.com/mikestefanello/pagoda/ent/payrequest"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/profile"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
 	"github.com/mikestefanello/pagoda/ent/radacct"
+	"github.com/mikestefanello/pagoda/ent/radcheck"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+	"github.com/mikestefanello/pagoda/ent/radreply"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
 	"github.com/mikestefanello/pagoda/ent/ticket"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/vendor"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -95,29 +121,55 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			balancetransfer.Table:        balancetransfer.ValidColumn,
+			clientaddon.Table:            clientaddon.ValidColumn,
 			clienttxn.Table:              clienttxn.ValidColumn,
 			clientuser.Table:             clientuser.ValidColumn,
+			coupon.Table:                 coupon.ValidColumn,
+			couponredemption.Table:       couponredemption.ValidColumn,
+			dunningstep.Table:            dunningstep.ValidColumn,
 			emailsubscription.Table:      emailsubscription.ValidColumn,
 			emailsubscriptiontype.Table:  emailsubscriptiontype.ValidColumn,
 			emojis.Table:                 emojis.ValidColumn,
 			fcmsubscriptions.Table:       fcmsubscriptions.ValidColumn,
 			filestorage.Table:            filestorage.ValidColumn,
+			graceperiod.Table:            graceperiod.ValidColumn,
 			image.Table:                  image.ValidColumn,
 			imagesize.Table:              imagesize.ValidColumn,
 			invitation.Table:             invitation.ValidColumn,
+			invoice.Table:                invoice.ValidColumn,
+			invoiceline.Table:            invoiceline.ValidColumn,
 			lastseenonline.Table:         lastseenonline.ValidColumn,
+			manualpayment.Table:          manualpayment.ValidColumn,
 			monthlysubscription.Table:    monthlysubscription.ValidColumn,
 			notification.Table:           notification.ValidColumn,
 			notificationpermission.Table: notificationpermission.ValidColumn,
 			notificationtime.Table:       notificationtime.ValidColumn,
 			packageplan.Table:            packageplan.ValidColumn,
+			payrequest.Table:             payrequest.ValidColumn,
 			phoneverificationcode.Table:  phoneverificationcode.ValidColumn,
 			profile.Table:                profile.ValidColumn,
 			pwapushsubscription.Table:    pwapushsubscription.ValidColumn,
 			radacct.Table:                radacct.ValidColumn,
+			radcheck.Table:               radcheck.ValidColumn,
+			radgroupreply.Table:          radgroupreply.ValidColumn,
+			radreply.Table:               radreply.ValidColumn,
+			radusergroup.Table:           radusergroup.ValidColumn,
+			refundrequest.Table:          refundrequest.ValidColumn,
 			sentemail.Table:              sentemail.ValidColumn,
+			settlementrow.Table:          settlementrow.ValidColumn,
+			statemententry.Table:         statemententry.ValidColumn,
 			ticket.Table:                 ticket.ValidColumn,
+			usagecursor.Table:            usagecursor.ValidColumn,
+			usagedaily.Table:             usagedaily.ValidColumn,
+			usagehourly.Table:            usagehourly.ValidColumn,
 			user.Table:                   user.ValidColumn,
+			vendor.Table:                 vendor.ValidColumn,
+			vendorcommission.Table:       vendorcommission.ValidColumn,
+			vendortxn.Table:              vendortxn.ValidColumn,
+			voucher.Table:                voucher.ValidColumn,
+			voucherattempt.Table:         voucherattempt.ValidColumn,
+			voucherbatch.Table:           voucherbatch.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
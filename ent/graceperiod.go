@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+)
+
+// GracePeriod is the model entity for the GracePeriod schema.
+type GracePeriod struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID int `json:"client_id,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// Expiry of the last paid cycle
+	ExpiredAt time.Time `json:"expired_at,omitempty"`
+	// When the client is suspended unless they pay
+	GraceUntil time.Time `json:"grace_until,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage graceperiod.Stage `json:"stage,omitempty"`
+	// RADIUS group of the client's package, restored when they pay
+	Profile string `json:"profile,omitempty"`
+	// When the client paid and was reactivated
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GracePeriod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case graceperiod.FieldID, graceperiod.FieldClientID:
+			values[i] = new(sql.NullInt64)
+		case graceperiod.FieldClientUsername, graceperiod.FieldStage, graceperiod.FieldProfile:
+			values[i] = new(sql.NullString)
+		case graceperiod.FieldExpiredAt, graceperiod.FieldGraceUntil, graceperiod.FieldEndedAt, graceperiod.FieldCreatedAt, graceperiod.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GracePeriod fields.
+func (gp *GracePeriod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case graceperiod.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gp.ID = int(value.Int64)
+		case graceperiod.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				gp.ClientID = int(value.Int64)
+			}
+		case graceperiod.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				gp.ClientUsername = value.String
+			}
+		case graceperiod.FieldExpiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
+			} else if value.Valid {
+				gp.ExpiredAt = value.Time
+			}
+		case graceperiod.FieldGraceUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field grace_until", values[i])
+			} else if value.Valid {
+				gp.GraceUntil = value.Time
+			}
+		case graceperiod.FieldStage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				gp.Stage = graceperiod.Stage(value.String)
+			}
+		case graceperiod.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				gp.Profile = value.String
+			}
+		case graceperiod.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				gp.EndedAt = new(time.Time)
+				*gp.EndedAt = value.Time
+			}
+		case graceperiod.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				gp.CreatedAt = value.Time
+			}
+		case graceperiod.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				gp.UpdatedAt = value.Time
+			}
+		default:
+			gp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GracePeriod.
+// This includes values selected through modifiers, order, etc.
+func (gp *GracePeriod) Value(name string) (ent.Value, error) {
+	return gp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GracePeriod.
+// Note that you need to call GracePeriod.Unwrap() before calling this method if this GracePeriod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gp *GracePeriod) Update() *GracePeriodUpdateOne {
+	return NewGracePeriodClient(gp.config).UpdateOne(gp)
+}
+
+// Unwrap unwraps the GracePeriod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gp *GracePeriod) Unwrap() *GracePeriod {
+	_tx, ok := gp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GracePeriod is not a transactional entity")
+	}
+	gp.config.driver = _tx.drv
+	return gp
+}
+
+// String implements the fmt.Stringer.
+func (gp *GracePeriod) String() string {
+	var builder strings.Builder
+	builder.WriteString("GracePeriod(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gp.ID))
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", gp.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(gp.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("expired_at=")
+	builder.WriteString(gp.ExpiredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("grace_until=")
+	builder.WriteString(gp.GraceUntil.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(fmt.Sprintf("%v", gp.Stage))
+	builder.WriteString(", ")
+	builder.WriteString("profile=")
+	builder.WriteString(gp.Profile)
+	builder.WriteString(", ")
+	if v := gp.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(gp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(gp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GracePeriods is a parsable slice of GracePeriod.
+type GracePeriods []*GracePeriod
@@ -0,0 +1,510 @@
+// Code generated by ent, DO NOT EDIT.
+
+package graceperiod
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldID, id))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientUsername applies equality check predicate on the "client_username" field. It's identical to ClientUsernameEQ.
+func ClientUsername(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ExpiredAt applies equality check predicate on the "expired_at" field. It's identical to ExpiredAtEQ.
+func ExpiredAt(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// GraceUntil applies equality check predicate on the "grace_until" field. It's identical to GraceUntilEQ.
+func GraceUntil(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldGraceUntil, v))
+}
+
+// Profile applies equality check predicate on the "profile" field. It's identical to ProfileEQ.
+func Profile(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldProfile, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v int) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientUsernameEQ applies the EQ predicate on the "client_username" field.
+func ClientUsernameEQ(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameNEQ applies the NEQ predicate on the "client_username" field.
+func ClientUsernameNEQ(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameIn applies the In predicate on the "client_username" field.
+func ClientUsernameIn(vs ...string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameNotIn applies the NotIn predicate on the "client_username" field.
+func ClientUsernameNotIn(vs ...string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameGT applies the GT predicate on the "client_username" field.
+func ClientUsernameGT(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldClientUsername, v))
+}
+
+// ClientUsernameGTE applies the GTE predicate on the "client_username" field.
+func ClientUsernameGTE(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldClientUsername, v))
+}
+
+// ClientUsernameLT applies the LT predicate on the "client_username" field.
+func ClientUsernameLT(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldClientUsername, v))
+}
+
+// ClientUsernameLTE applies the LTE predicate on the "client_username" field.
+func ClientUsernameLTE(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldClientUsername, v))
+}
+
+// ClientUsernameContains applies the Contains predicate on the "client_username" field.
+func ClientUsernameContains(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldContains(FieldClientUsername, v))
+}
+
+// ClientUsernameHasPrefix applies the HasPrefix predicate on the "client_username" field.
+func ClientUsernameHasPrefix(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldHasPrefix(FieldClientUsername, v))
+}
+
+// ClientUsernameHasSuffix applies the HasSuffix predicate on the "client_username" field.
+func ClientUsernameHasSuffix(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldHasSuffix(FieldClientUsername, v))
+}
+
+// ClientUsernameEqualFold applies the EqualFold predicate on the "client_username" field.
+func ClientUsernameEqualFold(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEqualFold(FieldClientUsername, v))
+}
+
+// ClientUsernameContainsFold applies the ContainsFold predicate on the "client_username" field.
+func ClientUsernameContainsFold(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldContainsFold(FieldClientUsername, v))
+}
+
+// ExpiredAtEQ applies the EQ predicate on the "expired_at" field.
+func ExpiredAtEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtNEQ applies the NEQ predicate on the "expired_at" field.
+func ExpiredAtNEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldExpiredAt, v))
+}
+
+// ExpiredAtIn applies the In predicate on the "expired_at" field.
+func ExpiredAtIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtNotIn applies the NotIn predicate on the "expired_at" field.
+func ExpiredAtNotIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldExpiredAt, vs...))
+}
+
+// ExpiredAtGT applies the GT predicate on the "expired_at" field.
+func ExpiredAtGT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldExpiredAt, v))
+}
+
+// ExpiredAtGTE applies the GTE predicate on the "expired_at" field.
+func ExpiredAtGTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldExpiredAt, v))
+}
+
+// ExpiredAtLT applies the LT predicate on the "expired_at" field.
+func ExpiredAtLT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldExpiredAt, v))
+}
+
+// ExpiredAtLTE applies the LTE predicate on the "expired_at" field.
+func ExpiredAtLTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldExpiredAt, v))
+}
+
+// GraceUntilEQ applies the EQ predicate on the "grace_until" field.
+func GraceUntilEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldGraceUntil, v))
+}
+
+// GraceUntilNEQ applies the NEQ predicate on the "grace_until" field.
+func GraceUntilNEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldGraceUntil, v))
+}
+
+// GraceUntilIn applies the In predicate on the "grace_until" field.
+func GraceUntilIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldGraceUntil, vs...))
+}
+
+// GraceUntilNotIn applies the NotIn predicate on the "grace_until" field.
+func GraceUntilNotIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldGraceUntil, vs...))
+}
+
+// GraceUntilGT applies the GT predicate on the "grace_until" field.
+func GraceUntilGT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldGraceUntil, v))
+}
+
+// GraceUntilGTE applies the GTE predicate on the "grace_until" field.
+func GraceUntilGTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldGraceUntil, v))
+}
+
+// GraceUntilLT applies the LT predicate on the "grace_until" field.
+func GraceUntilLT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldGraceUntil, v))
+}
+
+// GraceUntilLTE applies the LTE predicate on the "grace_until" field.
+func GraceUntilLTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldGraceUntil, v))
+}
+
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v Stage) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldStage, v))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v Stage) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldStage, v))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...Stage) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldStage, vs...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...Stage) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldStage, vs...))
+}
+
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldProfile, v))
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldProfile, v))
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldProfile, vs...))
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldProfile, vs...))
+}
+
+// ProfileGT applies the GT predicate on the "profile" field.
+func ProfileGT(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldProfile, v))
+}
+
+// ProfileGTE applies the GTE predicate on the "profile" field.
+func ProfileGTE(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldProfile, v))
+}
+
+// ProfileLT applies the LT predicate on the "profile" field.
+func ProfileLT(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldProfile, v))
+}
+
+// ProfileLTE applies the LTE predicate on the "profile" field.
+func ProfileLTE(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldProfile, v))
+}
+
+// ProfileContains applies the Contains predicate on the "profile" field.
+func ProfileContains(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldContains(FieldProfile, v))
+}
+
+// ProfileHasPrefix applies the HasPrefix predicate on the "profile" field.
+func ProfileHasPrefix(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldHasPrefix(FieldProfile, v))
+}
+
+// ProfileHasSuffix applies the HasSuffix predicate on the "profile" field.
+func ProfileHasSuffix(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldHasSuffix(FieldProfile, v))
+}
+
+// ProfileEqualFold applies the EqualFold predicate on the "profile" field.
+func ProfileEqualFold(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEqualFold(FieldProfile, v))
+}
+
+// ProfileContainsFold applies the ContainsFold predicate on the "profile" field.
+func ProfileContainsFold(v string) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldContainsFold(FieldProfile, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotNull(FieldEndedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GracePeriod) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GracePeriod) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GracePeriod) predicate.GracePeriod {
+	return predicate.GracePeriod(sql.NotPredicates(p))
+}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+)
+
+// GracePeriodCreate is the builder for creating a GracePeriod entity.
+type GracePeriodCreate struct {
+	config
+	mutation *GracePeriodMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (gpc *GracePeriodCreate) SetClientID(i int) *GracePeriodCreate {
+	gpc.mutation.SetClientID(i)
+	return gpc
+}
+
+// SetClientUsername sets the "client_username" field.
+func (gpc *GracePeriodCreate) SetClientUsername(s string) *GracePeriodCreate {
+	gpc.mutation.SetClientUsername(s)
+	return gpc
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (gpc *GracePeriodCreate) SetExpiredAt(t time.Time) *GracePeriodCreate {
+	gpc.mutation.SetExpiredAt(t)
+	return gpc
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (gpc *GracePeriodCreate) SetGraceUntil(t time.Time) *GracePeriodCreate {
+	gpc.mutation.SetGraceUntil(t)
+	return gpc
+}
+
+// SetStage sets the "stage" field.
+func (gpc *GracePeriodCreate) SetStage(gr graceperiod.Stage) *GracePeriodCreate {
+	gpc.mutation.SetStage(gr)
+	return gpc
+}
+
+// SetProfile sets the "profile" field.
+func (gpc *GracePeriodCreate) SetProfile(s string) *GracePeriodCreate {
+	gpc.mutation.SetProfile(s)
+	return gpc
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (gpc *GracePeriodCreate) SetEndedAt(t time.Time) *GracePeriodCreate {
+	gpc.mutation.SetEndedAt(t)
+	return gpc
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (gpc *GracePeriodCreate) SetNillableEndedAt(t *time.Time) *GracePeriodCreate {
+	if t != nil {
+		gpc.SetEndedAt(*t)
+	}
+	return gpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (gpc *GracePeriodCreate) SetCreatedAt(t time.Time) *GracePeriodCreate {
+	gpc.mutation.SetCreatedAt(t)
+	return gpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (gpc *GracePeriodCreate) SetNillableCreatedAt(t *time.Time) *GracePeriodCreate {
+	if t != nil {
+		gpc.SetCreatedAt(*t)
+	}
+	return gpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gpc *GracePeriodCreate) SetUpdatedAt(t time.Time) *GracePeriodCreate {
+	gpc.mutation.SetUpdatedAt(t)
+	return gpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (gpc *GracePeriodCreate) SetNillableUpdatedAt(t *time.Time) *GracePeriodCreate {
+	if t != nil {
+		gpc.SetUpdatedAt(*t)
+	}
+	return gpc
+}
+
+// Mutation returns the GracePeriodMutation object of the builder.
+func (gpc *GracePeriodCreate) Mutation() *GracePeriodMutation {
+	return gpc.mutation
+}
+
+// Save creates the GracePeriod in the database.
+func (gpc *GracePeriodCreate) Save(ctx context.Context) (*GracePeriod, error) {
+	gpc.defaults()
+	return withHooks(ctx, gpc.sqlSave, gpc.mutation, gpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gpc *GracePeriodCreate) SaveX(ctx context.Context) *GracePeriod {
+	v, err := gpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gpc *GracePeriodCreate) Exec(ctx context.Context) error {
+	_, err := gpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpc *GracePeriodCreate) ExecX(ctx context.Context) {
+	if err := gpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gpc *GracePeriodCreate) defaults() {
+	if _, ok := gpc.mutation.CreatedAt(); !ok {
+		v := graceperiod.DefaultCreatedAt()
+		gpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := gpc.mutation.UpdatedAt(); !ok {
+		v := graceperiod.DefaultUpdatedAt()
+		gpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gpc *GracePeriodCreate) check() error {
+	if _, ok := gpc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "GracePeriod.client_id"`)}
+	}
+	if v, ok := gpc.mutation.ClientID(); ok {
+		if err := graceperiod.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.client_id": %w`, err)}
+		}
+	}
+	if _, ok := gpc.mutation.ClientUsername(); !ok {
+		return &ValidationError{Name: "client_username", err: errors.New(`ent: missing required field "GracePeriod.client_username"`)}
+	}
+	if v, ok := gpc.mutation.ClientUsername(); ok {
+		if err := graceperiod.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.client_username": %w`, err)}
+		}
+	}
+	if _, ok := gpc.mutation.ExpiredAt(); !ok {
+		return &ValidationError{Name: "expired_at", err: errors.New(`ent: missing required field "GracePeriod.expired_at"`)}
+	}
+	if _, ok := gpc.mutation.GraceUntil(); !ok {
+		return &ValidationError{Name: "grace_until", err: errors.New(`ent: missing required field "GracePeriod.grace_until"`)}
+	}
+	if _, ok := gpc.mutation.Stage(); !ok {
+		return &ValidationError{Name: "stage", err: errors.New(`ent: missing required field "GracePeriod.stage"`)}
+	}
+	if v, ok := gpc.mutation.Stage(); ok {
+		if err := graceperiod.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.stage": %w`, err)}
+		}
+	}
+	if _, ok := gpc.mutation.Profile(); !ok {
+		return &ValidationError{Name: "profile", err: errors.New(`ent: missing required field "GracePeriod.profile"`)}
+	}
+	if v, ok := gpc.mutation.Profile(); ok {
+		if err := graceperiod.ProfileValidator(v); err != nil {
+			return &ValidationError{Name: "profile", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.profile": %w`, err)}
+		}
+	}
+	if _, ok := gpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GracePeriod.created_at"`)}
+	}
+	if _, ok := gpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GracePeriod.updated_at"`)}
+	}
+	return nil
+}
+
+func (gpc *GracePeriodCreate) sqlSave(ctx context.Context) (*GracePeriod, error) {
+	if err := gpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gpc.mutation.id = &_node.ID
+	gpc.mutation.done = true
+	return _node, nil
+}
+
+func (gpc *GracePeriodCreate) createSpec() (*GracePeriod, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GracePeriod{config: gpc.config}
+		_spec = sqlgraph.NewCreateSpec(graceperiod.Table, sqlgraph.NewFieldSpec(graceperiod.FieldID, field.TypeInt))
+	)
+	if value, ok := gpc.mutation.ClientID(); ok {
+		_spec.SetField(graceperiod.FieldClientID, field.TypeInt, value)
+		_node.ClientID = value
+	}
+	if value, ok := gpc.mutation.ClientUsername(); ok {
+		_spec.SetField(graceperiod.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := gpc.mutation.ExpiredAt(); ok {
+		_spec.SetField(graceperiod.FieldExpiredAt, field.TypeTime, value)
+		_node.ExpiredAt = value
+	}
+	if value, ok := gpc.mutation.GraceUntil(); ok {
+		_spec.SetField(graceperiod.FieldGraceUntil, field.TypeTime, value)
+		_node.GraceUntil = value
+	}
+	if value, ok := gpc.mutation.Stage(); ok {
+		_spec.SetField(graceperiod.FieldStage, field.TypeEnum, value)
+		_node.Stage = value
+	}
+	if value, ok := gpc.mutation.Profile(); ok {
+		_spec.SetField(graceperiod.FieldProfile, field.TypeString, value)
+		_node.Profile = value
+	}
+	if value, ok := gpc.mutation.EndedAt(); ok {
+		_spec.SetField(graceperiod.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := gpc.mutation.CreatedAt(); ok {
+		_spec.SetField(graceperiod.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := gpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(graceperiod.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// GracePeriodCreateBulk is the builder for creating many GracePeriod entities in bulk.
+type GracePeriodCreateBulk struct {
+	config
+	err      error
+	builders []*GracePeriodCreate
+}
+
+// Save creates the GracePeriod entities in the database.
+func (gpcb *GracePeriodCreateBulk) Save(ctx context.Context) ([]*GracePeriod, error) {
+	if gpcb.err != nil {
+		return nil, gpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gpcb.builders))
+	nodes := make([]*GracePeriod, len(gpcb.builders))
+	mutators := make([]Mutator, len(gpcb.builders))
+	for i := range gpcb.builders {
+		func(i int, root context.Context) {
+			builder := gpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GracePeriodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpcb *GracePeriodCreateBulk) SaveX(ctx context.Context) []*GracePeriod {
+	v, err := gpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gpcb *GracePeriodCreateBulk) Exec(ctx context.Context) error {
+	_, err := gpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpcb *GracePeriodCreateBulk) ExecX(ctx context.Context) {
+	if err := gpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
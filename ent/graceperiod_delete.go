@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// GracePeriodDelete is the builder for deleting a GracePeriod entity.
+type GracePeriodDelete struct {
+	config
+	hooks    []Hook
+	mutation *GracePeriodMutation
+}
+
+// Where appends a list predicates to the GracePeriodDelete builder.
+func (gpd *GracePeriodDelete) Where(ps ...predicate.GracePeriod) *GracePeriodDelete {
+	gpd.mutation.Where(ps...)
+	return gpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gpd *GracePeriodDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gpd.sqlExec, gpd.mutation, gpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpd *GracePeriodDelete) ExecX(ctx context.Context) int {
+	n, err := gpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gpd *GracePeriodDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(graceperiod.Table, sqlgraph.NewFieldSpec(graceperiod.FieldID, field.TypeInt))
+	if ps := gpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gpd.mutation.done = true
+	return affected, err
+}
+
+// GracePeriodDeleteOne is the builder for deleting a single GracePeriod entity.
+type GracePeriodDeleteOne struct {
+	gpd *GracePeriodDelete
+}
+
+// Where appends a list predicates to the GracePeriodDelete builder.
+func (gpdo *GracePeriodDeleteOne) Where(ps ...predicate.GracePeriod) *GracePeriodDeleteOne {
+	gpdo.gpd.mutation.Where(ps...)
+	return gpdo
+}
+
+// Exec executes the deletion query.
+func (gpdo *GracePeriodDeleteOne) Exec(ctx context.Context) error {
+	n, err := gpdo.gpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{graceperiod.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpdo *GracePeriodDeleteOne) ExecX(ctx context.Context) {
+	if err := gpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
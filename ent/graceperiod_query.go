@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// GracePeriodQuery is the builder for querying GracePeriod entities.
+type GracePeriodQuery struct {
+	config
+	ctx        *QueryContext
+	order      []graceperiod.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GracePeriod
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GracePeriodQuery builder.
+func (gpq *GracePeriodQuery) Where(ps ...predicate.GracePeriod) *GracePeriodQuery {
+	gpq.predicates = append(gpq.predicates, ps...)
+	return gpq
+}
+
+// Limit the number of records to be returned by this query.
+func (gpq *GracePeriodQuery) Limit(limit int) *GracePeriodQuery {
+	gpq.ctx.Limit = &limit
+	return gpq
+}
+
+// Offset to start from.
+func (gpq *GracePeriodQuery) Offset(offset int) *GracePeriodQuery {
+	gpq.ctx.Offset = &offset
+	return gpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gpq *GracePeriodQuery) Unique(unique bool) *GracePeriodQuery {
+	gpq.ctx.Unique = &unique
+	return gpq
+}
+
+// Order specifies how the records should be ordered.
+func (gpq *GracePeriodQuery) Order(o ...graceperiod.OrderOption) *GracePeriodQuery {
+	gpq.order = append(gpq.order, o...)
+	return gpq
+}
+
+// First returns the first GracePeriod entity from the query.
+// Returns a *NotFoundError when no GracePeriod was found.
+func (gpq *GracePeriodQuery) First(ctx context.Context) (*GracePeriod, error) {
+	nodes, err := gpq.Limit(1).All(setContextOp(ctx, gpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{graceperiod.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gpq *GracePeriodQuery) FirstX(ctx context.Context) *GracePeriod {
+	node, err := gpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GracePeriod ID from the query.
+// Returns a *NotFoundError when no GracePeriod ID was found.
+func (gpq *GracePeriodQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gpq.Limit(1).IDs(setContextOp(ctx, gpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{graceperiod.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gpq *GracePeriodQuery) FirstIDX(ctx context.Context) int {
+	id, err := gpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GracePeriod entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GracePeriod entity is found.
+// Returns a *NotFoundError when no GracePeriod entities are found.
+func (gpq *GracePeriodQuery) Only(ctx context.Context) (*GracePeriod, error) {
+	nodes, err := gpq.Limit(2).All(setContextOp(ctx, gpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{graceperiod.Label}
+	default:
+		return nil, &NotSingularError{graceperiod.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gpq *GracePeriodQuery) OnlyX(ctx context.Context) *GracePeriod {
+	node, err := gpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GracePeriod ID in the query.
+// Returns a *NotSingularError when more than one GracePeriod ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gpq *GracePeriodQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gpq.Limit(2).IDs(setContextOp(ctx, gpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{graceperiod.Label}
+	default:
+		err = &NotSingularError{graceperiod.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gpq *GracePeriodQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GracePeriods.
+func (gpq *GracePeriodQuery) All(ctx context.Context) ([]*GracePeriod, error) {
+	ctx = setContextOp(ctx, gpq.ctx, ent.OpQueryAll)
+	if err := gpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GracePeriod, *GracePeriodQuery]()
+	return withInterceptors[[]*GracePeriod](ctx, gpq, qr, gpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gpq *GracePeriodQuery) AllX(ctx context.Context) []*GracePeriod {
+	nodes, err := gpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GracePeriod IDs.
+func (gpq *GracePeriodQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gpq.ctx.Unique == nil && gpq.path != nil {
+		gpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gpq.ctx, ent.OpQueryIDs)
+	if err = gpq.Select(graceperiod.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gpq *GracePeriodQuery) IDsX(ctx context.Context) []int {
+	ids, err := gpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gpq *GracePeriodQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gpq.ctx, ent.OpQueryCount)
+	if err := gpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gpq, querierCount[*GracePeriodQuery](), gpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gpq *GracePeriodQuery) CountX(ctx context.Context) int {
+	count, err := gpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gpq *GracePeriodQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gpq.ctx, ent.OpQueryExist)
+	switch _, err := gpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gpq *GracePeriodQuery) ExistX(ctx context.Context) bool {
+	exist, err := gpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GracePeriodQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gpq *GracePeriodQuery) Clone() *GracePeriodQuery {
+	if gpq == nil {
+		return nil
+	}
+	return &GracePeriodQuery{
+		config:     gpq.config,
+		ctx:        gpq.ctx.Clone(),
+		order:      append([]graceperiod.OrderOption{}, gpq.order...),
+		inters:     append([]Interceptor{}, gpq.inters...),
+		predicates: append([]predicate.GracePeriod{}, gpq.predicates...),
+		// clone intermediate query.
+		sql:  gpq.sql.Clone(),
+		path: gpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID int `json:"client_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GracePeriod.Query().
+//		GroupBy(graceperiod.FieldClientID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gpq *GracePeriodQuery) GroupBy(field string, fields ...string) *GracePeriodGroupBy {
+	gpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GracePeriodGroupBy{build: gpq}
+	grbuild.flds = &gpq.ctx.Fields
+	grbuild.label = graceperiod.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientID int `json:"client_id,omitempty"`
+//	}
+//
+//	client.GracePeriod.Query().
+//		Select(graceperiod.FieldClientID).
+//		Scan(ctx, &v)
+func (gpq *GracePeriodQuery) Select(fields ...string) *GracePeriodSelect {
+	gpq.ctx.Fields = append(gpq.ctx.Fields, fields...)
+	sbuild := &GracePeriodSelect{GracePeriodQuery: gpq}
+	sbuild.label = graceperiod.Label
+	sbuild.flds, sbuild.scan = &gpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GracePeriodSelect configured with the given aggregations.
+func (gpq *GracePeriodQuery) Aggregate(fns ...AggregateFunc) *GracePeriodSelect {
+	return gpq.Select().Aggregate(fns...)
+}
+
+func (gpq *GracePeriodQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gpq.ctx.Fields {
+		if !graceperiod.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gpq.path != nil {
+		prev, err := gpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gpq.sql = prev
+	}
+	return nil
+}
+
+func (gpq *GracePeriodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GracePeriod, error) {
+	var (
+		nodes = []*GracePeriod{}
+		_spec = gpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GracePeriod).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GracePeriod{config: gpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (gpq *GracePeriodQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gpq.querySpec()
+	_spec.Node.Columns = gpq.ctx.Fields
+	if len(gpq.ctx.Fields) > 0 {
+		_spec.Unique = gpq.ctx.Unique != nil && *gpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gpq.driver, _spec)
+}
+
+func (gpq *GracePeriodQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(graceperiod.Table, graceperiod.Columns, sqlgraph.NewFieldSpec(graceperiod.FieldID, field.TypeInt))
+	_spec.From = gpq.sql
+	if unique := gpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, graceperiod.FieldID)
+		for i := range fields {
+			if fields[i] != graceperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gpq *GracePeriodQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gpq.driver.Dialect())
+	t1 := builder.Table(graceperiod.Table)
+	columns := gpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = graceperiod.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gpq.sql != nil {
+		selector = gpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gpq.ctx.Unique != nil && *gpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range gpq.predicates {
+		p(selector)
+	}
+	for _, p := range gpq.order {
+		p(selector)
+	}
+	if offset := gpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GracePeriodGroupBy is the group-by builder for GracePeriod entities.
+type GracePeriodGroupBy struct {
+	selector
+	build *GracePeriodQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gpgb *GracePeriodGroupBy) Aggregate(fns ...AggregateFunc) *GracePeriodGroupBy {
+	gpgb.fns = append(gpgb.fns, fns...)
+	return gpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gpgb *GracePeriodGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := gpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GracePeriodQuery, *GracePeriodGroupBy](ctx, gpgb.build, gpgb, gpgb.build.inters, v)
+}
+
+func (gpgb *GracePeriodGroupBy) sqlScan(ctx context.Context, root *GracePeriodQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gpgb.fns))
+	for _, fn := range gpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gpgb.flds)+len(gpgb.fns))
+		for _, f := range *gpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GracePeriodSelect is the builder for selecting fields of GracePeriod entities.
+type GracePeriodSelect struct {
+	*GracePeriodQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gps *GracePeriodSelect) Aggregate(fns ...AggregateFunc) *GracePeriodSelect {
+	gps.fns = append(gps.fns, fns...)
+	return gps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gps *GracePeriodSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gps.ctx, ent.OpQuerySelect)
+	if err := gps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GracePeriodQuery, *GracePeriodSelect](ctx, gps.GracePeriodQuery, gps, gps.inters, v)
+}
+
+func (gps *GracePeriodSelect) sqlScan(ctx context.Context, root *GracePeriodQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gps.fns))
+	for _, fn := range gps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
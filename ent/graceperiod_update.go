@@ -0,0 +1,544 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// GracePeriodUpdate is the builder for updating GracePeriod entities.
+type GracePeriodUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GracePeriodMutation
+}
+
+// Where appends a list predicates to the GracePeriodUpdate builder.
+func (gpu *GracePeriodUpdate) Where(ps ...predicate.GracePeriod) *GracePeriodUpdate {
+	gpu.mutation.Where(ps...)
+	return gpu
+}
+
+// SetClientID sets the "client_id" field.
+func (gpu *GracePeriodUpdate) SetClientID(i int) *GracePeriodUpdate {
+	gpu.mutation.ResetClientID()
+	gpu.mutation.SetClientID(i)
+	return gpu
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (gpu *GracePeriodUpdate) SetNillableClientID(i *int) *GracePeriodUpdate {
+	if i != nil {
+		gpu.SetClientID(*i)
+	}
+	return gpu
+}
+
+// AddClientID adds i to the "client_id" field.
+func (gpu *GracePeriodUpdate) AddClientID(i int) *GracePeriodUpdate {
+	gpu.mutation.AddClientID(i)
+	return gpu
+}
+
+// SetClientUsername sets the "client_username" field.
+func (gpu *GracePeriodUpdate) SetClientUsername(s string) *GracePeriodUpdate {
+	gpu.mutation.SetClientUsername(s)
+	return gpu
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (gpu *GracePeriodUpdate) SetNillableClientUsername(s *string) *GracePeriodUpdate {
+	if s != nil {
+		gpu.SetClientUsername(*s)
+	}
+	return gpu
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (gpu *GracePeriodUpdate) SetExpiredAt(t time.Time) *GracePeriodUpdate {
+	gpu.mutation.SetExpiredAt(t)
+	return gpu
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (gpu *GracePeriodUpdate) SetNillableExpiredAt(t *time.Time) *GracePeriodUpdate {
+	if t != nil {
+		gpu.SetExpiredAt(*t)
+	}
+	return gpu
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (gpu *GracePeriodUpdate) SetGraceUntil(t time.Time) *GracePeriodUpdate {
+	gpu.mutation.SetGraceUntil(t)
+	return gpu
+}
+
+// SetNillableGraceUntil sets the "grace_until" field if the given value is not nil.
+func (gpu *GracePeriodUpdate) SetNillableGraceUntil(t *time.Time) *GracePeriodUpdate {
+	if t != nil {
+		gpu.SetGraceUntil(*t)
+	}
+	return gpu
+}
+
+// SetStage sets the "stage" field.
+func (gpu *GracePeriodUpdate) SetStage(gr graceperiod.Stage) *GracePeriodUpdate {
+	gpu.mutation.SetStage(gr)
+	return gpu
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (gpu *GracePeriodUpdate) SetNillableStage(gr *graceperiod.Stage) *GracePeriodUpdate {
+	if gr != nil {
+		gpu.SetStage(*gr)
+	}
+	return gpu
+}
+
+// SetProfile sets the "profile" field.
+func (gpu *GracePeriodUpdate) SetProfile(s string) *GracePeriodUpdate {
+	gpu.mutation.SetProfile(s)
+	return gpu
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (gpu *GracePeriodUpdate) SetNillableProfile(s *string) *GracePeriodUpdate {
+	if s != nil {
+		gpu.SetProfile(*s)
+	}
+	return gpu
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (gpu *GracePeriodUpdate) SetEndedAt(t time.Time) *GracePeriodUpdate {
+	gpu.mutation.SetEndedAt(t)
+	return gpu
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (gpu *GracePeriodUpdate) SetNillableEndedAt(t *time.Time) *GracePeriodUpdate {
+	if t != nil {
+		gpu.SetEndedAt(*t)
+	}
+	return gpu
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (gpu *GracePeriodUpdate) ClearEndedAt() *GracePeriodUpdate {
+	gpu.mutation.ClearEndedAt()
+	return gpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gpu *GracePeriodUpdate) SetUpdatedAt(t time.Time) *GracePeriodUpdate {
+	gpu.mutation.SetUpdatedAt(t)
+	return gpu
+}
+
+// Mutation returns the GracePeriodMutation object of the builder.
+func (gpu *GracePeriodUpdate) Mutation() *GracePeriodMutation {
+	return gpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gpu *GracePeriodUpdate) Save(ctx context.Context) (int, error) {
+	gpu.defaults()
+	return withHooks(ctx, gpu.sqlSave, gpu.mutation, gpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpu *GracePeriodUpdate) SaveX(ctx context.Context) int {
+	affected, err := gpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gpu *GracePeriodUpdate) Exec(ctx context.Context) error {
+	_, err := gpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpu *GracePeriodUpdate) ExecX(ctx context.Context) {
+	if err := gpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gpu *GracePeriodUpdate) defaults() {
+	if _, ok := gpu.mutation.UpdatedAt(); !ok {
+		v := graceperiod.UpdateDefaultUpdatedAt()
+		gpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gpu *GracePeriodUpdate) check() error {
+	if v, ok := gpu.mutation.ClientID(); ok {
+		if err := graceperiod.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.client_id": %w`, err)}
+		}
+	}
+	if v, ok := gpu.mutation.ClientUsername(); ok {
+		if err := graceperiod.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.client_username": %w`, err)}
+		}
+	}
+	if v, ok := gpu.mutation.Stage(); ok {
+		if err := graceperiod.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.stage": %w`, err)}
+		}
+	}
+	if v, ok := gpu.mutation.Profile(); ok {
+		if err := graceperiod.ProfileValidator(v); err != nil {
+			return &ValidationError{Name: "profile", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.profile": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gpu *GracePeriodUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(graceperiod.Table, graceperiod.Columns, sqlgraph.NewFieldSpec(graceperiod.FieldID, field.TypeInt))
+	if ps := gpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gpu.mutation.ClientID(); ok {
+		_spec.SetField(graceperiod.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := gpu.mutation.AddedClientID(); ok {
+		_spec.AddField(graceperiod.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := gpu.mutation.ClientUsername(); ok {
+		_spec.SetField(graceperiod.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := gpu.mutation.ExpiredAt(); ok {
+		_spec.SetField(graceperiod.FieldExpiredAt, field.TypeTime, value)
+	}
+	if value, ok := gpu.mutation.GraceUntil(); ok {
+		_spec.SetField(graceperiod.FieldGraceUntil, field.TypeTime, value)
+	}
+	if value, ok := gpu.mutation.Stage(); ok {
+		_spec.SetField(graceperiod.FieldStage, field.TypeEnum, value)
+	}
+	if value, ok := gpu.mutation.Profile(); ok {
+		_spec.SetField(graceperiod.FieldProfile, field.TypeString, value)
+	}
+	if value, ok := gpu.mutation.EndedAt(); ok {
+		_spec.SetField(graceperiod.FieldEndedAt, field.TypeTime, value)
+	}
+	if gpu.mutation.EndedAtCleared() {
+		_spec.ClearField(graceperiod.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := gpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(graceperiod.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{graceperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gpu.mutation.done = true
+	return n, nil
+}
+
+// GracePeriodUpdateOne is the builder for updating a single GracePeriod entity.
+type GracePeriodUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GracePeriodMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (gpuo *GracePeriodUpdateOne) SetClientID(i int) *GracePeriodUpdateOne {
+	gpuo.mutation.ResetClientID()
+	gpuo.mutation.SetClientID(i)
+	return gpuo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (gpuo *GracePeriodUpdateOne) SetNillableClientID(i *int) *GracePeriodUpdateOne {
+	if i != nil {
+		gpuo.SetClientID(*i)
+	}
+	return gpuo
+}
+
+// AddClientID adds i to the "client_id" field.
+func (gpuo *GracePeriodUpdateOne) AddClientID(i int) *GracePeriodUpdateOne {
+	gpuo.mutation.AddClientID(i)
+	return gpuo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (gpuo *GracePeriodUpdateOne) SetClientUsername(s string) *GracePeriodUpdateOne {
+	gpuo.mutation.SetClientUsername(s)
+	return gpuo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (gpuo *GracePeriodUpdateOne) SetNillableClientUsername(s *string) *GracePeriodUpdateOne {
+	if s != nil {
+		gpuo.SetClientUsername(*s)
+	}
+	return gpuo
+}
+
+// SetExpiredAt sets the "expired_at" field.
+func (gpuo *GracePeriodUpdateOne) SetExpiredAt(t time.Time) *GracePeriodUpdateOne {
+	gpuo.mutation.SetExpiredAt(t)
+	return gpuo
+}
+
+// SetNillableExpiredAt sets the "expired_at" field if the given value is not nil.
+func (gpuo *GracePeriodUpdateOne) SetNillableExpiredAt(t *time.Time) *GracePeriodUpdateOne {
+	if t != nil {
+		gpuo.SetExpiredAt(*t)
+	}
+	return gpuo
+}
+
+// SetGraceUntil sets the "grace_until" field.
+func (gpuo *GracePeriodUpdateOne) SetGraceUntil(t time.Time) *GracePeriodUpdateOne {
+	gpuo.mutation.SetGraceUntil(t)
+	return gpuo
+}
+
+// SetNillableGraceUntil sets the "grace_until" field if the given value is not nil.
+func (gpuo *GracePeriodUpdateOne) SetNillableGraceUntil(t *time.Time) *GracePeriodUpdateOne {
+	if t != nil {
+		gpuo.SetGraceUntil(*t)
+	}
+	return gpuo
+}
+
+// SetStage sets the "stage" field.
+func (gpuo *GracePeriodUpdateOne) SetStage(gr graceperiod.Stage) *GracePeriodUpdateOne {
+	gpuo.mutation.SetStage(gr)
+	return gpuo
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (gpuo *GracePeriodUpdateOne) SetNillableStage(gr *graceperiod.Stage) *GracePeriodUpdateOne {
+	if gr != nil {
+		gpuo.SetStage(*gr)
+	}
+	return gpuo
+}
+
+// SetProfile sets the "profile" field.
+func (gpuo *GracePeriodUpdateOne) SetProfile(s string) *GracePeriodUpdateOne {
+	gpuo.mutation.SetProfile(s)
+	return gpuo
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (gpuo *GracePeriodUpdateOne) SetNillableProfile(s *string) *GracePeriodUpdateOne {
+	if s != nil {
+		gpuo.SetProfile(*s)
+	}
+	return gpuo
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (gpuo *GracePeriodUpdateOne) SetEndedAt(t time.Time) *GracePeriodUpdateOne {
+	gpuo.mutation.SetEndedAt(t)
+	return gpuo
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (gpuo *GracePeriodUpdateOne) SetNillableEndedAt(t *time.Time) *GracePeriodUpdateOne {
+	if t != nil {
+		gpuo.SetEndedAt(*t)
+	}
+	return gpuo
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (gpuo *GracePeriodUpdateOne) ClearEndedAt() *GracePeriodUpdateOne {
+	gpuo.mutation.ClearEndedAt()
+	return gpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (gpuo *GracePeriodUpdateOne) SetUpdatedAt(t time.Time) *GracePeriodUpdateOne {
+	gpuo.mutation.SetUpdatedAt(t)
+	return gpuo
+}
+
+// Mutation returns the GracePeriodMutation object of the builder.
+func (gpuo *GracePeriodUpdateOne) Mutation() *GracePeriodMutation {
+	return gpuo.mutation
+}
+
+// Where appends a list predicates to the GracePeriodUpdate builder.
+func (gpuo *GracePeriodUpdateOne) Where(ps ...predicate.GracePeriod) *GracePeriodUpdateOne {
+	gpuo.mutation.Where(ps...)
+	return gpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gpuo *GracePeriodUpdateOne) Select(field string, fields ...string) *GracePeriodUpdateOne {
+	gpuo.fields = append([]string{field}, fields...)
+	return gpuo
+}
+
+// Save executes the query and returns the updated GracePeriod entity.
+func (gpuo *GracePeriodUpdateOne) Save(ctx context.Context) (*GracePeriod, error) {
+	gpuo.defaults()
+	return withHooks(ctx, gpuo.sqlSave, gpuo.mutation, gpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gpuo *GracePeriodUpdateOne) SaveX(ctx context.Context) *GracePeriod {
+	node, err := gpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gpuo *GracePeriodUpdateOne) Exec(ctx context.Context) error {
+	_, err := gpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gpuo *GracePeriodUpdateOne) ExecX(ctx context.Context) {
+	if err := gpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (gpuo *GracePeriodUpdateOne) defaults() {
+	if _, ok := gpuo.mutation.UpdatedAt(); !ok {
+		v := graceperiod.UpdateDefaultUpdatedAt()
+		gpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gpuo *GracePeriodUpdateOne) check() error {
+	if v, ok := gpuo.mutation.ClientID(); ok {
+		if err := graceperiod.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.client_id": %w`, err)}
+		}
+	}
+	if v, ok := gpuo.mutation.ClientUsername(); ok {
+		if err := graceperiod.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.client_username": %w`, err)}
+		}
+	}
+	if v, ok := gpuo.mutation.Stage(); ok {
+		if err := graceperiod.StageValidator(v); err != nil {
+			return &ValidationError{Name: "stage", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.stage": %w`, err)}
+		}
+	}
+	if v, ok := gpuo.mutation.Profile(); ok {
+		if err := graceperiod.ProfileValidator(v); err != nil {
+			return &ValidationError{Name: "profile", err: fmt.Errorf(`ent: validator failed for field "GracePeriod.profile": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gpuo *GracePeriodUpdateOne) sqlSave(ctx context.Context) (_node *GracePeriod, err error) {
+	if err := gpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(graceperiod.Table, graceperiod.Columns, sqlgraph.NewFieldSpec(graceperiod.FieldID, field.TypeInt))
+	id, ok := gpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GracePeriod.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, graceperiod.FieldID)
+		for _, f := range fields {
+			if !graceperiod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != graceperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gpuo.mutation.ClientID(); ok {
+		_spec.SetField(graceperiod.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := gpuo.mutation.AddedClientID(); ok {
+		_spec.AddField(graceperiod.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := gpuo.mutation.ClientUsername(); ok {
+		_spec.SetField(graceperiod.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := gpuo.mutation.ExpiredAt(); ok {
+		_spec.SetField(graceperiod.FieldExpiredAt, field.TypeTime, value)
+	}
+	if value, ok := gpuo.mutation.GraceUntil(); ok {
+		_spec.SetField(graceperiod.FieldGraceUntil, field.TypeTime, value)
+	}
+	if value, ok := gpuo.mutation.Stage(); ok {
+		_spec.SetField(graceperiod.FieldStage, field.TypeEnum, value)
+	}
+	if value, ok := gpuo.mutation.Profile(); ok {
+		_spec.SetField(graceperiod.FieldProfile, field.TypeString, value)
+	}
+	if value, ok := gpuo.mutation.EndedAt(); ok {
+		_spec.SetField(graceperiod.FieldEndedAt, field.TypeTime, value)
+	}
+	if gpuo.mutation.EndedAtCleared() {
+		_spec.ClearField(graceperiod.FieldEndedAt, field.TypeTime)
+	}
+	if value, ok := gpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(graceperiod.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &GracePeriod{config: gpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{graceperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gpuo.mutation.done = true
+	return _node, nil
+}
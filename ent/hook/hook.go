@@ -9,6 +9,30 @@ import (
 	"github.com/mikestefanello/pagoda/ent"
 )
 
+// The BalanceTransferFunc type is an adapter to allow the use of ordinary
+// function as BalanceTransfer mutator.
+type BalanceTransferFunc func(context.Context, *ent.BalanceTransferMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BalanceTransferFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BalanceTransferMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BalanceTransferMutation", m)
+}
+
+// The ClientAddonFunc type is an adapter to allow the use of ordinary
+// function as ClientAddon mutator.
+type ClientAddonFunc func(context.Context, *ent.ClientAddonMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClientAddonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClientAddonMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClientAddonMutation", m)
+}
+
 // The ClientTxnFunc type is an adapter to allow the use of ordinary
 // function as ClientTxn mutator.
 type ClientTxnFunc func(context.Context, *ent.ClientTxnMutation) (ent.Value, error)
@@ -33,6 +57,42 @@ func (f ClientUserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClientUserMutation", m)
 }
 
+// The CouponFunc type is an adapter to allow the use of ordinary
+// function as Coupon mutator.
+type CouponFunc func(context.Context, *ent.CouponMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CouponFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CouponMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CouponMutation", m)
+}
+
+// The CouponRedemptionFunc type is an adapter to allow the use of ordinary
+// function as CouponRedemption mutator.
+type CouponRedemptionFunc func(context.Context, *ent.CouponRedemptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CouponRedemptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CouponRedemptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CouponRedemptionMutation", m)
+}
+
+// The DunningStepFunc type is an adapter to allow the use of ordinary
+// function as DunningStep mutator.
+type DunningStepFunc func(context.Context, *ent.DunningStepMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DunningStepFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DunningStepMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DunningStepMutation", m)
+}
+
 // The EmailSubscriptionFunc type is an adapter to allow the use of ordinary
 // function as EmailSubscription mutator.
 type EmailSubscriptionFunc func(context.Context, *ent.EmailSubscriptionMutation) (ent.Value, error)
@@ -93,6 +153,18 @@ func (f FileStorageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileStorageMutation", m)
 }
 
+// The GracePeriodFunc type is an adapter to allow the use of ordinary
+// function as GracePeriod mutator.
+type GracePeriodFunc func(context.Context, *ent.GracePeriodMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GracePeriodFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GracePeriodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GracePeriodMutation", m)
+}
+
 // The ImageFunc type is an adapter to allow the use of ordinary
 // function as Image mutator.
 type ImageFunc func(context.Context, *ent.ImageMutation) (ent.Value, error)
@@ -129,6 +201,30 @@ func (f InvitationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvitationMutation", m)
 }
 
+// The InvoiceFunc type is an adapter to allow the use of ordinary
+// function as Invoice mutator.
+type InvoiceFunc func(context.Context, *ent.InvoiceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceMutation", m)
+}
+
+// The InvoiceLineFunc type is an adapter to allow the use of ordinary
+// function as InvoiceLine mutator.
+type InvoiceLineFunc func(context.Context, *ent.InvoiceLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceLineMutation", m)
+}
+
 // The LastSeenOnlineFunc type is an adapter to allow the use of ordinary
 // function as LastSeenOnline mutator.
 type LastSeenOnlineFunc func(context.Context, *ent.LastSeenOnlineMutation) (ent.Value, error)
@@ -141,6 +237,18 @@ func (f LastSeenOnlineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LastSeenOnlineMutation", m)
 }
 
+// The ManualPaymentFunc type is an adapter to allow the use of ordinary
+// function as ManualPayment mutator.
+type ManualPaymentFunc func(context.Context, *ent.ManualPaymentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ManualPaymentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ManualPaymentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ManualPaymentMutation", m)
+}
+
 // The MonthlySubscriptionFunc type is an adapter to allow the use of ordinary
 // function as MonthlySubscription mutator.
 type MonthlySubscriptionFunc func(context.Context, *ent.MonthlySubscriptionMutation) (ent.Value, error)
@@ -201,6 +309,18 @@ func (f PackagePlanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PackagePlanMutation", m)
 }
 
+// The PayRequestFunc type is an adapter to allow the use of ordinary
+// function as PayRequest mutator.
+type PayRequestFunc func(context.Context, *ent.PayRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PayRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PayRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PayRequestMutation", m)
+}
+
 // The PhoneVerificationCodeFunc type is an adapter to allow the use of ordinary
 // function as PhoneVerificationCode mutator.
 type PhoneVerificationCodeFunc func(context.Context, *ent.PhoneVerificationCodeMutation) (ent.Value, error)
@@ -249,6 +369,66 @@ func (f RadAcctFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RadAcctMutation", m)
 }
 
+// The RadCheckFunc type is an adapter to allow the use of ordinary
+// function as RadCheck mutator.
+type RadCheckFunc func(context.Context, *ent.RadCheckMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RadCheckFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RadCheckMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RadCheckMutation", m)
+}
+
+// The RadGroupReplyFunc type is an adapter to allow the use of ordinary
+// function as RadGroupReply mutator.
+type RadGroupReplyFunc func(context.Context, *ent.RadGroupReplyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RadGroupReplyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RadGroupReplyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RadGroupReplyMutation", m)
+}
+
+// The RadReplyFunc type is an adapter to allow the use of ordinary
+// function as RadReply mutator.
+type RadReplyFunc func(context.Context, *ent.RadReplyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RadReplyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RadReplyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RadReplyMutation", m)
+}
+
+// The RadUserGroupFunc type is an adapter to allow the use of ordinary
+// function as RadUserGroup mutator.
+type RadUserGroupFunc func(context.Context, *ent.RadUserGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RadUserGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RadUserGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RadUserGroupMutation", m)
+}
+
+// The RefundRequestFunc type is an adapter to allow the use of ordinary
+// function as RefundRequest mutator.
+type RefundRequestFunc func(context.Context, *ent.RefundRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefundRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RefundRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefundRequestMutation", m)
+}
+
 // The SentEmailFunc type is an adapter to allow the use of ordinary
 // function as SentEmail mutator.
 type SentEmailFunc func(context.Context, *ent.SentEmailMutation) (ent.Value, error)
@@ -261,6 +441,30 @@ func (f SentEmailFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SentEmailMutation", m)
 }
 
+// The SettlementRowFunc type is an adapter to allow the use of ordinary
+// function as SettlementRow mutator.
+type SettlementRowFunc func(context.Context, *ent.SettlementRowMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SettlementRowFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SettlementRowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettlementRowMutation", m)
+}
+
+// The StatementEntryFunc type is an adapter to allow the use of ordinary
+// function as StatementEntry mutator.
+type StatementEntryFunc func(context.Context, *ent.StatementEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StatementEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StatementEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StatementEntryMutation", m)
+}
+
 // The TicketFunc type is an adapter to allow the use of ordinary
 // function as Ticket mutator.
 type TicketFunc func(context.Context, *ent.TicketMutation) (ent.Value, error)
@@ -273,6 +477,42 @@ func (f TicketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TicketMutation", m)
 }
 
+// The UsageCursorFunc type is an adapter to allow the use of ordinary
+// function as UsageCursor mutator.
+type UsageCursorFunc func(context.Context, *ent.UsageCursorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageCursorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UsageCursorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UsageCursorMutation", m)
+}
+
+// The UsageDailyFunc type is an adapter to allow the use of ordinary
+// function as UsageDaily mutator.
+type UsageDailyFunc func(context.Context, *ent.UsageDailyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageDailyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UsageDailyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UsageDailyMutation", m)
+}
+
+// The UsageHourlyFunc type is an adapter to allow the use of ordinary
+// function as UsageHourly mutator.
+type UsageHourlyFunc func(context.Context, *ent.UsageHourlyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageHourlyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UsageHourlyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UsageHourlyMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -285,6 +525,78 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The VendorFunc type is an adapter to allow the use of ordinary
+// function as Vendor mutator.
+type VendorFunc func(context.Context, *ent.VendorMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VendorFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VendorMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VendorMutation", m)
+}
+
+// The VendorCommissionFunc type is an adapter to allow the use of ordinary
+// function as VendorCommission mutator.
+type VendorCommissionFunc func(context.Context, *ent.VendorCommissionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VendorCommissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VendorCommissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VendorCommissionMutation", m)
+}
+
+// The VendorTxnFunc type is an adapter to allow the use of ordinary
+// function as VendorTxn mutator.
+type VendorTxnFunc func(context.Context, *ent.VendorTxnMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VendorTxnFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VendorTxnMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VendorTxnMutation", m)
+}
+
+// The VoucherFunc type is an adapter to allow the use of ordinary
+// function as Voucher mutator.
+type VoucherFunc func(context.Context, *ent.VoucherMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VoucherFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VoucherMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VoucherMutation", m)
+}
+
+// The VoucherAttemptFunc type is an adapter to allow the use of ordinary
+// function as VoucherAttempt mutator.
+type VoucherAttemptFunc func(context.Context, *ent.VoucherAttemptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VoucherAttemptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VoucherAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VoucherAttemptMutation", m)
+}
+
+// The VoucherBatchFunc type is an adapter to allow the use of ordinary
+// function as VoucherBatch mutator.
+type VoucherBatchFunc func(context.Context, *ent.VoucherBatchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VoucherBatchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VoucherBatchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VoucherBatchMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
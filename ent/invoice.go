@@ -0,0 +1,265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID int `json:"client_id,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// First moment of the billed month
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// First moment after the billed month
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// Gross, including tax_amount
+	Amount float64 `json:"amount,omitempty"`
+	// NetAmount holds the value of the "net_amount" field.
+	NetAmount float64 `json:"net_amount,omitempty"`
+	// TaxAmount holds the value of the "tax_amount" field.
+	TaxAmount float64 `json:"tax_amount,omitempty"`
+	// PaidAmount holds the value of the "paid_amount" field.
+	PaidAmount float64 `json:"paid_amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status invoice.Status `json:"status,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt time.Time `json:"due_at,omitempty"`
+	// PaidAt holds the value of the "paid_at" field.
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+	// POSTPAID_INVOICE transaction that charged the invoice to the balance
+	TransactionRef string `json:"transaction_ref,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldAmount, invoice.FieldNetAmount, invoice.FieldTaxAmount, invoice.FieldPaidAmount:
+			values[i] = new(sql.NullFloat64)
+		case invoice.FieldID, invoice.FieldClientID:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldNumber, invoice.FieldClientUsername, invoice.FieldStatus, invoice.FieldTransactionRef:
+			values[i] = new(sql.NullString)
+		case invoice.FieldPeriodStart, invoice.FieldPeriodEnd, invoice.FieldDueAt, invoice.FieldPaidAt, invoice.FieldCreatedAt, invoice.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (i *Invoice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invoice.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case invoice.FieldNumber:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[j])
+			} else if value.Valid {
+				i.Number = value.String
+			}
+		case invoice.FieldClientID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[j])
+			} else if value.Valid {
+				i.ClientID = int(value.Int64)
+			}
+		case invoice.FieldClientUsername:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[j])
+			} else if value.Valid {
+				i.ClientUsername = value.String
+			}
+		case invoice.FieldPeriodStart:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[j])
+			} else if value.Valid {
+				i.PeriodStart = value.Time
+			}
+		case invoice.FieldPeriodEnd:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[j])
+			} else if value.Valid {
+				i.PeriodEnd = value.Time
+			}
+		case invoice.FieldAmount:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[j])
+			} else if value.Valid {
+				i.Amount = value.Float64
+			}
+		case invoice.FieldNetAmount:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field net_amount", values[j])
+			} else if value.Valid {
+				i.NetAmount = value.Float64
+			}
+		case invoice.FieldTaxAmount:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[j])
+			} else if value.Valid {
+				i.TaxAmount = value.Float64
+			}
+		case invoice.FieldPaidAmount:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_amount", values[j])
+			} else if value.Valid {
+				i.PaidAmount = value.Float64
+			}
+		case invoice.FieldStatus:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[j])
+			} else if value.Valid {
+				i.Status = invoice.Status(value.String)
+			}
+		case invoice.FieldDueAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[j])
+			} else if value.Valid {
+				i.DueAt = value.Time
+			}
+		case invoice.FieldPaidAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_at", values[j])
+			} else if value.Valid {
+				i.PaidAt = new(time.Time)
+				*i.PaidAt = value.Time
+			}
+		case invoice.FieldTransactionRef:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_ref", values[j])
+			} else if value.Valid {
+				i.TransactionRef = value.String
+			}
+		case invoice.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		case invoice.FieldUpdatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[j])
+			} else if value.Valid {
+				i.UpdatedAt = value.Time
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invoice.
+// This includes values selected through modifiers, order, etc.
+func (i *Invoice) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invoice) Update() *InvoiceUpdateOne {
+	return NewInvoiceClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Invoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Invoice) Unwrap() *Invoice {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invoice is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("number=")
+	builder.WriteString(i.Number)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(i.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(i.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(i.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("net_amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.NetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("paid_amount=")
+	builder.WriteString(fmt.Sprintf("%v", i.PaidAmount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", i.Status))
+	builder.WriteString(", ")
+	builder.WriteString("due_at=")
+	builder.WriteString(i.DueAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := i.PaidAt; v != nil {
+		builder.WriteString("paid_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("transaction_ref=")
+	builder.WriteString(i.TransactionRef)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(i.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
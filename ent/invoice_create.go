@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (ic *InvoiceCreate) SetNumber(s string) *InvoiceCreate {
+	ic.mutation.SetNumber(s)
+	return ic
+}
+
+// SetClientID sets the "client_id" field.
+func (ic *InvoiceCreate) SetClientID(i int) *InvoiceCreate {
+	ic.mutation.SetClientID(i)
+	return ic
+}
+
+// SetClientUsername sets the "client_username" field.
+func (ic *InvoiceCreate) SetClientUsername(s string) *InvoiceCreate {
+	ic.mutation.SetClientUsername(s)
+	return ic
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (ic *InvoiceCreate) SetPeriodStart(t time.Time) *InvoiceCreate {
+	ic.mutation.SetPeriodStart(t)
+	return ic
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (ic *InvoiceCreate) SetPeriodEnd(t time.Time) *InvoiceCreate {
+	ic.mutation.SetPeriodEnd(t)
+	return ic
+}
+
+// SetAmount sets the "amount" field.
+func (ic *InvoiceCreate) SetAmount(f float64) *InvoiceCreate {
+	ic.mutation.SetAmount(f)
+	return ic
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (ic *InvoiceCreate) SetNetAmount(f float64) *InvoiceCreate {
+	ic.mutation.SetNetAmount(f)
+	return ic
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableNetAmount(f *float64) *InvoiceCreate {
+	if f != nil {
+		ic.SetNetAmount(*f)
+	}
+	return ic
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (ic *InvoiceCreate) SetTaxAmount(f float64) *InvoiceCreate {
+	ic.mutation.SetTaxAmount(f)
+	return ic
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableTaxAmount(f *float64) *InvoiceCreate {
+	if f != nil {
+		ic.SetTaxAmount(*f)
+	}
+	return ic
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (ic *InvoiceCreate) SetPaidAmount(f float64) *InvoiceCreate {
+	ic.mutation.SetPaidAmount(f)
+	return ic
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePaidAmount(f *float64) *InvoiceCreate {
+	if f != nil {
+		ic.SetPaidAmount(*f)
+	}
+	return ic
+}
+
+// SetStatus sets the "status" field.
+func (ic *InvoiceCreate) SetStatus(i invoice.Status) *InvoiceCreate {
+	ic.mutation.SetStatus(i)
+	return ic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableStatus(i *invoice.Status) *InvoiceCreate {
+	if i != nil {
+		ic.SetStatus(*i)
+	}
+	return ic
+}
+
+// SetDueAt sets the "due_at" field.
+func (ic *InvoiceCreate) SetDueAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetDueAt(t)
+	return ic
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (ic *InvoiceCreate) SetPaidAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetPaidAt(t)
+	return ic
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillablePaidAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetPaidAt(*t)
+	}
+	return ic
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (ic *InvoiceCreate) SetTransactionRef(s string) *InvoiceCreate {
+	ic.mutation.SetTransactionRef(s)
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *InvoiceCreate) SetCreatedAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableCreatedAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ic *InvoiceCreate) SetUpdatedAt(t time.Time) *InvoiceCreate {
+	ic.mutation.SetUpdatedAt(t)
+	return ic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ic *InvoiceCreate) SetNillableUpdatedAt(t *time.Time) *InvoiceCreate {
+	if t != nil {
+		ic.SetUpdatedAt(*t)
+	}
+	return ic
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (ic *InvoiceCreate) Mutation() *InvoiceMutation {
+	return ic.mutation
+}
+
+// Save creates the Invoice in the database.
+func (ic *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvoiceCreate) defaults() {
+	if _, ok := ic.mutation.NetAmount(); !ok {
+		v := invoice.DefaultNetAmount
+		ic.mutation.SetNetAmount(v)
+	}
+	if _, ok := ic.mutation.TaxAmount(); !ok {
+		v := invoice.DefaultTaxAmount
+		ic.mutation.SetTaxAmount(v)
+	}
+	if _, ok := ic.mutation.PaidAmount(); !ok {
+		v := invoice.DefaultPaidAmount
+		ic.mutation.SetPaidAmount(v)
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		v := invoice.DefaultStatus
+		ic.mutation.SetStatus(v)
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := invoice.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		v := invoice.DefaultUpdatedAt()
+		ic.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvoiceCreate) check() error {
+	if _, ok := ic.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Invoice.number"`)}
+	}
+	if v, ok := ic.mutation.Number(); ok {
+		if err := invoice.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Invoice.number": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "Invoice.client_id"`)}
+	}
+	if v, ok := ic.mutation.ClientID(); ok {
+		if err := invoice.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.client_id": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.ClientUsername(); !ok {
+		return &ValidationError{Name: "client_username", err: errors.New(`ent: missing required field "Invoice.client_username"`)}
+	}
+	if v, ok := ic.mutation.ClientUsername(); ok {
+		if err := invoice.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "Invoice.client_username": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "Invoice.period_start"`)}
+	}
+	if _, ok := ic.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "Invoice.period_end"`)}
+	}
+	if _, ok := ic.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Invoice.amount"`)}
+	}
+	if _, ok := ic.mutation.NetAmount(); !ok {
+		return &ValidationError{Name: "net_amount", err: errors.New(`ent: missing required field "Invoice.net_amount"`)}
+	}
+	if _, ok := ic.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "Invoice.tax_amount"`)}
+	}
+	if _, ok := ic.mutation.PaidAmount(); !ok {
+		return &ValidationError{Name: "paid_amount", err: errors.New(`ent: missing required field "Invoice.paid_amount"`)}
+	}
+	if _, ok := ic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Invoice.status"`)}
+	}
+	if v, ok := ic.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.DueAt(); !ok {
+		return &ValidationError{Name: "due_at", err: errors.New(`ent: missing required field "Invoice.due_at"`)}
+	}
+	if _, ok := ic.mutation.TransactionRef(); !ok {
+		return &ValidationError{Name: "transaction_ref", err: errors.New(`ent: missing required field "Invoice.transaction_ref"`)}
+	}
+	if v, ok := ic.mutation.TransactionRef(); ok {
+		if err := invoice.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "Invoice.transaction_ref": %w`, err)}
+		}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invoice.created_at"`)}
+	}
+	if _, ok := ic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Invoice.updated_at"`)}
+	}
+	return nil
+}
+
+func (ic *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	)
+	if value, ok := ic.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := ic.mutation.ClientID(); ok {
+		_spec.SetField(invoice.FieldClientID, field.TypeInt, value)
+		_node.ClientID = value
+	}
+	if value, ok := ic.mutation.ClientUsername(); ok {
+		_spec.SetField(invoice.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := ic.mutation.PeriodStart(); ok {
+		_spec.SetField(invoice.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := ic.mutation.PeriodEnd(); ok {
+		_spec.SetField(invoice.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := ic.mutation.Amount(); ok {
+		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := ic.mutation.NetAmount(); ok {
+		_spec.SetField(invoice.FieldNetAmount, field.TypeFloat64, value)
+		_node.NetAmount = value
+	}
+	if value, ok := ic.mutation.TaxAmount(); ok {
+		_spec.SetField(invoice.FieldTaxAmount, field.TypeFloat64, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := ic.mutation.PaidAmount(); ok {
+		_spec.SetField(invoice.FieldPaidAmount, field.TypeFloat64, value)
+		_node.PaidAmount = value
+	}
+	if value, ok := ic.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ic.mutation.DueAt(); ok {
+		_spec.SetField(invoice.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = value
+	}
+	if value, ok := ic.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = &value
+	}
+	if value, ok := ic.mutation.TransactionRef(); ok {
+		_spec.SetField(invoice.FieldTransactionRef, field.TypeString, value)
+		_node.TransactionRef = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(invoice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ic.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceCreate
+}
+
+// Save creates the Invoice entities in the database.
+func (icb *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Invoice, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
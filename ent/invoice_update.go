@@ -0,0 +1,838 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (iu *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetNumber sets the "number" field.
+func (iu *InvoiceUpdate) SetNumber(s string) *InvoiceUpdate {
+	iu.mutation.SetNumber(s)
+	return iu
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableNumber(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetNumber(*s)
+	}
+	return iu
+}
+
+// SetClientID sets the "client_id" field.
+func (iu *InvoiceUpdate) SetClientID(i int) *InvoiceUpdate {
+	iu.mutation.ResetClientID()
+	iu.mutation.SetClientID(i)
+	return iu
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableClientID(i *int) *InvoiceUpdate {
+	if i != nil {
+		iu.SetClientID(*i)
+	}
+	return iu
+}
+
+// AddClientID adds i to the "client_id" field.
+func (iu *InvoiceUpdate) AddClientID(i int) *InvoiceUpdate {
+	iu.mutation.AddClientID(i)
+	return iu
+}
+
+// SetClientUsername sets the "client_username" field.
+func (iu *InvoiceUpdate) SetClientUsername(s string) *InvoiceUpdate {
+	iu.mutation.SetClientUsername(s)
+	return iu
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableClientUsername(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetClientUsername(*s)
+	}
+	return iu
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (iu *InvoiceUpdate) SetPeriodStart(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetPeriodStart(t)
+	return iu
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePeriodStart(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetPeriodStart(*t)
+	}
+	return iu
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (iu *InvoiceUpdate) SetPeriodEnd(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetPeriodEnd(t)
+	return iu
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePeriodEnd(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetPeriodEnd(*t)
+	}
+	return iu
+}
+
+// SetAmount sets the "amount" field.
+func (iu *InvoiceUpdate) SetAmount(f float64) *InvoiceUpdate {
+	iu.mutation.ResetAmount()
+	iu.mutation.SetAmount(f)
+	return iu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableAmount(f *float64) *InvoiceUpdate {
+	if f != nil {
+		iu.SetAmount(*f)
+	}
+	return iu
+}
+
+// AddAmount adds f to the "amount" field.
+func (iu *InvoiceUpdate) AddAmount(f float64) *InvoiceUpdate {
+	iu.mutation.AddAmount(f)
+	return iu
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (iu *InvoiceUpdate) SetNetAmount(f float64) *InvoiceUpdate {
+	iu.mutation.ResetNetAmount()
+	iu.mutation.SetNetAmount(f)
+	return iu
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableNetAmount(f *float64) *InvoiceUpdate {
+	if f != nil {
+		iu.SetNetAmount(*f)
+	}
+	return iu
+}
+
+// AddNetAmount adds f to the "net_amount" field.
+func (iu *InvoiceUpdate) AddNetAmount(f float64) *InvoiceUpdate {
+	iu.mutation.AddNetAmount(f)
+	return iu
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (iu *InvoiceUpdate) SetTaxAmount(f float64) *InvoiceUpdate {
+	iu.mutation.ResetTaxAmount()
+	iu.mutation.SetTaxAmount(f)
+	return iu
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTaxAmount(f *float64) *InvoiceUpdate {
+	if f != nil {
+		iu.SetTaxAmount(*f)
+	}
+	return iu
+}
+
+// AddTaxAmount adds f to the "tax_amount" field.
+func (iu *InvoiceUpdate) AddTaxAmount(f float64) *InvoiceUpdate {
+	iu.mutation.AddTaxAmount(f)
+	return iu
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (iu *InvoiceUpdate) SetPaidAmount(f float64) *InvoiceUpdate {
+	iu.mutation.ResetPaidAmount()
+	iu.mutation.SetPaidAmount(f)
+	return iu
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePaidAmount(f *float64) *InvoiceUpdate {
+	if f != nil {
+		iu.SetPaidAmount(*f)
+	}
+	return iu
+}
+
+// AddPaidAmount adds f to the "paid_amount" field.
+func (iu *InvoiceUpdate) AddPaidAmount(f float64) *InvoiceUpdate {
+	iu.mutation.AddPaidAmount(f)
+	return iu
+}
+
+// SetStatus sets the "status" field.
+func (iu *InvoiceUpdate) SetStatus(i invoice.Status) *InvoiceUpdate {
+	iu.mutation.SetStatus(i)
+	return iu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableStatus(i *invoice.Status) *InvoiceUpdate {
+	if i != nil {
+		iu.SetStatus(*i)
+	}
+	return iu
+}
+
+// SetDueAt sets the "due_at" field.
+func (iu *InvoiceUpdate) SetDueAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetDueAt(t)
+	return iu
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableDueAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetDueAt(*t)
+	}
+	return iu
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (iu *InvoiceUpdate) SetPaidAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetPaidAt(t)
+	return iu
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillablePaidAt(t *time.Time) *InvoiceUpdate {
+	if t != nil {
+		iu.SetPaidAt(*t)
+	}
+	return iu
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (iu *InvoiceUpdate) ClearPaidAt() *InvoiceUpdate {
+	iu.mutation.ClearPaidAt()
+	return iu
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (iu *InvoiceUpdate) SetTransactionRef(s string) *InvoiceUpdate {
+	iu.mutation.SetTransactionRef(s)
+	return iu
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (iu *InvoiceUpdate) SetNillableTransactionRef(s *string) *InvoiceUpdate {
+	if s != nil {
+		iu.SetTransactionRef(*s)
+	}
+	return iu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iu *InvoiceUpdate) SetUpdatedAt(t time.Time) *InvoiceUpdate {
+	iu.mutation.SetUpdatedAt(t)
+	return iu
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iu *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return iu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	iu.defaults()
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iu *InvoiceUpdate) defaults() {
+	if _, ok := iu.mutation.UpdatedAt(); !ok {
+		v := invoice.UpdateDefaultUpdatedAt()
+		iu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvoiceUpdate) check() error {
+	if v, ok := iu.mutation.Number(); ok {
+		if err := invoice.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Invoice.number": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.ClientID(); ok {
+		if err := invoice.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.client_id": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.ClientUsername(); ok {
+		if err := invoice.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "Invoice.client_username": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.TransactionRef(); ok {
+		if err := invoice.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "Invoice.transaction_ref": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.ClientID(); ok {
+		_spec.SetField(invoice.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.AddedClientID(); ok {
+		_spec.AddField(invoice.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := iu.mutation.ClientUsername(); ok {
+		_spec.SetField(invoice.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.PeriodStart(); ok {
+		_spec.SetField(invoice.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.PeriodEnd(); ok {
+		_spec.SetField(invoice.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.Amount(); ok {
+		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedAmount(); ok {
+		_spec.AddField(invoice.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.NetAmount(); ok {
+		_spec.SetField(invoice.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedNetAmount(); ok {
+		_spec.AddField(invoice.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.TaxAmount(); ok {
+		_spec.SetField(invoice.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(invoice.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.PaidAmount(); ok {
+		_spec.SetField(invoice.FieldPaidAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedPaidAmount(); ok {
+		_spec.AddField(invoice.FieldPaidAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iu.mutation.DueAt(); ok {
+		_spec.SetField(invoice.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := iu.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+	}
+	if iu.mutation.PaidAtCleared() {
+		_spec.ClearField(invoice.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.TransactionRef(); ok {
+		_spec.SetField(invoice.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetNumber sets the "number" field.
+func (iuo *InvoiceUpdateOne) SetNumber(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetNumber(s)
+	return iuo
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableNumber(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetNumber(*s)
+	}
+	return iuo
+}
+
+// SetClientID sets the "client_id" field.
+func (iuo *InvoiceUpdateOne) SetClientID(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetClientID()
+	iuo.mutation.SetClientID(i)
+	return iuo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableClientID(i *int) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetClientID(*i)
+	}
+	return iuo
+}
+
+// AddClientID adds i to the "client_id" field.
+func (iuo *InvoiceUpdateOne) AddClientID(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddClientID(i)
+	return iuo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (iuo *InvoiceUpdateOne) SetClientUsername(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetClientUsername(s)
+	return iuo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableClientUsername(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetClientUsername(*s)
+	}
+	return iuo
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (iuo *InvoiceUpdateOne) SetPeriodStart(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetPeriodStart(t)
+	return iuo
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePeriodStart(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetPeriodStart(*t)
+	}
+	return iuo
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (iuo *InvoiceUpdateOne) SetPeriodEnd(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetPeriodEnd(t)
+	return iuo
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePeriodEnd(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetPeriodEnd(*t)
+	}
+	return iuo
+}
+
+// SetAmount sets the "amount" field.
+func (iuo *InvoiceUpdateOne) SetAmount(f float64) *InvoiceUpdateOne {
+	iuo.mutation.ResetAmount()
+	iuo.mutation.SetAmount(f)
+	return iuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableAmount(f *float64) *InvoiceUpdateOne {
+	if f != nil {
+		iuo.SetAmount(*f)
+	}
+	return iuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (iuo *InvoiceUpdateOne) AddAmount(f float64) *InvoiceUpdateOne {
+	iuo.mutation.AddAmount(f)
+	return iuo
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (iuo *InvoiceUpdateOne) SetNetAmount(f float64) *InvoiceUpdateOne {
+	iuo.mutation.ResetNetAmount()
+	iuo.mutation.SetNetAmount(f)
+	return iuo
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableNetAmount(f *float64) *InvoiceUpdateOne {
+	if f != nil {
+		iuo.SetNetAmount(*f)
+	}
+	return iuo
+}
+
+// AddNetAmount adds f to the "net_amount" field.
+func (iuo *InvoiceUpdateOne) AddNetAmount(f float64) *InvoiceUpdateOne {
+	iuo.mutation.AddNetAmount(f)
+	return iuo
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (iuo *InvoiceUpdateOne) SetTaxAmount(f float64) *InvoiceUpdateOne {
+	iuo.mutation.ResetTaxAmount()
+	iuo.mutation.SetTaxAmount(f)
+	return iuo
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTaxAmount(f *float64) *InvoiceUpdateOne {
+	if f != nil {
+		iuo.SetTaxAmount(*f)
+	}
+	return iuo
+}
+
+// AddTaxAmount adds f to the "tax_amount" field.
+func (iuo *InvoiceUpdateOne) AddTaxAmount(f float64) *InvoiceUpdateOne {
+	iuo.mutation.AddTaxAmount(f)
+	return iuo
+}
+
+// SetPaidAmount sets the "paid_amount" field.
+func (iuo *InvoiceUpdateOne) SetPaidAmount(f float64) *InvoiceUpdateOne {
+	iuo.mutation.ResetPaidAmount()
+	iuo.mutation.SetPaidAmount(f)
+	return iuo
+}
+
+// SetNillablePaidAmount sets the "paid_amount" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePaidAmount(f *float64) *InvoiceUpdateOne {
+	if f != nil {
+		iuo.SetPaidAmount(*f)
+	}
+	return iuo
+}
+
+// AddPaidAmount adds f to the "paid_amount" field.
+func (iuo *InvoiceUpdateOne) AddPaidAmount(f float64) *InvoiceUpdateOne {
+	iuo.mutation.AddPaidAmount(f)
+	return iuo
+}
+
+// SetStatus sets the "status" field.
+func (iuo *InvoiceUpdateOne) SetStatus(i invoice.Status) *InvoiceUpdateOne {
+	iuo.mutation.SetStatus(i)
+	return iuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableStatus(i *invoice.Status) *InvoiceUpdateOne {
+	if i != nil {
+		iuo.SetStatus(*i)
+	}
+	return iuo
+}
+
+// SetDueAt sets the "due_at" field.
+func (iuo *InvoiceUpdateOne) SetDueAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetDueAt(t)
+	return iuo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableDueAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetDueAt(*t)
+	}
+	return iuo
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (iuo *InvoiceUpdateOne) SetPaidAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetPaidAt(t)
+	return iuo
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillablePaidAt(t *time.Time) *InvoiceUpdateOne {
+	if t != nil {
+		iuo.SetPaidAt(*t)
+	}
+	return iuo
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (iuo *InvoiceUpdateOne) ClearPaidAt() *InvoiceUpdateOne {
+	iuo.mutation.ClearPaidAt()
+	return iuo
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (iuo *InvoiceUpdateOne) SetTransactionRef(s string) *InvoiceUpdateOne {
+	iuo.mutation.SetTransactionRef(s)
+	return iuo
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (iuo *InvoiceUpdateOne) SetNillableTransactionRef(s *string) *InvoiceUpdateOne {
+	if s != nil {
+		iuo.SetTransactionRef(*s)
+	}
+	return iuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iuo *InvoiceUpdateOne) SetUpdatedAt(t time.Time) *InvoiceUpdateOne {
+	iuo.mutation.SetUpdatedAt(t)
+	return iuo
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (iuo *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return iuo.mutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (iuo *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (iuo *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	iuo.defaults()
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iuo *InvoiceUpdateOne) defaults() {
+	if _, ok := iuo.mutation.UpdatedAt(); !ok {
+		v := invoice.UpdateDefaultUpdatedAt()
+		iuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvoiceUpdateOne) check() error {
+	if v, ok := iuo.mutation.Number(); ok {
+		if err := invoice.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Invoice.number": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.ClientID(); ok {
+		if err := invoice.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.client_id": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.ClientUsername(); ok {
+		if err := invoice.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "Invoice.client_username": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.Status(); ok {
+		if err := invoice.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Invoice.status": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.TransactionRef(); ok {
+		if err := invoice.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "Invoice.transaction_ref": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.ClientID(); ok {
+		_spec.SetField(invoice.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.AddedClientID(); ok {
+		_spec.AddField(invoice.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := iuo.mutation.ClientUsername(); ok {
+		_spec.SetField(invoice.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.PeriodStart(); ok {
+		_spec.SetField(invoice.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.PeriodEnd(); ok {
+		_spec.SetField(invoice.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.Amount(); ok {
+		_spec.SetField(invoice.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedAmount(); ok {
+		_spec.AddField(invoice.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.NetAmount(); ok {
+		_spec.SetField(invoice.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedNetAmount(); ok {
+		_spec.AddField(invoice.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.TaxAmount(); ok {
+		_spec.SetField(invoice.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(invoice.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.PaidAmount(); ok {
+		_spec.SetField(invoice.FieldPaidAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedPaidAmount(); ok {
+		_spec.AddField(invoice.FieldPaidAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.Status(); ok {
+		_spec.SetField(invoice.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := iuo.mutation.DueAt(); ok {
+		_spec.SetField(invoice.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := iuo.mutation.PaidAt(); ok {
+		_spec.SetField(invoice.FieldPaidAt, field.TypeTime, value)
+	}
+	if iuo.mutation.PaidAtCleared() {
+		_spec.ClearField(invoice.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.TransactionRef(); ok {
+		_spec.SetField(invoice.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(invoice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Invoice{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
+)
+
+// InvoiceLine is the model entity for the InvoiceLine schema.
+type InvoiceLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// InvoiceID holds the value of the "invoice_id" field.
+	InvoiceID int `json:"invoice_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind invoiceline.Kind `json:"kind,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Gross, including tax_amount
+	Amount float64 `json:"amount,omitempty"`
+	// NetAmount holds the value of the "net_amount" field.
+	NetAmount float64 `json:"net_amount,omitempty"`
+	// TaxAmount holds the value of the "tax_amount" field.
+	TaxAmount float64 `json:"tax_amount,omitempty"`
+	// TaxRate holds the value of the "tax_rate" field.
+	TaxRate      float64 `json:"tax_rate,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InvoiceLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoiceline.FieldAmount, invoiceline.FieldNetAmount, invoiceline.FieldTaxAmount, invoiceline.FieldTaxRate:
+			values[i] = new(sql.NullFloat64)
+		case invoiceline.FieldID, invoiceline.FieldInvoiceID:
+			values[i] = new(sql.NullInt64)
+		case invoiceline.FieldKind, invoiceline.FieldDescription:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InvoiceLine fields.
+func (il *InvoiceLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoiceline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			il.ID = int(value.Int64)
+		case invoiceline.FieldInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_id", values[i])
+			} else if value.Valid {
+				il.InvoiceID = int(value.Int64)
+			}
+		case invoiceline.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				il.Kind = invoiceline.Kind(value.String)
+			}
+		case invoiceline.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				il.Description = value.String
+			}
+		case invoiceline.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				il.Amount = value.Float64
+			}
+		case invoiceline.FieldNetAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field net_amount", values[i])
+			} else if value.Valid {
+				il.NetAmount = value.Float64
+			}
+		case invoiceline.FieldTaxAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_amount", values[i])
+			} else if value.Valid {
+				il.TaxAmount = value.Float64
+			}
+		case invoiceline.FieldTaxRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_rate", values[i])
+			} else if value.Valid {
+				il.TaxRate = value.Float64
+			}
+		default:
+			il.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InvoiceLine.
+// This includes values selected through modifiers, order, etc.
+func (il *InvoiceLine) Value(name string) (ent.Value, error) {
+	return il.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InvoiceLine.
+// Note that you need to call InvoiceLine.Unwrap() before calling this method if this InvoiceLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (il *InvoiceLine) Update() *InvoiceLineUpdateOne {
+	return NewInvoiceLineClient(il.config).UpdateOne(il)
+}
+
+// Unwrap unwraps the InvoiceLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (il *InvoiceLine) Unwrap() *InvoiceLine {
+	_tx, ok := il.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceLine is not a transactional entity")
+	}
+	il.config.driver = _tx.drv
+	return il
+}
+
+// String implements the fmt.Stringer.
+func (il *InvoiceLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("InvoiceLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", il.ID))
+	builder.WriteString("invoice_id=")
+	builder.WriteString(fmt.Sprintf("%v", il.InvoiceID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", il.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(il.Description)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", il.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("net_amount=")
+	builder.WriteString(fmt.Sprintf("%v", il.NetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_amount=")
+	builder.WriteString(fmt.Sprintf("%v", il.TaxAmount))
+	builder.WriteString(", ")
+	builder.WriteString("tax_rate=")
+	builder.WriteString(fmt.Sprintf("%v", il.TaxRate))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InvoiceLines is a parsable slice of InvoiceLine.
+type InvoiceLines []*InvoiceLine
@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
+)
+
+// InvoiceLineCreate is the builder for creating a InvoiceLine entity.
+type InvoiceLineCreate struct {
+	config
+	mutation *InvoiceLineMutation
+	hooks    []Hook
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (ilc *InvoiceLineCreate) SetInvoiceID(i int) *InvoiceLineCreate {
+	ilc.mutation.SetInvoiceID(i)
+	return ilc
+}
+
+// SetKind sets the "kind" field.
+func (ilc *InvoiceLineCreate) SetKind(i invoiceline.Kind) *InvoiceLineCreate {
+	ilc.mutation.SetKind(i)
+	return ilc
+}
+
+// SetDescription sets the "description" field.
+func (ilc *InvoiceLineCreate) SetDescription(s string) *InvoiceLineCreate {
+	ilc.mutation.SetDescription(s)
+	return ilc
+}
+
+// SetAmount sets the "amount" field.
+func (ilc *InvoiceLineCreate) SetAmount(f float64) *InvoiceLineCreate {
+	ilc.mutation.SetAmount(f)
+	return ilc
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (ilc *InvoiceLineCreate) SetNetAmount(f float64) *InvoiceLineCreate {
+	ilc.mutation.SetNetAmount(f)
+	return ilc
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (ilc *InvoiceLineCreate) SetNillableNetAmount(f *float64) *InvoiceLineCreate {
+	if f != nil {
+		ilc.SetNetAmount(*f)
+	}
+	return ilc
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (ilc *InvoiceLineCreate) SetTaxAmount(f float64) *InvoiceLineCreate {
+	ilc.mutation.SetTaxAmount(f)
+	return ilc
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (ilc *InvoiceLineCreate) SetNillableTaxAmount(f *float64) *InvoiceLineCreate {
+	if f != nil {
+		ilc.SetTaxAmount(*f)
+	}
+	return ilc
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (ilc *InvoiceLineCreate) SetTaxRate(f float64) *InvoiceLineCreate {
+	ilc.mutation.SetTaxRate(f)
+	return ilc
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (ilc *InvoiceLineCreate) SetNillableTaxRate(f *float64) *InvoiceLineCreate {
+	if f != nil {
+		ilc.SetTaxRate(*f)
+	}
+	return ilc
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (ilc *InvoiceLineCreate) Mutation() *InvoiceLineMutation {
+	return ilc.mutation
+}
+
+// Save creates the InvoiceLine in the database.
+func (ilc *InvoiceLineCreate) Save(ctx context.Context) (*InvoiceLine, error) {
+	ilc.defaults()
+	return withHooks(ctx, ilc.sqlSave, ilc.mutation, ilc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ilc *InvoiceLineCreate) SaveX(ctx context.Context) *InvoiceLine {
+	v, err := ilc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ilc *InvoiceLineCreate) Exec(ctx context.Context) error {
+	_, err := ilc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilc *InvoiceLineCreate) ExecX(ctx context.Context) {
+	if err := ilc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ilc *InvoiceLineCreate) defaults() {
+	if _, ok := ilc.mutation.NetAmount(); !ok {
+		v := invoiceline.DefaultNetAmount
+		ilc.mutation.SetNetAmount(v)
+	}
+	if _, ok := ilc.mutation.TaxAmount(); !ok {
+		v := invoiceline.DefaultTaxAmount
+		ilc.mutation.SetTaxAmount(v)
+	}
+	if _, ok := ilc.mutation.TaxRate(); !ok {
+		v := invoiceline.DefaultTaxRate
+		ilc.mutation.SetTaxRate(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ilc *InvoiceLineCreate) check() error {
+	if _, ok := ilc.mutation.InvoiceID(); !ok {
+		return &ValidationError{Name: "invoice_id", err: errors.New(`ent: missing required field "InvoiceLine.invoice_id"`)}
+	}
+	if v, ok := ilc.mutation.InvoiceID(); ok {
+		if err := invoiceline.InvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_id", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.invoice_id": %w`, err)}
+		}
+	}
+	if _, ok := ilc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "InvoiceLine.kind"`)}
+	}
+	if v, ok := ilc.mutation.Kind(); ok {
+		if err := invoiceline.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.kind": %w`, err)}
+		}
+	}
+	if _, ok := ilc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "InvoiceLine.description"`)}
+	}
+	if v, ok := ilc.mutation.Description(); ok {
+		if err := invoiceline.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.description": %w`, err)}
+		}
+	}
+	if _, ok := ilc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "InvoiceLine.amount"`)}
+	}
+	if _, ok := ilc.mutation.NetAmount(); !ok {
+		return &ValidationError{Name: "net_amount", err: errors.New(`ent: missing required field "InvoiceLine.net_amount"`)}
+	}
+	if _, ok := ilc.mutation.TaxAmount(); !ok {
+		return &ValidationError{Name: "tax_amount", err: errors.New(`ent: missing required field "InvoiceLine.tax_amount"`)}
+	}
+	if _, ok := ilc.mutation.TaxRate(); !ok {
+		return &ValidationError{Name: "tax_rate", err: errors.New(`ent: missing required field "InvoiceLine.tax_rate"`)}
+	}
+	return nil
+}
+
+func (ilc *InvoiceLineCreate) sqlSave(ctx context.Context) (*InvoiceLine, error) {
+	if err := ilc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ilc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ilc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ilc.mutation.id = &_node.ID
+	ilc.mutation.done = true
+	return _node, nil
+}
+
+func (ilc *InvoiceLineCreate) createSpec() (*InvoiceLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InvoiceLine{config: ilc.config}
+		_spec = sqlgraph.NewCreateSpec(invoiceline.Table, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
+	)
+	if value, ok := ilc.mutation.InvoiceID(); ok {
+		_spec.SetField(invoiceline.FieldInvoiceID, field.TypeInt, value)
+		_node.InvoiceID = value
+	}
+	if value, ok := ilc.mutation.Kind(); ok {
+		_spec.SetField(invoiceline.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := ilc.mutation.Description(); ok {
+		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := ilc.mutation.Amount(); ok {
+		_spec.SetField(invoiceline.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := ilc.mutation.NetAmount(); ok {
+		_spec.SetField(invoiceline.FieldNetAmount, field.TypeFloat64, value)
+		_node.NetAmount = value
+	}
+	if value, ok := ilc.mutation.TaxAmount(); ok {
+		_spec.SetField(invoiceline.FieldTaxAmount, field.TypeFloat64, value)
+		_node.TaxAmount = value
+	}
+	if value, ok := ilc.mutation.TaxRate(); ok {
+		_spec.SetField(invoiceline.FieldTaxRate, field.TypeFloat64, value)
+		_node.TaxRate = value
+	}
+	return _node, _spec
+}
+
+// InvoiceLineCreateBulk is the builder for creating many InvoiceLine entities in bulk.
+type InvoiceLineCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceLineCreate
+}
+
+// Save creates the InvoiceLine entities in the database.
+func (ilcb *InvoiceLineCreateBulk) Save(ctx context.Context) ([]*InvoiceLine, error) {
+	if ilcb.err != nil {
+		return nil, ilcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ilcb.builders))
+	nodes := make([]*InvoiceLine, len(ilcb.builders))
+	mutators := make([]Mutator, len(ilcb.builders))
+	for i := range ilcb.builders {
+		func(i int, root context.Context) {
+			builder := ilcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ilcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ilcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ilcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ilcb *InvoiceLineCreateBulk) SaveX(ctx context.Context) []*InvoiceLine {
+	v, err := ilcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ilcb *InvoiceLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := ilcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilcb *InvoiceLineCreateBulk) ExecX(ctx context.Context) {
+	if err := ilcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// InvoiceLineDelete is the builder for deleting a InvoiceLine entity.
+type InvoiceLineDelete struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceLineMutation
+}
+
+// Where appends a list predicates to the InvoiceLineDelete builder.
+func (ild *InvoiceLineDelete) Where(ps ...predicate.InvoiceLine) *InvoiceLineDelete {
+	ild.mutation.Where(ps...)
+	return ild
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ild *InvoiceLineDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ild.sqlExec, ild.mutation, ild.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ild *InvoiceLineDelete) ExecX(ctx context.Context) int {
+	n, err := ild.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ild *InvoiceLineDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(invoiceline.Table, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
+	if ps := ild.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ild.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ild.mutation.done = true
+	return affected, err
+}
+
+// InvoiceLineDeleteOne is the builder for deleting a single InvoiceLine entity.
+type InvoiceLineDeleteOne struct {
+	ild *InvoiceLineDelete
+}
+
+// Where appends a list predicates to the InvoiceLineDelete builder.
+func (ildo *InvoiceLineDeleteOne) Where(ps ...predicate.InvoiceLine) *InvoiceLineDeleteOne {
+	ildo.ild.mutation.Where(ps...)
+	return ildo
+}
+
+// Exec executes the deletion query.
+func (ildo *InvoiceLineDeleteOne) Exec(ctx context.Context) error {
+	n, err := ildo.ild.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{invoiceline.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ildo *InvoiceLineDeleteOne) ExecX(ctx context.Context) {
+	if err := ildo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
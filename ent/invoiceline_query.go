@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// InvoiceLineQuery is the builder for querying InvoiceLine entities.
+type InvoiceLineQuery struct {
+	config
+	ctx        *QueryContext
+	order      []invoiceline.OrderOption
+	inters     []Interceptor
+	predicates []predicate.InvoiceLine
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the InvoiceLineQuery builder.
+func (ilq *InvoiceLineQuery) Where(ps ...predicate.InvoiceLine) *InvoiceLineQuery {
+	ilq.predicates = append(ilq.predicates, ps...)
+	return ilq
+}
+
+// Limit the number of records to be returned by this query.
+func (ilq *InvoiceLineQuery) Limit(limit int) *InvoiceLineQuery {
+	ilq.ctx.Limit = &limit
+	return ilq
+}
+
+// Offset to start from.
+func (ilq *InvoiceLineQuery) Offset(offset int) *InvoiceLineQuery {
+	ilq.ctx.Offset = &offset
+	return ilq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ilq *InvoiceLineQuery) Unique(unique bool) *InvoiceLineQuery {
+	ilq.ctx.Unique = &unique
+	return ilq
+}
+
+// Order specifies how the records should be ordered.
+func (ilq *InvoiceLineQuery) Order(o ...invoiceline.OrderOption) *InvoiceLineQuery {
+	ilq.order = append(ilq.order, o...)
+	return ilq
+}
+
+// First returns the first InvoiceLine entity from the query.
+// Returns a *NotFoundError when no InvoiceLine was found.
+func (ilq *InvoiceLineQuery) First(ctx context.Context) (*InvoiceLine, error) {
+	nodes, err := ilq.Limit(1).All(setContextOp(ctx, ilq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{invoiceline.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) FirstX(ctx context.Context) *InvoiceLine {
+	node, err := ilq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first InvoiceLine ID from the query.
+// Returns a *NotFoundError when no InvoiceLine ID was found.
+func (ilq *InvoiceLineQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ilq.Limit(1).IDs(setContextOp(ctx, ilq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoiceline.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) FirstIDX(ctx context.Context) int {
+	id, err := ilq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single InvoiceLine entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one InvoiceLine entity is found.
+// Returns a *NotFoundError when no InvoiceLine entities are found.
+func (ilq *InvoiceLineQuery) Only(ctx context.Context) (*InvoiceLine, error) {
+	nodes, err := ilq.Limit(2).All(setContextOp(ctx, ilq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{invoiceline.Label}
+	default:
+		return nil, &NotSingularError{invoiceline.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) OnlyX(ctx context.Context) *InvoiceLine {
+	node, err := ilq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only InvoiceLine ID in the query.
+// Returns a *NotSingularError when more than one InvoiceLine ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ilq *InvoiceLineQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ilq.Limit(2).IDs(setContextOp(ctx, ilq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoiceline.Label}
+	default:
+		err = &NotSingularError{invoiceline.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ilq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of InvoiceLines.
+func (ilq *InvoiceLineQuery) All(ctx context.Context) ([]*InvoiceLine, error) {
+	ctx = setContextOp(ctx, ilq.ctx, ent.OpQueryAll)
+	if err := ilq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*InvoiceLine, *InvoiceLineQuery]()
+	return withInterceptors[[]*InvoiceLine](ctx, ilq, qr, ilq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) AllX(ctx context.Context) []*InvoiceLine {
+	nodes, err := ilq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of InvoiceLine IDs.
+func (ilq *InvoiceLineQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ilq.ctx.Unique == nil && ilq.path != nil {
+		ilq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ilq.ctx, ent.OpQueryIDs)
+	if err = ilq.Select(invoiceline.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) IDsX(ctx context.Context) []int {
+	ids, err := ilq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ilq *InvoiceLineQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ilq.ctx, ent.OpQueryCount)
+	if err := ilq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ilq, querierCount[*InvoiceLineQuery](), ilq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) CountX(ctx context.Context) int {
+	count, err := ilq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ilq *InvoiceLineQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ilq.ctx, ent.OpQueryExist)
+	switch _, err := ilq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ilq *InvoiceLineQuery) ExistX(ctx context.Context) bool {
+	exist, err := ilq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the InvoiceLineQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ilq *InvoiceLineQuery) Clone() *InvoiceLineQuery {
+	if ilq == nil {
+		return nil
+	}
+	return &InvoiceLineQuery{
+		config:     ilq.config,
+		ctx:        ilq.ctx.Clone(),
+		order:      append([]invoiceline.OrderOption{}, ilq.order...),
+		inters:     append([]Interceptor{}, ilq.inters...),
+		predicates: append([]predicate.InvoiceLine{}, ilq.predicates...),
+		// clone intermediate query.
+		sql:  ilq.sql.Clone(),
+		path: ilq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID int `json:"invoice_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.InvoiceLine.Query().
+//		GroupBy(invoiceline.FieldInvoiceID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ilq *InvoiceLineQuery) GroupBy(field string, fields ...string) *InvoiceLineGroupBy {
+	ilq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &InvoiceLineGroupBy{build: ilq}
+	grbuild.flds = &ilq.ctx.Fields
+	grbuild.label = invoiceline.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		InvoiceID int `json:"invoice_id,omitempty"`
+//	}
+//
+//	client.InvoiceLine.Query().
+//		Select(invoiceline.FieldInvoiceID).
+//		Scan(ctx, &v)
+func (ilq *InvoiceLineQuery) Select(fields ...string) *InvoiceLineSelect {
+	ilq.ctx.Fields = append(ilq.ctx.Fields, fields...)
+	sbuild := &InvoiceLineSelect{InvoiceLineQuery: ilq}
+	sbuild.label = invoiceline.Label
+	sbuild.flds, sbuild.scan = &ilq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a InvoiceLineSelect configured with the given aggregations.
+func (ilq *InvoiceLineQuery) Aggregate(fns ...AggregateFunc) *InvoiceLineSelect {
+	return ilq.Select().Aggregate(fns...)
+}
+
+func (ilq *InvoiceLineQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ilq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ilq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ilq.ctx.Fields {
+		if !invoiceline.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ilq.path != nil {
+		prev, err := ilq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ilq.sql = prev
+	}
+	return nil
+}
+
+func (ilq *InvoiceLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*InvoiceLine, error) {
+	var (
+		nodes = []*InvoiceLine{}
+		_spec = ilq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*InvoiceLine).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &InvoiceLine{config: ilq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ilq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ilq *InvoiceLineQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ilq.querySpec()
+	_spec.Node.Columns = ilq.ctx.Fields
+	if len(ilq.ctx.Fields) > 0 {
+		_spec.Unique = ilq.ctx.Unique != nil && *ilq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ilq.driver, _spec)
+}
+
+func (ilq *InvoiceLineQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(invoiceline.Table, invoiceline.Columns, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
+	_spec.From = ilq.sql
+	if unique := ilq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ilq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ilq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoiceline.FieldID)
+		for i := range fields {
+			if fields[i] != invoiceline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ilq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ilq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ilq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ilq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ilq *InvoiceLineQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ilq.driver.Dialect())
+	t1 := builder.Table(invoiceline.Table)
+	columns := ilq.ctx.Fields
+	if len(columns) == 0 {
+		columns = invoiceline.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ilq.sql != nil {
+		selector = ilq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ilq.ctx.Unique != nil && *ilq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ilq.predicates {
+		p(selector)
+	}
+	for _, p := range ilq.order {
+		p(selector)
+	}
+	if offset := ilq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ilq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// InvoiceLineGroupBy is the group-by builder for InvoiceLine entities.
+type InvoiceLineGroupBy struct {
+	selector
+	build *InvoiceLineQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ilgb *InvoiceLineGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceLineGroupBy {
+	ilgb.fns = append(ilgb.fns, fns...)
+	return ilgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ilgb *InvoiceLineGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ilgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ilgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvoiceLineQuery, *InvoiceLineGroupBy](ctx, ilgb.build, ilgb, ilgb.build.inters, v)
+}
+
+func (ilgb *InvoiceLineGroupBy) sqlScan(ctx context.Context, root *InvoiceLineQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ilgb.fns))
+	for _, fn := range ilgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ilgb.flds)+len(ilgb.fns))
+		for _, f := range *ilgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ilgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ilgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// InvoiceLineSelect is the builder for selecting fields of InvoiceLine entities.
+type InvoiceLineSelect struct {
+	*InvoiceLineQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ils *InvoiceLineSelect) Aggregate(fns ...AggregateFunc) *InvoiceLineSelect {
+	ils.fns = append(ils.fns, fns...)
+	return ils
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ils *InvoiceLineSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ils.ctx, ent.OpQuerySelect)
+	if err := ils.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*InvoiceLineQuery, *InvoiceLineSelect](ctx, ils.InvoiceLineQuery, ils, ils.inters, v)
+}
+
+func (ils *InvoiceLineSelect) sqlScan(ctx context.Context, root *InvoiceLineQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ils.fns))
+	for _, fn := range ils.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ils.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ils.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
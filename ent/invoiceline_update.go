@@ -0,0 +1,559 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// InvoiceLineUpdate is the builder for updating InvoiceLine entities.
+type InvoiceLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceLineMutation
+}
+
+// Where appends a list predicates to the InvoiceLineUpdate builder.
+func (ilu *InvoiceLineUpdate) Where(ps ...predicate.InvoiceLine) *InvoiceLineUpdate {
+	ilu.mutation.Where(ps...)
+	return ilu
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (ilu *InvoiceLineUpdate) SetInvoiceID(i int) *InvoiceLineUpdate {
+	ilu.mutation.ResetInvoiceID()
+	ilu.mutation.SetInvoiceID(i)
+	return ilu
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (ilu *InvoiceLineUpdate) SetNillableInvoiceID(i *int) *InvoiceLineUpdate {
+	if i != nil {
+		ilu.SetInvoiceID(*i)
+	}
+	return ilu
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (ilu *InvoiceLineUpdate) AddInvoiceID(i int) *InvoiceLineUpdate {
+	ilu.mutation.AddInvoiceID(i)
+	return ilu
+}
+
+// SetKind sets the "kind" field.
+func (ilu *InvoiceLineUpdate) SetKind(i invoiceline.Kind) *InvoiceLineUpdate {
+	ilu.mutation.SetKind(i)
+	return ilu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (ilu *InvoiceLineUpdate) SetNillableKind(i *invoiceline.Kind) *InvoiceLineUpdate {
+	if i != nil {
+		ilu.SetKind(*i)
+	}
+	return ilu
+}
+
+// SetDescription sets the "description" field.
+func (ilu *InvoiceLineUpdate) SetDescription(s string) *InvoiceLineUpdate {
+	ilu.mutation.SetDescription(s)
+	return ilu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (ilu *InvoiceLineUpdate) SetNillableDescription(s *string) *InvoiceLineUpdate {
+	if s != nil {
+		ilu.SetDescription(*s)
+	}
+	return ilu
+}
+
+// SetAmount sets the "amount" field.
+func (ilu *InvoiceLineUpdate) SetAmount(f float64) *InvoiceLineUpdate {
+	ilu.mutation.ResetAmount()
+	ilu.mutation.SetAmount(f)
+	return ilu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ilu *InvoiceLineUpdate) SetNillableAmount(f *float64) *InvoiceLineUpdate {
+	if f != nil {
+		ilu.SetAmount(*f)
+	}
+	return ilu
+}
+
+// AddAmount adds f to the "amount" field.
+func (ilu *InvoiceLineUpdate) AddAmount(f float64) *InvoiceLineUpdate {
+	ilu.mutation.AddAmount(f)
+	return ilu
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (ilu *InvoiceLineUpdate) SetNetAmount(f float64) *InvoiceLineUpdate {
+	ilu.mutation.ResetNetAmount()
+	ilu.mutation.SetNetAmount(f)
+	return ilu
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (ilu *InvoiceLineUpdate) SetNillableNetAmount(f *float64) *InvoiceLineUpdate {
+	if f != nil {
+		ilu.SetNetAmount(*f)
+	}
+	return ilu
+}
+
+// AddNetAmount adds f to the "net_amount" field.
+func (ilu *InvoiceLineUpdate) AddNetAmount(f float64) *InvoiceLineUpdate {
+	ilu.mutation.AddNetAmount(f)
+	return ilu
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (ilu *InvoiceLineUpdate) SetTaxAmount(f float64) *InvoiceLineUpdate {
+	ilu.mutation.ResetTaxAmount()
+	ilu.mutation.SetTaxAmount(f)
+	return ilu
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (ilu *InvoiceLineUpdate) SetNillableTaxAmount(f *float64) *InvoiceLineUpdate {
+	if f != nil {
+		ilu.SetTaxAmount(*f)
+	}
+	return ilu
+}
+
+// AddTaxAmount adds f to the "tax_amount" field.
+func (ilu *InvoiceLineUpdate) AddTaxAmount(f float64) *InvoiceLineUpdate {
+	ilu.mutation.AddTaxAmount(f)
+	return ilu
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (ilu *InvoiceLineUpdate) SetTaxRate(f float64) *InvoiceLineUpdate {
+	ilu.mutation.ResetTaxRate()
+	ilu.mutation.SetTaxRate(f)
+	return ilu
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (ilu *InvoiceLineUpdate) SetNillableTaxRate(f *float64) *InvoiceLineUpdate {
+	if f != nil {
+		ilu.SetTaxRate(*f)
+	}
+	return ilu
+}
+
+// AddTaxRate adds f to the "tax_rate" field.
+func (ilu *InvoiceLineUpdate) AddTaxRate(f float64) *InvoiceLineUpdate {
+	ilu.mutation.AddTaxRate(f)
+	return ilu
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (ilu *InvoiceLineUpdate) Mutation() *InvoiceLineMutation {
+	return ilu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ilu *InvoiceLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ilu.sqlSave, ilu.mutation, ilu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ilu *InvoiceLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := ilu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ilu *InvoiceLineUpdate) Exec(ctx context.Context) error {
+	_, err := ilu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilu *InvoiceLineUpdate) ExecX(ctx context.Context) {
+	if err := ilu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ilu *InvoiceLineUpdate) check() error {
+	if v, ok := ilu.mutation.InvoiceID(); ok {
+		if err := invoiceline.InvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_id", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.invoice_id": %w`, err)}
+		}
+	}
+	if v, ok := ilu.mutation.Kind(); ok {
+		if err := invoiceline.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.kind": %w`, err)}
+		}
+	}
+	if v, ok := ilu.mutation.Description(); ok {
+		if err := invoiceline.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ilu *InvoiceLineUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ilu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoiceline.Table, invoiceline.Columns, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
+	if ps := ilu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ilu.mutation.InvoiceID(); ok {
+		_spec.SetField(invoiceline.FieldInvoiceID, field.TypeInt, value)
+	}
+	if value, ok := ilu.mutation.AddedInvoiceID(); ok {
+		_spec.AddField(invoiceline.FieldInvoiceID, field.TypeInt, value)
+	}
+	if value, ok := ilu.mutation.Kind(); ok {
+		_spec.SetField(invoiceline.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := ilu.mutation.Description(); ok {
+		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := ilu.mutation.Amount(); ok {
+		_spec.SetField(invoiceline.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ilu.mutation.AddedAmount(); ok {
+		_spec.AddField(invoiceline.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ilu.mutation.NetAmount(); ok {
+		_spec.SetField(invoiceline.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ilu.mutation.AddedNetAmount(); ok {
+		_spec.AddField(invoiceline.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ilu.mutation.TaxAmount(); ok {
+		_spec.SetField(invoiceline.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ilu.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(invoiceline.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := ilu.mutation.TaxRate(); ok {
+		_spec.SetField(invoiceline.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := ilu.mutation.AddedTaxRate(); ok {
+		_spec.AddField(invoiceline.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ilu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ilu.mutation.done = true
+	return n, nil
+}
+
+// InvoiceLineUpdateOne is the builder for updating a single InvoiceLine entity.
+type InvoiceLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceLineMutation
+}
+
+// SetInvoiceID sets the "invoice_id" field.
+func (iluo *InvoiceLineUpdateOne) SetInvoiceID(i int) *InvoiceLineUpdateOne {
+	iluo.mutation.ResetInvoiceID()
+	iluo.mutation.SetInvoiceID(i)
+	return iluo
+}
+
+// SetNillableInvoiceID sets the "invoice_id" field if the given value is not nil.
+func (iluo *InvoiceLineUpdateOne) SetNillableInvoiceID(i *int) *InvoiceLineUpdateOne {
+	if i != nil {
+		iluo.SetInvoiceID(*i)
+	}
+	return iluo
+}
+
+// AddInvoiceID adds i to the "invoice_id" field.
+func (iluo *InvoiceLineUpdateOne) AddInvoiceID(i int) *InvoiceLineUpdateOne {
+	iluo.mutation.AddInvoiceID(i)
+	return iluo
+}
+
+// SetKind sets the "kind" field.
+func (iluo *InvoiceLineUpdateOne) SetKind(i invoiceline.Kind) *InvoiceLineUpdateOne {
+	iluo.mutation.SetKind(i)
+	return iluo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (iluo *InvoiceLineUpdateOne) SetNillableKind(i *invoiceline.Kind) *InvoiceLineUpdateOne {
+	if i != nil {
+		iluo.SetKind(*i)
+	}
+	return iluo
+}
+
+// SetDescription sets the "description" field.
+func (iluo *InvoiceLineUpdateOne) SetDescription(s string) *InvoiceLineUpdateOne {
+	iluo.mutation.SetDescription(s)
+	return iluo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (iluo *InvoiceLineUpdateOne) SetNillableDescription(s *string) *InvoiceLineUpdateOne {
+	if s != nil {
+		iluo.SetDescription(*s)
+	}
+	return iluo
+}
+
+// SetAmount sets the "amount" field.
+func (iluo *InvoiceLineUpdateOne) SetAmount(f float64) *InvoiceLineUpdateOne {
+	iluo.mutation.ResetAmount()
+	iluo.mutation.SetAmount(f)
+	return iluo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (iluo *InvoiceLineUpdateOne) SetNillableAmount(f *float64) *InvoiceLineUpdateOne {
+	if f != nil {
+		iluo.SetAmount(*f)
+	}
+	return iluo
+}
+
+// AddAmount adds f to the "amount" field.
+func (iluo *InvoiceLineUpdateOne) AddAmount(f float64) *InvoiceLineUpdateOne {
+	iluo.mutation.AddAmount(f)
+	return iluo
+}
+
+// SetNetAmount sets the "net_amount" field.
+func (iluo *InvoiceLineUpdateOne) SetNetAmount(f float64) *InvoiceLineUpdateOne {
+	iluo.mutation.ResetNetAmount()
+	iluo.mutation.SetNetAmount(f)
+	return iluo
+}
+
+// SetNillableNetAmount sets the "net_amount" field if the given value is not nil.
+func (iluo *InvoiceLineUpdateOne) SetNillableNetAmount(f *float64) *InvoiceLineUpdateOne {
+	if f != nil {
+		iluo.SetNetAmount(*f)
+	}
+	return iluo
+}
+
+// AddNetAmount adds f to the "net_amount" field.
+func (iluo *InvoiceLineUpdateOne) AddNetAmount(f float64) *InvoiceLineUpdateOne {
+	iluo.mutation.AddNetAmount(f)
+	return iluo
+}
+
+// SetTaxAmount sets the "tax_amount" field.
+func (iluo *InvoiceLineUpdateOne) SetTaxAmount(f float64) *InvoiceLineUpdateOne {
+	iluo.mutation.ResetTaxAmount()
+	iluo.mutation.SetTaxAmount(f)
+	return iluo
+}
+
+// SetNillableTaxAmount sets the "tax_amount" field if the given value is not nil.
+func (iluo *InvoiceLineUpdateOne) SetNillableTaxAmount(f *float64) *InvoiceLineUpdateOne {
+	if f != nil {
+		iluo.SetTaxAmount(*f)
+	}
+	return iluo
+}
+
+// AddTaxAmount adds f to the "tax_amount" field.
+func (iluo *InvoiceLineUpdateOne) AddTaxAmount(f float64) *InvoiceLineUpdateOne {
+	iluo.mutation.AddTaxAmount(f)
+	return iluo
+}
+
+// SetTaxRate sets the "tax_rate" field.
+func (iluo *InvoiceLineUpdateOne) SetTaxRate(f float64) *InvoiceLineUpdateOne {
+	iluo.mutation.ResetTaxRate()
+	iluo.mutation.SetTaxRate(f)
+	return iluo
+}
+
+// SetNillableTaxRate sets the "tax_rate" field if the given value is not nil.
+func (iluo *InvoiceLineUpdateOne) SetNillableTaxRate(f *float64) *InvoiceLineUpdateOne {
+	if f != nil {
+		iluo.SetTaxRate(*f)
+	}
+	return iluo
+}
+
+// AddTaxRate adds f to the "tax_rate" field.
+func (iluo *InvoiceLineUpdateOne) AddTaxRate(f float64) *InvoiceLineUpdateOne {
+	iluo.mutation.AddTaxRate(f)
+	return iluo
+}
+
+// Mutation returns the InvoiceLineMutation object of the builder.
+func (iluo *InvoiceLineUpdateOne) Mutation() *InvoiceLineMutation {
+	return iluo.mutation
+}
+
+// Where appends a list predicates to the InvoiceLineUpdate builder.
+func (iluo *InvoiceLineUpdateOne) Where(ps ...predicate.InvoiceLine) *InvoiceLineUpdateOne {
+	iluo.mutation.Where(ps...)
+	return iluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iluo *InvoiceLineUpdateOne) Select(field string, fields ...string) *InvoiceLineUpdateOne {
+	iluo.fields = append([]string{field}, fields...)
+	return iluo
+}
+
+// Save executes the query and returns the updated InvoiceLine entity.
+func (iluo *InvoiceLineUpdateOne) Save(ctx context.Context) (*InvoiceLine, error) {
+	return withHooks(ctx, iluo.sqlSave, iluo.mutation, iluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iluo *InvoiceLineUpdateOne) SaveX(ctx context.Context) *InvoiceLine {
+	node, err := iluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iluo *InvoiceLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := iluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iluo *InvoiceLineUpdateOne) ExecX(ctx context.Context) {
+	if err := iluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iluo *InvoiceLineUpdateOne) check() error {
+	if v, ok := iluo.mutation.InvoiceID(); ok {
+		if err := invoiceline.InvoiceIDValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_id", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.invoice_id": %w`, err)}
+		}
+	}
+	if v, ok := iluo.mutation.Kind(); ok {
+		if err := invoiceline.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.kind": %w`, err)}
+		}
+	}
+	if v, ok := iluo.mutation.Description(); ok {
+		if err := invoiceline.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "InvoiceLine.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iluo *InvoiceLineUpdateOne) sqlSave(ctx context.Context) (_node *InvoiceLine, err error) {
+	if err := iluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoiceline.Table, invoiceline.Columns, sqlgraph.NewFieldSpec(invoiceline.FieldID, field.TypeInt))
+	id, ok := iluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InvoiceLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoiceline.FieldID)
+		for _, f := range fields {
+			if !invoiceline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoiceline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iluo.mutation.InvoiceID(); ok {
+		_spec.SetField(invoiceline.FieldInvoiceID, field.TypeInt, value)
+	}
+	if value, ok := iluo.mutation.AddedInvoiceID(); ok {
+		_spec.AddField(invoiceline.FieldInvoiceID, field.TypeInt, value)
+	}
+	if value, ok := iluo.mutation.Kind(); ok {
+		_spec.SetField(invoiceline.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := iluo.mutation.Description(); ok {
+		_spec.SetField(invoiceline.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := iluo.mutation.Amount(); ok {
+		_spec.SetField(invoiceline.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iluo.mutation.AddedAmount(); ok {
+		_spec.AddField(invoiceline.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iluo.mutation.NetAmount(); ok {
+		_spec.SetField(invoiceline.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iluo.mutation.AddedNetAmount(); ok {
+		_spec.AddField(invoiceline.FieldNetAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iluo.mutation.TaxAmount(); ok {
+		_spec.SetField(invoiceline.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iluo.mutation.AddedTaxAmount(); ok {
+		_spec.AddField(invoiceline.FieldTaxAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iluo.mutation.TaxRate(); ok {
+		_spec.SetField(invoiceline.FieldTaxRate, field.TypeFloat64, value)
+	}
+	if value, ok := iluo.mutation.AddedTaxRate(); ok {
+		_spec.AddField(invoiceline.FieldTaxRate, field.TypeFloat64, value)
+	}
+	_node = &InvoiceLine{config: iluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoiceline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iluo.mutation.done = true
+	return _node, nil
+}
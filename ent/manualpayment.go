@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+)
+
+// ManualPayment is the model entity for the ManualPayment schema.
+type ManualPayment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// transaction_ref of the pending RECHARGE created for the submission
+	TransactionRef string `json:"transaction_ref,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider manualpayment.Provider `json:"provider,omitempty"`
+	// SenderNumber holds the value of the "sender_number" field.
+	SenderNumber string `json:"sender_number,omitempty"`
+	// Transaction ID issued by the provider, stored in upper case
+	TrxID string `json:"trx_id,omitempty"`
+	// trx_id while the payment may still be credited. Cleared on rejection so the TrxID can be submitted again.
+	TrxKey *string `json:"trx_key,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// mismatched payments are on a statement with another amount or sender and wait for an operator
+	Status manualpayment.Status `json:"status,omitempty"`
+	// Operator who reviewed the payment, or statement when it was matched automatically
+	VerifiedBy string `json:"verified_by,omitempty"`
+	// ReviewNote holds the value of the "review_note" field.
+	ReviewNote string `json:"review_note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ManualPayment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case manualpayment.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case manualpayment.FieldID:
+			values[i] = new(sql.NullInt64)
+		case manualpayment.FieldTransactionRef, manualpayment.FieldClientUsername, manualpayment.FieldProvider, manualpayment.FieldSenderNumber, manualpayment.FieldTrxID, manualpayment.FieldTrxKey, manualpayment.FieldStatus, manualpayment.FieldVerifiedBy, manualpayment.FieldReviewNote:
+			values[i] = new(sql.NullString)
+		case manualpayment.FieldCreatedAt, manualpayment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ManualPayment fields.
+func (mp *ManualPayment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case manualpayment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mp.ID = int(value.Int64)
+		case manualpayment.FieldTransactionRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_ref", values[i])
+			} else if value.Valid {
+				mp.TransactionRef = value.String
+			}
+		case manualpayment.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				mp.ClientUsername = value.String
+			}
+		case manualpayment.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				mp.Provider = manualpayment.Provider(value.String)
+			}
+		case manualpayment.FieldSenderNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_number", values[i])
+			} else if value.Valid {
+				mp.SenderNumber = value.String
+			}
+		case manualpayment.FieldTrxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trx_id", values[i])
+			} else if value.Valid {
+				mp.TrxID = value.String
+			}
+		case manualpayment.FieldTrxKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trx_key", values[i])
+			} else if value.Valid {
+				mp.TrxKey = new(string)
+				*mp.TrxKey = value.String
+			}
+		case manualpayment.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				mp.Amount = value.Float64
+			}
+		case manualpayment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				mp.Status = manualpayment.Status(value.String)
+			}
+		case manualpayment.FieldVerifiedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_by", values[i])
+			} else if value.Valid {
+				mp.VerifiedBy = value.String
+			}
+		case manualpayment.FieldReviewNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_note", values[i])
+			} else if value.Valid {
+				mp.ReviewNote = value.String
+			}
+		case manualpayment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mp.CreatedAt = value.Time
+			}
+		case manualpayment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mp.UpdatedAt = value.Time
+			}
+		default:
+			mp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ManualPayment.
+// This includes values selected through modifiers, order, etc.
+func (mp *ManualPayment) Value(name string) (ent.Value, error) {
+	return mp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ManualPayment.
+// Note that you need to call ManualPayment.Unwrap() before calling this method if this ManualPayment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mp *ManualPayment) Update() *ManualPaymentUpdateOne {
+	return NewManualPaymentClient(mp.config).UpdateOne(mp)
+}
+
+// Unwrap unwraps the ManualPayment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mp *ManualPayment) Unwrap() *ManualPayment {
+	_tx, ok := mp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ManualPayment is not a transactional entity")
+	}
+	mp.config.driver = _tx.drv
+	return mp
+}
+
+// String implements the fmt.Stringer.
+func (mp *ManualPayment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ManualPayment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mp.ID))
+	builder.WriteString("transaction_ref=")
+	builder.WriteString(mp.TransactionRef)
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(mp.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(fmt.Sprintf("%v", mp.Provider))
+	builder.WriteString(", ")
+	builder.WriteString("sender_number=")
+	builder.WriteString(mp.SenderNumber)
+	builder.WriteString(", ")
+	builder.WriteString("trx_id=")
+	builder.WriteString(mp.TrxID)
+	builder.WriteString(", ")
+	if v := mp.TrxKey; v != nil {
+		builder.WriteString("trx_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", mp.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", mp.Status))
+	builder.WriteString(", ")
+	builder.WriteString("verified_by=")
+	builder.WriteString(mp.VerifiedBy)
+	builder.WriteString(", ")
+	builder.WriteString("review_note=")
+	builder.WriteString(mp.ReviewNote)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ManualPayments is a parsable slice of ManualPayment.
+type ManualPayments []*ManualPayment
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package manualpayment
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the manualpayment type in the database.
+	Label = "manual_payment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTransactionRef holds the string denoting the transaction_ref field in the database.
+	FieldTransactionRef = "transaction_ref"
+	// FieldClientUsername holds the string denoting the client_username field in the database.
+	FieldClientUsername = "client_username"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldSenderNumber holds the string denoting the sender_number field in the database.
+	FieldSenderNumber = "sender_number"
+	// FieldTrxID holds the string denoting the trx_id field in the database.
+	FieldTrxID = "trx_id"
+	// FieldTrxKey holds the string denoting the trx_key field in the database.
+	FieldTrxKey = "trx_key"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldVerifiedBy holds the string denoting the verified_by field in the database.
+	FieldVerifiedBy = "verified_by"
+	// FieldReviewNote holds the string denoting the review_note field in the database.
+	FieldReviewNote = "review_note"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the manualpayment in the database.
+	Table = "manual_payments"
+)
+
+// Columns holds all SQL columns for manualpayment fields.
+var Columns = []string{
+	FieldID,
+	FieldTransactionRef,
+	FieldClientUsername,
+	FieldProvider,
+	FieldSenderNumber,
+	FieldTrxID,
+	FieldTrxKey,
+	FieldAmount,
+	FieldStatus,
+	FieldVerifiedBy,
+	FieldReviewNote,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	TransactionRefValidator func(string) error
+	// ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	ClientUsernameValidator func(string) error
+	// SenderNumberValidator is a validator for the "sender_number" field. It is called by the builders before save.
+	SenderNumberValidator func(string) error
+	// TrxIDValidator is a validator for the "trx_id" field. It is called by the builders before save.
+	TrxIDValidator func(string) error
+	// TrxKeyValidator is a validator for the "trx_key" field. It is called by the builders before save.
+	TrxKeyValidator func(string) error
+	// VerifiedByValidator is a validator for the "verified_by" field. It is called by the builders before save.
+	VerifiedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Provider defines the type for the "provider" enum field.
+type Provider string
+
+// Provider values.
+const (
+	ProviderBkash Provider = "bkash"
+	ProviderNagad Provider = "nagad"
+)
+
+func (pr Provider) String() string {
+	return string(pr)
+}
+
+// ProviderValidator is a validator for the "provider" field enum values. It is called by the builders before save.
+func ProviderValidator(pr Provider) error {
+	switch pr {
+	case ProviderBkash, ProviderNagad:
+		return nil
+	default:
+		return fmt.Errorf("manualpayment: invalid enum value for provider field: %q", pr)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending    Status = "pending"
+	StatusMismatched Status = "mismatched"
+	StatusCompleted  Status = "completed"
+	StatusFailed     Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusMismatched, StatusCompleted, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("manualpayment: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ManualPayment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTransactionRef orders the results by the transaction_ref field.
+func ByTransactionRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionRef, opts...).ToFunc()
+}
+
+// ByClientUsername orders the results by the client_username field.
+func ByClientUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientUsername, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// BySenderNumber orders the results by the sender_number field.
+func BySenderNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderNumber, opts...).ToFunc()
+}
+
+// ByTrxID orders the results by the trx_id field.
+func ByTrxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrxID, opts...).ToFunc()
+}
+
+// ByTrxKey orders the results by the trx_key field.
+func ByTrxKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrxKey, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByVerifiedBy orders the results by the verified_by field.
+func ByVerifiedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedBy, opts...).ToFunc()
+}
+
+// ByReviewNote orders the results by the review_note field.
+func ByReviewNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNote, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
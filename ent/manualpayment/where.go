@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package manualpayment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldID, id))
+}
+
+// TransactionRef applies equality check predicate on the "transaction_ref" field. It's identical to TransactionRefEQ.
+func TransactionRef(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// ClientUsername applies equality check predicate on the "client_username" field. It's identical to ClientUsernameEQ.
+func ClientUsername(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// SenderNumber applies equality check predicate on the "sender_number" field. It's identical to SenderNumberEQ.
+func SenderNumber(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldSenderNumber, v))
+}
+
+// TrxID applies equality check predicate on the "trx_id" field. It's identical to TrxIDEQ.
+func TrxID(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldTrxID, v))
+}
+
+// TrxKey applies equality check predicate on the "trx_key" field. It's identical to TrxKeyEQ.
+func TrxKey(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldTrxKey, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldAmount, v))
+}
+
+// VerifiedBy applies equality check predicate on the "verified_by" field. It's identical to VerifiedByEQ.
+func VerifiedBy(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldVerifiedBy, v))
+}
+
+// ReviewNote applies equality check predicate on the "review_note" field. It's identical to ReviewNoteEQ.
+func ReviewNote(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TransactionRefEQ applies the EQ predicate on the "transaction_ref" field.
+func TransactionRefEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefNEQ applies the NEQ predicate on the "transaction_ref" field.
+func TransactionRefNEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefIn applies the In predicate on the "transaction_ref" field.
+func TransactionRefIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefNotIn applies the NotIn predicate on the "transaction_ref" field.
+func TransactionRefNotIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefGT applies the GT predicate on the "transaction_ref" field.
+func TransactionRefGT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldTransactionRef, v))
+}
+
+// TransactionRefGTE applies the GTE predicate on the "transaction_ref" field.
+func TransactionRefGTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldTransactionRef, v))
+}
+
+// TransactionRefLT applies the LT predicate on the "transaction_ref" field.
+func TransactionRefLT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldTransactionRef, v))
+}
+
+// TransactionRefLTE applies the LTE predicate on the "transaction_ref" field.
+func TransactionRefLTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldTransactionRef, v))
+}
+
+// TransactionRefContains applies the Contains predicate on the "transaction_ref" field.
+func TransactionRefContains(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContains(FieldTransactionRef, v))
+}
+
+// TransactionRefHasPrefix applies the HasPrefix predicate on the "transaction_ref" field.
+func TransactionRefHasPrefix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasPrefix(FieldTransactionRef, v))
+}
+
+// TransactionRefHasSuffix applies the HasSuffix predicate on the "transaction_ref" field.
+func TransactionRefHasSuffix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasSuffix(FieldTransactionRef, v))
+}
+
+// TransactionRefEqualFold applies the EqualFold predicate on the "transaction_ref" field.
+func TransactionRefEqualFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEqualFold(FieldTransactionRef, v))
+}
+
+// TransactionRefContainsFold applies the ContainsFold predicate on the "transaction_ref" field.
+func TransactionRefContainsFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContainsFold(FieldTransactionRef, v))
+}
+
+// ClientUsernameEQ applies the EQ predicate on the "client_username" field.
+func ClientUsernameEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameNEQ applies the NEQ predicate on the "client_username" field.
+func ClientUsernameNEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameIn applies the In predicate on the "client_username" field.
+func ClientUsernameIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameNotIn applies the NotIn predicate on the "client_username" field.
+func ClientUsernameNotIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameGT applies the GT predicate on the "client_username" field.
+func ClientUsernameGT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldClientUsername, v))
+}
+
+// ClientUsernameGTE applies the GTE predicate on the "client_username" field.
+func ClientUsernameGTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldClientUsername, v))
+}
+
+// ClientUsernameLT applies the LT predicate on the "client_username" field.
+func ClientUsernameLT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldClientUsername, v))
+}
+
+// ClientUsernameLTE applies the LTE predicate on the "client_username" field.
+func ClientUsernameLTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldClientUsername, v))
+}
+
+// ClientUsernameContains applies the Contains predicate on the "client_username" field.
+func ClientUsernameContains(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContains(FieldClientUsername, v))
+}
+
+// ClientUsernameHasPrefix applies the HasPrefix predicate on the "client_username" field.
+func ClientUsernameHasPrefix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasPrefix(FieldClientUsername, v))
+}
+
+// ClientUsernameHasSuffix applies the HasSuffix predicate on the "client_username" field.
+func ClientUsernameHasSuffix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasSuffix(FieldClientUsername, v))
+}
+
+// ClientUsernameEqualFold applies the EqualFold predicate on the "client_username" field.
+func ClientUsernameEqualFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEqualFold(FieldClientUsername, v))
+}
+
+// ClientUsernameContainsFold applies the ContainsFold predicate on the "client_username" field.
+func ClientUsernameContainsFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContainsFold(FieldClientUsername, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v Provider) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v Provider) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...Provider) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...Provider) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// SenderNumberEQ applies the EQ predicate on the "sender_number" field.
+func SenderNumberEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldSenderNumber, v))
+}
+
+// SenderNumberNEQ applies the NEQ predicate on the "sender_number" field.
+func SenderNumberNEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldSenderNumber, v))
+}
+
+// SenderNumberIn applies the In predicate on the "sender_number" field.
+func SenderNumberIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldSenderNumber, vs...))
+}
+
+// SenderNumberNotIn applies the NotIn predicate on the "sender_number" field.
+func SenderNumberNotIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldSenderNumber, vs...))
+}
+
+// SenderNumberGT applies the GT predicate on the "sender_number" field.
+func SenderNumberGT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldSenderNumber, v))
+}
+
+// SenderNumberGTE applies the GTE predicate on the "sender_number" field.
+func SenderNumberGTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldSenderNumber, v))
+}
+
+// SenderNumberLT applies the LT predicate on the "sender_number" field.
+func SenderNumberLT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldSenderNumber, v))
+}
+
+// SenderNumberLTE applies the LTE predicate on the "sender_number" field.
+func SenderNumberLTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldSenderNumber, v))
+}
+
+// SenderNumberContains applies the Contains predicate on the "sender_number" field.
+func SenderNumberContains(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContains(FieldSenderNumber, v))
+}
+
+// SenderNumberHasPrefix applies the HasPrefix predicate on the "sender_number" field.
+func SenderNumberHasPrefix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasPrefix(FieldSenderNumber, v))
+}
+
+// SenderNumberHasSuffix applies the HasSuffix predicate on the "sender_number" field.
+func SenderNumberHasSuffix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasSuffix(FieldSenderNumber, v))
+}
+
+// SenderNumberEqualFold applies the EqualFold predicate on the "sender_number" field.
+func SenderNumberEqualFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEqualFold(FieldSenderNumber, v))
+}
+
+// SenderNumberContainsFold applies the ContainsFold predicate on the "sender_number" field.
+func SenderNumberContainsFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContainsFold(FieldSenderNumber, v))
+}
+
+// TrxIDEQ applies the EQ predicate on the "trx_id" field.
+func TrxIDEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldTrxID, v))
+}
+
+// TrxIDNEQ applies the NEQ predicate on the "trx_id" field.
+func TrxIDNEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldTrxID, v))
+}
+
+// TrxIDIn applies the In predicate on the "trx_id" field.
+func TrxIDIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldTrxID, vs...))
+}
+
+// TrxIDNotIn applies the NotIn predicate on the "trx_id" field.
+func TrxIDNotIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldTrxID, vs...))
+}
+
+// TrxIDGT applies the GT predicate on the "trx_id" field.
+func TrxIDGT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldTrxID, v))
+}
+
+// TrxIDGTE applies the GTE predicate on the "trx_id" field.
+func TrxIDGTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldTrxID, v))
+}
+
+// TrxIDLT applies the LT predicate on the "trx_id" field.
+func TrxIDLT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldTrxID, v))
+}
+
+// TrxIDLTE applies the LTE predicate on the "trx_id" field.
+func TrxIDLTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldTrxID, v))
+}
+
+// TrxIDContains applies the Contains predicate on the "trx_id" field.
+func TrxIDContains(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContains(FieldTrxID, v))
+}
+
+// TrxIDHasPrefix applies the HasPrefix predicate on the "trx_id" field.
+func TrxIDHasPrefix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasPrefix(FieldTrxID, v))
+}
+
+// TrxIDHasSuffix applies the HasSuffix predicate on the "trx_id" field.
+func TrxIDHasSuffix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasSuffix(FieldTrxID, v))
+}
+
+// TrxIDEqualFold applies the EqualFold predicate on the "trx_id" field.
+func TrxIDEqualFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEqualFold(FieldTrxID, v))
+}
+
+// TrxIDContainsFold applies the ContainsFold predicate on the "trx_id" field.
+func TrxIDContainsFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContainsFold(FieldTrxID, v))
+}
+
+// TrxKeyEQ applies the EQ predicate on the "trx_key" field.
+func TrxKeyEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldTrxKey, v))
+}
+
+// TrxKeyNEQ applies the NEQ predicate on the "trx_key" field.
+func TrxKeyNEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldTrxKey, v))
+}
+
+// TrxKeyIn applies the In predicate on the "trx_key" field.
+func TrxKeyIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldTrxKey, vs...))
+}
+
+// TrxKeyNotIn applies the NotIn predicate on the "trx_key" field.
+func TrxKeyNotIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldTrxKey, vs...))
+}
+
+// TrxKeyGT applies the GT predicate on the "trx_key" field.
+func TrxKeyGT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldTrxKey, v))
+}
+
+// TrxKeyGTE applies the GTE predicate on the "trx_key" field.
+func TrxKeyGTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldTrxKey, v))
+}
+
+// TrxKeyLT applies the LT predicate on the "trx_key" field.
+func TrxKeyLT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldTrxKey, v))
+}
+
+// TrxKeyLTE applies the LTE predicate on the "trx_key" field.
+func TrxKeyLTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldTrxKey, v))
+}
+
+// TrxKeyContains applies the Contains predicate on the "trx_key" field.
+func TrxKeyContains(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContains(FieldTrxKey, v))
+}
+
+// TrxKeyHasPrefix applies the HasPrefix predicate on the "trx_key" field.
+func TrxKeyHasPrefix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasPrefix(FieldTrxKey, v))
+}
+
+// TrxKeyHasSuffix applies the HasSuffix predicate on the "trx_key" field.
+func TrxKeyHasSuffix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasSuffix(FieldTrxKey, v))
+}
+
+// TrxKeyIsNil applies the IsNil predicate on the "trx_key" field.
+func TrxKeyIsNil() predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIsNull(FieldTrxKey))
+}
+
+// TrxKeyNotNil applies the NotNil predicate on the "trx_key" field.
+func TrxKeyNotNil() predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotNull(FieldTrxKey))
+}
+
+// TrxKeyEqualFold applies the EqualFold predicate on the "trx_key" field.
+func TrxKeyEqualFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEqualFold(FieldTrxKey, v))
+}
+
+// TrxKeyContainsFold applies the ContainsFold predicate on the "trx_key" field.
+func TrxKeyContainsFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContainsFold(FieldTrxKey, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// VerifiedByEQ applies the EQ predicate on the "verified_by" field.
+func VerifiedByEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldVerifiedBy, v))
+}
+
+// VerifiedByNEQ applies the NEQ predicate on the "verified_by" field.
+func VerifiedByNEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldVerifiedBy, v))
+}
+
+// VerifiedByIn applies the In predicate on the "verified_by" field.
+func VerifiedByIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldVerifiedBy, vs...))
+}
+
+// VerifiedByNotIn applies the NotIn predicate on the "verified_by" field.
+func VerifiedByNotIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldVerifiedBy, vs...))
+}
+
+// VerifiedByGT applies the GT predicate on the "verified_by" field.
+func VerifiedByGT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldVerifiedBy, v))
+}
+
+// VerifiedByGTE applies the GTE predicate on the "verified_by" field.
+func VerifiedByGTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldVerifiedBy, v))
+}
+
+// VerifiedByLT applies the LT predicate on the "verified_by" field.
+func VerifiedByLT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldVerifiedBy, v))
+}
+
+// VerifiedByLTE applies the LTE predicate on the "verified_by" field.
+func VerifiedByLTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldVerifiedBy, v))
+}
+
+// VerifiedByContains applies the Contains predicate on the "verified_by" field.
+func VerifiedByContains(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContains(FieldVerifiedBy, v))
+}
+
+// VerifiedByHasPrefix applies the HasPrefix predicate on the "verified_by" field.
+func VerifiedByHasPrefix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasPrefix(FieldVerifiedBy, v))
+}
+
+// VerifiedByHasSuffix applies the HasSuffix predicate on the "verified_by" field.
+func VerifiedByHasSuffix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasSuffix(FieldVerifiedBy, v))
+}
+
+// VerifiedByIsNil applies the IsNil predicate on the "verified_by" field.
+func VerifiedByIsNil() predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIsNull(FieldVerifiedBy))
+}
+
+// VerifiedByNotNil applies the NotNil predicate on the "verified_by" field.
+func VerifiedByNotNil() predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotNull(FieldVerifiedBy))
+}
+
+// VerifiedByEqualFold applies the EqualFold predicate on the "verified_by" field.
+func VerifiedByEqualFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEqualFold(FieldVerifiedBy, v))
+}
+
+// VerifiedByContainsFold applies the ContainsFold predicate on the "verified_by" field.
+func VerifiedByContainsFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContainsFold(FieldVerifiedBy, v))
+}
+
+// ReviewNoteEQ applies the EQ predicate on the "review_note" field.
+func ReviewNoteEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteNEQ applies the NEQ predicate on the "review_note" field.
+func ReviewNoteNEQ(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteIn applies the In predicate on the "review_note" field.
+func ReviewNoteIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteNotIn applies the NotIn predicate on the "review_note" field.
+func ReviewNoteNotIn(vs ...string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteGT applies the GT predicate on the "review_note" field.
+func ReviewNoteGT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldReviewNote, v))
+}
+
+// ReviewNoteGTE applies the GTE predicate on the "review_note" field.
+func ReviewNoteGTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldReviewNote, v))
+}
+
+// ReviewNoteLT applies the LT predicate on the "review_note" field.
+func ReviewNoteLT(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldReviewNote, v))
+}
+
+// ReviewNoteLTE applies the LTE predicate on the "review_note" field.
+func ReviewNoteLTE(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldReviewNote, v))
+}
+
+// ReviewNoteContains applies the Contains predicate on the "review_note" field.
+func ReviewNoteContains(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContains(FieldReviewNote, v))
+}
+
+// ReviewNoteHasPrefix applies the HasPrefix predicate on the "review_note" field.
+func ReviewNoteHasPrefix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasPrefix(FieldReviewNote, v))
+}
+
+// ReviewNoteHasSuffix applies the HasSuffix predicate on the "review_note" field.
+func ReviewNoteHasSuffix(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldHasSuffix(FieldReviewNote, v))
+}
+
+// ReviewNoteIsNil applies the IsNil predicate on the "review_note" field.
+func ReviewNoteIsNil() predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIsNull(FieldReviewNote))
+}
+
+// ReviewNoteNotNil applies the NotNil predicate on the "review_note" field.
+func ReviewNoteNotNil() predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotNull(FieldReviewNote))
+}
+
+// ReviewNoteEqualFold applies the EqualFold predicate on the "review_note" field.
+func ReviewNoteEqualFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEqualFold(FieldReviewNote, v))
+}
+
+// ReviewNoteContainsFold applies the ContainsFold predicate on the "review_note" field.
+func ReviewNoteContainsFold(v string) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldContainsFold(FieldReviewNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ManualPayment) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ManualPayment) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ManualPayment) predicate.ManualPayment {
+	return predicate.ManualPayment(sql.NotPredicates(p))
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+)
+
+// ManualPaymentCreate is the builder for creating a ManualPayment entity.
+type ManualPaymentCreate struct {
+	config
+	mutation *ManualPaymentMutation
+	hooks    []Hook
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (mpc *ManualPaymentCreate) SetTransactionRef(s string) *ManualPaymentCreate {
+	mpc.mutation.SetTransactionRef(s)
+	return mpc
+}
+
+// SetClientUsername sets the "client_username" field.
+func (mpc *ManualPaymentCreate) SetClientUsername(s string) *ManualPaymentCreate {
+	mpc.mutation.SetClientUsername(s)
+	return mpc
+}
+
+// SetProvider sets the "provider" field.
+func (mpc *ManualPaymentCreate) SetProvider(m manualpayment.Provider) *ManualPaymentCreate {
+	mpc.mutation.SetProvider(m)
+	return mpc
+}
+
+// SetSenderNumber sets the "sender_number" field.
+func (mpc *ManualPaymentCreate) SetSenderNumber(s string) *ManualPaymentCreate {
+	mpc.mutation.SetSenderNumber(s)
+	return mpc
+}
+
+// SetTrxID sets the "trx_id" field.
+func (mpc *ManualPaymentCreate) SetTrxID(s string) *ManualPaymentCreate {
+	mpc.mutation.SetTrxID(s)
+	return mpc
+}
+
+// SetTrxKey sets the "trx_key" field.
+func (mpc *ManualPaymentCreate) SetTrxKey(s string) *ManualPaymentCreate {
+	mpc.mutation.SetTrxKey(s)
+	return mpc
+}
+
+// SetNillableTrxKey sets the "trx_key" field if the given value is not nil.
+func (mpc *ManualPaymentCreate) SetNillableTrxKey(s *string) *ManualPaymentCreate {
+	if s != nil {
+		mpc.SetTrxKey(*s)
+	}
+	return mpc
+}
+
+// SetAmount sets the "amount" field.
+func (mpc *ManualPaymentCreate) SetAmount(f float64) *ManualPaymentCreate {
+	mpc.mutation.SetAmount(f)
+	return mpc
+}
+
+// SetStatus sets the "status" field.
+func (mpc *ManualPaymentCreate) SetStatus(m manualpayment.Status) *ManualPaymentCreate {
+	mpc.mutation.SetStatus(m)
+	return mpc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mpc *ManualPaymentCreate) SetNillableStatus(m *manualpayment.Status) *ManualPaymentCreate {
+	if m != nil {
+		mpc.SetStatus(*m)
+	}
+	return mpc
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (mpc *ManualPaymentCreate) SetVerifiedBy(s string) *ManualPaymentCreate {
+	mpc.mutation.SetVerifiedBy(s)
+	return mpc
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (mpc *ManualPaymentCreate) SetNillableVerifiedBy(s *string) *ManualPaymentCreate {
+	if s != nil {
+		mpc.SetVerifiedBy(*s)
+	}
+	return mpc
+}
+
+// SetReviewNote sets the "review_note" field.
+func (mpc *ManualPaymentCreate) SetReviewNote(s string) *ManualPaymentCreate {
+	mpc.mutation.SetReviewNote(s)
+	return mpc
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (mpc *ManualPaymentCreate) SetNillableReviewNote(s *string) *ManualPaymentCreate {
+	if s != nil {
+		mpc.SetReviewNote(*s)
+	}
+	return mpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mpc *ManualPaymentCreate) SetCreatedAt(t time.Time) *ManualPaymentCreate {
+	mpc.mutation.SetCreatedAt(t)
+	return mpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mpc *ManualPaymentCreate) SetNillableCreatedAt(t *time.Time) *ManualPaymentCreate {
+	if t != nil {
+		mpc.SetCreatedAt(*t)
+	}
+	return mpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpc *ManualPaymentCreate) SetUpdatedAt(t time.Time) *ManualPaymentCreate {
+	mpc.mutation.SetUpdatedAt(t)
+	return mpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mpc *ManualPaymentCreate) SetNillableUpdatedAt(t *time.Time) *ManualPaymentCreate {
+	if t != nil {
+		mpc.SetUpdatedAt(*t)
+	}
+	return mpc
+}
+
+// Mutation returns the ManualPaymentMutation object of the builder.
+func (mpc *ManualPaymentCreate) Mutation() *ManualPaymentMutation {
+	return mpc.mutation
+}
+
+// Save creates the ManualPayment in the database.
+func (mpc *ManualPaymentCreate) Save(ctx context.Context) (*ManualPayment, error) {
+	mpc.defaults()
+	return withHooks(ctx, mpc.sqlSave, mpc.mutation, mpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mpc *ManualPaymentCreate) SaveX(ctx context.Context) *ManualPayment {
+	v, err := mpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpc *ManualPaymentCreate) Exec(ctx context.Context) error {
+	_, err := mpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpc *ManualPaymentCreate) ExecX(ctx context.Context) {
+	if err := mpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpc *ManualPaymentCreate) defaults() {
+	if _, ok := mpc.mutation.Status(); !ok {
+		v := manualpayment.DefaultStatus
+		mpc.mutation.SetStatus(v)
+	}
+	if _, ok := mpc.mutation.CreatedAt(); !ok {
+		v := manualpayment.DefaultCreatedAt()
+		mpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mpc.mutation.UpdatedAt(); !ok {
+		v := manualpayment.DefaultUpdatedAt()
+		mpc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpc *ManualPaymentCreate) check() error {
+	if _, ok := mpc.mutation.TransactionRef(); !ok {
+		return &ValidationError{Name: "transaction_ref", err: errors.New(`ent: missing required field "ManualPayment.transaction_ref"`)}
+	}
+	if v, ok := mpc.mutation.TransactionRef(); ok {
+		if err := manualpayment.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.transaction_ref": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.ClientUsername(); !ok {
+		return &ValidationError{Name: "client_username", err: errors.New(`ent: missing required field "ManualPayment.client_username"`)}
+	}
+	if v, ok := mpc.mutation.ClientUsername(); ok {
+		if err := manualpayment.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.client_username": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "ManualPayment.provider"`)}
+	}
+	if v, ok := mpc.mutation.Provider(); ok {
+		if err := manualpayment.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.provider": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.SenderNumber(); !ok {
+		return &ValidationError{Name: "sender_number", err: errors.New(`ent: missing required field "ManualPayment.sender_number"`)}
+	}
+	if v, ok := mpc.mutation.SenderNumber(); ok {
+		if err := manualpayment.SenderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "sender_number", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.sender_number": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.TrxID(); !ok {
+		return &ValidationError{Name: "trx_id", err: errors.New(`ent: missing required field "ManualPayment.trx_id"`)}
+	}
+	if v, ok := mpc.mutation.TrxID(); ok {
+		if err := manualpayment.TrxIDValidator(v); err != nil {
+			return &ValidationError{Name: "trx_id", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.trx_id": %w`, err)}
+		}
+	}
+	if v, ok := mpc.mutation.TrxKey(); ok {
+		if err := manualpayment.TrxKeyValidator(v); err != nil {
+			return &ValidationError{Name: "trx_key", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.trx_key": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "ManualPayment.amount"`)}
+	}
+	if _, ok := mpc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ManualPayment.status"`)}
+	}
+	if v, ok := mpc.mutation.Status(); ok {
+		if err := manualpayment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.status": %w`, err)}
+		}
+	}
+	if v, ok := mpc.mutation.VerifiedBy(); ok {
+		if err := manualpayment.VerifiedByValidator(v); err != nil {
+			return &ValidationError{Name: "verified_by", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.verified_by": %w`, err)}
+		}
+	}
+	if _, ok := mpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ManualPayment.created_at"`)}
+	}
+	if _, ok := mpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ManualPayment.updated_at"`)}
+	}
+	return nil
+}
+
+func (mpc *ManualPaymentCreate) sqlSave(ctx context.Context) (*ManualPayment, error) {
+	if err := mpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mpc.mutation.id = &_node.ID
+	mpc.mutation.done = true
+	return _node, nil
+}
+
+func (mpc *ManualPaymentCreate) createSpec() (*ManualPayment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ManualPayment{config: mpc.config}
+		_spec = sqlgraph.NewCreateSpec(manualpayment.Table, sqlgraph.NewFieldSpec(manualpayment.FieldID, field.TypeInt))
+	)
+	if value, ok := mpc.mutation.TransactionRef(); ok {
+		_spec.SetField(manualpayment.FieldTransactionRef, field.TypeString, value)
+		_node.TransactionRef = value
+	}
+	if value, ok := mpc.mutation.ClientUsername(); ok {
+		_spec.SetField(manualpayment.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := mpc.mutation.Provider(); ok {
+		_spec.SetField(manualpayment.FieldProvider, field.TypeEnum, value)
+		_node.Provider = value
+	}
+	if value, ok := mpc.mutation.SenderNumber(); ok {
+		_spec.SetField(manualpayment.FieldSenderNumber, field.TypeString, value)
+		_node.SenderNumber = value
+	}
+	if value, ok := mpc.mutation.TrxID(); ok {
+		_spec.SetField(manualpayment.FieldTrxID, field.TypeString, value)
+		_node.TrxID = value
+	}
+	if value, ok := mpc.mutation.TrxKey(); ok {
+		_spec.SetField(manualpayment.FieldTrxKey, field.TypeString, value)
+		_node.TrxKey = &value
+	}
+	if value, ok := mpc.mutation.Amount(); ok {
+		_spec.SetField(manualpayment.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := mpc.mutation.Status(); ok {
+		_spec.SetField(manualpayment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := mpc.mutation.VerifiedBy(); ok {
+		_spec.SetField(manualpayment.FieldVerifiedBy, field.TypeString, value)
+		_node.VerifiedBy = value
+	}
+	if value, ok := mpc.mutation.ReviewNote(); ok {
+		_spec.SetField(manualpayment.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = value
+	}
+	if value, ok := mpc.mutation.CreatedAt(); ok {
+		_spec.SetField(manualpayment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(manualpayment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ManualPaymentCreateBulk is the builder for creating many ManualPayment entities in bulk.
+type ManualPaymentCreateBulk struct {
+	config
+	err      error
+	builders []*ManualPaymentCreate
+}
+
+// Save creates the ManualPayment entities in the database.
+func (mpcb *ManualPaymentCreateBulk) Save(ctx context.Context) ([]*ManualPayment, error) {
+	if mpcb.err != nil {
+		return nil, mpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mpcb.builders))
+	nodes := make([]*ManualPayment, len(mpcb.builders))
+	mutators := make([]Mutator, len(mpcb.builders))
+	for i := range mpcb.builders {
+		func(i int, root context.Context) {
+			builder := mpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ManualPaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpcb *ManualPaymentCreateBulk) SaveX(ctx context.Context) []*ManualPayment {
+	v, err := mpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpcb *ManualPaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := mpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpcb *ManualPaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := mpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ManualPaymentDelete is the builder for deleting a ManualPayment entity.
+type ManualPaymentDelete struct {
+	config
+	hooks    []Hook
+	mutation *ManualPaymentMutation
+}
+
+// Where appends a list predicates to the ManualPaymentDelete builder.
+func (mpd *ManualPaymentDelete) Where(ps ...predicate.ManualPayment) *ManualPaymentDelete {
+	mpd.mutation.Where(ps...)
+	return mpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mpd *ManualPaymentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mpd.sqlExec, mpd.mutation, mpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpd *ManualPaymentDelete) ExecX(ctx context.Context) int {
+	n, err := mpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mpd *ManualPaymentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(manualpayment.Table, sqlgraph.NewFieldSpec(manualpayment.FieldID, field.TypeInt))
+	if ps := mpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mpd.mutation.done = true
+	return affected, err
+}
+
+// ManualPaymentDeleteOne is the builder for deleting a single ManualPayment entity.
+type ManualPaymentDeleteOne struct {
+	mpd *ManualPaymentDelete
+}
+
+// Where appends a list predicates to the ManualPaymentDelete builder.
+func (mpdo *ManualPaymentDeleteOne) Where(ps ...predicate.ManualPayment) *ManualPaymentDeleteOne {
+	mpdo.mpd.mutation.Where(ps...)
+	return mpdo
+}
+
+// Exec executes the deletion query.
+func (mpdo *ManualPaymentDeleteOne) Exec(ctx context.Context) error {
+	n, err := mpdo.mpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{manualpayment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpdo *ManualPaymentDeleteOne) ExecX(ctx context.Context) {
+	if err := mpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ManualPaymentQuery is the builder for querying ManualPayment entities.
+type ManualPaymentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []manualpayment.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ManualPayment
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ManualPaymentQuery builder.
+func (mpq *ManualPaymentQuery) Where(ps ...predicate.ManualPayment) *ManualPaymentQuery {
+	mpq.predicates = append(mpq.predicates, ps...)
+	return mpq
+}
+
+// Limit the number of records to be returned by this query.
+func (mpq *ManualPaymentQuery) Limit(limit int) *ManualPaymentQuery {
+	mpq.ctx.Limit = &limit
+	return mpq
+}
+
+// Offset to start from.
+func (mpq *ManualPaymentQuery) Offset(offset int) *ManualPaymentQuery {
+	mpq.ctx.Offset = &offset
+	return mpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mpq *ManualPaymentQuery) Unique(unique bool) *ManualPaymentQuery {
+	mpq.ctx.Unique = &unique
+	return mpq
+}
+
+// Order specifies how the records should be ordered.
+func (mpq *ManualPaymentQuery) Order(o ...manualpayment.OrderOption) *ManualPaymentQuery {
+	mpq.order = append(mpq.order, o...)
+	return mpq
+}
+
+// First returns the first ManualPayment entity from the query.
+// Returns a *NotFoundError when no ManualPayment was found.
+func (mpq *ManualPaymentQuery) First(ctx context.Context) (*ManualPayment, error) {
+	nodes, err := mpq.Limit(1).All(setContextOp(ctx, mpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{manualpayment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mpq *ManualPaymentQuery) FirstX(ctx context.Context) *ManualPayment {
+	node, err := mpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ManualPayment ID from the query.
+// Returns a *NotFoundError when no ManualPayment ID was found.
+func (mpq *ManualPaymentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mpq.Limit(1).IDs(setContextOp(ctx, mpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{manualpayment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mpq *ManualPaymentQuery) FirstIDX(ctx context.Context) int {
+	id, err := mpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ManualPayment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ManualPayment entity is found.
+// Returns a *NotFoundError when no ManualPayment entities are found.
+func (mpq *ManualPaymentQuery) Only(ctx context.Context) (*ManualPayment, error) {
+	nodes, err := mpq.Limit(2).All(setContextOp(ctx, mpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{manualpayment.Label}
+	default:
+		return nil, &NotSingularError{manualpayment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mpq *ManualPaymentQuery) OnlyX(ctx context.Context) *ManualPayment {
+	node, err := mpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ManualPayment ID in the query.
+// Returns a *NotSingularError when more than one ManualPayment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mpq *ManualPaymentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mpq.Limit(2).IDs(setContextOp(ctx, mpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{manualpayment.Label}
+	default:
+		err = &NotSingularError{manualpayment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mpq *ManualPaymentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ManualPayments.
+func (mpq *ManualPaymentQuery) All(ctx context.Context) ([]*ManualPayment, error) {
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryAll)
+	if err := mpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ManualPayment, *ManualPaymentQuery]()
+	return withInterceptors[[]*ManualPayment](ctx, mpq, qr, mpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mpq *ManualPaymentQuery) AllX(ctx context.Context) []*ManualPayment {
+	nodes, err := mpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ManualPayment IDs.
+func (mpq *ManualPaymentQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if mpq.ctx.Unique == nil && mpq.path != nil {
+		mpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryIDs)
+	if err = mpq.Select(manualpayment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mpq *ManualPaymentQuery) IDsX(ctx context.Context) []int {
+	ids, err := mpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mpq *ManualPaymentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryCount)
+	if err := mpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mpq, querierCount[*ManualPaymentQuery](), mpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mpq *ManualPaymentQuery) CountX(ctx context.Context) int {
+	count, err := mpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mpq *ManualPaymentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mpq.ctx, ent.OpQueryExist)
+	switch _, err := mpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mpq *ManualPaymentQuery) ExistX(ctx context.Context) bool {
+	exist, err := mpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ManualPaymentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mpq *ManualPaymentQuery) Clone() *ManualPaymentQuery {
+	if mpq == nil {
+		return nil
+	}
+	return &ManualPaymentQuery{
+		config:     mpq.config,
+		ctx:        mpq.ctx.Clone(),
+		order:      append([]manualpayment.OrderOption{}, mpq.order...),
+		inters:     append([]Interceptor{}, mpq.inters...),
+		predicates: append([]predicate.ManualPayment{}, mpq.predicates...),
+		// clone intermediate query.
+		sql:  mpq.sql.Clone(),
+		path: mpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TransactionRef string `json:"transaction_ref,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ManualPayment.Query().
+//		GroupBy(manualpayment.FieldTransactionRef).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mpq *ManualPaymentQuery) GroupBy(field string, fields ...string) *ManualPaymentGroupBy {
+	mpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ManualPaymentGroupBy{build: mpq}
+	grbuild.flds = &mpq.ctx.Fields
+	grbuild.label = manualpayment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TransactionRef string `json:"transaction_ref,omitempty"`
+//	}
+//
+//	client.ManualPayment.Query().
+//		Select(manualpayment.FieldTransactionRef).
+//		Scan(ctx, &v)
+func (mpq *ManualPaymentQuery) Select(fields ...string) *ManualPaymentSelect {
+	mpq.ctx.Fields = append(mpq.ctx.Fields, fields...)
+	sbuild := &ManualPaymentSelect{ManualPaymentQuery: mpq}
+	sbuild.label = manualpayment.Label
+	sbuild.flds, sbuild.scan = &mpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ManualPaymentSelect configured with the given aggregations.
+func (mpq *ManualPaymentQuery) Aggregate(fns ...AggregateFunc) *ManualPaymentSelect {
+	return mpq.Select().Aggregate(fns...)
+}
+
+func (mpq *ManualPaymentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mpq.ctx.Fields {
+		if !manualpayment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mpq.path != nil {
+		prev, err := mpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mpq.sql = prev
+	}
+	return nil
+}
+
+func (mpq *ManualPaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ManualPayment, error) {
+	var (
+		nodes = []*ManualPayment{}
+		_spec = mpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ManualPayment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ManualPayment{config: mpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mpq *ManualPaymentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mpq.querySpec()
+	_spec.Node.Columns = mpq.ctx.Fields
+	if len(mpq.ctx.Fields) > 0 {
+		_spec.Unique = mpq.ctx.Unique != nil && *mpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mpq.driver, _spec)
+}
+
+func (mpq *ManualPaymentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(manualpayment.Table, manualpayment.Columns, sqlgraph.NewFieldSpec(manualpayment.FieldID, field.TypeInt))
+	_spec.From = mpq.sql
+	if unique := mpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, manualpayment.FieldID)
+		for i := range fields {
+			if fields[i] != manualpayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mpq *ManualPaymentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mpq.driver.Dialect())
+	t1 := builder.Table(manualpayment.Table)
+	columns := mpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = manualpayment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mpq.sql != nil {
+		selector = mpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mpq.ctx.Unique != nil && *mpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range mpq.predicates {
+		p(selector)
+	}
+	for _, p := range mpq.order {
+		p(selector)
+	}
+	if offset := mpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ManualPaymentGroupBy is the group-by builder for ManualPayment entities.
+type ManualPaymentGroupBy struct {
+	selector
+	build *ManualPaymentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mpgb *ManualPaymentGroupBy) Aggregate(fns ...AggregateFunc) *ManualPaymentGroupBy {
+	mpgb.fns = append(mpgb.fns, fns...)
+	return mpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mpgb *ManualPaymentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ManualPaymentQuery, *ManualPaymentGroupBy](ctx, mpgb.build, mpgb, mpgb.build.inters, v)
+}
+
+func (mpgb *ManualPaymentGroupBy) sqlScan(ctx context.Context, root *ManualPaymentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mpgb.fns))
+	for _, fn := range mpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mpgb.flds)+len(mpgb.fns))
+		for _, f := range *mpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ManualPaymentSelect is the builder for selecting fields of ManualPayment entities.
+type ManualPaymentSelect struct {
+	*ManualPaymentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mps *ManualPaymentSelect) Aggregate(fns ...AggregateFunc) *ManualPaymentSelect {
+	mps.fns = append(mps.fns, fns...)
+	return mps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mps *ManualPaymentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mps.ctx, ent.OpQuerySelect)
+	if err := mps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ManualPaymentQuery, *ManualPaymentSelect](ctx, mps.ManualPaymentQuery, mps, mps.inters, v)
+}
+
+func (mps *ManualPaymentSelect) sqlScan(ctx context.Context, root *ManualPaymentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mps.fns))
+	for _, fn := range mps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
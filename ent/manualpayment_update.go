@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ManualPaymentUpdate is the builder for updating ManualPayment entities.
+type ManualPaymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ManualPaymentMutation
+}
+
+// Where appends a list predicates to the ManualPaymentUpdate builder.
+func (mpu *ManualPaymentUpdate) Where(ps ...predicate.ManualPayment) *ManualPaymentUpdate {
+	mpu.mutation.Where(ps...)
+	return mpu
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (mpu *ManualPaymentUpdate) SetTransactionRef(s string) *ManualPaymentUpdate {
+	mpu.mutation.SetTransactionRef(s)
+	return mpu
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableTransactionRef(s *string) *ManualPaymentUpdate {
+	if s != nil {
+		mpu.SetTransactionRef(*s)
+	}
+	return mpu
+}
+
+// SetClientUsername sets the "client_username" field.
+func (mpu *ManualPaymentUpdate) SetClientUsername(s string) *ManualPaymentUpdate {
+	mpu.mutation.SetClientUsername(s)
+	return mpu
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableClientUsername(s *string) *ManualPaymentUpdate {
+	if s != nil {
+		mpu.SetClientUsername(*s)
+	}
+	return mpu
+}
+
+// SetProvider sets the "provider" field.
+func (mpu *ManualPaymentUpdate) SetProvider(m manualpayment.Provider) *ManualPaymentUpdate {
+	mpu.mutation.SetProvider(m)
+	return mpu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableProvider(m *manualpayment.Provider) *ManualPaymentUpdate {
+	if m != nil {
+		mpu.SetProvider(*m)
+	}
+	return mpu
+}
+
+// SetSenderNumber sets the "sender_number" field.
+func (mpu *ManualPaymentUpdate) SetSenderNumber(s string) *ManualPaymentUpdate {
+	mpu.mutation.SetSenderNumber(s)
+	return mpu
+}
+
+// SetNillableSenderNumber sets the "sender_number" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableSenderNumber(s *string) *ManualPaymentUpdate {
+	if s != nil {
+		mpu.SetSenderNumber(*s)
+	}
+	return mpu
+}
+
+// SetTrxID sets the "trx_id" field.
+func (mpu *ManualPaymentUpdate) SetTrxID(s string) *ManualPaymentUpdate {
+	mpu.mutation.SetTrxID(s)
+	return mpu
+}
+
+// SetNillableTrxID sets the "trx_id" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableTrxID(s *string) *ManualPaymentUpdate {
+	if s != nil {
+		mpu.SetTrxID(*s)
+	}
+	return mpu
+}
+
+// SetTrxKey sets the "trx_key" field.
+func (mpu *ManualPaymentUpdate) SetTrxKey(s string) *ManualPaymentUpdate {
+	mpu.mutation.SetTrxKey(s)
+	return mpu
+}
+
+// SetNillableTrxKey sets the "trx_key" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableTrxKey(s *string) *ManualPaymentUpdate {
+	if s != nil {
+		mpu.SetTrxKey(*s)
+	}
+	return mpu
+}
+
+// ClearTrxKey clears the value of the "trx_key" field.
+func (mpu *ManualPaymentUpdate) ClearTrxKey() *ManualPaymentUpdate {
+	mpu.mutation.ClearTrxKey()
+	return mpu
+}
+
+// SetAmount sets the "amount" field.
+func (mpu *ManualPaymentUpdate) SetAmount(f float64) *ManualPaymentUpdate {
+	mpu.mutation.ResetAmount()
+	mpu.mutation.SetAmount(f)
+	return mpu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableAmount(f *float64) *ManualPaymentUpdate {
+	if f != nil {
+		mpu.SetAmount(*f)
+	}
+	return mpu
+}
+
+// AddAmount adds f to the "amount" field.
+func (mpu *ManualPaymentUpdate) AddAmount(f float64) *ManualPaymentUpdate {
+	mpu.mutation.AddAmount(f)
+	return mpu
+}
+
+// SetStatus sets the "status" field.
+func (mpu *ManualPaymentUpdate) SetStatus(m manualpayment.Status) *ManualPaymentUpdate {
+	mpu.mutation.SetStatus(m)
+	return mpu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableStatus(m *manualpayment.Status) *ManualPaymentUpdate {
+	if m != nil {
+		mpu.SetStatus(*m)
+	}
+	return mpu
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (mpu *ManualPaymentUpdate) SetVerifiedBy(s string) *ManualPaymentUpdate {
+	mpu.mutation.SetVerifiedBy(s)
+	return mpu
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableVerifiedBy(s *string) *ManualPaymentUpdate {
+	if s != nil {
+		mpu.SetVerifiedBy(*s)
+	}
+	return mpu
+}
+
+// ClearVerifiedBy clears the value of the "verified_by" field.
+func (mpu *ManualPaymentUpdate) ClearVerifiedBy() *ManualPaymentUpdate {
+	mpu.mutation.ClearVerifiedBy()
+	return mpu
+}
+
+// SetReviewNote sets the "review_note" field.
+func (mpu *ManualPaymentUpdate) SetReviewNote(s string) *ManualPaymentUpdate {
+	mpu.mutation.SetReviewNote(s)
+	return mpu
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (mpu *ManualPaymentUpdate) SetNillableReviewNote(s *string) *ManualPaymentUpdate {
+	if s != nil {
+		mpu.SetReviewNote(*s)
+	}
+	return mpu
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (mpu *ManualPaymentUpdate) ClearReviewNote() *ManualPaymentUpdate {
+	mpu.mutation.ClearReviewNote()
+	return mpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpu *ManualPaymentUpdate) SetUpdatedAt(t time.Time) *ManualPaymentUpdate {
+	mpu.mutation.SetUpdatedAt(t)
+	return mpu
+}
+
+// Mutation returns the ManualPaymentMutation object of the builder.
+func (mpu *ManualPaymentUpdate) Mutation() *ManualPaymentMutation {
+	return mpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mpu *ManualPaymentUpdate) Save(ctx context.Context) (int, error) {
+	mpu.defaults()
+	return withHooks(ctx, mpu.sqlSave, mpu.mutation, mpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpu *ManualPaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := mpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mpu *ManualPaymentUpdate) Exec(ctx context.Context) error {
+	_, err := mpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpu *ManualPaymentUpdate) ExecX(ctx context.Context) {
+	if err := mpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpu *ManualPaymentUpdate) defaults() {
+	if _, ok := mpu.mutation.UpdatedAt(); !ok {
+		v := manualpayment.UpdateDefaultUpdatedAt()
+		mpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpu *ManualPaymentUpdate) check() error {
+	if v, ok := mpu.mutation.TransactionRef(); ok {
+		if err := manualpayment.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.transaction_ref": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.ClientUsername(); ok {
+		if err := manualpayment.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.client_username": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.Provider(); ok {
+		if err := manualpayment.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.provider": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.SenderNumber(); ok {
+		if err := manualpayment.SenderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "sender_number", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.sender_number": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.TrxID(); ok {
+		if err := manualpayment.TrxIDValidator(v); err != nil {
+			return &ValidationError{Name: "trx_id", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.trx_id": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.TrxKey(); ok {
+		if err := manualpayment.TrxKeyValidator(v); err != nil {
+			return &ValidationError{Name: "trx_key", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.trx_key": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.Status(); ok {
+		if err := manualpayment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.status": %w`, err)}
+		}
+	}
+	if v, ok := mpu.mutation.VerifiedBy(); ok {
+		if err := manualpayment.VerifiedByValidator(v); err != nil {
+			return &ValidationError{Name: "verified_by", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.verified_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mpu *ManualPaymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(manualpayment.Table, manualpayment.Columns, sqlgraph.NewFieldSpec(manualpayment.FieldID, field.TypeInt))
+	if ps := mpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpu.mutation.TransactionRef(); ok {
+		_spec.SetField(manualpayment.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := mpu.mutation.ClientUsername(); ok {
+		_spec.SetField(manualpayment.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := mpu.mutation.Provider(); ok {
+		_spec.SetField(manualpayment.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := mpu.mutation.SenderNumber(); ok {
+		_spec.SetField(manualpayment.FieldSenderNumber, field.TypeString, value)
+	}
+	if value, ok := mpu.mutation.TrxID(); ok {
+		_spec.SetField(manualpayment.FieldTrxID, field.TypeString, value)
+	}
+	if value, ok := mpu.mutation.TrxKey(); ok {
+		_spec.SetField(manualpayment.FieldTrxKey, field.TypeString, value)
+	}
+	if mpu.mutation.TrxKeyCleared() {
+		_spec.ClearField(manualpayment.FieldTrxKey, field.TypeString)
+	}
+	if value, ok := mpu.mutation.Amount(); ok {
+		_spec.SetField(manualpayment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := mpu.mutation.AddedAmount(); ok {
+		_spec.AddField(manualpayment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := mpu.mutation.Status(); ok {
+		_spec.SetField(manualpayment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mpu.mutation.VerifiedBy(); ok {
+		_spec.SetField(manualpayment.FieldVerifiedBy, field.TypeString, value)
+	}
+	if mpu.mutation.VerifiedByCleared() {
+		_spec.ClearField(manualpayment.FieldVerifiedBy, field.TypeString)
+	}
+	if value, ok := mpu.mutation.ReviewNote(); ok {
+		_spec.SetField(manualpayment.FieldReviewNote, field.TypeString, value)
+	}
+	if mpu.mutation.ReviewNoteCleared() {
+		_spec.ClearField(manualpayment.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := mpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(manualpayment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{manualpayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mpu.mutation.done = true
+	return n, nil
+}
+
+// ManualPaymentUpdateOne is the builder for updating a single ManualPayment entity.
+type ManualPaymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ManualPaymentMutation
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (mpuo *ManualPaymentUpdateOne) SetTransactionRef(s string) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetTransactionRef(s)
+	return mpuo
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableTransactionRef(s *string) *ManualPaymentUpdateOne {
+	if s != nil {
+		mpuo.SetTransactionRef(*s)
+	}
+	return mpuo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (mpuo *ManualPaymentUpdateOne) SetClientUsername(s string) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetClientUsername(s)
+	return mpuo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableClientUsername(s *string) *ManualPaymentUpdateOne {
+	if s != nil {
+		mpuo.SetClientUsername(*s)
+	}
+	return mpuo
+}
+
+// SetProvider sets the "provider" field.
+func (mpuo *ManualPaymentUpdateOne) SetProvider(m manualpayment.Provider) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetProvider(m)
+	return mpuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableProvider(m *manualpayment.Provider) *ManualPaymentUpdateOne {
+	if m != nil {
+		mpuo.SetProvider(*m)
+	}
+	return mpuo
+}
+
+// SetSenderNumber sets the "sender_number" field.
+func (mpuo *ManualPaymentUpdateOne) SetSenderNumber(s string) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetSenderNumber(s)
+	return mpuo
+}
+
+// SetNillableSenderNumber sets the "sender_number" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableSenderNumber(s *string) *ManualPaymentUpdateOne {
+	if s != nil {
+		mpuo.SetSenderNumber(*s)
+	}
+	return mpuo
+}
+
+// SetTrxID sets the "trx_id" field.
+func (mpuo *ManualPaymentUpdateOne) SetTrxID(s string) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetTrxID(s)
+	return mpuo
+}
+
+// SetNillableTrxID sets the "trx_id" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableTrxID(s *string) *ManualPaymentUpdateOne {
+	if s != nil {
+		mpuo.SetTrxID(*s)
+	}
+	return mpuo
+}
+
+// SetTrxKey sets the "trx_key" field.
+func (mpuo *ManualPaymentUpdateOne) SetTrxKey(s string) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetTrxKey(s)
+	return mpuo
+}
+
+// SetNillableTrxKey sets the "trx_key" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableTrxKey(s *string) *ManualPaymentUpdateOne {
+	if s != nil {
+		mpuo.SetTrxKey(*s)
+	}
+	return mpuo
+}
+
+// ClearTrxKey clears the value of the "trx_key" field.
+func (mpuo *ManualPaymentUpdateOne) ClearTrxKey() *ManualPaymentUpdateOne {
+	mpuo.mutation.ClearTrxKey()
+	return mpuo
+}
+
+// SetAmount sets the "amount" field.
+func (mpuo *ManualPaymentUpdateOne) SetAmount(f float64) *ManualPaymentUpdateOne {
+	mpuo.mutation.ResetAmount()
+	mpuo.mutation.SetAmount(f)
+	return mpuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableAmount(f *float64) *ManualPaymentUpdateOne {
+	if f != nil {
+		mpuo.SetAmount(*f)
+	}
+	return mpuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (mpuo *ManualPaymentUpdateOne) AddAmount(f float64) *ManualPaymentUpdateOne {
+	mpuo.mutation.AddAmount(f)
+	return mpuo
+}
+
+// SetStatus sets the "status" field.
+func (mpuo *ManualPaymentUpdateOne) SetStatus(m manualpayment.Status) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetStatus(m)
+	return mpuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableStatus(m *manualpayment.Status) *ManualPaymentUpdateOne {
+	if m != nil {
+		mpuo.SetStatus(*m)
+	}
+	return mpuo
+}
+
+// SetVerifiedBy sets the "verified_by" field.
+func (mpuo *ManualPaymentUpdateOne) SetVerifiedBy(s string) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetVerifiedBy(s)
+	return mpuo
+}
+
+// SetNillableVerifiedBy sets the "verified_by" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableVerifiedBy(s *string) *ManualPaymentUpdateOne {
+	if s != nil {
+		mpuo.SetVerifiedBy(*s)
+	}
+	return mpuo
+}
+
+// ClearVerifiedBy clears the value of the "verified_by" field.
+func (mpuo *ManualPaymentUpdateOne) ClearVerifiedBy() *ManualPaymentUpdateOne {
+	mpuo.mutation.ClearVerifiedBy()
+	return mpuo
+}
+
+// SetReviewNote sets the "review_note" field.
+func (mpuo *ManualPaymentUpdateOne) SetReviewNote(s string) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetReviewNote(s)
+	return mpuo
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (mpuo *ManualPaymentUpdateOne) SetNillableReviewNote(s *string) *ManualPaymentUpdateOne {
+	if s != nil {
+		mpuo.SetReviewNote(*s)
+	}
+	return mpuo
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (mpuo *ManualPaymentUpdateOne) ClearReviewNote() *ManualPaymentUpdateOne {
+	mpuo.mutation.ClearReviewNote()
+	return mpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mpuo *ManualPaymentUpdateOne) SetUpdatedAt(t time.Time) *ManualPaymentUpdateOne {
+	mpuo.mutation.SetUpdatedAt(t)
+	return mpuo
+}
+
+// Mutation returns the ManualPaymentMutation object of the builder.
+func (mpuo *ManualPaymentUpdateOne) Mutation() *ManualPaymentMutation {
+	return mpuo.mutation
+}
+
+// Where appends a list predicates to the ManualPaymentUpdate builder.
+func (mpuo *ManualPaymentUpdateOne) Where(ps ...predicate.ManualPayment) *ManualPaymentUpdateOne {
+	mpuo.mutation.Where(ps...)
+	return mpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mpuo *ManualPaymentUpdateOne) Select(field string, fields ...string) *ManualPaymentUpdateOne {
+	mpuo.fields = append([]string{field}, fields...)
+	return mpuo
+}
+
+// Save executes the query and returns the updated ManualPayment entity.
+func (mpuo *ManualPaymentUpdateOne) Save(ctx context.Context) (*ManualPayment, error) {
+	mpuo.defaults()
+	return withHooks(ctx, mpuo.sqlSave, mpuo.mutation, mpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpuo *ManualPaymentUpdateOne) SaveX(ctx context.Context) *ManualPayment {
+	node, err := mpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mpuo *ManualPaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := mpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpuo *ManualPaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := mpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpuo *ManualPaymentUpdateOne) defaults() {
+	if _, ok := mpuo.mutation.UpdatedAt(); !ok {
+		v := manualpayment.UpdateDefaultUpdatedAt()
+		mpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpuo *ManualPaymentUpdateOne) check() error {
+	if v, ok := mpuo.mutation.TransactionRef(); ok {
+		if err := manualpayment.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.transaction_ref": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.ClientUsername(); ok {
+		if err := manualpayment.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.client_username": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.Provider(); ok {
+		if err := manualpayment.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.provider": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.SenderNumber(); ok {
+		if err := manualpayment.SenderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "sender_number", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.sender_number": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.TrxID(); ok {
+		if err := manualpayment.TrxIDValidator(v); err != nil {
+			return &ValidationError{Name: "trx_id", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.trx_id": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.TrxKey(); ok {
+		if err := manualpayment.TrxKeyValidator(v); err != nil {
+			return &ValidationError{Name: "trx_key", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.trx_key": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.Status(); ok {
+		if err := manualpayment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.status": %w`, err)}
+		}
+	}
+	if v, ok := mpuo.mutation.VerifiedBy(); ok {
+		if err := manualpayment.VerifiedByValidator(v); err != nil {
+			return &ValidationError{Name: "verified_by", err: fmt.Errorf(`ent: validator failed for field "ManualPayment.verified_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mpuo *ManualPaymentUpdateOne) sqlSave(ctx context.Context) (_node *ManualPayment, err error) {
+	if err := mpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(manualpayment.Table, manualpayment.Columns, sqlgraph.NewFieldSpec(manualpayment.FieldID, field.TypeInt))
+	id, ok := mpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ManualPayment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, manualpayment.FieldID)
+		for _, f := range fields {
+			if !manualpayment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != manualpayment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpuo.mutation.TransactionRef(); ok {
+		_spec.SetField(manualpayment.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := mpuo.mutation.ClientUsername(); ok {
+		_spec.SetField(manualpayment.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := mpuo.mutation.Provider(); ok {
+		_spec.SetField(manualpayment.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := mpuo.mutation.SenderNumber(); ok {
+		_spec.SetField(manualpayment.FieldSenderNumber, field.TypeString, value)
+	}
+	if value, ok := mpuo.mutation.TrxID(); ok {
+		_spec.SetField(manualpayment.FieldTrxID, field.TypeString, value)
+	}
+	if value, ok := mpuo.mutation.TrxKey(); ok {
+		_spec.SetField(manualpayment.FieldTrxKey, field.TypeString, value)
+	}
+	if mpuo.mutation.TrxKeyCleared() {
+		_spec.ClearField(manualpayment.FieldTrxKey, field.TypeString)
+	}
+	if value, ok := mpuo.mutation.Amount(); ok {
+		_spec.SetField(manualpayment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := mpuo.mutation.AddedAmount(); ok {
+		_spec.AddField(manualpayment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := mpuo.mutation.Status(); ok {
+		_spec.SetField(manualpayment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mpuo.mutation.VerifiedBy(); ok {
+		_spec.SetField(manualpayment.FieldVerifiedBy, field.TypeString, value)
+	}
+	if mpuo.mutation.VerifiedByCleared() {
+		_spec.ClearField(manualpayment.FieldVerifiedBy, field.TypeString)
+	}
+	if value, ok := mpuo.mutation.ReviewNote(); ok {
+		_spec.SetField(manualpayment.FieldReviewNote, field.TypeString, value)
+	}
+	if mpuo.mutation.ReviewNoteCleared() {
+		_spec.ClearField(manualpayment.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := mpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(manualpayment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ManualPayment{config: mpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{manualpayment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,94 @@
+-- The schema as it was before versioned migrations, with the FreeRADIUS tables it runs beside.
+-- Existing databases already have it, so mark it applied with: atlas migrate apply --baseline 20261018031234
+-- Create "client_txn" table
+CREATE TABLE `client_txn` (`id` bigint NOT NULL AUTO_INCREMENT, `transaction_ref` varchar(64) NOT NULL, `balance` double NOT NULL DEFAULT 0, `type` enum('ACTIVE','RENEWAL','REFUND','TRANSFER_REFUND','TRANSFER_RECEIVED','AUTO_RENEWAL','PACKAGE_MIGRATION','ADVANCE_PAYMENT') NOT NULL, `status` enum('pending','completed','failed','reversed') NOT NULL DEFAULT "completed", `total_balance` double NOT NULL DEFAULT 0, `payment_method` enum('vendor_balance','client_balance','cash','bank_transfer','mobile_banking','card','gateway_sslcommerz','gateway_bkash','gateway_nagad','gateway_stripe','gateway_paypal','free','other') NULL, `client_username` varchar(255) NULL, `description` varchar(255) NULL, `transaction_date` timestamp NOT NULL, `created_by` varchar(255) NOT NULL, PRIMARY KEY (`id`), INDEX `clienttxn_client_username` (`client_username`), INDEX `clienttxn_status` (`status`), INDEX `clienttxn_transaction_date` (`transaction_date`), INDEX `clienttxn_transaction_ref` (`transaction_ref`), INDEX `clienttxn_type` (`type`), UNIQUE INDEX `transaction_ref` (`transaction_ref`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "clients" table
+CREATE TABLE `clients` (`id` bigint NOT NULL AUTO_INCREMENT, `name` varchar(255) NOT NULL, `username` varchar(255) NOT NULL, `password` varchar(255) NOT NULL, `mobile_number` varchar(255) NOT NULL, `email` varchar(255) NOT NULL, `photo` varchar(255) NULL, `description` longtext NULL, `balance` double NOT NULL DEFAULT 0, `address_line1` varchar(255) NULL, `address_line2` varchar(255) NULL, `city` varchar(255) NULL, `district` varchar(255) NULL, `upazila` varchar(255) NULL, `union_name` varchar(255) NULL, `zip` varchar(255) NULL, `status` enum('active','inactive') NOT NULL DEFAULT "inactive", `payment_date` timestamp NULL, `payment_type` varchar(255) NULL, `auto_renew` bool NOT NULL DEFAULT 1, `c_name` varchar(32) NOT NULL, `vendor_id` bigint NOT NULL, `package_pool` varchar(255) NULL, `user_profile` varchar(64) NULL, `next_user_profile` varchar(255) NULL, `created_by` varchar(255) NOT NULL, `updated_by` varchar(255) NULL, `created_date` timestamp NOT NULL, `updated_date` timestamp NULL, PRIMARY KEY (`id`), INDEX `clientuser_auto_renew` (`auto_renew`), INDEX `clientuser_auto_renew_status_c_name_created_date` (`auto_renew`, `status`, `c_name`, `created_date`), INDEX `clientuser_balance` (`balance`), INDEX `clientuser_created_by` (`created_by`), INDEX `clientuser_created_date` (`created_date`), INDEX `clientuser_c_name` (`c_name`), INDEX `clientuser_c_name_status_created_date` (`c_name`, `status`, `created_date`), INDEX `clientuser_c_name_vendor_id_status` (`c_name`, `vendor_id`, `status`), INDEX `clientuser_c_name_vendor_id_status_created_date` (`c_name`, `vendor_id`, `status`, `created_date`), INDEX `clientuser_email` (`email`), INDEX `clientuser_mobile_number` (`mobile_number`), INDEX `clientuser_name` (`name`), INDEX `clientuser_next_user_profile` (`next_user_profile`), INDEX `clientuser_package_pool` (`package_pool`), INDEX `clientuser_package_pool_status_created_date` (`package_pool`, `status`, `created_date`), INDEX `clientuser_status` (`status`), INDEX `clientuser_updated_date_status` (`updated_date`, `status`), INDEX `clientuser_user_profile` (`user_profile`), INDEX `clientuser_vendor_id` (`vendor_id`), INDEX `clientuser_vendor_id_package_pool_status` (`vendor_id`, `package_pool`, `status`), UNIQUE INDEX `username` (`username`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "email_subscriptions" table
+CREATE TABLE `email_subscriptions` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `email` varchar(255) NOT NULL, `verified` bool NOT NULL DEFAULT 0, `confirmation_code` varchar(255) NOT NULL, `latitude` double NULL, `longitude` double NULL, PRIMARY KEY (`id`), UNIQUE INDEX `confirmation_code` (`confirmation_code`), UNIQUE INDEX `email` (`email`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "email_subscription_types" table
+CREATE TABLE `email_subscription_types` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `name` enum('email_newsletter','launch_announcement') NOT NULL, `active` bool NOT NULL DEFAULT 1, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "emojis" table
+CREATE TABLE `emojis` (`id` bigint NOT NULL AUTO_INCREMENT, `unified_code` varchar(255) NOT NULL, `shortcode` varchar(255) NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "fcm_subscriptions" table
+CREATE TABLE `fcm_subscriptions` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `token` varchar(255) NOT NULL, `profile_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `fcmsubscriptions_token_profile_id` (`token`, `profile_id`), INDEX `fcm_subscriptions_profiles_fcm_push_subscriptions` (`profile_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "file_storages" table
+CREATE TABLE `file_storages` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `bucket_name` varchar(255) NOT NULL, `object_key` varchar(255) NOT NULL, `original_file_name` varchar(255) NULL, `file_size` bigint NULL, `content_type` varchar(255) NULL, `file_hash` varchar(255) NULL, PRIMARY KEY (`id`), UNIQUE INDEX `filestorage_bucket_name_object_key` (`bucket_name`, `object_key`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "images" table
+CREATE TABLE `images` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `type` enum('profile_photo','profile_gallery') NOT NULL, `profile_photos` bigint NULL, PRIMARY KEY (`id`), INDEX `images_profiles_photos` (`profile_photos`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "image_sizes" table
+CREATE TABLE `image_sizes` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `size` enum('thumbnail','preview','full') NOT NULL, `width` bigint NOT NULL, `height` bigint NOT NULL, `image_sizes` bigint NULL, `image_size_file` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `image_sizes_file_storages_file` (`image_size_file`), INDEX `image_sizes_images_sizes` (`image_sizes`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "invitations" table
+CREATE TABLE `invitations` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `invitee_name` varchar(255) NOT NULL, `confirmation_code` varchar(255) NOT NULL, `profile_invitations` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `confirmation_code` (`confirmation_code`), INDEX `invitations_profiles_invitations` (`profile_invitations`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "last_seen_onlines" table
+CREATE TABLE `last_seen_onlines` (`id` bigint NOT NULL AUTO_INCREMENT, `seen_at` timestamp NOT NULL, `user_last_seen_at` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `last_seen_onlines_users_last_seen_at` (`user_last_seen_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "monthly_subscriptions" table
+CREATE TABLE `monthly_subscriptions` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `product` enum('free','pro') NOT NULL DEFAULT "free", `is_active` bool NOT NULL DEFAULT 0, `paid` bool NOT NULL DEFAULT 0, `is_trial` bool NOT NULL DEFAULT 1, `started_at` timestamp NULL, `expired_on` timestamp NULL, `cancelled_at` timestamp NULL, `paying_profile_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `monthlysubscription_paying_profile_id_is_active` (`paying_profile_id`, `is_active`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "notifications" table
+CREATE TABLE `notifications` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `type` enum('new_private_message','connection_engaged_with_question','increment_num_unseen_msg','decrement_num_unseen_msg','update_num_notifs','platform_update','payment_failed') NOT NULL, `title` varchar(255) NOT NULL DEFAULT "", `text` varchar(255) NOT NULL, `link` varchar(255) NULL, `read` bool NOT NULL DEFAULT 0, `read_at` timestamp NULL, `profile_id_who_caused_notification` bigint NULL, `resource_id_tied_to_notif` bigint NULL, `read_in_notifications_center` bool NULL, `profile_notifications` bigint NULL, PRIMARY KEY (`id`), INDEX `notifications_profiles_notifications` (`profile_notifications`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "notification_permissions" table
+CREATE TABLE `notification_permissions` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `permission` enum('partner_activity') NOT NULL, `platform` enum('push','fcm_push','email','sms') NOT NULL, `token` varchar(255) NOT NULL, `profile_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `notificationpermission_profile_id_permission_platform` (`profile_id`, `permission`, `platform`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "notification_times" table
+CREATE TABLE `notification_times` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `type` enum('new_private_message','connection_engaged_with_question','increment_num_unseen_msg','decrement_num_unseen_msg','update_num_notifs','platform_update','payment_failed') NOT NULL, `send_minute` bigint NOT NULL, `profile_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `notificationtime_profile_id_type` (`profile_id`, `type`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "packages" table
+CREATE TABLE `packages` (`id` bigint NOT NULL AUTO_INCREMENT, `name` varchar(255) NOT NULL, `pool_name` varchar(255) NULL, `profile_name` varchar(100) NOT NULL, `price` double NOT NULL DEFAULT 0, `currency` varchar(3) NOT NULL DEFAULT "BDT", `is_active` bool NOT NULL DEFAULT 1, `created_date` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `packageplan_is_active` (`is_active`), INDEX `packageplan_name` (`name`), INDEX `packageplan_profile_name` (`profile_name`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "phone_verification_codes" table
+CREATE TABLE `phone_verification_codes` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `code` varchar(255) NOT NULL, `profile_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `phoneverificationcode_code_profile_id` (`code`, `profile_id`), INDEX `phone_verification_codes_profiles_phone_verification_code` (`profile_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "profiles" table
+CREATE TABLE `profiles` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `bio` varchar(255) NULL, `description` varchar(255) NULL, `address_line1` varchar(255) NULL, `address_line2` varchar(255) NULL, `city` varchar(255) NULL, `district` varchar(255) NULL, `upazila` varchar(255) NULL, `union_name` varchar(255) NULL, `zip` varchar(255) NULL, `birthdate` timestamp NULL, `age` bigint NULL, `fully_onboarded` bool NOT NULL DEFAULT 0, `phone_number_e164` varchar(255) NULL, `country_code` varchar(255) NULL, `phone_verified` bool NULL, `stripe_id` varchar(255) NULL, `profile_profile_image` bigint NULL, `user_profile` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `profiles_images_profile_image` (`profile_profile_image`), UNIQUE INDEX `stripe_id` (`stripe_id`), UNIQUE INDEX `user_profile` (`user_profile`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "pwa_push_subscriptions" table
+CREATE TABLE `pwa_push_subscriptions` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `endpoint` varchar(255) NOT NULL, `p256dh` varchar(255) NOT NULL, `auth` varchar(255) NOT NULL, `profile_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `pwapushsubscription_profile_id_endpoint` (`profile_id`, `endpoint`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "radacct" table
+CREATE TABLE `radacct` (`radacctid` bigint NOT NULL AUTO_INCREMENT, `acctsessionid` varchar(64) NOT NULL, `acctuniqueid` varchar(32) NOT NULL, `username` varchar(64) NOT NULL, `acctstarttime` timestamp NULL, `acctstoptime` timestamp NULL, `acctsessiontime` int unsigned NULL, `acctinputoctets` bigint NULL, `acctoutputoctets` bigint NULL, `framedipaddress` varchar(15) NOT NULL, `acctterminatecause` varchar(32) NOT NULL, PRIMARY KEY (`radacctid`), UNIQUE INDEX `acctuniqueid` (`acctuniqueid`), INDEX `radacct_acctstarttime` (`acctstarttime`), INDEX `radacct_acctstoptime` (`acctstoptime`), INDEX `radacct_framedipaddress` (`framedipaddress`), INDEX `radacct_username` (`username`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "sent_emails" table
+CREATE TABLE `sent_emails` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `type` enum('partner_activity') NOT NULL, `profile_sent_emails` bigint NOT NULL, PRIMARY KEY (`id`), INDEX `sent_emails_profiles_sent_emails` (`profile_sent_emails`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "tickets" table
+CREATE TABLE `tickets` (`id` bigint NOT NULL AUTO_INCREMENT, `subject` varchar(255) NOT NULL, `description` longtext NOT NULL, `status` enum('open','pending','closed') NOT NULL DEFAULT "open", `priority` enum('low','medium','high') NOT NULL DEFAULT "medium", `client_id` bigint NOT NULL, `client_username` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `ticket_client_id` (`client_id`), INDEX `ticket_client_username` (`client_username`), INDEX `ticket_created_at` (`created_at`), INDEX `ticket_status` (`status`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "users" table
+CREATE TABLE `users` (`id` bigint NOT NULL AUTO_INCREMENT, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `name` varchar(255) NOT NULL, `email` varchar(255) NOT NULL, `password` varchar(255) NOT NULL, `last_online` timestamp NULL, `username` varchar(255) NULL, `status` varchar(255) NOT NULL DEFAULT "active", PRIMARY KEY (`id`), UNIQUE INDEX `email` (`email`), UNIQUE INDEX `username` (`username`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "email_subscription_subscriptions" table
+CREATE TABLE `email_subscription_subscriptions` (`email_subscription_id` bigint NOT NULL, `email_subscription_type_id` bigint NOT NULL, PRIMARY KEY (`email_subscription_id`, `email_subscription_type_id`), INDEX `email_subscription_subscriptions_email_subscription_type_id` (`email_subscription_type_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "monthly_subscription_benefactors" table
+CREATE TABLE `monthly_subscription_benefactors` (`monthly_subscription_id` bigint NOT NULL, `profile_id` bigint NOT NULL, PRIMARY KEY (`monthly_subscription_id`, `profile_id`), INDEX `monthly_subscription_benefactors_profile_id` (`profile_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "profile_friends" table
+CREATE TABLE `profile_friends` (`profile_id` bigint NOT NULL, `friend_id` bigint NOT NULL, PRIMARY KEY (`profile_id`, `friend_id`), INDEX `profile_friends_friend_id` (`friend_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Modify "fcm_subscriptions" table
+ALTER TABLE `fcm_subscriptions` ADD CONSTRAINT `fcm_subscriptions_profiles_fcm_push_subscriptions` FOREIGN KEY (`profile_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "images" table
+ALTER TABLE `images` ADD CONSTRAINT `images_profiles_photos` FOREIGN KEY (`profile_photos`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "image_sizes" table
+ALTER TABLE `image_sizes` ADD CONSTRAINT `image_sizes_file_storages_file` FOREIGN KEY (`image_size_file`) REFERENCES `file_storages` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT `image_sizes_images_sizes` FOREIGN KEY (`image_sizes`) REFERENCES `images` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "invitations" table
+ALTER TABLE `invitations` ADD CONSTRAINT `invitations_profiles_invitations` FOREIGN KEY (`profile_invitations`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "last_seen_onlines" table
+ALTER TABLE `last_seen_onlines` ADD CONSTRAINT `last_seen_onlines_users_last_seen_at` FOREIGN KEY (`user_last_seen_at`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "monthly_subscriptions" table
+ALTER TABLE `monthly_subscriptions` ADD CONSTRAINT `monthly_subscriptions_profiles_payer` FOREIGN KEY (`paying_profile_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Modify "notifications" table
+ALTER TABLE `notifications` ADD CONSTRAINT `notifications_profiles_notifications` FOREIGN KEY (`profile_notifications`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "notification_permissions" table
+ALTER TABLE `notification_permissions` ADD CONSTRAINT `notification_permissions_profiles_notification_permissions` FOREIGN KEY (`profile_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "notification_times" table
+ALTER TABLE `notification_times` ADD CONSTRAINT `notification_times_profiles_notification_times` FOREIGN KEY (`profile_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "phone_verification_codes" table
+ALTER TABLE `phone_verification_codes` ADD CONSTRAINT `phone_verification_codes_profiles_phone_verification_code` FOREIGN KEY (`profile_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "profiles" table
+ALTER TABLE `profiles` ADD CONSTRAINT `profiles_images_profile_image` FOREIGN KEY (`profile_profile_image`) REFERENCES `images` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT `profiles_users_profile` FOREIGN KEY (`user_profile`) REFERENCES `users` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "pwa_push_subscriptions" table
+ALTER TABLE `pwa_push_subscriptions` ADD CONSTRAINT `pwa_push_subscriptions_profiles_pwa_push_subscriptions` FOREIGN KEY (`profile_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "sent_emails" table
+ALTER TABLE `sent_emails` ADD CONSTRAINT `sent_emails_profiles_sent_emails` FOREIGN KEY (`profile_sent_emails`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "email_subscription_subscriptions" table
+ALTER TABLE `email_subscription_subscriptions` ADD CONSTRAINT `email_subscription_subscriptions_email_subscription_id` FOREIGN KEY (`email_subscription_id`) REFERENCES `email_subscriptions` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT `email_subscription_subscriptions_email_subscription_type_id` FOREIGN KEY (`email_subscription_type_id`) REFERENCES `email_subscription_types` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "monthly_subscription_benefactors" table
+ALTER TABLE `monthly_subscription_benefactors` ADD CONSTRAINT `monthly_subscription_benefactors_monthly_subscription_id` FOREIGN KEY (`monthly_subscription_id`) REFERENCES `monthly_subscriptions` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT `monthly_subscription_benefactors_profile_id` FOREIGN KEY (`profile_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Modify "profile_friends" table
+ALTER TABLE `profile_friends` ADD CONSTRAINT `profile_friends_friend_id` FOREIGN KEY (`friend_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT `profile_friends_profile_id` FOREIGN KEY (`profile_id`) REFERENCES `profiles` (`id`) ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Create "radcheck" table
+CREATE TABLE IF NOT EXISTS `radcheck` (`id` int unsigned NOT NULL AUTO_INCREMENT, `username` varchar(64) NOT NULL DEFAULT "", `attribute` varchar(64) NOT NULL DEFAULT "", `op` char(2) NOT NULL DEFAULT "==", `value` varchar(253) NOT NULL DEFAULT "", PRIMARY KEY (`id`), INDEX `radcheck_username` (`username` (32))) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "radreply" table
+CREATE TABLE IF NOT EXISTS `radreply` (`id` int unsigned NOT NULL AUTO_INCREMENT, `username` varchar(64) NOT NULL DEFAULT "", `attribute` varchar(64) NOT NULL DEFAULT "", `op` char(2) NOT NULL DEFAULT "=", `value` varchar(253) NOT NULL DEFAULT "", PRIMARY KEY (`id`), INDEX `radreply_username` (`username` (32))) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "radgroupreply" table
+CREATE TABLE IF NOT EXISTS `radgroupreply` (`id` int unsigned NOT NULL AUTO_INCREMENT, `groupname` varchar(64) NOT NULL DEFAULT "", `attribute` varchar(64) NOT NULL DEFAULT "", `op` char(2) NOT NULL DEFAULT "=", `value` varchar(253) NOT NULL DEFAULT "", PRIMARY KEY (`id`), INDEX `radgroupreply_groupname` (`groupname` (32))) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "radusergroup" table
+CREATE TABLE IF NOT EXISTS `radusergroup` (`id` int unsigned NOT NULL AUTO_INCREMENT, `username` varchar(64) NOT NULL DEFAULT "", `groupname` varchar(64) NOT NULL DEFAULT "", `priority` int NOT NULL DEFAULT 1, PRIMARY KEY (`id`), INDEX `radusergroup_username` (`username` (32))) CHARSET utf8mb4 COLLATE utf8mb4_bin;
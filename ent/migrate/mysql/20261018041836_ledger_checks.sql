@@ -0,0 +1,2 @@
+-- Modify "client_txn" table
+ALTER TABLE `client_txn` MODIFY COLUMN `type` enum('ACTIVE','RENEWAL','REFUND','TRANSFER_REFUND','TRANSFER_RECEIVED','AUTO_RENEWAL','PACKAGE_MIGRATION','ADVANCE_PAYMENT','RECHARGE','ADJUSTMENT') NOT NULL;
@@ -0,0 +1,2 @@
+-- Create "balance_transfers" table
+CREATE TABLE `balance_transfers` (`id` bigint NOT NULL AUTO_INCREMENT, `from_username` varchar(255) NOT NULL, `to_username` varchar(255) NOT NULL, `amount` double NOT NULL, `code_hash` varchar(64) NOT NULL, `attempts` bigint NOT NULL DEFAULT 0, `status` enum('pending','completed','cancelled','expired') NOT NULL DEFAULT "pending", `debit_ref` varchar(64) NULL, `credit_ref` varchar(64) NULL, `expires_at` timestamp NOT NULL, `completed_at` timestamp NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `balancetransfer_created_at` (`created_at`), INDEX `balancetransfer_from_username_status_completed_at` (`from_username`, `status`, `completed_at`), INDEX `balancetransfer_to_username` (`to_username`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
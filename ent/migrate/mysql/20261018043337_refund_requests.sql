@@ -0,0 +1,2 @@
+-- Create "refund_requests" table
+CREATE TABLE `refund_requests` (`id` bigint NOT NULL AUTO_INCREMENT, `client_id` bigint NOT NULL, `client_username` varchar(255) NOT NULL, `kind` enum('balance','failed_charge') NOT NULL, `amount` double NOT NULL, `status` enum('requested','approved','paid','rejected') NOT NULL DEFAULT "requested", `charge_ref` varchar(64) NULL, `payout_method` enum('bkash','nagad','bank_transfer') NOT NULL, `payout_account` varchar(128) NOT NULL, `reason` longtext NOT NULL, `refund_ref` varchar(64) NULL, `payout_ref` varchar(128) NULL, `review_note` longtext NULL, `reviewed_by` varchar(255) NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `refundrequest_charge_ref` (`charge_ref`), INDEX `refundrequest_client_username` (`client_username`), INDEX `refundrequest_status` (`status`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,6 @@
+-- Modify "client_txn" table
+ALTER TABLE `client_txn` ADD COLUMN `discount` double NOT NULL DEFAULT 0, ADD COLUMN `coupon_code` varchar(64) NULL;
+-- Create "coupons" table
+CREATE TABLE `coupons` (`id` bigint NOT NULL AUTO_INCREMENT, `code` varchar(64) NOT NULL, `description` varchar(255) NULL, `discount_type` enum('percent','fixed') NOT NULL, `value` double NOT NULL, `starts_at` timestamp NULL, `expires_at` timestamp NULL, `max_uses` bigint NOT NULL DEFAULT 0, `max_uses_per_client` bigint NOT NULL DEFAULT 1, `used_count` bigint NOT NULL DEFAULT 0, `plan_ids` json NULL, `districts` json NULL, `is_active` bool NOT NULL DEFAULT 1, `created_by` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `code` (`code`), INDEX `coupon_is_active_expires_at` (`is_active`, `expires_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "coupon_redemptions" table
+CREATE TABLE `coupon_redemptions` (`id` bigint NOT NULL AUTO_INCREMENT, `coupon_id` bigint NOT NULL, `code` varchar(64) NOT NULL, `client_id` bigint NOT NULL, `client_username` varchar(255) NOT NULL, `transaction_ref` varchar(64) NOT NULL, `discount` double NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `couponredemption_coupon_id_client_id` (`coupon_id`, `client_id`), UNIQUE INDEX `transaction_ref` (`transaction_ref`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,6 @@
+-- Create "vouchers" table
+CREATE TABLE `vouchers` (`id` bigint NOT NULL AUTO_INCREMENT, `batch_id` bigint NOT NULL, `serial` varchar(32) NOT NULL, `pin_hash` varchar(64) NOT NULL, `amount` double NOT NULL, `status` enum('active','redeemed','disabled') NOT NULL DEFAULT "active", `expires_at` timestamp NULL, `redeemed_by` varchar(255) NULL, `redeemed_at` timestamp NULL, `transaction_ref` varchar(64) NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `pin_hash` (`pin_hash`), UNIQUE INDEX `serial` (`serial`), INDEX `voucher_batch_id_status` (`batch_id`, `status`), INDEX `voucher_redeemed_by` (`redeemed_by`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "voucher_attempts" table
+CREATE TABLE `voucher_attempts` (`id` bigint NOT NULL AUTO_INCREMENT, `client_username` varchar(255) NOT NULL, `ip` varchar(64) NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `voucherattempt_client_username_created_at` (`client_username`, `created_at`), INDEX `voucherattempt_ip_created_at` (`ip`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "voucher_batches" table
+CREATE TABLE `voucher_batches` (`id` bigint NOT NULL AUTO_INCREMENT, `name` varchar(255) NOT NULL, `amount` double NOT NULL, `count` bigint NOT NULL, `expires_at` timestamp NULL, `created_by` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,4 @@
+-- Create "manual_payments" table
+CREATE TABLE `manual_payments` (`id` bigint NOT NULL AUTO_INCREMENT, `transaction_ref` varchar(64) NOT NULL, `client_username` varchar(255) NOT NULL, `provider` enum('bkash','nagad') NOT NULL, `sender_number` varchar(32) NOT NULL, `trx_id` varchar(64) NOT NULL, `amount` double NOT NULL, `status` enum('pending','completed','failed') NOT NULL DEFAULT "pending", `verified_by` varchar(255) NULL, `review_note` longtext NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `manualpayment_client_username` (`client_username`), UNIQUE INDEX `manualpayment_provider_trx_id` (`provider`, `trx_id`), INDEX `manualpayment_status` (`status`), UNIQUE INDEX `transaction_ref` (`transaction_ref`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "statement_entries" table
+CREATE TABLE `statement_entries` (`id` bigint NOT NULL AUTO_INCREMENT, `provider` enum('bkash','nagad') NOT NULL, `trx_id` varchar(64) NOT NULL, `amount` double NOT NULL, `sender_number` varchar(32) NULL, `received_at` timestamp NULL, `payment_ref` varchar(64) NULL, `imported_by` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `statemententry_provider_trx_id` (`provider`, `trx_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,2 @@
+-- Create "settlement_rows" table
+CREATE TABLE `settlement_rows` (`id` bigint NOT NULL AUTO_INCREMENT, `gateway` enum('bkash','nagad','sslcommerz','stripe') NOT NULL, `transaction_ref` varchar(64) NOT NULL, `gateway_ref` varchar(128) NULL, `amount` double NOT NULL, `fee` double NOT NULL DEFAULT 0, `settled_at` timestamp NOT NULL, `status` enum('matched','unmatched','amount_mismatch','status_mismatch') NOT NULL, `txn_amount` double NULL, `source` varchar(255) NOT NULL, `imported_by` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `settlementrow_gateway_transaction_ref` (`gateway`, `transaction_ref`), INDEX `settlementrow_settled_at` (`settled_at`), INDEX `settlementrow_status` (`status`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
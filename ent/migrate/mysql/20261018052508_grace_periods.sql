@@ -0,0 +1,6 @@
+-- Modify "clients" table
+ALTER TABLE `clients` MODIFY COLUMN `status` enum('active','inactive','warning','throttled','suspended') NOT NULL DEFAULT "inactive";
+-- Modify "packages" table
+ALTER TABLE `packages` ADD COLUMN `grace_days` bigint NOT NULL DEFAULT 0;
+-- Create "grace_periods" table
+CREATE TABLE `grace_periods` (`id` bigint NOT NULL AUTO_INCREMENT, `client_id` bigint NOT NULL, `client_username` varchar(255) NOT NULL, `expired_at` timestamp NOT NULL, `grace_until` timestamp NOT NULL, `stage` enum('warning','throttled','suspended') NOT NULL, `profile` varchar(100) NOT NULL, `ended_at` timestamp NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `graceperiod_client_id_expired_at` (`client_id`, `expired_at`), INDEX `graceperiod_client_username_ended_at` (`client_username`, `ended_at`), INDEX `graceperiod_ended_at_stage` (`ended_at`, `stage`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
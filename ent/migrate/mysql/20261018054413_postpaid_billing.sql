@@ -0,0 +1,10 @@
+-- Modify "clients" table
+ALTER TABLE `clients` ADD COLUMN `billing_mode` enum('prepaid','postpaid') NOT NULL DEFAULT "prepaid", ADD COLUMN `credit_limit` double NOT NULL DEFAULT 0, ADD COLUMN `postpaid_since` timestamp NULL;
+-- Modify "client_txn" table
+ALTER TABLE `client_txn` MODIFY COLUMN `type` enum('ACTIVE','RENEWAL','REFUND','TRANSFER_REFUND','TRANSFER_RECEIVED','AUTO_RENEWAL','PACKAGE_MIGRATION','ADVANCE_PAYMENT','RECHARGE','ADJUSTMENT','POSTPAID_INVOICE') NOT NULL;
+-- Create "client_addons" table
+CREATE TABLE `client_addons` (`id` bigint NOT NULL AUTO_INCREMENT, `client_id` bigint NOT NULL, `client_username` varchar(255) NOT NULL, `name` varchar(255) NOT NULL, `amount` double NOT NULL, `active` bool NOT NULL DEFAULT 1, `created_by` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `clientaddon_client_id_active` (`client_id`, `active`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "invoices" table
+CREATE TABLE `invoices` (`id` bigint NOT NULL AUTO_INCREMENT, `number` varchar(32) NOT NULL, `client_id` bigint NOT NULL, `client_username` varchar(255) NOT NULL, `period_start` timestamp NOT NULL, `period_end` timestamp NOT NULL, `amount` double NOT NULL, `paid_amount` double NOT NULL DEFAULT 0, `status` enum('open','paid','overdue','void') NOT NULL DEFAULT "open", `due_at` timestamp NOT NULL, `paid_at` timestamp NULL, `transaction_ref` varchar(64) NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `invoice_client_id_period_start` (`client_id`, `period_start`), INDEX `invoice_client_username_status` (`client_username`, `status`), INDEX `invoice_status_due_at` (`status`, `due_at`), UNIQUE INDEX `number` (`number`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "invoice_lines" table
+CREATE TABLE `invoice_lines` (`id` bigint NOT NULL AUTO_INCREMENT, `invoice_id` bigint NOT NULL, `kind` enum('package','addon') NOT NULL, `description` varchar(255) NOT NULL, `amount` double NOT NULL, PRIMARY KEY (`id`), INDEX `invoiceline_invoice_id` (`invoice_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
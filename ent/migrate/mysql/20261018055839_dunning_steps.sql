@@ -0,0 +1,6 @@
+-- Modify "notifications" table
+ALTER TABLE `notifications` MODIFY COLUMN `type` enum('new_private_message','connection_engaged_with_question','increment_num_unseen_msg','decrement_num_unseen_msg','update_num_notifs','platform_update','payment_failed','billing_reminder') NOT NULL;
+-- Modify "notification_times" table
+ALTER TABLE `notification_times` MODIFY COLUMN `type` enum('new_private_message','connection_engaged_with_question','increment_num_unseen_msg','decrement_num_unseen_msg','update_num_notifs','platform_update','payment_failed','billing_reminder') NOT NULL;
+-- Create "dunning_steps" table
+CREATE TABLE `dunning_steps` (`id` bigint NOT NULL AUTO_INCREMENT, `client_id` bigint NOT NULL, `client_username` varchar(255) NOT NULL, `kind` enum('expiry','invoice') NOT NULL, `reference` varchar(64) NOT NULL, `due_at` timestamp NOT NULL, `offset_days` bigint NOT NULL, `level` enum('reminder','due','overdue','final') NOT NULL, `amount` double NOT NULL, `subject` varchar(255) NOT NULL, `message` longtext NOT NULL, `status` enum('pending','sent','failed') NOT NULL DEFAULT "pending", `channels` varchar(64) NULL, `error` varchar(1000) NULL, `created_at` timestamp NOT NULL, `sent_at` timestamp NULL, PRIMARY KEY (`id`), UNIQUE INDEX `dunningstep_client_id_kind_reference_offset_days` (`client_id`, `kind`, `reference`, `offset_days`), INDEX `dunningstep_client_username_created_at` (`client_username`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
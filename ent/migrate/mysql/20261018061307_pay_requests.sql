@@ -0,0 +1,2 @@
+-- Create "pay_requests" table
+CREATE TABLE `pay_requests` (`id` bigint NOT NULL AUTO_INCREMENT, `ip` varchar(64) NOT NULL, `kind` enum('lookup','checkout') NOT NULL, `client_username` varchar(255) NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `payrequest_ip_created_at` (`ip`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
@@ -0,0 +1,6 @@
+-- Create "vendors" table
+CREATE TABLE `vendors` (`id` bigint NOT NULL AUTO_INCREMENT, `name` varchar(255) NOT NULL, `c_name` varchar(32) NOT NULL, `mobile_number` varchar(255) NULL, `balance` double NOT NULL DEFAULT 0, `is_active` bool NOT NULL DEFAULT 1, `created_date` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `vendor_c_name` (`c_name`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "vendor_commissions" table
+CREATE TABLE `vendor_commissions` (`id` bigint NOT NULL AUTO_INCREMENT, `package_id` bigint NOT NULL, `vendor_id` bigint NULL, `commission_type` enum('percent','fixed') NOT NULL, `value` double NOT NULL, `updated_by` varchar(255) NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `vendorcommission_package_id_vendor_id` (`package_id`, `vendor_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "vendor_txn" table
+CREATE TABLE `vendor_txn` (`id` bigint NOT NULL AUTO_INCREMENT, `transaction_ref` varchar(64) NOT NULL, `vendor_id` bigint NOT NULL, `type` enum('TOPUP','ACTIVE','RENEWAL','ADJUSTMENT') NOT NULL, `amount` double NOT NULL, `total_balance` double NOT NULL, `client_username` varchar(255) NULL, `client_txn_ref` varchar(64) NULL, `package_id` bigint NULL, `price` double NOT NULL DEFAULT 0, `commission` double NOT NULL DEFAULT 0, `description` varchar(255) NULL, `created_by` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `transaction_ref` (`transaction_ref`), INDEX `vendortxn_client_username` (`client_username`), INDEX `vendortxn_vendor_id_created_at` (`vendor_id`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
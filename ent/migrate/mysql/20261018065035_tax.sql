@@ -0,0 +1,6 @@
+-- Modify "client_txn" table
+ALTER TABLE `client_txn` ADD COLUMN `net_amount` double NOT NULL DEFAULT 0, ADD COLUMN `tax_amount` double NOT NULL DEFAULT 0, ADD COLUMN `tax_rate` double NOT NULL DEFAULT 0, ADD COLUMN `service_type` enum('internet','addon') NULL;
+-- Modify "invoices" table
+ALTER TABLE `invoices` ADD COLUMN `net_amount` double NOT NULL DEFAULT 0, ADD COLUMN `tax_amount` double NOT NULL DEFAULT 0;
+-- Modify "invoice_lines" table
+ALTER TABLE `invoice_lines` ADD COLUMN `net_amount` double NOT NULL DEFAULT 0, ADD COLUMN `tax_amount` double NOT NULL DEFAULT 0, ADD COLUMN `tax_rate` double NOT NULL DEFAULT 0;
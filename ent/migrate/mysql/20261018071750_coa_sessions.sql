@@ -0,0 +1,6 @@
+-- Modify "radacct" table
+-- FreeRADIUS creates radacct with nasipaddress, so it is only added to databases created from the baseline
+SET @stmt = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'radacct' AND column_name = 'nasipaddress') = 0, 'ALTER TABLE `radacct` ADD COLUMN `nasipaddress` varchar(15) NOT NULL DEFAULT ""', 'DO 0');
+PREPARE stmt FROM @stmt;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
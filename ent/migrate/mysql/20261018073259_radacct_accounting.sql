@@ -0,0 +1,6 @@
+-- Modify "radacct" table
+-- FreeRADIUS creates radacct with acctupdatetime, so it is only added to databases created from the baseline
+SET @stmt = IF((SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = 'radacct' AND column_name = 'acctupdatetime') = 0, 'ALTER TABLE `radacct` ADD COLUMN `acctupdatetime` timestamp NULL', 'DO 0');
+PREPARE stmt FROM @stmt;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,6 @@
+-- Create "usage_cursors" table
+CREATE TABLE `usage_cursors` (`id` bigint NOT NULL AUTO_INCREMENT, `acctuniqueid` varchar(32) NOT NULL, `username` varchar(64) NOT NULL, `input_octets` bigint NOT NULL DEFAULT 0, `output_octets` bigint NOT NULL DEFAULT 0, `rolled_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `acctuniqueid` (`acctuniqueid`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "usage_dailies" table
+CREATE TABLE `usage_dailies` (`id` bigint NOT NULL AUTO_INCREMENT, `username` varchar(64) NOT NULL, `day` timestamp NOT NULL, `input_octets` bigint NOT NULL DEFAULT 0, `output_octets` bigint NOT NULL DEFAULT 0, PRIMARY KEY (`id`), INDEX `usagedaily_day` (`day`), UNIQUE INDEX `usagedaily_username_day` (`username`, `day`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "usage_hourlies" table
+CREATE TABLE `usage_hourlies` (`id` bigint NOT NULL AUTO_INCREMENT, `username` varchar(64) NOT NULL, `hour` timestamp NOT NULL, `input_octets` bigint NOT NULL DEFAULT 0, `output_octets` bigint NOT NULL DEFAULT 0, PRIMARY KEY (`id`), INDEX `usagehourly_hour` (`hour`), UNIQUE INDEX `usagehourly_username_hour` (`username`, `hour`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
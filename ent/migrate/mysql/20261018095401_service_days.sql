@@ -0,0 +1,2 @@
+-- Modify "client_txn" table
+ALTER TABLE `client_txn` ADD COLUMN `service_days` bigint NOT NULL DEFAULT 0;
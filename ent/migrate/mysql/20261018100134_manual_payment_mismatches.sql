@@ -0,0 +1,2 @@
+-- Modify "manual_payments" table
+ALTER TABLE `manual_payments` MODIFY COLUMN `status` enum('pending','mismatched','completed','failed') NOT NULL DEFAULT "pending";
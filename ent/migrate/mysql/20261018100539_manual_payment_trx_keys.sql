@@ -0,0 +1,6 @@
+-- Modify "manual_payments" table
+ALTER TABLE `manual_payments` DROP INDEX `manualpayment_provider_trx_id`;
+-- Modify "manual_payments" table
+ALTER TABLE `manual_payments` ADD COLUMN `trx_key` varchar(64) NULL, ADD INDEX `manualpayment_provider_trx_id` (`provider`, `trx_id`), ADD UNIQUE INDEX `manualpayment_provider_trx_key` (`provider`, `trx_key`);
+-- Keep the TrxIDs of payments that were not rejected taken
+UPDATE `manual_payments` SET `trx_key` = `trx_id` WHERE `status` <> 'failed';
@@ -9,16 +9,86 @@ import (
 )
 
 var (
+	// BalanceTransfersColumns holds the columns for the "balance_transfers" table.
+	BalanceTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "from_username", Type: field.TypeString, Size: 255},
+		{Name: "to_username", Type: field.TypeString, Size: 255},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "completed", "cancelled", "expired"}, Default: "pending"},
+		{Name: "debit_ref", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "credit_ref", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BalanceTransfersTable holds the schema information for the "balance_transfers" table.
+	BalanceTransfersTable = &schema.Table{
+		Name:       "balance_transfers",
+		Columns:    BalanceTransfersColumns,
+		PrimaryKey: []*schema.Column{BalanceTransfersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancetransfer_from_username_status_completed_at",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceTransfersColumns[1], BalanceTransfersColumns[6], BalanceTransfersColumns[10]},
+			},
+			{
+				Name:    "balancetransfer_to_username",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceTransfersColumns[2]},
+			},
+			{
+				Name:    "balancetransfer_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceTransfersColumns[11]},
+			},
+		},
+	}
+	// ClientAddonsColumns holds the columns for the "client_addons" table.
+	ClientAddonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "client_id", Type: field.TypeInt},
+		{Name: "client_username", Type: field.TypeString, Size: 255},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ClientAddonsTable holds the schema information for the "client_addons" table.
+	ClientAddonsTable = &schema.Table{
+		Name:       "client_addons",
+		Columns:    ClientAddonsColumns,
+		PrimaryKey: []*schema.Column{ClientAddonsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "clientaddon_client_id_active",
+				Unique:  false,
+				Columns: []*schema.Column{ClientAddonsColumns[1], ClientAddonsColumns[5]},
+			},
+		},
+	}
 	// ClientTxnColumns holds the columns for the "client_txn" table.
 	ClientTxnColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "transaction_ref", Type: field.TypeString, Unique: true, Size: 64},
 		{Name: "balance", Type: field.TypeFloat64, Default: 0},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"ACTIVE", "RENEWAL", "REFUND", "TRANSFER_REFUND", "TRANSFER_RECEIVED", "AUTO_RENEWAL", "PACKAGE_MIGRATION", "ADVANCE_PAYMENT"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"ACTIVE", "RENEWAL", "REFUND", "TRANSFER_REFUND", "TRANSFER_RECEIVED", "AUTO_RENEWAL", "PACKAGE_MIGRATION", "ADVANCE_PAYMENT", "RECHARGE", "ADJUSTMENT", "POSTPAID_INVOICE"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "completed", "failed", "reversed"}, Default: "completed"},
 		{Name: "total_balance", Type: field.TypeFloat64, Default: 0},
 		{Name: "payment_method", Type: field.TypeEnum, Nullable: true, Enums: []string{"vendor_balance", "client_balance", "cash", "bank_transfer", "mobile_banking", "card", "gateway_sslcommerz", "gateway_bkash", "gateway_nagad", "gateway_stripe", "gateway_paypal", "free", "other"}},
+		{Name: "gateway_ref", Type: field.TypeString, Nullable: true, Size: 128},
 		{Name: "client_username", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "discount", Type: field.TypeFloat64, Default: 0},
+		{Name: "coupon_code", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "net_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "tax_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "tax_rate", Type: field.TypeFloat64, Default: 0},
+		{Name: "service_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"internet", "addon"}},
+		{Name: "service_days", Type: field.TypeInt, Default: 0},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "transaction_date", Type: field.TypeTime},
 		{Name: "created_by", Type: field.TypeString, Size: 255},
@@ -37,7 +107,7 @@ var (
 			{
 				Name:    "clienttxn_client_username",
 				Unique:  false,
-				Columns: []*schema.Column{ClientTxnColumns[7]},
+				Columns: []*schema.Column{ClientTxnColumns[8]},
 			},
 			{
 				Name:    "clienttxn_status",
@@ -52,7 +122,12 @@ var (
 			{
 				Name:    "clienttxn_transaction_date",
 				Unique:  false,
-				Columns: []*schema.Column{ClientTxnColumns[9]},
+				Columns: []*schema.Column{ClientTxnColumns[17]},
+			},
+			{
+				Name:    "clienttxn_gateway_ref",
+				Unique:  false,
+				Columns: []*schema.Column{ClientTxnColumns[7]},
 			},
 		},
 	}
@@ -67,6 +142,9 @@ var (
 		{Name: "photo", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "balance", Type: field.TypeFloat64, Default: 0},
+		{Name: "billing_mode", Type: field.TypeEnum, Enums: []string{"prepaid", "postpaid"}, Default: "prepaid"},
+		{Name: "credit_limit", Type: field.TypeFloat64, Default: 0},
+		{Name: "postpaid_since", Type: field.TypeTime, Nullable: true},
 		{Name: "address_line1", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "address_line2", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "city", Type: field.TypeString, Nullable: true, Size: 255},
@@ -74,7 +152,7 @@ var (
 		{Name: "upazila", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "union_name", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "zip", Type: field.TypeString, Nullable: true, Size: 255},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive"}, Default: "inactive"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "inactive", "warning", "throttled", "suspended"}, Default: "inactive"},
 		{Name: "payment_date", Type: field.TypeTime, Nullable: true},
 		{Name: "payment_type", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "auto_renew", Type: field.TypeBool, Default: true},
@@ -107,47 +185,47 @@ var (
 			{
 				Name:    "clientuser_c_name",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[20]},
+				Columns: []*schema.Column{ClientsColumns[23]},
 			},
 			{
 				Name:    "clientuser_vendor_id",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[21]},
+				Columns: []*schema.Column{ClientsColumns[24]},
 			},
 			{
 				Name:    "clientuser_package_pool",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[22]},
+				Columns: []*schema.Column{ClientsColumns[25]},
 			},
 			{
 				Name:    "clientuser_user_profile",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[23]},
+				Columns: []*schema.Column{ClientsColumns[26]},
 			},
 			{
 				Name:    "clientuser_next_user_profile",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[24]},
+				Columns: []*schema.Column{ClientsColumns[27]},
 			},
 			{
 				Name:    "clientuser_auto_renew",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[19]},
+				Columns: []*schema.Column{ClientsColumns[22]},
 			},
 			{
 				Name:    "clientuser_created_date",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[27]},
+				Columns: []*schema.Column{ClientsColumns[30]},
 			},
 			{
 				Name:    "clientuser_created_by",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[25]},
+				Columns: []*schema.Column{ClientsColumns[28]},
 			},
 			{
 				Name:    "clientuser_status",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[16]},
+				Columns: []*schema.Column{ClientsColumns[19]},
 			},
 			{
 				Name:    "clientuser_name",
@@ -162,37 +240,129 @@ var (
 			{
 				Name:    "clientuser_c_name_status_created_date",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[20], ClientsColumns[16], ClientsColumns[27]},
+				Columns: []*schema.Column{ClientsColumns[23], ClientsColumns[19], ClientsColumns[30]},
 			},
 			{
 				Name:    "clientuser_c_name_vendor_id_status_created_date",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[20], ClientsColumns[21], ClientsColumns[16], ClientsColumns[27]},
+				Columns: []*schema.Column{ClientsColumns[23], ClientsColumns[24], ClientsColumns[19], ClientsColumns[30]},
 			},
 			{
 				Name:    "clientuser_package_pool_status_created_date",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[22], ClientsColumns[16], ClientsColumns[27]},
+				Columns: []*schema.Column{ClientsColumns[25], ClientsColumns[19], ClientsColumns[30]},
 			},
 			{
 				Name:    "clientuser_auto_renew_status_c_name_created_date",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[19], ClientsColumns[16], ClientsColumns[20], ClientsColumns[27]},
+				Columns: []*schema.Column{ClientsColumns[22], ClientsColumns[19], ClientsColumns[23], ClientsColumns[30]},
 			},
 			{
 				Name:    "clientuser_vendor_id_package_pool_status",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[21], ClientsColumns[22], ClientsColumns[16]},
+				Columns: []*schema.Column{ClientsColumns[24], ClientsColumns[25], ClientsColumns[19]},
 			},
 			{
 				Name:    "clientuser_c_name_vendor_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[20], ClientsColumns[21], ClientsColumns[16]},
+				Columns: []*schema.Column{ClientsColumns[23], ClientsColumns[24], ClientsColumns[19]},
 			},
 			{
 				Name:    "clientuser_updated_date_status",
 				Unique:  false,
-				Columns: []*schema.Column{ClientsColumns[28], ClientsColumns[16]},
+				Columns: []*schema.Column{ClientsColumns[31], ClientsColumns[19]},
+			},
+		},
+	}
+	// CouponsColumns holds the columns for the "coupons" table.
+	CouponsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "discount_type", Type: field.TypeEnum, Enums: []string{"percent", "fixed"}},
+		{Name: "value", Type: field.TypeFloat64},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "max_uses_per_client", Type: field.TypeInt, Default: 1},
+		{Name: "used_count", Type: field.TypeInt, Default: 0},
+		{Name: "plan_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "districts", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CouponsTable holds the schema information for the "coupons" table.
+	CouponsTable = &schema.Table{
+		Name:       "coupons",
+		Columns:    CouponsColumns,
+		PrimaryKey: []*schema.Column{CouponsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "coupon_is_active_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{CouponsColumns[12], CouponsColumns[6]},
+			},
+		},
+	}
+	// CouponRedemptionsColumns holds the columns for the "coupon_redemptions" table.
+	CouponRedemptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "coupon_id", Type: field.TypeInt},
+		{Name: "code", Type: field.TypeString, Size: 64},
+		{Name: "client_id", Type: field.TypeInt},
+		{Name: "client_username", Type: field.TypeString, Size: 255},
+		{Name: "transaction_ref", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "discount", Type: field.TypeFloat64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CouponRedemptionsTable holds the schema information for the "coupon_redemptions" table.
+	CouponRedemptionsTable = &schema.Table{
+		Name:       "coupon_redemptions",
+		Columns:    CouponRedemptionsColumns,
+		PrimaryKey: []*schema.Column{CouponRedemptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "couponredemption_coupon_id_client_id",
+				Unique:  false,
+				Columns: []*schema.Column{CouponRedemptionsColumns[1], CouponRedemptionsColumns[3]},
+			},
+		},
+	}
+	// DunningStepsColumns holds the columns for the "dunning_steps" table.
+	DunningStepsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "client_id", Type: field.TypeInt},
+		{Name: "client_username", Type: field.TypeString, Size: 255},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"expiry", "invoice"}},
+		{Name: "reference", Type: field.TypeString, Size: 64},
+		{Name: "due_at", Type: field.TypeTime},
+		{Name: "offset_days", Type: field.TypeInt},
+		{Name: "level", Type: field.TypeEnum, Enums: []string{"reminder", "due", "overdue", "final"}},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "subject", Type: field.TypeString, Size: 255},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "failed"}, Default: "pending"},
+		{Name: "channels", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DunningStepsTable holds the schema information for the "dunning_steps" table.
+	DunningStepsTable = &schema.Table{
+		Name:       "dunning_steps",
+		Columns:    DunningStepsColumns,
+		PrimaryKey: []*schema.Column{DunningStepsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dunningstep_client_id_kind_reference_offset_days",
+				Unique:  true,
+				Columns: []*schema.Column{DunningStepsColumns[1], DunningStepsColumns[3], DunningStepsColumns[4], DunningStepsColumns[6]},
+			},
+			{
+				Name:    "dunningstep_client_username_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DunningStepsColumns[2], DunningStepsColumns[14]},
 			},
 		},
 	}
@@ -293,6 +463,42 @@ var (
 			},
 		},
 	}
+	// GracePeriodsColumns holds the columns for the "grace_periods" table.
+	GracePeriodsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "client_id", Type: field.TypeInt},
+		{Name: "client_username", Type: field.TypeString, Size: 255},
+		{Name: "expired_at", Type: field.TypeTime},
+		{Name: "grace_until", Type: field.TypeTime},
+		{Name: "stage", Type: field.TypeEnum, Enums: []string{"warning", "throttled", "suspended"}},
+		{Name: "profile", Type: field.TypeString, Size: 100},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GracePeriodsTable holds the schema information for the "grace_periods" table.
+	GracePeriodsTable = &schema.Table{
+		Name:       "grace_periods",
+		Columns:    GracePeriodsColumns,
+		PrimaryKey: []*schema.Column{GracePeriodsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "graceperiod_client_id_expired_at",
+				Unique:  true,
+				Columns: []*schema.Column{GracePeriodsColumns[1], GracePeriodsColumns[3]},
+			},
+			{
+				Name:    "graceperiod_client_username_ended_at",
+				Unique:  false,
+				Columns: []*schema.Column{GracePeriodsColumns[2], GracePeriodsColumns[7]},
+			},
+			{
+				Name:    "graceperiod_ended_at_stage",
+				Unique:  false,
+				Columns: []*schema.Column{GracePeriodsColumns[7], GracePeriodsColumns[5]},
+			},
+		},
+	}
 	// ImagesColumns holds the columns for the "images" table.
 	ImagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -369,6 +575,72 @@ var (
 			},
 		},
 	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "client_id", Type: field.TypeInt},
+		{Name: "client_username", Type: field.TypeString, Size: 255},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "net_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "tax_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "paid_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"open", "paid", "overdue", "void"}, Default: "open"},
+		{Name: "due_at", Type: field.TypeTime},
+		{Name: "paid_at", Type: field.TypeTime, Nullable: true},
+		{Name: "transaction_ref", Type: field.TypeString, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_client_id_period_start",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[2], InvoicesColumns[4]},
+			},
+			{
+				Name:    "invoice_client_username_status",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[3], InvoicesColumns[10]},
+			},
+			{
+				Name:    "invoice_status_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[10], InvoicesColumns[11]},
+			},
+		},
+	}
+	// InvoiceLinesColumns holds the columns for the "invoice_lines" table.
+	InvoiceLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "invoice_id", Type: field.TypeInt},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"package", "addon"}},
+		{Name: "description", Type: field.TypeString, Size: 255},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "net_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "tax_amount", Type: field.TypeFloat64, Default: 0},
+		{Name: "tax_rate", Type: field.TypeFloat64, Default: 0},
+	}
+	// InvoiceLinesTable holds the schema information for the "invoice_lines" table.
+	InvoiceLinesTable = &schema.Table{
+		Name:       "invoice_lines",
+		Columns:    InvoiceLinesColumns,
+		PrimaryKey: []*schema.Column{InvoiceLinesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoiceline_invoice_id",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceLinesColumns[1]},
+			},
+		},
+	}
 	// LastSeenOnlinesColumns holds the columns for the "last_seen_onlines" table.
 	LastSeenOnlinesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -389,6 +661,50 @@ var (
 			},
 		},
 	}
+	// ManualPaymentsColumns holds the columns for the "manual_payments" table.
+	ManualPaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "transaction_ref", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "client_username", Type: field.TypeString, Size: 255},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"bkash", "nagad"}},
+		{Name: "sender_number", Type: field.TypeString, Size: 32},
+		{Name: "trx_id", Type: field.TypeString, Size: 64},
+		{Name: "trx_key", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "mismatched", "completed", "failed"}, Default: "pending"},
+		{Name: "verified_by", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "review_note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ManualPaymentsTable holds the schema information for the "manual_payments" table.
+	ManualPaymentsTable = &schema.Table{
+		Name:       "manual_payments",
+		Columns:    ManualPaymentsColumns,
+		PrimaryKey: []*schema.Column{ManualPaymentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "manualpayment_provider_trx_id",
+				Unique:  false,
+				Columns: []*schema.Column{ManualPaymentsColumns[3], ManualPaymentsColumns[5]},
+			},
+			{
+				Name:    "manualpayment_provider_trx_key",
+				Unique:  true,
+				Columns: []*schema.Column{ManualPaymentsColumns[3], ManualPaymentsColumns[6]},
+			},
+			{
+				Name:    "manualpayment_client_username",
+				Unique:  false,
+				Columns: []*schema.Column{ManualPaymentsColumns[2]},
+			},
+			{
+				Name:    "manualpayment_status",
+				Unique:  false,
+				Columns: []*schema.Column{ManualPaymentsColumns[8]},
+			},
+		},
+	}
 	// MonthlySubscriptionsColumns holds the columns for the "monthly_subscriptions" table.
 	MonthlySubscriptionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -429,7 +745,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"new_private_message", "connection_engaged_with_question", "increment_num_unseen_msg", "decrement_num_unseen_msg", "update_num_notifs", "platform_update", "payment_failed"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"new_private_message", "connection_engaged_with_question", "increment_num_unseen_msg", "decrement_num_unseen_msg", "update_num_notifs", "platform_update", "payment_failed", "billing_reminder"}},
 		{Name: "title", Type: field.TypeString, Default: ""},
 		{Name: "text", Type: field.TypeString},
 		{Name: "link", Type: field.TypeString, Nullable: true},
@@ -490,7 +806,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"new_private_message", "connection_engaged_with_question", "increment_num_unseen_msg", "decrement_num_unseen_msg", "update_num_notifs", "platform_update", "payment_failed"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"new_private_message", "connection_engaged_with_question", "increment_num_unseen_msg", "decrement_num_unseen_msg", "update_num_notifs", "platform_update", "payment_failed", "billing_reminder"}},
 		{Name: "send_minute", Type: field.TypeInt},
 		{Name: "profile_id", Type: field.TypeInt},
 	}
@@ -524,6 +840,7 @@ var (
 		{Name: "price", Type: field.TypeFloat64, Default: 0},
 		{Name: "currency", Type: field.TypeString, Size: 3, Default: "BDT"},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "grace_days", Type: field.TypeInt, Default: 0},
 		{Name: "created_date", Type: field.TypeTime},
 	}
 	// PackagesTable holds the schema information for the "packages" table.
@@ -549,6 +866,27 @@ var (
 			},
 		},
 	}
+	// PayRequestsColumns holds the columns for the "pay_requests" table.
+	PayRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "ip", Type: field.TypeString, Size: 64},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"lookup", "checkout"}},
+		{Name: "client_username", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PayRequestsTable holds the schema information for the "pay_requests" table.
+	PayRequestsTable = &schema.Table{
+		Name:       "pay_requests",
+		Columns:    PayRequestsColumns,
+		PrimaryKey: []*schema.Column{PayRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "payrequest_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PayRequestsColumns[1], PayRequestsColumns[4]},
+			},
+		},
+	}
 	// PhoneVerificationCodesColumns holds the columns for the "phone_verification_codes" table.
 	PhoneVerificationCodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -660,11 +998,13 @@ var (
 		{Name: "acctuniqueid", Type: field.TypeString, Unique: true, Size: 32},
 		{Name: "username", Type: field.TypeString, Size: 64},
 		{Name: "acctstarttime", Type: field.TypeTime, Nullable: true},
+		{Name: "acctupdatetime", Type: field.TypeTime, Nullable: true},
 		{Name: "acctstoptime", Type: field.TypeTime, Nullable: true},
 		{Name: "acctsessiontime", Type: field.TypeUint32, Nullable: true},
 		{Name: "acctinputoctets", Type: field.TypeInt64, Nullable: true},
 		{Name: "acctoutputoctets", Type: field.TypeInt64, Nullable: true},
 		{Name: "framedipaddress", Type: field.TypeString, Size: 15},
+		{Name: "nasipaddress", Type: field.TypeString, Size: 15, Default: ""},
 		{Name: "acctterminatecause", Type: field.TypeString, Size: 32},
 	}
 	// RadacctTable holds the schema information for the "radacct" table.
@@ -686,12 +1026,149 @@ var (
 			{
 				Name:    "radacct_acctstoptime",
 				Unique:  false,
-				Columns: []*schema.Column{RadacctColumns[5]},
+				Columns: []*schema.Column{RadacctColumns[6]},
 			},
 			{
 				Name:    "radacct_framedipaddress",
 				Unique:  false,
-				Columns: []*schema.Column{RadacctColumns[9]},
+				Columns: []*schema.Column{RadacctColumns[10]},
+			},
+		},
+	}
+	// RadcheckColumns holds the columns for the "radcheck" table.
+	RadcheckColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"mysql": "int unsigned"}},
+		{Name: "username", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "attribute", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "op", Type: field.TypeString, Size: 2, Default: "==", SchemaType: map[string]string{"mysql": "char(2)"}},
+		{Name: "value", Type: field.TypeString, Size: 253, Default: ""},
+	}
+	// RadcheckTable holds the schema information for the "radcheck" table.
+	RadcheckTable = &schema.Table{
+		Name:       "radcheck",
+		Columns:    RadcheckColumns,
+		PrimaryKey: []*schema.Column{RadcheckColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "radcheck_username",
+				Unique:  false,
+				Columns: []*schema.Column{RadcheckColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 32,
+				},
+			},
+		},
+	}
+	// RadgroupreplyColumns holds the columns for the "radgroupreply" table.
+	RadgroupreplyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"mysql": "int unsigned"}},
+		{Name: "groupname", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "attribute", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "op", Type: field.TypeString, Size: 2, Default: "=", SchemaType: map[string]string{"mysql": "char(2)"}},
+		{Name: "value", Type: field.TypeString, Size: 253, Default: ""},
+	}
+	// RadgroupreplyTable holds the schema information for the "radgroupreply" table.
+	RadgroupreplyTable = &schema.Table{
+		Name:       "radgroupreply",
+		Columns:    RadgroupreplyColumns,
+		PrimaryKey: []*schema.Column{RadgroupreplyColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "radgroupreply_groupname",
+				Unique:  false,
+				Columns: []*schema.Column{RadgroupreplyColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 32,
+				},
+			},
+		},
+	}
+	// RadreplyColumns holds the columns for the "radreply" table.
+	RadreplyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"mysql": "int unsigned"}},
+		{Name: "username", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "attribute", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "op", Type: field.TypeString, Size: 2, Default: "=", SchemaType: map[string]string{"mysql": "char(2)"}},
+		{Name: "value", Type: field.TypeString, Size: 253, Default: ""},
+	}
+	// RadreplyTable holds the schema information for the "radreply" table.
+	RadreplyTable = &schema.Table{
+		Name:       "radreply",
+		Columns:    RadreplyColumns,
+		PrimaryKey: []*schema.Column{RadreplyColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "radreply_username",
+				Unique:  false,
+				Columns: []*schema.Column{RadreplyColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 32,
+				},
+			},
+		},
+	}
+	// RadusergroupColumns holds the columns for the "radusergroup" table.
+	RadusergroupColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true, SchemaType: map[string]string{"mysql": "int unsigned"}},
+		{Name: "username", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "groupname", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "priority", Type: field.TypeInt, Default: 1, SchemaType: map[string]string{"mysql": "int"}},
+	}
+	// RadusergroupTable holds the schema information for the "radusergroup" table.
+	RadusergroupTable = &schema.Table{
+		Name:       "radusergroup",
+		Columns:    RadusergroupColumns,
+		PrimaryKey: []*schema.Column{RadusergroupColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "radusergroup_username",
+				Unique:  false,
+				Columns: []*schema.Column{RadusergroupColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Prefix: 32,
+				},
+			},
+		},
+	}
+	// RefundRequestsColumns holds the columns for the "refund_requests" table.
+	RefundRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "client_id", Type: field.TypeInt},
+		{Name: "client_username", Type: field.TypeString, Size: 255},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"balance", "failed_charge"}},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"requested", "approved", "paid", "rejected"}, Default: "requested"},
+		{Name: "charge_ref", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "payout_method", Type: field.TypeEnum, Enums: []string{"bkash", "nagad", "bank_transfer"}},
+		{Name: "payout_account", Type: field.TypeString, Size: 128},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "refund_ref", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "payout_ref", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "review_note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "reviewed_by", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RefundRequestsTable holds the schema information for the "refund_requests" table.
+	RefundRequestsTable = &schema.Table{
+		Name:       "refund_requests",
+		Columns:    RefundRequestsColumns,
+		PrimaryKey: []*schema.Column{RefundRequestsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refundrequest_client_username",
+				Unique:  false,
+				Columns: []*schema.Column{RefundRequestsColumns[2]},
+			},
+			{
+				Name:    "refundrequest_status",
+				Unique:  false,
+				Columns: []*schema.Column{RefundRequestsColumns[5]},
+			},
+			{
+				Name:    "refundrequest_charge_ref",
+				Unique:  false,
+				Columns: []*schema.Column{RefundRequestsColumns[6]},
 			},
 		},
 	}
@@ -717,6 +1194,69 @@ var (
 			},
 		},
 	}
+	// SettlementRowsColumns holds the columns for the "settlement_rows" table.
+	SettlementRowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "gateway", Type: field.TypeEnum, Enums: []string{"bkash", "nagad", "sslcommerz", "stripe"}},
+		{Name: "transaction_ref", Type: field.TypeString, Size: 64},
+		{Name: "gateway_ref", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "fee", Type: field.TypeFloat64, Default: 0},
+		{Name: "settled_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"matched", "unmatched", "amount_mismatch", "status_mismatch"}},
+		{Name: "txn_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "source", Type: field.TypeString, Size: 255},
+		{Name: "imported_by", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SettlementRowsTable holds the schema information for the "settlement_rows" table.
+	SettlementRowsTable = &schema.Table{
+		Name:       "settlement_rows",
+		Columns:    SettlementRowsColumns,
+		PrimaryKey: []*schema.Column{SettlementRowsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "settlementrow_gateway_transaction_ref",
+				Unique:  true,
+				Columns: []*schema.Column{SettlementRowsColumns[1], SettlementRowsColumns[2]},
+			},
+			{
+				Name:    "settlementrow_settled_at",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementRowsColumns[6]},
+			},
+			{
+				Name:    "settlementrow_status",
+				Unique:  false,
+				Columns: []*schema.Column{SettlementRowsColumns[7]},
+			},
+		},
+	}
+	// StatementEntriesColumns holds the columns for the "statement_entries" table.
+	StatementEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"bkash", "nagad"}},
+		{Name: "trx_id", Type: field.TypeString, Size: 64},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "sender_number", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "received_at", Type: field.TypeTime, Nullable: true},
+		{Name: "payment_ref", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "imported_by", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// StatementEntriesTable holds the schema information for the "statement_entries" table.
+	StatementEntriesTable = &schema.Table{
+		Name:       "statement_entries",
+		Columns:    StatementEntriesColumns,
+		PrimaryKey: []*schema.Column{StatementEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "statemententry_provider_trx_id",
+				Unique:  true,
+				Columns: []*schema.Column{StatementEntriesColumns[1], StatementEntriesColumns[2]},
+			},
+		},
+	}
 	// TicketsColumns holds the columns for the "tickets" table.
 	TicketsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -757,6 +1297,73 @@ var (
 			},
 		},
 	}
+	// UsageCursorsColumns holds the columns for the "usage_cursors" table.
+	UsageCursorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "acctuniqueid", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "username", Type: field.TypeString, Size: 64},
+		{Name: "input_octets", Type: field.TypeInt64, Default: 0},
+		{Name: "output_octets", Type: field.TypeInt64, Default: 0},
+		{Name: "rolled_at", Type: field.TypeTime},
+	}
+	// UsageCursorsTable holds the schema information for the "usage_cursors" table.
+	UsageCursorsTable = &schema.Table{
+		Name:       "usage_cursors",
+		Columns:    UsageCursorsColumns,
+		PrimaryKey: []*schema.Column{UsageCursorsColumns[0]},
+	}
+	// UsageDailiesColumns holds the columns for the "usage_dailies" table.
+	UsageDailiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Size: 64},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "input_octets", Type: field.TypeInt64, Default: 0},
+		{Name: "output_octets", Type: field.TypeInt64, Default: 0},
+	}
+	// UsageDailiesTable holds the schema information for the "usage_dailies" table.
+	UsageDailiesTable = &schema.Table{
+		Name:       "usage_dailies",
+		Columns:    UsageDailiesColumns,
+		PrimaryKey: []*schema.Column{UsageDailiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagedaily_username_day",
+				Unique:  true,
+				Columns: []*schema.Column{UsageDailiesColumns[1], UsageDailiesColumns[2]},
+			},
+			{
+				Name:    "usagedaily_day",
+				Unique:  false,
+				Columns: []*schema.Column{UsageDailiesColumns[2]},
+			},
+		},
+	}
+	// UsageHourliesColumns holds the columns for the "usage_hourlies" table.
+	UsageHourliesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString, Size: 64},
+		{Name: "hour", Type: field.TypeTime},
+		{Name: "input_octets", Type: field.TypeInt64, Default: 0},
+		{Name: "output_octets", Type: field.TypeInt64, Default: 0},
+	}
+	// UsageHourliesTable holds the schema information for the "usage_hourlies" table.
+	UsageHourliesTable = &schema.Table{
+		Name:       "usage_hourlies",
+		Columns:    UsageHourliesColumns,
+		PrimaryKey: []*schema.Column{UsageHourliesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagehourly_username_hour",
+				Unique:  true,
+				Columns: []*schema.Column{UsageHourliesColumns[1], UsageHourliesColumns[2]},
+			},
+			{
+				Name:    "usagehourly_hour",
+				Unique:  false,
+				Columns: []*schema.Column{UsageHourliesColumns[2]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -775,6 +1382,160 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// VendorsColumns holds the columns for the "vendors" table.
+	VendorsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "c_name", Type: field.TypeString, Size: 32},
+		{Name: "mobile_number", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "balance", Type: field.TypeFloat64, Default: 0},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_date", Type: field.TypeTime},
+	}
+	// VendorsTable holds the schema information for the "vendors" table.
+	VendorsTable = &schema.Table{
+		Name:       "vendors",
+		Columns:    VendorsColumns,
+		PrimaryKey: []*schema.Column{VendorsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vendor_c_name",
+				Unique:  false,
+				Columns: []*schema.Column{VendorsColumns[2]},
+			},
+		},
+	}
+	// VendorCommissionsColumns holds the columns for the "vendor_commissions" table.
+	VendorCommissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "package_id", Type: field.TypeInt},
+		{Name: "vendor_id", Type: field.TypeInt, Nullable: true},
+		{Name: "commission_type", Type: field.TypeEnum, Enums: []string{"percent", "fixed"}},
+		{Name: "value", Type: field.TypeFloat64},
+		{Name: "updated_by", Type: field.TypeString, Size: 255},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// VendorCommissionsTable holds the schema information for the "vendor_commissions" table.
+	VendorCommissionsTable = &schema.Table{
+		Name:       "vendor_commissions",
+		Columns:    VendorCommissionsColumns,
+		PrimaryKey: []*schema.Column{VendorCommissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vendorcommission_package_id_vendor_id",
+				Unique:  true,
+				Columns: []*schema.Column{VendorCommissionsColumns[1], VendorCommissionsColumns[2]},
+			},
+		},
+	}
+	// VendorTxnColumns holds the columns for the "vendor_txn" table.
+	VendorTxnColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "transaction_ref", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "vendor_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"TOPUP", "ACTIVE", "RENEWAL", "ADJUSTMENT"}},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "total_balance", Type: field.TypeFloat64},
+		{Name: "client_username", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "client_txn_ref", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "package_id", Type: field.TypeInt, Nullable: true},
+		{Name: "price", Type: field.TypeFloat64, Default: 0},
+		{Name: "commission", Type: field.TypeFloat64, Default: 0},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VendorTxnTable holds the schema information for the "vendor_txn" table.
+	VendorTxnTable = &schema.Table{
+		Name:       "vendor_txn",
+		Columns:    VendorTxnColumns,
+		PrimaryKey: []*schema.Column{VendorTxnColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vendortxn_vendor_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VendorTxnColumns[2], VendorTxnColumns[13]},
+			},
+			{
+				Name:    "vendortxn_client_username",
+				Unique:  false,
+				Columns: []*schema.Column{VendorTxnColumns[6]},
+			},
+		},
+	}
+	// VouchersColumns holds the columns for the "vouchers" table.
+	VouchersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "batch_id", Type: field.TypeInt},
+		{Name: "serial", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "pin_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "redeemed", "disabled"}, Default: "active"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "redeemed_by", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "redeemed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "transaction_ref", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VouchersTable holds the schema information for the "vouchers" table.
+	VouchersTable = &schema.Table{
+		Name:       "vouchers",
+		Columns:    VouchersColumns,
+		PrimaryKey: []*schema.Column{VouchersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "voucher_batch_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{VouchersColumns[1], VouchersColumns[5]},
+			},
+			{
+				Name:    "voucher_redeemed_by",
+				Unique:  false,
+				Columns: []*schema.Column{VouchersColumns[7]},
+			},
+		},
+	}
+	// VoucherAttemptsColumns holds the columns for the "voucher_attempts" table.
+	VoucherAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "client_username", Type: field.TypeString, Size: 255},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VoucherAttemptsTable holds the schema information for the "voucher_attempts" table.
+	VoucherAttemptsTable = &schema.Table{
+		Name:       "voucher_attempts",
+		Columns:    VoucherAttemptsColumns,
+		PrimaryKey: []*schema.Column{VoucherAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "voucherattempt_client_username_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VoucherAttemptsColumns[1], VoucherAttemptsColumns[3]},
+			},
+			{
+				Name:    "voucherattempt_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VoucherAttemptsColumns[2], VoucherAttemptsColumns[3]},
+			},
+		},
+	}
+	// VoucherBatchesColumns holds the columns for the "voucher_batches" table.
+	VoucherBatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "count", Type: field.TypeInt},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VoucherBatchesTable holds the schema information for the "voucher_batches" table.
+	VoucherBatchesTable = &schema.Table{
+		Name:       "voucher_batches",
+		Columns:    VoucherBatchesColumns,
+		PrimaryKey: []*schema.Column{VoucherBatchesColumns[0]},
+	}
 	// EmailSubscriptionSubscriptionsColumns holds the columns for the "email_subscription_subscriptions" table.
 	EmailSubscriptionSubscriptionsColumns = []*schema.Column{
 		{Name: "email_subscription_id", Type: field.TypeInt},
@@ -852,29 +1613,55 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BalanceTransfersTable,
+		ClientAddonsTable,
 		ClientTxnTable,
 		ClientsTable,
+		CouponsTable,
+		CouponRedemptionsTable,
+		DunningStepsTable,
 		EmailSubscriptionsTable,
 		EmailSubscriptionTypesTable,
 		EmojisTable,
 		FcmSubscriptionsTable,
 		FileStoragesTable,
+		GracePeriodsTable,
 		ImagesTable,
 		ImageSizesTable,
 		InvitationsTable,
+		InvoicesTable,
+		InvoiceLinesTable,
 		LastSeenOnlinesTable,
+		ManualPaymentsTable,
 		MonthlySubscriptionsTable,
 		NotificationsTable,
 		NotificationPermissionsTable,
 		NotificationTimesTable,
 		PackagesTable,
+		PayRequestsTable,
 		PhoneVerificationCodesTable,
 		ProfilesTable,
 		PwaPushSubscriptionsTable,
 		RadacctTable,
+		RadcheckTable,
+		RadgroupreplyTable,
+		RadreplyTable,
+		RadusergroupTable,
+		RefundRequestsTable,
 		SentEmailsTable,
+		SettlementRowsTable,
+		StatementEntriesTable,
 		TicketsTable,
+		UsageCursorsTable,
+		UsageDailiesTable,
+		UsageHourliesTable,
 		UsersTable,
+		VendorsTable,
+		VendorCommissionsTable,
+		VendorTxnTable,
+		VouchersTable,
+		VoucherAttemptsTable,
+		VoucherBatchesTable,
 		EmailSubscriptionSubscriptionsTable,
 		MonthlySubscriptionBenefactorsTable,
 		ProfileFriendsTable,
@@ -908,7 +1695,28 @@ func init() {
 	RadacctTable.Annotation = &entsql.Annotation{
 		Table: "radacct",
 	}
+	RadcheckTable.Annotation = &entsql.Annotation{
+		Table: "radcheck",
+	}
+	RadgroupreplyTable.Annotation = &entsql.Annotation{
+		Table: "radgroupreply",
+	}
+	RadreplyTable.Annotation = &entsql.Annotation{
+		Table: "radreply",
+	}
+	RadusergroupTable.Annotation = &entsql.Annotation{
+		Table: "radusergroup",
+	}
 	SentEmailsTable.ForeignKeys[0].RefTable = ProfilesTable
+	VendorsTable.Annotation = &entsql.Annotation{
+		Table: "vendors",
+	}
+	VendorCommissionsTable.Annotation = &entsql.Annotation{
+		Table: "vendor_commissions",
+	}
+	VendorTxnTable.Annotation = &entsql.Annotation{
+		Table: "vendor_txn",
+	}
 	EmailSubscriptionSubscriptionsTable.ForeignKeys[0].RefTable = EmailSubscriptionsTable
 	EmailSubscriptionSubscriptionsTable.ForeignKeys[1].RefTable = EmailSubscriptionTypesTable
 	MonthlySubscriptionBenefactorsTable.ForeignKeys[0].RefTable = MonthlySubscriptionsTable
@@ -119,6 +119,7 @@ const (
 	TypeUpdateNumNotifs               Type = "update_num_notifs"
 	TypePlatformUpdate                Type = "platform_update"
 	TypePaymentFailed                 Type = "payment_failed"
+	TypeBillingReminder               Type = "billing_reminder"
 )
 
 func (_type Type) String() string {
@@ -128,7 +129,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeNewPrivateMessage, TypeConnectionEngagedWithQuestion, TypeIncrementNumUnseenMsg, TypeDecrementNumUnseenMsg, TypeUpdateNumNotifs, TypePlatformUpdate, TypePaymentFailed:
+	case TypeNewPrivateMessage, TypeConnectionEngagedWithQuestion, TypeIncrementNumUnseenMsg, TypeDecrementNumUnseenMsg, TypeUpdateNumNotifs, TypePlatformUpdate, TypePaymentFailed, TypeBillingReminder:
 		return nil
 	default:
 		return fmt.Errorf("notification: invalid enum value for type field: %q", _type)
@@ -29,6 +29,8 @@ type PackagePlan struct {
 	Currency string `json:"currency,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
+	// Days an expired client stays connected, first warned and then throttled, before being suspended
+	GraceDays int `json:"grace_days,omitempty"`
 	// CreatedDate holds the value of the "created_date" field.
 	CreatedDate  time.Time `json:"created_date,omitempty"`
 	selectValues sql.SelectValues
@@ -43,7 +45,7 @@ func (*PackagePlan) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case packageplan.FieldPrice:
 			values[i] = new(sql.NullFloat64)
-		case packageplan.FieldID:
+		case packageplan.FieldID, packageplan.FieldGraceDays:
 			values[i] = new(sql.NullInt64)
 		case packageplan.FieldName, packageplan.FieldPoolName, packageplan.FieldProfileName, packageplan.FieldCurrency:
 			values[i] = new(sql.NullString)
@@ -106,6 +108,12 @@ func (pp *PackagePlan) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pp.IsActive = value.Bool
 			}
+		case packageplan.FieldGraceDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field grace_days", values[i])
+			} else if value.Valid {
+				pp.GraceDays = int(value.Int64)
+			}
 		case packageplan.FieldCreatedDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_date", values[i])
@@ -166,6 +174,9 @@ func (pp *PackagePlan) String() string {
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", pp.IsActive))
 	builder.WriteString(", ")
+	builder.WriteString("grace_days=")
+	builder.WriteString(fmt.Sprintf("%v", pp.GraceDays))
+	builder.WriteString(", ")
 	builder.WriteString("created_date=")
 	builder.WriteString(pp.CreatedDate.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -25,6 +25,8 @@ const (
 	FieldCurrency = "currency"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldGraceDays holds the string denoting the grace_days field in the database.
+	FieldGraceDays = "grace_days"
 	// FieldCreatedDate holds the string denoting the created_date field in the database.
 	FieldCreatedDate = "created_date"
 	// Table holds the table name of the packageplan in the database.
@@ -40,6 +42,7 @@ var Columns = []string{
 	FieldPrice,
 	FieldCurrency,
 	FieldIsActive,
+	FieldGraceDays,
 	FieldCreatedDate,
 }
 
@@ -68,6 +71,10 @@ var (
 	CurrencyValidator func(string) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
+	// DefaultGraceDays holds the default value on creation for the "grace_days" field.
+	DefaultGraceDays int
+	// GraceDaysValidator is a validator for the "grace_days" field. It is called by the builders before save.
+	GraceDaysValidator func(int) error
 	// DefaultCreatedDate holds the default value on creation for the "created_date" field.
 	DefaultCreatedDate func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -112,6 +119,11 @@ func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByGraceDays orders the results by the grace_days field.
+func ByGraceDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGraceDays, opts...).ToFunc()
+}
+
 // ByCreatedDate orders the results by the created_date field.
 func ByCreatedDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedDate, opts...).ToFunc()
@@ -84,6 +84,11 @@ func IsActive(v bool) predicate.PackagePlan {
 	return predicate.PackagePlan(sql.FieldEQ(FieldIsActive, v))
 }
 
+// GraceDays applies equality check predicate on the "grace_days" field. It's identical to GraceDaysEQ.
+func GraceDays(v int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldEQ(FieldGraceDays, v))
+}
+
 // CreatedDate applies equality check predicate on the "created_date" field. It's identical to CreatedDateEQ.
 func CreatedDate(v time.Time) predicate.PackagePlan {
 	return predicate.PackagePlan(sql.FieldEQ(FieldCreatedDate, v))
@@ -409,6 +414,46 @@ func IsActiveNEQ(v bool) predicate.PackagePlan {
 	return predicate.PackagePlan(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// GraceDaysEQ applies the EQ predicate on the "grace_days" field.
+func GraceDaysEQ(v int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldEQ(FieldGraceDays, v))
+}
+
+// GraceDaysNEQ applies the NEQ predicate on the "grace_days" field.
+func GraceDaysNEQ(v int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldNEQ(FieldGraceDays, v))
+}
+
+// GraceDaysIn applies the In predicate on the "grace_days" field.
+func GraceDaysIn(vs ...int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldIn(FieldGraceDays, vs...))
+}
+
+// GraceDaysNotIn applies the NotIn predicate on the "grace_days" field.
+func GraceDaysNotIn(vs ...int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldNotIn(FieldGraceDays, vs...))
+}
+
+// GraceDaysGT applies the GT predicate on the "grace_days" field.
+func GraceDaysGT(v int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldGT(FieldGraceDays, v))
+}
+
+// GraceDaysGTE applies the GTE predicate on the "grace_days" field.
+func GraceDaysGTE(v int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldGTE(FieldGraceDays, v))
+}
+
+// GraceDaysLT applies the LT predicate on the "grace_days" field.
+func GraceDaysLT(v int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldLT(FieldGraceDays, v))
+}
+
+// GraceDaysLTE applies the LTE predicate on the "grace_days" field.
+func GraceDaysLTE(v int) predicate.PackagePlan {
+	return predicate.PackagePlan(sql.FieldLTE(FieldGraceDays, v))
+}
+
 // CreatedDateEQ applies the EQ predicate on the "created_date" field.
 func CreatedDateEQ(v time.Time) predicate.PackagePlan {
 	return predicate.PackagePlan(sql.FieldEQ(FieldCreatedDate, v))
@@ -88,6 +88,20 @@ func (ppc *PackagePlanCreate) SetNillableIsActive(b *bool) *PackagePlanCreate {
 	return ppc
 }
 
+// SetGraceDays sets the "grace_days" field.
+func (ppc *PackagePlanCreate) SetGraceDays(i int) *PackagePlanCreate {
+	ppc.mutation.SetGraceDays(i)
+	return ppc
+}
+
+// SetNillableGraceDays sets the "grace_days" field if the given value is not nil.
+func (ppc *PackagePlanCreate) SetNillableGraceDays(i *int) *PackagePlanCreate {
+	if i != nil {
+		ppc.SetGraceDays(*i)
+	}
+	return ppc
+}
+
 // SetCreatedDate sets the "created_date" field.
 func (ppc *PackagePlanCreate) SetCreatedDate(t time.Time) *PackagePlanCreate {
 	ppc.mutation.SetCreatedDate(t)
@@ -155,6 +169,10 @@ func (ppc *PackagePlanCreate) defaults() {
 		v := packageplan.DefaultIsActive
 		ppc.mutation.SetIsActive(v)
 	}
+	if _, ok := ppc.mutation.GraceDays(); !ok {
+		v := packageplan.DefaultGraceDays
+		ppc.mutation.SetGraceDays(v)
+	}
 	if _, ok := ppc.mutation.CreatedDate(); !ok {
 		v := packageplan.DefaultCreatedDate()
 		ppc.mutation.SetCreatedDate(v)
@@ -198,6 +216,14 @@ func (ppc *PackagePlanCreate) check() error {
 	if _, ok := ppc.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "PackagePlan.is_active"`)}
 	}
+	if _, ok := ppc.mutation.GraceDays(); !ok {
+		return &ValidationError{Name: "grace_days", err: errors.New(`ent: missing required field "PackagePlan.grace_days"`)}
+	}
+	if v, ok := ppc.mutation.GraceDays(); ok {
+		if err := packageplan.GraceDaysValidator(v); err != nil {
+			return &ValidationError{Name: "grace_days", err: fmt.Errorf(`ent: validator failed for field "PackagePlan.grace_days": %w`, err)}
+		}
+	}
 	if _, ok := ppc.mutation.CreatedDate(); !ok {
 		return &ValidationError{Name: "created_date", err: errors.New(`ent: missing required field "PackagePlan.created_date"`)}
 	}
@@ -262,6 +288,10 @@ func (ppc *PackagePlanCreate) createSpec() (*PackagePlan, *sqlgraph.CreateSpec)
 		_spec.SetField(packageplan.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := ppc.mutation.GraceDays(); ok {
+		_spec.SetField(packageplan.FieldGraceDays, field.TypeInt, value)
+		_node.GraceDays = value
+	}
 	if value, ok := ppc.mutation.CreatedDate(); ok {
 		_spec.SetField(packageplan.FieldCreatedDate, field.TypeTime, value)
 		_node.CreatedDate = value
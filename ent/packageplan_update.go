@@ -124,6 +124,27 @@ func (ppu *PackagePlanUpdate) SetNillableIsActive(b *bool) *PackagePlanUpdate {
 	return ppu
 }
 
+// SetGraceDays sets the "grace_days" field.
+func (ppu *PackagePlanUpdate) SetGraceDays(i int) *PackagePlanUpdate {
+	ppu.mutation.ResetGraceDays()
+	ppu.mutation.SetGraceDays(i)
+	return ppu
+}
+
+// SetNillableGraceDays sets the "grace_days" field if the given value is not nil.
+func (ppu *PackagePlanUpdate) SetNillableGraceDays(i *int) *PackagePlanUpdate {
+	if i != nil {
+		ppu.SetGraceDays(*i)
+	}
+	return ppu
+}
+
+// AddGraceDays adds i to the "grace_days" field.
+func (ppu *PackagePlanUpdate) AddGraceDays(i int) *PackagePlanUpdate {
+	ppu.mutation.AddGraceDays(i)
+	return ppu
+}
+
 // Mutation returns the PackagePlanMutation object of the builder.
 func (ppu *PackagePlanUpdate) Mutation() *PackagePlanMutation {
 	return ppu.mutation
@@ -178,6 +199,11 @@ func (ppu *PackagePlanUpdate) check() error {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PackagePlan.currency": %w`, err)}
 		}
 	}
+	if v, ok := ppu.mutation.GraceDays(); ok {
+		if err := packageplan.GraceDaysValidator(v); err != nil {
+			return &ValidationError{Name: "grace_days", err: fmt.Errorf(`ent: validator failed for field "PackagePlan.grace_days": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -217,6 +243,12 @@ func (ppu *PackagePlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ppu.mutation.IsActive(); ok {
 		_spec.SetField(packageplan.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := ppu.mutation.GraceDays(); ok {
+		_spec.SetField(packageplan.FieldGraceDays, field.TypeInt, value)
+	}
+	if value, ok := ppu.mutation.AddedGraceDays(); ok {
+		_spec.AddField(packageplan.FieldGraceDays, field.TypeInt, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ppu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{packageplan.Label}
@@ -334,6 +366,27 @@ func (ppuo *PackagePlanUpdateOne) SetNillableIsActive(b *bool) *PackagePlanUpdat
 	return ppuo
 }
 
+// SetGraceDays sets the "grace_days" field.
+func (ppuo *PackagePlanUpdateOne) SetGraceDays(i int) *PackagePlanUpdateOne {
+	ppuo.mutation.ResetGraceDays()
+	ppuo.mutation.SetGraceDays(i)
+	return ppuo
+}
+
+// SetNillableGraceDays sets the "grace_days" field if the given value is not nil.
+func (ppuo *PackagePlanUpdateOne) SetNillableGraceDays(i *int) *PackagePlanUpdateOne {
+	if i != nil {
+		ppuo.SetGraceDays(*i)
+	}
+	return ppuo
+}
+
+// AddGraceDays adds i to the "grace_days" field.
+func (ppuo *PackagePlanUpdateOne) AddGraceDays(i int) *PackagePlanUpdateOne {
+	ppuo.mutation.AddGraceDays(i)
+	return ppuo
+}
+
 // Mutation returns the PackagePlanMutation object of the builder.
 func (ppuo *PackagePlanUpdateOne) Mutation() *PackagePlanMutation {
 	return ppuo.mutation
@@ -401,6 +454,11 @@ func (ppuo *PackagePlanUpdateOne) check() error {
 			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PackagePlan.currency": %w`, err)}
 		}
 	}
+	if v, ok := ppuo.mutation.GraceDays(); ok {
+		if err := packageplan.GraceDaysValidator(v); err != nil {
+			return &ValidationError{Name: "grace_days", err: fmt.Errorf(`ent: validator failed for field "PackagePlan.grace_days": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -457,6 +515,12 @@ func (ppuo *PackagePlanUpdateOne) sqlSave(ctx context.Context) (_node *PackagePl
 	if value, ok := ppuo.mutation.IsActive(); ok {
 		_spec.SetField(packageplan.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := ppuo.mutation.GraceDays(); ok {
+		_spec.SetField(packageplan.FieldGraceDays, field.TypeInt, value)
+	}
+	if value, ok := ppuo.mutation.AddedGraceDays(); ok {
+		_spec.AddField(packageplan.FieldGraceDays, field.TypeInt, value)
+	}
 	_node = &PackagePlan{config: ppuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/payrequest"
+)
+
+// PayRequest is the model entity for the PayRequest schema.
+type PayRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind payrequest.Kind `json:"kind,omitempty"`
+	// Client looked up or paid for, empty when the username did not match anyone
+	ClientUsername string `json:"client_username,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PayRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payrequest.FieldID:
+			values[i] = new(sql.NullInt64)
+		case payrequest.FieldIP, payrequest.FieldKind, payrequest.FieldClientUsername:
+			values[i] = new(sql.NullString)
+		case payrequest.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PayRequest fields.
+func (pr *PayRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case payrequest.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				pr.IP = value.String
+			}
+		case payrequest.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				pr.Kind = payrequest.Kind(value.String)
+			}
+		case payrequest.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				pr.ClientUsername = value.String
+			}
+		case payrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PayRequest.
+// This includes values selected through modifiers, order, etc.
+func (pr *PayRequest) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PayRequest.
+// Note that you need to call PayRequest.Unwrap() before calling this method if this PayRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PayRequest) Update() *PayRequestUpdateOne {
+	return NewPayRequestClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PayRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PayRequest) Unwrap() *PayRequest {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PayRequest is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PayRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("PayRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("ip=")
+	builder.WriteString(pr.IP)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(pr.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PayRequests is a parsable slice of PayRequest.
+type PayRequests []*PayRequest
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/payrequest"
+)
+
+// PayRequestCreate is the builder for creating a PayRequest entity.
+type PayRequestCreate struct {
+	config
+	mutation *PayRequestMutation
+	hooks    []Hook
+}
+
+// SetIP sets the "ip" field.
+func (prc *PayRequestCreate) SetIP(s string) *PayRequestCreate {
+	prc.mutation.SetIP(s)
+	return prc
+}
+
+// SetKind sets the "kind" field.
+func (prc *PayRequestCreate) SetKind(pa payrequest.Kind) *PayRequestCreate {
+	prc.mutation.SetKind(pa)
+	return prc
+}
+
+// SetClientUsername sets the "client_username" field.
+func (prc *PayRequestCreate) SetClientUsername(s string) *PayRequestCreate {
+	prc.mutation.SetClientUsername(s)
+	return prc
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (prc *PayRequestCreate) SetNillableClientUsername(s *string) *PayRequestCreate {
+	if s != nil {
+		prc.SetClientUsername(*s)
+	}
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PayRequestCreate) SetCreatedAt(t time.Time) *PayRequestCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PayRequestCreate) SetNillableCreatedAt(t *time.Time) *PayRequestCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// Mutation returns the PayRequestMutation object of the builder.
+func (prc *PayRequestCreate) Mutation() *PayRequestMutation {
+	return prc.mutation
+}
+
+// Save creates the PayRequest in the database.
+func (prc *PayRequestCreate) Save(ctx context.Context) (*PayRequest, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PayRequestCreate) SaveX(ctx context.Context) *PayRequest {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PayRequestCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PayRequestCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PayRequestCreate) defaults() {
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := payrequest.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PayRequestCreate) check() error {
+	if _, ok := prc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "PayRequest.ip"`)}
+	}
+	if v, ok := prc.mutation.IP(); ok {
+		if err := payrequest.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "PayRequest.ip": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "PayRequest.kind"`)}
+	}
+	if v, ok := prc.mutation.Kind(); ok {
+		if err := payrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PayRequest.kind": %w`, err)}
+		}
+	}
+	if v, ok := prc.mutation.ClientUsername(); ok {
+		if err := payrequest.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "PayRequest.client_username": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PayRequest.created_at"`)}
+	}
+	return nil
+}
+
+func (prc *PayRequestCreate) sqlSave(ctx context.Context) (*PayRequest, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PayRequestCreate) createSpec() (*PayRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PayRequest{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(payrequest.Table, sqlgraph.NewFieldSpec(payrequest.FieldID, field.TypeInt))
+	)
+	if value, ok := prc.mutation.IP(); ok {
+		_spec.SetField(payrequest.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := prc.mutation.Kind(); ok {
+		_spec.SetField(payrequest.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := prc.mutation.ClientUsername(); ok {
+		_spec.SetField(payrequest.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(payrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PayRequestCreateBulk is the builder for creating many PayRequest entities in bulk.
+type PayRequestCreateBulk struct {
+	config
+	err      error
+	builders []*PayRequestCreate
+}
+
+// Save creates the PayRequest entities in the database.
+func (prcb *PayRequestCreateBulk) Save(ctx context.Context) ([]*PayRequest, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PayRequest, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PayRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PayRequestCreateBulk) SaveX(ctx context.Context) []*PayRequest {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PayRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PayRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/payrequest"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// PayRequestUpdate is the builder for updating PayRequest entities.
+type PayRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PayRequestMutation
+}
+
+// Where appends a list predicates to the PayRequestUpdate builder.
+func (pru *PayRequestUpdate) Where(ps ...predicate.PayRequest) *PayRequestUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetIP sets the "ip" field.
+func (pru *PayRequestUpdate) SetIP(s string) *PayRequestUpdate {
+	pru.mutation.SetIP(s)
+	return pru
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (pru *PayRequestUpdate) SetNillableIP(s *string) *PayRequestUpdate {
+	if s != nil {
+		pru.SetIP(*s)
+	}
+	return pru
+}
+
+// SetKind sets the "kind" field.
+func (pru *PayRequestUpdate) SetKind(pa payrequest.Kind) *PayRequestUpdate {
+	pru.mutation.SetKind(pa)
+	return pru
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pru *PayRequestUpdate) SetNillableKind(pa *payrequest.Kind) *PayRequestUpdate {
+	if pa != nil {
+		pru.SetKind(*pa)
+	}
+	return pru
+}
+
+// SetClientUsername sets the "client_username" field.
+func (pru *PayRequestUpdate) SetClientUsername(s string) *PayRequestUpdate {
+	pru.mutation.SetClientUsername(s)
+	return pru
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (pru *PayRequestUpdate) SetNillableClientUsername(s *string) *PayRequestUpdate {
+	if s != nil {
+		pru.SetClientUsername(*s)
+	}
+	return pru
+}
+
+// ClearClientUsername clears the value of the "client_username" field.
+func (pru *PayRequestUpdate) ClearClientUsername() *PayRequestUpdate {
+	pru.mutation.ClearClientUsername()
+	return pru
+}
+
+// Mutation returns the PayRequestMutation object of the builder.
+func (pru *PayRequestUpdate) Mutation() *PayRequestMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PayRequestUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PayRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PayRequestUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PayRequestUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PayRequestUpdate) check() error {
+	if v, ok := pru.mutation.IP(); ok {
+		if err := payrequest.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "PayRequest.ip": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.Kind(); ok {
+		if err := payrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PayRequest.kind": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.ClientUsername(); ok {
+		if err := payrequest.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "PayRequest.client_username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pru *PayRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrequest.Table, payrequest.Columns, sqlgraph.NewFieldSpec(payrequest.FieldID, field.TypeInt))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.IP(); ok {
+		_spec.SetField(payrequest.FieldIP, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.Kind(); ok {
+		_spec.SetField(payrequest.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := pru.mutation.ClientUsername(); ok {
+		_spec.SetField(payrequest.FieldClientUsername, field.TypeString, value)
+	}
+	if pru.mutation.ClientUsernameCleared() {
+		_spec.ClearField(payrequest.FieldClientUsername, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PayRequestUpdateOne is the builder for updating a single PayRequest entity.
+type PayRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PayRequestMutation
+}
+
+// SetIP sets the "ip" field.
+func (pruo *PayRequestUpdateOne) SetIP(s string) *PayRequestUpdateOne {
+	pruo.mutation.SetIP(s)
+	return pruo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (pruo *PayRequestUpdateOne) SetNillableIP(s *string) *PayRequestUpdateOne {
+	if s != nil {
+		pruo.SetIP(*s)
+	}
+	return pruo
+}
+
+// SetKind sets the "kind" field.
+func (pruo *PayRequestUpdateOne) SetKind(pa payrequest.Kind) *PayRequestUpdateOne {
+	pruo.mutation.SetKind(pa)
+	return pruo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (pruo *PayRequestUpdateOne) SetNillableKind(pa *payrequest.Kind) *PayRequestUpdateOne {
+	if pa != nil {
+		pruo.SetKind(*pa)
+	}
+	return pruo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (pruo *PayRequestUpdateOne) SetClientUsername(s string) *PayRequestUpdateOne {
+	pruo.mutation.SetClientUsername(s)
+	return pruo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (pruo *PayRequestUpdateOne) SetNillableClientUsername(s *string) *PayRequestUpdateOne {
+	if s != nil {
+		pruo.SetClientUsername(*s)
+	}
+	return pruo
+}
+
+// ClearClientUsername clears the value of the "client_username" field.
+func (pruo *PayRequestUpdateOne) ClearClientUsername() *PayRequestUpdateOne {
+	pruo.mutation.ClearClientUsername()
+	return pruo
+}
+
+// Mutation returns the PayRequestMutation object of the builder.
+func (pruo *PayRequestUpdateOne) Mutation() *PayRequestMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the PayRequestUpdate builder.
+func (pruo *PayRequestUpdateOne) Where(ps ...predicate.PayRequest) *PayRequestUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PayRequestUpdateOne) Select(field string, fields ...string) *PayRequestUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PayRequest entity.
+func (pruo *PayRequestUpdateOne) Save(ctx context.Context) (*PayRequest, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PayRequestUpdateOne) SaveX(ctx context.Context) *PayRequest {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PayRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PayRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PayRequestUpdateOne) check() error {
+	if v, ok := pruo.mutation.IP(); ok {
+		if err := payrequest.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "PayRequest.ip": %w`, err)}
+		}
+	}
+	if v, ok := pruo.mutation.Kind(); ok {
+		if err := payrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "PayRequest.kind": %w`, err)}
+		}
+	}
+	if v, ok := pruo.mutation.ClientUsername(); ok {
+		if err := payrequest.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "PayRequest.client_username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pruo *PayRequestUpdateOne) sqlSave(ctx context.Context) (_node *PayRequest, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payrequest.Table, payrequest.Columns, sqlgraph.NewFieldSpec(payrequest.FieldID, field.TypeInt))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PayRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payrequest.FieldID)
+		for _, f := range fields {
+			if !payrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.IP(); ok {
+		_spec.SetField(payrequest.FieldIP, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.Kind(); ok {
+		_spec.SetField(payrequest.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := pruo.mutation.ClientUsername(); ok {
+		_spec.SetField(payrequest.FieldClientUsername, field.TypeString, value)
+	}
+	if pruo.mutation.ClientUsernameCleared() {
+		_spec.ClearField(payrequest.FieldClientUsername, field.TypeString)
+	}
+	_node = &PayRequest{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
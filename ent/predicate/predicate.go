@@ -6,12 +6,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BalanceTransfer is the predicate function for balancetransfer builders.
+type BalanceTransfer func(*sql.Selector)
+
+// ClientAddon is the predicate function for clientaddon builders.
+type ClientAddon func(*sql.Selector)
+
 // ClientTxn is the predicate function for clienttxn builders.
 type ClientTxn func(*sql.Selector)
 
 // ClientUser is the predicate function for clientuser builders.
 type ClientUser func(*sql.Selector)
 
+// Coupon is the predicate function for coupon builders.
+type Coupon func(*sql.Selector)
+
+// CouponRedemption is the predicate function for couponredemption builders.
+type CouponRedemption func(*sql.Selector)
+
+// DunningStep is the predicate function for dunningstep builders.
+type DunningStep func(*sql.Selector)
+
 // EmailSubscription is the predicate function for emailsubscription builders.
 type EmailSubscription func(*sql.Selector)
 
@@ -27,6 +42,9 @@ type FCMSubscriptions func(*sql.Selector)
 // FileStorage is the predicate function for filestorage builders.
 type FileStorage func(*sql.Selector)
 
+// GracePeriod is the predicate function for graceperiod builders.
+type GracePeriod func(*sql.Selector)
+
 // Image is the predicate function for image builders.
 type Image func(*sql.Selector)
 
@@ -36,9 +54,18 @@ type ImageSize func(*sql.Selector)
 // Invitation is the predicate function for invitation builders.
 type Invitation func(*sql.Selector)
 
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
+// InvoiceLine is the predicate function for invoiceline builders.
+type InvoiceLine func(*sql.Selector)
+
 // LastSeenOnline is the predicate function for lastseenonline builders.
 type LastSeenOnline func(*sql.Selector)
 
+// ManualPayment is the predicate function for manualpayment builders.
+type ManualPayment func(*sql.Selector)
+
 // MonthlySubscription is the predicate function for monthlysubscription builders.
 type MonthlySubscription func(*sql.Selector)
 
@@ -54,6 +81,9 @@ type NotificationTime func(*sql.Selector)
 // PackagePlan is the predicate function for packageplan builders.
 type PackagePlan func(*sql.Selector)
 
+// PayRequest is the predicate function for payrequest builders.
+type PayRequest func(*sql.Selector)
+
 // PhoneVerificationCode is the predicate function for phoneverificationcode builders.
 type PhoneVerificationCode func(*sql.Selector)
 
@@ -66,11 +96,59 @@ type PwaPushSubscription func(*sql.Selector)
 // RadAcct is the predicate function for radacct builders.
 type RadAcct func(*sql.Selector)
 
+// RadCheck is the predicate function for radcheck builders.
+type RadCheck func(*sql.Selector)
+
+// RadGroupReply is the predicate function for radgroupreply builders.
+type RadGroupReply func(*sql.Selector)
+
+// RadReply is the predicate function for radreply builders.
+type RadReply func(*sql.Selector)
+
+// RadUserGroup is the predicate function for radusergroup builders.
+type RadUserGroup func(*sql.Selector)
+
+// RefundRequest is the predicate function for refundrequest builders.
+type RefundRequest func(*sql.Selector)
+
 // SentEmail is the predicate function for sentemail builders.
 type SentEmail func(*sql.Selector)
 
+// SettlementRow is the predicate function for settlementrow builders.
+type SettlementRow func(*sql.Selector)
+
+// StatementEntry is the predicate function for statemententry builders.
+type StatementEntry func(*sql.Selector)
+
 // Ticket is the predicate function for ticket builders.
 type Ticket func(*sql.Selector)
 
+// UsageCursor is the predicate function for usagecursor builders.
+type UsageCursor func(*sql.Selector)
+
+// UsageDaily is the predicate function for usagedaily builders.
+type UsageDaily func(*sql.Selector)
+
+// UsageHourly is the predicate function for usagehourly builders.
+type UsageHourly func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Vendor is the predicate function for vendor builders.
+type Vendor func(*sql.Selector)
+
+// VendorCommission is the predicate function for vendorcommission builders.
+type VendorCommission func(*sql.Selector)
+
+// VendorTxn is the predicate function for vendortxn builders.
+type VendorTxn func(*sql.Selector)
+
+// Voucher is the predicate function for voucher builders.
+type Voucher func(*sql.Selector)
+
+// VoucherAttempt is the predicate function for voucherattempt builders.
+type VoucherAttempt func(*sql.Selector)
+
+// VoucherBatch is the predicate function for voucherbatch builders.
+type VoucherBatch func(*sql.Selector)
@@ -25,6 +25,8 @@ type RadAcct struct {
 	Username string `json:"username,omitempty"`
 	// Acctstarttime holds the value of the "acctstarttime" field.
 	Acctstarttime *time.Time `json:"acctstarttime,omitempty"`
+	// Acctupdatetime holds the value of the "acctupdatetime" field.
+	Acctupdatetime *time.Time `json:"acctupdatetime,omitempty"`
 	// Acctstoptime holds the value of the "acctstoptime" field.
 	Acctstoptime *time.Time `json:"acctstoptime,omitempty"`
 	// Acctsessiontime holds the value of the "acctsessiontime" field.
@@ -35,6 +37,8 @@ type RadAcct struct {
 	Acctoutputoctets *int64 `json:"acctoutputoctets,omitempty"`
 	// Framedipaddress holds the value of the "framedipaddress" field.
 	Framedipaddress string `json:"framedipaddress,omitempty"`
+	// Nasipaddress holds the value of the "nasipaddress" field.
+	Nasipaddress string `json:"nasipaddress,omitempty"`
 	// Acctterminatecause holds the value of the "acctterminatecause" field.
 	Acctterminatecause string `json:"acctterminatecause,omitempty"`
 	selectValues       sql.SelectValues
@@ -47,9 +51,9 @@ func (*RadAcct) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case radacct.FieldID, radacct.FieldAcctsessiontime, radacct.FieldAcctinputoctets, radacct.FieldAcctoutputoctets:
 			values[i] = new(sql.NullInt64)
-		case radacct.FieldAcctsessionid, radacct.FieldAcctuniqueid, radacct.FieldUsername, radacct.FieldFramedipaddress, radacct.FieldAcctterminatecause:
+		case radacct.FieldAcctsessionid, radacct.FieldAcctuniqueid, radacct.FieldUsername, radacct.FieldFramedipaddress, radacct.FieldNasipaddress, radacct.FieldAcctterminatecause:
 			values[i] = new(sql.NullString)
-		case radacct.FieldAcctstarttime, radacct.FieldAcctstoptime:
+		case radacct.FieldAcctstarttime, radacct.FieldAcctupdatetime, radacct.FieldAcctstoptime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -97,6 +101,13 @@ func (ra *RadAcct) assignValues(columns []string, values []any) error {
 				ra.Acctstarttime = new(time.Time)
 				*ra.Acctstarttime = value.Time
 			}
+		case radacct.FieldAcctupdatetime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acctupdatetime", values[i])
+			} else if value.Valid {
+				ra.Acctupdatetime = new(time.Time)
+				*ra.Acctupdatetime = value.Time
+			}
 		case radacct.FieldAcctstoptime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field acctstoptime", values[i])
@@ -131,6 +142,12 @@ func (ra *RadAcct) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ra.Framedipaddress = value.String
 			}
+		case radacct.FieldNasipaddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nasipaddress", values[i])
+			} else if value.Valid {
+				ra.Nasipaddress = value.String
+			}
 		case radacct.FieldAcctterminatecause:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field acctterminatecause", values[i])
@@ -187,6 +204,11 @@ func (ra *RadAcct) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := ra.Acctupdatetime; v != nil {
+		builder.WriteString("acctupdatetime=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := ra.Acctstoptime; v != nil {
 		builder.WriteString("acctstoptime=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -210,6 +232,9 @@ func (ra *RadAcct) String() string {
 	builder.WriteString("framedipaddress=")
 	builder.WriteString(ra.Framedipaddress)
 	builder.WriteString(", ")
+	builder.WriteString("nasipaddress=")
+	builder.WriteString(ra.Nasipaddress)
+	builder.WriteString(", ")
 	builder.WriteString("acctterminatecause=")
 	builder.WriteString(ra.Acctterminatecause)
 	builder.WriteByte(')')
@@ -19,6 +19,8 @@ const (
 	FieldUsername = "username"
 	// FieldAcctstarttime holds the string denoting the acctstarttime field in the database.
 	FieldAcctstarttime = "acctstarttime"
+	// FieldAcctupdatetime holds the string denoting the acctupdatetime field in the database.
+	FieldAcctupdatetime = "acctupdatetime"
 	// FieldAcctstoptime holds the string denoting the acctstoptime field in the database.
 	FieldAcctstoptime = "acctstoptime"
 	// FieldAcctsessiontime holds the string denoting the acctsessiontime field in the database.
@@ -29,6 +31,8 @@ const (
 	FieldAcctoutputoctets = "acctoutputoctets"
 	// FieldFramedipaddress holds the string denoting the framedipaddress field in the database.
 	FieldFramedipaddress = "framedipaddress"
+	// FieldNasipaddress holds the string denoting the nasipaddress field in the database.
+	FieldNasipaddress = "nasipaddress"
 	// FieldAcctterminatecause holds the string denoting the acctterminatecause field in the database.
 	FieldAcctterminatecause = "acctterminatecause"
 	// Table holds the table name of the radacct in the database.
@@ -42,11 +46,13 @@ var Columns = []string{
 	FieldAcctuniqueid,
 	FieldUsername,
 	FieldAcctstarttime,
+	FieldAcctupdatetime,
 	FieldAcctstoptime,
 	FieldAcctsessiontime,
 	FieldAcctinputoctets,
 	FieldAcctoutputoctets,
 	FieldFramedipaddress,
+	FieldNasipaddress,
 	FieldAcctterminatecause,
 }
 
@@ -69,6 +75,10 @@ var (
 	UsernameValidator func(string) error
 	// FramedipaddressValidator is a validator for the "framedipaddress" field. It is called by the builders before save.
 	FramedipaddressValidator func(string) error
+	// DefaultNasipaddress holds the default value on creation for the "nasipaddress" field.
+	DefaultNasipaddress string
+	// NasipaddressValidator is a validator for the "nasipaddress" field. It is called by the builders before save.
+	NasipaddressValidator func(string) error
 	// AcctterminatecauseValidator is a validator for the "acctterminatecause" field. It is called by the builders before save.
 	AcctterminatecauseValidator func(string) error
 )
@@ -101,6 +111,11 @@ func ByAcctstarttime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAcctstarttime, opts...).ToFunc()
 }
 
+// ByAcctupdatetime orders the results by the acctupdatetime field.
+func ByAcctupdatetime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcctupdatetime, opts...).ToFunc()
+}
+
 // ByAcctstoptime orders the results by the acctstoptime field.
 func ByAcctstoptime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAcctstoptime, opts...).ToFunc()
@@ -126,6 +141,11 @@ func ByFramedipaddress(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFramedipaddress, opts...).ToFunc()
 }
 
+// ByNasipaddress orders the results by the nasipaddress field.
+func ByNasipaddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNasipaddress, opts...).ToFunc()
+}
+
 // ByAcctterminatecause orders the results by the acctterminatecause field.
 func ByAcctterminatecause(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAcctterminatecause, opts...).ToFunc()
@@ -74,6 +74,11 @@ func Acctstarttime(v time.Time) predicate.RadAcct {
 	return predicate.RadAcct(sql.FieldEQ(FieldAcctstarttime, v))
 }
 
+// Acctupdatetime applies equality check predicate on the "acctupdatetime" field. It's identical to AcctupdatetimeEQ.
+func Acctupdatetime(v time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldEQ(FieldAcctupdatetime, v))
+}
+
 // Acctstoptime applies equality check predicate on the "acctstoptime" field. It's identical to AcctstoptimeEQ.
 func Acctstoptime(v time.Time) predicate.RadAcct {
 	return predicate.RadAcct(sql.FieldEQ(FieldAcctstoptime, v))
@@ -99,6 +104,11 @@ func Framedipaddress(v string) predicate.RadAcct {
 	return predicate.RadAcct(sql.FieldEQ(FieldFramedipaddress, v))
 }
 
+// Nasipaddress applies equality check predicate on the "nasipaddress" field. It's identical to NasipaddressEQ.
+func Nasipaddress(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldEQ(FieldNasipaddress, v))
+}
+
 // Acctterminatecause applies equality check predicate on the "acctterminatecause" field. It's identical to AcctterminatecauseEQ.
 func Acctterminatecause(v string) predicate.RadAcct {
 	return predicate.RadAcct(sql.FieldEQ(FieldAcctterminatecause, v))
@@ -349,6 +359,56 @@ func AcctstarttimeNotNil() predicate.RadAcct {
 	return predicate.RadAcct(sql.FieldNotNull(FieldAcctstarttime))
 }
 
+// AcctupdatetimeEQ applies the EQ predicate on the "acctupdatetime" field.
+func AcctupdatetimeEQ(v time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldEQ(FieldAcctupdatetime, v))
+}
+
+// AcctupdatetimeNEQ applies the NEQ predicate on the "acctupdatetime" field.
+func AcctupdatetimeNEQ(v time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldNEQ(FieldAcctupdatetime, v))
+}
+
+// AcctupdatetimeIn applies the In predicate on the "acctupdatetime" field.
+func AcctupdatetimeIn(vs ...time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldIn(FieldAcctupdatetime, vs...))
+}
+
+// AcctupdatetimeNotIn applies the NotIn predicate on the "acctupdatetime" field.
+func AcctupdatetimeNotIn(vs ...time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldNotIn(FieldAcctupdatetime, vs...))
+}
+
+// AcctupdatetimeGT applies the GT predicate on the "acctupdatetime" field.
+func AcctupdatetimeGT(v time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldGT(FieldAcctupdatetime, v))
+}
+
+// AcctupdatetimeGTE applies the GTE predicate on the "acctupdatetime" field.
+func AcctupdatetimeGTE(v time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldGTE(FieldAcctupdatetime, v))
+}
+
+// AcctupdatetimeLT applies the LT predicate on the "acctupdatetime" field.
+func AcctupdatetimeLT(v time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldLT(FieldAcctupdatetime, v))
+}
+
+// AcctupdatetimeLTE applies the LTE predicate on the "acctupdatetime" field.
+func AcctupdatetimeLTE(v time.Time) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldLTE(FieldAcctupdatetime, v))
+}
+
+// AcctupdatetimeIsNil applies the IsNil predicate on the "acctupdatetime" field.
+func AcctupdatetimeIsNil() predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldIsNull(FieldAcctupdatetime))
+}
+
+// AcctupdatetimeNotNil applies the NotNil predicate on the "acctupdatetime" field.
+func AcctupdatetimeNotNil() predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldNotNull(FieldAcctupdatetime))
+}
+
 // AcctstoptimeEQ applies the EQ predicate on the "acctstoptime" field.
 func AcctstoptimeEQ(v time.Time) predicate.RadAcct {
 	return predicate.RadAcct(sql.FieldEQ(FieldAcctstoptime, v))
@@ -614,6 +674,71 @@ func FramedipaddressContainsFold(v string) predicate.RadAcct {
 	return predicate.RadAcct(sql.FieldContainsFold(FieldFramedipaddress, v))
 }
 
+// NasipaddressEQ applies the EQ predicate on the "nasipaddress" field.
+func NasipaddressEQ(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldEQ(FieldNasipaddress, v))
+}
+
+// NasipaddressNEQ applies the NEQ predicate on the "nasipaddress" field.
+func NasipaddressNEQ(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldNEQ(FieldNasipaddress, v))
+}
+
+// NasipaddressIn applies the In predicate on the "nasipaddress" field.
+func NasipaddressIn(vs ...string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldIn(FieldNasipaddress, vs...))
+}
+
+// NasipaddressNotIn applies the NotIn predicate on the "nasipaddress" field.
+func NasipaddressNotIn(vs ...string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldNotIn(FieldNasipaddress, vs...))
+}
+
+// NasipaddressGT applies the GT predicate on the "nasipaddress" field.
+func NasipaddressGT(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldGT(FieldNasipaddress, v))
+}
+
+// NasipaddressGTE applies the GTE predicate on the "nasipaddress" field.
+func NasipaddressGTE(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldGTE(FieldNasipaddress, v))
+}
+
+// NasipaddressLT applies the LT predicate on the "nasipaddress" field.
+func NasipaddressLT(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldLT(FieldNasipaddress, v))
+}
+
+// NasipaddressLTE applies the LTE predicate on the "nasipaddress" field.
+func NasipaddressLTE(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldLTE(FieldNasipaddress, v))
+}
+
+// NasipaddressContains applies the Contains predicate on the "nasipaddress" field.
+func NasipaddressContains(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldContains(FieldNasipaddress, v))
+}
+
+// NasipaddressHasPrefix applies the HasPrefix predicate on the "nasipaddress" field.
+func NasipaddressHasPrefix(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldHasPrefix(FieldNasipaddress, v))
+}
+
+// NasipaddressHasSuffix applies the HasSuffix predicate on the "nasipaddress" field.
+func NasipaddressHasSuffix(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldHasSuffix(FieldNasipaddress, v))
+}
+
+// NasipaddressEqualFold applies the EqualFold predicate on the "nasipaddress" field.
+func NasipaddressEqualFold(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldEqualFold(FieldNasipaddress, v))
+}
+
+// NasipaddressContainsFold applies the ContainsFold predicate on the "nasipaddress" field.
+func NasipaddressContainsFold(v string) predicate.RadAcct {
+	return predicate.RadAcct(sql.FieldContainsFold(FieldNasipaddress, v))
+}
+
 // AcctterminatecauseEQ applies the EQ predicate on the "acctterminatecause" field.
 func AcctterminatecauseEQ(v string) predicate.RadAcct {
 	return predicate.RadAcct(sql.FieldEQ(FieldAcctterminatecause, v))
@@ -52,6 +52,20 @@ func (rac *RadAcctCreate) SetNillableAcctstarttime(t *time.Time) *RadAcctCreate
 	return rac
 }
 
+// SetAcctupdatetime sets the "acctupdatetime" field.
+func (rac *RadAcctCreate) SetAcctupdatetime(t time.Time) *RadAcctCreate {
+	rac.mutation.SetAcctupdatetime(t)
+	return rac
+}
+
+// SetNillableAcctupdatetime sets the "acctupdatetime" field if the given value is not nil.
+func (rac *RadAcctCreate) SetNillableAcctupdatetime(t *time.Time) *RadAcctCreate {
+	if t != nil {
+		rac.SetAcctupdatetime(*t)
+	}
+	return rac
+}
+
 // SetAcctstoptime sets the "acctstoptime" field.
 func (rac *RadAcctCreate) SetAcctstoptime(t time.Time) *RadAcctCreate {
 	rac.mutation.SetAcctstoptime(t)
@@ -114,6 +128,20 @@ func (rac *RadAcctCreate) SetFramedipaddress(s string) *RadAcctCreate {
 	return rac
 }
 
+// SetNasipaddress sets the "nasipaddress" field.
+func (rac *RadAcctCreate) SetNasipaddress(s string) *RadAcctCreate {
+	rac.mutation.SetNasipaddress(s)
+	return rac
+}
+
+// SetNillableNasipaddress sets the "nasipaddress" field if the given value is not nil.
+func (rac *RadAcctCreate) SetNillableNasipaddress(s *string) *RadAcctCreate {
+	if s != nil {
+		rac.SetNasipaddress(*s)
+	}
+	return rac
+}
+
 // SetAcctterminatecause sets the "acctterminatecause" field.
 func (rac *RadAcctCreate) SetAcctterminatecause(s string) *RadAcctCreate {
 	rac.mutation.SetAcctterminatecause(s)
@@ -133,6 +161,7 @@ func (rac *RadAcctCreate) Mutation() *RadAcctMutation {
 
 // Save creates the RadAcct in the database.
 func (rac *RadAcctCreate) Save(ctx context.Context) (*RadAcct, error) {
+	rac.defaults()
 	return withHooks(ctx, rac.sqlSave, rac.mutation, rac.hooks)
 }
 
@@ -158,6 +187,14 @@ func (rac *RadAcctCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (rac *RadAcctCreate) defaults() {
+	if _, ok := rac.mutation.Nasipaddress(); !ok {
+		v := radacct.DefaultNasipaddress
+		rac.mutation.SetNasipaddress(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (rac *RadAcctCreate) check() error {
 	if _, ok := rac.mutation.Acctsessionid(); !ok {
@@ -192,6 +229,14 @@ func (rac *RadAcctCreate) check() error {
 			return &ValidationError{Name: "framedipaddress", err: fmt.Errorf(`ent: validator failed for field "RadAcct.framedipaddress": %w`, err)}
 		}
 	}
+	if _, ok := rac.mutation.Nasipaddress(); !ok {
+		return &ValidationError{Name: "nasipaddress", err: errors.New(`ent: missing required field "RadAcct.nasipaddress"`)}
+	}
+	if v, ok := rac.mutation.Nasipaddress(); ok {
+		if err := radacct.NasipaddressValidator(v); err != nil {
+			return &ValidationError{Name: "nasipaddress", err: fmt.Errorf(`ent: validator failed for field "RadAcct.nasipaddress": %w`, err)}
+		}
+	}
 	if _, ok := rac.mutation.Acctterminatecause(); !ok {
 		return &ValidationError{Name: "acctterminatecause", err: errors.New(`ent: missing required field "RadAcct.acctterminatecause"`)}
 	}
@@ -248,6 +293,10 @@ func (rac *RadAcctCreate) createSpec() (*RadAcct, *sqlgraph.CreateSpec) {
 		_spec.SetField(radacct.FieldAcctstarttime, field.TypeTime, value)
 		_node.Acctstarttime = &value
 	}
+	if value, ok := rac.mutation.Acctupdatetime(); ok {
+		_spec.SetField(radacct.FieldAcctupdatetime, field.TypeTime, value)
+		_node.Acctupdatetime = &value
+	}
 	if value, ok := rac.mutation.Acctstoptime(); ok {
 		_spec.SetField(radacct.FieldAcctstoptime, field.TypeTime, value)
 		_node.Acctstoptime = &value
@@ -268,6 +317,10 @@ func (rac *RadAcctCreate) createSpec() (*RadAcct, *sqlgraph.CreateSpec) {
 		_spec.SetField(radacct.FieldFramedipaddress, field.TypeString, value)
 		_node.Framedipaddress = value
 	}
+	if value, ok := rac.mutation.Nasipaddress(); ok {
+		_spec.SetField(radacct.FieldNasipaddress, field.TypeString, value)
+		_node.Nasipaddress = value
+	}
 	if value, ok := rac.mutation.Acctterminatecause(); ok {
 		_spec.SetField(radacct.FieldAcctterminatecause, field.TypeString, value)
 		_node.Acctterminatecause = value
@@ -293,6 +346,7 @@ func (racb *RadAcctCreateBulk) Save(ctx context.Context) ([]*RadAcct, error) {
 	for i := range racb.builders {
 		func(i int, root context.Context) {
 			builder := racb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*RadAcctMutation)
 				if !ok {
@@ -90,6 +90,26 @@ func (rau *RadAcctUpdate) ClearAcctstarttime() *RadAcctUpdate {
 	return rau
 }
 
+// SetAcctupdatetime sets the "acctupdatetime" field.
+func (rau *RadAcctUpdate) SetAcctupdatetime(t time.Time) *RadAcctUpdate {
+	rau.mutation.SetAcctupdatetime(t)
+	return rau
+}
+
+// SetNillableAcctupdatetime sets the "acctupdatetime" field if the given value is not nil.
+func (rau *RadAcctUpdate) SetNillableAcctupdatetime(t *time.Time) *RadAcctUpdate {
+	if t != nil {
+		rau.SetAcctupdatetime(*t)
+	}
+	return rau
+}
+
+// ClearAcctupdatetime clears the value of the "acctupdatetime" field.
+func (rau *RadAcctUpdate) ClearAcctupdatetime() *RadAcctUpdate {
+	rau.mutation.ClearAcctupdatetime()
+	return rau
+}
+
 // SetAcctstoptime sets the "acctstoptime" field.
 func (rau *RadAcctUpdate) SetAcctstoptime(t time.Time) *RadAcctUpdate {
 	rau.mutation.SetAcctstoptime(t)
@@ -205,6 +225,20 @@ func (rau *RadAcctUpdate) SetNillableFramedipaddress(s *string) *RadAcctUpdate {
 	return rau
 }
 
+// SetNasipaddress sets the "nasipaddress" field.
+func (rau *RadAcctUpdate) SetNasipaddress(s string) *RadAcctUpdate {
+	rau.mutation.SetNasipaddress(s)
+	return rau
+}
+
+// SetNillableNasipaddress sets the "nasipaddress" field if the given value is not nil.
+func (rau *RadAcctUpdate) SetNillableNasipaddress(s *string) *RadAcctUpdate {
+	if s != nil {
+		rau.SetNasipaddress(*s)
+	}
+	return rau
+}
+
 // SetAcctterminatecause sets the "acctterminatecause" field.
 func (rau *RadAcctUpdate) SetAcctterminatecause(s string) *RadAcctUpdate {
 	rau.mutation.SetAcctterminatecause(s)
@@ -273,6 +307,11 @@ func (rau *RadAcctUpdate) check() error {
 			return &ValidationError{Name: "framedipaddress", err: fmt.Errorf(`ent: validator failed for field "RadAcct.framedipaddress": %w`, err)}
 		}
 	}
+	if v, ok := rau.mutation.Nasipaddress(); ok {
+		if err := radacct.NasipaddressValidator(v); err != nil {
+			return &ValidationError{Name: "nasipaddress", err: fmt.Errorf(`ent: validator failed for field "RadAcct.nasipaddress": %w`, err)}
+		}
+	}
 	if v, ok := rau.mutation.Acctterminatecause(); ok {
 		if err := radacct.AcctterminatecauseValidator(v); err != nil {
 			return &ValidationError{Name: "acctterminatecause", err: fmt.Errorf(`ent: validator failed for field "RadAcct.acctterminatecause": %w`, err)}
@@ -308,6 +347,12 @@ func (rau *RadAcctUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if rau.mutation.AcctstarttimeCleared() {
 		_spec.ClearField(radacct.FieldAcctstarttime, field.TypeTime)
 	}
+	if value, ok := rau.mutation.Acctupdatetime(); ok {
+		_spec.SetField(radacct.FieldAcctupdatetime, field.TypeTime, value)
+	}
+	if rau.mutation.AcctupdatetimeCleared() {
+		_spec.ClearField(radacct.FieldAcctupdatetime, field.TypeTime)
+	}
 	if value, ok := rau.mutation.Acctstoptime(); ok {
 		_spec.SetField(radacct.FieldAcctstoptime, field.TypeTime, value)
 	}
@@ -344,6 +389,9 @@ func (rau *RadAcctUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := rau.mutation.Framedipaddress(); ok {
 		_spec.SetField(radacct.FieldFramedipaddress, field.TypeString, value)
 	}
+	if value, ok := rau.mutation.Nasipaddress(); ok {
+		_spec.SetField(radacct.FieldNasipaddress, field.TypeString, value)
+	}
 	if value, ok := rau.mutation.Acctterminatecause(); ok {
 		_spec.SetField(radacct.FieldAcctterminatecause, field.TypeString, value)
 	}
@@ -429,6 +477,26 @@ func (rauo *RadAcctUpdateOne) ClearAcctstarttime() *RadAcctUpdateOne {
 	return rauo
 }
 
+// SetAcctupdatetime sets the "acctupdatetime" field.
+func (rauo *RadAcctUpdateOne) SetAcctupdatetime(t time.Time) *RadAcctUpdateOne {
+	rauo.mutation.SetAcctupdatetime(t)
+	return rauo
+}
+
+// SetNillableAcctupdatetime sets the "acctupdatetime" field if the given value is not nil.
+func (rauo *RadAcctUpdateOne) SetNillableAcctupdatetime(t *time.Time) *RadAcctUpdateOne {
+	if t != nil {
+		rauo.SetAcctupdatetime(*t)
+	}
+	return rauo
+}
+
+// ClearAcctupdatetime clears the value of the "acctupdatetime" field.
+func (rauo *RadAcctUpdateOne) ClearAcctupdatetime() *RadAcctUpdateOne {
+	rauo.mutation.ClearAcctupdatetime()
+	return rauo
+}
+
 // SetAcctstoptime sets the "acctstoptime" field.
 func (rauo *RadAcctUpdateOne) SetAcctstoptime(t time.Time) *RadAcctUpdateOne {
 	rauo.mutation.SetAcctstoptime(t)
@@ -544,6 +612,20 @@ func (rauo *RadAcctUpdateOne) SetNillableFramedipaddress(s *string) *RadAcctUpda
 	return rauo
 }
 
+// SetNasipaddress sets the "nasipaddress" field.
+func (rauo *RadAcctUpdateOne) SetNasipaddress(s string) *RadAcctUpdateOne {
+	rauo.mutation.SetNasipaddress(s)
+	return rauo
+}
+
+// SetNillableNasipaddress sets the "nasipaddress" field if the given value is not nil.
+func (rauo *RadAcctUpdateOne) SetNillableNasipaddress(s *string) *RadAcctUpdateOne {
+	if s != nil {
+		rauo.SetNasipaddress(*s)
+	}
+	return rauo
+}
+
 // SetAcctterminatecause sets the "acctterminatecause" field.
 func (rauo *RadAcctUpdateOne) SetAcctterminatecause(s string) *RadAcctUpdateOne {
 	rauo.mutation.SetAcctterminatecause(s)
@@ -625,6 +707,11 @@ func (rauo *RadAcctUpdateOne) check() error {
 			return &ValidationError{Name: "framedipaddress", err: fmt.Errorf(`ent: validator failed for field "RadAcct.framedipaddress": %w`, err)}
 		}
 	}
+	if v, ok := rauo.mutation.Nasipaddress(); ok {
+		if err := radacct.NasipaddressValidator(v); err != nil {
+			return &ValidationError{Name: "nasipaddress", err: fmt.Errorf(`ent: validator failed for field "RadAcct.nasipaddress": %w`, err)}
+		}
+	}
 	if v, ok := rauo.mutation.Acctterminatecause(); ok {
 		if err := radacct.AcctterminatecauseValidator(v); err != nil {
 			return &ValidationError{Name: "acctterminatecause", err: fmt.Errorf(`ent: validator failed for field "RadAcct.acctterminatecause": %w`, err)}
@@ -677,6 +764,12 @@ func (rauo *RadAcctUpdateOne) sqlSave(ctx context.Context) (_node *RadAcct, err
 	if rauo.mutation.AcctstarttimeCleared() {
 		_spec.ClearField(radacct.FieldAcctstarttime, field.TypeTime)
 	}
+	if value, ok := rauo.mutation.Acctupdatetime(); ok {
+		_spec.SetField(radacct.FieldAcctupdatetime, field.TypeTime, value)
+	}
+	if rauo.mutation.AcctupdatetimeCleared() {
+		_spec.ClearField(radacct.FieldAcctupdatetime, field.TypeTime)
+	}
 	if value, ok := rauo.mutation.Acctstoptime(); ok {
 		_spec.SetField(radacct.FieldAcctstoptime, field.TypeTime, value)
 	}
@@ -713,6 +806,9 @@ func (rauo *RadAcctUpdateOne) sqlSave(ctx context.Context) (_node *RadAcct, err
 	if value, ok := rauo.mutation.Framedipaddress(); ok {
 		_spec.SetField(radacct.FieldFramedipaddress, field.TypeString, value)
 	}
+	if value, ok := rauo.mutation.Nasipaddress(); ok {
+		_spec.SetField(radacct.FieldNasipaddress, field.TypeString, value)
+	}
 	if value, ok := rauo.mutation.Acctterminatecause(); ok {
 		_spec.SetField(radacct.FieldAcctterminatecause, field.TypeString, value)
 	}
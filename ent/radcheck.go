@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/radcheck"
+)
+
+// RadCheck is the model entity for the RadCheck schema.
+type RadCheck struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Attribute holds the value of the "attribute" field.
+	Attribute string `json:"attribute,omitempty"`
+	// Op holds the value of the "op" field.
+	Op string `json:"op,omitempty"`
+	// Value holds the value of the "value" field.
+	Value        string `json:"value,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RadCheck) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case radcheck.FieldID:
+			values[i] = new(sql.NullInt64)
+		case radcheck.FieldUsername, radcheck.FieldAttribute, radcheck.FieldOp, radcheck.FieldValue:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RadCheck fields.
+func (rc *RadCheck) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case radcheck.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rc.ID = int(value.Int64)
+		case radcheck.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				rc.Username = value.String
+			}
+		case radcheck.FieldAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute", values[i])
+			} else if value.Valid {
+				rc.Attribute = value.String
+			}
+		case radcheck.FieldOp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field op", values[i])
+			} else if value.Valid {
+				rc.Op = value.String
+			}
+		case radcheck.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				rc.Value = value.String
+			}
+		default:
+			rc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the RadCheck.
+// This includes values selected through modifiers, order, etc.
+func (rc *RadCheck) GetValue(name string) (ent.Value, error) {
+	return rc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RadCheck.
+// Note that you need to call RadCheck.Unwrap() before calling this method if this RadCheck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rc *RadCheck) Update() *RadCheckUpdateOne {
+	return NewRadCheckClient(rc.config).UpdateOne(rc)
+}
+
+// Unwrap unwraps the RadCheck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rc *RadCheck) Unwrap() *RadCheck {
+	_tx, ok := rc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RadCheck is not a transactional entity")
+	}
+	rc.config.driver = _tx.drv
+	return rc
+}
+
+// String implements the fmt.Stringer.
+func (rc *RadCheck) String() string {
+	var builder strings.Builder
+	builder.WriteString("RadCheck(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rc.ID))
+	builder.WriteString("username=")
+	builder.WriteString(rc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("attribute=")
+	builder.WriteString(rc.Attribute)
+	builder.WriteString(", ")
+	builder.WriteString("op=")
+	builder.WriteString(rc.Op)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(rc.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RadChecks is a parsable slice of RadCheck.
+type RadChecks []*RadCheck
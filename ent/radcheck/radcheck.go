@@ -0,0 +1,90 @@
+// Code generated by ent, DO NOT EDIT.
+
+package radcheck
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the radcheck type in the database.
+	Label = "rad_check"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldAttribute holds the string denoting the attribute field in the database.
+	FieldAttribute = "attribute"
+	// FieldOp holds the string denoting the op field in the database.
+	FieldOp = "op"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// Table holds the table name of the radcheck in the database.
+	Table = "radcheck"
+)
+
+// Columns holds all SQL columns for radcheck fields.
+var Columns = []string{
+	FieldID,
+	FieldUsername,
+	FieldAttribute,
+	FieldOp,
+	FieldValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUsername holds the default value on creation for the "username" field.
+	DefaultUsername string
+	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	UsernameValidator func(string) error
+	// DefaultAttribute holds the default value on creation for the "attribute" field.
+	DefaultAttribute string
+	// AttributeValidator is a validator for the "attribute" field. It is called by the builders before save.
+	AttributeValidator func(string) error
+	// DefaultOp holds the default value on creation for the "op" field.
+	DefaultOp string
+	// OpValidator is a validator for the "op" field. It is called by the builders before save.
+	OpValidator func(string) error
+	// DefaultValue holds the default value on creation for the "value" field.
+	DefaultValue string
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the RadCheck queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByAttribute orders the results by the attribute field.
+func ByAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttribute, opts...).ToFunc()
+}
+
+// ByOp orders the results by the op field.
+func ByOp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOp, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
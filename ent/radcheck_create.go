@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/radcheck"
+)
+
+// RadCheckCreate is the builder for creating a RadCheck entity.
+type RadCheckCreate struct {
+	config
+	mutation *RadCheckMutation
+	hooks    []Hook
+}
+
+// SetUsername sets the "username" field.
+func (rcc *RadCheckCreate) SetUsername(s string) *RadCheckCreate {
+	rcc.mutation.SetUsername(s)
+	return rcc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rcc *RadCheckCreate) SetNillableUsername(s *string) *RadCheckCreate {
+	if s != nil {
+		rcc.SetUsername(*s)
+	}
+	return rcc
+}
+
+// SetAttribute sets the "attribute" field.
+func (rcc *RadCheckCreate) SetAttribute(s string) *RadCheckCreate {
+	rcc.mutation.SetAttribute(s)
+	return rcc
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rcc *RadCheckCreate) SetNillableAttribute(s *string) *RadCheckCreate {
+	if s != nil {
+		rcc.SetAttribute(*s)
+	}
+	return rcc
+}
+
+// SetOp sets the "op" field.
+func (rcc *RadCheckCreate) SetOp(s string) *RadCheckCreate {
+	rcc.mutation.SetOpField(s)
+	return rcc
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rcc *RadCheckCreate) SetNillableOp(s *string) *RadCheckCreate {
+	if s != nil {
+		rcc.SetOp(*s)
+	}
+	return rcc
+}
+
+// SetValue sets the "value" field.
+func (rcc *RadCheckCreate) SetValue(s string) *RadCheckCreate {
+	rcc.mutation.SetValue(s)
+	return rcc
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rcc *RadCheckCreate) SetNillableValue(s *string) *RadCheckCreate {
+	if s != nil {
+		rcc.SetValue(*s)
+	}
+	return rcc
+}
+
+// SetID sets the "id" field.
+func (rcc *RadCheckCreate) SetID(i int) *RadCheckCreate {
+	rcc.mutation.SetID(i)
+	return rcc
+}
+
+// Mutation returns the RadCheckMutation object of the builder.
+func (rcc *RadCheckCreate) Mutation() *RadCheckMutation {
+	return rcc.mutation
+}
+
+// Save creates the RadCheck in the database.
+func (rcc *RadCheckCreate) Save(ctx context.Context) (*RadCheck, error) {
+	rcc.defaults()
+	return withHooks(ctx, rcc.sqlSave, rcc.mutation, rcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *RadCheckCreate) SaveX(ctx context.Context) *RadCheck {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *RadCheckCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *RadCheckCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *RadCheckCreate) defaults() {
+	if _, ok := rcc.mutation.Username(); !ok {
+		v := radcheck.DefaultUsername
+		rcc.mutation.SetUsername(v)
+	}
+	if _, ok := rcc.mutation.Attribute(); !ok {
+		v := radcheck.DefaultAttribute
+		rcc.mutation.SetAttribute(v)
+	}
+	if _, ok := rcc.mutation.GetOp(); !ok {
+		v := radcheck.DefaultOp
+		rcc.mutation.SetOpField(v)
+	}
+	if _, ok := rcc.mutation.Value(); !ok {
+		v := radcheck.DefaultValue
+		rcc.mutation.SetValue(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *RadCheckCreate) check() error {
+	if _, ok := rcc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "RadCheck.username"`)}
+	}
+	if v, ok := rcc.mutation.Username(); ok {
+		if err := radcheck.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadCheck.username": %w`, err)}
+		}
+	}
+	if _, ok := rcc.mutation.Attribute(); !ok {
+		return &ValidationError{Name: "attribute", err: errors.New(`ent: missing required field "RadCheck.attribute"`)}
+	}
+	if v, ok := rcc.mutation.Attribute(); ok {
+		if err := radcheck.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadCheck.attribute": %w`, err)}
+		}
+	}
+	if _, ok := rcc.mutation.GetOp(); !ok {
+		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "RadCheck.op"`)}
+	}
+	if v, ok := rcc.mutation.GetOp(); ok {
+		if err := radcheck.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadCheck.op": %w`, err)}
+		}
+	}
+	if _, ok := rcc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "RadCheck.value"`)}
+	}
+	if v, ok := rcc.mutation.Value(); ok {
+		if err := radcheck.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadCheck.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rcc *RadCheckCreate) sqlSave(ctx context.Context) (*RadCheck, error) {
+	if err := rcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rcc.mutation.id = &_node.ID
+	rcc.mutation.done = true
+	return _node, nil
+}
+
+func (rcc *RadCheckCreate) createSpec() (*RadCheck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RadCheck{config: rcc.config}
+		_spec = sqlgraph.NewCreateSpec(radcheck.Table, sqlgraph.NewFieldSpec(radcheck.FieldID, field.TypeInt))
+	)
+	if id, ok := rcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rcc.mutation.Username(); ok {
+		_spec.SetField(radcheck.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := rcc.mutation.Attribute(); ok {
+		_spec.SetField(radcheck.FieldAttribute, field.TypeString, value)
+		_node.Attribute = value
+	}
+	if value, ok := rcc.mutation.GetOp(); ok {
+		_spec.SetField(radcheck.FieldOp, field.TypeString, value)
+		_node.Op = value
+	}
+	if value, ok := rcc.mutation.Value(); ok {
+		_spec.SetField(radcheck.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	return _node, _spec
+}
+
+// RadCheckCreateBulk is the builder for creating many RadCheck entities in bulk.
+type RadCheckCreateBulk struct {
+	config
+	err      error
+	builders []*RadCheckCreate
+}
+
+// Save creates the RadCheck entities in the database.
+func (rccb *RadCheckCreateBulk) Save(ctx context.Context) ([]*RadCheck, error) {
+	if rccb.err != nil {
+		return nil, rccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*RadCheck, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RadCheckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *RadCheckCreateBulk) SaveX(ctx context.Context) []*RadCheck {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *RadCheckCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *RadCheckCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/radcheck"
+)
+
+// RadCheckUpdate is the builder for updating RadCheck entities.
+type RadCheckUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RadCheckMutation
+}
+
+// Where appends a list predicates to the RadCheckUpdate builder.
+func (rcu *RadCheckUpdate) Where(ps ...predicate.RadCheck) *RadCheckUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetUsername sets the "username" field.
+func (rcu *RadCheckUpdate) SetUsername(s string) *RadCheckUpdate {
+	rcu.mutation.SetUsername(s)
+	return rcu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rcu *RadCheckUpdate) SetNillableUsername(s *string) *RadCheckUpdate {
+	if s != nil {
+		rcu.SetUsername(*s)
+	}
+	return rcu
+}
+
+// SetAttribute sets the "attribute" field.
+func (rcu *RadCheckUpdate) SetAttribute(s string) *RadCheckUpdate {
+	rcu.mutation.SetAttribute(s)
+	return rcu
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rcu *RadCheckUpdate) SetNillableAttribute(s *string) *RadCheckUpdate {
+	if s != nil {
+		rcu.SetAttribute(*s)
+	}
+	return rcu
+}
+
+// SetOp sets the "op" field.
+func (rcu *RadCheckUpdate) SetOp(s string) *RadCheckUpdate {
+	rcu.mutation.SetOpField(s)
+	return rcu
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rcu *RadCheckUpdate) SetNillableOp(s *string) *RadCheckUpdate {
+	if s != nil {
+		rcu.SetOp(*s)
+	}
+	return rcu
+}
+
+// SetValue sets the "value" field.
+func (rcu *RadCheckUpdate) SetValue(s string) *RadCheckUpdate {
+	rcu.mutation.SetValue(s)
+	return rcu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rcu *RadCheckUpdate) SetNillableValue(s *string) *RadCheckUpdate {
+	if s != nil {
+		rcu.SetValue(*s)
+	}
+	return rcu
+}
+
+// Mutation returns the RadCheckMutation object of the builder.
+func (rcu *RadCheckUpdate) Mutation() *RadCheckMutation {
+	return rcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *RadCheckUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rcu.sqlSave, rcu.mutation, rcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *RadCheckUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *RadCheckUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *RadCheckUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcu *RadCheckUpdate) check() error {
+	if v, ok := rcu.mutation.Username(); ok {
+		if err := radcheck.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadCheck.username": %w`, err)}
+		}
+	}
+	if v, ok := rcu.mutation.Attribute(); ok {
+		if err := radcheck.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadCheck.attribute": %w`, err)}
+		}
+	}
+	if v, ok := rcu.mutation.GetOp(); ok {
+		if err := radcheck.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadCheck.op": %w`, err)}
+		}
+	}
+	if v, ok := rcu.mutation.Value(); ok {
+		if err := radcheck.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadCheck.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rcu *RadCheckUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(radcheck.Table, radcheck.Columns, sqlgraph.NewFieldSpec(radcheck.FieldID, field.TypeInt))
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.Username(); ok {
+		_spec.SetField(radcheck.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.Attribute(); ok {
+		_spec.SetField(radcheck.FieldAttribute, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.GetOp(); ok {
+		_spec.SetField(radcheck.FieldOp, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.Value(); ok {
+		_spec.SetField(radcheck.FieldValue, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{radcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcu.mutation.done = true
+	return n, nil
+}
+
+// RadCheckUpdateOne is the builder for updating a single RadCheck entity.
+type RadCheckUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RadCheckMutation
+}
+
+// SetUsername sets the "username" field.
+func (rcuo *RadCheckUpdateOne) SetUsername(s string) *RadCheckUpdateOne {
+	rcuo.mutation.SetUsername(s)
+	return rcuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rcuo *RadCheckUpdateOne) SetNillableUsername(s *string) *RadCheckUpdateOne {
+	if s != nil {
+		rcuo.SetUsername(*s)
+	}
+	return rcuo
+}
+
+// SetAttribute sets the "attribute" field.
+func (rcuo *RadCheckUpdateOne) SetAttribute(s string) *RadCheckUpdateOne {
+	rcuo.mutation.SetAttribute(s)
+	return rcuo
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rcuo *RadCheckUpdateOne) SetNillableAttribute(s *string) *RadCheckUpdateOne {
+	if s != nil {
+		rcuo.SetAttribute(*s)
+	}
+	return rcuo
+}
+
+// SetOp sets the "op" field.
+func (rcuo *RadCheckUpdateOne) SetOp(s string) *RadCheckUpdateOne {
+	rcuo.mutation.SetOpField(s)
+	return rcuo
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rcuo *RadCheckUpdateOne) SetNillableOp(s *string) *RadCheckUpdateOne {
+	if s != nil {
+		rcuo.SetOp(*s)
+	}
+	return rcuo
+}
+
+// SetValue sets the "value" field.
+func (rcuo *RadCheckUpdateOne) SetValue(s string) *RadCheckUpdateOne {
+	rcuo.mutation.SetValue(s)
+	return rcuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rcuo *RadCheckUpdateOne) SetNillableValue(s *string) *RadCheckUpdateOne {
+	if s != nil {
+		rcuo.SetValue(*s)
+	}
+	return rcuo
+}
+
+// Mutation returns the RadCheckMutation object of the builder.
+func (rcuo *RadCheckUpdateOne) Mutation() *RadCheckMutation {
+	return rcuo.mutation
+}
+
+// Where appends a list predicates to the RadCheckUpdate builder.
+func (rcuo *RadCheckUpdateOne) Where(ps ...predicate.RadCheck) *RadCheckUpdateOne {
+	rcuo.mutation.Where(ps...)
+	return rcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *RadCheckUpdateOne) Select(field string, fields ...string) *RadCheckUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated RadCheck entity.
+func (rcuo *RadCheckUpdateOne) Save(ctx context.Context) (*RadCheck, error) {
+	return withHooks(ctx, rcuo.sqlSave, rcuo.mutation, rcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *RadCheckUpdateOne) SaveX(ctx context.Context) *RadCheck {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *RadCheckUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *RadCheckUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcuo *RadCheckUpdateOne) check() error {
+	if v, ok := rcuo.mutation.Username(); ok {
+		if err := radcheck.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadCheck.username": %w`, err)}
+		}
+	}
+	if v, ok := rcuo.mutation.Attribute(); ok {
+		if err := radcheck.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadCheck.attribute": %w`, err)}
+		}
+	}
+	if v, ok := rcuo.mutation.GetOp(); ok {
+		if err := radcheck.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadCheck.op": %w`, err)}
+		}
+	}
+	if v, ok := rcuo.mutation.Value(); ok {
+		if err := radcheck.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadCheck.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rcuo *RadCheckUpdateOne) sqlSave(ctx context.Context) (_node *RadCheck, err error) {
+	if err := rcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(radcheck.Table, radcheck.Columns, sqlgraph.NewFieldSpec(radcheck.FieldID, field.TypeInt))
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RadCheck.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, radcheck.FieldID)
+		for _, f := range fields {
+			if !radcheck.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != radcheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.Username(); ok {
+		_spec.SetField(radcheck.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.Attribute(); ok {
+		_spec.SetField(radcheck.FieldAttribute, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.GetOp(); ok {
+		_spec.SetField(radcheck.FieldOp, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.Value(); ok {
+		_spec.SetField(radcheck.FieldValue, field.TypeString, value)
+	}
+	_node = &RadCheck{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{radcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcuo.mutation.done = true
+	return _node, nil
+}
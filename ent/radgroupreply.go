@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+)
+
+// RadGroupReply is the model entity for the RadGroupReply schema.
+type RadGroupReply struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Groupname holds the value of the "groupname" field.
+	Groupname string `json:"groupname,omitempty"`
+	// Attribute holds the value of the "attribute" field.
+	Attribute string `json:"attribute,omitempty"`
+	// Op holds the value of the "op" field.
+	Op string `json:"op,omitempty"`
+	// Value holds the value of the "value" field.
+	Value        string `json:"value,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RadGroupReply) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case radgroupreply.FieldID:
+			values[i] = new(sql.NullInt64)
+		case radgroupreply.FieldGroupname, radgroupreply.FieldAttribute, radgroupreply.FieldOp, radgroupreply.FieldValue:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RadGroupReply fields.
+func (rgr *RadGroupReply) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case radgroupreply.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rgr.ID = int(value.Int64)
+		case radgroupreply.FieldGroupname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field groupname", values[i])
+			} else if value.Valid {
+				rgr.Groupname = value.String
+			}
+		case radgroupreply.FieldAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute", values[i])
+			} else if value.Valid {
+				rgr.Attribute = value.String
+			}
+		case radgroupreply.FieldOp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field op", values[i])
+			} else if value.Valid {
+				rgr.Op = value.String
+			}
+		case radgroupreply.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				rgr.Value = value.String
+			}
+		default:
+			rgr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the RadGroupReply.
+// This includes values selected through modifiers, order, etc.
+func (rgr *RadGroupReply) GetValue(name string) (ent.Value, error) {
+	return rgr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RadGroupReply.
+// Note that you need to call RadGroupReply.Unwrap() before calling this method if this RadGroupReply
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rgr *RadGroupReply) Update() *RadGroupReplyUpdateOne {
+	return NewRadGroupReplyClient(rgr.config).UpdateOne(rgr)
+}
+
+// Unwrap unwraps the RadGroupReply entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rgr *RadGroupReply) Unwrap() *RadGroupReply {
+	_tx, ok := rgr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RadGroupReply is not a transactional entity")
+	}
+	rgr.config.driver = _tx.drv
+	return rgr
+}
+
+// String implements the fmt.Stringer.
+func (rgr *RadGroupReply) String() string {
+	var builder strings.Builder
+	builder.WriteString("RadGroupReply(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rgr.ID))
+	builder.WriteString("groupname=")
+	builder.WriteString(rgr.Groupname)
+	builder.WriteString(", ")
+	builder.WriteString("attribute=")
+	builder.WriteString(rgr.Attribute)
+	builder.WriteString(", ")
+	builder.WriteString("op=")
+	builder.WriteString(rgr.Op)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(rgr.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RadGroupReplies is a parsable slice of RadGroupReply.
+type RadGroupReplies []*RadGroupReply
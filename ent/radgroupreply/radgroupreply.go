@@ -0,0 +1,90 @@
+// Code generated by ent, DO NOT EDIT.
+
+package radgroupreply
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the radgroupreply type in the database.
+	Label = "rad_group_reply"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGroupname holds the string denoting the groupname field in the database.
+	FieldGroupname = "groupname"
+	// FieldAttribute holds the string denoting the attribute field in the database.
+	FieldAttribute = "attribute"
+	// FieldOp holds the string denoting the op field in the database.
+	FieldOp = "op"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// Table holds the table name of the radgroupreply in the database.
+	Table = "radgroupreply"
+)
+
+// Columns holds all SQL columns for radgroupreply fields.
+var Columns = []string{
+	FieldID,
+	FieldGroupname,
+	FieldAttribute,
+	FieldOp,
+	FieldValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultGroupname holds the default value on creation for the "groupname" field.
+	DefaultGroupname string
+	// GroupnameValidator is a validator for the "groupname" field. It is called by the builders before save.
+	GroupnameValidator func(string) error
+	// DefaultAttribute holds the default value on creation for the "attribute" field.
+	DefaultAttribute string
+	// AttributeValidator is a validator for the "attribute" field. It is called by the builders before save.
+	AttributeValidator func(string) error
+	// DefaultOp holds the default value on creation for the "op" field.
+	DefaultOp string
+	// OpValidator is a validator for the "op" field. It is called by the builders before save.
+	OpValidator func(string) error
+	// DefaultValue holds the default value on creation for the "value" field.
+	DefaultValue string
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the RadGroupReply queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGroupname orders the results by the groupname field.
+func ByGroupname(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupname, opts...).ToFunc()
+}
+
+// ByAttribute orders the results by the attribute field.
+func ByAttribute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttribute, opts...).ToFunc()
+}
+
+// ByOp orders the results by the op field.
+func ByOp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOp, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
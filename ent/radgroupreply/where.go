@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package radgroupreply
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLTE(FieldID, id))
+}
+
+// Groupname applies equality check predicate on the "groupname" field. It's identical to GroupnameEQ.
+func Groupname(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldGroupname, v))
+}
+
+// Attribute applies equality check predicate on the "attribute" field. It's identical to AttributeEQ.
+func Attribute(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldAttribute, v))
+}
+
+// Op applies equality check predicate on the "op" field. It's identical to OpEQ.
+func Op(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldOp, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldValue, v))
+}
+
+// GroupnameEQ applies the EQ predicate on the "groupname" field.
+func GroupnameEQ(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldGroupname, v))
+}
+
+// GroupnameNEQ applies the NEQ predicate on the "groupname" field.
+func GroupnameNEQ(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNEQ(FieldGroupname, v))
+}
+
+// GroupnameIn applies the In predicate on the "groupname" field.
+func GroupnameIn(vs ...string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldIn(FieldGroupname, vs...))
+}
+
+// GroupnameNotIn applies the NotIn predicate on the "groupname" field.
+func GroupnameNotIn(vs ...string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNotIn(FieldGroupname, vs...))
+}
+
+// GroupnameGT applies the GT predicate on the "groupname" field.
+func GroupnameGT(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGT(FieldGroupname, v))
+}
+
+// GroupnameGTE applies the GTE predicate on the "groupname" field.
+func GroupnameGTE(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGTE(FieldGroupname, v))
+}
+
+// GroupnameLT applies the LT predicate on the "groupname" field.
+func GroupnameLT(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLT(FieldGroupname, v))
+}
+
+// GroupnameLTE applies the LTE predicate on the "groupname" field.
+func GroupnameLTE(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLTE(FieldGroupname, v))
+}
+
+// GroupnameContains applies the Contains predicate on the "groupname" field.
+func GroupnameContains(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldContains(FieldGroupname, v))
+}
+
+// GroupnameHasPrefix applies the HasPrefix predicate on the "groupname" field.
+func GroupnameHasPrefix(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldHasPrefix(FieldGroupname, v))
+}
+
+// GroupnameHasSuffix applies the HasSuffix predicate on the "groupname" field.
+func GroupnameHasSuffix(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldHasSuffix(FieldGroupname, v))
+}
+
+// GroupnameEqualFold applies the EqualFold predicate on the "groupname" field.
+func GroupnameEqualFold(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEqualFold(FieldGroupname, v))
+}
+
+// GroupnameContainsFold applies the ContainsFold predicate on the "groupname" field.
+func GroupnameContainsFold(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldContainsFold(FieldGroupname, v))
+}
+
+// AttributeEQ applies the EQ predicate on the "attribute" field.
+func AttributeEQ(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldAttribute, v))
+}
+
+// AttributeNEQ applies the NEQ predicate on the "attribute" field.
+func AttributeNEQ(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNEQ(FieldAttribute, v))
+}
+
+// AttributeIn applies the In predicate on the "attribute" field.
+func AttributeIn(vs ...string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldIn(FieldAttribute, vs...))
+}
+
+// AttributeNotIn applies the NotIn predicate on the "attribute" field.
+func AttributeNotIn(vs ...string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNotIn(FieldAttribute, vs...))
+}
+
+// AttributeGT applies the GT predicate on the "attribute" field.
+func AttributeGT(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGT(FieldAttribute, v))
+}
+
+// AttributeGTE applies the GTE predicate on the "attribute" field.
+func AttributeGTE(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGTE(FieldAttribute, v))
+}
+
+// AttributeLT applies the LT predicate on the "attribute" field.
+func AttributeLT(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLT(FieldAttribute, v))
+}
+
+// AttributeLTE applies the LTE predicate on the "attribute" field.
+func AttributeLTE(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLTE(FieldAttribute, v))
+}
+
+// AttributeContains applies the Contains predicate on the "attribute" field.
+func AttributeContains(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldContains(FieldAttribute, v))
+}
+
+// AttributeHasPrefix applies the HasPrefix predicate on the "attribute" field.
+func AttributeHasPrefix(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldHasPrefix(FieldAttribute, v))
+}
+
+// AttributeHasSuffix applies the HasSuffix predicate on the "attribute" field.
+func AttributeHasSuffix(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldHasSuffix(FieldAttribute, v))
+}
+
+// AttributeEqualFold applies the EqualFold predicate on the "attribute" field.
+func AttributeEqualFold(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEqualFold(FieldAttribute, v))
+}
+
+// AttributeContainsFold applies the ContainsFold predicate on the "attribute" field.
+func AttributeContainsFold(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldContainsFold(FieldAttribute, v))
+}
+
+// OpEQ applies the EQ predicate on the "op" field.
+func OpEQ(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldOp, v))
+}
+
+// OpNEQ applies the NEQ predicate on the "op" field.
+func OpNEQ(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNEQ(FieldOp, v))
+}
+
+// OpIn applies the In predicate on the "op" field.
+func OpIn(vs ...string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldIn(FieldOp, vs...))
+}
+
+// OpNotIn applies the NotIn predicate on the "op" field.
+func OpNotIn(vs ...string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNotIn(FieldOp, vs...))
+}
+
+// OpGT applies the GT predicate on the "op" field.
+func OpGT(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGT(FieldOp, v))
+}
+
+// OpGTE applies the GTE predicate on the "op" field.
+func OpGTE(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGTE(FieldOp, v))
+}
+
+// OpLT applies the LT predicate on the "op" field.
+func OpLT(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLT(FieldOp, v))
+}
+
+// OpLTE applies the LTE predicate on the "op" field.
+func OpLTE(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLTE(FieldOp, v))
+}
+
+// OpContains applies the Contains predicate on the "op" field.
+func OpContains(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldContains(FieldOp, v))
+}
+
+// OpHasPrefix applies the HasPrefix predicate on the "op" field.
+func OpHasPrefix(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldHasPrefix(FieldOp, v))
+}
+
+// OpHasSuffix applies the HasSuffix predicate on the "op" field.
+func OpHasSuffix(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldHasSuffix(FieldOp, v))
+}
+
+// OpEqualFold applies the EqualFold predicate on the "op" field.
+func OpEqualFold(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEqualFold(FieldOp, v))
+}
+
+// OpContainsFold applies the ContainsFold predicate on the "op" field.
+func OpContainsFold(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldContainsFold(FieldOp, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.FieldContainsFold(FieldValue, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RadGroupReply) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RadGroupReply) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RadGroupReply) predicate.RadGroupReply {
+	return predicate.RadGroupReply(sql.NotPredicates(p))
+}
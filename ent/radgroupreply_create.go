@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+)
+
+// RadGroupReplyCreate is the builder for creating a RadGroupReply entity.
+type RadGroupReplyCreate struct {
+	config
+	mutation *RadGroupReplyMutation
+	hooks    []Hook
+}
+
+// SetGroupname sets the "groupname" field.
+func (rgrc *RadGroupReplyCreate) SetGroupname(s string) *RadGroupReplyCreate {
+	rgrc.mutation.SetGroupname(s)
+	return rgrc
+}
+
+// SetNillableGroupname sets the "groupname" field if the given value is not nil.
+func (rgrc *RadGroupReplyCreate) SetNillableGroupname(s *string) *RadGroupReplyCreate {
+	if s != nil {
+		rgrc.SetGroupname(*s)
+	}
+	return rgrc
+}
+
+// SetAttribute sets the "attribute" field.
+func (rgrc *RadGroupReplyCreate) SetAttribute(s string) *RadGroupReplyCreate {
+	rgrc.mutation.SetAttribute(s)
+	return rgrc
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rgrc *RadGroupReplyCreate) SetNillableAttribute(s *string) *RadGroupReplyCreate {
+	if s != nil {
+		rgrc.SetAttribute(*s)
+	}
+	return rgrc
+}
+
+// SetOp sets the "op" field.
+func (rgrc *RadGroupReplyCreate) SetOp(s string) *RadGroupReplyCreate {
+	rgrc.mutation.SetOpField(s)
+	return rgrc
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rgrc *RadGroupReplyCreate) SetNillableOp(s *string) *RadGroupReplyCreate {
+	if s != nil {
+		rgrc.SetOp(*s)
+	}
+	return rgrc
+}
+
+// SetValue sets the "value" field.
+func (rgrc *RadGroupReplyCreate) SetValue(s string) *RadGroupReplyCreate {
+	rgrc.mutation.SetValue(s)
+	return rgrc
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rgrc *RadGroupReplyCreate) SetNillableValue(s *string) *RadGroupReplyCreate {
+	if s != nil {
+		rgrc.SetValue(*s)
+	}
+	return rgrc
+}
+
+// SetID sets the "id" field.
+func (rgrc *RadGroupReplyCreate) SetID(i int) *RadGroupReplyCreate {
+	rgrc.mutation.SetID(i)
+	return rgrc
+}
+
+// Mutation returns the RadGroupReplyMutation object of the builder.
+func (rgrc *RadGroupReplyCreate) Mutation() *RadGroupReplyMutation {
+	return rgrc.mutation
+}
+
+// Save creates the RadGroupReply in the database.
+func (rgrc *RadGroupReplyCreate) Save(ctx context.Context) (*RadGroupReply, error) {
+	rgrc.defaults()
+	return withHooks(ctx, rgrc.sqlSave, rgrc.mutation, rgrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rgrc *RadGroupReplyCreate) SaveX(ctx context.Context) *RadGroupReply {
+	v, err := rgrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rgrc *RadGroupReplyCreate) Exec(ctx context.Context) error {
+	_, err := rgrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgrc *RadGroupReplyCreate) ExecX(ctx context.Context) {
+	if err := rgrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rgrc *RadGroupReplyCreate) defaults() {
+	if _, ok := rgrc.mutation.Groupname(); !ok {
+		v := radgroupreply.DefaultGroupname
+		rgrc.mutation.SetGroupname(v)
+	}
+	if _, ok := rgrc.mutation.Attribute(); !ok {
+		v := radgroupreply.DefaultAttribute
+		rgrc.mutation.SetAttribute(v)
+	}
+	if _, ok := rgrc.mutation.GetOp(); !ok {
+		v := radgroupreply.DefaultOp
+		rgrc.mutation.SetOpField(v)
+	}
+	if _, ok := rgrc.mutation.Value(); !ok {
+		v := radgroupreply.DefaultValue
+		rgrc.mutation.SetValue(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rgrc *RadGroupReplyCreate) check() error {
+	if _, ok := rgrc.mutation.Groupname(); !ok {
+		return &ValidationError{Name: "groupname", err: errors.New(`ent: missing required field "RadGroupReply.groupname"`)}
+	}
+	if v, ok := rgrc.mutation.Groupname(); ok {
+		if err := radgroupreply.GroupnameValidator(v); err != nil {
+			return &ValidationError{Name: "groupname", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.groupname": %w`, err)}
+		}
+	}
+	if _, ok := rgrc.mutation.Attribute(); !ok {
+		return &ValidationError{Name: "attribute", err: errors.New(`ent: missing required field "RadGroupReply.attribute"`)}
+	}
+	if v, ok := rgrc.mutation.Attribute(); ok {
+		if err := radgroupreply.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.attribute": %w`, err)}
+		}
+	}
+	if _, ok := rgrc.mutation.GetOp(); !ok {
+		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "RadGroupReply.op"`)}
+	}
+	if v, ok := rgrc.mutation.GetOp(); ok {
+		if err := radgroupreply.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.op": %w`, err)}
+		}
+	}
+	if _, ok := rgrc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "RadGroupReply.value"`)}
+	}
+	if v, ok := rgrc.mutation.Value(); ok {
+		if err := radgroupreply.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rgrc *RadGroupReplyCreate) sqlSave(ctx context.Context) (*RadGroupReply, error) {
+	if err := rgrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rgrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rgrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rgrc.mutation.id = &_node.ID
+	rgrc.mutation.done = true
+	return _node, nil
+}
+
+func (rgrc *RadGroupReplyCreate) createSpec() (*RadGroupReply, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RadGroupReply{config: rgrc.config}
+		_spec = sqlgraph.NewCreateSpec(radgroupreply.Table, sqlgraph.NewFieldSpec(radgroupreply.FieldID, field.TypeInt))
+	)
+	if id, ok := rgrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rgrc.mutation.Groupname(); ok {
+		_spec.SetField(radgroupreply.FieldGroupname, field.TypeString, value)
+		_node.Groupname = value
+	}
+	if value, ok := rgrc.mutation.Attribute(); ok {
+		_spec.SetField(radgroupreply.FieldAttribute, field.TypeString, value)
+		_node.Attribute = value
+	}
+	if value, ok := rgrc.mutation.GetOp(); ok {
+		_spec.SetField(radgroupreply.FieldOp, field.TypeString, value)
+		_node.Op = value
+	}
+	if value, ok := rgrc.mutation.Value(); ok {
+		_spec.SetField(radgroupreply.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	return _node, _spec
+}
+
+// RadGroupReplyCreateBulk is the builder for creating many RadGroupReply entities in bulk.
+type RadGroupReplyCreateBulk struct {
+	config
+	err      error
+	builders []*RadGroupReplyCreate
+}
+
+// Save creates the RadGroupReply entities in the database.
+func (rgrcb *RadGroupReplyCreateBulk) Save(ctx context.Context) ([]*RadGroupReply, error) {
+	if rgrcb.err != nil {
+		return nil, rgrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rgrcb.builders))
+	nodes := make([]*RadGroupReply, len(rgrcb.builders))
+	mutators := make([]Mutator, len(rgrcb.builders))
+	for i := range rgrcb.builders {
+		func(i int, root context.Context) {
+			builder := rgrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RadGroupReplyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rgrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rgrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rgrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rgrcb *RadGroupReplyCreateBulk) SaveX(ctx context.Context) []*RadGroupReply {
+	v, err := rgrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rgrcb *RadGroupReplyCreateBulk) Exec(ctx context.Context) error {
+	_, err := rgrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgrcb *RadGroupReplyCreateBulk) ExecX(ctx context.Context) {
+	if err := rgrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+)
+
+// RadGroupReplyDelete is the builder for deleting a RadGroupReply entity.
+type RadGroupReplyDelete struct {
+	config
+	hooks    []Hook
+	mutation *RadGroupReplyMutation
+}
+
+// Where appends a list predicates to the RadGroupReplyDelete builder.
+func (rgrd *RadGroupReplyDelete) Where(ps ...predicate.RadGroupReply) *RadGroupReplyDelete {
+	rgrd.mutation.Where(ps...)
+	return rgrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rgrd *RadGroupReplyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rgrd.sqlExec, rgrd.mutation, rgrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgrd *RadGroupReplyDelete) ExecX(ctx context.Context) int {
+	n, err := rgrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rgrd *RadGroupReplyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(radgroupreply.Table, sqlgraph.NewFieldSpec(radgroupreply.FieldID, field.TypeInt))
+	if ps := rgrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rgrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rgrd.mutation.done = true
+	return affected, err
+}
+
+// RadGroupReplyDeleteOne is the builder for deleting a single RadGroupReply entity.
+type RadGroupReplyDeleteOne struct {
+	rgrd *RadGroupReplyDelete
+}
+
+// Where appends a list predicates to the RadGroupReplyDelete builder.
+func (rgrdo *RadGroupReplyDeleteOne) Where(ps ...predicate.RadGroupReply) *RadGroupReplyDeleteOne {
+	rgrdo.rgrd.mutation.Where(ps...)
+	return rgrdo
+}
+
+// Exec executes the deletion query.
+func (rgrdo *RadGroupReplyDeleteOne) Exec(ctx context.Context) error {
+	n, err := rgrdo.rgrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{radgroupreply.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgrdo *RadGroupReplyDeleteOne) ExecX(ctx context.Context) {
+	if err := rgrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+)
+
+// RadGroupReplyQuery is the builder for querying RadGroupReply entities.
+type RadGroupReplyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []radgroupreply.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RadGroupReply
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RadGroupReplyQuery builder.
+func (rgrq *RadGroupReplyQuery) Where(ps ...predicate.RadGroupReply) *RadGroupReplyQuery {
+	rgrq.predicates = append(rgrq.predicates, ps...)
+	return rgrq
+}
+
+// Limit the number of records to be returned by this query.
+func (rgrq *RadGroupReplyQuery) Limit(limit int) *RadGroupReplyQuery {
+	rgrq.ctx.Limit = &limit
+	return rgrq
+}
+
+// Offset to start from.
+func (rgrq *RadGroupReplyQuery) Offset(offset int) *RadGroupReplyQuery {
+	rgrq.ctx.Offset = &offset
+	return rgrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rgrq *RadGroupReplyQuery) Unique(unique bool) *RadGroupReplyQuery {
+	rgrq.ctx.Unique = &unique
+	return rgrq
+}
+
+// Order specifies how the records should be ordered.
+func (rgrq *RadGroupReplyQuery) Order(o ...radgroupreply.OrderOption) *RadGroupReplyQuery {
+	rgrq.order = append(rgrq.order, o...)
+	return rgrq
+}
+
+// First returns the first RadGroupReply entity from the query.
+// Returns a *NotFoundError when no RadGroupReply was found.
+func (rgrq *RadGroupReplyQuery) First(ctx context.Context) (*RadGroupReply, error) {
+	nodes, err := rgrq.Limit(1).All(setContextOp(ctx, rgrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{radgroupreply.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rgrq *RadGroupReplyQuery) FirstX(ctx context.Context) *RadGroupReply {
+	node, err := rgrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RadGroupReply ID from the query.
+// Returns a *NotFoundError when no RadGroupReply ID was found.
+func (rgrq *RadGroupReplyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rgrq.Limit(1).IDs(setContextOp(ctx, rgrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{radgroupreply.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rgrq *RadGroupReplyQuery) FirstIDX(ctx context.Context) int {
+	id, err := rgrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RadGroupReply entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RadGroupReply entity is found.
+// Returns a *NotFoundError when no RadGroupReply entities are found.
+func (rgrq *RadGroupReplyQuery) Only(ctx context.Context) (*RadGroupReply, error) {
+	nodes, err := rgrq.Limit(2).All(setContextOp(ctx, rgrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{radgroupreply.Label}
+	default:
+		return nil, &NotSingularError{radgroupreply.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rgrq *RadGroupReplyQuery) OnlyX(ctx context.Context) *RadGroupReply {
+	node, err := rgrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RadGroupReply ID in the query.
+// Returns a *NotSingularError when more than one RadGroupReply ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rgrq *RadGroupReplyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rgrq.Limit(2).IDs(setContextOp(ctx, rgrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{radgroupreply.Label}
+	default:
+		err = &NotSingularError{radgroupreply.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rgrq *RadGroupReplyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rgrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RadGroupReplies.
+func (rgrq *RadGroupReplyQuery) All(ctx context.Context) ([]*RadGroupReply, error) {
+	ctx = setContextOp(ctx, rgrq.ctx, ent.OpQueryAll)
+	if err := rgrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RadGroupReply, *RadGroupReplyQuery]()
+	return withInterceptors[[]*RadGroupReply](ctx, rgrq, qr, rgrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rgrq *RadGroupReplyQuery) AllX(ctx context.Context) []*RadGroupReply {
+	nodes, err := rgrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RadGroupReply IDs.
+func (rgrq *RadGroupReplyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rgrq.ctx.Unique == nil && rgrq.path != nil {
+		rgrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rgrq.ctx, ent.OpQueryIDs)
+	if err = rgrq.Select(radgroupreply.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rgrq *RadGroupReplyQuery) IDsX(ctx context.Context) []int {
+	ids, err := rgrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rgrq *RadGroupReplyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rgrq.ctx, ent.OpQueryCount)
+	if err := rgrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rgrq, querierCount[*RadGroupReplyQuery](), rgrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rgrq *RadGroupReplyQuery) CountX(ctx context.Context) int {
+	count, err := rgrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rgrq *RadGroupReplyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rgrq.ctx, ent.OpQueryExist)
+	switch _, err := rgrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rgrq *RadGroupReplyQuery) ExistX(ctx context.Context) bool {
+	exist, err := rgrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RadGroupReplyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rgrq *RadGroupReplyQuery) Clone() *RadGroupReplyQuery {
+	if rgrq == nil {
+		return nil
+	}
+	return &RadGroupReplyQuery{
+		config:     rgrq.config,
+		ctx:        rgrq.ctx.Clone(),
+		order:      append([]radgroupreply.OrderOption{}, rgrq.order...),
+		inters:     append([]Interceptor{}, rgrq.inters...),
+		predicates: append([]predicate.RadGroupReply{}, rgrq.predicates...),
+		// clone intermediate query.
+		sql:  rgrq.sql.Clone(),
+		path: rgrq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Groupname string `json:"groupname,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RadGroupReply.Query().
+//		GroupBy(radgroupreply.FieldGroupname).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rgrq *RadGroupReplyQuery) GroupBy(field string, fields ...string) *RadGroupReplyGroupBy {
+	rgrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RadGroupReplyGroupBy{build: rgrq}
+	grbuild.flds = &rgrq.ctx.Fields
+	grbuild.label = radgroupreply.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Groupname string `json:"groupname,omitempty"`
+//	}
+//
+//	client.RadGroupReply.Query().
+//		Select(radgroupreply.FieldGroupname).
+//		Scan(ctx, &v)
+func (rgrq *RadGroupReplyQuery) Select(fields ...string) *RadGroupReplySelect {
+	rgrq.ctx.Fields = append(rgrq.ctx.Fields, fields...)
+	sbuild := &RadGroupReplySelect{RadGroupReplyQuery: rgrq}
+	sbuild.label = radgroupreply.Label
+	sbuild.flds, sbuild.scan = &rgrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RadGroupReplySelect configured with the given aggregations.
+func (rgrq *RadGroupReplyQuery) Aggregate(fns ...AggregateFunc) *RadGroupReplySelect {
+	return rgrq.Select().Aggregate(fns...)
+}
+
+func (rgrq *RadGroupReplyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rgrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rgrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rgrq.ctx.Fields {
+		if !radgroupreply.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rgrq.path != nil {
+		prev, err := rgrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rgrq.sql = prev
+	}
+	return nil
+}
+
+func (rgrq *RadGroupReplyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RadGroupReply, error) {
+	var (
+		nodes = []*RadGroupReply{}
+		_spec = rgrq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RadGroupReply).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RadGroupReply{config: rgrq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rgrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rgrq *RadGroupReplyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rgrq.querySpec()
+	_spec.Node.Columns = rgrq.ctx.Fields
+	if len(rgrq.ctx.Fields) > 0 {
+		_spec.Unique = rgrq.ctx.Unique != nil && *rgrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rgrq.driver, _spec)
+}
+
+func (rgrq *RadGroupReplyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(radgroupreply.Table, radgroupreply.Columns, sqlgraph.NewFieldSpec(radgroupreply.FieldID, field.TypeInt))
+	_spec.From = rgrq.sql
+	if unique := rgrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rgrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rgrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, radgroupreply.FieldID)
+		for i := range fields {
+			if fields[i] != radgroupreply.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rgrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rgrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rgrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rgrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rgrq *RadGroupReplyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rgrq.driver.Dialect())
+	t1 := builder.Table(radgroupreply.Table)
+	columns := rgrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = radgroupreply.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rgrq.sql != nil {
+		selector = rgrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rgrq.ctx.Unique != nil && *rgrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rgrq.predicates {
+		p(selector)
+	}
+	for _, p := range rgrq.order {
+		p(selector)
+	}
+	if offset := rgrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rgrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RadGroupReplyGroupBy is the group-by builder for RadGroupReply entities.
+type RadGroupReplyGroupBy struct {
+	selector
+	build *RadGroupReplyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rgrgb *RadGroupReplyGroupBy) Aggregate(fns ...AggregateFunc) *RadGroupReplyGroupBy {
+	rgrgb.fns = append(rgrgb.fns, fns...)
+	return rgrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rgrgb *RadGroupReplyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rgrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rgrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RadGroupReplyQuery, *RadGroupReplyGroupBy](ctx, rgrgb.build, rgrgb, rgrgb.build.inters, v)
+}
+
+func (rgrgb *RadGroupReplyGroupBy) sqlScan(ctx context.Context, root *RadGroupReplyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rgrgb.fns))
+	for _, fn := range rgrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rgrgb.flds)+len(rgrgb.fns))
+		for _, f := range *rgrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rgrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rgrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RadGroupReplySelect is the builder for selecting fields of RadGroupReply entities.
+type RadGroupReplySelect struct {
+	*RadGroupReplyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rgrs *RadGroupReplySelect) Aggregate(fns ...AggregateFunc) *RadGroupReplySelect {
+	rgrs.fns = append(rgrs.fns, fns...)
+	return rgrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rgrs *RadGroupReplySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rgrs.ctx, ent.OpQuerySelect)
+	if err := rgrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RadGroupReplyQuery, *RadGroupReplySelect](ctx, rgrs.RadGroupReplyQuery, rgrs, rgrs.inters, v)
+}
+
+func (rgrs *RadGroupReplySelect) sqlScan(ctx context.Context, root *RadGroupReplyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rgrs.fns))
+	for _, fn := range rgrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rgrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rgrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+)
+
+// RadGroupReplyUpdate is the builder for updating RadGroupReply entities.
+type RadGroupReplyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RadGroupReplyMutation
+}
+
+// Where appends a list predicates to the RadGroupReplyUpdate builder.
+func (rgru *RadGroupReplyUpdate) Where(ps ...predicate.RadGroupReply) *RadGroupReplyUpdate {
+	rgru.mutation.Where(ps...)
+	return rgru
+}
+
+// SetGroupname sets the "groupname" field.
+func (rgru *RadGroupReplyUpdate) SetGroupname(s string) *RadGroupReplyUpdate {
+	rgru.mutation.SetGroupname(s)
+	return rgru
+}
+
+// SetNillableGroupname sets the "groupname" field if the given value is not nil.
+func (rgru *RadGroupReplyUpdate) SetNillableGroupname(s *string) *RadGroupReplyUpdate {
+	if s != nil {
+		rgru.SetGroupname(*s)
+	}
+	return rgru
+}
+
+// SetAttribute sets the "attribute" field.
+func (rgru *RadGroupReplyUpdate) SetAttribute(s string) *RadGroupReplyUpdate {
+	rgru.mutation.SetAttribute(s)
+	return rgru
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rgru *RadGroupReplyUpdate) SetNillableAttribute(s *string) *RadGroupReplyUpdate {
+	if s != nil {
+		rgru.SetAttribute(*s)
+	}
+	return rgru
+}
+
+// SetOp sets the "op" field.
+func (rgru *RadGroupReplyUpdate) SetOp(s string) *RadGroupReplyUpdate {
+	rgru.mutation.SetOpField(s)
+	return rgru
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rgru *RadGroupReplyUpdate) SetNillableOp(s *string) *RadGroupReplyUpdate {
+	if s != nil {
+		rgru.SetOp(*s)
+	}
+	return rgru
+}
+
+// SetValue sets the "value" field.
+func (rgru *RadGroupReplyUpdate) SetValue(s string) *RadGroupReplyUpdate {
+	rgru.mutation.SetValue(s)
+	return rgru
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rgru *RadGroupReplyUpdate) SetNillableValue(s *string) *RadGroupReplyUpdate {
+	if s != nil {
+		rgru.SetValue(*s)
+	}
+	return rgru
+}
+
+// Mutation returns the RadGroupReplyMutation object of the builder.
+func (rgru *RadGroupReplyUpdate) Mutation() *RadGroupReplyMutation {
+	return rgru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rgru *RadGroupReplyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rgru.sqlSave, rgru.mutation, rgru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rgru *RadGroupReplyUpdate) SaveX(ctx context.Context) int {
+	affected, err := rgru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rgru *RadGroupReplyUpdate) Exec(ctx context.Context) error {
+	_, err := rgru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgru *RadGroupReplyUpdate) ExecX(ctx context.Context) {
+	if err := rgru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rgru *RadGroupReplyUpdate) check() error {
+	if v, ok := rgru.mutation.Groupname(); ok {
+		if err := radgroupreply.GroupnameValidator(v); err != nil {
+			return &ValidationError{Name: "groupname", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.groupname": %w`, err)}
+		}
+	}
+	if v, ok := rgru.mutation.Attribute(); ok {
+		if err := radgroupreply.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.attribute": %w`, err)}
+		}
+	}
+	if v, ok := rgru.mutation.GetOp(); ok {
+		if err := radgroupreply.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.op": %w`, err)}
+		}
+	}
+	if v, ok := rgru.mutation.Value(); ok {
+		if err := radgroupreply.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rgru *RadGroupReplyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rgru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(radgroupreply.Table, radgroupreply.Columns, sqlgraph.NewFieldSpec(radgroupreply.FieldID, field.TypeInt))
+	if ps := rgru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rgru.mutation.Groupname(); ok {
+		_spec.SetField(radgroupreply.FieldGroupname, field.TypeString, value)
+	}
+	if value, ok := rgru.mutation.Attribute(); ok {
+		_spec.SetField(radgroupreply.FieldAttribute, field.TypeString, value)
+	}
+	if value, ok := rgru.mutation.GetOp(); ok {
+		_spec.SetField(radgroupreply.FieldOp, field.TypeString, value)
+	}
+	if value, ok := rgru.mutation.Value(); ok {
+		_spec.SetField(radgroupreply.FieldValue, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rgru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{radgroupreply.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rgru.mutation.done = true
+	return n, nil
+}
+
+// RadGroupReplyUpdateOne is the builder for updating a single RadGroupReply entity.
+type RadGroupReplyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RadGroupReplyMutation
+}
+
+// SetGroupname sets the "groupname" field.
+func (rgruo *RadGroupReplyUpdateOne) SetGroupname(s string) *RadGroupReplyUpdateOne {
+	rgruo.mutation.SetGroupname(s)
+	return rgruo
+}
+
+// SetNillableGroupname sets the "groupname" field if the given value is not nil.
+func (rgruo *RadGroupReplyUpdateOne) SetNillableGroupname(s *string) *RadGroupReplyUpdateOne {
+	if s != nil {
+		rgruo.SetGroupname(*s)
+	}
+	return rgruo
+}
+
+// SetAttribute sets the "attribute" field.
+func (rgruo *RadGroupReplyUpdateOne) SetAttribute(s string) *RadGroupReplyUpdateOne {
+	rgruo.mutation.SetAttribute(s)
+	return rgruo
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rgruo *RadGroupReplyUpdateOne) SetNillableAttribute(s *string) *RadGroupReplyUpdateOne {
+	if s != nil {
+		rgruo.SetAttribute(*s)
+	}
+	return rgruo
+}
+
+// SetOp sets the "op" field.
+func (rgruo *RadGroupReplyUpdateOne) SetOp(s string) *RadGroupReplyUpdateOne {
+	rgruo.mutation.SetOpField(s)
+	return rgruo
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rgruo *RadGroupReplyUpdateOne) SetNillableOp(s *string) *RadGroupReplyUpdateOne {
+	if s != nil {
+		rgruo.SetOp(*s)
+	}
+	return rgruo
+}
+
+// SetValue sets the "value" field.
+func (rgruo *RadGroupReplyUpdateOne) SetValue(s string) *RadGroupReplyUpdateOne {
+	rgruo.mutation.SetValue(s)
+	return rgruo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rgruo *RadGroupReplyUpdateOne) SetNillableValue(s *string) *RadGroupReplyUpdateOne {
+	if s != nil {
+		rgruo.SetValue(*s)
+	}
+	return rgruo
+}
+
+// Mutation returns the RadGroupReplyMutation object of the builder.
+func (rgruo *RadGroupReplyUpdateOne) Mutation() *RadGroupReplyMutation {
+	return rgruo.mutation
+}
+
+// Where appends a list predicates to the RadGroupReplyUpdate builder.
+func (rgruo *RadGroupReplyUpdateOne) Where(ps ...predicate.RadGroupReply) *RadGroupReplyUpdateOne {
+	rgruo.mutation.Where(ps...)
+	return rgruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rgruo *RadGroupReplyUpdateOne) Select(field string, fields ...string) *RadGroupReplyUpdateOne {
+	rgruo.fields = append([]string{field}, fields...)
+	return rgruo
+}
+
+// Save executes the query and returns the updated RadGroupReply entity.
+func (rgruo *RadGroupReplyUpdateOne) Save(ctx context.Context) (*RadGroupReply, error) {
+	return withHooks(ctx, rgruo.sqlSave, rgruo.mutation, rgruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rgruo *RadGroupReplyUpdateOne) SaveX(ctx context.Context) *RadGroupReply {
+	node, err := rgruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rgruo *RadGroupReplyUpdateOne) Exec(ctx context.Context) error {
+	_, err := rgruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgruo *RadGroupReplyUpdateOne) ExecX(ctx context.Context) {
+	if err := rgruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rgruo *RadGroupReplyUpdateOne) check() error {
+	if v, ok := rgruo.mutation.Groupname(); ok {
+		if err := radgroupreply.GroupnameValidator(v); err != nil {
+			return &ValidationError{Name: "groupname", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.groupname": %w`, err)}
+		}
+	}
+	if v, ok := rgruo.mutation.Attribute(); ok {
+		if err := radgroupreply.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.attribute": %w`, err)}
+		}
+	}
+	if v, ok := rgruo.mutation.GetOp(); ok {
+		if err := radgroupreply.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.op": %w`, err)}
+		}
+	}
+	if v, ok := rgruo.mutation.Value(); ok {
+		if err := radgroupreply.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadGroupReply.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rgruo *RadGroupReplyUpdateOne) sqlSave(ctx context.Context) (_node *RadGroupReply, err error) {
+	if err := rgruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(radgroupreply.Table, radgroupreply.Columns, sqlgraph.NewFieldSpec(radgroupreply.FieldID, field.TypeInt))
+	id, ok := rgruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RadGroupReply.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rgruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, radgroupreply.FieldID)
+		for _, f := range fields {
+			if !radgroupreply.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != radgroupreply.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rgruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rgruo.mutation.Groupname(); ok {
+		_spec.SetField(radgroupreply.FieldGroupname, field.TypeString, value)
+	}
+	if value, ok := rgruo.mutation.Attribute(); ok {
+		_spec.SetField(radgroupreply.FieldAttribute, field.TypeString, value)
+	}
+	if value, ok := rgruo.mutation.GetOp(); ok {
+		_spec.SetField(radgroupreply.FieldOp, field.TypeString, value)
+	}
+	if value, ok := rgruo.mutation.Value(); ok {
+		_spec.SetField(radgroupreply.FieldValue, field.TypeString, value)
+	}
+	_node = &RadGroupReply{config: rgruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rgruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{radgroupreply.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rgruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/radreply"
+)
+
+// RadReply is the model entity for the RadReply schema.
+type RadReply struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Attribute holds the value of the "attribute" field.
+	Attribute string `json:"attribute,omitempty"`
+	// Op holds the value of the "op" field.
+	Op string `json:"op,omitempty"`
+	// Value holds the value of the "value" field.
+	Value        string `json:"value,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RadReply) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case radreply.FieldID:
+			values[i] = new(sql.NullInt64)
+		case radreply.FieldUsername, radreply.FieldAttribute, radreply.FieldOp, radreply.FieldValue:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RadReply fields.
+func (rr *RadReply) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case radreply.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rr.ID = int(value.Int64)
+		case radreply.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				rr.Username = value.String
+			}
+		case radreply.FieldAttribute:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute", values[i])
+			} else if value.Valid {
+				rr.Attribute = value.String
+			}
+		case radreply.FieldOp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field op", values[i])
+			} else if value.Valid {
+				rr.Op = value.String
+			}
+		case radreply.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				rr.Value = value.String
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the RadReply.
+// This includes values selected through modifiers, order, etc.
+func (rr *RadReply) GetValue(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RadReply.
+// Note that you need to call RadReply.Unwrap() before calling this method if this RadReply
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *RadReply) Update() *RadReplyUpdateOne {
+	return NewRadReplyClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the RadReply entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *RadReply) Unwrap() *RadReply {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RadReply is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *RadReply) String() string {
+	var builder strings.Builder
+	builder.WriteString("RadReply(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("username=")
+	builder.WriteString(rr.Username)
+	builder.WriteString(", ")
+	builder.WriteString("attribute=")
+	builder.WriteString(rr.Attribute)
+	builder.WriteString(", ")
+	builder.WriteString("op=")
+	builder.WriteString(rr.Op)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(rr.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RadReplies is a parsable slice of RadReply.
+type RadReplies []*RadReply
@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package radreply
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLTE(FieldID, id))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldUsername, v))
+}
+
+// Attribute applies equality check predicate on the "attribute" field. It's identical to AttributeEQ.
+func Attribute(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldAttribute, v))
+}
+
+// Op applies equality check predicate on the "op" field. It's identical to OpEQ.
+func Op(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldOp, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldValue, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// AttributeEQ applies the EQ predicate on the "attribute" field.
+func AttributeEQ(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldAttribute, v))
+}
+
+// AttributeNEQ applies the NEQ predicate on the "attribute" field.
+func AttributeNEQ(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNEQ(FieldAttribute, v))
+}
+
+// AttributeIn applies the In predicate on the "attribute" field.
+func AttributeIn(vs ...string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldIn(FieldAttribute, vs...))
+}
+
+// AttributeNotIn applies the NotIn predicate on the "attribute" field.
+func AttributeNotIn(vs ...string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNotIn(FieldAttribute, vs...))
+}
+
+// AttributeGT applies the GT predicate on the "attribute" field.
+func AttributeGT(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGT(FieldAttribute, v))
+}
+
+// AttributeGTE applies the GTE predicate on the "attribute" field.
+func AttributeGTE(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGTE(FieldAttribute, v))
+}
+
+// AttributeLT applies the LT predicate on the "attribute" field.
+func AttributeLT(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLT(FieldAttribute, v))
+}
+
+// AttributeLTE applies the LTE predicate on the "attribute" field.
+func AttributeLTE(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLTE(FieldAttribute, v))
+}
+
+// AttributeContains applies the Contains predicate on the "attribute" field.
+func AttributeContains(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldContains(FieldAttribute, v))
+}
+
+// AttributeHasPrefix applies the HasPrefix predicate on the "attribute" field.
+func AttributeHasPrefix(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldHasPrefix(FieldAttribute, v))
+}
+
+// AttributeHasSuffix applies the HasSuffix predicate on the "attribute" field.
+func AttributeHasSuffix(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldHasSuffix(FieldAttribute, v))
+}
+
+// AttributeEqualFold applies the EqualFold predicate on the "attribute" field.
+func AttributeEqualFold(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEqualFold(FieldAttribute, v))
+}
+
+// AttributeContainsFold applies the ContainsFold predicate on the "attribute" field.
+func AttributeContainsFold(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldContainsFold(FieldAttribute, v))
+}
+
+// OpEQ applies the EQ predicate on the "op" field.
+func OpEQ(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldOp, v))
+}
+
+// OpNEQ applies the NEQ predicate on the "op" field.
+func OpNEQ(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNEQ(FieldOp, v))
+}
+
+// OpIn applies the In predicate on the "op" field.
+func OpIn(vs ...string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldIn(FieldOp, vs...))
+}
+
+// OpNotIn applies the NotIn predicate on the "op" field.
+func OpNotIn(vs ...string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNotIn(FieldOp, vs...))
+}
+
+// OpGT applies the GT predicate on the "op" field.
+func OpGT(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGT(FieldOp, v))
+}
+
+// OpGTE applies the GTE predicate on the "op" field.
+func OpGTE(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGTE(FieldOp, v))
+}
+
+// OpLT applies the LT predicate on the "op" field.
+func OpLT(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLT(FieldOp, v))
+}
+
+// OpLTE applies the LTE predicate on the "op" field.
+func OpLTE(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLTE(FieldOp, v))
+}
+
+// OpContains applies the Contains predicate on the "op" field.
+func OpContains(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldContains(FieldOp, v))
+}
+
+// OpHasPrefix applies the HasPrefix predicate on the "op" field.
+func OpHasPrefix(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldHasPrefix(FieldOp, v))
+}
+
+// OpHasSuffix applies the HasSuffix predicate on the "op" field.
+func OpHasSuffix(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldHasSuffix(FieldOp, v))
+}
+
+// OpEqualFold applies the EqualFold predicate on the "op" field.
+func OpEqualFold(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEqualFold(FieldOp, v))
+}
+
+// OpContainsFold applies the ContainsFold predicate on the "op" field.
+func OpContainsFold(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldContainsFold(FieldOp, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.RadReply {
+	return predicate.RadReply(sql.FieldContainsFold(FieldValue, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RadReply) predicate.RadReply {
+	return predicate.RadReply(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RadReply) predicate.RadReply {
+	return predicate.RadReply(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RadReply) predicate.RadReply {
+	return predicate.RadReply(sql.NotPredicates(p))
+}
@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/radreply"
+)
+
+// RadReplyCreate is the builder for creating a RadReply entity.
+type RadReplyCreate struct {
+	config
+	mutation *RadReplyMutation
+	hooks    []Hook
+}
+
+// SetUsername sets the "username" field.
+func (rrc *RadReplyCreate) SetUsername(s string) *RadReplyCreate {
+	rrc.mutation.SetUsername(s)
+	return rrc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rrc *RadReplyCreate) SetNillableUsername(s *string) *RadReplyCreate {
+	if s != nil {
+		rrc.SetUsername(*s)
+	}
+	return rrc
+}
+
+// SetAttribute sets the "attribute" field.
+func (rrc *RadReplyCreate) SetAttribute(s string) *RadReplyCreate {
+	rrc.mutation.SetAttribute(s)
+	return rrc
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rrc *RadReplyCreate) SetNillableAttribute(s *string) *RadReplyCreate {
+	if s != nil {
+		rrc.SetAttribute(*s)
+	}
+	return rrc
+}
+
+// SetOp sets the "op" field.
+func (rrc *RadReplyCreate) SetOp(s string) *RadReplyCreate {
+	rrc.mutation.SetOpField(s)
+	return rrc
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rrc *RadReplyCreate) SetNillableOp(s *string) *RadReplyCreate {
+	if s != nil {
+		rrc.SetOp(*s)
+	}
+	return rrc
+}
+
+// SetValue sets the "value" field.
+func (rrc *RadReplyCreate) SetValue(s string) *RadReplyCreate {
+	rrc.mutation.SetValue(s)
+	return rrc
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rrc *RadReplyCreate) SetNillableValue(s *string) *RadReplyCreate {
+	if s != nil {
+		rrc.SetValue(*s)
+	}
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *RadReplyCreate) SetID(i int) *RadReplyCreate {
+	rrc.mutation.SetID(i)
+	return rrc
+}
+
+// Mutation returns the RadReplyMutation object of the builder.
+func (rrc *RadReplyCreate) Mutation() *RadReplyMutation {
+	return rrc.mutation
+}
+
+// Save creates the RadReply in the database.
+func (rrc *RadReplyCreate) Save(ctx context.Context) (*RadReply, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *RadReplyCreate) SaveX(ctx context.Context) *RadReply {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *RadReplyCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *RadReplyCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *RadReplyCreate) defaults() {
+	if _, ok := rrc.mutation.Username(); !ok {
+		v := radreply.DefaultUsername
+		rrc.mutation.SetUsername(v)
+	}
+	if _, ok := rrc.mutation.Attribute(); !ok {
+		v := radreply.DefaultAttribute
+		rrc.mutation.SetAttribute(v)
+	}
+	if _, ok := rrc.mutation.GetOp(); !ok {
+		v := radreply.DefaultOp
+		rrc.mutation.SetOpField(v)
+	}
+	if _, ok := rrc.mutation.Value(); !ok {
+		v := radreply.DefaultValue
+		rrc.mutation.SetValue(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *RadReplyCreate) check() error {
+	if _, ok := rrc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "RadReply.username"`)}
+	}
+	if v, ok := rrc.mutation.Username(); ok {
+		if err := radreply.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadReply.username": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Attribute(); !ok {
+		return &ValidationError{Name: "attribute", err: errors.New(`ent: missing required field "RadReply.attribute"`)}
+	}
+	if v, ok := rrc.mutation.Attribute(); ok {
+		if err := radreply.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadReply.attribute": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.GetOp(); !ok {
+		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "RadReply.op"`)}
+	}
+	if v, ok := rrc.mutation.GetOp(); ok {
+		if err := radreply.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadReply.op": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "RadReply.value"`)}
+	}
+	if v, ok := rrc.mutation.Value(); ok {
+		if err := radreply.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadReply.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rrc *RadReplyCreate) sqlSave(ctx context.Context) (*RadReply, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *RadReplyCreate) createSpec() (*RadReply, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RadReply{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(radreply.Table, sqlgraph.NewFieldSpec(radreply.FieldID, field.TypeInt))
+	)
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rrc.mutation.Username(); ok {
+		_spec.SetField(radreply.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := rrc.mutation.Attribute(); ok {
+		_spec.SetField(radreply.FieldAttribute, field.TypeString, value)
+		_node.Attribute = value
+	}
+	if value, ok := rrc.mutation.GetOp(); ok {
+		_spec.SetField(radreply.FieldOp, field.TypeString, value)
+		_node.Op = value
+	}
+	if value, ok := rrc.mutation.Value(); ok {
+		_spec.SetField(radreply.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	return _node, _spec
+}
+
+// RadReplyCreateBulk is the builder for creating many RadReply entities in bulk.
+type RadReplyCreateBulk struct {
+	config
+	err      error
+	builders []*RadReplyCreate
+}
+
+// Save creates the RadReply entities in the database.
+func (rrcb *RadReplyCreateBulk) Save(ctx context.Context) ([]*RadReply, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*RadReply, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RadReplyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *RadReplyCreateBulk) SaveX(ctx context.Context) []*RadReply {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *RadReplyCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *RadReplyCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,367 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/radreply"
+)
+
+// RadReplyUpdate is the builder for updating RadReply entities.
+type RadReplyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RadReplyMutation
+}
+
+// Where appends a list predicates to the RadReplyUpdate builder.
+func (rru *RadReplyUpdate) Where(ps ...predicate.RadReply) *RadReplyUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetUsername sets the "username" field.
+func (rru *RadReplyUpdate) SetUsername(s string) *RadReplyUpdate {
+	rru.mutation.SetUsername(s)
+	return rru
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rru *RadReplyUpdate) SetNillableUsername(s *string) *RadReplyUpdate {
+	if s != nil {
+		rru.SetUsername(*s)
+	}
+	return rru
+}
+
+// SetAttribute sets the "attribute" field.
+func (rru *RadReplyUpdate) SetAttribute(s string) *RadReplyUpdate {
+	rru.mutation.SetAttribute(s)
+	return rru
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rru *RadReplyUpdate) SetNillableAttribute(s *string) *RadReplyUpdate {
+	if s != nil {
+		rru.SetAttribute(*s)
+	}
+	return rru
+}
+
+// SetOp sets the "op" field.
+func (rru *RadReplyUpdate) SetOp(s string) *RadReplyUpdate {
+	rru.mutation.SetOpField(s)
+	return rru
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rru *RadReplyUpdate) SetNillableOp(s *string) *RadReplyUpdate {
+	if s != nil {
+		rru.SetOp(*s)
+	}
+	return rru
+}
+
+// SetValue sets the "value" field.
+func (rru *RadReplyUpdate) SetValue(s string) *RadReplyUpdate {
+	rru.mutation.SetValue(s)
+	return rru
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rru *RadReplyUpdate) SetNillableValue(s *string) *RadReplyUpdate {
+	if s != nil {
+		rru.SetValue(*s)
+	}
+	return rru
+}
+
+// Mutation returns the RadReplyMutation object of the builder.
+func (rru *RadReplyUpdate) Mutation() *RadReplyMutation {
+	return rru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *RadReplyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *RadReplyUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *RadReplyUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *RadReplyUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *RadReplyUpdate) check() error {
+	if v, ok := rru.mutation.Username(); ok {
+		if err := radreply.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadReply.username": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.Attribute(); ok {
+		if err := radreply.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadReply.attribute": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.GetOp(); ok {
+		if err := radreply.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadReply.op": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.Value(); ok {
+		if err := radreply.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadReply.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rru *RadReplyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(radreply.Table, radreply.Columns, sqlgraph.NewFieldSpec(radreply.FieldID, field.TypeInt))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.Username(); ok {
+		_spec.SetField(radreply.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.Attribute(); ok {
+		_spec.SetField(radreply.FieldAttribute, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.GetOp(); ok {
+		_spec.SetField(radreply.FieldOp, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.Value(); ok {
+		_spec.SetField(radreply.FieldValue, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{radreply.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// RadReplyUpdateOne is the builder for updating a single RadReply entity.
+type RadReplyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RadReplyMutation
+}
+
+// SetUsername sets the "username" field.
+func (rruo *RadReplyUpdateOne) SetUsername(s string) *RadReplyUpdateOne {
+	rruo.mutation.SetUsername(s)
+	return rruo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rruo *RadReplyUpdateOne) SetNillableUsername(s *string) *RadReplyUpdateOne {
+	if s != nil {
+		rruo.SetUsername(*s)
+	}
+	return rruo
+}
+
+// SetAttribute sets the "attribute" field.
+func (rruo *RadReplyUpdateOne) SetAttribute(s string) *RadReplyUpdateOne {
+	rruo.mutation.SetAttribute(s)
+	return rruo
+}
+
+// SetNillableAttribute sets the "attribute" field if the given value is not nil.
+func (rruo *RadReplyUpdateOne) SetNillableAttribute(s *string) *RadReplyUpdateOne {
+	if s != nil {
+		rruo.SetAttribute(*s)
+	}
+	return rruo
+}
+
+// SetOp sets the "op" field.
+func (rruo *RadReplyUpdateOne) SetOp(s string) *RadReplyUpdateOne {
+	rruo.mutation.SetOpField(s)
+	return rruo
+}
+
+// SetNillableOp sets the "op" field if the given value is not nil.
+func (rruo *RadReplyUpdateOne) SetNillableOp(s *string) *RadReplyUpdateOne {
+	if s != nil {
+		rruo.SetOp(*s)
+	}
+	return rruo
+}
+
+// SetValue sets the "value" field.
+func (rruo *RadReplyUpdateOne) SetValue(s string) *RadReplyUpdateOne {
+	rruo.mutation.SetValue(s)
+	return rruo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (rruo *RadReplyUpdateOne) SetNillableValue(s *string) *RadReplyUpdateOne {
+	if s != nil {
+		rruo.SetValue(*s)
+	}
+	return rruo
+}
+
+// Mutation returns the RadReplyMutation object of the builder.
+func (rruo *RadReplyUpdateOne) Mutation() *RadReplyMutation {
+	return rruo.mutation
+}
+
+// Where appends a list predicates to the RadReplyUpdate builder.
+func (rruo *RadReplyUpdateOne) Where(ps ...predicate.RadReply) *RadReplyUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *RadReplyUpdateOne) Select(field string, fields ...string) *RadReplyUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated RadReply entity.
+func (rruo *RadReplyUpdateOne) Save(ctx context.Context) (*RadReply, error) {
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *RadReplyUpdateOne) SaveX(ctx context.Context) *RadReply {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *RadReplyUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *RadReplyUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *RadReplyUpdateOne) check() error {
+	if v, ok := rruo.mutation.Username(); ok {
+		if err := radreply.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadReply.username": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.Attribute(); ok {
+		if err := radreply.AttributeValidator(v); err != nil {
+			return &ValidationError{Name: "attribute", err: fmt.Errorf(`ent: validator failed for field "RadReply.attribute": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.GetOp(); ok {
+		if err := radreply.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "RadReply.op": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.Value(); ok {
+		if err := radreply.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "RadReply.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rruo *RadReplyUpdateOne) sqlSave(ctx context.Context) (_node *RadReply, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(radreply.Table, radreply.Columns, sqlgraph.NewFieldSpec(radreply.FieldID, field.TypeInt))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RadReply.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, radreply.FieldID)
+		for _, f := range fields {
+			if !radreply.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != radreply.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.Username(); ok {
+		_spec.SetField(radreply.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.Attribute(); ok {
+		_spec.SetField(radreply.FieldAttribute, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.GetOp(); ok {
+		_spec.SetField(radreply.FieldOp, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.Value(); ok {
+		_spec.SetField(radreply.FieldValue, field.TypeString, value)
+	}
+	_node = &RadReply{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{radreply.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+)
+
+// RadUserGroup is the model entity for the RadUserGroup schema.
+type RadUserGroup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Groupname holds the value of the "groupname" field.
+	Groupname string `json:"groupname,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority     int `json:"priority,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RadUserGroup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case radusergroup.FieldID, radusergroup.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case radusergroup.FieldUsername, radusergroup.FieldGroupname:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RadUserGroup fields.
+func (rug *RadUserGroup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case radusergroup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rug.ID = int(value.Int64)
+		case radusergroup.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				rug.Username = value.String
+			}
+		case radusergroup.FieldGroupname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field groupname", values[i])
+			} else if value.Valid {
+				rug.Groupname = value.String
+			}
+		case radusergroup.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				rug.Priority = int(value.Int64)
+			}
+		default:
+			rug.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RadUserGroup.
+// This includes values selected through modifiers, order, etc.
+func (rug *RadUserGroup) Value(name string) (ent.Value, error) {
+	return rug.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RadUserGroup.
+// Note that you need to call RadUserGroup.Unwrap() before calling this method if this RadUserGroup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rug *RadUserGroup) Update() *RadUserGroupUpdateOne {
+	return NewRadUserGroupClient(rug.config).UpdateOne(rug)
+}
+
+// Unwrap unwraps the RadUserGroup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rug *RadUserGroup) Unwrap() *RadUserGroup {
+	_tx, ok := rug.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RadUserGroup is not a transactional entity")
+	}
+	rug.config.driver = _tx.drv
+	return rug
+}
+
+// String implements the fmt.Stringer.
+func (rug *RadUserGroup) String() string {
+	var builder strings.Builder
+	builder.WriteString("RadUserGroup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rug.ID))
+	builder.WriteString("username=")
+	builder.WriteString(rug.Username)
+	builder.WriteString(", ")
+	builder.WriteString("groupname=")
+	builder.WriteString(rug.Groupname)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", rug.Priority))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RadUserGroups is a parsable slice of RadUserGroup.
+type RadUserGroups []*RadUserGroup
@@ -0,0 +1,76 @@
+// Code generated by ent, DO NOT EDIT.
+
+package radusergroup
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the radusergroup type in the database.
+	Label = "rad_user_group"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldGroupname holds the string denoting the groupname field in the database.
+	FieldGroupname = "groupname"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// Table holds the table name of the radusergroup in the database.
+	Table = "radusergroup"
+)
+
+// Columns holds all SQL columns for radusergroup fields.
+var Columns = []string{
+	FieldID,
+	FieldUsername,
+	FieldGroupname,
+	FieldPriority,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUsername holds the default value on creation for the "username" field.
+	DefaultUsername string
+	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	UsernameValidator func(string) error
+	// DefaultGroupname holds the default value on creation for the "groupname" field.
+	DefaultGroupname string
+	// GroupnameValidator is a validator for the "groupname" field. It is called by the builders before save.
+	GroupnameValidator func(string) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+)
+
+// OrderOption defines the ordering options for the RadUserGroup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByGroupname orders the results by the groupname field.
+func ByGroupname(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupname, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
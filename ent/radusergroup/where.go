@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package radusergroup
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldLTE(FieldID, id))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEQ(FieldUsername, v))
+}
+
+// Groupname applies equality check predicate on the "groupname" field. It's identical to GroupnameEQ.
+func Groupname(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEQ(FieldGroupname, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEQ(FieldPriority, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// GroupnameEQ applies the EQ predicate on the "groupname" field.
+func GroupnameEQ(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEQ(FieldGroupname, v))
+}
+
+// GroupnameNEQ applies the NEQ predicate on the "groupname" field.
+func GroupnameNEQ(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldNEQ(FieldGroupname, v))
+}
+
+// GroupnameIn applies the In predicate on the "groupname" field.
+func GroupnameIn(vs ...string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldIn(FieldGroupname, vs...))
+}
+
+// GroupnameNotIn applies the NotIn predicate on the "groupname" field.
+func GroupnameNotIn(vs ...string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldNotIn(FieldGroupname, vs...))
+}
+
+// GroupnameGT applies the GT predicate on the "groupname" field.
+func GroupnameGT(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldGT(FieldGroupname, v))
+}
+
+// GroupnameGTE applies the GTE predicate on the "groupname" field.
+func GroupnameGTE(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldGTE(FieldGroupname, v))
+}
+
+// GroupnameLT applies the LT predicate on the "groupname" field.
+func GroupnameLT(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldLT(FieldGroupname, v))
+}
+
+// GroupnameLTE applies the LTE predicate on the "groupname" field.
+func GroupnameLTE(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldLTE(FieldGroupname, v))
+}
+
+// GroupnameContains applies the Contains predicate on the "groupname" field.
+func GroupnameContains(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldContains(FieldGroupname, v))
+}
+
+// GroupnameHasPrefix applies the HasPrefix predicate on the "groupname" field.
+func GroupnameHasPrefix(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldHasPrefix(FieldGroupname, v))
+}
+
+// GroupnameHasSuffix applies the HasSuffix predicate on the "groupname" field.
+func GroupnameHasSuffix(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldHasSuffix(FieldGroupname, v))
+}
+
+// GroupnameEqualFold applies the EqualFold predicate on the "groupname" field.
+func GroupnameEqualFold(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEqualFold(FieldGroupname, v))
+}
+
+// GroupnameContainsFold applies the ContainsFold predicate on the "groupname" field.
+func GroupnameContainsFold(v string) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldContainsFold(FieldGroupname, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.FieldLTE(FieldPriority, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RadUserGroup) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RadUserGroup) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RadUserGroup) predicate.RadUserGroup {
+	return predicate.RadUserGroup(sql.NotPredicates(p))
+}
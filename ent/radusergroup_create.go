@@ -0,0 +1,273 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+)
+
+// RadUserGroupCreate is the builder for creating a RadUserGroup entity.
+type RadUserGroupCreate struct {
+	config
+	mutation *RadUserGroupMutation
+	hooks    []Hook
+}
+
+// SetUsername sets the "username" field.
+func (rugc *RadUserGroupCreate) SetUsername(s string) *RadUserGroupCreate {
+	rugc.mutation.SetUsername(s)
+	return rugc
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rugc *RadUserGroupCreate) SetNillableUsername(s *string) *RadUserGroupCreate {
+	if s != nil {
+		rugc.SetUsername(*s)
+	}
+	return rugc
+}
+
+// SetGroupname sets the "groupname" field.
+func (rugc *RadUserGroupCreate) SetGroupname(s string) *RadUserGroupCreate {
+	rugc.mutation.SetGroupname(s)
+	return rugc
+}
+
+// SetNillableGroupname sets the "groupname" field if the given value is not nil.
+func (rugc *RadUserGroupCreate) SetNillableGroupname(s *string) *RadUserGroupCreate {
+	if s != nil {
+		rugc.SetGroupname(*s)
+	}
+	return rugc
+}
+
+// SetPriority sets the "priority" field.
+func (rugc *RadUserGroupCreate) SetPriority(i int) *RadUserGroupCreate {
+	rugc.mutation.SetPriority(i)
+	return rugc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rugc *RadUserGroupCreate) SetNillablePriority(i *int) *RadUserGroupCreate {
+	if i != nil {
+		rugc.SetPriority(*i)
+	}
+	return rugc
+}
+
+// SetID sets the "id" field.
+func (rugc *RadUserGroupCreate) SetID(i int) *RadUserGroupCreate {
+	rugc.mutation.SetID(i)
+	return rugc
+}
+
+// Mutation returns the RadUserGroupMutation object of the builder.
+func (rugc *RadUserGroupCreate) Mutation() *RadUserGroupMutation {
+	return rugc.mutation
+}
+
+// Save creates the RadUserGroup in the database.
+func (rugc *RadUserGroupCreate) Save(ctx context.Context) (*RadUserGroup, error) {
+	rugc.defaults()
+	return withHooks(ctx, rugc.sqlSave, rugc.mutation, rugc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rugc *RadUserGroupCreate) SaveX(ctx context.Context) *RadUserGroup {
+	v, err := rugc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rugc *RadUserGroupCreate) Exec(ctx context.Context) error {
+	_, err := rugc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rugc *RadUserGroupCreate) ExecX(ctx context.Context) {
+	if err := rugc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rugc *RadUserGroupCreate) defaults() {
+	if _, ok := rugc.mutation.Username(); !ok {
+		v := radusergroup.DefaultUsername
+		rugc.mutation.SetUsername(v)
+	}
+	if _, ok := rugc.mutation.Groupname(); !ok {
+		v := radusergroup.DefaultGroupname
+		rugc.mutation.SetGroupname(v)
+	}
+	if _, ok := rugc.mutation.Priority(); !ok {
+		v := radusergroup.DefaultPriority
+		rugc.mutation.SetPriority(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rugc *RadUserGroupCreate) check() error {
+	if _, ok := rugc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "RadUserGroup.username"`)}
+	}
+	if v, ok := rugc.mutation.Username(); ok {
+		if err := radusergroup.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadUserGroup.username": %w`, err)}
+		}
+	}
+	if _, ok := rugc.mutation.Groupname(); !ok {
+		return &ValidationError{Name: "groupname", err: errors.New(`ent: missing required field "RadUserGroup.groupname"`)}
+	}
+	if v, ok := rugc.mutation.Groupname(); ok {
+		if err := radusergroup.GroupnameValidator(v); err != nil {
+			return &ValidationError{Name: "groupname", err: fmt.Errorf(`ent: validator failed for field "RadUserGroup.groupname": %w`, err)}
+		}
+	}
+	if _, ok := rugc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "RadUserGroup.priority"`)}
+	}
+	return nil
+}
+
+func (rugc *RadUserGroupCreate) sqlSave(ctx context.Context) (*RadUserGroup, error) {
+	if err := rugc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rugc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rugc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	rugc.mutation.id = &_node.ID
+	rugc.mutation.done = true
+	return _node, nil
+}
+
+func (rugc *RadUserGroupCreate) createSpec() (*RadUserGroup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RadUserGroup{config: rugc.config}
+		_spec = sqlgraph.NewCreateSpec(radusergroup.Table, sqlgraph.NewFieldSpec(radusergroup.FieldID, field.TypeInt))
+	)
+	if id, ok := rugc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rugc.mutation.Username(); ok {
+		_spec.SetField(radusergroup.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := rugc.mutation.Groupname(); ok {
+		_spec.SetField(radusergroup.FieldGroupname, field.TypeString, value)
+		_node.Groupname = value
+	}
+	if value, ok := rugc.mutation.Priority(); ok {
+		_spec.SetField(radusergroup.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	return _node, _spec
+}
+
+// RadUserGroupCreateBulk is the builder for creating many RadUserGroup entities in bulk.
+type RadUserGroupCreateBulk struct {
+	config
+	err      error
+	builders []*RadUserGroupCreate
+}
+
+// Save creates the RadUserGroup entities in the database.
+func (rugcb *RadUserGroupCreateBulk) Save(ctx context.Context) ([]*RadUserGroup, error) {
+	if rugcb.err != nil {
+		return nil, rugcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rugcb.builders))
+	nodes := make([]*RadUserGroup, len(rugcb.builders))
+	mutators := make([]Mutator, len(rugcb.builders))
+	for i := range rugcb.builders {
+		func(i int, root context.Context) {
+			builder := rugcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RadUserGroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rugcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rugcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rugcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rugcb *RadUserGroupCreateBulk) SaveX(ctx context.Context) []*RadUserGroup {
+	v, err := rugcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rugcb *RadUserGroupCreateBulk) Exec(ctx context.Context) error {
+	_, err := rugcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rugcb *RadUserGroupCreateBulk) ExecX(ctx context.Context) {
+	if err := rugcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+)
+
+// RadUserGroupDelete is the builder for deleting a RadUserGroup entity.
+type RadUserGroupDelete struct {
+	config
+	hooks    []Hook
+	mutation *RadUserGroupMutation
+}
+
+// Where appends a list predicates to the RadUserGroupDelete builder.
+func (rugd *RadUserGroupDelete) Where(ps ...predicate.RadUserGroup) *RadUserGroupDelete {
+	rugd.mutation.Where(ps...)
+	return rugd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rugd *RadUserGroupDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rugd.sqlExec, rugd.mutation, rugd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rugd *RadUserGroupDelete) ExecX(ctx context.Context) int {
+	n, err := rugd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rugd *RadUserGroupDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(radusergroup.Table, sqlgraph.NewFieldSpec(radusergroup.FieldID, field.TypeInt))
+	if ps := rugd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rugd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rugd.mutation.done = true
+	return affected, err
+}
+
+// RadUserGroupDeleteOne is the builder for deleting a single RadUserGroup entity.
+type RadUserGroupDeleteOne struct {
+	rugd *RadUserGroupDelete
+}
+
+// Where appends a list predicates to the RadUserGroupDelete builder.
+func (rugdo *RadUserGroupDeleteOne) Where(ps ...predicate.RadUserGroup) *RadUserGroupDeleteOne {
+	rugdo.rugd.mutation.Where(ps...)
+	return rugdo
+}
+
+// Exec executes the deletion query.
+func (rugdo *RadUserGroupDeleteOne) Exec(ctx context.Context) error {
+	n, err := rugdo.rugd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{radusergroup.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rugdo *RadUserGroupDeleteOne) ExecX(ctx context.Context) {
+	if err := rugdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
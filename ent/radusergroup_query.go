@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+)
+
+// RadUserGroupQuery is the builder for querying RadUserGroup entities.
+type RadUserGroupQuery struct {
+	config
+	ctx        *QueryContext
+	order      []radusergroup.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RadUserGroup
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RadUserGroupQuery builder.
+func (rugq *RadUserGroupQuery) Where(ps ...predicate.RadUserGroup) *RadUserGroupQuery {
+	rugq.predicates = append(rugq.predicates, ps...)
+	return rugq
+}
+
+// Limit the number of records to be returned by this query.
+func (rugq *RadUserGroupQuery) Limit(limit int) *RadUserGroupQuery {
+	rugq.ctx.Limit = &limit
+	return rugq
+}
+
+// Offset to start from.
+func (rugq *RadUserGroupQuery) Offset(offset int) *RadUserGroupQuery {
+	rugq.ctx.Offset = &offset
+	return rugq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rugq *RadUserGroupQuery) Unique(unique bool) *RadUserGroupQuery {
+	rugq.ctx.Unique = &unique
+	return rugq
+}
+
+// Order specifies how the records should be ordered.
+func (rugq *RadUserGroupQuery) Order(o ...radusergroup.OrderOption) *RadUserGroupQuery {
+	rugq.order = append(rugq.order, o...)
+	return rugq
+}
+
+// First returns the first RadUserGroup entity from the query.
+// Returns a *NotFoundError when no RadUserGroup was found.
+func (rugq *RadUserGroupQuery) First(ctx context.Context) (*RadUserGroup, error) {
+	nodes, err := rugq.Limit(1).All(setContextOp(ctx, rugq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{radusergroup.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rugq *RadUserGroupQuery) FirstX(ctx context.Context) *RadUserGroup {
+	node, err := rugq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RadUserGroup ID from the query.
+// Returns a *NotFoundError when no RadUserGroup ID was found.
+func (rugq *RadUserGroupQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rugq.Limit(1).IDs(setContextOp(ctx, rugq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{radusergroup.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rugq *RadUserGroupQuery) FirstIDX(ctx context.Context) int {
+	id, err := rugq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RadUserGroup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RadUserGroup entity is found.
+// Returns a *NotFoundError when no RadUserGroup entities are found.
+func (rugq *RadUserGroupQuery) Only(ctx context.Context) (*RadUserGroup, error) {
+	nodes, err := rugq.Limit(2).All(setContextOp(ctx, rugq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{radusergroup.Label}
+	default:
+		return nil, &NotSingularError{radusergroup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rugq *RadUserGroupQuery) OnlyX(ctx context.Context) *RadUserGroup {
+	node, err := rugq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RadUserGroup ID in the query.
+// Returns a *NotSingularError when more than one RadUserGroup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rugq *RadUserGroupQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rugq.Limit(2).IDs(setContextOp(ctx, rugq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{radusergroup.Label}
+	default:
+		err = &NotSingularError{radusergroup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rugq *RadUserGroupQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rugq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RadUserGroups.
+func (rugq *RadUserGroupQuery) All(ctx context.Context) ([]*RadUserGroup, error) {
+	ctx = setContextOp(ctx, rugq.ctx, ent.OpQueryAll)
+	if err := rugq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RadUserGroup, *RadUserGroupQuery]()
+	return withInterceptors[[]*RadUserGroup](ctx, rugq, qr, rugq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rugq *RadUserGroupQuery) AllX(ctx context.Context) []*RadUserGroup {
+	nodes, err := rugq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RadUserGroup IDs.
+func (rugq *RadUserGroupQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rugq.ctx.Unique == nil && rugq.path != nil {
+		rugq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rugq.ctx, ent.OpQueryIDs)
+	if err = rugq.Select(radusergroup.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rugq *RadUserGroupQuery) IDsX(ctx context.Context) []int {
+	ids, err := rugq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rugq *RadUserGroupQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rugq.ctx, ent.OpQueryCount)
+	if err := rugq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rugq, querierCount[*RadUserGroupQuery](), rugq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rugq *RadUserGroupQuery) CountX(ctx context.Context) int {
+	count, err := rugq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rugq *RadUserGroupQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rugq.ctx, ent.OpQueryExist)
+	switch _, err := rugq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rugq *RadUserGroupQuery) ExistX(ctx context.Context) bool {
+	exist, err := rugq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RadUserGroupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rugq *RadUserGroupQuery) Clone() *RadUserGroupQuery {
+	if rugq == nil {
+		return nil
+	}
+	return &RadUserGroupQuery{
+		config:     rugq.config,
+		ctx:        rugq.ctx.Clone(),
+		order:      append([]radusergroup.OrderOption{}, rugq.order...),
+		inters:     append([]Interceptor{}, rugq.inters...),
+		predicates: append([]predicate.RadUserGroup{}, rugq.predicates...),
+		// clone intermediate query.
+		sql:  rugq.sql.Clone(),
+		path: rugq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Username string `json:"username,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RadUserGroup.Query().
+//		GroupBy(radusergroup.FieldUsername).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rugq *RadUserGroupQuery) GroupBy(field string, fields ...string) *RadUserGroupGroupBy {
+	rugq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RadUserGroupGroupBy{build: rugq}
+	grbuild.flds = &rugq.ctx.Fields
+	grbuild.label = radusergroup.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Username string `json:"username,omitempty"`
+//	}
+//
+//	client.RadUserGroup.Query().
+//		Select(radusergroup.FieldUsername).
+//		Scan(ctx, &v)
+func (rugq *RadUserGroupQuery) Select(fields ...string) *RadUserGroupSelect {
+	rugq.ctx.Fields = append(rugq.ctx.Fields, fields...)
+	sbuild := &RadUserGroupSelect{RadUserGroupQuery: rugq}
+	sbuild.label = radusergroup.Label
+	sbuild.flds, sbuild.scan = &rugq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RadUserGroupSelect configured with the given aggregations.
+func (rugq *RadUserGroupQuery) Aggregate(fns ...AggregateFunc) *RadUserGroupSelect {
+	return rugq.Select().Aggregate(fns...)
+}
+
+func (rugq *RadUserGroupQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rugq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rugq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rugq.ctx.Fields {
+		if !radusergroup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rugq.path != nil {
+		prev, err := rugq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rugq.sql = prev
+	}
+	return nil
+}
+
+func (rugq *RadUserGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RadUserGroup, error) {
+	var (
+		nodes = []*RadUserGroup{}
+		_spec = rugq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RadUserGroup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RadUserGroup{config: rugq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rugq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rugq *RadUserGroupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rugq.querySpec()
+	_spec.Node.Columns = rugq.ctx.Fields
+	if len(rugq.ctx.Fields) > 0 {
+		_spec.Unique = rugq.ctx.Unique != nil && *rugq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rugq.driver, _spec)
+}
+
+func (rugq *RadUserGroupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(radusergroup.Table, radusergroup.Columns, sqlgraph.NewFieldSpec(radusergroup.FieldID, field.TypeInt))
+	_spec.From = rugq.sql
+	if unique := rugq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rugq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rugq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, radusergroup.FieldID)
+		for i := range fields {
+			if fields[i] != radusergroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rugq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rugq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rugq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rugq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rugq *RadUserGroupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rugq.driver.Dialect())
+	t1 := builder.Table(radusergroup.Table)
+	columns := rugq.ctx.Fields
+	if len(columns) == 0 {
+		columns = radusergroup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rugq.sql != nil {
+		selector = rugq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rugq.ctx.Unique != nil && *rugq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rugq.predicates {
+		p(selector)
+	}
+	for _, p := range rugq.order {
+		p(selector)
+	}
+	if offset := rugq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rugq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RadUserGroupGroupBy is the group-by builder for RadUserGroup entities.
+type RadUserGroupGroupBy struct {
+	selector
+	build *RadUserGroupQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ruggb *RadUserGroupGroupBy) Aggregate(fns ...AggregateFunc) *RadUserGroupGroupBy {
+	ruggb.fns = append(ruggb.fns, fns...)
+	return ruggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ruggb *RadUserGroupGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ruggb.build.ctx, ent.OpQueryGroupBy)
+	if err := ruggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RadUserGroupQuery, *RadUserGroupGroupBy](ctx, ruggb.build, ruggb, ruggb.build.inters, v)
+}
+
+func (ruggb *RadUserGroupGroupBy) sqlScan(ctx context.Context, root *RadUserGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ruggb.fns))
+	for _, fn := range ruggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ruggb.flds)+len(ruggb.fns))
+		for _, f := range *ruggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ruggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ruggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RadUserGroupSelect is the builder for selecting fields of RadUserGroup entities.
+type RadUserGroupSelect struct {
+	*RadUserGroupQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rugs *RadUserGroupSelect) Aggregate(fns ...AggregateFunc) *RadUserGroupSelect {
+	rugs.fns = append(rugs.fns, fns...)
+	return rugs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rugs *RadUserGroupSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rugs.ctx, ent.OpQuerySelect)
+	if err := rugs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RadUserGroupQuery, *RadUserGroupSelect](ctx, rugs.RadUserGroupQuery, rugs, rugs.inters, v)
+}
+
+func (rugs *RadUserGroupSelect) sqlScan(ctx context.Context, root *RadUserGroupQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rugs.fns))
+	for _, fn := range rugs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rugs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rugs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+)
+
+// RadUserGroupUpdate is the builder for updating RadUserGroup entities.
+type RadUserGroupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RadUserGroupMutation
+}
+
+// Where appends a list predicates to the RadUserGroupUpdate builder.
+func (rugu *RadUserGroupUpdate) Where(ps ...predicate.RadUserGroup) *RadUserGroupUpdate {
+	rugu.mutation.Where(ps...)
+	return rugu
+}
+
+// SetUsername sets the "username" field.
+func (rugu *RadUserGroupUpdate) SetUsername(s string) *RadUserGroupUpdate {
+	rugu.mutation.SetUsername(s)
+	return rugu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (rugu *RadUserGroupUpdate) SetNillableUsername(s *string) *RadUserGroupUpdate {
+	if s != nil {
+		rugu.SetUsername(*s)
+	}
+	return rugu
+}
+
+// SetGroupname sets the "groupname" field.
+func (rugu *RadUserGroupUpdate) SetGroupname(s string) *RadUserGroupUpdate {
+	rugu.mutation.SetGroupname(s)
+	return rugu
+}
+
+// SetNillableGroupname sets the "groupname" field if the given value is not nil.
+func (rugu *RadUserGroupUpdate) SetNillableGroupname(s *string) *RadUserGroupUpdate {
+	if s != nil {
+		rugu.SetGroupname(*s)
+	}
+	return rugu
+}
+
+// SetPriority sets the "priority" field.
+func (rugu *RadUserGroupUpdate) SetPriority(i int) *RadUserGroupUpdate {
+	rugu.mutation.ResetPriority()
+	rugu.mutation.SetPriority(i)
+	return rugu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (rugu *RadUserGroupUpdate) SetNillablePriority(i *int) *RadUserGroupUpdate {
+	if i != nil {
+		rugu.SetPriority(*i)
+	}
+	return rugu
+}
+
+// AddPriority adds i to the "priority" field.
+func (rugu *RadUserGroupUpdate) AddPriority(i int) *RadUserGroupUpdate {
+	rugu.mutation.AddPriority(i)
+	return rugu
+}
+
+// Mutation returns the RadUserGroupMutation object of the builder.
+func (rugu *RadUserGroupUpdate) Mutation() *RadUserGroupMutation {
+	return rugu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rugu *RadUserGroupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rugu.sqlSave, rugu.mutation, rugu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rugu *RadUserGroupUpdate) SaveX(ctx context.Context) int {
+	affected, err := rugu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rugu *RadUserGroupUpdate) Exec(ctx context.Context) error {
+	_, err := rugu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rugu *RadUserGroupUpdate) ExecX(ctx context.Context) {
+	if err := rugu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rugu *RadUserGroupUpdate) check() error {
+	if v, ok := rugu.mutation.Username(); ok {
+		if err := radusergroup.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadUserGroup.username": %w`, err)}
+		}
+	}
+	if v, ok := rugu.mutation.Groupname(); ok {
+		if err := radusergroup.GroupnameValidator(v); err != nil {
+			return &ValidationError{Name: "groupname", err: fmt.Errorf(`ent: validator failed for field "RadUserGroup.groupname": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rugu *RadUserGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rugu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(radusergroup.Table, radusergroup.Columns, sqlgraph.NewFieldSpec(radusergroup.FieldID, field.TypeInt))
+	if ps := rugu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rugu.mutation.Username(); ok {
+		_spec.SetField(radusergroup.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := rugu.mutation.Groupname(); ok {
+		_spec.SetField(radusergroup.FieldGroupname, field.TypeString, value)
+	}
+	if value, ok := rugu.mutation.Priority(); ok {
+		_spec.SetField(radusergroup.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := rugu.mutation.AddedPriority(); ok {
+		_spec.AddField(radusergroup.FieldPriority, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rugu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{radusergroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rugu.mutation.done = true
+	return n, nil
+}
+
+// RadUserGroupUpdateOne is the builder for updating a single RadUserGroup entity.
+type RadUserGroupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RadUserGroupMutation
+}
+
+// SetUsername sets the "username" field.
+func (ruguo *RadUserGroupUpdateOne) SetUsername(s string) *RadUserGroupUpdateOne {
+	ruguo.mutation.SetUsername(s)
+	return ruguo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (ruguo *RadUserGroupUpdateOne) SetNillableUsername(s *string) *RadUserGroupUpdateOne {
+	if s != nil {
+		ruguo.SetUsername(*s)
+	}
+	return ruguo
+}
+
+// SetGroupname sets the "groupname" field.
+func (ruguo *RadUserGroupUpdateOne) SetGroupname(s string) *RadUserGroupUpdateOne {
+	ruguo.mutation.SetGroupname(s)
+	return ruguo
+}
+
+// SetNillableGroupname sets the "groupname" field if the given value is not nil.
+func (ruguo *RadUserGroupUpdateOne) SetNillableGroupname(s *string) *RadUserGroupUpdateOne {
+	if s != nil {
+		ruguo.SetGroupname(*s)
+	}
+	return ruguo
+}
+
+// SetPriority sets the "priority" field.
+func (ruguo *RadUserGroupUpdateOne) SetPriority(i int) *RadUserGroupUpdateOne {
+	ruguo.mutation.ResetPriority()
+	ruguo.mutation.SetPriority(i)
+	return ruguo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (ruguo *RadUserGroupUpdateOne) SetNillablePriority(i *int) *RadUserGroupUpdateOne {
+	if i != nil {
+		ruguo.SetPriority(*i)
+	}
+	return ruguo
+}
+
+// AddPriority adds i to the "priority" field.
+func (ruguo *RadUserGroupUpdateOne) AddPriority(i int) *RadUserGroupUpdateOne {
+	ruguo.mutation.AddPriority(i)
+	return ruguo
+}
+
+// Mutation returns the RadUserGroupMutation object of the builder.
+func (ruguo *RadUserGroupUpdateOne) Mutation() *RadUserGroupMutation {
+	return ruguo.mutation
+}
+
+// Where appends a list predicates to the RadUserGroupUpdate builder.
+func (ruguo *RadUserGroupUpdateOne) Where(ps ...predicate.RadUserGroup) *RadUserGroupUpdateOne {
+	ruguo.mutation.Where(ps...)
+	return ruguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruguo *RadUserGroupUpdateOne) Select(field string, fields ...string) *RadUserGroupUpdateOne {
+	ruguo.fields = append([]string{field}, fields...)
+	return ruguo
+}
+
+// Save executes the query and returns the updated RadUserGroup entity.
+func (ruguo *RadUserGroupUpdateOne) Save(ctx context.Context) (*RadUserGroup, error) {
+	return withHooks(ctx, ruguo.sqlSave, ruguo.mutation, ruguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruguo *RadUserGroupUpdateOne) SaveX(ctx context.Context) *RadUserGroup {
+	node, err := ruguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruguo *RadUserGroupUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruguo *RadUserGroupUpdateOne) ExecX(ctx context.Context) {
+	if err := ruguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruguo *RadUserGroupUpdateOne) check() error {
+	if v, ok := ruguo.mutation.Username(); ok {
+		if err := radusergroup.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "RadUserGroup.username": %w`, err)}
+		}
+	}
+	if v, ok := ruguo.mutation.Groupname(); ok {
+		if err := radusergroup.GroupnameValidator(v); err != nil {
+			return &ValidationError{Name: "groupname", err: fmt.Errorf(`ent: validator failed for field "RadUserGroup.groupname": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ruguo *RadUserGroupUpdateOne) sqlSave(ctx context.Context) (_node *RadUserGroup, err error) {
+	if err := ruguo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(radusergroup.Table, radusergroup.Columns, sqlgraph.NewFieldSpec(radusergroup.FieldID, field.TypeInt))
+	id, ok := ruguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RadUserGroup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, radusergroup.FieldID)
+		for _, f := range fields {
+			if !radusergroup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != radusergroup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruguo.mutation.Username(); ok {
+		_spec.SetField(radusergroup.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := ruguo.mutation.Groupname(); ok {
+		_spec.SetField(radusergroup.FieldGroupname, field.TypeString, value)
+	}
+	if value, ok := ruguo.mutation.Priority(); ok {
+		_spec.SetField(radusergroup.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := ruguo.mutation.AddedPriority(); ok {
+		_spec.AddField(radusergroup.FieldPriority, field.TypeInt, value)
+	}
+	_node = &RadUserGroup{config: ruguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{radusergroup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruguo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
+)
+
+// RefundRequest is the model entity for the RefundRequest schema.
+type RefundRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID int `json:"client_id,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// balance pays out unused balance, failed_charge returns a payment that never reached the balance
+	Kind refundrequest.Kind `json:"kind,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status refundrequest.Status `json:"status,omitempty"`
+	// transaction_ref of the failed charge, for failed_charge refunds
+	ChargeRef string `json:"charge_ref,omitempty"`
+	// PayoutMethod holds the value of the "payout_method" field.
+	PayoutMethod refundrequest.PayoutMethod `json:"payout_method,omitempty"`
+	// PayoutAccount holds the value of the "payout_account" field.
+	PayoutAccount string `json:"payout_account,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// transaction_ref of the REFUND transaction
+	RefundRef string `json:"refund_ref,omitempty"`
+	// Reference of the payout sent to the client
+	PayoutRef string `json:"payout_ref,omitempty"`
+	// ReviewNote holds the value of the "review_note" field.
+	ReviewNote string `json:"review_note,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RefundRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case refundrequest.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case refundrequest.FieldID, refundrequest.FieldClientID:
+			values[i] = new(sql.NullInt64)
+		case refundrequest.FieldClientUsername, refundrequest.FieldKind, refundrequest.FieldStatus, refundrequest.FieldChargeRef, refundrequest.FieldPayoutMethod, refundrequest.FieldPayoutAccount, refundrequest.FieldReason, refundrequest.FieldRefundRef, refundrequest.FieldPayoutRef, refundrequest.FieldReviewNote, refundrequest.FieldReviewedBy:
+			values[i] = new(sql.NullString)
+		case refundrequest.FieldCreatedAt, refundrequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RefundRequest fields.
+func (rr *RefundRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case refundrequest.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rr.ID = int(value.Int64)
+		case refundrequest.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				rr.ClientID = int(value.Int64)
+			}
+		case refundrequest.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				rr.ClientUsername = value.String
+			}
+		case refundrequest.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				rr.Kind = refundrequest.Kind(value.String)
+			}
+		case refundrequest.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				rr.Amount = value.Float64
+			}
+		case refundrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rr.Status = refundrequest.Status(value.String)
+			}
+		case refundrequest.FieldChargeRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field charge_ref", values[i])
+			} else if value.Valid {
+				rr.ChargeRef = value.String
+			}
+		case refundrequest.FieldPayoutMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_method", values[i])
+			} else if value.Valid {
+				rr.PayoutMethod = refundrequest.PayoutMethod(value.String)
+			}
+		case refundrequest.FieldPayoutAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_account", values[i])
+			} else if value.Valid {
+				rr.PayoutAccount = value.String
+			}
+		case refundrequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				rr.Reason = value.String
+			}
+		case refundrequest.FieldRefundRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_ref", values[i])
+			} else if value.Valid {
+				rr.RefundRef = value.String
+			}
+		case refundrequest.FieldPayoutRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_ref", values[i])
+			} else if value.Valid {
+				rr.PayoutRef = value.String
+			}
+		case refundrequest.FieldReviewNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_note", values[i])
+			} else if value.Valid {
+				rr.ReviewNote = value.String
+			}
+		case refundrequest.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				rr.ReviewedBy = value.String
+			}
+		case refundrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case refundrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RefundRequest.
+// This includes values selected through modifiers, order, etc.
+func (rr *RefundRequest) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RefundRequest.
+// Note that you need to call RefundRequest.Unwrap() before calling this method if this RefundRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *RefundRequest) Update() *RefundRequestUpdateOne {
+	return NewRefundRequestClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the RefundRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *RefundRequest) Unwrap() *RefundRequest {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RefundRequest is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *RefundRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("RefundRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", rr.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(rr.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("charge_ref=")
+	builder.WriteString(rr.ChargeRef)
+	builder.WriteString(", ")
+	builder.WriteString("payout_method=")
+	builder.WriteString(fmt.Sprintf("%v", rr.PayoutMethod))
+	builder.WriteString(", ")
+	builder.WriteString("payout_account=")
+	builder.WriteString(rr.PayoutAccount)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(rr.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("refund_ref=")
+	builder.WriteString(rr.RefundRef)
+	builder.WriteString(", ")
+	builder.WriteString("payout_ref=")
+	builder.WriteString(rr.PayoutRef)
+	builder.WriteString(", ")
+	builder.WriteString("review_note=")
+	builder.WriteString(rr.ReviewNote)
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_by=")
+	builder.WriteString(rr.ReviewedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RefundRequests is a parsable slice of RefundRequest.
+type RefundRequests []*RefundRequest
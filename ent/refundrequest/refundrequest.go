@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package refundrequest
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the refundrequest type in the database.
+	Label = "refund_request"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldClientUsername holds the string denoting the client_username field in the database.
+	FieldClientUsername = "client_username"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldChargeRef holds the string denoting the charge_ref field in the database.
+	FieldChargeRef = "charge_ref"
+	// FieldPayoutMethod holds the string denoting the payout_method field in the database.
+	FieldPayoutMethod = "payout_method"
+	// FieldPayoutAccount holds the string denoting the payout_account field in the database.
+	FieldPayoutAccount = "payout_account"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldRefundRef holds the string denoting the refund_ref field in the database.
+	FieldRefundRef = "refund_ref"
+	// FieldPayoutRef holds the string denoting the payout_ref field in the database.
+	FieldPayoutRef = "payout_ref"
+	// FieldReviewNote holds the string denoting the review_note field in the database.
+	FieldReviewNote = "review_note"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the refundrequest in the database.
+	Table = "refund_requests"
+)
+
+// Columns holds all SQL columns for refundrequest fields.
+var Columns = []string{
+	FieldID,
+	FieldClientID,
+	FieldClientUsername,
+	FieldKind,
+	FieldAmount,
+	FieldStatus,
+	FieldChargeRef,
+	FieldPayoutMethod,
+	FieldPayoutAccount,
+	FieldReason,
+	FieldRefundRef,
+	FieldPayoutRef,
+	FieldReviewNote,
+	FieldReviewedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	ClientIDValidator func(int) error
+	// ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	ClientUsernameValidator func(string) error
+	// ChargeRefValidator is a validator for the "charge_ref" field. It is called by the builders before save.
+	ChargeRefValidator func(string) error
+	// PayoutAccountValidator is a validator for the "payout_account" field. It is called by the builders before save.
+	PayoutAccountValidator func(string) error
+	// RefundRefValidator is a validator for the "refund_ref" field. It is called by the builders before save.
+	RefundRefValidator func(string) error
+	// PayoutRefValidator is a validator for the "payout_ref" field. It is called by the builders before save.
+	PayoutRefValidator func(string) error
+	// ReviewedByValidator is a validator for the "reviewed_by" field. It is called by the builders before save.
+	ReviewedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindBalance      Kind = "balance"
+	KindFailedCharge Kind = "failed_charge"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindBalance, KindFailedCharge:
+		return nil
+	default:
+		return fmt.Errorf("refundrequest: invalid enum value for kind field: %q", k)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusRequested is the default value of the Status enum.
+const DefaultStatus = StatusRequested
+
+// Status values.
+const (
+	StatusRequested Status = "requested"
+	StatusApproved  Status = "approved"
+	StatusPaid      Status = "paid"
+	StatusRejected  Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRequested, StatusApproved, StatusPaid, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("refundrequest: invalid enum value for status field: %q", s)
+	}
+}
+
+// PayoutMethod defines the type for the "payout_method" enum field.
+type PayoutMethod string
+
+// PayoutMethod values.
+const (
+	PayoutMethodBkash        PayoutMethod = "bkash"
+	PayoutMethodNagad        PayoutMethod = "nagad"
+	PayoutMethodBankTransfer PayoutMethod = "bank_transfer"
+)
+
+func (pm PayoutMethod) String() string {
+	return string(pm)
+}
+
+// PayoutMethodValidator is a validator for the "payout_method" field enum values. It is called by the builders before save.
+func PayoutMethodValidator(pm PayoutMethod) error {
+	switch pm {
+	case PayoutMethodBkash, PayoutMethodNagad, PayoutMethodBankTransfer:
+		return nil
+	default:
+		return fmt.Errorf("refundrequest: invalid enum value for payout_method field: %q", pm)
+	}
+}
+
+// OrderOption defines the ordering options for the RefundRequest queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByClientUsername orders the results by the client_username field.
+func ByClientUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientUsername, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByChargeRef orders the results by the charge_ref field.
+func ByChargeRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargeRef, opts...).ToFunc()
+}
+
+// ByPayoutMethod orders the results by the payout_method field.
+func ByPayoutMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutMethod, opts...).ToFunc()
+}
+
+// ByPayoutAccount orders the results by the payout_account field.
+func ByPayoutAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutAccount, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByRefundRef orders the results by the refund_ref field.
+func ByRefundRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundRef, opts...).ToFunc()
+}
+
+// ByPayoutRef orders the results by the payout_ref field.
+func ByPayoutRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutRef, opts...).ToFunc()
+}
+
+// ByReviewNote orders the results by the review_note field.
+func ByReviewNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNote, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
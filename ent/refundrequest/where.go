@@ -0,0 +1,920 @@
+// Code generated by ent, DO NOT EDIT.
+
+package refundrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldID, id))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientUsername applies equality check predicate on the "client_username" field. It's identical to ClientUsernameEQ.
+func ClientUsername(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldAmount, v))
+}
+
+// ChargeRef applies equality check predicate on the "charge_ref" field. It's identical to ChargeRefEQ.
+func ChargeRef(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldChargeRef, v))
+}
+
+// PayoutAccount applies equality check predicate on the "payout_account" field. It's identical to PayoutAccountEQ.
+func PayoutAccount(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldPayoutAccount, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// RefundRef applies equality check predicate on the "refund_ref" field. It's identical to RefundRefEQ.
+func RefundRef(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldRefundRef, v))
+}
+
+// PayoutRef applies equality check predicate on the "payout_ref" field. It's identical to PayoutRefEQ.
+func PayoutRef(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldPayoutRef, v))
+}
+
+// ReviewNote applies equality check predicate on the "review_note" field. It's identical to ReviewNoteEQ.
+func ReviewNote(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v int) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientUsernameEQ applies the EQ predicate on the "client_username" field.
+func ClientUsernameEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameNEQ applies the NEQ predicate on the "client_username" field.
+func ClientUsernameNEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameIn applies the In predicate on the "client_username" field.
+func ClientUsernameIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameNotIn applies the NotIn predicate on the "client_username" field.
+func ClientUsernameNotIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameGT applies the GT predicate on the "client_username" field.
+func ClientUsernameGT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldClientUsername, v))
+}
+
+// ClientUsernameGTE applies the GTE predicate on the "client_username" field.
+func ClientUsernameGTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldClientUsername, v))
+}
+
+// ClientUsernameLT applies the LT predicate on the "client_username" field.
+func ClientUsernameLT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldClientUsername, v))
+}
+
+// ClientUsernameLTE applies the LTE predicate on the "client_username" field.
+func ClientUsernameLTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldClientUsername, v))
+}
+
+// ClientUsernameContains applies the Contains predicate on the "client_username" field.
+func ClientUsernameContains(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContains(FieldClientUsername, v))
+}
+
+// ClientUsernameHasPrefix applies the HasPrefix predicate on the "client_username" field.
+func ClientUsernameHasPrefix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasPrefix(FieldClientUsername, v))
+}
+
+// ClientUsernameHasSuffix applies the HasSuffix predicate on the "client_username" field.
+func ClientUsernameHasSuffix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasSuffix(FieldClientUsername, v))
+}
+
+// ClientUsernameEqualFold applies the EqualFold predicate on the "client_username" field.
+func ClientUsernameEqualFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEqualFold(FieldClientUsername, v))
+}
+
+// ClientUsernameContainsFold applies the ContainsFold predicate on the "client_username" field.
+func ClientUsernameContainsFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContainsFold(FieldClientUsername, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ChargeRefEQ applies the EQ predicate on the "charge_ref" field.
+func ChargeRefEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldChargeRef, v))
+}
+
+// ChargeRefNEQ applies the NEQ predicate on the "charge_ref" field.
+func ChargeRefNEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldChargeRef, v))
+}
+
+// ChargeRefIn applies the In predicate on the "charge_ref" field.
+func ChargeRefIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldChargeRef, vs...))
+}
+
+// ChargeRefNotIn applies the NotIn predicate on the "charge_ref" field.
+func ChargeRefNotIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldChargeRef, vs...))
+}
+
+// ChargeRefGT applies the GT predicate on the "charge_ref" field.
+func ChargeRefGT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldChargeRef, v))
+}
+
+// ChargeRefGTE applies the GTE predicate on the "charge_ref" field.
+func ChargeRefGTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldChargeRef, v))
+}
+
+// ChargeRefLT applies the LT predicate on the "charge_ref" field.
+func ChargeRefLT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldChargeRef, v))
+}
+
+// ChargeRefLTE applies the LTE predicate on the "charge_ref" field.
+func ChargeRefLTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldChargeRef, v))
+}
+
+// ChargeRefContains applies the Contains predicate on the "charge_ref" field.
+func ChargeRefContains(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContains(FieldChargeRef, v))
+}
+
+// ChargeRefHasPrefix applies the HasPrefix predicate on the "charge_ref" field.
+func ChargeRefHasPrefix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasPrefix(FieldChargeRef, v))
+}
+
+// ChargeRefHasSuffix applies the HasSuffix predicate on the "charge_ref" field.
+func ChargeRefHasSuffix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasSuffix(FieldChargeRef, v))
+}
+
+// ChargeRefIsNil applies the IsNil predicate on the "charge_ref" field.
+func ChargeRefIsNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIsNull(FieldChargeRef))
+}
+
+// ChargeRefNotNil applies the NotNil predicate on the "charge_ref" field.
+func ChargeRefNotNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotNull(FieldChargeRef))
+}
+
+// ChargeRefEqualFold applies the EqualFold predicate on the "charge_ref" field.
+func ChargeRefEqualFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEqualFold(FieldChargeRef, v))
+}
+
+// ChargeRefContainsFold applies the ContainsFold predicate on the "charge_ref" field.
+func ChargeRefContainsFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContainsFold(FieldChargeRef, v))
+}
+
+// PayoutMethodEQ applies the EQ predicate on the "payout_method" field.
+func PayoutMethodEQ(v PayoutMethod) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldPayoutMethod, v))
+}
+
+// PayoutMethodNEQ applies the NEQ predicate on the "payout_method" field.
+func PayoutMethodNEQ(v PayoutMethod) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldPayoutMethod, v))
+}
+
+// PayoutMethodIn applies the In predicate on the "payout_method" field.
+func PayoutMethodIn(vs ...PayoutMethod) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldPayoutMethod, vs...))
+}
+
+// PayoutMethodNotIn applies the NotIn predicate on the "payout_method" field.
+func PayoutMethodNotIn(vs ...PayoutMethod) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldPayoutMethod, vs...))
+}
+
+// PayoutAccountEQ applies the EQ predicate on the "payout_account" field.
+func PayoutAccountEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldPayoutAccount, v))
+}
+
+// PayoutAccountNEQ applies the NEQ predicate on the "payout_account" field.
+func PayoutAccountNEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldPayoutAccount, v))
+}
+
+// PayoutAccountIn applies the In predicate on the "payout_account" field.
+func PayoutAccountIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldPayoutAccount, vs...))
+}
+
+// PayoutAccountNotIn applies the NotIn predicate on the "payout_account" field.
+func PayoutAccountNotIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldPayoutAccount, vs...))
+}
+
+// PayoutAccountGT applies the GT predicate on the "payout_account" field.
+func PayoutAccountGT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldPayoutAccount, v))
+}
+
+// PayoutAccountGTE applies the GTE predicate on the "payout_account" field.
+func PayoutAccountGTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldPayoutAccount, v))
+}
+
+// PayoutAccountLT applies the LT predicate on the "payout_account" field.
+func PayoutAccountLT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldPayoutAccount, v))
+}
+
+// PayoutAccountLTE applies the LTE predicate on the "payout_account" field.
+func PayoutAccountLTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldPayoutAccount, v))
+}
+
+// PayoutAccountContains applies the Contains predicate on the "payout_account" field.
+func PayoutAccountContains(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContains(FieldPayoutAccount, v))
+}
+
+// PayoutAccountHasPrefix applies the HasPrefix predicate on the "payout_account" field.
+func PayoutAccountHasPrefix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasPrefix(FieldPayoutAccount, v))
+}
+
+// PayoutAccountHasSuffix applies the HasSuffix predicate on the "payout_account" field.
+func PayoutAccountHasSuffix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasSuffix(FieldPayoutAccount, v))
+}
+
+// PayoutAccountEqualFold applies the EqualFold predicate on the "payout_account" field.
+func PayoutAccountEqualFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEqualFold(FieldPayoutAccount, v))
+}
+
+// PayoutAccountContainsFold applies the ContainsFold predicate on the "payout_account" field.
+func PayoutAccountContainsFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContainsFold(FieldPayoutAccount, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContainsFold(FieldReason, v))
+}
+
+// RefundRefEQ applies the EQ predicate on the "refund_ref" field.
+func RefundRefEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldRefundRef, v))
+}
+
+// RefundRefNEQ applies the NEQ predicate on the "refund_ref" field.
+func RefundRefNEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldRefundRef, v))
+}
+
+// RefundRefIn applies the In predicate on the "refund_ref" field.
+func RefundRefIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldRefundRef, vs...))
+}
+
+// RefundRefNotIn applies the NotIn predicate on the "refund_ref" field.
+func RefundRefNotIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldRefundRef, vs...))
+}
+
+// RefundRefGT applies the GT predicate on the "refund_ref" field.
+func RefundRefGT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldRefundRef, v))
+}
+
+// RefundRefGTE applies the GTE predicate on the "refund_ref" field.
+func RefundRefGTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldRefundRef, v))
+}
+
+// RefundRefLT applies the LT predicate on the "refund_ref" field.
+func RefundRefLT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldRefundRef, v))
+}
+
+// RefundRefLTE applies the LTE predicate on the "refund_ref" field.
+func RefundRefLTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldRefundRef, v))
+}
+
+// RefundRefContains applies the Contains predicate on the "refund_ref" field.
+func RefundRefContains(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContains(FieldRefundRef, v))
+}
+
+// RefundRefHasPrefix applies the HasPrefix predicate on the "refund_ref" field.
+func RefundRefHasPrefix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasPrefix(FieldRefundRef, v))
+}
+
+// RefundRefHasSuffix applies the HasSuffix predicate on the "refund_ref" field.
+func RefundRefHasSuffix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasSuffix(FieldRefundRef, v))
+}
+
+// RefundRefIsNil applies the IsNil predicate on the "refund_ref" field.
+func RefundRefIsNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIsNull(FieldRefundRef))
+}
+
+// RefundRefNotNil applies the NotNil predicate on the "refund_ref" field.
+func RefundRefNotNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotNull(FieldRefundRef))
+}
+
+// RefundRefEqualFold applies the EqualFold predicate on the "refund_ref" field.
+func RefundRefEqualFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEqualFold(FieldRefundRef, v))
+}
+
+// RefundRefContainsFold applies the ContainsFold predicate on the "refund_ref" field.
+func RefundRefContainsFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContainsFold(FieldRefundRef, v))
+}
+
+// PayoutRefEQ applies the EQ predicate on the "payout_ref" field.
+func PayoutRefEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldPayoutRef, v))
+}
+
+// PayoutRefNEQ applies the NEQ predicate on the "payout_ref" field.
+func PayoutRefNEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldPayoutRef, v))
+}
+
+// PayoutRefIn applies the In predicate on the "payout_ref" field.
+func PayoutRefIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldPayoutRef, vs...))
+}
+
+// PayoutRefNotIn applies the NotIn predicate on the "payout_ref" field.
+func PayoutRefNotIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldPayoutRef, vs...))
+}
+
+// PayoutRefGT applies the GT predicate on the "payout_ref" field.
+func PayoutRefGT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldPayoutRef, v))
+}
+
+// PayoutRefGTE applies the GTE predicate on the "payout_ref" field.
+func PayoutRefGTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldPayoutRef, v))
+}
+
+// PayoutRefLT applies the LT predicate on the "payout_ref" field.
+func PayoutRefLT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldPayoutRef, v))
+}
+
+// PayoutRefLTE applies the LTE predicate on the "payout_ref" field.
+func PayoutRefLTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldPayoutRef, v))
+}
+
+// PayoutRefContains applies the Contains predicate on the "payout_ref" field.
+func PayoutRefContains(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContains(FieldPayoutRef, v))
+}
+
+// PayoutRefHasPrefix applies the HasPrefix predicate on the "payout_ref" field.
+func PayoutRefHasPrefix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasPrefix(FieldPayoutRef, v))
+}
+
+// PayoutRefHasSuffix applies the HasSuffix predicate on the "payout_ref" field.
+func PayoutRefHasSuffix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasSuffix(FieldPayoutRef, v))
+}
+
+// PayoutRefIsNil applies the IsNil predicate on the "payout_ref" field.
+func PayoutRefIsNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIsNull(FieldPayoutRef))
+}
+
+// PayoutRefNotNil applies the NotNil predicate on the "payout_ref" field.
+func PayoutRefNotNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotNull(FieldPayoutRef))
+}
+
+// PayoutRefEqualFold applies the EqualFold predicate on the "payout_ref" field.
+func PayoutRefEqualFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEqualFold(FieldPayoutRef, v))
+}
+
+// PayoutRefContainsFold applies the ContainsFold predicate on the "payout_ref" field.
+func PayoutRefContainsFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContainsFold(FieldPayoutRef, v))
+}
+
+// ReviewNoteEQ applies the EQ predicate on the "review_note" field.
+func ReviewNoteEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteNEQ applies the NEQ predicate on the "review_note" field.
+func ReviewNoteNEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteIn applies the In predicate on the "review_note" field.
+func ReviewNoteIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteNotIn applies the NotIn predicate on the "review_note" field.
+func ReviewNoteNotIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteGT applies the GT predicate on the "review_note" field.
+func ReviewNoteGT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldReviewNote, v))
+}
+
+// ReviewNoteGTE applies the GTE predicate on the "review_note" field.
+func ReviewNoteGTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldReviewNote, v))
+}
+
+// ReviewNoteLT applies the LT predicate on the "review_note" field.
+func ReviewNoteLT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldReviewNote, v))
+}
+
+// ReviewNoteLTE applies the LTE predicate on the "review_note" field.
+func ReviewNoteLTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldReviewNote, v))
+}
+
+// ReviewNoteContains applies the Contains predicate on the "review_note" field.
+func ReviewNoteContains(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContains(FieldReviewNote, v))
+}
+
+// ReviewNoteHasPrefix applies the HasPrefix predicate on the "review_note" field.
+func ReviewNoteHasPrefix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasPrefix(FieldReviewNote, v))
+}
+
+// ReviewNoteHasSuffix applies the HasSuffix predicate on the "review_note" field.
+func ReviewNoteHasSuffix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasSuffix(FieldReviewNote, v))
+}
+
+// ReviewNoteIsNil applies the IsNil predicate on the "review_note" field.
+func ReviewNoteIsNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIsNull(FieldReviewNote))
+}
+
+// ReviewNoteNotNil applies the NotNil predicate on the "review_note" field.
+func ReviewNoteNotNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotNull(FieldReviewNote))
+}
+
+// ReviewNoteEqualFold applies the EqualFold predicate on the "review_note" field.
+func ReviewNoteEqualFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEqualFold(FieldReviewNote, v))
+}
+
+// ReviewNoteContainsFold applies the ContainsFold predicate on the "review_note" field.
+func ReviewNoteContainsFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContainsFold(FieldReviewNote, v))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByContains applies the Contains predicate on the "reviewed_by" field.
+func ReviewedByContains(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContains(FieldReviewedBy, v))
+}
+
+// ReviewedByHasPrefix applies the HasPrefix predicate on the "reviewed_by" field.
+func ReviewedByHasPrefix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasPrefix(FieldReviewedBy, v))
+}
+
+// ReviewedByHasSuffix applies the HasSuffix predicate on the "reviewed_by" field.
+func ReviewedByHasSuffix(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldHasSuffix(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedByEqualFold applies the EqualFold predicate on the "reviewed_by" field.
+func ReviewedByEqualFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEqualFold(FieldReviewedBy, v))
+}
+
+// ReviewedByContainsFold applies the ContainsFold predicate on the "reviewed_by" field.
+func ReviewedByContainsFold(v string) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldContainsFold(FieldReviewedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RefundRequest) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RefundRequest) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RefundRequest) predicate.RefundRequest {
+	return predicate.RefundRequest(sql.NotPredicates(p))
+}
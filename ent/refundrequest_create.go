@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
+)
+
+// RefundRequestCreate is the builder for creating a RefundRequest entity.
+type RefundRequestCreate struct {
+	config
+	mutation *RefundRequestMutation
+	hooks    []Hook
+}
+
+// SetClientID sets the "client_id" field.
+func (rrc *RefundRequestCreate) SetClientID(i int) *RefundRequestCreate {
+	rrc.mutation.SetClientID(i)
+	return rrc
+}
+
+// SetClientUsername sets the "client_username" field.
+func (rrc *RefundRequestCreate) SetClientUsername(s string) *RefundRequestCreate {
+	rrc.mutation.SetClientUsername(s)
+	return rrc
+}
+
+// SetKind sets the "kind" field.
+func (rrc *RefundRequestCreate) SetKind(r refundrequest.Kind) *RefundRequestCreate {
+	rrc.mutation.SetKind(r)
+	return rrc
+}
+
+// SetAmount sets the "amount" field.
+func (rrc *RefundRequestCreate) SetAmount(f float64) *RefundRequestCreate {
+	rrc.mutation.SetAmount(f)
+	return rrc
+}
+
+// SetStatus sets the "status" field.
+func (rrc *RefundRequestCreate) SetStatus(r refundrequest.Status) *RefundRequestCreate {
+	rrc.mutation.SetStatus(r)
+	return rrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rrc *RefundRequestCreate) SetNillableStatus(r *refundrequest.Status) *RefundRequestCreate {
+	if r != nil {
+		rrc.SetStatus(*r)
+	}
+	return rrc
+}
+
+// SetChargeRef sets the "charge_ref" field.
+func (rrc *RefundRequestCreate) SetChargeRef(s string) *RefundRequestCreate {
+	rrc.mutation.SetChargeRef(s)
+	return rrc
+}
+
+// SetNillableChargeRef sets the "charge_ref" field if the given value is not nil.
+func (rrc *RefundRequestCreate) SetNillableChargeRef(s *string) *RefundRequestCreate {
+	if s != nil {
+		rrc.SetChargeRef(*s)
+	}
+	return rrc
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (rrc *RefundRequestCreate) SetPayoutMethod(rm refundrequest.PayoutMethod) *RefundRequestCreate {
+	rrc.mutation.SetPayoutMethod(rm)
+	return rrc
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (rrc *RefundRequestCreate) SetPayoutAccount(s string) *RefundRequestCreate {
+	rrc.mutation.SetPayoutAccount(s)
+	return rrc
+}
+
+// SetReason sets the "reason" field.
+func (rrc *RefundRequestCreate) SetReason(s string) *RefundRequestCreate {
+	rrc.mutation.SetReason(s)
+	return rrc
+}
+
+// SetRefundRef sets the "refund_ref" field.
+func (rrc *RefundRequestCreate) SetRefundRef(s string) *RefundRequestCreate {
+	rrc.mutation.SetRefundRef(s)
+	return rrc
+}
+
+// SetNillableRefundRef sets the "refund_ref" field if the given value is not nil.
+func (rrc *RefundRequestCreate) SetNillableRefundRef(s *string) *RefundRequestCreate {
+	if s != nil {
+		rrc.SetRefundRef(*s)
+	}
+	return rrc
+}
+
+// SetPayoutRef sets the "payout_ref" field.
+func (rrc *RefundRequestCreate) SetPayoutRef(s string) *RefundRequestCreate {
+	rrc.mutation.SetPayoutRef(s)
+	return rrc
+}
+
+// SetNillablePayoutRef sets the "payout_ref" field if the given value is not nil.
+func (rrc *RefundRequestCreate) SetNillablePayoutRef(s *string) *RefundRequestCreate {
+	if s != nil {
+		rrc.SetPayoutRef(*s)
+	}
+	return rrc
+}
+
+// SetReviewNote sets the "review_note" field.
+func (rrc *RefundRequestCreate) SetReviewNote(s string) *RefundRequestCreate {
+	rrc.mutation.SetReviewNote(s)
+	return rrc
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (rrc *RefundRequestCreate) SetNillableReviewNote(s *string) *RefundRequestCreate {
+	if s != nil {
+		rrc.SetReviewNote(*s)
+	}
+	return rrc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rrc *RefundRequestCreate) SetReviewedBy(s string) *RefundRequestCreate {
+	rrc.mutation.SetReviewedBy(s)
+	return rrc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rrc *RefundRequestCreate) SetNillableReviewedBy(s *string) *RefundRequestCreate {
+	if s != nil {
+		rrc.SetReviewedBy(*s)
+	}
+	return rrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *RefundRequestCreate) SetCreatedAt(t time.Time) *RefundRequestCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *RefundRequestCreate) SetNillableCreatedAt(t *time.Time) *RefundRequestCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *RefundRequestCreate) SetUpdatedAt(t time.Time) *RefundRequestCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *RefundRequestCreate) SetNillableUpdatedAt(t *time.Time) *RefundRequestCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// Mutation returns the RefundRequestMutation object of the builder.
+func (rrc *RefundRequestCreate) Mutation() *RefundRequestMutation {
+	return rrc.mutation
+}
+
+// Save creates the RefundRequest in the database.
+func (rrc *RefundRequestCreate) Save(ctx context.Context) (*RefundRequest, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *RefundRequestCreate) SaveX(ctx context.Context) *RefundRequest {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *RefundRequestCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *RefundRequestCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *RefundRequestCreate) defaults() {
+	if _, ok := rrc.mutation.Status(); !ok {
+		v := refundrequest.DefaultStatus
+		rrc.mutation.SetStatus(v)
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := refundrequest.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		v := refundrequest.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *RefundRequestCreate) check() error {
+	if _, ok := rrc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "RefundRequest.client_id"`)}
+	}
+	if v, ok := rrc.mutation.ClientID(); ok {
+		if err := refundrequest.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.client_id": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.ClientUsername(); !ok {
+		return &ValidationError{Name: "client_username", err: errors.New(`ent: missing required field "RefundRequest.client_username"`)}
+	}
+	if v, ok := rrc.mutation.ClientUsername(); ok {
+		if err := refundrequest.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.client_username": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "RefundRequest.kind"`)}
+	}
+	if v, ok := rrc.mutation.Kind(); ok {
+		if err := refundrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.kind": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "RefundRequest.amount"`)}
+	}
+	if _, ok := rrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RefundRequest.status"`)}
+	}
+	if v, ok := rrc.mutation.Status(); ok {
+		if err := refundrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := rrc.mutation.ChargeRef(); ok {
+		if err := refundrequest.ChargeRefValidator(v); err != nil {
+			return &ValidationError{Name: "charge_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.charge_ref": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.PayoutMethod(); !ok {
+		return &ValidationError{Name: "payout_method", err: errors.New(`ent: missing required field "RefundRequest.payout_method"`)}
+	}
+	if v, ok := rrc.mutation.PayoutMethod(); ok {
+		if err := refundrequest.PayoutMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payout_method", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_method": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.PayoutAccount(); !ok {
+		return &ValidationError{Name: "payout_account", err: errors.New(`ent: missing required field "RefundRequest.payout_account"`)}
+	}
+	if v, ok := rrc.mutation.PayoutAccount(); ok {
+		if err := refundrequest.PayoutAccountValidator(v); err != nil {
+			return &ValidationError{Name: "payout_account", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_account": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "RefundRequest.reason"`)}
+	}
+	if v, ok := rrc.mutation.RefundRef(); ok {
+		if err := refundrequest.RefundRefValidator(v); err != nil {
+			return &ValidationError{Name: "refund_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.refund_ref": %w`, err)}
+		}
+	}
+	if v, ok := rrc.mutation.PayoutRef(); ok {
+		if err := refundrequest.PayoutRefValidator(v); err != nil {
+			return &ValidationError{Name: "payout_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_ref": %w`, err)}
+		}
+	}
+	if v, ok := rrc.mutation.ReviewedBy(); ok {
+		if err := refundrequest.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.reviewed_by": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RefundRequest.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RefundRequest.updated_at"`)}
+	}
+	return nil
+}
+
+func (rrc *RefundRequestCreate) sqlSave(ctx context.Context) (*RefundRequest, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *RefundRequestCreate) createSpec() (*RefundRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RefundRequest{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(refundrequest.Table, sqlgraph.NewFieldSpec(refundrequest.FieldID, field.TypeInt))
+	)
+	if value, ok := rrc.mutation.ClientID(); ok {
+		_spec.SetField(refundrequest.FieldClientID, field.TypeInt, value)
+		_node.ClientID = value
+	}
+	if value, ok := rrc.mutation.ClientUsername(); ok {
+		_spec.SetField(refundrequest.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := rrc.mutation.Kind(); ok {
+		_spec.SetField(refundrequest.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := rrc.mutation.Amount(); ok {
+		_spec.SetField(refundrequest.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := rrc.mutation.Status(); ok {
+		_spec.SetField(refundrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rrc.mutation.ChargeRef(); ok {
+		_spec.SetField(refundrequest.FieldChargeRef, field.TypeString, value)
+		_node.ChargeRef = value
+	}
+	if value, ok := rrc.mutation.PayoutMethod(); ok {
+		_spec.SetField(refundrequest.FieldPayoutMethod, field.TypeEnum, value)
+		_node.PayoutMethod = value
+	}
+	if value, ok := rrc.mutation.PayoutAccount(); ok {
+		_spec.SetField(refundrequest.FieldPayoutAccount, field.TypeString, value)
+		_node.PayoutAccount = value
+	}
+	if value, ok := rrc.mutation.Reason(); ok {
+		_spec.SetField(refundrequest.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := rrc.mutation.RefundRef(); ok {
+		_spec.SetField(refundrequest.FieldRefundRef, field.TypeString, value)
+		_node.RefundRef = value
+	}
+	if value, ok := rrc.mutation.PayoutRef(); ok {
+		_spec.SetField(refundrequest.FieldPayoutRef, field.TypeString, value)
+		_node.PayoutRef = value
+	}
+	if value, ok := rrc.mutation.ReviewNote(); ok {
+		_spec.SetField(refundrequest.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = value
+	}
+	if value, ok := rrc.mutation.ReviewedBy(); ok {
+		_spec.SetField(refundrequest.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = value
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(refundrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(refundrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RefundRequestCreateBulk is the builder for creating many RefundRequest entities in bulk.
+type RefundRequestCreateBulk struct {
+	config
+	err      error
+	builders []*RefundRequestCreate
+}
+
+// Save creates the RefundRequest entities in the database.
+func (rrcb *RefundRequestCreateBulk) Save(ctx context.Context) ([]*RefundRequest, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*RefundRequest, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RefundRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *RefundRequestCreateBulk) SaveX(ctx context.Context) []*RefundRequest {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *RefundRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *RefundRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,900 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
+)
+
+// RefundRequestUpdate is the builder for updating RefundRequest entities.
+type RefundRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RefundRequestMutation
+}
+
+// Where appends a list predicates to the RefundRequestUpdate builder.
+func (rru *RefundRequestUpdate) Where(ps ...predicate.RefundRequest) *RefundRequestUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetClientID sets the "client_id" field.
+func (rru *RefundRequestUpdate) SetClientID(i int) *RefundRequestUpdate {
+	rru.mutation.ResetClientID()
+	rru.mutation.SetClientID(i)
+	return rru
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableClientID(i *int) *RefundRequestUpdate {
+	if i != nil {
+		rru.SetClientID(*i)
+	}
+	return rru
+}
+
+// AddClientID adds i to the "client_id" field.
+func (rru *RefundRequestUpdate) AddClientID(i int) *RefundRequestUpdate {
+	rru.mutation.AddClientID(i)
+	return rru
+}
+
+// SetClientUsername sets the "client_username" field.
+func (rru *RefundRequestUpdate) SetClientUsername(s string) *RefundRequestUpdate {
+	rru.mutation.SetClientUsername(s)
+	return rru
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableClientUsername(s *string) *RefundRequestUpdate {
+	if s != nil {
+		rru.SetClientUsername(*s)
+	}
+	return rru
+}
+
+// SetKind sets the "kind" field.
+func (rru *RefundRequestUpdate) SetKind(r refundrequest.Kind) *RefundRequestUpdate {
+	rru.mutation.SetKind(r)
+	return rru
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableKind(r *refundrequest.Kind) *RefundRequestUpdate {
+	if r != nil {
+		rru.SetKind(*r)
+	}
+	return rru
+}
+
+// SetAmount sets the "amount" field.
+func (rru *RefundRequestUpdate) SetAmount(f float64) *RefundRequestUpdate {
+	rru.mutation.ResetAmount()
+	rru.mutation.SetAmount(f)
+	return rru
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableAmount(f *float64) *RefundRequestUpdate {
+	if f != nil {
+		rru.SetAmount(*f)
+	}
+	return rru
+}
+
+// AddAmount adds f to the "amount" field.
+func (rru *RefundRequestUpdate) AddAmount(f float64) *RefundRequestUpdate {
+	rru.mutation.AddAmount(f)
+	return rru
+}
+
+// SetStatus sets the "status" field.
+func (rru *RefundRequestUpdate) SetStatus(r refundrequest.Status) *RefundRequestUpdate {
+	rru.mutation.SetStatus(r)
+	return rru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableStatus(r *refundrequest.Status) *RefundRequestUpdate {
+	if r != nil {
+		rru.SetStatus(*r)
+	}
+	return rru
+}
+
+// SetChargeRef sets the "charge_ref" field.
+func (rru *RefundRequestUpdate) SetChargeRef(s string) *RefundRequestUpdate {
+	rru.mutation.SetChargeRef(s)
+	return rru
+}
+
+// SetNillableChargeRef sets the "charge_ref" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableChargeRef(s *string) *RefundRequestUpdate {
+	if s != nil {
+		rru.SetChargeRef(*s)
+	}
+	return rru
+}
+
+// ClearChargeRef clears the value of the "charge_ref" field.
+func (rru *RefundRequestUpdate) ClearChargeRef() *RefundRequestUpdate {
+	rru.mutation.ClearChargeRef()
+	return rru
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (rru *RefundRequestUpdate) SetPayoutMethod(rm refundrequest.PayoutMethod) *RefundRequestUpdate {
+	rru.mutation.SetPayoutMethod(rm)
+	return rru
+}
+
+// SetNillablePayoutMethod sets the "payout_method" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillablePayoutMethod(rm *refundrequest.PayoutMethod) *RefundRequestUpdate {
+	if rm != nil {
+		rru.SetPayoutMethod(*rm)
+	}
+	return rru
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (rru *RefundRequestUpdate) SetPayoutAccount(s string) *RefundRequestUpdate {
+	rru.mutation.SetPayoutAccount(s)
+	return rru
+}
+
+// SetNillablePayoutAccount sets the "payout_account" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillablePayoutAccount(s *string) *RefundRequestUpdate {
+	if s != nil {
+		rru.SetPayoutAccount(*s)
+	}
+	return rru
+}
+
+// SetReason sets the "reason" field.
+func (rru *RefundRequestUpdate) SetReason(s string) *RefundRequestUpdate {
+	rru.mutation.SetReason(s)
+	return rru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableReason(s *string) *RefundRequestUpdate {
+	if s != nil {
+		rru.SetReason(*s)
+	}
+	return rru
+}
+
+// SetRefundRef sets the "refund_ref" field.
+func (rru *RefundRequestUpdate) SetRefundRef(s string) *RefundRequestUpdate {
+	rru.mutation.SetRefundRef(s)
+	return rru
+}
+
+// SetNillableRefundRef sets the "refund_ref" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableRefundRef(s *string) *RefundRequestUpdate {
+	if s != nil {
+		rru.SetRefundRef(*s)
+	}
+	return rru
+}
+
+// ClearRefundRef clears the value of the "refund_ref" field.
+func (rru *RefundRequestUpdate) ClearRefundRef() *RefundRequestUpdate {
+	rru.mutation.ClearRefundRef()
+	return rru
+}
+
+// SetPayoutRef sets the "payout_ref" field.
+func (rru *RefundRequestUpdate) SetPayoutRef(s string) *RefundRequestUpdate {
+	rru.mutation.SetPayoutRef(s)
+	return rru
+}
+
+// SetNillablePayoutRef sets the "payout_ref" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillablePayoutRef(s *string) *RefundRequestUpdate {
+	if s != nil {
+		rru.SetPayoutRef(*s)
+	}
+	return rru
+}
+
+// ClearPayoutRef clears the value of the "payout_ref" field.
+func (rru *RefundRequestUpdate) ClearPayoutRef() *RefundRequestUpdate {
+	rru.mutation.ClearPayoutRef()
+	return rru
+}
+
+// SetReviewNote sets the "review_note" field.
+func (rru *RefundRequestUpdate) SetReviewNote(s string) *RefundRequestUpdate {
+	rru.mutation.SetReviewNote(s)
+	return rru
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableReviewNote(s *string) *RefundRequestUpdate {
+	if s != nil {
+		rru.SetReviewNote(*s)
+	}
+	return rru
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (rru *RefundRequestUpdate) ClearReviewNote() *RefundRequestUpdate {
+	rru.mutation.ClearReviewNote()
+	return rru
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rru *RefundRequestUpdate) SetReviewedBy(s string) *RefundRequestUpdate {
+	rru.mutation.SetReviewedBy(s)
+	return rru
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rru *RefundRequestUpdate) SetNillableReviewedBy(s *string) *RefundRequestUpdate {
+	if s != nil {
+		rru.SetReviewedBy(*s)
+	}
+	return rru
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (rru *RefundRequestUpdate) ClearReviewedBy() *RefundRequestUpdate {
+	rru.mutation.ClearReviewedBy()
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *RefundRequestUpdate) SetUpdatedAt(t time.Time) *RefundRequestUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// Mutation returns the RefundRequestMutation object of the builder.
+func (rru *RefundRequestUpdate) Mutation() *RefundRequestMutation {
+	return rru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *RefundRequestUpdate) Save(ctx context.Context) (int, error) {
+	rru.defaults()
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *RefundRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *RefundRequestUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *RefundRequestUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *RefundRequestUpdate) defaults() {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		v := refundrequest.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *RefundRequestUpdate) check() error {
+	if v, ok := rru.mutation.ClientID(); ok {
+		if err := refundrequest.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.client_id": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.ClientUsername(); ok {
+		if err := refundrequest.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.client_username": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.Kind(); ok {
+		if err := refundrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.kind": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.Status(); ok {
+		if err := refundrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.ChargeRef(); ok {
+		if err := refundrequest.ChargeRefValidator(v); err != nil {
+			return &ValidationError{Name: "charge_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.charge_ref": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.PayoutMethod(); ok {
+		if err := refundrequest.PayoutMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payout_method", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_method": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.PayoutAccount(); ok {
+		if err := refundrequest.PayoutAccountValidator(v); err != nil {
+			return &ValidationError{Name: "payout_account", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_account": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.RefundRef(); ok {
+		if err := refundrequest.RefundRefValidator(v); err != nil {
+			return &ValidationError{Name: "refund_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.refund_ref": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.PayoutRef(); ok {
+		if err := refundrequest.PayoutRefValidator(v); err != nil {
+			return &ValidationError{Name: "payout_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_ref": %w`, err)}
+		}
+	}
+	if v, ok := rru.mutation.ReviewedBy(); ok {
+		if err := refundrequest.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.reviewed_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rru *RefundRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(refundrequest.Table, refundrequest.Columns, sqlgraph.NewFieldSpec(refundrequest.FieldID, field.TypeInt))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.ClientID(); ok {
+		_spec.SetField(refundrequest.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.AddedClientID(); ok {
+		_spec.AddField(refundrequest.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := rru.mutation.ClientUsername(); ok {
+		_spec.SetField(refundrequest.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.Kind(); ok {
+		_spec.SetField(refundrequest.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.Amount(); ok {
+		_spec.SetField(refundrequest.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rru.mutation.AddedAmount(); ok {
+		_spec.AddField(refundrequest.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rru.mutation.Status(); ok {
+		_spec.SetField(refundrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.ChargeRef(); ok {
+		_spec.SetField(refundrequest.FieldChargeRef, field.TypeString, value)
+	}
+	if rru.mutation.ChargeRefCleared() {
+		_spec.ClearField(refundrequest.FieldChargeRef, field.TypeString)
+	}
+	if value, ok := rru.mutation.PayoutMethod(); ok {
+		_spec.SetField(refundrequest.FieldPayoutMethod, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.PayoutAccount(); ok {
+		_spec.SetField(refundrequest.FieldPayoutAccount, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.Reason(); ok {
+		_spec.SetField(refundrequest.FieldReason, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.RefundRef(); ok {
+		_spec.SetField(refundrequest.FieldRefundRef, field.TypeString, value)
+	}
+	if rru.mutation.RefundRefCleared() {
+		_spec.ClearField(refundrequest.FieldRefundRef, field.TypeString)
+	}
+	if value, ok := rru.mutation.PayoutRef(); ok {
+		_spec.SetField(refundrequest.FieldPayoutRef, field.TypeString, value)
+	}
+	if rru.mutation.PayoutRefCleared() {
+		_spec.ClearField(refundrequest.FieldPayoutRef, field.TypeString)
+	}
+	if value, ok := rru.mutation.ReviewNote(); ok {
+		_spec.SetField(refundrequest.FieldReviewNote, field.TypeString, value)
+	}
+	if rru.mutation.ReviewNoteCleared() {
+		_spec.ClearField(refundrequest.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := rru.mutation.ReviewedBy(); ok {
+		_spec.SetField(refundrequest.FieldReviewedBy, field.TypeString, value)
+	}
+	if rru.mutation.ReviewedByCleared() {
+		_spec.ClearField(refundrequest.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(refundrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refundrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// RefundRequestUpdateOne is the builder for updating a single RefundRequest entity.
+type RefundRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RefundRequestMutation
+}
+
+// SetClientID sets the "client_id" field.
+func (rruo *RefundRequestUpdateOne) SetClientID(i int) *RefundRequestUpdateOne {
+	rruo.mutation.ResetClientID()
+	rruo.mutation.SetClientID(i)
+	return rruo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableClientID(i *int) *RefundRequestUpdateOne {
+	if i != nil {
+		rruo.SetClientID(*i)
+	}
+	return rruo
+}
+
+// AddClientID adds i to the "client_id" field.
+func (rruo *RefundRequestUpdateOne) AddClientID(i int) *RefundRequestUpdateOne {
+	rruo.mutation.AddClientID(i)
+	return rruo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (rruo *RefundRequestUpdateOne) SetClientUsername(s string) *RefundRequestUpdateOne {
+	rruo.mutation.SetClientUsername(s)
+	return rruo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableClientUsername(s *string) *RefundRequestUpdateOne {
+	if s != nil {
+		rruo.SetClientUsername(*s)
+	}
+	return rruo
+}
+
+// SetKind sets the "kind" field.
+func (rruo *RefundRequestUpdateOne) SetKind(r refundrequest.Kind) *RefundRequestUpdateOne {
+	rruo.mutation.SetKind(r)
+	return rruo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableKind(r *refundrequest.Kind) *RefundRequestUpdateOne {
+	if r != nil {
+		rruo.SetKind(*r)
+	}
+	return rruo
+}
+
+// SetAmount sets the "amount" field.
+func (rruo *RefundRequestUpdateOne) SetAmount(f float64) *RefundRequestUpdateOne {
+	rruo.mutation.ResetAmount()
+	rruo.mutation.SetAmount(f)
+	return rruo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableAmount(f *float64) *RefundRequestUpdateOne {
+	if f != nil {
+		rruo.SetAmount(*f)
+	}
+	return rruo
+}
+
+// AddAmount adds f to the "amount" field.
+func (rruo *RefundRequestUpdateOne) AddAmount(f float64) *RefundRequestUpdateOne {
+	rruo.mutation.AddAmount(f)
+	return rruo
+}
+
+// SetStatus sets the "status" field.
+func (rruo *RefundRequestUpdateOne) SetStatus(r refundrequest.Status) *RefundRequestUpdateOne {
+	rruo.mutation.SetStatus(r)
+	return rruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableStatus(r *refundrequest.Status) *RefundRequestUpdateOne {
+	if r != nil {
+		rruo.SetStatus(*r)
+	}
+	return rruo
+}
+
+// SetChargeRef sets the "charge_ref" field.
+func (rruo *RefundRequestUpdateOne) SetChargeRef(s string) *RefundRequestUpdateOne {
+	rruo.mutation.SetChargeRef(s)
+	return rruo
+}
+
+// SetNillableChargeRef sets the "charge_ref" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableChargeRef(s *string) *RefundRequestUpdateOne {
+	if s != nil {
+		rruo.SetChargeRef(*s)
+	}
+	return rruo
+}
+
+// ClearChargeRef clears the value of the "charge_ref" field.
+func (rruo *RefundRequestUpdateOne) ClearChargeRef() *RefundRequestUpdateOne {
+	rruo.mutation.ClearChargeRef()
+	return rruo
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (rruo *RefundRequestUpdateOne) SetPayoutMethod(rm refundrequest.PayoutMethod) *RefundRequestUpdateOne {
+	rruo.mutation.SetPayoutMethod(rm)
+	return rruo
+}
+
+// SetNillablePayoutMethod sets the "payout_method" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillablePayoutMethod(rm *refundrequest.PayoutMethod) *RefundRequestUpdateOne {
+	if rm != nil {
+		rruo.SetPayoutMethod(*rm)
+	}
+	return rruo
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (rruo *RefundRequestUpdateOne) SetPayoutAccount(s string) *RefundRequestUpdateOne {
+	rruo.mutation.SetPayoutAccount(s)
+	return rruo
+}
+
+// SetNillablePayoutAccount sets the "payout_account" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillablePayoutAccount(s *string) *RefundRequestUpdateOne {
+	if s != nil {
+		rruo.SetPayoutAccount(*s)
+	}
+	return rruo
+}
+
+// SetReason sets the "reason" field.
+func (rruo *RefundRequestUpdateOne) SetReason(s string) *RefundRequestUpdateOne {
+	rruo.mutation.SetReason(s)
+	return rruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableReason(s *string) *RefundRequestUpdateOne {
+	if s != nil {
+		rruo.SetReason(*s)
+	}
+	return rruo
+}
+
+// SetRefundRef sets the "refund_ref" field.
+func (rruo *RefundRequestUpdateOne) SetRefundRef(s string) *RefundRequestUpdateOne {
+	rruo.mutation.SetRefundRef(s)
+	return rruo
+}
+
+// SetNillableRefundRef sets the "refund_ref" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableRefundRef(s *string) *RefundRequestUpdateOne {
+	if s != nil {
+		rruo.SetRefundRef(*s)
+	}
+	return rruo
+}
+
+// ClearRefundRef clears the value of the "refund_ref" field.
+func (rruo *RefundRequestUpdateOne) ClearRefundRef() *RefundRequestUpdateOne {
+	rruo.mutation.ClearRefundRef()
+	return rruo
+}
+
+// SetPayoutRef sets the "payout_ref" field.
+func (rruo *RefundRequestUpdateOne) SetPayoutRef(s string) *RefundRequestUpdateOne {
+	rruo.mutation.SetPayoutRef(s)
+	return rruo
+}
+
+// SetNillablePayoutRef sets the "payout_ref" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillablePayoutRef(s *string) *RefundRequestUpdateOne {
+	if s != nil {
+		rruo.SetPayoutRef(*s)
+	}
+	return rruo
+}
+
+// ClearPayoutRef clears the value of the "payout_ref" field.
+func (rruo *RefundRequestUpdateOne) ClearPayoutRef() *RefundRequestUpdateOne {
+	rruo.mutation.ClearPayoutRef()
+	return rruo
+}
+
+// SetReviewNote sets the "review_note" field.
+func (rruo *RefundRequestUpdateOne) SetReviewNote(s string) *RefundRequestUpdateOne {
+	rruo.mutation.SetReviewNote(s)
+	return rruo
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableReviewNote(s *string) *RefundRequestUpdateOne {
+	if s != nil {
+		rruo.SetReviewNote(*s)
+	}
+	return rruo
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (rruo *RefundRequestUpdateOne) ClearReviewNote() *RefundRequestUpdateOne {
+	rruo.mutation.ClearReviewNote()
+	return rruo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rruo *RefundRequestUpdateOne) SetReviewedBy(s string) *RefundRequestUpdateOne {
+	rruo.mutation.SetReviewedBy(s)
+	return rruo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rruo *RefundRequestUpdateOne) SetNillableReviewedBy(s *string) *RefundRequestUpdateOne {
+	if s != nil {
+		rruo.SetReviewedBy(*s)
+	}
+	return rruo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (rruo *RefundRequestUpdateOne) ClearReviewedBy() *RefundRequestUpdateOne {
+	rruo.mutation.ClearReviewedBy()
+	return rruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *RefundRequestUpdateOne) SetUpdatedAt(t time.Time) *RefundRequestUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// Mutation returns the RefundRequestMutation object of the builder.
+func (rruo *RefundRequestUpdateOne) Mutation() *RefundRequestMutation {
+	return rruo.mutation
+}
+
+// Where appends a list predicates to the RefundRequestUpdate builder.
+func (rruo *RefundRequestUpdateOne) Where(ps ...predicate.RefundRequest) *RefundRequestUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *RefundRequestUpdateOne) Select(field string, fields ...string) *RefundRequestUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated RefundRequest entity.
+func (rruo *RefundRequestUpdateOne) Save(ctx context.Context) (*RefundRequest, error) {
+	rruo.defaults()
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *RefundRequestUpdateOne) SaveX(ctx context.Context) *RefundRequest {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *RefundRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *RefundRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *RefundRequestUpdateOne) defaults() {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		v := refundrequest.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *RefundRequestUpdateOne) check() error {
+	if v, ok := rruo.mutation.ClientID(); ok {
+		if err := refundrequest.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.client_id": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.ClientUsername(); ok {
+		if err := refundrequest.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.client_username": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.Kind(); ok {
+		if err := refundrequest.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.kind": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.Status(); ok {
+		if err := refundrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.status": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.ChargeRef(); ok {
+		if err := refundrequest.ChargeRefValidator(v); err != nil {
+			return &ValidationError{Name: "charge_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.charge_ref": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.PayoutMethod(); ok {
+		if err := refundrequest.PayoutMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payout_method", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_method": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.PayoutAccount(); ok {
+		if err := refundrequest.PayoutAccountValidator(v); err != nil {
+			return &ValidationError{Name: "payout_account", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_account": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.RefundRef(); ok {
+		if err := refundrequest.RefundRefValidator(v); err != nil {
+			return &ValidationError{Name: "refund_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.refund_ref": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.PayoutRef(); ok {
+		if err := refundrequest.PayoutRefValidator(v); err != nil {
+			return &ValidationError{Name: "payout_ref", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.payout_ref": %w`, err)}
+		}
+	}
+	if v, ok := rruo.mutation.ReviewedBy(); ok {
+		if err := refundrequest.ReviewedByValidator(v); err != nil {
+			return &ValidationError{Name: "reviewed_by", err: fmt.Errorf(`ent: validator failed for field "RefundRequest.reviewed_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rruo *RefundRequestUpdateOne) sqlSave(ctx context.Context) (_node *RefundRequest, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(refundrequest.Table, refundrequest.Columns, sqlgraph.NewFieldSpec(refundrequest.FieldID, field.TypeInt))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RefundRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, refundrequest.FieldID)
+		for _, f := range fields {
+			if !refundrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != refundrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.ClientID(); ok {
+		_spec.SetField(refundrequest.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.AddedClientID(); ok {
+		_spec.AddField(refundrequest.FieldClientID, field.TypeInt, value)
+	}
+	if value, ok := rruo.mutation.ClientUsername(); ok {
+		_spec.SetField(refundrequest.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.Kind(); ok {
+		_spec.SetField(refundrequest.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.Amount(); ok {
+		_spec.SetField(refundrequest.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rruo.mutation.AddedAmount(); ok {
+		_spec.AddField(refundrequest.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := rruo.mutation.Status(); ok {
+		_spec.SetField(refundrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.ChargeRef(); ok {
+		_spec.SetField(refundrequest.FieldChargeRef, field.TypeString, value)
+	}
+	if rruo.mutation.ChargeRefCleared() {
+		_spec.ClearField(refundrequest.FieldChargeRef, field.TypeString)
+	}
+	if value, ok := rruo.mutation.PayoutMethod(); ok {
+		_spec.SetField(refundrequest.FieldPayoutMethod, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.PayoutAccount(); ok {
+		_spec.SetField(refundrequest.FieldPayoutAccount, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.Reason(); ok {
+		_spec.SetField(refundrequest.FieldReason, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.RefundRef(); ok {
+		_spec.SetField(refundrequest.FieldRefundRef, field.TypeString, value)
+	}
+	if rruo.mutation.RefundRefCleared() {
+		_spec.ClearField(refundrequest.FieldRefundRef, field.TypeString)
+	}
+	if value, ok := rruo.mutation.PayoutRef(); ok {
+		_spec.SetField(refundrequest.FieldPayoutRef, field.TypeString, value)
+	}
+	if rruo.mutation.PayoutRefCleared() {
+		_spec.ClearField(refundrequest.FieldPayoutRef, field.TypeString)
+	}
+	if value, ok := rruo.mutation.ReviewNote(); ok {
+		_spec.SetField(refundrequest.FieldReviewNote, field.TypeString, value)
+	}
+	if rruo.mutation.ReviewNoteCleared() {
+		_spec.ClearField(refundrequest.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := rruo.mutation.ReviewedBy(); ok {
+		_spec.SetField(refundrequest.FieldReviewedBy, field.TypeString, value)
+	}
+	if rruo.mutation.ReviewedByCleared() {
+		_spec.ClearField(refundrequest.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(refundrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &RefundRequest{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refundrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
@@ -5,36 +5,164 @@ package runtime
 import (
 	"time"
 
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+	"github.com/mikestefanello/pagoda/ent/clientaddon"
 	"github.com/mikestefanello/pagoda/ent/clienttxn"
 	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/coupon"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
 	"github.com/mikestefanello/pagoda/ent/emailsubscription"
 	"github.com/mikestefanello/pagoda/ent/emailsubscriptiontype"
 	"github.com/mikestefanello/pagoda/ent/emojis"
 	"github.com/mikestefanello/pagoda/ent/fcmsubscriptions"
 	"github.com/mikestefanello/pagoda/ent/filestorage"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
 	"github.com/mikestefanello/pagoda/ent/image"
 	"github.com/mikestefanello/pagoda/ent/imagesize"
 	"github.com/mikestefanello/pagoda/ent/invitation"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
 	"github.com/mikestefanello/pagoda/ent/lastseenonline"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
 	"github.com/mikestefanello/pagoda/ent/monthlysubscription"
 	"github.com/mikestefanello/pagoda/ent/notification"
 	"github.com/mikestefanello/pagoda/ent/notificationpermission"
 	"github.com/mikestefanello/pagoda/ent/notificationtime"
 	"github.com/mikestefanello/pagoda/ent/packageplan"
+	"github.com/mikestefanello/pagoda/ent/payrequest"
 	"github.com/mikestefanello/pagoda/ent/phoneverificationcode"
 	"github.com/mikestefanello/pagoda/ent/profile"
 	"github.com/mikestefanello/pagoda/ent/pwapushsubscription"
 	"github.com/mikestefanello/pagoda/ent/radacct"
+	"github.com/mikestefanello/pagoda/ent/radcheck"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+	"github.com/mikestefanello/pagoda/ent/radreply"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
 	"github.com/mikestefanello/pagoda/ent/schema"
 	"github.com/mikestefanello/pagoda/ent/sentemail"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
 	"github.com/mikestefanello/pagoda/ent/ticket"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
 	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/ent/vendor"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	balancetransferFields := schema.BalanceTransfer{}.Fields()
+	_ = balancetransferFields
+	// balancetransferDescFromUsername is the schema descriptor for from_username field.
+	balancetransferDescFromUsername := balancetransferFields[0].Descriptor()
+	// balancetransfer.FromUsernameValidator is a validator for the "from_username" field. It is called by the builders before save.
+	balancetransfer.FromUsernameValidator = func() func(string) error {
+		validators := balancetransferDescFromUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(from_username string) error {
+			for _, fn := range fns {
+				if err := fn(from_username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// balancetransferDescToUsername is the schema descriptor for to_username field.
+	balancetransferDescToUsername := balancetransferFields[1].Descriptor()
+	// balancetransfer.ToUsernameValidator is a validator for the "to_username" field. It is called by the builders before save.
+	balancetransfer.ToUsernameValidator = func() func(string) error {
+		validators := balancetransferDescToUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(to_username string) error {
+			for _, fn := range fns {
+				if err := fn(to_username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// balancetransferDescCodeHash is the schema descriptor for code_hash field.
+	balancetransferDescCodeHash := balancetransferFields[3].Descriptor()
+	// balancetransfer.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	balancetransfer.CodeHashValidator = balancetransferDescCodeHash.Validators[0].(func(string) error)
+	// balancetransferDescAttempts is the schema descriptor for attempts field.
+	balancetransferDescAttempts := balancetransferFields[4].Descriptor()
+	// balancetransfer.DefaultAttempts holds the default value on creation for the attempts field.
+	balancetransfer.DefaultAttempts = balancetransferDescAttempts.Default.(int)
+	// balancetransferDescDebitRef is the schema descriptor for debit_ref field.
+	balancetransferDescDebitRef := balancetransferFields[6].Descriptor()
+	// balancetransfer.DebitRefValidator is a validator for the "debit_ref" field. It is called by the builders before save.
+	balancetransfer.DebitRefValidator = balancetransferDescDebitRef.Validators[0].(func(string) error)
+	// balancetransferDescCreditRef is the schema descriptor for credit_ref field.
+	balancetransferDescCreditRef := balancetransferFields[7].Descriptor()
+	// balancetransfer.CreditRefValidator is a validator for the "credit_ref" field. It is called by the builders before save.
+	balancetransfer.CreditRefValidator = balancetransferDescCreditRef.Validators[0].(func(string) error)
+	// balancetransferDescCreatedAt is the schema descriptor for created_at field.
+	balancetransferDescCreatedAt := balancetransferFields[10].Descriptor()
+	// balancetransfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancetransfer.DefaultCreatedAt = balancetransferDescCreatedAt.Default.(func() time.Time)
+	clientaddonFields := schema.ClientAddon{}.Fields()
+	_ = clientaddonFields
+	// clientaddonDescClientID is the schema descriptor for client_id field.
+	clientaddonDescClientID := clientaddonFields[0].Descriptor()
+	// clientaddon.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	clientaddon.ClientIDValidator = clientaddonDescClientID.Validators[0].(func(int) error)
+	// clientaddonDescClientUsername is the schema descriptor for client_username field.
+	clientaddonDescClientUsername := clientaddonFields[1].Descriptor()
+	// clientaddon.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	clientaddon.ClientUsernameValidator = clientaddonDescClientUsername.Validators[0].(func(string) error)
+	// clientaddonDescName is the schema descriptor for name field.
+	clientaddonDescName := clientaddonFields[2].Descriptor()
+	// clientaddon.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	clientaddon.NameValidator = func() func(string) error {
+		validators := clientaddonDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// clientaddonDescAmount is the schema descriptor for amount field.
+	clientaddonDescAmount := clientaddonFields[3].Descriptor()
+	// clientaddon.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	clientaddon.AmountValidator = clientaddonDescAmount.Validators[0].(func(float64) error)
+	// clientaddonDescActive is the schema descriptor for active field.
+	clientaddonDescActive := clientaddonFields[4].Descriptor()
+	// clientaddon.DefaultActive holds the default value on creation for the active field.
+	clientaddon.DefaultActive = clientaddonDescActive.Default.(bool)
+	// clientaddonDescCreatedBy is the schema descriptor for created_by field.
+	clientaddonDescCreatedBy := clientaddonFields[5].Descriptor()
+	// clientaddon.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	clientaddon.CreatedByValidator = clientaddonDescCreatedBy.Validators[0].(func(string) error)
+	// clientaddonDescCreatedAt is the schema descriptor for created_at field.
+	clientaddonDescCreatedAt := clientaddonFields[6].Descriptor()
+	// clientaddon.DefaultCreatedAt holds the default value on creation for the created_at field.
+	clientaddon.DefaultCreatedAt = clientaddonDescCreatedAt.Default.(func() time.Time)
 	clienttxnFields := schema.ClientTxn{}.Fields()
 	_ = clienttxnFields
 	// clienttxnDescTransactionRef is the schema descriptor for transaction_ref field.
@@ -49,16 +177,44 @@ func init() {
 	clienttxnDescTotalBalance := clienttxnFields[5].Descriptor()
 	// clienttxn.DefaultTotalBalance holds the default value on creation for the total_balance field.
 	clienttxn.DefaultTotalBalance = clienttxnDescTotalBalance.Default.(float64)
+	// clienttxnDescGatewayRef is the schema descriptor for gateway_ref field.
+	clienttxnDescGatewayRef := clienttxnFields[7].Descriptor()
+	// clienttxn.GatewayRefValidator is a validator for the "gateway_ref" field. It is called by the builders before save.
+	clienttxn.GatewayRefValidator = clienttxnDescGatewayRef.Validators[0].(func(string) error)
 	// clienttxnDescClientUsername is the schema descriptor for client_username field.
-	clienttxnDescClientUsername := clienttxnFields[7].Descriptor()
+	clienttxnDescClientUsername := clienttxnFields[8].Descriptor()
 	// clienttxn.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
 	clienttxn.ClientUsernameValidator = clienttxnDescClientUsername.Validators[0].(func(string) error)
+	// clienttxnDescDiscount is the schema descriptor for discount field.
+	clienttxnDescDiscount := clienttxnFields[9].Descriptor()
+	// clienttxn.DefaultDiscount holds the default value on creation for the discount field.
+	clienttxn.DefaultDiscount = clienttxnDescDiscount.Default.(float64)
+	// clienttxnDescCouponCode is the schema descriptor for coupon_code field.
+	clienttxnDescCouponCode := clienttxnFields[10].Descriptor()
+	// clienttxn.CouponCodeValidator is a validator for the "coupon_code" field. It is called by the builders before save.
+	clienttxn.CouponCodeValidator = clienttxnDescCouponCode.Validators[0].(func(string) error)
+	// clienttxnDescNetAmount is the schema descriptor for net_amount field.
+	clienttxnDescNetAmount := clienttxnFields[11].Descriptor()
+	// clienttxn.DefaultNetAmount holds the default value on creation for the net_amount field.
+	clienttxn.DefaultNetAmount = clienttxnDescNetAmount.Default.(float64)
+	// clienttxnDescTaxAmount is the schema descriptor for tax_amount field.
+	clienttxnDescTaxAmount := clienttxnFields[12].Descriptor()
+	// clienttxn.DefaultTaxAmount holds the default value on creation for the tax_amount field.
+	clienttxn.DefaultTaxAmount = clienttxnDescTaxAmount.Default.(float64)
+	// clienttxnDescTaxRate is the schema descriptor for tax_rate field.
+	clienttxnDescTaxRate := clienttxnFields[13].Descriptor()
+	// clienttxn.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	clienttxn.DefaultTaxRate = clienttxnDescTaxRate.Default.(float64)
+	// clienttxnDescServiceDays is the schema descriptor for service_days field.
+	clienttxnDescServiceDays := clienttxnFields[15].Descriptor()
+	// clienttxn.DefaultServiceDays holds the default value on creation for the service_days field.
+	clienttxn.DefaultServiceDays = clienttxnDescServiceDays.Default.(int)
 	// clienttxnDescTransactionDate is the schema descriptor for transaction_date field.
-	clienttxnDescTransactionDate := clienttxnFields[9].Descriptor()
+	clienttxnDescTransactionDate := clienttxnFields[17].Descriptor()
 	// clienttxn.DefaultTransactionDate holds the default value on creation for the transaction_date field.
 	clienttxn.DefaultTransactionDate = clienttxnDescTransactionDate.Default.(func() time.Time)
 	// clienttxnDescCreatedBy is the schema descriptor for created_by field.
-	clienttxnDescCreatedBy := clienttxnFields[10].Descriptor()
+	clienttxnDescCreatedBy := clienttxnFields[18].Descriptor()
 	// clienttxn.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	clienttxn.CreatedByValidator = clienttxnDescCreatedBy.Validators[0].(func(string) error)
 	// clienttxnDescID is the schema descriptor for id field.
@@ -165,46 +321,50 @@ func init() {
 	clientuserDescBalance := clientuserFields[8].Descriptor()
 	// clientuser.DefaultBalance holds the default value on creation for the balance field.
 	clientuser.DefaultBalance = clientuserDescBalance.Default.(float64)
-	// clientuser.BalanceValidator is a validator for the "balance" field. It is called by the builders before save.
-	clientuser.BalanceValidator = clientuserDescBalance.Validators[0].(func(float64) error)
+	// clientuserDescCreditLimit is the schema descriptor for credit_limit field.
+	clientuserDescCreditLimit := clientuserFields[10].Descriptor()
+	// clientuser.DefaultCreditLimit holds the default value on creation for the credit_limit field.
+	clientuser.DefaultCreditLimit = clientuserDescCreditLimit.Default.(float64)
+	// clientuser.CreditLimitValidator is a validator for the "credit_limit" field. It is called by the builders before save.
+	clientuser.CreditLimitValidator = clientuserDescCreditLimit.Validators[0].(func(float64) error)
 	// clientuserDescAddressLine1 is the schema descriptor for address_line1 field.
-	clientuserDescAddressLine1 := clientuserFields[9].Descriptor()
+	clientuserDescAddressLine1 := clientuserFields[12].Descriptor()
 	// clientuser.AddressLine1Validator is a validator for the "address_line1" field. It is called by the builders before save.
 	clientuser.AddressLine1Validator = clientuserDescAddressLine1.Validators[0].(func(string) error)
 	// clientuserDescAddressLine2 is the schema descriptor for address_line2 field.
-	clientuserDescAddressLine2 := clientuserFields[10].Descriptor()
+	clientuserDescAddressLine2 := clientuserFields[13].Descriptor()
 	// clientuser.AddressLine2Validator is a validator for the "address_line2" field. It is called by the builders before save.
 	clientuser.AddressLine2Validator = clientuserDescAddressLine2.Validators[0].(func(string) error)
 	// clientuserDescCity is the schema descriptor for city field.
-	clientuserDescCity := clientuserFields[11].Descriptor()
+	clientuserDescCity := clientuserFields[14].Descriptor()
 	// clientuser.CityValidator is a validator for the "city" field. It is called by the builders before save.
 	clientuser.CityValidator = clientuserDescCity.Validators[0].(func(string) error)
 	// clientuserDescDistrict is the schema descriptor for district field.
-	clientuserDescDistrict := clientuserFields[12].Descriptor()
+	clientuserDescDistrict := clientuserFields[15].Descriptor()
 	// clientuser.DistrictValidator is a validator for the "district" field. It is called by the builders before save.
 	clientuser.DistrictValidator = clientuserDescDistrict.Validators[0].(func(string) error)
 	// clientuserDescUpazila is the schema descriptor for upazila field.
-	clientuserDescUpazila := clientuserFields[13].Descriptor()
+	clientuserDescUpazila := clientuserFields[16].Descriptor()
 	// clientuser.UpazilaValidator is a validator for the "upazila" field. It is called by the builders before save.
 	clientuser.UpazilaValidator = clientuserDescUpazila.Validators[0].(func(string) error)
 	// clientuserDescUnionName is the schema descriptor for union_name field.
-	clientuserDescUnionName := clientuserFields[14].Descriptor()
+	clientuserDescUnionName := clientuserFields[17].Descriptor()
 	// clientuser.UnionNameValidator is a validator for the "union_name" field. It is called by the builders before save.
 	clientuser.UnionNameValidator = clientuserDescUnionName.Validators[0].(func(string) error)
 	// clientuserDescZip is the schema descriptor for zip field.
-	clientuserDescZip := clientuserFields[15].Descriptor()
+	clientuserDescZip := clientuserFields[18].Descriptor()
 	// clientuser.ZipValidator is a validator for the "zip" field. It is called by the builders before save.
 	clientuser.ZipValidator = clientuserDescZip.Validators[0].(func(string) error)
 	// clientuserDescPaymentType is the schema descriptor for payment_type field.
-	clientuserDescPaymentType := clientuserFields[18].Descriptor()
+	clientuserDescPaymentType := clientuserFields[21].Descriptor()
 	// clientuser.PaymentTypeValidator is a validator for the "payment_type" field. It is called by the builders before save.
 	clientuser.PaymentTypeValidator = clientuserDescPaymentType.Validators[0].(func(string) error)
 	// clientuserDescAutoRenew is the schema descriptor for auto_renew field.
-	clientuserDescAutoRenew := clientuserFields[19].Descriptor()
+	clientuserDescAutoRenew := clientuserFields[22].Descriptor()
 	// clientuser.DefaultAutoRenew holds the default value on creation for the auto_renew field.
 	clientuser.DefaultAutoRenew = clientuserDescAutoRenew.Default.(bool)
 	// clientuserDescCName is the schema descriptor for c_name field.
-	clientuserDescCName := clientuserFields[20].Descriptor()
+	clientuserDescCName := clientuserFields[23].Descriptor()
 	// clientuser.CNameValidator is a validator for the "c_name" field. It is called by the builders before save.
 	clientuser.CNameValidator = func() func(string) error {
 		validators := clientuserDescCName.Validators
@@ -222,23 +382,23 @@ func init() {
 		}
 	}()
 	// clientuserDescVendorID is the schema descriptor for vendor_id field.
-	clientuserDescVendorID := clientuserFields[21].Descriptor()
+	clientuserDescVendorID := clientuserFields[24].Descriptor()
 	// clientuser.VendorIDValidator is a validator for the "vendor_id" field. It is called by the builders before save.
 	clientuser.VendorIDValidator = clientuserDescVendorID.Validators[0].(func(int) error)
 	// clientuserDescPackagePool is the schema descriptor for package_pool field.
-	clientuserDescPackagePool := clientuserFields[22].Descriptor()
+	clientuserDescPackagePool := clientuserFields[25].Descriptor()
 	// clientuser.PackagePoolValidator is a validator for the "package_pool" field. It is called by the builders before save.
 	clientuser.PackagePoolValidator = clientuserDescPackagePool.Validators[0].(func(string) error)
 	// clientuserDescUserProfile is the schema descriptor for user_profile field.
-	clientuserDescUserProfile := clientuserFields[23].Descriptor()
+	clientuserDescUserProfile := clientuserFields[26].Descriptor()
 	// clientuser.UserProfileValidator is a validator for the "user_profile" field. It is called by the builders before save.
 	clientuser.UserProfileValidator = clientuserDescUserProfile.Validators[0].(func(string) error)
 	// clientuserDescNextUserProfile is the schema descriptor for next_user_profile field.
-	clientuserDescNextUserProfile := clientuserFields[24].Descriptor()
+	clientuserDescNextUserProfile := clientuserFields[27].Descriptor()
 	// clientuser.NextUserProfileValidator is a validator for the "next_user_profile" field. It is called by the builders before save.
 	clientuser.NextUserProfileValidator = clientuserDescNextUserProfile.Validators[0].(func(string) error)
 	// clientuserDescCreatedBy is the schema descriptor for created_by field.
-	clientuserDescCreatedBy := clientuserFields[25].Descriptor()
+	clientuserDescCreatedBy := clientuserFields[28].Descriptor()
 	// clientuser.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	clientuser.CreatedByValidator = func() func(string) error {
 		validators := clientuserDescCreatedBy.Validators
@@ -256,21 +416,125 @@ func init() {
 		}
 	}()
 	// clientuserDescUpdatedBy is the schema descriptor for updated_by field.
-	clientuserDescUpdatedBy := clientuserFields[26].Descriptor()
+	clientuserDescUpdatedBy := clientuserFields[29].Descriptor()
 	// clientuser.UpdatedByValidator is a validator for the "updated_by" field. It is called by the builders before save.
 	clientuser.UpdatedByValidator = clientuserDescUpdatedBy.Validators[0].(func(string) error)
 	// clientuserDescCreatedDate is the schema descriptor for created_date field.
-	clientuserDescCreatedDate := clientuserFields[27].Descriptor()
+	clientuserDescCreatedDate := clientuserFields[30].Descriptor()
 	// clientuser.DefaultCreatedDate holds the default value on creation for the created_date field.
 	clientuser.DefaultCreatedDate = clientuserDescCreatedDate.Default.(func() time.Time)
 	// clientuserDescUpdatedDate is the schema descriptor for updated_date field.
-	clientuserDescUpdatedDate := clientuserFields[28].Descriptor()
+	clientuserDescUpdatedDate := clientuserFields[31].Descriptor()
 	// clientuser.UpdateDefaultUpdatedDate holds the default value on update for the updated_date field.
 	clientuser.UpdateDefaultUpdatedDate = clientuserDescUpdatedDate.UpdateDefault.(func() time.Time)
 	// clientuserDescID is the schema descriptor for id field.
 	clientuserDescID := clientuserFields[0].Descriptor()
 	// clientuser.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	clientuser.IDValidator = clientuserDescID.Validators[0].(func(int) error)
+	couponFields := schema.Coupon{}.Fields()
+	_ = couponFields
+	// couponDescCode is the schema descriptor for code field.
+	couponDescCode := couponFields[0].Descriptor()
+	// coupon.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	coupon.CodeValidator = func() func(string) error {
+		validators := couponDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// couponDescDescription is the schema descriptor for description field.
+	couponDescDescription := couponFields[1].Descriptor()
+	// coupon.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	coupon.DescriptionValidator = couponDescDescription.Validators[0].(func(string) error)
+	// couponDescMaxUses is the schema descriptor for max_uses field.
+	couponDescMaxUses := couponFields[6].Descriptor()
+	// coupon.DefaultMaxUses holds the default value on creation for the max_uses field.
+	coupon.DefaultMaxUses = couponDescMaxUses.Default.(int)
+	// couponDescMaxUsesPerClient is the schema descriptor for max_uses_per_client field.
+	couponDescMaxUsesPerClient := couponFields[7].Descriptor()
+	// coupon.DefaultMaxUsesPerClient holds the default value on creation for the max_uses_per_client field.
+	coupon.DefaultMaxUsesPerClient = couponDescMaxUsesPerClient.Default.(int)
+	// couponDescUsedCount is the schema descriptor for used_count field.
+	couponDescUsedCount := couponFields[8].Descriptor()
+	// coupon.DefaultUsedCount holds the default value on creation for the used_count field.
+	coupon.DefaultUsedCount = couponDescUsedCount.Default.(int)
+	// couponDescIsActive is the schema descriptor for is_active field.
+	couponDescIsActive := couponFields[11].Descriptor()
+	// coupon.DefaultIsActive holds the default value on creation for the is_active field.
+	coupon.DefaultIsActive = couponDescIsActive.Default.(bool)
+	// couponDescCreatedBy is the schema descriptor for created_by field.
+	couponDescCreatedBy := couponFields[12].Descriptor()
+	// coupon.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	coupon.CreatedByValidator = couponDescCreatedBy.Validators[0].(func(string) error)
+	// couponDescCreatedAt is the schema descriptor for created_at field.
+	couponDescCreatedAt := couponFields[13].Descriptor()
+	// coupon.DefaultCreatedAt holds the default value on creation for the created_at field.
+	coupon.DefaultCreatedAt = couponDescCreatedAt.Default.(func() time.Time)
+	couponredemptionFields := schema.CouponRedemption{}.Fields()
+	_ = couponredemptionFields
+	// couponredemptionDescCouponID is the schema descriptor for coupon_id field.
+	couponredemptionDescCouponID := couponredemptionFields[0].Descriptor()
+	// couponredemption.CouponIDValidator is a validator for the "coupon_id" field. It is called by the builders before save.
+	couponredemption.CouponIDValidator = couponredemptionDescCouponID.Validators[0].(func(int) error)
+	// couponredemptionDescCode is the schema descriptor for code field.
+	couponredemptionDescCode := couponredemptionFields[1].Descriptor()
+	// couponredemption.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	couponredemption.CodeValidator = couponredemptionDescCode.Validators[0].(func(string) error)
+	// couponredemptionDescClientID is the schema descriptor for client_id field.
+	couponredemptionDescClientID := couponredemptionFields[2].Descriptor()
+	// couponredemption.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	couponredemption.ClientIDValidator = couponredemptionDescClientID.Validators[0].(func(int) error)
+	// couponredemptionDescClientUsername is the schema descriptor for client_username field.
+	couponredemptionDescClientUsername := couponredemptionFields[3].Descriptor()
+	// couponredemption.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	couponredemption.ClientUsernameValidator = couponredemptionDescClientUsername.Validators[0].(func(string) error)
+	// couponredemptionDescTransactionRef is the schema descriptor for transaction_ref field.
+	couponredemptionDescTransactionRef := couponredemptionFields[4].Descriptor()
+	// couponredemption.TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	couponredemption.TransactionRefValidator = couponredemptionDescTransactionRef.Validators[0].(func(string) error)
+	// couponredemptionDescCreatedAt is the schema descriptor for created_at field.
+	couponredemptionDescCreatedAt := couponredemptionFields[6].Descriptor()
+	// couponredemption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	couponredemption.DefaultCreatedAt = couponredemptionDescCreatedAt.Default.(func() time.Time)
+	dunningstepFields := schema.DunningStep{}.Fields()
+	_ = dunningstepFields
+	// dunningstepDescClientID is the schema descriptor for client_id field.
+	dunningstepDescClientID := dunningstepFields[0].Descriptor()
+	// dunningstep.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	dunningstep.ClientIDValidator = dunningstepDescClientID.Validators[0].(func(int) error)
+	// dunningstepDescClientUsername is the schema descriptor for client_username field.
+	dunningstepDescClientUsername := dunningstepFields[1].Descriptor()
+	// dunningstep.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	dunningstep.ClientUsernameValidator = dunningstepDescClientUsername.Validators[0].(func(string) error)
+	// dunningstepDescReference is the schema descriptor for reference field.
+	dunningstepDescReference := dunningstepFields[3].Descriptor()
+	// dunningstep.ReferenceValidator is a validator for the "reference" field. It is called by the builders before save.
+	dunningstep.ReferenceValidator = dunningstepDescReference.Validators[0].(func(string) error)
+	// dunningstepDescSubject is the schema descriptor for subject field.
+	dunningstepDescSubject := dunningstepFields[8].Descriptor()
+	// dunningstep.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	dunningstep.SubjectValidator = dunningstepDescSubject.Validators[0].(func(string) error)
+	// dunningstepDescChannels is the schema descriptor for channels field.
+	dunningstepDescChannels := dunningstepFields[11].Descriptor()
+	// dunningstep.ChannelsValidator is a validator for the "channels" field. It is called by the builders before save.
+	dunningstep.ChannelsValidator = dunningstepDescChannels.Validators[0].(func(string) error)
+	// dunningstepDescError is the schema descriptor for error field.
+	dunningstepDescError := dunningstepFields[12].Descriptor()
+	// dunningstep.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	dunningstep.ErrorValidator = dunningstepDescError.Validators[0].(func(string) error)
+	// dunningstepDescCreatedAt is the schema descriptor for created_at field.
+	dunningstepDescCreatedAt := dunningstepFields[13].Descriptor()
+	// dunningstep.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dunningstep.DefaultCreatedAt = dunningstepDescCreatedAt.Default.(func() time.Time)
 	emailsubscriptionMixin := schema.EmailSubscription{}.Mixin()
 	emailsubscriptionMixinFields0 := emailsubscriptionMixin[0].Fields()
 	_ = emailsubscriptionMixinFields0
@@ -369,6 +633,30 @@ func init() {
 	filestorageDescObjectKey := filestorageFields[1].Descriptor()
 	// filestorage.ObjectKeyValidator is a validator for the "object_key" field. It is called by the builders before save.
 	filestorage.ObjectKeyValidator = filestorageDescObjectKey.Validators[0].(func(string) error)
+	graceperiodFields := schema.GracePeriod{}.Fields()
+	_ = graceperiodFields
+	// graceperiodDescClientID is the schema descriptor for client_id field.
+	graceperiodDescClientID := graceperiodFields[0].Descriptor()
+	// graceperiod.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	graceperiod.ClientIDValidator = graceperiodDescClientID.Validators[0].(func(int) error)
+	// graceperiodDescClientUsername is the schema descriptor for client_username field.
+	graceperiodDescClientUsername := graceperiodFields[1].Descriptor()
+	// graceperiod.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	graceperiod.ClientUsernameValidator = graceperiodDescClientUsername.Validators[0].(func(string) error)
+	// graceperiodDescProfile is the schema descriptor for profile field.
+	graceperiodDescProfile := graceperiodFields[5].Descriptor()
+	// graceperiod.ProfileValidator is a validator for the "profile" field. It is called by the builders before save.
+	graceperiod.ProfileValidator = graceperiodDescProfile.Validators[0].(func(string) error)
+	// graceperiodDescCreatedAt is the schema descriptor for created_at field.
+	graceperiodDescCreatedAt := graceperiodFields[7].Descriptor()
+	// graceperiod.DefaultCreatedAt holds the default value on creation for the created_at field.
+	graceperiod.DefaultCreatedAt = graceperiodDescCreatedAt.Default.(func() time.Time)
+	// graceperiodDescUpdatedAt is the schema descriptor for updated_at field.
+	graceperiodDescUpdatedAt := graceperiodFields[8].Descriptor()
+	// graceperiod.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	graceperiod.DefaultUpdatedAt = graceperiodDescUpdatedAt.Default.(func() time.Time)
+	// graceperiod.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	graceperiod.UpdateDefaultUpdatedAt = graceperiodDescUpdatedAt.UpdateDefault.(func() time.Time)
 	imageMixin := schema.Image{}.Mixin()
 	imageMixinFields0 := imageMixin[0].Fields()
 	_ = imageMixinFields0
@@ -430,12 +718,110 @@ func init() {
 	invitationDescConfirmationCode := invitationFields[1].Descriptor()
 	// invitation.ConfirmationCodeValidator is a validator for the "confirmation_code" field. It is called by the builders before save.
 	invitation.ConfirmationCodeValidator = invitationDescConfirmationCode.Validators[0].(func(string) error)
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescNumber is the schema descriptor for number field.
+	invoiceDescNumber := invoiceFields[0].Descriptor()
+	// invoice.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	invoice.NumberValidator = invoiceDescNumber.Validators[0].(func(string) error)
+	// invoiceDescClientID is the schema descriptor for client_id field.
+	invoiceDescClientID := invoiceFields[1].Descriptor()
+	// invoice.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	invoice.ClientIDValidator = invoiceDescClientID.Validators[0].(func(int) error)
+	// invoiceDescClientUsername is the schema descriptor for client_username field.
+	invoiceDescClientUsername := invoiceFields[2].Descriptor()
+	// invoice.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	invoice.ClientUsernameValidator = invoiceDescClientUsername.Validators[0].(func(string) error)
+	// invoiceDescNetAmount is the schema descriptor for net_amount field.
+	invoiceDescNetAmount := invoiceFields[6].Descriptor()
+	// invoice.DefaultNetAmount holds the default value on creation for the net_amount field.
+	invoice.DefaultNetAmount = invoiceDescNetAmount.Default.(float64)
+	// invoiceDescTaxAmount is the schema descriptor for tax_amount field.
+	invoiceDescTaxAmount := invoiceFields[7].Descriptor()
+	// invoice.DefaultTaxAmount holds the default value on creation for the tax_amount field.
+	invoice.DefaultTaxAmount = invoiceDescTaxAmount.Default.(float64)
+	// invoiceDescPaidAmount is the schema descriptor for paid_amount field.
+	invoiceDescPaidAmount := invoiceFields[8].Descriptor()
+	// invoice.DefaultPaidAmount holds the default value on creation for the paid_amount field.
+	invoice.DefaultPaidAmount = invoiceDescPaidAmount.Default.(float64)
+	// invoiceDescTransactionRef is the schema descriptor for transaction_ref field.
+	invoiceDescTransactionRef := invoiceFields[12].Descriptor()
+	// invoice.TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	invoice.TransactionRefValidator = invoiceDescTransactionRef.Validators[0].(func(string) error)
+	// invoiceDescCreatedAt is the schema descriptor for created_at field.
+	invoiceDescCreatedAt := invoiceFields[13].Descriptor()
+	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
+	// invoiceDescUpdatedAt is the schema descriptor for updated_at field.
+	invoiceDescUpdatedAt := invoiceFields[14].Descriptor()
+	// invoice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	invoice.DefaultUpdatedAt = invoiceDescUpdatedAt.Default.(func() time.Time)
+	// invoice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	invoice.UpdateDefaultUpdatedAt = invoiceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	invoicelineFields := schema.InvoiceLine{}.Fields()
+	_ = invoicelineFields
+	// invoicelineDescInvoiceID is the schema descriptor for invoice_id field.
+	invoicelineDescInvoiceID := invoicelineFields[0].Descriptor()
+	// invoiceline.InvoiceIDValidator is a validator for the "invoice_id" field. It is called by the builders before save.
+	invoiceline.InvoiceIDValidator = invoicelineDescInvoiceID.Validators[0].(func(int) error)
+	// invoicelineDescDescription is the schema descriptor for description field.
+	invoicelineDescDescription := invoicelineFields[2].Descriptor()
+	// invoiceline.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	invoiceline.DescriptionValidator = invoicelineDescDescription.Validators[0].(func(string) error)
+	// invoicelineDescNetAmount is the schema descriptor for net_amount field.
+	invoicelineDescNetAmount := invoicelineFields[4].Descriptor()
+	// invoiceline.DefaultNetAmount holds the default value on creation for the net_amount field.
+	invoiceline.DefaultNetAmount = invoicelineDescNetAmount.Default.(float64)
+	// invoicelineDescTaxAmount is the schema descriptor for tax_amount field.
+	invoicelineDescTaxAmount := invoicelineFields[5].Descriptor()
+	// invoiceline.DefaultTaxAmount holds the default value on creation for the tax_amount field.
+	invoiceline.DefaultTaxAmount = invoicelineDescTaxAmount.Default.(float64)
+	// invoicelineDescTaxRate is the schema descriptor for tax_rate field.
+	invoicelineDescTaxRate := invoicelineFields[6].Descriptor()
+	// invoiceline.DefaultTaxRate holds the default value on creation for the tax_rate field.
+	invoiceline.DefaultTaxRate = invoicelineDescTaxRate.Default.(float64)
 	lastseenonlineFields := schema.LastSeenOnline{}.Fields()
 	_ = lastseenonlineFields
 	// lastseenonlineDescSeenAt is the schema descriptor for seen_at field.
 	lastseenonlineDescSeenAt := lastseenonlineFields[0].Descriptor()
 	// lastseenonline.DefaultSeenAt holds the default value on creation for the seen_at field.
 	lastseenonline.DefaultSeenAt = lastseenonlineDescSeenAt.Default.(func() time.Time)
+	manualpaymentFields := schema.ManualPayment{}.Fields()
+	_ = manualpaymentFields
+	// manualpaymentDescTransactionRef is the schema descriptor for transaction_ref field.
+	manualpaymentDescTransactionRef := manualpaymentFields[0].Descriptor()
+	// manualpayment.TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	manualpayment.TransactionRefValidator = manualpaymentDescTransactionRef.Validators[0].(func(string) error)
+	// manualpaymentDescClientUsername is the schema descriptor for client_username field.
+	manualpaymentDescClientUsername := manualpaymentFields[1].Descriptor()
+	// manualpayment.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	manualpayment.ClientUsernameValidator = manualpaymentDescClientUsername.Validators[0].(func(string) error)
+	// manualpaymentDescSenderNumber is the schema descriptor for sender_number field.
+	manualpaymentDescSenderNumber := manualpaymentFields[3].Descriptor()
+	// manualpayment.SenderNumberValidator is a validator for the "sender_number" field. It is called by the builders before save.
+	manualpayment.SenderNumberValidator = manualpaymentDescSenderNumber.Validators[0].(func(string) error)
+	// manualpaymentDescTrxID is the schema descriptor for trx_id field.
+	manualpaymentDescTrxID := manualpaymentFields[4].Descriptor()
+	// manualpayment.TrxIDValidator is a validator for the "trx_id" field. It is called by the builders before save.
+	manualpayment.TrxIDValidator = manualpaymentDescTrxID.Validators[0].(func(string) error)
+	// manualpaymentDescTrxKey is the schema descriptor for trx_key field.
+	manualpaymentDescTrxKey := manualpaymentFields[5].Descriptor()
+	// manualpayment.TrxKeyValidator is a validator for the "trx_key" field. It is called by the builders before save.
+	manualpayment.TrxKeyValidator = manualpaymentDescTrxKey.Validators[0].(func(string) error)
+	// manualpaymentDescVerifiedBy is the schema descriptor for verified_by field.
+	manualpaymentDescVerifiedBy := manualpaymentFields[8].Descriptor()
+	// manualpayment.VerifiedByValidator is a validator for the "verified_by" field. It is called by the builders before save.
+	manualpayment.VerifiedByValidator = manualpaymentDescVerifiedBy.Validators[0].(func(string) error)
+	// manualpaymentDescCreatedAt is the schema descriptor for created_at field.
+	manualpaymentDescCreatedAt := manualpaymentFields[10].Descriptor()
+	// manualpayment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	manualpayment.DefaultCreatedAt = manualpaymentDescCreatedAt.Default.(func() time.Time)
+	// manualpaymentDescUpdatedAt is the schema descriptor for updated_at field.
+	manualpaymentDescUpdatedAt := manualpaymentFields[11].Descriptor()
+	// manualpayment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	manualpayment.DefaultUpdatedAt = manualpaymentDescUpdatedAt.Default.(func() time.Time)
+	// manualpayment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	manualpayment.UpdateDefaultUpdatedAt = manualpaymentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	monthlysubscriptionMixin := schema.MonthlySubscription{}.Mixin()
 	monthlysubscriptionMixinFields0 := monthlysubscriptionMixin[0].Fields()
 	_ = monthlysubscriptionMixinFields0
@@ -592,14 +978,34 @@ func init() {
 	packageplanDescIsActive := packageplanFields[6].Descriptor()
 	// packageplan.DefaultIsActive holds the default value on creation for the is_active field.
 	packageplan.DefaultIsActive = packageplanDescIsActive.Default.(bool)
+	// packageplanDescGraceDays is the schema descriptor for grace_days field.
+	packageplanDescGraceDays := packageplanFields[7].Descriptor()
+	// packageplan.DefaultGraceDays holds the default value on creation for the grace_days field.
+	packageplan.DefaultGraceDays = packageplanDescGraceDays.Default.(int)
+	// packageplan.GraceDaysValidator is a validator for the "grace_days" field. It is called by the builders before save.
+	packageplan.GraceDaysValidator = packageplanDescGraceDays.Validators[0].(func(int) error)
 	// packageplanDescCreatedDate is the schema descriptor for created_date field.
-	packageplanDescCreatedDate := packageplanFields[7].Descriptor()
+	packageplanDescCreatedDate := packageplanFields[8].Descriptor()
 	// packageplan.DefaultCreatedDate holds the default value on creation for the created_date field.
 	packageplan.DefaultCreatedDate = packageplanDescCreatedDate.Default.(func() time.Time)
 	// packageplanDescID is the schema descriptor for id field.
 	packageplanDescID := packageplanFields[0].Descriptor()
 	// packageplan.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	packageplan.IDValidator = packageplanDescID.Validators[0].(func(int) error)
+	payrequestFields := schema.PayRequest{}.Fields()
+	_ = payrequestFields
+	// payrequestDescIP is the schema descriptor for ip field.
+	payrequestDescIP := payrequestFields[0].Descriptor()
+	// payrequest.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	payrequest.IPValidator = payrequestDescIP.Validators[0].(func(string) error)
+	// payrequestDescClientUsername is the schema descriptor for client_username field.
+	payrequestDescClientUsername := payrequestFields[2].Descriptor()
+	// payrequest.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	payrequest.ClientUsernameValidator = payrequestDescClientUsername.Validators[0].(func(string) error)
+	// payrequestDescCreatedAt is the schema descriptor for created_at field.
+	payrequestDescCreatedAt := payrequestFields[3].Descriptor()
+	// payrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payrequest.DefaultCreatedAt = payrequestDescCreatedAt.Default.(func() time.Time)
 	phoneverificationcodeMixin := schema.PhoneVerificationCode{}.Mixin()
 	phoneverificationcodeMixinFields0 := phoneverificationcodeMixin[0].Fields()
 	_ = phoneverificationcodeMixinFields0
@@ -676,13 +1082,183 @@ func init() {
 	// radacct.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	radacct.UsernameValidator = radacctDescUsername.Validators[0].(func(string) error)
 	// radacctDescFramedipaddress is the schema descriptor for framedipaddress field.
-	radacctDescFramedipaddress := radacctFields[9].Descriptor()
+	radacctDescFramedipaddress := radacctFields[10].Descriptor()
 	// radacct.FramedipaddressValidator is a validator for the "framedipaddress" field. It is called by the builders before save.
 	radacct.FramedipaddressValidator = radacctDescFramedipaddress.Validators[0].(func(string) error)
+	// radacctDescNasipaddress is the schema descriptor for nasipaddress field.
+	radacctDescNasipaddress := radacctFields[11].Descriptor()
+	// radacct.DefaultNasipaddress holds the default value on creation for the nasipaddress field.
+	radacct.DefaultNasipaddress = radacctDescNasipaddress.Default.(string)
+	// radacct.NasipaddressValidator is a validator for the "nasipaddress" field. It is called by the builders before save.
+	radacct.NasipaddressValidator = radacctDescNasipaddress.Validators[0].(func(string) error)
 	// radacctDescAcctterminatecause is the schema descriptor for acctterminatecause field.
-	radacctDescAcctterminatecause := radacctFields[10].Descriptor()
+	radacctDescAcctterminatecause := radacctFields[12].Descriptor()
 	// radacct.AcctterminatecauseValidator is a validator for the "acctterminatecause" field. It is called by the builders before save.
 	radacct.AcctterminatecauseValidator = radacctDescAcctterminatecause.Validators[0].(func(string) error)
+	radcheckFields := schema.RadCheck{}.Fields()
+	_ = radcheckFields
+	// radcheckDescUsername is the schema descriptor for username field.
+	radcheckDescUsername := radcheckFields[1].Descriptor()
+	// radcheck.DefaultUsername holds the default value on creation for the username field.
+	radcheck.DefaultUsername = radcheckDescUsername.Default.(string)
+	// radcheck.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	radcheck.UsernameValidator = radcheckDescUsername.Validators[0].(func(string) error)
+	// radcheckDescAttribute is the schema descriptor for attribute field.
+	radcheckDescAttribute := radcheckFields[2].Descriptor()
+	// radcheck.DefaultAttribute holds the default value on creation for the attribute field.
+	radcheck.DefaultAttribute = radcheckDescAttribute.Default.(string)
+	// radcheck.AttributeValidator is a validator for the "attribute" field. It is called by the builders before save.
+	radcheck.AttributeValidator = radcheckDescAttribute.Validators[0].(func(string) error)
+	// radcheckDescOp is the schema descriptor for op field.
+	radcheckDescOp := radcheckFields[3].Descriptor()
+	// radcheck.DefaultOp holds the default value on creation for the op field.
+	radcheck.DefaultOp = radcheckDescOp.Default.(string)
+	// radcheck.OpValidator is a validator for the "op" field. It is called by the builders before save.
+	radcheck.OpValidator = radcheckDescOp.Validators[0].(func(string) error)
+	// radcheckDescValue is the schema descriptor for value field.
+	radcheckDescValue := radcheckFields[4].Descriptor()
+	// radcheck.DefaultValue holds the default value on creation for the value field.
+	radcheck.DefaultValue = radcheckDescValue.Default.(string)
+	// radcheck.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	radcheck.ValueValidator = radcheckDescValue.Validators[0].(func(string) error)
+	radgroupreplyFields := schema.RadGroupReply{}.Fields()
+	_ = radgroupreplyFields
+	// radgroupreplyDescGroupname is the schema descriptor for groupname field.
+	radgroupreplyDescGroupname := radgroupreplyFields[1].Descriptor()
+	// radgroupreply.DefaultGroupname holds the default value on creation for the groupname field.
+	radgroupreply.DefaultGroupname = radgroupreplyDescGroupname.Default.(string)
+	// radgroupreply.GroupnameValidator is a validator for the "groupname" field. It is called by the builders before save.
+	radgroupreply.GroupnameValidator = radgroupreplyDescGroupname.Validators[0].(func(string) error)
+	// radgroupreplyDescAttribute is the schema descriptor for attribute field.
+	radgroupreplyDescAttribute := radgroupreplyFields[2].Descriptor()
+	// radgroupreply.DefaultAttribute holds the default value on creation for the attribute field.
+	radgroupreply.DefaultAttribute = radgroupreplyDescAttribute.Default.(string)
+	// radgroupreply.AttributeValidator is a validator for the "attribute" field. It is called by the builders before save.
+	radgroupreply.AttributeValidator = radgroupreplyDescAttribute.Validators[0].(func(string) error)
+	// radgroupreplyDescOp is the schema descriptor for op field.
+	radgroupreplyDescOp := radgroupreplyFields[3].Descriptor()
+	// radgroupreply.DefaultOp holds the default value on creation for the op field.
+	radgroupreply.DefaultOp = radgroupreplyDescOp.Default.(string)
+	// radgroupreply.OpValidator is a validator for the "op" field. It is called by the builders before save.
+	radgroupreply.OpValidator = radgroupreplyDescOp.Validators[0].(func(string) error)
+	// radgroupreplyDescValue is the schema descriptor for value field.
+	radgroupreplyDescValue := radgroupreplyFields[4].Descriptor()
+	// radgroupreply.DefaultValue holds the default value on creation for the value field.
+	radgroupreply.DefaultValue = radgroupreplyDescValue.Default.(string)
+	// radgroupreply.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	radgroupreply.ValueValidator = radgroupreplyDescValue.Validators[0].(func(string) error)
+	radreplyFields := schema.RadReply{}.Fields()
+	_ = radreplyFields
+	// radreplyDescUsername is the schema descriptor for username field.
+	radreplyDescUsername := radreplyFields[1].Descriptor()
+	// radreply.DefaultUsername holds the default value on creation for the username field.
+	radreply.DefaultUsername = radreplyDescUsername.Default.(string)
+	// radreply.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	radreply.UsernameValidator = radreplyDescUsername.Validators[0].(func(string) error)
+	// radreplyDescAttribute is the schema descriptor for attribute field.
+	radreplyDescAttribute := radreplyFields[2].Descriptor()
+	// radreply.DefaultAttribute holds the default value on creation for the attribute field.
+	radreply.DefaultAttribute = radreplyDescAttribute.Default.(string)
+	// radreply.AttributeValidator is a validator for the "attribute" field. It is called by the builders before save.
+	radreply.AttributeValidator = radreplyDescAttribute.Validators[0].(func(string) error)
+	// radreplyDescOp is the schema descriptor for op field.
+	radreplyDescOp := radreplyFields[3].Descriptor()
+	// radreply.DefaultOp holds the default value on creation for the op field.
+	radreply.DefaultOp = radreplyDescOp.Default.(string)
+	// radreply.OpValidator is a validator for the "op" field. It is called by the builders before save.
+	radreply.OpValidator = radreplyDescOp.Validators[0].(func(string) error)
+	// radreplyDescValue is the schema descriptor for value field.
+	radreplyDescValue := radreplyFields[4].Descriptor()
+	// radreply.DefaultValue holds the default value on creation for the value field.
+	radreply.DefaultValue = radreplyDescValue.Default.(string)
+	// radreply.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	radreply.ValueValidator = radreplyDescValue.Validators[0].(func(string) error)
+	radusergroupFields := schema.RadUserGroup{}.Fields()
+	_ = radusergroupFields
+	// radusergroupDescUsername is the schema descriptor for username field.
+	radusergroupDescUsername := radusergroupFields[1].Descriptor()
+	// radusergroup.DefaultUsername holds the default value on creation for the username field.
+	radusergroup.DefaultUsername = radusergroupDescUsername.Default.(string)
+	// radusergroup.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	radusergroup.UsernameValidator = radusergroupDescUsername.Validators[0].(func(string) error)
+	// radusergroupDescGroupname is the schema descriptor for groupname field.
+	radusergroupDescGroupname := radusergroupFields[2].Descriptor()
+	// radusergroup.DefaultGroupname holds the default value on creation for the groupname field.
+	radusergroup.DefaultGroupname = radusergroupDescGroupname.Default.(string)
+	// radusergroup.GroupnameValidator is a validator for the "groupname" field. It is called by the builders before save.
+	radusergroup.GroupnameValidator = radusergroupDescGroupname.Validators[0].(func(string) error)
+	// radusergroupDescPriority is the schema descriptor for priority field.
+	radusergroupDescPriority := radusergroupFields[3].Descriptor()
+	// radusergroup.DefaultPriority holds the default value on creation for the priority field.
+	radusergroup.DefaultPriority = radusergroupDescPriority.Default.(int)
+	refundrequestFields := schema.RefundRequest{}.Fields()
+	_ = refundrequestFields
+	// refundrequestDescClientID is the schema descriptor for client_id field.
+	refundrequestDescClientID := refundrequestFields[0].Descriptor()
+	// refundrequest.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	refundrequest.ClientIDValidator = refundrequestDescClientID.Validators[0].(func(int) error)
+	// refundrequestDescClientUsername is the schema descriptor for client_username field.
+	refundrequestDescClientUsername := refundrequestFields[1].Descriptor()
+	// refundrequest.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	refundrequest.ClientUsernameValidator = func() func(string) error {
+		validators := refundrequestDescClientUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_username string) error {
+			for _, fn := range fns {
+				if err := fn(client_username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// refundrequestDescChargeRef is the schema descriptor for charge_ref field.
+	refundrequestDescChargeRef := refundrequestFields[5].Descriptor()
+	// refundrequest.ChargeRefValidator is a validator for the "charge_ref" field. It is called by the builders before save.
+	refundrequest.ChargeRefValidator = refundrequestDescChargeRef.Validators[0].(func(string) error)
+	// refundrequestDescPayoutAccount is the schema descriptor for payout_account field.
+	refundrequestDescPayoutAccount := refundrequestFields[7].Descriptor()
+	// refundrequest.PayoutAccountValidator is a validator for the "payout_account" field. It is called by the builders before save.
+	refundrequest.PayoutAccountValidator = func() func(string) error {
+		validators := refundrequestDescPayoutAccount.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(payout_account string) error {
+			for _, fn := range fns {
+				if err := fn(payout_account); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// refundrequestDescRefundRef is the schema descriptor for refund_ref field.
+	refundrequestDescRefundRef := refundrequestFields[9].Descriptor()
+	// refundrequest.RefundRefValidator is a validator for the "refund_ref" field. It is called by the builders before save.
+	refundrequest.RefundRefValidator = refundrequestDescRefundRef.Validators[0].(func(string) error)
+	// refundrequestDescPayoutRef is the schema descriptor for payout_ref field.
+	refundrequestDescPayoutRef := refundrequestFields[10].Descriptor()
+	// refundrequest.PayoutRefValidator is a validator for the "payout_ref" field. It is called by the builders before save.
+	refundrequest.PayoutRefValidator = refundrequestDescPayoutRef.Validators[0].(func(string) error)
+	// refundrequestDescReviewedBy is the schema descriptor for reviewed_by field.
+	refundrequestDescReviewedBy := refundrequestFields[12].Descriptor()
+	// refundrequest.ReviewedByValidator is a validator for the "reviewed_by" field. It is called by the builders before save.
+	refundrequest.ReviewedByValidator = refundrequestDescReviewedBy.Validators[0].(func(string) error)
+	// refundrequestDescCreatedAt is the schema descriptor for created_at field.
+	refundrequestDescCreatedAt := refundrequestFields[13].Descriptor()
+	// refundrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refundrequest.DefaultCreatedAt = refundrequestDescCreatedAt.Default.(func() time.Time)
+	// refundrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	refundrequestDescUpdatedAt := refundrequestFields[14].Descriptor()
+	// refundrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	refundrequest.DefaultUpdatedAt = refundrequestDescUpdatedAt.Default.(func() time.Time)
+	// refundrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	refundrequest.UpdateDefaultUpdatedAt = refundrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
 	sentemailMixin := schema.SentEmail{}.Mixin()
 	sentemailMixinFields0 := sentemailMixin[0].Fields()
 	_ = sentemailMixinFields0
@@ -698,6 +1274,54 @@ func init() {
 	sentemail.DefaultUpdatedAt = sentemailDescUpdatedAt.Default.(func() time.Time)
 	// sentemail.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	sentemail.UpdateDefaultUpdatedAt = sentemailDescUpdatedAt.UpdateDefault.(func() time.Time)
+	settlementrowFields := schema.SettlementRow{}.Fields()
+	_ = settlementrowFields
+	// settlementrowDescTransactionRef is the schema descriptor for transaction_ref field.
+	settlementrowDescTransactionRef := settlementrowFields[1].Descriptor()
+	// settlementrow.TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	settlementrow.TransactionRefValidator = settlementrowDescTransactionRef.Validators[0].(func(string) error)
+	// settlementrowDescGatewayRef is the schema descriptor for gateway_ref field.
+	settlementrowDescGatewayRef := settlementrowFields[2].Descriptor()
+	// settlementrow.GatewayRefValidator is a validator for the "gateway_ref" field. It is called by the builders before save.
+	settlementrow.GatewayRefValidator = settlementrowDescGatewayRef.Validators[0].(func(string) error)
+	// settlementrowDescFee is the schema descriptor for fee field.
+	settlementrowDescFee := settlementrowFields[4].Descriptor()
+	// settlementrow.DefaultFee holds the default value on creation for the fee field.
+	settlementrow.DefaultFee = settlementrowDescFee.Default.(float64)
+	// settlementrowDescSource is the schema descriptor for source field.
+	settlementrowDescSource := settlementrowFields[8].Descriptor()
+	// settlementrow.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	settlementrow.SourceValidator = settlementrowDescSource.Validators[0].(func(string) error)
+	// settlementrowDescImportedBy is the schema descriptor for imported_by field.
+	settlementrowDescImportedBy := settlementrowFields[9].Descriptor()
+	// settlementrow.ImportedByValidator is a validator for the "imported_by" field. It is called by the builders before save.
+	settlementrow.ImportedByValidator = settlementrowDescImportedBy.Validators[0].(func(string) error)
+	// settlementrowDescCreatedAt is the schema descriptor for created_at field.
+	settlementrowDescCreatedAt := settlementrowFields[10].Descriptor()
+	// settlementrow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	settlementrow.DefaultCreatedAt = settlementrowDescCreatedAt.Default.(func() time.Time)
+	statemententryFields := schema.StatementEntry{}.Fields()
+	_ = statemententryFields
+	// statemententryDescTrxID is the schema descriptor for trx_id field.
+	statemententryDescTrxID := statemententryFields[1].Descriptor()
+	// statemententry.TrxIDValidator is a validator for the "trx_id" field. It is called by the builders before save.
+	statemententry.TrxIDValidator = statemententryDescTrxID.Validators[0].(func(string) error)
+	// statemententryDescSenderNumber is the schema descriptor for sender_number field.
+	statemententryDescSenderNumber := statemententryFields[3].Descriptor()
+	// statemententry.SenderNumberValidator is a validator for the "sender_number" field. It is called by the builders before save.
+	statemententry.SenderNumberValidator = statemententryDescSenderNumber.Validators[0].(func(string) error)
+	// statemententryDescPaymentRef is the schema descriptor for payment_ref field.
+	statemententryDescPaymentRef := statemententryFields[5].Descriptor()
+	// statemententry.PaymentRefValidator is a validator for the "payment_ref" field. It is called by the builders before save.
+	statemententry.PaymentRefValidator = statemententryDescPaymentRef.Validators[0].(func(string) error)
+	// statemententryDescImportedBy is the schema descriptor for imported_by field.
+	statemententryDescImportedBy := statemententryFields[6].Descriptor()
+	// statemententry.ImportedByValidator is a validator for the "imported_by" field. It is called by the builders before save.
+	statemententry.ImportedByValidator = statemententryDescImportedBy.Validators[0].(func(string) error)
+	// statemententryDescCreatedAt is the schema descriptor for created_at field.
+	statemententryDescCreatedAt := statemententryFields[7].Descriptor()
+	// statemententry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	statemententry.DefaultCreatedAt = statemententryDescCreatedAt.Default.(func() time.Time)
 	ticketFields := schema.Ticket{}.Fields()
 	_ = ticketFields
 	// ticketDescSubject is the schema descriptor for subject field.
@@ -740,6 +1364,52 @@ func init() {
 	ticket.DefaultUpdatedAt = ticketDescUpdatedAt.Default.(func() time.Time)
 	// ticket.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	ticket.UpdateDefaultUpdatedAt = ticketDescUpdatedAt.UpdateDefault.(func() time.Time)
+	usagecursorFields := schema.UsageCursor{}.Fields()
+	_ = usagecursorFields
+	// usagecursorDescAcctuniqueid is the schema descriptor for acctuniqueid field.
+	usagecursorDescAcctuniqueid := usagecursorFields[0].Descriptor()
+	// usagecursor.AcctuniqueidValidator is a validator for the "acctuniqueid" field. It is called by the builders before save.
+	usagecursor.AcctuniqueidValidator = usagecursorDescAcctuniqueid.Validators[0].(func(string) error)
+	// usagecursorDescUsername is the schema descriptor for username field.
+	usagecursorDescUsername := usagecursorFields[1].Descriptor()
+	// usagecursor.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	usagecursor.UsernameValidator = usagecursorDescUsername.Validators[0].(func(string) error)
+	// usagecursorDescInputOctets is the schema descriptor for input_octets field.
+	usagecursorDescInputOctets := usagecursorFields[2].Descriptor()
+	// usagecursor.DefaultInputOctets holds the default value on creation for the input_octets field.
+	usagecursor.DefaultInputOctets = usagecursorDescInputOctets.Default.(int64)
+	// usagecursorDescOutputOctets is the schema descriptor for output_octets field.
+	usagecursorDescOutputOctets := usagecursorFields[3].Descriptor()
+	// usagecursor.DefaultOutputOctets holds the default value on creation for the output_octets field.
+	usagecursor.DefaultOutputOctets = usagecursorDescOutputOctets.Default.(int64)
+	usagedailyFields := schema.UsageDaily{}.Fields()
+	_ = usagedailyFields
+	// usagedailyDescUsername is the schema descriptor for username field.
+	usagedailyDescUsername := usagedailyFields[0].Descriptor()
+	// usagedaily.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	usagedaily.UsernameValidator = usagedailyDescUsername.Validators[0].(func(string) error)
+	// usagedailyDescInputOctets is the schema descriptor for input_octets field.
+	usagedailyDescInputOctets := usagedailyFields[2].Descriptor()
+	// usagedaily.DefaultInputOctets holds the default value on creation for the input_octets field.
+	usagedaily.DefaultInputOctets = usagedailyDescInputOctets.Default.(int64)
+	// usagedailyDescOutputOctets is the schema descriptor for output_octets field.
+	usagedailyDescOutputOctets := usagedailyFields[3].Descriptor()
+	// usagedaily.DefaultOutputOctets holds the default value on creation for the output_octets field.
+	usagedaily.DefaultOutputOctets = usagedailyDescOutputOctets.Default.(int64)
+	usagehourlyFields := schema.UsageHourly{}.Fields()
+	_ = usagehourlyFields
+	// usagehourlyDescUsername is the schema descriptor for username field.
+	usagehourlyDescUsername := usagehourlyFields[0].Descriptor()
+	// usagehourly.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	usagehourly.UsernameValidator = usagehourlyDescUsername.Validators[0].(func(string) error)
+	// usagehourlyDescInputOctets is the schema descriptor for input_octets field.
+	usagehourlyDescInputOctets := usagehourlyFields[2].Descriptor()
+	// usagehourly.DefaultInputOctets holds the default value on creation for the input_octets field.
+	usagehourly.DefaultInputOctets = usagehourlyDescInputOctets.Default.(int64)
+	// usagehourlyDescOutputOctets is the schema descriptor for output_octets field.
+	usagehourlyDescOutputOctets := usagehourlyFields[3].Descriptor()
+	// usagehourly.DefaultOutputOctets holds the default value on creation for the output_octets field.
+	usagehourly.DefaultOutputOctets = usagehourlyDescOutputOctets.Default.(int64)
 	userMixin := schema.User{}.Mixin()
 	userHooks := schema.User{}.Hooks()
 	user.Hooks[0] = userHooks[0]
@@ -773,6 +1443,178 @@ func init() {
 	userDescStatus := userFields[5].Descriptor()
 	// user.DefaultStatus holds the default value on creation for the status field.
 	user.DefaultStatus = userDescStatus.Default.(string)
+	vendorFields := schema.Vendor{}.Fields()
+	_ = vendorFields
+	// vendorDescName is the schema descriptor for name field.
+	vendorDescName := vendorFields[1].Descriptor()
+	// vendor.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	vendor.NameValidator = func() func(string) error {
+		validators := vendorDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// vendorDescCName is the schema descriptor for c_name field.
+	vendorDescCName := vendorFields[2].Descriptor()
+	// vendor.CNameValidator is a validator for the "c_name" field. It is called by the builders before save.
+	vendor.CNameValidator = func() func(string) error {
+		validators := vendorDescCName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(c_name string) error {
+			for _, fn := range fns {
+				if err := fn(c_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// vendorDescMobileNumber is the schema descriptor for mobile_number field.
+	vendorDescMobileNumber := vendorFields[3].Descriptor()
+	// vendor.MobileNumberValidator is a validator for the "mobile_number" field. It is called by the builders before save.
+	vendor.MobileNumberValidator = vendorDescMobileNumber.Validators[0].(func(string) error)
+	// vendorDescBalance is the schema descriptor for balance field.
+	vendorDescBalance := vendorFields[4].Descriptor()
+	// vendor.DefaultBalance holds the default value on creation for the balance field.
+	vendor.DefaultBalance = vendorDescBalance.Default.(float64)
+	// vendorDescIsActive is the schema descriptor for is_active field.
+	vendorDescIsActive := vendorFields[5].Descriptor()
+	// vendor.DefaultIsActive holds the default value on creation for the is_active field.
+	vendor.DefaultIsActive = vendorDescIsActive.Default.(bool)
+	// vendorDescCreatedDate is the schema descriptor for created_date field.
+	vendorDescCreatedDate := vendorFields[6].Descriptor()
+	// vendor.DefaultCreatedDate holds the default value on creation for the created_date field.
+	vendor.DefaultCreatedDate = vendorDescCreatedDate.Default.(func() time.Time)
+	// vendorDescID is the schema descriptor for id field.
+	vendorDescID := vendorFields[0].Descriptor()
+	// vendor.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	vendor.IDValidator = vendorDescID.Validators[0].(func(int) error)
+	vendorcommissionFields := schema.VendorCommission{}.Fields()
+	_ = vendorcommissionFields
+	// vendorcommissionDescValue is the schema descriptor for value field.
+	vendorcommissionDescValue := vendorcommissionFields[3].Descriptor()
+	// vendorcommission.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	vendorcommission.ValueValidator = vendorcommissionDescValue.Validators[0].(func(float64) error)
+	// vendorcommissionDescUpdatedBy is the schema descriptor for updated_by field.
+	vendorcommissionDescUpdatedBy := vendorcommissionFields[4].Descriptor()
+	// vendorcommission.UpdatedByValidator is a validator for the "updated_by" field. It is called by the builders before save.
+	vendorcommission.UpdatedByValidator = vendorcommissionDescUpdatedBy.Validators[0].(func(string) error)
+	// vendorcommissionDescUpdatedAt is the schema descriptor for updated_at field.
+	vendorcommissionDescUpdatedAt := vendorcommissionFields[5].Descriptor()
+	// vendorcommission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vendorcommission.DefaultUpdatedAt = vendorcommissionDescUpdatedAt.Default.(func() time.Time)
+	// vendorcommission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vendorcommission.UpdateDefaultUpdatedAt = vendorcommissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	vendortxnFields := schema.VendorTxn{}.Fields()
+	_ = vendortxnFields
+	// vendortxnDescTransactionRef is the schema descriptor for transaction_ref field.
+	vendortxnDescTransactionRef := vendortxnFields[0].Descriptor()
+	// vendortxn.TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	vendortxn.TransactionRefValidator = vendortxnDescTransactionRef.Validators[0].(func(string) error)
+	// vendortxnDescClientUsername is the schema descriptor for client_username field.
+	vendortxnDescClientUsername := vendortxnFields[5].Descriptor()
+	// vendortxn.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	vendortxn.ClientUsernameValidator = vendortxnDescClientUsername.Validators[0].(func(string) error)
+	// vendortxnDescClientTxnRef is the schema descriptor for client_txn_ref field.
+	vendortxnDescClientTxnRef := vendortxnFields[6].Descriptor()
+	// vendortxn.ClientTxnRefValidator is a validator for the "client_txn_ref" field. It is called by the builders before save.
+	vendortxn.ClientTxnRefValidator = vendortxnDescClientTxnRef.Validators[0].(func(string) error)
+	// vendortxnDescPrice is the schema descriptor for price field.
+	vendortxnDescPrice := vendortxnFields[8].Descriptor()
+	// vendortxn.DefaultPrice holds the default value on creation for the price field.
+	vendortxn.DefaultPrice = vendortxnDescPrice.Default.(float64)
+	// vendortxnDescCommission is the schema descriptor for commission field.
+	vendortxnDescCommission := vendortxnFields[9].Descriptor()
+	// vendortxn.DefaultCommission holds the default value on creation for the commission field.
+	vendortxn.DefaultCommission = vendortxnDescCommission.Default.(float64)
+	// vendortxnDescCreatedBy is the schema descriptor for created_by field.
+	vendortxnDescCreatedBy := vendortxnFields[11].Descriptor()
+	// vendortxn.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	vendortxn.CreatedByValidator = vendortxnDescCreatedBy.Validators[0].(func(string) error)
+	// vendortxnDescCreatedAt is the schema descriptor for created_at field.
+	vendortxnDescCreatedAt := vendortxnFields[12].Descriptor()
+	// vendortxn.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vendortxn.DefaultCreatedAt = vendortxnDescCreatedAt.Default.(func() time.Time)
+	voucherFields := schema.Voucher{}.Fields()
+	_ = voucherFields
+	// voucherDescBatchID is the schema descriptor for batch_id field.
+	voucherDescBatchID := voucherFields[0].Descriptor()
+	// voucher.BatchIDValidator is a validator for the "batch_id" field. It is called by the builders before save.
+	voucher.BatchIDValidator = voucherDescBatchID.Validators[0].(func(int) error)
+	// voucherDescSerial is the schema descriptor for serial field.
+	voucherDescSerial := voucherFields[1].Descriptor()
+	// voucher.SerialValidator is a validator for the "serial" field. It is called by the builders before save.
+	voucher.SerialValidator = voucherDescSerial.Validators[0].(func(string) error)
+	// voucherDescPinHash is the schema descriptor for pin_hash field.
+	voucherDescPinHash := voucherFields[2].Descriptor()
+	// voucher.PinHashValidator is a validator for the "pin_hash" field. It is called by the builders before save.
+	voucher.PinHashValidator = voucherDescPinHash.Validators[0].(func(string) error)
+	// voucherDescRedeemedBy is the schema descriptor for redeemed_by field.
+	voucherDescRedeemedBy := voucherFields[6].Descriptor()
+	// voucher.RedeemedByValidator is a validator for the "redeemed_by" field. It is called by the builders before save.
+	voucher.RedeemedByValidator = voucherDescRedeemedBy.Validators[0].(func(string) error)
+	// voucherDescTransactionRef is the schema descriptor for transaction_ref field.
+	voucherDescTransactionRef := voucherFields[8].Descriptor()
+	// voucher.TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	voucher.TransactionRefValidator = voucherDescTransactionRef.Validators[0].(func(string) error)
+	// voucherDescCreatedAt is the schema descriptor for created_at field.
+	voucherDescCreatedAt := voucherFields[9].Descriptor()
+	// voucher.DefaultCreatedAt holds the default value on creation for the created_at field.
+	voucher.DefaultCreatedAt = voucherDescCreatedAt.Default.(func() time.Time)
+	voucherattemptFields := schema.VoucherAttempt{}.Fields()
+	_ = voucherattemptFields
+	// voucherattemptDescClientUsername is the schema descriptor for client_username field.
+	voucherattemptDescClientUsername := voucherattemptFields[0].Descriptor()
+	// voucherattempt.ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	voucherattempt.ClientUsernameValidator = voucherattemptDescClientUsername.Validators[0].(func(string) error)
+	// voucherattemptDescIP is the schema descriptor for ip field.
+	voucherattemptDescIP := voucherattemptFields[1].Descriptor()
+	// voucherattempt.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	voucherattempt.IPValidator = voucherattemptDescIP.Validators[0].(func(string) error)
+	// voucherattemptDescCreatedAt is the schema descriptor for created_at field.
+	voucherattemptDescCreatedAt := voucherattemptFields[2].Descriptor()
+	// voucherattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	voucherattempt.DefaultCreatedAt = voucherattemptDescCreatedAt.Default.(func() time.Time)
+	voucherbatchFields := schema.VoucherBatch{}.Fields()
+	_ = voucherbatchFields
+	// voucherbatchDescName is the schema descriptor for name field.
+	voucherbatchDescName := voucherbatchFields[0].Descriptor()
+	// voucherbatch.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	voucherbatch.NameValidator = func() func(string) error {
+		validators := voucherbatchDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// voucherbatchDescCreatedBy is the schema descriptor for created_by field.
+	voucherbatchDescCreatedBy := voucherbatchFields[4].Descriptor()
+	// voucherbatch.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	voucherbatch.CreatedByValidator = voucherbatchDescCreatedBy.Validators[0].(func(string) error)
+	// voucherbatchDescCreatedAt is the schema descriptor for created_at field.
+	voucherbatchDescCreatedAt := voucherbatchFields[5].Descriptor()
+	// voucherbatch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	voucherbatch.DefaultCreatedAt = voucherbatchDescCreatedAt.Default.(func() time.Time)
 }
 
 const (
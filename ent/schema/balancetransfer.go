@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BalanceTransfer holds the schema definition for the BalanceTransfer entity.
+type BalanceTransfer struct {
+	ent.Schema
+}
+
+// Fields of the BalanceTransfer.
+func (BalanceTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("from_username").
+			NotEmpty().
+			MaxLen(255),
+		field.String("to_username").
+			NotEmpty().
+			MaxLen(255),
+		field.Float("amount"),
+		field.String("code_hash").
+			MaxLen(64).
+			Sensitive().
+			Comment("SHA-256 of the one-time code sent to the sender"),
+		field.Int("attempts").
+			Default(0).
+			Comment("Wrong codes entered so far"),
+		field.Enum("status").
+			Values("pending", "completed", "cancelled", "expired").
+			Default("pending"),
+		field.String("debit_ref").
+			Optional().
+			MaxLen(64).
+			Comment("transaction_ref of the sender's TRANSFER_REFUND"),
+		field.String("credit_ref").
+			Optional().
+			MaxLen(64).
+			Comment("transaction_ref of the recipient's TRANSFER_RECEIVED"),
+		field.Time("expires_at"),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the BalanceTransfer.
+func (BalanceTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("from_username", "status", "completed_at"),
+		index.Fields("to_username"),
+		index.Fields("created_at"),
+	}
+}
+
+// Edges of the BalanceTransfer.
+func (BalanceTransfer) Edges() []ent.Edge {
+	return nil
+}
@@ -57,7 +57,18 @@ func (ClientUser) Fields() []ent.Field {
 		// Financial
 		field.Float("balance").
 			Default(0.00).
-			Min(0),
+			Comment("Negative only for postpaid clients, whose debt is billed monthly"),
+		field.Enum("billing_mode").
+			Values("prepaid", "postpaid").
+			Default("prepaid"),
+		field.Float("credit_limit").
+			Default(0.00).
+			Min(0).
+			Comment("How far below zero the balance of a postpaid client may be charged"),
+		field.Time("postpaid_since").
+			Optional().
+			Nillable().
+			Comment("When the client switched to postpaid, their first invoice is prorated from then"),
 
 		// Address Information
 		field.String("address_line1").
@@ -84,8 +95,9 @@ func (ClientUser) Fields() []ent.Field {
 
 		// Status and Payment
 		field.Enum("status").
-			Values("active", "inactive").
-			Default("inactive"),
+			Values("active", "inactive", "warning", "throttled", "suspended").
+			Default("inactive").
+			Comment("warning, throttled and suspended are the stages of the grace period after expiry"),
 		field.Time("payment_date").
 			Optional().
 			Nillable(),
@@ -35,7 +35,7 @@ func (ClientTxn) Fields() []ent.Field {
 			StorageKey("balance"). // The database column is 'balance' but it represents the transaction amount
 			Default(0.00),
 		field.Enum("type").
-			Values("ACTIVE", "RENEWAL", "REFUND", "TRANSFER_REFUND", "TRANSFER_RECEIVED", "AUTO_RENEWAL", "PACKAGE_MIGRATION", "ADVANCE_PAYMENT"),
+			Values("ACTIVE", "RENEWAL", "REFUND", "TRANSFER_REFUND", "TRANSFER_RECEIVED", "AUTO_RENEWAL", "PACKAGE_MIGRATION", "ADVANCE_PAYMENT", "RECHARGE", "ADJUSTMENT", "POSTPAID_INVOICE"),
 		field.Enum("status").
 			Values("pending", "completed", "failed", "reversed").
 			Default("completed"),
@@ -45,9 +45,35 @@ func (ClientTxn) Fields() []ent.Field {
 			Values("vendor_balance", "client_balance", "cash", "bank_transfer", "mobile_banking", "card", "gateway_sslcommerz", "gateway_bkash", "gateway_nagad", "gateway_stripe", "gateway_paypal", "free", "other").
 			Optional().
 			Nillable(),
+		field.String("gateway_ref").
+			Optional().
+			MaxLen(128).
+			Comment("Transaction ID assigned by the payment gateway, if any"),
 		field.String("client_username").
 			Optional().
 			MaxLen(255),
+		field.Float("discount").
+			Default(0.00).
+			Comment("Taken off the price by a coupon, amount is what was charged after it"),
+		field.String("coupon_code").
+			Optional().
+			MaxLen(64),
+		field.Float("net_amount").
+			Default(0.00).
+			Comment("Amount before tax of a charge for a service, 0 for payments and other balance movements"),
+		field.Float("tax_amount").
+			Default(0.00).
+			Comment("Tax included in amount, which is the gross"),
+		field.Float("tax_rate").
+			Default(0.00),
+		field.Enum("service_type").
+			Values("internet", "addon").
+			Optional().
+			Nillable().
+			Comment("Service a prepaid charge was for. Postpaid invoices mix services, see their lines."),
+		field.Int("service_days").
+			Default(0).
+			Comment("Days of service a renewal or package change paid for, 0 for other transactions"),
 		field.String("description").
 			Optional(),
 		field.Time("transaction_date").
@@ -65,6 +91,7 @@ func (ClientTxn) Indexes() []ent.Index {
 		index.Fields("status"),
 		index.Fields("type"),
 		index.Fields("transaction_date"),
+		index.Fields("gateway_ref"),
 	}
 }
 
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ClientAddon holds the schema definition for the ClientAddon entity, a monthly charge billed to
+// a postpaid client on top of their package, e.g. a static IP.
+type ClientAddon struct {
+	ent.Schema
+}
+
+// Fields of the ClientAddon.
+func (ClientAddon) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("client_id").
+			Positive(),
+		field.String("client_username").
+			MaxLen(255),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.Float("amount").
+			Positive(),
+		field.Bool("active").
+			Default(true),
+		field.String("created_by").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the ClientAddon.
+func (ClientAddon) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id", "active"),
+	}
+}
+
+// Edges of the ClientAddon.
+func (ClientAddon) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Coupon holds the schema definition for the Coupon entity.
+type Coupon struct {
+	ent.Schema
+}
+
+// Fields of the Coupon.
+func (Coupon) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			Unique().
+			NotEmpty().
+			MaxLen(64).
+			Comment("Stored in upper case, clients may enter it in any case"),
+		field.String("description").
+			Optional().
+			MaxLen(255),
+		field.Enum("discount_type").
+			Values("percent", "fixed"),
+		field.Float("value").
+			Comment("Percentage off for percent coupons, amount off for fixed ones"),
+		field.Time("starts_at").
+			Optional().
+			Nillable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Int("max_uses").
+			Default(0).
+			Comment("Redemptions allowed across all clients, 0 for no limit"),
+		field.Int("max_uses_per_client").
+			Default(1).
+			Comment("Redemptions allowed per client, 0 for no limit"),
+		field.Int("used_count").
+			Default(0),
+		field.JSON("plan_ids", []int{}).
+			Optional().
+			Comment("Packages the coupon applies to, any package when empty"),
+		field.Strings("districts").
+			Optional().
+			Comment("Client districts the coupon applies to, any district when empty"),
+		field.Bool("is_active").
+			Default(true),
+		field.String("created_by").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Coupon.
+func (Coupon) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("is_active", "expires_at"),
+	}
+}
+
+// Edges of the Coupon.
+func (Coupon) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CouponRedemption holds the schema definition for the CouponRedemption entity.
+type CouponRedemption struct {
+	ent.Schema
+}
+
+// Fields of the CouponRedemption.
+func (CouponRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("coupon_id").
+			Positive(),
+		field.String("code").
+			MaxLen(64),
+		field.Int("client_id").
+			Positive(),
+		field.String("client_username").
+			MaxLen(255),
+		field.String("transaction_ref").
+			Unique().
+			MaxLen(64).
+			Comment("transaction_ref of the ClientTxn the discount was applied to"),
+		field.Float("discount"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the CouponRedemption.
+func (CouponRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("coupon_id", "client_id"),
+	}
+}
+
+// Edges of the CouponRedemption.
+func (CouponRedemption) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DunningStep holds the schema definition for the DunningStep entity, one reminder of the dunning
+// schedule sent to a client about a package expiry or an unpaid invoice. A row is claimed before
+// the reminder goes out, so each step is sent once per expiry or invoice.
+type DunningStep struct {
+	ent.Schema
+}
+
+// Fields of the DunningStep.
+func (DunningStep) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("client_id").
+			Positive(),
+		field.String("client_username").
+			MaxLen(255),
+		field.Enum("kind").
+			Values("expiry", "invoice"),
+		field.String("reference").
+			MaxLen(64).
+			Comment("Expiry cycle or invoice number the reminder is about"),
+		field.Time("due_at").
+			Comment("Package expiry or invoice due date the step is counted from"),
+		field.Int("offset_days").
+			Comment("Day of the step relative to due_at, negative before it"),
+		field.Enum("level").
+			Values("reminder", "due", "overdue", "final"),
+		field.Float("amount").
+			Comment("Amount the client was asked to pay"),
+		field.String("subject").
+			MaxLen(255),
+		field.Text("message"),
+		field.Enum("status").
+			Values("pending", "sent", "failed").
+			Default("pending"),
+		field.String("channels").
+			MaxLen(64).
+			Optional().
+			Comment("Comma separated channels the reminder reached, e.g. email,sms,push"),
+		field.String("error").
+			MaxLen(1000).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("sent_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the DunningStep.
+func (DunningStep) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id", "kind", "reference", "offset_days").
+			Unique(),
+		index.Fields("client_username", "created_at"),
+	}
+}
+
+// Edges of the DunningStep.
+func (DunningStep) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GracePeriod holds the schema definition for the GracePeriod entity, the time an expired client
+// is kept connected before being suspended. While it is open the radcheck Expiration is pushed to
+// the end of the grace period, so expired_at keeps the expiry that was actually paid for.
+type GracePeriod struct {
+	ent.Schema
+}
+
+// Fields of the GracePeriod.
+func (GracePeriod) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("client_id").
+			Positive(),
+		field.String("client_username").
+			MaxLen(255),
+		field.Time("expired_at").
+			Comment("Expiry of the last paid cycle"),
+		field.Time("grace_until").
+			Comment("When the client is suspended unless they pay"),
+		field.Enum("stage").
+			Values("warning", "throttled", "suspended"),
+		field.String("profile").
+			MaxLen(100).
+			Comment("RADIUS group of the client's package, restored when they pay"),
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			Comment("When the client paid and was reactivated"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the GracePeriod.
+func (GracePeriod) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id", "expired_at").
+			Unique(),
+		index.Fields("client_username", "ended_at"),
+		index.Fields("ended_at", "stage"),
+	}
+}
+
+// Edges of the GracePeriod.
+func (GracePeriod) Edges() []ent.Edge {
+	return nil
+}
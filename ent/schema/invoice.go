@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Invoice holds the schema definition for the Invoice entity, the monthly bill of a postpaid
+// client. Issuing it charges the total to their balance; payments settle the oldest open invoices
+// first.
+type Invoice struct {
+	ent.Schema
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number").
+			Unique().
+			MaxLen(32),
+		field.Int("client_id").
+			Positive(),
+		field.String("client_username").
+			MaxLen(255),
+		field.Time("period_start").
+			Comment("First moment of the billed month"),
+		field.Time("period_end").
+			Comment("First moment after the billed month"),
+		field.Float("amount").
+			Comment("Gross, including tax_amount"),
+		field.Float("net_amount").
+			Default(0.00),
+		field.Float("tax_amount").
+			Default(0.00),
+		field.Float("paid_amount").
+			Default(0.00),
+		field.Enum("status").
+			Values("open", "paid", "overdue", "void").
+			Default("open"),
+		field.Time("due_at"),
+		field.Time("paid_at").
+			Optional().
+			Nillable(),
+		field.String("transaction_ref").
+			MaxLen(64).
+			Comment("POSTPAID_INVOICE transaction that charged the invoice to the balance"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Invoice.
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id", "period_start").
+			Unique(),
+		index.Fields("client_username", "status"),
+		index.Fields("status", "due_at"),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// InvoiceLine holds the schema definition for the InvoiceLine entity, one charge on an invoice.
+type InvoiceLine struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceLine.
+func (InvoiceLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("invoice_id").
+			Positive(),
+		field.Enum("kind").
+			Values("package", "addon"),
+		field.String("description").
+			MaxLen(255),
+		field.Float("amount").
+			Comment("Gross, including tax_amount"),
+		field.Float("net_amount").
+			Default(0.00),
+		field.Float("tax_amount").
+			Default(0.00),
+		field.Float("tax_rate").
+			Default(0.00),
+	}
+}
+
+// Indexes of the InvoiceLine.
+func (InvoiceLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("invoice_id"),
+	}
+}
+
+// Edges of the InvoiceLine.
+func (InvoiceLine) Edges() []ent.Edge {
+	return nil
+}
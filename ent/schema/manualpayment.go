@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ManualPayment holds the schema definition for the ManualPayment entity, money a client sent to
+// the merchant bKash or Nagad number and reported with its TrxID.
+type ManualPayment struct {
+	ent.Schema
+}
+
+// Fields of the ManualPayment.
+func (ManualPayment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("transaction_ref").
+			Unique().
+			MaxLen(64).
+			Comment("transaction_ref of the pending RECHARGE created for the submission"),
+		field.String("client_username").
+			MaxLen(255),
+		field.Enum("provider").
+			Values("bkash", "nagad"),
+		field.String("sender_number").
+			MaxLen(32),
+		field.String("trx_id").
+			MaxLen(64).
+			Comment("Transaction ID issued by the provider, stored in upper case"),
+		field.String("trx_key").
+			Optional().
+			Nillable().
+			MaxLen(64).
+			Comment("trx_id while the payment may still be credited. Cleared on rejection so the TrxID can be submitted again."),
+		field.Float("amount"),
+		field.Enum("status").
+			Values("pending", "mismatched", "completed", "failed").
+			Default("pending").
+			Comment("mismatched payments are on a statement with another amount or sender and wait for an operator"),
+		field.String("verified_by").
+			Optional().
+			MaxLen(255).
+			Comment("Operator who reviewed the payment, or statement when it was matched automatically"),
+		field.Text("review_note").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ManualPayment.
+func (ManualPayment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "trx_id"),
+		index.Fields("provider", "trx_key").
+			Unique(),
+		index.Fields("client_username"),
+		index.Fields("status"),
+	}
+}
+
+// Edges of the ManualPayment.
+func (ManualPayment) Edges() []ent.Edge {
+	return nil
+}
@@ -44,6 +44,10 @@ func (PackagePlan) Fields() []ent.Field {
 			MaxLen(3),
 		field.Bool("is_active").
 			Default(true),
+		field.Int("grace_days").
+			Default(0).
+			NonNegative().
+			Comment("Days an expired client stays connected, first warned and then throttled, before being suspended"),
 		field.Time("created_date").
 			Default(time.Now).
 			Immutable(),
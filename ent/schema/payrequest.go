@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PayRequest holds the schema definition for the PayRequest entity, a lookup or payment started on
+// the public page where anyone can pay for a client. They are kept to rate limit the page by IP,
+// since it tells whether a username exists.
+type PayRequest struct {
+	ent.Schema
+}
+
+// Fields of the PayRequest.
+func (PayRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("ip").
+			MaxLen(64),
+		field.Enum("kind").
+			Values("lookup", "checkout"),
+		field.String("client_username").
+			Optional().
+			MaxLen(255).
+			Comment("Client looked up or paid for, empty when the username did not match anyone"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the PayRequest.
+func (PayRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ip", "created_at"),
+	}
+}
+
+// Edges of the PayRequest.
+func (PayRequest) Edges() []ent.Edge {
+	return nil
+}
@@ -36,6 +36,9 @@ func (RadAcct) Fields() []ent.Field {
 		field.Time("acctstarttime").
 			Optional().
 			Nillable(),
+		field.Time("acctupdatetime").
+			Optional().
+			Nillable(),
 		field.Time("acctstoptime").
 			Optional().
 			Nillable(),
@@ -50,6 +53,9 @@ func (RadAcct) Fields() []ent.Field {
 			Nillable(),
 		field.String("framedipaddress").
 			MaxLen(15),
+		field.String("nasipaddress").
+			Default("").
+			MaxLen(15),
 		field.String("acctterminatecause").
 			MaxLen(32),
 	}
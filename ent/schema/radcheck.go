@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RadCheck holds the schema definition for the RadCheck entity. It is a FreeRADIUS check attribute
+// of a user, e.g. their Cleartext-Password or Expiration.
+type RadCheck struct {
+	ent.Schema
+}
+
+// Annotations of the RadCheck.
+func (RadCheck) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "radcheck"},
+	}
+}
+
+// Fields of the RadCheck.
+func (RadCheck) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			SchemaType(map[string]string{dialect.MySQL: "int unsigned"}),
+		field.String("username").
+			Default("").
+			MaxLen(64),
+		field.String("attribute").
+			Default("").
+			MaxLen(64),
+		field.String("op").
+			Default("==").
+			MaxLen(2).
+			SchemaType(map[string]string{dialect.MySQL: "char(2)"}),
+		field.String("value").
+			Default("").
+			MaxLen(253),
+	}
+}
+
+// Indexes of the RadCheck.
+func (RadCheck) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username").
+			Annotations(entsql.Prefix(32)),
+	}
+}
+
+// Edges of the RadCheck.
+func (RadCheck) Edges() []ent.Edge {
+	return nil
+}
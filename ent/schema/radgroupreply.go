@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RadGroupReply holds the schema definition for the RadGroupReply entity. It is a FreeRADIUS reply
+// attribute of every user in a group, e.g. the Mikrotik-Rate-Limit of a package profile.
+type RadGroupReply struct {
+	ent.Schema
+}
+
+// Annotations of the RadGroupReply.
+func (RadGroupReply) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "radgroupreply"},
+	}
+}
+
+// Fields of the RadGroupReply.
+func (RadGroupReply) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			SchemaType(map[string]string{dialect.MySQL: "int unsigned"}),
+		field.String("groupname").
+			Default("").
+			MaxLen(64),
+		field.String("attribute").
+			Default("").
+			MaxLen(64),
+		field.String("op").
+			Default("=").
+			MaxLen(2).
+			SchemaType(map[string]string{dialect.MySQL: "char(2)"}),
+		field.String("value").
+			Default("").
+			MaxLen(253),
+	}
+}
+
+// Indexes of the RadGroupReply.
+func (RadGroupReply) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("groupname").
+			Annotations(entsql.Prefix(32)),
+	}
+}
+
+// Edges of the RadGroupReply.
+func (RadGroupReply) Edges() []ent.Edge {
+	return nil
+}
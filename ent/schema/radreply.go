@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RadReply holds the schema definition for the RadReply entity. It is a FreeRADIUS reply attribute
+// of a user, e.g. their Framed-IP-Address.
+type RadReply struct {
+	ent.Schema
+}
+
+// Annotations of the RadReply.
+func (RadReply) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "radreply"},
+	}
+}
+
+// Fields of the RadReply.
+func (RadReply) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			SchemaType(map[string]string{dialect.MySQL: "int unsigned"}),
+		field.String("username").
+			Default("").
+			MaxLen(64),
+		field.String("attribute").
+			Default("").
+			MaxLen(64),
+		field.String("op").
+			Default("=").
+			MaxLen(2).
+			SchemaType(map[string]string{dialect.MySQL: "char(2)"}),
+		field.String("value").
+			Default("").
+			MaxLen(253),
+	}
+}
+
+// Indexes of the RadReply.
+func (RadReply) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username").
+			Annotations(entsql.Prefix(32)),
+	}
+}
+
+// Edges of the RadReply.
+func (RadReply) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RadUserGroup holds the schema definition for the RadUserGroup entity. It puts a FreeRADIUS user
+// in a group, which is named after the profile of their package.
+type RadUserGroup struct {
+	ent.Schema
+}
+
+// Annotations of the RadUserGroup.
+func (RadUserGroup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "radusergroup"},
+	}
+}
+
+// Fields of the RadUserGroup.
+func (RadUserGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			SchemaType(map[string]string{dialect.MySQL: "int unsigned"}),
+		field.String("username").
+			Default("").
+			MaxLen(64),
+		field.String("groupname").
+			Default("").
+			MaxLen(64),
+		field.Int("priority").
+			Default(1).
+			SchemaType(map[string]string{dialect.MySQL: "int"}),
+	}
+}
+
+// Indexes of the RadUserGroup.
+func (RadUserGroup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username").
+			Annotations(entsql.Prefix(32)),
+	}
+}
+
+// Edges of the RadUserGroup.
+func (RadUserGroup) Edges() []ent.Edge {
+	return nil
+}
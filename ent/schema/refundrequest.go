@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RefundRequest holds the schema definition for the RefundRequest entity.
+type RefundRequest struct {
+	ent.Schema
+}
+
+// Fields of the RefundRequest.
+func (RefundRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("client_id").
+			Positive(),
+		field.String("client_username").
+			NotEmpty().
+			MaxLen(255),
+		field.Enum("kind").
+			Values("balance", "failed_charge").
+			Comment("balance pays out unused balance, failed_charge returns a payment that never reached the balance"),
+		field.Float("amount"),
+		field.Enum("status").
+			Values("requested", "approved", "paid", "rejected").
+			Default("requested"),
+		field.String("charge_ref").
+			Optional().
+			MaxLen(64).
+			Comment("transaction_ref of the failed charge, for failed_charge refunds"),
+		field.Enum("payout_method").
+			Values("bkash", "nagad", "bank_transfer"),
+		field.String("payout_account").
+			NotEmpty().
+			MaxLen(128),
+		field.Text("reason"),
+		field.String("refund_ref").
+			Optional().
+			MaxLen(64).
+			Comment("transaction_ref of the REFUND transaction"),
+		field.String("payout_ref").
+			Optional().
+			MaxLen(128).
+			Comment("Reference of the payout sent to the client"),
+		field.Text("review_note").
+			Optional(),
+		field.String("reviewed_by").
+			Optional().
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the RefundRequest.
+func (RefundRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_username"),
+		index.Fields("status"),
+		index.Fields("charge_ref"),
+	}
+}
+
+// Edges of the RefundRequest.
+func (RefundRequest) Edges() []ent.Edge {
+	return nil
+}
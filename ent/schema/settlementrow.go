@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SettlementRow holds the schema definition for the SettlementRow entity, one payment a gateway
+// settled to us as listed in its settlement file, matched against the client transaction it pays.
+type SettlementRow struct {
+	ent.Schema
+}
+
+// Fields of the SettlementRow.
+func (SettlementRow) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("gateway").
+			Values("bkash", "nagad", "sslcommerz", "stripe"),
+		field.String("transaction_ref").
+			MaxLen(64).
+			Comment("Our transaction_ref as reported by the gateway"),
+		field.String("gateway_ref").
+			Optional().
+			MaxLen(128),
+		field.Float("amount"),
+		field.Float("fee").
+			Default(0.00),
+		field.Time("settled_at"),
+		field.Enum("status").
+			Values("matched", "unmatched", "amount_mismatch", "status_mismatch").
+			Comment("unmatched rows have no client transaction, status_mismatch ones pay a transaction that is not completed"),
+		field.Float("txn_amount").
+			Optional().
+			Nillable().
+			Comment("Amount of the matched client transaction"),
+		field.String("source").
+			MaxLen(255).
+			Comment("Name of the file the row was imported from"),
+		field.String("imported_by").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the SettlementRow.
+func (SettlementRow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("gateway", "transaction_ref").
+			Unique(),
+		index.Fields("settled_at"),
+		index.Fields("status"),
+	}
+}
+
+// Edges of the SettlementRow.
+func (SettlementRow) Edges() []ent.Edge {
+	return nil
+}
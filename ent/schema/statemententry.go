@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StatementEntry holds the schema definition for the StatementEntry entity, a payment received on
+// a merchant bKash or Nagad account as listed in a statement imported by an operator.
+type StatementEntry struct {
+	ent.Schema
+}
+
+// Fields of the StatementEntry.
+func (StatementEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("provider").
+			Values("bkash", "nagad"),
+		field.String("trx_id").
+			MaxLen(64),
+		field.Float("amount"),
+		field.String("sender_number").
+			Optional().
+			MaxLen(32),
+		field.Time("received_at").
+			Optional().
+			Nillable(),
+		field.String("payment_ref").
+			Optional().
+			MaxLen(64).
+			Comment("transaction_ref of the manual payment the entry confirmed"),
+		field.String("imported_by").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the StatementEntry.
+func (StatementEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "trx_id").
+			Unique(),
+	}
+}
+
+// Edges of the StatementEntry.
+func (StatementEntry) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// UsageCursor holds the schema definition for the UsageCursor entity, how much of an accounting
+// session has been rolled up into UsageHourly and UsageDaily. Each roll-up adds what the session's
+// counters grew by since, spread over the time since rolled_at.
+type UsageCursor struct {
+	ent.Schema
+}
+
+// Fields of the UsageCursor.
+func (UsageCursor) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("acctuniqueid").
+			Unique().
+			MaxLen(32),
+		field.String("username").
+			MaxLen(64),
+		field.Int64("input_octets").
+			Default(0),
+		field.Int64("output_octets").
+			Default(0),
+		field.Time("rolled_at").
+			Comment("Time of the accounting record the counters were last rolled up from"),
+	}
+}
+
+// Edges of the UsageCursor.
+func (UsageCursor) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UsageDaily holds the schema definition for the UsageDaily entity, the traffic of a client in
+// one day of local time, rolled up from their accounting records. Sessions that run past midnight
+// count towards both days.
+type UsageDaily struct {
+	ent.Schema
+}
+
+// Fields of the UsageDaily.
+func (UsageDaily) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			MaxLen(64),
+		field.Time("day").
+			Comment("Local midnight starting the day"),
+		field.Int64("input_octets").
+			Default(0).
+			Comment("Octets the client sent, Acct-Input-Octets"),
+		field.Int64("output_octets").
+			Default(0).
+			Comment("Octets the client received, Acct-Output-Octets"),
+	}
+}
+
+// Indexes of the UsageDaily.
+func (UsageDaily) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username", "day").
+			Unique(),
+		index.Fields("day"),
+	}
+}
+
+// Edges of the UsageDaily.
+func (UsageDaily) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UsageHourly holds the schema definition for the UsageHourly entity, the traffic of a client in
+// one hour of local time, rolled up from their accounting records.
+type UsageHourly struct {
+	ent.Schema
+}
+
+// Fields of the UsageHourly.
+func (UsageHourly) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			MaxLen(64),
+		field.Time("hour").
+			Comment("Start of the hour, in local time"),
+		field.Int64("input_octets").
+			Default(0).
+			Comment("Octets the client sent, Acct-Input-Octets"),
+		field.Int64("output_octets").
+			Default(0).
+			Comment("Octets the client received, Acct-Output-Octets"),
+	}
+}
+
+// Indexes of the UsageHourly.
+func (UsageHourly) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("username", "hour").
+			Unique(),
+		index.Fields("hour"),
+	}
+}
+
+// Edges of the UsageHourly.
+func (UsageHourly) Edges() []ent.Edge {
+	return nil
+}
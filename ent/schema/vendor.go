@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Vendor holds the schema definition for the Vendor entity, a reseller who activates and renews
+// clients on our network and pays for them from a prepaid wallet.
+type Vendor struct {
+	ent.Schema
+}
+
+// Annotations of the Vendor.
+func (Vendor) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vendors"},
+	}
+}
+
+// Fields of the Vendor.
+func (Vendor) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive().
+			Unique().
+			Comment("Matches clients.vendor_id"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.String("c_name").
+			NotEmpty().
+			MaxLen(32),
+		field.String("mobile_number").
+			Optional().
+			MaxLen(255),
+		field.Float("balance").
+			Default(0.00).
+			Comment("Wallet the vendor pays for activations and renewals from, never negative"),
+		field.Bool("is_active").
+			Default(true),
+		field.Time("created_date").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Vendor.
+func (Vendor) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("c_name"),
+	}
+}
+
+// Edges of the Vendor.
+func (Vendor) Edges() []ent.Edge {
+	return nil
+}
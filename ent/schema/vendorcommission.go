@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VendorCommission holds the schema definition for the VendorCommission entity, what a vendor keeps
+// of the price of a package when they activate or renew a client on it.
+type VendorCommission struct {
+	ent.Schema
+}
+
+// Annotations of the VendorCommission.
+func (VendorCommission) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vendor_commissions"},
+	}
+}
+
+// Fields of the VendorCommission.
+func (VendorCommission) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("package_id"),
+		field.Int("vendor_id").
+			Optional().
+			Nillable().
+			Comment("Vendor the rule is for, unset for the default of vendors without their own rule"),
+		field.Enum("commission_type").
+			Values("percent", "fixed"),
+		field.Float("value").
+			Min(0).
+			Comment("Percentage of the price for percent rules, amount per cycle for fixed ones"),
+		field.String("updated_by").
+			MaxLen(255),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the VendorCommission.
+func (VendorCommission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("package_id", "vendor_id").
+			Unique(),
+	}
+}
+
+// Edges of the VendorCommission.
+func (VendorCommission) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VendorTxn holds the schema definition for the VendorTxn entity, one movement of a vendor's wallet.
+type VendorTxn struct {
+	ent.Schema
+}
+
+// Annotations of the VendorTxn.
+func (VendorTxn) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vendor_txn"},
+	}
+}
+
+// Fields of the VendorTxn.
+func (VendorTxn) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("transaction_ref").
+			Unique().
+			MaxLen(64),
+		field.Int("vendor_id"),
+		field.Enum("type").
+			Values("TOPUP", "ACTIVE", "RENEWAL", "ADJUSTMENT"),
+		field.Float("amount").
+			Comment("Added to the wallet, negative for activations and renewals"),
+		field.Float("total_balance").
+			Comment("Wallet balance after the transaction"),
+		field.String("client_username").
+			Optional().
+			MaxLen(255),
+		field.String("client_txn_ref").
+			Optional().
+			MaxLen(64).
+			Comment("transaction_ref of the client transaction the vendor paid for"),
+		field.Int("package_id").
+			Optional().
+			Nillable(),
+		field.Float("price").
+			Default(0.00).
+			Comment("Package price the client was charged, amount is this less the commission"),
+		field.Float("commission").
+			Default(0.00),
+		field.String("description").
+			Optional(),
+		field.String("created_by").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the VendorTxn.
+func (VendorTxn) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vendor_id", "created_at"),
+		index.Fields("client_username"),
+	}
+}
+
+// Edges of the VendorTxn.
+func (VendorTxn) Edges() []ent.Edge {
+	return nil
+}
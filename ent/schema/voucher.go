@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Voucher holds the schema definition for the Voucher entity.
+type Voucher struct {
+	ent.Schema
+}
+
+// Fields of the Voucher.
+func (Voucher) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("batch_id").
+			Positive(),
+		field.String("serial").
+			Unique().
+			MaxLen(32).
+			Comment("Printed on the card next to the PIN, identifies the voucher without redeeming it"),
+		field.String("pin_hash").
+			Unique().
+			MaxLen(64).
+			Sensitive().
+			Comment("SHA-256 of the PIN, which is only ever shown in the batch export"),
+		field.Float("amount"),
+		field.Enum("status").
+			Values("active", "redeemed", "disabled").
+			Default("active"),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.String("redeemed_by").
+			Optional().
+			MaxLen(255),
+		field.Time("redeemed_at").
+			Optional().
+			Nillable(),
+		field.String("transaction_ref").
+			Optional().
+			MaxLen(64).
+			Comment("transaction_ref of the RECHARGE that credited the voucher"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Voucher.
+func (Voucher) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("batch_id", "status"),
+		index.Fields("redeemed_by"),
+	}
+}
+
+// Edges of the Voucher.
+func (Voucher) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VoucherAttempt holds the schema definition for the VoucherAttempt entity, a failed voucher
+// redemption kept to slow down PIN guessing.
+type VoucherAttempt struct {
+	ent.Schema
+}
+
+// Fields of the VoucherAttempt.
+func (VoucherAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("client_username").
+			MaxLen(255),
+		field.String("ip").
+			Optional().
+			MaxLen(64),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the VoucherAttempt.
+func (VoucherAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_username", "created_at"),
+		index.Fields("ip", "created_at"),
+	}
+}
+
+// Edges of the VoucherAttempt.
+func (VoucherAttempt) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// VoucherBatch holds the schema definition for the VoucherBatch entity.
+type VoucherBatch struct {
+	ent.Schema
+}
+
+// Fields of the VoucherBatch.
+func (VoucherBatch) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.Float("amount").
+			Comment("Denomination of every voucher in the batch"),
+		field.Int("count"),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.String("created_by").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the VoucherBatch.
+func (VoucherBatch) Edges() []ent.Edge {
+	return nil
+}
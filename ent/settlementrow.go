@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+)
+
+// SettlementRow is the model entity for the SettlementRow schema.
+type SettlementRow struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Gateway holds the value of the "gateway" field.
+	Gateway settlementrow.Gateway `json:"gateway,omitempty"`
+	// Our transaction_ref as reported by the gateway
+	TransactionRef string `json:"transaction_ref,omitempty"`
+	// GatewayRef holds the value of the "gateway_ref" field.
+	GatewayRef string `json:"gateway_ref,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee float64 `json:"fee,omitempty"`
+	// SettledAt holds the value of the "settled_at" field.
+	SettledAt time.Time `json:"settled_at,omitempty"`
+	// unmatched rows have no client transaction, status_mismatch ones pay a transaction that is not completed
+	Status settlementrow.Status `json:"status,omitempty"`
+	// Amount of the matched client transaction
+	TxnAmount *float64 `json:"txn_amount,omitempty"`
+	// Name of the file the row was imported from
+	Source string `json:"source,omitempty"`
+	// ImportedBy holds the value of the "imported_by" field.
+	ImportedBy string `json:"imported_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SettlementRow) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settlementrow.FieldAmount, settlementrow.FieldFee, settlementrow.FieldTxnAmount:
+			values[i] = new(sql.NullFloat64)
+		case settlementrow.FieldID:
+			values[i] = new(sql.NullInt64)
+		case settlementrow.FieldGateway, settlementrow.FieldTransactionRef, settlementrow.FieldGatewayRef, settlementrow.FieldStatus, settlementrow.FieldSource, settlementrow.FieldImportedBy:
+			values[i] = new(sql.NullString)
+		case settlementrow.FieldSettledAt, settlementrow.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SettlementRow fields.
+func (sr *SettlementRow) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settlementrow.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sr.ID = int(value.Int64)
+		case settlementrow.FieldGateway:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway", values[i])
+			} else if value.Valid {
+				sr.Gateway = settlementrow.Gateway(value.String)
+			}
+		case settlementrow.FieldTransactionRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_ref", values[i])
+			} else if value.Valid {
+				sr.TransactionRef = value.String
+			}
+		case settlementrow.FieldGatewayRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gateway_ref", values[i])
+			} else if value.Valid {
+				sr.GatewayRef = value.String
+			}
+		case settlementrow.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				sr.Amount = value.Float64
+			}
+		case settlementrow.FieldFee:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fee", values[i])
+			} else if value.Valid {
+				sr.Fee = value.Float64
+			}
+		case settlementrow.FieldSettledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field settled_at", values[i])
+			} else if value.Valid {
+				sr.SettledAt = value.Time
+			}
+		case settlementrow.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sr.Status = settlementrow.Status(value.String)
+			}
+		case settlementrow.FieldTxnAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field txn_amount", values[i])
+			} else if value.Valid {
+				sr.TxnAmount = new(float64)
+				*sr.TxnAmount = value.Float64
+			}
+		case settlementrow.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				sr.Source = value.String
+			}
+		case settlementrow.FieldImportedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field imported_by", values[i])
+			} else if value.Valid {
+				sr.ImportedBy = value.String
+			}
+		case settlementrow.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sr.CreatedAt = value.Time
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SettlementRow.
+// This includes values selected through modifiers, order, etc.
+func (sr *SettlementRow) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SettlementRow.
+// Note that you need to call SettlementRow.Unwrap() before calling this method if this SettlementRow
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *SettlementRow) Update() *SettlementRowUpdateOne {
+	return NewSettlementRowClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the SettlementRow entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *SettlementRow) Unwrap() *SettlementRow {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SettlementRow is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *SettlementRow) String() string {
+	var builder strings.Builder
+	builder.WriteString("SettlementRow(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("gateway=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Gateway))
+	builder.WriteString(", ")
+	builder.WriteString("transaction_ref=")
+	builder.WriteString(sr.TransactionRef)
+	builder.WriteString(", ")
+	builder.WriteString("gateway_ref=")
+	builder.WriteString(sr.GatewayRef)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("fee=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Fee))
+	builder.WriteString(", ")
+	builder.WriteString("settled_at=")
+	builder.WriteString(sr.SettledAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Status))
+	builder.WriteString(", ")
+	if v := sr.TxnAmount; v != nil {
+		builder.WriteString("txn_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(sr.Source)
+	builder.WriteString(", ")
+	builder.WriteString("imported_by=")
+	builder.WriteString(sr.ImportedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SettlementRows is a parsable slice of SettlementRow.
+type SettlementRows []*SettlementRow
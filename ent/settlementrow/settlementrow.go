@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlementrow
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the settlementrow type in the database.
+	Label = "settlement_row"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGateway holds the string denoting the gateway field in the database.
+	FieldGateway = "gateway"
+	// FieldTransactionRef holds the string denoting the transaction_ref field in the database.
+	FieldTransactionRef = "transaction_ref"
+	// FieldGatewayRef holds the string denoting the gateway_ref field in the database.
+	FieldGatewayRef = "gateway_ref"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldFee holds the string denoting the fee field in the database.
+	FieldFee = "fee"
+	// FieldSettledAt holds the string denoting the settled_at field in the database.
+	FieldSettledAt = "settled_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTxnAmount holds the string denoting the txn_amount field in the database.
+	FieldTxnAmount = "txn_amount"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldImportedBy holds the string denoting the imported_by field in the database.
+	FieldImportedBy = "imported_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the settlementrow in the database.
+	Table = "settlement_rows"
+)
+
+// Columns holds all SQL columns for settlementrow fields.
+var Columns = []string{
+	FieldID,
+	FieldGateway,
+	FieldTransactionRef,
+	FieldGatewayRef,
+	FieldAmount,
+	FieldFee,
+	FieldSettledAt,
+	FieldStatus,
+	FieldTxnAmount,
+	FieldSource,
+	FieldImportedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	TransactionRefValidator func(string) error
+	// GatewayRefValidator is a validator for the "gateway_ref" field. It is called by the builders before save.
+	GatewayRefValidator func(string) error
+	// DefaultFee holds the default value on creation for the "fee" field.
+	DefaultFee float64
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// ImportedByValidator is a validator for the "imported_by" field. It is called by the builders before save.
+	ImportedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Gateway defines the type for the "gateway" enum field.
+type Gateway string
+
+// Gateway values.
+const (
+	GatewayBkash      Gateway = "bkash"
+	GatewayNagad      Gateway = "nagad"
+	GatewaySslcommerz Gateway = "sslcommerz"
+	GatewayStripe     Gateway = "stripe"
+)
+
+func (ga Gateway) String() string {
+	return string(ga)
+}
+
+// GatewayValidator is a validator for the "gateway" field enum values. It is called by the builders before save.
+func GatewayValidator(ga Gateway) error {
+	switch ga {
+	case GatewayBkash, GatewayNagad, GatewaySslcommerz, GatewayStripe:
+		return nil
+	default:
+		return fmt.Errorf("settlementrow: invalid enum value for gateway field: %q", ga)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusMatched        Status = "matched"
+	StatusUnmatched      Status = "unmatched"
+	StatusAmountMismatch Status = "amount_mismatch"
+	StatusStatusMismatch Status = "status_mismatch"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusMatched, StatusUnmatched, StatusAmountMismatch, StatusStatusMismatch:
+		return nil
+	default:
+		return fmt.Errorf("settlementrow: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the SettlementRow queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGateway orders the results by the gateway field.
+func ByGateway(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGateway, opts...).ToFunc()
+}
+
+// ByTransactionRef orders the results by the transaction_ref field.
+func ByTransactionRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionRef, opts...).ToFunc()
+}
+
+// ByGatewayRef orders the results by the gateway_ref field.
+func ByGatewayRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGatewayRef, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByFee orders the results by the fee field.
+func ByFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFee, opts...).ToFunc()
+}
+
+// BySettledAt orders the results by the settled_at field.
+func BySettledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSettledAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTxnAmount orders the results by the txn_amount field.
+func ByTxnAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTxnAmount, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByImportedBy orders the results by the imported_by field.
+func ByImportedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package settlementrow
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldID, id))
+}
+
+// TransactionRef applies equality check predicate on the "transaction_ref" field. It's identical to TransactionRefEQ.
+func TransactionRef(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// GatewayRef applies equality check predicate on the "gateway_ref" field. It's identical to GatewayRefEQ.
+func GatewayRef(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldGatewayRef, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldAmount, v))
+}
+
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldFee, v))
+}
+
+// SettledAt applies equality check predicate on the "settled_at" field. It's identical to SettledAtEQ.
+func SettledAt(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// TxnAmount applies equality check predicate on the "txn_amount" field. It's identical to TxnAmountEQ.
+func TxnAmount(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldTxnAmount, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldSource, v))
+}
+
+// ImportedBy applies equality check predicate on the "imported_by" field. It's identical to ImportedByEQ.
+func ImportedBy(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldImportedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GatewayEQ applies the EQ predicate on the "gateway" field.
+func GatewayEQ(v Gateway) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldGateway, v))
+}
+
+// GatewayNEQ applies the NEQ predicate on the "gateway" field.
+func GatewayNEQ(v Gateway) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldGateway, v))
+}
+
+// GatewayIn applies the In predicate on the "gateway" field.
+func GatewayIn(vs ...Gateway) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldGateway, vs...))
+}
+
+// GatewayNotIn applies the NotIn predicate on the "gateway" field.
+func GatewayNotIn(vs ...Gateway) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldGateway, vs...))
+}
+
+// TransactionRefEQ applies the EQ predicate on the "transaction_ref" field.
+func TransactionRefEQ(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefNEQ applies the NEQ predicate on the "transaction_ref" field.
+func TransactionRefNEQ(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefIn applies the In predicate on the "transaction_ref" field.
+func TransactionRefIn(vs ...string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefNotIn applies the NotIn predicate on the "transaction_ref" field.
+func TransactionRefNotIn(vs ...string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefGT applies the GT predicate on the "transaction_ref" field.
+func TransactionRefGT(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldTransactionRef, v))
+}
+
+// TransactionRefGTE applies the GTE predicate on the "transaction_ref" field.
+func TransactionRefGTE(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldTransactionRef, v))
+}
+
+// TransactionRefLT applies the LT predicate on the "transaction_ref" field.
+func TransactionRefLT(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldTransactionRef, v))
+}
+
+// TransactionRefLTE applies the LTE predicate on the "transaction_ref" field.
+func TransactionRefLTE(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldTransactionRef, v))
+}
+
+// TransactionRefContains applies the Contains predicate on the "transaction_ref" field.
+func TransactionRefContains(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldContains(FieldTransactionRef, v))
+}
+
+// TransactionRefHasPrefix applies the HasPrefix predicate on the "transaction_ref" field.
+func TransactionRefHasPrefix(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldHasPrefix(FieldTransactionRef, v))
+}
+
+// TransactionRefHasSuffix applies the HasSuffix predicate on the "transaction_ref" field.
+func TransactionRefHasSuffix(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldHasSuffix(FieldTransactionRef, v))
+}
+
+// TransactionRefEqualFold applies the EqualFold predicate on the "transaction_ref" field.
+func TransactionRefEqualFold(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEqualFold(FieldTransactionRef, v))
+}
+
+// TransactionRefContainsFold applies the ContainsFold predicate on the "transaction_ref" field.
+func TransactionRefContainsFold(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldContainsFold(FieldTransactionRef, v))
+}
+
+// GatewayRefEQ applies the EQ predicate on the "gateway_ref" field.
+func GatewayRefEQ(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldGatewayRef, v))
+}
+
+// GatewayRefNEQ applies the NEQ predicate on the "gateway_ref" field.
+func GatewayRefNEQ(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldGatewayRef, v))
+}
+
+// GatewayRefIn applies the In predicate on the "gateway_ref" field.
+func GatewayRefIn(vs ...string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldGatewayRef, vs...))
+}
+
+// GatewayRefNotIn applies the NotIn predicate on the "gateway_ref" field.
+func GatewayRefNotIn(vs ...string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldGatewayRef, vs...))
+}
+
+// GatewayRefGT applies the GT predicate on the "gateway_ref" field.
+func GatewayRefGT(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldGatewayRef, v))
+}
+
+// GatewayRefGTE applies the GTE predicate on the "gateway_ref" field.
+func GatewayRefGTE(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldGatewayRef, v))
+}
+
+// GatewayRefLT applies the LT predicate on the "gateway_ref" field.
+func GatewayRefLT(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldGatewayRef, v))
+}
+
+// GatewayRefLTE applies the LTE predicate on the "gateway_ref" field.
+func GatewayRefLTE(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldGatewayRef, v))
+}
+
+// GatewayRefContains applies the Contains predicate on the "gateway_ref" field.
+func GatewayRefContains(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldContains(FieldGatewayRef, v))
+}
+
+// GatewayRefHasPrefix applies the HasPrefix predicate on the "gateway_ref" field.
+func GatewayRefHasPrefix(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldHasPrefix(FieldGatewayRef, v))
+}
+
+// GatewayRefHasSuffix applies the HasSuffix predicate on the "gateway_ref" field.
+func GatewayRefHasSuffix(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldHasSuffix(FieldGatewayRef, v))
+}
+
+// GatewayRefIsNil applies the IsNil predicate on the "gateway_ref" field.
+func GatewayRefIsNil() predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIsNull(FieldGatewayRef))
+}
+
+// GatewayRefNotNil applies the NotNil predicate on the "gateway_ref" field.
+func GatewayRefNotNil() predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotNull(FieldGatewayRef))
+}
+
+// GatewayRefEqualFold applies the EqualFold predicate on the "gateway_ref" field.
+func GatewayRefEqualFold(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEqualFold(FieldGatewayRef, v))
+}
+
+// GatewayRefContainsFold applies the ContainsFold predicate on the "gateway_ref" field.
+func GatewayRefContainsFold(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldContainsFold(FieldGatewayRef, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldAmount, v))
+}
+
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldFee, v))
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldFee, v))
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldFee, vs...))
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldFee, vs...))
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldFee, v))
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldFee, v))
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldFee, v))
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldFee, v))
+}
+
+// SettledAtEQ applies the EQ predicate on the "settled_at" field.
+func SettledAtEQ(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// SettledAtNEQ applies the NEQ predicate on the "settled_at" field.
+func SettledAtNEQ(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldSettledAt, v))
+}
+
+// SettledAtIn applies the In predicate on the "settled_at" field.
+func SettledAtIn(vs ...time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldSettledAt, vs...))
+}
+
+// SettledAtNotIn applies the NotIn predicate on the "settled_at" field.
+func SettledAtNotIn(vs ...time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldSettledAt, vs...))
+}
+
+// SettledAtGT applies the GT predicate on the "settled_at" field.
+func SettledAtGT(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldSettledAt, v))
+}
+
+// SettledAtGTE applies the GTE predicate on the "settled_at" field.
+func SettledAtGTE(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldSettledAt, v))
+}
+
+// SettledAtLT applies the LT predicate on the "settled_at" field.
+func SettledAtLT(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldSettledAt, v))
+}
+
+// SettledAtLTE applies the LTE predicate on the "settled_at" field.
+func SettledAtLTE(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldSettledAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TxnAmountEQ applies the EQ predicate on the "txn_amount" field.
+func TxnAmountEQ(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldTxnAmount, v))
+}
+
+// TxnAmountNEQ applies the NEQ predicate on the "txn_amount" field.
+func TxnAmountNEQ(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldTxnAmount, v))
+}
+
+// TxnAmountIn applies the In predicate on the "txn_amount" field.
+func TxnAmountIn(vs ...float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldTxnAmount, vs...))
+}
+
+// TxnAmountNotIn applies the NotIn predicate on the "txn_amount" field.
+func TxnAmountNotIn(vs ...float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldTxnAmount, vs...))
+}
+
+// TxnAmountGT applies the GT predicate on the "txn_amount" field.
+func TxnAmountGT(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldTxnAmount, v))
+}
+
+// TxnAmountGTE applies the GTE predicate on the "txn_amount" field.
+func TxnAmountGTE(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldTxnAmount, v))
+}
+
+// TxnAmountLT applies the LT predicate on the "txn_amount" field.
+func TxnAmountLT(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldTxnAmount, v))
+}
+
+// TxnAmountLTE applies the LTE predicate on the "txn_amount" field.
+func TxnAmountLTE(v float64) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldTxnAmount, v))
+}
+
+// TxnAmountIsNil applies the IsNil predicate on the "txn_amount" field.
+func TxnAmountIsNil() predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIsNull(FieldTxnAmount))
+}
+
+// TxnAmountNotNil applies the NotNil predicate on the "txn_amount" field.
+func TxnAmountNotNil() predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotNull(FieldTxnAmount))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ImportedByEQ applies the EQ predicate on the "imported_by" field.
+func ImportedByEQ(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldImportedBy, v))
+}
+
+// ImportedByNEQ applies the NEQ predicate on the "imported_by" field.
+func ImportedByNEQ(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldImportedBy, v))
+}
+
+// ImportedByIn applies the In predicate on the "imported_by" field.
+func ImportedByIn(vs ...string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldImportedBy, vs...))
+}
+
+// ImportedByNotIn applies the NotIn predicate on the "imported_by" field.
+func ImportedByNotIn(vs ...string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldImportedBy, vs...))
+}
+
+// ImportedByGT applies the GT predicate on the "imported_by" field.
+func ImportedByGT(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldImportedBy, v))
+}
+
+// ImportedByGTE applies the GTE predicate on the "imported_by" field.
+func ImportedByGTE(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldImportedBy, v))
+}
+
+// ImportedByLT applies the LT predicate on the "imported_by" field.
+func ImportedByLT(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldImportedBy, v))
+}
+
+// ImportedByLTE applies the LTE predicate on the "imported_by" field.
+func ImportedByLTE(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldImportedBy, v))
+}
+
+// ImportedByContains applies the Contains predicate on the "imported_by" field.
+func ImportedByContains(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldContains(FieldImportedBy, v))
+}
+
+// ImportedByHasPrefix applies the HasPrefix predicate on the "imported_by" field.
+func ImportedByHasPrefix(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldHasPrefix(FieldImportedBy, v))
+}
+
+// ImportedByHasSuffix applies the HasSuffix predicate on the "imported_by" field.
+func ImportedByHasSuffix(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldHasSuffix(FieldImportedBy, v))
+}
+
+// ImportedByEqualFold applies the EqualFold predicate on the "imported_by" field.
+func ImportedByEqualFold(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEqualFold(FieldImportedBy, v))
+}
+
+// ImportedByContainsFold applies the ContainsFold predicate on the "imported_by" field.
+func ImportedByContainsFold(v string) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldContainsFold(FieldImportedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SettlementRow) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SettlementRow) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SettlementRow) predicate.SettlementRow {
+	return predicate.SettlementRow(sql.NotPredicates(p))
+}
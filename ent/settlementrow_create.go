@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+)
+
+// SettlementRowCreate is the builder for creating a SettlementRow entity.
+type SettlementRowCreate struct {
+	config
+	mutation *SettlementRowMutation
+	hooks    []Hook
+}
+
+// SetGateway sets the "gateway" field.
+func (src *SettlementRowCreate) SetGateway(s settlementrow.Gateway) *SettlementRowCreate {
+	src.mutation.SetGateway(s)
+	return src
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (src *SettlementRowCreate) SetTransactionRef(s string) *SettlementRowCreate {
+	src.mutation.SetTransactionRef(s)
+	return src
+}
+
+// SetGatewayRef sets the "gateway_ref" field.
+func (src *SettlementRowCreate) SetGatewayRef(s string) *SettlementRowCreate {
+	src.mutation.SetGatewayRef(s)
+	return src
+}
+
+// SetNillableGatewayRef sets the "gateway_ref" field if the given value is not nil.
+func (src *SettlementRowCreate) SetNillableGatewayRef(s *string) *SettlementRowCreate {
+	if s != nil {
+		src.SetGatewayRef(*s)
+	}
+	return src
+}
+
+// SetAmount sets the "amount" field.
+func (src *SettlementRowCreate) SetAmount(f float64) *SettlementRowCreate {
+	src.mutation.SetAmount(f)
+	return src
+}
+
+// SetFee sets the "fee" field.
+func (src *SettlementRowCreate) SetFee(f float64) *SettlementRowCreate {
+	src.mutation.SetFee(f)
+	return src
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (src *SettlementRowCreate) SetNillableFee(f *float64) *SettlementRowCreate {
+	if f != nil {
+		src.SetFee(*f)
+	}
+	return src
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (src *SettlementRowCreate) SetSettledAt(t time.Time) *SettlementRowCreate {
+	src.mutation.SetSettledAt(t)
+	return src
+}
+
+// SetStatus sets the "status" field.
+func (src *SettlementRowCreate) SetStatus(s settlementrow.Status) *SettlementRowCreate {
+	src.mutation.SetStatus(s)
+	return src
+}
+
+// SetTxnAmount sets the "txn_amount" field.
+func (src *SettlementRowCreate) SetTxnAmount(f float64) *SettlementRowCreate {
+	src.mutation.SetTxnAmount(f)
+	return src
+}
+
+// SetNillableTxnAmount sets the "txn_amount" field if the given value is not nil.
+func (src *SettlementRowCreate) SetNillableTxnAmount(f *float64) *SettlementRowCreate {
+	if f != nil {
+		src.SetTxnAmount(*f)
+	}
+	return src
+}
+
+// SetSource sets the "source" field.
+func (src *SettlementRowCreate) SetSource(s string) *SettlementRowCreate {
+	src.mutation.SetSource(s)
+	return src
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (src *SettlementRowCreate) SetImportedBy(s string) *SettlementRowCreate {
+	src.mutation.SetImportedBy(s)
+	return src
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (src *SettlementRowCreate) SetCreatedAt(t time.Time) *SettlementRowCreate {
+	src.mutation.SetCreatedAt(t)
+	return src
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (src *SettlementRowCreate) SetNillableCreatedAt(t *time.Time) *SettlementRowCreate {
+	if t != nil {
+		src.SetCreatedAt(*t)
+	}
+	return src
+}
+
+// Mutation returns the SettlementRowMutation object of the builder.
+func (src *SettlementRowCreate) Mutation() *SettlementRowMutation {
+	return src.mutation
+}
+
+// Save creates the SettlementRow in the database.
+func (src *SettlementRowCreate) Save(ctx context.Context) (*SettlementRow, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *SettlementRowCreate) SaveX(ctx context.Context) *SettlementRow {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *SettlementRowCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *SettlementRowCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *SettlementRowCreate) defaults() {
+	if _, ok := src.mutation.Fee(); !ok {
+		v := settlementrow.DefaultFee
+		src.mutation.SetFee(v)
+	}
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		v := settlementrow.DefaultCreatedAt()
+		src.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *SettlementRowCreate) check() error {
+	if _, ok := src.mutation.Gateway(); !ok {
+		return &ValidationError{Name: "gateway", err: errors.New(`ent: missing required field "SettlementRow.gateway"`)}
+	}
+	if v, ok := src.mutation.Gateway(); ok {
+		if err := settlementrow.GatewayValidator(v); err != nil {
+			return &ValidationError{Name: "gateway", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.gateway": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.TransactionRef(); !ok {
+		return &ValidationError{Name: "transaction_ref", err: errors.New(`ent: missing required field "SettlementRow.transaction_ref"`)}
+	}
+	if v, ok := src.mutation.TransactionRef(); ok {
+		if err := settlementrow.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.transaction_ref": %w`, err)}
+		}
+	}
+	if v, ok := src.mutation.GatewayRef(); ok {
+		if err := settlementrow.GatewayRefValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_ref", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.gateway_ref": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SettlementRow.amount"`)}
+	}
+	if _, ok := src.mutation.Fee(); !ok {
+		return &ValidationError{Name: "fee", err: errors.New(`ent: missing required field "SettlementRow.fee"`)}
+	}
+	if _, ok := src.mutation.SettledAt(); !ok {
+		return &ValidationError{Name: "settled_at", err: errors.New(`ent: missing required field "SettlementRow.settled_at"`)}
+	}
+	if _, ok := src.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SettlementRow.status"`)}
+	}
+	if v, ok := src.mutation.Status(); ok {
+		if err := settlementrow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.status": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "SettlementRow.source"`)}
+	}
+	if v, ok := src.mutation.Source(); ok {
+		if err := settlementrow.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.source": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.ImportedBy(); !ok {
+		return &ValidationError{Name: "imported_by", err: errors.New(`ent: missing required field "SettlementRow.imported_by"`)}
+	}
+	if v, ok := src.mutation.ImportedBy(); ok {
+		if err := settlementrow.ImportedByValidator(v); err != nil {
+			return &ValidationError{Name: "imported_by", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.imported_by": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SettlementRow.created_at"`)}
+	}
+	return nil
+}
+
+func (src *SettlementRowCreate) sqlSave(ctx context.Context) (*SettlementRow, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *SettlementRowCreate) createSpec() (*SettlementRow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SettlementRow{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(settlementrow.Table, sqlgraph.NewFieldSpec(settlementrow.FieldID, field.TypeInt))
+	)
+	if value, ok := src.mutation.Gateway(); ok {
+		_spec.SetField(settlementrow.FieldGateway, field.TypeEnum, value)
+		_node.Gateway = value
+	}
+	if value, ok := src.mutation.TransactionRef(); ok {
+		_spec.SetField(settlementrow.FieldTransactionRef, field.TypeString, value)
+		_node.TransactionRef = value
+	}
+	if value, ok := src.mutation.GatewayRef(); ok {
+		_spec.SetField(settlementrow.FieldGatewayRef, field.TypeString, value)
+		_node.GatewayRef = value
+	}
+	if value, ok := src.mutation.Amount(); ok {
+		_spec.SetField(settlementrow.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := src.mutation.Fee(); ok {
+		_spec.SetField(settlementrow.FieldFee, field.TypeFloat64, value)
+		_node.Fee = value
+	}
+	if value, ok := src.mutation.SettledAt(); ok {
+		_spec.SetField(settlementrow.FieldSettledAt, field.TypeTime, value)
+		_node.SettledAt = value
+	}
+	if value, ok := src.mutation.Status(); ok {
+		_spec.SetField(settlementrow.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := src.mutation.TxnAmount(); ok {
+		_spec.SetField(settlementrow.FieldTxnAmount, field.TypeFloat64, value)
+		_node.TxnAmount = &value
+	}
+	if value, ok := src.mutation.Source(); ok {
+		_spec.SetField(settlementrow.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := src.mutation.ImportedBy(); ok {
+		_spec.SetField(settlementrow.FieldImportedBy, field.TypeString, value)
+		_node.ImportedBy = value
+	}
+	if value, ok := src.mutation.CreatedAt(); ok {
+		_spec.SetField(settlementrow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SettlementRowCreateBulk is the builder for creating many SettlementRow entities in bulk.
+type SettlementRowCreateBulk struct {
+	config
+	err      error
+	builders []*SettlementRowCreate
+}
+
+// Save creates the SettlementRow entities in the database.
+func (srcb *SettlementRowCreateBulk) Save(ctx context.Context) ([]*SettlementRow, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*SettlementRow, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettlementRowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *SettlementRowCreateBulk) SaveX(ctx context.Context) []*SettlementRow {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *SettlementRowCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *SettlementRowCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
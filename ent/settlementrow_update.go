@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+)
+
+// SettlementRowUpdate is the builder for updating SettlementRow entities.
+type SettlementRowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SettlementRowMutation
+}
+
+// Where appends a list predicates to the SettlementRowUpdate builder.
+func (sru *SettlementRowUpdate) Where(ps ...predicate.SettlementRow) *SettlementRowUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetGateway sets the "gateway" field.
+func (sru *SettlementRowUpdate) SetGateway(s settlementrow.Gateway) *SettlementRowUpdate {
+	sru.mutation.SetGateway(s)
+	return sru
+}
+
+// SetNillableGateway sets the "gateway" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableGateway(s *settlementrow.Gateway) *SettlementRowUpdate {
+	if s != nil {
+		sru.SetGateway(*s)
+	}
+	return sru
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (sru *SettlementRowUpdate) SetTransactionRef(s string) *SettlementRowUpdate {
+	sru.mutation.SetTransactionRef(s)
+	return sru
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableTransactionRef(s *string) *SettlementRowUpdate {
+	if s != nil {
+		sru.SetTransactionRef(*s)
+	}
+	return sru
+}
+
+// SetGatewayRef sets the "gateway_ref" field.
+func (sru *SettlementRowUpdate) SetGatewayRef(s string) *SettlementRowUpdate {
+	sru.mutation.SetGatewayRef(s)
+	return sru
+}
+
+// SetNillableGatewayRef sets the "gateway_ref" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableGatewayRef(s *string) *SettlementRowUpdate {
+	if s != nil {
+		sru.SetGatewayRef(*s)
+	}
+	return sru
+}
+
+// ClearGatewayRef clears the value of the "gateway_ref" field.
+func (sru *SettlementRowUpdate) ClearGatewayRef() *SettlementRowUpdate {
+	sru.mutation.ClearGatewayRef()
+	return sru
+}
+
+// SetAmount sets the "amount" field.
+func (sru *SettlementRowUpdate) SetAmount(f float64) *SettlementRowUpdate {
+	sru.mutation.ResetAmount()
+	sru.mutation.SetAmount(f)
+	return sru
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableAmount(f *float64) *SettlementRowUpdate {
+	if f != nil {
+		sru.SetAmount(*f)
+	}
+	return sru
+}
+
+// AddAmount adds f to the "amount" field.
+func (sru *SettlementRowUpdate) AddAmount(f float64) *SettlementRowUpdate {
+	sru.mutation.AddAmount(f)
+	return sru
+}
+
+// SetFee sets the "fee" field.
+func (sru *SettlementRowUpdate) SetFee(f float64) *SettlementRowUpdate {
+	sru.mutation.ResetFee()
+	sru.mutation.SetFee(f)
+	return sru
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableFee(f *float64) *SettlementRowUpdate {
+	if f != nil {
+		sru.SetFee(*f)
+	}
+	return sru
+}
+
+// AddFee adds f to the "fee" field.
+func (sru *SettlementRowUpdate) AddFee(f float64) *SettlementRowUpdate {
+	sru.mutation.AddFee(f)
+	return sru
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (sru *SettlementRowUpdate) SetSettledAt(t time.Time) *SettlementRowUpdate {
+	sru.mutation.SetSettledAt(t)
+	return sru
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableSettledAt(t *time.Time) *SettlementRowUpdate {
+	if t != nil {
+		sru.SetSettledAt(*t)
+	}
+	return sru
+}
+
+// SetStatus sets the "status" field.
+func (sru *SettlementRowUpdate) SetStatus(s settlementrow.Status) *SettlementRowUpdate {
+	sru.mutation.SetStatus(s)
+	return sru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableStatus(s *settlementrow.Status) *SettlementRowUpdate {
+	if s != nil {
+		sru.SetStatus(*s)
+	}
+	return sru
+}
+
+// SetTxnAmount sets the "txn_amount" field.
+func (sru *SettlementRowUpdate) SetTxnAmount(f float64) *SettlementRowUpdate {
+	sru.mutation.ResetTxnAmount()
+	sru.mutation.SetTxnAmount(f)
+	return sru
+}
+
+// SetNillableTxnAmount sets the "txn_amount" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableTxnAmount(f *float64) *SettlementRowUpdate {
+	if f != nil {
+		sru.SetTxnAmount(*f)
+	}
+	return sru
+}
+
+// AddTxnAmount adds f to the "txn_amount" field.
+func (sru *SettlementRowUpdate) AddTxnAmount(f float64) *SettlementRowUpdate {
+	sru.mutation.AddTxnAmount(f)
+	return sru
+}
+
+// ClearTxnAmount clears the value of the "txn_amount" field.
+func (sru *SettlementRowUpdate) ClearTxnAmount() *SettlementRowUpdate {
+	sru.mutation.ClearTxnAmount()
+	return sru
+}
+
+// SetSource sets the "source" field.
+func (sru *SettlementRowUpdate) SetSource(s string) *SettlementRowUpdate {
+	sru.mutation.SetSource(s)
+	return sru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableSource(s *string) *SettlementRowUpdate {
+	if s != nil {
+		sru.SetSource(*s)
+	}
+	return sru
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (sru *SettlementRowUpdate) SetImportedBy(s string) *SettlementRowUpdate {
+	sru.mutation.SetImportedBy(s)
+	return sru
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (sru *SettlementRowUpdate) SetNillableImportedBy(s *string) *SettlementRowUpdate {
+	if s != nil {
+		sru.SetImportedBy(*s)
+	}
+	return sru
+}
+
+// Mutation returns the SettlementRowMutation object of the builder.
+func (sru *SettlementRowUpdate) Mutation() *SettlementRowMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *SettlementRowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *SettlementRowUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *SettlementRowUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *SettlementRowUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sru *SettlementRowUpdate) check() error {
+	if v, ok := sru.mutation.Gateway(); ok {
+		if err := settlementrow.GatewayValidator(v); err != nil {
+			return &ValidationError{Name: "gateway", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.gateway": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.TransactionRef(); ok {
+		if err := settlementrow.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.transaction_ref": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.GatewayRef(); ok {
+		if err := settlementrow.GatewayRefValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_ref", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.gateway_ref": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.Status(); ok {
+		if err := settlementrow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.status": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.Source(); ok {
+		if err := settlementrow.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.source": %w`, err)}
+		}
+	}
+	if v, ok := sru.mutation.ImportedBy(); ok {
+		if err := settlementrow.ImportedByValidator(v); err != nil {
+			return &ValidationError{Name: "imported_by", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.imported_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sru *SettlementRowUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlementrow.Table, settlementrow.Columns, sqlgraph.NewFieldSpec(settlementrow.FieldID, field.TypeInt))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.Gateway(); ok {
+		_spec.SetField(settlementrow.FieldGateway, field.TypeEnum, value)
+	}
+	if value, ok := sru.mutation.TransactionRef(); ok {
+		_spec.SetField(settlementrow.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.GatewayRef(); ok {
+		_spec.SetField(settlementrow.FieldGatewayRef, field.TypeString, value)
+	}
+	if sru.mutation.GatewayRefCleared() {
+		_spec.ClearField(settlementrow.FieldGatewayRef, field.TypeString)
+	}
+	if value, ok := sru.mutation.Amount(); ok {
+		_spec.SetField(settlementrow.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := sru.mutation.AddedAmount(); ok {
+		_spec.AddField(settlementrow.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := sru.mutation.Fee(); ok {
+		_spec.SetField(settlementrow.FieldFee, field.TypeFloat64, value)
+	}
+	if value, ok := sru.mutation.AddedFee(); ok {
+		_spec.AddField(settlementrow.FieldFee, field.TypeFloat64, value)
+	}
+	if value, ok := sru.mutation.SettledAt(); ok {
+		_spec.SetField(settlementrow.FieldSettledAt, field.TypeTime, value)
+	}
+	if value, ok := sru.mutation.Status(); ok {
+		_spec.SetField(settlementrow.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sru.mutation.TxnAmount(); ok {
+		_spec.SetField(settlementrow.FieldTxnAmount, field.TypeFloat64, value)
+	}
+	if value, ok := sru.mutation.AddedTxnAmount(); ok {
+		_spec.AddField(settlementrow.FieldTxnAmount, field.TypeFloat64, value)
+	}
+	if sru.mutation.TxnAmountCleared() {
+		_spec.ClearField(settlementrow.FieldTxnAmount, field.TypeFloat64)
+	}
+	if value, ok := sru.mutation.Source(); ok {
+		_spec.SetField(settlementrow.FieldSource, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.ImportedBy(); ok {
+		_spec.SetField(settlementrow.FieldImportedBy, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementrow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// SettlementRowUpdateOne is the builder for updating a single SettlementRow entity.
+type SettlementRowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SettlementRowMutation
+}
+
+// SetGateway sets the "gateway" field.
+func (sruo *SettlementRowUpdateOne) SetGateway(s settlementrow.Gateway) *SettlementRowUpdateOne {
+	sruo.mutation.SetGateway(s)
+	return sruo
+}
+
+// SetNillableGateway sets the "gateway" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableGateway(s *settlementrow.Gateway) *SettlementRowUpdateOne {
+	if s != nil {
+		sruo.SetGateway(*s)
+	}
+	return sruo
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (sruo *SettlementRowUpdateOne) SetTransactionRef(s string) *SettlementRowUpdateOne {
+	sruo.mutation.SetTransactionRef(s)
+	return sruo
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableTransactionRef(s *string) *SettlementRowUpdateOne {
+	if s != nil {
+		sruo.SetTransactionRef(*s)
+	}
+	return sruo
+}
+
+// SetGatewayRef sets the "gateway_ref" field.
+func (sruo *SettlementRowUpdateOne) SetGatewayRef(s string) *SettlementRowUpdateOne {
+	sruo.mutation.SetGatewayRef(s)
+	return sruo
+}
+
+// SetNillableGatewayRef sets the "gateway_ref" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableGatewayRef(s *string) *SettlementRowUpdateOne {
+	if s != nil {
+		sruo.SetGatewayRef(*s)
+	}
+	return sruo
+}
+
+// ClearGatewayRef clears the value of the "gateway_ref" field.
+func (sruo *SettlementRowUpdateOne) ClearGatewayRef() *SettlementRowUpdateOne {
+	sruo.mutation.ClearGatewayRef()
+	return sruo
+}
+
+// SetAmount sets the "amount" field.
+func (sruo *SettlementRowUpdateOne) SetAmount(f float64) *SettlementRowUpdateOne {
+	sruo.mutation.ResetAmount()
+	sruo.mutation.SetAmount(f)
+	return sruo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableAmount(f *float64) *SettlementRowUpdateOne {
+	if f != nil {
+		sruo.SetAmount(*f)
+	}
+	return sruo
+}
+
+// AddAmount adds f to the "amount" field.
+func (sruo *SettlementRowUpdateOne) AddAmount(f float64) *SettlementRowUpdateOne {
+	sruo.mutation.AddAmount(f)
+	return sruo
+}
+
+// SetFee sets the "fee" field.
+func (sruo *SettlementRowUpdateOne) SetFee(f float64) *SettlementRowUpdateOne {
+	sruo.mutation.ResetFee()
+	sruo.mutation.SetFee(f)
+	return sruo
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableFee(f *float64) *SettlementRowUpdateOne {
+	if f != nil {
+		sruo.SetFee(*f)
+	}
+	return sruo
+}
+
+// AddFee adds f to the "fee" field.
+func (sruo *SettlementRowUpdateOne) AddFee(f float64) *SettlementRowUpdateOne {
+	sruo.mutation.AddFee(f)
+	return sruo
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (sruo *SettlementRowUpdateOne) SetSettledAt(t time.Time) *SettlementRowUpdateOne {
+	sruo.mutation.SetSettledAt(t)
+	return sruo
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableSettledAt(t *time.Time) *SettlementRowUpdateOne {
+	if t != nil {
+		sruo.SetSettledAt(*t)
+	}
+	return sruo
+}
+
+// SetStatus sets the "status" field.
+func (sruo *SettlementRowUpdateOne) SetStatus(s settlementrow.Status) *SettlementRowUpdateOne {
+	sruo.mutation.SetStatus(s)
+	return sruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableStatus(s *settlementrow.Status) *SettlementRowUpdateOne {
+	if s != nil {
+		sruo.SetStatus(*s)
+	}
+	return sruo
+}
+
+// SetTxnAmount sets the "txn_amount" field.
+func (sruo *SettlementRowUpdateOne) SetTxnAmount(f float64) *SettlementRowUpdateOne {
+	sruo.mutation.ResetTxnAmount()
+	sruo.mutation.SetTxnAmount(f)
+	return sruo
+}
+
+// SetNillableTxnAmount sets the "txn_amount" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableTxnAmount(f *float64) *SettlementRowUpdateOne {
+	if f != nil {
+		sruo.SetTxnAmount(*f)
+	}
+	return sruo
+}
+
+// AddTxnAmount adds f to the "txn_amount" field.
+func (sruo *SettlementRowUpdateOne) AddTxnAmount(f float64) *SettlementRowUpdateOne {
+	sruo.mutation.AddTxnAmount(f)
+	return sruo
+}
+
+// ClearTxnAmount clears the value of the "txn_amount" field.
+func (sruo *SettlementRowUpdateOne) ClearTxnAmount() *SettlementRowUpdateOne {
+	sruo.mutation.ClearTxnAmount()
+	return sruo
+}
+
+// SetSource sets the "source" field.
+func (sruo *SettlementRowUpdateOne) SetSource(s string) *SettlementRowUpdateOne {
+	sruo.mutation.SetSource(s)
+	return sruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableSource(s *string) *SettlementRowUpdateOne {
+	if s != nil {
+		sruo.SetSource(*s)
+	}
+	return sruo
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (sruo *SettlementRowUpdateOne) SetImportedBy(s string) *SettlementRowUpdateOne {
+	sruo.mutation.SetImportedBy(s)
+	return sruo
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (sruo *SettlementRowUpdateOne) SetNillableImportedBy(s *string) *SettlementRowUpdateOne {
+	if s != nil {
+		sruo.SetImportedBy(*s)
+	}
+	return sruo
+}
+
+// Mutation returns the SettlementRowMutation object of the builder.
+func (sruo *SettlementRowUpdateOne) Mutation() *SettlementRowMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the SettlementRowUpdate builder.
+func (sruo *SettlementRowUpdateOne) Where(ps ...predicate.SettlementRow) *SettlementRowUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *SettlementRowUpdateOne) Select(field string, fields ...string) *SettlementRowUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated SettlementRow entity.
+func (sruo *SettlementRowUpdateOne) Save(ctx context.Context) (*SettlementRow, error) {
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *SettlementRowUpdateOne) SaveX(ctx context.Context) *SettlementRow {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *SettlementRowUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *SettlementRowUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sruo *SettlementRowUpdateOne) check() error {
+	if v, ok := sruo.mutation.Gateway(); ok {
+		if err := settlementrow.GatewayValidator(v); err != nil {
+			return &ValidationError{Name: "gateway", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.gateway": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.TransactionRef(); ok {
+		if err := settlementrow.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.transaction_ref": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.GatewayRef(); ok {
+		if err := settlementrow.GatewayRefValidator(v); err != nil {
+			return &ValidationError{Name: "gateway_ref", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.gateway_ref": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.Status(); ok {
+		if err := settlementrow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.status": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.Source(); ok {
+		if err := settlementrow.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.source": %w`, err)}
+		}
+	}
+	if v, ok := sruo.mutation.ImportedBy(); ok {
+		if err := settlementrow.ImportedByValidator(v); err != nil {
+			return &ValidationError{Name: "imported_by", err: fmt.Errorf(`ent: validator failed for field "SettlementRow.imported_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sruo *SettlementRowUpdateOne) sqlSave(ctx context.Context) (_node *SettlementRow, err error) {
+	if err := sruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(settlementrow.Table, settlementrow.Columns, sqlgraph.NewFieldSpec(settlementrow.FieldID, field.TypeInt))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SettlementRow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, settlementrow.FieldID)
+		for _, f := range fields {
+			if !settlementrow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != settlementrow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.Gateway(); ok {
+		_spec.SetField(settlementrow.FieldGateway, field.TypeEnum, value)
+	}
+	if value, ok := sruo.mutation.TransactionRef(); ok {
+		_spec.SetField(settlementrow.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.GatewayRef(); ok {
+		_spec.SetField(settlementrow.FieldGatewayRef, field.TypeString, value)
+	}
+	if sruo.mutation.GatewayRefCleared() {
+		_spec.ClearField(settlementrow.FieldGatewayRef, field.TypeString)
+	}
+	if value, ok := sruo.mutation.Amount(); ok {
+		_spec.SetField(settlementrow.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := sruo.mutation.AddedAmount(); ok {
+		_spec.AddField(settlementrow.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := sruo.mutation.Fee(); ok {
+		_spec.SetField(settlementrow.FieldFee, field.TypeFloat64, value)
+	}
+	if value, ok := sruo.mutation.AddedFee(); ok {
+		_spec.AddField(settlementrow.FieldFee, field.TypeFloat64, value)
+	}
+	if value, ok := sruo.mutation.SettledAt(); ok {
+		_spec.SetField(settlementrow.FieldSettledAt, field.TypeTime, value)
+	}
+	if value, ok := sruo.mutation.Status(); ok {
+		_spec.SetField(settlementrow.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := sruo.mutation.TxnAmount(); ok {
+		_spec.SetField(settlementrow.FieldTxnAmount, field.TypeFloat64, value)
+	}
+	if value, ok := sruo.mutation.AddedTxnAmount(); ok {
+		_spec.AddField(settlementrow.FieldTxnAmount, field.TypeFloat64, value)
+	}
+	if sruo.mutation.TxnAmountCleared() {
+		_spec.ClearField(settlementrow.FieldTxnAmount, field.TypeFloat64)
+	}
+	if value, ok := sruo.mutation.Source(); ok {
+		_spec.SetField(settlementrow.FieldSource, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.ImportedBy(); ok {
+		_spec.SetField(settlementrow.FieldImportedBy, field.TypeString, value)
+	}
+	_node = &SettlementRow{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{settlementrow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
+)
+
+// StatementEntry is the model entity for the StatementEntry schema.
+type StatementEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider statemententry.Provider `json:"provider,omitempty"`
+	// TrxID holds the value of the "trx_id" field.
+	TrxID string `json:"trx_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// SenderNumber holds the value of the "sender_number" field.
+	SenderNumber string `json:"sender_number,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	// transaction_ref of the manual payment the entry confirmed
+	PaymentRef string `json:"payment_ref,omitempty"`
+	// ImportedBy holds the value of the "imported_by" field.
+	ImportedBy string `json:"imported_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StatementEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case statemententry.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case statemententry.FieldID:
+			values[i] = new(sql.NullInt64)
+		case statemententry.FieldProvider, statemententry.FieldTrxID, statemententry.FieldSenderNumber, statemententry.FieldPaymentRef, statemententry.FieldImportedBy:
+			values[i] = new(sql.NullString)
+		case statemententry.FieldReceivedAt, statemententry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StatementEntry fields.
+func (se *StatementEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case statemententry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			se.ID = int(value.Int64)
+		case statemententry.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				se.Provider = statemententry.Provider(value.String)
+			}
+		case statemententry.FieldTrxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trx_id", values[i])
+			} else if value.Valid {
+				se.TrxID = value.String
+			}
+		case statemententry.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				se.Amount = value.Float64
+			}
+		case statemententry.FieldSenderNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sender_number", values[i])
+			} else if value.Valid {
+				se.SenderNumber = value.String
+			}
+		case statemententry.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				se.ReceivedAt = new(time.Time)
+				*se.ReceivedAt = value.Time
+			}
+		case statemententry.FieldPaymentRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_ref", values[i])
+			} else if value.Valid {
+				se.PaymentRef = value.String
+			}
+		case statemententry.FieldImportedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field imported_by", values[i])
+			} else if value.Valid {
+				se.ImportedBy = value.String
+			}
+		case statemententry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				se.CreatedAt = value.Time
+			}
+		default:
+			se.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StatementEntry.
+// This includes values selected through modifiers, order, etc.
+func (se *StatementEntry) Value(name string) (ent.Value, error) {
+	return se.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StatementEntry.
+// Note that you need to call StatementEntry.Unwrap() before calling this method if this StatementEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (se *StatementEntry) Update() *StatementEntryUpdateOne {
+	return NewStatementEntryClient(se.config).UpdateOne(se)
+}
+
+// Unwrap unwraps the StatementEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (se *StatementEntry) Unwrap() *StatementEntry {
+	_tx, ok := se.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StatementEntry is not a transactional entity")
+	}
+	se.config.driver = _tx.drv
+	return se
+}
+
+// String implements the fmt.Stringer.
+func (se *StatementEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("StatementEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", se.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(fmt.Sprintf("%v", se.Provider))
+	builder.WriteString(", ")
+	builder.WriteString("trx_id=")
+	builder.WriteString(se.TrxID)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", se.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("sender_number=")
+	builder.WriteString(se.SenderNumber)
+	builder.WriteString(", ")
+	if v := se.ReceivedAt; v != nil {
+		builder.WriteString("received_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("payment_ref=")
+	builder.WriteString(se.PaymentRef)
+	builder.WriteString(", ")
+	builder.WriteString("imported_by=")
+	builder.WriteString(se.ImportedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(se.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StatementEntries is a parsable slice of StatementEntry.
+type StatementEntries []*StatementEntry
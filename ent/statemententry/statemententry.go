@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statemententry
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the statemententry type in the database.
+	Label = "statement_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldTrxID holds the string denoting the trx_id field in the database.
+	FieldTrxID = "trx_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldSenderNumber holds the string denoting the sender_number field in the database.
+	FieldSenderNumber = "sender_number"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// FieldPaymentRef holds the string denoting the payment_ref field in the database.
+	FieldPaymentRef = "payment_ref"
+	// FieldImportedBy holds the string denoting the imported_by field in the database.
+	FieldImportedBy = "imported_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the statemententry in the database.
+	Table = "statement_entries"
+)
+
+// Columns holds all SQL columns for statemententry fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldTrxID,
+	FieldAmount,
+	FieldSenderNumber,
+	FieldReceivedAt,
+	FieldPaymentRef,
+	FieldImportedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TrxIDValidator is a validator for the "trx_id" field. It is called by the builders before save.
+	TrxIDValidator func(string) error
+	// SenderNumberValidator is a validator for the "sender_number" field. It is called by the builders before save.
+	SenderNumberValidator func(string) error
+	// PaymentRefValidator is a validator for the "payment_ref" field. It is called by the builders before save.
+	PaymentRefValidator func(string) error
+	// ImportedByValidator is a validator for the "imported_by" field. It is called by the builders before save.
+	ImportedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Provider defines the type for the "provider" enum field.
+type Provider string
+
+// Provider values.
+const (
+	ProviderBkash Provider = "bkash"
+	ProviderNagad Provider = "nagad"
+)
+
+func (pr Provider) String() string {
+	return string(pr)
+}
+
+// ProviderValidator is a validator for the "provider" field enum values. It is called by the builders before save.
+func ProviderValidator(pr Provider) error {
+	switch pr {
+	case ProviderBkash, ProviderNagad:
+		return nil
+	default:
+		return fmt.Errorf("statemententry: invalid enum value for provider field: %q", pr)
+	}
+}
+
+// OrderOption defines the ordering options for the StatementEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByTrxID orders the results by the trx_id field.
+func ByTrxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrxID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// BySenderNumber orders the results by the sender_number field.
+func BySenderNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSenderNumber, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByPaymentRef orders the results by the payment_ref field.
+func ByPaymentRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentRef, opts...).ToFunc()
+}
+
+// ByImportedBy orders the results by the imported_by field.
+func ByImportedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,535 @@
+// Code generated by ent, DO NOT EDIT.
+
+package statemententry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLTE(FieldID, id))
+}
+
+// TrxID applies equality check predicate on the "trx_id" field. It's identical to TrxIDEQ.
+func TrxID(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldTrxID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldAmount, v))
+}
+
+// SenderNumber applies equality check predicate on the "sender_number" field. It's identical to SenderNumberEQ.
+func SenderNumber(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldSenderNumber, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// PaymentRef applies equality check predicate on the "payment_ref" field. It's identical to PaymentRefEQ.
+func PaymentRef(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldPaymentRef, v))
+}
+
+// ImportedBy applies equality check predicate on the "imported_by" field. It's identical to ImportedByEQ.
+func ImportedBy(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldImportedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v Provider) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v Provider) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...Provider) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...Provider) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// TrxIDEQ applies the EQ predicate on the "trx_id" field.
+func TrxIDEQ(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldTrxID, v))
+}
+
+// TrxIDNEQ applies the NEQ predicate on the "trx_id" field.
+func TrxIDNEQ(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldTrxID, v))
+}
+
+// TrxIDIn applies the In predicate on the "trx_id" field.
+func TrxIDIn(vs ...string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldTrxID, vs...))
+}
+
+// TrxIDNotIn applies the NotIn predicate on the "trx_id" field.
+func TrxIDNotIn(vs ...string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldTrxID, vs...))
+}
+
+// TrxIDGT applies the GT predicate on the "trx_id" field.
+func TrxIDGT(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGT(FieldTrxID, v))
+}
+
+// TrxIDGTE applies the GTE predicate on the "trx_id" field.
+func TrxIDGTE(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGTE(FieldTrxID, v))
+}
+
+// TrxIDLT applies the LT predicate on the "trx_id" field.
+func TrxIDLT(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLT(FieldTrxID, v))
+}
+
+// TrxIDLTE applies the LTE predicate on the "trx_id" field.
+func TrxIDLTE(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLTE(FieldTrxID, v))
+}
+
+// TrxIDContains applies the Contains predicate on the "trx_id" field.
+func TrxIDContains(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldContains(FieldTrxID, v))
+}
+
+// TrxIDHasPrefix applies the HasPrefix predicate on the "trx_id" field.
+func TrxIDHasPrefix(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldHasPrefix(FieldTrxID, v))
+}
+
+// TrxIDHasSuffix applies the HasSuffix predicate on the "trx_id" field.
+func TrxIDHasSuffix(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldHasSuffix(FieldTrxID, v))
+}
+
+// TrxIDEqualFold applies the EqualFold predicate on the "trx_id" field.
+func TrxIDEqualFold(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEqualFold(FieldTrxID, v))
+}
+
+// TrxIDContainsFold applies the ContainsFold predicate on the "trx_id" field.
+func TrxIDContainsFold(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldContainsFold(FieldTrxID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLTE(FieldAmount, v))
+}
+
+// SenderNumberEQ applies the EQ predicate on the "sender_number" field.
+func SenderNumberEQ(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldSenderNumber, v))
+}
+
+// SenderNumberNEQ applies the NEQ predicate on the "sender_number" field.
+func SenderNumberNEQ(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldSenderNumber, v))
+}
+
+// SenderNumberIn applies the In predicate on the "sender_number" field.
+func SenderNumberIn(vs ...string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldSenderNumber, vs...))
+}
+
+// SenderNumberNotIn applies the NotIn predicate on the "sender_number" field.
+func SenderNumberNotIn(vs ...string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldSenderNumber, vs...))
+}
+
+// SenderNumberGT applies the GT predicate on the "sender_number" field.
+func SenderNumberGT(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGT(FieldSenderNumber, v))
+}
+
+// SenderNumberGTE applies the GTE predicate on the "sender_number" field.
+func SenderNumberGTE(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGTE(FieldSenderNumber, v))
+}
+
+// SenderNumberLT applies the LT predicate on the "sender_number" field.
+func SenderNumberLT(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLT(FieldSenderNumber, v))
+}
+
+// SenderNumberLTE applies the LTE predicate on the "sender_number" field.
+func SenderNumberLTE(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLTE(FieldSenderNumber, v))
+}
+
+// SenderNumberContains applies the Contains predicate on the "sender_number" field.
+func SenderNumberContains(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldContains(FieldSenderNumber, v))
+}
+
+// SenderNumberHasPrefix applies the HasPrefix predicate on the "sender_number" field.
+func SenderNumberHasPrefix(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldHasPrefix(FieldSenderNumber, v))
+}
+
+// SenderNumberHasSuffix applies the HasSuffix predicate on the "sender_number" field.
+func SenderNumberHasSuffix(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldHasSuffix(FieldSenderNumber, v))
+}
+
+// SenderNumberIsNil applies the IsNil predicate on the "sender_number" field.
+func SenderNumberIsNil() predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIsNull(FieldSenderNumber))
+}
+
+// SenderNumberNotNil applies the NotNil predicate on the "sender_number" field.
+func SenderNumberNotNil() predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotNull(FieldSenderNumber))
+}
+
+// SenderNumberEqualFold applies the EqualFold predicate on the "sender_number" field.
+func SenderNumberEqualFold(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEqualFold(FieldSenderNumber, v))
+}
+
+// SenderNumberContainsFold applies the ContainsFold predicate on the "sender_number" field.
+func SenderNumberContainsFold(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldContainsFold(FieldSenderNumber, v))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtIsNil applies the IsNil predicate on the "received_at" field.
+func ReceivedAtIsNil() predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIsNull(FieldReceivedAt))
+}
+
+// ReceivedAtNotNil applies the NotNil predicate on the "received_at" field.
+func ReceivedAtNotNil() predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotNull(FieldReceivedAt))
+}
+
+// PaymentRefEQ applies the EQ predicate on the "payment_ref" field.
+func PaymentRefEQ(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldPaymentRef, v))
+}
+
+// PaymentRefNEQ applies the NEQ predicate on the "payment_ref" field.
+func PaymentRefNEQ(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldPaymentRef, v))
+}
+
+// PaymentRefIn applies the In predicate on the "payment_ref" field.
+func PaymentRefIn(vs ...string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldPaymentRef, vs...))
+}
+
+// PaymentRefNotIn applies the NotIn predicate on the "payment_ref" field.
+func PaymentRefNotIn(vs ...string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldPaymentRef, vs...))
+}
+
+// PaymentRefGT applies the GT predicate on the "payment_ref" field.
+func PaymentRefGT(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGT(FieldPaymentRef, v))
+}
+
+// PaymentRefGTE applies the GTE predicate on the "payment_ref" field.
+func PaymentRefGTE(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGTE(FieldPaymentRef, v))
+}
+
+// PaymentRefLT applies the LT predicate on the "payment_ref" field.
+func PaymentRefLT(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLT(FieldPaymentRef, v))
+}
+
+// PaymentRefLTE applies the LTE predicate on the "payment_ref" field.
+func PaymentRefLTE(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLTE(FieldPaymentRef, v))
+}
+
+// PaymentRefContains applies the Contains predicate on the "payment_ref" field.
+func PaymentRefContains(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldContains(FieldPaymentRef, v))
+}
+
+// PaymentRefHasPrefix applies the HasPrefix predicate on the "payment_ref" field.
+func PaymentRefHasPrefix(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldHasPrefix(FieldPaymentRef, v))
+}
+
+// PaymentRefHasSuffix applies the HasSuffix predicate on the "payment_ref" field.
+func PaymentRefHasSuffix(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldHasSuffix(FieldPaymentRef, v))
+}
+
+// PaymentRefIsNil applies the IsNil predicate on the "payment_ref" field.
+func PaymentRefIsNil() predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIsNull(FieldPaymentRef))
+}
+
+// PaymentRefNotNil applies the NotNil predicate on the "payment_ref" field.
+func PaymentRefNotNil() predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotNull(FieldPaymentRef))
+}
+
+// PaymentRefEqualFold applies the EqualFold predicate on the "payment_ref" field.
+func PaymentRefEqualFold(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEqualFold(FieldPaymentRef, v))
+}
+
+// PaymentRefContainsFold applies the ContainsFold predicate on the "payment_ref" field.
+func PaymentRefContainsFold(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldContainsFold(FieldPaymentRef, v))
+}
+
+// ImportedByEQ applies the EQ predicate on the "imported_by" field.
+func ImportedByEQ(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldImportedBy, v))
+}
+
+// ImportedByNEQ applies the NEQ predicate on the "imported_by" field.
+func ImportedByNEQ(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldImportedBy, v))
+}
+
+// ImportedByIn applies the In predicate on the "imported_by" field.
+func ImportedByIn(vs ...string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldImportedBy, vs...))
+}
+
+// ImportedByNotIn applies the NotIn predicate on the "imported_by" field.
+func ImportedByNotIn(vs ...string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldImportedBy, vs...))
+}
+
+// ImportedByGT applies the GT predicate on the "imported_by" field.
+func ImportedByGT(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGT(FieldImportedBy, v))
+}
+
+// ImportedByGTE applies the GTE predicate on the "imported_by" field.
+func ImportedByGTE(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGTE(FieldImportedBy, v))
+}
+
+// ImportedByLT applies the LT predicate on the "imported_by" field.
+func ImportedByLT(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLT(FieldImportedBy, v))
+}
+
+// ImportedByLTE applies the LTE predicate on the "imported_by" field.
+func ImportedByLTE(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLTE(FieldImportedBy, v))
+}
+
+// ImportedByContains applies the Contains predicate on the "imported_by" field.
+func ImportedByContains(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldContains(FieldImportedBy, v))
+}
+
+// ImportedByHasPrefix applies the HasPrefix predicate on the "imported_by" field.
+func ImportedByHasPrefix(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldHasPrefix(FieldImportedBy, v))
+}
+
+// ImportedByHasSuffix applies the HasSuffix predicate on the "imported_by" field.
+func ImportedByHasSuffix(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldHasSuffix(FieldImportedBy, v))
+}
+
+// ImportedByEqualFold applies the EqualFold predicate on the "imported_by" field.
+func ImportedByEqualFold(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEqualFold(FieldImportedBy, v))
+}
+
+// ImportedByContainsFold applies the ContainsFold predicate on the "imported_by" field.
+func ImportedByContainsFold(v string) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldContainsFold(FieldImportedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StatementEntry) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StatementEntry) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StatementEntry) predicate.StatementEntry {
+	return predicate.StatementEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,333 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
+)
+
+// StatementEntryCreate is the builder for creating a StatementEntry entity.
+type StatementEntryCreate struct {
+	config
+	mutation *StatementEntryMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (sec *StatementEntryCreate) SetProvider(s statemententry.Provider) *StatementEntryCreate {
+	sec.mutation.SetProvider(s)
+	return sec
+}
+
+// SetTrxID sets the "trx_id" field.
+func (sec *StatementEntryCreate) SetTrxID(s string) *StatementEntryCreate {
+	sec.mutation.SetTrxID(s)
+	return sec
+}
+
+// SetAmount sets the "amount" field.
+func (sec *StatementEntryCreate) SetAmount(f float64) *StatementEntryCreate {
+	sec.mutation.SetAmount(f)
+	return sec
+}
+
+// SetSenderNumber sets the "sender_number" field.
+func (sec *StatementEntryCreate) SetSenderNumber(s string) *StatementEntryCreate {
+	sec.mutation.SetSenderNumber(s)
+	return sec
+}
+
+// SetNillableSenderNumber sets the "sender_number" field if the given value is not nil.
+func (sec *StatementEntryCreate) SetNillableSenderNumber(s *string) *StatementEntryCreate {
+	if s != nil {
+		sec.SetSenderNumber(*s)
+	}
+	return sec
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (sec *StatementEntryCreate) SetReceivedAt(t time.Time) *StatementEntryCreate {
+	sec.mutation.SetReceivedAt(t)
+	return sec
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (sec *StatementEntryCreate) SetNillableReceivedAt(t *time.Time) *StatementEntryCreate {
+	if t != nil {
+		sec.SetReceivedAt(*t)
+	}
+	return sec
+}
+
+// SetPaymentRef sets the "payment_ref" field.
+func (sec *StatementEntryCreate) SetPaymentRef(s string) *StatementEntryCreate {
+	sec.mutation.SetPaymentRef(s)
+	return sec
+}
+
+// SetNillablePaymentRef sets the "payment_ref" field if the given value is not nil.
+func (sec *StatementEntryCreate) SetNillablePaymentRef(s *string) *StatementEntryCreate {
+	if s != nil {
+		sec.SetPaymentRef(*s)
+	}
+	return sec
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (sec *StatementEntryCreate) SetImportedBy(s string) *StatementEntryCreate {
+	sec.mutation.SetImportedBy(s)
+	return sec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sec *StatementEntryCreate) SetCreatedAt(t time.Time) *StatementEntryCreate {
+	sec.mutation.SetCreatedAt(t)
+	return sec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sec *StatementEntryCreate) SetNillableCreatedAt(t *time.Time) *StatementEntryCreate {
+	if t != nil {
+		sec.SetCreatedAt(*t)
+	}
+	return sec
+}
+
+// Mutation returns the StatementEntryMutation object of the builder.
+func (sec *StatementEntryCreate) Mutation() *StatementEntryMutation {
+	return sec.mutation
+}
+
+// Save creates the StatementEntry in the database.
+func (sec *StatementEntryCreate) Save(ctx context.Context) (*StatementEntry, error) {
+	sec.defaults()
+	return withHooks(ctx, sec.sqlSave, sec.mutation, sec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sec *StatementEntryCreate) SaveX(ctx context.Context) *StatementEntry {
+	v, err := sec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sec *StatementEntryCreate) Exec(ctx context.Context) error {
+	_, err := sec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sec *StatementEntryCreate) ExecX(ctx context.Context) {
+	if err := sec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sec *StatementEntryCreate) defaults() {
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		v := statemententry.DefaultCreatedAt()
+		sec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sec *StatementEntryCreate) check() error {
+	if _, ok := sec.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "StatementEntry.provider"`)}
+	}
+	if v, ok := sec.mutation.Provider(); ok {
+		if err := statemententry.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.provider": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.TrxID(); !ok {
+		return &ValidationError{Name: "trx_id", err: errors.New(`ent: missing required field "StatementEntry.trx_id"`)}
+	}
+	if v, ok := sec.mutation.TrxID(); ok {
+		if err := statemententry.TrxIDValidator(v); err != nil {
+			return &ValidationError{Name: "trx_id", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.trx_id": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "StatementEntry.amount"`)}
+	}
+	if v, ok := sec.mutation.SenderNumber(); ok {
+		if err := statemententry.SenderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "sender_number", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.sender_number": %w`, err)}
+		}
+	}
+	if v, ok := sec.mutation.PaymentRef(); ok {
+		if err := statemententry.PaymentRefValidator(v); err != nil {
+			return &ValidationError{Name: "payment_ref", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.payment_ref": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.ImportedBy(); !ok {
+		return &ValidationError{Name: "imported_by", err: errors.New(`ent: missing required field "StatementEntry.imported_by"`)}
+	}
+	if v, ok := sec.mutation.ImportedBy(); ok {
+		if err := statemententry.ImportedByValidator(v); err != nil {
+			return &ValidationError{Name: "imported_by", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.imported_by": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StatementEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (sec *StatementEntryCreate) sqlSave(ctx context.Context) (*StatementEntry, error) {
+	if err := sec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sec.mutation.id = &_node.ID
+	sec.mutation.done = true
+	return _node, nil
+}
+
+func (sec *StatementEntryCreate) createSpec() (*StatementEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StatementEntry{config: sec.config}
+		_spec = sqlgraph.NewCreateSpec(statemententry.Table, sqlgraph.NewFieldSpec(statemententry.FieldID, field.TypeInt))
+	)
+	if value, ok := sec.mutation.Provider(); ok {
+		_spec.SetField(statemententry.FieldProvider, field.TypeEnum, value)
+		_node.Provider = value
+	}
+	if value, ok := sec.mutation.TrxID(); ok {
+		_spec.SetField(statemententry.FieldTrxID, field.TypeString, value)
+		_node.TrxID = value
+	}
+	if value, ok := sec.mutation.Amount(); ok {
+		_spec.SetField(statemententry.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := sec.mutation.SenderNumber(); ok {
+		_spec.SetField(statemententry.FieldSenderNumber, field.TypeString, value)
+		_node.SenderNumber = value
+	}
+	if value, ok := sec.mutation.ReceivedAt(); ok {
+		_spec.SetField(statemententry.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = &value
+	}
+	if value, ok := sec.mutation.PaymentRef(); ok {
+		_spec.SetField(statemententry.FieldPaymentRef, field.TypeString, value)
+		_node.PaymentRef = value
+	}
+	if value, ok := sec.mutation.ImportedBy(); ok {
+		_spec.SetField(statemententry.FieldImportedBy, field.TypeString, value)
+		_node.ImportedBy = value
+	}
+	if value, ok := sec.mutation.CreatedAt(); ok {
+		_spec.SetField(statemententry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// StatementEntryCreateBulk is the builder for creating many StatementEntry entities in bulk.
+type StatementEntryCreateBulk struct {
+	config
+	err      error
+	builders []*StatementEntryCreate
+}
+
+// Save creates the StatementEntry entities in the database.
+func (secb *StatementEntryCreateBulk) Save(ctx context.Context) ([]*StatementEntry, error) {
+	if secb.err != nil {
+		return nil, secb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(secb.builders))
+	nodes := make([]*StatementEntry, len(secb.builders))
+	mutators := make([]Mutator, len(secb.builders))
+	for i := range secb.builders {
+		func(i int, root context.Context) {
+			builder := secb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StatementEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, secb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, secb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, secb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (secb *StatementEntryCreateBulk) SaveX(ctx context.Context) []*StatementEntry {
+	v, err := secb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (secb *StatementEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := secb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (secb *StatementEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := secb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
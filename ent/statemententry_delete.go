@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
+)
+
+// StatementEntryDelete is the builder for deleting a StatementEntry entity.
+type StatementEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *StatementEntryMutation
+}
+
+// Where appends a list predicates to the StatementEntryDelete builder.
+func (sed *StatementEntryDelete) Where(ps ...predicate.StatementEntry) *StatementEntryDelete {
+	sed.mutation.Where(ps...)
+	return sed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sed *StatementEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sed.sqlExec, sed.mutation, sed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sed *StatementEntryDelete) ExecX(ctx context.Context) int {
+	n, err := sed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sed *StatementEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(statemententry.Table, sqlgraph.NewFieldSpec(statemententry.FieldID, field.TypeInt))
+	if ps := sed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sed.mutation.done = true
+	return affected, err
+}
+
+// StatementEntryDeleteOne is the builder for deleting a single StatementEntry entity.
+type StatementEntryDeleteOne struct {
+	sed *StatementEntryDelete
+}
+
+// Where appends a list predicates to the StatementEntryDelete builder.
+func (sedo *StatementEntryDeleteOne) Where(ps ...predicate.StatementEntry) *StatementEntryDeleteOne {
+	sedo.sed.mutation.Where(ps...)
+	return sedo
+}
+
+// Exec executes the deletion query.
+func (sedo *StatementEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := sedo.sed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{statemententry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sedo *StatementEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := sedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
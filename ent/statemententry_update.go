@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
+)
+
+// StatementEntryUpdate is the builder for updating StatementEntry entities.
+type StatementEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StatementEntryMutation
+}
+
+// Where appends a list predicates to the StatementEntryUpdate builder.
+func (seu *StatementEntryUpdate) Where(ps ...predicate.StatementEntry) *StatementEntryUpdate {
+	seu.mutation.Where(ps...)
+	return seu
+}
+
+// SetProvider sets the "provider" field.
+func (seu *StatementEntryUpdate) SetProvider(s statemententry.Provider) *StatementEntryUpdate {
+	seu.mutation.SetProvider(s)
+	return seu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (seu *StatementEntryUpdate) SetNillableProvider(s *statemententry.Provider) *StatementEntryUpdate {
+	if s != nil {
+		seu.SetProvider(*s)
+	}
+	return seu
+}
+
+// SetTrxID sets the "trx_id" field.
+func (seu *StatementEntryUpdate) SetTrxID(s string) *StatementEntryUpdate {
+	seu.mutation.SetTrxID(s)
+	return seu
+}
+
+// SetNillableTrxID sets the "trx_id" field if the given value is not nil.
+func (seu *StatementEntryUpdate) SetNillableTrxID(s *string) *StatementEntryUpdate {
+	if s != nil {
+		seu.SetTrxID(*s)
+	}
+	return seu
+}
+
+// SetAmount sets the "amount" field.
+func (seu *StatementEntryUpdate) SetAmount(f float64) *StatementEntryUpdate {
+	seu.mutation.ResetAmount()
+	seu.mutation.SetAmount(f)
+	return seu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (seu *StatementEntryUpdate) SetNillableAmount(f *float64) *StatementEntryUpdate {
+	if f != nil {
+		seu.SetAmount(*f)
+	}
+	return seu
+}
+
+// AddAmount adds f to the "amount" field.
+func (seu *StatementEntryUpdate) AddAmount(f float64) *StatementEntryUpdate {
+	seu.mutation.AddAmount(f)
+	return seu
+}
+
+// SetSenderNumber sets the "sender_number" field.
+func (seu *StatementEntryUpdate) SetSenderNumber(s string) *StatementEntryUpdate {
+	seu.mutation.SetSenderNumber(s)
+	return seu
+}
+
+// SetNillableSenderNumber sets the "sender_number" field if the given value is not nil.
+func (seu *StatementEntryUpdate) SetNillableSenderNumber(s *string) *StatementEntryUpdate {
+	if s != nil {
+		seu.SetSenderNumber(*s)
+	}
+	return seu
+}
+
+// ClearSenderNumber clears the value of the "sender_number" field.
+func (seu *StatementEntryUpdate) ClearSenderNumber() *StatementEntryUpdate {
+	seu.mutation.ClearSenderNumber()
+	return seu
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (seu *StatementEntryUpdate) SetReceivedAt(t time.Time) *StatementEntryUpdate {
+	seu.mutation.SetReceivedAt(t)
+	return seu
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (seu *StatementEntryUpdate) SetNillableReceivedAt(t *time.Time) *StatementEntryUpdate {
+	if t != nil {
+		seu.SetReceivedAt(*t)
+	}
+	return seu
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (seu *StatementEntryUpdate) ClearReceivedAt() *StatementEntryUpdate {
+	seu.mutation.ClearReceivedAt()
+	return seu
+}
+
+// SetPaymentRef sets the "payment_ref" field.
+func (seu *StatementEntryUpdate) SetPaymentRef(s string) *StatementEntryUpdate {
+	seu.mutation.SetPaymentRef(s)
+	return seu
+}
+
+// SetNillablePaymentRef sets the "payment_ref" field if the given value is not nil.
+func (seu *StatementEntryUpdate) SetNillablePaymentRef(s *string) *StatementEntryUpdate {
+	if s != nil {
+		seu.SetPaymentRef(*s)
+	}
+	return seu
+}
+
+// ClearPaymentRef clears the value of the "payment_ref" field.
+func (seu *StatementEntryUpdate) ClearPaymentRef() *StatementEntryUpdate {
+	seu.mutation.ClearPaymentRef()
+	return seu
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (seu *StatementEntryUpdate) SetImportedBy(s string) *StatementEntryUpdate {
+	seu.mutation.SetImportedBy(s)
+	return seu
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (seu *StatementEntryUpdate) SetNillableImportedBy(s *string) *StatementEntryUpdate {
+	if s != nil {
+		seu.SetImportedBy(*s)
+	}
+	return seu
+}
+
+// Mutation returns the StatementEntryMutation object of the builder.
+func (seu *StatementEntryUpdate) Mutation() *StatementEntryMutation {
+	return seu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (seu *StatementEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, seu.sqlSave, seu.mutation, seu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seu *StatementEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := seu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (seu *StatementEntryUpdate) Exec(ctx context.Context) error {
+	_, err := seu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seu *StatementEntryUpdate) ExecX(ctx context.Context) {
+	if err := seu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seu *StatementEntryUpdate) check() error {
+	if v, ok := seu.mutation.Provider(); ok {
+		if err := statemententry.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.provider": %w`, err)}
+		}
+	}
+	if v, ok := seu.mutation.TrxID(); ok {
+		if err := statemententry.TrxIDValidator(v); err != nil {
+			return &ValidationError{Name: "trx_id", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.trx_id": %w`, err)}
+		}
+	}
+	if v, ok := seu.mutation.SenderNumber(); ok {
+		if err := statemententry.SenderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "sender_number", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.sender_number": %w`, err)}
+		}
+	}
+	if v, ok := seu.mutation.PaymentRef(); ok {
+		if err := statemententry.PaymentRefValidator(v); err != nil {
+			return &ValidationError{Name: "payment_ref", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.payment_ref": %w`, err)}
+		}
+	}
+	if v, ok := seu.mutation.ImportedBy(); ok {
+		if err := statemententry.ImportedByValidator(v); err != nil {
+			return &ValidationError{Name: "imported_by", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.imported_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seu *StatementEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := seu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statemententry.Table, statemententry.Columns, sqlgraph.NewFieldSpec(statemententry.FieldID, field.TypeInt))
+	if ps := seu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seu.mutation.Provider(); ok {
+		_spec.SetField(statemententry.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := seu.mutation.TrxID(); ok {
+		_spec.SetField(statemententry.FieldTrxID, field.TypeString, value)
+	}
+	if value, ok := seu.mutation.Amount(); ok {
+		_spec.SetField(statemententry.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := seu.mutation.AddedAmount(); ok {
+		_spec.AddField(statemententry.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := seu.mutation.SenderNumber(); ok {
+		_spec.SetField(statemententry.FieldSenderNumber, field.TypeString, value)
+	}
+	if seu.mutation.SenderNumberCleared() {
+		_spec.ClearField(statemententry.FieldSenderNumber, field.TypeString)
+	}
+	if value, ok := seu.mutation.ReceivedAt(); ok {
+		_spec.SetField(statemententry.FieldReceivedAt, field.TypeTime, value)
+	}
+	if seu.mutation.ReceivedAtCleared() {
+		_spec.ClearField(statemententry.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := seu.mutation.PaymentRef(); ok {
+		_spec.SetField(statemententry.FieldPaymentRef, field.TypeString, value)
+	}
+	if seu.mutation.PaymentRefCleared() {
+		_spec.ClearField(statemententry.FieldPaymentRef, field.TypeString)
+	}
+	if value, ok := seu.mutation.ImportedBy(); ok {
+		_spec.SetField(statemententry.FieldImportedBy, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, seu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statemententry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	seu.mutation.done = true
+	return n, nil
+}
+
+// StatementEntryUpdateOne is the builder for updating a single StatementEntry entity.
+type StatementEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StatementEntryMutation
+}
+
+// SetProvider sets the "provider" field.
+func (seuo *StatementEntryUpdateOne) SetProvider(s statemententry.Provider) *StatementEntryUpdateOne {
+	seuo.mutation.SetProvider(s)
+	return seuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (seuo *StatementEntryUpdateOne) SetNillableProvider(s *statemententry.Provider) *StatementEntryUpdateOne {
+	if s != nil {
+		seuo.SetProvider(*s)
+	}
+	return seuo
+}
+
+// SetTrxID sets the "trx_id" field.
+func (seuo *StatementEntryUpdateOne) SetTrxID(s string) *StatementEntryUpdateOne {
+	seuo.mutation.SetTrxID(s)
+	return seuo
+}
+
+// SetNillableTrxID sets the "trx_id" field if the given value is not nil.
+func (seuo *StatementEntryUpdateOne) SetNillableTrxID(s *string) *StatementEntryUpdateOne {
+	if s != nil {
+		seuo.SetTrxID(*s)
+	}
+	return seuo
+}
+
+// SetAmount sets the "amount" field.
+func (seuo *StatementEntryUpdateOne) SetAmount(f float64) *StatementEntryUpdateOne {
+	seuo.mutation.ResetAmount()
+	seuo.mutation.SetAmount(f)
+	return seuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (seuo *StatementEntryUpdateOne) SetNillableAmount(f *float64) *StatementEntryUpdateOne {
+	if f != nil {
+		seuo.SetAmount(*f)
+	}
+	return seuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (seuo *StatementEntryUpdateOne) AddAmount(f float64) *StatementEntryUpdateOne {
+	seuo.mutation.AddAmount(f)
+	return seuo
+}
+
+// SetSenderNumber sets the "sender_number" field.
+func (seuo *StatementEntryUpdateOne) SetSenderNumber(s string) *StatementEntryUpdateOne {
+	seuo.mutation.SetSenderNumber(s)
+	return seuo
+}
+
+// SetNillableSenderNumber sets the "sender_number" field if the given value is not nil.
+func (seuo *StatementEntryUpdateOne) SetNillableSenderNumber(s *string) *StatementEntryUpdateOne {
+	if s != nil {
+		seuo.SetSenderNumber(*s)
+	}
+	return seuo
+}
+
+// ClearSenderNumber clears the value of the "sender_number" field.
+func (seuo *StatementEntryUpdateOne) ClearSenderNumber() *StatementEntryUpdateOne {
+	seuo.mutation.ClearSenderNumber()
+	return seuo
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (seuo *StatementEntryUpdateOne) SetReceivedAt(t time.Time) *StatementEntryUpdateOne {
+	seuo.mutation.SetReceivedAt(t)
+	return seuo
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (seuo *StatementEntryUpdateOne) SetNillableReceivedAt(t *time.Time) *StatementEntryUpdateOne {
+	if t != nil {
+		seuo.SetReceivedAt(*t)
+	}
+	return seuo
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (seuo *StatementEntryUpdateOne) ClearReceivedAt() *StatementEntryUpdateOne {
+	seuo.mutation.ClearReceivedAt()
+	return seuo
+}
+
+// SetPaymentRef sets the "payment_ref" field.
+func (seuo *StatementEntryUpdateOne) SetPaymentRef(s string) *StatementEntryUpdateOne {
+	seuo.mutation.SetPaymentRef(s)
+	return seuo
+}
+
+// SetNillablePaymentRef sets the "payment_ref" field if the given value is not nil.
+func (seuo *StatementEntryUpdateOne) SetNillablePaymentRef(s *string) *StatementEntryUpdateOne {
+	if s != nil {
+		seuo.SetPaymentRef(*s)
+	}
+	return seuo
+}
+
+// ClearPaymentRef clears the value of the "payment_ref" field.
+func (seuo *StatementEntryUpdateOne) ClearPaymentRef() *StatementEntryUpdateOne {
+	seuo.mutation.ClearPaymentRef()
+	return seuo
+}
+
+// SetImportedBy sets the "imported_by" field.
+func (seuo *StatementEntryUpdateOne) SetImportedBy(s string) *StatementEntryUpdateOne {
+	seuo.mutation.SetImportedBy(s)
+	return seuo
+}
+
+// SetNillableImportedBy sets the "imported_by" field if the given value is not nil.
+func (seuo *StatementEntryUpdateOne) SetNillableImportedBy(s *string) *StatementEntryUpdateOne {
+	if s != nil {
+		seuo.SetImportedBy(*s)
+	}
+	return seuo
+}
+
+// Mutation returns the StatementEntryMutation object of the builder.
+func (seuo *StatementEntryUpdateOne) Mutation() *StatementEntryMutation {
+	return seuo.mutation
+}
+
+// Where appends a list predicates to the StatementEntryUpdate builder.
+func (seuo *StatementEntryUpdateOne) Where(ps ...predicate.StatementEntry) *StatementEntryUpdateOne {
+	seuo.mutation.Where(ps...)
+	return seuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (seuo *StatementEntryUpdateOne) Select(field string, fields ...string) *StatementEntryUpdateOne {
+	seuo.fields = append([]string{field}, fields...)
+	return seuo
+}
+
+// Save executes the query and returns the updated StatementEntry entity.
+func (seuo *StatementEntryUpdateOne) Save(ctx context.Context) (*StatementEntry, error) {
+	return withHooks(ctx, seuo.sqlSave, seuo.mutation, seuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seuo *StatementEntryUpdateOne) SaveX(ctx context.Context) *StatementEntry {
+	node, err := seuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (seuo *StatementEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := seuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seuo *StatementEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := seuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seuo *StatementEntryUpdateOne) check() error {
+	if v, ok := seuo.mutation.Provider(); ok {
+		if err := statemententry.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.provider": %w`, err)}
+		}
+	}
+	if v, ok := seuo.mutation.TrxID(); ok {
+		if err := statemententry.TrxIDValidator(v); err != nil {
+			return &ValidationError{Name: "trx_id", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.trx_id": %w`, err)}
+		}
+	}
+	if v, ok := seuo.mutation.SenderNumber(); ok {
+		if err := statemententry.SenderNumberValidator(v); err != nil {
+			return &ValidationError{Name: "sender_number", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.sender_number": %w`, err)}
+		}
+	}
+	if v, ok := seuo.mutation.PaymentRef(); ok {
+		if err := statemententry.PaymentRefValidator(v); err != nil {
+			return &ValidationError{Name: "payment_ref", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.payment_ref": %w`, err)}
+		}
+	}
+	if v, ok := seuo.mutation.ImportedBy(); ok {
+		if err := statemententry.ImportedByValidator(v); err != nil {
+			return &ValidationError{Name: "imported_by", err: fmt.Errorf(`ent: validator failed for field "StatementEntry.imported_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seuo *StatementEntryUpdateOne) sqlSave(ctx context.Context) (_node *StatementEntry, err error) {
+	if err := seuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(statemententry.Table, statemententry.Columns, sqlgraph.NewFieldSpec(statemententry.FieldID, field.TypeInt))
+	id, ok := seuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StatementEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := seuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statemententry.FieldID)
+		for _, f := range fields {
+			if !statemententry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != statemententry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := seuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := seuo.mutation.Provider(); ok {
+		_spec.SetField(statemententry.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := seuo.mutation.TrxID(); ok {
+		_spec.SetField(statemententry.FieldTrxID, field.TypeString, value)
+	}
+	if value, ok := seuo.mutation.Amount(); ok {
+		_spec.SetField(statemententry.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := seuo.mutation.AddedAmount(); ok {
+		_spec.AddField(statemententry.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := seuo.mutation.SenderNumber(); ok {
+		_spec.SetField(statemententry.FieldSenderNumber, field.TypeString, value)
+	}
+	if seuo.mutation.SenderNumberCleared() {
+		_spec.ClearField(statemententry.FieldSenderNumber, field.TypeString)
+	}
+	if value, ok := seuo.mutation.ReceivedAt(); ok {
+		_spec.SetField(statemententry.FieldReceivedAt, field.TypeTime, value)
+	}
+	if seuo.mutation.ReceivedAtCleared() {
+		_spec.ClearField(statemententry.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := seuo.mutation.PaymentRef(); ok {
+		_spec.SetField(statemententry.FieldPaymentRef, field.TypeString, value)
+	}
+	if seuo.mutation.PaymentRefCleared() {
+		_spec.ClearField(statemententry.FieldPaymentRef, field.TypeString)
+	}
+	if value, ok := seuo.mutation.ImportedBy(); ok {
+		_spec.SetField(statemententry.FieldImportedBy, field.TypeString, value)
+	}
+	_node = &StatementEntry{config: seuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, seuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statemententry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	seuo.mutation.done = true
+	return _node, nil
+}
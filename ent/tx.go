@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,10 +14,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BalanceTransfer is the client for interacting with the BalanceTransfer builders.
+	BalanceTransfer *BalanceTransferClient
+	// ClientAddon is the client for interacting with the ClientAddon builders.
+	ClientAddon *ClientAddonClient
 	// ClientTxn is the client for interacting with the ClientTxn builders.
 	ClientTxn *ClientTxnClient
 	// ClientUser is the client for interacting with the ClientUser builders.
 	ClientUser *ClientUserClient
+	// Coupon is the client for interacting with the Coupon builders.
+	Coupon *CouponClient
+	// CouponRedemption is the client for interacting with the CouponRedemption builders.
+	CouponRedemption *CouponRedemptionClient
+	// DunningStep is the client for interacting with the DunningStep builders.
+	DunningStep *DunningStepClient
 	// EmailSubscription is the client for interacting with the EmailSubscription builders.
 	EmailSubscription *EmailSubscriptionClient
 	// EmailSubscriptionType is the client for interacting with the EmailSubscriptionType builders.
@@ -26,14 +38,22 @@ type Tx struct {
 	FCMSubscriptions *FCMSubscriptionsClient
 	// FileStorage is the client for interacting with the FileStorage builders.
 	FileStorage *FileStorageClient
+	// GracePeriod is the client for interacting with the GracePeriod builders.
+	GracePeriod *GracePeriodClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
 	// ImageSize is the client for interacting with the ImageSize builders.
 	ImageSize *ImageSizeClient
 	// Invitation is the client for interacting with the Invitation builders.
 	Invitation *InvitationClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceLine is the client for interacting with the InvoiceLine builders.
+	InvoiceLine *InvoiceLineClient
 	// LastSeenOnline is the client for interacting with the LastSeenOnline builders.
 	LastSeenOnline *LastSeenOnlineClient
+	// ManualPayment is the client for interacting with the ManualPayment builders.
+	ManualPayment *ManualPaymentClient
 	// MonthlySubscription is the client for interacting with the MonthlySubscription builders.
 	MonthlySubscription *MonthlySubscriptionClient
 	// Notification is the client for interacting with the Notification builders.
@@ -44,6 +64,8 @@ type Tx struct {
 	NotificationTime *NotificationTimeClient
 	// PackagePlan is the client for interacting with the PackagePlan builders.
 	PackagePlan *PackagePlanClient
+	// PayRequest is the client for interacting with the PayRequest builders.
+	PayRequest *PayRequestClient
 	// PhoneVerificationCode is the client for interacting with the PhoneVerificationCode builders.
 	PhoneVerificationCode *PhoneVerificationCodeClient
 	// Profile is the client for interacting with the Profile builders.
@@ -52,12 +74,44 @@ type Tx struct {
 	PwaPushSubscription *PwaPushSubscriptionClient
 	// RadAcct is the client for interacting with the RadAcct builders.
 	RadAcct *RadAcctClient
+	// RadCheck is the client for interacting with the RadCheck builders.
+	RadCheck *RadCheckClient
+	// RadGroupReply is the client for interacting with the RadGroupReply builders.
+	RadGroupReply *RadGroupReplyClient
+	// RadReply is the client for interacting with the RadReply builders.
+	RadReply *RadReplyClient
+	// RadUserGroup is the client for interacting with the RadUserGroup builders.
+	RadUserGroup *RadUserGroupClient
+	// RefundRequest is the client for interacting with the RefundRequest builders.
+	RefundRequest *RefundRequestClient
 	// SentEmail is the client for interacting with the SentEmail builders.
 	SentEmail *SentEmailClient
+	// SettlementRow is the client for interacting with the SettlementRow builders.
+	SettlementRow *SettlementRowClient
+	// StatementEntry is the client for interacting with the StatementEntry builders.
+	StatementEntry *StatementEntryClient
 	// Ticket is the client for interacting with the Ticket builders.
 	Ticket *TicketClient
+	// UsageCursor is the client for interacting with the UsageCursor builders.
+	UsageCursor *UsageCursorClient
+	// UsageDaily is the client for interacting with the UsageDaily builders.
+	UsageDaily *UsageDailyClient
+	// UsageHourly is the client for interacting with the UsageHourly builders.
+	UsageHourly *UsageHourlyClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Vendor is the client for interacting with the Vendor builders.
+	Vendor *VendorClient
+	// VendorCommission is the client for interacting with the VendorCommission builders.
+	VendorCommission *VendorCommissionClient
+	// VendorTxn is the client for interacting with the VendorTxn builders.
+	VendorTxn *VendorTxnClient
+	// Voucher is the client for interacting with the Voucher builders.
+	Voucher *VoucherClient
+	// VoucherAttempt is the client for interacting with the VoucherAttempt builders.
+	VoucherAttempt *VoucherAttemptClient
+	// VoucherBatch is the client for interacting with the VoucherBatch builders.
+	VoucherBatch *VoucherBatchClient
 
 	// lazily loaded.
 	client     *Client
@@ -189,29 +243,55 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BalanceTransfer = NewBalanceTransferClient(tx.config)
+	tx.ClientAddon = NewClientAddonClient(tx.config)
 	tx.ClientTxn = NewClientTxnClient(tx.config)
 	tx.ClientUser = NewClientUserClient(tx.config)
+	tx.Coupon = NewCouponClient(tx.config)
+	tx.CouponRedemption = NewCouponRedemptionClient(tx.config)
+	tx.DunningStep = NewDunningStepClient(tx.config)
 	tx.EmailSubscription = NewEmailSubscriptionClient(tx.config)
 	tx.EmailSubscriptionType = NewEmailSubscriptionTypeClient(tx.config)
 	tx.Emojis = NewEmojisClient(tx.config)
 	tx.FCMSubscriptions = NewFCMSubscriptionsClient(tx.config)
 	tx.FileStorage = NewFileStorageClient(tx.config)
+	tx.GracePeriod = NewGracePeriodClient(tx.config)
 	tx.Image = NewImageClient(tx.config)
 	tx.ImageSize = NewImageSizeClient(tx.config)
 	tx.Invitation = NewInvitationClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoiceLine = NewInvoiceLineClient(tx.config)
 	tx.LastSeenOnline = NewLastSeenOnlineClient(tx.config)
+	tx.ManualPayment = NewManualPaymentClient(tx.config)
 	tx.MonthlySubscription = NewMonthlySubscriptionClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
 	tx.NotificationPermission = NewNotificationPermissionClient(tx.config)
 	tx.NotificationTime = NewNotificationTimeClient(tx.config)
 	tx.PackagePlan = NewPackagePlanClient(tx.config)
+	tx.PayRequest = NewPayRequestClient(tx.config)
 	tx.PhoneVerificationCode = NewPhoneVerificationCodeClient(tx.config)
 	tx.Profile = NewProfileClient(tx.config)
 	tx.PwaPushSubscription = NewPwaPushSubscriptionClient(tx.config)
 	tx.RadAcct = NewRadAcctClient(tx.config)
+	tx.RadCheck = NewRadCheckClient(tx.config)
+	tx.RadGroupReply = NewRadGroupReplyClient(tx.config)
+	tx.RadReply = NewRadReplyClient(tx.config)
+	tx.RadUserGroup = NewRadUserGroupClient(tx.config)
+	tx.RefundRequest = NewRefundRequestClient(tx.config)
 	tx.SentEmail = NewSentEmailClient(tx.config)
+	tx.SettlementRow = NewSettlementRowClient(tx.config)
+	tx.StatementEntry = NewStatementEntryClient(tx.config)
 	tx.Ticket = NewTicketClient(tx.config)
+	tx.UsageCursor = NewUsageCursorClient(tx.config)
+	tx.UsageDaily = NewUsageDailyClient(tx.config)
+	tx.UsageHourly = NewUsageHourlyClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Vendor = NewVendorClient(tx.config)
+	tx.VendorCommission = NewVendorCommissionClient(tx.config)
+	tx.VendorTxn = NewVendorTxnClient(tx.config)
+	tx.Voucher = NewVoucherClient(tx.config)
+	tx.VoucherAttempt = NewVoucherAttemptClient(tx.config)
+	tx.VoucherBatch = NewVoucherBatchClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -221,7 +301,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ClientTxn.QueryXXX(), the query will be executed
+// applies a query, for example: BalanceTransfer.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -274,3 +354,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+)
+
+// UsageCursor is the model entity for the UsageCursor schema.
+type UsageCursor struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Acctuniqueid holds the value of the "acctuniqueid" field.
+	Acctuniqueid string `json:"acctuniqueid,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// InputOctets holds the value of the "input_octets" field.
+	InputOctets int64 `json:"input_octets,omitempty"`
+	// OutputOctets holds the value of the "output_octets" field.
+	OutputOctets int64 `json:"output_octets,omitempty"`
+	// Time of the accounting record the counters were last rolled up from
+	RolledAt     time.Time `json:"rolled_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageCursor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usagecursor.FieldID, usagecursor.FieldInputOctets, usagecursor.FieldOutputOctets:
+			values[i] = new(sql.NullInt64)
+		case usagecursor.FieldAcctuniqueid, usagecursor.FieldUsername:
+			values[i] = new(sql.NullString)
+		case usagecursor.FieldRolledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageCursor fields.
+func (uc *UsageCursor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usagecursor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uc.ID = int(value.Int64)
+		case usagecursor.FieldAcctuniqueid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acctuniqueid", values[i])
+			} else if value.Valid {
+				uc.Acctuniqueid = value.String
+			}
+		case usagecursor.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				uc.Username = value.String
+			}
+		case usagecursor.FieldInputOctets:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_octets", values[i])
+			} else if value.Valid {
+				uc.InputOctets = value.Int64
+			}
+		case usagecursor.FieldOutputOctets:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_octets", values[i])
+			} else if value.Valid {
+				uc.OutputOctets = value.Int64
+			}
+		case usagecursor.FieldRolledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rolled_at", values[i])
+			} else if value.Valid {
+				uc.RolledAt = value.Time
+			}
+		default:
+			uc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageCursor.
+// This includes values selected through modifiers, order, etc.
+func (uc *UsageCursor) Value(name string) (ent.Value, error) {
+	return uc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UsageCursor.
+// Note that you need to call UsageCursor.Unwrap() before calling this method if this UsageCursor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uc *UsageCursor) Update() *UsageCursorUpdateOne {
+	return NewUsageCursorClient(uc.config).UpdateOne(uc)
+}
+
+// Unwrap unwraps the UsageCursor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uc *UsageCursor) Unwrap() *UsageCursor {
+	_tx, ok := uc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsageCursor is not a transactional entity")
+	}
+	uc.config.driver = _tx.drv
+	return uc
+}
+
+// String implements the fmt.Stringer.
+func (uc *UsageCursor) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageCursor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uc.ID))
+	builder.WriteString("acctuniqueid=")
+	builder.WriteString(uc.Acctuniqueid)
+	builder.WriteString(", ")
+	builder.WriteString("username=")
+	builder.WriteString(uc.Username)
+	builder.WriteString(", ")
+	builder.WriteString("input_octets=")
+	builder.WriteString(fmt.Sprintf("%v", uc.InputOctets))
+	builder.WriteString(", ")
+	builder.WriteString("output_octets=")
+	builder.WriteString(fmt.Sprintf("%v", uc.OutputOctets))
+	builder.WriteString(", ")
+	builder.WriteString("rolled_at=")
+	builder.WriteString(uc.RolledAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageCursors is a parsable slice of UsageCursor.
+type UsageCursors []*UsageCursor
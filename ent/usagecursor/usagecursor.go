@@ -0,0 +1,90 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagecursor
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the usagecursor type in the database.
+	Label = "usage_cursor"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAcctuniqueid holds the string denoting the acctuniqueid field in the database.
+	FieldAcctuniqueid = "acctuniqueid"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldInputOctets holds the string denoting the input_octets field in the database.
+	FieldInputOctets = "input_octets"
+	// FieldOutputOctets holds the string denoting the output_octets field in the database.
+	FieldOutputOctets = "output_octets"
+	// FieldRolledAt holds the string denoting the rolled_at field in the database.
+	FieldRolledAt = "rolled_at"
+	// Table holds the table name of the usagecursor in the database.
+	Table = "usage_cursors"
+)
+
+// Columns holds all SQL columns for usagecursor fields.
+var Columns = []string{
+	FieldID,
+	FieldAcctuniqueid,
+	FieldUsername,
+	FieldInputOctets,
+	FieldOutputOctets,
+	FieldRolledAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AcctuniqueidValidator is a validator for the "acctuniqueid" field. It is called by the builders before save.
+	AcctuniqueidValidator func(string) error
+	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	UsernameValidator func(string) error
+	// DefaultInputOctets holds the default value on creation for the "input_octets" field.
+	DefaultInputOctets int64
+	// DefaultOutputOctets holds the default value on creation for the "output_octets" field.
+	DefaultOutputOctets int64
+)
+
+// OrderOption defines the ordering options for the UsageCursor queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAcctuniqueid orders the results by the acctuniqueid field.
+func ByAcctuniqueid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcctuniqueid, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByInputOctets orders the results by the input_octets field.
+func ByInputOctets(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputOctets, opts...).ToFunc()
+}
+
+// ByOutputOctets orders the results by the output_octets field.
+func ByOutputOctets(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputOctets, opts...).ToFunc()
+}
+
+// ByRolledAt orders the results by the rolled_at field.
+func ByRolledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRolledAt, opts...).ToFunc()
+}
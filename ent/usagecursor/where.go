@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagecursor
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLTE(FieldID, id))
+}
+
+// Acctuniqueid applies equality check predicate on the "acctuniqueid" field. It's identical to AcctuniqueidEQ.
+func Acctuniqueid(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldAcctuniqueid, v))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldUsername, v))
+}
+
+// InputOctets applies equality check predicate on the "input_octets" field. It's identical to InputOctetsEQ.
+func InputOctets(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldInputOctets, v))
+}
+
+// OutputOctets applies equality check predicate on the "output_octets" field. It's identical to OutputOctetsEQ.
+func OutputOctets(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldOutputOctets, v))
+}
+
+// RolledAt applies equality check predicate on the "rolled_at" field. It's identical to RolledAtEQ.
+func RolledAt(v time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldRolledAt, v))
+}
+
+// AcctuniqueidEQ applies the EQ predicate on the "acctuniqueid" field.
+func AcctuniqueidEQ(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidNEQ applies the NEQ predicate on the "acctuniqueid" field.
+func AcctuniqueidNEQ(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNEQ(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidIn applies the In predicate on the "acctuniqueid" field.
+func AcctuniqueidIn(vs ...string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldIn(FieldAcctuniqueid, vs...))
+}
+
+// AcctuniqueidNotIn applies the NotIn predicate on the "acctuniqueid" field.
+func AcctuniqueidNotIn(vs ...string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNotIn(FieldAcctuniqueid, vs...))
+}
+
+// AcctuniqueidGT applies the GT predicate on the "acctuniqueid" field.
+func AcctuniqueidGT(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGT(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidGTE applies the GTE predicate on the "acctuniqueid" field.
+func AcctuniqueidGTE(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGTE(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidLT applies the LT predicate on the "acctuniqueid" field.
+func AcctuniqueidLT(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLT(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidLTE applies the LTE predicate on the "acctuniqueid" field.
+func AcctuniqueidLTE(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLTE(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidContains applies the Contains predicate on the "acctuniqueid" field.
+func AcctuniqueidContains(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldContains(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidHasPrefix applies the HasPrefix predicate on the "acctuniqueid" field.
+func AcctuniqueidHasPrefix(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldHasPrefix(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidHasSuffix applies the HasSuffix predicate on the "acctuniqueid" field.
+func AcctuniqueidHasSuffix(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldHasSuffix(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidEqualFold applies the EqualFold predicate on the "acctuniqueid" field.
+func AcctuniqueidEqualFold(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEqualFold(FieldAcctuniqueid, v))
+}
+
+// AcctuniqueidContainsFold applies the ContainsFold predicate on the "acctuniqueid" field.
+func AcctuniqueidContainsFold(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldContainsFold(FieldAcctuniqueid, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// InputOctetsEQ applies the EQ predicate on the "input_octets" field.
+func InputOctetsEQ(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldInputOctets, v))
+}
+
+// InputOctetsNEQ applies the NEQ predicate on the "input_octets" field.
+func InputOctetsNEQ(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNEQ(FieldInputOctets, v))
+}
+
+// InputOctetsIn applies the In predicate on the "input_octets" field.
+func InputOctetsIn(vs ...int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldIn(FieldInputOctets, vs...))
+}
+
+// InputOctetsNotIn applies the NotIn predicate on the "input_octets" field.
+func InputOctetsNotIn(vs ...int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNotIn(FieldInputOctets, vs...))
+}
+
+// InputOctetsGT applies the GT predicate on the "input_octets" field.
+func InputOctetsGT(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGT(FieldInputOctets, v))
+}
+
+// InputOctetsGTE applies the GTE predicate on the "input_octets" field.
+func InputOctetsGTE(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGTE(FieldInputOctets, v))
+}
+
+// InputOctetsLT applies the LT predicate on the "input_octets" field.
+func InputOctetsLT(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLT(FieldInputOctets, v))
+}
+
+// InputOctetsLTE applies the LTE predicate on the "input_octets" field.
+func InputOctetsLTE(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLTE(FieldInputOctets, v))
+}
+
+// OutputOctetsEQ applies the EQ predicate on the "output_octets" field.
+func OutputOctetsEQ(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldOutputOctets, v))
+}
+
+// OutputOctetsNEQ applies the NEQ predicate on the "output_octets" field.
+func OutputOctetsNEQ(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNEQ(FieldOutputOctets, v))
+}
+
+// OutputOctetsIn applies the In predicate on the "output_octets" field.
+func OutputOctetsIn(vs ...int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldIn(FieldOutputOctets, vs...))
+}
+
+// OutputOctetsNotIn applies the NotIn predicate on the "output_octets" field.
+func OutputOctetsNotIn(vs ...int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNotIn(FieldOutputOctets, vs...))
+}
+
+// OutputOctetsGT applies the GT predicate on the "output_octets" field.
+func OutputOctetsGT(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGT(FieldOutputOctets, v))
+}
+
+// OutputOctetsGTE applies the GTE predicate on the "output_octets" field.
+func OutputOctetsGTE(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGTE(FieldOutputOctets, v))
+}
+
+// OutputOctetsLT applies the LT predicate on the "output_octets" field.
+func OutputOctetsLT(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLT(FieldOutputOctets, v))
+}
+
+// OutputOctetsLTE applies the LTE predicate on the "output_octets" field.
+func OutputOctetsLTE(v int64) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLTE(FieldOutputOctets, v))
+}
+
+// RolledAtEQ applies the EQ predicate on the "rolled_at" field.
+func RolledAtEQ(v time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldEQ(FieldRolledAt, v))
+}
+
+// RolledAtNEQ applies the NEQ predicate on the "rolled_at" field.
+func RolledAtNEQ(v time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNEQ(FieldRolledAt, v))
+}
+
+// RolledAtIn applies the In predicate on the "rolled_at" field.
+func RolledAtIn(vs ...time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldIn(FieldRolledAt, vs...))
+}
+
+// RolledAtNotIn applies the NotIn predicate on the "rolled_at" field.
+func RolledAtNotIn(vs ...time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldNotIn(FieldRolledAt, vs...))
+}
+
+// RolledAtGT applies the GT predicate on the "rolled_at" field.
+func RolledAtGT(v time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGT(FieldRolledAt, v))
+}
+
+// RolledAtGTE applies the GTE predicate on the "rolled_at" field.
+func RolledAtGTE(v time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldGTE(FieldRolledAt, v))
+}
+
+// RolledAtLT applies the LT predicate on the "rolled_at" field.
+func RolledAtLT(v time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLT(FieldRolledAt, v))
+}
+
+// RolledAtLTE applies the LTE predicate on the "rolled_at" field.
+func RolledAtLTE(v time.Time) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.FieldLTE(FieldRolledAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageCursor) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageCursor) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageCursor) predicate.UsageCursor {
+	return predicate.UsageCursor(sql.NotPredicates(p))
+}
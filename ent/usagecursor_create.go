@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+)
+
+// UsageCursorCreate is the builder for creating a UsageCursor entity.
+type UsageCursorCreate struct {
+	config
+	mutation *UsageCursorMutation
+	hooks    []Hook
+}
+
+// SetAcctuniqueid sets the "acctuniqueid" field.
+func (ucc *UsageCursorCreate) SetAcctuniqueid(s string) *UsageCursorCreate {
+	ucc.mutation.SetAcctuniqueid(s)
+	return ucc
+}
+
+// SetUsername sets the "username" field.
+func (ucc *UsageCursorCreate) SetUsername(s string) *UsageCursorCreate {
+	ucc.mutation.SetUsername(s)
+	return ucc
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (ucc *UsageCursorCreate) SetInputOctets(i int64) *UsageCursorCreate {
+	ucc.mutation.SetInputOctets(i)
+	return ucc
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (ucc *UsageCursorCreate) SetNillableInputOctets(i *int64) *UsageCursorCreate {
+	if i != nil {
+		ucc.SetInputOctets(*i)
+	}
+	return ucc
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (ucc *UsageCursorCreate) SetOutputOctets(i int64) *UsageCursorCreate {
+	ucc.mutation.SetOutputOctets(i)
+	return ucc
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (ucc *UsageCursorCreate) SetNillableOutputOctets(i *int64) *UsageCursorCreate {
+	if i != nil {
+		ucc.SetOutputOctets(*i)
+	}
+	return ucc
+}
+
+// SetRolledAt sets the "rolled_at" field.
+func (ucc *UsageCursorCreate) SetRolledAt(t time.Time) *UsageCursorCreate {
+	ucc.mutation.SetRolledAt(t)
+	return ucc
+}
+
+// Mutation returns the UsageCursorMutation object of the builder.
+func (ucc *UsageCursorCreate) Mutation() *UsageCursorMutation {
+	return ucc.mutation
+}
+
+// Save creates the UsageCursor in the database.
+func (ucc *UsageCursorCreate) Save(ctx context.Context) (*UsageCursor, error) {
+	ucc.defaults()
+	return withHooks(ctx, ucc.sqlSave, ucc.mutation, ucc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ucc *UsageCursorCreate) SaveX(ctx context.Context) *UsageCursor {
+	v, err := ucc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ucc *UsageCursorCreate) Exec(ctx context.Context) error {
+	_, err := ucc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucc *UsageCursorCreate) ExecX(ctx context.Context) {
+	if err := ucc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ucc *UsageCursorCreate) defaults() {
+	if _, ok := ucc.mutation.InputOctets(); !ok {
+		v := usagecursor.DefaultInputOctets
+		ucc.mutation.SetInputOctets(v)
+	}
+	if _, ok := ucc.mutation.OutputOctets(); !ok {
+		v := usagecursor.DefaultOutputOctets
+		ucc.mutation.SetOutputOctets(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucc *UsageCursorCreate) check() error {
+	if _, ok := ucc.mutation.Acctuniqueid(); !ok {
+		return &ValidationError{Name: "acctuniqueid", err: errors.New(`ent: missing required field "UsageCursor.acctuniqueid"`)}
+	}
+	if v, ok := ucc.mutation.Acctuniqueid(); ok {
+		if err := usagecursor.AcctuniqueidValidator(v); err != nil {
+			return &ValidationError{Name: "acctuniqueid", err: fmt.Errorf(`ent: validator failed for field "UsageCursor.acctuniqueid": %w`, err)}
+		}
+	}
+	if _, ok := ucc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "UsageCursor.username"`)}
+	}
+	if v, ok := ucc.mutation.Username(); ok {
+		if err := usagecursor.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageCursor.username": %w`, err)}
+		}
+	}
+	if _, ok := ucc.mutation.InputOctets(); !ok {
+		return &ValidationError{Name: "input_octets", err: errors.New(`ent: missing required field "UsageCursor.input_octets"`)}
+	}
+	if _, ok := ucc.mutation.OutputOctets(); !ok {
+		return &ValidationError{Name: "output_octets", err: errors.New(`ent: missing required field "UsageCursor.output_octets"`)}
+	}
+	if _, ok := ucc.mutation.RolledAt(); !ok {
+		return &ValidationError{Name: "rolled_at", err: errors.New(`ent: missing required field "UsageCursor.rolled_at"`)}
+	}
+	return nil
+}
+
+func (ucc *UsageCursorCreate) sqlSave(ctx context.Context) (*UsageCursor, error) {
+	if err := ucc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ucc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ucc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ucc.mutation.id = &_node.ID
+	ucc.mutation.done = true
+	return _node, nil
+}
+
+func (ucc *UsageCursorCreate) createSpec() (*UsageCursor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageCursor{config: ucc.config}
+		_spec = sqlgraph.NewCreateSpec(usagecursor.Table, sqlgraph.NewFieldSpec(usagecursor.FieldID, field.TypeInt))
+	)
+	if value, ok := ucc.mutation.Acctuniqueid(); ok {
+		_spec.SetField(usagecursor.FieldAcctuniqueid, field.TypeString, value)
+		_node.Acctuniqueid = value
+	}
+	if value, ok := ucc.mutation.Username(); ok {
+		_spec.SetField(usagecursor.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := ucc.mutation.InputOctets(); ok {
+		_spec.SetField(usagecursor.FieldInputOctets, field.TypeInt64, value)
+		_node.InputOctets = value
+	}
+	if value, ok := ucc.mutation.OutputOctets(); ok {
+		_spec.SetField(usagecursor.FieldOutputOctets, field.TypeInt64, value)
+		_node.OutputOctets = value
+	}
+	if value, ok := ucc.mutation.RolledAt(); ok {
+		_spec.SetField(usagecursor.FieldRolledAt, field.TypeTime, value)
+		_node.RolledAt = value
+	}
+	return _node, _spec
+}
+
+// UsageCursorCreateBulk is the builder for creating many UsageCursor entities in bulk.
+type UsageCursorCreateBulk struct {
+	config
+	err      error
+	builders []*UsageCursorCreate
+}
+
+// Save creates the UsageCursor entities in the database.
+func (uccb *UsageCursorCreateBulk) Save(ctx context.Context) ([]*UsageCursor, error) {
+	if uccb.err != nil {
+		return nil, uccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uccb.builders))
+	nodes := make([]*UsageCursor, len(uccb.builders))
+	mutators := make([]Mutator, len(uccb.builders))
+	for i := range uccb.builders {
+		func(i int, root context.Context) {
+			builder := uccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageCursorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uccb *UsageCursorCreateBulk) SaveX(ctx context.Context) []*UsageCursor {
+	v, err := uccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uccb *UsageCursorCreateBulk) Exec(ctx context.Context) error {
+	_, err := uccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uccb *UsageCursorCreateBulk) ExecX(ctx context.Context) {
+	if err := uccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+)
+
+// UsageCursorDelete is the builder for deleting a UsageCursor entity.
+type UsageCursorDelete struct {
+	config
+	hooks    []Hook
+	mutation *UsageCursorMutation
+}
+
+// Where appends a list predicates to the UsageCursorDelete builder.
+func (ucd *UsageCursorDelete) Where(ps ...predicate.UsageCursor) *UsageCursorDelete {
+	ucd.mutation.Where(ps...)
+	return ucd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ucd *UsageCursorDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ucd.sqlExec, ucd.mutation, ucd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucd *UsageCursorDelete) ExecX(ctx context.Context) int {
+	n, err := ucd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ucd *UsageCursorDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usagecursor.Table, sqlgraph.NewFieldSpec(usagecursor.FieldID, field.TypeInt))
+	if ps := ucd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ucd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ucd.mutation.done = true
+	return affected, err
+}
+
+// UsageCursorDeleteOne is the builder for deleting a single UsageCursor entity.
+type UsageCursorDeleteOne struct {
+	ucd *UsageCursorDelete
+}
+
+// Where appends a list predicates to the UsageCursorDelete builder.
+func (ucdo *UsageCursorDeleteOne) Where(ps ...predicate.UsageCursor) *UsageCursorDeleteOne {
+	ucdo.ucd.mutation.Where(ps...)
+	return ucdo
+}
+
+// Exec executes the deletion query.
+func (ucdo *UsageCursorDeleteOne) Exec(ctx context.Context) error {
+	n, err := ucdo.ucd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usagecursor.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucdo *UsageCursorDeleteOne) ExecX(ctx context.Context) {
+	if err := ucdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+)
+
+// UsageCursorQuery is the builder for querying UsageCursor entities.
+type UsageCursorQuery struct {
+	config
+	ctx        *QueryContext
+	order      []usagecursor.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UsageCursor
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UsageCursorQuery builder.
+func (ucq *UsageCursorQuery) Where(ps ...predicate.UsageCursor) *UsageCursorQuery {
+	ucq.predicates = append(ucq.predicates, ps...)
+	return ucq
+}
+
+// Limit the number of records to be returned by this query.
+func (ucq *UsageCursorQuery) Limit(limit int) *UsageCursorQuery {
+	ucq.ctx.Limit = &limit
+	return ucq
+}
+
+// Offset to start from.
+func (ucq *UsageCursorQuery) Offset(offset int) *UsageCursorQuery {
+	ucq.ctx.Offset = &offset
+	return ucq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ucq *UsageCursorQuery) Unique(unique bool) *UsageCursorQuery {
+	ucq.ctx.Unique = &unique
+	return ucq
+}
+
+// Order specifies how the records should be ordered.
+func (ucq *UsageCursorQuery) Order(o ...usagecursor.OrderOption) *UsageCursorQuery {
+	ucq.order = append(ucq.order, o...)
+	return ucq
+}
+
+// First returns the first UsageCursor entity from the query.
+// Returns a *NotFoundError when no UsageCursor was found.
+func (ucq *UsageCursorQuery) First(ctx context.Context) (*UsageCursor, error) {
+	nodes, err := ucq.Limit(1).All(setContextOp(ctx, ucq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usagecursor.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ucq *UsageCursorQuery) FirstX(ctx context.Context) *UsageCursor {
+	node, err := ucq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UsageCursor ID from the query.
+// Returns a *NotFoundError when no UsageCursor ID was found.
+func (ucq *UsageCursorQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ucq.Limit(1).IDs(setContextOp(ctx, ucq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usagecursor.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ucq *UsageCursorQuery) FirstIDX(ctx context.Context) int {
+	id, err := ucq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UsageCursor entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UsageCursor entity is found.
+// Returns a *NotFoundError when no UsageCursor entities are found.
+func (ucq *UsageCursorQuery) Only(ctx context.Context) (*UsageCursor, error) {
+	nodes, err := ucq.Limit(2).All(setContextOp(ctx, ucq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usagecursor.Label}
+	default:
+		return nil, &NotSingularError{usagecursor.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ucq *UsageCursorQuery) OnlyX(ctx context.Context) *UsageCursor {
+	node, err := ucq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UsageCursor ID in the query.
+// Returns a *NotSingularError when more than one UsageCursor ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ucq *UsageCursorQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ucq.Limit(2).IDs(setContextOp(ctx, ucq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usagecursor.Label}
+	default:
+		err = &NotSingularError{usagecursor.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ucq *UsageCursorQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ucq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UsageCursors.
+func (ucq *UsageCursorQuery) All(ctx context.Context) ([]*UsageCursor, error) {
+	ctx = setContextOp(ctx, ucq.ctx, ent.OpQueryAll)
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UsageCursor, *UsageCursorQuery]()
+	return withInterceptors[[]*UsageCursor](ctx, ucq, qr, ucq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ucq *UsageCursorQuery) AllX(ctx context.Context) []*UsageCursor {
+	nodes, err := ucq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UsageCursor IDs.
+func (ucq *UsageCursorQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ucq.ctx.Unique == nil && ucq.path != nil {
+		ucq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ucq.ctx, ent.OpQueryIDs)
+	if err = ucq.Select(usagecursor.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ucq *UsageCursorQuery) IDsX(ctx context.Context) []int {
+	ids, err := ucq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ucq *UsageCursorQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ucq.ctx, ent.OpQueryCount)
+	if err := ucq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ucq, querierCount[*UsageCursorQuery](), ucq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ucq *UsageCursorQuery) CountX(ctx context.Context) int {
+	count, err := ucq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ucq *UsageCursorQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ucq.ctx, ent.OpQueryExist)
+	switch _, err := ucq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ucq *UsageCursorQuery) ExistX(ctx context.Context) bool {
+	exist, err := ucq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UsageCursorQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ucq *UsageCursorQuery) Clone() *UsageCursorQuery {
+	if ucq == nil {
+		return nil
+	}
+	return &UsageCursorQuery{
+		config:     ucq.config,
+		ctx:        ucq.ctx.Clone(),
+		order:      append([]usagecursor.OrderOption{}, ucq.order...),
+		inters:     append([]Interceptor{}, ucq.inters...),
+		predicates: append([]predicate.UsageCursor{}, ucq.predicates...),
+		// clone intermediate query.
+		sql:  ucq.sql.Clone(),
+		path: ucq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Acctuniqueid string `json:"acctuniqueid,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UsageCursor.Query().
+//		GroupBy(usagecursor.FieldAcctuniqueid).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ucq *UsageCursorQuery) GroupBy(field string, fields ...string) *UsageCursorGroupBy {
+	ucq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UsageCursorGroupBy{build: ucq}
+	grbuild.flds = &ucq.ctx.Fields
+	grbuild.label = usagecursor.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Acctuniqueid string `json:"acctuniqueid,omitempty"`
+//	}
+//
+//	client.UsageCursor.Query().
+//		Select(usagecursor.FieldAcctuniqueid).
+//		Scan(ctx, &v)
+func (ucq *UsageCursorQuery) Select(fields ...string) *UsageCursorSelect {
+	ucq.ctx.Fields = append(ucq.ctx.Fields, fields...)
+	sbuild := &UsageCursorSelect{UsageCursorQuery: ucq}
+	sbuild.label = usagecursor.Label
+	sbuild.flds, sbuild.scan = &ucq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UsageCursorSelect configured with the given aggregations.
+func (ucq *UsageCursorQuery) Aggregate(fns ...AggregateFunc) *UsageCursorSelect {
+	return ucq.Select().Aggregate(fns...)
+}
+
+func (ucq *UsageCursorQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ucq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ucq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ucq.ctx.Fields {
+		if !usagecursor.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ucq.path != nil {
+		prev, err := ucq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ucq.sql = prev
+	}
+	return nil
+}
+
+func (ucq *UsageCursorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UsageCursor, error) {
+	var (
+		nodes = []*UsageCursor{}
+		_spec = ucq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UsageCursor).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UsageCursor{config: ucq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ucq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ucq *UsageCursorQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ucq.querySpec()
+	_spec.Node.Columns = ucq.ctx.Fields
+	if len(ucq.ctx.Fields) > 0 {
+		_spec.Unique = ucq.ctx.Unique != nil && *ucq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ucq.driver, _spec)
+}
+
+func (ucq *UsageCursorQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(usagecursor.Table, usagecursor.Columns, sqlgraph.NewFieldSpec(usagecursor.FieldID, field.TypeInt))
+	_spec.From = ucq.sql
+	if unique := ucq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ucq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ucq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagecursor.FieldID)
+		for i := range fields {
+			if fields[i] != usagecursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ucq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ucq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ucq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ucq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ucq *UsageCursorQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ucq.driver.Dialect())
+	t1 := builder.Table(usagecursor.Table)
+	columns := ucq.ctx.Fields
+	if len(columns) == 0 {
+		columns = usagecursor.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ucq.sql != nil {
+		selector = ucq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ucq.ctx.Unique != nil && *ucq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ucq.predicates {
+		p(selector)
+	}
+	for _, p := range ucq.order {
+		p(selector)
+	}
+	if offset := ucq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ucq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UsageCursorGroupBy is the group-by builder for UsageCursor entities.
+type UsageCursorGroupBy struct {
+	selector
+	build *UsageCursorQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ucgb *UsageCursorGroupBy) Aggregate(fns ...AggregateFunc) *UsageCursorGroupBy {
+	ucgb.fns = append(ucgb.fns, fns...)
+	return ucgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ucgb *UsageCursorGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ucgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ucgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UsageCursorQuery, *UsageCursorGroupBy](ctx, ucgb.build, ucgb, ucgb.build.inters, v)
+}
+
+func (ucgb *UsageCursorGroupBy) sqlScan(ctx context.Context, root *UsageCursorQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ucgb.fns))
+	for _, fn := range ucgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ucgb.flds)+len(ucgb.fns))
+		for _, f := range *ucgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ucgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ucgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UsageCursorSelect is the builder for selecting fields of UsageCursor entities.
+type UsageCursorSelect struct {
+	*UsageCursorQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ucs *UsageCursorSelect) Aggregate(fns ...AggregateFunc) *UsageCursorSelect {
+	ucs.fns = append(ucs.fns, fns...)
+	return ucs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ucs *UsageCursorSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ucs.ctx, ent.OpQuerySelect)
+	if err := ucs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UsageCursorQuery, *UsageCursorSelect](ctx, ucs.UsageCursorQuery, ucs, ucs.inters, v)
+}
+
+func (ucs *UsageCursorSelect) sqlScan(ctx context.Context, root *UsageCursorQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ucs.fns))
+	for _, fn := range ucs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ucs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ucs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
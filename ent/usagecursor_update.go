@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+)
+
+// UsageCursorUpdate is the builder for updating UsageCursor entities.
+type UsageCursorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageCursorMutation
+}
+
+// Where appends a list predicates to the UsageCursorUpdate builder.
+func (ucu *UsageCursorUpdate) Where(ps ...predicate.UsageCursor) *UsageCursorUpdate {
+	ucu.mutation.Where(ps...)
+	return ucu
+}
+
+// SetAcctuniqueid sets the "acctuniqueid" field.
+func (ucu *UsageCursorUpdate) SetAcctuniqueid(s string) *UsageCursorUpdate {
+	ucu.mutation.SetAcctuniqueid(s)
+	return ucu
+}
+
+// SetNillableAcctuniqueid sets the "acctuniqueid" field if the given value is not nil.
+func (ucu *UsageCursorUpdate) SetNillableAcctuniqueid(s *string) *UsageCursorUpdate {
+	if s != nil {
+		ucu.SetAcctuniqueid(*s)
+	}
+	return ucu
+}
+
+// SetUsername sets the "username" field.
+func (ucu *UsageCursorUpdate) SetUsername(s string) *UsageCursorUpdate {
+	ucu.mutation.SetUsername(s)
+	return ucu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (ucu *UsageCursorUpdate) SetNillableUsername(s *string) *UsageCursorUpdate {
+	if s != nil {
+		ucu.SetUsername(*s)
+	}
+	return ucu
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (ucu *UsageCursorUpdate) SetInputOctets(i int64) *UsageCursorUpdate {
+	ucu.mutation.ResetInputOctets()
+	ucu.mutation.SetInputOctets(i)
+	return ucu
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (ucu *UsageCursorUpdate) SetNillableInputOctets(i *int64) *UsageCursorUpdate {
+	if i != nil {
+		ucu.SetInputOctets(*i)
+	}
+	return ucu
+}
+
+// AddInputOctets adds i to the "input_octets" field.
+func (ucu *UsageCursorUpdate) AddInputOctets(i int64) *UsageCursorUpdate {
+	ucu.mutation.AddInputOctets(i)
+	return ucu
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (ucu *UsageCursorUpdate) SetOutputOctets(i int64) *UsageCursorUpdate {
+	ucu.mutation.ResetOutputOctets()
+	ucu.mutation.SetOutputOctets(i)
+	return ucu
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (ucu *UsageCursorUpdate) SetNillableOutputOctets(i *int64) *UsageCursorUpdate {
+	if i != nil {
+		ucu.SetOutputOctets(*i)
+	}
+	return ucu
+}
+
+// AddOutputOctets adds i to the "output_octets" field.
+func (ucu *UsageCursorUpdate) AddOutputOctets(i int64) *UsageCursorUpdate {
+	ucu.mutation.AddOutputOctets(i)
+	return ucu
+}
+
+// SetRolledAt sets the "rolled_at" field.
+func (ucu *UsageCursorUpdate) SetRolledAt(t time.Time) *UsageCursorUpdate {
+	ucu.mutation.SetRolledAt(t)
+	return ucu
+}
+
+// SetNillableRolledAt sets the "rolled_at" field if the given value is not nil.
+func (ucu *UsageCursorUpdate) SetNillableRolledAt(t *time.Time) *UsageCursorUpdate {
+	if t != nil {
+		ucu.SetRolledAt(*t)
+	}
+	return ucu
+}
+
+// Mutation returns the UsageCursorMutation object of the builder.
+func (ucu *UsageCursorUpdate) Mutation() *UsageCursorMutation {
+	return ucu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ucu *UsageCursorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ucu.sqlSave, ucu.mutation, ucu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucu *UsageCursorUpdate) SaveX(ctx context.Context) int {
+	affected, err := ucu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ucu *UsageCursorUpdate) Exec(ctx context.Context) error {
+	_, err := ucu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucu *UsageCursorUpdate) ExecX(ctx context.Context) {
+	if err := ucu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucu *UsageCursorUpdate) check() error {
+	if v, ok := ucu.mutation.Acctuniqueid(); ok {
+		if err := usagecursor.AcctuniqueidValidator(v); err != nil {
+			return &ValidationError{Name: "acctuniqueid", err: fmt.Errorf(`ent: validator failed for field "UsageCursor.acctuniqueid": %w`, err)}
+		}
+	}
+	if v, ok := ucu.mutation.Username(); ok {
+		if err := usagecursor.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageCursor.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ucu *UsageCursorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ucu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagecursor.Table, usagecursor.Columns, sqlgraph.NewFieldSpec(usagecursor.FieldID, field.TypeInt))
+	if ps := ucu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ucu.mutation.Acctuniqueid(); ok {
+		_spec.SetField(usagecursor.FieldAcctuniqueid, field.TypeString, value)
+	}
+	if value, ok := ucu.mutation.Username(); ok {
+		_spec.SetField(usagecursor.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := ucu.mutation.InputOctets(); ok {
+		_spec.SetField(usagecursor.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := ucu.mutation.AddedInputOctets(); ok {
+		_spec.AddField(usagecursor.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := ucu.mutation.OutputOctets(); ok {
+		_spec.SetField(usagecursor.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if value, ok := ucu.mutation.AddedOutputOctets(); ok {
+		_spec.AddField(usagecursor.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if value, ok := ucu.mutation.RolledAt(); ok {
+		_spec.SetField(usagecursor.FieldRolledAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ucu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagecursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ucu.mutation.done = true
+	return n, nil
+}
+
+// UsageCursorUpdateOne is the builder for updating a single UsageCursor entity.
+type UsageCursorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageCursorMutation
+}
+
+// SetAcctuniqueid sets the "acctuniqueid" field.
+func (ucuo *UsageCursorUpdateOne) SetAcctuniqueid(s string) *UsageCursorUpdateOne {
+	ucuo.mutation.SetAcctuniqueid(s)
+	return ucuo
+}
+
+// SetNillableAcctuniqueid sets the "acctuniqueid" field if the given value is not nil.
+func (ucuo *UsageCursorUpdateOne) SetNillableAcctuniqueid(s *string) *UsageCursorUpdateOne {
+	if s != nil {
+		ucuo.SetAcctuniqueid(*s)
+	}
+	return ucuo
+}
+
+// SetUsername sets the "username" field.
+func (ucuo *UsageCursorUpdateOne) SetUsername(s string) *UsageCursorUpdateOne {
+	ucuo.mutation.SetUsername(s)
+	return ucuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (ucuo *UsageCursorUpdateOne) SetNillableUsername(s *string) *UsageCursorUpdateOne {
+	if s != nil {
+		ucuo.SetUsername(*s)
+	}
+	return ucuo
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (ucuo *UsageCursorUpdateOne) SetInputOctets(i int64) *UsageCursorUpdateOne {
+	ucuo.mutation.ResetInputOctets()
+	ucuo.mutation.SetInputOctets(i)
+	return ucuo
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (ucuo *UsageCursorUpdateOne) SetNillableInputOctets(i *int64) *UsageCursorUpdateOne {
+	if i != nil {
+		ucuo.SetInputOctets(*i)
+	}
+	return ucuo
+}
+
+// AddInputOctets adds i to the "input_octets" field.
+func (ucuo *UsageCursorUpdateOne) AddInputOctets(i int64) *UsageCursorUpdateOne {
+	ucuo.mutation.AddInputOctets(i)
+	return ucuo
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (ucuo *UsageCursorUpdateOne) SetOutputOctets(i int64) *UsageCursorUpdateOne {
+	ucuo.mutation.ResetOutputOctets()
+	ucuo.mutation.SetOutputOctets(i)
+	return ucuo
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (ucuo *UsageCursorUpdateOne) SetNillableOutputOctets(i *int64) *UsageCursorUpdateOne {
+	if i != nil {
+		ucuo.SetOutputOctets(*i)
+	}
+	return ucuo
+}
+
+// AddOutputOctets adds i to the "output_octets" field.
+func (ucuo *UsageCursorUpdateOne) AddOutputOctets(i int64) *UsageCursorUpdateOne {
+	ucuo.mutation.AddOutputOctets(i)
+	return ucuo
+}
+
+// SetRolledAt sets the "rolled_at" field.
+func (ucuo *UsageCursorUpdateOne) SetRolledAt(t time.Time) *UsageCursorUpdateOne {
+	ucuo.mutation.SetRolledAt(t)
+	return ucuo
+}
+
+// SetNillableRolledAt sets the "rolled_at" field if the given value is not nil.
+func (ucuo *UsageCursorUpdateOne) SetNillableRolledAt(t *time.Time) *UsageCursorUpdateOne {
+	if t != nil {
+		ucuo.SetRolledAt(*t)
+	}
+	return ucuo
+}
+
+// Mutation returns the UsageCursorMutation object of the builder.
+func (ucuo *UsageCursorUpdateOne) Mutation() *UsageCursorMutation {
+	return ucuo.mutation
+}
+
+// Where appends a list predicates to the UsageCursorUpdate builder.
+func (ucuo *UsageCursorUpdateOne) Where(ps ...predicate.UsageCursor) *UsageCursorUpdateOne {
+	ucuo.mutation.Where(ps...)
+	return ucuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ucuo *UsageCursorUpdateOne) Select(field string, fields ...string) *UsageCursorUpdateOne {
+	ucuo.fields = append([]string{field}, fields...)
+	return ucuo
+}
+
+// Save executes the query and returns the updated UsageCursor entity.
+func (ucuo *UsageCursorUpdateOne) Save(ctx context.Context) (*UsageCursor, error) {
+	return withHooks(ctx, ucuo.sqlSave, ucuo.mutation, ucuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucuo *UsageCursorUpdateOne) SaveX(ctx context.Context) *UsageCursor {
+	node, err := ucuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ucuo *UsageCursorUpdateOne) Exec(ctx context.Context) error {
+	_, err := ucuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ucuo *UsageCursorUpdateOne) ExecX(ctx context.Context) {
+	if err := ucuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ucuo *UsageCursorUpdateOne) check() error {
+	if v, ok := ucuo.mutation.Acctuniqueid(); ok {
+		if err := usagecursor.AcctuniqueidValidator(v); err != nil {
+			return &ValidationError{Name: "acctuniqueid", err: fmt.Errorf(`ent: validator failed for field "UsageCursor.acctuniqueid": %w`, err)}
+		}
+	}
+	if v, ok := ucuo.mutation.Username(); ok {
+		if err := usagecursor.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageCursor.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ucuo *UsageCursorUpdateOne) sqlSave(ctx context.Context) (_node *UsageCursor, err error) {
+	if err := ucuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagecursor.Table, usagecursor.Columns, sqlgraph.NewFieldSpec(usagecursor.FieldID, field.TypeInt))
+	id, ok := ucuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageCursor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ucuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagecursor.FieldID)
+		for _, f := range fields {
+			if !usagecursor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagecursor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ucuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ucuo.mutation.Acctuniqueid(); ok {
+		_spec.SetField(usagecursor.FieldAcctuniqueid, field.TypeString, value)
+	}
+	if value, ok := ucuo.mutation.Username(); ok {
+		_spec.SetField(usagecursor.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := ucuo.mutation.InputOctets(); ok {
+		_spec.SetField(usagecursor.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := ucuo.mutation.AddedInputOctets(); ok {
+		_spec.AddField(usagecursor.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := ucuo.mutation.OutputOctets(); ok {
+		_spec.SetField(usagecursor.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if value, ok := ucuo.mutation.AddedOutputOctets(); ok {
+		_spec.AddField(usagecursor.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if value, ok := ucuo.mutation.RolledAt(); ok {
+		_spec.SetField(usagecursor.FieldRolledAt, field.TypeTime, value)
+	}
+	_node = &UsageCursor{config: ucuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ucuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagecursor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ucuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+)
+
+// UsageDaily is the model entity for the UsageDaily schema.
+type UsageDaily struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Local midnight starting the day
+	Day time.Time `json:"day,omitempty"`
+	// Octets the client sent, Acct-Input-Octets
+	InputOctets int64 `json:"input_octets,omitempty"`
+	// Octets the client received, Acct-Output-Octets
+	OutputOctets int64 `json:"output_octets,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageDaily) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usagedaily.FieldID, usagedaily.FieldInputOctets, usagedaily.FieldOutputOctets:
+			values[i] = new(sql.NullInt64)
+		case usagedaily.FieldUsername:
+			values[i] = new(sql.NullString)
+		case usagedaily.FieldDay:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageDaily fields.
+func (ud *UsageDaily) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usagedaily.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ud.ID = int(value.Int64)
+		case usagedaily.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				ud.Username = value.String
+			}
+		case usagedaily.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				ud.Day = value.Time
+			}
+		case usagedaily.FieldInputOctets:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_octets", values[i])
+			} else if value.Valid {
+				ud.InputOctets = value.Int64
+			}
+		case usagedaily.FieldOutputOctets:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_octets", values[i])
+			} else if value.Valid {
+				ud.OutputOctets = value.Int64
+			}
+		default:
+			ud.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageDaily.
+// This includes values selected through modifiers, order, etc.
+func (ud *UsageDaily) Value(name string) (ent.Value, error) {
+	return ud.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UsageDaily.
+// Note that you need to call UsageDaily.Unwrap() before calling this method if this UsageDaily
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ud *UsageDaily) Update() *UsageDailyUpdateOne {
+	return NewUsageDailyClient(ud.config).UpdateOne(ud)
+}
+
+// Unwrap unwraps the UsageDaily entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ud *UsageDaily) Unwrap() *UsageDaily {
+	_tx, ok := ud.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsageDaily is not a transactional entity")
+	}
+	ud.config.driver = _tx.drv
+	return ud
+}
+
+// String implements the fmt.Stringer.
+func (ud *UsageDaily) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageDaily(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ud.ID))
+	builder.WriteString("username=")
+	builder.WriteString(ud.Username)
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(ud.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("input_octets=")
+	builder.WriteString(fmt.Sprintf("%v", ud.InputOctets))
+	builder.WriteString(", ")
+	builder.WriteString("output_octets=")
+	builder.WriteString(fmt.Sprintf("%v", ud.OutputOctets))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageDailies is a parsable slice of UsageDaily.
+type UsageDailies []*UsageDaily
@@ -0,0 +1,80 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagedaily
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the usagedaily type in the database.
+	Label = "usage_daily"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUsername holds the string denoting the username field in the database.
+	FieldUsername = "username"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldInputOctets holds the string denoting the input_octets field in the database.
+	FieldInputOctets = "input_octets"
+	// FieldOutputOctets holds the string denoting the output_octets field in the database.
+	FieldOutputOctets = "output_octets"
+	// Table holds the table name of the usagedaily in the database.
+	Table = "usage_dailies"
+)
+
+// Columns holds all SQL columns for usagedaily fields.
+var Columns = []string{
+	FieldID,
+	FieldUsername,
+	FieldDay,
+	FieldInputOctets,
+	FieldOutputOctets,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	UsernameValidator func(string) error
+	// DefaultInputOctets holds the default value on creation for the "input_octets" field.
+	DefaultInputOctets int64
+	// DefaultOutputOctets holds the default value on creation for the "output_octets" field.
+	DefaultOutputOctets int64
+)
+
+// OrderOption defines the ordering options for the UsageDaily queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUsername orders the results by the username field.
+func ByUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsername, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByInputOctets orders the results by the input_octets field.
+func ByInputOctets(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputOctets, opts...).ToFunc()
+}
+
+// ByOutputOctets orders the results by the output_octets field.
+func ByOutputOctets(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputOctets, opts...).ToFunc()
+}
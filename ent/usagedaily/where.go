@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagedaily
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLTE(FieldID, id))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldUsername, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldDay, v))
+}
+
+// InputOctets applies equality check predicate on the "input_octets" field. It's identical to InputOctetsEQ.
+func InputOctets(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldInputOctets, v))
+}
+
+// OutputOctets applies equality check predicate on the "output_octets" field. It's identical to OutputOctetsEQ.
+func OutputOctets(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldOutputOctets, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLTE(FieldDay, v))
+}
+
+// InputOctetsEQ applies the EQ predicate on the "input_octets" field.
+func InputOctetsEQ(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldInputOctets, v))
+}
+
+// InputOctetsNEQ applies the NEQ predicate on the "input_octets" field.
+func InputOctetsNEQ(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNEQ(FieldInputOctets, v))
+}
+
+// InputOctetsIn applies the In predicate on the "input_octets" field.
+func InputOctetsIn(vs ...int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldIn(FieldInputOctets, vs...))
+}
+
+// InputOctetsNotIn applies the NotIn predicate on the "input_octets" field.
+func InputOctetsNotIn(vs ...int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNotIn(FieldInputOctets, vs...))
+}
+
+// InputOctetsGT applies the GT predicate on the "input_octets" field.
+func InputOctetsGT(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGT(FieldInputOctets, v))
+}
+
+// InputOctetsGTE applies the GTE predicate on the "input_octets" field.
+func InputOctetsGTE(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGTE(FieldInputOctets, v))
+}
+
+// InputOctetsLT applies the LT predicate on the "input_octets" field.
+func InputOctetsLT(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLT(FieldInputOctets, v))
+}
+
+// InputOctetsLTE applies the LTE predicate on the "input_octets" field.
+func InputOctetsLTE(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLTE(FieldInputOctets, v))
+}
+
+// OutputOctetsEQ applies the EQ predicate on the "output_octets" field.
+func OutputOctetsEQ(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldEQ(FieldOutputOctets, v))
+}
+
+// OutputOctetsNEQ applies the NEQ predicate on the "output_octets" field.
+func OutputOctetsNEQ(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNEQ(FieldOutputOctets, v))
+}
+
+// OutputOctetsIn applies the In predicate on the "output_octets" field.
+func OutputOctetsIn(vs ...int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldIn(FieldOutputOctets, vs...))
+}
+
+// OutputOctetsNotIn applies the NotIn predicate on the "output_octets" field.
+func OutputOctetsNotIn(vs ...int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldNotIn(FieldOutputOctets, vs...))
+}
+
+// OutputOctetsGT applies the GT predicate on the "output_octets" field.
+func OutputOctetsGT(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGT(FieldOutputOctets, v))
+}
+
+// OutputOctetsGTE applies the GTE predicate on the "output_octets" field.
+func OutputOctetsGTE(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldGTE(FieldOutputOctets, v))
+}
+
+// OutputOctetsLT applies the LT predicate on the "output_octets" field.
+func OutputOctetsLT(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLT(FieldOutputOctets, v))
+}
+
+// OutputOctetsLTE applies the LTE predicate on the "output_octets" field.
+func OutputOctetsLTE(v int64) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.FieldLTE(FieldOutputOctets, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageDaily) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageDaily) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageDaily) predicate.UsageDaily {
+	return predicate.UsageDaily(sql.NotPredicates(p))
+}
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+)
+
+// UsageDailyCreate is the builder for creating a UsageDaily entity.
+type UsageDailyCreate struct {
+	config
+	mutation *UsageDailyMutation
+	hooks    []Hook
+}
+
+// SetUsername sets the "username" field.
+func (udc *UsageDailyCreate) SetUsername(s string) *UsageDailyCreate {
+	udc.mutation.SetUsername(s)
+	return udc
+}
+
+// SetDay sets the "day" field.
+func (udc *UsageDailyCreate) SetDay(t time.Time) *UsageDailyCreate {
+	udc.mutation.SetDay(t)
+	return udc
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (udc *UsageDailyCreate) SetInputOctets(i int64) *UsageDailyCreate {
+	udc.mutation.SetInputOctets(i)
+	return udc
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (udc *UsageDailyCreate) SetNillableInputOctets(i *int64) *UsageDailyCreate {
+	if i != nil {
+		udc.SetInputOctets(*i)
+	}
+	return udc
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (udc *UsageDailyCreate) SetOutputOctets(i int64) *UsageDailyCreate {
+	udc.mutation.SetOutputOctets(i)
+	return udc
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (udc *UsageDailyCreate) SetNillableOutputOctets(i *int64) *UsageDailyCreate {
+	if i != nil {
+		udc.SetOutputOctets(*i)
+	}
+	return udc
+}
+
+// Mutation returns the UsageDailyMutation object of the builder.
+func (udc *UsageDailyCreate) Mutation() *UsageDailyMutation {
+	return udc.mutation
+}
+
+// Save creates the UsageDaily in the database.
+func (udc *UsageDailyCreate) Save(ctx context.Context) (*UsageDaily, error) {
+	udc.defaults()
+	return withHooks(ctx, udc.sqlSave, udc.mutation, udc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (udc *UsageDailyCreate) SaveX(ctx context.Context) *UsageDaily {
+	v, err := udc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (udc *UsageDailyCreate) Exec(ctx context.Context) error {
+	_, err := udc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udc *UsageDailyCreate) ExecX(ctx context.Context) {
+	if err := udc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (udc *UsageDailyCreate) defaults() {
+	if _, ok := udc.mutation.InputOctets(); !ok {
+		v := usagedaily.DefaultInputOctets
+		udc.mutation.SetInputOctets(v)
+	}
+	if _, ok := udc.mutation.OutputOctets(); !ok {
+		v := usagedaily.DefaultOutputOctets
+		udc.mutation.SetOutputOctets(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (udc *UsageDailyCreate) check() error {
+	if _, ok := udc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "UsageDaily.username"`)}
+	}
+	if v, ok := udc.mutation.Username(); ok {
+		if err := usagedaily.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageDaily.username": %w`, err)}
+		}
+	}
+	if _, ok := udc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "UsageDaily.day"`)}
+	}
+	if _, ok := udc.mutation.InputOctets(); !ok {
+		return &ValidationError{Name: "input_octets", err: errors.New(`ent: missing required field "UsageDaily.input_octets"`)}
+	}
+	if _, ok := udc.mutation.OutputOctets(); !ok {
+		return &ValidationError{Name: "output_octets", err: errors.New(`ent: missing required field "UsageDaily.output_octets"`)}
+	}
+	return nil
+}
+
+func (udc *UsageDailyCreate) sqlSave(ctx context.Context) (*UsageDaily, error) {
+	if err := udc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := udc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, udc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	udc.mutation.id = &_node.ID
+	udc.mutation.done = true
+	return _node, nil
+}
+
+func (udc *UsageDailyCreate) createSpec() (*UsageDaily, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageDaily{config: udc.config}
+		_spec = sqlgraph.NewCreateSpec(usagedaily.Table, sqlgraph.NewFieldSpec(usagedaily.FieldID, field.TypeInt))
+	)
+	if value, ok := udc.mutation.Username(); ok {
+		_spec.SetField(usagedaily.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := udc.mutation.Day(); ok {
+		_spec.SetField(usagedaily.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := udc.mutation.InputOctets(); ok {
+		_spec.SetField(usagedaily.FieldInputOctets, field.TypeInt64, value)
+		_node.InputOctets = value
+	}
+	if value, ok := udc.mutation.OutputOctets(); ok {
+		_spec.SetField(usagedaily.FieldOutputOctets, field.TypeInt64, value)
+		_node.OutputOctets = value
+	}
+	return _node, _spec
+}
+
+// UsageDailyCreateBulk is the builder for creating many UsageDaily entities in bulk.
+type UsageDailyCreateBulk struct {
+	config
+	err      error
+	builders []*UsageDailyCreate
+}
+
+// Save creates the UsageDaily entities in the database.
+func (udcb *UsageDailyCreateBulk) Save(ctx context.Context) ([]*UsageDaily, error) {
+	if udcb.err != nil {
+		return nil, udcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(udcb.builders))
+	nodes := make([]*UsageDaily, len(udcb.builders))
+	mutators := make([]Mutator, len(udcb.builders))
+	for i := range udcb.builders {
+		func(i int, root context.Context) {
+			builder := udcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageDailyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, udcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, udcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, udcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (udcb *UsageDailyCreateBulk) SaveX(ctx context.Context) []*UsageDaily {
+	v, err := udcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (udcb *UsageDailyCreateBulk) Exec(ctx context.Context) error {
+	_, err := udcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udcb *UsageDailyCreateBulk) ExecX(ctx context.Context) {
+	if err := udcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+)
+
+// UsageDailyDelete is the builder for deleting a UsageDaily entity.
+type UsageDailyDelete struct {
+	config
+	hooks    []Hook
+	mutation *UsageDailyMutation
+}
+
+// Where appends a list predicates to the UsageDailyDelete builder.
+func (udd *UsageDailyDelete) Where(ps ...predicate.UsageDaily) *UsageDailyDelete {
+	udd.mutation.Where(ps...)
+	return udd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (udd *UsageDailyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, udd.sqlExec, udd.mutation, udd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udd *UsageDailyDelete) ExecX(ctx context.Context) int {
+	n, err := udd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (udd *UsageDailyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usagedaily.Table, sqlgraph.NewFieldSpec(usagedaily.FieldID, field.TypeInt))
+	if ps := udd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, udd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	udd.mutation.done = true
+	return affected, err
+}
+
+// UsageDailyDeleteOne is the builder for deleting a single UsageDaily entity.
+type UsageDailyDeleteOne struct {
+	udd *UsageDailyDelete
+}
+
+// Where appends a list predicates to the UsageDailyDelete builder.
+func (uddo *UsageDailyDeleteOne) Where(ps ...predicate.UsageDaily) *UsageDailyDeleteOne {
+	uddo.udd.mutation.Where(ps...)
+	return uddo
+}
+
+// Exec executes the deletion query.
+func (uddo *UsageDailyDeleteOne) Exec(ctx context.Context) error {
+	n, err := uddo.udd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usagedaily.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uddo *UsageDailyDeleteOne) ExecX(ctx context.Context) {
+	if err := uddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
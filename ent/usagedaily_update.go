@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+)
+
+// UsageDailyUpdate is the builder for updating UsageDaily entities.
+type UsageDailyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageDailyMutation
+}
+
+// Where appends a list predicates to the UsageDailyUpdate builder.
+func (udu *UsageDailyUpdate) Where(ps ...predicate.UsageDaily) *UsageDailyUpdate {
+	udu.mutation.Where(ps...)
+	return udu
+}
+
+// SetUsername sets the "username" field.
+func (udu *UsageDailyUpdate) SetUsername(s string) *UsageDailyUpdate {
+	udu.mutation.SetUsername(s)
+	return udu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (udu *UsageDailyUpdate) SetNillableUsername(s *string) *UsageDailyUpdate {
+	if s != nil {
+		udu.SetUsername(*s)
+	}
+	return udu
+}
+
+// SetDay sets the "day" field.
+func (udu *UsageDailyUpdate) SetDay(t time.Time) *UsageDailyUpdate {
+	udu.mutation.SetDay(t)
+	return udu
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (udu *UsageDailyUpdate) SetNillableDay(t *time.Time) *UsageDailyUpdate {
+	if t != nil {
+		udu.SetDay(*t)
+	}
+	return udu
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (udu *UsageDailyUpdate) SetInputOctets(i int64) *UsageDailyUpdate {
+	udu.mutation.ResetInputOctets()
+	udu.mutation.SetInputOctets(i)
+	return udu
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (udu *UsageDailyUpdate) SetNillableInputOctets(i *int64) *UsageDailyUpdate {
+	if i != nil {
+		udu.SetInputOctets(*i)
+	}
+	return udu
+}
+
+// AddInputOctets adds i to the "input_octets" field.
+func (udu *UsageDailyUpdate) AddInputOctets(i int64) *UsageDailyUpdate {
+	udu.mutation.AddInputOctets(i)
+	return udu
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (udu *UsageDailyUpdate) SetOutputOctets(i int64) *UsageDailyUpdate {
+	udu.mutation.ResetOutputOctets()
+	udu.mutation.SetOutputOctets(i)
+	return udu
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (udu *UsageDailyUpdate) SetNillableOutputOctets(i *int64) *UsageDailyUpdate {
+	if i != nil {
+		udu.SetOutputOctets(*i)
+	}
+	return udu
+}
+
+// AddOutputOctets adds i to the "output_octets" field.
+func (udu *UsageDailyUpdate) AddOutputOctets(i int64) *UsageDailyUpdate {
+	udu.mutation.AddOutputOctets(i)
+	return udu
+}
+
+// Mutation returns the UsageDailyMutation object of the builder.
+func (udu *UsageDailyUpdate) Mutation() *UsageDailyMutation {
+	return udu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (udu *UsageDailyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, udu.sqlSave, udu.mutation, udu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (udu *UsageDailyUpdate) SaveX(ctx context.Context) int {
+	affected, err := udu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (udu *UsageDailyUpdate) Exec(ctx context.Context) error {
+	_, err := udu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udu *UsageDailyUpdate) ExecX(ctx context.Context) {
+	if err := udu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (udu *UsageDailyUpdate) check() error {
+	if v, ok := udu.mutation.Username(); ok {
+		if err := usagedaily.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageDaily.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (udu *UsageDailyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := udu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagedaily.Table, usagedaily.Columns, sqlgraph.NewFieldSpec(usagedaily.FieldID, field.TypeInt))
+	if ps := udu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := udu.mutation.Username(); ok {
+		_spec.SetField(usagedaily.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := udu.mutation.Day(); ok {
+		_spec.SetField(usagedaily.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := udu.mutation.InputOctets(); ok {
+		_spec.SetField(usagedaily.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := udu.mutation.AddedInputOctets(); ok {
+		_spec.AddField(usagedaily.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := udu.mutation.OutputOctets(); ok {
+		_spec.SetField(usagedaily.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if value, ok := udu.mutation.AddedOutputOctets(); ok {
+		_spec.AddField(usagedaily.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, udu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagedaily.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	udu.mutation.done = true
+	return n, nil
+}
+
+// UsageDailyUpdateOne is the builder for updating a single UsageDaily entity.
+type UsageDailyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageDailyMutation
+}
+
+// SetUsername sets the "username" field.
+func (uduo *UsageDailyUpdateOne) SetUsername(s string) *UsageDailyUpdateOne {
+	uduo.mutation.SetUsername(s)
+	return uduo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uduo *UsageDailyUpdateOne) SetNillableUsername(s *string) *UsageDailyUpdateOne {
+	if s != nil {
+		uduo.SetUsername(*s)
+	}
+	return uduo
+}
+
+// SetDay sets the "day" field.
+func (uduo *UsageDailyUpdateOne) SetDay(t time.Time) *UsageDailyUpdateOne {
+	uduo.mutation.SetDay(t)
+	return uduo
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (uduo *UsageDailyUpdateOne) SetNillableDay(t *time.Time) *UsageDailyUpdateOne {
+	if t != nil {
+		uduo.SetDay(*t)
+	}
+	return uduo
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (uduo *UsageDailyUpdateOne) SetInputOctets(i int64) *UsageDailyUpdateOne {
+	uduo.mutation.ResetInputOctets()
+	uduo.mutation.SetInputOctets(i)
+	return uduo
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (uduo *UsageDailyUpdateOne) SetNillableInputOctets(i *int64) *UsageDailyUpdateOne {
+	if i != nil {
+		uduo.SetInputOctets(*i)
+	}
+	return uduo
+}
+
+// AddInputOctets adds i to the "input_octets" field.
+func (uduo *UsageDailyUpdateOne) AddInputOctets(i int64) *UsageDailyUpdateOne {
+	uduo.mutation.AddInputOctets(i)
+	return uduo
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (uduo *UsageDailyUpdateOne) SetOutputOctets(i int64) *UsageDailyUpdateOne {
+	uduo.mutation.ResetOutputOctets()
+	uduo.mutation.SetOutputOctets(i)
+	return uduo
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (uduo *UsageDailyUpdateOne) SetNillableOutputOctets(i *int64) *UsageDailyUpdateOne {
+	if i != nil {
+		uduo.SetOutputOctets(*i)
+	}
+	return uduo
+}
+
+// AddOutputOctets adds i to the "output_octets" field.
+func (uduo *UsageDailyUpdateOne) AddOutputOctets(i int64) *UsageDailyUpdateOne {
+	uduo.mutation.AddOutputOctets(i)
+	return uduo
+}
+
+// Mutation returns the UsageDailyMutation object of the builder.
+func (uduo *UsageDailyUpdateOne) Mutation() *UsageDailyMutation {
+	return uduo.mutation
+}
+
+// Where appends a list predicates to the UsageDailyUpdate builder.
+func (uduo *UsageDailyUpdateOne) Where(ps ...predicate.UsageDaily) *UsageDailyUpdateOne {
+	uduo.mutation.Where(ps...)
+	return uduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uduo *UsageDailyUpdateOne) Select(field string, fields ...string) *UsageDailyUpdateOne {
+	uduo.fields = append([]string{field}, fields...)
+	return uduo
+}
+
+// Save executes the query and returns the updated UsageDaily entity.
+func (uduo *UsageDailyUpdateOne) Save(ctx context.Context) (*UsageDaily, error) {
+	return withHooks(ctx, uduo.sqlSave, uduo.mutation, uduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uduo *UsageDailyUpdateOne) SaveX(ctx context.Context) *UsageDaily {
+	node, err := uduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uduo *UsageDailyUpdateOne) Exec(ctx context.Context) error {
+	_, err := uduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uduo *UsageDailyUpdateOne) ExecX(ctx context.Context) {
+	if err := uduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uduo *UsageDailyUpdateOne) check() error {
+	if v, ok := uduo.mutation.Username(); ok {
+		if err := usagedaily.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageDaily.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uduo *UsageDailyUpdateOne) sqlSave(ctx context.Context) (_node *UsageDaily, err error) {
+	if err := uduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagedaily.Table, usagedaily.Columns, sqlgraph.NewFieldSpec(usagedaily.FieldID, field.TypeInt))
+	id, ok := uduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageDaily.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagedaily.FieldID)
+		for _, f := range fields {
+			if !usagedaily.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagedaily.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uduo.mutation.Username(); ok {
+		_spec.SetField(usagedaily.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uduo.mutation.Day(); ok {
+		_spec.SetField(usagedaily.FieldDay, field.TypeTime, value)
+	}
+	if value, ok := uduo.mutation.InputOctets(); ok {
+		_spec.SetField(usagedaily.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uduo.mutation.AddedInputOctets(); ok {
+		_spec.AddField(usagedaily.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uduo.mutation.OutputOctets(); ok {
+		_spec.SetField(usagedaily.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uduo.mutation.AddedOutputOctets(); ok {
+		_spec.AddField(usagedaily.FieldOutputOctets, field.TypeInt64, value)
+	}
+	_node = &UsageDaily{config: uduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagedaily.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
+)
+
+// UsageHourly is the model entity for the UsageHourly schema.
+type UsageHourly struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Username holds the value of the "username" field.
+	Username string `json:"username,omitempty"`
+	// Start of the hour, in local time
+	Hour time.Time `json:"hour,omitempty"`
+	// Octets the client sent, Acct-Input-Octets
+	InputOctets int64 `json:"input_octets,omitempty"`
+	// Octets the client received, Acct-Output-Octets
+	OutputOctets int64 `json:"output_octets,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UsageHourly) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usagehourly.FieldID, usagehourly.FieldInputOctets, usagehourly.FieldOutputOctets:
+			values[i] = new(sql.NullInt64)
+		case usagehourly.FieldUsername:
+			values[i] = new(sql.NullString)
+		case usagehourly.FieldHour:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UsageHourly fields.
+func (uh *UsageHourly) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usagehourly.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			uh.ID = int(value.Int64)
+		case usagehourly.FieldUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field username", values[i])
+			} else if value.Valid {
+				uh.Username = value.String
+			}
+		case usagehourly.FieldHour:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field hour", values[i])
+			} else if value.Valid {
+				uh.Hour = value.Time
+			}
+		case usagehourly.FieldInputOctets:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_octets", values[i])
+			} else if value.Valid {
+				uh.InputOctets = value.Int64
+			}
+		case usagehourly.FieldOutputOctets:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_octets", values[i])
+			} else if value.Valid {
+				uh.OutputOctets = value.Int64
+			}
+		default:
+			uh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UsageHourly.
+// This includes values selected through modifiers, order, etc.
+func (uh *UsageHourly) Value(name string) (ent.Value, error) {
+	return uh.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UsageHourly.
+// Note that you need to call UsageHourly.Unwrap() before calling this method if this UsageHourly
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (uh *UsageHourly) Update() *UsageHourlyUpdateOne {
+	return NewUsageHourlyClient(uh.config).UpdateOne(uh)
+}
+
+// Unwrap unwraps the UsageHourly entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (uh *UsageHourly) Unwrap() *UsageHourly {
+	_tx, ok := uh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UsageHourly is not a transactional entity")
+	}
+	uh.config.driver = _tx.drv
+	return uh
+}
+
+// String implements the fmt.Stringer.
+func (uh *UsageHourly) String() string {
+	var builder strings.Builder
+	builder.WriteString("UsageHourly(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", uh.ID))
+	builder.WriteString("username=")
+	builder.WriteString(uh.Username)
+	builder.WriteString(", ")
+	builder.WriteString("hour=")
+	builder.WriteString(uh.Hour.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("input_octets=")
+	builder.WriteString(fmt.Sprintf("%v", uh.InputOctets))
+	builder.WriteString(", ")
+	builder.WriteString("output_octets=")
+	builder.WriteString(fmt.Sprintf("%v", uh.OutputOctets))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UsageHourlies is a parsable slice of UsageHourly.
+type UsageHourlies []*UsageHourly
@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagehourly
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLTE(FieldID, id))
+}
+
+// Username applies equality check predicate on the "username" field. It's identical to UsernameEQ.
+func Username(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldUsername, v))
+}
+
+// Hour applies equality check predicate on the "hour" field. It's identical to HourEQ.
+func Hour(v time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldHour, v))
+}
+
+// InputOctets applies equality check predicate on the "input_octets" field. It's identical to InputOctetsEQ.
+func InputOctets(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldInputOctets, v))
+}
+
+// OutputOctets applies equality check predicate on the "output_octets" field. It's identical to OutputOctetsEQ.
+func OutputOctets(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldOutputOctets, v))
+}
+
+// UsernameEQ applies the EQ predicate on the "username" field.
+func UsernameEQ(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldUsername, v))
+}
+
+// UsernameNEQ applies the NEQ predicate on the "username" field.
+func UsernameNEQ(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNEQ(FieldUsername, v))
+}
+
+// UsernameIn applies the In predicate on the "username" field.
+func UsernameIn(vs ...string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldIn(FieldUsername, vs...))
+}
+
+// UsernameNotIn applies the NotIn predicate on the "username" field.
+func UsernameNotIn(vs ...string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNotIn(FieldUsername, vs...))
+}
+
+// UsernameGT applies the GT predicate on the "username" field.
+func UsernameGT(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGT(FieldUsername, v))
+}
+
+// UsernameGTE applies the GTE predicate on the "username" field.
+func UsernameGTE(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGTE(FieldUsername, v))
+}
+
+// UsernameLT applies the LT predicate on the "username" field.
+func UsernameLT(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLT(FieldUsername, v))
+}
+
+// UsernameLTE applies the LTE predicate on the "username" field.
+func UsernameLTE(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLTE(FieldUsername, v))
+}
+
+// UsernameContains applies the Contains predicate on the "username" field.
+func UsernameContains(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldContains(FieldUsername, v))
+}
+
+// UsernameHasPrefix applies the HasPrefix predicate on the "username" field.
+func UsernameHasPrefix(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldHasPrefix(FieldUsername, v))
+}
+
+// UsernameHasSuffix applies the HasSuffix predicate on the "username" field.
+func UsernameHasSuffix(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldHasSuffix(FieldUsername, v))
+}
+
+// UsernameEqualFold applies the EqualFold predicate on the "username" field.
+func UsernameEqualFold(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEqualFold(FieldUsername, v))
+}
+
+// UsernameContainsFold applies the ContainsFold predicate on the "username" field.
+func UsernameContainsFold(v string) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldContainsFold(FieldUsername, v))
+}
+
+// HourEQ applies the EQ predicate on the "hour" field.
+func HourEQ(v time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldHour, v))
+}
+
+// HourNEQ applies the NEQ predicate on the "hour" field.
+func HourNEQ(v time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNEQ(FieldHour, v))
+}
+
+// HourIn applies the In predicate on the "hour" field.
+func HourIn(vs ...time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldIn(FieldHour, vs...))
+}
+
+// HourNotIn applies the NotIn predicate on the "hour" field.
+func HourNotIn(vs ...time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNotIn(FieldHour, vs...))
+}
+
+// HourGT applies the GT predicate on the "hour" field.
+func HourGT(v time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGT(FieldHour, v))
+}
+
+// HourGTE applies the GTE predicate on the "hour" field.
+func HourGTE(v time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGTE(FieldHour, v))
+}
+
+// HourLT applies the LT predicate on the "hour" field.
+func HourLT(v time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLT(FieldHour, v))
+}
+
+// HourLTE applies the LTE predicate on the "hour" field.
+func HourLTE(v time.Time) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLTE(FieldHour, v))
+}
+
+// InputOctetsEQ applies the EQ predicate on the "input_octets" field.
+func InputOctetsEQ(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldInputOctets, v))
+}
+
+// InputOctetsNEQ applies the NEQ predicate on the "input_octets" field.
+func InputOctetsNEQ(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNEQ(FieldInputOctets, v))
+}
+
+// InputOctetsIn applies the In predicate on the "input_octets" field.
+func InputOctetsIn(vs ...int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldIn(FieldInputOctets, vs...))
+}
+
+// InputOctetsNotIn applies the NotIn predicate on the "input_octets" field.
+func InputOctetsNotIn(vs ...int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNotIn(FieldInputOctets, vs...))
+}
+
+// InputOctetsGT applies the GT predicate on the "input_octets" field.
+func InputOctetsGT(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGT(FieldInputOctets, v))
+}
+
+// InputOctetsGTE applies the GTE predicate on the "input_octets" field.
+func InputOctetsGTE(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGTE(FieldInputOctets, v))
+}
+
+// InputOctetsLT applies the LT predicate on the "input_octets" field.
+func InputOctetsLT(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLT(FieldInputOctets, v))
+}
+
+// InputOctetsLTE applies the LTE predicate on the "input_octets" field.
+func InputOctetsLTE(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLTE(FieldInputOctets, v))
+}
+
+// OutputOctetsEQ applies the EQ predicate on the "output_octets" field.
+func OutputOctetsEQ(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldEQ(FieldOutputOctets, v))
+}
+
+// OutputOctetsNEQ applies the NEQ predicate on the "output_octets" field.
+func OutputOctetsNEQ(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNEQ(FieldOutputOctets, v))
+}
+
+// OutputOctetsIn applies the In predicate on the "output_octets" field.
+func OutputOctetsIn(vs ...int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldIn(FieldOutputOctets, vs...))
+}
+
+// OutputOctetsNotIn applies the NotIn predicate on the "output_octets" field.
+func OutputOctetsNotIn(vs ...int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldNotIn(FieldOutputOctets, vs...))
+}
+
+// OutputOctetsGT applies the GT predicate on the "output_octets" field.
+func OutputOctetsGT(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGT(FieldOutputOctets, v))
+}
+
+// OutputOctetsGTE applies the GTE predicate on the "output_octets" field.
+func OutputOctetsGTE(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldGTE(FieldOutputOctets, v))
+}
+
+// OutputOctetsLT applies the LT predicate on the "output_octets" field.
+func OutputOctetsLT(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLT(FieldOutputOctets, v))
+}
+
+// OutputOctetsLTE applies the LTE predicate on the "output_octets" field.
+func OutputOctetsLTE(v int64) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.FieldLTE(FieldOutputOctets, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageHourly) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageHourly) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageHourly) predicate.UsageHourly {
+	return predicate.UsageHourly(sql.NotPredicates(p))
+}
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
+)
+
+// UsageHourlyCreate is the builder for creating a UsageHourly entity.
+type UsageHourlyCreate struct {
+	config
+	mutation *UsageHourlyMutation
+	hooks    []Hook
+}
+
+// SetUsername sets the "username" field.
+func (uhc *UsageHourlyCreate) SetUsername(s string) *UsageHourlyCreate {
+	uhc.mutation.SetUsername(s)
+	return uhc
+}
+
+// SetHour sets the "hour" field.
+func (uhc *UsageHourlyCreate) SetHour(t time.Time) *UsageHourlyCreate {
+	uhc.mutation.SetHour(t)
+	return uhc
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (uhc *UsageHourlyCreate) SetInputOctets(i int64) *UsageHourlyCreate {
+	uhc.mutation.SetInputOctets(i)
+	return uhc
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (uhc *UsageHourlyCreate) SetNillableInputOctets(i *int64) *UsageHourlyCreate {
+	if i != nil {
+		uhc.SetInputOctets(*i)
+	}
+	return uhc
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (uhc *UsageHourlyCreate) SetOutputOctets(i int64) *UsageHourlyCreate {
+	uhc.mutation.SetOutputOctets(i)
+	return uhc
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (uhc *UsageHourlyCreate) SetNillableOutputOctets(i *int64) *UsageHourlyCreate {
+	if i != nil {
+		uhc.SetOutputOctets(*i)
+	}
+	return uhc
+}
+
+// Mutation returns the UsageHourlyMutation object of the builder.
+func (uhc *UsageHourlyCreate) Mutation() *UsageHourlyMutation {
+	return uhc.mutation
+}
+
+// Save creates the UsageHourly in the database.
+func (uhc *UsageHourlyCreate) Save(ctx context.Context) (*UsageHourly, error) {
+	uhc.defaults()
+	return withHooks(ctx, uhc.sqlSave, uhc.mutation, uhc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uhc *UsageHourlyCreate) SaveX(ctx context.Context) *UsageHourly {
+	v, err := uhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uhc *UsageHourlyCreate) Exec(ctx context.Context) error {
+	_, err := uhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhc *UsageHourlyCreate) ExecX(ctx context.Context) {
+	if err := uhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uhc *UsageHourlyCreate) defaults() {
+	if _, ok := uhc.mutation.InputOctets(); !ok {
+		v := usagehourly.DefaultInputOctets
+		uhc.mutation.SetInputOctets(v)
+	}
+	if _, ok := uhc.mutation.OutputOctets(); !ok {
+		v := usagehourly.DefaultOutputOctets
+		uhc.mutation.SetOutputOctets(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uhc *UsageHourlyCreate) check() error {
+	if _, ok := uhc.mutation.Username(); !ok {
+		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "UsageHourly.username"`)}
+	}
+	if v, ok := uhc.mutation.Username(); ok {
+		if err := usagehourly.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageHourly.username": %w`, err)}
+		}
+	}
+	if _, ok := uhc.mutation.Hour(); !ok {
+		return &ValidationError{Name: "hour", err: errors.New(`ent: missing required field "UsageHourly.hour"`)}
+	}
+	if _, ok := uhc.mutation.InputOctets(); !ok {
+		return &ValidationError{Name: "input_octets", err: errors.New(`ent: missing required field "UsageHourly.input_octets"`)}
+	}
+	if _, ok := uhc.mutation.OutputOctets(); !ok {
+		return &ValidationError{Name: "output_octets", err: errors.New(`ent: missing required field "UsageHourly.output_octets"`)}
+	}
+	return nil
+}
+
+func (uhc *UsageHourlyCreate) sqlSave(ctx context.Context) (*UsageHourly, error) {
+	if err := uhc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	uhc.mutation.id = &_node.ID
+	uhc.mutation.done = true
+	return _node, nil
+}
+
+func (uhc *UsageHourlyCreate) createSpec() (*UsageHourly, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageHourly{config: uhc.config}
+		_spec = sqlgraph.NewCreateSpec(usagehourly.Table, sqlgraph.NewFieldSpec(usagehourly.FieldID, field.TypeInt))
+	)
+	if value, ok := uhc.mutation.Username(); ok {
+		_spec.SetField(usagehourly.FieldUsername, field.TypeString, value)
+		_node.Username = value
+	}
+	if value, ok := uhc.mutation.Hour(); ok {
+		_spec.SetField(usagehourly.FieldHour, field.TypeTime, value)
+		_node.Hour = value
+	}
+	if value, ok := uhc.mutation.InputOctets(); ok {
+		_spec.SetField(usagehourly.FieldInputOctets, field.TypeInt64, value)
+		_node.InputOctets = value
+	}
+	if value, ok := uhc.mutation.OutputOctets(); ok {
+		_spec.SetField(usagehourly.FieldOutputOctets, field.TypeInt64, value)
+		_node.OutputOctets = value
+	}
+	return _node, _spec
+}
+
+// UsageHourlyCreateBulk is the builder for creating many UsageHourly entities in bulk.
+type UsageHourlyCreateBulk struct {
+	config
+	err      error
+	builders []*UsageHourlyCreate
+}
+
+// Save creates the UsageHourly entities in the database.
+func (uhcb *UsageHourlyCreateBulk) Save(ctx context.Context) ([]*UsageHourly, error) {
+	if uhcb.err != nil {
+		return nil, uhcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uhcb.builders))
+	nodes := make([]*UsageHourly, len(uhcb.builders))
+	mutators := make([]Mutator, len(uhcb.builders))
+	for i := range uhcb.builders {
+		func(i int, root context.Context) {
+			builder := uhcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageHourlyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uhcb *UsageHourlyCreateBulk) SaveX(ctx context.Context) []*UsageHourly {
+	v, err := uhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uhcb *UsageHourlyCreateBulk) Exec(ctx context.Context) error {
+	_, err := uhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhcb *UsageHourlyCreateBulk) ExecX(ctx context.Context) {
+	if err := uhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
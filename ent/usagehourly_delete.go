@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
+)
+
+// UsageHourlyDelete is the builder for deleting a UsageHourly entity.
+type UsageHourlyDelete struct {
+	config
+	hooks    []Hook
+	mutation *UsageHourlyMutation
+}
+
+// Where appends a list predicates to the UsageHourlyDelete builder.
+func (uhd *UsageHourlyDelete) Where(ps ...predicate.UsageHourly) *UsageHourlyDelete {
+	uhd.mutation.Where(ps...)
+	return uhd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (uhd *UsageHourlyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, uhd.sqlExec, uhd.mutation, uhd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhd *UsageHourlyDelete) ExecX(ctx context.Context) int {
+	n, err := uhd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (uhd *UsageHourlyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usagehourly.Table, sqlgraph.NewFieldSpec(usagehourly.FieldID, field.TypeInt))
+	if ps := uhd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, uhd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	uhd.mutation.done = true
+	return affected, err
+}
+
+// UsageHourlyDeleteOne is the builder for deleting a single UsageHourly entity.
+type UsageHourlyDeleteOne struct {
+	uhd *UsageHourlyDelete
+}
+
+// Where appends a list predicates to the UsageHourlyDelete builder.
+func (uhdo *UsageHourlyDeleteOne) Where(ps ...predicate.UsageHourly) *UsageHourlyDeleteOne {
+	uhdo.uhd.mutation.Where(ps...)
+	return uhdo
+}
+
+// Exec executes the deletion query.
+func (uhdo *UsageHourlyDeleteOne) Exec(ctx context.Context) error {
+	n, err := uhdo.uhd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usagehourly.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhdo *UsageHourlyDeleteOne) ExecX(ctx context.Context) {
+	if err := uhdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
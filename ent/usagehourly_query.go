@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
+)
+
+// UsageHourlyQuery is the builder for querying UsageHourly entities.
+type UsageHourlyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []usagehourly.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UsageHourly
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UsageHourlyQuery builder.
+func (uhq *UsageHourlyQuery) Where(ps ...predicate.UsageHourly) *UsageHourlyQuery {
+	uhq.predicates = append(uhq.predicates, ps...)
+	return uhq
+}
+
+// Limit the number of records to be returned by this query.
+func (uhq *UsageHourlyQuery) Limit(limit int) *UsageHourlyQuery {
+	uhq.ctx.Limit = &limit
+	return uhq
+}
+
+// Offset to start from.
+func (uhq *UsageHourlyQuery) Offset(offset int) *UsageHourlyQuery {
+	uhq.ctx.Offset = &offset
+	return uhq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (uhq *UsageHourlyQuery) Unique(unique bool) *UsageHourlyQuery {
+	uhq.ctx.Unique = &unique
+	return uhq
+}
+
+// Order specifies how the records should be ordered.
+func (uhq *UsageHourlyQuery) Order(o ...usagehourly.OrderOption) *UsageHourlyQuery {
+	uhq.order = append(uhq.order, o...)
+	return uhq
+}
+
+// First returns the first UsageHourly entity from the query.
+// Returns a *NotFoundError when no UsageHourly was found.
+func (uhq *UsageHourlyQuery) First(ctx context.Context) (*UsageHourly, error) {
+	nodes, err := uhq.Limit(1).All(setContextOp(ctx, uhq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usagehourly.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uhq *UsageHourlyQuery) FirstX(ctx context.Context) *UsageHourly {
+	node, err := uhq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UsageHourly ID from the query.
+// Returns a *NotFoundError when no UsageHourly ID was found.
+func (uhq *UsageHourlyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = uhq.Limit(1).IDs(setContextOp(ctx, uhq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usagehourly.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uhq *UsageHourlyQuery) FirstIDX(ctx context.Context) int {
+	id, err := uhq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UsageHourly entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UsageHourly entity is found.
+// Returns a *NotFoundError when no UsageHourly entities are found.
+func (uhq *UsageHourlyQuery) Only(ctx context.Context) (*UsageHourly, error) {
+	nodes, err := uhq.Limit(2).All(setContextOp(ctx, uhq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usagehourly.Label}
+	default:
+		return nil, &NotSingularError{usagehourly.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uhq *UsageHourlyQuery) OnlyX(ctx context.Context) *UsageHourly {
+	node, err := uhq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UsageHourly ID in the query.
+// Returns a *NotSingularError when more than one UsageHourly ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uhq *UsageHourlyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = uhq.Limit(2).IDs(setContextOp(ctx, uhq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usagehourly.Label}
+	default:
+		err = &NotSingularError{usagehourly.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uhq *UsageHourlyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := uhq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UsageHourlies.
+func (uhq *UsageHourlyQuery) All(ctx context.Context) ([]*UsageHourly, error) {
+	ctx = setContextOp(ctx, uhq.ctx, ent.OpQueryAll)
+	if err := uhq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UsageHourly, *UsageHourlyQuery]()
+	return withInterceptors[[]*UsageHourly](ctx, uhq, qr, uhq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uhq *UsageHourlyQuery) AllX(ctx context.Context) []*UsageHourly {
+	nodes, err := uhq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UsageHourly IDs.
+func (uhq *UsageHourlyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if uhq.ctx.Unique == nil && uhq.path != nil {
+		uhq.Unique(true)
+	}
+	ctx = setContextOp(ctx, uhq.ctx, ent.OpQueryIDs)
+	if err = uhq.Select(usagehourly.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (uhq *UsageHourlyQuery) IDsX(ctx context.Context) []int {
+	ids, err := uhq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (uhq *UsageHourlyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, uhq.ctx, ent.OpQueryCount)
+	if err := uhq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, uhq, querierCount[*UsageHourlyQuery](), uhq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (uhq *UsageHourlyQuery) CountX(ctx context.Context) int {
+	count, err := uhq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (uhq *UsageHourlyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, uhq.ctx, ent.OpQueryExist)
+	switch _, err := uhq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (uhq *UsageHourlyQuery) ExistX(ctx context.Context) bool {
+	exist, err := uhq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UsageHourlyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (uhq *UsageHourlyQuery) Clone() *UsageHourlyQuery {
+	if uhq == nil {
+		return nil
+	}
+	return &UsageHourlyQuery{
+		config:     uhq.config,
+		ctx:        uhq.ctx.Clone(),
+		order:      append([]usagehourly.OrderOption{}, uhq.order...),
+		inters:     append([]Interceptor{}, uhq.inters...),
+		predicates: append([]predicate.UsageHourly{}, uhq.predicates...),
+		// clone intermediate query.
+		sql:  uhq.sql.Clone(),
+		path: uhq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Username string `json:"username,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UsageHourly.Query().
+//		GroupBy(usagehourly.FieldUsername).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uhq *UsageHourlyQuery) GroupBy(field string, fields ...string) *UsageHourlyGroupBy {
+	uhq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UsageHourlyGroupBy{build: uhq}
+	grbuild.flds = &uhq.ctx.Fields
+	grbuild.label = usagehourly.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Username string `json:"username,omitempty"`
+//	}
+//
+//	client.UsageHourly.Query().
+//		Select(usagehourly.FieldUsername).
+//		Scan(ctx, &v)
+func (uhq *UsageHourlyQuery) Select(fields ...string) *UsageHourlySelect {
+	uhq.ctx.Fields = append(uhq.ctx.Fields, fields...)
+	sbuild := &UsageHourlySelect{UsageHourlyQuery: uhq}
+	sbuild.label = usagehourly.Label
+	sbuild.flds, sbuild.scan = &uhq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UsageHourlySelect configured with the given aggregations.
+func (uhq *UsageHourlyQuery) Aggregate(fns ...AggregateFunc) *UsageHourlySelect {
+	return uhq.Select().Aggregate(fns...)
+}
+
+func (uhq *UsageHourlyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range uhq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uhq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range uhq.ctx.Fields {
+		if !usagehourly.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if uhq.path != nil {
+		prev, err := uhq.path(ctx)
+		if err != nil {
+			return err
+		}
+		uhq.sql = prev
+	}
+	return nil
+}
+
+func (uhq *UsageHourlyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UsageHourly, error) {
+	var (
+		nodes = []*UsageHourly{}
+		_spec = uhq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UsageHourly).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UsageHourly{config: uhq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, uhq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (uhq *UsageHourlyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := uhq.querySpec()
+	_spec.Node.Columns = uhq.ctx.Fields
+	if len(uhq.ctx.Fields) > 0 {
+		_spec.Unique = uhq.ctx.Unique != nil && *uhq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, uhq.driver, _spec)
+}
+
+func (uhq *UsageHourlyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(usagehourly.Table, usagehourly.Columns, sqlgraph.NewFieldSpec(usagehourly.FieldID, field.TypeInt))
+	_spec.From = uhq.sql
+	if unique := uhq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if uhq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := uhq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagehourly.FieldID)
+		for i := range fields {
+			if fields[i] != usagehourly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := uhq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := uhq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := uhq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := uhq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (uhq *UsageHourlyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(uhq.driver.Dialect())
+	t1 := builder.Table(usagehourly.Table)
+	columns := uhq.ctx.Fields
+	if len(columns) == 0 {
+		columns = usagehourly.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if uhq.sql != nil {
+		selector = uhq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if uhq.ctx.Unique != nil && *uhq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range uhq.predicates {
+		p(selector)
+	}
+	for _, p := range uhq.order {
+		p(selector)
+	}
+	if offset := uhq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := uhq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UsageHourlyGroupBy is the group-by builder for UsageHourly entities.
+type UsageHourlyGroupBy struct {
+	selector
+	build *UsageHourlyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uhgb *UsageHourlyGroupBy) Aggregate(fns ...AggregateFunc) *UsageHourlyGroupBy {
+	uhgb.fns = append(uhgb.fns, fns...)
+	return uhgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uhgb *UsageHourlyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uhgb.build.ctx, ent.OpQueryGroupBy)
+	if err := uhgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UsageHourlyQuery, *UsageHourlyGroupBy](ctx, uhgb.build, uhgb, uhgb.build.inters, v)
+}
+
+func (uhgb *UsageHourlyGroupBy) sqlScan(ctx context.Context, root *UsageHourlyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(uhgb.fns))
+	for _, fn := range uhgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*uhgb.flds)+len(uhgb.fns))
+		for _, f := range *uhgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*uhgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uhgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UsageHourlySelect is the builder for selecting fields of UsageHourly entities.
+type UsageHourlySelect struct {
+	*UsageHourlyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (uhs *UsageHourlySelect) Aggregate(fns ...AggregateFunc) *UsageHourlySelect {
+	uhs.fns = append(uhs.fns, fns...)
+	return uhs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uhs *UsageHourlySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uhs.ctx, ent.OpQuerySelect)
+	if err := uhs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UsageHourlyQuery, *UsageHourlySelect](ctx, uhs.UsageHourlyQuery, uhs, uhs.inters, v)
+}
+
+func (uhs *UsageHourlySelect) sqlScan(ctx context.Context, root *UsageHourlyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(uhs.fns))
+	for _, fn := range uhs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*uhs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uhs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
+)
+
+// UsageHourlyUpdate is the builder for updating UsageHourly entities.
+type UsageHourlyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageHourlyMutation
+}
+
+// Where appends a list predicates to the UsageHourlyUpdate builder.
+func (uhu *UsageHourlyUpdate) Where(ps ...predicate.UsageHourly) *UsageHourlyUpdate {
+	uhu.mutation.Where(ps...)
+	return uhu
+}
+
+// SetUsername sets the "username" field.
+func (uhu *UsageHourlyUpdate) SetUsername(s string) *UsageHourlyUpdate {
+	uhu.mutation.SetUsername(s)
+	return uhu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uhu *UsageHourlyUpdate) SetNillableUsername(s *string) *UsageHourlyUpdate {
+	if s != nil {
+		uhu.SetUsername(*s)
+	}
+	return uhu
+}
+
+// SetHour sets the "hour" field.
+func (uhu *UsageHourlyUpdate) SetHour(t time.Time) *UsageHourlyUpdate {
+	uhu.mutation.SetHour(t)
+	return uhu
+}
+
+// SetNillableHour sets the "hour" field if the given value is not nil.
+func (uhu *UsageHourlyUpdate) SetNillableHour(t *time.Time) *UsageHourlyUpdate {
+	if t != nil {
+		uhu.SetHour(*t)
+	}
+	return uhu
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (uhu *UsageHourlyUpdate) SetInputOctets(i int64) *UsageHourlyUpdate {
+	uhu.mutation.ResetInputOctets()
+	uhu.mutation.SetInputOctets(i)
+	return uhu
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (uhu *UsageHourlyUpdate) SetNillableInputOctets(i *int64) *UsageHourlyUpdate {
+	if i != nil {
+		uhu.SetInputOctets(*i)
+	}
+	return uhu
+}
+
+// AddInputOctets adds i to the "input_octets" field.
+func (uhu *UsageHourlyUpdate) AddInputOctets(i int64) *UsageHourlyUpdate {
+	uhu.mutation.AddInputOctets(i)
+	return uhu
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (uhu *UsageHourlyUpdate) SetOutputOctets(i int64) *UsageHourlyUpdate {
+	uhu.mutation.ResetOutputOctets()
+	uhu.mutation.SetOutputOctets(i)
+	return uhu
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (uhu *UsageHourlyUpdate) SetNillableOutputOctets(i *int64) *UsageHourlyUpdate {
+	if i != nil {
+		uhu.SetOutputOctets(*i)
+	}
+	return uhu
+}
+
+// AddOutputOctets adds i to the "output_octets" field.
+func (uhu *UsageHourlyUpdate) AddOutputOctets(i int64) *UsageHourlyUpdate {
+	uhu.mutation.AddOutputOctets(i)
+	return uhu
+}
+
+// Mutation returns the UsageHourlyMutation object of the builder.
+func (uhu *UsageHourlyUpdate) Mutation() *UsageHourlyMutation {
+	return uhu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uhu *UsageHourlyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uhu.sqlSave, uhu.mutation, uhu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uhu *UsageHourlyUpdate) SaveX(ctx context.Context) int {
+	affected, err := uhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uhu *UsageHourlyUpdate) Exec(ctx context.Context) error {
+	_, err := uhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhu *UsageHourlyUpdate) ExecX(ctx context.Context) {
+	if err := uhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uhu *UsageHourlyUpdate) check() error {
+	if v, ok := uhu.mutation.Username(); ok {
+		if err := usagehourly.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageHourly.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uhu *UsageHourlyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uhu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagehourly.Table, usagehourly.Columns, sqlgraph.NewFieldSpec(usagehourly.FieldID, field.TypeInt))
+	if ps := uhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uhu.mutation.Username(); ok {
+		_spec.SetField(usagehourly.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uhu.mutation.Hour(); ok {
+		_spec.SetField(usagehourly.FieldHour, field.TypeTime, value)
+	}
+	if value, ok := uhu.mutation.InputOctets(); ok {
+		_spec.SetField(usagehourly.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uhu.mutation.AddedInputOctets(); ok {
+		_spec.AddField(usagehourly.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uhu.mutation.OutputOctets(); ok {
+		_spec.SetField(usagehourly.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uhu.mutation.AddedOutputOctets(); ok {
+		_spec.AddField(usagehourly.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagehourly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uhu.mutation.done = true
+	return n, nil
+}
+
+// UsageHourlyUpdateOne is the builder for updating a single UsageHourly entity.
+type UsageHourlyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageHourlyMutation
+}
+
+// SetUsername sets the "username" field.
+func (uhuo *UsageHourlyUpdateOne) SetUsername(s string) *UsageHourlyUpdateOne {
+	uhuo.mutation.SetUsername(s)
+	return uhuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (uhuo *UsageHourlyUpdateOne) SetNillableUsername(s *string) *UsageHourlyUpdateOne {
+	if s != nil {
+		uhuo.SetUsername(*s)
+	}
+	return uhuo
+}
+
+// SetHour sets the "hour" field.
+func (uhuo *UsageHourlyUpdateOne) SetHour(t time.Time) *UsageHourlyUpdateOne {
+	uhuo.mutation.SetHour(t)
+	return uhuo
+}
+
+// SetNillableHour sets the "hour" field if the given value is not nil.
+func (uhuo *UsageHourlyUpdateOne) SetNillableHour(t *time.Time) *UsageHourlyUpdateOne {
+	if t != nil {
+		uhuo.SetHour(*t)
+	}
+	return uhuo
+}
+
+// SetInputOctets sets the "input_octets" field.
+func (uhuo *UsageHourlyUpdateOne) SetInputOctets(i int64) *UsageHourlyUpdateOne {
+	uhuo.mutation.ResetInputOctets()
+	uhuo.mutation.SetInputOctets(i)
+	return uhuo
+}
+
+// SetNillableInputOctets sets the "input_octets" field if the given value is not nil.
+func (uhuo *UsageHourlyUpdateOne) SetNillableInputOctets(i *int64) *UsageHourlyUpdateOne {
+	if i != nil {
+		uhuo.SetInputOctets(*i)
+	}
+	return uhuo
+}
+
+// AddInputOctets adds i to the "input_octets" field.
+func (uhuo *UsageHourlyUpdateOne) AddInputOctets(i int64) *UsageHourlyUpdateOne {
+	uhuo.mutation.AddInputOctets(i)
+	return uhuo
+}
+
+// SetOutputOctets sets the "output_octets" field.
+func (uhuo *UsageHourlyUpdateOne) SetOutputOctets(i int64) *UsageHourlyUpdateOne {
+	uhuo.mutation.ResetOutputOctets()
+	uhuo.mutation.SetOutputOctets(i)
+	return uhuo
+}
+
+// SetNillableOutputOctets sets the "output_octets" field if the given value is not nil.
+func (uhuo *UsageHourlyUpdateOne) SetNillableOutputOctets(i *int64) *UsageHourlyUpdateOne {
+	if i != nil {
+		uhuo.SetOutputOctets(*i)
+	}
+	return uhuo
+}
+
+// AddOutputOctets adds i to the "output_octets" field.
+func (uhuo *UsageHourlyUpdateOne) AddOutputOctets(i int64) *UsageHourlyUpdateOne {
+	uhuo.mutation.AddOutputOctets(i)
+	return uhuo
+}
+
+// Mutation returns the UsageHourlyMutation object of the builder.
+func (uhuo *UsageHourlyUpdateOne) Mutation() *UsageHourlyMutation {
+	return uhuo.mutation
+}
+
+// Where appends a list predicates to the UsageHourlyUpdate builder.
+func (uhuo *UsageHourlyUpdateOne) Where(ps ...predicate.UsageHourly) *UsageHourlyUpdateOne {
+	uhuo.mutation.Where(ps...)
+	return uhuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uhuo *UsageHourlyUpdateOne) Select(field string, fields ...string) *UsageHourlyUpdateOne {
+	uhuo.fields = append([]string{field}, fields...)
+	return uhuo
+}
+
+// Save executes the query and returns the updated UsageHourly entity.
+func (uhuo *UsageHourlyUpdateOne) Save(ctx context.Context) (*UsageHourly, error) {
+	return withHooks(ctx, uhuo.sqlSave, uhuo.mutation, uhuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uhuo *UsageHourlyUpdateOne) SaveX(ctx context.Context) *UsageHourly {
+	node, err := uhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uhuo *UsageHourlyUpdateOne) Exec(ctx context.Context) error {
+	_, err := uhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhuo *UsageHourlyUpdateOne) ExecX(ctx context.Context) {
+	if err := uhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uhuo *UsageHourlyUpdateOne) check() error {
+	if v, ok := uhuo.mutation.Username(); ok {
+		if err := usagehourly.UsernameValidator(v); err != nil {
+			return &ValidationError{Name: "username", err: fmt.Errorf(`ent: validator failed for field "UsageHourly.username": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uhuo *UsageHourlyUpdateOne) sqlSave(ctx context.Context) (_node *UsageHourly, err error) {
+	if err := uhuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagehourly.Table, usagehourly.Columns, sqlgraph.NewFieldSpec(usagehourly.FieldID, field.TypeInt))
+	id, ok := uhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageHourly.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagehourly.FieldID)
+		for _, f := range fields {
+			if !usagehourly.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagehourly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uhuo.mutation.Username(); ok {
+		_spec.SetField(usagehourly.FieldUsername, field.TypeString, value)
+	}
+	if value, ok := uhuo.mutation.Hour(); ok {
+		_spec.SetField(usagehourly.FieldHour, field.TypeTime, value)
+	}
+	if value, ok := uhuo.mutation.InputOctets(); ok {
+		_spec.SetField(usagehourly.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uhuo.mutation.AddedInputOctets(); ok {
+		_spec.AddField(usagehourly.FieldInputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uhuo.mutation.OutputOctets(); ok {
+		_spec.SetField(usagehourly.FieldOutputOctets, field.TypeInt64, value)
+	}
+	if value, ok := uhuo.mutation.AddedOutputOctets(); ok {
+		_spec.AddField(usagehourly.FieldOutputOctets, field.TypeInt64, value)
+	}
+	_node = &UsageHourly{config: uhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagehourly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uhuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/vendor"
+)
+
+// Vendor is the model entity for the Vendor schema.
+type Vendor struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Matches clients.vendor_id
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CName holds the value of the "c_name" field.
+	CName string `json:"c_name,omitempty"`
+	// MobileNumber holds the value of the "mobile_number" field.
+	MobileNumber string `json:"mobile_number,omitempty"`
+	// Wallet the vendor pays for activations and renewals from, never negative
+	Balance float64 `json:"balance,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// CreatedDate holds the value of the "created_date" field.
+	CreatedDate  time.Time `json:"created_date,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Vendor) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vendor.FieldIsActive:
+			values[i] = new(sql.NullBool)
+		case vendor.FieldBalance:
+			values[i] = new(sql.NullFloat64)
+		case vendor.FieldID:
+			values[i] = new(sql.NullInt64)
+		case vendor.FieldName, vendor.FieldCName, vendor.FieldMobileNumber:
+			values[i] = new(sql.NullString)
+		case vendor.FieldCreatedDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Vendor fields.
+func (v *Vendor) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vendor.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			v.ID = int(value.Int64)
+		case vendor.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				v.Name = value.String
+			}
+		case vendor.FieldCName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field c_name", values[i])
+			} else if value.Valid {
+				v.CName = value.String
+			}
+		case vendor.FieldMobileNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mobile_number", values[i])
+			} else if value.Valid {
+				v.MobileNumber = value.String
+			}
+		case vendor.FieldBalance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				v.Balance = value.Float64
+			}
+		case vendor.FieldIsActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				v.IsActive = value.Bool
+			}
+		case vendor.FieldCreatedDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_date", values[i])
+			} else if value.Valid {
+				v.CreatedDate = value.Time
+			}
+		default:
+			v.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Vendor.
+// This includes values selected through modifiers, order, etc.
+func (v *Vendor) Value(name string) (ent.Value, error) {
+	return v.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Vendor.
+// Note that you need to call Vendor.Unwrap() before calling this method if this Vendor
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (v *Vendor) Update() *VendorUpdateOne {
+	return NewVendorClient(v.config).UpdateOne(v)
+}
+
+// Unwrap unwraps the Vendor entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (v *Vendor) Unwrap() *Vendor {
+	_tx, ok := v.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Vendor is not a transactional entity")
+	}
+	v.config.driver = _tx.drv
+	return v
+}
+
+// String implements the fmt.Stringer.
+func (v *Vendor) String() string {
+	var builder strings.Builder
+	builder.WriteString("Vendor(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", v.ID))
+	builder.WriteString("name=")
+	builder.WriteString(v.Name)
+	builder.WriteString(", ")
+	builder.WriteString("c_name=")
+	builder.WriteString(v.CName)
+	builder.WriteString(", ")
+	builder.WriteString("mobile_number=")
+	builder.WriteString(v.MobileNumber)
+	builder.WriteString(", ")
+	builder.WriteString("balance=")
+	builder.WriteString(fmt.Sprintf("%v", v.Balance))
+	builder.WriteString(", ")
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", v.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("created_date=")
+	builder.WriteString(v.CreatedDate.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Vendors is a parsable slice of Vendor.
+type Vendors []*Vendor
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/vendor"
+)
+
+// VendorCreate is the builder for creating a Vendor entity.
+type VendorCreate struct {
+	config
+	mutation *VendorMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (vc *VendorCreate) SetName(s string) *VendorCreate {
+	vc.mutation.SetName(s)
+	return vc
+}
+
+// SetCName sets the "c_name" field.
+func (vc *VendorCreate) SetCName(s string) *VendorCreate {
+	vc.mutation.SetCName(s)
+	return vc
+}
+
+// SetMobileNumber sets the "mobile_number" field.
+func (vc *VendorCreate) SetMobileNumber(s string) *VendorCreate {
+	vc.mutation.SetMobileNumber(s)
+	return vc
+}
+
+// SetNillableMobileNumber sets the "mobile_number" field if the given value is not nil.
+func (vc *VendorCreate) SetNillableMobileNumber(s *string) *VendorCreate {
+	if s != nil {
+		vc.SetMobileNumber(*s)
+	}
+	return vc
+}
+
+// SetBalance sets the "balance" field.
+func (vc *VendorCreate) SetBalance(f float64) *VendorCreate {
+	vc.mutation.SetBalance(f)
+	return vc
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (vc *VendorCreate) SetNillableBalance(f *float64) *VendorCreate {
+	if f != nil {
+		vc.SetBalance(*f)
+	}
+	return vc
+}
+
+// SetIsActive sets the "is_active" field.
+func (vc *VendorCreate) SetIsActive(b bool) *VendorCreate {
+	vc.mutation.SetIsActive(b)
+	return vc
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (vc *VendorCreate) SetNillableIsActive(b *bool) *VendorCreate {
+	if b != nil {
+		vc.SetIsActive(*b)
+	}
+	return vc
+}
+
+// SetCreatedDate sets the "created_date" field.
+func (vc *VendorCreate) SetCreatedDate(t time.Time) *VendorCreate {
+	vc.mutation.SetCreatedDate(t)
+	return vc
+}
+
+// SetNillableCreatedDate sets the "created_date" field if the given value is not nil.
+func (vc *VendorCreate) SetNillableCreatedDate(t *time.Time) *VendorCreate {
+	if t != nil {
+		vc.SetCreatedDate(*t)
+	}
+	return vc
+}
+
+// SetID sets the "id" field.
+func (vc *VendorCreate) SetID(i int) *VendorCreate {
+	vc.mutation.SetID(i)
+	return vc
+}
+
+// Mutation returns the VendorMutation object of the builder.
+func (vc *VendorCreate) Mutation() *VendorMutation {
+	return vc.mutation
+}
+
+// Save creates the Vendor in the database.
+func (vc *VendorCreate) Save(ctx context.Context) (*Vendor, error) {
+	vc.defaults()
+	return withHooks(ctx, vc.sqlSave, vc.mutation, vc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vc *VendorCreate) SaveX(ctx context.Context) *Vendor {
+	v, err := vc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vc *VendorCreate) Exec(ctx context.Context) error {
+	_, err := vc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vc *VendorCreate) ExecX(ctx context.Context) {
+	if err := vc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vc *VendorCreate) defaults() {
+	if _, ok := vc.mutation.Balance(); !ok {
+		v := vendor.DefaultBalance
+		vc.mutation.SetBalance(v)
+	}
+	if _, ok := vc.mutation.IsActive(); !ok {
+		v := vendor.DefaultIsActive
+		vc.mutation.SetIsActive(v)
+	}
+	if _, ok := vc.mutation.CreatedDate(); !ok {
+		v := vendor.DefaultCreatedDate()
+		vc.mutation.SetCreatedDate(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vc *VendorCreate) check() error {
+	if _, ok := vc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Vendor.name"`)}
+	}
+	if v, ok := vc.mutation.Name(); ok {
+		if err := vendor.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Vendor.name": %w`, err)}
+		}
+	}
+	if _, ok := vc.mutation.CName(); !ok {
+		return &ValidationError{Name: "c_name", err: errors.New(`ent: missing required field "Vendor.c_name"`)}
+	}
+	if v, ok := vc.mutation.CName(); ok {
+		if err := vendor.CNameValidator(v); err != nil {
+			return &ValidationError{Name: "c_name", err: fmt.Errorf(`ent: validator failed for field "Vendor.c_name": %w`, err)}
+		}
+	}
+	if v, ok := vc.mutation.MobileNumber(); ok {
+		if err := vendor.MobileNumberValidator(v); err != nil {
+			return &ValidationError{Name: "mobile_number", err: fmt.Errorf(`ent: validator failed for field "Vendor.mobile_number": %w`, err)}
+		}
+	}
+	if _, ok := vc.mutation.Balance(); !ok {
+		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "Vendor.balance"`)}
+	}
+	if _, ok := vc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Vendor.is_active"`)}
+	}
+	if _, ok := vc.mutation.CreatedDate(); !ok {
+		return &ValidationError{Name: "created_date", err: errors.New(`ent: missing required field "Vendor.created_date"`)}
+	}
+	if v, ok := vc.mutation.ID(); ok {
+		if err := vendor.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Vendor.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vc *VendorCreate) sqlSave(ctx context.Context) (*Vendor, error) {
+	if err := vc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	vc.mutation.id = &_node.ID
+	vc.mutation.done = true
+	return _node, nil
+}
+
+func (vc *VendorCreate) createSpec() (*Vendor, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Vendor{config: vc.config}
+		_spec = sqlgraph.NewCreateSpec(vendor.Table, sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt))
+	)
+	if id, ok := vc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := vc.mutation.Name(); ok {
+		_spec.SetField(vendor.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := vc.mutation.CName(); ok {
+		_spec.SetField(vendor.FieldCName, field.TypeString, value)
+		_node.CName = value
+	}
+	if value, ok := vc.mutation.MobileNumber(); ok {
+		_spec.SetField(vendor.FieldMobileNumber, field.TypeString, value)
+		_node.MobileNumber = value
+	}
+	if value, ok := vc.mutation.Balance(); ok {
+		_spec.SetField(vendor.FieldBalance, field.TypeFloat64, value)
+		_node.Balance = value
+	}
+	if value, ok := vc.mutation.IsActive(); ok {
+		_spec.SetField(vendor.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := vc.mutation.CreatedDate(); ok {
+		_spec.SetField(vendor.FieldCreatedDate, field.TypeTime, value)
+		_node.CreatedDate = value
+	}
+	return _node, _spec
+}
+
+// VendorCreateBulk is the builder for creating many Vendor entities in bulk.
+type VendorCreateBulk struct {
+	config
+	err      error
+	builders []*VendorCreate
+}
+
+// Save creates the Vendor entities in the database.
+func (vcb *VendorCreateBulk) Save(ctx context.Context) ([]*Vendor, error) {
+	if vcb.err != nil {
+		return nil, vcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vcb.builders))
+	nodes := make([]*Vendor, len(vcb.builders))
+	mutators := make([]Mutator, len(vcb.builders))
+	for i := range vcb.builders {
+		func(i int, root context.Context) {
+			builder := vcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VendorMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcb *VendorCreateBulk) SaveX(ctx context.Context) []*Vendor {
+	v, err := vcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcb *VendorCreateBulk) Exec(ctx context.Context) error {
+	_, err := vcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcb *VendorCreateBulk) ExecX(ctx context.Context) {
+	if err := vcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/vendor"
+)
+
+// VendorUpdate is the builder for updating Vendor entities.
+type VendorUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VendorMutation
+}
+
+// Where appends a list predicates to the VendorUpdate builder.
+func (vu *VendorUpdate) Where(ps ...predicate.Vendor) *VendorUpdate {
+	vu.mutation.Where(ps...)
+	return vu
+}
+
+// SetName sets the "name" field.
+func (vu *VendorUpdate) SetName(s string) *VendorUpdate {
+	vu.mutation.SetName(s)
+	return vu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vu *VendorUpdate) SetNillableName(s *string) *VendorUpdate {
+	if s != nil {
+		vu.SetName(*s)
+	}
+	return vu
+}
+
+// SetCName sets the "c_name" field.
+func (vu *VendorUpdate) SetCName(s string) *VendorUpdate {
+	vu.mutation.SetCName(s)
+	return vu
+}
+
+// SetNillableCName sets the "c_name" field if the given value is not nil.
+func (vu *VendorUpdate) SetNillableCName(s *string) *VendorUpdate {
+	if s != nil {
+		vu.SetCName(*s)
+	}
+	return vu
+}
+
+// SetMobileNumber sets the "mobile_number" field.
+func (vu *VendorUpdate) SetMobileNumber(s string) *VendorUpdate {
+	vu.mutation.SetMobileNumber(s)
+	return vu
+}
+
+// SetNillableMobileNumber sets the "mobile_number" field if the given value is not nil.
+func (vu *VendorUpdate) SetNillableMobileNumber(s *string) *VendorUpdate {
+	if s != nil {
+		vu.SetMobileNumber(*s)
+	}
+	return vu
+}
+
+// ClearMobileNumber clears the value of the "mobile_number" field.
+func (vu *VendorUpdate) ClearMobileNumber() *VendorUpdate {
+	vu.mutation.ClearMobileNumber()
+	return vu
+}
+
+// SetBalance sets the "balance" field.
+func (vu *VendorUpdate) SetBalance(f float64) *VendorUpdate {
+	vu.mutation.ResetBalance()
+	vu.mutation.SetBalance(f)
+	return vu
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (vu *VendorUpdate) SetNillableBalance(f *float64) *VendorUpdate {
+	if f != nil {
+		vu.SetBalance(*f)
+	}
+	return vu
+}
+
+// AddBalance adds f to the "balance" field.
+func (vu *VendorUpdate) AddBalance(f float64) *VendorUpdate {
+	vu.mutation.AddBalance(f)
+	return vu
+}
+
+// SetIsActive sets the "is_active" field.
+func (vu *VendorUpdate) SetIsActive(b bool) *VendorUpdate {
+	vu.mutation.SetIsActive(b)
+	return vu
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (vu *VendorUpdate) SetNillableIsActive(b *bool) *VendorUpdate {
+	if b != nil {
+		vu.SetIsActive(*b)
+	}
+	return vu
+}
+
+// Mutation returns the VendorMutation object of the builder.
+func (vu *VendorUpdate) Mutation() *VendorMutation {
+	return vu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vu *VendorUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vu.sqlSave, vu.mutation, vu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vu *VendorUpdate) SaveX(ctx context.Context) int {
+	affected, err := vu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vu *VendorUpdate) Exec(ctx context.Context) error {
+	_, err := vu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vu *VendorUpdate) ExecX(ctx context.Context) {
+	if err := vu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vu *VendorUpdate) check() error {
+	if v, ok := vu.mutation.Name(); ok {
+		if err := vendor.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Vendor.name": %w`, err)}
+		}
+	}
+	if v, ok := vu.mutation.CName(); ok {
+		if err := vendor.CNameValidator(v); err != nil {
+			return &ValidationError{Name: "c_name", err: fmt.Errorf(`ent: validator failed for field "Vendor.c_name": %w`, err)}
+		}
+	}
+	if v, ok := vu.mutation.MobileNumber(); ok {
+		if err := vendor.MobileNumberValidator(v); err != nil {
+			return &ValidationError{Name: "mobile_number", err: fmt.Errorf(`ent: validator failed for field "Vendor.mobile_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vu *VendorUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendor.Table, vendor.Columns, sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt))
+	if ps := vu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vu.mutation.Name(); ok {
+		_spec.SetField(vendor.FieldName, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.CName(); ok {
+		_spec.SetField(vendor.FieldCName, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.MobileNumber(); ok {
+		_spec.SetField(vendor.FieldMobileNumber, field.TypeString, value)
+	}
+	if vu.mutation.MobileNumberCleared() {
+		_spec.ClearField(vendor.FieldMobileNumber, field.TypeString)
+	}
+	if value, ok := vu.mutation.Balance(); ok {
+		_spec.SetField(vendor.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := vu.mutation.AddedBalance(); ok {
+		_spec.AddField(vendor.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := vu.mutation.IsActive(); ok {
+		_spec.SetField(vendor.FieldIsActive, field.TypeBool, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vu.mutation.done = true
+	return n, nil
+}
+
+// VendorUpdateOne is the builder for updating a single Vendor entity.
+type VendorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VendorMutation
+}
+
+// SetName sets the "name" field.
+func (vuo *VendorUpdateOne) SetName(s string) *VendorUpdateOne {
+	vuo.mutation.SetName(s)
+	return vuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vuo *VendorUpdateOne) SetNillableName(s *string) *VendorUpdateOne {
+	if s != nil {
+		vuo.SetName(*s)
+	}
+	return vuo
+}
+
+// SetCName sets the "c_name" field.
+func (vuo *VendorUpdateOne) SetCName(s string) *VendorUpdateOne {
+	vuo.mutation.SetCName(s)
+	return vuo
+}
+
+// SetNillableCName sets the "c_name" field if the given value is not nil.
+func (vuo *VendorUpdateOne) SetNillableCName(s *string) *VendorUpdateOne {
+	if s != nil {
+		vuo.SetCName(*s)
+	}
+	return vuo
+}
+
+// SetMobileNumber sets the "mobile_number" field.
+func (vuo *VendorUpdateOne) SetMobileNumber(s string) *VendorUpdateOne {
+	vuo.mutation.SetMobileNumber(s)
+	return vuo
+}
+
+// SetNillableMobileNumber sets the "mobile_number" field if the given value is not nil.
+func (vuo *VendorUpdateOne) SetNillableMobileNumber(s *string) *VendorUpdateOne {
+	if s != nil {
+		vuo.SetMobileNumber(*s)
+	}
+	return vuo
+}
+
+// ClearMobileNumber clears the value of the "mobile_number" field.
+func (vuo *VendorUpdateOne) ClearMobileNumber() *VendorUpdateOne {
+	vuo.mutation.ClearMobileNumber()
+	return vuo
+}
+
+// SetBalance sets the "balance" field.
+func (vuo *VendorUpdateOne) SetBalance(f float64) *VendorUpdateOne {
+	vuo.mutation.ResetBalance()
+	vuo.mutation.SetBalance(f)
+	return vuo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (vuo *VendorUpdateOne) SetNillableBalance(f *float64) *VendorUpdateOne {
+	if f != nil {
+		vuo.SetBalance(*f)
+	}
+	return vuo
+}
+
+// AddBalance adds f to the "balance" field.
+func (vuo *VendorUpdateOne) AddBalance(f float64) *VendorUpdateOne {
+	vuo.mutation.AddBalance(f)
+	return vuo
+}
+
+// SetIsActive sets the "is_active" field.
+func (vuo *VendorUpdateOne) SetIsActive(b bool) *VendorUpdateOne {
+	vuo.mutation.SetIsActive(b)
+	return vuo
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (vuo *VendorUpdateOne) SetNillableIsActive(b *bool) *VendorUpdateOne {
+	if b != nil {
+		vuo.SetIsActive(*b)
+	}
+	return vuo
+}
+
+// Mutation returns the VendorMutation object of the builder.
+func (vuo *VendorUpdateOne) Mutation() *VendorMutation {
+	return vuo.mutation
+}
+
+// Where appends a list predicates to the VendorUpdate builder.
+func (vuo *VendorUpdateOne) Where(ps ...predicate.Vendor) *VendorUpdateOne {
+	vuo.mutation.Where(ps...)
+	return vuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vuo *VendorUpdateOne) Select(field string, fields ...string) *VendorUpdateOne {
+	vuo.fields = append([]string{field}, fields...)
+	return vuo
+}
+
+// Save executes the query and returns the updated Vendor entity.
+func (vuo *VendorUpdateOne) Save(ctx context.Context) (*Vendor, error) {
+	return withHooks(ctx, vuo.sqlSave, vuo.mutation, vuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vuo *VendorUpdateOne) SaveX(ctx context.Context) *Vendor {
+	node, err := vuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vuo *VendorUpdateOne) Exec(ctx context.Context) error {
+	_, err := vuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vuo *VendorUpdateOne) ExecX(ctx context.Context) {
+	if err := vuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vuo *VendorUpdateOne) check() error {
+	if v, ok := vuo.mutation.Name(); ok {
+		if err := vendor.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Vendor.name": %w`, err)}
+		}
+	}
+	if v, ok := vuo.mutation.CName(); ok {
+		if err := vendor.CNameValidator(v); err != nil {
+			return &ValidationError{Name: "c_name", err: fmt.Errorf(`ent: validator failed for field "Vendor.c_name": %w`, err)}
+		}
+	}
+	if v, ok := vuo.mutation.MobileNumber(); ok {
+		if err := vendor.MobileNumberValidator(v); err != nil {
+			return &ValidationError{Name: "mobile_number", err: fmt.Errorf(`ent: validator failed for field "Vendor.mobile_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vuo *VendorUpdateOne) sqlSave(ctx context.Context) (_node *Vendor, err error) {
+	if err := vuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendor.Table, vendor.Columns, sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt))
+	id, ok := vuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Vendor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vendor.FieldID)
+		for _, f := range fields {
+			if !vendor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vendor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vuo.mutation.Name(); ok {
+		_spec.SetField(vendor.FieldName, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.CName(); ok {
+		_spec.SetField(vendor.FieldCName, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.MobileNumber(); ok {
+		_spec.SetField(vendor.FieldMobileNumber, field.TypeString, value)
+	}
+	if vuo.mutation.MobileNumberCleared() {
+		_spec.ClearField(vendor.FieldMobileNumber, field.TypeString)
+	}
+	if value, ok := vuo.mutation.Balance(); ok {
+		_spec.SetField(vendor.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := vuo.mutation.AddedBalance(); ok {
+		_spec.AddField(vendor.FieldBalance, field.TypeFloat64, value)
+	}
+	if value, ok := vuo.mutation.IsActive(); ok {
+		_spec.SetField(vendor.FieldIsActive, field.TypeBool, value)
+	}
+	_node = &Vendor{config: vuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vuo.mutation.done = true
+	return _node, nil
+}
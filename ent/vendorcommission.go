@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+)
+
+// VendorCommission is the model entity for the VendorCommission schema.
+type VendorCommission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PackageID holds the value of the "package_id" field.
+	PackageID int `json:"package_id,omitempty"`
+	// Vendor the rule is for, unset for the default of vendors without their own rule
+	VendorID *int `json:"vendor_id,omitempty"`
+	// CommissionType holds the value of the "commission_type" field.
+	CommissionType vendorcommission.CommissionType `json:"commission_type,omitempty"`
+	// Percentage of the price for percent rules, amount per cycle for fixed ones
+	Value float64 `json:"value,omitempty"`
+	// UpdatedBy holds the value of the "updated_by" field.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VendorCommission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vendorcommission.FieldValue:
+			values[i] = new(sql.NullFloat64)
+		case vendorcommission.FieldID, vendorcommission.FieldPackageID, vendorcommission.FieldVendorID:
+			values[i] = new(sql.NullInt64)
+		case vendorcommission.FieldCommissionType, vendorcommission.FieldUpdatedBy:
+			values[i] = new(sql.NullString)
+		case vendorcommission.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VendorCommission fields.
+func (vc *VendorCommission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vendorcommission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vc.ID = int(value.Int64)
+		case vendorcommission.FieldPackageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_id", values[i])
+			} else if value.Valid {
+				vc.PackageID = int(value.Int64)
+			}
+		case vendorcommission.FieldVendorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_id", values[i])
+			} else if value.Valid {
+				vc.VendorID = new(int)
+				*vc.VendorID = int(value.Int64)
+			}
+		case vendorcommission.FieldCommissionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commission_type", values[i])
+			} else if value.Valid {
+				vc.CommissionType = vendorcommission.CommissionType(value.String)
+			}
+		case vendorcommission.FieldValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				vc.Value = value.Float64
+			}
+		case vendorcommission.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				vc.UpdatedBy = value.String
+			}
+		case vendorcommission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				vc.UpdatedAt = value.Time
+			}
+		default:
+			vc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the VendorCommission.
+// This includes values selected through modifiers, order, etc.
+func (vc *VendorCommission) GetValue(name string) (ent.Value, error) {
+	return vc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VendorCommission.
+// Note that you need to call VendorCommission.Unwrap() before calling this method if this VendorCommission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vc *VendorCommission) Update() *VendorCommissionUpdateOne {
+	return NewVendorCommissionClient(vc.config).UpdateOne(vc)
+}
+
+// Unwrap unwraps the VendorCommission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vc *VendorCommission) Unwrap() *VendorCommission {
+	_tx, ok := vc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VendorCommission is not a transactional entity")
+	}
+	vc.config.driver = _tx.drv
+	return vc
+}
+
+// String implements the fmt.Stringer.
+func (vc *VendorCommission) String() string {
+	var builder strings.Builder
+	builder.WriteString("VendorCommission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vc.ID))
+	builder.WriteString("package_id=")
+	builder.WriteString(fmt.Sprintf("%v", vc.PackageID))
+	builder.WriteString(", ")
+	if v := vc.VendorID; v != nil {
+		builder.WriteString("vendor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("commission_type=")
+	builder.WriteString(fmt.Sprintf("%v", vc.CommissionType))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", vc.Value))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(vc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(vc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VendorCommissions is a parsable slice of VendorCommission.
+type VendorCommissions []*VendorCommission
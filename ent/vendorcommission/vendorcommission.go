@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vendorcommission
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the vendorcommission type in the database.
+	Label = "vendor_commission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackageID holds the string denoting the package_id field in the database.
+	FieldPackageID = "package_id"
+	// FieldVendorID holds the string denoting the vendor_id field in the database.
+	FieldVendorID = "vendor_id"
+	// FieldCommissionType holds the string denoting the commission_type field in the database.
+	FieldCommissionType = "commission_type"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
+	FieldUpdatedBy = "updated_by"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the vendorcommission in the database.
+	Table = "vendor_commissions"
+)
+
+// Columns holds all SQL columns for vendorcommission fields.
+var Columns = []string{
+	FieldID,
+	FieldPackageID,
+	FieldVendorID,
+	FieldCommissionType,
+	FieldValue,
+	FieldUpdatedBy,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(float64) error
+	// UpdatedByValidator is a validator for the "updated_by" field. It is called by the builders before save.
+	UpdatedByValidator func(string) error
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// CommissionType defines the type for the "commission_type" enum field.
+type CommissionType string
+
+// CommissionType values.
+const (
+	CommissionTypePercent CommissionType = "percent"
+	CommissionTypeFixed   CommissionType = "fixed"
+)
+
+func (ct CommissionType) String() string {
+	return string(ct)
+}
+
+// CommissionTypeValidator is a validator for the "commission_type" field enum values. It is called by the builders before save.
+func CommissionTypeValidator(ct CommissionType) error {
+	switch ct {
+	case CommissionTypePercent, CommissionTypeFixed:
+		return nil
+	default:
+		return fmt.Errorf("vendorcommission: invalid enum value for commission_type field: %q", ct)
+	}
+}
+
+// OrderOption defines the ordering options for the VendorCommission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPackageID orders the results by the package_id field.
+func ByPackageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackageID, opts...).ToFunc()
+}
+
+// ByVendorID orders the results by the vendor_id field.
+func ByVendorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorID, opts...).ToFunc()
+}
+
+// ByCommissionType orders the results by the commission_type field.
+func ByCommissionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommissionType, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByUpdatedBy orders the results by the updated_by field.
+func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
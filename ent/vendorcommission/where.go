@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vendorcommission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLTE(FieldID, id))
+}
+
+// PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
+func PackageID(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldPackageID, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldVendorID, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldValue, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PackageIDEQ applies the EQ predicate on the "package_id" field.
+func PackageIDEQ(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldPackageID, v))
+}
+
+// PackageIDNEQ applies the NEQ predicate on the "package_id" field.
+func PackageIDNEQ(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNEQ(FieldPackageID, v))
+}
+
+// PackageIDIn applies the In predicate on the "package_id" field.
+func PackageIDIn(vs ...int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldIn(FieldPackageID, vs...))
+}
+
+// PackageIDNotIn applies the NotIn predicate on the "package_id" field.
+func PackageIDNotIn(vs ...int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNotIn(FieldPackageID, vs...))
+}
+
+// PackageIDGT applies the GT predicate on the "package_id" field.
+func PackageIDGT(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGT(FieldPackageID, v))
+}
+
+// PackageIDGTE applies the GTE predicate on the "package_id" field.
+func PackageIDGTE(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGTE(FieldPackageID, v))
+}
+
+// PackageIDLT applies the LT predicate on the "package_id" field.
+func PackageIDLT(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLT(FieldPackageID, v))
+}
+
+// PackageIDLTE applies the LTE predicate on the "package_id" field.
+func PackageIDLTE(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLTE(FieldPackageID, v))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// VendorIDGT applies the GT predicate on the "vendor_id" field.
+func VendorIDGT(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGT(FieldVendorID, v))
+}
+
+// VendorIDGTE applies the GTE predicate on the "vendor_id" field.
+func VendorIDGTE(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGTE(FieldVendorID, v))
+}
+
+// VendorIDLT applies the LT predicate on the "vendor_id" field.
+func VendorIDLT(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLT(FieldVendorID, v))
+}
+
+// VendorIDLTE applies the LTE predicate on the "vendor_id" field.
+func VendorIDLTE(v int) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLTE(FieldVendorID, v))
+}
+
+// VendorIDIsNil applies the IsNil predicate on the "vendor_id" field.
+func VendorIDIsNil() predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldIsNull(FieldVendorID))
+}
+
+// VendorIDNotNil applies the NotNil predicate on the "vendor_id" field.
+func VendorIDNotNil() predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNotNull(FieldVendorID))
+}
+
+// CommissionTypeEQ applies the EQ predicate on the "commission_type" field.
+func CommissionTypeEQ(v CommissionType) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldCommissionType, v))
+}
+
+// CommissionTypeNEQ applies the NEQ predicate on the "commission_type" field.
+func CommissionTypeNEQ(v CommissionType) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNEQ(FieldCommissionType, v))
+}
+
+// CommissionTypeIn applies the In predicate on the "commission_type" field.
+func CommissionTypeIn(vs ...CommissionType) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldIn(FieldCommissionType, vs...))
+}
+
+// CommissionTypeNotIn applies the NotIn predicate on the "commission_type" field.
+func CommissionTypeNotIn(vs ...CommissionType) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNotIn(FieldCommissionType, vs...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v float64) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLTE(FieldValue, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VendorCommission) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VendorCommission) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VendorCommission) predicate.VendorCommission {
+	return predicate.VendorCommission(sql.NotPredicates(p))
+}
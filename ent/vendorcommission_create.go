@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+)
+
+// VendorCommissionCreate is the builder for creating a VendorCommission entity.
+type VendorCommissionCreate struct {
+	config
+	mutation *VendorCommissionMutation
+	hooks    []Hook
+}
+
+// SetPackageID sets the "package_id" field.
+func (vcc *VendorCommissionCreate) SetPackageID(i int) *VendorCommissionCreate {
+	vcc.mutation.SetPackageID(i)
+	return vcc
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (vcc *VendorCommissionCreate) SetVendorID(i int) *VendorCommissionCreate {
+	vcc.mutation.SetVendorID(i)
+	return vcc
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (vcc *VendorCommissionCreate) SetNillableVendorID(i *int) *VendorCommissionCreate {
+	if i != nil {
+		vcc.SetVendorID(*i)
+	}
+	return vcc
+}
+
+// SetCommissionType sets the "commission_type" field.
+func (vcc *VendorCommissionCreate) SetCommissionType(vt vendorcommission.CommissionType) *VendorCommissionCreate {
+	vcc.mutation.SetCommissionType(vt)
+	return vcc
+}
+
+// SetValue sets the "value" field.
+func (vcc *VendorCommissionCreate) SetValue(f float64) *VendorCommissionCreate {
+	vcc.mutation.SetValue(f)
+	return vcc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (vcc *VendorCommissionCreate) SetUpdatedBy(s string) *VendorCommissionCreate {
+	vcc.mutation.SetUpdatedBy(s)
+	return vcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vcc *VendorCommissionCreate) SetUpdatedAt(t time.Time) *VendorCommissionCreate {
+	vcc.mutation.SetUpdatedAt(t)
+	return vcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (vcc *VendorCommissionCreate) SetNillableUpdatedAt(t *time.Time) *VendorCommissionCreate {
+	if t != nil {
+		vcc.SetUpdatedAt(*t)
+	}
+	return vcc
+}
+
+// Mutation returns the VendorCommissionMutation object of the builder.
+func (vcc *VendorCommissionCreate) Mutation() *VendorCommissionMutation {
+	return vcc.mutation
+}
+
+// Save creates the VendorCommission in the database.
+func (vcc *VendorCommissionCreate) Save(ctx context.Context) (*VendorCommission, error) {
+	vcc.defaults()
+	return withHooks(ctx, vcc.sqlSave, vcc.mutation, vcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vcc *VendorCommissionCreate) SaveX(ctx context.Context) *VendorCommission {
+	v, err := vcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcc *VendorCommissionCreate) Exec(ctx context.Context) error {
+	_, err := vcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcc *VendorCommissionCreate) ExecX(ctx context.Context) {
+	if err := vcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcc *VendorCommissionCreate) defaults() {
+	if _, ok := vcc.mutation.UpdatedAt(); !ok {
+		v := vendorcommission.DefaultUpdatedAt()
+		vcc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcc *VendorCommissionCreate) check() error {
+	if _, ok := vcc.mutation.PackageID(); !ok {
+		return &ValidationError{Name: "package_id", err: errors.New(`ent: missing required field "VendorCommission.package_id"`)}
+	}
+	if _, ok := vcc.mutation.CommissionType(); !ok {
+		return &ValidationError{Name: "commission_type", err: errors.New(`ent: missing required field "VendorCommission.commission_type"`)}
+	}
+	if v, ok := vcc.mutation.CommissionType(); ok {
+		if err := vendorcommission.CommissionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "commission_type", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.commission_type": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "VendorCommission.value"`)}
+	}
+	if v, ok := vcc.mutation.Value(); ok {
+		if err := vendorcommission.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.value": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "VendorCommission.updated_by"`)}
+	}
+	if v, ok := vcc.mutation.UpdatedBy(); ok {
+		if err := vendorcommission.UpdatedByValidator(v); err != nil {
+			return &ValidationError{Name: "updated_by", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.updated_by": %w`, err)}
+		}
+	}
+	if _, ok := vcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VendorCommission.updated_at"`)}
+	}
+	return nil
+}
+
+func (vcc *VendorCommissionCreate) sqlSave(ctx context.Context) (*VendorCommission, error) {
+	if err := vcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vcc.mutation.id = &_node.ID
+	vcc.mutation.done = true
+	return _node, nil
+}
+
+func (vcc *VendorCommissionCreate) createSpec() (*VendorCommission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VendorCommission{config: vcc.config}
+		_spec = sqlgraph.NewCreateSpec(vendorcommission.Table, sqlgraph.NewFieldSpec(vendorcommission.FieldID, field.TypeInt))
+	)
+	if value, ok := vcc.mutation.PackageID(); ok {
+		_spec.SetField(vendorcommission.FieldPackageID, field.TypeInt, value)
+		_node.PackageID = value
+	}
+	if value, ok := vcc.mutation.VendorID(); ok {
+		_spec.SetField(vendorcommission.FieldVendorID, field.TypeInt, value)
+		_node.VendorID = &value
+	}
+	if value, ok := vcc.mutation.CommissionType(); ok {
+		_spec.SetField(vendorcommission.FieldCommissionType, field.TypeEnum, value)
+		_node.CommissionType = value
+	}
+	if value, ok := vcc.mutation.Value(); ok {
+		_spec.SetField(vendorcommission.FieldValue, field.TypeFloat64, value)
+		_node.Value = value
+	}
+	if value, ok := vcc.mutation.UpdatedBy(); ok {
+		_spec.SetField(vendorcommission.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := vcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendorcommission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// VendorCommissionCreateBulk is the builder for creating many VendorCommission entities in bulk.
+type VendorCommissionCreateBulk struct {
+	config
+	err      error
+	builders []*VendorCommissionCreate
+}
+
+// Save creates the VendorCommission entities in the database.
+func (vccb *VendorCommissionCreateBulk) Save(ctx context.Context) ([]*VendorCommission, error) {
+	if vccb.err != nil {
+		return nil, vccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vccb.builders))
+	nodes := make([]*VendorCommission, len(vccb.builders))
+	mutators := make([]Mutator, len(vccb.builders))
+	for i := range vccb.builders {
+		func(i int, root context.Context) {
+			builder := vccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VendorCommissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vccb *VendorCommissionCreateBulk) SaveX(ctx context.Context) []*VendorCommission {
+	v, err := vccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vccb *VendorCommissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := vccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vccb *VendorCommissionCreateBulk) ExecX(ctx context.Context) {
+	if err := vccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
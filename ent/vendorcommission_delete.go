@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+)
+
+// VendorCommissionDelete is the builder for deleting a VendorCommission entity.
+type VendorCommissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *VendorCommissionMutation
+}
+
+// Where appends a list predicates to the VendorCommissionDelete builder.
+func (vcd *VendorCommissionDelete) Where(ps ...predicate.VendorCommission) *VendorCommissionDelete {
+	vcd.mutation.Where(ps...)
+	return vcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vcd *VendorCommissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vcd.sqlExec, vcd.mutation, vcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcd *VendorCommissionDelete) ExecX(ctx context.Context) int {
+	n, err := vcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vcd *VendorCommissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vendorcommission.Table, sqlgraph.NewFieldSpec(vendorcommission.FieldID, field.TypeInt))
+	if ps := vcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vcd.mutation.done = true
+	return affected, err
+}
+
+// VendorCommissionDeleteOne is the builder for deleting a single VendorCommission entity.
+type VendorCommissionDeleteOne struct {
+	vcd *VendorCommissionDelete
+}
+
+// Where appends a list predicates to the VendorCommissionDelete builder.
+func (vcdo *VendorCommissionDeleteOne) Where(ps ...predicate.VendorCommission) *VendorCommissionDeleteOne {
+	vcdo.vcd.mutation.Where(ps...)
+	return vcdo
+}
+
+// Exec executes the deletion query.
+func (vcdo *VendorCommissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := vcdo.vcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vendorcommission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcdo *VendorCommissionDeleteOne) ExecX(ctx context.Context) {
+	if err := vcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+)
+
+// VendorCommissionUpdate is the builder for updating VendorCommission entities.
+type VendorCommissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VendorCommissionMutation
+}
+
+// Where appends a list predicates to the VendorCommissionUpdate builder.
+func (vcu *VendorCommissionUpdate) Where(ps ...predicate.VendorCommission) *VendorCommissionUpdate {
+	vcu.mutation.Where(ps...)
+	return vcu
+}
+
+// SetPackageID sets the "package_id" field.
+func (vcu *VendorCommissionUpdate) SetPackageID(i int) *VendorCommissionUpdate {
+	vcu.mutation.ResetPackageID()
+	vcu.mutation.SetPackageID(i)
+	return vcu
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (vcu *VendorCommissionUpdate) SetNillablePackageID(i *int) *VendorCommissionUpdate {
+	if i != nil {
+		vcu.SetPackageID(*i)
+	}
+	return vcu
+}
+
+// AddPackageID adds i to the "package_id" field.
+func (vcu *VendorCommissionUpdate) AddPackageID(i int) *VendorCommissionUpdate {
+	vcu.mutation.AddPackageID(i)
+	return vcu
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (vcu *VendorCommissionUpdate) SetVendorID(i int) *VendorCommissionUpdate {
+	vcu.mutation.ResetVendorID()
+	vcu.mutation.SetVendorID(i)
+	return vcu
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (vcu *VendorCommissionUpdate) SetNillableVendorID(i *int) *VendorCommissionUpdate {
+	if i != nil {
+		vcu.SetVendorID(*i)
+	}
+	return vcu
+}
+
+// AddVendorID adds i to the "vendor_id" field.
+func (vcu *VendorCommissionUpdate) AddVendorID(i int) *VendorCommissionUpdate {
+	vcu.mutation.AddVendorID(i)
+	return vcu
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (vcu *VendorCommissionUpdate) ClearVendorID() *VendorCommissionUpdate {
+	vcu.mutation.ClearVendorID()
+	return vcu
+}
+
+// SetCommissionType sets the "commission_type" field.
+func (vcu *VendorCommissionUpdate) SetCommissionType(vt vendorcommission.CommissionType) *VendorCommissionUpdate {
+	vcu.mutation.SetCommissionType(vt)
+	return vcu
+}
+
+// SetNillableCommissionType sets the "commission_type" field if the given value is not nil.
+func (vcu *VendorCommissionUpdate) SetNillableCommissionType(vt *vendorcommission.CommissionType) *VendorCommissionUpdate {
+	if vt != nil {
+		vcu.SetCommissionType(*vt)
+	}
+	return vcu
+}
+
+// SetValue sets the "value" field.
+func (vcu *VendorCommissionUpdate) SetValue(f float64) *VendorCommissionUpdate {
+	vcu.mutation.ResetValue()
+	vcu.mutation.SetValue(f)
+	return vcu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (vcu *VendorCommissionUpdate) SetNillableValue(f *float64) *VendorCommissionUpdate {
+	if f != nil {
+		vcu.SetValue(*f)
+	}
+	return vcu
+}
+
+// AddValue adds f to the "value" field.
+func (vcu *VendorCommissionUpdate) AddValue(f float64) *VendorCommissionUpdate {
+	vcu.mutation.AddValue(f)
+	return vcu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (vcu *VendorCommissionUpdate) SetUpdatedBy(s string) *VendorCommissionUpdate {
+	vcu.mutation.SetUpdatedBy(s)
+	return vcu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (vcu *VendorCommissionUpdate) SetNillableUpdatedBy(s *string) *VendorCommissionUpdate {
+	if s != nil {
+		vcu.SetUpdatedBy(*s)
+	}
+	return vcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vcu *VendorCommissionUpdate) SetUpdatedAt(t time.Time) *VendorCommissionUpdate {
+	vcu.mutation.SetUpdatedAt(t)
+	return vcu
+}
+
+// Mutation returns the VendorCommissionMutation object of the builder.
+func (vcu *VendorCommissionUpdate) Mutation() *VendorCommissionMutation {
+	return vcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vcu *VendorCommissionUpdate) Save(ctx context.Context) (int, error) {
+	vcu.defaults()
+	return withHooks(ctx, vcu.sqlSave, vcu.mutation, vcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcu *VendorCommissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := vcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vcu *VendorCommissionUpdate) Exec(ctx context.Context) error {
+	_, err := vcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcu *VendorCommissionUpdate) ExecX(ctx context.Context) {
+	if err := vcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcu *VendorCommissionUpdate) defaults() {
+	if _, ok := vcu.mutation.UpdatedAt(); !ok {
+		v := vendorcommission.UpdateDefaultUpdatedAt()
+		vcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcu *VendorCommissionUpdate) check() error {
+	if v, ok := vcu.mutation.CommissionType(); ok {
+		if err := vendorcommission.CommissionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "commission_type", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.commission_type": %w`, err)}
+		}
+	}
+	if v, ok := vcu.mutation.Value(); ok {
+		if err := vendorcommission.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.value": %w`, err)}
+		}
+	}
+	if v, ok := vcu.mutation.UpdatedBy(); ok {
+		if err := vendorcommission.UpdatedByValidator(v); err != nil {
+			return &ValidationError{Name: "updated_by", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.updated_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vcu *VendorCommissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendorcommission.Table, vendorcommission.Columns, sqlgraph.NewFieldSpec(vendorcommission.FieldID, field.TypeInt))
+	if ps := vcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vcu.mutation.PackageID(); ok {
+		_spec.SetField(vendorcommission.FieldPackageID, field.TypeInt, value)
+	}
+	if value, ok := vcu.mutation.AddedPackageID(); ok {
+		_spec.AddField(vendorcommission.FieldPackageID, field.TypeInt, value)
+	}
+	if value, ok := vcu.mutation.VendorID(); ok {
+		_spec.SetField(vendorcommission.FieldVendorID, field.TypeInt, value)
+	}
+	if value, ok := vcu.mutation.AddedVendorID(); ok {
+		_spec.AddField(vendorcommission.FieldVendorID, field.TypeInt, value)
+	}
+	if vcu.mutation.VendorIDCleared() {
+		_spec.ClearField(vendorcommission.FieldVendorID, field.TypeInt)
+	}
+	if value, ok := vcu.mutation.CommissionType(); ok {
+		_spec.SetField(vendorcommission.FieldCommissionType, field.TypeEnum, value)
+	}
+	if value, ok := vcu.mutation.Value(); ok {
+		_spec.SetField(vendorcommission.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := vcu.mutation.AddedValue(); ok {
+		_spec.AddField(vendorcommission.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := vcu.mutation.UpdatedBy(); ok {
+		_spec.SetField(vendorcommission.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := vcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendorcommission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendorcommission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vcu.mutation.done = true
+	return n, nil
+}
+
+// VendorCommissionUpdateOne is the builder for updating a single VendorCommission entity.
+type VendorCommissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VendorCommissionMutation
+}
+
+// SetPackageID sets the "package_id" field.
+func (vcuo *VendorCommissionUpdateOne) SetPackageID(i int) *VendorCommissionUpdateOne {
+	vcuo.mutation.ResetPackageID()
+	vcuo.mutation.SetPackageID(i)
+	return vcuo
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (vcuo *VendorCommissionUpdateOne) SetNillablePackageID(i *int) *VendorCommissionUpdateOne {
+	if i != nil {
+		vcuo.SetPackageID(*i)
+	}
+	return vcuo
+}
+
+// AddPackageID adds i to the "package_id" field.
+func (vcuo *VendorCommissionUpdateOne) AddPackageID(i int) *VendorCommissionUpdateOne {
+	vcuo.mutation.AddPackageID(i)
+	return vcuo
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (vcuo *VendorCommissionUpdateOne) SetVendorID(i int) *VendorCommissionUpdateOne {
+	vcuo.mutation.ResetVendorID()
+	vcuo.mutation.SetVendorID(i)
+	return vcuo
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (vcuo *VendorCommissionUpdateOne) SetNillableVendorID(i *int) *VendorCommissionUpdateOne {
+	if i != nil {
+		vcuo.SetVendorID(*i)
+	}
+	return vcuo
+}
+
+// AddVendorID adds i to the "vendor_id" field.
+func (vcuo *VendorCommissionUpdateOne) AddVendorID(i int) *VendorCommissionUpdateOne {
+	vcuo.mutation.AddVendorID(i)
+	return vcuo
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (vcuo *VendorCommissionUpdateOne) ClearVendorID() *VendorCommissionUpdateOne {
+	vcuo.mutation.ClearVendorID()
+	return vcuo
+}
+
+// SetCommissionType sets the "commission_type" field.
+func (vcuo *VendorCommissionUpdateOne) SetCommissionType(vt vendorcommission.CommissionType) *VendorCommissionUpdateOne {
+	vcuo.mutation.SetCommissionType(vt)
+	return vcuo
+}
+
+// SetNillableCommissionType sets the "commission_type" field if the given value is not nil.
+func (vcuo *VendorCommissionUpdateOne) SetNillableCommissionType(vt *vendorcommission.CommissionType) *VendorCommissionUpdateOne {
+	if vt != nil {
+		vcuo.SetCommissionType(*vt)
+	}
+	return vcuo
+}
+
+// SetValue sets the "value" field.
+func (vcuo *VendorCommissionUpdateOne) SetValue(f float64) *VendorCommissionUpdateOne {
+	vcuo.mutation.ResetValue()
+	vcuo.mutation.SetValue(f)
+	return vcuo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (vcuo *VendorCommissionUpdateOne) SetNillableValue(f *float64) *VendorCommissionUpdateOne {
+	if f != nil {
+		vcuo.SetValue(*f)
+	}
+	return vcuo
+}
+
+// AddValue adds f to the "value" field.
+func (vcuo *VendorCommissionUpdateOne) AddValue(f float64) *VendorCommissionUpdateOne {
+	vcuo.mutation.AddValue(f)
+	return vcuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (vcuo *VendorCommissionUpdateOne) SetUpdatedBy(s string) *VendorCommissionUpdateOne {
+	vcuo.mutation.SetUpdatedBy(s)
+	return vcuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (vcuo *VendorCommissionUpdateOne) SetNillableUpdatedBy(s *string) *VendorCommissionUpdateOne {
+	if s != nil {
+		vcuo.SetUpdatedBy(*s)
+	}
+	return vcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (vcuo *VendorCommissionUpdateOne) SetUpdatedAt(t time.Time) *VendorCommissionUpdateOne {
+	vcuo.mutation.SetUpdatedAt(t)
+	return vcuo
+}
+
+// Mutation returns the VendorCommissionMutation object of the builder.
+func (vcuo *VendorCommissionUpdateOne) Mutation() *VendorCommissionMutation {
+	return vcuo.mutation
+}
+
+// Where appends a list predicates to the VendorCommissionUpdate builder.
+func (vcuo *VendorCommissionUpdateOne) Where(ps ...predicate.VendorCommission) *VendorCommissionUpdateOne {
+	vcuo.mutation.Where(ps...)
+	return vcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vcuo *VendorCommissionUpdateOne) Select(field string, fields ...string) *VendorCommissionUpdateOne {
+	vcuo.fields = append([]string{field}, fields...)
+	return vcuo
+}
+
+// Save executes the query and returns the updated VendorCommission entity.
+func (vcuo *VendorCommissionUpdateOne) Save(ctx context.Context) (*VendorCommission, error) {
+	vcuo.defaults()
+	return withHooks(ctx, vcuo.sqlSave, vcuo.mutation, vcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcuo *VendorCommissionUpdateOne) SaveX(ctx context.Context) *VendorCommission {
+	node, err := vcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vcuo *VendorCommissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := vcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcuo *VendorCommissionUpdateOne) ExecX(ctx context.Context) {
+	if err := vcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vcuo *VendorCommissionUpdateOne) defaults() {
+	if _, ok := vcuo.mutation.UpdatedAt(); !ok {
+		v := vendorcommission.UpdateDefaultUpdatedAt()
+		vcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vcuo *VendorCommissionUpdateOne) check() error {
+	if v, ok := vcuo.mutation.CommissionType(); ok {
+		if err := vendorcommission.CommissionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "commission_type", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.commission_type": %w`, err)}
+		}
+	}
+	if v, ok := vcuo.mutation.Value(); ok {
+		if err := vendorcommission.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.value": %w`, err)}
+		}
+	}
+	if v, ok := vcuo.mutation.UpdatedBy(); ok {
+		if err := vendorcommission.UpdatedByValidator(v); err != nil {
+			return &ValidationError{Name: "updated_by", err: fmt.Errorf(`ent: validator failed for field "VendorCommission.updated_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vcuo *VendorCommissionUpdateOne) sqlSave(ctx context.Context) (_node *VendorCommission, err error) {
+	if err := vcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendorcommission.Table, vendorcommission.Columns, sqlgraph.NewFieldSpec(vendorcommission.FieldID, field.TypeInt))
+	id, ok := vcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VendorCommission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vendorcommission.FieldID)
+		for _, f := range fields {
+			if !vendorcommission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vendorcommission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vcuo.mutation.PackageID(); ok {
+		_spec.SetField(vendorcommission.FieldPackageID, field.TypeInt, value)
+	}
+	if value, ok := vcuo.mutation.AddedPackageID(); ok {
+		_spec.AddField(vendorcommission.FieldPackageID, field.TypeInt, value)
+	}
+	if value, ok := vcuo.mutation.VendorID(); ok {
+		_spec.SetField(vendorcommission.FieldVendorID, field.TypeInt, value)
+	}
+	if value, ok := vcuo.mutation.AddedVendorID(); ok {
+		_spec.AddField(vendorcommission.FieldVendorID, field.TypeInt, value)
+	}
+	if vcuo.mutation.VendorIDCleared() {
+		_spec.ClearField(vendorcommission.FieldVendorID, field.TypeInt)
+	}
+	if value, ok := vcuo.mutation.CommissionType(); ok {
+		_spec.SetField(vendorcommission.FieldCommissionType, field.TypeEnum, value)
+	}
+	if value, ok := vcuo.mutation.Value(); ok {
+		_spec.SetField(vendorcommission.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := vcuo.mutation.AddedValue(); ok {
+		_spec.AddField(vendorcommission.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := vcuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(vendorcommission.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := vcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(vendorcommission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &VendorCommission{config: vcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendorcommission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vcuo.mutation.done = true
+	return _node, nil
+}
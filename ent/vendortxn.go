@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+)
+
+// VendorTxn is the model entity for the VendorTxn schema.
+type VendorTxn struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TransactionRef holds the value of the "transaction_ref" field.
+	TransactionRef string `json:"transaction_ref,omitempty"`
+	// VendorID holds the value of the "vendor_id" field.
+	VendorID int `json:"vendor_id,omitempty"`
+	// Type holds the value of the "type" field.
+	Type vendortxn.Type `json:"type,omitempty"`
+	// Added to the wallet, negative for activations and renewals
+	Amount float64 `json:"amount,omitempty"`
+	// Wallet balance after the transaction
+	TotalBalance float64 `json:"total_balance,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// transaction_ref of the client transaction the vendor paid for
+	ClientTxnRef string `json:"client_txn_ref,omitempty"`
+	// PackageID holds the value of the "package_id" field.
+	PackageID *int `json:"package_id,omitempty"`
+	// Package price the client was charged, amount is this less the commission
+	Price float64 `json:"price,omitempty"`
+	// Commission holds the value of the "commission" field.
+	Commission float64 `json:"commission,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VendorTxn) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vendortxn.FieldAmount, vendortxn.FieldTotalBalance, vendortxn.FieldPrice, vendortxn.FieldCommission:
+			values[i] = new(sql.NullFloat64)
+		case vendortxn.FieldID, vendortxn.FieldVendorID, vendortxn.FieldPackageID:
+			values[i] = new(sql.NullInt64)
+		case vendortxn.FieldTransactionRef, vendortxn.FieldType, vendortxn.FieldClientUsername, vendortxn.FieldClientTxnRef, vendortxn.FieldDescription, vendortxn.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case vendortxn.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VendorTxn fields.
+func (vt *VendorTxn) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vendortxn.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vt.ID = int(value.Int64)
+		case vendortxn.FieldTransactionRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_ref", values[i])
+			} else if value.Valid {
+				vt.TransactionRef = value.String
+			}
+		case vendortxn.FieldVendorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vendor_id", values[i])
+			} else if value.Valid {
+				vt.VendorID = int(value.Int64)
+			}
+		case vendortxn.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				vt.Type = vendortxn.Type(value.String)
+			}
+		case vendortxn.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				vt.Amount = value.Float64
+			}
+		case vendortxn.FieldTotalBalance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_balance", values[i])
+			} else if value.Valid {
+				vt.TotalBalance = value.Float64
+			}
+		case vendortxn.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				vt.ClientUsername = value.String
+			}
+		case vendortxn.FieldClientTxnRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_txn_ref", values[i])
+			} else if value.Valid {
+				vt.ClientTxnRef = value.String
+			}
+		case vendortxn.FieldPackageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field package_id", values[i])
+			} else if value.Valid {
+				vt.PackageID = new(int)
+				*vt.PackageID = int(value.Int64)
+			}
+		case vendortxn.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				vt.Price = value.Float64
+			}
+		case vendortxn.FieldCommission:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field commission", values[i])
+			} else if value.Valid {
+				vt.Commission = value.Float64
+			}
+		case vendortxn.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				vt.Description = value.String
+			}
+		case vendortxn.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				vt.CreatedBy = value.String
+			}
+		case vendortxn.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				vt.CreatedAt = value.Time
+			}
+		default:
+			vt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VendorTxn.
+// This includes values selected through modifiers, order, etc.
+func (vt *VendorTxn) Value(name string) (ent.Value, error) {
+	return vt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VendorTxn.
+// Note that you need to call VendorTxn.Unwrap() before calling this method if this VendorTxn
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vt *VendorTxn) Update() *VendorTxnUpdateOne {
+	return NewVendorTxnClient(vt.config).UpdateOne(vt)
+}
+
+// Unwrap unwraps the VendorTxn entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vt *VendorTxn) Unwrap() *VendorTxn {
+	_tx, ok := vt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VendorTxn is not a transactional entity")
+	}
+	vt.config.driver = _tx.drv
+	return vt
+}
+
+// String implements the fmt.Stringer.
+func (vt *VendorTxn) String() string {
+	var builder strings.Builder
+	builder.WriteString("VendorTxn(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vt.ID))
+	builder.WriteString("transaction_ref=")
+	builder.WriteString(vt.TransactionRef)
+	builder.WriteString(", ")
+	builder.WriteString("vendor_id=")
+	builder.WriteString(fmt.Sprintf("%v", vt.VendorID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", vt.Type))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", vt.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("total_balance=")
+	builder.WriteString(fmt.Sprintf("%v", vt.TotalBalance))
+	builder.WriteString(", ")
+	builder.WriteString("client_username=")
+	builder.WriteString(vt.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("client_txn_ref=")
+	builder.WriteString(vt.ClientTxnRef)
+	builder.WriteString(", ")
+	if v := vt.PackageID; v != nil {
+		builder.WriteString("package_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", vt.Price))
+	builder.WriteString(", ")
+	builder.WriteString("commission=")
+	builder.WriteString(fmt.Sprintf("%v", vt.Commission))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(vt.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(vt.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(vt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VendorTxns is a parsable slice of VendorTxn.
+type VendorTxns []*VendorTxn
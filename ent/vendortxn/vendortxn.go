@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vendortxn
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the vendortxn type in the database.
+	Label = "vendor_txn"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTransactionRef holds the string denoting the transaction_ref field in the database.
+	FieldTransactionRef = "transaction_ref"
+	// FieldVendorID holds the string denoting the vendor_id field in the database.
+	FieldVendorID = "vendor_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldTotalBalance holds the string denoting the total_balance field in the database.
+	FieldTotalBalance = "total_balance"
+	// FieldClientUsername holds the string denoting the client_username field in the database.
+	FieldClientUsername = "client_username"
+	// FieldClientTxnRef holds the string denoting the client_txn_ref field in the database.
+	FieldClientTxnRef = "client_txn_ref"
+	// FieldPackageID holds the string denoting the package_id field in the database.
+	FieldPackageID = "package_id"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldCommission holds the string denoting the commission field in the database.
+	FieldCommission = "commission"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the vendortxn in the database.
+	Table = "vendor_txn"
+)
+
+// Columns holds all SQL columns for vendortxn fields.
+var Columns = []string{
+	FieldID,
+	FieldTransactionRef,
+	FieldVendorID,
+	FieldType,
+	FieldAmount,
+	FieldTotalBalance,
+	FieldClientUsername,
+	FieldClientTxnRef,
+	FieldPackageID,
+	FieldPrice,
+	FieldCommission,
+	FieldDescription,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	TransactionRefValidator func(string) error
+	// ClientUsernameValidator is a validator for the "client_username" field. It is called by the builders before save.
+	ClientUsernameValidator func(string) error
+	// ClientTxnRefValidator is a validator for the "client_txn_ref" field. It is called by the builders before save.
+	ClientTxnRefValidator func(string) error
+	// DefaultPrice holds the default value on creation for the "price" field.
+	DefaultPrice float64
+	// DefaultCommission holds the default value on creation for the "commission" field.
+	DefaultCommission float64
+	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	CreatedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeTOPUP      Type = "TOPUP"
+	TypeACTIVE     Type = "ACTIVE"
+	TypeRENEWAL    Type = "RENEWAL"
+	TypeADJUSTMENT Type = "ADJUSTMENT"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeTOPUP, TypeACTIVE, TypeRENEWAL, TypeADJUSTMENT:
+		return nil
+	default:
+		return fmt.Errorf("vendortxn: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the VendorTxn queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTransactionRef orders the results by the transaction_ref field.
+func ByTransactionRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionRef, opts...).ToFunc()
+}
+
+// ByVendorID orders the results by the vendor_id field.
+func ByVendorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVendorID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByTotalBalance orders the results by the total_balance field.
+func ByTotalBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalBalance, opts...).ToFunc()
+}
+
+// ByClientUsername orders the results by the client_username field.
+func ByClientUsername(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientUsername, opts...).ToFunc()
+}
+
+// ByClientTxnRef orders the results by the client_txn_ref field.
+func ByClientTxnRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientTxnRef, opts...).ToFunc()
+}
+
+// ByPackageID orders the results by the package_id field.
+func ByPackageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackageID, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByCommission orders the results by the commission field.
+func ByCommission(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommission, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,795 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vendortxn
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldID, id))
+}
+
+// TransactionRef applies equality check predicate on the "transaction_ref" field. It's identical to TransactionRefEQ.
+func TransactionRef(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldVendorID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldAmount, v))
+}
+
+// TotalBalance applies equality check predicate on the "total_balance" field. It's identical to TotalBalanceEQ.
+func TotalBalance(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldTotalBalance, v))
+}
+
+// ClientUsername applies equality check predicate on the "client_username" field. It's identical to ClientUsernameEQ.
+func ClientUsername(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ClientTxnRef applies equality check predicate on the "client_txn_ref" field. It's identical to ClientTxnRefEQ.
+func ClientTxnRef(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldClientTxnRef, v))
+}
+
+// PackageID applies equality check predicate on the "package_id" field. It's identical to PackageIDEQ.
+func PackageID(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldPackageID, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldPrice, v))
+}
+
+// Commission applies equality check predicate on the "commission" field. It's identical to CommissionEQ.
+func Commission(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldCommission, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TransactionRefEQ applies the EQ predicate on the "transaction_ref" field.
+func TransactionRefEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefNEQ applies the NEQ predicate on the "transaction_ref" field.
+func TransactionRefNEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefIn applies the In predicate on the "transaction_ref" field.
+func TransactionRefIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefNotIn applies the NotIn predicate on the "transaction_ref" field.
+func TransactionRefNotIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefGT applies the GT predicate on the "transaction_ref" field.
+func TransactionRefGT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldTransactionRef, v))
+}
+
+// TransactionRefGTE applies the GTE predicate on the "transaction_ref" field.
+func TransactionRefGTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldTransactionRef, v))
+}
+
+// TransactionRefLT applies the LT predicate on the "transaction_ref" field.
+func TransactionRefLT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldTransactionRef, v))
+}
+
+// TransactionRefLTE applies the LTE predicate on the "transaction_ref" field.
+func TransactionRefLTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldTransactionRef, v))
+}
+
+// TransactionRefContains applies the Contains predicate on the "transaction_ref" field.
+func TransactionRefContains(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContains(FieldTransactionRef, v))
+}
+
+// TransactionRefHasPrefix applies the HasPrefix predicate on the "transaction_ref" field.
+func TransactionRefHasPrefix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasPrefix(FieldTransactionRef, v))
+}
+
+// TransactionRefHasSuffix applies the HasSuffix predicate on the "transaction_ref" field.
+func TransactionRefHasSuffix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasSuffix(FieldTransactionRef, v))
+}
+
+// TransactionRefEqualFold applies the EqualFold predicate on the "transaction_ref" field.
+func TransactionRefEqualFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEqualFold(FieldTransactionRef, v))
+}
+
+// TransactionRefContainsFold applies the ContainsFold predicate on the "transaction_ref" field.
+func TransactionRefContainsFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContainsFold(FieldTransactionRef, v))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// VendorIDGT applies the GT predicate on the "vendor_id" field.
+func VendorIDGT(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldVendorID, v))
+}
+
+// VendorIDGTE applies the GTE predicate on the "vendor_id" field.
+func VendorIDGTE(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldVendorID, v))
+}
+
+// VendorIDLT applies the LT predicate on the "vendor_id" field.
+func VendorIDLT(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldVendorID, v))
+}
+
+// VendorIDLTE applies the LTE predicate on the "vendor_id" field.
+func VendorIDLTE(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldVendorID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldType, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldAmount, v))
+}
+
+// TotalBalanceEQ applies the EQ predicate on the "total_balance" field.
+func TotalBalanceEQ(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldTotalBalance, v))
+}
+
+// TotalBalanceNEQ applies the NEQ predicate on the "total_balance" field.
+func TotalBalanceNEQ(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldTotalBalance, v))
+}
+
+// TotalBalanceIn applies the In predicate on the "total_balance" field.
+func TotalBalanceIn(vs ...float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldTotalBalance, vs...))
+}
+
+// TotalBalanceNotIn applies the NotIn predicate on the "total_balance" field.
+func TotalBalanceNotIn(vs ...float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldTotalBalance, vs...))
+}
+
+// TotalBalanceGT applies the GT predicate on the "total_balance" field.
+func TotalBalanceGT(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldTotalBalance, v))
+}
+
+// TotalBalanceGTE applies the GTE predicate on the "total_balance" field.
+func TotalBalanceGTE(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldTotalBalance, v))
+}
+
+// TotalBalanceLT applies the LT predicate on the "total_balance" field.
+func TotalBalanceLT(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldTotalBalance, v))
+}
+
+// TotalBalanceLTE applies the LTE predicate on the "total_balance" field.
+func TotalBalanceLTE(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldTotalBalance, v))
+}
+
+// ClientUsernameEQ applies the EQ predicate on the "client_username" field.
+func ClientUsernameEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameNEQ applies the NEQ predicate on the "client_username" field.
+func ClientUsernameNEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldClientUsername, v))
+}
+
+// ClientUsernameIn applies the In predicate on the "client_username" field.
+func ClientUsernameIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameNotIn applies the NotIn predicate on the "client_username" field.
+func ClientUsernameNotIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldClientUsername, vs...))
+}
+
+// ClientUsernameGT applies the GT predicate on the "client_username" field.
+func ClientUsernameGT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldClientUsername, v))
+}
+
+// ClientUsernameGTE applies the GTE predicate on the "client_username" field.
+func ClientUsernameGTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldClientUsername, v))
+}
+
+// ClientUsernameLT applies the LT predicate on the "client_username" field.
+func ClientUsernameLT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldClientUsername, v))
+}
+
+// ClientUsernameLTE applies the LTE predicate on the "client_username" field.
+func ClientUsernameLTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldClientUsername, v))
+}
+
+// ClientUsernameContains applies the Contains predicate on the "client_username" field.
+func ClientUsernameContains(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContains(FieldClientUsername, v))
+}
+
+// ClientUsernameHasPrefix applies the HasPrefix predicate on the "client_username" field.
+func ClientUsernameHasPrefix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasPrefix(FieldClientUsername, v))
+}
+
+// ClientUsernameHasSuffix applies the HasSuffix predicate on the "client_username" field.
+func ClientUsernameHasSuffix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasSuffix(FieldClientUsername, v))
+}
+
+// ClientUsernameIsNil applies the IsNil predicate on the "client_username" field.
+func ClientUsernameIsNil() predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIsNull(FieldClientUsername))
+}
+
+// ClientUsernameNotNil applies the NotNil predicate on the "client_username" field.
+func ClientUsernameNotNil() predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotNull(FieldClientUsername))
+}
+
+// ClientUsernameEqualFold applies the EqualFold predicate on the "client_username" field.
+func ClientUsernameEqualFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEqualFold(FieldClientUsername, v))
+}
+
+// ClientUsernameContainsFold applies the ContainsFold predicate on the "client_username" field.
+func ClientUsernameContainsFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContainsFold(FieldClientUsername, v))
+}
+
+// ClientTxnRefEQ applies the EQ predicate on the "client_txn_ref" field.
+func ClientTxnRefEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefNEQ applies the NEQ predicate on the "client_txn_ref" field.
+func ClientTxnRefNEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefIn applies the In predicate on the "client_txn_ref" field.
+func ClientTxnRefIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldClientTxnRef, vs...))
+}
+
+// ClientTxnRefNotIn applies the NotIn predicate on the "client_txn_ref" field.
+func ClientTxnRefNotIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldClientTxnRef, vs...))
+}
+
+// ClientTxnRefGT applies the GT predicate on the "client_txn_ref" field.
+func ClientTxnRefGT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefGTE applies the GTE predicate on the "client_txn_ref" field.
+func ClientTxnRefGTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefLT applies the LT predicate on the "client_txn_ref" field.
+func ClientTxnRefLT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefLTE applies the LTE predicate on the "client_txn_ref" field.
+func ClientTxnRefLTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefContains applies the Contains predicate on the "client_txn_ref" field.
+func ClientTxnRefContains(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContains(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefHasPrefix applies the HasPrefix predicate on the "client_txn_ref" field.
+func ClientTxnRefHasPrefix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasPrefix(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefHasSuffix applies the HasSuffix predicate on the "client_txn_ref" field.
+func ClientTxnRefHasSuffix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasSuffix(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefIsNil applies the IsNil predicate on the "client_txn_ref" field.
+func ClientTxnRefIsNil() predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIsNull(FieldClientTxnRef))
+}
+
+// ClientTxnRefNotNil applies the NotNil predicate on the "client_txn_ref" field.
+func ClientTxnRefNotNil() predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotNull(FieldClientTxnRef))
+}
+
+// ClientTxnRefEqualFold applies the EqualFold predicate on the "client_txn_ref" field.
+func ClientTxnRefEqualFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEqualFold(FieldClientTxnRef, v))
+}
+
+// ClientTxnRefContainsFold applies the ContainsFold predicate on the "client_txn_ref" field.
+func ClientTxnRefContainsFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContainsFold(FieldClientTxnRef, v))
+}
+
+// PackageIDEQ applies the EQ predicate on the "package_id" field.
+func PackageIDEQ(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldPackageID, v))
+}
+
+// PackageIDNEQ applies the NEQ predicate on the "package_id" field.
+func PackageIDNEQ(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldPackageID, v))
+}
+
+// PackageIDIn applies the In predicate on the "package_id" field.
+func PackageIDIn(vs ...int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldPackageID, vs...))
+}
+
+// PackageIDNotIn applies the NotIn predicate on the "package_id" field.
+func PackageIDNotIn(vs ...int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldPackageID, vs...))
+}
+
+// PackageIDGT applies the GT predicate on the "package_id" field.
+func PackageIDGT(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldPackageID, v))
+}
+
+// PackageIDGTE applies the GTE predicate on the "package_id" field.
+func PackageIDGTE(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldPackageID, v))
+}
+
+// PackageIDLT applies the LT predicate on the "package_id" field.
+func PackageIDLT(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldPackageID, v))
+}
+
+// PackageIDLTE applies the LTE predicate on the "package_id" field.
+func PackageIDLTE(v int) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldPackageID, v))
+}
+
+// PackageIDIsNil applies the IsNil predicate on the "package_id" field.
+func PackageIDIsNil() predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIsNull(FieldPackageID))
+}
+
+// PackageIDNotNil applies the NotNil predicate on the "package_id" field.
+func PackageIDNotNil() predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotNull(FieldPackageID))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldPrice, v))
+}
+
+// CommissionEQ applies the EQ predicate on the "commission" field.
+func CommissionEQ(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldCommission, v))
+}
+
+// CommissionNEQ applies the NEQ predicate on the "commission" field.
+func CommissionNEQ(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldCommission, v))
+}
+
+// CommissionIn applies the In predicate on the "commission" field.
+func CommissionIn(vs ...float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldCommission, vs...))
+}
+
+// CommissionNotIn applies the NotIn predicate on the "commission" field.
+func CommissionNotIn(vs ...float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldCommission, vs...))
+}
+
+// CommissionGT applies the GT predicate on the "commission" field.
+func CommissionGT(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldCommission, v))
+}
+
+// CommissionGTE applies the GTE predicate on the "commission" field.
+func CommissionGTE(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldCommission, v))
+}
+
+// CommissionLT applies the LT predicate on the "commission" field.
+func CommissionLT(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldCommission, v))
+}
+
+// CommissionLTE applies the LTE predicate on the "commission" field.
+func CommissionLTE(v float64) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldCommission, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VendorTxn) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VendorTxn) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VendorTxn) predicate.VendorTxn {
+	return predicate.VendorTxn(sql.NotPredicates(p))
+}
@@ -0,0 +1,427 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+)
+
+// VendorTxnCreate is the builder for creating a VendorTxn entity.
+type VendorTxnCreate struct {
+	config
+	mutation *VendorTxnMutation
+	hooks    []Hook
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (vtc *VendorTxnCreate) SetTransactionRef(s string) *VendorTxnCreate {
+	vtc.mutation.SetTransactionRef(s)
+	return vtc
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (vtc *VendorTxnCreate) SetVendorID(i int) *VendorTxnCreate {
+	vtc.mutation.SetVendorID(i)
+	return vtc
+}
+
+// SetType sets the "type" field.
+func (vtc *VendorTxnCreate) SetType(v vendortxn.Type) *VendorTxnCreate {
+	vtc.mutation.SetType(v)
+	return vtc
+}
+
+// SetAmount sets the "amount" field.
+func (vtc *VendorTxnCreate) SetAmount(f float64) *VendorTxnCreate {
+	vtc.mutation.SetAmount(f)
+	return vtc
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (vtc *VendorTxnCreate) SetTotalBalance(f float64) *VendorTxnCreate {
+	vtc.mutation.SetTotalBalance(f)
+	return vtc
+}
+
+// SetClientUsername sets the "client_username" field.
+func (vtc *VendorTxnCreate) SetClientUsername(s string) *VendorTxnCreate {
+	vtc.mutation.SetClientUsername(s)
+	return vtc
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (vtc *VendorTxnCreate) SetNillableClientUsername(s *string) *VendorTxnCreate {
+	if s != nil {
+		vtc.SetClientUsername(*s)
+	}
+	return vtc
+}
+
+// SetClientTxnRef sets the "client_txn_ref" field.
+func (vtc *VendorTxnCreate) SetClientTxnRef(s string) *VendorTxnCreate {
+	vtc.mutation.SetClientTxnRef(s)
+	return vtc
+}
+
+// SetNillableClientTxnRef sets the "client_txn_ref" field if the given value is not nil.
+func (vtc *VendorTxnCreate) SetNillableClientTxnRef(s *string) *VendorTxnCreate {
+	if s != nil {
+		vtc.SetClientTxnRef(*s)
+	}
+	return vtc
+}
+
+// SetPackageID sets the "package_id" field.
+func (vtc *VendorTxnCreate) SetPackageID(i int) *VendorTxnCreate {
+	vtc.mutation.SetPackageID(i)
+	return vtc
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (vtc *VendorTxnCreate) SetNillablePackageID(i *int) *VendorTxnCreate {
+	if i != nil {
+		vtc.SetPackageID(*i)
+	}
+	return vtc
+}
+
+// SetPrice sets the "price" field.
+func (vtc *VendorTxnCreate) SetPrice(f float64) *VendorTxnCreate {
+	vtc.mutation.SetPrice(f)
+	return vtc
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (vtc *VendorTxnCreate) SetNillablePrice(f *float64) *VendorTxnCreate {
+	if f != nil {
+		vtc.SetPrice(*f)
+	}
+	return vtc
+}
+
+// SetCommission sets the "commission" field.
+func (vtc *VendorTxnCreate) SetCommission(f float64) *VendorTxnCreate {
+	vtc.mutation.SetCommission(f)
+	return vtc
+}
+
+// SetNillableCommission sets the "commission" field if the given value is not nil.
+func (vtc *VendorTxnCreate) SetNillableCommission(f *float64) *VendorTxnCreate {
+	if f != nil {
+		vtc.SetCommission(*f)
+	}
+	return vtc
+}
+
+// SetDescription sets the "description" field.
+func (vtc *VendorTxnCreate) SetDescription(s string) *VendorTxnCreate {
+	vtc.mutation.SetDescription(s)
+	return vtc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vtc *VendorTxnCreate) SetNillableDescription(s *string) *VendorTxnCreate {
+	if s != nil {
+		vtc.SetDescription(*s)
+	}
+	return vtc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (vtc *VendorTxnCreate) SetCreatedBy(s string) *VendorTxnCreate {
+	vtc.mutation.SetCreatedBy(s)
+	return vtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vtc *VendorTxnCreate) SetCreatedAt(t time.Time) *VendorTxnCreate {
+	vtc.mutation.SetCreatedAt(t)
+	return vtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vtc *VendorTxnCreate) SetNillableCreatedAt(t *time.Time) *VendorTxnCreate {
+	if t != nil {
+		vtc.SetCreatedAt(*t)
+	}
+	return vtc
+}
+
+// Mutation returns the VendorTxnMutation object of the builder.
+func (vtc *VendorTxnCreate) Mutation() *VendorTxnMutation {
+	return vtc.mutation
+}
+
+// Save creates the VendorTxn in the database.
+func (vtc *VendorTxnCreate) Save(ctx context.Context) (*VendorTxn, error) {
+	vtc.defaults()
+	return withHooks(ctx, vtc.sqlSave, vtc.mutation, vtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vtc *VendorTxnCreate) SaveX(ctx context.Context) *VendorTxn {
+	v, err := vtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vtc *VendorTxnCreate) Exec(ctx context.Context) error {
+	_, err := vtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vtc *VendorTxnCreate) ExecX(ctx context.Context) {
+	if err := vtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vtc *VendorTxnCreate) defaults() {
+	if _, ok := vtc.mutation.Price(); !ok {
+		v := vendortxn.DefaultPrice
+		vtc.mutation.SetPrice(v)
+	}
+	if _, ok := vtc.mutation.Commission(); !ok {
+		v := vendortxn.DefaultCommission
+		vtc.mutation.SetCommission(v)
+	}
+	if _, ok := vtc.mutation.CreatedAt(); !ok {
+		v := vendortxn.DefaultCreatedAt()
+		vtc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vtc *VendorTxnCreate) check() error {
+	if _, ok := vtc.mutation.TransactionRef(); !ok {
+		return &ValidationError{Name: "transaction_ref", err: errors.New(`ent: missing required field "VendorTxn.transaction_ref"`)}
+	}
+	if v, ok := vtc.mutation.TransactionRef(); ok {
+		if err := vendortxn.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.transaction_ref": %w`, err)}
+		}
+	}
+	if _, ok := vtc.mutation.VendorID(); !ok {
+		return &ValidationError{Name: "vendor_id", err: errors.New(`ent: missing required field "VendorTxn.vendor_id"`)}
+	}
+	if _, ok := vtc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "VendorTxn.type"`)}
+	}
+	if v, ok := vtc.mutation.GetType(); ok {
+		if err := vendortxn.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.type": %w`, err)}
+		}
+	}
+	if _, ok := vtc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "VendorTxn.amount"`)}
+	}
+	if _, ok := vtc.mutation.TotalBalance(); !ok {
+		return &ValidationError{Name: "total_balance", err: errors.New(`ent: missing required field "VendorTxn.total_balance"`)}
+	}
+	if v, ok := vtc.mutation.ClientUsername(); ok {
+		if err := vendortxn.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.client_username": %w`, err)}
+		}
+	}
+	if v, ok := vtc.mutation.ClientTxnRef(); ok {
+		if err := vendortxn.ClientTxnRefValidator(v); err != nil {
+			return &ValidationError{Name: "client_txn_ref", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.client_txn_ref": %w`, err)}
+		}
+	}
+	if _, ok := vtc.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "VendorTxn.price"`)}
+	}
+	if _, ok := vtc.mutation.Commission(); !ok {
+		return &ValidationError{Name: "commission", err: errors.New(`ent: missing required field "VendorTxn.commission"`)}
+	}
+	if _, ok := vtc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "VendorTxn.created_by"`)}
+	}
+	if v, ok := vtc.mutation.CreatedBy(); ok {
+		if err := vendortxn.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.created_by": %w`, err)}
+		}
+	}
+	if _, ok := vtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VendorTxn.created_at"`)}
+	}
+	return nil
+}
+
+func (vtc *VendorTxnCreate) sqlSave(ctx context.Context) (*VendorTxn, error) {
+	if err := vtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vtc.mutation.id = &_node.ID
+	vtc.mutation.done = true
+	return _node, nil
+}
+
+func (vtc *VendorTxnCreate) createSpec() (*VendorTxn, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VendorTxn{config: vtc.config}
+		_spec = sqlgraph.NewCreateSpec(vendortxn.Table, sqlgraph.NewFieldSpec(vendortxn.FieldID, field.TypeInt))
+	)
+	if value, ok := vtc.mutation.TransactionRef(); ok {
+		_spec.SetField(vendortxn.FieldTransactionRef, field.TypeString, value)
+		_node.TransactionRef = value
+	}
+	if value, ok := vtc.mutation.VendorID(); ok {
+		_spec.SetField(vendortxn.FieldVendorID, field.TypeInt, value)
+		_node.VendorID = value
+	}
+	if value, ok := vtc.mutation.GetType(); ok {
+		_spec.SetField(vendortxn.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := vtc.mutation.Amount(); ok {
+		_spec.SetField(vendortxn.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := vtc.mutation.TotalBalance(); ok {
+		_spec.SetField(vendortxn.FieldTotalBalance, field.TypeFloat64, value)
+		_node.TotalBalance = value
+	}
+	if value, ok := vtc.mutation.ClientUsername(); ok {
+		_spec.SetField(vendortxn.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := vtc.mutation.ClientTxnRef(); ok {
+		_spec.SetField(vendortxn.FieldClientTxnRef, field.TypeString, value)
+		_node.ClientTxnRef = value
+	}
+	if value, ok := vtc.mutation.PackageID(); ok {
+		_spec.SetField(vendortxn.FieldPackageID, field.TypeInt, value)
+		_node.PackageID = &value
+	}
+	if value, ok := vtc.mutation.Price(); ok {
+		_spec.SetField(vendortxn.FieldPrice, field.TypeFloat64, value)
+		_node.Price = value
+	}
+	if value, ok := vtc.mutation.Commission(); ok {
+		_spec.SetField(vendortxn.FieldCommission, field.TypeFloat64, value)
+		_node.Commission = value
+	}
+	if value, ok := vtc.mutation.Description(); ok {
+		_spec.SetField(vendortxn.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := vtc.mutation.CreatedBy(); ok {
+		_spec.SetField(vendortxn.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := vtc.mutation.CreatedAt(); ok {
+		_spec.SetField(vendortxn.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VendorTxnCreateBulk is the builder for creating many VendorTxn entities in bulk.
+type VendorTxnCreateBulk struct {
+	config
+	err      error
+	builders []*VendorTxnCreate
+}
+
+// Save creates the VendorTxn entities in the database.
+func (vtcb *VendorTxnCreateBulk) Save(ctx context.Context) ([]*VendorTxn, error) {
+	if vtcb.err != nil {
+		return nil, vtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vtcb.builders))
+	nodes := make([]*VendorTxn, len(vtcb.builders))
+	mutators := make([]Mutator, len(vtcb.builders))
+	for i := range vtcb.builders {
+		func(i int, root context.Context) {
+			builder := vtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VendorTxnMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vtcb *VendorTxnCreateBulk) SaveX(ctx context.Context) []*VendorTxn {
+	v, err := vtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vtcb *VendorTxnCreateBulk) Exec(ctx context.Context) error {
+	_, err := vtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vtcb *VendorTxnCreateBulk) ExecX(ctx context.Context) {
+	if err := vtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+)
+
+// VendorTxnDelete is the builder for deleting a VendorTxn entity.
+type VendorTxnDelete struct {
+	config
+	hooks    []Hook
+	mutation *VendorTxnMutation
+}
+
+// Where appends a list predicates to the VendorTxnDelete builder.
+func (vtd *VendorTxnDelete) Where(ps ...predicate.VendorTxn) *VendorTxnDelete {
+	vtd.mutation.Where(ps...)
+	return vtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vtd *VendorTxnDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vtd.sqlExec, vtd.mutation, vtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vtd *VendorTxnDelete) ExecX(ctx context.Context) int {
+	n, err := vtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vtd *VendorTxnDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vendortxn.Table, sqlgraph.NewFieldSpec(vendortxn.FieldID, field.TypeInt))
+	if ps := vtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vtd.mutation.done = true
+	return affected, err
+}
+
+// VendorTxnDeleteOne is the builder for deleting a single VendorTxn entity.
+type VendorTxnDeleteOne struct {
+	vtd *VendorTxnDelete
+}
+
+// Where appends a list predicates to the VendorTxnDelete builder.
+func (vtdo *VendorTxnDeleteOne) Where(ps ...predicate.VendorTxn) *VendorTxnDeleteOne {
+	vtdo.vtd.mutation.Where(ps...)
+	return vtdo
+}
+
+// Exec executes the deletion query.
+func (vtdo *VendorTxnDeleteOne) Exec(ctx context.Context) error {
+	n, err := vtdo.vtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vendortxn.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vtdo *VendorTxnDeleteOne) ExecX(ctx context.Context) {
+	if err := vtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+)
+
+// VendorTxnQuery is the builder for querying VendorTxn entities.
+type VendorTxnQuery struct {
+	config
+	ctx        *QueryContext
+	order      []vendortxn.OrderOption
+	inters     []Interceptor
+	predicates []predicate.VendorTxn
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VendorTxnQuery builder.
+func (vtq *VendorTxnQuery) Where(ps ...predicate.VendorTxn) *VendorTxnQuery {
+	vtq.predicates = append(vtq.predicates, ps...)
+	return vtq
+}
+
+// Limit the number of records to be returned by this query.
+func (vtq *VendorTxnQuery) Limit(limit int) *VendorTxnQuery {
+	vtq.ctx.Limit = &limit
+	return vtq
+}
+
+// Offset to start from.
+func (vtq *VendorTxnQuery) Offset(offset int) *VendorTxnQuery {
+	vtq.ctx.Offset = &offset
+	return vtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vtq *VendorTxnQuery) Unique(unique bool) *VendorTxnQuery {
+	vtq.ctx.Unique = &unique
+	return vtq
+}
+
+// Order specifies how the records should be ordered.
+func (vtq *VendorTxnQuery) Order(o ...vendortxn.OrderOption) *VendorTxnQuery {
+	vtq.order = append(vtq.order, o...)
+	return vtq
+}
+
+// First returns the first VendorTxn entity from the query.
+// Returns a *NotFoundError when no VendorTxn was found.
+func (vtq *VendorTxnQuery) First(ctx context.Context) (*VendorTxn, error) {
+	nodes, err := vtq.Limit(1).All(setContextOp(ctx, vtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{vendortxn.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vtq *VendorTxnQuery) FirstX(ctx context.Context) *VendorTxn {
+	node, err := vtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VendorTxn ID from the query.
+// Returns a *NotFoundError when no VendorTxn ID was found.
+func (vtq *VendorTxnQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vtq.Limit(1).IDs(setContextOp(ctx, vtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{vendortxn.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vtq *VendorTxnQuery) FirstIDX(ctx context.Context) int {
+	id, err := vtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VendorTxn entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VendorTxn entity is found.
+// Returns a *NotFoundError when no VendorTxn entities are found.
+func (vtq *VendorTxnQuery) Only(ctx context.Context) (*VendorTxn, error) {
+	nodes, err := vtq.Limit(2).All(setContextOp(ctx, vtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{vendortxn.Label}
+	default:
+		return nil, &NotSingularError{vendortxn.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vtq *VendorTxnQuery) OnlyX(ctx context.Context) *VendorTxn {
+	node, err := vtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VendorTxn ID in the query.
+// Returns a *NotSingularError when more than one VendorTxn ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vtq *VendorTxnQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vtq.Limit(2).IDs(setContextOp(ctx, vtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{vendortxn.Label}
+	default:
+		err = &NotSingularError{vendortxn.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vtq *VendorTxnQuery) OnlyIDX(ctx context.Context) int {
+	id, err := vtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VendorTxns.
+func (vtq *VendorTxnQuery) All(ctx context.Context) ([]*VendorTxn, error) {
+	ctx = setContextOp(ctx, vtq.ctx, ent.OpQueryAll)
+	if err := vtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VendorTxn, *VendorTxnQuery]()
+	return withInterceptors[[]*VendorTxn](ctx, vtq, qr, vtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vtq *VendorTxnQuery) AllX(ctx context.Context) []*VendorTxn {
+	nodes, err := vtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VendorTxn IDs.
+func (vtq *VendorTxnQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if vtq.ctx.Unique == nil && vtq.path != nil {
+		vtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vtq.ctx, ent.OpQueryIDs)
+	if err = vtq.Select(vendortxn.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vtq *VendorTxnQuery) IDsX(ctx context.Context) []int {
+	ids, err := vtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vtq *VendorTxnQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vtq.ctx, ent.OpQueryCount)
+	if err := vtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vtq, querierCount[*VendorTxnQuery](), vtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vtq *VendorTxnQuery) CountX(ctx context.Context) int {
+	count, err := vtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vtq *VendorTxnQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vtq.ctx, ent.OpQueryExist)
+	switch _, err := vtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vtq *VendorTxnQuery) ExistX(ctx context.Context) bool {
+	exist, err := vtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VendorTxnQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vtq *VendorTxnQuery) Clone() *VendorTxnQuery {
+	if vtq == nil {
+		return nil
+	}
+	return &VendorTxnQuery{
+		config:     vtq.config,
+		ctx:        vtq.ctx.Clone(),
+		order:      append([]vendortxn.OrderOption{}, vtq.order...),
+		inters:     append([]Interceptor{}, vtq.inters...),
+		predicates: append([]predicate.VendorTxn{}, vtq.predicates...),
+		// clone intermediate query.
+		sql:  vtq.sql.Clone(),
+		path: vtq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TransactionRef string `json:"transaction_ref,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VendorTxn.Query().
+//		GroupBy(vendortxn.FieldTransactionRef).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vtq *VendorTxnQuery) GroupBy(field string, fields ...string) *VendorTxnGroupBy {
+	vtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VendorTxnGroupBy{build: vtq}
+	grbuild.flds = &vtq.ctx.Fields
+	grbuild.label = vendortxn.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TransactionRef string `json:"transaction_ref,omitempty"`
+//	}
+//
+//	client.VendorTxn.Query().
+//		Select(vendortxn.FieldTransactionRef).
+//		Scan(ctx, &v)
+func (vtq *VendorTxnQuery) Select(fields ...string) *VendorTxnSelect {
+	vtq.ctx.Fields = append(vtq.ctx.Fields, fields...)
+	sbuild := &VendorTxnSelect{VendorTxnQuery: vtq}
+	sbuild.label = vendortxn.Label
+	sbuild.flds, sbuild.scan = &vtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VendorTxnSelect configured with the given aggregations.
+func (vtq *VendorTxnQuery) Aggregate(fns ...AggregateFunc) *VendorTxnSelect {
+	return vtq.Select().Aggregate(fns...)
+}
+
+func (vtq *VendorTxnQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vtq.ctx.Fields {
+		if !vendortxn.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vtq.path != nil {
+		prev, err := vtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vtq.sql = prev
+	}
+	return nil
+}
+
+func (vtq *VendorTxnQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VendorTxn, error) {
+	var (
+		nodes = []*VendorTxn{}
+		_spec = vtq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VendorTxn).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VendorTxn{config: vtq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (vtq *VendorTxnQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vtq.querySpec()
+	_spec.Node.Columns = vtq.ctx.Fields
+	if len(vtq.ctx.Fields) > 0 {
+		_spec.Unique = vtq.ctx.Unique != nil && *vtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vtq.driver, _spec)
+}
+
+func (vtq *VendorTxnQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(vendortxn.Table, vendortxn.Columns, sqlgraph.NewFieldSpec(vendortxn.FieldID, field.TypeInt))
+	_spec.From = vtq.sql
+	if unique := vtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vendortxn.FieldID)
+		for i := range fields {
+			if fields[i] != vendortxn.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vtq *VendorTxnQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vtq.driver.Dialect())
+	t1 := builder.Table(vendortxn.Table)
+	columns := vtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = vendortxn.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vtq.sql != nil {
+		selector = vtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vtq.ctx.Unique != nil && *vtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range vtq.predicates {
+		p(selector)
+	}
+	for _, p := range vtq.order {
+		p(selector)
+	}
+	if offset := vtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VendorTxnGroupBy is the group-by builder for VendorTxn entities.
+type VendorTxnGroupBy struct {
+	selector
+	build *VendorTxnQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vtgb *VendorTxnGroupBy) Aggregate(fns ...AggregateFunc) *VendorTxnGroupBy {
+	vtgb.fns = append(vtgb.fns, fns...)
+	return vtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vtgb *VendorTxnGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := vtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VendorTxnQuery, *VendorTxnGroupBy](ctx, vtgb.build, vtgb, vtgb.build.inters, v)
+}
+
+func (vtgb *VendorTxnGroupBy) sqlScan(ctx context.Context, root *VendorTxnQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vtgb.fns))
+	for _, fn := range vtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vtgb.flds)+len(vtgb.fns))
+		for _, f := range *vtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VendorTxnSelect is the builder for selecting fields of VendorTxn entities.
+type VendorTxnSelect struct {
+	*VendorTxnQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vts *VendorTxnSelect) Aggregate(fns ...AggregateFunc) *VendorTxnSelect {
+	vts.fns = append(vts.fns, fns...)
+	return vts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vts *VendorTxnSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vts.ctx, ent.OpQuerySelect)
+	if err := vts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VendorTxnQuery, *VendorTxnSelect](ctx, vts.VendorTxnQuery, vts, vts.inters, v)
+}
+
+func (vts *VendorTxnSelect) sqlScan(ctx context.Context, root *VendorTxnQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vts.fns))
+	for _, fn := range vts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,841 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+)
+
+// VendorTxnUpdate is the builder for updating VendorTxn entities.
+type VendorTxnUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VendorTxnMutation
+}
+
+// Where appends a list predicates to the VendorTxnUpdate builder.
+func (vtu *VendorTxnUpdate) Where(ps ...predicate.VendorTxn) *VendorTxnUpdate {
+	vtu.mutation.Where(ps...)
+	return vtu
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (vtu *VendorTxnUpdate) SetTransactionRef(s string) *VendorTxnUpdate {
+	vtu.mutation.SetTransactionRef(s)
+	return vtu
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableTransactionRef(s *string) *VendorTxnUpdate {
+	if s != nil {
+		vtu.SetTransactionRef(*s)
+	}
+	return vtu
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (vtu *VendorTxnUpdate) SetVendorID(i int) *VendorTxnUpdate {
+	vtu.mutation.ResetVendorID()
+	vtu.mutation.SetVendorID(i)
+	return vtu
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableVendorID(i *int) *VendorTxnUpdate {
+	if i != nil {
+		vtu.SetVendorID(*i)
+	}
+	return vtu
+}
+
+// AddVendorID adds i to the "vendor_id" field.
+func (vtu *VendorTxnUpdate) AddVendorID(i int) *VendorTxnUpdate {
+	vtu.mutation.AddVendorID(i)
+	return vtu
+}
+
+// SetType sets the "type" field.
+func (vtu *VendorTxnUpdate) SetType(v vendortxn.Type) *VendorTxnUpdate {
+	vtu.mutation.SetType(v)
+	return vtu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableType(v *vendortxn.Type) *VendorTxnUpdate {
+	if v != nil {
+		vtu.SetType(*v)
+	}
+	return vtu
+}
+
+// SetAmount sets the "amount" field.
+func (vtu *VendorTxnUpdate) SetAmount(f float64) *VendorTxnUpdate {
+	vtu.mutation.ResetAmount()
+	vtu.mutation.SetAmount(f)
+	return vtu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableAmount(f *float64) *VendorTxnUpdate {
+	if f != nil {
+		vtu.SetAmount(*f)
+	}
+	return vtu
+}
+
+// AddAmount adds f to the "amount" field.
+func (vtu *VendorTxnUpdate) AddAmount(f float64) *VendorTxnUpdate {
+	vtu.mutation.AddAmount(f)
+	return vtu
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (vtu *VendorTxnUpdate) SetTotalBalance(f float64) *VendorTxnUpdate {
+	vtu.mutation.ResetTotalBalance()
+	vtu.mutation.SetTotalBalance(f)
+	return vtu
+}
+
+// SetNillableTotalBalance sets the "total_balance" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableTotalBalance(f *float64) *VendorTxnUpdate {
+	if f != nil {
+		vtu.SetTotalBalance(*f)
+	}
+	return vtu
+}
+
+// AddTotalBalance adds f to the "total_balance" field.
+func (vtu *VendorTxnUpdate) AddTotalBalance(f float64) *VendorTxnUpdate {
+	vtu.mutation.AddTotalBalance(f)
+	return vtu
+}
+
+// SetClientUsername sets the "client_username" field.
+func (vtu *VendorTxnUpdate) SetClientUsername(s string) *VendorTxnUpdate {
+	vtu.mutation.SetClientUsername(s)
+	return vtu
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableClientUsername(s *string) *VendorTxnUpdate {
+	if s != nil {
+		vtu.SetClientUsername(*s)
+	}
+	return vtu
+}
+
+// ClearClientUsername clears the value of the "client_username" field.
+func (vtu *VendorTxnUpdate) ClearClientUsername() *VendorTxnUpdate {
+	vtu.mutation.ClearClientUsername()
+	return vtu
+}
+
+// SetClientTxnRef sets the "client_txn_ref" field.
+func (vtu *VendorTxnUpdate) SetClientTxnRef(s string) *VendorTxnUpdate {
+	vtu.mutation.SetClientTxnRef(s)
+	return vtu
+}
+
+// SetNillableClientTxnRef sets the "client_txn_ref" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableClientTxnRef(s *string) *VendorTxnUpdate {
+	if s != nil {
+		vtu.SetClientTxnRef(*s)
+	}
+	return vtu
+}
+
+// ClearClientTxnRef clears the value of the "client_txn_ref" field.
+func (vtu *VendorTxnUpdate) ClearClientTxnRef() *VendorTxnUpdate {
+	vtu.mutation.ClearClientTxnRef()
+	return vtu
+}
+
+// SetPackageID sets the "package_id" field.
+func (vtu *VendorTxnUpdate) SetPackageID(i int) *VendorTxnUpdate {
+	vtu.mutation.ResetPackageID()
+	vtu.mutation.SetPackageID(i)
+	return vtu
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillablePackageID(i *int) *VendorTxnUpdate {
+	if i != nil {
+		vtu.SetPackageID(*i)
+	}
+	return vtu
+}
+
+// AddPackageID adds i to the "package_id" field.
+func (vtu *VendorTxnUpdate) AddPackageID(i int) *VendorTxnUpdate {
+	vtu.mutation.AddPackageID(i)
+	return vtu
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (vtu *VendorTxnUpdate) ClearPackageID() *VendorTxnUpdate {
+	vtu.mutation.ClearPackageID()
+	return vtu
+}
+
+// SetPrice sets the "price" field.
+func (vtu *VendorTxnUpdate) SetPrice(f float64) *VendorTxnUpdate {
+	vtu.mutation.ResetPrice()
+	vtu.mutation.SetPrice(f)
+	return vtu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillablePrice(f *float64) *VendorTxnUpdate {
+	if f != nil {
+		vtu.SetPrice(*f)
+	}
+	return vtu
+}
+
+// AddPrice adds f to the "price" field.
+func (vtu *VendorTxnUpdate) AddPrice(f float64) *VendorTxnUpdate {
+	vtu.mutation.AddPrice(f)
+	return vtu
+}
+
+// SetCommission sets the "commission" field.
+func (vtu *VendorTxnUpdate) SetCommission(f float64) *VendorTxnUpdate {
+	vtu.mutation.ResetCommission()
+	vtu.mutation.SetCommission(f)
+	return vtu
+}
+
+// SetNillableCommission sets the "commission" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableCommission(f *float64) *VendorTxnUpdate {
+	if f != nil {
+		vtu.SetCommission(*f)
+	}
+	return vtu
+}
+
+// AddCommission adds f to the "commission" field.
+func (vtu *VendorTxnUpdate) AddCommission(f float64) *VendorTxnUpdate {
+	vtu.mutation.AddCommission(f)
+	return vtu
+}
+
+// SetDescription sets the "description" field.
+func (vtu *VendorTxnUpdate) SetDescription(s string) *VendorTxnUpdate {
+	vtu.mutation.SetDescription(s)
+	return vtu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableDescription(s *string) *VendorTxnUpdate {
+	if s != nil {
+		vtu.SetDescription(*s)
+	}
+	return vtu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (vtu *VendorTxnUpdate) ClearDescription() *VendorTxnUpdate {
+	vtu.mutation.ClearDescription()
+	return vtu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (vtu *VendorTxnUpdate) SetCreatedBy(s string) *VendorTxnUpdate {
+	vtu.mutation.SetCreatedBy(s)
+	return vtu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (vtu *VendorTxnUpdate) SetNillableCreatedBy(s *string) *VendorTxnUpdate {
+	if s != nil {
+		vtu.SetCreatedBy(*s)
+	}
+	return vtu
+}
+
+// Mutation returns the VendorTxnMutation object of the builder.
+func (vtu *VendorTxnUpdate) Mutation() *VendorTxnMutation {
+	return vtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vtu *VendorTxnUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vtu.sqlSave, vtu.mutation, vtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vtu *VendorTxnUpdate) SaveX(ctx context.Context) int {
+	affected, err := vtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vtu *VendorTxnUpdate) Exec(ctx context.Context) error {
+	_, err := vtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vtu *VendorTxnUpdate) ExecX(ctx context.Context) {
+	if err := vtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vtu *VendorTxnUpdate) check() error {
+	if v, ok := vtu.mutation.TransactionRef(); ok {
+		if err := vendortxn.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.transaction_ref": %w`, err)}
+		}
+	}
+	if v, ok := vtu.mutation.GetType(); ok {
+		if err := vendortxn.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.type": %w`, err)}
+		}
+	}
+	if v, ok := vtu.mutation.ClientUsername(); ok {
+		if err := vendortxn.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.client_username": %w`, err)}
+		}
+	}
+	if v, ok := vtu.mutation.ClientTxnRef(); ok {
+		if err := vendortxn.ClientTxnRefValidator(v); err != nil {
+			return &ValidationError{Name: "client_txn_ref", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.client_txn_ref": %w`, err)}
+		}
+	}
+	if v, ok := vtu.mutation.CreatedBy(); ok {
+		if err := vendortxn.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vtu *VendorTxnUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendortxn.Table, vendortxn.Columns, sqlgraph.NewFieldSpec(vendortxn.FieldID, field.TypeInt))
+	if ps := vtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vtu.mutation.TransactionRef(); ok {
+		_spec.SetField(vendortxn.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := vtu.mutation.VendorID(); ok {
+		_spec.SetField(vendortxn.FieldVendorID, field.TypeInt, value)
+	}
+	if value, ok := vtu.mutation.AddedVendorID(); ok {
+		_spec.AddField(vendortxn.FieldVendorID, field.TypeInt, value)
+	}
+	if value, ok := vtu.mutation.GetType(); ok {
+		_spec.SetField(vendortxn.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := vtu.mutation.Amount(); ok {
+		_spec.SetField(vendortxn.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vtu.mutation.AddedAmount(); ok {
+		_spec.AddField(vendortxn.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vtu.mutation.TotalBalance(); ok {
+		_spec.SetField(vendortxn.FieldTotalBalance, field.TypeFloat64, value)
+	}
+	if value, ok := vtu.mutation.AddedTotalBalance(); ok {
+		_spec.AddField(vendortxn.FieldTotalBalance, field.TypeFloat64, value)
+	}
+	if value, ok := vtu.mutation.ClientUsername(); ok {
+		_spec.SetField(vendortxn.FieldClientUsername, field.TypeString, value)
+	}
+	if vtu.mutation.ClientUsernameCleared() {
+		_spec.ClearField(vendortxn.FieldClientUsername, field.TypeString)
+	}
+	if value, ok := vtu.mutation.ClientTxnRef(); ok {
+		_spec.SetField(vendortxn.FieldClientTxnRef, field.TypeString, value)
+	}
+	if vtu.mutation.ClientTxnRefCleared() {
+		_spec.ClearField(vendortxn.FieldClientTxnRef, field.TypeString)
+	}
+	if value, ok := vtu.mutation.PackageID(); ok {
+		_spec.SetField(vendortxn.FieldPackageID, field.TypeInt, value)
+	}
+	if value, ok := vtu.mutation.AddedPackageID(); ok {
+		_spec.AddField(vendortxn.FieldPackageID, field.TypeInt, value)
+	}
+	if vtu.mutation.PackageIDCleared() {
+		_spec.ClearField(vendortxn.FieldPackageID, field.TypeInt)
+	}
+	if value, ok := vtu.mutation.Price(); ok {
+		_spec.SetField(vendortxn.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := vtu.mutation.AddedPrice(); ok {
+		_spec.AddField(vendortxn.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := vtu.mutation.Commission(); ok {
+		_spec.SetField(vendortxn.FieldCommission, field.TypeFloat64, value)
+	}
+	if value, ok := vtu.mutation.AddedCommission(); ok {
+		_spec.AddField(vendortxn.FieldCommission, field.TypeFloat64, value)
+	}
+	if value, ok := vtu.mutation.Description(); ok {
+		_spec.SetField(vendortxn.FieldDescription, field.TypeString, value)
+	}
+	if vtu.mutation.DescriptionCleared() {
+		_spec.ClearField(vendortxn.FieldDescription, field.TypeString)
+	}
+	if value, ok := vtu.mutation.CreatedBy(); ok {
+		_spec.SetField(vendortxn.FieldCreatedBy, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendortxn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vtu.mutation.done = true
+	return n, nil
+}
+
+// VendorTxnUpdateOne is the builder for updating a single VendorTxn entity.
+type VendorTxnUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VendorTxnMutation
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (vtuo *VendorTxnUpdateOne) SetTransactionRef(s string) *VendorTxnUpdateOne {
+	vtuo.mutation.SetTransactionRef(s)
+	return vtuo
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableTransactionRef(s *string) *VendorTxnUpdateOne {
+	if s != nil {
+		vtuo.SetTransactionRef(*s)
+	}
+	return vtuo
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (vtuo *VendorTxnUpdateOne) SetVendorID(i int) *VendorTxnUpdateOne {
+	vtuo.mutation.ResetVendorID()
+	vtuo.mutation.SetVendorID(i)
+	return vtuo
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableVendorID(i *int) *VendorTxnUpdateOne {
+	if i != nil {
+		vtuo.SetVendorID(*i)
+	}
+	return vtuo
+}
+
+// AddVendorID adds i to the "vendor_id" field.
+func (vtuo *VendorTxnUpdateOne) AddVendorID(i int) *VendorTxnUpdateOne {
+	vtuo.mutation.AddVendorID(i)
+	return vtuo
+}
+
+// SetType sets the "type" field.
+func (vtuo *VendorTxnUpdateOne) SetType(v vendortxn.Type) *VendorTxnUpdateOne {
+	vtuo.mutation.SetType(v)
+	return vtuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableType(v *vendortxn.Type) *VendorTxnUpdateOne {
+	if v != nil {
+		vtuo.SetType(*v)
+	}
+	return vtuo
+}
+
+// SetAmount sets the "amount" field.
+func (vtuo *VendorTxnUpdateOne) SetAmount(f float64) *VendorTxnUpdateOne {
+	vtuo.mutation.ResetAmount()
+	vtuo.mutation.SetAmount(f)
+	return vtuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableAmount(f *float64) *VendorTxnUpdateOne {
+	if f != nil {
+		vtuo.SetAmount(*f)
+	}
+	return vtuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (vtuo *VendorTxnUpdateOne) AddAmount(f float64) *VendorTxnUpdateOne {
+	vtuo.mutation.AddAmount(f)
+	return vtuo
+}
+
+// SetTotalBalance sets the "total_balance" field.
+func (vtuo *VendorTxnUpdateOne) SetTotalBalance(f float64) *VendorTxnUpdateOne {
+	vtuo.mutation.ResetTotalBalance()
+	vtuo.mutation.SetTotalBalance(f)
+	return vtuo
+}
+
+// SetNillableTotalBalance sets the "total_balance" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableTotalBalance(f *float64) *VendorTxnUpdateOne {
+	if f != nil {
+		vtuo.SetTotalBalance(*f)
+	}
+	return vtuo
+}
+
+// AddTotalBalance adds f to the "total_balance" field.
+func (vtuo *VendorTxnUpdateOne) AddTotalBalance(f float64) *VendorTxnUpdateOne {
+	vtuo.mutation.AddTotalBalance(f)
+	return vtuo
+}
+
+// SetClientUsername sets the "client_username" field.
+func (vtuo *VendorTxnUpdateOne) SetClientUsername(s string) *VendorTxnUpdateOne {
+	vtuo.mutation.SetClientUsername(s)
+	return vtuo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableClientUsername(s *string) *VendorTxnUpdateOne {
+	if s != nil {
+		vtuo.SetClientUsername(*s)
+	}
+	return vtuo
+}
+
+// ClearClientUsername clears the value of the "client_username" field.
+func (vtuo *VendorTxnUpdateOne) ClearClientUsername() *VendorTxnUpdateOne {
+	vtuo.mutation.ClearClientUsername()
+	return vtuo
+}
+
+// SetClientTxnRef sets the "client_txn_ref" field.
+func (vtuo *VendorTxnUpdateOne) SetClientTxnRef(s string) *VendorTxnUpdateOne {
+	vtuo.mutation.SetClientTxnRef(s)
+	return vtuo
+}
+
+// SetNillableClientTxnRef sets the "client_txn_ref" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableClientTxnRef(s *string) *VendorTxnUpdateOne {
+	if s != nil {
+		vtuo.SetClientTxnRef(*s)
+	}
+	return vtuo
+}
+
+// ClearClientTxnRef clears the value of the "client_txn_ref" field.
+func (vtuo *VendorTxnUpdateOne) ClearClientTxnRef() *VendorTxnUpdateOne {
+	vtuo.mutation.ClearClientTxnRef()
+	return vtuo
+}
+
+// SetPackageID sets the "package_id" field.
+func (vtuo *VendorTxnUpdateOne) SetPackageID(i int) *VendorTxnUpdateOne {
+	vtuo.mutation.ResetPackageID()
+	vtuo.mutation.SetPackageID(i)
+	return vtuo
+}
+
+// SetNillablePackageID sets the "package_id" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillablePackageID(i *int) *VendorTxnUpdateOne {
+	if i != nil {
+		vtuo.SetPackageID(*i)
+	}
+	return vtuo
+}
+
+// AddPackageID adds i to the "package_id" field.
+func (vtuo *VendorTxnUpdateOne) AddPackageID(i int) *VendorTxnUpdateOne {
+	vtuo.mutation.AddPackageID(i)
+	return vtuo
+}
+
+// ClearPackageID clears the value of the "package_id" field.
+func (vtuo *VendorTxnUpdateOne) ClearPackageID() *VendorTxnUpdateOne {
+	vtuo.mutation.ClearPackageID()
+	return vtuo
+}
+
+// SetPrice sets the "price" field.
+func (vtuo *VendorTxnUpdateOne) SetPrice(f float64) *VendorTxnUpdateOne {
+	vtuo.mutation.ResetPrice()
+	vtuo.mutation.SetPrice(f)
+	return vtuo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillablePrice(f *float64) *VendorTxnUpdateOne {
+	if f != nil {
+		vtuo.SetPrice(*f)
+	}
+	return vtuo
+}
+
+// AddPrice adds f to the "price" field.
+func (vtuo *VendorTxnUpdateOne) AddPrice(f float64) *VendorTxnUpdateOne {
+	vtuo.mutation.AddPrice(f)
+	return vtuo
+}
+
+// SetCommission sets the "commission" field.
+func (vtuo *VendorTxnUpdateOne) SetCommission(f float64) *VendorTxnUpdateOne {
+	vtuo.mutation.ResetCommission()
+	vtuo.mutation.SetCommission(f)
+	return vtuo
+}
+
+// SetNillableCommission sets the "commission" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableCommission(f *float64) *VendorTxnUpdateOne {
+	if f != nil {
+		vtuo.SetCommission(*f)
+	}
+	return vtuo
+}
+
+// AddCommission adds f to the "commission" field.
+func (vtuo *VendorTxnUpdateOne) AddCommission(f float64) *VendorTxnUpdateOne {
+	vtuo.mutation.AddCommission(f)
+	return vtuo
+}
+
+// SetDescription sets the "description" field.
+func (vtuo *VendorTxnUpdateOne) SetDescription(s string) *VendorTxnUpdateOne {
+	vtuo.mutation.SetDescription(s)
+	return vtuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableDescription(s *string) *VendorTxnUpdateOne {
+	if s != nil {
+		vtuo.SetDescription(*s)
+	}
+	return vtuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (vtuo *VendorTxnUpdateOne) ClearDescription() *VendorTxnUpdateOne {
+	vtuo.mutation.ClearDescription()
+	return vtuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (vtuo *VendorTxnUpdateOne) SetCreatedBy(s string) *VendorTxnUpdateOne {
+	vtuo.mutation.SetCreatedBy(s)
+	return vtuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (vtuo *VendorTxnUpdateOne) SetNillableCreatedBy(s *string) *VendorTxnUpdateOne {
+	if s != nil {
+		vtuo.SetCreatedBy(*s)
+	}
+	return vtuo
+}
+
+// Mutation returns the VendorTxnMutation object of the builder.
+func (vtuo *VendorTxnUpdateOne) Mutation() *VendorTxnMutation {
+	return vtuo.mutation
+}
+
+// Where appends a list predicates to the VendorTxnUpdate builder.
+func (vtuo *VendorTxnUpdateOne) Where(ps ...predicate.VendorTxn) *VendorTxnUpdateOne {
+	vtuo.mutation.Where(ps...)
+	return vtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vtuo *VendorTxnUpdateOne) Select(field string, fields ...string) *VendorTxnUpdateOne {
+	vtuo.fields = append([]string{field}, fields...)
+	return vtuo
+}
+
+// Save executes the query and returns the updated VendorTxn entity.
+func (vtuo *VendorTxnUpdateOne) Save(ctx context.Context) (*VendorTxn, error) {
+	return withHooks(ctx, vtuo.sqlSave, vtuo.mutation, vtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vtuo *VendorTxnUpdateOne) SaveX(ctx context.Context) *VendorTxn {
+	node, err := vtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vtuo *VendorTxnUpdateOne) Exec(ctx context.Context) error {
+	_, err := vtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vtuo *VendorTxnUpdateOne) ExecX(ctx context.Context) {
+	if err := vtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vtuo *VendorTxnUpdateOne) check() error {
+	if v, ok := vtuo.mutation.TransactionRef(); ok {
+		if err := vendortxn.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.transaction_ref": %w`, err)}
+		}
+	}
+	if v, ok := vtuo.mutation.GetType(); ok {
+		if err := vendortxn.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.type": %w`, err)}
+		}
+	}
+	if v, ok := vtuo.mutation.ClientUsername(); ok {
+		if err := vendortxn.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.client_username": %w`, err)}
+		}
+	}
+	if v, ok := vtuo.mutation.ClientTxnRef(); ok {
+		if err := vendortxn.ClientTxnRefValidator(v); err != nil {
+			return &ValidationError{Name: "client_txn_ref", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.client_txn_ref": %w`, err)}
+		}
+	}
+	if v, ok := vtuo.mutation.CreatedBy(); ok {
+		if err := vendortxn.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "VendorTxn.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vtuo *VendorTxnUpdateOne) sqlSave(ctx context.Context) (_node *VendorTxn, err error) {
+	if err := vtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vendortxn.Table, vendortxn.Columns, sqlgraph.NewFieldSpec(vendortxn.FieldID, field.TypeInt))
+	id, ok := vtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VendorTxn.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vendortxn.FieldID)
+		for _, f := range fields {
+			if !vendortxn.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vendortxn.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vtuo.mutation.TransactionRef(); ok {
+		_spec.SetField(vendortxn.FieldTransactionRef, field.TypeString, value)
+	}
+	if value, ok := vtuo.mutation.VendorID(); ok {
+		_spec.SetField(vendortxn.FieldVendorID, field.TypeInt, value)
+	}
+	if value, ok := vtuo.mutation.AddedVendorID(); ok {
+		_spec.AddField(vendortxn.FieldVendorID, field.TypeInt, value)
+	}
+	if value, ok := vtuo.mutation.GetType(); ok {
+		_spec.SetField(vendortxn.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := vtuo.mutation.Amount(); ok {
+		_spec.SetField(vendortxn.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vtuo.mutation.AddedAmount(); ok {
+		_spec.AddField(vendortxn.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vtuo.mutation.TotalBalance(); ok {
+		_spec.SetField(vendortxn.FieldTotalBalance, field.TypeFloat64, value)
+	}
+	if value, ok := vtuo.mutation.AddedTotalBalance(); ok {
+		_spec.AddField(vendortxn.FieldTotalBalance, field.TypeFloat64, value)
+	}
+	if value, ok := vtuo.mutation.ClientUsername(); ok {
+		_spec.SetField(vendortxn.FieldClientUsername, field.TypeString, value)
+	}
+	if vtuo.mutation.ClientUsernameCleared() {
+		_spec.ClearField(vendortxn.FieldClientUsername, field.TypeString)
+	}
+	if value, ok := vtuo.mutation.ClientTxnRef(); ok {
+		_spec.SetField(vendortxn.FieldClientTxnRef, field.TypeString, value)
+	}
+	if vtuo.mutation.ClientTxnRefCleared() {
+		_spec.ClearField(vendortxn.FieldClientTxnRef, field.TypeString)
+	}
+	if value, ok := vtuo.mutation.PackageID(); ok {
+		_spec.SetField(vendortxn.FieldPackageID, field.TypeInt, value)
+	}
+	if value, ok := vtuo.mutation.AddedPackageID(); ok {
+		_spec.AddField(vendortxn.FieldPackageID, field.TypeInt, value)
+	}
+	if vtuo.mutation.PackageIDCleared() {
+		_spec.ClearField(vendortxn.FieldPackageID, field.TypeInt)
+	}
+	if value, ok := vtuo.mutation.Price(); ok {
+		_spec.SetField(vendortxn.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := vtuo.mutation.AddedPrice(); ok {
+		_spec.AddField(vendortxn.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := vtuo.mutation.Commission(); ok {
+		_spec.SetField(vendortxn.FieldCommission, field.TypeFloat64, value)
+	}
+	if value, ok := vtuo.mutation.AddedCommission(); ok {
+		_spec.AddField(vendortxn.FieldCommission, field.TypeFloat64, value)
+	}
+	if value, ok := vtuo.mutation.Description(); ok {
+		_spec.SetField(vendortxn.FieldDescription, field.TypeString, value)
+	}
+	if vtuo.mutation.DescriptionCleared() {
+		_spec.ClearField(vendortxn.FieldDescription, field.TypeString)
+	}
+	if value, ok := vtuo.mutation.CreatedBy(); ok {
+		_spec.SetField(vendortxn.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &VendorTxn{config: vtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vendortxn.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vtuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+)
+
+// Voucher is the model entity for the Voucher schema.
+type Voucher struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BatchID holds the value of the "batch_id" field.
+	BatchID int `json:"batch_id,omitempty"`
+	// Printed on the card next to the PIN, identifies the voucher without redeeming it
+	Serial string `json:"serial,omitempty"`
+	// SHA-256 of the PIN, which is only ever shown in the batch export
+	PinHash string `json:"-"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status voucher.Status `json:"status,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// RedeemedBy holds the value of the "redeemed_by" field.
+	RedeemedBy string `json:"redeemed_by,omitempty"`
+	// RedeemedAt holds the value of the "redeemed_at" field.
+	RedeemedAt *time.Time `json:"redeemed_at,omitempty"`
+	// transaction_ref of the RECHARGE that credited the voucher
+	TransactionRef string `json:"transaction_ref,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Voucher) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case voucher.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case voucher.FieldID, voucher.FieldBatchID:
+			values[i] = new(sql.NullInt64)
+		case voucher.FieldSerial, voucher.FieldPinHash, voucher.FieldStatus, voucher.FieldRedeemedBy, voucher.FieldTransactionRef:
+			values[i] = new(sql.NullString)
+		case voucher.FieldExpiresAt, voucher.FieldRedeemedAt, voucher.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Voucher fields.
+func (v *Voucher) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case voucher.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			v.ID = int(value.Int64)
+		case voucher.FieldBatchID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_id", values[i])
+			} else if value.Valid {
+				v.BatchID = int(value.Int64)
+			}
+		case voucher.FieldSerial:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field serial", values[i])
+			} else if value.Valid {
+				v.Serial = value.String
+			}
+		case voucher.FieldPinHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pin_hash", values[i])
+			} else if value.Valid {
+				v.PinHash = value.String
+			}
+		case voucher.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				v.Amount = value.Float64
+			}
+		case voucher.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				v.Status = voucher.Status(value.String)
+			}
+		case voucher.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				v.ExpiresAt = new(time.Time)
+				*v.ExpiresAt = value.Time
+			}
+		case voucher.FieldRedeemedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redeemed_by", values[i])
+			} else if value.Valid {
+				v.RedeemedBy = value.String
+			}
+		case voucher.FieldRedeemedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field redeemed_at", values[i])
+			} else if value.Valid {
+				v.RedeemedAt = new(time.Time)
+				*v.RedeemedAt = value.Time
+			}
+		case voucher.FieldTransactionRef:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_ref", values[i])
+			} else if value.Valid {
+				v.TransactionRef = value.String
+			}
+		case voucher.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				v.CreatedAt = value.Time
+			}
+		default:
+			v.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Voucher.
+// This includes values selected through modifiers, order, etc.
+func (v *Voucher) Value(name string) (ent.Value, error) {
+	return v.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Voucher.
+// Note that you need to call Voucher.Unwrap() before calling this method if this Voucher
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (v *Voucher) Update() *VoucherUpdateOne {
+	return NewVoucherClient(v.config).UpdateOne(v)
+}
+
+// Unwrap unwraps the Voucher entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (v *Voucher) Unwrap() *Voucher {
+	_tx, ok := v.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Voucher is not a transactional entity")
+	}
+	v.config.driver = _tx.drv
+	return v
+}
+
+// String implements the fmt.Stringer.
+func (v *Voucher) String() string {
+	var builder strings.Builder
+	builder.WriteString("Voucher(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", v.ID))
+	builder.WriteString("batch_id=")
+	builder.WriteString(fmt.Sprintf("%v", v.BatchID))
+	builder.WriteString(", ")
+	builder.WriteString("serial=")
+	builder.WriteString(v.Serial)
+	builder.WriteString(", ")
+	builder.WriteString("pin_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", v.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", v.Status))
+	builder.WriteString(", ")
+	if v := v.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("redeemed_by=")
+	builder.WriteString(v.RedeemedBy)
+	builder.WriteString(", ")
+	if v := v.RedeemedAt; v != nil {
+		builder.WriteString("redeemed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("transaction_ref=")
+	builder.WriteString(v.TransactionRef)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(v.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Vouchers is a parsable slice of Voucher.
+type Vouchers []*Voucher
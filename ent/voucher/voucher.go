@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package voucher
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the voucher type in the database.
+	Label = "voucher"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBatchID holds the string denoting the batch_id field in the database.
+	FieldBatchID = "batch_id"
+	// FieldSerial holds the string denoting the serial field in the database.
+	FieldSerial = "serial"
+	// FieldPinHash holds the string denoting the pin_hash field in the database.
+	FieldPinHash = "pin_hash"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRedeemedBy holds the string denoting the redeemed_by field in the database.
+	FieldRedeemedBy = "redeemed_by"
+	// FieldRedeemedAt holds the string denoting the redeemed_at field in the database.
+	FieldRedeemedAt = "redeemed_at"
+	// FieldTransactionRef holds the string denoting the transaction_ref field in the database.
+	FieldTransactionRef = "transaction_ref"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the voucher in the database.
+	Table = "vouchers"
+)
+
+// Columns holds all SQL columns for voucher fields.
+var Columns = []string{
+	FieldID,
+	FieldBatchID,
+	FieldSerial,
+	FieldPinHash,
+	FieldAmount,
+	FieldStatus,
+	FieldExpiresAt,
+	FieldRedeemedBy,
+	FieldRedeemedAt,
+	FieldTransactionRef,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// BatchIDValidator is a validator for the "batch_id" field. It is called by the builders before save.
+	BatchIDValidator func(int) error
+	// SerialValidator is a validator for the "serial" field. It is called by the builders before save.
+	SerialValidator func(string) error
+	// PinHashValidator is a validator for the "pin_hash" field. It is called by the builders before save.
+	PinHashValidator func(string) error
+	// RedeemedByValidator is a validator for the "redeemed_by" field. It is called by the builders before save.
+	RedeemedByValidator func(string) error
+	// TransactionRefValidator is a validator for the "transaction_ref" field. It is called by the builders before save.
+	TransactionRefValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive   Status = "active"
+	StatusRedeemed Status = "redeemed"
+	StatusDisabled Status = "disabled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusRedeemed, StatusDisabled:
+		return nil
+	default:
+		return fmt.Errorf("voucher: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Voucher queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBatchID orders the results by the batch_id field.
+func ByBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchID, opts...).ToFunc()
+}
+
+// BySerial orders the results by the serial field.
+func BySerial(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSerial, opts...).ToFunc()
+}
+
+// ByPinHash orders the results by the pin_hash field.
+func ByPinHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinHash, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRedeemedBy orders the results by the redeemed_by field.
+func ByRedeemedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemedBy, opts...).ToFunc()
+}
+
+// ByRedeemedAt orders the results by the redeemed_at field.
+func ByRedeemedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemedAt, opts...).ToFunc()
+}
+
+// ByTransactionRef orders the results by the transaction_ref field.
+func ByTransactionRef(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionRef, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package voucher
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldID, id))
+}
+
+// BatchID applies equality check predicate on the "batch_id" field. It's identical to BatchIDEQ.
+func BatchID(v int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldBatchID, v))
+}
+
+// Serial applies equality check predicate on the "serial" field. It's identical to SerialEQ.
+func Serial(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldSerial, v))
+}
+
+// PinHash applies equality check predicate on the "pin_hash" field. It's identical to PinHashEQ.
+func PinHash(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldPinHash, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldAmount, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RedeemedBy applies equality check predicate on the "redeemed_by" field. It's identical to RedeemedByEQ.
+func RedeemedBy(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldRedeemedBy, v))
+}
+
+// RedeemedAt applies equality check predicate on the "redeemed_at" field. It's identical to RedeemedAtEQ.
+func RedeemedAt(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldRedeemedAt, v))
+}
+
+// TransactionRef applies equality check predicate on the "transaction_ref" field. It's identical to TransactionRefEQ.
+func TransactionRef(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// BatchIDEQ applies the EQ predicate on the "batch_id" field.
+func BatchIDEQ(v int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldBatchID, v))
+}
+
+// BatchIDNEQ applies the NEQ predicate on the "batch_id" field.
+func BatchIDNEQ(v int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldBatchID, v))
+}
+
+// BatchIDIn applies the In predicate on the "batch_id" field.
+func BatchIDIn(vs ...int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldBatchID, vs...))
+}
+
+// BatchIDNotIn applies the NotIn predicate on the "batch_id" field.
+func BatchIDNotIn(vs ...int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldBatchID, vs...))
+}
+
+// BatchIDGT applies the GT predicate on the "batch_id" field.
+func BatchIDGT(v int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldBatchID, v))
+}
+
+// BatchIDGTE applies the GTE predicate on the "batch_id" field.
+func BatchIDGTE(v int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldBatchID, v))
+}
+
+// BatchIDLT applies the LT predicate on the "batch_id" field.
+func BatchIDLT(v int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldBatchID, v))
+}
+
+// BatchIDLTE applies the LTE predicate on the "batch_id" field.
+func BatchIDLTE(v int) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldBatchID, v))
+}
+
+// SerialEQ applies the EQ predicate on the "serial" field.
+func SerialEQ(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldSerial, v))
+}
+
+// SerialNEQ applies the NEQ predicate on the "serial" field.
+func SerialNEQ(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldSerial, v))
+}
+
+// SerialIn applies the In predicate on the "serial" field.
+func SerialIn(vs ...string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldSerial, vs...))
+}
+
+// SerialNotIn applies the NotIn predicate on the "serial" field.
+func SerialNotIn(vs ...string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldSerial, vs...))
+}
+
+// SerialGT applies the GT predicate on the "serial" field.
+func SerialGT(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldSerial, v))
+}
+
+// SerialGTE applies the GTE predicate on the "serial" field.
+func SerialGTE(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldSerial, v))
+}
+
+// SerialLT applies the LT predicate on the "serial" field.
+func SerialLT(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldSerial, v))
+}
+
+// SerialLTE applies the LTE predicate on the "serial" field.
+func SerialLTE(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldSerial, v))
+}
+
+// SerialContains applies the Contains predicate on the "serial" field.
+func SerialContains(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldContains(FieldSerial, v))
+}
+
+// SerialHasPrefix applies the HasPrefix predicate on the "serial" field.
+func SerialHasPrefix(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldHasPrefix(FieldSerial, v))
+}
+
+// SerialHasSuffix applies the HasSuffix predicate on the "serial" field.
+func SerialHasSuffix(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldHasSuffix(FieldSerial, v))
+}
+
+// SerialEqualFold applies the EqualFold predicate on the "serial" field.
+func SerialEqualFold(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEqualFold(FieldSerial, v))
+}
+
+// SerialContainsFold applies the ContainsFold predicate on the "serial" field.
+func SerialContainsFold(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldContainsFold(FieldSerial, v))
+}
+
+// PinHashEQ applies the EQ predicate on the "pin_hash" field.
+func PinHashEQ(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldPinHash, v))
+}
+
+// PinHashNEQ applies the NEQ predicate on the "pin_hash" field.
+func PinHashNEQ(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldPinHash, v))
+}
+
+// PinHashIn applies the In predicate on the "pin_hash" field.
+func PinHashIn(vs ...string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldPinHash, vs...))
+}
+
+// PinHashNotIn applies the NotIn predicate on the "pin_hash" field.
+func PinHashNotIn(vs ...string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldPinHash, vs...))
+}
+
+// PinHashGT applies the GT predicate on the "pin_hash" field.
+func PinHashGT(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldPinHash, v))
+}
+
+// PinHashGTE applies the GTE predicate on the "pin_hash" field.
+func PinHashGTE(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldPinHash, v))
+}
+
+// PinHashLT applies the LT predicate on the "pin_hash" field.
+func PinHashLT(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldPinHash, v))
+}
+
+// PinHashLTE applies the LTE predicate on the "pin_hash" field.
+func PinHashLTE(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldPinHash, v))
+}
+
+// PinHashContains applies the Contains predicate on the "pin_hash" field.
+func PinHashContains(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldContains(FieldPinHash, v))
+}
+
+// PinHashHasPrefix applies the HasPrefix predicate on the "pin_hash" field.
+func PinHashHasPrefix(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldHasPrefix(FieldPinHash, v))
+}
+
+// PinHashHasSuffix applies the HasSuffix predicate on the "pin_hash" field.
+func PinHashHasSuffix(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldHasSuffix(FieldPinHash, v))
+}
+
+// PinHashEqualFold applies the EqualFold predicate on the "pin_hash" field.
+func PinHashEqualFold(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEqualFold(FieldPinHash, v))
+}
+
+// PinHashContainsFold applies the ContainsFold predicate on the "pin_hash" field.
+func PinHashContainsFold(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldContainsFold(FieldPinHash, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Voucher {
+	return predicate.Voucher(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// RedeemedByEQ applies the EQ predicate on the "redeemed_by" field.
+func RedeemedByEQ(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldRedeemedBy, v))
+}
+
+// RedeemedByNEQ applies the NEQ predicate on the "redeemed_by" field.
+func RedeemedByNEQ(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldRedeemedBy, v))
+}
+
+// RedeemedByIn applies the In predicate on the "redeemed_by" field.
+func RedeemedByIn(vs ...string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldRedeemedBy, vs...))
+}
+
+// RedeemedByNotIn applies the NotIn predicate on the "redeemed_by" field.
+func RedeemedByNotIn(vs ...string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldRedeemedBy, vs...))
+}
+
+// RedeemedByGT applies the GT predicate on the "redeemed_by" field.
+func RedeemedByGT(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldRedeemedBy, v))
+}
+
+// RedeemedByGTE applies the GTE predicate on the "redeemed_by" field.
+func RedeemedByGTE(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldRedeemedBy, v))
+}
+
+// RedeemedByLT applies the LT predicate on the "redeemed_by" field.
+func RedeemedByLT(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldRedeemedBy, v))
+}
+
+// RedeemedByLTE applies the LTE predicate on the "redeemed_by" field.
+func RedeemedByLTE(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldRedeemedBy, v))
+}
+
+// RedeemedByContains applies the Contains predicate on the "redeemed_by" field.
+func RedeemedByContains(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldContains(FieldRedeemedBy, v))
+}
+
+// RedeemedByHasPrefix applies the HasPrefix predicate on the "redeemed_by" field.
+func RedeemedByHasPrefix(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldHasPrefix(FieldRedeemedBy, v))
+}
+
+// RedeemedByHasSuffix applies the HasSuffix predicate on the "redeemed_by" field.
+func RedeemedByHasSuffix(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldHasSuffix(FieldRedeemedBy, v))
+}
+
+// RedeemedByIsNil applies the IsNil predicate on the "redeemed_by" field.
+func RedeemedByIsNil() predicate.Voucher {
+	return predicate.Voucher(sql.FieldIsNull(FieldRedeemedBy))
+}
+
+// RedeemedByNotNil applies the NotNil predicate on the "redeemed_by" field.
+func RedeemedByNotNil() predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotNull(FieldRedeemedBy))
+}
+
+// RedeemedByEqualFold applies the EqualFold predicate on the "redeemed_by" field.
+func RedeemedByEqualFold(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEqualFold(FieldRedeemedBy, v))
+}
+
+// RedeemedByContainsFold applies the ContainsFold predicate on the "redeemed_by" field.
+func RedeemedByContainsFold(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldContainsFold(FieldRedeemedBy, v))
+}
+
+// RedeemedAtEQ applies the EQ predicate on the "redeemed_at" field.
+func RedeemedAtEQ(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldRedeemedAt, v))
+}
+
+// RedeemedAtNEQ applies the NEQ predicate on the "redeemed_at" field.
+func RedeemedAtNEQ(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldRedeemedAt, v))
+}
+
+// RedeemedAtIn applies the In predicate on the "redeemed_at" field.
+func RedeemedAtIn(vs ...time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldRedeemedAt, vs...))
+}
+
+// RedeemedAtNotIn applies the NotIn predicate on the "redeemed_at" field.
+func RedeemedAtNotIn(vs ...time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldRedeemedAt, vs...))
+}
+
+// RedeemedAtGT applies the GT predicate on the "redeemed_at" field.
+func RedeemedAtGT(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldRedeemedAt, v))
+}
+
+// RedeemedAtGTE applies the GTE predicate on the "redeemed_at" field.
+func RedeemedAtGTE(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldRedeemedAt, v))
+}
+
+// RedeemedAtLT applies the LT predicate on the "redeemed_at" field.
+func RedeemedAtLT(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldRedeemedAt, v))
+}
+
+// RedeemedAtLTE applies the LTE predicate on the "redeemed_at" field.
+func RedeemedAtLTE(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldRedeemedAt, v))
+}
+
+// RedeemedAtIsNil applies the IsNil predicate on the "redeemed_at" field.
+func RedeemedAtIsNil() predicate.Voucher {
+	return predicate.Voucher(sql.FieldIsNull(FieldRedeemedAt))
+}
+
+// RedeemedAtNotNil applies the NotNil predicate on the "redeemed_at" field.
+func RedeemedAtNotNil() predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotNull(FieldRedeemedAt))
+}
+
+// TransactionRefEQ applies the EQ predicate on the "transaction_ref" field.
+func TransactionRefEQ(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefNEQ applies the NEQ predicate on the "transaction_ref" field.
+func TransactionRefNEQ(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldTransactionRef, v))
+}
+
+// TransactionRefIn applies the In predicate on the "transaction_ref" field.
+func TransactionRefIn(vs ...string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefNotIn applies the NotIn predicate on the "transaction_ref" field.
+func TransactionRefNotIn(vs ...string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldTransactionRef, vs...))
+}
+
+// TransactionRefGT applies the GT predicate on the "transaction_ref" field.
+func TransactionRefGT(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldTransactionRef, v))
+}
+
+// TransactionRefGTE applies the GTE predicate on the "transaction_ref" field.
+func TransactionRefGTE(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldTransactionRef, v))
+}
+
+// TransactionRefLT applies the LT predicate on the "transaction_ref" field.
+func TransactionRefLT(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldTransactionRef, v))
+}
+
+// TransactionRefLTE applies the LTE predicate on the "transaction_ref" field.
+func TransactionRefLTE(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldTransactionRef, v))
+}
+
+// TransactionRefContains applies the Contains predicate on the "transaction_ref" field.
+func TransactionRefContains(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldContains(FieldTransactionRef, v))
+}
+
+// TransactionRefHasPrefix applies the HasPrefix predicate on the "transaction_ref" field.
+func TransactionRefHasPrefix(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldHasPrefix(FieldTransactionRef, v))
+}
+
+// TransactionRefHasSuffix applies the HasSuffix predicate on the "transaction_ref" field.
+func TransactionRefHasSuffix(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldHasSuffix(FieldTransactionRef, v))
+}
+
+// TransactionRefIsNil applies the IsNil predicate on the "transaction_ref" field.
+func TransactionRefIsNil() predicate.Voucher {
+	return predicate.Voucher(sql.FieldIsNull(FieldTransactionRef))
+}
+
+// TransactionRefNotNil applies the NotNil predicate on the "transaction_ref" field.
+func TransactionRefNotNil() predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotNull(FieldTransactionRef))
+}
+
+// TransactionRefEqualFold applies the EqualFold predicate on the "transaction_ref" field.
+func TransactionRefEqualFold(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEqualFold(FieldTransactionRef, v))
+}
+
+// TransactionRefContainsFold applies the ContainsFold predicate on the "transaction_ref" field.
+func TransactionRefContainsFold(v string) predicate.Voucher {
+	return predicate.Voucher(sql.FieldContainsFold(FieldTransactionRef, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Voucher {
+	return predicate.Voucher(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Voucher) predicate.Voucher {
+	return predicate.Voucher(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Voucher) predicate.Voucher {
+	return predicate.Voucher(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Voucher) predicate.Voucher {
+	return predicate.Voucher(sql.NotPredicates(p))
+}
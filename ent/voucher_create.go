@@ -0,0 +1,381 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+)
+
+// VoucherCreate is the builder for creating a Voucher entity.
+type VoucherCreate struct {
+	config
+	mutation *VoucherMutation
+	hooks    []Hook
+}
+
+// SetBatchID sets the "batch_id" field.
+func (vc *VoucherCreate) SetBatchID(i int) *VoucherCreate {
+	vc.mutation.SetBatchID(i)
+	return vc
+}
+
+// SetSerial sets the "serial" field.
+func (vc *VoucherCreate) SetSerial(s string) *VoucherCreate {
+	vc.mutation.SetSerial(s)
+	return vc
+}
+
+// SetPinHash sets the "pin_hash" field.
+func (vc *VoucherCreate) SetPinHash(s string) *VoucherCreate {
+	vc.mutation.SetPinHash(s)
+	return vc
+}
+
+// SetAmount sets the "amount" field.
+func (vc *VoucherCreate) SetAmount(f float64) *VoucherCreate {
+	vc.mutation.SetAmount(f)
+	return vc
+}
+
+// SetStatus sets the "status" field.
+func (vc *VoucherCreate) SetStatus(v voucher.Status) *VoucherCreate {
+	vc.mutation.SetStatus(v)
+	return vc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vc *VoucherCreate) SetNillableStatus(v *voucher.Status) *VoucherCreate {
+	if v != nil {
+		vc.SetStatus(*v)
+	}
+	return vc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vc *VoucherCreate) SetExpiresAt(t time.Time) *VoucherCreate {
+	vc.mutation.SetExpiresAt(t)
+	return vc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (vc *VoucherCreate) SetNillableExpiresAt(t *time.Time) *VoucherCreate {
+	if t != nil {
+		vc.SetExpiresAt(*t)
+	}
+	return vc
+}
+
+// SetRedeemedBy sets the "redeemed_by" field.
+func (vc *VoucherCreate) SetRedeemedBy(s string) *VoucherCreate {
+	vc.mutation.SetRedeemedBy(s)
+	return vc
+}
+
+// SetNillableRedeemedBy sets the "redeemed_by" field if the given value is not nil.
+func (vc *VoucherCreate) SetNillableRedeemedBy(s *string) *VoucherCreate {
+	if s != nil {
+		vc.SetRedeemedBy(*s)
+	}
+	return vc
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (vc *VoucherCreate) SetRedeemedAt(t time.Time) *VoucherCreate {
+	vc.mutation.SetRedeemedAt(t)
+	return vc
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (vc *VoucherCreate) SetNillableRedeemedAt(t *time.Time) *VoucherCreate {
+	if t != nil {
+		vc.SetRedeemedAt(*t)
+	}
+	return vc
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (vc *VoucherCreate) SetTransactionRef(s string) *VoucherCreate {
+	vc.mutation.SetTransactionRef(s)
+	return vc
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (vc *VoucherCreate) SetNillableTransactionRef(s *string) *VoucherCreate {
+	if s != nil {
+		vc.SetTransactionRef(*s)
+	}
+	return vc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vc *VoucherCreate) SetCreatedAt(t time.Time) *VoucherCreate {
+	vc.mutation.SetCreatedAt(t)
+	return vc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vc *VoucherCreate) SetNillableCreatedAt(t *time.Time) *VoucherCreate {
+	if t != nil {
+		vc.SetCreatedAt(*t)
+	}
+	return vc
+}
+
+// Mutation returns the VoucherMutation object of the builder.
+func (vc *VoucherCreate) Mutation() *VoucherMutation {
+	return vc.mutation
+}
+
+// Save creates the Voucher in the database.
+func (vc *VoucherCreate) Save(ctx context.Context) (*Voucher, error) {
+	vc.defaults()
+	return withHooks(ctx, vc.sqlSave, vc.mutation, vc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vc *VoucherCreate) SaveX(ctx context.Context) *Voucher {
+	v, err := vc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vc *VoucherCreate) Exec(ctx context.Context) error {
+	_, err := vc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vc *VoucherCreate) ExecX(ctx context.Context) {
+	if err := vc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vc *VoucherCreate) defaults() {
+	if _, ok := vc.mutation.Status(); !ok {
+		v := voucher.DefaultStatus
+		vc.mutation.SetStatus(v)
+	}
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		v := voucher.DefaultCreatedAt()
+		vc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vc *VoucherCreate) check() error {
+	if _, ok := vc.mutation.BatchID(); !ok {
+		return &ValidationError{Name: "batch_id", err: errors.New(`ent: missing required field "Voucher.batch_id"`)}
+	}
+	if v, ok := vc.mutation.BatchID(); ok {
+		if err := voucher.BatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "batch_id", err: fmt.Errorf(`ent: validator failed for field "Voucher.batch_id": %w`, err)}
+		}
+	}
+	if _, ok := vc.mutation.Serial(); !ok {
+		return &ValidationError{Name: "serial", err: errors.New(`ent: missing required field "Voucher.serial"`)}
+	}
+	if v, ok := vc.mutation.Serial(); ok {
+		if err := voucher.SerialValidator(v); err != nil {
+			return &ValidationError{Name: "serial", err: fmt.Errorf(`ent: validator failed for field "Voucher.serial": %w`, err)}
+		}
+	}
+	if _, ok := vc.mutation.PinHash(); !ok {
+		return &ValidationError{Name: "pin_hash", err: errors.New(`ent: missing required field "Voucher.pin_hash"`)}
+	}
+	if v, ok := vc.mutation.PinHash(); ok {
+		if err := voucher.PinHashValidator(v); err != nil {
+			return &ValidationError{Name: "pin_hash", err: fmt.Errorf(`ent: validator failed for field "Voucher.pin_hash": %w`, err)}
+		}
+	}
+	if _, ok := vc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Voucher.amount"`)}
+	}
+	if _, ok := vc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Voucher.status"`)}
+	}
+	if v, ok := vc.mutation.Status(); ok {
+		if err := voucher.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Voucher.status": %w`, err)}
+		}
+	}
+	if v, ok := vc.mutation.RedeemedBy(); ok {
+		if err := voucher.RedeemedByValidator(v); err != nil {
+			return &ValidationError{Name: "redeemed_by", err: fmt.Errorf(`ent: validator failed for field "Voucher.redeemed_by": %w`, err)}
+		}
+	}
+	if v, ok := vc.mutation.TransactionRef(); ok {
+		if err := voucher.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "Voucher.transaction_ref": %w`, err)}
+		}
+	}
+	if _, ok := vc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Voucher.created_at"`)}
+	}
+	return nil
+}
+
+func (vc *VoucherCreate) sqlSave(ctx context.Context) (*Voucher, error) {
+	if err := vc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vc.mutation.id = &_node.ID
+	vc.mutation.done = true
+	return _node, nil
+}
+
+func (vc *VoucherCreate) createSpec() (*Voucher, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Voucher{config: vc.config}
+		_spec = sqlgraph.NewCreateSpec(voucher.Table, sqlgraph.NewFieldSpec(voucher.FieldID, field.TypeInt))
+	)
+	if value, ok := vc.mutation.BatchID(); ok {
+		_spec.SetField(voucher.FieldBatchID, field.TypeInt, value)
+		_node.BatchID = value
+	}
+	if value, ok := vc.mutation.Serial(); ok {
+		_spec.SetField(voucher.FieldSerial, field.TypeString, value)
+		_node.Serial = value
+	}
+	if value, ok := vc.mutation.PinHash(); ok {
+		_spec.SetField(voucher.FieldPinHash, field.TypeString, value)
+		_node.PinHash = value
+	}
+	if value, ok := vc.mutation.Amount(); ok {
+		_spec.SetField(voucher.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := vc.mutation.Status(); ok {
+		_spec.SetField(voucher.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := vc.mutation.ExpiresAt(); ok {
+		_spec.SetField(voucher.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := vc.mutation.RedeemedBy(); ok {
+		_spec.SetField(voucher.FieldRedeemedBy, field.TypeString, value)
+		_node.RedeemedBy = value
+	}
+	if value, ok := vc.mutation.RedeemedAt(); ok {
+		_spec.SetField(voucher.FieldRedeemedAt, field.TypeTime, value)
+		_node.RedeemedAt = &value
+	}
+	if value, ok := vc.mutation.TransactionRef(); ok {
+		_spec.SetField(voucher.FieldTransactionRef, field.TypeString, value)
+		_node.TransactionRef = value
+	}
+	if value, ok := vc.mutation.CreatedAt(); ok {
+		_spec.SetField(voucher.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VoucherCreateBulk is the builder for creating many Voucher entities in bulk.
+type VoucherCreateBulk struct {
+	config
+	err      error
+	builders []*VoucherCreate
+}
+
+// Save creates the Voucher entities in the database.
+func (vcb *VoucherCreateBulk) Save(ctx context.Context) ([]*Voucher, error) {
+	if vcb.err != nil {
+		return nil, vcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vcb.builders))
+	nodes := make([]*Voucher, len(vcb.builders))
+	mutators := make([]Mutator, len(vcb.builders))
+	for i := range vcb.builders {
+		func(i int, root context.Context) {
+			builder := vcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VoucherMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vcb *VoucherCreateBulk) SaveX(ctx context.Context) []*Voucher {
+	v, err := vcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vcb *VoucherCreateBulk) Exec(ctx context.Context) error {
+	_, err := vcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vcb *VoucherCreateBulk) ExecX(ctx context.Context) {
+	if err := vcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
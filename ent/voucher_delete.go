@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+)
+
+// VoucherDelete is the builder for deleting a Voucher entity.
+type VoucherDelete struct {
+	config
+	hooks    []Hook
+	mutation *VoucherMutation
+}
+
+// Where appends a list predicates to the VoucherDelete builder.
+func (vd *VoucherDelete) Where(ps ...predicate.Voucher) *VoucherDelete {
+	vd.mutation.Where(ps...)
+	return vd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vd *VoucherDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vd.sqlExec, vd.mutation, vd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vd *VoucherDelete) ExecX(ctx context.Context) int {
+	n, err := vd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vd *VoucherDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(voucher.Table, sqlgraph.NewFieldSpec(voucher.FieldID, field.TypeInt))
+	if ps := vd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vd.mutation.done = true
+	return affected, err
+}
+
+// VoucherDeleteOne is the builder for deleting a single Voucher entity.
+type VoucherDeleteOne struct {
+	vd *VoucherDelete
+}
+
+// Where appends a list predicates to the VoucherDelete builder.
+func (vdo *VoucherDeleteOne) Where(ps ...predicate.Voucher) *VoucherDeleteOne {
+	vdo.vd.mutation.Where(ps...)
+	return vdo
+}
+
+// Exec executes the deletion query.
+func (vdo *VoucherDeleteOne) Exec(ctx context.Context) error {
+	n, err := vdo.vd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{voucher.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vdo *VoucherDeleteOne) ExecX(ctx context.Context) {
+	if err := vdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+)
+
+// VoucherUpdate is the builder for updating Voucher entities.
+type VoucherUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VoucherMutation
+}
+
+// Where appends a list predicates to the VoucherUpdate builder.
+func (vu *VoucherUpdate) Where(ps ...predicate.Voucher) *VoucherUpdate {
+	vu.mutation.Where(ps...)
+	return vu
+}
+
+// SetBatchID sets the "batch_id" field.
+func (vu *VoucherUpdate) SetBatchID(i int) *VoucherUpdate {
+	vu.mutation.ResetBatchID()
+	vu.mutation.SetBatchID(i)
+	return vu
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillableBatchID(i *int) *VoucherUpdate {
+	if i != nil {
+		vu.SetBatchID(*i)
+	}
+	return vu
+}
+
+// AddBatchID adds i to the "batch_id" field.
+func (vu *VoucherUpdate) AddBatchID(i int) *VoucherUpdate {
+	vu.mutation.AddBatchID(i)
+	return vu
+}
+
+// SetSerial sets the "serial" field.
+func (vu *VoucherUpdate) SetSerial(s string) *VoucherUpdate {
+	vu.mutation.SetSerial(s)
+	return vu
+}
+
+// SetNillableSerial sets the "serial" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillableSerial(s *string) *VoucherUpdate {
+	if s != nil {
+		vu.SetSerial(*s)
+	}
+	return vu
+}
+
+// SetPinHash sets the "pin_hash" field.
+func (vu *VoucherUpdate) SetPinHash(s string) *VoucherUpdate {
+	vu.mutation.SetPinHash(s)
+	return vu
+}
+
+// SetNillablePinHash sets the "pin_hash" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillablePinHash(s *string) *VoucherUpdate {
+	if s != nil {
+		vu.SetPinHash(*s)
+	}
+	return vu
+}
+
+// SetAmount sets the "amount" field.
+func (vu *VoucherUpdate) SetAmount(f float64) *VoucherUpdate {
+	vu.mutation.ResetAmount()
+	vu.mutation.SetAmount(f)
+	return vu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillableAmount(f *float64) *VoucherUpdate {
+	if f != nil {
+		vu.SetAmount(*f)
+	}
+	return vu
+}
+
+// AddAmount adds f to the "amount" field.
+func (vu *VoucherUpdate) AddAmount(f float64) *VoucherUpdate {
+	vu.mutation.AddAmount(f)
+	return vu
+}
+
+// SetStatus sets the "status" field.
+func (vu *VoucherUpdate) SetStatus(v voucher.Status) *VoucherUpdate {
+	vu.mutation.SetStatus(v)
+	return vu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillableStatus(v *voucher.Status) *VoucherUpdate {
+	if v != nil {
+		vu.SetStatus(*v)
+	}
+	return vu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vu *VoucherUpdate) SetExpiresAt(t time.Time) *VoucherUpdate {
+	vu.mutation.SetExpiresAt(t)
+	return vu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillableExpiresAt(t *time.Time) *VoucherUpdate {
+	if t != nil {
+		vu.SetExpiresAt(*t)
+	}
+	return vu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (vu *VoucherUpdate) ClearExpiresAt() *VoucherUpdate {
+	vu.mutation.ClearExpiresAt()
+	return vu
+}
+
+// SetRedeemedBy sets the "redeemed_by" field.
+func (vu *VoucherUpdate) SetRedeemedBy(s string) *VoucherUpdate {
+	vu.mutation.SetRedeemedBy(s)
+	return vu
+}
+
+// SetNillableRedeemedBy sets the "redeemed_by" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillableRedeemedBy(s *string) *VoucherUpdate {
+	if s != nil {
+		vu.SetRedeemedBy(*s)
+	}
+	return vu
+}
+
+// ClearRedeemedBy clears the value of the "redeemed_by" field.
+func (vu *VoucherUpdate) ClearRedeemedBy() *VoucherUpdate {
+	vu.mutation.ClearRedeemedBy()
+	return vu
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (vu *VoucherUpdate) SetRedeemedAt(t time.Time) *VoucherUpdate {
+	vu.mutation.SetRedeemedAt(t)
+	return vu
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillableRedeemedAt(t *time.Time) *VoucherUpdate {
+	if t != nil {
+		vu.SetRedeemedAt(*t)
+	}
+	return vu
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (vu *VoucherUpdate) ClearRedeemedAt() *VoucherUpdate {
+	vu.mutation.ClearRedeemedAt()
+	return vu
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (vu *VoucherUpdate) SetTransactionRef(s string) *VoucherUpdate {
+	vu.mutation.SetTransactionRef(s)
+	return vu
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (vu *VoucherUpdate) SetNillableTransactionRef(s *string) *VoucherUpdate {
+	if s != nil {
+		vu.SetTransactionRef(*s)
+	}
+	return vu
+}
+
+// ClearTransactionRef clears the value of the "transaction_ref" field.
+func (vu *VoucherUpdate) ClearTransactionRef() *VoucherUpdate {
+	vu.mutation.ClearTransactionRef()
+	return vu
+}
+
+// Mutation returns the VoucherMutation object of the builder.
+func (vu *VoucherUpdate) Mutation() *VoucherMutation {
+	return vu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vu *VoucherUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vu.sqlSave, vu.mutation, vu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vu *VoucherUpdate) SaveX(ctx context.Context) int {
+	affected, err := vu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vu *VoucherUpdate) Exec(ctx context.Context) error {
+	_, err := vu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vu *VoucherUpdate) ExecX(ctx context.Context) {
+	if err := vu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vu *VoucherUpdate) check() error {
+	if v, ok := vu.mutation.BatchID(); ok {
+		if err := voucher.BatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "batch_id", err: fmt.Errorf(`ent: validator failed for field "Voucher.batch_id": %w`, err)}
+		}
+	}
+	if v, ok := vu.mutation.Serial(); ok {
+		if err := voucher.SerialValidator(v); err != nil {
+			return &ValidationError{Name: "serial", err: fmt.Errorf(`ent: validator failed for field "Voucher.serial": %w`, err)}
+		}
+	}
+	if v, ok := vu.mutation.PinHash(); ok {
+		if err := voucher.PinHashValidator(v); err != nil {
+			return &ValidationError{Name: "pin_hash", err: fmt.Errorf(`ent: validator failed for field "Voucher.pin_hash": %w`, err)}
+		}
+	}
+	if v, ok := vu.mutation.Status(); ok {
+		if err := voucher.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Voucher.status": %w`, err)}
+		}
+	}
+	if v, ok := vu.mutation.RedeemedBy(); ok {
+		if err := voucher.RedeemedByValidator(v); err != nil {
+			return &ValidationError{Name: "redeemed_by", err: fmt.Errorf(`ent: validator failed for field "Voucher.redeemed_by": %w`, err)}
+		}
+	}
+	if v, ok := vu.mutation.TransactionRef(); ok {
+		if err := voucher.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "Voucher.transaction_ref": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vu *VoucherUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voucher.Table, voucher.Columns, sqlgraph.NewFieldSpec(voucher.FieldID, field.TypeInt))
+	if ps := vu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vu.mutation.BatchID(); ok {
+		_spec.SetField(voucher.FieldBatchID, field.TypeInt, value)
+	}
+	if value, ok := vu.mutation.AddedBatchID(); ok {
+		_spec.AddField(voucher.FieldBatchID, field.TypeInt, value)
+	}
+	if value, ok := vu.mutation.Serial(); ok {
+		_spec.SetField(voucher.FieldSerial, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.PinHash(); ok {
+		_spec.SetField(voucher.FieldPinHash, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.Amount(); ok {
+		_spec.SetField(voucher.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vu.mutation.AddedAmount(); ok {
+		_spec.AddField(voucher.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vu.mutation.Status(); ok {
+		_spec.SetField(voucher.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := vu.mutation.ExpiresAt(); ok {
+		_spec.SetField(voucher.FieldExpiresAt, field.TypeTime, value)
+	}
+	if vu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(voucher.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := vu.mutation.RedeemedBy(); ok {
+		_spec.SetField(voucher.FieldRedeemedBy, field.TypeString, value)
+	}
+	if vu.mutation.RedeemedByCleared() {
+		_spec.ClearField(voucher.FieldRedeemedBy, field.TypeString)
+	}
+	if value, ok := vu.mutation.RedeemedAt(); ok {
+		_spec.SetField(voucher.FieldRedeemedAt, field.TypeTime, value)
+	}
+	if vu.mutation.RedeemedAtCleared() {
+		_spec.ClearField(voucher.FieldRedeemedAt, field.TypeTime)
+	}
+	if value, ok := vu.mutation.TransactionRef(); ok {
+		_spec.SetField(voucher.FieldTransactionRef, field.TypeString, value)
+	}
+	if vu.mutation.TransactionRefCleared() {
+		_spec.ClearField(voucher.FieldTransactionRef, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voucher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vu.mutation.done = true
+	return n, nil
+}
+
+// VoucherUpdateOne is the builder for updating a single Voucher entity.
+type VoucherUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VoucherMutation
+}
+
+// SetBatchID sets the "batch_id" field.
+func (vuo *VoucherUpdateOne) SetBatchID(i int) *VoucherUpdateOne {
+	vuo.mutation.ResetBatchID()
+	vuo.mutation.SetBatchID(i)
+	return vuo
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillableBatchID(i *int) *VoucherUpdateOne {
+	if i != nil {
+		vuo.SetBatchID(*i)
+	}
+	return vuo
+}
+
+// AddBatchID adds i to the "batch_id" field.
+func (vuo *VoucherUpdateOne) AddBatchID(i int) *VoucherUpdateOne {
+	vuo.mutation.AddBatchID(i)
+	return vuo
+}
+
+// SetSerial sets the "serial" field.
+func (vuo *VoucherUpdateOne) SetSerial(s string) *VoucherUpdateOne {
+	vuo.mutation.SetSerial(s)
+	return vuo
+}
+
+// SetNillableSerial sets the "serial" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillableSerial(s *string) *VoucherUpdateOne {
+	if s != nil {
+		vuo.SetSerial(*s)
+	}
+	return vuo
+}
+
+// SetPinHash sets the "pin_hash" field.
+func (vuo *VoucherUpdateOne) SetPinHash(s string) *VoucherUpdateOne {
+	vuo.mutation.SetPinHash(s)
+	return vuo
+}
+
+// SetNillablePinHash sets the "pin_hash" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillablePinHash(s *string) *VoucherUpdateOne {
+	if s != nil {
+		vuo.SetPinHash(*s)
+	}
+	return vuo
+}
+
+// SetAmount sets the "amount" field.
+func (vuo *VoucherUpdateOne) SetAmount(f float64) *VoucherUpdateOne {
+	vuo.mutation.ResetAmount()
+	vuo.mutation.SetAmount(f)
+	return vuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillableAmount(f *float64) *VoucherUpdateOne {
+	if f != nil {
+		vuo.SetAmount(*f)
+	}
+	return vuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (vuo *VoucherUpdateOne) AddAmount(f float64) *VoucherUpdateOne {
+	vuo.mutation.AddAmount(f)
+	return vuo
+}
+
+// SetStatus sets the "status" field.
+func (vuo *VoucherUpdateOne) SetStatus(v voucher.Status) *VoucherUpdateOne {
+	vuo.mutation.SetStatus(v)
+	return vuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillableStatus(v *voucher.Status) *VoucherUpdateOne {
+	if v != nil {
+		vuo.SetStatus(*v)
+	}
+	return vuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vuo *VoucherUpdateOne) SetExpiresAt(t time.Time) *VoucherUpdateOne {
+	vuo.mutation.SetExpiresAt(t)
+	return vuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillableExpiresAt(t *time.Time) *VoucherUpdateOne {
+	if t != nil {
+		vuo.SetExpiresAt(*t)
+	}
+	return vuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (vuo *VoucherUpdateOne) ClearExpiresAt() *VoucherUpdateOne {
+	vuo.mutation.ClearExpiresAt()
+	return vuo
+}
+
+// SetRedeemedBy sets the "redeemed_by" field.
+func (vuo *VoucherUpdateOne) SetRedeemedBy(s string) *VoucherUpdateOne {
+	vuo.mutation.SetRedeemedBy(s)
+	return vuo
+}
+
+// SetNillableRedeemedBy sets the "redeemed_by" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillableRedeemedBy(s *string) *VoucherUpdateOne {
+	if s != nil {
+		vuo.SetRedeemedBy(*s)
+	}
+	return vuo
+}
+
+// ClearRedeemedBy clears the value of the "redeemed_by" field.
+func (vuo *VoucherUpdateOne) ClearRedeemedBy() *VoucherUpdateOne {
+	vuo.mutation.ClearRedeemedBy()
+	return vuo
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (vuo *VoucherUpdateOne) SetRedeemedAt(t time.Time) *VoucherUpdateOne {
+	vuo.mutation.SetRedeemedAt(t)
+	return vuo
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillableRedeemedAt(t *time.Time) *VoucherUpdateOne {
+	if t != nil {
+		vuo.SetRedeemedAt(*t)
+	}
+	return vuo
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (vuo *VoucherUpdateOne) ClearRedeemedAt() *VoucherUpdateOne {
+	vuo.mutation.ClearRedeemedAt()
+	return vuo
+}
+
+// SetTransactionRef sets the "transaction_ref" field.
+func (vuo *VoucherUpdateOne) SetTransactionRef(s string) *VoucherUpdateOne {
+	vuo.mutation.SetTransactionRef(s)
+	return vuo
+}
+
+// SetNillableTransactionRef sets the "transaction_ref" field if the given value is not nil.
+func (vuo *VoucherUpdateOne) SetNillableTransactionRef(s *string) *VoucherUpdateOne {
+	if s != nil {
+		vuo.SetTransactionRef(*s)
+	}
+	return vuo
+}
+
+// ClearTransactionRef clears the value of the "transaction_ref" field.
+func (vuo *VoucherUpdateOne) ClearTransactionRef() *VoucherUpdateOne {
+	vuo.mutation.ClearTransactionRef()
+	return vuo
+}
+
+// Mutation returns the VoucherMutation object of the builder.
+func (vuo *VoucherUpdateOne) Mutation() *VoucherMutation {
+	return vuo.mutation
+}
+
+// Where appends a list predicates to the VoucherUpdate builder.
+func (vuo *VoucherUpdateOne) Where(ps ...predicate.Voucher) *VoucherUpdateOne {
+	vuo.mutation.Where(ps...)
+	return vuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vuo *VoucherUpdateOne) Select(field string, fields ...string) *VoucherUpdateOne {
+	vuo.fields = append([]string{field}, fields...)
+	return vuo
+}
+
+// Save executes the query and returns the updated Voucher entity.
+func (vuo *VoucherUpdateOne) Save(ctx context.Context) (*Voucher, error) {
+	return withHooks(ctx, vuo.sqlSave, vuo.mutation, vuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vuo *VoucherUpdateOne) SaveX(ctx context.Context) *Voucher {
+	node, err := vuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vuo *VoucherUpdateOne) Exec(ctx context.Context) error {
+	_, err := vuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vuo *VoucherUpdateOne) ExecX(ctx context.Context) {
+	if err := vuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vuo *VoucherUpdateOne) check() error {
+	if v, ok := vuo.mutation.BatchID(); ok {
+		if err := voucher.BatchIDValidator(v); err != nil {
+			return &ValidationError{Name: "batch_id", err: fmt.Errorf(`ent: validator failed for field "Voucher.batch_id": %w`, err)}
+		}
+	}
+	if v, ok := vuo.mutation.Serial(); ok {
+		if err := voucher.SerialValidator(v); err != nil {
+			return &ValidationError{Name: "serial", err: fmt.Errorf(`ent: validator failed for field "Voucher.serial": %w`, err)}
+		}
+	}
+	if v, ok := vuo.mutation.PinHash(); ok {
+		if err := voucher.PinHashValidator(v); err != nil {
+			return &ValidationError{Name: "pin_hash", err: fmt.Errorf(`ent: validator failed for field "Voucher.pin_hash": %w`, err)}
+		}
+	}
+	if v, ok := vuo.mutation.Status(); ok {
+		if err := voucher.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Voucher.status": %w`, err)}
+		}
+	}
+	if v, ok := vuo.mutation.RedeemedBy(); ok {
+		if err := voucher.RedeemedByValidator(v); err != nil {
+			return &ValidationError{Name: "redeemed_by", err: fmt.Errorf(`ent: validator failed for field "Voucher.redeemed_by": %w`, err)}
+		}
+	}
+	if v, ok := vuo.mutation.TransactionRef(); ok {
+		if err := voucher.TransactionRefValidator(v); err != nil {
+			return &ValidationError{Name: "transaction_ref", err: fmt.Errorf(`ent: validator failed for field "Voucher.transaction_ref": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vuo *VoucherUpdateOne) sqlSave(ctx context.Context) (_node *Voucher, err error) {
+	if err := vuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voucher.Table, voucher.Columns, sqlgraph.NewFieldSpec(voucher.FieldID, field.TypeInt))
+	id, ok := vuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Voucher.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, voucher.FieldID)
+		for _, f := range fields {
+			if !voucher.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != voucher.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vuo.mutation.BatchID(); ok {
+		_spec.SetField(voucher.FieldBatchID, field.TypeInt, value)
+	}
+	if value, ok := vuo.mutation.AddedBatchID(); ok {
+		_spec.AddField(voucher.FieldBatchID, field.TypeInt, value)
+	}
+	if value, ok := vuo.mutation.Serial(); ok {
+		_spec.SetField(voucher.FieldSerial, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.PinHash(); ok {
+		_spec.SetField(voucher.FieldPinHash, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.Amount(); ok {
+		_spec.SetField(voucher.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vuo.mutation.AddedAmount(); ok {
+		_spec.AddField(voucher.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vuo.mutation.Status(); ok {
+		_spec.SetField(voucher.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := vuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(voucher.FieldExpiresAt, field.TypeTime, value)
+	}
+	if vuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(voucher.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := vuo.mutation.RedeemedBy(); ok {
+		_spec.SetField(voucher.FieldRedeemedBy, field.TypeString, value)
+	}
+	if vuo.mutation.RedeemedByCleared() {
+		_spec.ClearField(voucher.FieldRedeemedBy, field.TypeString)
+	}
+	if value, ok := vuo.mutation.RedeemedAt(); ok {
+		_spec.SetField(voucher.FieldRedeemedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.RedeemedAtCleared() {
+		_spec.ClearField(voucher.FieldRedeemedAt, field.TypeTime)
+	}
+	if value, ok := vuo.mutation.TransactionRef(); ok {
+		_spec.SetField(voucher.FieldTransactionRef, field.TypeString, value)
+	}
+	if vuo.mutation.TransactionRefCleared() {
+		_spec.ClearField(voucher.FieldTransactionRef, field.TypeString)
+	}
+	_node = &Voucher{config: vuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voucher.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vuo.mutation.done = true
+	return _node, nil
+}
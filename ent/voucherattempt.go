@@ -0,0 +1,128 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+)
+
+// VoucherAttempt is the model entity for the VoucherAttempt schema.
+type VoucherAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ClientUsername holds the value of the "client_username" field.
+	ClientUsername string `json:"client_username,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VoucherAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case voucherattempt.FieldID:
+			values[i] = new(sql.NullInt64)
+		case voucherattempt.FieldClientUsername, voucherattempt.FieldIP:
+			values[i] = new(sql.NullString)
+		case voucherattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VoucherAttempt fields.
+func (va *VoucherAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case voucherattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			va.ID = int(value.Int64)
+		case voucherattempt.FieldClientUsername:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_username", values[i])
+			} else if value.Valid {
+				va.ClientUsername = value.String
+			}
+		case voucherattempt.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				va.IP = value.String
+			}
+		case voucherattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				va.CreatedAt = value.Time
+			}
+		default:
+			va.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VoucherAttempt.
+// This includes values selected through modifiers, order, etc.
+func (va *VoucherAttempt) Value(name string) (ent.Value, error) {
+	return va.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VoucherAttempt.
+// Note that you need to call VoucherAttempt.Unwrap() before calling this method if this VoucherAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (va *VoucherAttempt) Update() *VoucherAttemptUpdateOne {
+	return NewVoucherAttemptClient(va.config).UpdateOne(va)
+}
+
+// Unwrap unwraps the VoucherAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (va *VoucherAttempt) Unwrap() *VoucherAttempt {
+	_tx, ok := va.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VoucherAttempt is not a transactional entity")
+	}
+	va.config.driver = _tx.drv
+	return va
+}
+
+// String implements the fmt.Stringer.
+func (va *VoucherAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("VoucherAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", va.ID))
+	builder.WriteString("client_username=")
+	builder.WriteString(va.ClientUsername)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(va.IP)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(va.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VoucherAttempts is a parsable slice of VoucherAttempt.
+type VoucherAttempts []*VoucherAttempt
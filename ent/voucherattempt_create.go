@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+)
+
+// VoucherAttemptCreate is the builder for creating a VoucherAttempt entity.
+type VoucherAttemptCreate struct {
+	config
+	mutation *VoucherAttemptMutation
+	hooks    []Hook
+}
+
+// SetClientUsername sets the "client_username" field.
+func (vac *VoucherAttemptCreate) SetClientUsername(s string) *VoucherAttemptCreate {
+	vac.mutation.SetClientUsername(s)
+	return vac
+}
+
+// SetIP sets the "ip" field.
+func (vac *VoucherAttemptCreate) SetIP(s string) *VoucherAttemptCreate {
+	vac.mutation.SetIP(s)
+	return vac
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (vac *VoucherAttemptCreate) SetNillableIP(s *string) *VoucherAttemptCreate {
+	if s != nil {
+		vac.SetIP(*s)
+	}
+	return vac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vac *VoucherAttemptCreate) SetCreatedAt(t time.Time) *VoucherAttemptCreate {
+	vac.mutation.SetCreatedAt(t)
+	return vac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vac *VoucherAttemptCreate) SetNillableCreatedAt(t *time.Time) *VoucherAttemptCreate {
+	if t != nil {
+		vac.SetCreatedAt(*t)
+	}
+	return vac
+}
+
+// Mutation returns the VoucherAttemptMutation object of the builder.
+func (vac *VoucherAttemptCreate) Mutation() *VoucherAttemptMutation {
+	return vac.mutation
+}
+
+// Save creates the VoucherAttempt in the database.
+func (vac *VoucherAttemptCreate) Save(ctx context.Context) (*VoucherAttempt, error) {
+	vac.defaults()
+	return withHooks(ctx, vac.sqlSave, vac.mutation, vac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vac *VoucherAttemptCreate) SaveX(ctx context.Context) *VoucherAttempt {
+	v, err := vac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vac *VoucherAttemptCreate) Exec(ctx context.Context) error {
+	_, err := vac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vac *VoucherAttemptCreate) ExecX(ctx context.Context) {
+	if err := vac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vac *VoucherAttemptCreate) defaults() {
+	if _, ok := vac.mutation.CreatedAt(); !ok {
+		v := voucherattempt.DefaultCreatedAt()
+		vac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vac *VoucherAttemptCreate) check() error {
+	if _, ok := vac.mutation.ClientUsername(); !ok {
+		return &ValidationError{Name: "client_username", err: errors.New(`ent: missing required field "VoucherAttempt.client_username"`)}
+	}
+	if v, ok := vac.mutation.ClientUsername(); ok {
+		if err := voucherattempt.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "VoucherAttempt.client_username": %w`, err)}
+		}
+	}
+	if v, ok := vac.mutation.IP(); ok {
+		if err := voucherattempt.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "VoucherAttempt.ip": %w`, err)}
+		}
+	}
+	if _, ok := vac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VoucherAttempt.created_at"`)}
+	}
+	return nil
+}
+
+func (vac *VoucherAttemptCreate) sqlSave(ctx context.Context) (*VoucherAttempt, error) {
+	if err := vac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vac.mutation.id = &_node.ID
+	vac.mutation.done = true
+	return _node, nil
+}
+
+func (vac *VoucherAttemptCreate) createSpec() (*VoucherAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VoucherAttempt{config: vac.config}
+		_spec = sqlgraph.NewCreateSpec(voucherattempt.Table, sqlgraph.NewFieldSpec(voucherattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := vac.mutation.ClientUsername(); ok {
+		_spec.SetField(voucherattempt.FieldClientUsername, field.TypeString, value)
+		_node.ClientUsername = value
+	}
+	if value, ok := vac.mutation.IP(); ok {
+		_spec.SetField(voucherattempt.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := vac.mutation.CreatedAt(); ok {
+		_spec.SetField(voucherattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VoucherAttemptCreateBulk is the builder for creating many VoucherAttempt entities in bulk.
+type VoucherAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*VoucherAttemptCreate
+}
+
+// Save creates the VoucherAttempt entities in the database.
+func (vacb *VoucherAttemptCreateBulk) Save(ctx context.Context) ([]*VoucherAttempt, error) {
+	if vacb.err != nil {
+		return nil, vacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vacb.builders))
+	nodes := make([]*VoucherAttempt, len(vacb.builders))
+	mutators := make([]Mutator, len(vacb.builders))
+	for i := range vacb.builders {
+		func(i int, root context.Context) {
+			builder := vacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VoucherAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vacb *VoucherAttemptCreateBulk) SaveX(ctx context.Context) []*VoucherAttempt {
+	v, err := vacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vacb *VoucherAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := vacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vacb *VoucherAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := vacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+)
+
+// VoucherAttemptDelete is the builder for deleting a VoucherAttempt entity.
+type VoucherAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *VoucherAttemptMutation
+}
+
+// Where appends a list predicates to the VoucherAttemptDelete builder.
+func (vad *VoucherAttemptDelete) Where(ps ...predicate.VoucherAttempt) *VoucherAttemptDelete {
+	vad.mutation.Where(ps...)
+	return vad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vad *VoucherAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vad.sqlExec, vad.mutation, vad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vad *VoucherAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := vad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vad *VoucherAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(voucherattempt.Table, sqlgraph.NewFieldSpec(voucherattempt.FieldID, field.TypeInt))
+	if ps := vad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vad.mutation.done = true
+	return affected, err
+}
+
+// VoucherAttemptDeleteOne is the builder for deleting a single VoucherAttempt entity.
+type VoucherAttemptDeleteOne struct {
+	vad *VoucherAttemptDelete
+}
+
+// Where appends a list predicates to the VoucherAttemptDelete builder.
+func (vado *VoucherAttemptDeleteOne) Where(ps ...predicate.VoucherAttempt) *VoucherAttemptDeleteOne {
+	vado.vad.mutation.Where(ps...)
+	return vado
+}
+
+// Exec executes the deletion query.
+func (vado *VoucherAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := vado.vad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{voucherattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vado *VoucherAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := vado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+)
+
+// VoucherAttemptQuery is the builder for querying VoucherAttempt entities.
+type VoucherAttemptQuery struct {
+	config
+	ctx        *QueryContext
+	order      []voucherattempt.OrderOption
+	inters     []Interceptor
+	predicates []predicate.VoucherAttempt
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VoucherAttemptQuery builder.
+func (vaq *VoucherAttemptQuery) Where(ps ...predicate.VoucherAttempt) *VoucherAttemptQuery {
+	vaq.predicates = append(vaq.predicates, ps...)
+	return vaq
+}
+
+// Limit the number of records to be returned by this query.
+func (vaq *VoucherAttemptQuery) Limit(limit int) *VoucherAttemptQuery {
+	vaq.ctx.Limit = &limit
+	return vaq
+}
+
+// Offset to start from.
+func (vaq *VoucherAttemptQuery) Offset(offset int) *VoucherAttemptQuery {
+	vaq.ctx.Offset = &offset
+	return vaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vaq *VoucherAttemptQuery) Unique(unique bool) *VoucherAttemptQuery {
+	vaq.ctx.Unique = &unique
+	return vaq
+}
+
+// Order specifies how the records should be ordered.
+func (vaq *VoucherAttemptQuery) Order(o ...voucherattempt.OrderOption) *VoucherAttemptQuery {
+	vaq.order = append(vaq.order, o...)
+	return vaq
+}
+
+// First returns the first VoucherAttempt entity from the query.
+// Returns a *NotFoundError when no VoucherAttempt was found.
+func (vaq *VoucherAttemptQuery) First(ctx context.Context) (*VoucherAttempt, error) {
+	nodes, err := vaq.Limit(1).All(setContextOp(ctx, vaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{voucherattempt.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vaq *VoucherAttemptQuery) FirstX(ctx context.Context) *VoucherAttempt {
+	node, err := vaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VoucherAttempt ID from the query.
+// Returns a *NotFoundError when no VoucherAttempt ID was found.
+func (vaq *VoucherAttemptQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vaq.Limit(1).IDs(setContextOp(ctx, vaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{voucherattempt.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vaq *VoucherAttemptQuery) FirstIDX(ctx context.Context) int {
+	id, err := vaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VoucherAttempt entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VoucherAttempt entity is found.
+// Returns a *NotFoundError when no VoucherAttempt entities are found.
+func (vaq *VoucherAttemptQuery) Only(ctx context.Context) (*VoucherAttempt, error) {
+	nodes, err := vaq.Limit(2).All(setContextOp(ctx, vaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{voucherattempt.Label}
+	default:
+		return nil, &NotSingularError{voucherattempt.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vaq *VoucherAttemptQuery) OnlyX(ctx context.Context) *VoucherAttempt {
+	node, err := vaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VoucherAttempt ID in the query.
+// Returns a *NotSingularError when more than one VoucherAttempt ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vaq *VoucherAttemptQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vaq.Limit(2).IDs(setContextOp(ctx, vaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{voucherattempt.Label}
+	default:
+		err = &NotSingularError{voucherattempt.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vaq *VoucherAttemptQuery) OnlyIDX(ctx context.Context) int {
+	id, err := vaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VoucherAttempts.
+func (vaq *VoucherAttemptQuery) All(ctx context.Context) ([]*VoucherAttempt, error) {
+	ctx = setContextOp(ctx, vaq.ctx, ent.OpQueryAll)
+	if err := vaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VoucherAttempt, *VoucherAttemptQuery]()
+	return withInterceptors[[]*VoucherAttempt](ctx, vaq, qr, vaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vaq *VoucherAttemptQuery) AllX(ctx context.Context) []*VoucherAttempt {
+	nodes, err := vaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VoucherAttempt IDs.
+func (vaq *VoucherAttemptQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if vaq.ctx.Unique == nil && vaq.path != nil {
+		vaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vaq.ctx, ent.OpQueryIDs)
+	if err = vaq.Select(voucherattempt.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vaq *VoucherAttemptQuery) IDsX(ctx context.Context) []int {
+	ids, err := vaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vaq *VoucherAttemptQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vaq.ctx, ent.OpQueryCount)
+	if err := vaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vaq, querierCount[*VoucherAttemptQuery](), vaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vaq *VoucherAttemptQuery) CountX(ctx context.Context) int {
+	count, err := vaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vaq *VoucherAttemptQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vaq.ctx, ent.OpQueryExist)
+	switch _, err := vaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vaq *VoucherAttemptQuery) ExistX(ctx context.Context) bool {
+	exist, err := vaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VoucherAttemptQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vaq *VoucherAttemptQuery) Clone() *VoucherAttemptQuery {
+	if vaq == nil {
+		return nil
+	}
+	return &VoucherAttemptQuery{
+		config:     vaq.config,
+		ctx:        vaq.ctx.Clone(),
+		order:      append([]voucherattempt.OrderOption{}, vaq.order...),
+		inters:     append([]Interceptor{}, vaq.inters...),
+		predicates: append([]predicate.VoucherAttempt{}, vaq.predicates...),
+		// clone intermediate query.
+		sql:  vaq.sql.Clone(),
+		path: vaq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientUsername string `json:"client_username,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VoucherAttempt.Query().
+//		GroupBy(voucherattempt.FieldClientUsername).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vaq *VoucherAttemptQuery) GroupBy(field string, fields ...string) *VoucherAttemptGroupBy {
+	vaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VoucherAttemptGroupBy{build: vaq}
+	grbuild.flds = &vaq.ctx.Fields
+	grbuild.label = voucherattempt.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ClientUsername string `json:"client_username,omitempty"`
+//	}
+//
+//	client.VoucherAttempt.Query().
+//		Select(voucherattempt.FieldClientUsername).
+//		Scan(ctx, &v)
+func (vaq *VoucherAttemptQuery) Select(fields ...string) *VoucherAttemptSelect {
+	vaq.ctx.Fields = append(vaq.ctx.Fields, fields...)
+	sbuild := &VoucherAttemptSelect{VoucherAttemptQuery: vaq}
+	sbuild.label = voucherattempt.Label
+	sbuild.flds, sbuild.scan = &vaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VoucherAttemptSelect configured with the given aggregations.
+func (vaq *VoucherAttemptQuery) Aggregate(fns ...AggregateFunc) *VoucherAttemptSelect {
+	return vaq.Select().Aggregate(fns...)
+}
+
+func (vaq *VoucherAttemptQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vaq.ctx.Fields {
+		if !voucherattempt.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vaq.path != nil {
+		prev, err := vaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vaq.sql = prev
+	}
+	return nil
+}
+
+func (vaq *VoucherAttemptQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VoucherAttempt, error) {
+	var (
+		nodes = []*VoucherAttempt{}
+		_spec = vaq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VoucherAttempt).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VoucherAttempt{config: vaq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (vaq *VoucherAttemptQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vaq.querySpec()
+	_spec.Node.Columns = vaq.ctx.Fields
+	if len(vaq.ctx.Fields) > 0 {
+		_spec.Unique = vaq.ctx.Unique != nil && *vaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vaq.driver, _spec)
+}
+
+func (vaq *VoucherAttemptQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(voucherattempt.Table, voucherattempt.Columns, sqlgraph.NewFieldSpec(voucherattempt.FieldID, field.TypeInt))
+	_spec.From = vaq.sql
+	if unique := vaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, voucherattempt.FieldID)
+		for i := range fields {
+			if fields[i] != voucherattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vaq *VoucherAttemptQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vaq.driver.Dialect())
+	t1 := builder.Table(voucherattempt.Table)
+	columns := vaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = voucherattempt.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vaq.sql != nil {
+		selector = vaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vaq.ctx.Unique != nil && *vaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range vaq.predicates {
+		p(selector)
+	}
+	for _, p := range vaq.order {
+		p(selector)
+	}
+	if offset := vaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VoucherAttemptGroupBy is the group-by builder for VoucherAttempt entities.
+type VoucherAttemptGroupBy struct {
+	selector
+	build *VoucherAttemptQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vagb *VoucherAttemptGroupBy) Aggregate(fns ...AggregateFunc) *VoucherAttemptGroupBy {
+	vagb.fns = append(vagb.fns, fns...)
+	return vagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vagb *VoucherAttemptGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vagb.build.ctx, ent.OpQueryGroupBy)
+	if err := vagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VoucherAttemptQuery, *VoucherAttemptGroupBy](ctx, vagb.build, vagb, vagb.build.inters, v)
+}
+
+func (vagb *VoucherAttemptGroupBy) sqlScan(ctx context.Context, root *VoucherAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vagb.fns))
+	for _, fn := range vagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vagb.flds)+len(vagb.fns))
+		for _, f := range *vagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VoucherAttemptSelect is the builder for selecting fields of VoucherAttempt entities.
+type VoucherAttemptSelect struct {
+	*VoucherAttemptQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vas *VoucherAttemptSelect) Aggregate(fns ...AggregateFunc) *VoucherAttemptSelect {
+	vas.fns = append(vas.fns, fns...)
+	return vas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vas *VoucherAttemptSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vas.ctx, ent.OpQuerySelect)
+	if err := vas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VoucherAttemptQuery, *VoucherAttemptSelect](ctx, vas.VoucherAttemptQuery, vas, vas.inters, v)
+}
+
+func (vas *VoucherAttemptSelect) sqlScan(ctx context.Context, root *VoucherAttemptQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vas.fns))
+	for _, fn := range vas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+)
+
+// VoucherAttemptUpdate is the builder for updating VoucherAttempt entities.
+type VoucherAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VoucherAttemptMutation
+}
+
+// Where appends a list predicates to the VoucherAttemptUpdate builder.
+func (vau *VoucherAttemptUpdate) Where(ps ...predicate.VoucherAttempt) *VoucherAttemptUpdate {
+	vau.mutation.Where(ps...)
+	return vau
+}
+
+// SetClientUsername sets the "client_username" field.
+func (vau *VoucherAttemptUpdate) SetClientUsername(s string) *VoucherAttemptUpdate {
+	vau.mutation.SetClientUsername(s)
+	return vau
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (vau *VoucherAttemptUpdate) SetNillableClientUsername(s *string) *VoucherAttemptUpdate {
+	if s != nil {
+		vau.SetClientUsername(*s)
+	}
+	return vau
+}
+
+// SetIP sets the "ip" field.
+func (vau *VoucherAttemptUpdate) SetIP(s string) *VoucherAttemptUpdate {
+	vau.mutation.SetIP(s)
+	return vau
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (vau *VoucherAttemptUpdate) SetNillableIP(s *string) *VoucherAttemptUpdate {
+	if s != nil {
+		vau.SetIP(*s)
+	}
+	return vau
+}
+
+// ClearIP clears the value of the "ip" field.
+func (vau *VoucherAttemptUpdate) ClearIP() *VoucherAttemptUpdate {
+	vau.mutation.ClearIP()
+	return vau
+}
+
+// Mutation returns the VoucherAttemptMutation object of the builder.
+func (vau *VoucherAttemptUpdate) Mutation() *VoucherAttemptMutation {
+	return vau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vau *VoucherAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vau.sqlSave, vau.mutation, vau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vau *VoucherAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := vau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vau *VoucherAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := vau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vau *VoucherAttemptUpdate) ExecX(ctx context.Context) {
+	if err := vau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vau *VoucherAttemptUpdate) check() error {
+	if v, ok := vau.mutation.ClientUsername(); ok {
+		if err := voucherattempt.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "VoucherAttempt.client_username": %w`, err)}
+		}
+	}
+	if v, ok := vau.mutation.IP(); ok {
+		if err := voucherattempt.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "VoucherAttempt.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vau *VoucherAttemptUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voucherattempt.Table, voucherattempt.Columns, sqlgraph.NewFieldSpec(voucherattempt.FieldID, field.TypeInt))
+	if ps := vau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vau.mutation.ClientUsername(); ok {
+		_spec.SetField(voucherattempt.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := vau.mutation.IP(); ok {
+		_spec.SetField(voucherattempt.FieldIP, field.TypeString, value)
+	}
+	if vau.mutation.IPCleared() {
+		_spec.ClearField(voucherattempt.FieldIP, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voucherattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vau.mutation.done = true
+	return n, nil
+}
+
+// VoucherAttemptUpdateOne is the builder for updating a single VoucherAttempt entity.
+type VoucherAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VoucherAttemptMutation
+}
+
+// SetClientUsername sets the "client_username" field.
+func (vauo *VoucherAttemptUpdateOne) SetClientUsername(s string) *VoucherAttemptUpdateOne {
+	vauo.mutation.SetClientUsername(s)
+	return vauo
+}
+
+// SetNillableClientUsername sets the "client_username" field if the given value is not nil.
+func (vauo *VoucherAttemptUpdateOne) SetNillableClientUsername(s *string) *VoucherAttemptUpdateOne {
+	if s != nil {
+		vauo.SetClientUsername(*s)
+	}
+	return vauo
+}
+
+// SetIP sets the "ip" field.
+func (vauo *VoucherAttemptUpdateOne) SetIP(s string) *VoucherAttemptUpdateOne {
+	vauo.mutation.SetIP(s)
+	return vauo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (vauo *VoucherAttemptUpdateOne) SetNillableIP(s *string) *VoucherAttemptUpdateOne {
+	if s != nil {
+		vauo.SetIP(*s)
+	}
+	return vauo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (vauo *VoucherAttemptUpdateOne) ClearIP() *VoucherAttemptUpdateOne {
+	vauo.mutation.ClearIP()
+	return vauo
+}
+
+// Mutation returns the VoucherAttemptMutation object of the builder.
+func (vauo *VoucherAttemptUpdateOne) Mutation() *VoucherAttemptMutation {
+	return vauo.mutation
+}
+
+// Where appends a list predicates to the VoucherAttemptUpdate builder.
+func (vauo *VoucherAttemptUpdateOne) Where(ps ...predicate.VoucherAttempt) *VoucherAttemptUpdateOne {
+	vauo.mutation.Where(ps...)
+	return vauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vauo *VoucherAttemptUpdateOne) Select(field string, fields ...string) *VoucherAttemptUpdateOne {
+	vauo.fields = append([]string{field}, fields...)
+	return vauo
+}
+
+// Save executes the query and returns the updated VoucherAttempt entity.
+func (vauo *VoucherAttemptUpdateOne) Save(ctx context.Context) (*VoucherAttempt, error) {
+	return withHooks(ctx, vauo.sqlSave, vauo.mutation, vauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vauo *VoucherAttemptUpdateOne) SaveX(ctx context.Context) *VoucherAttempt {
+	node, err := vauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vauo *VoucherAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := vauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vauo *VoucherAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := vauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vauo *VoucherAttemptUpdateOne) check() error {
+	if v, ok := vauo.mutation.ClientUsername(); ok {
+		if err := voucherattempt.ClientUsernameValidator(v); err != nil {
+			return &ValidationError{Name: "client_username", err: fmt.Errorf(`ent: validator failed for field "VoucherAttempt.client_username": %w`, err)}
+		}
+	}
+	if v, ok := vauo.mutation.IP(); ok {
+		if err := voucherattempt.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "VoucherAttempt.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vauo *VoucherAttemptUpdateOne) sqlSave(ctx context.Context) (_node *VoucherAttempt, err error) {
+	if err := vauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voucherattempt.Table, voucherattempt.Columns, sqlgraph.NewFieldSpec(voucherattempt.FieldID, field.TypeInt))
+	id, ok := vauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VoucherAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, voucherattempt.FieldID)
+		for _, f := range fields {
+			if !voucherattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != voucherattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vauo.mutation.ClientUsername(); ok {
+		_spec.SetField(voucherattempt.FieldClientUsername, field.TypeString, value)
+	}
+	if value, ok := vauo.mutation.IP(); ok {
+		_spec.SetField(voucherattempt.FieldIP, field.TypeString, value)
+	}
+	if vauo.mutation.IPCleared() {
+		_spec.ClearField(voucherattempt.FieldIP, field.TypeString)
+	}
+	_node = &VoucherAttempt{config: vauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voucherattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
+)
+
+// VoucherBatch is the model entity for the VoucherBatch schema.
+type VoucherBatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Denomination of every voucher in the batch
+	Amount float64 `json:"amount,omitempty"`
+	// Count holds the value of the "count" field.
+	Count int `json:"count,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VoucherBatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case voucherbatch.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case voucherbatch.FieldID, voucherbatch.FieldCount:
+			values[i] = new(sql.NullInt64)
+		case voucherbatch.FieldName, voucherbatch.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case voucherbatch.FieldExpiresAt, voucherbatch.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VoucherBatch fields.
+func (vb *VoucherBatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case voucherbatch.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			vb.ID = int(value.Int64)
+		case voucherbatch.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				vb.Name = value.String
+			}
+		case voucherbatch.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				vb.Amount = value.Float64
+			}
+		case voucherbatch.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				vb.Count = int(value.Int64)
+			}
+		case voucherbatch.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				vb.ExpiresAt = new(time.Time)
+				*vb.ExpiresAt = value.Time
+			}
+		case voucherbatch.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				vb.CreatedBy = value.String
+			}
+		case voucherbatch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				vb.CreatedAt = value.Time
+			}
+		default:
+			vb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VoucherBatch.
+// This includes values selected through modifiers, order, etc.
+func (vb *VoucherBatch) Value(name string) (ent.Value, error) {
+	return vb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VoucherBatch.
+// Note that you need to call VoucherBatch.Unwrap() before calling this method if this VoucherBatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vb *VoucherBatch) Update() *VoucherBatchUpdateOne {
+	return NewVoucherBatchClient(vb.config).UpdateOne(vb)
+}
+
+// Unwrap unwraps the VoucherBatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vb *VoucherBatch) Unwrap() *VoucherBatch {
+	_tx, ok := vb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VoucherBatch is not a transactional entity")
+	}
+	vb.config.driver = _tx.drv
+	return vb
+}
+
+// String implements the fmt.Stringer.
+func (vb *VoucherBatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("VoucherBatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", vb.ID))
+	builder.WriteString("name=")
+	builder.WriteString(vb.Name)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", vb.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", vb.Count))
+	builder.WriteString(", ")
+	if v := vb.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(vb.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(vb.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VoucherBatches is a parsable slice of VoucherBatch.
+type VoucherBatches []*VoucherBatch
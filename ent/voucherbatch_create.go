@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
+)
+
+// VoucherBatchCreate is the builder for creating a VoucherBatch entity.
+type VoucherBatchCreate struct {
+	config
+	mutation *VoucherBatchMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (vbc *VoucherBatchCreate) SetName(s string) *VoucherBatchCreate {
+	vbc.mutation.SetName(s)
+	return vbc
+}
+
+// SetAmount sets the "amount" field.
+func (vbc *VoucherBatchCreate) SetAmount(f float64) *VoucherBatchCreate {
+	vbc.mutation.SetAmount(f)
+	return vbc
+}
+
+// SetCount sets the "count" field.
+func (vbc *VoucherBatchCreate) SetCount(i int) *VoucherBatchCreate {
+	vbc.mutation.SetCount(i)
+	return vbc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vbc *VoucherBatchCreate) SetExpiresAt(t time.Time) *VoucherBatchCreate {
+	vbc.mutation.SetExpiresAt(t)
+	return vbc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (vbc *VoucherBatchCreate) SetNillableExpiresAt(t *time.Time) *VoucherBatchCreate {
+	if t != nil {
+		vbc.SetExpiresAt(*t)
+	}
+	return vbc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (vbc *VoucherBatchCreate) SetCreatedBy(s string) *VoucherBatchCreate {
+	vbc.mutation.SetCreatedBy(s)
+	return vbc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (vbc *VoucherBatchCreate) SetCreatedAt(t time.Time) *VoucherBatchCreate {
+	vbc.mutation.SetCreatedAt(t)
+	return vbc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (vbc *VoucherBatchCreate) SetNillableCreatedAt(t *time.Time) *VoucherBatchCreate {
+	if t != nil {
+		vbc.SetCreatedAt(*t)
+	}
+	return vbc
+}
+
+// Mutation returns the VoucherBatchMutation object of the builder.
+func (vbc *VoucherBatchCreate) Mutation() *VoucherBatchMutation {
+	return vbc.mutation
+}
+
+// Save creates the VoucherBatch in the database.
+func (vbc *VoucherBatchCreate) Save(ctx context.Context) (*VoucherBatch, error) {
+	vbc.defaults()
+	return withHooks(ctx, vbc.sqlSave, vbc.mutation, vbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vbc *VoucherBatchCreate) SaveX(ctx context.Context) *VoucherBatch {
+	v, err := vbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vbc *VoucherBatchCreate) Exec(ctx context.Context) error {
+	_, err := vbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vbc *VoucherBatchCreate) ExecX(ctx context.Context) {
+	if err := vbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (vbc *VoucherBatchCreate) defaults() {
+	if _, ok := vbc.mutation.CreatedAt(); !ok {
+		v := voucherbatch.DefaultCreatedAt()
+		vbc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vbc *VoucherBatchCreate) check() error {
+	if _, ok := vbc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "VoucherBatch.name"`)}
+	}
+	if v, ok := vbc.mutation.Name(); ok {
+		if err := voucherbatch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VoucherBatch.name": %w`, err)}
+		}
+	}
+	if _, ok := vbc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "VoucherBatch.amount"`)}
+	}
+	if _, ok := vbc.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "VoucherBatch.count"`)}
+	}
+	if _, ok := vbc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "VoucherBatch.created_by"`)}
+	}
+	if v, ok := vbc.mutation.CreatedBy(); ok {
+		if err := voucherbatch.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "VoucherBatch.created_by": %w`, err)}
+		}
+	}
+	if _, ok := vbc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VoucherBatch.created_at"`)}
+	}
+	return nil
+}
+
+func (vbc *VoucherBatchCreate) sqlSave(ctx context.Context) (*VoucherBatch, error) {
+	if err := vbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := vbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, vbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	vbc.mutation.id = &_node.ID
+	vbc.mutation.done = true
+	return _node, nil
+}
+
+func (vbc *VoucherBatchCreate) createSpec() (*VoucherBatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VoucherBatch{config: vbc.config}
+		_spec = sqlgraph.NewCreateSpec(voucherbatch.Table, sqlgraph.NewFieldSpec(voucherbatch.FieldID, field.TypeInt))
+	)
+	if value, ok := vbc.mutation.Name(); ok {
+		_spec.SetField(voucherbatch.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := vbc.mutation.Amount(); ok {
+		_spec.SetField(voucherbatch.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := vbc.mutation.Count(); ok {
+		_spec.SetField(voucherbatch.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := vbc.mutation.ExpiresAt(); ok {
+		_spec.SetField(voucherbatch.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := vbc.mutation.CreatedBy(); ok {
+		_spec.SetField(voucherbatch.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := vbc.mutation.CreatedAt(); ok {
+		_spec.SetField(voucherbatch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VoucherBatchCreateBulk is the builder for creating many VoucherBatch entities in bulk.
+type VoucherBatchCreateBulk struct {
+	config
+	err      error
+	builders []*VoucherBatchCreate
+}
+
+// Save creates the VoucherBatch entities in the database.
+func (vbcb *VoucherBatchCreateBulk) Save(ctx context.Context) ([]*VoucherBatch, error) {
+	if vbcb.err != nil {
+		return nil, vbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(vbcb.builders))
+	nodes := make([]*VoucherBatch, len(vbcb.builders))
+	mutators := make([]Mutator, len(vbcb.builders))
+	for i := range vbcb.builders {
+		func(i int, root context.Context) {
+			builder := vbcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VoucherBatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, vbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, vbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, vbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vbcb *VoucherBatchCreateBulk) SaveX(ctx context.Context) []*VoucherBatch {
+	v, err := vbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vbcb *VoucherBatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := vbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vbcb *VoucherBatchCreateBulk) ExecX(ctx context.Context) {
+	if err := vbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
+)
+
+// VoucherBatchDelete is the builder for deleting a VoucherBatch entity.
+type VoucherBatchDelete struct {
+	config
+	hooks    []Hook
+	mutation *VoucherBatchMutation
+}
+
+// Where appends a list predicates to the VoucherBatchDelete builder.
+func (vbd *VoucherBatchDelete) Where(ps ...predicate.VoucherBatch) *VoucherBatchDelete {
+	vbd.mutation.Where(ps...)
+	return vbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (vbd *VoucherBatchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, vbd.sqlExec, vbd.mutation, vbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vbd *VoucherBatchDelete) ExecX(ctx context.Context) int {
+	n, err := vbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (vbd *VoucherBatchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(voucherbatch.Table, sqlgraph.NewFieldSpec(voucherbatch.FieldID, field.TypeInt))
+	if ps := vbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, vbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	vbd.mutation.done = true
+	return affected, err
+}
+
+// VoucherBatchDeleteOne is the builder for deleting a single VoucherBatch entity.
+type VoucherBatchDeleteOne struct {
+	vbd *VoucherBatchDelete
+}
+
+// Where appends a list predicates to the VoucherBatchDelete builder.
+func (vbdo *VoucherBatchDeleteOne) Where(ps ...predicate.VoucherBatch) *VoucherBatchDeleteOne {
+	vbdo.vbd.mutation.Where(ps...)
+	return vbdo
+}
+
+// Exec executes the deletion query.
+func (vbdo *VoucherBatchDeleteOne) Exec(ctx context.Context) error {
+	n, err := vbdo.vbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{voucherbatch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vbdo *VoucherBatchDeleteOne) ExecX(ctx context.Context) {
+	if err := vbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
+)
+
+// VoucherBatchQuery is the builder for querying VoucherBatch entities.
+type VoucherBatchQuery struct {
+	config
+	ctx        *QueryContext
+	order      []voucherbatch.OrderOption
+	inters     []Interceptor
+	predicates []predicate.VoucherBatch
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VoucherBatchQuery builder.
+func (vbq *VoucherBatchQuery) Where(ps ...predicate.VoucherBatch) *VoucherBatchQuery {
+	vbq.predicates = append(vbq.predicates, ps...)
+	return vbq
+}
+
+// Limit the number of records to be returned by this query.
+func (vbq *VoucherBatchQuery) Limit(limit int) *VoucherBatchQuery {
+	vbq.ctx.Limit = &limit
+	return vbq
+}
+
+// Offset to start from.
+func (vbq *VoucherBatchQuery) Offset(offset int) *VoucherBatchQuery {
+	vbq.ctx.Offset = &offset
+	return vbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (vbq *VoucherBatchQuery) Unique(unique bool) *VoucherBatchQuery {
+	vbq.ctx.Unique = &unique
+	return vbq
+}
+
+// Order specifies how the records should be ordered.
+func (vbq *VoucherBatchQuery) Order(o ...voucherbatch.OrderOption) *VoucherBatchQuery {
+	vbq.order = append(vbq.order, o...)
+	return vbq
+}
+
+// First returns the first VoucherBatch entity from the query.
+// Returns a *NotFoundError when no VoucherBatch was found.
+func (vbq *VoucherBatchQuery) First(ctx context.Context) (*VoucherBatch, error) {
+	nodes, err := vbq.Limit(1).All(setContextOp(ctx, vbq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{voucherbatch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (vbq *VoucherBatchQuery) FirstX(ctx context.Context) *VoucherBatch {
+	node, err := vbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VoucherBatch ID from the query.
+// Returns a *NotFoundError when no VoucherBatch ID was found.
+func (vbq *VoucherBatchQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vbq.Limit(1).IDs(setContextOp(ctx, vbq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{voucherbatch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (vbq *VoucherBatchQuery) FirstIDX(ctx context.Context) int {
+	id, err := vbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VoucherBatch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VoucherBatch entity is found.
+// Returns a *NotFoundError when no VoucherBatch entities are found.
+func (vbq *VoucherBatchQuery) Only(ctx context.Context) (*VoucherBatch, error) {
+	nodes, err := vbq.Limit(2).All(setContextOp(ctx, vbq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{voucherbatch.Label}
+	default:
+		return nil, &NotSingularError{voucherbatch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (vbq *VoucherBatchQuery) OnlyX(ctx context.Context) *VoucherBatch {
+	node, err := vbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VoucherBatch ID in the query.
+// Returns a *NotSingularError when more than one VoucherBatch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (vbq *VoucherBatchQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = vbq.Limit(2).IDs(setContextOp(ctx, vbq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{voucherbatch.Label}
+	default:
+		err = &NotSingularError{voucherbatch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (vbq *VoucherBatchQuery) OnlyIDX(ctx context.Context) int {
+	id, err := vbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VoucherBatches.
+func (vbq *VoucherBatchQuery) All(ctx context.Context) ([]*VoucherBatch, error) {
+	ctx = setContextOp(ctx, vbq.ctx, ent.OpQueryAll)
+	if err := vbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VoucherBatch, *VoucherBatchQuery]()
+	return withInterceptors[[]*VoucherBatch](ctx, vbq, qr, vbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (vbq *VoucherBatchQuery) AllX(ctx context.Context) []*VoucherBatch {
+	nodes, err := vbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VoucherBatch IDs.
+func (vbq *VoucherBatchQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if vbq.ctx.Unique == nil && vbq.path != nil {
+		vbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, vbq.ctx, ent.OpQueryIDs)
+	if err = vbq.Select(voucherbatch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (vbq *VoucherBatchQuery) IDsX(ctx context.Context) []int {
+	ids, err := vbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (vbq *VoucherBatchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, vbq.ctx, ent.OpQueryCount)
+	if err := vbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, vbq, querierCount[*VoucherBatchQuery](), vbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (vbq *VoucherBatchQuery) CountX(ctx context.Context) int {
+	count, err := vbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (vbq *VoucherBatchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, vbq.ctx, ent.OpQueryExist)
+	switch _, err := vbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (vbq *VoucherBatchQuery) ExistX(ctx context.Context) bool {
+	exist, err := vbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VoucherBatchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (vbq *VoucherBatchQuery) Clone() *VoucherBatchQuery {
+	if vbq == nil {
+		return nil
+	}
+	return &VoucherBatchQuery{
+		config:     vbq.config,
+		ctx:        vbq.ctx.Clone(),
+		order:      append([]voucherbatch.OrderOption{}, vbq.order...),
+		inters:     append([]Interceptor{}, vbq.inters...),
+		predicates: append([]predicate.VoucherBatch{}, vbq.predicates...),
+		// clone intermediate query.
+		sql:  vbq.sql.Clone(),
+		path: vbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VoucherBatch.Query().
+//		GroupBy(voucherbatch.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (vbq *VoucherBatchQuery) GroupBy(field string, fields ...string) *VoucherBatchGroupBy {
+	vbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VoucherBatchGroupBy{build: vbq}
+	grbuild.flds = &vbq.ctx.Fields
+	grbuild.label = voucherbatch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.VoucherBatch.Query().
+//		Select(voucherbatch.FieldName).
+//		Scan(ctx, &v)
+func (vbq *VoucherBatchQuery) Select(fields ...string) *VoucherBatchSelect {
+	vbq.ctx.Fields = append(vbq.ctx.Fields, fields...)
+	sbuild := &VoucherBatchSelect{VoucherBatchQuery: vbq}
+	sbuild.label = voucherbatch.Label
+	sbuild.flds, sbuild.scan = &vbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VoucherBatchSelect configured with the given aggregations.
+func (vbq *VoucherBatchQuery) Aggregate(fns ...AggregateFunc) *VoucherBatchSelect {
+	return vbq.Select().Aggregate(fns...)
+}
+
+func (vbq *VoucherBatchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range vbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, vbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range vbq.ctx.Fields {
+		if !voucherbatch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if vbq.path != nil {
+		prev, err := vbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		vbq.sql = prev
+	}
+	return nil
+}
+
+func (vbq *VoucherBatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VoucherBatch, error) {
+	var (
+		nodes = []*VoucherBatch{}
+		_spec = vbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VoucherBatch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VoucherBatch{config: vbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, vbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (vbq *VoucherBatchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := vbq.querySpec()
+	_spec.Node.Columns = vbq.ctx.Fields
+	if len(vbq.ctx.Fields) > 0 {
+		_spec.Unique = vbq.ctx.Unique != nil && *vbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, vbq.driver, _spec)
+}
+
+func (vbq *VoucherBatchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(voucherbatch.Table, voucherbatch.Columns, sqlgraph.NewFieldSpec(voucherbatch.FieldID, field.TypeInt))
+	_spec.From = vbq.sql
+	if unique := vbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if vbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := vbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, voucherbatch.FieldID)
+		for i := range fields {
+			if fields[i] != voucherbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := vbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := vbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := vbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := vbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (vbq *VoucherBatchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(vbq.driver.Dialect())
+	t1 := builder.Table(voucherbatch.Table)
+	columns := vbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = voucherbatch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if vbq.sql != nil {
+		selector = vbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if vbq.ctx.Unique != nil && *vbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range vbq.predicates {
+		p(selector)
+	}
+	for _, p := range vbq.order {
+		p(selector)
+	}
+	if offset := vbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := vbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VoucherBatchGroupBy is the group-by builder for VoucherBatch entities.
+type VoucherBatchGroupBy struct {
+	selector
+	build *VoucherBatchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (vbgb *VoucherBatchGroupBy) Aggregate(fns ...AggregateFunc) *VoucherBatchGroupBy {
+	vbgb.fns = append(vbgb.fns, fns...)
+	return vbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vbgb *VoucherBatchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vbgb.build.ctx, ent.OpQueryGroupBy)
+	if err := vbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VoucherBatchQuery, *VoucherBatchGroupBy](ctx, vbgb.build, vbgb, vbgb.build.inters, v)
+}
+
+func (vbgb *VoucherBatchGroupBy) sqlScan(ctx context.Context, root *VoucherBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(vbgb.fns))
+	for _, fn := range vbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*vbgb.flds)+len(vbgb.fns))
+		for _, f := range *vbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*vbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VoucherBatchSelect is the builder for selecting fields of VoucherBatch entities.
+type VoucherBatchSelect struct {
+	*VoucherBatchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (vbs *VoucherBatchSelect) Aggregate(fns ...AggregateFunc) *VoucherBatchSelect {
+	vbs.fns = append(vbs.fns, fns...)
+	return vbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (vbs *VoucherBatchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, vbs.ctx, ent.OpQuerySelect)
+	if err := vbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VoucherBatchQuery, *VoucherBatchSelect](ctx, vbs.VoucherBatchQuery, vbs, vbs.inters, v)
+}
+
+func (vbs *VoucherBatchSelect) sqlScan(ctx context.Context, root *VoucherBatchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(vbs.fns))
+	for _, fn := range vbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*vbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := vbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
+)
+
+// VoucherBatchUpdate is the builder for updating VoucherBatch entities.
+type VoucherBatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VoucherBatchMutation
+}
+
+// Where appends a list predicates to the VoucherBatchUpdate builder.
+func (vbu *VoucherBatchUpdate) Where(ps ...predicate.VoucherBatch) *VoucherBatchUpdate {
+	vbu.mutation.Where(ps...)
+	return vbu
+}
+
+// SetName sets the "name" field.
+func (vbu *VoucherBatchUpdate) SetName(s string) *VoucherBatchUpdate {
+	vbu.mutation.SetName(s)
+	return vbu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vbu *VoucherBatchUpdate) SetNillableName(s *string) *VoucherBatchUpdate {
+	if s != nil {
+		vbu.SetName(*s)
+	}
+	return vbu
+}
+
+// SetAmount sets the "amount" field.
+func (vbu *VoucherBatchUpdate) SetAmount(f float64) *VoucherBatchUpdate {
+	vbu.mutation.ResetAmount()
+	vbu.mutation.SetAmount(f)
+	return vbu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vbu *VoucherBatchUpdate) SetNillableAmount(f *float64) *VoucherBatchUpdate {
+	if f != nil {
+		vbu.SetAmount(*f)
+	}
+	return vbu
+}
+
+// AddAmount adds f to the "amount" field.
+func (vbu *VoucherBatchUpdate) AddAmount(f float64) *VoucherBatchUpdate {
+	vbu.mutation.AddAmount(f)
+	return vbu
+}
+
+// SetCount sets the "count" field.
+func (vbu *VoucherBatchUpdate) SetCount(i int) *VoucherBatchUpdate {
+	vbu.mutation.ResetCount()
+	vbu.mutation.SetCount(i)
+	return vbu
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (vbu *VoucherBatchUpdate) SetNillableCount(i *int) *VoucherBatchUpdate {
+	if i != nil {
+		vbu.SetCount(*i)
+	}
+	return vbu
+}
+
+// AddCount adds i to the "count" field.
+func (vbu *VoucherBatchUpdate) AddCount(i int) *VoucherBatchUpdate {
+	vbu.mutation.AddCount(i)
+	return vbu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vbu *VoucherBatchUpdate) SetExpiresAt(t time.Time) *VoucherBatchUpdate {
+	vbu.mutation.SetExpiresAt(t)
+	return vbu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (vbu *VoucherBatchUpdate) SetNillableExpiresAt(t *time.Time) *VoucherBatchUpdate {
+	if t != nil {
+		vbu.SetExpiresAt(*t)
+	}
+	return vbu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (vbu *VoucherBatchUpdate) ClearExpiresAt() *VoucherBatchUpdate {
+	vbu.mutation.ClearExpiresAt()
+	return vbu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (vbu *VoucherBatchUpdate) SetCreatedBy(s string) *VoucherBatchUpdate {
+	vbu.mutation.SetCreatedBy(s)
+	return vbu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (vbu *VoucherBatchUpdate) SetNillableCreatedBy(s *string) *VoucherBatchUpdate {
+	if s != nil {
+		vbu.SetCreatedBy(*s)
+	}
+	return vbu
+}
+
+// Mutation returns the VoucherBatchMutation object of the builder.
+func (vbu *VoucherBatchUpdate) Mutation() *VoucherBatchMutation {
+	return vbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vbu *VoucherBatchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, vbu.sqlSave, vbu.mutation, vbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vbu *VoucherBatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := vbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vbu *VoucherBatchUpdate) Exec(ctx context.Context) error {
+	_, err := vbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vbu *VoucherBatchUpdate) ExecX(ctx context.Context) {
+	if err := vbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vbu *VoucherBatchUpdate) check() error {
+	if v, ok := vbu.mutation.Name(); ok {
+		if err := voucherbatch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VoucherBatch.name": %w`, err)}
+		}
+	}
+	if v, ok := vbu.mutation.CreatedBy(); ok {
+		if err := voucherbatch.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "VoucherBatch.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vbu *VoucherBatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := vbu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voucherbatch.Table, voucherbatch.Columns, sqlgraph.NewFieldSpec(voucherbatch.FieldID, field.TypeInt))
+	if ps := vbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vbu.mutation.Name(); ok {
+		_spec.SetField(voucherbatch.FieldName, field.TypeString, value)
+	}
+	if value, ok := vbu.mutation.Amount(); ok {
+		_spec.SetField(voucherbatch.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vbu.mutation.AddedAmount(); ok {
+		_spec.AddField(voucherbatch.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vbu.mutation.Count(); ok {
+		_spec.SetField(voucherbatch.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := vbu.mutation.AddedCount(); ok {
+		_spec.AddField(voucherbatch.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := vbu.mutation.ExpiresAt(); ok {
+		_spec.SetField(voucherbatch.FieldExpiresAt, field.TypeTime, value)
+	}
+	if vbu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(voucherbatch.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := vbu.mutation.CreatedBy(); ok {
+		_spec.SetField(voucherbatch.FieldCreatedBy, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voucherbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vbu.mutation.done = true
+	return n, nil
+}
+
+// VoucherBatchUpdateOne is the builder for updating a single VoucherBatch entity.
+type VoucherBatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VoucherBatchMutation
+}
+
+// SetName sets the "name" field.
+func (vbuo *VoucherBatchUpdateOne) SetName(s string) *VoucherBatchUpdateOne {
+	vbuo.mutation.SetName(s)
+	return vbuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (vbuo *VoucherBatchUpdateOne) SetNillableName(s *string) *VoucherBatchUpdateOne {
+	if s != nil {
+		vbuo.SetName(*s)
+	}
+	return vbuo
+}
+
+// SetAmount sets the "amount" field.
+func (vbuo *VoucherBatchUpdateOne) SetAmount(f float64) *VoucherBatchUpdateOne {
+	vbuo.mutation.ResetAmount()
+	vbuo.mutation.SetAmount(f)
+	return vbuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (vbuo *VoucherBatchUpdateOne) SetNillableAmount(f *float64) *VoucherBatchUpdateOne {
+	if f != nil {
+		vbuo.SetAmount(*f)
+	}
+	return vbuo
+}
+
+// AddAmount adds f to the "amount" field.
+func (vbuo *VoucherBatchUpdateOne) AddAmount(f float64) *VoucherBatchUpdateOne {
+	vbuo.mutation.AddAmount(f)
+	return vbuo
+}
+
+// SetCount sets the "count" field.
+func (vbuo *VoucherBatchUpdateOne) SetCount(i int) *VoucherBatchUpdateOne {
+	vbuo.mutation.ResetCount()
+	vbuo.mutation.SetCount(i)
+	return vbuo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (vbuo *VoucherBatchUpdateOne) SetNillableCount(i *int) *VoucherBatchUpdateOne {
+	if i != nil {
+		vbuo.SetCount(*i)
+	}
+	return vbuo
+}
+
+// AddCount adds i to the "count" field.
+func (vbuo *VoucherBatchUpdateOne) AddCount(i int) *VoucherBatchUpdateOne {
+	vbuo.mutation.AddCount(i)
+	return vbuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (vbuo *VoucherBatchUpdateOne) SetExpiresAt(t time.Time) *VoucherBatchUpdateOne {
+	vbuo.mutation.SetExpiresAt(t)
+	return vbuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (vbuo *VoucherBatchUpdateOne) SetNillableExpiresAt(t *time.Time) *VoucherBatchUpdateOne {
+	if t != nil {
+		vbuo.SetExpiresAt(*t)
+	}
+	return vbuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (vbuo *VoucherBatchUpdateOne) ClearExpiresAt() *VoucherBatchUpdateOne {
+	vbuo.mutation.ClearExpiresAt()
+	return vbuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (vbuo *VoucherBatchUpdateOne) SetCreatedBy(s string) *VoucherBatchUpdateOne {
+	vbuo.mutation.SetCreatedBy(s)
+	return vbuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (vbuo *VoucherBatchUpdateOne) SetNillableCreatedBy(s *string) *VoucherBatchUpdateOne {
+	if s != nil {
+		vbuo.SetCreatedBy(*s)
+	}
+	return vbuo
+}
+
+// Mutation returns the VoucherBatchMutation object of the builder.
+func (vbuo *VoucherBatchUpdateOne) Mutation() *VoucherBatchMutation {
+	return vbuo.mutation
+}
+
+// Where appends a list predicates to the VoucherBatchUpdate builder.
+func (vbuo *VoucherBatchUpdateOne) Where(ps ...predicate.VoucherBatch) *VoucherBatchUpdateOne {
+	vbuo.mutation.Where(ps...)
+	return vbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vbuo *VoucherBatchUpdateOne) Select(field string, fields ...string) *VoucherBatchUpdateOne {
+	vbuo.fields = append([]string{field}, fields...)
+	return vbuo
+}
+
+// Save executes the query and returns the updated VoucherBatch entity.
+func (vbuo *VoucherBatchUpdateOne) Save(ctx context.Context) (*VoucherBatch, error) {
+	return withHooks(ctx, vbuo.sqlSave, vbuo.mutation, vbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vbuo *VoucherBatchUpdateOne) SaveX(ctx context.Context) *VoucherBatch {
+	node, err := vbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vbuo *VoucherBatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := vbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vbuo *VoucherBatchUpdateOne) ExecX(ctx context.Context) {
+	if err := vbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vbuo *VoucherBatchUpdateOne) check() error {
+	if v, ok := vbuo.mutation.Name(); ok {
+		if err := voucherbatch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "VoucherBatch.name": %w`, err)}
+		}
+	}
+	if v, ok := vbuo.mutation.CreatedBy(); ok {
+		if err := voucherbatch.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "VoucherBatch.created_by": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (vbuo *VoucherBatchUpdateOne) sqlSave(ctx context.Context) (_node *VoucherBatch, err error) {
+	if err := vbuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(voucherbatch.Table, voucherbatch.Columns, sqlgraph.NewFieldSpec(voucherbatch.FieldID, field.TypeInt))
+	id, ok := vbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VoucherBatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := vbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, voucherbatch.FieldID)
+		for _, f := range fields {
+			if !voucherbatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != voucherbatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := vbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := vbuo.mutation.Name(); ok {
+		_spec.SetField(voucherbatch.FieldName, field.TypeString, value)
+	}
+	if value, ok := vbuo.mutation.Amount(); ok {
+		_spec.SetField(voucherbatch.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vbuo.mutation.AddedAmount(); ok {
+		_spec.AddField(voucherbatch.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := vbuo.mutation.Count(); ok {
+		_spec.SetField(voucherbatch.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := vbuo.mutation.AddedCount(); ok {
+		_spec.AddField(voucherbatch.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := vbuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(voucherbatch.FieldExpiresAt, field.TypeTime, value)
+	}
+	if vbuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(voucherbatch.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := vbuo.mutation.CreatedBy(); ok {
+		_spec.SetField(voucherbatch.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &VoucherBatch{config: vbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, vbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{voucherbatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	vbuo.mutation.done = true
+	return _node, nil
+}
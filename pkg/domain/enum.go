@@ -15,6 +15,7 @@ var (
 	NotificationTypeUpdateNumNotifications        = NotificationType{"update_num_notifs"}
 	NotificationTypePlatformUpdate                = NotificationType{"platform_update"}
 	NotificationTypePaymentFailed                 = NotificationType{"payment_failed"}
+	NotificationTypeBillingReminder               = NotificationType{"billing_reminder"}
 
 	NotificationTypes = enum.New(
 		NotificationTypeNewPrivateMessage,
@@ -24,6 +25,7 @@ var (
 		NotificationTypeUpdateNumNotifications,
 		NotificationTypePlatformUpdate,
 		NotificationTypePaymentFailed,
+		NotificationTypeBillingReminder,
 	)
 )
 
@@ -0,0 +1,418 @@
+// Package accounting is an RFC 2866 accounting server that keeps radacct up to date, for networks
+// that do not run FreeRADIUS accounting. Rows are keyed by acctuniqueid, worked out the way
+// FreeRADIUS does, so both can feed the same table.
+package accounting
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/radacct"
+	"github.com/mikestefanello/pagoda/pkg/repos/pubsub"
+	"github.com/mikestefanello/pagoda/pkg/repos/radius"
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
+	"github.com/rs/zerolog/log"
+)
+
+// Topic receives an event for every session started, updated or stopped. UserTopic receives the
+// events of one client.
+const Topic = "radacct"
+
+// Event types published for a session
+const (
+	EventStart  = "session-start"
+	EventUpdate = "session-update"
+	EventStop   = "session-stop"
+)
+
+// terminateNASReboot is the Acct-Terminate-Cause recorded on sessions closed by Accounting-On or
+// Accounting-Off, which a NAS sends when it restarts and has lost its sessions
+const terminateNASReboot = "NAS-Reboot"
+
+var (
+	ErrUnknownNAS    = errors.New("no secret configured for nas")
+	ErrBadSignature  = errors.New("accounting request authenticator does not match")
+	ErrInvalidRecord = errors.New("invalid accounting request")
+)
+
+// UserTopic is the topic of one client's session events
+func UserTopic(username string) string {
+	return Topic + "." + username
+}
+
+// Record is what an Accounting-Request says about a session
+type Record struct {
+	Status         uint32
+	Username       string
+	SessionID      string
+	UniqueID       string
+	NAS            netip.Addr
+	FramedIP       netip.Addr
+	InputOctets    int64
+	OutputOctets   int64
+	SessionTime    uint32
+	TerminateCause string
+	// At is when the NAS saw the event, which may be well before the request arrived
+	At time.Time
+}
+
+// Event is a change to a session, as published to subscribers
+type Event struct {
+	Username     string    `json:"username"`
+	SessionID    string    `json:"sessionId"`
+	NAS          string    `json:"nas"`
+	FramedIP     string    `json:"framedIp,omitempty"`
+	InputOctets  int64     `json:"inputOctets"`
+	OutputOctets int64     `json:"outputOctets"`
+	SessionTime  uint32    `json:"sessionTime"`
+	Stopped      bool      `json:"stopped"`
+	At           time.Time `json:"at"`
+}
+
+// Publisher sends session events to subscribers, e.g. the pub/sub client behind SSE
+type Publisher interface {
+	Publish(ctx context.Context, topic string, event pubsub.SSEEvent) error
+}
+
+// ParseRecord reads an Accounting-Request sent from the given address. The NAS is the
+// NAS-IP-Address it reports, or the address it sent from.
+func ParseRecord(p *radius.Packet, from netip.Addr, now time.Time) (Record, error) {
+	status, ok := p.Uint32(radius.TypeAcctStatusType)
+	if !ok {
+		return Record{}, fmt.Errorf("%w: no Acct-Status-Type", ErrInvalidRecord)
+	}
+	r := Record{
+		Status:    status,
+		Username:  p.Text(radius.TypeUserName),
+		SessionID: p.Text(radius.TypeAcctSessionID),
+		NAS:       p.Addr(radius.TypeNASIPAddress),
+		FramedIP:  p.Addr(radius.TypeFramedIPAddress),
+		At:        now,
+	}
+	if !r.NAS.IsValid() {
+		r.NAS = from
+	}
+	if ts, ok := p.Uint32(radius.TypeEventTimestamp); ok {
+		r.At = time.Unix(int64(ts), 0)
+	} else if delay, ok := p.Uint32(radius.TypeAcctDelayTime); ok {
+		r.At = now.Add(-time.Duration(delay) * time.Second)
+	}
+	if status == radius.AcctStatusAccountingOn || status == radius.AcctStatusAccountingOff {
+		return r, nil
+	}
+
+	if r.Username == "" || r.SessionID == "" {
+		return Record{}, fmt.Errorf("%w: no User-Name or Acct-Session-Id", ErrInvalidRecord)
+	}
+	r.UniqueID = uniqueID(p, r.NAS)
+	r.InputOctets = octets(p, radius.TypeAcctInputOctets, radius.TypeAcctInputGigawords)
+	r.OutputOctets = octets(p, radius.TypeAcctOutputOctets, radius.TypeAcctOutputGigawords)
+	r.SessionTime, _ = p.Uint32(radius.TypeAcctSessionTime)
+	if cause, ok := p.Uint32(radius.TypeAcctTerminateCause); ok {
+		r.TerminateCause = radius.TerminateCause(cause)
+	}
+	return r, nil
+}
+
+// uniqueID is the acctuniqueid FreeRADIUS gives a session: the MD5 of User-Name, Acct-Session-Id,
+// NAS-IP-Address, NAS-Identifier, NAS-Port-Id and NAS-Port
+func uniqueID(p *radius.Packet, nas netip.Addr) string {
+	port := ""
+	if n, ok := p.Uint32(radius.TypeNASPort); ok {
+		port = strconv.FormatUint(uint64(n), 10)
+	}
+	sum := md5.Sum([]byte(strings.Join([]string{
+		p.Text(radius.TypeUserName),
+		p.Text(radius.TypeAcctSessionID),
+		nas.String(),
+		p.Text(radius.TypeNASIdentifier),
+		p.Text(radius.TypeNASPortID),
+		port,
+	}, ",")))
+	return hex.EncodeToString(sum[:])
+}
+
+// octets adds the 4 GiB wraps counted by a gigawords attribute to an octets attribute
+func octets(p *radius.Packet, typ, gigawords byte) int64 {
+	n, _ := p.Uint32(typ)
+	g, _ := p.Uint32(gigawords)
+	return int64(g)<<32 | int64(n)
+}
+
+// Server answers Accounting-Requests once they are written to radacct. A NAS whose request is
+// dropped sends it again, so nothing is acknowledged that was not recorded. Each record is rolled
+// up into the usage tables as it comes in; the worker catches up on any that fail.
+type Server struct {
+	orm       *ent.Client
+	usage     *usagerepo.UsageRepo
+	secrets   map[netip.Addr][]byte
+	publisher Publisher
+}
+
+// NewServer builds a server for the configured NASes. Events are only published with a publisher.
+func NewServer(orm *ent.Client, cfg config.RadiusConfig, publisher Publisher) (*Server, error) {
+	s := &Server{
+		orm:       orm,
+		usage:     usagerepo.NewUsageRepo(orm),
+		secrets:   make(map[netip.Addr][]byte, len(cfg.NAS)),
+		publisher: publisher,
+	}
+	for _, n := range cfg.NAS {
+		addr, err := netip.ParseAddr(n.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nas address %q: %w", n.Address, err)
+		}
+		if n.Secret == "" {
+			return nil, fmt.Errorf("nas %s has no secret", addr)
+		}
+		s.secrets[addr] = []byte(n.Secret)
+	}
+	return s, nil
+}
+
+// Serve answers requests on conn until the context is done
+func (s *Server) Serve(ctx context.Context, conn net.PacketConn) error {
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	buf := make([]byte, radius.MaxPacketLen)
+	for {
+		n, from, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		addr, ok := from.(*net.UDPAddr)
+		if !ok {
+			continue
+		}
+		// Requests are handled in order, so the updates of a session are never applied out of turn
+		reply, err := s.Handle(ctx, buf[:n], addr.AddrPort().Addr().Unmap())
+		if err != nil {
+			log.Warn().Err(err).Str("from", from.String()).Msg("dropped accounting request")
+			continue
+		}
+		if _, err := conn.WriteTo(reply, from); err != nil {
+			log.Warn().Err(err).Str("from", from.String()).Msg("could not answer accounting request")
+		}
+	}
+}
+
+// Handle records an encoded Accounting-Request sent from the given address and returns the
+// encoded Accounting-Response to send back
+func (s *Server) Handle(ctx context.Context, b []byte, from netip.Addr) ([]byte, error) {
+	request, err := radius.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+	if request.Code != radius.CodeAccountingRequest {
+		return nil, fmt.Errorf("%w: code %d", ErrInvalidRecord, request.Code)
+	}
+	// The NAS is known by the address it sends from, or the one it reports if it is behind NAT
+	secret, ok := s.secrets[from]
+	if !ok {
+		secret, ok = s.secrets[request.Addr(radius.TypeNASIPAddress)]
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownNAS, from)
+	}
+	if !radius.VerifyRequest(b, secret) {
+		return nil, ErrBadSignature
+	}
+
+	record, err := ParseRecord(request, from, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	switch record.Status {
+	case radius.AcctStatusStart, radius.AcctStatusInterimUpdate, radius.AcctStatusStop:
+		if _, err := s.Record(ctx, record); err != nil {
+			return nil, err
+		}
+	case radius.AcctStatusAccountingOn, radius.AcctStatusAccountingOff:
+		if err := s.closeNAS(ctx, record.NAS, record.At); err != nil {
+			return nil, err
+		}
+	}
+
+	reply := &radius.Packet{Code: radius.CodeAccountingResponse}
+	return reply.EncodeResponse(request, secret)
+}
+
+// Record writes a session's accounting record to radacct, adding the row on the first record seen
+// for it. Records may arrive late or more than once, so counters never go back and a stopped
+// session stays stopped.
+func (s *Server) Record(ctx context.Context, r Record) (*ent.RadAcct, error) {
+	row, err := s.orm.RadAcct.Query().
+		Where(radacct.AcctuniqueidEQ(r.UniqueID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		row, err = s.create(ctx, r)
+		if !ent.IsConstraintError(err) {
+			s.recorded(ctx, r.Status, row)
+			return row, err
+		}
+		// Added by a request for the same session in the meantime
+		row, err = s.orm.RadAcct.Query().
+			Where(radacct.AcctuniqueidEQ(r.UniqueID)).
+			Only(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.orm.RadAcct.UpdateOne(row).
+		SetAcctsessiontime(max(r.SessionTime, deref(row.Acctsessiontime))).
+		SetAcctinputoctets(max(r.InputOctets, deref(row.Acctinputoctets))).
+		SetAcctoutputoctets(max(r.OutputOctets, deref(row.Acctoutputoctets)))
+	if r.FramedIP.IsValid() {
+		update.SetFramedipaddress(r.FramedIP.String())
+	}
+	switch r.Status {
+	case radius.AcctStatusStart:
+		update.SetAcctstarttime(r.At)
+	case radius.AcctStatusInterimUpdate:
+		update.SetAcctupdatetime(r.At)
+	case radius.AcctStatusStop:
+		if row.Acctstoptime == nil {
+			update.
+				SetAcctstoptime(r.At).
+				SetAcctupdatetime(r.At).
+				SetAcctterminatecause(r.TerminateCause)
+		}
+	}
+	if row.Acctstarttime == nil && r.Status != radius.AcctStatusStart {
+		update.SetAcctstarttime(r.At.Add(-time.Duration(r.SessionTime) * time.Second))
+	}
+	row, err = update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.recorded(ctx, r.Status, row)
+	return row, nil
+}
+
+func (s *Server) create(ctx context.Context, r Record) (*ent.RadAcct, error) {
+	create := s.orm.RadAcct.Create().
+		SetAcctsessionid(r.SessionID).
+		SetAcctuniqueid(r.UniqueID).
+		SetUsername(r.Username).
+		SetNasipaddress(r.NAS.String()).
+		SetFramedipaddress("").
+		SetAcctterminatecause("").
+		SetAcctstarttime(r.At.Add(-time.Duration(r.SessionTime) * time.Second)).
+		SetAcctsessiontime(r.SessionTime).
+		SetAcctinputoctets(r.InputOctets).
+		SetAcctoutputoctets(r.OutputOctets)
+	if r.FramedIP.IsValid() {
+		create.SetFramedipaddress(r.FramedIP.String())
+	}
+	switch r.Status {
+	case radius.AcctStatusInterimUpdate:
+		create.SetAcctupdatetime(r.At)
+	case radius.AcctStatusStop:
+		create.
+			SetAcctstoptime(r.At).
+			SetAcctupdatetime(r.At).
+			SetAcctterminatecause(r.TerminateCause)
+	}
+	return create.Save(ctx)
+}
+
+// closeNAS stops the open sessions of a NAS that restarted
+func (s *Server) closeNAS(ctx context.Context, nas netip.Addr, at time.Time) error {
+	rows, err := s.orm.RadAcct.Query().
+		Where(
+			radacct.NasipaddressEQ(nas.String()),
+			radacct.AcctstoptimeIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		update := s.orm.RadAcct.UpdateOne(row).
+			SetAcctstoptime(at).
+			SetAcctterminatecause(terminateNASReboot)
+		if row.Acctstarttime != nil && at.After(*row.Acctstarttime) {
+			update.SetAcctsessiontime(uint32(at.Sub(*row.Acctstarttime) / time.Second))
+		}
+		row, err = update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		s.recorded(ctx, radius.AcctStatusStop, row)
+	}
+	return nil
+}
+
+// recorded rolls up a session's new record and sends a session event to Topic and the client's
+// UserTopic. Neither is what the NAS waits for, so failures are only logged.
+func (s *Server) recorded(ctx context.Context, status uint32, row *ent.RadAcct) {
+	if row == nil {
+		return
+	}
+	if err := s.usage.Rollup(ctx, row); err != nil {
+		log.Warn().Err(err).Str("acctuniqueid", row.Acctuniqueid).Msg("could not roll up session usage")
+	}
+	if s.publisher == nil {
+		return
+	}
+	typ := EventUpdate
+	switch status {
+	case radius.AcctStatusStart:
+		typ = EventStart
+	case radius.AcctStatusStop:
+		typ = EventStop
+	}
+	at := time.Now()
+	for _, t := range []*time.Time{row.Acctstoptime, row.Acctupdatetime, row.Acctstarttime} {
+		if t != nil {
+			at = *t
+			break
+		}
+	}
+	data, err := json.Marshal(Event{
+		Username:     row.Username,
+		SessionID:    row.Acctsessionid,
+		NAS:          row.Nasipaddress,
+		FramedIP:     row.Framedipaddress,
+		InputOctets:  deref(row.Acctinputoctets),
+		OutputOctets: deref(row.Acctoutputoctets),
+		SessionTime:  deref(row.Acctsessiontime),
+		Stopped:      row.Acctstoptime != nil,
+		At:           at,
+	})
+	if err != nil {
+		return
+	}
+	event := pubsub.SSEEvent{Type: typ, Data: string(data)}
+	for _, topic := range []string{Topic, UserTopic(row.Username)} {
+		if err := s.publisher.Publish(ctx, topic, event); err != nil {
+			log.Warn().Err(err).Str("topic", topic).Msg("could not publish session event")
+		}
+	}
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
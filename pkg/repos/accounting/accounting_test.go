@@ -0,0 +1,207 @@
+package accounting_test
+
+import (
+	"context"
+	"database/sql"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent/radacct"
+	"github.com/mikestefanello/pagoda/pkg/repos/accounting"
+	"github.com/mikestefanello/pagoda/pkg/repos/pubsub"
+	"github.com/mikestefanello/pagoda/pkg/repos/radius"
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+const secret = "testing123"
+
+var nas = netip.MustParseAddr("10.0.0.1")
+
+func init() {
+	// Register "pgx" as "postgres" explicitly for database/sql
+	sql.Register("postgres", stdlib.GetDefaultDriver())
+}
+
+type recorder struct {
+	mu     sync.Mutex
+	events map[string][]pubsub.SSEEvent
+}
+
+func (r *recorder) Publish(_ context.Context, topic string, event pubsub.SSEEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.events == nil {
+		r.events = make(map[string][]pubsub.SSEEvent)
+	}
+	r.events[topic] = append(r.events[topic], event)
+	return nil
+}
+
+func request(t *testing.T, key string, status uint32, attrs ...radius.Attribute) []byte {
+	p := &radius.Packet{
+		Code:       radius.CodeAccountingRequest,
+		Identifier: 1,
+		Attributes: append([]radius.Attribute{radius.Integer(radius.TypeAcctStatusType, status)}, attrs...),
+	}
+	b, err := p.EncodeRequest([]byte(key))
+	require.NoError(t, err)
+	return b
+}
+
+func session(username, id string) []radius.Attribute {
+	return []radius.Attribute{
+		radius.String(radius.TypeUserName, username),
+		radius.String(radius.TypeAcctSessionID, id),
+		radius.IPv4(radius.TypeNASIPAddress, nas),
+		radius.Integer(radius.TypeNASPort, 15728640),
+	}
+}
+
+func TestParseRecord(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	p := &radius.Packet{
+		Code: radius.CodeAccountingRequest,
+		Attributes: append(session("alice", "81a00001"),
+			radius.Integer(radius.TypeAcctStatusType, radius.AcctStatusInterimUpdate),
+			radius.IPv4(radius.TypeFramedIPAddress, netip.MustParseAddr("100.64.0.9")),
+			radius.Integer(radius.TypeAcctInputOctets, 100),
+			radius.Integer(radius.TypeAcctInputGigawords, 2),
+			radius.Integer(radius.TypeAcctOutputOctets, 50),
+			radius.Integer(radius.TypeAcctSessionTime, 600),
+			radius.Integer(radius.TypeAcctDelayTime, 5),
+		),
+	}
+	r, err := accounting.ParseRecord(p, netip.MustParseAddr("192.0.2.1"), now)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", r.Username)
+	assert.Equal(t, nas, r.NAS)
+	assert.Equal(t, int64(2<<32+100), r.InputOctets)
+	assert.Equal(t, int64(50), r.OutputOctets)
+	assert.Equal(t, uint32(600), r.SessionTime)
+	assert.Equal(t, now.Add(-5*time.Second), r.At)
+	// md5("alice,81a00001,10.0.0.1,,,15728640"), as FreeRADIUS would store it
+	assert.Len(t, r.UniqueID, 32)
+
+	same, err := accounting.ParseRecord(p, nas, now)
+	require.NoError(t, err)
+	assert.Equal(t, r.UniqueID, same.UniqueID)
+	other, err := accounting.ParseRecord(&radius.Packet{Attributes: append(session("alice", "81a00002"),
+		radius.Integer(radius.TypeAcctStatusType, radius.AcctStatusStart))}, nas, now)
+	require.NoError(t, err)
+	assert.NotEqual(t, r.UniqueID, other.UniqueID)
+
+	// Without a NAS-IP-Address the NAS is where the request came from
+	p.Attributes = append(p.Attributes[:2], p.Attributes[3:]...)
+	r, err = accounting.ParseRecord(p, netip.MustParseAddr("192.0.2.1"), now)
+	require.NoError(t, err)
+	assert.Equal(t, netip.MustParseAddr("192.0.2.1"), r.NAS)
+
+	_, err = accounting.ParseRecord(&radius.Packet{Attributes: []radius.Attribute{
+		radius.Integer(radius.TypeAcctStatusType, radius.AcctStatusStart),
+	}}, nas, now)
+	assert.ErrorIs(t, err, accounting.ErrInvalidRecord)
+}
+
+func TestHandleRejects(t *testing.T) {
+	server, err := accounting.NewServer(nil, config.RadiusConfig{
+		NAS: []config.NASConfig{{Address: nas.String(), Secret: secret}},
+	}, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = server.Handle(ctx, request(t, "wrong", radius.AcctStatusStart, session("alice", "1")...), nas)
+	assert.ErrorIs(t, err, accounting.ErrBadSignature)
+
+	b := request(t, secret, radius.AcctStatusStart,
+		radius.String(radius.TypeUserName, "alice"), radius.String(radius.TypeAcctSessionID, "1"))
+	_, err = server.Handle(ctx, b, netip.MustParseAddr("10.9.9.9"))
+	assert.ErrorIs(t, err, accounting.ErrUnknownNAS)
+}
+
+func TestHandle(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	events := &recorder{}
+	server, err := accounting.NewServer(client, config.RadiusConfig{
+		NAS: []config.NASConfig{{Address: nas.String(), Secret: secret}},
+	}, events)
+	require.NoError(t, err)
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	b := request(t, secret, radius.AcctStatusStart, append(session("alice", "1"),
+		radius.IPv4(radius.TypeFramedIPAddress, netip.MustParseAddr("100.64.0.9")),
+		radius.Integer(radius.TypeEventTimestamp, uint32(start.Unix())))...)
+	reply, err := server.Handle(ctx, b, nas)
+	require.NoError(t, err)
+	decoded, err := radius.Decode(b)
+	require.NoError(t, err)
+	assert.True(t, radius.VerifyResponse(reply, decoded, []byte(secret)))
+
+	row := client.RadAcct.Query().Where(radacct.UsernameEQ("alice")).OnlyX(ctx)
+	assert.Equal(t, "100.64.0.9", row.Framedipaddress)
+	assert.Nil(t, row.Acctstoptime)
+
+	// A resent start changes nothing, an interim update moves the counters on
+	_, err = server.Handle(ctx, b, nas)
+	require.NoError(t, err)
+	_, err = server.Handle(ctx, request(t, secret, radius.AcctStatusInterimUpdate, append(session("alice", "1"),
+		radius.Integer(radius.TypeAcctInputOctets, 1000),
+		radius.Integer(radius.TypeAcctOutputOctets, 4000),
+		radius.Integer(radius.TypeAcctSessionTime, 1800))...), nas)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.RadAcct.Query().CountX(ctx))
+
+	// A late interim update does not move them back
+	_, err = server.Handle(ctx, request(t, secret, radius.AcctStatusInterimUpdate, append(session("alice", "1"),
+		radius.Integer(radius.TypeAcctInputOctets, 10),
+		radius.Integer(radius.TypeAcctSessionTime, 300))...), nas)
+	require.NoError(t, err)
+	row = client.RadAcct.GetX(ctx, row.ID)
+	assert.Equal(t, int64(1000), *row.Acctinputoctets)
+	assert.Equal(t, int64(4000), *row.Acctoutputoctets)
+	assert.NotNil(t, row.Acctupdatetime)
+
+	_, err = server.Handle(ctx, request(t, secret, radius.AcctStatusStop, append(session("alice", "1"),
+		radius.Integer(radius.TypeAcctInputOctets, 2000),
+		radius.Integer(radius.TypeAcctSessionTime, 3600),
+		radius.Integer(radius.TypeAcctTerminateCause, 1))...), nas)
+	require.NoError(t, err)
+	row = client.RadAcct.GetX(ctx, row.ID)
+	require.NotNil(t, row.Acctstoptime)
+	assert.Equal(t, "User-Request", row.Acctterminatecause)
+	assert.Equal(t, int64(2000), *row.Acctinputoctets)
+
+	// Each record was rolled up into usage as it came in
+	usage, err := usagerepo.NewUsageRepo(client).Usage(ctx, "alice", start, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, usagerepo.Totals{Input: 2000, Output: 4000}, usage)
+
+	// A stop for a session never seen starting adds it, backdated by its session time
+	_, err = server.Handle(ctx, request(t, secret, radius.AcctStatusStop, append(session("bob", "2"),
+		radius.Integer(radius.TypeAcctSessionTime, 60))...), nas)
+	require.NoError(t, err)
+	bob := client.RadAcct.Query().Where(radacct.UsernameEQ("bob")).OnlyX(ctx)
+	require.NotNil(t, bob.Acctstarttime)
+	assert.Equal(t, time.Minute, bob.Acctstoptime.Sub(*bob.Acctstarttime))
+
+	// Accounting-On closes what was left open on the NAS
+	_, err = server.Handle(ctx, request(t, secret, radius.AcctStatusStart, session("carol", "3")...), nas)
+	require.NoError(t, err)
+	_, err = server.Handle(ctx, request(t, secret, radius.AcctStatusAccountingOn,
+		radius.IPv4(radius.TypeNASIPAddress, nas)), nas)
+	require.NoError(t, err)
+	assert.Zero(t, client.RadAcct.Query().Where(radacct.AcctstoptimeIsNil()).CountX(ctx))
+
+	assert.Len(t, events.events[accounting.UserTopic("alice")], 5)
+	assert.Equal(t, accounting.EventStop, events.events[accounting.UserTopic("carol")][1].Type)
+	assert.Len(t, events.events[accounting.Topic], 8)
+}
@@ -0,0 +1,238 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	createdByAutoRenewal = "auto-renewal"
+	// usernameBatchSize bounds how many clients are loaded at once, and the IN clause when loading
+	// their expiries by username
+	usernameBatchSize = 500
+)
+
+// AutoRenewalSummary describes one run of AutoRenew
+type AutoRenewalSummary struct {
+	// Due is the number of auto renew clients whose package expires within the window
+	Due int `json:"due"`
+	// Renewed counts the packages renewed by this run, Switched those that also moved to a
+	// package scheduled for the next cycle
+	Renewed  int `json:"renewed"`
+	Switched int `json:"switched"`
+	// Skipped counts clients renewed by someone else since they were found, or without a package
+	Skipped           int     `json:"skipped"`
+	InsufficientFunds int     `json:"insufficient_funds"`
+	Failed            int     `json:"failed"`
+	Collected         float64 `json:"collected"`
+	// Unfunded lists the clients found short of funds for the first time this cycle. Later runs
+	// in the same cycle count them in InsufficientFunds but leave them out here, so each client
+	// is notified once per cycle.
+	Unfunded []UnfundedRenewal `json:"-"`
+}
+
+// UnfundedRenewal is a package that could not be renewed for lack of balance
+type UnfundedRenewal struct {
+	Client  *ent.ClientUser
+	Package *ent.PackagePlan
+	// Amount is what renewing costs, tax included
+	Amount float64
+	Expiry time.Time
+}
+
+// DueRenewal is an auto renew client whose package expires soon
+type DueRenewal struct {
+	Client *ent.ClientUser
+	Expiry time.Time
+}
+
+// AutoRenew renews the packages of auto renew clients expiring before now+window, recording them
+// as AUTO_RENEWAL transactions. Runs are idempotent: each renewal is tied to the expiry it was
+// found with, so a package renewed since (by the client or an overlapping run) is skipped.
+//
+// A client short of funds gets a failed AUTO_RENEWAL transaction for that cycle, which both
+// shows up in their history and keeps later runs from reporting them as newly unfunded.
+func (b *BillingRepo) AutoRenew(ctx context.Context, window time.Duration) (*AutoRenewalSummary, error) {
+	due, err := b.DueRenewals(ctx, time.Now().Add(window))
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &AutoRenewalSummary{Due: len(due)}
+	for _, d := range due {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		expiry := d.Expiry
+		renewal, err := b.RenewPackage(ctx, d.Client.ID, RenewOptions{
+			Type:           clienttxn.TypeAUTO_RENEWAL,
+			CreatedBy:      createdByAutoRenewal,
+			VerifyExpiry:   true,
+			ExpectedExpiry: &expiry,
+		})
+		switch {
+		case err == nil:
+			summary.Renewed++
+			summary.Collected = RoundAmount(summary.Collected + renewal.Txn.Amount)
+			if renewal.Switched {
+				summary.Switched++
+			}
+		case errors.Is(err, ErrAlreadyRenewed), errors.Is(err, ErrNoPackage), errors.Is(err, ErrClientNotFound):
+			summary.Skipped++
+		case errors.Is(err, ErrInsufficientBalance):
+			summary.InsufficientFunds++
+			unfunded, err := b.recordUnfundedRenewal(ctx, d)
+			if err != nil {
+				log.Error().Err(err).Str("username", d.Client.Username).Msg("failed to record unfunded auto renewal")
+			} else if unfunded != nil {
+				summary.Unfunded = append(summary.Unfunded, *unfunded)
+			}
+		default:
+			summary.Failed++
+			log.Error().Err(err).Str("username", d.Client.Username).Msg("auto renewal failed")
+		}
+	}
+	return summary, nil
+}
+
+// DueRenewals lists the auto renew clients whose package expires before the given time, soonest
+// first. Expired packages are included, so a client who tops up after expiry is renewed by the next
+// run. Clients in a grace period are due from the expiry they paid for, not the radcheck
+// Expiration their grace period pushed.
+func (b *BillingRepo) DueRenewals(ctx context.Context, before time.Time) ([]DueRenewal, error) {
+	graced, err := b.gracedExpiries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var due []DueRenewal
+	err = b.clientBatches(ctx, func(clients []*ent.ClientUser) error {
+		expiries, err := radiusrepo.NewRadiusRepo(b.orm).Expiries(ctx, usernamesOf(clients), before)
+		if err != nil {
+			return err
+		}
+		for _, client := range clients {
+			expiry, ok := graced[client.Username]
+			if !ok {
+				expiry, ok = expiries[client.Username]
+			}
+			if ok {
+				due = append(due, DueRenewal{Client: client, Expiry: expiry})
+			}
+		}
+		return nil
+	}, clientuser.AutoRenewEQ(true), clientuser.BillingModeEQ(clientuser.BillingModePrepaid))
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(due, func(a, b DueRenewal) int {
+		if c := a.Expiry.Compare(b.Expiry); c != 0 {
+			return c
+		}
+		return a.Client.ID - b.Client.ID
+	})
+	return due, nil
+}
+
+// gracedExpiries returns the expiry each client in a grace period paid for, by username
+func (b *BillingRepo) gracedExpiries(ctx context.Context) (map[string]time.Time, error) {
+	open, err := b.orm.GracePeriod.Query().
+		Where(graceperiod.EndedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	expiries := make(map[string]time.Time, len(open))
+	for _, grace := range open {
+		expiries[grace.ClientUsername] = grace.ExpiredAt
+	}
+	return expiries, nil
+}
+
+// clientBatches calls fn with the clients matching the predicates, usernameBatchSize at a time
+// in ID order, stopping at the first error
+func (b *BillingRepo) clientBatches(
+	ctx context.Context, fn func(clients []*ent.ClientUser) error, ps ...predicate.ClientUser,
+) error {
+	lastID := 0
+	for {
+		clients, err := b.orm.ClientUser.Query().
+			Where(ps...).
+			Where(clientuser.IDGT(lastID)).
+			Order(ent.Asc(clientuser.FieldID)).
+			Limit(usernameBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(clients) > 0 {
+			if err := fn(clients); err != nil {
+				return err
+			}
+		}
+		if len(clients) < usernameBatchSize {
+			return nil
+		}
+		lastID = clients[len(clients)-1].ID
+	}
+}
+
+// usernamesOf returns the usernames of the clients
+func usernamesOf(clients []*ent.ClientUser) []string {
+	usernames := make([]string, 0, len(clients))
+	for _, client := range clients {
+		usernames = append(usernames, client.Username)
+	}
+	return usernames
+}
+
+// recordUnfundedRenewal writes a failed AUTO_RENEWAL transaction for the cycle, returning nil if
+// one was already recorded by an earlier run
+func (b *BillingRepo) recordUnfundedRenewal(ctx context.Context, d DueRenewal) (*UnfundedRenewal, error) {
+	client, err := b.orm.ClientUser.Get(ctx, d.Client.ID)
+	if err != nil {
+		return nil, err
+	}
+	plan, _, err := renewalPackage(ctx, b.orm, client)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := d.Expiry
+	price := b.PackagePrice(plan)
+	_, err = b.orm.ClientTxn.Create().
+		SetTransactionRef(unfundedRenewalRef(client.ID, &expiry)).
+		SetAmount(price).
+		SetType(clienttxn.TypeAUTO_RENEWAL).
+		SetStatus(clienttxn.StatusFailed).
+		SetTotalBalance(RoundAmount(client.Balance)).
+		SetPaymentMethod(clienttxn.PaymentMethodClientBalance).
+		SetClientUsername(client.Username).
+		SetDescription(fmt.Sprintf("Auto renewal of %s failed: insufficient balance", plan.Name)).
+		SetCreatedBy(createdByAutoRenewal).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &UnfundedRenewal{Client: client, Package: plan, Amount: price, Expiry: d.Expiry}, nil
+}
+
+// unfundedRenewalRef identifies a failed auto renewal of the cycle that starts at the given expiry
+func unfundedRenewalRef(clientID int, expiry *time.Time) string {
+	return fmt.Sprintf("ARF-%d-%s", clientID, cycleKey(expiry))
+}
@@ -0,0 +1,105 @@
+package billingrepo_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestAutoRenew(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	radiusRepo := radiusrepo.NewRadiusRepo(client)
+	subscriber := func(username string, balance float64, autoRenew bool, expiry time.Time) int {
+		clientUser := tests.CreateSubscriber(ctx, client, username, balance, plan).
+			Update().
+			SetAutoRenew(autoRenew).
+			SaveX(ctx)
+		require.NoError(t, radiusRepo.SetExpiry(ctx, username, expiry))
+		return clientUser.ID
+	}
+	soon := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	funded := subscriber("auto1", 1000, true, soon)
+	unfunded := subscriber("auto2", 100, true, soon)
+	subscriber("auto3", 1000, false, soon)
+	subscriber("auto4", 1000, true, soon.AddDate(0, 0, 10))
+
+	summary, err := billingRepo.AutoRenew(ctx, 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.Due)
+	assert.Equal(t, 1, summary.Renewed)
+	assert.Equal(t, 1, summary.InsufficientFunds)
+	assert.Equal(t, 300.0, summary.Collected)
+	require.Len(t, summary.Unfunded, 1)
+	assert.Equal(t, unfunded, summary.Unfunded[0].Client.ID)
+
+	txn := client.ClientTxn.Query().Where(clienttxn.ClientUsernameEQ("auto1")).OnlyX(ctx)
+	assert.Equal(t, clienttxn.TypeAUTO_RENEWAL, txn.Type)
+	assert.Equal(t, clienttxn.StatusCompleted, txn.Status)
+	assert.Equal(t, 700.0, client.ClientUser.GetX(ctx, funded).Balance)
+	txn = client.ClientTxn.Query().Where(clienttxn.ClientUsernameEQ("auto2")).OnlyX(ctx)
+	assert.Equal(t, clienttxn.StatusFailed, txn.Status)
+	assert.Equal(t, 100.0, client.ClientUser.GetX(ctx, unfunded).Balance)
+
+	// The next run leaves the renewed client alone and does not report the unfunded one again
+	summary, err = billingRepo.AutoRenew(ctx, 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Due)
+	assert.Zero(t, summary.Renewed)
+	assert.Equal(t, 1, summary.InsufficientFunds)
+	assert.Empty(t, summary.Unfunded)
+	assert.Equal(t, 1, client.ClientTxn.Query().Where(clienttxn.ClientUsernameEQ("auto1")).CountX(ctx))
+	assert.Equal(t, 1, client.ClientTxn.Query().Where(clienttxn.ClientUsernameEQ("auto2")).CountX(ctx))
+
+	// Once topped up, the expired client is renewed by the next run
+	client.ClientUser.UpdateOneID(unfunded).AddBalance(200).ExecX(ctx)
+	summary, err = billingRepo.AutoRenew(ctx, 24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Renewed)
+	assert.Zero(t, client.ClientUser.GetX(ctx, unfunded).Balance)
+}
+
+func TestAutoRenewOverlappingRuns(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300)
+	clientUser := tests.CreateSubscriber(ctx, client, "overlap1", 1000, plan).
+		Update().
+		SetAutoRenew(true).
+		SaveX(ctx)
+	require.NoError(t, radiusrepo.NewRadiusRepo(client).SetExpiry(ctx, clientUser.Username, time.Now().Add(time.Hour)))
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+
+	// A slow run still going when the next one is scheduled must not renew the same cycle twice
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := billingRepo.AutoRenew(ctx, 24*time.Hour)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 700.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	assert.Equal(t, 1, client.ClientTxn.Query().
+		Where(
+			clienttxn.ClientUsernameEQ(clientUser.Username),
+			clienttxn.TypeEQ(clienttxn.TypeAUTO_RENEWAL),
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+		).
+		CountX(ctx))
+}
@@ -0,0 +1,267 @@
+package billingrepo
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	createdBySelfCare = "self-care"
+	defaultCycleDays  = 30
+)
+
+var (
+	ErrTxnNotFound    = errors.New("transaction not found")
+	ErrAmountMismatch = errors.New("paid amount does not match the transaction amount")
+	ErrInvalidAmount  = errors.New("amount must be greater than zero")
+)
+
+// BillingRepo owns every change to a client's balance. Each change is written together with the
+// ClientTxn that explains it, and ClientTxn.total_balance always holds the balance right after it.
+type BillingRepo struct {
+	orm       *ent.Client
+	cycleDays int
+	tax       Tax
+	sessions  SessionUpdater
+	// voucherSecret keys the hashes voucher PINs are stored as
+	voucherSecret string
+}
+
+func NewBillingRepo(orm *ent.Client, cycleDays int) *BillingRepo {
+	if cycleDays <= 0 {
+		cycleDays = defaultCycleDays
+	}
+	return &BillingRepo{
+		orm:       orm,
+		cycleDays: cycleDays,
+	}
+}
+
+// StartTopUp records a pending recharge for a client about to pay through a gateway.
+// The balance is only credited once the gateway confirms the payment in CompleteTopUp.
+func (b *BillingRepo) StartTopUp(
+	ctx context.Context, client *ent.ClientUser, amount float64, method clienttxn.PaymentMethod,
+) (*ent.ClientTxn, error) {
+	amount = RoundAmount(amount)
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	return b.orm.ClientTxn.
+		Create().
+		SetTransactionRef(NewTransactionRef("TOP")).
+		SetAmount(amount).
+		SetType(clienttxn.TypeRECHARGE).
+		SetStatus(clienttxn.StatusPending).
+		SetTotalBalance(client.Balance).
+		SetPaymentMethod(method).
+		SetClientUsername(client.Username).
+		SetDescription(fmt.Sprintf("Balance recharge via %s", method)).
+		SetCreatedBy(createdBySelfCare).
+		Save(ctx)
+}
+
+// CompleteTopUp credits the client for a verified gateway payment and marks the recharge completed.
+// Only a recharge started for the payment method of the confirming gateway can be completed.
+// Gateways may deliver both a browser callback and an IPN for the same payment, so repeated calls
+// are no-ops that return the already completed transaction.
+func (b *BillingRepo) CompleteTopUp(
+	ctx context.Context, method clienttxn.PaymentMethod, ref, gatewayRef string, paidAmount float64,
+) (*ent.ClientTxn, error) {
+	var completed *ent.ClientTxn
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		var err error
+		completed, err = completeTopUp(ctx, tx, method, ref, gatewayRef, paidAmount)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.afterPayment(ctx, completed.ClientUsername)
+	return completed, nil
+}
+
+// afterPayment settles what a payment covers: the unpaid invoices of a postpaid client, or the
+// expired package of a prepaid one in a grace period. Failures are only logged since the payment
+// itself went through.
+func (b *BillingRepo) afterPayment(ctx context.Context, username string) {
+	client, err := b.orm.ClientUser.Query().
+		Where(clientuser.UsernameEQ(username)).
+		Only(ctx)
+	if err == nil {
+		if client.BillingMode == clientuser.BillingModePostpaid {
+			_, err = b.SettleInvoices(ctx, username)
+		} else {
+			_, err = b.resumeService(ctx, client)
+		}
+	}
+	if err != nil {
+		log.Error().Err(err).Str("username", username).Msg("failed to settle payment")
+	}
+}
+
+// completeTopUp is CompleteTopUp inside an existing transaction
+func completeTopUp(
+	ctx context.Context, tx *ent.Tx, method clienttxn.PaymentMethod, ref, gatewayRef string, paidAmount float64,
+) (*ent.ClientTxn, error) {
+	// Any other transaction is unknown to the gateway, even if the reference exists
+	txn, err := tx.ClientTxn.Query().
+		Where(
+			clienttxn.TransactionRefEQ(ref),
+			clienttxn.TypeEQ(clienttxn.TypeRECHARGE),
+			clienttxn.PaymentMethodEQ(method),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrTxnNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if txn.Status == clienttxn.StatusCompleted {
+		return txn, nil
+	}
+	if math.Abs(RoundAmount(paidAmount)-txn.Amount) >= 0.01 {
+		return nil, ErrAmountMismatch
+	}
+
+	from := clienttxn.StatusPending
+	if txn.Status == clienttxn.StatusFailed {
+		// The gateway reported a failure and then confirmed the payment after all. The money was
+		// taken, so the client is credited, but it is logged since it needs to be reconciled.
+		log.Warn().
+			Str("ref", txn.TransactionRef).
+			Str("gateway_ref", gatewayRef).
+			Str("username", txn.ClientUsername).
+			Msg("late success for a failed recharge")
+		from = clienttxn.StatusFailed
+	}
+
+	// Claim the transaction; a concurrent callback that loses this race leaves the balance alone.
+	// Reversed recharges were refunded and are never claimed.
+	claimed, err := tx.ClientTxn.Update().
+		Where(
+			clienttxn.IDEQ(txn.ID),
+			clienttxn.StatusEQ(from),
+		).
+		SetStatus(clienttxn.StatusCompleted).
+		SetGatewayRef(gatewayRef).
+		SetTransactionDate(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claimed == 0 {
+		return tx.ClientTxn.Get(ctx, txn.ID)
+	}
+
+	balance, err := credit(ctx, tx, txn.ClientUsername, txn.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.ClientTxn.UpdateOneID(txn.ID).
+		SetTotalBalance(balance).
+		Save(ctx)
+}
+
+// FailTopUp marks a pending recharge as failed. Completed recharges are never downgraded.
+func (b *BillingRepo) FailTopUp(ctx context.Context, ref, gatewayRef string) error {
+	update := b.orm.ClientTxn.Update().
+		Where(
+			clienttxn.TransactionRefEQ(ref),
+			clienttxn.StatusEQ(clienttxn.StatusPending),
+		).
+		SetStatus(clienttxn.StatusFailed)
+	if gatewayRef != "" {
+		update.SetGatewayRef(gatewayRef)
+	}
+	_, err := update.Save(ctx)
+	return err
+}
+
+// GetClientTxn returns a transaction by reference, scoped to the given client
+func (b *BillingRepo) GetClientTxn(ctx context.Context, username, ref string) (*ent.ClientTxn, error) {
+	txn, err := b.orm.ClientTxn.Query().
+		Where(
+			clienttxn.TransactionRefEQ(ref),
+			clienttxn.ClientUsernameEQ(username),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrTxnNotFound
+	}
+	return txn, err
+}
+
+// credit adds amount to a client's balance and returns the resulting balance
+func credit(ctx context.Context, tx *ent.Tx, username string, amount float64) (float64, error) {
+	n, err := tx.ClientUser.Update().
+		Where(clientuser.UsernameEQ(username)).
+		AddBalance(amount).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("client %q not found", username)
+	}
+	return balanceOf(ctx, tx, username)
+}
+
+// balanceOf reads a client's balance inside a transaction
+func balanceOf(ctx context.Context, tx *ent.Tx, username string) (float64, error) {
+	client, err := tx.ClientUser.Query().
+		Where(clientuser.UsernameEQ(username)).
+		Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return RoundAmount(client.Balance), nil
+}
+
+// WithTx runs fn inside a database transaction, committing only if fn succeeds
+func WithTx(ctx context.Context, orm *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := orm.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			log.Error().Err(rerr).Msg("failed to rollback billing transaction")
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// NewTransactionRef generates a unique transaction reference such as TOP-20251114-3F9A1C2B
+func NewTransactionRef(prefix string) string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("%s-%s-%s", prefix, time.Now().Format("20060102"), strings.ToUpper(hex.EncodeToString(b)))
+}
+
+// RoundAmount rounds an amount to two decimals
+func RoundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,130 @@
+package billingrepo_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func init() {
+	// Register "pgx" as "postgres" explicitly for database/sql
+	sql.Register("postgres", stdlib.GetDefaultDriver())
+}
+
+func TestTopUp(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "topup1", 100)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+
+	txn, err := billingRepo.StartTopUp(ctx, clientUser, 500, clienttxn.PaymentMethodGatewayBkash)
+	require.NoError(t, err)
+	assert.Equal(t, clienttxn.StatusPending, txn.Status)
+	assert.Equal(t, clienttxn.TypeRECHARGE, txn.Type)
+
+	// A pending recharge does not touch the balance
+	clientUser = client.ClientUser.GetX(ctx, clientUser.ID)
+	assert.Equal(t, 100.0, clientUser.Balance)
+
+	_, err = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayBkash, txn.TransactionRef, "TRX1", 499)
+	assert.ErrorIs(t, err, billingrepo.ErrAmountMismatch)
+
+	// Callbacks and IPNs may arrive together, only one of them may credit the balance
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayBkash, txn.TransactionRef, "TRX1", 500)
+		}()
+	}
+	wg.Wait()
+
+	completed, err := billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayBkash, txn.TransactionRef, "TRX1", 500)
+	require.NoError(t, err)
+	assert.Equal(t, clienttxn.StatusCompleted, completed.Status)
+	assert.Equal(t, "TRX1", completed.GatewayRef)
+	assert.Equal(t, 600.0, completed.TotalBalance)
+
+	clientUser = client.ClientUser.GetX(ctx, clientUser.ID)
+	assert.Equal(t, 600.0, clientUser.Balance)
+
+	// A late failure notification never downgrades a completed recharge
+	require.NoError(t, billingRepo.FailTopUp(ctx, txn.TransactionRef, ""))
+	txn = client.ClientTxn.GetX(ctx, txn.ID)
+	assert.Equal(t, clienttxn.StatusCompleted, txn.Status)
+
+	_, err = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayBkash, "TOP-UNKNOWN", "TRX2", 500)
+	assert.ErrorIs(t, err, billingrepo.ErrTxnNotFound)
+
+	// Another gateway cannot complete the recharge, nor can anything complete a transaction that
+	// is not a recharge
+	other, err := billingRepo.StartTopUp(ctx, clientUser, 200, clienttxn.PaymentMethodGatewayBkash)
+	require.NoError(t, err)
+	_, err = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayNagad, other.TransactionRef, "NGD2", 200)
+	assert.ErrorIs(t, err, billingrepo.ErrTxnNotFound)
+	charge := client.ClientTxn.Create().
+		SetTransactionRef("RNW-1").
+		SetAmount(200).
+		SetType(clienttxn.TypeRENEWAL).
+		SetStatus(clienttxn.StatusPending).
+		SetPaymentMethod(clienttxn.PaymentMethodGatewayBkash).
+		SetClientUsername(clientUser.Username).
+		SetCreatedBy("test").
+		SaveX(ctx)
+	_, err = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayBkash, charge.TransactionRef, "TRX3", 200)
+	assert.ErrorIs(t, err, billingrepo.ErrTxnNotFound)
+	assert.Equal(t, 600.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+}
+
+func TestLateTopUpSuccess(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "topup3", 100)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+
+	txn, err := billingRepo.StartTopUp(ctx, clientUser, 300, clienttxn.PaymentMethodGatewayNagad)
+	require.NoError(t, err)
+	require.NoError(t, billingRepo.FailTopUp(ctx, txn.TransactionRef, "NGD1"))
+
+	// The gateway took the money after reporting a failure, so the client is credited once
+	for i := 0; i < 2; i++ {
+		completed, err := billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayNagad, txn.TransactionRef, "NGD1", 300)
+		require.NoError(t, err)
+		assert.Equal(t, clienttxn.StatusCompleted, completed.Status)
+		assert.Equal(t, 400.0, completed.TotalBalance)
+	}
+	assert.Equal(t, 400.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+}
+
+func TestFailTopUp(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "topup2", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+
+	txn, err := billingRepo.StartTopUp(ctx, clientUser, 300, clienttxn.PaymentMethodGatewayNagad)
+	require.NoError(t, err)
+
+	require.NoError(t, billingRepo.FailTopUp(ctx, txn.TransactionRef, "NGD1"))
+	txn, err = billingRepo.GetClientTxn(ctx, clientUser.Username, txn.TransactionRef)
+	require.NoError(t, err)
+	assert.Equal(t, clienttxn.StatusFailed, txn.Status)
+
+	_, err = billingRepo.GetClientTxn(ctx, "someone-else", txn.TransactionRef)
+	assert.ErrorIs(t, err, billingrepo.ErrTxnNotFound)
+
+	_, err = billingRepo.StartTopUp(ctx, clientUser, 0, clienttxn.PaymentMethodGatewayNagad)
+	assert.ErrorIs(t, err, billingrepo.ErrInvalidAmount)
+}
@@ -0,0 +1,237 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/coupon"
+	"github.com/mikestefanello/pagoda/ent/couponredemption"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+var (
+	ErrCouponNotFound    = errors.New("coupon not found")
+	ErrCouponExpired     = errors.New("coupon is not valid at this time")
+	ErrCouponNotEligible = errors.New("coupon does not apply to this client or package")
+	ErrCouponUsedUp      = errors.New("coupon has no redemptions left")
+	ErrCouponExists      = errors.New("a coupon with this code already exists")
+)
+
+// CouponInput describes a new coupon
+type CouponInput struct {
+	Code         string
+	Description  string
+	DiscountType coupon.DiscountType
+	Value        float64
+	StartsAt     *time.Time
+	ExpiresAt    *time.Time
+	// MaxUses and MaxUsesPerClient limit redemptions, 0 meaning no limit
+	MaxUses          int
+	MaxUsesPerClient int
+	PlanIDs          []int
+	Districts        []string
+	CreatedBy        string
+}
+
+// NormalizeCouponCode returns a coupon code the way it is stored
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CreateCoupon adds a coupon clients can redeem on renewals and package changes
+func (b *BillingRepo) CreateCoupon(ctx context.Context, input CouponInput) (*ent.Coupon, error) {
+	code := NormalizeCouponCode(input.Code)
+	if code == "" || input.Value <= 0 || (input.DiscountType == coupon.DiscountTypePercent && input.Value > 100) {
+		return nil, ErrInvalidAmount
+	}
+	if err := coupon.DiscountTypeValidator(input.DiscountType); err != nil {
+		return nil, err
+	}
+
+	create := b.orm.Coupon.Create().
+		SetCode(code).
+		SetDescription(input.Description).
+		SetDiscountType(input.DiscountType).
+		SetValue(RoundAmount(input.Value)).
+		SetNillableStartsAt(input.StartsAt).
+		SetNillableExpiresAt(input.ExpiresAt).
+		SetMaxUses(input.MaxUses).
+		SetMaxUsesPerClient(input.MaxUsesPerClient).
+		SetCreatedBy(input.CreatedBy)
+	if len(input.PlanIDs) > 0 {
+		create.SetPlanIds(input.PlanIDs)
+	}
+	if len(input.Districts) > 0 {
+		create.SetDistricts(input.Districts)
+	}
+	c, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrCouponExists
+	}
+	return c, err
+}
+
+// ListCoupons returns every coupon, newest first
+func (b *BillingRepo) ListCoupons(ctx context.Context) ([]*ent.Coupon, error) {
+	return b.orm.Coupon.Query().
+		Order(ent.Desc(coupon.FieldCreatedAt)).
+		All(ctx)
+}
+
+// DisableCoupon stops a coupon from being redeemed any further
+func (b *BillingRepo) DisableCoupon(ctx context.Context, code string) error {
+	n, err := b.orm.Coupon.Update().
+		Where(coupon.CodeEQ(NormalizeCouponCode(code))).
+		SetIsActive(false).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrCouponNotFound
+	}
+	return nil
+}
+
+// CheckCoupon tells whether a coupon applies to a client buying the given package at the given time.
+// Redemption limits are checked separately, as they depend on the coupon's usage.
+func CheckCoupon(c *ent.Coupon, client *ent.ClientUser, plan *ent.PackagePlan, now time.Time) error {
+	if !c.IsActive {
+		return ErrCouponNotFound
+	}
+	if (c.StartsAt != nil && now.Before(*c.StartsAt)) || (c.ExpiresAt != nil && !now.Before(*c.ExpiresAt)) {
+		return ErrCouponExpired
+	}
+	if len(c.PlanIds) > 0 {
+		eligible := false
+		for _, id := range c.PlanIds {
+			eligible = eligible || id == plan.ID
+		}
+		if !eligible {
+			return ErrCouponNotEligible
+		}
+	}
+	if len(c.Districts) > 0 {
+		eligible := false
+		for _, district := range c.Districts {
+			eligible = eligible || strings.EqualFold(strings.TrimSpace(district), strings.TrimSpace(client.District))
+		}
+		if !eligible {
+			return ErrCouponNotEligible
+		}
+	}
+	return nil
+}
+
+// CouponDiscount is what a coupon takes off a price, never more than the price itself
+func CouponDiscount(c *ent.Coupon, price float64) float64 {
+	if price <= 0 {
+		return 0
+	}
+	discount := c.Value
+	if c.DiscountType == coupon.DiscountTypePercent {
+		discount = price * c.Value / 100
+	}
+	return RoundAmount(math.Min(discount, price))
+}
+
+// applyCoupon finds the coupon a client entered and works out its discount on price. Inside a
+// renewal or package change the coupon must have been claimed first, which already enforces the
+// overall limit, so it is only checked here when claimed is false.
+func applyCoupon(
+	ctx context.Context, orm *ent.Client, code string, client *ent.ClientUser, plan *ent.PackagePlan, price float64, claimed bool,
+) (*ent.Coupon, float64, error) {
+	c, err := orm.Coupon.Query().
+		Where(coupon.CodeEQ(NormalizeCouponCode(code))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, 0, ErrCouponNotFound
+	} else if err != nil {
+		return nil, 0, err
+	}
+
+	if err := CheckCoupon(c, client, plan, time.Now()); err != nil {
+		return nil, 0, err
+	}
+	if !claimed && c.MaxUses > 0 && c.UsedCount >= c.MaxUses {
+		return nil, 0, ErrCouponUsedUp
+	}
+	if c.MaxUsesPerClient > 0 {
+		used, err := orm.CouponRedemption.Query().
+			Where(
+				couponredemption.CouponIDEQ(c.ID),
+				couponredemption.ClientIDEQ(client.ID),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		if used >= c.MaxUsesPerClient {
+			return nil, 0, ErrCouponUsedUp
+		}
+	}
+	return c, CouponDiscount(c, price), nil
+}
+
+// claimCoupon counts a redemption against a coupon's overall limit. It should be the first write of
+// the transaction redeeming the coupon: the update locks the coupon's row, so concurrent
+// redemptions queue up behind it and then see each other's redemptions when checking the
+// per-client limit. The count is undone with the rest of the transaction if the redemption fails.
+func claimCoupon(ctx context.Context, tx *ent.Tx, code string) error {
+	n, err := tx.Coupon.Update().
+		Where(
+			coupon.CodeEQ(NormalizeCouponCode(code)),
+			coupon.IsActiveEQ(true),
+			coupon.Or(
+				coupon.MaxUsesEQ(0),
+				predicate.Coupon(sql.FieldsLT(coupon.FieldUsedCount, coupon.FieldMaxUses)),
+			),
+		).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		exists, err := tx.Coupon.Query().
+			Where(
+				coupon.CodeEQ(NormalizeCouponCode(code)),
+				coupon.IsActiveEQ(true),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrCouponUsedUp
+		}
+		return ErrCouponNotFound
+	}
+	return nil
+}
+
+// redeemCoupon records a coupon's discount against the transaction it was applied to
+func redeemCoupon(ctx context.Context, tx *ent.Tx, c *ent.Coupon, client *ent.ClientUser, txn *ent.ClientTxn) error {
+	return tx.CouponRedemption.Create().
+		SetCouponID(c.ID).
+		SetCode(c.Code).
+		SetClientID(client.ID).
+		SetClientUsername(client.Username).
+		SetTransactionRef(txn.TransactionRef).
+		SetDiscount(txn.Discount).
+		Exec(ctx)
+}
+
+// withDiscount sets the coupon discount on a transaction being created
+func withDiscount(create *ent.ClientTxnCreate, c *ent.Coupon, discount float64) *ent.ClientTxnCreate {
+	if c == nil {
+		return create
+	}
+	return create.SetDiscount(discount).SetCouponCode(c.Code)
+}
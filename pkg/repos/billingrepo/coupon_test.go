@@ -0,0 +1,44 @@
+package billingrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/coupon"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+)
+
+func TestCheckCoupon(t *testing.T) {
+	now := time.Date(2026, 3, 20, 10, 0, 0, 0, time.Local)
+	starts, expires := now.AddDate(0, 0, -1), now.AddDate(0, 0, 10)
+	client := &ent.ClientUser{District: "Dhaka"}
+	plan := &ent.PackagePlan{ID: 3}
+
+	c := &ent.Coupon{IsActive: true, StartsAt: &starts, ExpiresAt: &expires}
+	assert.NoError(t, billingrepo.CheckCoupon(c, client, plan, now))
+	assert.ErrorIs(t, billingrepo.CheckCoupon(c, client, plan, starts.Add(-time.Second)), billingrepo.ErrCouponExpired)
+	assert.ErrorIs(t, billingrepo.CheckCoupon(c, client, plan, expires), billingrepo.ErrCouponExpired)
+
+	c = &ent.Coupon{IsActive: true, PlanIds: []int{1, 3}, Districts: []string{"dhaka ", "Gazipur"}}
+	assert.NoError(t, billingrepo.CheckCoupon(c, client, plan, now))
+	assert.ErrorIs(t, billingrepo.CheckCoupon(c, client, &ent.PackagePlan{ID: 2}, now), billingrepo.ErrCouponNotEligible)
+	assert.ErrorIs(t, billingrepo.CheckCoupon(c, &ent.ClientUser{District: "Khulna"}, plan, now), billingrepo.ErrCouponNotEligible)
+	assert.ErrorIs(t, billingrepo.CheckCoupon(c, &ent.ClientUser{}, plan, now), billingrepo.ErrCouponNotEligible)
+
+	c.IsActive = false
+	assert.ErrorIs(t, billingrepo.CheckCoupon(c, client, plan, now), billingrepo.ErrCouponNotFound)
+}
+
+func TestCouponDiscount(t *testing.T) {
+	percent := &ent.Coupon{DiscountType: coupon.DiscountTypePercent, Value: 15}
+	assert.Equal(t, 75.0, billingrepo.CouponDiscount(percent, 500))
+	assert.Equal(t, 18.75, billingrepo.CouponDiscount(percent, 125))
+
+	fixed := &ent.Coupon{DiscountType: coupon.DiscountTypeFixed, Value: 100}
+	assert.Equal(t, 100.0, billingrepo.CouponDiscount(fixed, 500))
+	assert.Equal(t, 60.0, billingrepo.CouponDiscount(fixed, 60), "never more than the price")
+	assert.Zero(t, billingrepo.CouponDiscount(fixed, 0))
+}
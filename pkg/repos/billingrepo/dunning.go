@@ -0,0 +1,281 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+)
+
+// ErrDunningSettled is returned when a client paid between being found due and being reminded
+var ErrDunningSettled = errors.New("client paid before the reminder was sent")
+
+// DunningNotice is a reminder due to a client under the dunning schedule
+type DunningNotice struct {
+	Client *ent.ClientUser
+	Kind   dunningstep.Kind
+	// Reference is the expiry cycle or the invoice number the reminder is about
+	Reference string
+	DueAt     time.Time
+	Offset    int
+	Level     dunningstep.Level
+	// Amount is the renewal price or what is left to pay on the invoice
+	Amount float64
+	// Package is the package up for renewal, for expiry reminders
+	Package *ent.PackagePlan
+	// Invoice is the unpaid invoice, for invoice reminders
+	Invoice *ent.Invoice
+}
+
+// DunningOffsets sorts the days of a dunning schedule and drops repeated ones
+func DunningOffsets(offsets []int) []int {
+	sorted := slices.Clone(offsets)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
+
+// DunningLevel is how firmly step i of the sorted offsets asks for payment. Steps after the due
+// date are overdue notices, the last of them a final notice.
+func DunningLevel(offsets []int, i int) dunningstep.Level {
+	switch {
+	case offsets[i] < 0:
+		return dunningstep.LevelReminder
+	case offsets[i] == 0:
+		return dunningstep.LevelDue
+	case i == len(offsets)-1:
+		return dunningstep.LevelFinal
+	default:
+		return dunningstep.LevelOverdue
+	}
+}
+
+// CurrentDunningStep returns the index of the step of the sorted offsets that is current at now
+// for a payment due at due. A step stays current until the next one starts and the last one for a
+// day, so a run that was missed sends the latest step rather than every step or a stale one.
+func CurrentDunningStep(due, now time.Time, offsets []int) (int, bool) {
+	for i := len(offsets) - 1; i >= 0; i-- {
+		start := due.AddDate(0, 0, offsets[i])
+		if now.Before(start) {
+			continue
+		}
+		end := start.AddDate(0, 0, 1)
+		if i < len(offsets)-1 {
+			end = due.AddDate(0, 0, offsets[i+1])
+		}
+		return i, now.Before(end)
+	}
+	return -1, false
+}
+
+// DueDunning lists the reminders due at now under the dunning schedule given by offsets: prepaid
+// packages expiring or expired, and postpaid invoices left unpaid. Auto renew clients whose
+// balance already covers the renewal are left out. Whether a step was already sent is only known
+// once it is claimed with ClaimDunningStep.
+func (b *BillingRepo) DueDunning(ctx context.Context, now time.Time, offsets []int) ([]DunningNotice, error) {
+	offsets = DunningOffsets(offsets)
+	if len(offsets) == 0 {
+		return nil, nil
+	}
+	// Due dates whose first step has started and whose last step has not ended yet
+	latest := now.AddDate(0, 0, -offsets[0])
+	earliest := now.AddDate(0, 0, -offsets[len(offsets)-1]-1)
+
+	notices, err := b.dueExpiryDunning(ctx, now, earliest, latest, offsets)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := b.dueInvoiceDunning(ctx, now, earliest, latest, offsets)
+	if err != nil {
+		return nil, err
+	}
+	notices = append(notices, invoices...)
+
+	slices.SortFunc(notices, func(a, b DunningNotice) int {
+		if c := a.DueAt.Compare(b.DueAt); c != 0 {
+			return c
+		}
+		return a.Client.ID - b.Client.ID
+	})
+	return notices, nil
+}
+
+func (b *BillingRepo) dueExpiryDunning(
+	ctx context.Context, now, earliest, latest time.Time, offsets []int,
+) ([]DunningNotice, error) {
+	graced, err := b.gracedExpiries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var notices []DunningNotice
+	err = b.clientBatches(ctx, func(clients []*ent.ClientUser) error {
+		expiries, err := radiusrepo.NewRadiusRepo(b.orm).Expiries(ctx, usernamesOf(clients), latest.Add(time.Second))
+		if err != nil {
+			return err
+		}
+		for _, client := range clients {
+			due, ok := graced[client.Username]
+			if !ok {
+				due, ok = expiries[client.Username]
+			}
+			if !ok || !due.After(earliest) || due.After(latest) {
+				continue
+			}
+			i, ok := CurrentDunningStep(due, now, offsets)
+			if !ok {
+				continue
+			}
+			plan, _, err := renewalPackage(ctx, b.orm, client)
+			if errors.Is(err, ErrNoPackage) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			price := b.PackagePrice(plan)
+			if client.AutoRenew && AvailableCredit(client) >= price {
+				continue
+			}
+			notices = append(notices, DunningNotice{
+				Client:    client,
+				Kind:      dunningstep.KindExpiry,
+				Reference: cycleKey(&due),
+				DueAt:     due,
+				Offset:    offsets[i],
+				Level:     DunningLevel(offsets, i),
+				Amount:    price,
+				Package:   plan,
+			})
+		}
+		return nil
+	}, clientuser.BillingModeEQ(clientuser.BillingModePrepaid), clientuser.StatusNEQ(clientuser.StatusInactive))
+	if err != nil {
+		return nil, err
+	}
+	return notices, nil
+}
+
+func (b *BillingRepo) dueInvoiceDunning(
+	ctx context.Context, now, earliest, latest time.Time, offsets []int,
+) ([]DunningNotice, error) {
+	invoices, err := b.orm.Invoice.Query().
+		Where(
+			invoice.StatusIn(invoice.StatusOpen, invoice.StatusOverdue),
+			invoice.DueAtGT(earliest),
+			invoice.DueAtLTE(latest),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var notices []DunningNotice
+	for _, inv := range invoices {
+		i, ok := CurrentDunningStep(inv.DueAt, now, offsets)
+		if !ok {
+			continue
+		}
+		client, err := b.orm.ClientUser.Get(ctx, inv.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		if client.Status == clientuser.StatusInactive {
+			continue
+		}
+		notices = append(notices, DunningNotice{
+			Client:    client,
+			Kind:      dunningstep.KindInvoice,
+			Reference: inv.Number,
+			DueAt:     inv.DueAt,
+			Offset:    offsets[i],
+			Level:     DunningLevel(offsets, i),
+			Amount:    RoundAmount(inv.Amount - inv.PaidAmount),
+			Invoice:   inv,
+		})
+	}
+	return notices, nil
+}
+
+// ClaimDunningStep records a reminder about to be sent, returning nil if the step was already
+// sent to the client and ErrDunningSettled if they paid since it was found due.
+func (b *BillingRepo) ClaimDunningStep(ctx context.Context, n DunningNotice, subject, message string) (*ent.DunningStep, error) {
+	if settled, err := b.dunningSettled(ctx, n); err != nil {
+		return nil, err
+	} else if settled {
+		return nil, ErrDunningSettled
+	}
+
+	step, err := b.orm.DunningStep.Create().
+		SetClientID(n.Client.ID).
+		SetClientUsername(n.Client.Username).
+		SetKind(n.Kind).
+		SetReference(n.Reference).
+		SetDueAt(n.DueAt).
+		SetOffsetDays(n.Offset).
+		SetLevel(n.Level).
+		SetAmount(n.Amount).
+		SetSubject(subject).
+		SetMessage(message).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, nil
+	}
+	return step, err
+}
+
+// dunningSettled reports whether the client paid what a reminder is about: the invoice was paid,
+// or the package was renewed past the expiry it was found with
+func (b *BillingRepo) dunningSettled(ctx context.Context, n DunningNotice) (bool, error) {
+	if n.Kind == dunningstep.KindInvoice {
+		inv, err := b.orm.Invoice.Get(ctx, n.Invoice.ID)
+		if err != nil {
+			return false, err
+		}
+		return inv.Status != invoice.StatusOpen && inv.Status != invoice.StatusOverdue, nil
+	}
+
+	client, err := b.orm.ClientUser.Get(ctx, n.Client.ID)
+	if err != nil {
+		return false, err
+	}
+	if client.BillingMode != clientuser.BillingModePrepaid {
+		return true, nil
+	}
+	expiry, _, err := billingExpiry(ctx, b.orm, client)
+	if err != nil {
+		return false, err
+	}
+	due := n.DueAt
+	return !sameExpiry(expiry, &due), nil
+}
+
+// RecordDunningResult stores the channels a claimed reminder reached, or why it reached none
+func (b *BillingRepo) RecordDunningResult(ctx context.Context, stepID int, channels []string, sendErr error) error {
+	update := b.orm.DunningStep.UpdateOneID(stepID).
+		SetChannels(strings.Join(channels, ","))
+	if sendErr != nil {
+		msg := sendErr.Error()
+		if len(msg) > 1000 {
+			msg = msg[:1000]
+		}
+		update.SetStatus(dunningstep.StatusFailed).SetError(msg)
+	} else {
+		update.SetStatus(dunningstep.StatusSent).SetSentAt(time.Now())
+	}
+	return update.Exec(ctx)
+}
+
+// ClientDunning lists the reminders sent to a client, newest first
+func (b *BillingRepo) ClientDunning(ctx context.Context, username string, limit int) ([]*ent.DunningStep, error) {
+	return b.orm.DunningStep.Query().
+		Where(dunningstep.ClientUsernameEQ(username)).
+		Order(ent.Desc(dunningstep.FieldCreatedAt), ent.Desc(dunningstep.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
@@ -0,0 +1,51 @@
+package billingrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+)
+
+func TestDunningOffsets(t *testing.T) {
+	assert.Equal(t, []int{-3, 0, 2}, billingrepo.DunningOffsets([]int{2, -3, 0, 2}))
+	assert.Empty(t, billingrepo.DunningOffsets(nil))
+}
+
+func TestDunningLevel(t *testing.T) {
+	offsets := []int{-3, 0, 2, 5}
+	assert.Equal(t, dunningstep.LevelReminder, billingrepo.DunningLevel(offsets, 0))
+	assert.Equal(t, dunningstep.LevelDue, billingrepo.DunningLevel(offsets, 1))
+	assert.Equal(t, dunningstep.LevelOverdue, billingrepo.DunningLevel(offsets, 2))
+	assert.Equal(t, dunningstep.LevelFinal, billingrepo.DunningLevel(offsets, 3))
+
+	// A schedule that stops at the due date has no final notice
+	assert.Equal(t, dunningstep.LevelDue, billingrepo.DunningLevel([]int{-3, 0}, 1))
+}
+
+func TestCurrentDunningStep(t *testing.T) {
+	due := time.Date(2025, 11, 14, 13, 0, 0, 0, time.Local)
+	offsets := []int{-3, 0, 2}
+
+	step := func(now time.Time) int {
+		i, ok := billingrepo.CurrentDunningStep(due, now, offsets)
+		if !ok {
+			return -1
+		}
+		return i
+	}
+
+	assert.Equal(t, -1, step(due.AddDate(0, 0, -3).Add(-time.Second)))
+	assert.Equal(t, 0, step(due.AddDate(0, 0, -3)))
+	assert.Equal(t, 0, step(due.Add(-time.Second)))
+	assert.Equal(t, 1, step(due))
+	assert.Equal(t, 1, step(due.AddDate(0, 0, 2).Add(-time.Second)))
+	assert.Equal(t, 2, step(due.AddDate(0, 0, 2)))
+
+	// The last step is only sent for a day, so old debts are not chased when dunning is turned on
+	assert.Equal(t, 2, step(due.AddDate(0, 0, 3).Add(-time.Second)))
+	assert.Equal(t, -1, step(due.AddDate(0, 0, 3)))
+}
@@ -0,0 +1,364 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const createdByGracePeriod = "grace-period"
+
+// ErrInGracePeriod is returned for changes that need a package that has not expired
+var ErrInGracePeriod = errors.New("package has expired, renew it first")
+
+// GracePolicy is how expired clients are handled until they pay. How long the grace period lasts
+// is set per package; WarningDays of it are at full speed and the rest throttled.
+type GracePolicy struct {
+	WarningDays int
+	// ThrottleProfile is the RADIUS group throttled clients are moved to
+	ThrottleProfile string
+	// SuspendedProfile is the RADIUS group suspended clients are moved to. Their group is left alone
+	// when it is empty.
+	SuspendedProfile string
+}
+
+// GraceSummary describes one run of EnforceGrace
+type GraceSummary struct {
+	// Expired counts the clients found expired and put in a grace period by this run
+	Expired     int `json:"expired"`
+	Warned      int `json:"warned"`
+	Throttled   int `json:"throttled"`
+	Suspended   int `json:"suspended"`
+	Reactivated int `json:"reactivated"`
+	Failed      int `json:"failed"`
+	// Changes lists every client moved to another stage or reactivated, to notify them
+	Changes []GraceChange `json:"-"`
+}
+
+// GraceChange is a client moved to a stage of their grace period, or reactivated
+type GraceChange struct {
+	Client *ent.ClientUser
+	Grace  *ent.GracePeriod
+	// Renewal is set when the client was reactivated
+	Renewal *Renewal
+}
+
+// GraceStage returns the stage of the grace period a client whose package expired at expiredAt
+// is in at now
+func GraceStage(expiredAt, graceUntil, now time.Time, warningDays int) graceperiod.Stage {
+	switch {
+	case !now.Before(graceUntil):
+		return graceperiod.StageSuspended
+	case now.Before(expiredAt.AddDate(0, 0, warningDays)):
+		return graceperiod.StageWarning
+	default:
+		return graceperiod.StageThrottled
+	}
+}
+
+// EnforceGrace puts newly expired clients in a grace period and moves those already in one to
+// the stage they have reached. Clients in a grace period who turned on auto renewal and can now
+// afford their package are renewed, which reactivates them.
+func (b *BillingRepo) EnforceGrace(ctx context.Context, policy GracePolicy) (*GraceSummary, error) {
+	summary := &GraceSummary{}
+	now := time.Now()
+
+	open, err := b.orm.GracePeriod.Query().
+		Where(graceperiod.EndedAtIsNil()).
+		Order(ent.Asc(graceperiod.FieldExpiredAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	graced := make(map[string]bool, len(open))
+	for _, grace := range open {
+		graced[grace.ClientUsername] = true
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		client, err := b.orm.ClientUser.Get(ctx, grace.ClientID)
+		if ent.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if client.AutoRenew {
+			renewal, err := b.resumeService(ctx, client)
+			if err != nil {
+				summary.Failed++
+				log.Error().Err(err).Str("username", client.Username).Msg("failed to reactivate client")
+				continue
+			}
+			if renewal != nil {
+				summary.Reactivated++
+				summary.Changes = append(summary.Changes, GraceChange{Client: client, Grace: grace, Renewal: renewal})
+				continue
+			}
+		}
+
+		stage := GraceStage(grace.ExpiredAt, grace.GraceUntil, now, policy.WarningDays)
+		if stageRank(stage) <= stageRank(grace.Stage) {
+			continue
+		}
+		if err := b.moveGraceStage(ctx, summary, client, grace, stage, policy); err != nil {
+			summary.Failed++
+			log.Error().Err(err).Str("username", client.Username).Msg("failed to move client to the next grace stage")
+		}
+	}
+
+	err = b.clientBatches(ctx, func(clients []*ent.ClientUser) error {
+		expiries, err := radiusrepo.NewRadiusRepo(b.orm).Expiries(ctx, usernamesOf(clients), now)
+		if err != nil {
+			return err
+		}
+		for _, client := range clients {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			expiry, ok := expiries[client.Username]
+			if !ok || graced[client.Username] {
+				continue
+			}
+			if err := b.startGrace(ctx, summary, client, expiry, now, policy); err != nil {
+				summary.Failed++
+				log.Error().Err(err).Str("username", client.Username).Msg("failed to start grace period")
+			}
+		}
+		return nil
+	}, clientuser.StatusEQ(clientuser.StatusActive), clientuser.BillingModeEQ(clientuser.BillingModePrepaid))
+	if err != nil {
+		return summary, err
+	}
+	return summary, nil
+}
+
+// ResumeService renews the package of a client in a grace period if their balance now covers it,
+// reactivating them. It is a no-op for clients who are not in a grace period or cannot afford
+// their package yet, so it can be called after every payment.
+func (b *BillingRepo) ResumeService(ctx context.Context, username string) (*Renewal, error) {
+	client, err := b.orm.ClientUser.Query().
+		Where(clientuser.UsernameEQ(username)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.resumeService(ctx, client)
+}
+
+// OpenGracePeriod returns the grace period a client is in, nil if their package has not expired
+func (b *BillingRepo) OpenGracePeriod(ctx context.Context, clientID int) (*ent.GracePeriod, error) {
+	return openGracePeriod(ctx, b.orm, clientID)
+}
+
+func (b *BillingRepo) resumeService(ctx context.Context, client *ent.ClientUser) (*Renewal, error) {
+	grace, err := openGracePeriod(ctx, b.orm, client.ID)
+	if err != nil || grace == nil {
+		return nil, err
+	}
+	plan, _, err := renewalPackage(ctx, b.orm, client)
+	if err != nil {
+		return nil, err
+	}
+	if client.Balance < b.PackagePrice(plan) {
+		return nil, nil
+	}
+
+	renewal, err := b.RenewPackage(ctx, client.ID, RenewOptions{
+		CreatedBy:      createdByGracePeriod,
+		VerifyExpiry:   true,
+		ExpectedExpiry: &grace.ExpiredAt,
+	})
+	if errors.Is(err, ErrInsufficientBalance) || errors.Is(err, ErrAlreadyRenewed) {
+		return nil, nil
+	}
+	return renewal, err
+}
+
+// startGrace puts a newly expired client in the stage of the grace period they have reached.
+// A client found long after expiry, e.g. when grace periods are first enabled, goes straight to
+// the later stages rather than getting a fresh grace period.
+func (b *BillingRepo) startGrace(
+	ctx context.Context, summary *GraceSummary, client *ent.ClientUser, expiry, now time.Time, policy GracePolicy,
+) error {
+	plan, err := planByProfile(ctx, b.orm, client.UserProfile)
+	if errors.Is(err, ErrNoPackage) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	graceUntil := expiry.AddDate(0, 0, plan.GraceDays)
+	stage := GraceStage(expiry, graceUntil, now, policy.WarningDays)
+	var grace *ent.GracePeriod
+	err = WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		var err error
+		grace, err = tx.GracePeriod.Create().
+			SetClientID(client.ID).
+			SetClientUsername(client.Username).
+			SetExpiredAt(expiry).
+			SetGraceUntil(graceUntil).
+			SetStage(stage).
+			SetProfile(plan.ProfileName).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return applyGraceStage(ctx, tx, client, grace, policy)
+	})
+	if ent.IsConstraintError(err) {
+		// Started by an overlapping run
+		return nil
+	} else if err != nil {
+		return err
+	}
+	b.pushGraceStage(ctx, client, grace, policy)
+	summary.Expired++
+	countGraceStage(summary, stage)
+	summary.Changes = append(summary.Changes, GraceChange{Client: client, Grace: grace})
+	return nil
+}
+
+func (b *BillingRepo) moveGraceStage(
+	ctx context.Context, summary *GraceSummary, client *ent.ClientUser, grace *ent.GracePeriod,
+	stage graceperiod.Stage, policy GracePolicy,
+) error {
+	var moved *ent.GracePeriod
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		// Claim the move from the stage it was found in, so an overlapping run or a payment
+		// reactivating the client in the meantime makes it a no-op
+		n, err := tx.GracePeriod.Update().
+			Where(
+				graceperiod.IDEQ(grace.ID),
+				graceperiod.StageEQ(grace.Stage),
+				graceperiod.EndedAtIsNil(),
+			).
+			SetStage(stage).
+			Save(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+		moved, err = tx.GracePeriod.Get(ctx, grace.ID)
+		if err != nil {
+			return err
+		}
+		return applyGraceStage(ctx, tx, client, moved, policy)
+	})
+	if err != nil || moved == nil {
+		return err
+	}
+	b.pushGraceStage(ctx, client, moved, policy)
+	countGraceStage(summary, stage)
+	summary.Changes = append(summary.Changes, GraceChange{Client: client, Grace: moved})
+	return nil
+}
+
+// applyGraceStage updates the client's RADIUS attributes and status for their grace stage. Until
+// suspended the Expiration is pushed to the end of the grace period so RADIUS keeps accepting them;
+// once suspended it is set back to the expiry that was paid for.
+func applyGraceStage(ctx context.Context, tx *ent.Tx, client *ent.ClientUser, grace *ent.GracePeriod, policy GracePolicy) error {
+	radius := radiusrepo.NewRadiusRepo(tx.Client())
+	status := clientuser.StatusWarning
+	expiry := grace.GraceUntil
+	switch grace.Stage {
+	case graceperiod.StageThrottled:
+		status = clientuser.StatusThrottled
+		if policy.ThrottleProfile != "" {
+			if err := radius.SetGroup(ctx, client.Username, policy.ThrottleProfile); err != nil {
+				return err
+			}
+		}
+	case graceperiod.StageSuspended:
+		status = clientuser.StatusSuspended
+		expiry = grace.ExpiredAt
+		if policy.SuspendedProfile != "" {
+			if err := radius.SetGroup(ctx, client.Username, policy.SuspendedProfile); err != nil {
+				return err
+			}
+		}
+	}
+	if err := radius.SetExpiry(ctx, client.Username, expiry); err != nil {
+		return err
+	}
+	return tx.ClientUser.UpdateOneID(client.ID).
+		SetStatus(status).
+		SetUpdatedBy(createdByGracePeriod).
+		Exec(ctx)
+}
+
+// pushGraceStage applies a committed grace stage to the client's open sessions: throttled ones get
+// the throttle group's speed and suspended ones are disconnected
+func (b *BillingRepo) pushGraceStage(ctx context.Context, client *ent.ClientUser, grace *ent.GracePeriod, policy GracePolicy) {
+	switch grace.Stage {
+	case graceperiod.StageThrottled:
+		b.applyProfile(ctx, client.Username, policy.ThrottleProfile)
+	case graceperiod.StageSuspended:
+		b.disconnect(ctx, client.Username)
+	}
+}
+
+// endGrace closes the grace period of a client who renewed
+func endGrace(ctx context.Context, tx *ent.Tx, grace *ent.GracePeriod) error {
+	if grace == nil {
+		return nil
+	}
+	return tx.GracePeriod.UpdateOneID(grace.ID).
+		SetEndedAt(time.Now()).
+		Exec(ctx)
+}
+
+// billingExpiry is the expiry a client has paid for. It is the radcheck Expiration, unless the
+// client is in a grace period that pushed it further.
+func billingExpiry(ctx context.Context, orm *ent.Client, client *ent.ClientUser) (*time.Time, *ent.GracePeriod, error) {
+	grace, err := openGracePeriod(ctx, orm, client.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if grace != nil {
+		expiry := grace.ExpiredAt
+		return &expiry, grace, nil
+	}
+	expiry, err := radiusrepo.NewRadiusRepo(orm).Expiry(ctx, client.Username)
+	return expiry, nil, err
+}
+
+func openGracePeriod(ctx context.Context, orm *ent.Client, clientID int) (*ent.GracePeriod, error) {
+	grace, err := orm.GracePeriod.Query().
+		Where(
+			graceperiod.ClientIDEQ(clientID),
+			graceperiod.EndedAtIsNil(),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return grace, err
+}
+
+func stageRank(stage graceperiod.Stage) int {
+	switch stage {
+	case graceperiod.StageThrottled:
+		return 1
+	case graceperiod.StageSuspended:
+		return 2
+	default:
+		return 0
+	}
+}
+
+func countGraceStage(summary *GraceSummary, stage graceperiod.Stage) {
+	switch stage {
+	case graceperiod.StageWarning:
+		summary.Warned++
+	case graceperiod.StageThrottled:
+		summary.Throttled++
+	case graceperiod.StageSuspended:
+		summary.Suspended++
+	}
+}
@@ -0,0 +1,139 @@
+package billingrepo_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestGraceStage(t *testing.T) {
+	expired := time.Date(2025, 11, 14, 13, 0, 0, 0, time.Local)
+	until := expired.AddDate(0, 0, 5)
+
+	assert.Equal(t, graceperiod.StageWarning, billingrepo.GraceStage(expired, until, expired, 2))
+	assert.Equal(t, graceperiod.StageWarning, billingrepo.GraceStage(expired, until, expired.AddDate(0, 0, 2).Add(-time.Second), 2))
+	assert.Equal(t, graceperiod.StageThrottled, billingrepo.GraceStage(expired, until, expired.AddDate(0, 0, 2), 2))
+	assert.Equal(t, graceperiod.StageSuspended, billingrepo.GraceStage(expired, until, until, 2))
+
+	// Without warning days clients are throttled right away, and without grace days suspended
+	assert.Equal(t, graceperiod.StageThrottled, billingrepo.GraceStage(expired, until, expired, 0))
+	assert.Equal(t, graceperiod.StageSuspended, billingrepo.GraceStage(expired, expired, expired, 2))
+}
+
+func TestEnforceGrace(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300).
+		Update().
+		SetGraceDays(5).
+		SaveX(ctx)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	radiusRepo := radiusrepo.NewRadiusRepo(client)
+	policy := billingrepo.GracePolicy{
+		WarningDays:      2,
+		ThrottleProfile:  "grace-throttle",
+		SuspendedProfile: "grace-suspended",
+	}
+	now := time.Now().Truncate(time.Second)
+	expired := func(username string, ago time.Duration) *ent.ClientUser {
+		clientUser := tests.CreateSubscriber(ctx, client, username, 0, plan)
+		require.NoError(t, radiusRepo.SetExpiry(ctx, username, now.Add(-ago)))
+		require.NoError(t, radiusRepo.SetGroup(ctx, username, plan.ProfileName))
+		return clientUser
+	}
+	warned := expired("grace1", time.Hour)
+	throttled := expired("grace2", 3*24*time.Hour)
+	suspended := expired("grace3", 10*24*time.Hour)
+
+	summary, err := billingRepo.EnforceGrace(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, 3, summary.Expired)
+	assert.Equal(t, 1, summary.Warned)
+	assert.Equal(t, 1, summary.Throttled)
+	assert.Equal(t, 1, summary.Suspended)
+	assert.Len(t, summary.Changes, 3)
+
+	// Until suspended, the Expiration is pushed to the end of the grace period
+	assert.Equal(t, clientuser.StatusWarning, client.ClientUser.GetX(ctx, warned.ID).Status)
+	expiry, err := radiusRepo.Expiry(ctx, warned.Username)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-time.Hour).AddDate(0, 0, 5).Unix(), expiry.Unix())
+	group, err := radiusRepo.Group(ctx, warned.Username)
+	require.NoError(t, err)
+	assert.Equal(t, plan.ProfileName, group)
+
+	assert.Equal(t, clientuser.StatusThrottled, client.ClientUser.GetX(ctx, throttled.ID).Status)
+	group, err = radiusRepo.Group(ctx, throttled.Username)
+	require.NoError(t, err)
+	assert.Equal(t, policy.ThrottleProfile, group)
+
+	assert.Equal(t, clientuser.StatusSuspended, client.ClientUser.GetX(ctx, suspended.ID).Status)
+	expiry, err = radiusRepo.Expiry(ctx, suspended.Username)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-10*24*time.Hour).Unix(), expiry.Unix())
+	group, err = radiusRepo.Group(ctx, suspended.Username)
+	require.NoError(t, err)
+	assert.Equal(t, policy.SuspendedProfile, group)
+
+	// Running again changes nothing, even when runs overlap
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			summary, err := billingRepo.EnforceGrace(ctx, policy)
+			assert.NoError(t, err)
+			assert.Empty(t, summary.Changes)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 3, client.GracePeriod.Query().CountX(ctx))
+
+	// Once the warning days are over the client is throttled
+	grace := client.GracePeriod.Query().Where(graceperiod.ClientIDEQ(warned.ID)).OnlyX(ctx)
+	grace.Update().
+		SetExpiredAt(grace.ExpiredAt.AddDate(0, 0, -3)).
+		SetGraceUntil(grace.GraceUntil.AddDate(0, 0, -3)).
+		ExecX(ctx)
+	summary, err = billingRepo.EnforceGrace(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Throttled)
+	assert.Equal(t, graceperiod.StageThrottled, client.GracePeriod.GetX(ctx, grace.ID).Stage)
+
+	// Paying reactivates a client with a fresh cycle
+	client.ClientUser.UpdateOneID(suspended.ID).AddBalance(300).ExecX(ctx)
+	renewal, err := billingRepo.ResumeService(ctx, suspended.Username)
+	require.NoError(t, err)
+	require.NotNil(t, renewal)
+	assert.WithinDuration(t, now.AddDate(0, 0, 30), renewal.Expiry, time.Minute)
+	assert.Equal(t, clientuser.StatusActive, client.ClientUser.GetX(ctx, suspended.ID).Status)
+	group, err = radiusRepo.Group(ctx, suspended.Username)
+	require.NoError(t, err)
+	assert.Equal(t, plan.ProfileName, group)
+	open, err := billingRepo.OpenGracePeriod(ctx, suspended.ID)
+	require.NoError(t, err)
+	assert.Nil(t, open)
+
+	// A client on auto renewal who can afford their package is reactivated by the run
+	client.ClientUser.UpdateOneID(throttled.ID).SetAutoRenew(true).AddBalance(300).ExecX(ctx)
+	summary, err = billingRepo.EnforceGrace(ctx, policy)
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Reactivated)
+	assert.Zero(t, client.ClientUser.GetX(ctx, throttled.ID).Balance)
+
+	// Resuming twice charges once
+	renewal, err = billingRepo.ResumeService(ctx, throttled.Username)
+	require.NoError(t, err)
+	assert.Nil(t, renewal)
+}
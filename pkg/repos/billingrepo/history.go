@@ -0,0 +1,66 @@
+package billingrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+)
+
+// MaxExportTxns caps how many transactions a single export returns
+const MaxExportTxns = 10000
+
+// TxnFilter narrows down a client's transaction history. Empty fields match everything.
+type TxnFilter struct {
+	Type          clienttxn.Type
+	Status        clienttxn.Status
+	PaymentMethod clienttxn.PaymentMethod
+	// From and To bound the transaction date; From is inclusive, To exclusive
+	From *time.Time
+	To   *time.Time
+}
+
+// CountClientTxns counts a client's transactions matching the filter
+func (b *BillingRepo) CountClientTxns(ctx context.Context, username string, filter TxnFilter) (int, error) {
+	return b.orm.ClientTxn.Query().
+		Where(filter.predicates(username)...).
+		Count(ctx)
+}
+
+// ClientTxns lists a client's transactions matching the filter, latest first. A limit of zero or
+// less returns up to MaxExportTxns.
+func (b *BillingRepo) ClientTxns(
+	ctx context.Context, username string, filter TxnFilter, offset, limit int,
+) ([]*ent.ClientTxn, error) {
+	if limit <= 0 || limit > MaxExportTxns {
+		limit = MaxExportTxns
+	}
+	return b.orm.ClientTxn.Query().
+		Where(filter.predicates(username)...).
+		Order(ent.Desc(clienttxn.FieldTransactionDate), ent.Desc(clienttxn.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+}
+
+func (f TxnFilter) predicates(username string) []predicate.ClientTxn {
+	where := []predicate.ClientTxn{clienttxn.ClientUsernameEQ(username)}
+	if f.Type != "" {
+		where = append(where, clienttxn.TypeEQ(f.Type))
+	}
+	if f.Status != "" {
+		where = append(where, clienttxn.StatusEQ(f.Status))
+	}
+	if f.PaymentMethod != "" {
+		where = append(where, clienttxn.PaymentMethodEQ(f.PaymentMethod))
+	}
+	if f.From != nil {
+		where = append(where, clienttxn.TransactionDateGTE(*f.From))
+	}
+	if f.To != nil {
+		where = append(where, clienttxn.TransactionDateLT(*f.To))
+	}
+	return where
+}
@@ -0,0 +1,352 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+)
+
+const createdByLedgerRepair = "ledger-repair"
+
+// LedgerIssueKind names a kind of inconsistency found by CheckLedger
+type LedgerIssueKind string
+
+const (
+	// IssueBalanceDrift is a clients.balance that differs from the sum of the client's transactions
+	IssueBalanceDrift LedgerIssueKind = "balance_drift"
+	// IssueRunningBalanceDrift is a total_balance that differs from the replayed balance at that point
+	IssueRunningBalanceDrift LedgerIssueKind = "running_balance_drift"
+	// IssueNegativeBalance is a point in the history where the balance went below zero
+	IssueNegativeBalance LedgerIssueKind = "negative_balance"
+	IssueMissingRef      LedgerIssueKind = "missing_ref"
+	IssueDuplicateRef    LedgerIssueKind = "duplicate_ref"
+	// IssueUnknownClient is a transaction whose client_username matches no client
+	IssueUnknownClient LedgerIssueKind = "unknown_client"
+)
+
+var ErrStaleLedgerIssue = errors.New("ledger changed since the report was generated")
+
+// LedgerReport is the machine-readable outcome of a ledger check
+type LedgerReport struct {
+	GeneratedAt       time.Time     `json:"generated_at"`
+	ClientsChecked    int           `json:"clients_checked"`
+	TxnsChecked       int           `json:"txns_checked"`
+	ClientsWithIssues int           `json:"clients_with_issues"`
+	Issues            []LedgerIssue `json:"issues"`
+}
+
+// LedgerIssue is one inconsistency. Expected is what replaying the ledger gives, Actual what is stored.
+type LedgerIssue struct {
+	Kind           LedgerIssueKind `json:"kind"`
+	Username       string          `json:"username,omitempty"`
+	TxnID          int             `json:"txn_id,omitempty"`
+	TransactionRef string          `json:"transaction_ref,omitempty"`
+	Expected       float64         `json:"expected"`
+	Actual         float64         `json:"actual"`
+	Detail         string          `json:"detail"`
+}
+
+// LedgerRepair is a correcting entry written, or planned, for a balance drift
+type LedgerRepair struct {
+	Username string  `json:"username"`
+	Amount   float64 `json:"amount"`
+	// TransactionRef is the ADJUSTMENT written, empty on a dry run
+	TransactionRef string `json:"transaction_ref,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// BalanceEffect returns how much a completed transaction added to the client's balance, negative
+// when it took from it. Charges paid outside the balance, e.g. a renewal paid in cash at the
+// office, leave the balance untouched.
+func BalanceEffect(txn *ent.ClientTxn) float64 {
+	switch txn.Type {
+	case clienttxn.TypeRECHARGE, clienttxn.TypeTRANSFER_RECEIVED, clienttxn.TypeADJUSTMENT:
+		return txn.Amount
+	case clienttxn.TypeTRANSFER_REFUND:
+		// Money leaving the balance to another client
+		return -txn.Amount
+	default:
+		// Including refunds: one of unused balance is paid from the balance, one of a failed
+		// payment never reached it
+		if txn.PaymentMethod != nil && *txn.PaymentMethod == clienttxn.PaymentMethodClientBalance {
+			return -txn.Amount
+		}
+		return 0
+	}
+}
+
+// CheckLedger replays the completed transactions of every client, or only of the given usernames,
+// in date order and reports where the ledger disagrees with itself or with clients.balance
+func (b *BillingRepo) CheckLedger(ctx context.Context, usernames ...string) (*LedgerReport, error) {
+	report := &LedgerReport{
+		GeneratedAt: time.Now(),
+		Issues:      []LedgerIssue{},
+	}
+
+	clientQuery := b.orm.ClientUser.Query().Order(ent.Asc(clientuser.FieldID))
+	txnQuery := b.orm.ClientTxn.Query().
+		Where(clienttxn.StatusEQ(clienttxn.StatusCompleted)).
+		Order(ent.Asc(clienttxn.FieldTransactionDate), ent.Asc(clienttxn.FieldID))
+	if len(usernames) > 0 {
+		clientQuery.Where(clientuser.UsernameIn(usernames...))
+		txnQuery.Where(clienttxn.ClientUsernameIn(usernames...))
+	}
+
+	clients, err := clientQuery.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txns, err := txnQuery.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byClient := make(map[string][]*ent.ClientTxn, len(clients))
+	for _, txn := range txns {
+		byClient[txn.ClientUsername] = append(byClient[txn.ClientUsername], txn)
+	}
+
+	withIssues := make(map[string]bool)
+	add := func(issue LedgerIssue) {
+		report.Issues = append(report.Issues, issue)
+		if issue.Username != "" {
+			withIssues[issue.Username] = true
+		}
+	}
+
+	for _, client := range clients {
+		for _, issue := range ReplayLedger(client, byClient[client.Username]) {
+			add(issue)
+		}
+		delete(byClient, client.Username)
+	}
+	for username, orphans := range byClient {
+		for _, txn := range orphans {
+			add(LedgerIssue{
+				Kind:           IssueUnknownClient,
+				Username:       username,
+				TxnID:          txn.ID,
+				TransactionRef: txn.TransactionRef,
+				Actual:         txn.Amount,
+				Detail:         "transaction belongs to no known client",
+			})
+		}
+	}
+
+	// The unique index on transaction_ref may be missing on older databases, so check it here too
+	duplicates, err := b.duplicateRefs(ctx, usernames)
+	if err != nil {
+		return nil, err
+	}
+	for _, issue := range duplicates {
+		add(issue)
+	}
+
+	report.ClientsChecked = len(clients)
+	report.TxnsChecked = len(txns)
+	report.ClientsWithIssues = len(withIssues)
+	return report, nil
+}
+
+// ReplayLedger checks a client's completed transactions, in date order, against their balance
+func ReplayLedger(client *ent.ClientUser, txns []*ent.ClientTxn) []LedgerIssue {
+	var (
+		issues  []LedgerIssue
+		running float64
+	)
+	for _, txn := range txns {
+		if strings.TrimSpace(txn.TransactionRef) == "" {
+			issues = append(issues, LedgerIssue{
+				Kind:     IssueMissingRef,
+				Username: client.Username,
+				TxnID:    txn.ID,
+				Actual:   txn.Amount,
+				Detail:   "transaction has no transaction_ref",
+			})
+		}
+
+		running = RoundAmount(running + BalanceEffect(txn))
+		if !sameAmount(running, txn.TotalBalance) {
+			issues = append(issues, LedgerIssue{
+				Kind:           IssueRunningBalanceDrift,
+				Username:       client.Username,
+				TxnID:          txn.ID,
+				TransactionRef: txn.TransactionRef,
+				Expected:       running,
+				Actual:         txn.TotalBalance,
+				Detail:         fmt.Sprintf("total_balance after %s %s", txn.Type, txn.TransactionDate.Format(time.RFC3339)),
+			})
+			// Carry on from the recorded balance so one bad row is reported once, not on every row after it
+			running = RoundAmount(txn.TotalBalance)
+		}
+		if running < 0 && client.BillingMode != clientuser.BillingModePostpaid {
+			issues = append(issues, LedgerIssue{
+				Kind:           IssueNegativeBalance,
+				Username:       client.Username,
+				TxnID:          txn.ID,
+				TransactionRef: txn.TransactionRef,
+				Expected:       0,
+				Actual:         running,
+				Detail:         fmt.Sprintf("balance went negative after %s", txn.Type),
+			})
+		}
+	}
+
+	expected := ledgerBalance(txns)
+	if !sameAmount(expected, client.Balance) {
+		issues = append(issues, LedgerIssue{
+			Kind:     IssueBalanceDrift,
+			Username: client.Username,
+			Expected: expected,
+			Actual:   RoundAmount(client.Balance),
+			Detail:   "clients.balance differs from the sum of completed transactions",
+		})
+	}
+	return issues
+}
+
+// RepairLedger writes an ADJUSTMENT for every balance drift in a reviewed report, so the ledger
+// sums up to the balance the client actually has. Balances themselves are never changed. Each
+// drift is checked again first and skipped if it no longer matches the report. With apply unset,
+// the repairs are only planned.
+func (b *BillingRepo) RepairLedger(ctx context.Context, report *LedgerReport, apply bool) ([]LedgerRepair, error) {
+	repairs := []LedgerRepair{}
+	for _, issue := range report.Issues {
+		if issue.Kind != IssueBalanceDrift {
+			continue
+		}
+		repair := LedgerRepair{
+			Username: issue.Username,
+			Amount:   RoundAmount(issue.Actual - issue.Expected),
+		}
+		if err := ctx.Err(); err != nil {
+			return repairs, err
+		}
+		if apply {
+			ref, err := b.repairBalanceDrift(ctx, issue)
+			if err != nil {
+				repair.Error = err.Error()
+			}
+			repair.TransactionRef = ref
+		}
+		repairs = append(repairs, repair)
+	}
+	return repairs, nil
+}
+
+func (b *BillingRepo) repairBalanceDrift(ctx context.Context, issue LedgerIssue) (string, error) {
+	var ref string
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		// A no-op balance update locks the client's row, so no payment lands between check and write
+		n, err := tx.ClientUser.Update().
+			Where(clientuser.UsernameEQ(issue.Username)).
+			AddBalance(0).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrClientNotFound
+		}
+		client, err := tx.ClientUser.Query().
+			Where(clientuser.UsernameEQ(issue.Username)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		txns, err := tx.ClientTxn.Query().
+			Where(
+				clienttxn.ClientUsernameEQ(client.Username),
+				clienttxn.StatusEQ(clienttxn.StatusCompleted),
+			).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		expected := ledgerBalance(txns)
+		if !sameAmount(expected, issue.Expected) || !sameAmount(client.Balance, issue.Actual) {
+			return ErrStaleLedgerIssue
+		}
+
+		ref = NewTransactionRef("ADJ")
+		return tx.ClientTxn.Create().
+			SetTransactionRef(ref).
+			SetAmount(RoundAmount(client.Balance - expected)).
+			SetType(clienttxn.TypeADJUSTMENT).
+			SetStatus(clienttxn.StatusCompleted).
+			SetTotalBalance(RoundAmount(client.Balance)).
+			SetPaymentMethod(clienttxn.PaymentMethodOther).
+			SetClientUsername(client.Username).
+			SetDescription(fmt.Sprintf("Ledger adjustment: balance %.2f, transactions sum to %.2f", client.Balance, expected)).
+			SetCreatedBy(createdByLedgerRepair).
+			Exec(ctx)
+	})
+	if err != nil {
+		return "", err
+	}
+	return ref, nil
+}
+
+// duplicateRefs reports transaction_refs shared by more than one transaction of any status
+func (b *BillingRepo) duplicateRefs(ctx context.Context, usernames []string) ([]LedgerIssue, error) {
+	var groups []struct {
+		TransactionRef string `json:"transaction_ref"`
+		Count          int    `json:"count"`
+	}
+	query := b.orm.ClientTxn.Query()
+	if len(usernames) > 0 {
+		query.Where(clienttxn.ClientUsernameIn(usernames...))
+	}
+	err := query.
+		GroupBy(clienttxn.FieldTransactionRef).
+		Aggregate(ent.Count()).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []LedgerIssue
+	for _, g := range groups {
+		if g.Count < 2 || strings.TrimSpace(g.TransactionRef) == "" {
+			continue
+		}
+		txns, err := b.orm.ClientTxn.Query().
+			Where(clienttxn.TransactionRefEQ(g.TransactionRef)).
+			Order(ent.Asc(clienttxn.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, txn := range txns[1:] {
+			issues = append(issues, LedgerIssue{
+				Kind:           IssueDuplicateRef,
+				Username:       txn.ClientUsername,
+				TxnID:          txn.ID,
+				TransactionRef: txn.TransactionRef,
+				Actual:         txn.Amount,
+				Detail:         fmt.Sprintf("transaction_ref is shared by %d transactions, first is #%d", g.Count, txns[0].ID),
+			})
+		}
+	}
+	return issues, nil
+}
+
+func ledgerBalance(txns []*ent.ClientTxn) float64 {
+	var sum float64
+	for _, txn := range txns {
+		sum += BalanceEffect(txn)
+	}
+	return RoundAmount(sum)
+}
+
+func sameAmount(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
@@ -0,0 +1,70 @@
+package billingrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+)
+
+func TestBalanceEffect(t *testing.T) {
+	balance := clienttxn.PaymentMethodClientBalance
+	cash := clienttxn.PaymentMethodCash
+
+	assert.Equal(t, 500.0, billingrepo.BalanceEffect(&ent.ClientTxn{Type: clienttxn.TypeRECHARGE, Amount: 500}))
+	assert.Equal(t, -20.0, billingrepo.BalanceEffect(&ent.ClientTxn{Type: clienttxn.TypeADJUSTMENT, Amount: -20}))
+	assert.Equal(t, -300.0, billingrepo.BalanceEffect(&ent.ClientTxn{Type: clienttxn.TypeTRANSFER_REFUND, Amount: 300}))
+	assert.Equal(t, -400.0, billingrepo.BalanceEffect(&ent.ClientTxn{Type: clienttxn.TypeRENEWAL, Amount: 400, PaymentMethod: &balance}))
+
+	// A proration credit is a negative charge
+	assert.Equal(t, 150.0, billingrepo.BalanceEffect(&ent.ClientTxn{Type: clienttxn.TypePACKAGE_MIGRATION, Amount: -150, PaymentMethod: &balance}))
+
+	// A refunded failed payment never reached the balance
+	bkash := clienttxn.PaymentMethodGatewayBkash
+	assert.Equal(t, -50.0, billingrepo.BalanceEffect(&ent.ClientTxn{Type: clienttxn.TypeREFUND, Amount: 50, PaymentMethod: &balance}))
+	assert.Zero(t, billingrepo.BalanceEffect(&ent.ClientTxn{Type: clienttxn.TypeREFUND, Amount: 50, PaymentMethod: &bkash}))
+
+	// Paid at the office, the balance is untouched
+	assert.Zero(t, billingrepo.BalanceEffect(&ent.ClientTxn{Type: clienttxn.TypeRENEWAL, Amount: 400, PaymentMethod: &cash}))
+}
+
+func TestReplayLedger(t *testing.T) {
+	balance := clienttxn.PaymentMethodClientBalance
+	client := &ent.ClientUser{Username: "client1", Balance: 150}
+	txns := []*ent.ClientTxn{
+		{ID: 1, TransactionRef: "TOP-1", Type: clienttxn.TypeRECHARGE, Amount: 500, TotalBalance: 500},
+		{ID: 2, TransactionRef: "RNW-1", Type: clienttxn.TypeRENEWAL, Amount: 400, TotalBalance: 100, PaymentMethod: &balance},
+		{ID: 3, TransactionRef: "", Type: clienttxn.TypeRENEWAL, Amount: 400, TotalBalance: -300, PaymentMethod: &balance},
+	}
+
+	issues := billingrepo.ReplayLedger(client, txns)
+	kinds := make([]billingrepo.LedgerIssueKind, 0, len(issues))
+	for _, issue := range issues {
+		kinds = append(kinds, issue.Kind)
+	}
+	assert.Equal(t, []billingrepo.LedgerIssueKind{
+		billingrepo.IssueMissingRef,
+		billingrepo.IssueNegativeBalance,
+		billingrepo.IssueBalanceDrift,
+	}, kinds)
+
+	drift := issues[2]
+	assert.Equal(t, -300.0, drift.Expected)
+	assert.Equal(t, 150.0, drift.Actual)
+
+	// A wrong total_balance is reported once, then the replay carries on from it
+	txns[1].TotalBalance = 90
+	txns[2].TotalBalance = -310
+	issues = billingrepo.ReplayLedger(client, txns)
+	assert.Equal(t, billingrepo.IssueRunningBalanceDrift, issues[0].Kind)
+	assert.Equal(t, 100.0, issues[0].Expected)
+	assert.Equal(t, 90.0, issues[0].Actual)
+	assert.Len(t, issues, 4)
+
+	// A consistent ledger has no issues
+	client.Balance = 500
+	assert.Empty(t, billingrepo.ReplayLedger(client, txns[:1]))
+}
@@ -0,0 +1,416 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+	"github.com/mikestefanello/pagoda/ent/statemententry"
+)
+
+// verifiedByStatement marks manual payments confirmed by an imported statement
+const verifiedByStatement = "statement"
+
+var (
+	ErrTrxIDUsed             = errors.New("this TrxID was already submitted")
+	ErrManualPaymentNotFound = errors.New("manual payment not found or no longer pending")
+)
+
+// ManualPaymentInput is what a client reports after sending money to the merchant number
+type ManualPaymentInput struct {
+	Provider     manualpayment.Provider
+	SenderNumber string
+	TrxID        string
+	Amount       float64
+}
+
+// ManualPaymentReview is an operator's decision on a manual payment
+type ManualPaymentReview struct {
+	Reviewer string
+	Note     string
+}
+
+// StatementEntryInput is one payment received on a merchant account
+type StatementEntryInput struct {
+	TrxID        string
+	Amount       float64
+	SenderNumber string
+	ReceivedAt   *time.Time
+}
+
+// StatementImport is the outcome of importing a merchant statement
+type StatementImport struct {
+	Imported int `json:"imported"`
+	// Duplicates were already imported from an earlier statement
+	Duplicates int `json:"duplicates"`
+	// Matched are the manual payments the statement confirmed
+	Matched []*ent.ManualPayment `json:"matched"`
+	// Mismatched are pending manual payments whose TrxID is on the statement with another amount
+	// or sender number. They are marked mismatched for an operator to review.
+	Mismatched []StatementMismatch `json:"mismatched"`
+	// Unclaimed counts the entries no client has submitted yet. They are matched as soon as one does.
+	Unclaimed int `json:"unclaimed"`
+}
+
+// StatementMismatch is a manual payment that does not agree with the statement
+type StatementMismatch struct {
+	Payment         *ent.ManualPayment `json:"payment"`
+	StatementAmount float64            `json:"statement_amount"`
+	StatementSender string             `json:"statement_sender"`
+}
+
+// NormalizeTrxID returns a provider TrxID the way it is stored
+func NormalizeTrxID(trxID string) string {
+	return strings.ToUpper(strings.TrimSpace(trxID))
+}
+
+// NormalizeSenderNumber returns a mobile number in its local 01XXXXXXXXX form, whether it was
+// written with spaces or dashes or with the +880 country code
+func NormalizeSenderNumber(number string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number)
+	if len(digits) == 13 && strings.HasPrefix(digits, "880") {
+		return digits[2:]
+	}
+	return digits
+}
+
+// SubmitManualPayment records a payment a client made to the merchant number as a pending RECHARGE
+// waiting for verification. If an imported statement already lists the TrxID with the same amount
+// and sender number, the payment is completed right away and matched is true. If the statement
+// disagrees, the payment is marked mismatched for an operator.
+func (b *BillingRepo) SubmitManualPayment(
+	ctx context.Context, client *ent.ClientUser, input ManualPaymentInput,
+) (payment *ent.ManualPayment, matched bool, err error) {
+	input.Amount = RoundAmount(input.Amount)
+	input.TrxID = NormalizeTrxID(input.TrxID)
+	input.SenderNumber = strings.TrimSpace(input.SenderNumber)
+	if input.Amount <= 0 {
+		return nil, false, ErrInvalidAmount
+	}
+	if err := manualpayment.ProviderValidator(input.Provider); err != nil {
+		return nil, false, err
+	}
+
+	err = WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		ref := NewTransactionRef("MAN")
+		_, err := tx.ClientTxn.Create().
+			SetTransactionRef(ref).
+			SetAmount(input.Amount).
+			SetType(clienttxn.TypeRECHARGE).
+			SetStatus(clienttxn.StatusPending).
+			SetTotalBalance(client.Balance).
+			SetPaymentMethod(clienttxn.PaymentMethodMobileBanking).
+			SetGatewayRef(input.TrxID).
+			SetClientUsername(client.Username).
+			SetDescription(fmt.Sprintf("%s payment from %s", providerLabel(input.Provider), input.SenderNumber)).
+			SetCreatedBy(createdBySelfCare).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		payment, err = tx.ManualPayment.Create().
+			SetTransactionRef(ref).
+			SetClientUsername(client.Username).
+			SetProvider(input.Provider).
+			SetSenderNumber(input.SenderNumber).
+			SetTrxID(input.TrxID).
+			SetTrxKey(input.TrxID).
+			SetAmount(input.Amount).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			return ErrTrxIDUsed
+		}
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	entry, err := b.orm.StatementEntry.Query().
+		Where(
+			statemententry.ProviderEQ(statemententry.Provider(payment.Provider)),
+			statemententry.TrxIDEQ(payment.TrxID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return payment, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	if !statementAgrees(entry, payment) {
+		mismatched, err := markMismatched(ctx, b.orm, payment)
+		if err != nil {
+			return nil, false, err
+		}
+		return mismatched, false, nil
+	}
+
+	completed, err := b.matchStatementEntry(ctx, entry, payment)
+	if err != nil {
+		return nil, false, err
+	}
+	if completed == nil {
+		return payment, false, nil
+	}
+	return completed, true, nil
+}
+
+// ClientManualPayments lists a client's manual payments, newest first
+func (b *BillingRepo) ClientManualPayments(ctx context.Context, username string, limit int) ([]*ent.ManualPayment, error) {
+	return b.orm.ManualPayment.Query().
+		Where(manualpayment.ClientUsernameEQ(username)).
+		Order(ent.Desc(manualpayment.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// ListManualPayments lists manual payments in the given statuses, or all of them, oldest first so
+// the verification queue is worked in order
+func (b *BillingRepo) ListManualPayments(ctx context.Context, statuses ...manualpayment.Status) ([]*ent.ManualPayment, error) {
+	query := b.orm.ManualPayment.Query().
+		Order(ent.Asc(manualpayment.FieldCreatedAt))
+	if len(statuses) > 0 {
+		query.Where(manualpayment.StatusIn(statuses...))
+	}
+	return query.All(ctx)
+}
+
+// VerifyManualPayment completes a pending or mismatched manual payment an operator found on the
+// merchant account and credits the client
+func (b *BillingRepo) VerifyManualPayment(ctx context.Context, ref string, review ManualPaymentReview) (*ent.ManualPayment, error) {
+	var payment *ent.ManualPayment
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		var err error
+		payment, err = settleManualPayment(ctx, tx, ref, manualpayment.StatusCompleted, review)
+		if err != nil {
+			return err
+		}
+		_, err = completeTopUp(ctx, tx, clienttxn.PaymentMethodMobileBanking,
+			payment.TransactionRef, payment.TrxID, payment.Amount)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.afterPayment(ctx, payment.ClientUsername)
+	return payment, nil
+}
+
+// RejectManualPayment fails a pending or mismatched manual payment that never reached the merchant
+// account. Its TrxID is released, so a client who mistyped it or submitted someone else's can
+// submit the payment again.
+func (b *BillingRepo) RejectManualPayment(ctx context.Context, ref string, review ManualPaymentReview) (*ent.ManualPayment, error) {
+	var payment *ent.ManualPayment
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		var err error
+		payment, err = settleManualPayment(ctx, tx, ref, manualpayment.StatusFailed, review)
+		if err != nil {
+			return err
+		}
+		payment, err = payment.Update().
+			ClearTrxKey().
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.ClientTxn.Update().
+			Where(
+				clienttxn.TransactionRefEQ(payment.TransactionRef),
+				clienttxn.StatusEQ(clienttxn.StatusPending),
+			).
+			SetStatus(clienttxn.StatusFailed).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+// ImportStatement stores the payments received on a merchant account and completes the pending
+// manual payments they confirm. Entries already imported are skipped, so overlapping statements
+// can be imported safely.
+func (b *BillingRepo) ImportStatement(
+	ctx context.Context, provider statemententry.Provider, entries []StatementEntryInput, importedBy string,
+) (*StatementImport, error) {
+	if err := statemententry.ProviderValidator(provider); err != nil {
+		return nil, err
+	}
+
+	result := &StatementImport{}
+	for _, in := range entries {
+		entry, err := b.orm.StatementEntry.Create().
+			SetProvider(provider).
+			SetTrxID(NormalizeTrxID(in.TrxID)).
+			SetAmount(RoundAmount(in.Amount)).
+			SetSenderNumber(strings.TrimSpace(in.SenderNumber)).
+			SetNillableReceivedAt(in.ReceivedAt).
+			SetImportedBy(importedBy).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			result.Duplicates++
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		result.Imported++
+
+		payment, err := b.orm.ManualPayment.Query().
+			Where(
+				manualpayment.ProviderEQ(manualpayment.Provider(provider)),
+				manualpayment.TrxIDEQ(entry.TrxID),
+				manualpayment.StatusEQ(manualpayment.StatusPending),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			result.Unclaimed++
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if !statementAgrees(entry, payment) {
+			mismatched, err := markMismatched(ctx, b.orm, payment)
+			if err != nil {
+				return nil, err
+			}
+			result.Mismatched = append(result.Mismatched, StatementMismatch{
+				Payment: mismatched, StatementAmount: entry.Amount, StatementSender: entry.SenderNumber,
+			})
+			continue
+		}
+
+		completed, err := b.matchStatementEntry(ctx, entry, payment)
+		if err != nil {
+			return nil, err
+		}
+		if completed != nil {
+			result.Matched = append(result.Matched, completed)
+		}
+	}
+	return result, nil
+}
+
+// matchStatementEntry completes a manual payment confirmed by a statement entry. Both are claimed
+// so that neither can confirm anything else; nil is returned if either was claimed already.
+func (b *BillingRepo) matchStatementEntry(
+	ctx context.Context, entry *ent.StatementEntry, payment *ent.ManualPayment,
+) (*ent.ManualPayment, error) {
+	var completed *ent.ManualPayment
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		n, err := tx.StatementEntry.Update().
+			Where(
+				statemententry.IDEQ(entry.ID),
+				statemententry.Or(
+					statemententry.PaymentRefIsNil(),
+					statemententry.PaymentRefEQ(""),
+				),
+			).
+			SetPaymentRef(payment.TransactionRef).
+			Save(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+
+		completed, err = settleManualPayment(ctx, tx, payment.TransactionRef, manualpayment.StatusCompleted,
+			ManualPaymentReview{Reviewer: verifiedByStatement})
+		if errors.Is(err, ErrManualPaymentNotFound) {
+			return errAlreadySettled
+		} else if err != nil {
+			return err
+		}
+		_, err = completeTopUp(ctx, tx, clienttxn.PaymentMethodMobileBanking,
+			payment.TransactionRef, payment.TrxID, payment.Amount)
+		return err
+	})
+	if errors.Is(err, errAlreadySettled) {
+		return nil, nil
+	} else if err != nil || completed == nil {
+		return nil, err
+	}
+	b.afterPayment(ctx, completed.ClientUsername)
+	return completed, nil
+}
+
+// errAlreadySettled rolls back a statement match when an operator settled the payment first
+var errAlreadySettled = errors.New("manual payment was already settled")
+
+// statementAgrees reports whether a statement entry confirms a manual payment: the money came from
+// the number the client reported, and as much as they said
+func statementAgrees(entry *ent.StatementEntry, payment *ent.ManualPayment) bool {
+	sender := NormalizeSenderNumber(entry.SenderNumber)
+	return sameAmount(entry.Amount, payment.Amount) &&
+		sender != "" && sender == NormalizeSenderNumber(payment.SenderNumber)
+}
+
+// markMismatched moves a pending manual payment a statement disagrees with to mismatched, where
+// only an operator can settle it
+func markMismatched(ctx context.Context, orm *ent.Client, payment *ent.ManualPayment) (*ent.ManualPayment, error) {
+	err := orm.ManualPayment.Update().
+		Where(
+			manualpayment.IDEQ(payment.ID),
+			manualpayment.StatusEQ(manualpayment.StatusPending),
+		).
+		SetStatus(manualpayment.StatusMismatched).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return orm.ManualPayment.Get(ctx, payment.ID)
+}
+
+// settleManualPayment moves a pending or mismatched manual payment to its final status
+func settleManualPayment(
+	ctx context.Context, tx *ent.Tx, ref string, status manualpayment.Status, review ManualPaymentReview,
+) (*ent.ManualPayment, error) {
+	n, err := tx.ManualPayment.Update().
+		Where(
+			manualpayment.TransactionRefEQ(ref),
+			manualpayment.StatusIn(manualpayment.StatusPending, manualpayment.StatusMismatched),
+		).
+		SetStatus(status).
+		SetVerifiedBy(review.Reviewer).
+		SetReviewNote(review.Note).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrManualPaymentNotFound
+	}
+	return tx.ManualPayment.Query().
+		Where(manualpayment.TransactionRefEQ(ref)).
+		Only(ctx)
+}
+
+func providerLabel(provider manualpayment.Provider) string {
+	if provider == manualpayment.ProviderNagad {
+		return "Nagad"
+	}
+	return "bKash"
+}
+
+// ManualPaymentNotice is the message telling a client how their manual payment was settled
+func ManualPaymentNotice(p *ent.ManualPayment, currency string) (subject, message string) {
+	provider := providerLabel(p.Provider)
+	if p.Status == manualpayment.StatusCompleted {
+		return provider + " payment received", fmt.Sprintf(
+			"Your %s payment of %.2f %s (TrxID %s) was verified and added to your balance.",
+			provider, p.Amount, currency, p.TrxID)
+	}
+	message = fmt.Sprintf("We could not verify your %s payment of %.2f %s (TrxID %s).", provider, p.Amount, currency, p.TrxID)
+	if p.ReviewNote != "" {
+		message += " " + p.ReviewNote
+	}
+	return provider + " payment not verified", message + " Please contact support if money left your account."
+}
@@ -0,0 +1,153 @@
+package billingrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestManualPaymentReview(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "manual1", 100)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+
+	payment, matched, err := billingRepo.SubmitManualPayment(ctx, clientUser, billingrepo.ManualPaymentInput{
+		Provider: manualpayment.ProviderBkash, SenderNumber: "01700000000", TrxID: " abc123 ", Amount: 500,
+	})
+	require.NoError(t, err)
+	assert.False(t, matched)
+	assert.Equal(t, manualpayment.StatusPending, payment.Status)
+	assert.Equal(t, "ABC123", payment.TrxID)
+	assert.Equal(t, 100.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// A TrxID can only be submitted once
+	_, _, err = billingRepo.SubmitManualPayment(ctx, clientUser, billingrepo.ManualPaymentInput{
+		Provider: manualpayment.ProviderBkash, SenderNumber: "01700000000", TrxID: "ABC123", Amount: 500,
+	})
+	assert.ErrorIs(t, err, billingrepo.ErrTrxIDUsed)
+
+	verified, err := billingRepo.VerifyManualPayment(ctx, payment.TransactionRef, billingrepo.ManualPaymentReview{Reviewer: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, manualpayment.StatusCompleted, verified.Status)
+	assert.Equal(t, 600.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	txn := client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(payment.TransactionRef)).OnlyX(ctx)
+	assert.Equal(t, clienttxn.StatusCompleted, txn.Status)
+	assert.Equal(t, 600.0, txn.TotalBalance)
+
+	// A settled payment cannot be reviewed again
+	_, err = billingRepo.RejectManualPayment(ctx, payment.TransactionRef, billingrepo.ManualPaymentReview{Reviewer: "alice"})
+	assert.ErrorIs(t, err, billingrepo.ErrManualPaymentNotFound)
+
+	payment, _, err = billingRepo.SubmitManualPayment(ctx, clientUser, billingrepo.ManualPaymentInput{
+		Provider: manualpayment.ProviderNagad, SenderNumber: "01800000000", TrxID: "XYZ789", Amount: 200,
+	})
+	require.NoError(t, err)
+	rejected, err := billingRepo.RejectManualPayment(ctx, payment.TransactionRef, billingrepo.ManualPaymentReview{
+		Reviewer: "alice", Note: "Not on the statement.",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, manualpayment.StatusFailed, rejected.Status)
+	assert.Equal(t, 600.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	txn = client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(payment.TransactionRef)).OnlyX(ctx)
+	assert.Equal(t, clienttxn.StatusFailed, txn.Status)
+
+	// A rejected TrxID can be submitted again, and taken only once more
+	resubmitted, _, err := billingRepo.SubmitManualPayment(ctx, clientUser, billingrepo.ManualPaymentInput{
+		Provider: manualpayment.ProviderNagad, SenderNumber: "01800000000", TrxID: "xyz789", Amount: 200,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, manualpayment.StatusPending, resubmitted.Status)
+	assert.NotEqual(t, payment.TransactionRef, resubmitted.TransactionRef)
+	_, _, err = billingRepo.SubmitManualPayment(ctx, clientUser, billingrepo.ManualPaymentInput{
+		Provider: manualpayment.ProviderNagad, SenderNumber: "01800000000", TrxID: "XYZ789", Amount: 200,
+	})
+	assert.ErrorIs(t, err, billingrepo.ErrTrxIDUsed)
+	_, err = billingRepo.VerifyManualPayment(ctx, resubmitted.TransactionRef, billingrepo.ManualPaymentReview{Reviewer: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, 800.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+}
+
+func TestManualPaymentStatement(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "manual2", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	submit := func(trxID string, amount float64) billingrepo.ManualPaymentInput {
+		return billingrepo.ManualPaymentInput{
+			Provider: manualpayment.ProviderBkash, SenderNumber: "01700000000", TrxID: trxID, Amount: amount,
+		}
+	}
+
+	pending, _, err := billingRepo.SubmitManualPayment(ctx, clientUser, submit("TRX1", 300))
+	require.NoError(t, err)
+	mismatched, _, err := billingRepo.SubmitManualPayment(ctx, clientUser, submit("TRX2", 1000))
+	require.NoError(t, err)
+
+	otherSender, _, err := billingRepo.SubmitManualPayment(ctx, clientUser, submit("TRX4", 400))
+	require.NoError(t, err)
+
+	entries := []billingrepo.StatementEntryInput{
+		{TrxID: "trx1", Amount: 300, SenderNumber: "+880 1700-000000"},
+		{TrxID: "TRX2", Amount: 100, SenderNumber: "01700000000"},
+		{TrxID: "TRX3", Amount: 250, SenderNumber: "01700000000"},
+		{TrxID: "TRX4", Amount: 400, SenderNumber: "01900000000"},
+		{TrxID: "TRX5", Amount: 150, SenderNumber: "01900000000"},
+	}
+	result, err := billingRepo.ImportStatement(ctx, "bkash", entries, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Imported)
+	require.Len(t, result.Matched, 1)
+	assert.Equal(t, pending.TransactionRef, result.Matched[0].TransactionRef)
+	assert.Equal(t, 2, result.Unclaimed)
+	assert.Equal(t, 300.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// Another amount or another sender number leaves the payment to an operator
+	require.Len(t, result.Mismatched, 2)
+	assert.Equal(t, mismatched.TransactionRef, result.Mismatched[0].Payment.TransactionRef)
+	assert.Equal(t, manualpayment.StatusMismatched, result.Mismatched[0].Payment.Status)
+	assert.Equal(t, otherSender.TransactionRef, result.Mismatched[1].Payment.TransactionRef)
+	assert.Equal(t, "01900000000", result.Mismatched[1].StatementSender)
+	verified, err := billingRepo.VerifyManualPayment(ctx, otherSender.TransactionRef, billingrepo.ManualPaymentReview{
+		Reviewer: "alice", Note: "Paid from a family member's number.",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, manualpayment.StatusCompleted, verified.Status)
+	assert.Equal(t, 700.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// Importing an overlapping statement does not match anything twice
+	result, err = billingRepo.ImportStatement(ctx, "bkash", entries, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Imported)
+	assert.Equal(t, 5, result.Duplicates)
+
+	// A payment submitted after its statement entry was imported is completed right away
+	payment, matched, err := billingRepo.SubmitManualPayment(ctx, clientUser, submit("TRX3", 250))
+	require.NoError(t, err)
+	assert.True(t, matched)
+	assert.Equal(t, manualpayment.StatusCompleted, payment.Status)
+	assert.Equal(t, "statement", payment.VerifiedBy)
+	assert.Equal(t, 950.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// Unless it came from another number than the one on the statement
+	payment, matched, err = billingRepo.SubmitManualPayment(ctx, clientUser, submit("TRX5", 150))
+	require.NoError(t, err)
+	assert.False(t, matched)
+	assert.Equal(t, manualpayment.StatusMismatched, payment.Status)
+	assert.Equal(t, 950.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// The same TrxID on the other provider's statement confirms nothing
+	_, matched, err = billingRepo.SubmitManualPayment(ctx, clientUser, billingrepo.ManualPaymentInput{
+		Provider: manualpayment.ProviderNagad, SenderNumber: "01800000000", TrxID: "TRX3", Amount: 250,
+	})
+	require.NoError(t, err)
+	assert.False(t, matched)
+}
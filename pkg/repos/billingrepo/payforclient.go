@@ -0,0 +1,270 @@
+package billingrepo
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/payrequest"
+)
+
+const (
+	createdByPayForClient = "pay-for-client"
+	// payForClientRefPrefix marks the recharges paid by someone else, whose gateway callback lands on
+	// the public result page instead of the client's dashboard
+	payForClientRefPrefix = "PAY"
+)
+
+var (
+	ErrPayLinkInvalid     = errors.New("payment link is invalid or has expired")
+	ErrPayeeNotFound      = errors.New("no active client with that username")
+	ErrTooManyPayRequests = errors.New("too many payment requests, try again later")
+)
+
+// PayLimits rate limits the public pay for a client page: one IP address may look up or start
+// paying for MaxRequests clients within Window. Zero values are not enforced.
+type PayLimits struct {
+	MaxRequests int
+	Window      time.Duration
+}
+
+// Payee is all someone paying for a client is shown about them
+type Payee struct {
+	ClientID   int
+	MaskedName string
+	// AmountDue is what the client needs to be paid up, see AmountDue
+	AmountDue float64
+}
+
+// PayLinkToken signs a link anyone can use to pay for a client until expires. It carries the
+// client ID rather than anything personal, since whoever holds the link can read it.
+func PayLinkToken(secret string, clientID int, expires time.Time) string {
+	payload := fmt.Sprintf("%d.%d", clientID, expires.Unix())
+	return payload + "." + payLinkSignature(secret, payload)
+}
+
+// ParsePayLinkToken returns the client a payment link is for. Forged and expired links fail with
+// ErrPayLinkInvalid.
+func ParsePayLinkToken(secret, token string, now time.Time) (int, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, ErrPayLinkInvalid
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(payLinkSignature(secret, payload))) {
+		return 0, ErrPayLinkInvalid
+	}
+	clientID, err := strconv.Atoi(parts[0])
+	if err != nil || clientID <= 0 {
+		return 0, ErrPayLinkInvalid
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || !now.Before(time.Unix(expires, 0)) {
+		return 0, ErrPayLinkInvalid
+	}
+	return clientID, nil
+}
+
+func payLinkSignature(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("pay-link|" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:18])
+}
+
+// MaskName keeps the first two letters of each part of a name, enough for someone paying or
+// sending balance to recognise it but not to learn it: "Mamun Ahmed" becomes "Ma*** Ah***".
+func MaskName(name string) string {
+	parts := strings.Fields(name)
+	for i, part := range parts {
+		keep := 2
+		if utf8.RuneCountInString(part) <= 2 {
+			keep = 1
+		}
+		runes := []rune(part)
+		parts[i] = string(runes[:keep]) + "***"
+	}
+	return strings.Join(parts, " ")
+}
+
+// IsPaymentForClientRef reports whether a recharge was paid by someone else on the public page
+func IsPaymentForClientRef(ref string) bool {
+	return strings.HasPrefix(ref, payForClientRefPrefix+"-")
+}
+
+// AmountDue is what a client needs to be paid up: what a postpaid client owes, or what a prepaid
+// client is short of renewing their package
+func (b *BillingRepo) AmountDue(ctx context.Context, client *ent.ClientUser) (float64, error) {
+	if client.BillingMode == clientuser.BillingModePostpaid {
+		return RoundAmount(max(-client.Balance, 0)), nil
+	}
+	plan, _, err := renewalPackage(ctx, b.orm, client)
+	if errors.Is(err, ErrNoPackage) {
+		return RoundAmount(max(-client.Balance, 0)), nil
+	} else if err != nil {
+		return 0, err
+	}
+	return RoundAmount(max(b.PackagePrice(plan)-client.Balance, 0)), nil
+}
+
+// LookupPayee finds the client someone wants to pay for by username. Every lookup counts against
+// the IP's limits, found or not, so the page cannot be used to test many usernames.
+func (b *BillingRepo) LookupPayee(ctx context.Context, username, ip string, limits PayLimits) (*Payee, error) {
+	if err := b.checkPayLimits(ctx, ip, limits); err != nil {
+		return nil, err
+	}
+
+	client, err := b.orm.ClientUser.Query().
+		Where(
+			clientuser.UsernameEQ(strings.TrimSpace(username)),
+			clientuser.StatusNEQ(clientuser.StatusInactive),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	create := b.orm.PayRequest.Create().
+		SetIP(ip).
+		SetKind(payrequest.KindLookup)
+	if client != nil {
+		create.SetClientUsername(client.Username)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, ErrPayeeNotFound
+	}
+	return b.payee(ctx, client)
+}
+
+// GetPayee returns what is shown about the client a payment link is for
+func (b *BillingRepo) GetPayee(ctx context.Context, clientID int) (*Payee, error) {
+	client, err := b.activePayee(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return b.payee(ctx, client)
+}
+
+// StartPaymentForClient starts a recharge of a client's balance paid by someone else, payer being
+// the name they gave. Like any recharge it is credited when the gateway calls back.
+func (b *BillingRepo) StartPaymentForClient(
+	ctx context.Context, clientID int, amount float64, method clienttxn.PaymentMethod, payer, ip string, limits PayLimits,
+) (*ent.ClientTxn, *ent.ClientUser, error) {
+	amount = RoundAmount(amount)
+	if amount <= 0 {
+		return nil, nil, ErrInvalidAmount
+	}
+	if err := b.checkPayLimits(ctx, ip, limits); err != nil {
+		return nil, nil, err
+	}
+	client, err := b.activePayee(ctx, clientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	description := fmt.Sprintf("Balance recharge via %s paid by someone else", method)
+	if payer = strings.TrimSpace(payer); payer != "" {
+		description = fmt.Sprintf("Balance recharge via %s paid by %s", method, payer)
+	}
+
+	var txn *ent.ClientTxn
+	err = WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		err := tx.PayRequest.Create().
+			SetIP(ip).
+			SetKind(payrequest.KindCheckout).
+			SetClientUsername(client.Username).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		txn, err = tx.ClientTxn.Create().
+			SetTransactionRef(NewTransactionRef(payForClientRefPrefix)).
+			SetAmount(amount).
+			SetType(clienttxn.TypeRECHARGE).
+			SetStatus(clienttxn.StatusPending).
+			SetTotalBalance(client.Balance).
+			SetPaymentMethod(method).
+			SetClientUsername(client.Username).
+			SetDescription(description).
+			SetCreatedBy(createdByPayForClient).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return txn, client, nil
+}
+
+// GetPaymentForClient returns a recharge paid by someone else, for the payer to see its outcome
+func (b *BillingRepo) GetPaymentForClient(ctx context.Context, ref string) (*ent.ClientTxn, error) {
+	if !IsPaymentForClientRef(ref) {
+		return nil, ErrTxnNotFound
+	}
+	txn, err := b.orm.ClientTxn.Query().
+		Where(
+			clienttxn.TransactionRefEQ(ref),
+			clienttxn.CreatedByEQ(createdByPayForClient),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrTxnNotFound
+	}
+	return txn, err
+}
+
+func (b *BillingRepo) payee(ctx context.Context, client *ent.ClientUser) (*Payee, error) {
+	due, err := b.AmountDue(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return &Payee{
+		ClientID:   client.ID,
+		MaskedName: MaskName(client.Name),
+		AmountDue:  due,
+	}, nil
+}
+
+func (b *BillingRepo) activePayee(ctx context.Context, clientID int) (*ent.ClientUser, error) {
+	client, err := b.orm.ClientUser.Query().
+		Where(
+			clientuser.IDEQ(clientID),
+			clientuser.StatusNEQ(clientuser.StatusInactive),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrPayeeNotFound
+	}
+	return client, err
+}
+
+func (b *BillingRepo) checkPayLimits(ctx context.Context, ip string, limits PayLimits) error {
+	if limits.MaxRequests <= 0 {
+		return nil
+	}
+	n, err := b.orm.PayRequest.Query().
+		Where(
+			payrequest.IPEQ(ip),
+			payrequest.CreatedAtGT(time.Now().Add(-limits.Window)),
+		).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+	if n >= limits.MaxRequests {
+		return ErrTooManyPayRequests
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package billingrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+)
+
+func TestPayLinkToken(t *testing.T) {
+	now := time.Date(2025, 11, 14, 13, 0, 0, 0, time.Local)
+	token := billingrepo.PayLinkToken("secret", 42, now.Add(time.Hour))
+
+	clientID, err := billingrepo.ParsePayLinkToken("secret", token, now)
+	require.NoError(t, err)
+	assert.Equal(t, 42, clientID)
+
+	_, err = billingrepo.ParsePayLinkToken("secret", token, now.Add(time.Hour))
+	assert.ErrorIs(t, err, billingrepo.ErrPayLinkInvalid)
+	_, err = billingrepo.ParsePayLinkToken("other", token, now)
+	assert.ErrorIs(t, err, billingrepo.ErrPayLinkInvalid)
+
+	// Pointing a link at another client breaks its signature
+	forged := "43" + token[2:]
+	_, err = billingrepo.ParsePayLinkToken("secret", forged, now)
+	assert.ErrorIs(t, err, billingrepo.ErrPayLinkInvalid)
+	_, err = billingrepo.ParsePayLinkToken("secret", "not-a-token", now)
+	assert.ErrorIs(t, err, billingrepo.ErrPayLinkInvalid)
+}
+
+func TestMaskName(t *testing.T) {
+	assert.Equal(t, "Ma*** Ah***", billingrepo.MaskName("Mamun Ahmed"))
+	assert.Equal(t, "M*** Ra***", billingrepo.MaskName(" Md  Rahim "))
+	assert.Equal(t, "", billingrepo.MaskName(""))
+
+	// Name lengths are not given away, and letters are counted as runes
+	assert.Equal(t, "Ra*** Ud***", billingrepo.MaskName("Rahim  Uddin"))
+	assert.Equal(t, "Ab***", billingrepo.MaskName("Abdurrahman"))
+	assert.Equal(t, "রহ***", billingrepo.MaskName("রহিম"))
+	assert.Equal(t, "র***", billingrepo.MaskName("র"))
+}
+
+func TestIsPaymentForClientRef(t *testing.T) {
+	assert.True(t, billingrepo.IsPaymentForClientRef("PAY-20251114-3F9A1C2B"))
+	assert.False(t, billingrepo.IsPaymentForClientRef("TOP-20251114-3F9A1C2B"))
+	assert.False(t, billingrepo.IsPaymentForClientRef(""))
+}
@@ -0,0 +1,313 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/packageplan"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+)
+
+var (
+	ErrPlanNotFound = errors.New("package not found or not available")
+	ErrSamePlan     = errors.New("client is already on this package")
+	ErrPlanChanged  = errors.New("package or price changed since the preview")
+)
+
+// PlanChangeQuote is the price of switching a client to another package right away. Everything
+// left until expiry, including any cycles prepaid in a bundle, is credited at the old price, up to
+// what the client paid for it, and charged again at the new one.
+type PlanChangeQuote struct {
+	From *ent.PackagePlan
+	To   *ent.PackagePlan
+	// Expiry is the current RADIUS expiry, which an immediate change keeps
+	Expiry   *time.Time
+	DaysLeft int
+	Credit   float64
+	Charge   float64
+	// Coupon is the coupon applied to the change, if any, and Discount what it took off the charge
+	Coupon   *ent.Coupon
+	Discount float64
+	// Tax splits the change into the net amount and tax on it
+	Tax TaxBreakdown
+	// Amount is what the client pays for the change, tax included. A negative amount is credited
+	// to them.
+	Amount float64
+}
+
+// QuotePlanChange prices an immediate switch of a client to the given package, with the coupon
+// they entered if couponCode is set
+func (b *BillingRepo) QuotePlanChange(
+	ctx context.Context, client *ent.ClientUser, planID int, couponCode string,
+) (*PlanChangeQuote, error) {
+	return b.quotePlanChange(ctx, b.orm, client, planID, couponCode, false)
+}
+
+// ChangePlanNow switches a client to another package immediately. The prorated difference is
+// debited from or credited to their balance and recorded as a PACKAGE_MIGRATION transaction.
+// expectedAmount is the amount the client confirmed; if the price has moved since, ErrPlanChanged
+// is returned so they can review it again. A coupon can only be redeemed on a change that costs
+// the client something.
+func (b *BillingRepo) ChangePlanNow(
+	ctx context.Context, clientID, planID int, expectedAmount float64, couponCode, createdBy string,
+) (*PlanChangeQuote, *ent.ClientTxn, error) {
+	var (
+		quote *PlanChangeQuote
+		txn   *ent.ClientTxn
+	)
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		if couponCode != "" {
+			if err := claimCoupon(ctx, tx, couponCode); err != nil {
+				return err
+			}
+		}
+
+		client, err := tx.ClientUser.Get(ctx, clientID)
+		if ent.IsNotFound(err) {
+			return ErrClientNotFound
+		} else if err != nil {
+			return err
+		}
+
+		quote, err = b.quotePlanChange(ctx, tx.Client(), client, planID, couponCode, true)
+		if err != nil {
+			return err
+		}
+		if math.Abs(quote.Amount-RoundAmount(expectedAmount)) >= 0.01 {
+			return ErrPlanChanged
+		}
+
+		// Switching only from the profile the quote was based on makes a repeated submit a no-op
+		update := tx.ClientUser.Update().
+			Where(
+				clientuser.IDEQ(client.ID),
+				clientuser.UserProfileEQ(client.UserProfile),
+			).
+			SetUserProfile(quote.To.ProfileName).
+			SetPackagePool(quote.To.PoolName).
+			ClearNextUserProfile().
+			SetUpdatedBy(createdBy).
+			AddBalance(-quote.Amount)
+		if quote.Amount > 0 {
+			update.Where(canCharge(quote.Amount))
+		}
+		n, err := update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			if quote.Amount > 0 && AvailableCredit(client) < quote.Amount {
+				return ErrInsufficientBalance
+			}
+			return ErrPlanChanged
+		}
+
+		balance, err := balanceOf(ctx, tx, client.Username)
+		if err != nil {
+			return err
+		}
+
+		txn, err = withTax(withDiscount(tx.ClientTxn.Create(), quote.Coupon, quote.Discount), quote.Tax).
+			SetTransactionRef(NewTransactionRef("MIG")).
+			SetAmount(quote.Amount).
+			SetType(clienttxn.TypePACKAGE_MIGRATION).
+			SetStatus(clienttxn.StatusCompleted).
+			SetTotalBalance(balance).
+			SetServiceDays(quote.DaysLeft).
+			SetPaymentMethod(clienttxn.PaymentMethodClientBalance).
+			SetClientUsername(client.Username).
+			SetDescription(fmt.Sprintf("Changed package from %s to %s, %d days prorated",
+				quote.From.Name, quote.To.Name, quote.DaysLeft)).
+			SetCreatedBy(createdBy).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if quote.Coupon != nil {
+			if err := redeemCoupon(ctx, tx, quote.Coupon, client, txn); err != nil {
+				return err
+			}
+		}
+
+		return radiusrepo.NewRadiusRepo(tx.Client()).SetGroup(ctx, client.Username, quote.To.ProfileName)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	b.applyProfile(ctx, txn.ClientUsername, quote.To.ProfileName)
+	return quote, txn, nil
+}
+
+// SchedulePlanChange switches a client to another package at their next renewal, free of charge.
+// Scheduling the current package cancels a previously scheduled change.
+func (b *BillingRepo) SchedulePlanChange(ctx context.Context, clientID, planID int, updatedBy string) (*ent.PackagePlan, error) {
+	client, err := b.orm.ClientUser.Get(ctx, clientID)
+	if ent.IsNotFound(err) {
+		return nil, ErrClientNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	plan, err := activePlan(ctx, b.orm, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := b.orm.ClientUser.UpdateOneID(client.ID).SetUpdatedBy(updatedBy)
+	if plan.ProfileName == client.UserProfile {
+		update.ClearNextUserProfile()
+	} else {
+		update.SetNextUserProfile(plan.ProfileName)
+	}
+	return plan, update.Exec(ctx)
+}
+
+// ActivePlans lists the packages clients can switch to, cheapest first
+func (b *BillingRepo) ActivePlans(ctx context.Context) ([]*ent.PackagePlan, error) {
+	return b.orm.PackagePlan.Query().
+		Where(packageplan.IsActiveEQ(true)).
+		Order(ent.Asc(packageplan.FieldPrice), ent.Asc(packageplan.FieldName)).
+		All(ctx)
+}
+
+func (b *BillingRepo) quotePlanChange(
+	ctx context.Context, orm *ent.Client, client *ent.ClientUser, planID int, couponCode string, claimed bool,
+) (*PlanChangeQuote, error) {
+	from, err := planByProfile(ctx, orm, client.UserProfile)
+	if err != nil {
+		return nil, err
+	}
+	to, err := activePlan(ctx, orm, planID)
+	if err != nil {
+		return nil, err
+	}
+	if to.ProfileName == client.UserProfile {
+		return nil, ErrSamePlan
+	}
+
+	expiry, grace, err := billingExpiry(ctx, orm, client)
+	if err != nil {
+		return nil, err
+	}
+	if grace != nil {
+		// Switching would lift the throttling or suspension without paying for the package
+		return nil, ErrInGracePeriod
+	}
+
+	quote := &PlanChangeQuote{
+		From:     from,
+		To:       to,
+		Expiry:   expiry,
+		DaysLeft: DaysLeft(expiry, time.Now()),
+	}
+	// The days left are credited at the package price, but never beyond what the client actually
+	// paid for them, so a discounted bundle or coupon does not come back at full price
+	paid, err := b.unusedValue(ctx, orm, client.Username, quote.DaysLeft)
+	if err != nil {
+		return nil, err
+	}
+	quote.Credit = RoundAmount(math.Min(from.Price*float64(quote.DaysLeft)/float64(b.cycleDays), paid))
+	quote.Charge = RoundAmount(to.Price * float64(quote.DaysLeft) / float64(b.cycleDays))
+	quote.Amount = RoundAmount(quote.Charge - quote.Credit)
+
+	if couponCode != "" {
+		if quote.Amount <= 0 {
+			return nil, ErrCouponNotEligible
+		}
+		quote.Coupon, quote.Discount, err = applyCoupon(ctx, orm, couponCode, client, to, quote.Amount, claimed)
+		if err != nil {
+			return nil, err
+		}
+		quote.Amount = RoundAmount(quote.Amount - quote.Discount)
+	}
+	quote.Tax = b.tax.Apply(ServiceInternet, quote.Amount)
+	quote.Amount = quote.Tax.Gross
+	return quote, nil
+}
+
+// unusedValue is what a client paid, before tax, for the days left until expiry: the share of the
+// renewal that bought them and of any package changes since, each spread over the days it paid for
+func (b *BillingRepo) unusedValue(ctx context.Context, orm *ent.Client, username string, daysLeft int) (float64, error) {
+	renewal, err := orm.ClientTxn.Query().
+		Where(
+			clienttxn.ClientUsernameEQ(username),
+			clienttxn.TypeIn(clienttxn.TypeACTIVE, clienttxn.TypeRENEWAL, clienttxn.TypeAUTO_RENEWAL, clienttxn.TypeADVANCE_PAYMENT),
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+		).
+		Order(ent.Desc(clienttxn.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	changes, err := orm.ClientTxn.Query().
+		Where(
+			clienttxn.ClientUsernameEQ(username),
+			clienttxn.TypeEQ(clienttxn.TypePACKAGE_MIGRATION),
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+			clienttxn.IDGT(renewal.ID),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var value float64
+	for _, txn := range append(changes, renewal) {
+		paid := txn.Amount
+		if txn.NetAmount != 0 {
+			paid = txn.NetAmount
+		}
+		// Transactions from before service days were recorded paid for a single cycle
+		days := txn.ServiceDays
+		if days <= 0 {
+			days = b.cycleDays
+		}
+		value += paid * math.Min(float64(daysLeft)/float64(days), 1)
+	}
+	return math.Max(value, 0), nil
+}
+
+// DaysLeft returns the started days left until expiry. An immediate change keeps the expiry, so
+// this spans every cycle the client has prepaid, not just the current one.
+func DaysLeft(expiry *time.Time, now time.Time) int {
+	if expiry == nil || !expiry.After(now) {
+		return 0
+	}
+	return int(math.Ceil(expiry.Sub(now).Hours() / 24))
+}
+
+// activePlan returns a package clients may subscribe to
+func activePlan(ctx context.Context, orm *ent.Client, planID int) (*ent.PackagePlan, error) {
+	plan, err := orm.PackagePlan.Query().
+		Where(
+			packageplan.IDEQ(planID),
+			packageplan.IsActiveEQ(true),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrPlanNotFound
+	}
+	return plan, err
+}
+
+// planByProfile returns the package with the given RADIUS profile
+func planByProfile(ctx context.Context, orm *ent.Client, profile string) (*ent.PackagePlan, error) {
+	if profile == "" {
+		return nil, ErrNoPackage
+	}
+	plan, err := orm.PackagePlan.Query().
+		Where(packageplan.ProfileNameEQ(profile)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNoPackage
+	}
+	return plan, err
+}
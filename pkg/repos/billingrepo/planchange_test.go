@@ -0,0 +1,88 @@
+package billingrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestDaysLeft(t *testing.T) {
+	now := time.Date(2025, 11, 14, 13, 0, 0, 0, time.Local)
+
+	expiry := now.Add(9*24*time.Hour + time.Hour)
+	assert.Equal(t, 10, billingrepo.DaysLeft(&expiry, now), "a started day counts as a full day")
+
+	expiry = now.AddDate(0, 0, 45)
+	assert.Equal(t, 45, billingrepo.DaysLeft(&expiry, now), "prepaid cycles count too")
+
+	expiry = now.Add(-time.Minute)
+	assert.Equal(t, 0, billingrepo.DaysLeft(&expiry, now))
+	assert.Equal(t, 0, billingrepo.DaysLeft(nil, now))
+}
+
+func TestChangePlanAfterBundle(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	basic := tests.CreatePackagePlan(ctx, client, "basic", 300)
+	premium := tests.CreatePackagePlan(ctx, client, "premium", 600)
+	clientUser := tests.CreateSubscriber(ctx, client, "bundle1", 2000, basic)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+
+	renewal, err := billingRepo.RenewPackage(ctx, clientUser.ID, billingrepo.RenewOptions{
+		Bundle: &billingrepo.AdvanceBundle{Cycles: 3},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 900.0, renewal.Txn.Amount)
+	assert.Equal(t, 1100.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// The upgrade is charged for all three prepaid cycles, not just the first
+	quote, err := billingRepo.QuotePlanChange(ctx, clientUser, premium.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, 90, quote.DaysLeft)
+	assert.Equal(t, 900.0, quote.Credit)
+	assert.Equal(t, 1800.0, quote.Charge)
+	assert.Equal(t, 900.0, quote.Amount)
+
+	_, txn, err := billingRepo.ChangePlanNow(ctx, clientUser.ID, premium.ID, quote.Amount, "", "test")
+	require.NoError(t, err)
+	assert.Equal(t, clienttxn.TypePACKAGE_MIGRATION, txn.Type)
+	assert.Equal(t, 200.0, txn.TotalBalance)
+	clientUser = client.ClientUser.GetX(ctx, clientUser.ID)
+	assert.Equal(t, premium.ProfileName, clientUser.UserProfile)
+	assert.Equal(t, 200.0, clientUser.Balance)
+
+	// The expiry is kept, so downgrading again credits the same three cycles back
+	expiry, err := radiusrepo.NewRadiusRepo(client).Expiry(ctx, clientUser.Username)
+	require.NoError(t, err)
+	assert.Equal(t, renewal.Expiry.Unix(), expiry.Unix())
+
+	quote, err = billingRepo.QuotePlanChange(ctx, clientUser, basic.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, -900.0, quote.Amount)
+	_, _, err = billingRepo.ChangePlanNow(ctx, clientUser.ID, basic.ID, quote.Amount, "", "test")
+	require.NoError(t, err)
+	assert.Equal(t, 1100.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// A discounted bundle is credited at what was paid for it, not at the package price
+	discounted := tests.CreateSubscriber(ctx, client, "bundle2", 2000, basic)
+	renewal, err = billingRepo.RenewPackage(ctx, discounted.ID, billingrepo.RenewOptions{
+		Bundle: &billingrepo.AdvanceBundle{Cycles: 3, Discount: 10},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 810.0, renewal.Txn.Amount)
+
+	quote, err = billingRepo.QuotePlanChange(ctx, discounted, premium.ID, "")
+	require.NoError(t, err)
+	assert.Equal(t, 90, quote.DaysLeft)
+	assert.LessOrEqual(t, quote.Credit, renewal.Txn.Amount)
+	assert.Equal(t, 810.0, quote.Credit)
+	assert.Equal(t, 990.0, quote.Amount)
+}
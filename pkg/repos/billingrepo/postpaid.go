@@ -0,0 +1,637 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clientaddon"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
+	"github.com/mikestefanello/pagoda/ent/predicate"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const createdByPostpaid = "postpaid-billing"
+
+var (
+	ErrPostpaid           = errors.New("postpaid clients are billed monthly and do not renew")
+	ErrAmountDue          = errors.New("client has an amount due, settle it first")
+	ErrAddonNotFound      = errors.New("add-on not found")
+	ErrInvalidBillingMode = errors.New("billing mode must be prepaid or postpaid")
+)
+
+// PostpaidPolicy is how postpaid clients are billed
+type PostpaidPolicy struct {
+	// DueDays is how long after the end of the billed month an invoice is due
+	DueDays int
+	// SuspendAfterDays is how long after the due date a client with an unpaid invoice is suspended
+	SuspendAfterDays int
+	// SuspendedProfile is the RADIUS group suspended clients are moved to, left alone when empty
+	SuspendedProfile string
+}
+
+// PostpaidSummary describes one run of RunPostpaidBilling
+type PostpaidSummary struct {
+	// Period is the first moment of the month invoiced by this run
+	Period    time.Time `json:"period"`
+	Issued    int       `json:"issued"`
+	Billed    float64   `json:"billed"`
+	Overdue   int       `json:"overdue"`
+	Suspended int       `json:"suspended"`
+	Failed    int       `json:"failed"`
+	// Invoices, NewlyOverdue and SuspendedClients list what changed, to notify the clients
+	Invoices         []PostpaidInvoice `json:"-"`
+	NewlyOverdue     []PostpaidInvoice `json:"-"`
+	SuspendedClients []*ent.ClientUser `json:"-"`
+}
+
+// PostpaidInvoice is an invoice with the client it was issued to
+type PostpaidInvoice struct {
+	Client  *ent.ClientUser
+	Invoice *ent.Invoice
+}
+
+// PostpaidAccount is what a postpaid client owes
+type PostpaidAccount struct {
+	CreditLimit float64
+	// AmountDue is the negative part of the balance
+	AmountDue       float64
+	AvailableCredit float64
+	// Invoices are the unpaid invoices, oldest first
+	Invoices []*ent.Invoice
+}
+
+// AvailableCredit is how much can be charged to a client's balance: the balance itself, plus the
+// credit limit of a postpaid client
+func AvailableCredit(client *ent.ClientUser) float64 {
+	if client.BillingMode == clientuser.BillingModePostpaid {
+		return RoundAmount(client.Balance + client.CreditLimit)
+	}
+	return RoundAmount(client.Balance)
+}
+
+// monthStart returns the first moment of the month t falls in
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// PackageCharge is what a postpaid client owes for a month of their package. A client who switched
+// to postpaid during the month pays for the days since.
+func PackageCharge(price float64, periodStart, periodEnd time.Time, since *time.Time) float64 {
+	if since == nil || !since.After(periodStart) {
+		return RoundAmount(price)
+	}
+	if !since.Before(periodEnd) {
+		return 0
+	}
+	days := math.Round(periodEnd.Sub(periodStart).Hours() / 24)
+	used := math.Round(periodEnd.Sub(startOfDay(*since)).Hours() / 24)
+	return RoundAmount(price * used / days)
+}
+
+// SetBillingMode switches a client between prepaid and postpaid. A postpaid client's RADIUS expiry
+// is moved to the end of next month and pushed on by every invoice, so their service no longer
+// lapses between payments. A client can only go back to prepaid once nothing is due.
+func (b *BillingRepo) SetBillingMode(
+	ctx context.Context, username string, mode clientuser.BillingMode, creditLimit float64, updatedBy string,
+) (*ent.ClientUser, error) {
+	if clientuser.BillingModeValidator(mode) != nil {
+		return nil, ErrInvalidBillingMode
+	}
+	if creditLimit < 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	var updated *ent.ClientUser
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		client, err := tx.ClientUser.Query().
+			Where(clientuser.UsernameEQ(username)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return ErrClientNotFound
+		} else if err != nil {
+			return err
+		}
+
+		update := tx.ClientUser.UpdateOneID(client.ID).
+			SetBillingMode(mode).
+			SetUpdatedBy(updatedBy)
+		if mode == clientuser.BillingModePrepaid {
+			if client.Balance < 0 {
+				return ErrAmountDue
+			}
+			update.SetCreditLimit(0).ClearPostpaidSince()
+		} else {
+			update.SetCreditLimit(RoundAmount(creditLimit))
+			if client.BillingMode != clientuser.BillingModePostpaid {
+				update.SetPostpaidSince(time.Now())
+				if err := b.startPostpaid(ctx, tx, client); err != nil {
+					return err
+				}
+				update.SetStatus(clientuser.StatusActive)
+			}
+		}
+		updated, err = update.Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// startPostpaid ends the grace period of a client switching to postpaid and keeps their RADIUS
+// expiry from lapsing
+func (b *BillingRepo) startPostpaid(ctx context.Context, tx *ent.Tx, client *ent.ClientUser) error {
+	grace, err := openGracePeriod(ctx, tx.Client(), client.ID)
+	if err != nil {
+		return err
+	}
+	if grace != nil {
+		if err := endGrace(ctx, tx, grace); err != nil {
+			return err
+		}
+		if client.UserProfile != "" {
+			if err := radiusrepo.NewRadiusRepo(tx.Client()).SetGroup(ctx, client.Username, client.UserProfile); err != nil {
+				return err
+			}
+		}
+	}
+	return extendPostpaidExpiry(ctx, tx, client.Username, time.Now())
+}
+
+// AddAddon bills a postpaid client amount every month on top of their package
+func (b *BillingRepo) AddAddon(ctx context.Context, username, name string, amount float64, createdBy string) (*ent.ClientAddon, error) {
+	if RoundAmount(amount) <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	client, err := b.orm.ClientUser.Query().
+		Where(clientuser.UsernameEQ(username)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrClientNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return b.orm.ClientAddon.Create().
+		SetClientID(client.ID).
+		SetClientUsername(client.Username).
+		SetName(strings.TrimSpace(name)).
+		SetAmount(RoundAmount(amount)).
+		SetCreatedBy(createdBy).
+		Save(ctx)
+}
+
+// RemoveAddon stops billing an add-on, including for the month in progress
+func (b *BillingRepo) RemoveAddon(ctx context.Context, id int) error {
+	n, err := b.orm.ClientAddon.Update().
+		Where(
+			clientaddon.IDEQ(id),
+			clientaddon.ActiveEQ(true),
+		).
+		SetActive(false).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrAddonNotFound
+	}
+	return nil
+}
+
+// ClientAddons lists the add-ons billed to a client
+func (b *BillingRepo) ClientAddons(ctx context.Context, username string) ([]*ent.ClientAddon, error) {
+	return b.orm.ClientAddon.Query().
+		Where(
+			clientaddon.ClientUsernameEQ(username),
+			clientaddon.ActiveEQ(true),
+		).
+		Order(ent.Asc(clientaddon.FieldID)).
+		All(ctx)
+}
+
+// ClientInvoices lists a client's invoices, newest first
+func (b *BillingRepo) ClientInvoices(ctx context.Context, username string, limit int) ([]*ent.Invoice, error) {
+	return b.orm.Invoice.Query().
+		Where(invoice.ClientUsernameEQ(username)).
+		Order(ent.Desc(invoice.FieldPeriodStart)).
+		Limit(limit).
+		All(ctx)
+}
+
+// InvoiceLines lists the charges on an invoice
+func (b *BillingRepo) InvoiceLines(ctx context.Context, invoiceID int) ([]*ent.InvoiceLine, error) {
+	return b.orm.InvoiceLine.Query().
+		Where(invoiceline.InvoiceIDEQ(invoiceID)).
+		Order(ent.Asc(invoiceline.FieldID)).
+		All(ctx)
+}
+
+// GetPostpaidAccount returns what a postpaid client owes
+func (b *BillingRepo) GetPostpaidAccount(ctx context.Context, client *ent.ClientUser) (*PostpaidAccount, error) {
+	invoices, err := b.orm.Invoice.Query().
+		Where(
+			invoice.ClientIDEQ(client.ID),
+			invoice.StatusIn(invoice.StatusOpen, invoice.StatusOverdue),
+		).
+		Order(ent.Asc(invoice.FieldPeriodStart)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &PostpaidAccount{
+		CreditLimit:     client.CreditLimit,
+		AmountDue:       RoundAmount(max(-client.Balance, 0)),
+		AvailableCredit: AvailableCredit(client),
+		Invoices:        invoices,
+	}, nil
+}
+
+// RunPostpaidBilling invoices every postpaid client for the month before now, marks the invoices
+// past their due date overdue and suspends the clients who left one unpaid for longer than the
+// policy allows. Runs are idempotent, so it can run daily and catch up after downtime.
+func (b *BillingRepo) RunPostpaidBilling(ctx context.Context, now time.Time, policy PostpaidPolicy) (*PostpaidSummary, error) {
+	periodEnd := monthStart(now)
+	summary := &PostpaidSummary{Period: periodEnd.AddDate(0, -1, 0)}
+
+	clients, err := b.orm.ClientUser.Query().
+		Where(
+			clientuser.BillingModeEQ(clientuser.BillingModePostpaid),
+			clientuser.StatusNEQ(clientuser.StatusInactive),
+		).
+		Order(ent.Asc(clientuser.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, client := range clients {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+		inv, err := b.issueInvoice(ctx, client, summary.Period, periodEnd, now, policy)
+		if err != nil {
+			summary.Failed++
+			log.Error().Err(err).Str("username", client.Username).Msg("failed to issue postpaid invoice")
+			continue
+		}
+		if inv != nil {
+			summary.Issued++
+			summary.Billed = RoundAmount(summary.Billed + inv.Amount)
+			summary.Invoices = append(summary.Invoices, PostpaidInvoice{Client: client, Invoice: inv})
+		}
+	}
+
+	overdue, err := b.orm.Invoice.Query().
+		Where(
+			invoice.StatusEQ(invoice.StatusOpen),
+			invoice.DueAtLT(now),
+		).
+		Order(ent.Asc(invoice.FieldDueAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, inv := range overdue {
+		n, err := b.orm.Invoice.Update().
+			Where(
+				invoice.IDEQ(inv.ID),
+				invoice.StatusEQ(invoice.StatusOpen),
+			).
+			SetStatus(invoice.StatusOverdue).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+		client, err := b.orm.ClientUser.Get(ctx, inv.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		inv.Status = invoice.StatusOverdue
+		summary.Overdue++
+		summary.NewlyOverdue = append(summary.NewlyOverdue, PostpaidInvoice{Client: client, Invoice: inv})
+	}
+
+	late, err := b.orm.Invoice.Query().
+		Where(
+			invoice.StatusEQ(invoice.StatusOverdue),
+			invoice.DueAtLT(now.AddDate(0, 0, -policy.SuspendAfterDays)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	suspended := make(map[int]bool)
+	for _, inv := range late {
+		if suspended[inv.ClientID] {
+			continue
+		}
+		suspended[inv.ClientID] = true
+		client, err := b.suspendPostpaid(ctx, inv.ClientID, policy)
+		if err != nil {
+			summary.Failed++
+			log.Error().Err(err).Str("username", inv.ClientUsername).Msg("failed to suspend postpaid client")
+			continue
+		}
+		if client != nil {
+			summary.Suspended++
+			summary.SuspendedClients = append(summary.SuspendedClients, client)
+		}
+	}
+	return summary, nil
+}
+
+// SettleInvoices marks the invoices a postpaid client's payments cover as paid, oldest first, and
+// reactivates them once nothing overdue is left. It returns the invoices paid off by this call.
+func (b *BillingRepo) SettleInvoices(ctx context.Context, username string) ([]*ent.Invoice, error) {
+	var paid []*ent.Invoice
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		var err error
+		paid, err = settleInvoices(ctx, tx, username)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paid, nil
+}
+
+// issueInvoice bills a postpaid client for the month from periodStart to periodEnd, returning nil
+// if they were billed already or owe nothing for it
+func (b *BillingRepo) issueInvoice(
+	ctx context.Context, client *ent.ClientUser, periodStart, periodEnd, now time.Time, policy PostpaidPolicy,
+) (*ent.Invoice, error) {
+	type line struct {
+		kind        invoiceline.Kind
+		description string
+		charge      TaxBreakdown
+	}
+	var lines []line
+
+	plan, err := planByProfile(ctx, b.orm, client.UserProfile)
+	switch {
+	case err == nil:
+		if charge := PackageCharge(plan.Price, periodStart, periodEnd, client.PostpaidSince); charge > 0 {
+			lines = append(lines, line{invoiceline.KindPackage,
+				fmt.Sprintf("%s, %s", plan.Name, periodStart.Format("January 2006")), b.tax.Apply(ServiceInternet, charge)})
+		}
+	case !errors.Is(err, ErrNoPackage):
+		return nil, err
+	}
+	addons, err := b.orm.ClientAddon.Query().
+		Where(
+			clientaddon.ClientIDEQ(client.ID),
+			clientaddon.ActiveEQ(true),
+			clientaddon.CreatedAtLT(periodEnd),
+		).
+		Order(ent.Asc(clientaddon.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range addons {
+		lines = append(lines, line{invoiceline.KindAddon, a.Name, b.tax.Apply(ServiceAddon, a.Amount)})
+	}
+
+	var total, net, tax float64
+	for _, l := range lines {
+		total = RoundAmount(total + l.charge.Gross)
+		net = RoundAmount(net + l.charge.Net)
+		tax = RoundAmount(tax + l.charge.Tax)
+	}
+	if total <= 0 {
+		return nil, nil
+	}
+
+	number := fmt.Sprintf("INV-%s-%06d", periodStart.Format("200601"), client.ID)
+	var inv *ent.Invoice
+	err = WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		var err error
+		inv, err = tx.Invoice.Create().
+			SetNumber(number).
+			SetClientID(client.ID).
+			SetClientUsername(client.Username).
+			SetPeriodStart(periodStart).
+			SetPeriodEnd(periodEnd).
+			SetAmount(total).
+			SetNetAmount(net).
+			SetTaxAmount(tax).
+			SetDueAt(periodEnd.AddDate(0, 0, policy.DueDays)).
+			SetTransactionRef(number).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range lines {
+			err := tx.InvoiceLine.Create().
+				SetInvoiceID(inv.ID).
+				SetKind(l.kind).
+				SetDescription(l.description).
+				SetAmount(l.charge.Gross).
+				SetNetAmount(l.charge.Net).
+				SetTaxAmount(l.charge.Tax).
+				SetTaxRate(l.charge.Rate).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Service already used is charged even past the credit limit
+		balance, err := credit(ctx, tx, client.Username, -total)
+		if err != nil {
+			return err
+		}
+		err = tx.ClientTxn.Create().
+			SetTransactionRef(number).
+			SetAmount(total).
+			SetNetAmount(net).
+			SetTaxAmount(tax).
+			SetType(clienttxn.TypePOSTPAID_INVOICE).
+			SetStatus(clienttxn.StatusCompleted).
+			SetTotalBalance(balance).
+			SetPaymentMethod(clienttxn.PaymentMethodClientBalance).
+			SetClientUsername(client.Username).
+			SetDescription(fmt.Sprintf("Invoice %s for %s", number, periodStart.Format("January 2006"))).
+			SetCreatedBy(createdByPostpaid).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := extendPostpaidExpiry(ctx, tx, client.Username, now); err != nil {
+			return err
+		}
+		// A client with credit on their balance has paid already
+		if _, err := settleInvoices(ctx, tx, client.Username); err != nil {
+			return err
+		}
+		inv, err = tx.Invoice.Get(ctx, inv.ID)
+		return err
+	})
+	if ent.IsConstraintError(err) {
+		// Issued by an earlier or overlapping run
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// suspendPostpaid suspends a postpaid client with an invoice left unpaid too long, returning nil
+// if they were suspended already or have paid since
+func (b *BillingRepo) suspendPostpaid(ctx context.Context, clientID int, policy PostpaidPolicy) (*ent.ClientUser, error) {
+	var suspended *ent.ClientUser
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		n, err := tx.ClientUser.Update().
+			Where(
+				clientuser.IDEQ(clientID),
+				clientuser.BillingModeEQ(clientuser.BillingModePostpaid),
+				clientuser.StatusNotIn(clientuser.StatusSuspended, clientuser.StatusInactive),
+				clientuser.BalanceLT(0),
+			).
+			SetStatus(clientuser.StatusSuspended).
+			SetUpdatedBy(createdByPostpaid).
+			Save(ctx)
+		if err != nil || n == 0 {
+			return err
+		}
+		suspended, err = tx.ClientUser.Get(ctx, clientID)
+		if err != nil {
+			return err
+		}
+		if policy.SuspendedProfile != "" {
+			if err := radiusrepo.NewRadiusRepo(tx.Client()).SetGroup(ctx, suspended.Username, policy.SuspendedProfile); err != nil {
+				return err
+			}
+		}
+		return radiusrepo.NewRadiusRepo(tx.Client()).SetExpiry(ctx, suspended.Username, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	if suspended != nil {
+		b.disconnect(ctx, suspended.Username)
+	}
+	return suspended, nil
+}
+
+// settleInvoices applies what a postpaid client has paid to their unpaid invoices, oldest first.
+// Whatever part of the unpaid total is no longer owed on the balance has been paid.
+func settleInvoices(ctx context.Context, tx *ent.Tx, username string) ([]*ent.Invoice, error) {
+	client, err := tx.ClientUser.Query().
+		Where(clientuser.UsernameEQ(username)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	unpaid, err := tx.Invoice.Query().
+		Where(
+			invoice.ClientIDEQ(client.ID),
+			invoice.StatusIn(invoice.StatusOpen, invoice.StatusOverdue),
+		).
+		Order(ent.Asc(invoice.FieldPeriodStart)).
+		All(ctx)
+	if err != nil || len(unpaid) == 0 {
+		return nil, err
+	}
+
+	var outstanding float64
+	for _, inv := range unpaid {
+		outstanding = RoundAmount(outstanding + inv.Amount - inv.PaidAmount)
+	}
+	covered := RoundAmount(outstanding - max(-client.Balance, 0))
+
+	var (
+		paid    []*ent.Invoice
+		overdue bool
+		now     = time.Now()
+	)
+	for _, inv := range unpaid {
+		remaining := RoundAmount(inv.Amount - inv.PaidAmount)
+		switch {
+		case covered >= remaining-0.005:
+			inv, err = tx.Invoice.UpdateOne(inv).
+				SetPaidAmount(inv.Amount).
+				SetStatus(invoice.StatusPaid).
+				SetPaidAt(now).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+			covered = RoundAmount(covered - remaining)
+			paid = append(paid, inv)
+			continue
+		case covered > 0:
+			if err := tx.Invoice.UpdateOne(inv).
+				AddPaidAmount(covered).
+				Exec(ctx); err != nil {
+				return nil, err
+			}
+			covered = 0
+		}
+		if inv.Status == invoice.StatusOverdue {
+			overdue = true
+		}
+	}
+
+	if !overdue && client.Status == clientuser.StatusSuspended {
+		if client.UserProfile != "" {
+			if err := radiusrepo.NewRadiusRepo(tx.Client()).SetGroup(ctx, client.Username, client.UserProfile); err != nil {
+				return nil, err
+			}
+		}
+		if err := extendPostpaidExpiry(ctx, tx, client.Username, now); err != nil {
+			return nil, err
+		}
+		if err := tx.ClientUser.UpdateOneID(client.ID).
+			SetStatus(clientuser.StatusActive).
+			SetUpdatedBy(createdByPostpaid).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return paid, nil
+}
+
+// extendPostpaidExpiry moves a postpaid client's RADIUS expiry to the end of the month after now,
+// so it only lapses if invoicing stops. An expiry further out is kept.
+func extendPostpaidExpiry(ctx context.Context, tx *ent.Tx, username string, now time.Time) error {
+	radius := radiusrepo.NewRadiusRepo(tx.Client())
+	current, err := radius.Expiry(ctx, username)
+	if err != nil {
+		return err
+	}
+	expiry := monthStart(now).AddDate(0, 2, 0)
+	if current != nil && current.After(expiry) {
+		return nil
+	}
+	return radius.SetExpiry(ctx, username, expiry)
+}
+
+// canCharge matches the clients whose balance covers amount, counting the credit limit of postpaid
+// clients
+func canCharge(amount float64) predicate.ClientUser {
+	return clientuser.Or(
+		clientuser.BalanceGTE(amount),
+		clientuser.And(
+			clientuser.BillingModeEQ(clientuser.BillingModePostpaid),
+			func(s *sql.Selector) {
+				s.Where(sql.ExprP(
+					fmt.Sprintf("%s + %s >= ?", s.C(clientuser.FieldBalance), s.C(clientuser.FieldCreditLimit)), amount))
+			},
+		),
+	)
+}
@@ -0,0 +1,145 @@
+package billingrepo_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestPackageCharge(t *testing.T) {
+	start := time.Date(2025, 11, 1, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 1, 0)
+
+	assert.Equal(t, 900.0, billingrepo.PackageCharge(900, start, end, nil))
+	before := start.AddDate(0, -2, 0)
+	assert.Equal(t, 900.0, billingrepo.PackageCharge(900, start, end, &before))
+
+	// Switched on the 21st: 10 of 30 days, counting the day of the switch
+	since := time.Date(2025, 11, 21, 15, 30, 0, 0, time.Local)
+	assert.Equal(t, 300.0, billingrepo.PackageCharge(900, start, end, &since))
+
+	after := end.Add(time.Hour)
+	assert.Equal(t, 0.0, billingrepo.PackageCharge(900, start, end, &after))
+}
+
+func TestAvailableCredit(t *testing.T) {
+	assert.Equal(t, 200.0, billingrepo.AvailableCredit(&ent.ClientUser{Balance: 200, CreditLimit: 1000}))
+	assert.Equal(t, 700.0, billingrepo.AvailableCredit(&ent.ClientUser{
+		Balance: -300, CreditLimit: 1000, BillingMode: clientuser.BillingModePostpaid,
+	}))
+}
+
+func TestRunPostpaidBilling(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300)
+	clientUser := tests.CreateSubscriber(ctx, client, "postpaid1", 0, plan)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	radiusRepo := radiusrepo.NewRadiusRepo(client)
+	policy := billingrepo.PostpaidPolicy{DueDays: 10, SuspendAfterDays: 5, SuspendedProfile: "postpaid-suspended"}
+
+	_, err := billingRepo.SetBillingMode(ctx, clientUser.Username, clientuser.BillingModePostpaid, 500, "billing")
+	require.NoError(t, err)
+	_, err = billingRepo.RenewPackage(ctx, clientUser.ID, billingrepo.RenewOptions{})
+	assert.ErrorIs(t, err, billingrepo.ErrPostpaid)
+	_, err = billingRepo.AddAddon(ctx, clientUser.Username, "Static IP", 100, "billing")
+	require.NoError(t, err)
+
+	// Bill this month as if it were over, for a client who was postpaid all of it
+	thisMonth := time.Date(time.Now().Year(), time.Now().Month(), 1, 0, 0, 0, 0, time.Local)
+	nextMonth := thisMonth.AddDate(0, 1, 0)
+	client.ClientUser.UpdateOneID(clientUser.ID).SetPostpaidSince(thisMonth.AddDate(0, -1, 0)).ExecX(ctx)
+
+	summary, err := billingRepo.RunPostpaidBilling(ctx, nextMonth.AddDate(0, 0, 1), policy)
+	require.NoError(t, err)
+	assert.Equal(t, thisMonth, summary.Period)
+	assert.Equal(t, 1, summary.Issued)
+	assert.Equal(t, 400.0, summary.Billed)
+	inv := client.Invoice.Query().OnlyX(ctx)
+	assert.Equal(t, 400.0, inv.Amount)
+	assert.Equal(t, invoice.StatusOpen, inv.Status)
+	assert.Equal(t, nextMonth.AddDate(0, 0, 10).Unix(), inv.DueAt.Unix())
+	assert.Len(t, client.InvoiceLine.Query().AllX(ctx), 2)
+	txn := client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(inv.Number)).OnlyX(ctx)
+	assert.Equal(t, clienttxn.TypePOSTPAID_INVOICE, txn.Type)
+	assert.Equal(t, -400.0, txn.TotalBalance)
+	assert.Equal(t, -400.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	expiry, err := radiusRepo.Expiry(ctx, clientUser.Username)
+	require.NoError(t, err)
+	assert.False(t, expiry.Before(nextMonth.AddDate(0, 1, 0)))
+
+	// Later and overlapping runs never invoice the month twice
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			summary, err := billingRepo.RunPostpaidBilling(ctx, nextMonth.AddDate(0, 0, 2), policy)
+			assert.NoError(t, err)
+			assert.Zero(t, summary.Issued)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, client.Invoice.Query().CountX(ctx))
+	assert.Equal(t, -400.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// Unpaid past the due date it is overdue, and some days later the client is suspended
+	summary, err = billingRepo.RunPostpaidBilling(ctx, nextMonth.AddDate(0, 0, 11), policy)
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Overdue)
+	assert.Zero(t, summary.Suspended)
+	summary, err = billingRepo.RunPostpaidBilling(ctx, nextMonth.AddDate(0, 0, 16), policy)
+	require.NoError(t, err)
+	assert.Zero(t, summary.Overdue)
+	assert.Equal(t, 1, summary.Suspended)
+	assert.Equal(t, clientuser.StatusSuspended, client.ClientUser.GetX(ctx, clientUser.ID).Status)
+	group, err := radiusRepo.Group(ctx, clientUser.Username)
+	require.NoError(t, err)
+	assert.Equal(t, policy.SuspendedProfile, group)
+
+	_, err = billingRepo.SetBillingMode(ctx, clientUser.Username, clientuser.BillingModePrepaid, 0, "billing")
+	assert.ErrorIs(t, err, billingrepo.ErrAmountDue)
+
+	// A part payment is applied to the invoice but leaves it overdue
+	pay := func(amount float64) {
+		topUp, err := billingRepo.StartTopUp(ctx, client.ClientUser.GetX(ctx, clientUser.ID), amount, clienttxn.PaymentMethodGatewayBkash)
+		require.NoError(t, err)
+		_, err = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayBkash, topUp.TransactionRef, "TRX-"+topUp.TransactionRef, amount)
+		require.NoError(t, err)
+	}
+	pay(150)
+	inv = client.Invoice.GetX(ctx, inv.ID)
+	assert.Equal(t, 150.0, inv.PaidAmount)
+	assert.Equal(t, invoice.StatusOverdue, inv.Status)
+	assert.Equal(t, clientuser.StatusSuspended, client.ClientUser.GetX(ctx, clientUser.ID).Status)
+
+	// Paying the rest settles it and reactivates the client
+	pay(250)
+	inv = client.Invoice.GetX(ctx, inv.ID)
+	assert.Equal(t, invoice.StatusPaid, inv.Status)
+	assert.Equal(t, 400.0, inv.PaidAmount)
+	clientUser = client.ClientUser.GetX(ctx, clientUser.ID)
+	assert.Equal(t, clientuser.StatusActive, clientUser.Status)
+	assert.Zero(t, clientUser.Balance)
+	group, err = radiusRepo.Group(ctx, clientUser.Username)
+	require.NoError(t, err)
+	assert.Equal(t, plan.ProfileName, group)
+
+	report, err := billingRepo.CheckLedger(ctx, clientUser.Username)
+	require.NoError(t, err)
+	assert.Empty(t, report.Issues)
+	_, err = billingRepo.SetBillingMode(ctx, clientUser.Username, clientuser.BillingModePrepaid, 0, "billing")
+	require.NoError(t, err)
+}
@@ -0,0 +1,407 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
+)
+
+var (
+	ErrRefundNotFound       = errors.New("refund request not found")
+	ErrRefundTransition     = errors.New("refund request cannot move to that status")
+	ErrRefundOpen           = errors.New("a refund request is already open")
+	ErrChargeNotRefundable  = errors.New("transaction is not a failed charge that can be refunded")
+	ErrRefundPayoutRequired = errors.New("payout reference is required")
+)
+
+// refundTransitions lists the statuses a refund request can move to from each status. Paid and
+// rejected are final.
+var refundTransitions = map[refundrequest.Status][]refundrequest.Status{
+	refundrequest.StatusRequested: {refundrequest.StatusApproved, refundrequest.StatusRejected},
+	refundrequest.StatusApproved:  {refundrequest.StatusPaid, refundrequest.StatusRejected},
+}
+
+// RefundInput is a client's refund request
+type RefundInput struct {
+	Kind refundrequest.Kind
+	// Amount is the unused balance to pay out, ignored for failed charges which refund the charge
+	Amount float64
+	// ChargeRef is the transaction_ref of the failed charge
+	ChargeRef     string
+	PayoutMethod  refundrequest.PayoutMethod
+	PayoutAccount string
+	Reason        string
+}
+
+// RefundReview is an operator's decision on a refund request
+type RefundReview struct {
+	Reviewer string
+	Note     string
+	// PayoutRef is the reference of the money sent to the client, required to mark a refund paid
+	PayoutRef string
+}
+
+// CanMoveRefund reports whether a refund request in status from can move to status to
+func CanMoveRefund(from, to refundrequest.Status) bool {
+	for _, next := range refundTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// RequestRefund opens a refund request. A client can have one open balance refund at a time, and
+// a failed charge can only be refunded once.
+func (b *BillingRepo) RequestRefund(ctx context.Context, client *ent.ClientUser, input RefundInput) (*ent.RefundRequest, error) {
+	open, err := b.orm.RefundRequest.Query().
+		Where(
+			refundrequest.ClientUsernameEQ(client.Username),
+			refundrequest.StatusIn(refundrequest.StatusRequested, refundrequest.StatusApproved),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := b.orm.RefundRequest.Create().
+		SetClientID(client.ID).
+		SetClientUsername(client.Username).
+		SetKind(input.Kind).
+		SetPayoutMethod(input.PayoutMethod).
+		SetPayoutAccount(strings.TrimSpace(input.PayoutAccount)).
+		SetReason(strings.TrimSpace(input.Reason))
+
+	switch input.Kind {
+	case refundrequest.KindBalance:
+		for _, r := range open {
+			if r.Kind == refundrequest.KindBalance {
+				return nil, ErrRefundOpen
+			}
+		}
+		amount := RoundAmount(input.Amount)
+		if amount <= 0 {
+			return nil, ErrInvalidAmount
+		}
+		if amount > client.Balance {
+			return nil, ErrInsufficientBalance
+		}
+		create.SetAmount(amount)
+
+	case refundrequest.KindFailedCharge:
+		charge, err := b.refundableCharge(ctx, client.Username, input.ChargeRef)
+		if err != nil {
+			return nil, err
+		}
+		refunded, err := b.orm.RefundRequest.Query().
+			Where(
+				refundrequest.ChargeRefEQ(charge.TransactionRef),
+				refundrequest.StatusNEQ(refundrequest.StatusRejected),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if refunded {
+			return nil, ErrRefundOpen
+		}
+		create.SetAmount(charge.Amount).SetChargeRef(charge.TransactionRef)
+
+	default:
+		return nil, fmt.Errorf("unknown refund kind %q", input.Kind)
+	}
+
+	return create.Save(ctx)
+}
+
+// RefundableCharges lists a client's gateway recharges that failed or never completed, which the
+// client may have paid for anyway
+func (b *BillingRepo) RefundableCharges(ctx context.Context, username string) ([]*ent.ClientTxn, error) {
+	return b.orm.ClientTxn.Query().
+		Where(
+			clienttxn.ClientUsernameEQ(username),
+			clienttxn.TypeEQ(clienttxn.TypeRECHARGE),
+			clienttxn.StatusIn(clienttxn.StatusFailed, clienttxn.StatusPending),
+		).
+		Order(ent.Desc(clienttxn.FieldTransactionDate)).
+		Limit(20).
+		All(ctx)
+}
+
+// ClientRefunds lists a client's refund requests, latest first
+func (b *BillingRepo) ClientRefunds(ctx context.Context, username string) ([]*ent.RefundRequest, error) {
+	return b.orm.RefundRequest.Query().
+		Where(refundrequest.ClientUsernameEQ(username)).
+		Order(ent.Desc(refundrequest.FieldCreatedAt), ent.Desc(refundrequest.FieldID)).
+		All(ctx)
+}
+
+// ListRefunds lists refund requests in the given statuses, oldest first, or all of them
+func (b *BillingRepo) ListRefunds(ctx context.Context, statuses ...refundrequest.Status) ([]*ent.RefundRequest, error) {
+	query := b.orm.RefundRequest.Query().
+		Order(ent.Asc(refundrequest.FieldCreatedAt), ent.Asc(refundrequest.FieldID))
+	if len(statuses) > 0 {
+		query.Where(refundrequest.StatusIn(statuses...))
+	}
+	return query.All(ctx)
+}
+
+// ApproveRefund accepts a refund request. For a balance refund the amount is taken from the
+// balance right away with a REFUND transaction, so it cannot be spent while the payout is sent.
+// For a failed charge, the charge is marked reversed so a late gateway confirmation can no
+// longer credit it.
+func (b *BillingRepo) ApproveRefund(ctx context.Context, id int, review RefundReview) (*ent.RefundRequest, error) {
+	var approved *ent.RefundRequest
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		request, err := moveRefund(ctx, tx, id, refundrequest.StatusApproved, review)
+		if err != nil {
+			return err
+		}
+
+		update := tx.RefundRequest.UpdateOneID(id)
+		switch request.Kind {
+		case refundrequest.KindBalance:
+			client, err := tx.ClientUser.Query().
+				Where(clientuser.UsernameEQ(request.ClientUsername)).
+				Only(ctx)
+			if ent.IsNotFound(err) {
+				return ErrClientNotFound
+			} else if err != nil {
+				return err
+			}
+			if err := debit(ctx, tx, client.ID, request.Amount); err != nil {
+				return err
+			}
+			balance, err := balanceOf(ctx, tx, client.Username)
+			if err != nil {
+				return err
+			}
+			ref := refundRef(request.ID)
+			err = tx.ClientTxn.Create().
+				SetTransactionRef(ref).
+				SetAmount(request.Amount).
+				SetType(clienttxn.TypeREFUND).
+				SetStatus(clienttxn.StatusCompleted).
+				SetTotalBalance(balance).
+				SetPaymentMethod(clienttxn.PaymentMethodClientBalance).
+				SetClientUsername(client.Username).
+				SetDescription(fmt.Sprintf("Refund of unused balance to %s %s", request.PayoutMethod, request.PayoutAccount)).
+				SetCreatedBy(review.Reviewer).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+			update.SetRefundRef(ref)
+
+		case refundrequest.KindFailedCharge:
+			n, err := tx.ClientTxn.Update().
+				Where(
+					clienttxn.TransactionRefEQ(request.ChargeRef),
+					clienttxn.StatusIn(clienttxn.StatusFailed, clienttxn.StatusPending),
+				).
+				SetStatus(clienttxn.StatusReversed).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				// The payment went through after all and is already on the balance
+				return ErrChargeNotRefundable
+			}
+		}
+
+		approved, err = update.Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approved, nil
+}
+
+// RejectRefund declines a refund request. If it was already approved, whatever approving it did
+// is undone: a balance refund's amount is credited back with an ADJUSTMENT next to its REFUND
+// transaction, and a failed charge goes back to failed.
+func (b *BillingRepo) RejectRefund(ctx context.Context, id int, review RefundReview) (*ent.RefundRequest, error) {
+	var rejected *ent.RefundRequest
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		request, err := moveRefund(ctx, tx, id, refundrequest.StatusRejected, review)
+		if err != nil {
+			return err
+		}
+
+		if request.Status == refundrequest.StatusApproved {
+			switch request.Kind {
+			case refundrequest.KindBalance:
+				// The REFUND stays as it was so the running balances after it still add up
+				balance, err := credit(ctx, tx, request.ClientUsername, request.Amount)
+				if err != nil {
+					return err
+				}
+				err = tx.ClientTxn.Create().
+					SetTransactionRef(refundReversalRef(request.ID)).
+					SetAmount(request.Amount).
+					SetType(clienttxn.TypeADJUSTMENT).
+					SetStatus(clienttxn.StatusCompleted).
+					SetTotalBalance(balance).
+					SetPaymentMethod(clienttxn.PaymentMethodClientBalance).
+					SetClientUsername(request.ClientUsername).
+					SetDescription(fmt.Sprintf("Refund %s rejected, amount returned to balance", request.RefundRef)).
+					SetCreatedBy(review.Reviewer).
+					Exec(ctx)
+				if err != nil {
+					return err
+				}
+			case refundrequest.KindFailedCharge:
+				err := tx.ClientTxn.Update().
+					Where(
+						clienttxn.TransactionRefEQ(request.ChargeRef),
+						clienttxn.StatusEQ(clienttxn.StatusReversed),
+					).
+					SetStatus(clienttxn.StatusFailed).
+					Exec(ctx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		rejected, err = tx.RefundRequest.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rejected, nil
+}
+
+// MarkRefundPaid records that the payout reached the client. A failed charge's REFUND
+// transaction is written now; it never touched the balance, so it is paid by the original method.
+func (b *BillingRepo) MarkRefundPaid(ctx context.Context, id int, review RefundReview) (*ent.RefundRequest, error) {
+	review.PayoutRef = strings.TrimSpace(review.PayoutRef)
+	if review.PayoutRef == "" {
+		return nil, ErrRefundPayoutRequired
+	}
+
+	var paid *ent.RefundRequest
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		request, err := moveRefund(ctx, tx, id, refundrequest.StatusPaid, review)
+		if err != nil {
+			return err
+		}
+
+		update := tx.RefundRequest.UpdateOneID(id).SetPayoutRef(review.PayoutRef)
+		switch request.Kind {
+		case refundrequest.KindBalance:
+			err := tx.ClientTxn.Update().
+				Where(clienttxn.TransactionRefEQ(request.RefundRef)).
+				SetGatewayRef(review.PayoutRef).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+		case refundrequest.KindFailedCharge:
+			charge, err := tx.ClientTxn.Query().
+				Where(clienttxn.TransactionRefEQ(request.ChargeRef)).
+				Only(ctx)
+			if err != nil {
+				return err
+			}
+			balance, err := balanceOf(ctx, tx, request.ClientUsername)
+			if err != nil {
+				return err
+			}
+			ref := refundRef(request.ID)
+			create := tx.ClientTxn.Create().
+				SetTransactionRef(ref).
+				SetAmount(request.Amount).
+				SetType(clienttxn.TypeREFUND).
+				SetStatus(clienttxn.StatusCompleted).
+				SetTotalBalance(balance).
+				SetGatewayRef(review.PayoutRef).
+				SetClientUsername(request.ClientUsername).
+				SetDescription(fmt.Sprintf("Refund of failed payment %s", charge.TransactionRef)).
+				SetCreatedBy(review.Reviewer)
+			if charge.PaymentMethod != nil {
+				create.SetPaymentMethod(*charge.PaymentMethod)
+			}
+			if err := create.Exec(ctx); err != nil {
+				return err
+			}
+			update.SetRefundRef(ref)
+		}
+
+		paid, err = update.Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paid, nil
+}
+
+// moveRefund moves a refund request to the next status and returns it as it was before. The
+// status update only matches the expected current status, so concurrent reviews cannot both win.
+func moveRefund(
+	ctx context.Context, tx *ent.Tx, id int, to refundrequest.Status, review RefundReview,
+) (*ent.RefundRequest, error) {
+	request, err := tx.RefundRequest.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ErrRefundNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if !CanMoveRefund(request.Status, to) {
+		return nil, ErrRefundTransition
+	}
+
+	update := tx.RefundRequest.Update().
+		Where(
+			refundrequest.IDEQ(id),
+			refundrequest.StatusEQ(request.Status),
+		).
+		SetStatus(to).
+		SetReviewedBy(review.Reviewer)
+	if review.Note != "" {
+		update.SetReviewNote(review.Note)
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrRefundTransition
+	}
+	return request, nil
+}
+
+func (b *BillingRepo) refundableCharge(ctx context.Context, username, ref string) (*ent.ClientTxn, error) {
+	charge, err := b.orm.ClientTxn.Query().
+		Where(
+			clienttxn.TransactionRefEQ(strings.TrimSpace(ref)),
+			clienttxn.ClientUsernameEQ(username),
+			clienttxn.TypeEQ(clienttxn.TypeRECHARGE),
+			clienttxn.StatusIn(clienttxn.StatusFailed, clienttxn.StatusPending),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrChargeNotRefundable
+	}
+	return charge, err
+}
+
+func refundRef(requestID int) string {
+	return fmt.Sprintf("RFD-%d", requestID)
+}
+
+// refundReversalRef is the ADJUSTMENT that credits back a rejected refund's REFUND
+func refundReversalRef(requestID int) string {
+	return refundRef(requestID) + "-R"
+}
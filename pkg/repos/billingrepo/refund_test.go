@@ -0,0 +1,142 @@
+package billingrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestCanMoveRefund(t *testing.T) {
+	assert.True(t, billingrepo.CanMoveRefund(refundrequest.StatusRequested, refundrequest.StatusApproved))
+	assert.True(t, billingrepo.CanMoveRefund(refundrequest.StatusApproved, refundrequest.StatusRejected))
+	assert.False(t, billingrepo.CanMoveRefund(refundrequest.StatusRequested, refundrequest.StatusPaid))
+	assert.False(t, billingrepo.CanMoveRefund(refundrequest.StatusPaid, refundrequest.StatusRejected))
+	assert.False(t, billingrepo.CanMoveRefund(refundrequest.StatusRejected, refundrequest.StatusApproved))
+}
+
+func TestBalanceRefund(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	// The balance comes from a recharge so the ledger can be checked at the end
+	clientUser := tests.CreateClientUser(ctx, client, "refund1", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	review := billingrepo.RefundReview{Reviewer: "billing"}
+	recharge, err := billingRepo.StartTopUp(ctx, clientUser, 500, clienttxn.PaymentMethodGatewayBkash)
+	require.NoError(t, err)
+	_, err = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayBkash, recharge.TransactionRef, "TRX0", 500)
+	require.NoError(t, err)
+	clientUser = client.ClientUser.GetX(ctx, clientUser.ID)
+
+	input := billingrepo.RefundInput{
+		Kind:          refundrequest.KindBalance,
+		Amount:        600,
+		PayoutMethod:  refundrequest.PayoutMethodBkash,
+		PayoutAccount: "01700000000",
+		Reason:        "Moving away",
+	}
+	_, err = billingRepo.RequestRefund(ctx, clientUser, input)
+	assert.ErrorIs(t, err, billingrepo.ErrInsufficientBalance)
+
+	input.Amount = 200
+	request, err := billingRepo.RequestRefund(ctx, clientUser, input)
+	require.NoError(t, err)
+	assert.Equal(t, refundrequest.StatusRequested, request.Status)
+
+	_, err = billingRepo.RequestRefund(ctx, clientUser, input)
+	assert.ErrorIs(t, err, billingrepo.ErrRefundOpen)
+	_, err = billingRepo.MarkRefundPaid(ctx, request.ID, billingrepo.RefundReview{Reviewer: "billing", PayoutRef: "TRX1"})
+	assert.ErrorIs(t, err, billingrepo.ErrRefundTransition)
+
+	// Approving holds the amount with a REFUND
+	request, err = billingRepo.ApproveRefund(ctx, request.ID, review)
+	require.NoError(t, err)
+	assert.Equal(t, 300.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	refund := client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(request.RefundRef)).OnlyX(ctx)
+	assert.Equal(t, clienttxn.TypeREFUND, refund.Type)
+	assert.Equal(t, 300.0, refund.TotalBalance)
+
+	// Rejecting it afterwards gives the amount back with an ADJUSTMENT, leaving the REFUND as it was
+	request, err = billingRepo.RejectRefund(ctx, request.ID, billingrepo.RefundReview{Reviewer: "billing", Note: "Contract term"})
+	require.NoError(t, err)
+	assert.Equal(t, refundrequest.StatusRejected, request.Status)
+	assert.Equal(t, 500.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	refund = client.ClientTxn.GetX(ctx, refund.ID)
+	assert.Equal(t, clienttxn.StatusCompleted, refund.Status)
+	assert.Equal(t, 300.0, refund.TotalBalance)
+	reversal := client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(request.RefundRef + "-R")).OnlyX(ctx)
+	assert.Equal(t, clienttxn.TypeADJUSTMENT, reversal.Type)
+	assert.Equal(t, 200.0, reversal.Amount)
+	assert.Equal(t, 500.0, reversal.TotalBalance)
+
+	_, err = billingRepo.RejectRefund(ctx, request.ID, review)
+	assert.ErrorIs(t, err, billingrepo.ErrRefundTransition)
+	assert.Equal(t, 500.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// A new request can then go all the way
+	request, err = billingRepo.RequestRefund(ctx, clientUser, input)
+	require.NoError(t, err)
+	_, err = billingRepo.ApproveRefund(ctx, request.ID, review)
+	require.NoError(t, err)
+	request, err = billingRepo.MarkRefundPaid(ctx, request.ID, billingrepo.RefundReview{Reviewer: "billing", PayoutRef: "TRX2"})
+	require.NoError(t, err)
+	assert.Equal(t, refundrequest.StatusPaid, request.Status)
+	refund = client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(request.RefundRef)).OnlyX(ctx)
+	assert.Equal(t, clienttxn.StatusCompleted, refund.Status)
+	assert.Equal(t, "TRX2", refund.GatewayRef)
+	assert.Equal(t, 300.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// The approved, rejected and paid refunds leave the ledger consistent
+	report, err := billingRepo.CheckLedger(ctx, clientUser.Username)
+	require.NoError(t, err)
+	assert.Empty(t, report.Issues)
+}
+
+func TestFailedChargeRefund(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "refund2", 100)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	review := billingrepo.RefundReview{Reviewer: "billing", PayoutRef: "NGD-REFUND-1"}
+
+	charge, err := billingRepo.StartTopUp(ctx, clientUser, 300, clienttxn.PaymentMethodGatewayNagad)
+	require.NoError(t, err)
+	require.NoError(t, billingRepo.FailTopUp(ctx, charge.TransactionRef, "NGD1"))
+
+	input := billingrepo.RefundInput{
+		Kind:          refundrequest.KindFailedCharge,
+		ChargeRef:     charge.TransactionRef,
+		PayoutMethod:  refundrequest.PayoutMethodNagad,
+		PayoutAccount: "01800000000",
+		Reason:        "Money was taken but the recharge failed",
+	}
+	request, err := billingRepo.RequestRefund(ctx, clientUser, input)
+	require.NoError(t, err)
+	assert.Equal(t, 300.0, request.Amount)
+
+	_, err = billingRepo.RequestRefund(ctx, clientUser, input)
+	assert.ErrorIs(t, err, billingrepo.ErrRefundOpen)
+
+	_, err = billingRepo.ApproveRefund(ctx, request.ID, review)
+	require.NoError(t, err)
+	assert.Equal(t, clienttxn.StatusReversed, client.ClientTxn.GetX(ctx, charge.ID).Status)
+
+	// A late confirmation of the reversed charge can no longer credit it
+	_, _ = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewayNagad, charge.TransactionRef, "NGD1", 300)
+	assert.Equal(t, 100.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	request, err = billingRepo.MarkRefundPaid(ctx, request.ID, review)
+	require.NoError(t, err)
+	refund := client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(request.RefundRef)).OnlyX(ctx)
+	assert.Equal(t, clienttxn.TypeREFUND, refund.Type)
+	assert.Equal(t, clienttxn.PaymentMethodGatewayNagad, *refund.PaymentMethod)
+	assert.Equal(t, 100.0, refund.TotalBalance)
+	assert.Zero(t, billingrepo.BalanceEffect(refund))
+}
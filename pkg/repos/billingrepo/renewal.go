@@ -0,0 +1,372 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/packageplan"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+)
+
+var (
+	ErrClientNotFound      = errors.New("client not found")
+	ErrNoPackage           = errors.New("client has no package assigned")
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	ErrAlreadyRenewed      = errors.New("package was already renewed for this cycle")
+	ErrInvalidBundle       = errors.New("advance payment bundle is not valid")
+)
+
+// RenewOptions controls how a renewal is recorded
+type RenewOptions struct {
+	// Type is the ClientTxn type to record, RENEWAL unless set
+	Type clienttxn.Type
+	// CreatedBy is recorded on the transaction, the self-care portal unless set
+	CreatedBy string
+	// VerifyExpiry makes the renewal check that the current expiry is still ExpectedExpiry, the one
+	// the caller saw when it decided to renew (nil meaning no expiry was set). If it has moved since,
+	// the package was renewed in the meantime and ErrAlreadyRenewed is returned instead of charging
+	// the client again.
+	VerifyExpiry   bool
+	ExpectedExpiry *time.Time
+	// Bundle renews several cycles at once, one cycle at full price unless set
+	Bundle *AdvanceBundle
+	// CouponCode is a coupon the client entered, taken off the price after any bundle discount
+	CouponCode string
+	// vendorID pays for the renewal from the wallet of the client's vendor, see RenewByVendor
+	vendorID int
+}
+
+// AdvanceBundle is a number of billing cycles a client pays for in advance at a discount
+type AdvanceBundle struct {
+	Cycles int
+	// Discount is the percentage taken off the price of the bundle
+	Discount float64
+}
+
+// Price is what the bundle costs for a package of the given price
+func (a AdvanceBundle) Price(packagePrice float64) float64 {
+	return RoundAmount(packagePrice * float64(a.Cycles) * (100 - a.Discount) / 100)
+}
+
+// Renewal is the outcome of a successful renewal
+type Renewal struct {
+	Txn     *ent.ClientTxn
+	Package *ent.PackagePlan
+	// Switched is set when the renewal applied a package change scheduled for the next cycle
+	Switched       bool
+	PreviousExpiry *time.Time
+	Expiry         time.Time
+	// Cycles is how many billing cycles the renewal paid for
+	Cycles int
+	// VendorTxn is the charge to the vendor's wallet of a renewal paid by a vendor
+	VendorTxn *ent.VendorTxn
+}
+
+// RenewPackage charges a client the price of their current package and extends their access by one
+// billing cycle. The debit, the RENEWAL transaction, the radcheck Expiration and clients.payment_date
+// are all written in one database transaction.
+//
+// With a Bundle, the client pays the discounted price of that many cycles up front and the expiry
+// moves that many cycles ahead, recorded as an ADVANCE_PAYMENT unless opts.Type says otherwise.
+//
+// Concurrent renewals of the same client are serialized by the balance update, which locks the
+// client's row. Each cycle also gets a deterministic transaction_ref, so its unique index rejects
+// a second charge for the same cycle even if two renewals race.
+func (b *BillingRepo) RenewPackage(ctx context.Context, clientID int, opts RenewOptions) (*Renewal, error) {
+	bundle := AdvanceBundle{Cycles: 1}
+	if opts.Bundle != nil {
+		if opts.Bundle.Cycles < 1 || opts.Bundle.Discount < 0 || opts.Bundle.Discount >= 100 {
+			return nil, ErrInvalidBundle
+		}
+		bundle = *opts.Bundle
+	}
+	if opts.Type == "" {
+		opts.Type = clienttxn.TypeRENEWAL
+		if opts.Bundle != nil {
+			opts.Type = clienttxn.TypeADVANCE_PAYMENT
+		}
+	}
+	if opts.CreatedBy == "" {
+		opts.CreatedBy = createdBySelfCare
+	}
+
+	var (
+		renewal *Renewal
+		regroup bool
+	)
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		if opts.CouponCode != "" {
+			if err := claimCoupon(ctx, tx, opts.CouponCode); err != nil {
+				return err
+			}
+		}
+
+		client, err := tx.ClientUser.Get(ctx, clientID)
+		if ent.IsNotFound(err) {
+			return ErrClientNotFound
+		} else if err != nil {
+			return err
+		}
+		if opts.vendorID != 0 {
+			if err := vendorClient(client, opts.vendorID); err != nil {
+				return err
+			}
+		}
+		if client.BillingMode == clientuser.BillingModePostpaid {
+			return ErrPostpaid
+		}
+
+		plan, switched, err := renewalPackage(ctx, tx.Client(), client)
+		if err != nil {
+			return err
+		}
+
+		price := bundle.Price(plan.Price)
+		var (
+			coupon   *ent.Coupon
+			discount float64
+		)
+		if opts.CouponCode != "" {
+			coupon, discount, err = applyCoupon(ctx, tx.Client(), opts.CouponCode, client, plan, price, true)
+			if err != nil {
+				return err
+			}
+			price = RoundAmount(price - discount)
+		}
+		charge := b.tax.Apply(ServiceInternet, price)
+
+		// Debit first: the conditional update both guards the balance and locks the client's row.
+		// A vendor's wallet is only charged once the client transaction exists, so the client's row
+		// is locked on its own.
+		paymentMethod := clienttxn.PaymentMethodClientBalance
+		if opts.vendorID != 0 {
+			paymentMethod = clienttxn.PaymentMethodVendorBalance
+			err = tx.ClientUser.UpdateOneID(client.ID).
+				SetUpdatedBy(opts.CreatedBy).
+				Exec(ctx)
+		} else {
+			err = debit(ctx, tx, client.ID, charge.Gross)
+		}
+		if err != nil {
+			return err
+		}
+
+		previous, grace, err := billingExpiry(ctx, tx.Client(), client)
+		if err != nil {
+			return err
+		}
+		if opts.VerifyExpiry && !sameExpiry(previous, opts.ExpectedExpiry) {
+			return ErrAlreadyRenewed
+		}
+
+		refundID, err := latestRefundID(ctx, tx.Client(), client.Username)
+		if err != nil {
+			return err
+		}
+
+		txnType := opts.Type
+		if opts.vendorID != 0 && (previous == nil || client.Status == clientuser.StatusInactive) {
+			txnType = clienttxn.TypeACTIVE
+		}
+
+		expiry := NextExpiry(previous, time.Now(), b.cycleDays*bundle.Cycles)
+		balance, err := balanceOf(ctx, tx, client.Username)
+		if err != nil {
+			return err
+		}
+
+		txn, err := withTax(withDiscount(tx.ClientTxn.Create(), coupon, discount), charge).
+			SetTransactionRef(renewalRef(client.ID, previous, refundID)).
+			SetAmount(charge.Gross).
+			SetType(txnType).
+			SetStatus(clienttxn.StatusCompleted).
+			SetTotalBalance(balance).
+			SetServiceDays(b.cycleDays * bundle.Cycles).
+			SetPaymentMethod(paymentMethod).
+			SetClientUsername(client.Username).
+			SetDescription(renewalDescription(plan, switched, bundle, expiry)).
+			SetCreatedBy(opts.CreatedBy).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			return ErrAlreadyRenewed
+		} else if err != nil {
+			return err
+		}
+		if coupon != nil {
+			if err := redeemCoupon(ctx, tx, coupon, client, txn); err != nil {
+				return err
+			}
+		}
+		var vendorTxn *ent.VendorTxn
+		if opts.vendorID != 0 {
+			if vendorTxn, err = chargeVendor(ctx, tx, opts.vendorID, client, plan, charge, txn); err != nil {
+				return err
+			}
+		}
+
+		if err := radiusrepo.NewRadiusRepo(tx.Client()).SetExpiry(ctx, client.Username, expiry); err != nil {
+			return err
+		}
+
+		update := tx.ClientUser.UpdateOneID(client.ID).
+			SetPaymentDate(expiry).
+			SetStatus(clientuser.StatusActive).
+			SetUpdatedBy(opts.CreatedBy)
+		if switched {
+			update.
+				SetUserProfile(plan.ProfileName).
+				SetPackagePool(plan.PoolName).
+				ClearNextUserProfile()
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+		// A grace period may have moved the client to the throttled or suspended group
+		regroup = switched || grace != nil
+		if regroup {
+			if err := radiusrepo.NewRadiusRepo(tx.Client()).SetGroup(ctx, client.Username, plan.ProfileName); err != nil {
+				return err
+			}
+		}
+		if err := endGrace(ctx, tx, grace); err != nil {
+			return err
+		}
+
+		renewal = &Renewal{
+			Txn:            txn,
+			Package:        plan,
+			Switched:       switched,
+			PreviousExpiry: previous,
+			Expiry:         expiry,
+			Cycles:         bundle.Cycles,
+			VendorTxn:      vendorTxn,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if regroup {
+		b.applyProfile(ctx, renewal.Txn.ClientUsername, renewal.Package.ProfileName)
+	}
+	return renewal, nil
+}
+
+// NextExpiry returns the expiry after renewing for a billing cycle of cycleDays. Renewing before the
+// current expiry extends it, while an expired package starts a fresh cycle from now.
+func NextExpiry(current *time.Time, now time.Time, cycleDays int) time.Time {
+	start := now
+	if current != nil && current.After(now) {
+		start = *current
+	}
+	return start.AddDate(0, 0, cycleDays).Truncate(time.Second)
+}
+
+// renewalPackage returns the package a client renews into: the one scheduled for the next cycle
+// if it is still offered, otherwise their current package
+func renewalPackage(ctx context.Context, orm *ent.Client, client *ent.ClientUser) (*ent.PackagePlan, bool, error) {
+	if client.NextUserProfile != "" && client.NextUserProfile != client.UserProfile {
+		next, err := orm.PackagePlan.Query().
+			Where(
+				packageplan.ProfileNameEQ(client.NextUserProfile),
+				packageplan.IsActiveEQ(true),
+			).
+			First(ctx)
+		if err == nil {
+			return next, true, nil
+		} else if !ent.IsNotFound(err) {
+			return nil, false, err
+		}
+	}
+	plan, err := planByProfile(ctx, orm, client.UserProfile)
+	return plan, false, err
+}
+
+// debit takes amount from a client's balance, failing rather than letting the balance go negative
+func debit(ctx context.Context, tx *ent.Tx, clientID int, amount float64) error {
+	n, err := tx.ClientUser.Update().
+		Where(
+			clientuser.IDEQ(clientID),
+			canCharge(amount),
+		).
+		AddBalance(-amount).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrInsufficientBalance
+	}
+	return nil
+}
+
+// CyclesLeft is the number of billing cycles a client has paid for that have not ended yet,
+// counting the current one
+func CyclesLeft(expiry *time.Time, now time.Time, cycleDays int) int {
+	if expiry == nil || !expiry.After(now) || cycleDays <= 0 {
+		return 0
+	}
+	cycle := time.Duration(cycleDays) * 24 * time.Hour
+	return int((expiry.Sub(now) + cycle - 1) / cycle)
+}
+
+func renewalDescription(plan *ent.PackagePlan, switched bool, bundle AdvanceBundle, expiry time.Time) string {
+	if bundle.Cycles > 1 {
+		description := fmt.Sprintf("%s prepaid for %d cycles until %s", plan.Name, bundle.Cycles, expiry.Format("02 Jan 2006"))
+		if switched {
+			description = fmt.Sprintf("Switched to %s, prepaid for %d cycles until %s", plan.Name, bundle.Cycles, expiry.Format("02 Jan 2006"))
+		}
+		if bundle.Discount > 0 {
+			description += fmt.Sprintf(" at %g%% off", bundle.Discount)
+		}
+		return description
+	}
+	if switched {
+		return fmt.Sprintf("Switched to %s, renewed until %s", plan.Name, expiry.Format("02 Jan 2006"))
+	}
+	return fmt.Sprintf("%s renewed until %s", plan.Name, expiry.Format("02 Jan 2006"))
+}
+
+// renewalRef identifies the billing cycle that starts at the given expiry. A refund can roll the
+// expiry back to a cycle that was already paid for once, so the client's latest refund is part of
+// the ref and the cycle can be paid for again after it.
+func renewalRef(clientID int, expiry *time.Time, refundID int) string {
+	if refundID == 0 {
+		return fmt.Sprintf("RNW-%d-%s", clientID, cycleKey(expiry))
+	}
+	return fmt.Sprintf("RNW-%d-%s-R%d", clientID, cycleKey(expiry), refundID)
+}
+
+// latestRefundID returns the ID of a client's latest REFUND transaction, 0 if they have none
+func latestRefundID(ctx context.Context, orm *ent.Client, username string) (int, error) {
+	id, err := orm.ClientTxn.Query().
+		Where(
+			clienttxn.ClientUsernameEQ(username),
+			clienttxn.TypeEQ(clienttxn.TypeREFUND),
+		).
+		Order(ent.Desc(clienttxn.FieldID)).
+		FirstID(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	return id, err
+}
+
+func cycleKey(expiry *time.Time) string {
+	if expiry == nil {
+		return "0"
+	}
+	return expiry.Format("20060102150405")
+}
+
+func sameExpiry(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Unix() == b.Unix()
+}
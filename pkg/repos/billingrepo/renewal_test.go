@@ -0,0 +1,200 @@
+package billingrepo_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestNextExpiry(t *testing.T) {
+	now := time.Date(2025, 11, 14, 13, 0, 0, 0, time.Local)
+
+	// Renewing early extends the current cycle
+	current := now.AddDate(0, 0, 5)
+	assert.Equal(t, current.AddDate(0, 0, 30), billingrepo.NextExpiry(&current, now, 30))
+
+	// An expired package starts a new cycle from now
+	expired := now.AddDate(0, 0, -3)
+	assert.Equal(t, now.AddDate(0, 0, 30), billingrepo.NextExpiry(&expired, now, 30))
+
+	// So does a client without any expiry
+	assert.Equal(t, now.AddDate(0, 0, 30), billingrepo.NextExpiry(nil, now, 30))
+}
+
+func TestAdvanceBundlePrice(t *testing.T) {
+	assert.Equal(t, 500.0, billingrepo.AdvanceBundle{Cycles: 1}.Price(500))
+	assert.Equal(t, 2850.0, billingrepo.AdvanceBundle{Cycles: 6, Discount: 5}.Price(500))
+	assert.Equal(t, 1320.0, billingrepo.AdvanceBundle{Cycles: 12, Discount: 12}.Price(125))
+	assert.Equal(t, 1094.48, billingrepo.AdvanceBundle{Cycles: 3, Discount: 8}.Price(396.55))
+}
+
+func TestCyclesLeft(t *testing.T) {
+	now := time.Date(2025, 11, 14, 13, 0, 0, 0, time.Local)
+
+	expiry := now.AddDate(0, 0, 90)
+	assert.Equal(t, 3, billingrepo.CyclesLeft(&expiry, now, 30))
+	assert.Equal(t, 3, billingrepo.CyclesLeft(&expiry, now.Add(time.Hour), 30), "a started cycle still counts")
+	assert.Equal(t, 2, billingrepo.CyclesLeft(&expiry, now.AddDate(0, 0, 30), 30))
+
+	expiry = now.AddDate(0, 0, 5)
+	assert.Equal(t, 1, billingrepo.CyclesLeft(&expiry, now, 30))
+
+	expiry = now.Add(-time.Minute)
+	assert.Equal(t, 0, billingrepo.CyclesLeft(&expiry, now, 30))
+	assert.Equal(t, 0, billingrepo.CyclesLeft(nil, now, 30))
+}
+
+func TestRenewPackage(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300)
+	clientUser := tests.CreateSubscriber(ctx, client, "renew1", 1000, plan)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	radiusRepo := radiusrepo.NewRadiusRepo(client)
+
+	expiry := time.Now().AddDate(0, 0, 3).Truncate(time.Second)
+	require.NoError(t, radiusRepo.SetExpiry(ctx, clientUser.Username, expiry))
+
+	opts := billingrepo.RenewOptions{VerifyExpiry: true, ExpectedExpiry: &expiry}
+	renewal, err := billingRepo.RenewPackage(ctx, clientUser.ID, opts)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("RNW-%d-%s", clientUser.ID, expiry.Format("20060102150405")), renewal.Txn.TransactionRef)
+	assert.Equal(t, clienttxn.TypeRENEWAL, renewal.Txn.Type)
+	assert.Equal(t, 300.0, renewal.Txn.Amount)
+	assert.Equal(t, 700.0, renewal.Txn.TotalBalance)
+	assert.Equal(t, expiry.AddDate(0, 0, 30).Unix(), renewal.Expiry.Unix())
+	stored, err := radiusRepo.Expiry(ctx, clientUser.Username)
+	require.NoError(t, err)
+	assert.Equal(t, renewal.Expiry.Unix(), stored.Unix())
+
+	// A double submit of the same form finds the expiry moved and charges nothing
+	_, err = billingRepo.RenewPackage(ctx, clientUser.ID, opts)
+	assert.ErrorIs(t, err, billingrepo.ErrAlreadyRenewed)
+	assert.Equal(t, 700.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// Renewing again on purpose pays for the next cycle, under that cycle's ref
+	next, err := billingRepo.RenewPackage(ctx, clientUser.ID, billingrepo.RenewOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("RNW-%d-%s", clientUser.ID, renewal.Expiry.Format("20060102150405")), next.Txn.TransactionRef)
+	assert.Equal(t, 400.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// A balance short of the price is never debited
+	poor := tests.CreateSubscriber(ctx, client, "renew2", 299, plan)
+	_, err = billingRepo.RenewPackage(ctx, poor.ID, billingrepo.RenewOptions{})
+	assert.ErrorIs(t, err, billingrepo.ErrInsufficientBalance)
+	assert.Equal(t, 299.0, client.ClientUser.GetX(ctx, poor.ID).Balance)
+	assert.Zero(t, client.ClientTxn.Query().Where(clienttxn.ClientUsernameEQ(poor.Username)).CountX(ctx))
+}
+
+func TestRenewAfterRefund(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300)
+	clientUser := tests.CreateSubscriber(ctx, client, "renew3", 1000, plan)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	radiusRepo := radiusrepo.NewRadiusRepo(client)
+
+	expiry := time.Now().AddDate(0, 0, 3).Truncate(time.Second)
+	require.NoError(t, radiusRepo.SetExpiry(ctx, clientUser.Username, expiry))
+	_, err := billingRepo.RenewPackage(ctx, clientUser.ID, billingrepo.RenewOptions{})
+	require.NoError(t, err)
+
+	// Rolling the expiry back alone does not allow paying for the same cycle twice
+	require.NoError(t, radiusRepo.SetExpiry(ctx, clientUser.Username, expiry))
+	_, err = billingRepo.RenewPackage(ctx, clientUser.ID, billingrepo.RenewOptions{})
+	assert.ErrorIs(t, err, billingrepo.ErrAlreadyRenewed)
+	assert.Equal(t, 700.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+
+	// After the renewal was refunded, the client can pay for the cycle again
+	request, err := billingRepo.RequestRefund(ctx, client.ClientUser.GetX(ctx, clientUser.ID), billingrepo.RefundInput{
+		Kind:          refundrequest.KindBalance,
+		Amount:        300,
+		PayoutMethod:  refundrequest.PayoutMethodBkash,
+		PayoutAccount: "01700000000",
+		Reason:        "Renewed by mistake",
+	})
+	require.NoError(t, err)
+	_, err = billingRepo.ApproveRefund(ctx, request.ID, billingrepo.RefundReview{Reviewer: "billing"})
+	require.NoError(t, err)
+	require.NoError(t, radiusRepo.SetExpiry(ctx, clientUser.Username, expiry))
+
+	renewal, err := billingRepo.RenewPackage(ctx, clientUser.ID, billingrepo.RenewOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, expiry.AddDate(0, 0, 30).Unix(), renewal.Expiry.Unix())
+	assert.Equal(t, 100.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+}
+
+func TestRenewPackageRace(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300)
+	clientUser := tests.CreateSubscriber(ctx, client, "race1", 1000, plan)
+	clientUser = clientUser.Update().SetAutoRenew(true).SaveX(ctx)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, radiusrepo.NewRadiusRepo(client).SetExpiry(ctx, clientUser.Username, expiry))
+
+	// Clicks on the renew button race the auto renewal of the same cycle; only one may charge
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		renewed int
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := billingRepo.RenewPackage(ctx, clientUser.ID, billingrepo.RenewOptions{
+				VerifyExpiry:   true,
+				ExpectedExpiry: &expiry,
+			})
+			if err == nil {
+				mu.Lock()
+				renewed++
+				mu.Unlock()
+			} else {
+				assert.ErrorIs(t, err, billingrepo.ErrAlreadyRenewed)
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		summary, err := billingRepo.AutoRenew(ctx, 24*time.Hour)
+		require.NoError(t, err)
+		// A run that starts after a click went through no longer finds the client due
+		assert.LessOrEqual(t, summary.Due, 1)
+		assert.Equal(t, summary.Due, summary.Renewed+summary.Skipped)
+		assert.Zero(t, summary.Failed)
+		mu.Lock()
+		renewed += summary.Renewed
+		mu.Unlock()
+	}()
+	wg.Wait()
+
+	assert.Equal(t, 1, renewed)
+	assert.Equal(t, 700.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	txns := client.ClientTxn.Query().
+		Where(
+			clienttxn.ClientUsernameEQ(clientUser.Username),
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+		).
+		AllX(ctx)
+	require.Len(t, txns, 1)
+	assert.Equal(t, fmt.Sprintf("RNW-%d-%s", clientUser.ID, expiry.Format("20060102150405")), txns[0].TransactionRef)
+	assert.Equal(t, 700.0, txns[0].TotalBalance)
+}
@@ -0,0 +1,45 @@
+package billingrepo
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// SessionUpdater pushes RADIUS changes to the sessions clients already have open, which would
+// otherwise keep their old speed until the client reconnects
+type SessionUpdater interface {
+	// ApplyProfile gives the open sessions of a client the attributes of a RADIUS group
+	ApplyProfile(ctx context.Context, username, profile string) error
+	// DisconnectUser ends the open sessions of a client
+	DisconnectUser(ctx context.Context, username string) error
+}
+
+// WithSessions makes the repo push plan changes, throttling and suspensions to open sessions once
+// they are committed. Without it they take effect when the client next connects.
+func (b *BillingRepo) WithSessions(s SessionUpdater) *BillingRepo {
+	b.sessions = s
+	return b
+}
+
+// applyProfile moves the open sessions of a client to a RADIUS group. The change is already
+// committed, so a NAS that cannot be reached is only logged.
+func (b *BillingRepo) applyProfile(ctx context.Context, username, profile string) {
+	if b.sessions == nil || profile == "" {
+		return
+	}
+	if err := b.sessions.ApplyProfile(ctx, username, profile); err != nil {
+		log.Warn().Err(err).Str("username", username).Str("profile", profile).
+			Msg("could not update open sessions")
+	}
+}
+
+// disconnect ends the open sessions of a client, logging a NAS that cannot be reached
+func (b *BillingRepo) disconnect(ctx context.Context, username string) {
+	if b.sessions == nil {
+		return
+	}
+	if err := b.sessions.DisconnectUser(ctx, username); err != nil {
+		log.Warn().Err(err).Str("username", username).Msg("could not disconnect open sessions")
+	}
+}
@@ -0,0 +1,366 @@
+package billingrepo
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+)
+
+// settlementColumns are the headers each settlement column is recognised by. Gateways name them
+// differently in their exports, so a file only has to use one of them.
+var settlementColumns = map[string][]string{
+	"transaction_ref": {"transaction_ref", "tran_id", "merchant_invoice_number", "invoice", "order_id", "client_reference_id"},
+	"gateway_ref":     {"gateway_ref", "trx_id", "bank_tran_id", "payment_ref_id", "payment_intent", "id"},
+	"amount":          {"amount", "gross", "gross_amount"},
+	"fee":             {"fee", "charge", "commission", "mdr"},
+	"date":            {"date", "settled_at", "settlement_date", "available_on"},
+}
+
+// SettlementGateways are the gateways settlement files are imported from, in report order
+var SettlementGateways = []settlementrow.Gateway{
+	settlementrow.GatewayBkash,
+	settlementrow.GatewayNagad,
+	settlementrow.GatewaySslcommerz,
+	settlementrow.GatewayStripe,
+}
+
+var ErrSettlementFile = errors.New("settlement file needs a transaction_ref and an amount column")
+
+// SettlementInput is one payment listed in a gateway settlement file
+type SettlementInput struct {
+	TransactionRef string
+	GatewayRef     string
+	Amount         float64
+	Fee            float64
+	SettledAt      time.Time
+}
+
+// SettlementImport is the outcome of importing a gateway settlement file
+type SettlementImport struct {
+	Gateway  settlementrow.Gateway `json:"gateway"`
+	Source   string                `json:"source"`
+	Imported int                   `json:"imported"`
+	// Duplicates were already imported from an earlier file
+	Duplicates int `json:"duplicates"`
+	Matched    int `json:"matched"`
+	// Exceptions are the imported rows that are unmatched or disagree with the transaction they pay
+	Exceptions []*ent.SettlementRow `json:"exceptions"`
+}
+
+// SettlementReport is the daily reconciliation of what clients paid through each gateway with what
+// the gateways settled
+type SettlementReport struct {
+	Day         string              `json:"day"`
+	GeneratedAt time.Time           `json:"generated_at"`
+	Gateways    []GatewaySettlement `json:"gateways"`
+	// Exceptions are the rows settled that day that are still unmatched or mismatched
+	Exceptions []*ent.SettlementRow `json:"exceptions"`
+	// Unsettled are the gateway payments completed that day that no settlement file listed yet
+	Unsettled []*ent.ClientTxn `json:"unsettled"`
+}
+
+// GatewaySettlement sums up one gateway's day
+type GatewaySettlement struct {
+	Gateway settlementrow.Gateway `json:"gateway"`
+	// Collected is what clients paid through the gateway, by completed transactions of the day
+	Collected      float64 `json:"collected"`
+	CollectedCount int     `json:"collected_count"`
+	// Settled, Fees and Net are what the gateway settled that day, by settlement date
+	Settled      float64 `json:"settled"`
+	SettledCount int     `json:"settled_count"`
+	Fees         float64 `json:"fees"`
+	Net          float64 `json:"net"`
+	Exceptions   int     `json:"exceptions"`
+	Unsettled    int     `json:"unsettled"`
+}
+
+// ParseSettlementCSV reads the payments of a gateway settlement file. Columns are found by their
+// header in any order; rows without a date are taken to be settled on day.
+func ParseSettlementCSV(r io.Reader, day time.Time) ([]SettlementInput, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrSettlementFile
+	}
+
+	headers := make(map[string]int, len(records[0]))
+	for i, name := range records[0] {
+		headers[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	columns := make(map[string]int, len(settlementColumns))
+	for column, aliases := range settlementColumns {
+		for _, alias := range aliases {
+			if i, ok := headers[alias]; ok {
+				columns[column] = i
+				break
+			}
+		}
+	}
+	if _, ok := columns["transaction_ref"]; !ok {
+		return nil, ErrSettlementFile
+	}
+	if _, ok := columns["amount"]; !ok {
+		return nil, ErrSettlementFile
+	}
+	cell := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	rows := make([]SettlementInput, 0, len(records)-1)
+	for n, record := range records[1:] {
+		line := n + 2
+		row := SettlementInput{
+			TransactionRef: cell(record, "transaction_ref"),
+			GatewayRef:     cell(record, "gateway_ref"),
+			SettledAt:      day,
+		}
+		if row.TransactionRef == "" {
+			return nil, fmt.Errorf("line %d: missing transaction reference", line)
+		}
+		if row.Amount, err = parseSettlementAmount(cell(record, "amount")); err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount: %w", line, err)
+		}
+		if fee := cell(record, "fee"); fee != "" {
+			if row.Fee, err = parseSettlementAmount(fee); err != nil {
+				return nil, fmt.Errorf("line %d: invalid fee: %w", line, err)
+			}
+		}
+		if date := cell(record, "date"); date != "" {
+			if row.SettledAt, err = parseSettlementDate(date, day.Location()); err != nil {
+				return nil, fmt.Errorf("line %d: invalid date %q", line, date)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// ImportSettlement stores the rows of a gateway settlement file and matches each to the client
+// transaction it pays. Rows already imported are skipped, so overlapping files can be imported
+// safely.
+func (b *BillingRepo) ImportSettlement(
+	ctx context.Context, gateway settlementrow.Gateway, rows []SettlementInput, source, importedBy string,
+) (*SettlementImport, error) {
+	if err := settlementrow.GatewayValidator(gateway); err != nil {
+		return nil, err
+	}
+
+	result := &SettlementImport{Gateway: gateway, Source: source}
+	for _, in := range rows {
+		ref := strings.TrimSpace(in.TransactionRef)
+		status, txnAmount, err := b.matchSettlement(ctx, gateway, ref, in.Amount)
+		if err != nil {
+			return nil, err
+		}
+		row, err := b.orm.SettlementRow.Create().
+			SetGateway(gateway).
+			SetTransactionRef(ref).
+			SetGatewayRef(strings.TrimSpace(in.GatewayRef)).
+			SetAmount(RoundAmount(in.Amount)).
+			SetFee(RoundAmount(in.Fee)).
+			SetSettledAt(in.SettledAt).
+			SetStatus(status).
+			SetNillableTxnAmount(txnAmount).
+			SetSource(source).
+			SetImportedBy(importedBy).
+			Save(ctx)
+		if ent.IsConstraintError(err) {
+			result.Duplicates++
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		result.Imported++
+		if row.Status == settlementrow.StatusMatched {
+			result.Matched++
+		} else {
+			result.Exceptions = append(result.Exceptions, row)
+		}
+	}
+	return result, nil
+}
+
+// SettlementReport reconciles the given day. Rows that were exceptions are matched again first, as
+// a transaction may have been completed or corrected since its settlement was imported.
+func (b *BillingRepo) SettlementReport(ctx context.Context, day time.Time) (*SettlementReport, error) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+	report := &SettlementReport{
+		Day:         start.Format("2006-01-02"),
+		GeneratedAt: time.Now(),
+	}
+
+	rows, err := b.orm.SettlementRow.Query().
+		Where(
+			settlementrow.SettledAtGTE(start),
+			settlementrow.SettledAtLT(end),
+		).
+		Order(ent.Asc(settlementrow.FieldSettledAt), ent.Asc(settlementrow.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if row.Status == settlementrow.StatusMatched {
+			continue
+		}
+		status, txnAmount, err := b.matchSettlement(ctx, row.Gateway, row.TransactionRef, row.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if status != row.Status {
+			update := row.Update().SetStatus(status)
+			if txnAmount != nil {
+				update.SetTxnAmount(*txnAmount)
+			}
+			if row, err = update.Save(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if row.Status != settlementrow.StatusMatched {
+			report.Exceptions = append(report.Exceptions, row)
+		}
+	}
+
+	methods := make([]clienttxn.PaymentMethod, 0, len(SettlementGateways))
+	for _, gateway := range SettlementGateways {
+		methods = append(methods, gatewayPaymentMethod(gateway))
+	}
+	collected, err := b.orm.ClientTxn.Query().
+		Where(
+			clienttxn.TypeEQ(clienttxn.TypeRECHARGE),
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+			clienttxn.PaymentMethodIn(methods...),
+			clienttxn.TransactionDateGTE(start),
+			clienttxn.TransactionDateLT(end),
+		).
+		Order(ent.Asc(clienttxn.FieldTransactionDate)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// A payment is only settled by a row of the gateway it was paid through
+	settled := make(map[settlementKey]bool)
+	if len(collected) > 0 {
+		refs := make([]string, 0, len(collected))
+		for _, txn := range collected {
+			refs = append(refs, txn.TransactionRef)
+		}
+		settledRows, err := b.orm.SettlementRow.Query().
+			Where(settlementrow.TransactionRefIn(refs...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range settledRows {
+			settled[settlementKey{gatewayPaymentMethod(row.Gateway), row.TransactionRef}] = true
+		}
+	}
+
+	for _, gateway := range SettlementGateways {
+		summary := GatewaySettlement{Gateway: gateway}
+		for _, txn := range collected {
+			if txn.PaymentMethod == nil || *txn.PaymentMethod != gatewayPaymentMethod(gateway) {
+				continue
+			}
+			summary.Collected += txn.Amount
+			summary.CollectedCount++
+			if !settled[settlementKey{*txn.PaymentMethod, txn.TransactionRef}] {
+				summary.Unsettled++
+				report.Unsettled = append(report.Unsettled, txn)
+			}
+		}
+		for _, row := range rows {
+			if row.Gateway != gateway {
+				continue
+			}
+			summary.Settled += row.Amount
+			summary.SettledCount++
+			summary.Fees += row.Fee
+		}
+		for _, row := range report.Exceptions {
+			if row.Gateway == gateway {
+				summary.Exceptions++
+			}
+		}
+		if summary.CollectedCount == 0 && summary.SettledCount == 0 {
+			continue
+		}
+		summary.Collected = RoundAmount(summary.Collected)
+		summary.Settled = RoundAmount(summary.Settled)
+		summary.Fees = RoundAmount(summary.Fees)
+		summary.Net = RoundAmount(summary.Settled - summary.Fees)
+		report.Gateways = append(report.Gateways, summary)
+	}
+	return report, nil
+}
+
+// settlementKey identifies a gateway payment in a settlement
+type settlementKey struct {
+	method clienttxn.PaymentMethod
+	ref    string
+}
+
+// matchSettlement finds how a settled amount compares to the client transaction it pays. Only a
+// recharge paid through the gateway that settled it can match; a row naming anything else is
+// unmatched.
+func (b *BillingRepo) matchSettlement(
+	ctx context.Context, gateway settlementrow.Gateway, ref string, amount float64,
+) (settlementrow.Status, *float64, error) {
+	txn, err := b.orm.ClientTxn.Query().
+		Where(
+			clienttxn.TransactionRefEQ(ref),
+			clienttxn.TypeEQ(clienttxn.TypeRECHARGE),
+			clienttxn.PaymentMethodEQ(gatewayPaymentMethod(gateway)),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return settlementrow.StatusUnmatched, nil, nil
+	} else if err != nil {
+		return "", nil, err
+	}
+
+	switch {
+	case txn.Status != clienttxn.StatusCompleted:
+		return settlementrow.StatusStatusMismatch, &txn.Amount, nil
+	case !sameAmount(txn.Amount, RoundAmount(amount)):
+		return settlementrow.StatusAmountMismatch, &txn.Amount, nil
+	default:
+		return settlementrow.StatusMatched, &txn.Amount, nil
+	}
+}
+
+// gatewayPaymentMethod is the payment method of transactions paid through a gateway
+func gatewayPaymentMethod(gateway settlementrow.Gateway) clienttxn.PaymentMethod {
+	return clienttxn.PaymentMethod("gateway_" + string(gateway))
+}
+
+func parseSettlementAmount(value string) (float64, error) {
+	return strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+}
+
+func parseSettlementDate(value string, loc *time.Location) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02T15:04:05Z07:00", "2006-01-02", "02/01/2006"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown date format %q", value)
+}
@@ -0,0 +1,121 @@
+package billingrepo_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestParseSettlementCSV(t *testing.T) {
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	rows, err := billingrepo.ParseSettlementCSV(strings.NewReader(
+		"\ufeffTran_ID,Bank_Tran_ID,Amount,Fee,Settlement_Date\n"+
+			"TOP-1,B1,\"1,000.00\",15,2026-02-28\n"+
+			"TOP-2,B2,500,,\n"), day)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, billingrepo.SettlementInput{
+		TransactionRef: "TOP-1", GatewayRef: "B1", Amount: 1000, Fee: 15,
+		SettledAt: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+	}, rows[0])
+	assert.Equal(t, day, rows[1].SettledAt)
+	assert.Zero(t, rows[1].Fee)
+
+	_, err = billingrepo.ParseSettlementCSV(strings.NewReader("ref,total\nTOP-1,10\n"), day)
+	assert.ErrorIs(t, err, billingrepo.ErrSettlementFile)
+	_, err = billingrepo.ParseSettlementCSV(strings.NewReader("transaction_ref,amount\nTOP-1,ten\n"), day)
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestSettlementReconciliation(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "settle1", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	topUpWith := func(method clienttxn.PaymentMethod, amount float64, complete bool) string {
+		txn, err := billingRepo.StartTopUp(ctx, clientUser, amount, method)
+		require.NoError(t, err)
+		if complete {
+			_, err = billingRepo.CompleteTopUp(ctx, method, txn.TransactionRef, "GW-"+txn.TransactionRef, amount)
+			require.NoError(t, err)
+		}
+		return txn.TransactionRef
+	}
+	topUp := func(amount float64, complete bool) string {
+		return topUpWith(clienttxn.PaymentMethodGatewaySslcommerz, amount, complete)
+	}
+	matched := topUp(1000, true)
+	mismatched := topUp(500, true)
+	pending := topUp(200, false)
+	unsettled := topUp(300, true)
+	// Paid through another gateway, or not a payment at all, so SSLCommerz cannot have settled them
+	otherGateway := topUpWith(clienttxn.PaymentMethodGatewayBkash, 400, true)
+	refund := client.ClientTxn.Create().
+		SetTransactionRef("RFD-1").
+		SetAmount(100).
+		SetType(clienttxn.TypeREFUND).
+		SetPaymentMethod(clienttxn.PaymentMethodGatewaySslcommerz).
+		SetClientUsername(clientUser.Username).
+		SetCreatedBy("test").
+		SaveX(ctx)
+
+	today := time.Now()
+	rows := []billingrepo.SettlementInput{
+		{TransactionRef: matched, Amount: 1000, Fee: 15, SettledAt: today},
+		{TransactionRef: mismatched, Amount: 450, Fee: 7, SettledAt: today},
+		{TransactionRef: pending, Amount: 200, Fee: 3, SettledAt: today},
+		{TransactionRef: "TOP-UNKNOWN", Amount: 50, SettledAt: today},
+		{TransactionRef: otherGateway, Amount: 400, SettledAt: today},
+		{TransactionRef: refund.TransactionRef, Amount: 100, SettledAt: today},
+	}
+	result, err := billingRepo.ImportSettlement(ctx, settlementrow.GatewaySslcommerz, rows, "day.csv", "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 6, result.Imported)
+	assert.Equal(t, 1, result.Matched)
+	require.Len(t, result.Exceptions, 5)
+	assert.Equal(t, settlementrow.StatusAmountMismatch, result.Exceptions[0].Status)
+	assert.Equal(t, settlementrow.StatusStatusMismatch, result.Exceptions[1].Status)
+	assert.Equal(t, settlementrow.StatusUnmatched, result.Exceptions[2].Status)
+	assert.Equal(t, settlementrow.StatusUnmatched, result.Exceptions[3].Status)
+	assert.Equal(t, settlementrow.StatusUnmatched, result.Exceptions[4].Status)
+
+	// Importing the same file again changes nothing
+	result, err = billingRepo.ImportSettlement(ctx, settlementrow.GatewaySslcommerz, rows, "day.csv", "alice")
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Imported)
+	assert.Equal(t, 6, result.Duplicates)
+
+	// The pending payment is confirmed late, so the report no longer flags it
+	_, err = billingRepo.CompleteTopUp(ctx, clienttxn.PaymentMethodGatewaySslcommerz, pending, "GW-"+pending, 200)
+	require.NoError(t, err)
+
+	report, err := billingRepo.SettlementReport(ctx, today)
+	require.NoError(t, err)
+	require.Len(t, report.Gateways, 2)
+	summary := report.Gateways[1]
+	assert.Equal(t, settlementrow.GatewaySslcommerz, summary.Gateway)
+	assert.Equal(t, 2000.0, summary.Collected)
+	assert.Equal(t, 4, summary.CollectedCount)
+	assert.Equal(t, 2200.0, summary.Settled)
+	assert.Equal(t, 25.0, summary.Fees)
+	assert.Equal(t, 2175.0, summary.Net)
+	assert.Equal(t, 4, summary.Exceptions)
+	assert.Len(t, report.Exceptions, 4)
+
+	// The bKash payment on the SSLCommerz file is still waiting for its own settlement
+	assert.Equal(t, settlementrow.GatewayBkash, report.Gateways[0].Gateway)
+	assert.Equal(t, 1, report.Gateways[0].Unsettled)
+	require.Len(t, report.Unsettled, 2)
+	assert.Equal(t, otherGateway, report.Unsettled[0].TransactionRef)
+	assert.Equal(t, unsettled, report.Unsettled[1].TransactionRef)
+}
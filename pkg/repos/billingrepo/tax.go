@@ -0,0 +1,214 @@
+package billingrepo
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/invoice"
+	"github.com/mikestefanello/pagoda/ent/invoiceline"
+)
+
+// ServiceType is a kind of service clients are charged for, which tax rules are set per
+type ServiceType string
+
+const (
+	// ServiceInternet is a package, whether renewed, prepaid or invoiced
+	ServiceInternet ServiceType = "internet"
+	// ServiceAddon is a monthly add-on of a postpaid client, e.g. a static IP
+	ServiceAddon ServiceType = "addon"
+)
+
+// TaxRule is the tax on a service. Inclusive rules take the tax out of the price, exclusive ones
+// add it on top, so the client pays more than the price.
+type TaxRule struct {
+	Rate      float64
+	Inclusive bool
+}
+
+// Tax holds the tax rules by service type. The zero value taxes nothing.
+type Tax struct {
+	Name  string
+	rules map[ServiceType]TaxRule
+}
+
+// TaxBreakdown splits what a client pays for a service into the net amount and the tax on it
+type TaxBreakdown struct {
+	Service   ServiceType `json:"service"`
+	Rate      float64     `json:"rate"`
+	Inclusive bool        `json:"inclusive"`
+	Net       float64     `json:"net"`
+	Tax       float64     `json:"tax"`
+	Gross     float64     `json:"gross"`
+}
+
+// TaxSummary is the tax charged in a month, by service and rate. Prepaid charges count when they
+// are made and postpaid ones when their invoice is issued.
+type TaxSummary struct {
+	// Month is the first moment of the month
+	Month time.Time       `json:"month"`
+	Rows  []TaxSummaryRow `json:"rows"`
+	Net   float64         `json:"net"`
+	Tax   float64         `json:"tax"`
+	Gross float64         `json:"gross"`
+}
+
+// TaxSummaryRow totals the charges for a service at one rate. Count is the number of transactions
+// and invoice lines, credits for downgrades included as negative amounts.
+type TaxSummaryRow struct {
+	Service ServiceType `json:"service"`
+	Rate    float64     `json:"rate"`
+	Count   int         `json:"count"`
+	Net     float64     `json:"net"`
+	Tax     float64     `json:"tax"`
+	Gross   float64     `json:"gross"`
+}
+
+// NewTax builds the tax rules from configuration
+func NewTax(cfg config.TaxConfig) Tax {
+	tax := Tax{
+		Name:  cfg.Name,
+		rules: make(map[ServiceType]TaxRule, len(cfg.Rules)),
+	}
+	for _, r := range cfg.Rules {
+		tax.rules[ServiceType(r.Service)] = TaxRule{Rate: r.Rate, Inclusive: r.Inclusive}
+	}
+	return tax
+}
+
+// Apply works out the tax on a service priced at price. Under an inclusive rule price is the gross,
+// otherwise it is the net. Negative prices, i.e. credits, are split the same way.
+func (t Tax) Apply(service ServiceType, price float64) TaxBreakdown {
+	rule := t.rules[service]
+	b := TaxBreakdown{
+		Service:   service,
+		Rate:      rule.Rate,
+		Inclusive: rule.Inclusive,
+	}
+	price = RoundAmount(price)
+	switch {
+	case rule.Rate <= 0:
+		b.Net, b.Gross = price, price
+	case rule.Inclusive:
+		b.Gross = price
+		b.Net = RoundAmount(price * 100 / (100 + rule.Rate))
+		b.Tax = RoundAmount(b.Gross - b.Net)
+	default:
+		b.Net = price
+		b.Tax = RoundAmount(price * rule.Rate / 100)
+		b.Gross = RoundAmount(b.Net + b.Tax)
+	}
+	return b
+}
+
+// Gross is what a client pays for a service priced at price
+func (t Tax) Gross(service ServiceType, price float64) float64 {
+	return t.Apply(service, price).Gross
+}
+
+// WithTax makes the repo charge tax on services as configured. Without it nothing is taxed.
+func (b *BillingRepo) WithTax(cfg config.TaxConfig) *BillingRepo {
+	b.tax = NewTax(cfg)
+	return b
+}
+
+// Tax returns the tax rules the repo charges
+func (b *BillingRepo) Tax() Tax {
+	return b.tax
+}
+
+// PackagePrice is what a client pays for one cycle of a package, tax included
+func (b *BillingRepo) PackagePrice(plan *ent.PackagePlan) float64 {
+	return b.tax.Gross(ServiceInternet, plan.Price)
+}
+
+// MonthlyTaxSummary totals the tax charged in the month containing the given time
+func (b *BillingRepo) MonthlyTaxSummary(ctx context.Context, month time.Time) (*TaxSummary, error) {
+	month = month.In(time.Local)
+	start := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.Local)
+	end := start.AddDate(0, 1, 0)
+
+	txns, err := b.orm.ClientTxn.Query().
+		Where(
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+			clienttxn.ServiceTypeNotNil(),
+			clienttxn.TransactionDateGTE(start),
+			clienttxn.TransactionDateLT(end),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invoiceIDs, err := b.orm.Invoice.Query().
+		Where(
+			invoice.StatusNEQ(invoice.StatusVoid),
+			invoice.CreatedAtGTE(start),
+			invoice.CreatedAtLT(end),
+		).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var lines []*ent.InvoiceLine
+	for ids := range slices.Chunk(invoiceIDs, usernameBatchSize) {
+		batch, err := b.orm.InvoiceLine.Query().
+			Where(invoiceline.InvoiceIDIn(ids...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, batch...)
+	}
+
+	summary := &TaxSummary{Month: start, Rows: []TaxSummaryRow{}}
+	for _, txn := range txns {
+		summary.add(ServiceType(*txn.ServiceType), txn.TaxRate, txn.NetAmount, txn.TaxAmount, txn.Amount)
+	}
+	for _, l := range lines {
+		service := ServiceInternet
+		if l.Kind == invoiceline.KindAddon {
+			service = ServiceAddon
+		}
+		summary.add(service, l.TaxRate, l.NetAmount, l.TaxAmount, l.Amount)
+	}
+	sort.Slice(summary.Rows, func(i, j int) bool {
+		if summary.Rows[i].Service != summary.Rows[j].Service {
+			return summary.Rows[i].Service < summary.Rows[j].Service
+		}
+		return summary.Rows[i].Rate < summary.Rows[j].Rate
+	})
+	return summary, nil
+}
+
+func (s *TaxSummary) add(service ServiceType, rate, net, tax, gross float64) {
+	i := 0
+	for ; i < len(s.Rows); i++ {
+		if s.Rows[i].Service == service && s.Rows[i].Rate == rate {
+			break
+		}
+	}
+	if i == len(s.Rows) {
+		s.Rows = append(s.Rows, TaxSummaryRow{Service: service, Rate: rate})
+	}
+	row := &s.Rows[i]
+	row.Count++
+	row.Net = RoundAmount(row.Net + net)
+	row.Tax = RoundAmount(row.Tax + tax)
+	row.Gross = RoundAmount(row.Gross + gross)
+	s.Net = RoundAmount(s.Net + net)
+	s.Tax = RoundAmount(s.Tax + tax)
+	s.Gross = RoundAmount(s.Gross + gross)
+}
+
+// withTax records the tax breakdown of a charge on its transaction
+func withTax(create *ent.ClientTxnCreate, b TaxBreakdown) *ent.ClientTxnCreate {
+	return create.
+		SetNetAmount(b.Net).
+		SetTaxAmount(b.Tax).
+		SetTaxRate(b.Rate).
+		SetServiceType(clienttxn.ServiceType(b.Service))
+}
@@ -0,0 +1,49 @@
+package billingrepo_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+)
+
+func TestTaxApply(t *testing.T) {
+	tax := billingrepo.NewTax(config.TaxConfig{
+		Name: "VAT",
+		Rules: []config.TaxRuleConfig{
+			{Service: "internet", Rate: 5, Inclusive: true},
+			{Service: "addon", Rate: 15},
+		},
+	})
+
+	// Inclusive: the price is what the client pays and the tax is taken out of it
+	b := tax.Apply(billingrepo.ServiceInternet, 1050)
+	assert.Equal(t, 1000.0, b.Net)
+	assert.Equal(t, 50.0, b.Tax)
+	assert.Equal(t, 1050.0, b.Gross)
+
+	// Rounding never makes net and tax add up to more than the price
+	b = tax.Apply(billingrepo.ServiceInternet, 999)
+	assert.Equal(t, 951.43, b.Net)
+	assert.Equal(t, 47.57, b.Tax)
+	assert.Equal(t, 999.0, b.Gross)
+
+	// Exclusive: the tax is added on top of the price
+	b = tax.Apply(billingrepo.ServiceAddon, 300)
+	assert.Equal(t, 300.0, b.Net)
+	assert.Equal(t, 45.0, b.Tax)
+	assert.Equal(t, 345.0, tax.Gross(billingrepo.ServiceAddon, 300))
+
+	// A credit for a downgrade gets back the tax charged on it
+	b = tax.Apply(billingrepo.ServiceInternet, -105)
+	assert.Equal(t, -100.0, b.Net)
+	assert.Equal(t, -5.0, b.Tax)
+
+	// Services without a rule, and a repo without tax configured, are not taxed
+	b = billingrepo.Tax{}.Apply(billingrepo.ServiceInternet, 500)
+	assert.Equal(t, 500.0, b.Net)
+	assert.Zero(t, b.Tax)
+	assert.Equal(t, 500.0, b.Gross)
+}
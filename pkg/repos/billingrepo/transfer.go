@@ -0,0 +1,431 @@
+package billingrepo
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+)
+
+const (
+	transferCodeDigits = 6
+	// transferCodeInterval is how long a client waits before another code is sent
+	transferCodeInterval = time.Minute
+)
+
+var (
+	ErrTransferToSelf       = errors.New("cannot transfer to the same account")
+	ErrRecipientNotFound    = errors.New("recipient not found")
+	ErrTransferAmount       = errors.New("transfer amount is out of range")
+	ErrTransferDailyLimit   = errors.New("daily transfer limit reached")
+	ErrTransferNotFound     = errors.New("transfer not found or no longer pending")
+	ErrTransferExpired      = errors.New("transfer code has expired")
+	ErrTransferCodeMismatch = errors.New("incorrect transfer code")
+	ErrTransferLocked       = errors.New("too many incorrect transfer codes")
+	ErrTransferTooSoon      = errors.New("a transfer code was sent moments ago")
+)
+
+// TransferLimits bounds balance transfers between clients. Zero values are not enforced.
+type TransferLimits struct {
+	MinAmount   float64
+	MaxAmount   float64
+	DailyAmount float64
+	DailyCount  int
+	CodeExpiry  time.Duration
+	MaxAttempts int
+}
+
+// TransferUsage is what a client has already sent today
+type TransferUsage struct {
+	Amount float64
+	Count  int
+}
+
+// PendingTransfer is a transfer waiting for its one-time code
+type PendingTransfer struct {
+	Transfer  *ent.BalanceTransfer
+	Recipient *ent.ClientUser
+	// Code is sent to the sender and never stored in plain text
+	Code string
+}
+
+// Transfer is the outcome of a confirmed transfer
+type Transfer struct {
+	Transfer  *ent.BalanceTransfer
+	Sender    *ent.ClientUser
+	Recipient *ent.ClientUser
+	Debit     *ent.ClientTxn
+	Credit    *ent.ClientTxn
+}
+
+// StartTransfer validates a transfer and creates it pending confirmation, replacing any transfer
+// the sender left unconfirmed. The returned code has to be delivered to the sender.
+func (b *BillingRepo) StartTransfer(
+	ctx context.Context, sender *ent.ClientUser, toUsername string, amount float64, limits TransferLimits,
+) (*PendingTransfer, error) {
+	amount = RoundAmount(amount)
+	toUsername = strings.TrimSpace(toUsername)
+	if strings.EqualFold(toUsername, sender.Username) {
+		return nil, ErrTransferToSelf
+	}
+	if amount <= 0 || (limits.MinAmount > 0 && amount < limits.MinAmount) ||
+		(limits.MaxAmount > 0 && amount > limits.MaxAmount) {
+		return nil, ErrTransferAmount
+	}
+	if amount > sender.Balance {
+		return nil, ErrInsufficientBalance
+	}
+
+	recipient, err := b.orm.ClientUser.Query().
+		Where(clientuser.UsernameEQ(toUsername)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrRecipientNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	usage, err := transferUsage(ctx, b.orm, sender.Username)
+	if err != nil {
+		return nil, err
+	}
+	if !usage.allows(amount, limits) {
+		return nil, ErrTransferDailyLimit
+	}
+
+	recent, err := b.orm.BalanceTransfer.Query().
+		Where(
+			balancetransfer.FromUsernameEQ(sender.Username),
+			balancetransfer.CreatedAtGT(time.Now().Add(-transferCodeInterval)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if recent {
+		return nil, ErrTransferTooSoon
+	}
+
+	code, err := newTransferCode()
+	if err != nil {
+		return nil, err
+	}
+
+	var transfer *ent.BalanceTransfer
+	err = WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		_, err := tx.BalanceTransfer.Update().
+			Where(
+				balancetransfer.FromUsernameEQ(sender.Username),
+				balancetransfer.StatusEQ(balancetransfer.StatusPending),
+			).
+			SetStatus(balancetransfer.StatusCancelled).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		transfer, err = tx.BalanceTransfer.Create().
+			SetFromUsername(sender.Username).
+			SetToUsername(recipient.Username).
+			SetAmount(amount).
+			SetCodeHash(hashTransferCode(code)).
+			SetExpiresAt(time.Now().Add(limits.CodeExpiry)).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PendingTransfer{
+		Transfer:  transfer,
+		Recipient: recipient,
+		Code:      code,
+	}, nil
+}
+
+// GetPendingTransfer loads a sender's transfer that is still waiting for its code
+func (b *BillingRepo) GetPendingTransfer(ctx context.Context, senderUsername string, transferID int) (*ent.BalanceTransfer, error) {
+	transfer, err := b.orm.BalanceTransfer.Query().
+		Where(
+			balancetransfer.IDEQ(transferID),
+			balancetransfer.FromUsernameEQ(senderUsername),
+			balancetransfer.StatusEQ(balancetransfer.StatusPending),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrTransferNotFound
+	}
+	return transfer, err
+}
+
+// ConfirmTransfer checks the one-time code and moves the balance. The sender's TRANSFER_REFUND,
+// the recipient's TRANSFER_RECEIVED and both balances are written in one database transaction,
+// and the two transactions share the transfer's number in their transaction_ref.
+//
+// A wrong code counts as an attempt; once the limit is reached the transfer is cancelled.
+func (b *BillingRepo) ConfirmTransfer(
+	ctx context.Context, senderUsername string, transferID int, code string, limits TransferLimits,
+) (*Transfer, error) {
+	pending, err := b.GetPendingTransfer(ctx, senderUsername, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(pending.ExpiresAt) {
+		err := b.orm.BalanceTransfer.UpdateOneID(pending.ID).
+			Where(balancetransfer.StatusEQ(balancetransfer.StatusPending)).
+			SetStatus(balancetransfer.StatusExpired).
+			Exec(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		return nil, ErrTransferExpired
+	}
+	if subtle.ConstantTimeCompare([]byte(hashTransferCode(strings.TrimSpace(code))), []byte(pending.CodeHash)) != 1 {
+		return nil, b.failTransferAttempt(ctx, pending.ID, limits.MaxAttempts)
+	}
+
+	result := &Transfer{}
+	err = WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		// A no-op balance update locks the sender's row, so their other confirmations wait here and
+		// then count this transfer against the daily limits
+		n, err := tx.ClientUser.Update().
+			Where(clientuser.UsernameEQ(pending.FromUsername)).
+			AddBalance(0).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrClientNotFound
+		}
+
+		// Claiming the transfer first makes a second confirmation find nothing to do
+		now := time.Now()
+		n, err = tx.BalanceTransfer.Update().
+			Where(
+				balancetransfer.IDEQ(pending.ID),
+				balancetransfer.StatusEQ(balancetransfer.StatusPending),
+			).
+			SetStatus(balancetransfer.StatusCompleted).
+			SetCompletedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrTransferNotFound
+		}
+
+		// Limits are checked again here, as several transfers may have been started at once
+		usage, err := transferUsage(ctx, tx.Client(), senderUsername, pending.ID)
+		if err != nil {
+			return err
+		}
+		if !usage.allows(pending.Amount, limits) {
+			return ErrTransferDailyLimit
+		}
+
+		sender, err := tx.ClientUser.Query().
+			Where(clientuser.UsernameEQ(pending.FromUsername)).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		exists, err := tx.ClientUser.Query().
+			Where(clientuser.UsernameEQ(pending.ToUsername)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrRecipientNotFound
+		}
+		if err := debit(ctx, tx, sender.ID, pending.Amount); err != nil {
+			return err
+		}
+		senderBalance, err := balanceOf(ctx, tx, sender.Username)
+		if err != nil {
+			return err
+		}
+		recipientBalance, err := credit(ctx, tx, pending.ToUsername, pending.Amount)
+		if err != nil {
+			return err
+		}
+
+		debitRef := fmt.Sprintf("TRF-%d-OUT", pending.ID)
+		creditRef := fmt.Sprintf("TRF-%d-IN", pending.ID)
+		result.Debit, err = tx.ClientTxn.Create().
+			SetTransactionRef(debitRef).
+			SetAmount(pending.Amount).
+			SetType(clienttxn.TypeTRANSFER_REFUND).
+			SetStatus(clienttxn.StatusCompleted).
+			SetTotalBalance(senderBalance).
+			SetPaymentMethod(clienttxn.PaymentMethodClientBalance).
+			SetClientUsername(sender.Username).
+			SetDescription(fmt.Sprintf("Balance transfer to %s (%s)", pending.ToUsername, creditRef)).
+			SetTransactionDate(now).
+			SetCreatedBy(sender.Username).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		result.Credit, err = tx.ClientTxn.Create().
+			SetTransactionRef(creditRef).
+			SetAmount(pending.Amount).
+			SetType(clienttxn.TypeTRANSFER_RECEIVED).
+			SetStatus(clienttxn.StatusCompleted).
+			SetTotalBalance(recipientBalance).
+			SetPaymentMethod(clienttxn.PaymentMethodClientBalance).
+			SetClientUsername(pending.ToUsername).
+			SetDescription(fmt.Sprintf("Balance transfer from %s (%s)", sender.Username, debitRef)).
+			SetTransactionDate(now).
+			SetCreatedBy(sender.Username).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		result.Transfer, err = tx.BalanceTransfer.UpdateOneID(pending.ID).
+			SetDebitRef(debitRef).
+			SetCreditRef(creditRef).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		result.Sender, err = tx.ClientUser.Get(ctx, sender.ID)
+		if err != nil {
+			return err
+		}
+		result.Recipient, err = tx.ClientUser.Query().
+			Where(clientuser.UsernameEQ(pending.ToUsername)).
+			Only(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.afterPayment(ctx, result.Recipient.Username)
+	return result, nil
+}
+
+// TransferUsageToday is what a client has sent in completed transfers since midnight
+func (b *BillingRepo) TransferUsageToday(ctx context.Context, username string) (TransferUsage, error) {
+	return transferUsage(ctx, b.orm, username)
+}
+
+// failTransferAttempt counts a wrong code and cancels the transfer once the limit is reached.
+// Attempts are only added while some are left, and whether this one used up the last is read
+// back while the update still holds the row, so concurrent guesses cannot go over the limit.
+func (b *BillingRepo) failTransferAttempt(ctx context.Context, transferID, maxAttempts int) error {
+	var result error
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		update := tx.BalanceTransfer.Update().
+			Where(
+				balancetransfer.IDEQ(transferID),
+				balancetransfer.StatusEQ(balancetransfer.StatusPending),
+			).
+			AddAttempts(1)
+		if maxAttempts > 0 {
+			update.Where(balancetransfer.AttemptsLT(maxAttempts))
+		}
+		n, err := update.Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		transfer, err := tx.BalanceTransfer.Get(ctx, transferID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case n == 0 && transfer.Status == balancetransfer.StatusCancelled:
+			// Another wrong code already used up the last attempt
+			result = ErrTransferLocked
+		case n == 0:
+			result = ErrTransferNotFound
+		case maxAttempts > 0 && transfer.Attempts >= maxAttempts:
+			result = ErrTransferLocked
+			return tx.BalanceTransfer.UpdateOneID(transferID).
+				SetStatus(balancetransfer.StatusCancelled).
+				Exec(ctx)
+		default:
+			result = ErrTransferCodeMismatch
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return result
+}
+
+// transferUsage sums a client's completed transfers since midnight, leaving out the given ones
+func transferUsage(ctx context.Context, orm *ent.Client, username string, exclude ...int) (TransferUsage, error) {
+	query := orm.BalanceTransfer.Query().
+		Where(
+			balancetransfer.FromUsernameEQ(username),
+			balancetransfer.StatusEQ(balancetransfer.StatusCompleted),
+			balancetransfer.CompletedAtGTE(startOfDay(time.Now())),
+		)
+	if len(exclude) > 0 {
+		query.Where(balancetransfer.IDNotIn(exclude...))
+	}
+	transfers, err := query.All(ctx)
+	if err != nil {
+		return TransferUsage{}, err
+	}
+
+	var usage TransferUsage
+	for _, t := range transfers {
+		usage.Amount += t.Amount
+		usage.Count++
+	}
+	usage.Amount = RoundAmount(usage.Amount)
+	return usage, nil
+}
+
+// allows reports whether one more transfer of amount stays within the daily limits
+func (u TransferUsage) allows(amount float64, limits TransferLimits) bool {
+	if limits.DailyCount > 0 && u.Count+1 > limits.DailyCount {
+		return false
+	}
+	if limits.DailyAmount > 0 && RoundAmount(u.Amount+amount) > limits.DailyAmount {
+		return false
+	}
+	return true
+}
+
+func newTransferCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < transferCodeDigits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", transferCodeDigits, n), nil
+}
+
+func hashTransferCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,221 @@
+package billingrepo_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/balancetransfer"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestTransfer(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	sender := tests.CreateClientUser(ctx, client, "transfer1", 1000)
+	recipient := tests.CreateClientUser(ctx, client, "transfer2", 50)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	limits := billingrepo.TransferLimits{
+		MinAmount:   10,
+		MaxAmount:   500,
+		DailyAmount: 600,
+		DailyCount:  5,
+		CodeExpiry:  10 * time.Minute,
+		MaxAttempts: 3,
+	}
+
+	_, err := billingRepo.StartTransfer(ctx, sender, "transfer1", 100, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferToSelf)
+	_, err = billingRepo.StartTransfer(ctx, sender, "nobody", 100, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrRecipientNotFound)
+	_, err = billingRepo.StartTransfer(ctx, sender, "transfer2", 501, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferAmount)
+
+	pending, err := billingRepo.StartTransfer(ctx, sender, "transfer2", 400, limits)
+	require.NoError(t, err)
+	assert.Len(t, pending.Code, 6)
+	assert.NotEqual(t, pending.Code, pending.Transfer.CodeHash)
+
+	// Codes are not sent again right away
+	_, err = billingRepo.StartTransfer(ctx, sender, "transfer2", 400, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferTooSoon)
+
+	wrong := "000000"
+	if pending.Code == wrong {
+		wrong = "111111"
+	}
+	_, err = billingRepo.ConfirmTransfer(ctx, "transfer1", pending.Transfer.ID, wrong, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferCodeMismatch)
+
+	// Only the sender can confirm
+	_, err = billingRepo.ConfirmTransfer(ctx, "transfer2", pending.Transfer.ID, pending.Code, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferNotFound)
+
+	// Confirming twice at once moves the balance once
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = billingRepo.ConfirmTransfer(ctx, "transfer1", pending.Transfer.ID, pending.Code, limits)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 600.0, client.ClientUser.GetX(ctx, sender.ID).Balance)
+	assert.Equal(t, 450.0, client.ClientUser.GetX(ctx, recipient.ID).Balance)
+
+	transfer := client.BalanceTransfer.GetX(ctx, pending.Transfer.ID)
+	assert.Equal(t, balancetransfer.StatusCompleted, transfer.Status)
+	out := client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(transfer.DebitRef)).OnlyX(ctx)
+	in := client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ(transfer.CreditRef)).OnlyX(ctx)
+	assert.Equal(t, clienttxn.TypeTRANSFER_REFUND, out.Type)
+	assert.Equal(t, 600.0, out.TotalBalance)
+	assert.Equal(t, clienttxn.TypeTRANSFER_RECEIVED, in.Type)
+	assert.Equal(t, 450.0, in.TotalBalance)
+
+	// The daily amount is used up
+	sender = client.ClientUser.GetX(ctx, sender.ID)
+	_, err = billingRepo.StartTransfer(ctx, sender, "transfer2", 201, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferDailyLimit)
+}
+
+func TestTransferAttempts(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	sender := tests.CreateClientUser(ctx, client, "transfer3", 1000)
+	tests.CreateClientUser(ctx, client, "transfer4", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	limits := billingrepo.TransferLimits{CodeExpiry: 10 * time.Minute, MaxAttempts: 2}
+
+	pending, err := billingRepo.StartTransfer(ctx, sender, "transfer4", 100, limits)
+	require.NoError(t, err)
+
+	wrong := "000000"
+	if pending.Code == wrong {
+		wrong = "111111"
+	}
+	_, err = billingRepo.ConfirmTransfer(ctx, "transfer3", pending.Transfer.ID, wrong, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferCodeMismatch)
+	_, err = billingRepo.ConfirmTransfer(ctx, "transfer3", pending.Transfer.ID, wrong, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferLocked)
+
+	// Once locked, even the right code does nothing
+	_, err = billingRepo.ConfirmTransfer(ctx, "transfer3", pending.Transfer.ID, pending.Code, limits)
+	assert.ErrorIs(t, err, billingrepo.ErrTransferNotFound)
+	assert.Equal(t, 1000.0, client.ClientUser.GetX(ctx, sender.ID).Balance)
+}
+
+func TestTransferAttemptsRace(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	sender := tests.CreateClientUser(ctx, client, "transfer8", 1000)
+	tests.CreateClientUser(ctx, client, "transfer9", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	limits := billingrepo.TransferLimits{CodeExpiry: 10 * time.Minute, MaxAttempts: 3}
+
+	pending, err := billingRepo.StartTransfer(ctx, sender, "transfer9", 100, limits)
+	require.NoError(t, err)
+	wrong := "000000"
+	if pending.Code == wrong {
+		wrong = "111111"
+	}
+
+	// Guesses sent all at once still only get the allowed number of attempts
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, 10)
+	)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = billingRepo.ConfirmTransfer(ctx, "transfer8", pending.Transfer.ID, wrong, limits)
+		}()
+	}
+	wg.Wait()
+
+	var mismatches, locked int
+	for _, err := range errs {
+		switch {
+		case errors.Is(err, billingrepo.ErrTransferCodeMismatch):
+			mismatches++
+		case errors.Is(err, billingrepo.ErrTransferLocked):
+			locked++
+		default:
+			assert.ErrorIs(t, err, billingrepo.ErrTransferNotFound)
+		}
+	}
+	assert.Equal(t, 2, mismatches)
+	assert.GreaterOrEqual(t, locked, 1)
+
+	transfer := client.BalanceTransfer.GetX(ctx, pending.Transfer.ID)
+	assert.Equal(t, 3, transfer.Attempts)
+	assert.Equal(t, balancetransfer.StatusCancelled, transfer.Status)
+}
+
+func TestTransferDailyLimitRace(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	sender := tests.CreateClientUser(ctx, client, "transfer5", 1000)
+	tests.CreateClientUser(ctx, client, "transfer6", 0)
+	tests.CreateClientUser(ctx, client, "transfer7", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	limits := billingrepo.TransferLimits{DailyAmount: 600, CodeExpiry: 10 * time.Minute, MaxAttempts: 3}
+
+	// Two transfers waiting for the same code at once, which together go over the daily amount
+	pending, err := billingRepo.StartTransfer(ctx, sender, "transfer6", 400, limits)
+	require.NoError(t, err)
+	transfers := []*ent.BalanceTransfer{
+		pending.Transfer,
+		client.BalanceTransfer.Create().
+			SetFromUsername("transfer5").
+			SetToUsername("transfer7").
+			SetAmount(300).
+			SetCodeHash(pending.Transfer.CodeHash).
+			SetExpiresAt(pending.Transfer.ExpiresAt).
+			SaveX(ctx),
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(transfers))
+	)
+	for i, transfer := range transfers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = billingRepo.ConfirmTransfer(ctx, "transfer5", transfer.ID, pending.Code, limits)
+		}()
+	}
+	wg.Wait()
+
+	// Only one of them is let through
+	var (
+		confirmed int
+		moved     float64
+	)
+	for i, transfer := range transfers {
+		if errs[i] == nil {
+			confirmed++
+			moved += transfer.Amount
+			continue
+		}
+		assert.ErrorIs(t, errs[i], billingrepo.ErrTransferDailyLimit)
+		assert.Equal(t, balancetransfer.StatusPending, client.BalanceTransfer.GetX(ctx, transfer.ID).Status)
+	}
+	assert.Equal(t, 1, confirmed)
+	assert.Equal(t, 1000-moved, client.ClientUser.GetX(ctx, sender.ID).Balance)
+	assert.Equal(t, 1, client.ClientTxn.Query().Where(clienttxn.TypeEQ(clienttxn.TypeTRANSFER_REFUND)).CountX(ctx))
+}
@@ -0,0 +1,404 @@
+package billingrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/vendor"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+)
+
+var (
+	ErrVendorNotFound            = errors.New("vendor not found")
+	ErrVendorExists              = errors.New("a vendor with this id already exists")
+	ErrInsufficientVendorBalance = errors.New("insufficient vendor balance")
+	ErrNotVendorClient           = errors.New("client does not belong to this vendor")
+)
+
+// VendorInput describes a new vendor. ID must be the vendor_id their clients already carry.
+type VendorInput struct {
+	ID           int
+	Name         string
+	CName        string
+	MobileNumber string
+}
+
+// CommissionInput sets what vendors keep of a package's price. Without a VendorID it is the default
+// for vendors who have no rule of their own for the package.
+type CommissionInput struct {
+	PackageID int
+	VendorID  *int
+	Type      vendorcommission.CommissionType
+	Value     float64
+	UpdatedBy string
+}
+
+// VendorStatement is a vendor's wallet over a period, with every movement in it
+type VendorStatement struct {
+	Vendor *ent.Vendor `json:"vendor"`
+	// From is inclusive, To exclusive
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Opening float64   `json:"opening_balance"`
+	Closing float64   `json:"closing_balance"`
+	TopUps  float64   `json:"top_ups"`
+	// Charges is what the wallet paid for activations and renewals, Sales what the clients were
+	// charged for them and Commission the difference the vendor kept
+	Charges     float64          `json:"charges"`
+	Sales       float64          `json:"sales"`
+	Commission  float64          `json:"commission"`
+	Adjustments float64          `json:"adjustments"`
+	Activations int              `json:"activations"`
+	Renewals    int              `json:"renewals"`
+	Entries     []*ent.VendorTxn `json:"entries"`
+}
+
+// VendorCommission is what a vendor keeps of a package price under a commission rule, never more
+// than the price itself. Without a rule the vendor keeps nothing.
+func VendorCommission(rule *ent.VendorCommission, price float64) float64 {
+	if rule == nil || price <= 0 {
+		return 0
+	}
+	commission := rule.Value
+	if rule.CommissionType == vendorcommission.CommissionTypePercent {
+		commission = price * rule.Value / 100
+	}
+	return RoundAmount(math.Min(commission, price))
+}
+
+// CreateVendor adds a vendor with an empty wallet
+func (b *BillingRepo) CreateVendor(ctx context.Context, input VendorInput) (*ent.Vendor, error) {
+	v, err := b.orm.Vendor.Create().
+		SetID(input.ID).
+		SetName(strings.TrimSpace(input.Name)).
+		SetCName(strings.TrimSpace(input.CName)).
+		SetMobileNumber(strings.TrimSpace(input.MobileNumber)).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, ErrVendorExists
+	}
+	return v, err
+}
+
+// GetVendor returns a vendor by ID
+func (b *BillingRepo) GetVendor(ctx context.Context, vendorID int) (*ent.Vendor, error) {
+	v, err := b.orm.Vendor.Get(ctx, vendorID)
+	if ent.IsNotFound(err) {
+		return nil, ErrVendorNotFound
+	}
+	return v, err
+}
+
+// ListVendors returns every vendor by ID
+func (b *BillingRepo) ListVendors(ctx context.Context) ([]*ent.Vendor, error) {
+	return b.orm.Vendor.Query().
+		Order(ent.Asc(vendor.FieldID)).
+		All(ctx)
+}
+
+// TopUpVendor adds money a vendor paid us to their wallet
+func (b *BillingRepo) TopUpVendor(ctx context.Context, vendorID int, amount float64, note, createdBy string) (*ent.VendorTxn, error) {
+	amount = RoundAmount(amount)
+	if amount <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	description := "Wallet top-up"
+	if note = strings.TrimSpace(note); note != "" {
+		description += ": " + note
+	}
+	return b.moveVendorBalance(ctx, vendorID, vendortxn.TypeTOPUP, amount, description, createdBy)
+}
+
+// AdjustVendor corrects a vendor's wallet by amount, negative to take from it. The wallet cannot be
+// taken below zero.
+func (b *BillingRepo) AdjustVendor(ctx context.Context, vendorID int, amount float64, reason, createdBy string) (*ent.VendorTxn, error) {
+	amount = RoundAmount(amount)
+	reason = strings.TrimSpace(reason)
+	if amount == 0 || reason == "" {
+		return nil, ErrInvalidAmount
+	}
+	return b.moveVendorBalance(ctx, vendorID, vendortxn.TypeADJUSTMENT, amount, "Adjustment: "+reason, createdBy)
+}
+
+// RenewByVendor activates or renews a vendor's client for one billing cycle, paid from the
+// vendor's wallet rather than the client's balance. The wallet is charged the package price, tax
+// included, less the vendor's commission, and the client's RENEWAL, or ACTIVE for a client who had no active
+// package, records the full price paid by vendor_balance. See RenewPackage.
+func (b *BillingRepo) RenewByVendor(ctx context.Context, vendorID, clientID int, createdBy string) (*Renewal, error) {
+	return b.RenewPackage(ctx, clientID, RenewOptions{
+		CreatedBy: createdBy,
+		vendorID:  vendorID,
+	})
+}
+
+// SetCommission creates or replaces a commission rule for a package
+func (b *BillingRepo) SetCommission(ctx context.Context, input CommissionInput) (*ent.VendorCommission, error) {
+	if input.Value < 0 || (input.Type == vendorcommission.CommissionTypePercent && input.Value > 100) {
+		return nil, ErrInvalidAmount
+	}
+	if err := vendorcommission.CommissionTypeValidator(input.Type); err != nil {
+		return nil, err
+	}
+	if _, err := b.orm.PackagePlan.Get(ctx, input.PackageID); ent.IsNotFound(err) {
+		return nil, ErrPlanNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if input.VendorID != nil {
+		if _, err := b.GetVendor(ctx, *input.VendorID); err != nil {
+			return nil, err
+		}
+	}
+
+	var rule *ent.VendorCommission
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		existing, err := commissionRule(ctx, tx.Client(), input.PackageID, input.VendorID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			rule, err = existing.Update().
+				SetCommissionType(input.Type).
+				SetValue(RoundAmount(input.Value)).
+				SetUpdatedBy(input.UpdatedBy).
+				Save(ctx)
+			return err
+		}
+		rule, err = tx.VendorCommission.Create().
+			SetPackageID(input.PackageID).
+			SetNillableVendorID(input.VendorID).
+			SetCommissionType(input.Type).
+			SetValue(RoundAmount(input.Value)).
+			SetUpdatedBy(input.UpdatedBy).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// ListCommissions returns the commission rules of a vendor, or every rule when vendorID is zero,
+// by package
+func (b *BillingRepo) ListCommissions(ctx context.Context, vendorID int) ([]*ent.VendorCommission, error) {
+	query := b.orm.VendorCommission.Query().
+		Order(ent.Asc(vendorcommission.FieldPackageID), ent.Asc(vendorcommission.FieldVendorID))
+	if vendorID != 0 {
+		query.Where(vendorcommission.Or(
+			vendorcommission.VendorIDEQ(vendorID),
+			vendorcommission.VendorIDIsNil(),
+		))
+	}
+	return query.All(ctx)
+}
+
+// VendorStatementFor returns a vendor's wallet statement for [from, to)
+func (b *BillingRepo) VendorStatementFor(ctx context.Context, vendorID int, from, to time.Time) (*VendorStatement, error) {
+	v, err := b.GetVendor(ctx, vendorID)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &VendorStatement{
+		Vendor:  v,
+		From:    from,
+		To:      to,
+		Entries: []*ent.VendorTxn{},
+	}
+	last, err := b.orm.VendorTxn.Query().
+		Where(
+			vendortxn.VendorIDEQ(vendorID),
+			vendortxn.CreatedAtLT(from),
+		).
+		Order(ent.Desc(vendortxn.FieldCreatedAt), ent.Desc(vendortxn.FieldID)).
+		First(ctx)
+	if err == nil {
+		statement.Opening = last.TotalBalance
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	entries, err := b.orm.VendorTxn.Query().
+		Where(
+			vendortxn.VendorIDEQ(vendorID),
+			vendortxn.CreatedAtGTE(from),
+			vendortxn.CreatedAtLT(to),
+		).
+		Order(ent.Asc(vendortxn.FieldCreatedAt), ent.Asc(vendortxn.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statement.Closing = statement.Opening
+	statement.add(entries...)
+	return statement, nil
+}
+
+// add totals up entries, which must come in order, onto the statement
+func (s *VendorStatement) add(entries ...*ent.VendorTxn) {
+	for _, e := range entries {
+		switch e.Type {
+		case vendortxn.TypeTOPUP:
+			s.TopUps += e.Amount
+		case vendortxn.TypeADJUSTMENT:
+			s.Adjustments += e.Amount
+		case vendortxn.TypeACTIVE, vendortxn.TypeRENEWAL:
+			s.Charges -= e.Amount
+			s.Sales += e.Price
+			s.Commission += e.Commission
+			if e.Type == vendortxn.TypeACTIVE {
+				s.Activations++
+			} else {
+				s.Renewals++
+			}
+		}
+		s.Closing = e.TotalBalance
+		s.Entries = append(s.Entries, e)
+	}
+	s.TopUps = RoundAmount(s.TopUps)
+	s.Adjustments = RoundAmount(s.Adjustments)
+	s.Charges = RoundAmount(s.Charges)
+	s.Sales = RoundAmount(s.Sales)
+	s.Commission = RoundAmount(s.Commission)
+}
+
+func (b *BillingRepo) moveVendorBalance(
+	ctx context.Context, vendorID int, typ vendortxn.Type, amount float64, description, createdBy string,
+) (*ent.VendorTxn, error) {
+	var txn *ent.VendorTxn
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		v, err := moveVendorWallet(ctx, tx, vendorID, amount)
+		if err != nil {
+			return err
+		}
+		txn, err = tx.VendorTxn.Create().
+			SetTransactionRef(NewTransactionRef("VND")).
+			SetVendorID(vendorID).
+			SetType(typ).
+			SetAmount(amount).
+			SetTotalBalance(RoundAmount(v.Balance)).
+			SetDescription(description).
+			SetCreatedBy(createdBy).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return txn, nil
+}
+
+// chargeVendor pays for a client's renewal from their vendor's wallet. The vendor is charged what
+// the client pays, tax included, less their commission on the net price, and the movement is
+// recorded against the client's transaction.
+func chargeVendor(
+	ctx context.Context, tx *ent.Tx, vendorID int, client *ent.ClientUser, plan *ent.PackagePlan, charge TaxBreakdown, clientTxn *ent.ClientTxn,
+) (*ent.VendorTxn, error) {
+	rule, err := vendorCommissionRule(ctx, tx.Client(), plan.ID, vendorID)
+	if err != nil {
+		return nil, err
+	}
+	commission := VendorCommission(rule, charge.Net)
+	cost := RoundAmount(charge.Gross - commission)
+
+	v, err := moveVendorWallet(ctx, tx, vendorID, -cost)
+	if err != nil {
+		return nil, err
+	}
+
+	typ := vendortxn.TypeRENEWAL
+	if clientTxn.Type == clienttxn.TypeACTIVE {
+		typ = vendortxn.TypeACTIVE
+	}
+	return tx.VendorTxn.Create().
+		SetTransactionRef(NewTransactionRef("VND")).
+		SetVendorID(vendorID).
+		SetType(typ).
+		SetAmount(-cost).
+		SetTotalBalance(RoundAmount(v.Balance)).
+		SetClientUsername(client.Username).
+		SetClientTxnRef(clientTxn.TransactionRef).
+		SetPackageID(plan.ID).
+		SetPrice(charge.Gross).
+		SetCommission(commission).
+		SetDescription(clientTxn.Description).
+		SetCreatedBy(clientTxn.CreatedBy).
+		Save(ctx)
+}
+
+// moveVendorWallet adds amount to a vendor's wallet, failing rather than letting it go negative,
+// and returns the vendor as updated
+func moveVendorWallet(ctx context.Context, tx *ent.Tx, vendorID int, amount float64) (*ent.Vendor, error) {
+	update := tx.Vendor.Update().
+		Where(vendor.IDEQ(vendorID)).
+		AddBalance(amount)
+	if amount < 0 {
+		update.Where(
+			vendor.IsActiveEQ(true),
+			vendor.BalanceGTE(-amount),
+		)
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		v, err := tx.Vendor.Get(ctx, vendorID)
+		if ent.IsNotFound(err) {
+			return nil, ErrVendorNotFound
+		} else if err != nil {
+			return nil, err
+		}
+		if !v.IsActive {
+			return nil, fmt.Errorf("vendor %d is not active: %w", vendorID, ErrVendorNotFound)
+		}
+		return nil, ErrInsufficientVendorBalance
+	}
+	return tx.Vendor.Get(ctx, vendorID)
+}
+
+// vendorCommissionRule returns the rule that applies to a vendor selling a package: their own if
+// they have one, otherwise the package's default, nil if neither exists
+func vendorCommissionRule(ctx context.Context, orm *ent.Client, packageID, vendorID int) (*ent.VendorCommission, error) {
+	rule, err := commissionRule(ctx, orm, packageID, &vendorID)
+	if rule != nil || err != nil {
+		return rule, err
+	}
+	return commissionRule(ctx, orm, packageID, nil)
+}
+
+func commissionRule(ctx context.Context, orm *ent.Client, packageID int, vendorID *int) (*ent.VendorCommission, error) {
+	query := orm.VendorCommission.Query().
+		Where(vendorcommission.PackageIDEQ(packageID))
+	if vendorID != nil {
+		query.Where(vendorcommission.VendorIDEQ(*vendorID))
+	} else {
+		query.Where(vendorcommission.VendorIDIsNil())
+	}
+	rule, err := query.First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return rule, err
+}
+
+// vendorClient checks that a client belongs to a vendor and is not postpaid, whose renewals are
+// invoiced to the client instead
+func vendorClient(client *ent.ClientUser, vendorID int) error {
+	if client.VendorID != vendorID {
+		return ErrNotVendorClient
+	}
+	if client.BillingMode == clientuser.BillingModePostpaid {
+		return ErrPostpaid
+	}
+	return nil
+}
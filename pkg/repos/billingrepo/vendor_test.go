@@ -0,0 +1,157 @@
+package billingrepo_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/vendorcommission"
+	"github.com/mikestefanello/pagoda/ent/vendortxn"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestVendorCommission(t *testing.T) {
+	percent := &ent.VendorCommission{CommissionType: vendorcommission.CommissionTypePercent, Value: 12.5}
+	fixed := &ent.VendorCommission{CommissionType: vendorcommission.CommissionTypeFixed, Value: 150}
+
+	assert.Equal(t, 62.5, billingrepo.VendorCommission(percent, 500))
+	assert.Equal(t, 150.0, billingrepo.VendorCommission(fixed, 500))
+
+	// Never more than the price, so a vendor is not paid to sell a cheap package
+	assert.Equal(t, 100.0, billingrepo.VendorCommission(fixed, 100))
+	assert.Zero(t, billingrepo.VendorCommission(fixed, 0))
+
+	// Without a rule the vendor pays the full price
+	assert.Zero(t, billingrepo.VendorCommission(nil, 500))
+}
+
+func TestRenewByVendor(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300)
+	clientUser := tests.CreateSubscriber(ctx, client, "vendor1", 50, plan)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+
+	_, err := billingRepo.CreateVendor(ctx, billingrepo.VendorInput{ID: 1, Name: "Corner shop", CName: "test"})
+	require.NoError(t, err)
+	_, err = billingRepo.CreateVendor(ctx, billingrepo.VendorInput{ID: 1, Name: "Again", CName: "test"})
+	assert.ErrorIs(t, err, billingrepo.ErrVendorExists)
+	_, err = billingRepo.CreateVendor(ctx, billingrepo.VendorInput{ID: 2, Name: "Other shop", CName: "test"})
+	require.NoError(t, err)
+
+	_, err = billingRepo.RenewByVendor(ctx, 1, clientUser.ID, "shop")
+	assert.ErrorIs(t, err, billingrepo.ErrInsufficientVendorBalance)
+	_, err = billingRepo.TopUpVendor(ctx, 1, 1000, "cash", "billing")
+	require.NoError(t, err)
+	_, err = billingRepo.SetCommission(ctx, billingrepo.CommissionInput{
+		PackageID: plan.ID, Type: vendorcommission.CommissionTypePercent, Value: 10, UpdatedBy: "billing",
+	})
+	require.NoError(t, err)
+
+	// A client without a package yet is activated, paid from the wallet less the default commission
+	renewal, err := billingRepo.RenewByVendor(ctx, 1, clientUser.ID, "shop")
+	require.NoError(t, err)
+	assert.Equal(t, clienttxn.TypeACTIVE, renewal.Txn.Type)
+	assert.Equal(t, clienttxn.PaymentMethodVendorBalance, *renewal.Txn.PaymentMethod)
+	assert.Equal(t, 300.0, renewal.Txn.Amount)
+	require.NotNil(t, renewal.VendorTxn)
+	assert.Equal(t, vendortxn.TypeACTIVE, renewal.VendorTxn.Type)
+	assert.Equal(t, -270.0, renewal.VendorTxn.Amount)
+	assert.Equal(t, 30.0, renewal.VendorTxn.Commission)
+	assert.Equal(t, renewal.Txn.TransactionRef, renewal.VendorTxn.ClientTxnRef)
+	assert.Equal(t, 730.0, client.Vendor.GetX(ctx, 1).Balance)
+	assert.Equal(t, 50.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	assert.Zero(t, billingrepo.BalanceEffect(renewal.Txn))
+
+	// The vendor's own rule wins over the default
+	vendorID := 1
+	_, err = billingRepo.SetCommission(ctx, billingrepo.CommissionInput{
+		PackageID: plan.ID, VendorID: &vendorID, Type: vendorcommission.CommissionTypeFixed, Value: 50, UpdatedBy: "billing",
+	})
+	require.NoError(t, err)
+	renewal, err = billingRepo.RenewByVendor(ctx, 1, clientUser.ID, "shop")
+	require.NoError(t, err)
+	assert.Equal(t, clienttxn.TypeRENEWAL, renewal.Txn.Type)
+	assert.Equal(t, -250.0, renewal.VendorTxn.Amount)
+	assert.Equal(t, 480.0, client.Vendor.GetX(ctx, 1).Balance)
+
+	// Another vendor cannot renew the client, and a wallet short of the cost changes nothing
+	_, err = billingRepo.TopUpVendor(ctx, 2, 1000, "", "billing")
+	require.NoError(t, err)
+	_, err = billingRepo.RenewByVendor(ctx, 2, clientUser.ID, "other")
+	assert.ErrorIs(t, err, billingrepo.ErrNotVendorClient)
+	_, err = billingRepo.AdjustVendor(ctx, 1, -500, "chargeback", "billing")
+	assert.ErrorIs(t, err, billingrepo.ErrInsufficientVendorBalance)
+	_, err = billingRepo.AdjustVendor(ctx, 1, -400, "chargeback", "billing")
+	require.NoError(t, err)
+	expiry, err := radiusrepo.NewRadiusRepo(client).Expiry(ctx, clientUser.Username)
+	require.NoError(t, err)
+	_, err = billingRepo.RenewByVendor(ctx, 1, clientUser.ID, "shop")
+	assert.ErrorIs(t, err, billingrepo.ErrInsufficientVendorBalance)
+	assert.Equal(t, 80.0, client.Vendor.GetX(ctx, 1).Balance)
+	after, err := radiusrepo.NewRadiusRepo(client).Expiry(ctx, clientUser.Username)
+	require.NoError(t, err)
+	assert.Equal(t, expiry.Unix(), after.Unix())
+	assert.Equal(t, 2, client.ClientTxn.Query().Where(clienttxn.ClientUsernameEQ(clientUser.Username)).CountX(ctx))
+
+	statement, err := billingRepo.VendorStatementFor(ctx, 1, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, statement.Opening)
+	assert.Equal(t, 80.0, statement.Closing)
+	assert.Equal(t, 1000.0, statement.TopUps)
+	assert.Equal(t, -400.0, statement.Adjustments)
+	assert.Equal(t, 520.0, statement.Charges)
+	assert.Equal(t, 600.0, statement.Sales)
+	assert.Equal(t, 80.0, statement.Commission)
+	assert.Equal(t, 1, statement.Activations)
+	assert.Equal(t, 1, statement.Renewals)
+}
+
+func TestRenewByVendorRace(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	plan := tests.CreatePackagePlan(ctx, client, "home", 300)
+	billingRepo := billingrepo.NewBillingRepo(client, 30)
+	_, err := billingRepo.CreateVendor(ctx, billingrepo.VendorInput{ID: 1, Name: "Corner shop", CName: "test"})
+	require.NoError(t, err)
+	_, err = billingRepo.TopUpVendor(ctx, 1, 600, "", "billing")
+	require.NoError(t, err)
+
+	// The wallet covers two renewals, however many are sold at once
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		renewed int
+	)
+	for i := 0; i < 5; i++ {
+		clientUser := tests.CreateSubscriber(ctx, client, fmt.Sprintf("vendorrace%d", i), 0, plan)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := billingRepo.RenewByVendor(ctx, 1, clientUser.ID, "shop")
+			if err == nil {
+				mu.Lock()
+				renewed++
+				mu.Unlock()
+			} else {
+				assert.ErrorIs(t, err, billingrepo.ErrInsufficientVendorBalance)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, renewed)
+	assert.Zero(t, client.Vendor.GetX(ctx, 1).Balance)
+	assert.Equal(t, 2, client.ClientTxn.Query().Where(clienttxn.StatusEQ(clienttxn.StatusCompleted)).CountX(ctx))
+	assert.Equal(t, 2, client.VendorTxn.Query().Where(vendortxn.TypeEQ(vendortxn.TypeACTIVE)).CountX(ctx))
+}
@@ -0,0 +1,337 @@
+package billingrepo
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+	"github.com/mikestefanello/pagoda/ent/voucherattempt"
+	"github.com/mikestefanello/pagoda/ent/voucherbatch"
+)
+
+const (
+	voucherPINDigits = 16
+	// maxVoucherBatch keeps a single batch to what can be generated and printed in one go
+	maxVoucherBatch = 10000
+)
+
+var (
+	ErrVoucherInvalid = errors.New("voucher PIN is invalid, used or expired")
+	ErrVoucherLocked  = errors.New("too many invalid voucher PINs")
+	// ErrVoucherRedeemed is returned to the client who already redeemed the voucher, e.g. on a
+	// double submit, and does not count as an invalid PIN
+	ErrVoucherRedeemed = errors.New("voucher was already redeemed by this client")
+	ErrVoucherBatch    = errors.New("voucher batch needs a name, an amount and a count")
+)
+
+// VoucherLimits slows down PIN guessing: a client with MaxAttempts invalid PINs within Window
+// cannot redeem vouchers until the oldest of them falls out of it. Zero values are not enforced.
+type VoucherLimits struct {
+	MaxAttempts int
+	Window      time.Duration
+}
+
+// VoucherBatchInput describes a batch of vouchers to generate
+type VoucherBatchInput struct {
+	Name      string
+	Amount    float64
+	Count     int
+	ExpiresAt *time.Time
+	CreatedBy string
+}
+
+// IssuedVoucher is a freshly generated voucher. Its PIN is only known at this point and has to go
+// straight to the printer.
+type IssuedVoucher struct {
+	Serial    string
+	PIN       string
+	Amount    float64
+	ExpiresAt *time.Time
+}
+
+// WithVoucherSecret sets the key voucher PINs are hashed with. Changing it makes every unused
+// voucher unredeemable, since their PINs no longer match what was stored.
+func (b *BillingRepo) WithVoucherSecret(secret string) *BillingRepo {
+	b.voucherSecret = secret
+	return b
+}
+
+// GenerateVouchers creates a batch of vouchers of one denomination. Only PIN hashes are stored, so
+// the returned PINs are the only copy.
+func (b *BillingRepo) GenerateVouchers(ctx context.Context, input VoucherBatchInput) (*ent.VoucherBatch, []IssuedVoucher, error) {
+	input.Amount = RoundAmount(input.Amount)
+	if strings.TrimSpace(input.Name) == "" || input.Amount <= 0 || input.Count <= 0 || input.Count > maxVoucherBatch {
+		return nil, nil, ErrVoucherBatch
+	}
+
+	var (
+		batch  *ent.VoucherBatch
+		issued = make([]IssuedVoucher, 0, input.Count)
+	)
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		var err error
+		batch, err = tx.VoucherBatch.Create().
+			SetName(strings.TrimSpace(input.Name)).
+			SetAmount(input.Amount).
+			SetCount(input.Count).
+			SetNillableExpiresAt(input.ExpiresAt).
+			SetCreatedBy(input.CreatedBy).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		creates := make([]*ent.VoucherCreate, 0, input.Count)
+		for i := 1; i <= input.Count; i++ {
+			pin, err := newVoucherPIN()
+			if err != nil {
+				return err
+			}
+			v := IssuedVoucher{
+				Serial:    fmt.Sprintf("V%d-%05d", batch.ID, i),
+				PIN:       pin,
+				Amount:    input.Amount,
+				ExpiresAt: input.ExpiresAt,
+			}
+			issued = append(issued, v)
+			creates = append(creates, tx.Voucher.Create().
+				SetBatchID(batch.ID).
+				SetSerial(v.Serial).
+				SetPinHash(b.hashVoucherPIN(NormalizeVoucherPIN(pin))).
+				SetAmount(v.Amount).
+				SetNillableExpiresAt(v.ExpiresAt))
+		}
+		// Insert in chunks to stay well below placeholder limits
+		for start := 0; start < len(creates); start += 500 {
+			end := min(start+500, len(creates))
+			if err := tx.Voucher.CreateBulk(creates[start:end]...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return batch, issued, nil
+}
+
+// ListVoucherBatches returns every batch, newest first
+func (b *BillingRepo) ListVoucherBatches(ctx context.Context) ([]*ent.VoucherBatch, error) {
+	return b.orm.VoucherBatch.Query().
+		Order(ent.Desc(voucherbatch.FieldCreatedAt)).
+		All(ctx)
+}
+
+// BatchVouchers lists the vouchers of a batch in serial order
+func (b *BillingRepo) BatchVouchers(ctx context.Context, batchID int) ([]*ent.Voucher, error) {
+	return b.orm.Voucher.Query().
+		Where(voucher.BatchIDEQ(batchID)).
+		Order(ent.Asc(voucher.FieldSerial)).
+		All(ctx)
+}
+
+// DisableVoucherBatch stops the unused vouchers of a batch from being redeemed, e.g. when cards
+// were lost or stolen. It returns how many vouchers were disabled.
+func (b *BillingRepo) DisableVoucherBatch(ctx context.Context, batchID int) (int, error) {
+	return b.orm.Voucher.Update().
+		Where(
+			voucher.BatchIDEQ(batchID),
+			voucher.StatusEQ(voucher.StatusActive),
+		).
+		SetStatus(voucher.StatusDisabled).
+		Save(ctx)
+}
+
+// RedeemVoucher credits a client's balance with the value of a voucher and records it as a
+// RECHARGE. Every invalid PIN counts against the client's limits, whether it never existed, was
+// used by someone else or has expired, so guessing PINs quickly locks the client out.
+func (b *BillingRepo) RedeemVoucher(
+	ctx context.Context, client *ent.ClientUser, pin, ip string, limits VoucherLimits,
+) (*ent.ClientTxn, error) {
+	pin = NormalizeVoucherPIN(pin)
+	var (
+		txn    *ent.ClientTxn
+		result error
+	)
+	err := WithTx(ctx, b.orm, func(tx *ent.Tx) error {
+		// A no-op balance update locks the client's row, so their other guesses wait here and then
+		// count this one against the limit
+		n, err := tx.ClientUser.Update().
+			Where(clientuser.UsernameEQ(client.Username)).
+			AddBalance(0).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrClientNotFound
+		}
+
+		failed, err := failedVoucherAttempts(ctx, tx.Client(), client.Username, limits)
+		if err != nil {
+			return err
+		}
+		if limits.MaxAttempts > 0 && failed >= limits.MaxAttempts {
+			result = ErrVoucherLocked
+			return nil
+		}
+
+		txn, err = b.redeemVoucher(ctx, tx, client.Username, pin)
+		if !errors.Is(err, ErrVoucherInvalid) {
+			return err
+		}
+
+		// The attempt is committed with the rest of the transaction, which has nothing else to
+		// write for an invalid PIN
+		result = ErrVoucherInvalid
+		if limits.MaxAttempts > 0 && failed+1 >= limits.MaxAttempts {
+			result = ErrVoucherLocked
+		}
+		return tx.VoucherAttempt.Create().
+			SetClientUsername(client.Username).
+			SetIP(ip).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result != nil {
+		return nil, result
+	}
+	b.afterPayment(ctx, client.Username)
+	return txn, nil
+}
+
+// redeemVoucher claims the voucher with the given PIN for a client and credits them
+func (b *BillingRepo) redeemVoucher(ctx context.Context, tx *ent.Tx, username, pin string) (*ent.ClientTxn, error) {
+	now := time.Now()
+	v, err := tx.Voucher.Query().
+		Where(voucher.PinHashEQ(b.hashVoucherPIN(pin))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrVoucherInvalid
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Claiming the voucher only while it is still active makes a concurrent redemption of the
+	// same PIN a no-op
+	ref := "VCH-" + v.Serial
+	n, err := tx.Voucher.Update().
+		Where(
+			voucher.IDEQ(v.ID),
+			voucher.StatusEQ(voucher.StatusActive),
+			voucher.Or(
+				voucher.ExpiresAtIsNil(),
+				voucher.ExpiresAtGT(now),
+			),
+		).
+		SetStatus(voucher.StatusRedeemed).
+		SetRedeemedBy(username).
+		SetRedeemedAt(now).
+		SetTransactionRef(ref).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		v, err = tx.Voucher.Get(ctx, v.ID)
+		if err != nil {
+			return nil, err
+		}
+		if v.Status == voucher.StatusRedeemed && v.RedeemedBy == username {
+			return nil, ErrVoucherRedeemed
+		}
+		return nil, ErrVoucherInvalid
+	}
+
+	balance, err := credit(ctx, tx, username, v.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return tx.ClientTxn.Create().
+		SetTransactionRef(ref).
+		SetAmount(v.Amount).
+		SetType(clienttxn.TypeRECHARGE).
+		SetStatus(clienttxn.StatusCompleted).
+		SetTotalBalance(balance).
+		SetPaymentMethod(clienttxn.PaymentMethodCash).
+		SetClientUsername(username).
+		SetDescription(fmt.Sprintf("Recharge voucher %s", v.Serial)).
+		SetCreatedBy(createdBySelfCare).
+		Save(ctx)
+}
+
+// VoucherAttemptsLeft is how many more invalid PINs a client may enter before being locked out,
+// -1 if attempts are not limited
+func (b *BillingRepo) VoucherAttemptsLeft(ctx context.Context, username string, limits VoucherLimits) (int, error) {
+	if limits.MaxAttempts <= 0 {
+		return -1, nil
+	}
+	failed, err := failedVoucherAttempts(ctx, b.orm, username, limits)
+	if err != nil {
+		return 0, err
+	}
+	return max(limits.MaxAttempts-failed, 0), nil
+}
+
+func failedVoucherAttempts(ctx context.Context, orm *ent.Client, username string, limits VoucherLimits) (int, error) {
+	if limits.MaxAttempts <= 0 {
+		return 0, nil
+	}
+	return orm.VoucherAttempt.Query().
+		Where(
+			voucherattempt.ClientUsernameEQ(username),
+			voucherattempt.CreatedAtGT(time.Now().Add(-limits.Window)),
+		).
+		Count(ctx)
+}
+
+// NormalizeVoucherPIN strips the spaces and dashes a PIN is printed or typed with
+func NormalizeVoucherPIN(pin string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, pin)
+}
+
+// FormatVoucherPIN groups a PIN in blocks of four digits for printing
+func FormatVoucherPIN(pin string) string {
+	var groups []string
+	for len(pin) > 4 {
+		groups = append(groups, pin[:4])
+		pin = pin[4:]
+	}
+	return strings.Join(append(groups, pin), "-")
+}
+
+// hashVoucherPIN keys the hash of a PIN with the voucher secret. PINs are only 16 digits, so a
+// plain hash of a leaked voucher table could be brute forced back to redeemable PINs.
+func (b *BillingRepo) hashVoucherPIN(pin string) string {
+	mac := hmac.New(sha256.New, []byte(b.voucherSecret))
+	mac.Write([]byte("voucher-pin|" + pin))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newVoucherPIN() (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(voucherPINDigits), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", voucherPINDigits, n), nil
+}
@@ -0,0 +1,124 @@
+package billingrepo_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/voucher"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestVoucherPIN(t *testing.T) {
+	assert.Equal(t, "1234567890123456", billingrepo.NormalizeVoucherPIN(" 1234-5678 9012-3456 "))
+	assert.Equal(t, "1234-5678-9012-3456", billingrepo.FormatVoucherPIN("1234567890123456"))
+	assert.Equal(t, "1234-56", billingrepo.FormatVoucherPIN("123456"))
+}
+
+func TestRedeemVoucher(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "voucher1", 100)
+	other := tests.CreateClientUser(ctx, client, "voucher2", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30).WithVoucherSecret("voucher-secret")
+	limits := billingrepo.VoucherLimits{MaxAttempts: 3, Window: time.Hour}
+
+	batch, issued, err := billingRepo.GenerateVouchers(ctx, billingrepo.VoucherBatchInput{
+		Name: "Test", Amount: 500, Count: 3, CreatedBy: "test",
+	})
+	require.NoError(t, err)
+	require.Len(t, issued, 3)
+	assert.Len(t, client.Voucher.Query().Where(voucher.BatchIDEQ(batch.ID)).AllX(ctx), 3)
+
+	// PINs only match under the secret they were hashed with
+	_, err = billingrepo.NewBillingRepo(client, 30).WithVoucherSecret("other-secret").
+		RedeemVoucher(ctx, tests.CreateClientUser(ctx, client, "voucher4", 0), issued[0].PIN, "", limits)
+	assert.ErrorIs(t, err, billingrepo.ErrVoucherInvalid)
+
+	// Redeeming the same card twice at once only credits it once
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = billingRepo.RedeemVoucher(ctx, clientUser, billingrepo.FormatVoucherPIN(issued[0].PIN), "", limits)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 600.0, client.ClientUser.GetX(ctx, clientUser.ID).Balance)
+	txn := client.ClientTxn.Query().Where(clienttxn.TransactionRefEQ("VCH-" + issued[0].Serial)).OnlyX(ctx)
+	assert.Equal(t, clienttxn.TypeRECHARGE, txn.Type)
+	assert.Equal(t, 600.0, txn.TotalBalance)
+
+	_, err = billingRepo.RedeemVoucher(ctx, clientUser, issued[0].PIN, "", limits)
+	assert.ErrorIs(t, err, billingrepo.ErrVoucherRedeemed)
+
+	// Invalid PINs lock the client out, but not anyone else
+	_, err = billingRepo.RedeemVoucher(ctx, other, issued[0].PIN, "", limits)
+	assert.ErrorIs(t, err, billingrepo.ErrVoucherInvalid)
+	for i := 0; i < 2; i++ {
+		_, err = billingRepo.RedeemVoucher(ctx, clientUser, "0000-0000-0000-0000", "", limits)
+		assert.ErrorIs(t, err, billingrepo.ErrVoucherInvalid)
+	}
+	_, err = billingRepo.RedeemVoucher(ctx, clientUser, "0000-0000-0000-0001", "", limits)
+	assert.ErrorIs(t, err, billingrepo.ErrVoucherLocked)
+	_, err = billingRepo.RedeemVoucher(ctx, clientUser, issued[1].PIN, "", limits)
+	assert.ErrorIs(t, err, billingrepo.ErrVoucherLocked)
+
+	_, err = billingRepo.RedeemVoucher(ctx, other, issued[1].PIN, "", limits)
+	require.NoError(t, err)
+	assert.Equal(t, 500.0, client.ClientUser.GetX(ctx, other.ID).Balance)
+
+	// Disabled cards can no longer be redeemed
+	n, err := billingRepo.DisableVoucherBatch(ctx, batch.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	_, err = billingRepo.RedeemVoucher(ctx, other, issued[2].PIN, "", limits)
+	assert.ErrorIs(t, err, billingrepo.ErrVoucherInvalid)
+}
+
+func TestRedeemVoucherAttemptsRace(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+
+	clientUser := tests.CreateClientUser(ctx, client, "voucher3", 0)
+	billingRepo := billingrepo.NewBillingRepo(client, 30).WithVoucherSecret("voucher-secret")
+	limits := billingrepo.VoucherLimits{MaxAttempts: 3, Window: time.Hour}
+
+	// Guesses sent all at once still only get the allowed number of attempts
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, 10)
+	)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = billingRepo.RedeemVoucher(ctx, clientUser, fmt.Sprintf("0000-0000-0000-%04d", i), "", limits)
+		}()
+	}
+	wg.Wait()
+
+	var invalid int
+	for _, err := range errs {
+		if errors.Is(err, billingrepo.ErrVoucherInvalid) {
+			invalid++
+			continue
+		}
+		assert.ErrorIs(t, err, billingrepo.ErrVoucherLocked)
+	}
+	assert.Equal(t, 2, invalid)
+	assert.Equal(t, 3, client.VoucherAttempt.Query().CountX(ctx))
+
+	left, err := billingRepo.VoucherAttemptsLeft(ctx, clientUser.Username, limits)
+	require.NoError(t, err)
+	assert.Equal(t, 0, left)
+}
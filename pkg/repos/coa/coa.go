@@ -0,0 +1,338 @@
+package coa
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/radacct"
+	"github.com/mikestefanello/pagoda/pkg/repos/radius"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultPort is the port NASes listen for dynamic authorization requests on, RFC 5176 section 3
+const DefaultPort = 3799
+
+const defaultTimeout = 3 * time.Second
+
+var (
+	ErrUnknownNAS = errors.New("no secret configured for nas")
+	ErrTimeout    = errors.New("nas did not answer")
+	ErrBadReply   = errors.New("nas reply did not match the request")
+)
+
+// NAKError is returned when a NAS refuses a request
+type NAKError struct {
+	Code radius.Code
+	// Cause is the Error-Cause the NAS gave, 0 if it gave none
+	Cause int
+}
+
+func (e *NAKError) Error() string {
+	return fmt.Sprintf("nas refused request with code %d, error cause %d", e.Code, e.Cause)
+}
+
+// SessionGone reports whether the NAS no longer has the session, so there was nothing to change
+func (e *NAKError) SessionGone() bool {
+	return e.Cause == radius.ErrorCauseSessionNotFound
+}
+
+// Session is an open session of a client on a NAS
+type Session struct {
+	Username  string
+	SessionID string
+	NAS       netip.Addr
+	FramedIP  netip.Addr
+}
+
+type nas struct {
+	addr   netip.AddrPort
+	secret []byte
+}
+
+// Client sends RFC 5176 Change-of-Authorization and Disconnect requests to the NASes clients are
+// connected through, so plan changes, suspensions and speed boosts apply to open sessions. A client
+// built from a disabled configuration does nothing.
+type Client struct {
+	orm        *ent.Client
+	enabled    bool
+	nas        map[netip.Addr]nas
+	timeout    time.Duration
+	retries    int
+	identifier atomic.Uint32
+}
+
+func NewClient(orm *ent.Client, cfg config.RadiusConfig) (*Client, error) {
+	c := &Client{
+		orm:     orm,
+		enabled: cfg.CoA.Enabled,
+		nas:     make(map[netip.Addr]nas, len(cfg.NAS)),
+		timeout: cfg.CoA.Timeout,
+		retries: cfg.CoA.Retries,
+	}
+	if c.timeout <= 0 {
+		c.timeout = defaultTimeout
+	}
+	if c.retries < 0 {
+		c.retries = 0
+	}
+	for _, n := range cfg.NAS {
+		addr, err := netip.ParseAddr(n.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nas address %q: %w", n.Address, err)
+		}
+		if n.Secret == "" {
+			return nil, fmt.Errorf("nas %s has no secret", addr)
+		}
+		port := n.CoAPort
+		if port == 0 {
+			port = cfg.CoA.Port
+		}
+		if port == 0 {
+			port = DefaultPort
+		}
+		c.nas[addr] = nas{
+			addr:   netip.AddrPortFrom(addr, uint16(port)),
+			secret: []byte(n.Secret),
+		}
+	}
+	var start [1]byte
+	_, _ = rand.Read(start[:])
+	c.identifier.Store(uint32(start[0]))
+	return c, nil
+}
+
+// Enabled reports whether requests are sent at all
+func (c *Client) Enabled() bool {
+	return c != nil && c.enabled
+}
+
+// Disconnect ends a session, so the client has to reconnect
+func (c *Client) Disconnect(ctx context.Context, s Session) error {
+	_, err := c.exchange(ctx, s.NAS, radius.CodeDisconnectRequest, sessionAttributes(s))
+	return err
+}
+
+// Change applies attrs to a session without ending it, e.g. a new Mikrotik-Rate-Limit
+func (c *Client) Change(ctx context.Context, s Session, attrs []radius.Attribute) error {
+	_, err := c.exchange(ctx, s.NAS, radius.CodeCoARequest, append(sessionAttributes(s), attrs...))
+	return err
+}
+
+// Sessions returns the open sessions of a client, by their accounting records
+func (c *Client) Sessions(ctx context.Context, username string) ([]Session, error) {
+	rows, err := c.orm.RadAcct.Query().
+		Where(
+			radacct.UsernameEQ(username),
+			radacct.AcctstoptimeIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]Session, 0, len(rows))
+	for _, row := range rows {
+		addr, err := netip.ParseAddr(row.Nasipaddress)
+		if err != nil {
+			log.Warn().Str("username", username).Str("nas", row.Nasipaddress).
+				Msg("skipping session without a nas address")
+			continue
+		}
+		s := Session{Username: row.Username, SessionID: row.Acctsessionid, NAS: addr}
+		if ip, err := netip.ParseAddr(row.Framedipaddress); err == nil {
+			s.FramedIP = ip
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, nil
+}
+
+// DisconnectUser ends every open session of a client, e.g. when they are suspended
+func (c *Client) DisconnectUser(ctx context.Context, username string) error {
+	if !c.Enabled() {
+		return nil
+	}
+	sessions, err := c.Sessions(ctx, username)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, s := range sessions {
+		if err := ignoreGone(c.Disconnect(ctx, s)); err != nil {
+			errs = append(errs, fmt.Errorf("session %s on %s: %w", s.SessionID, s.NAS, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ApplyProfile gives the open sessions of a client the reply attributes of a RADIUS group, without
+// moving them into it. It is how a plan change or throttling takes effect at once, and how a speed
+// boost is given: the boost lasts until the client reconnects or ApplyProfile is called again with
+// their own group. Sessions the NAS refuses to change, or a group with no attributes that can be
+// sent, are disconnected so the client reconnects into their group.
+func (c *Client) ApplyProfile(ctx context.Context, username, profile string) error {
+	if !c.Enabled() {
+		return nil
+	}
+	sessions, err := c.Sessions(ctx, username)
+	if err != nil || len(sessions) == 0 {
+		return err
+	}
+	replies, err := radiusrepo.NewRadiusRepo(c.orm).GroupReplies(ctx, profile)
+	if err != nil {
+		return err
+	}
+	attrs := make([]radius.Attribute, 0, len(replies))
+	for _, r := range replies {
+		if a, ok := ReplyAttribute(r.Attribute, r.Value); ok {
+			attrs = append(attrs, a)
+		}
+	}
+
+	var errs []error
+	for _, s := range sessions {
+		var err error
+		if len(attrs) > 0 {
+			err = c.Change(ctx, s, attrs)
+		}
+		var nak *NAKError
+		if len(attrs) == 0 || errors.As(err, &nak) && !nak.SessionGone() {
+			// The session cannot be changed in place, so it is ended and the client reconnects
+			err = c.Disconnect(ctx, s)
+		}
+		if err = ignoreGone(err); err != nil {
+			errs = append(errs, fmt.Errorf("session %s on %s: %w", s.SessionID, s.NAS, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ReplyAttribute converts a radgroupreply attribute to one a CoA-Request can carry. Only attributes
+// that change the service of an open session are known.
+func ReplyAttribute(name, value string) (radius.Attribute, bool) {
+	switch name {
+	case "Mikrotik-Rate-Limit":
+		return radius.Vendor(radius.VendorMikrotik, 8, value), true
+	case "Mikrotik-Address-List":
+		return radius.Vendor(radius.VendorMikrotik, 19, value), true
+	case "Filter-Id":
+		return radius.String(radius.TypeFilterID, value), true
+	case "Framed-Pool":
+		return radius.String(radius.TypeFramedPool, value), true
+	case "Session-Timeout":
+		return integerAttribute(radius.TypeSessionTimeout, value)
+	case "Idle-Timeout":
+		return integerAttribute(radius.TypeIdleTimeout, value)
+	case "Acct-Interim-Interval":
+		return integerAttribute(radius.TypeAcctInterimInterval, value)
+	}
+	return radius.Attribute{}, false
+}
+
+func integerAttribute(typ byte, value string) (radius.Attribute, bool) {
+	n, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return radius.Attribute{}, false
+	}
+	return radius.Integer(typ, uint32(n)), true
+}
+
+// sessionAttributes identify a session to the NAS, RFC 5176 section 3
+func sessionAttributes(s Session) []radius.Attribute {
+	attrs := []radius.Attribute{radius.String(radius.TypeUserName, s.Username)}
+	if s.NAS.Is4() {
+		attrs = append(attrs, radius.IPv4(radius.TypeNASIPAddress, s.NAS))
+	}
+	if s.SessionID != "" {
+		attrs = append(attrs, radius.String(radius.TypeAcctSessionID, s.SessionID))
+	}
+	if s.FramedIP.Is4() {
+		attrs = append(attrs, radius.IPv4(radius.TypeFramedIPAddress, s.FramedIP))
+	}
+	return append(attrs, radius.Integer(radius.TypeEventTimestamp, uint32(time.Now().Unix())))
+}
+
+// exchange sends a request to a NAS, resending it on timeout, and returns its ACK. A NAK is
+// returned as a NAKError.
+func (c *Client) exchange(ctx context.Context, addr netip.Addr, code radius.Code, attrs []radius.Attribute) (*radius.Packet, error) {
+	n, ok := c.nas[addr]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownNAS, addr)
+	}
+	request := &radius.Packet{
+		Code:       code,
+		Identifier: byte(c.identifier.Add(1)),
+		Attributes: attrs,
+	}
+	b, err := request.EncodeRequest(n.secret)
+	if err != nil {
+		return nil, err
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", n.addr.String())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	buf := make([]byte, radius.MaxPacketLen)
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		// Resent requests keep their identifier and authenticator, so the NAS can spot duplicates
+		if _, err := conn.Write(b); err != nil {
+			return nil, err
+		}
+		deadline := time.Now().Add(c.timeout)
+		if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		if err := conn.SetReadDeadline(deadline); err != nil {
+			return nil, err
+		}
+		for {
+			size, err := conn.Read(buf)
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			reply, err := radius.Decode(buf[:size])
+			// Stray or forged packets are dropped and the wait goes on, RFC 5176 section 3.5
+			if err != nil || reply.Identifier != request.Identifier ||
+				!radius.VerifyResponse(buf[:size], request, n.secret) {
+				continue
+			}
+			switch reply.Code {
+			case code + 1:
+				return reply, nil
+			case code + 2:
+				return nil, &NAKError{Code: reply.Code, Cause: reply.ErrorCause()}
+			default:
+				return nil, ErrBadReply
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return nil, ErrTimeout
+}
+
+// ignoreGone treats a NAK for a session the NAS no longer has as done
+func ignoreGone(err error) error {
+	var nak *NAKError
+	if errors.As(err, &nak) && nak.SessionGone() {
+		return nil
+	}
+	return err
+}
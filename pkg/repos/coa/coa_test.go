@@ -0,0 +1,131 @@
+package coa_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/repos/coa"
+	"github.com/mikestefanello/pagoda/pkg/repos/radius"
+)
+
+const secret = "testing123"
+
+func newClient(t *testing.T, nas *coa.FakeNAS, secret string, retries int) *coa.Client {
+	client, err := coa.NewClient(nil, config.RadiusConfig{
+		NAS: []config.NASConfig{
+			{Address: nas.Addr().Addr().String(), Secret: secret, CoAPort: int(nas.Addr().Port())},
+		},
+		CoA: config.CoAConfig{
+			Enabled: true,
+			Timeout: 50 * time.Millisecond,
+			Retries: retries,
+		},
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func newNAS(t *testing.T) *coa.FakeNAS {
+	nas, err := coa.NewFakeNAS(secret)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = nas.Close() })
+	return nas
+}
+
+func session(nas *coa.FakeNAS) coa.Session {
+	return coa.Session{
+		Username:  "alice",
+		SessionID: "81a00002",
+		NAS:       nas.Addr().Addr(),
+		FramedIP:  netip.MustParseAddr("10.10.0.7"),
+	}
+}
+
+func TestDisconnect(t *testing.T) {
+	nas := newNAS(t)
+	client := newClient(t, nas, secret, 0)
+
+	require.NoError(t, client.Disconnect(context.Background(), session(nas)))
+	requests := nas.Requests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, radius.CodeDisconnectRequest, requests[0].Code)
+	assert.Equal(t, "alice", string(requests[0].Attr(radius.TypeUserName).Value))
+	assert.Equal(t, "81a00002", string(requests[0].Attr(radius.TypeAcctSessionID).Value))
+	assert.Equal(t, []byte{10, 10, 0, 7}, requests[0].Attr(radius.TypeFramedIPAddress).Value)
+}
+
+func TestChange(t *testing.T) {
+	nas := newNAS(t)
+	client := newClient(t, nas, secret, 0)
+	rate, ok := coa.ReplyAttribute("Mikrotik-Rate-Limit", "20M/20M")
+	require.True(t, ok)
+
+	require.NoError(t, client.Change(context.Background(), session(nas), []radius.Attribute{rate}))
+	requests := nas.Requests()
+	require.Len(t, requests, 1)
+	assert.Equal(t, radius.CodeCoARequest, requests[0].Code)
+	last := requests[0].Attributes[len(requests[0].Attributes)-1]
+	assert.Equal(t, radius.VendorMikrotik, last.Vendor)
+	assert.Equal(t, "20M/20M", string(last.Value))
+
+	// A refusal carries the reason the NAS gave
+	nas.NAK(radius.ErrorCauseSessionNotFound)
+	err := client.Change(context.Background(), session(nas), []radius.Attribute{rate})
+	var nak *coa.NAKError
+	require.ErrorAs(t, err, &nak)
+	assert.Equal(t, radius.CodeCoANAK, nak.Code)
+	assert.True(t, nak.SessionGone())
+}
+
+func TestRetries(t *testing.T) {
+	nas := newNAS(t)
+
+	// A lost request is sent again as is
+	nas.Drop(1)
+	require.NoError(t, newClient(t, nas, secret, 1).Disconnect(context.Background(), session(nas)))
+	requests := nas.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, requests[0].Identifier, requests[1].Identifier)
+	assert.Equal(t, requests[0].Authenticator, requests[1].Authenticator)
+
+	// Until the retries run out
+	nas.Drop(2)
+	err := newClient(t, nas, secret, 1).Disconnect(context.Background(), session(nas))
+	assert.ErrorIs(t, err, coa.ErrTimeout)
+}
+
+func TestWrongSecret(t *testing.T) {
+	nas := newNAS(t)
+
+	// The NAS drops requests it cannot verify
+	err := newClient(t, nas, "wrong", 0).Disconnect(context.Background(), session(nas))
+	assert.ErrorIs(t, err, coa.ErrTimeout)
+	assert.Empty(t, nas.Requests())
+}
+
+func TestUnknownNAS(t *testing.T) {
+	nas := newNAS(t)
+	s := session(nas)
+	s.NAS = netip.MustParseAddr("192.0.2.1")
+
+	err := newClient(t, nas, secret, 0).Disconnect(context.Background(), s)
+	assert.ErrorIs(t, err, coa.ErrUnknownNAS)
+}
+
+func TestReplyAttribute(t *testing.T) {
+	a, ok := coa.ReplyAttribute("Session-Timeout", "3600")
+	require.True(t, ok)
+	assert.Equal(t, radius.TypeSessionTimeout, a.Type)
+	assert.Equal(t, []byte{0, 0, 0x0e, 0x10}, a.Value)
+
+	_, ok = coa.ReplyAttribute("Session-Timeout", "soon")
+	assert.False(t, ok)
+	_, ok = coa.ReplyAttribute("Cleartext-Password", "secret")
+	assert.False(t, ok)
+}
@@ -0,0 +1,100 @@
+package coa
+
+import (
+	"net"
+	"net/netip"
+	"sync"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/radius"
+)
+
+// FakeNAS is a local stand-in for a NAS that answers dynamic authorization requests on a UDP port,
+// so CoA and Disconnect requests can be exercised offline. It acknowledges every correctly signed
+// request unless told otherwise with NAK, and keeps what it received.
+type FakeNAS struct {
+	conn   net.PacketConn
+	secret []byte
+
+	mu       sync.Mutex
+	requests []*radius.Packet
+	nakCause int
+	silent   int
+}
+
+// NewFakeNAS listens on a random port of the loopback address
+func NewFakeNAS(secret string) (*FakeNAS, error) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	f := &FakeNAS{conn: conn, secret: []byte(secret)}
+	go f.serve()
+	return f, nil
+}
+
+// Addr is the address the NAS listens on
+func (f *FakeNAS) Addr() netip.AddrPort {
+	return f.conn.LocalAddr().(*net.UDPAddr).AddrPort()
+}
+
+// NAK makes the NAS refuse the following requests with the given Error-Cause. 0 goes back to
+// acknowledging them.
+func (f *FakeNAS) NAK(cause int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nakCause = cause
+}
+
+// Drop makes the NAS ignore the next n requests, as if they were lost
+func (f *FakeNAS) Drop(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.silent = n
+}
+
+// Requests returns the correctly signed requests the NAS received, dropped ones included
+func (f *FakeNAS) Requests() []*radius.Packet {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*radius.Packet(nil), f.requests...)
+}
+
+func (f *FakeNAS) Close() error {
+	return f.conn.Close()
+}
+
+func (f *FakeNAS) serve() {
+	buf := make([]byte, radius.MaxPacketLen)
+	for {
+		n, from, err := f.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		request, err := radius.Decode(buf[:n])
+		if err != nil || !radius.VerifyRequest(buf[:n], f.secret) {
+			continue
+		}
+
+		f.mu.Lock()
+		f.requests = append(f.requests, request)
+		cause, drop := f.nakCause, f.silent > 0
+		if drop {
+			f.silent--
+		}
+		f.mu.Unlock()
+		if drop {
+			continue
+		}
+
+		reply := &radius.Packet{Code: request.Code + 1}
+		if cause != 0 {
+			reply.Code = request.Code + 2
+			reply.Attributes = []radius.Attribute{radius.Integer(radius.TypeErrorCause, uint32(cause))}
+		}
+		b, err := reply.EncodeResponse(request, f.secret)
+		if err != nil {
+			continue
+		}
+		_, _ = f.conn.WriteTo(b, from)
+	}
+}
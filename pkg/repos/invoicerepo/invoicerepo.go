@@ -0,0 +1,207 @@
+package invoicerepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/packageplan"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+)
+
+var (
+	ErrReceiptNotFound = errors.New("no receipt for this transaction")
+	ErrClientNotFound  = errors.New("client not found")
+)
+
+// InvoiceRepo builds the receipts and monthly invoices clients download as PDFs.
+// Everything is rendered locally, so no outside service is involved.
+type InvoiceRepo struct {
+	orm    *ent.Client
+	config *config.Config
+}
+
+// Receipt confirms a single completed transaction
+type Receipt struct {
+	Number  string
+	Client  *ent.ClientUser
+	Package *ent.PackagePlan
+	Txn     *ent.ClientTxn
+}
+
+// Invoice is a client's statement of all completed transactions in a calendar month
+type Invoice struct {
+	Number  string
+	Client  *ent.ClientUser
+	Package *ent.PackagePlan
+	// Month is the first moment of the invoiced month
+	Month          time.Time
+	Txns           []*ent.ClientTxn
+	OpeningBalance float64
+	ClosingBalance float64
+	// Credits is what was added to the balance during the month, Charges what was taken from it
+	Credits float64
+	Charges float64
+	// Tax is the tax included in the month's charges, less any on credits for downgrades
+	Tax float64
+}
+
+func NewInvoiceRepo(orm *ent.Client, cfg *config.Config) *InvoiceRepo {
+	return &InvoiceRepo{
+		orm:    orm,
+		config: cfg,
+	}
+}
+
+// GetReceipt returns the receipt of a client's completed transaction
+func (r *InvoiceRepo) GetReceipt(ctx context.Context, username, ref string) (*Receipt, error) {
+	client, err := r.client(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	txn, err := r.orm.ClientTxn.Query().
+		Where(
+			clienttxn.TransactionRefEQ(ref),
+			clienttxn.ClientUsernameEQ(username),
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrReceiptNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &Receipt{
+		Number:  ReceiptNumber(txn),
+		Client:  client,
+		Package: r.currentPackage(ctx, client),
+		Txn:     txn,
+	}, nil
+}
+
+// GetMonthlyInvoice returns a client's invoice for the month containing the given time
+func (r *InvoiceRepo) GetMonthlyInvoice(ctx context.Context, username string, month time.Time) (*Invoice, error) {
+	client, err := r.client(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	start := MonthStart(month)
+	end := start.AddDate(0, 1, 0)
+
+	txns, err := r.orm.ClientTxn.Query().
+		Where(
+			clienttxn.ClientUsernameEQ(username),
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+			clienttxn.TransactionDateGTE(start),
+			clienttxn.TransactionDateLT(end),
+		).
+		Order(ent.Asc(clienttxn.FieldTransactionDate), ent.Asc(clienttxn.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := &Invoice{
+		Number:  InvoiceNumber(client.ID, start),
+		Client:  client,
+		Package: r.currentPackage(ctx, client),
+		Month:   start,
+		Txns:    txns,
+	}
+
+	// The balance carried into the month is the one left by the last transaction before it
+	previous, err := r.orm.ClientTxn.Query().
+		Where(
+			clienttxn.ClientUsernameEQ(username),
+			clienttxn.StatusEQ(clienttxn.StatusCompleted),
+			clienttxn.TransactionDateLT(start),
+		).
+		Order(ent.Desc(clienttxn.FieldTransactionDate), ent.Desc(clienttxn.FieldID)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if previous != nil {
+		invoice.OpeningBalance = previous.TotalBalance
+	}
+
+	invoice.ClosingBalance = invoice.OpeningBalance
+	for _, txn := range txns {
+		if IsCredit(txn) {
+			invoice.Credits += math.Abs(txn.Amount)
+		} else {
+			invoice.Charges += math.Abs(txn.Amount)
+		}
+		invoice.Tax += txn.TaxAmount
+		invoice.ClosingBalance = txn.TotalBalance
+	}
+	invoice.Credits = billingrepo.RoundAmount(invoice.Credits)
+	invoice.Charges = billingrepo.RoundAmount(invoice.Charges)
+	invoice.Tax = billingrepo.RoundAmount(invoice.Tax)
+	return invoice, nil
+}
+
+// ReceiptNumber is the printed number of a transaction's receipt. It follows the transaction ID,
+// so receipts are numbered in the order payments were recorded and never change.
+func ReceiptNumber(txn *ent.ClientTxn) string {
+	return fmt.Sprintf("RCT-%08d", txn.ID)
+}
+
+// InvoiceNumber is the printed number of a client's invoice for a month
+func InvoiceNumber(clientID int, month time.Time) string {
+	return fmt.Sprintf("INV-%s-%06d", month.Format("200601"), clientID)
+}
+
+// MonthStart returns the first moment of the month containing t, in local time
+func MonthStart(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.Local)
+}
+
+// IsCredit reports whether a transaction added to the client's balance rather than taking from it
+func IsCredit(txn *ent.ClientTxn) bool {
+	switch txn.Type {
+	case clienttxn.TypeRECHARGE, clienttxn.TypeTRANSFER_RECEIVED:
+		return true
+	case clienttxn.TypeADJUSTMENT:
+		return txn.Amount > 0
+	case clienttxn.TypePACKAGE_MIGRATION:
+		// A downgrade credits the unused part of the old package
+		return txn.Amount < 0
+	default:
+		return false
+	}
+}
+
+func (r *InvoiceRepo) client(ctx context.Context, username string) (*ent.ClientUser, error) {
+	client, err := r.orm.ClientUser.Query().
+		Where(clientuser.UsernameEQ(username)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrClientNotFound
+	}
+	return client, err
+}
+
+// currentPackage returns the client's package, nil if it is no longer on offer
+func (r *InvoiceRepo) currentPackage(ctx context.Context, client *ent.ClientUser) *ent.PackagePlan {
+	if client.UserProfile == "" {
+		return nil
+	}
+	plan, err := r.orm.PackagePlan.Query().
+		Where(packageplan.ProfileNameEQ(client.UserProfile)).
+		First(ctx)
+	if err != nil {
+		return nil
+	}
+	return plan
+}
@@ -0,0 +1,119 @@
+package invoicerepo_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/repos/invoicerepo"
+)
+
+func TestNumbers(t *testing.T) {
+	assert.Equal(t, "RCT-00000042", invoicerepo.ReceiptNumber(&ent.ClientTxn{ID: 42}))
+
+	month := time.Date(2025, 11, 1, 0, 0, 0, 0, time.Local)
+	assert.Equal(t, "INV-202511-000007", invoicerepo.InvoiceNumber(7, month))
+	assert.Equal(t, month, invoicerepo.MonthStart(time.Date(2025, 11, 30, 23, 59, 0, 0, time.Local)))
+}
+
+func TestIsCredit(t *testing.T) {
+	assert.True(t, invoicerepo.IsCredit(&ent.ClientTxn{Type: clienttxn.TypeRECHARGE, Amount: 500}))
+	assert.True(t, invoicerepo.IsCredit(&ent.ClientTxn{Type: clienttxn.TypePACKAGE_MIGRATION, Amount: -120}))
+	assert.False(t, invoicerepo.IsCredit(&ent.ClientTxn{Type: clienttxn.TypePACKAGE_MIGRATION, Amount: 120}))
+	assert.False(t, invoicerepo.IsCredit(&ent.ClientTxn{Type: clienttxn.TypeAUTO_RENEWAL, Amount: 500}))
+	assert.False(t, invoicerepo.IsCredit(&ent.ClientTxn{Type: clienttxn.TypeADJUSTMENT, Amount: -20}))
+}
+
+func TestTaxLabel(t *testing.T) {
+	assert.Equal(t, "VAT 5%", invoicerepo.TaxLabel("VAT", 5))
+	assert.Equal(t, "VAT 7.5%", invoicerepo.TaxLabel("VAT", 7.5))
+	assert.Equal(t, "VAT", invoicerepo.TaxLabel("VAT", 0))
+	assert.Equal(t, "Tax 5%", invoicerepo.TaxLabel("", 5))
+}
+
+func TestWritePDFs(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Billing.Currency = "BDT"
+	cfg.Billing.Branding.Name = "Test ISP"
+	cfg.Billing.Branding.Address = "House 1, Road 2, Dhaka"
+	cfg.Billing.Branding.Footer = "Thank you"
+	cfg.Billing.Tax.Name = "VAT"
+	cfg.Billing.Tax.RegistrationNumber = "000123456-0101"
+	repo := invoicerepo.NewInvoiceRepo(nil, cfg)
+
+	method := clienttxn.PaymentMethodGatewayBkash
+	client := &ent.ClientUser{ID: 7, Name: "Rahim Uddin", Username: "rahim", District: "Dhaka"}
+	plan := &ent.PackagePlan{Name: "Home 20 Mbps", Price: 1000}
+	txn := &ent.ClientTxn{
+		ID:              42,
+		TransactionRef:  "TOP-20251114-3F9A1C2B",
+		Amount:          1500,
+		Type:            clienttxn.TypeRECHARGE,
+		Status:          clienttxn.StatusCompleted,
+		TotalBalance:    1750,
+		PaymentMethod:   &method,
+		GatewayRef:      "BK123",
+		ClientUsername:  "rahim",
+		Description:     "Balance recharge via gateway_bkash",
+		TransactionDate: time.Date(2025, 11, 14, 13, 0, 0, 0, time.Local),
+	}
+
+	buf := &bytes.Buffer{}
+	err := repo.WriteReceiptPDF(buf, &invoicerepo.Receipt{
+		Number:  invoicerepo.ReceiptNumber(txn),
+		Client:  client,
+		Package: plan,
+		Txn:     txn,
+	})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+
+	// A charge for a service shows its tax breakdown
+	internet := clienttxn.ServiceTypeInternet
+	balance := clienttxn.PaymentMethodClientBalance
+	renewal := &ent.ClientTxn{
+		ID:              43,
+		TransactionRef:  "RNW-7-20251114130000",
+		Amount:          1050,
+		NetAmount:       1000,
+		TaxAmount:       50,
+		TaxRate:         5,
+		ServiceType:     &internet,
+		Type:            clienttxn.TypeRENEWAL,
+		Status:          clienttxn.StatusCompleted,
+		TotalBalance:    700,
+		PaymentMethod:   &balance,
+		ClientUsername:  "rahim",
+		TransactionDate: time.Date(2025, 11, 14, 13, 5, 0, 0, time.Local),
+	}
+	buf.Reset()
+	err = repo.WriteReceiptPDF(buf, &invoicerepo.Receipt{
+		Number:  invoicerepo.ReceiptNumber(renewal),
+		Client:  client,
+		Package: plan,
+		Txn:     renewal,
+	})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+
+	buf.Reset()
+	err = repo.WriteInvoicePDF(buf, &invoicerepo.Invoice{
+		Number:         invoicerepo.InvoiceNumber(client.ID, invoicerepo.MonthStart(txn.TransactionDate)),
+		Client:         client,
+		Package:        plan,
+		Month:          invoicerepo.MonthStart(txn.TransactionDate),
+		Txns:           []*ent.ClientTxn{txn},
+		OpeningBalance: 250,
+		ClosingBalance: 1750,
+		Credits:        1500,
+		Tax:            50,
+	})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")))
+}
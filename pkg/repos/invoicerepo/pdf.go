@@ -0,0 +1,357 @@
+package invoicerepo
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+)
+
+const (
+	pageMargin = 15.0
+	lineHeight = 6.0
+)
+
+// document wraps an A4 page with the helpers shared by receipts and invoices
+type document struct {
+	pdf *fpdf.Fpdf
+	// tr converts UTF-8 text to the encoding of the built-in fonts, which keeps rendering offline
+	tr       func(string) string
+	currency string
+}
+
+// WriteReceiptPDF renders a receipt as a PDF
+func (r *InvoiceRepo) WriteReceiptPDF(w io.Writer, receipt *Receipt) error {
+	d := r.newDocument(fmt.Sprintf("Receipt %s", receipt.Number))
+	txn := receipt.Txn
+
+	d.header(r, "PAYMENT RECEIPT", receipt.Number, txn.TransactionDate)
+	d.billTo(receipt.Client)
+
+	d.sectionTitle("Transaction")
+	d.row("Reference", txn.TransactionRef)
+	if txn.GatewayRef != "" {
+		d.row("Gateway reference", txn.GatewayRef)
+	}
+	d.row("Date", txn.TransactionDate.In(time.Local).Format("02 Jan 2006 03:04 PM"))
+	d.row("Type", TypeLabel(txn.Type))
+	d.row("Payment method", MethodLabel(txn.PaymentMethod))
+	if receipt.Package != nil {
+		d.row("Package", receipt.Package.Name)
+	}
+	if txn.Description != "" {
+		d.row("Description", txn.Description)
+	}
+	if txn.Discount > 0 {
+		d.row(fmt.Sprintf("Coupon %s", txn.CouponCode), "-"+d.money(txn.Discount))
+	}
+	d.row("Status", strings.ToUpper(string(txn.Status)))
+	if txn.ServiceType != nil || txn.TaxAmount != 0 {
+		d.pdf.Ln(4)
+		d.row("Net amount", d.money(txn.NetAmount))
+		d.row(TaxLabel(r.config.Billing.Tax.Name, txn.TaxRate), d.money(txn.TaxAmount))
+	}
+
+	d.pdf.Ln(4)
+	d.total("Amount", d.money(txn.Amount))
+	d.row("Balance after this transaction", d.money(txn.TotalBalance))
+
+	d.footer(r)
+	return d.output(w)
+}
+
+// WriteInvoicePDF renders a monthly invoice as a PDF
+func (r *InvoiceRepo) WriteInvoicePDF(w io.Writer, invoice *Invoice) error {
+	d := r.newDocument(fmt.Sprintf("Invoice %s", invoice.Number))
+
+	d.header(r, "MONTHLY INVOICE", invoice.Number, invoice.Month)
+	d.billTo(invoice.Client)
+
+	d.sectionTitle(fmt.Sprintf("Summary for %s", invoice.Month.Format("January 2006")))
+	if invoice.Package != nil {
+		price := billingrepo.NewTax(r.config.Billing.Tax).Gross(billingrepo.ServiceInternet, invoice.Package.Price)
+		d.row("Package", fmt.Sprintf("%s (%s / month)", invoice.Package.Name, d.money(price)))
+	}
+	d.row("Opening balance", d.money(invoice.OpeningBalance))
+	d.row("Payments and credits", d.money(invoice.Credits))
+	d.row("Charges", d.money(invoice.Charges))
+	if invoice.Tax != 0 {
+		d.row(fmt.Sprintf("%s included in charges", TaxLabel(r.config.Billing.Tax.Name, 0)), d.money(invoice.Tax))
+	}
+	d.total("Closing balance", d.money(invoice.ClosingBalance))
+
+	d.pdf.Ln(4)
+	d.sectionTitle("Transactions")
+	widths := []float64{22, 42, 48, 28, 20, 20}
+	d.tableRow(widths, true, "Date", "Reference", "Description", "Method", "Amount", "Balance")
+	for _, txn := range invoice.Txns {
+		amount := "-" + d.amount(math.Abs(txn.Amount))
+		if IsCredit(txn) {
+			amount = "+" + d.amount(math.Abs(txn.Amount))
+		}
+		d.tableRow(widths, false,
+			txn.TransactionDate.In(time.Local).Format("02 Jan 2006"),
+			txn.TransactionRef,
+			txnDescription(txn),
+			MethodLabel(txn.PaymentMethod),
+			amount,
+			d.amount(txn.TotalBalance),
+		)
+	}
+	if len(invoice.Txns) == 0 {
+		d.pdf.SetFont("Helvetica", "I", 9)
+		d.pdf.CellFormat(0, lineHeight*1.5, "No transactions this month", "", 1, "C", false, 0, "")
+	}
+
+	d.footer(r)
+	return d.output(w)
+}
+
+func (r *InvoiceRepo) newDocument(title string) *document {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin+10)
+	pdf.SetTitle(title, true)
+	pdf.SetAuthor(r.config.Billing.Branding.Name, true)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pageMargin - 5)
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.SetTextColor(150, 150, 150)
+		pdf.CellFormat(0, 5, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	return &document{
+		pdf:      pdf,
+		tr:       pdf.UnicodeTranslatorFromDescriptor(""),
+		currency: r.config.Billing.Currency,
+	}
+}
+
+// header prints the ISP branding on the left and the document title and number on the right
+func (d *document) header(r *InvoiceRepo, title, number string, date time.Time) {
+	brand := r.config.Billing.Branding
+	pdf := d.pdf
+	top := pdf.GetY()
+	left := pageMargin
+
+	if brand.LogoPath != "" {
+		if _, err := os.Stat(brand.LogoPath); err == nil {
+			pdf.ImageOptions(brand.LogoPath, left, top, 0, 16, false, fpdf.ImageOptions{ReadDpi: true}, 0, "")
+			left += 20
+		}
+	}
+
+	pdf.SetXY(left, top)
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.SetTextColor(30, 41, 59)
+	pdf.CellFormat(90, 8, d.tr(brand.Name), "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.SetTextColor(100, 116, 139)
+	var registration string
+	if tax := r.config.Billing.Tax; tax.RegistrationNumber != "" {
+		registration = fmt.Sprintf("%s Reg. No. %s", TaxLabel(tax.Name, 0), tax.RegistrationNumber)
+	}
+	for _, line := range []string{brand.Address, brand.Phone, brand.Email, brand.Website, registration} {
+		if line != "" {
+			pdf.CellFormat(90, 4.5, d.tr(line), "", 2, "L", false, 0, "")
+		}
+	}
+	bottom := pdf.GetY()
+
+	pdf.SetXY(120, top)
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.SetTextColor(37, 99, 235)
+	pdf.CellFormat(75, 8, title, "", 2, "R", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.SetTextColor(30, 41, 59)
+	pdf.CellFormat(75, 5, fmt.Sprintf("No. %s", number), "", 2, "R", false, 0, "")
+	pdf.CellFormat(75, 5, date.In(time.Local).Format("02 Jan 2006"), "", 2, "R", false, 0, "")
+
+	pdf.SetY(max(bottom, pdf.GetY()) + 4)
+	pdf.SetDrawColor(226, 232, 240)
+	pdf.Line(pageMargin, pdf.GetY(), 210-pageMargin, pdf.GetY())
+	pdf.Ln(6)
+}
+
+func (d *document) billTo(client *ent.ClientUser) {
+	d.sectionTitle("Billed to")
+	d.row("Name", client.Name)
+	d.row("Username", client.Username)
+	if client.MobileNumber != "" {
+		d.row("Mobile", client.MobileNumber)
+	}
+	if client.Email != "" {
+		d.row("Email", client.Email)
+	}
+	if address := clientAddress(client); address != "" {
+		d.row("Address", address)
+	}
+	d.pdf.Ln(4)
+}
+
+func (d *document) sectionTitle(title string) {
+	d.pdf.SetFont("Helvetica", "B", 10)
+	d.pdf.SetTextColor(100, 116, 139)
+	d.pdf.CellFormat(0, lineHeight+1, strings.ToUpper(d.tr(title)), "", 1, "L", false, 0, "")
+}
+
+func (d *document) row(label, value string) {
+	pdf := d.pdf
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.SetTextColor(100, 116, 139)
+	pdf.CellFormat(55, lineHeight, d.tr(label), "", 0, "L", false, 0, "")
+	pdf.SetTextColor(30, 41, 59)
+	pdf.MultiCell(0, lineHeight, d.tr(value), "", "L", false)
+}
+
+func (d *document) total(label, value string) {
+	pdf := d.pdf
+	pdf.SetFillColor(241, 245, 249)
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.SetTextColor(30, 41, 59)
+	pdf.CellFormat(55, lineHeight+4, d.tr(label), "", 0, "L", true, 0, "")
+	pdf.CellFormat(0, lineHeight+4, d.tr(value), "", 1, "L", true, 0, "")
+	pdf.Ln(1)
+}
+
+func (d *document) tableRow(widths []float64, head bool, cells ...string) {
+	pdf := d.pdf
+	if head {
+		pdf.SetFont("Helvetica", "B", 8)
+		pdf.SetFillColor(241, 245, 249)
+		pdf.SetTextColor(71, 85, 105)
+	} else {
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(30, 41, 59)
+	}
+	for i, cell := range cells {
+		align := "L"
+		if i >= len(cells)-2 {
+			align = "R"
+		}
+		pdf.CellFormat(widths[i], lineHeight, d.fit(d.tr(cell), widths[i]-2), "B", 0, align, head, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+// fit shortens text to the given width so table rows stay on one line
+func (d *document) fit(text string, width float64) string {
+	if d.pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 && d.pdf.GetStringWidth(string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+func (d *document) footer(r *InvoiceRepo) {
+	pdf := d.pdf
+	pdf.Ln(10)
+	if footer := r.config.Billing.Branding.Footer; footer != "" {
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.SetTextColor(71, 85, 105)
+		pdf.MultiCell(0, 5, d.tr(footer), "", "C", false)
+	}
+	pdf.SetFont("Helvetica", "I", 8)
+	pdf.SetTextColor(150, 150, 150)
+	pdf.CellFormat(0, 5, fmt.Sprintf("Generated on %s. This document is computer generated and needs no signature.",
+		time.Now().Format("02 Jan 2006 03:04 PM")), "", 1, "C", false, 0, "")
+}
+
+func (d *document) output(w io.Writer) error {
+	if err := d.pdf.Error(); err != nil {
+		return err
+	}
+	return d.pdf.Output(w)
+}
+
+func (d *document) money(amount float64) string {
+	return fmt.Sprintf("%s %s", d.currency, d.amount(amount))
+}
+
+func (d *document) amount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
+
+// TaxLabel names the tax charged at a rate, e.g. "VAT 5%", leaving the rate out when it is zero
+func TaxLabel(name string, rate float64) string {
+	if name == "" {
+		name = "Tax"
+	}
+	if rate == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s %g%%", name, rate)
+}
+
+// TypeLabel turns a transaction type such as AUTO_RENEWAL into "Auto renewal"
+func TypeLabel(t clienttxn.Type) string {
+	if t == clienttxn.TypeTRANSFER_REFUND {
+		// Recorded on the sender's side of a balance transfer
+		return "Transfer sent"
+	}
+	label := strings.ReplaceAll(strings.ToLower(string(t)), "_", " ")
+	if label == "" {
+		return ""
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// MethodLabel is the printable name of a payment method
+func MethodLabel(method *clienttxn.PaymentMethod) string {
+	if method == nil {
+		return "-"
+	}
+	switch *method {
+	case clienttxn.PaymentMethodClientBalance:
+		return "Account balance"
+	case clienttxn.PaymentMethodVendorBalance:
+		return "Reseller"
+	case clienttxn.PaymentMethodBankTransfer:
+		return "Bank transfer"
+	case clienttxn.PaymentMethodMobileBanking:
+		return "Mobile banking"
+	case clienttxn.PaymentMethodGatewayBkash:
+		return "bKash"
+	case clienttxn.PaymentMethodGatewayNagad:
+		return "Nagad"
+	case clienttxn.PaymentMethodGatewaySslcommerz:
+		return "SSLCommerz"
+	case clienttxn.PaymentMethodGatewayStripe:
+		return "Stripe"
+	case clienttxn.PaymentMethodGatewayPaypal:
+		return "PayPal"
+	default:
+		return TypeLabel(clienttxn.Type(*method))
+	}
+}
+
+func txnDescription(txn *ent.ClientTxn) string {
+	if txn.Description != "" {
+		return txn.Description
+	}
+	return TypeLabel(txn.Type)
+}
+
+func clientAddress(client *ent.ClientUser) string {
+	parts := make([]string, 0, 6)
+	for _, part := range []string{
+		client.AddressLine1, client.AddressLine2, client.UnionName, client.Upazila, client.District, client.Zip,
+	} {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
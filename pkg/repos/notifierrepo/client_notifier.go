@@ -0,0 +1,124 @@
+package notifierrepo
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/profile"
+	"github.com/mikestefanello/pagoda/ent/user"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/mailer"
+	"github.com/rs/zerolog/log"
+)
+
+// Channels a client can be reached on
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+	ChannelPush  = "push"
+)
+
+// ClientNotifier reaches ISP clients by email and SMS, and with push notifications on the app
+// profile their portal login created once WithPush is set. Either sender may be nil to leave that
+// channel out.
+type ClientNotifier struct {
+	mail *mailer.MailClient
+	sms  *SMSSender
+	orm  *ent.Client
+	push *NotifierRepo
+}
+
+func NewClientNotifier(mail *mailer.MailClient, sms *SMSSender) *ClientNotifier {
+	return &ClientNotifier{
+		mail: mail,
+		sms:  sms,
+	}
+}
+
+// WithPush returns a notifier that also sends push notifications through notifier, to clients
+// who logged in to the portal at least once. It is a no-op when notifier is nil.
+func (n *ClientNotifier) WithPush(orm *ent.Client, notifier *NotifierRepo) *ClientNotifier {
+	if notifier == nil {
+		return n
+	}
+	withPush := *n
+	withPush.orm = orm
+	withPush.push = notifier
+	return &withPush
+}
+
+// NotifyClient sends a message on every channel the client can be reached on. It only fails
+// if the client could not be reached at all.
+func (n *ClientNotifier) NotifyClient(ctx context.Context, client *ent.ClientUser, subject, message string) error {
+	_, err := n.Notify(ctx, client, subject, message)
+	return err
+}
+
+// Notify is NotifyClient, returning the channels the client was reached on
+func (n *ClientNotifier) Notify(ctx context.Context, client *ent.ClientUser, subject, message string) ([]string, error) {
+	var errs []error
+	var sent []string
+
+	if n.mail != nil && client.Email != "" {
+		err := n.mail.
+			Compose().
+			To(client.Email).
+			Subject(subject).
+			Body(message).
+			Send(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			sent = append(sent, ChannelEmail)
+		}
+	}
+
+	if n.sms != nil && client.MobileNumber != "" {
+		if _, err := n.sms.SendSms(ctx, client.MobileNumber, message); err != nil {
+			errs = append(errs, err)
+		} else {
+			sent = append(sent, ChannelSMS)
+		}
+	}
+
+	if n.push != nil && client.Email != "" {
+		if ok, err := n.pushClient(ctx, client, subject, message); err != nil {
+			errs = append(errs, err)
+		} else if ok {
+			sent = append(sent, ChannelPush)
+		}
+	}
+
+	if len(sent) > 0 {
+		if len(errs) > 0 {
+			log.Warn().Err(errors.Join(errs...)).Str("username", client.Username).Msg("client notified on some channels only")
+		}
+		return sent, nil
+	}
+	if len(errs) == 0 {
+		return nil, errors.New("client has no email or mobile number to notify")
+	}
+	return nil, errors.Join(errs...)
+}
+
+// pushClient notifies the profile of the user account created for the client at login, the same
+// way the login links them: by email. It reports false for clients who never logged in.
+func (n *ClientNotifier) pushClient(ctx context.Context, client *ent.ClientUser, subject, message string) (bool, error) {
+	profileID, err := n.orm.Profile.Query().
+		Where(profile.HasUserWith(user.EmailEQ(strings.ToLower(client.Email)))).
+		FirstID(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	err = n.push.PublishNotification(ctx, domain.Notification{
+		Type:      domain.NotificationTypeBillingReminder,
+		ProfileID: profileID,
+		Title:     subject,
+		Text:      message,
+	}, true, true)
+	return err == nil, err
+}
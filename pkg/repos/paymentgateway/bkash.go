@@ -0,0 +1,214 @@
+package paymentgateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+)
+
+const bkashSuccessCode = "0000"
+
+// BkashGateway implements the bKash tokenized checkout API
+type BkashGateway struct {
+	baseURL    string
+	appKey     string
+	appSecret  string
+	username   string
+	password   string
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	idToken     string
+	tokenExpiry time.Time
+}
+
+type bkashResponse struct {
+	StatusCode            string `json:"statusCode"`
+	StatusMessage         string `json:"statusMessage"`
+	IDToken               string `json:"id_token"`
+	ExpiresIn             int    `json:"expires_in"`
+	PaymentID             string `json:"paymentID"`
+	BkashURL              string `json:"bkashURL"`
+	TrxID                 string `json:"trxID"`
+	TransactionStatus     string `json:"transactionStatus"`
+	Amount                string `json:"amount"`
+	MerchantInvoiceNumber string `json:"merchantInvoiceNumber"`
+}
+
+func NewBkashGateway(baseURL, appKey, appSecret, username, password string, httpClient *http.Client) *BkashGateway {
+	return &BkashGateway{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		appKey:     appKey,
+		appSecret:  appSecret,
+		username:   username,
+		password:   password,
+		httpClient: httpClient,
+	}
+}
+
+func (b *BkashGateway) Name() string {
+	return NameBkash
+}
+
+func (b *BkashGateway) Label() string {
+	return "bKash"
+}
+
+func (b *BkashGateway) Method() clienttxn.PaymentMethod {
+	return clienttxn.PaymentMethodGatewayBkash
+}
+
+func (b *BkashGateway) StartCheckout(ctx context.Context, req CheckoutRequest) (*Checkout, error) {
+	resp, err := b.call(ctx, "/tokenized/checkout/create", map[string]string{
+		"mode":                  "0011",
+		"payerReference":        req.Customer.Username,
+		"callbackURL":           req.CallbackURL,
+		"amount":                formatAmount(req.Amount),
+		"currency":              req.Currency,
+		"intent":                "sale",
+		"merchantInvoiceNumber": req.Ref,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != bkashSuccessCode || resp.BkashURL == "" {
+		return nil, fmt.Errorf("bkash create payment failed: %s %s", resp.StatusCode, resp.StatusMessage)
+	}
+	return &Checkout{RedirectURL: resp.BkashURL, GatewayRef: resp.PaymentID}, nil
+}
+
+// VerifyCallback handles the browser callback, which carries the paymentID and a status.
+// Successful payments still need to be executed; if that already happened (for example the
+// client reloaded the callback page), the payment status is queried instead.
+func (b *BkashGateway) VerifyCallback(ctx context.Context, r *http.Request) (*Result, error) {
+	paymentID := r.FormValue("paymentID")
+	if paymentID == "" {
+		return nil, ErrInvalidCallback
+	}
+
+	if status := r.FormValue("status"); status != "success" {
+		// The payment was never executed, so query it to learn which invoice it belonged to
+		resp, err := b.call(ctx, "/tokenized/checkout/payment/status", map[string]string{"paymentID": paymentID})
+		if err != nil {
+			return nil, err
+		}
+		result := StatusFailed
+		if status == "cancel" {
+			result = StatusCancelled
+		}
+		return &Result{Ref: resp.MerchantInvoiceNumber, GatewayRef: paymentID, Status: result}, nil
+	}
+
+	resp, err := b.call(ctx, "/tokenized/checkout/execute", map[string]string{"paymentID": paymentID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != bkashSuccessCode {
+		resp, err = b.call(ctx, "/tokenized/checkout/payment/status", map[string]string{"paymentID": paymentID})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b.toResult(resp)
+}
+
+func (b *BkashGateway) toResult(resp *bkashResponse) (*Result, error) {
+	if resp.MerchantInvoiceNumber == "" {
+		return nil, fmt.Errorf("bkash payment lookup failed: %s %s", resp.StatusCode, resp.StatusMessage)
+	}
+	result := &Result{
+		Ref:        resp.MerchantInvoiceNumber,
+		GatewayRef: resp.TrxID,
+		Status:     StatusFailed,
+	}
+	if resp.TransactionStatus == "Completed" {
+		amount, err := parseAmount(resp.Amount)
+		if err != nil {
+			return nil, err
+		}
+		result.Amount = amount
+		result.Status = StatusCompleted
+	}
+	return result, nil
+}
+
+// token returns a valid id_token, granting a new one when the cached token is about to expire
+func (b *BkashGateway) token(ctx context.Context) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.idToken != "" && time.Now().Before(b.tokenExpiry) {
+		return b.idToken, nil
+	}
+
+	body, _ := json.Marshal(map[string]string{"app_key": b.appKey, "app_secret": b.appSecret})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		b.baseURL+"/tokenized/checkout/token/grant", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("username", b.username)
+	req.Header.Set("password", b.password)
+
+	var resp bkashResponse
+	if err := doJSON(b.httpClient, req, &resp); err != nil {
+		return "", err
+	}
+	if resp.IDToken == "" {
+		return "", fmt.Errorf("bkash token grant failed: %s %s", resp.StatusCode, resp.StatusMessage)
+	}
+
+	b.idToken = resp.IDToken
+	// Refresh a minute early so a token never expires mid-request
+	b.tokenExpiry = time.Now().Add(time.Duration(resp.ExpiresIn)*time.Second - time.Minute)
+	return b.idToken, nil
+}
+
+func (b *BkashGateway) call(ctx context.Context, path string, payload map[string]string) (*bkashResponse, error) {
+	token, err := b.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, _ := json.Marshal(payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", token)
+	req.Header.Set("X-APP-Key", b.appKey)
+
+	var resp bkashResponse
+	if err := doJSON(b.httpClient, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// doJSON sends a request and decodes a JSON response body into out
+func doJSON(client *http.Client, req *http.Request, out any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("%s %s: unexpected status %d", req.Method, req.URL.Path, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, err)
+	}
+	return nil
+}
@@ -0,0 +1,313 @@
+package paymentgateway
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+)
+
+const (
+	nagadAPIVersion   = "v-0.2.0"
+	nagadCurrencyCode = "050" // BDT
+)
+
+// dhaka is the timezone Nagad expects request timestamps in
+var dhaka = time.FixedZone("Asia/Dhaka", 6*60*60)
+
+// NagadGateway implements the Nagad merchant checkout API. Sensitive data is encrypted with the
+// payment gateway's public key and signed with the merchant's private key.
+type NagadGateway struct {
+	baseURL        string
+	merchantID     string
+	merchantNumber string
+	merchantKey    *rsa.PrivateKey
+	pgKey          *rsa.PublicKey
+	httpClient     *http.Client
+}
+
+type nagadSealedRequest struct {
+	AccountNumber          string            `json:"accountNumber,omitempty"`
+	DateTime               string            `json:"dateTime,omitempty"`
+	SensitiveData          string            `json:"sensitiveData"`
+	Signature              string            `json:"signature"`
+	MerchantCallbackURL    string            `json:"merchantCallbackURL,omitempty"`
+	AdditionalMerchantInfo map[string]string `json:"additionalMerchantInfo,omitempty"`
+}
+
+type nagadResponse struct {
+	SensitiveData string `json:"sensitiveData"`
+	Signature     string `json:"signature"`
+	CallBackURL   string `json:"callBackUrl"`
+	Status        string `json:"status"`
+	Reason        string `json:"reason"`
+	Message       string `json:"message"`
+
+	// Fields returned by the verify endpoint
+	OrderID            string `json:"orderId"`
+	PaymentRefID       string `json:"paymentRefId"`
+	Amount             string `json:"amount"`
+	IssuerPaymentRefNo string `json:"issuerPaymentRefNo"`
+}
+
+type nagadInitData struct {
+	PaymentReferenceID string `json:"paymentReferenceId"`
+	Challenge          string `json:"challenge"`
+}
+
+func NewNagadGateway(
+	baseURL, merchantID, merchantNumber, merchantPrivateKey, pgPublicKey string, httpClient *http.Client,
+) (*NagadGateway, error) {
+	merchantKey, err := parseRSAPrivateKey(merchantPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("merchant private key: %w", err)
+	}
+	pgKey, err := parseRSAPublicKey(pgPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("gateway public key: %w", err)
+	}
+	return &NagadGateway{
+		baseURL:        strings.TrimRight(baseURL, "/"),
+		merchantID:     merchantID,
+		merchantNumber: merchantNumber,
+		merchantKey:    merchantKey,
+		pgKey:          pgKey,
+		httpClient:     httpClient,
+	}, nil
+}
+
+func (n *NagadGateway) Name() string {
+	return NameNagad
+}
+
+func (n *NagadGateway) Label() string {
+	return "Nagad"
+}
+
+func (n *NagadGateway) Method() clienttxn.PaymentMethod {
+	return clienttxn.PaymentMethodGatewayNagad
+}
+
+func (n *NagadGateway) StartCheckout(ctx context.Context, req CheckoutRequest) (*Checkout, error) {
+	now := time.Now().In(dhaka).Format("20060102150405")
+
+	challenge := make([]byte, 20)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, err
+	}
+
+	// Step 1: initialize the order and receive a payment reference and a fresh challenge
+	initReq, err := n.seal(map[string]string{
+		"merchantId": n.merchantID,
+		"datetime":   now,
+		"orderId":    req.Ref,
+		"challenge":  hex.EncodeToString(challenge),
+	})
+	if err != nil {
+		return nil, err
+	}
+	initReq.AccountNumber = n.merchantNumber
+	initReq.DateTime = now
+
+	var initResp nagadResponse
+	path := fmt.Sprintf("/api/dfs/check-out/initialize/%s/%s?locale=EN", n.merchantID, req.Ref)
+	if err := n.post(ctx, path, initReq, &initResp); err != nil {
+		return nil, err
+	}
+	if initResp.SensitiveData == "" {
+		return nil, fmt.Errorf("nagad initialize failed: %s %s", initResp.Reason, initResp.Message)
+	}
+	var initData nagadInitData
+	if err := n.open(initResp, &initData); err != nil {
+		return nil, err
+	}
+
+	// Step 2: complete the order with the amount, which returns the hosted checkout URL
+	completeReq, err := n.seal(map[string]string{
+		"merchantId":   n.merchantID,
+		"orderId":      req.Ref,
+		"currencyCode": nagadCurrencyCode,
+		"amount":       formatAmount(req.Amount),
+		"challenge":    initData.Challenge,
+	})
+	if err != nil {
+		return nil, err
+	}
+	completeReq.MerchantCallbackURL = req.CallbackURL
+	completeReq.AdditionalMerchantInfo = map[string]string{"username": req.Customer.Username}
+
+	var completeResp nagadResponse
+	path = "/api/dfs/check-out/complete/" + initData.PaymentReferenceID
+	if err := n.post(ctx, path, completeReq, &completeResp); err != nil {
+		return nil, err
+	}
+	if completeResp.Status != "Success" || completeResp.CallBackURL == "" {
+		return nil, fmt.Errorf("nagad complete failed: %s %s", completeResp.Reason, completeResp.Message)
+	}
+
+	return &Checkout{RedirectURL: completeResp.CallBackURL, GatewayRef: initData.PaymentReferenceID}, nil
+}
+
+// VerifyCallback looks up the payment_ref_id from the callback with the verify API, since the
+// callback query string itself is not signed.
+func (n *NagadGateway) VerifyCallback(ctx context.Context, r *http.Request) (*Result, error) {
+	paymentRefID := r.FormValue("payment_ref_id")
+	if paymentRefID == "" {
+		return nil, ErrInvalidCallback
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		n.baseURL+"/api/dfs/verify/payment/"+paymentRefID, nil)
+	if err != nil {
+		return nil, err
+	}
+	n.setHeaders(httpReq)
+
+	var resp nagadResponse
+	if err := doJSON(n.httpClient, httpReq, &resp); err != nil {
+		return nil, err
+	}
+	if resp.OrderID == "" {
+		return nil, fmt.Errorf("nagad verify failed: %s %s", resp.Reason, resp.Message)
+	}
+
+	result := &Result{Ref: resp.OrderID, GatewayRef: resp.IssuerPaymentRefNo, Status: StatusFailed}
+	switch resp.Status {
+	case "Success":
+		amount, err := parseAmount(resp.Amount)
+		if err != nil {
+			return nil, err
+		}
+		result.Amount = amount
+		result.Status = StatusCompleted
+	case "Aborted", "Cancelled":
+		result.Status = StatusCancelled
+	}
+	return result, nil
+}
+
+// seal encrypts the payload for the gateway and signs it with the merchant key
+func (n *NagadGateway) seal(payload map[string]string) (*nagadSealedRequest, error) {
+	plain, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, n.pgKey, plain)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(plain)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, n.merchantKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	return &nagadSealedRequest{
+		SensitiveData: base64.StdEncoding.EncodeToString(encrypted),
+		Signature:     base64.StdEncoding.EncodeToString(signature),
+	}, nil
+}
+
+// open decrypts a response sealed for the merchant and checks the gateway's signature
+func (n *NagadGateway) open(resp nagadResponse, out any) error {
+	encrypted, err := base64.StdEncoding.DecodeString(resp.SensitiveData)
+	if err != nil {
+		return err
+	}
+	plain, err := rsa.DecryptPKCS1v15(rand.Reader, n.merchantKey, encrypted)
+	if err != nil {
+		return err
+	}
+	signature, err := base64.StdEncoding.DecodeString(resp.Signature)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(plain)
+	if err := rsa.VerifyPKCS1v15(n.pgKey, crypto.SHA256, digest[:], signature); err != nil {
+		return ErrInvalidCallback
+	}
+	return json.Unmarshal(plain, out)
+}
+
+func (n *NagadGateway) post(ctx context.Context, path string, payload any, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	n.setHeaders(req)
+	return doJSON(n.httpClient, req, out)
+}
+
+func (n *NagadGateway) setHeaders(req *http.Request) {
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-KM-Api-Version", nagadAPIVersion)
+	req.Header.Set("X-KM-Client-Type", "PC_WEB")
+	req.Header.Set("X-KM-IP-V4", "127.0.0.1")
+}
+
+// decodeKey accepts a key either as a PEM block or as the bare base64 body Nagad hands out
+func decodeKey(key string) ([]byte, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, errors.New("key is empty")
+	}
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		return block.Bytes, nil
+	}
+	return base64.StdEncoding.DecodeString(key)
+}
+
+func parseRSAPrivateKey(key string) (*rsa.PrivateKey, error) {
+	der, err := decodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if k, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return k, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	k, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an RSA private key")
+	}
+	return k, nil
+}
+
+func parseRSAPublicKey(key string) (*rsa.PublicKey, error) {
+	der, err := decodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if k, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		return k, nil
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	k, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+	return k, nil
+}
@@ -0,0 +1,179 @@
+package paymentgateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+)
+
+const (
+	NameBkash      = "bkash"
+	NameNagad      = "nagad"
+	NameSSLCommerz = "sslcommerz"
+	NameStripe     = "stripe"
+	NameFake       = "fake"
+)
+
+// Status is the outcome of a payment as reported by a gateway
+type Status string
+
+const (
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+	StatusPending   Status = "pending"
+)
+
+var (
+	// ErrInvalidCallback is returned when a callback or IPN cannot be authenticated
+	ErrInvalidCallback = errors.New("invalid payment gateway callback")
+	// ErrGatewayNotFound is returned when a gateway is not configured or not enabled
+	ErrGatewayNotFound = errors.New("payment gateway not found")
+)
+
+type (
+	// Gateway is implemented by every payment provider a client can top up their balance through.
+	Gateway interface {
+		// Name is the URL-safe identifier of the gateway, used in callback routes
+		Name() string
+		// Label is the human readable name shown to clients
+		Label() string
+		// Method is the ClientTxn payment method recorded for payments made through the gateway
+		Method() clienttxn.PaymentMethod
+		// StartCheckout creates a payment at the provider and returns where the client must be sent
+		StartCheckout(ctx context.Context, req CheckoutRequest) (*Checkout, error)
+		// VerifyCallback authenticates a browser callback or IPN and returns the verified payment outcome.
+		// Implementations must confirm the outcome with the provider rather than trusting the request.
+		VerifyCallback(ctx context.Context, r *http.Request) (*Result, error)
+	}
+
+	// CheckoutRequest describes a payment to start at a gateway
+	CheckoutRequest struct {
+		// Ref is our transaction_ref, sent to the gateway as the merchant invoice number
+		Ref      string
+		Amount   float64
+		Currency string
+		Customer Customer
+		// CallbackURL is where the client's browser returns to once the payment is done
+		CallbackURL string
+		// IPNURL receives server-to-server notifications, for gateways that support them
+		IPNURL string
+	}
+
+	Customer struct {
+		Username string
+		Name     string
+		Email    string
+		Phone    string
+		Address  string
+		City     string
+	}
+
+	// Checkout is a started payment
+	Checkout struct {
+		RedirectURL string
+		GatewayRef  string
+	}
+
+	// Result is the verified outcome of a payment
+	Result struct {
+		Ref        string
+		GatewayRef string
+		Amount     float64
+		Status     Status
+	}
+)
+
+// Registry holds the gateways enabled in configuration
+type Registry struct {
+	gateways map[string]Gateway
+}
+
+// NewRegistry builds a registry of all gateways enabled in the configuration.
+// The fake gateway is never registered in production, nor without a secret to sign its callbacks.
+func NewRegistry(cfg *config.Config, httpClient *http.Client) *Registry {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	gw := cfg.Billing.Gateways
+
+	r := NewRegistryWith()
+	if gw.Bkash.Enabled {
+		r.Register(NewBkashGateway(gw.Bkash.BaseURL, gw.Bkash.AppKey, gw.Bkash.AppSecret,
+			gw.Bkash.Username, gw.Bkash.Password, httpClient))
+	}
+	if gw.Nagad.Enabled {
+		nagad, err := NewNagadGateway(gw.Nagad.BaseURL, gw.Nagad.MerchantID, gw.Nagad.MerchantNumber,
+			gw.Nagad.MerchantPrivateKey, gw.Nagad.PGPublicKey, httpClient)
+		if err != nil {
+			panic(fmt.Sprintf("failed to initialize nagad gateway: %v", err))
+		}
+		r.Register(nagad)
+	}
+	if gw.SSLCommerz.Enabled {
+		r.Register(NewSSLCommerzGateway(gw.SSLCommerz.BaseURL, gw.SSLCommerz.StoreID,
+			gw.SSLCommerz.StorePassword, httpClient))
+	}
+	if gw.Stripe.Enabled {
+		r.Register(NewStripeGateway(gw.Stripe.SecretKey, gw.Stripe.WebhookSecret))
+	}
+	if gw.Fake.Enabled && gw.Fake.Secret != "" && cfg.App.Environment != config.EnvProduction {
+		r.Register(NewFakeGateway(gw.Fake.Secret))
+	}
+	return r
+}
+
+// NewRegistryWith builds a registry from the given gateways
+func NewRegistryWith(gateways ...Gateway) *Registry {
+	r := &Registry{gateways: make(map[string]Gateway)}
+	for _, g := range gateways {
+		r.Register(g)
+	}
+	return r
+}
+
+// Register adds a gateway, replacing any gateway with the same name
+func (r *Registry) Register(g Gateway) {
+	r.gateways[g.Name()] = g
+}
+
+// Get returns the gateway with the given name
+func (r *Registry) Get(name string) (Gateway, error) {
+	g, ok := r.gateways[name]
+	if !ok {
+		return nil, ErrGatewayNotFound
+	}
+	return g, nil
+}
+
+// All returns the enabled gateways, sorted by name
+func (r *Registry) All() []Gateway {
+	all := make([]Gateway, 0, len(r.gateways))
+	for _, g := range r.gateways {
+		all = append(all, g)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Name() < all[j].Name() })
+	return all
+}
+
+// formatAmount formats an amount the way gateways expect it, with exactly two decimals
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// parseAmount parses an amount returned by a gateway
+func parseAmount(s string) (float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %w", s, err)
+	}
+	return math.Round(v*100) / 100, nil
+}
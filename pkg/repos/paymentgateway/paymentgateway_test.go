@@ -0,0 +1,293 @@
+package paymentgateway_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/pkg/repos/paymentgateway"
+)
+
+func checkoutRequest(ref string) paymentgateway.CheckoutRequest {
+	return paymentgateway.CheckoutRequest{
+		Ref:         ref,
+		Amount:      500,
+		Currency:    "BDT",
+		Customer:    paymentgateway.Customer{Username: "client1", Name: "Client One"},
+		CallbackURL: "https://isp.example/payments/gateway/test/callback",
+		IPNURL:      "https://isp.example/payments/gateway/test/ipn",
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestRegistry(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Billing.Gateways.Fake.Enabled = true
+	cfg.Billing.Gateways.Fake.Secret = "secret"
+	cfg.Billing.Gateways.SSLCommerz.Enabled = true
+
+	registry := paymentgateway.NewRegistry(cfg, nil)
+	all := registry.All()
+	require.Len(t, all, 2)
+	assert.Equal(t, paymentgateway.NameFake, all[0].Name())
+	assert.Equal(t, paymentgateway.NameSSLCommerz, all[1].Name())
+
+	_, err := registry.Get(paymentgateway.NameBkash)
+	assert.ErrorIs(t, err, paymentgateway.ErrGatewayNotFound)
+
+	// Nor without a secret to sign its callbacks
+	cfg.Billing.Gateways.Fake.Secret = ""
+	_, err = paymentgateway.NewRegistry(cfg, nil).Get(paymentgateway.NameFake)
+	assert.ErrorIs(t, err, paymentgateway.ErrGatewayNotFound)
+
+	// The fake gateway must never be reachable in production
+	cfg.Billing.Gateways.Fake.Secret = "secret"
+	cfg.App.Environment = config.EnvProduction
+	_, err = paymentgateway.NewRegistry(cfg, nil).Get(paymentgateway.NameFake)
+	assert.ErrorIs(t, err, paymentgateway.ErrGatewayNotFound)
+}
+
+func TestFakeGateway(t *testing.T) {
+	ctx := context.Background()
+	fake := paymentgateway.NewFakeGateway("secret")
+
+	checkout, err := fake.StartCheckout(ctx, checkoutRequest("TOP-1"))
+	require.NoError(t, err)
+
+	result, err := fake.VerifyCallback(ctx, httptest.NewRequest(http.MethodGet, checkout.RedirectURL, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "TOP-1", result.Ref)
+	assert.Equal(t, 500.0, result.Amount)
+	assert.Equal(t, paymentgateway.StatusCompleted, result.Status)
+
+	// Tampering with the amount invalidates the signature
+	tampered := strings.Replace(checkout.RedirectURL, "amount=500.00", "amount=5000.00", 1)
+	_, err = fake.VerifyCallback(ctx, httptest.NewRequest(http.MethodGet, tampered, nil))
+	assert.ErrorIs(t, err, paymentgateway.ErrInvalidCallback)
+
+	failed := fake.SignedCallbackURL("https://isp.example/cb", "TOP-1", "", 500, paymentgateway.StatusFailed)
+	result, err = fake.VerifyCallback(ctx, httptest.NewRequest(http.MethodGet, failed, nil))
+	require.NoError(t, err)
+	assert.Equal(t, paymentgateway.StatusFailed, result.Status)
+}
+
+func TestBkashGateway(t *testing.T) {
+	ctx := context.Background()
+	grants := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		switch r.URL.Path {
+		case "/tokenized/checkout/token/grant":
+			grants++
+			assert.Equal(t, "user", r.Header.Get("username"))
+			writeJSON(w, map[string]any{"statusCode": "0000", "id_token": "token", "expires_in": 3600})
+		case "/tokenized/checkout/create":
+			assert.Equal(t, "token", r.Header.Get("Authorization"))
+			assert.Equal(t, "500.00", body["amount"])
+			assert.Equal(t, "TOP-1", body["merchantInvoiceNumber"])
+			writeJSON(w, map[string]string{"statusCode": "0000", "paymentID": "PAY1", "bkashURL": "https://bkash.example/pay"})
+		case "/tokenized/checkout/execute":
+			assert.Equal(t, "PAY1", body["paymentID"])
+			writeJSON(w, map[string]string{
+				"statusCode": "0000", "trxID": "TRX1", "transactionStatus": "Completed",
+				"amount": "500.00", "merchantInvoiceNumber": "TOP-1",
+			})
+		case "/tokenized/checkout/payment/status":
+			writeJSON(w, map[string]string{
+				"statusCode": "0000", "transactionStatus": "Initiated", "merchantInvoiceNumber": "TOP-1",
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	bkash := paymentgateway.NewBkashGateway(srv.URL, "key", "secret", "user", "pass", srv.Client())
+
+	checkout, err := bkash.StartCheckout(ctx, checkoutRequest("TOP-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://bkash.example/pay", checkout.RedirectURL)
+	assert.Equal(t, "PAY1", checkout.GatewayRef)
+
+	req := httptest.NewRequest(http.MethodGet, "/callback?paymentID=PAY1&status=success", nil)
+	result, err := bkash.VerifyCallback(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, paymentgateway.Result{
+		Ref: "TOP-1", GatewayRef: "TRX1", Amount: 500, Status: paymentgateway.StatusCompleted,
+	}, *result)
+
+	req = httptest.NewRequest(http.MethodGet, "/callback?paymentID=PAY1&status=cancel", nil)
+	result, err = bkash.VerifyCallback(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "TOP-1", result.Ref)
+	assert.Equal(t, paymentgateway.StatusCancelled, result.Status)
+
+	// The token is granted once and reused
+	assert.Equal(t, 1, grants)
+}
+
+func TestSSLCommerzGateway(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gwprocess/v4/api.php":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "store", r.PostForm.Get("store_id"))
+			assert.Equal(t, "TOP-1", r.PostForm.Get("tran_id"))
+			assert.Equal(t, "500.00", r.PostForm.Get("total_amount"))
+			writeJSON(w, map[string]string{
+				"status": "SUCCESS", "sessionkey": "SESS1", "GatewayPageURL": "https://ssl.example/pay",
+			})
+		case "/validator/api/validationserverAPI.php":
+			assert.Equal(t, "VAL1", r.URL.Query().Get("val_id"))
+			writeJSON(w, map[string]string{
+				"status": "VALID", "tran_id": "TOP-1", "amount": "500.00", "bank_tran_id": "BANK1",
+			})
+		case "/validator/api/merchantTransIDvalidationAPI.php":
+			tranID := r.URL.Query().Get("tran_id")
+			element := []map[string]string{{"status": "FAILED", "tran_id": tranID}}
+			switch tranID {
+			case "TOP-3":
+				element = append(element, map[string]string{
+					"status": "VALID", "tran_id": tranID, "amount": "500.00", "bank_tran_id": "BANK3",
+				})
+			case "TOP-4":
+				element = append(element, map[string]string{"status": "PENDING", "tran_id": tranID})
+			}
+			writeJSON(w, map[string]any{"APIConnect": "DONE", "element": element})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	ssl := paymentgateway.NewSSLCommerzGateway(srv.URL, "store", "pass", srv.Client())
+
+	checkout, err := ssl.StartCheckout(ctx, checkoutRequest("TOP-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://ssl.example/pay", checkout.RedirectURL)
+
+	form := url.Values{"tran_id": {"TOP-1"}, "status": {"VALID"}, "val_id": {"VAL1"}}
+	req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	result, err := ssl.VerifyCallback(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, paymentgateway.Result{
+		Ref: "TOP-1", GatewayRef: "BANK1", Amount: 500, Status: paymentgateway.StatusCompleted,
+	}, *result)
+
+	// A callback for another transaction cannot reuse a validated val_id
+	form.Set("tran_id", "TOP-2")
+	req = httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, err = ssl.VerifyCallback(ctx, req)
+	assert.ErrorIs(t, err, paymentgateway.ErrInvalidCallback)
+
+	// A failed or cancelled redirect is only believed once SSLCommerz confirms it
+	verify := func(tranID, status string) *paymentgateway.Result {
+		form := url.Values{"tran_id": {tranID}, "status": {status}}
+		req := httptest.NewRequest(http.MethodPost, "/callback", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		result, err := ssl.VerifyCallback(ctx, req)
+		require.NoError(t, err)
+		return result
+	}
+	assert.Equal(t, paymentgateway.StatusFailed, verify("TOP-2", "FAILED").Status)
+	assert.Equal(t, paymentgateway.Result{
+		Ref: "TOP-3", GatewayRef: "BANK3", Amount: 500, Status: paymentgateway.StatusCompleted,
+	}, *verify("TOP-3", "CANCELLED"))
+	assert.Equal(t, paymentgateway.StatusPending, verify("TOP-4", "FAILED").Status)
+}
+
+func TestNagadGateway(t *testing.T) {
+	ctx := context.Background()
+
+	merchantKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pgKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	merchantPrivate := base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PrivateKey(merchantKey))
+	pgPublicDER, err := x509.MarshalPKIXPublicKey(&pgKey.PublicKey)
+	require.NoError(t, err)
+	pgPublic := base64.StdEncoding.EncodeToString(pgPublicDER)
+
+	// open decrypts a request the way Nagad does and checks the merchant's signature
+	open := func(body map[string]any) map[string]string {
+		encrypted, _ := base64.StdEncoding.DecodeString(body["sensitiveData"].(string))
+		plain, err := rsa.DecryptPKCS1v15(rand.Reader, pgKey, encrypted)
+		require.NoError(t, err)
+		signature, _ := base64.StdEncoding.DecodeString(body["signature"].(string))
+		digest := sha256.Sum256(plain)
+		require.NoError(t, rsa.VerifyPKCS1v15(&merchantKey.PublicKey, crypto.SHA256, digest[:], signature))
+		var data map[string]string
+		require.NoError(t, json.Unmarshal(plain, &data))
+		return data
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/api/dfs/check-out/initialize/"):
+			data := open(body)
+			assert.Equal(t, "TOP-1", data["orderId"])
+			plain, _ := json.Marshal(map[string]string{"paymentReferenceId": "NGREF1", "challenge": "abc"})
+			encrypted, _ := rsa.EncryptPKCS1v15(rand.Reader, &merchantKey.PublicKey, plain)
+			digest := sha256.Sum256(plain)
+			signature, _ := rsa.SignPKCS1v15(rand.Reader, pgKey, crypto.SHA256, digest[:])
+			writeJSON(w, map[string]string{
+				"sensitiveData": base64.StdEncoding.EncodeToString(encrypted),
+				"signature":     base64.StdEncoding.EncodeToString(signature),
+			})
+		case r.URL.Path == "/api/dfs/check-out/complete/NGREF1":
+			data := open(body)
+			assert.Equal(t, "abc", data["challenge"])
+			assert.Equal(t, "500.00", data["amount"])
+			writeJSON(w, map[string]string{"status": "Success", "callBackUrl": "https://nagad.example/pay"})
+		case r.URL.Path == "/api/dfs/verify/payment/NGREF1":
+			writeJSON(w, map[string]string{
+				"status": "Success", "orderId": "TOP-1", "amount": "500", "issuerPaymentRefNo": "NGTRX1",
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	nagad, err := paymentgateway.NewNagadGateway(srv.URL, "merchant", "01700000000", merchantPrivate, pgPublic, srv.Client())
+	require.NoError(t, err)
+
+	checkout, err := nagad.StartCheckout(ctx, checkoutRequest("TOP-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://nagad.example/pay", checkout.RedirectURL)
+
+	req := httptest.NewRequest(http.MethodGet, "/callback?payment_ref_id=NGREF1&status=Success", nil)
+	result, err := nagad.VerifyCallback(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, paymentgateway.Result{
+		Ref: "TOP-1", GatewayRef: "NGTRX1", Amount: 500, Status: paymentgateway.StatusCompleted,
+	}, *result)
+}
@@ -0,0 +1,203 @@
+package paymentgateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+)
+
+// SSLCommerzGateway implements the SSLCommerz v4 hosted payment API
+type SSLCommerzGateway struct {
+	baseURL       string
+	storeID       string
+	storePassword string
+	httpClient    *http.Client
+}
+
+type sslCommerzSessionResponse struct {
+	Status         string `json:"status"`
+	FailedReason   string `json:"failedreason"`
+	SessionKey     string `json:"sessionkey"`
+	GatewayPageURL string `json:"GatewayPageURL"`
+}
+
+type sslCommerzValidationResponse struct {
+	Status     string `json:"status"`
+	TranID     string `json:"tran_id"`
+	Amount     string `json:"amount"`
+	BankTranID string `json:"bank_tran_id"`
+}
+
+type sslCommerzQueryResponse struct {
+	APIConnect string                         `json:"APIConnect"`
+	Element    []sslCommerzValidationResponse `json:"element"`
+}
+
+func NewSSLCommerzGateway(baseURL, storeID, storePassword string, httpClient *http.Client) *SSLCommerzGateway {
+	return &SSLCommerzGateway{
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		storeID:       storeID,
+		storePassword: storePassword,
+		httpClient:    httpClient,
+	}
+}
+
+func (s *SSLCommerzGateway) Name() string {
+	return NameSSLCommerz
+}
+
+func (s *SSLCommerzGateway) Label() string {
+	return "Card / Mobile Banking (SSLCommerz)"
+}
+
+func (s *SSLCommerzGateway) Method() clienttxn.PaymentMethod {
+	return clienttxn.PaymentMethodGatewaySslcommerz
+}
+
+func (s *SSLCommerzGateway) StartCheckout(ctx context.Context, req CheckoutRequest) (*Checkout, error) {
+	form := url.Values{}
+	form.Set("store_id", s.storeID)
+	form.Set("store_passwd", s.storePassword)
+	form.Set("total_amount", formatAmount(req.Amount))
+	form.Set("currency", req.Currency)
+	form.Set("tran_id", req.Ref)
+	form.Set("success_url", req.CallbackURL)
+	form.Set("fail_url", req.CallbackURL)
+	form.Set("cancel_url", req.CallbackURL)
+	form.Set("ipn_url", req.IPNURL)
+	form.Set("cus_name", req.Customer.Name)
+	form.Set("cus_email", req.Customer.Email)
+	form.Set("cus_phone", req.Customer.Phone)
+	form.Set("cus_add1", req.Customer.Address)
+	form.Set("cus_city", req.Customer.City)
+	form.Set("cus_country", "Bangladesh")
+	form.Set("shipping_method", "NO")
+	form.Set("product_name", "Internet balance recharge")
+	form.Set("product_category", "Internet")
+	form.Set("product_profile", "non-physical-goods")
+	form.Set("value_a", req.Customer.Username)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		s.baseURL+"/gwprocess/v4/api.php", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp sslCommerzSessionResponse
+	if err := doJSON(s.httpClient, httpReq, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != "SUCCESS" || resp.GatewayPageURL == "" {
+		return nil, fmt.Errorf("sslcommerz session failed: %s", resp.FailedReason)
+	}
+	return &Checkout{RedirectURL: resp.GatewayPageURL, GatewayRef: resp.SessionKey}, nil
+}
+
+// VerifyCallback handles the success, fail and cancel redirects as well as the IPN, which all
+// post the same fields. Only a val_id confirmed by the validation API counts as paid, and a failed
+// or cancelled payment is looked up by tran_id before the recharge is given up on.
+func (s *SSLCommerzGateway) VerifyCallback(ctx context.Context, r *http.Request) (*Result, error) {
+	tranID := r.FormValue("tran_id")
+	if tranID == "" {
+		return nil, ErrInvalidCallback
+	}
+
+	switch r.FormValue("status") {
+	case "VALID", "VALIDATED":
+	default:
+		return s.queryTransaction(ctx, tranID)
+	}
+
+	valID := r.FormValue("val_id")
+	if valID == "" {
+		return nil, ErrInvalidCallback
+	}
+
+	q := url.Values{}
+	q.Set("val_id", valID)
+	q.Set("store_id", s.storeID)
+	q.Set("store_passwd", s.storePassword)
+	q.Set("format", "json")
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		s.baseURL+"/validator/api/validationserverAPI.php?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp sslCommerzValidationResponse
+	if err := doJSON(s.httpClient, httpReq, &resp); err != nil {
+		return nil, err
+	}
+	if resp.TranID != tranID {
+		return nil, ErrInvalidCallback
+	}
+	if resp.Status != "VALID" && resp.Status != "VALIDATED" {
+		return &Result{Ref: tranID, GatewayRef: resp.BankTranID, Status: StatusFailed}, nil
+	}
+
+	amount, err := parseAmount(resp.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		Ref:        tranID,
+		GatewayRef: resp.BankTranID,
+		Amount:     amount,
+		Status:     StatusCompleted,
+	}, nil
+}
+
+// queryTransaction asks SSLCommerz how every attempt to pay tran_id went. Any validated attempt
+// means the client paid; only when all of them failed, were cancelled or expired is the payment
+// given up on. Anything else is still pending.
+func (s *SSLCommerzGateway) queryTransaction(ctx context.Context, tranID string) (*Result, error) {
+	q := url.Values{}
+	q.Set("tran_id", tranID)
+	q.Set("store_id", s.storeID)
+	q.Set("store_passwd", s.storePassword)
+	q.Set("format", "json")
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		s.baseURL+"/validator/api/merchantTransIDvalidationAPI.php?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp sslCommerzQueryResponse
+	if err := doJSON(s.httpClient, httpReq, &resp); err != nil {
+		return nil, err
+	}
+	if resp.APIConnect != "DONE" {
+		return nil, fmt.Errorf("sslcommerz transaction query failed: %s", resp.APIConnect)
+	}
+
+	result := &Result{Ref: tranID, Status: StatusPending}
+	pending := len(resp.Element) == 0
+	for _, e := range resp.Element {
+		if e.TranID != tranID {
+			return nil, ErrInvalidCallback
+		}
+		switch e.Status {
+		case "VALID", "VALIDATED":
+			amount, err := parseAmount(e.Amount)
+			if err != nil {
+				return nil, err
+			}
+			return &Result{Ref: tranID, GatewayRef: e.BankTranID, Amount: amount, Status: StatusCompleted}, nil
+		case "FAILED", "EXPIRED":
+			result.Status = StatusFailed
+		case "CANCELLED":
+			result.Status = StatusCancelled
+		default:
+			pending = true
+		}
+	}
+	if pending {
+		result.Status = StatusPending
+	}
+	return result, nil
+}
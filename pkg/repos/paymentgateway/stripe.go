@@ -0,0 +1,147 @@
+package paymentgateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/stripe/stripe-go/v78"
+	"github.com/stripe/stripe-go/v78/client"
+	"github.com/stripe/stripe-go/v78/webhook"
+)
+
+const (
+	stripeMaxWebhookBytes = int64(65536)
+	// stripeCheckoutExpiry is how long a checkout stays payable, the shortest Stripe allows, so an
+	// abandoned recharge is soon failed by the checkout.session.expired webhook
+	stripeCheckoutExpiry = 30 * time.Minute
+)
+
+// StripeGateway takes one-off card payments through Stripe Checkout. It uses its own API client
+// so it does not interfere with the global key used for pro subscriptions.
+type StripeGateway struct {
+	api           *client.API
+	webhookSecret string
+}
+
+func NewStripeGateway(secretKey, webhookSecret string) *StripeGateway {
+	api := &client.API{}
+	api.Init(secretKey, nil)
+	return &StripeGateway{api: api, webhookSecret: webhookSecret}
+}
+
+func (s *StripeGateway) Name() string {
+	return NameStripe
+}
+
+func (s *StripeGateway) Label() string {
+	return "International Card (Stripe)"
+}
+
+func (s *StripeGateway) Method() clienttxn.PaymentMethod {
+	return clienttxn.PaymentMethodGatewayStripe
+}
+
+func (s *StripeGateway) StartCheckout(ctx context.Context, req CheckoutRequest) (*Checkout, error) {
+	params := &stripe.CheckoutSessionParams{
+		Mode:              stripe.String(string(stripe.CheckoutSessionModePayment)),
+		ClientReferenceID: stripe.String(req.Ref),
+		SuccessURL:        stripe.String(req.CallbackURL + "?session_id={CHECKOUT_SESSION_ID}"),
+		CancelURL:         stripe.String(req.CallbackURL + "?cancelled=1&ref=" + req.Ref),
+		ExpiresAt:         stripe.Int64(time.Now().Add(stripeCheckoutExpiry).Unix()),
+		LineItems: []*stripe.CheckoutSessionLineItemParams{
+			{
+				Quantity: stripe.Int64(1),
+				PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+					Currency:   stripe.String(strings.ToLower(req.Currency)),
+					UnitAmount: stripe.Int64(int64(math.Round(req.Amount * 100))),
+					ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+						Name: stripe.String("Internet balance recharge"),
+					},
+				},
+			},
+		},
+	}
+	if req.Customer.Email != "" {
+		params.CustomerEmail = stripe.String(req.Customer.Email)
+	}
+	params.Context = ctx
+
+	sess, err := s.api.CheckoutSessions.New(params)
+	if err != nil {
+		return nil, err
+	}
+	return &Checkout{RedirectURL: sess.URL, GatewayRef: sess.ID}, nil
+}
+
+// VerifyCallback handles both the browser return, which carries the checkout session ID, and
+// signed webhook events. The return from a cancelled checkout is neither signed nor tied to a
+// session, so it leaves the recharge pending until the session expires and Stripe says so.
+func (s *StripeGateway) VerifyCallback(ctx context.Context, r *http.Request) (*Result, error) {
+	if signature := r.Header.Get("Stripe-Signature"); signature != "" {
+		return s.verifyWebhook(r, signature)
+	}
+
+	if r.FormValue("cancelled") != "" {
+		ref := r.FormValue("ref")
+		if ref == "" {
+			return nil, ErrInvalidCallback
+		}
+		return &Result{Ref: ref, Status: StatusPending}, nil
+	}
+
+	sessionID := r.FormValue("session_id")
+	if sessionID == "" {
+		return nil, ErrInvalidCallback
+	}
+	params := &stripe.CheckoutSessionParams{}
+	params.Context = ctx
+	sess, err := s.api.CheckoutSessions.Get(sessionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return sessionResult(sess), nil
+}
+
+func (s *StripeGateway) verifyWebhook(r *http.Request, signature string) (*Result, error) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, stripeMaxWebhookBytes))
+	if err != nil {
+		return nil, err
+	}
+	event, err := webhook.ConstructEventWithOptions(payload, signature, s.webhookSecret,
+		webhook.ConstructEventOptions{IgnoreAPIVersionMismatch: true})
+	if err != nil {
+		return nil, ErrInvalidCallback
+	}
+
+	var sess stripe.CheckoutSession
+	if err := json.Unmarshal(event.Data.Raw, &sess); err != nil {
+		return nil, err
+	}
+	result := sessionResult(&sess)
+	if event.Type == "checkout.session.expired" || event.Type == "checkout.session.async_payment_failed" {
+		result.Status = StatusFailed
+	}
+	return result, nil
+}
+
+func sessionResult(sess *stripe.CheckoutSession) *Result {
+	result := &Result{
+		Ref:        sess.ClientReferenceID,
+		GatewayRef: sess.ID,
+		Status:     StatusPending,
+	}
+	if sess.PaymentIntent != nil {
+		result.GatewayRef = sess.PaymentIntent.ID
+	}
+	if sess.PaymentStatus == stripe.CheckoutSessionPaymentStatusPaid {
+		result.Amount = float64(sess.AmountTotal) / 100
+		result.Status = StatusCompleted
+	}
+	return result
+}
@@ -0,0 +1,295 @@
+// Package radius encodes and decodes the RADIUS packets the portal exchanges with NASes: RFC 2866
+// accounting and RFC 5176 dynamic authorization. Both sign requests the same way, with an MD5 of
+// the packet and the shared secret.
+package radius
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"strconv"
+)
+
+// Code is the type of a RADIUS packet
+type Code byte
+
+// Accounting codes, RFC 2866 section 3, and dynamic authorization codes, RFC 5176 section 3
+const (
+	CodeAccountingRequest  Code = 4
+	CodeAccountingResponse Code = 5
+
+	CodeDisconnectRequest Code = 40
+	CodeDisconnectACK     Code = 41
+	CodeDisconnectNAK     Code = 42
+	CodeCoARequest        Code = 43
+	CodeCoAACK            Code = 44
+	CodeCoANAK            Code = 45
+)
+
+// Attribute types used in requests and responses
+const (
+	TypeUserName            byte = 1
+	TypeNASIPAddress        byte = 4
+	TypeNASPort             byte = 5
+	TypeFramedIPAddress     byte = 8
+	TypeFilterID            byte = 11
+	TypeVendorSpecific      byte = 26
+	TypeSessionTimeout      byte = 27
+	TypeIdleTimeout         byte = 28
+	TypeCallingStationID    byte = 31
+	TypeNASIdentifier       byte = 32
+	TypeAcctStatusType      byte = 40
+	TypeAcctDelayTime       byte = 41
+	TypeAcctInputOctets     byte = 42
+	TypeAcctOutputOctets    byte = 43
+	TypeAcctSessionID       byte = 44
+	TypeAcctSessionTime     byte = 46
+	TypeAcctTerminateCause  byte = 49
+	TypeAcctInputGigawords  byte = 52
+	TypeAcctOutputGigawords byte = 53
+	TypeEventTimestamp      byte = 55
+	TypeAcctInterimInterval byte = 85
+	TypeNASPortID           byte = 87
+	TypeFramedPool          byte = 88
+	TypeErrorCause          byte = 101
+)
+
+// Acct-Status-Type values, RFC 2866 section 5.1
+const (
+	AcctStatusStart         = 1
+	AcctStatusStop          = 2
+	AcctStatusInterimUpdate = 3
+	AcctStatusAccountingOn  = 7
+	AcctStatusAccountingOff = 8
+)
+
+// terminateCauses are the names of Acct-Terminate-Cause values, RFC 2866 section 5.10, as
+// FreeRADIUS writes them to radacct
+var terminateCauses = []string{
+	1: "User-Request", 2: "Lost-Carrier", 3: "Lost-Service", 4: "Idle-Timeout", 5: "Session-Timeout",
+	6: "Admin-Reset", 7: "Admin-Reboot", 8: "Port-Error", 9: "NAS-Error", 10: "NAS-Request",
+	11: "NAS-Reboot", 12: "Port-Unneeded", 13: "Port-Preempted", 14: "Port-Suspended",
+	15: "Service-Unavailable", 16: "Callback", 17: "User-Error", 18: "Host-Request",
+}
+
+// TerminateCause names an Acct-Terminate-Cause value
+func TerminateCause(value uint32) string {
+	if int(value) < len(terminateCauses) && terminateCauses[value] != "" {
+		return terminateCauses[value]
+	}
+	return strconv.FormatUint(uint64(value), 10)
+}
+
+// VendorMikrotik is the IANA enterprise number of MikroTik, whose vendor attributes set the speed
+// of a session
+const VendorMikrotik uint32 = 14988
+
+// ErrorCauseSessionNotFound is the Error-Cause of a NAK for a session the NAS no longer has
+const ErrorCauseSessionNotFound = 503
+
+// MaxPacketLen is the largest packet RADIUS allows, RFC 2865 section 3
+const MaxPacketLen = 4096
+
+const (
+	headerLen     = 20
+	maxAttrValue  = 253
+	authenticator = 16
+)
+
+var (
+	errMalformed = errors.New("malformed radius packet")
+	errTooLong   = errors.New("radius packet too long")
+)
+
+// Attribute is a RADIUS attribute. Vendor attributes set Vendor and VendorType and are sent inside a
+// Vendor-Specific attribute.
+type Attribute struct {
+	Type       byte
+	Vendor     uint32
+	VendorType byte
+	Value      []byte
+}
+
+// Packet is a RADIUS packet
+type Packet struct {
+	Code          Code
+	Identifier    byte
+	Authenticator [authenticator]byte
+	Attributes    []Attribute
+}
+
+// String builds a text attribute
+func String(typ byte, value string) Attribute {
+	return Attribute{Type: typ, Value: []byte(value)}
+}
+
+// Integer builds a 32-bit integer attribute
+func Integer(typ byte, value uint32) Attribute {
+	return Attribute{Type: typ, Value: binary.BigEndian.AppendUint32(nil, value)}
+}
+
+// IPv4 builds an address attribute
+func IPv4(typ byte, addr netip.Addr) Attribute {
+	v := addr.As4()
+	return Attribute{Type: typ, Value: v[:]}
+}
+
+// Vendor builds a vendor specific text attribute
+func Vendor(vendor uint32, typ byte, value string) Attribute {
+	return Attribute{Type: TypeVendorSpecific, Vendor: vendor, VendorType: typ, Value: []byte(value)}
+}
+
+// Attr returns the first attribute of a type, nil if the packet has none
+func (p *Packet) Attr(typ byte) *Attribute {
+	for i := range p.Attributes {
+		if p.Attributes[i].Type == typ && p.Attributes[i].Vendor == 0 {
+			return &p.Attributes[i]
+		}
+	}
+	return nil
+}
+
+// Text returns the value of a text attribute, empty if the packet has none
+func (p *Packet) Text(typ byte) string {
+	if a := p.Attr(typ); a != nil {
+		return string(a.Value)
+	}
+	return ""
+}
+
+// Uint32 returns the value of an integer attribute, false if the packet has none
+func (p *Packet) Uint32(typ byte) (uint32, bool) {
+	a := p.Attr(typ)
+	if a == nil || len(a.Value) != 4 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(a.Value), true
+}
+
+// Addr returns the value of an address attribute, the zero Addr if the packet has none
+func (p *Packet) Addr(typ byte) netip.Addr {
+	a := p.Attr(typ)
+	if a == nil || len(a.Value) != 4 {
+		return netip.Addr{}
+	}
+	return netip.AddrFrom4([4]byte(a.Value))
+}
+
+// ErrorCause returns the Error-Cause of a NAK, 0 if it has none
+func (p *Packet) ErrorCause() int {
+	cause, _ := p.Uint32(TypeErrorCause)
+	return int(cause)
+}
+
+// encode writes a packet with a zeroed authenticator, to be signed
+func (p *Packet) encode() ([]byte, error) {
+	b := make([]byte, headerLen, MaxPacketLen)
+	b[0] = byte(p.Code)
+	b[1] = p.Identifier
+	copy(b[4:headerLen], p.Authenticator[:])
+	for _, a := range p.Attributes {
+		value := a.Value
+		if a.Vendor != 0 {
+			if len(value) > maxAttrValue-8 {
+				return nil, errTooLong
+			}
+			vsa := binary.BigEndian.AppendUint32(nil, a.Vendor)
+			vsa = append(vsa, a.VendorType, byte(len(value)+2))
+			value = append(vsa, value...)
+		}
+		if len(value) > maxAttrValue {
+			return nil, errTooLong
+		}
+		b = append(b, a.Type, byte(len(value)+2))
+		b = append(b, value...)
+	}
+	if len(b) > MaxPacketLen {
+		return nil, errTooLong
+	}
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(b)))
+	return b, nil
+}
+
+// EncodeRequest encodes a request, signing it with the Request Authenticator of RFC 2866 section 3
+// and RFC 5176 section 3.5
+func (p *Packet) EncodeRequest(secret []byte) ([]byte, error) {
+	p.Authenticator = [authenticator]byte{}
+	b, err := p.encode()
+	if err != nil {
+		return nil, err
+	}
+	copy(p.Authenticator[:], sign(b, secret))
+	copy(b[4:headerLen], p.Authenticator[:])
+	return b, nil
+}
+
+// EncodeResponse encodes a response to request, signing it with the Response Authenticator
+func (p *Packet) EncodeResponse(request *Packet, secret []byte) ([]byte, error) {
+	p.Identifier = request.Identifier
+	p.Authenticator = request.Authenticator
+	b, err := p.encode()
+	if err != nil {
+		return nil, err
+	}
+	copy(p.Authenticator[:], sign(b, secret))
+	copy(b[4:headerLen], p.Authenticator[:])
+	return b, nil
+}
+
+// Decode parses a packet without checking its authenticator
+func Decode(b []byte) (*Packet, error) {
+	if len(b) < headerLen {
+		return nil, errMalformed
+	}
+	length := int(binary.BigEndian.Uint16(b[2:4]))
+	if length < headerLen || length > len(b) || length > MaxPacketLen {
+		return nil, errMalformed
+	}
+	p := &Packet{Code: Code(b[0]), Identifier: b[1]}
+	copy(p.Authenticator[:], b[4:headerLen])
+	for rest := b[headerLen:length]; len(rest) > 0; {
+		if len(rest) < 2 || int(rest[1]) < 2 || int(rest[1]) > len(rest) {
+			return nil, errMalformed
+		}
+		a := Attribute{Type: rest[0], Value: rest[2:rest[1]]}
+		if a.Type == TypeVendorSpecific && len(a.Value) >= 6 && int(a.Value[5]) == len(a.Value)-4 {
+			a.Vendor = binary.BigEndian.Uint32(a.Value[:4])
+			a.VendorType = a.Value[4]
+			a.Value = a.Value[6:]
+		}
+		p.Attributes = append(p.Attributes, a)
+		rest = rest[rest[1]:]
+	}
+	return p, nil
+}
+
+// VerifyRequest checks the Request Authenticator of an encoded request
+func VerifyRequest(b []byte, secret []byte) bool {
+	if len(b) < headerLen {
+		return false
+	}
+	signed := make([]byte, len(b))
+	copy(signed, b)
+	clear(signed[4:headerLen])
+	return subtle.ConstantTimeCompare(sign(signed, secret), b[4:headerLen]) == 1
+}
+
+// VerifyResponse checks the Response Authenticator of an encoded response to request
+func VerifyResponse(b []byte, request *Packet, secret []byte) bool {
+	if len(b) < headerLen {
+		return false
+	}
+	signed := make([]byte, len(b))
+	copy(signed, b)
+	copy(signed[4:headerLen], request.Authenticator[:])
+	return subtle.ConstantTimeCompare(sign(signed, secret), b[4:headerLen]) == 1
+}
+
+func sign(b []byte, secret []byte) []byte {
+	h := md5.New()
+	h.Write(b)
+	h.Write(secret)
+	return h.Sum(nil)
+}
@@ -0,0 +1,48 @@
+package radius_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/pkg/repos/radius"
+)
+
+const secret = "testing123"
+
+func TestPacket(t *testing.T) {
+	request := &radius.Packet{
+		Code:       radius.CodeCoARequest,
+		Identifier: 7,
+		Attributes: []radius.Attribute{
+			radius.String(radius.TypeUserName, "alice"),
+			radius.Vendor(radius.VendorMikrotik, 8, "10M/10M"),
+		},
+	}
+	b, err := request.EncodeRequest([]byte(secret))
+	require.NoError(t, err)
+	assert.True(t, radius.VerifyRequest(b, []byte(secret)))
+	assert.False(t, radius.VerifyRequest(b, []byte("wrong")))
+
+	decoded, err := radius.Decode(b)
+	require.NoError(t, err)
+	assert.Equal(t, radius.CodeCoARequest, decoded.Code)
+	assert.Equal(t, byte(7), decoded.Identifier)
+	assert.Equal(t, "alice", string(decoded.Attr(radius.TypeUserName).Value))
+	assert.Equal(t, radius.VendorMikrotik, decoded.Attributes[1].Vendor)
+	assert.Equal(t, byte(8), decoded.Attributes[1].VendorType)
+	assert.Equal(t, "10M/10M", string(decoded.Attributes[1].Value))
+
+	reply := &radius.Packet{Code: radius.CodeCoANAK, Attributes: []radius.Attribute{radius.Integer(radius.TypeErrorCause, 503)}}
+	rb, err := reply.EncodeResponse(decoded, []byte(secret))
+	require.NoError(t, err)
+	assert.True(t, radius.VerifyResponse(rb, request, []byte(secret)))
+	assert.False(t, radius.VerifyResponse(rb, request, []byte("wrong")))
+	decodedReply, err := radius.Decode(rb)
+	require.NoError(t, err)
+	assert.Equal(t, 503, decodedReply.ErrorCause())
+
+	_, err = radius.Decode(b[:10])
+	assert.Error(t, err)
+}
@@ -0,0 +1,355 @@
+package radiusrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/radcheck"
+	"github.com/mikestefanello/pagoda/ent/radgroupreply"
+	"github.com/mikestefanello/pagoda/ent/radreply"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+	"github.com/rs/zerolog/log"
+)
+
+// Attributes the portal reads and writes. Check attributes go in radcheck, reply ones in radreply.
+const (
+	AttrCleartextPassword = "Cleartext-Password"
+	AttrExpiration        = "Expiration"
+	AttrSimultaneousUse   = "Simultaneous-Use"
+	AttrFramedIPAddress   = "Framed-IP-Address"
+)
+
+// ExpirationLayout is the format of the radcheck Expiration attribute, e.g. "14 Nov 2025 13:00:22"
+const ExpirationLayout = "02 Jan 2006 15:04:05"
+
+// opSet is the operator the portal writes attributes with, replacing any value FreeRADIUS holds
+const opSet = ":="
+
+// ErrNotSet is returned when a user does not have the attribute asked for
+var ErrNotSet = errors.New("radius attribute not set")
+
+// RadiusRepo reads and writes the FreeRADIUS user tables. Build it on a transaction's client to
+// change them together with billing.
+type RadiusRepo struct {
+	orm *ent.Client
+}
+
+func NewRadiusRepo(orm *ent.Client) *RadiusRepo {
+	return &RadiusRepo{orm: orm}
+}
+
+// Check returns the value of a check attribute of a user
+func (r *RadiusRepo) Check(ctx context.Context, username, attribute string) (string, error) {
+	row, err := r.orm.RadCheck.Query().
+		Where(
+			radcheck.UsernameEQ(username),
+			radcheck.AttributeEQ(attribute),
+		).
+		Order(ent.Asc(radcheck.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", ErrNotSet
+	} else if err != nil {
+		return "", err
+	}
+	return row.Value, nil
+}
+
+// SetCheck sets a check attribute of a user, adding it if they do not have it
+func (r *RadiusRepo) SetCheck(ctx context.Context, username, attribute, value string) error {
+	exists, err := r.orm.RadCheck.Query().
+		Where(
+			radcheck.UsernameEQ(username),
+			radcheck.AttributeEQ(attribute),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return r.orm.RadCheck.Update().
+			Where(
+				radcheck.UsernameEQ(username),
+				radcheck.AttributeEQ(attribute),
+			).
+			SetOp(opSet).
+			SetValue(value).
+			Exec(ctx)
+	}
+	return r.orm.RadCheck.Create().
+		SetUsername(username).
+		SetAttribute(attribute).
+		SetOp(opSet).
+		SetValue(value).
+		Exec(ctx)
+}
+
+// DeleteCheck removes a check attribute of a user
+func (r *RadiusRepo) DeleteCheck(ctx context.Context, username, attribute string) error {
+	_, err := r.orm.RadCheck.Delete().
+		Where(
+			radcheck.UsernameEQ(username),
+			radcheck.AttributeEQ(attribute),
+		).
+		Exec(ctx)
+	return err
+}
+
+// Reply returns the value of a reply attribute of a user
+func (r *RadiusRepo) Reply(ctx context.Context, username, attribute string) (string, error) {
+	row, err := r.orm.RadReply.Query().
+		Where(
+			radreply.UsernameEQ(username),
+			radreply.AttributeEQ(attribute),
+		).
+		Order(ent.Asc(radreply.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", ErrNotSet
+	} else if err != nil {
+		return "", err
+	}
+	return row.Value, nil
+}
+
+// SetReply sets a reply attribute of a user, adding it if they do not have it
+func (r *RadiusRepo) SetReply(ctx context.Context, username, attribute, value string) error {
+	exists, err := r.orm.RadReply.Query().
+		Where(
+			radreply.UsernameEQ(username),
+			radreply.AttributeEQ(attribute),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return r.orm.RadReply.Update().
+			Where(
+				radreply.UsernameEQ(username),
+				radreply.AttributeEQ(attribute),
+			).
+			SetOp(opSet).
+			SetValue(value).
+			Exec(ctx)
+	}
+	return r.orm.RadReply.Create().
+		SetUsername(username).
+		SetAttribute(attribute).
+		SetOp(opSet).
+		SetValue(value).
+		Exec(ctx)
+}
+
+// DeleteReply removes a reply attribute of a user
+func (r *RadiusRepo) DeleteReply(ctx context.Context, username, attribute string) error {
+	_, err := r.orm.RadReply.Delete().
+		Where(
+			radreply.UsernameEQ(username),
+			radreply.AttributeEQ(attribute),
+		).
+		Exec(ctx)
+	return err
+}
+
+// Expiry returns when a user's access expires, nil if it never does
+func (r *RadiusRepo) Expiry(ctx context.Context, username string) (*time.Time, error) {
+	value, err := r.Check(ctx, username, AttrExpiration)
+	if errors.Is(err, ErrNotSet) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	t, err := ParseExpiry(value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// SetExpiry sets when a user's access expires
+func (r *RadiusRepo) SetExpiry(ctx context.Context, username string, expiry time.Time) error {
+	return r.SetCheck(ctx, username, AttrExpiration, FormatExpiry(expiry))
+}
+
+// Expiries returns the expiry of each of the given users whose access expires before the given
+// time. Users without an expiry are left out.
+func (r *RadiusRepo) Expiries(ctx context.Context, usernames []string, before time.Time) (map[string]time.Time, error) {
+	expiries := make(map[string]time.Time)
+	if len(usernames) == 0 {
+		return expiries, nil
+	}
+
+	// Expiration is stored as text, so it can only be compared once parsed
+	rows, err := r.orm.RadCheck.Query().
+		Where(
+			radcheck.UsernameIn(usernames...),
+			radcheck.AttributeEQ(AttrExpiration),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		expiry, err := ParseExpiry(row.Value)
+		if err != nil {
+			log.Warn().Err(err).Str("username", row.Username).Msg("skipping user with unreadable expiration")
+			continue
+		}
+		if expiry.Before(before) {
+			expiries[row.Username] = expiry
+		}
+	}
+	return expiries, nil
+}
+
+// Password returns a user's cleartext password, empty if they have none
+func (r *RadiusRepo) Password(ctx context.Context, username string) (string, error) {
+	value, err := r.Check(ctx, username, AttrCleartextPassword)
+	if errors.Is(err, ErrNotSet) {
+		return "", nil
+	}
+	return value, err
+}
+
+// SetPassword sets a user's cleartext password
+func (r *RadiusRepo) SetPassword(ctx context.Context, username, password string) error {
+	return r.SetCheck(ctx, username, AttrCleartextPassword, password)
+}
+
+// SimultaneousUse returns how many sessions a user may have at once, 0 if there is no limit
+func (r *RadiusRepo) SimultaneousUse(ctx context.Context, username string) (int, error) {
+	value, err := r.Check(ctx, username, AttrSimultaneousUse)
+	if errors.Is(err, ErrNotSet) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid radcheck simultaneous-use %q: %w", value, err)
+	}
+	return n, nil
+}
+
+// SetSimultaneousUse limits how many sessions a user may have at once. 0 removes the limit.
+func (r *RadiusRepo) SetSimultaneousUse(ctx context.Context, username string, sessions int) error {
+	if sessions <= 0 {
+		return r.DeleteCheck(ctx, username, AttrSimultaneousUse)
+	}
+	return r.SetCheck(ctx, username, AttrSimultaneousUse, strconv.Itoa(sessions))
+}
+
+// FramedIP returns the static IP address of a user, the zero Addr if they are given one from a pool
+func (r *RadiusRepo) FramedIP(ctx context.Context, username string) (netip.Addr, error) {
+	value, err := r.Reply(ctx, username, AttrFramedIPAddress)
+	if errors.Is(err, ErrNotSet) {
+		return netip.Addr{}, nil
+	} else if err != nil {
+		return netip.Addr{}, err
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid radreply framed-ip-address %q: %w", value, err)
+	}
+	return addr, nil
+}
+
+// SetFramedIP gives a user a static IP address. The zero Addr puts them back on the pool.
+func (r *RadiusRepo) SetFramedIP(ctx context.Context, username string, addr netip.Addr) error {
+	if !addr.IsValid() {
+		return r.DeleteReply(ctx, username, AttrFramedIPAddress)
+	}
+	return r.SetReply(ctx, username, AttrFramedIPAddress, addr.String())
+}
+
+// Group returns the group of a user, empty if they are in none
+func (r *RadiusRepo) Group(ctx context.Context, username string) (string, error) {
+	row, err := r.orm.RadUserGroup.Query().
+		Where(radusergroup.UsernameEQ(username)).
+		Order(ent.Asc(radusergroup.FieldPriority), ent.Asc(radusergroup.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return row.Groupname, nil
+}
+
+// SetGroup moves a user into a group, which is named after the profile of their package
+func (r *RadiusRepo) SetGroup(ctx context.Context, username, group string) error {
+	exists, err := r.orm.RadUserGroup.Query().
+		Where(radusergroup.UsernameEQ(username)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return r.orm.RadUserGroup.Update().
+			Where(radusergroup.UsernameEQ(username)).
+			SetGroupname(group).
+			Exec(ctx)
+	}
+	return r.orm.RadUserGroup.Create().
+		SetUsername(username).
+		SetGroupname(group).
+		SetPriority(1).
+		Exec(ctx)
+}
+
+// GroupReplies returns the reply attributes every user in a group gets
+func (r *RadiusRepo) GroupReplies(ctx context.Context, group string) ([]*ent.RadGroupReply, error) {
+	return r.orm.RadGroupReply.Query().
+		Where(radgroupreply.GroupnameEQ(group)).
+		Order(ent.Asc(radgroupreply.FieldID)).
+		All(ctx)
+}
+
+// SetGroupReply sets a reply attribute of a group, adding it if the group does not have it
+func (r *RadiusRepo) SetGroupReply(ctx context.Context, group, attribute, value string) error {
+	exists, err := r.orm.RadGroupReply.Query().
+		Where(
+			radgroupreply.GroupnameEQ(group),
+			radgroupreply.AttributeEQ(attribute),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return r.orm.RadGroupReply.Update().
+			Where(
+				radgroupreply.GroupnameEQ(group),
+				radgroupreply.AttributeEQ(attribute),
+			).
+			SetOp(opSet).
+			SetValue(value).
+			Exec(ctx)
+	}
+	return r.orm.RadGroupReply.Create().
+		SetGroupname(group).
+		SetAttribute(attribute).
+		SetOp(opSet).
+		SetValue(value).
+		Exec(ctx)
+}
+
+// ParseExpiry parses a radcheck Expiration value. FreeRADIUS reads it in the server's local time.
+func ParseExpiry(value string) (time.Time, error) {
+	t, err := time.ParseInLocation(ExpirationLayout, value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid radcheck expiration %q: %w", value, err)
+	}
+	return t, nil
+}
+
+// FormatExpiry formats an expiry as a radcheck Expiration value
+func FormatExpiry(expiry time.Time) string {
+	return expiry.In(time.Local).Format(ExpirationLayout)
+}
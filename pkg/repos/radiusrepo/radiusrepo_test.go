@@ -0,0 +1,144 @@
+package radiusrepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/radcheck"
+	"github.com/mikestefanello/pagoda/ent/radusergroup"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func TestParseExpiry(t *testing.T) {
+	expiry, err := radiusrepo.ParseExpiry("14 Nov 2025 13:00:22")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 11, 14, 13, 0, 22, 0, time.Local), expiry)
+
+	_, err = radiusrepo.ParseExpiry("2025-11-14")
+	assert.Error(t, err)
+}
+
+func TestFormatExpiry(t *testing.T) {
+	expiry := time.Date(2025, 11, 4, 9, 5, 2, 0, time.Local)
+	assert.Equal(t, "04 Nov 2025 09:05:02", radiusrepo.FormatExpiry(expiry))
+
+	parsed, err := radiusrepo.ParseExpiry(radiusrepo.FormatExpiry(expiry))
+	assert.NoError(t, err)
+	assert.True(t, expiry.Equal(parsed))
+}
+
+func TestSetExpiry(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	radius := radiusrepo.NewRadiusRepo(client)
+
+	expiry, err := radius.Expiry(ctx, "rad1")
+	require.NoError(t, err)
+	assert.Nil(t, expiry)
+
+	// The first expiry is inserted, later ones replace it
+	first := time.Date(2025, 11, 14, 13, 0, 22, 0, time.Local)
+	require.NoError(t, radius.SetExpiry(ctx, "rad1", first))
+	second := first.AddDate(0, 0, 30)
+	require.NoError(t, radius.SetExpiry(ctx, "rad1", second))
+
+	rows := client.RadCheck.Query().
+		Where(
+			radcheck.UsernameEQ("rad1"),
+			radcheck.AttributeEQ(radiusrepo.AttrExpiration),
+		).
+		AllX(ctx)
+	require.Len(t, rows, 1)
+	assert.Equal(t, ":=", rows[0].Op)
+	assert.Equal(t, "14 Dec 2025 13:00:22", rows[0].Value)
+
+	expiry, err = radius.Expiry(ctx, "rad1")
+	require.NoError(t, err)
+	require.NotNil(t, expiry)
+	assert.True(t, second.Equal(*expiry))
+
+	// Other attributes of the user are left alone
+	require.NoError(t, radius.SetPassword(ctx, "rad1", "secret"))
+	require.NoError(t, radius.SetExpiry(ctx, "rad1", first))
+	password, err := radius.Password(ctx, "rad1")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", password)
+}
+
+func TestSetGroup(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	radius := radiusrepo.NewRadiusRepo(client)
+
+	group, err := radius.Group(ctx, "rad2")
+	require.NoError(t, err)
+	assert.Empty(t, group)
+
+	require.NoError(t, radius.SetGroup(ctx, "rad2", "10mbps"))
+	require.NoError(t, radius.SetGroup(ctx, "rad2", "20mbps"))
+
+	// Moving a user replaces their group rather than adding a second one
+	rows := client.RadUserGroup.Query().
+		Where(radusergroup.UsernameEQ("rad2")).
+		AllX(ctx)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "20mbps", rows[0].Groupname)
+	assert.Equal(t, 1, rows[0].Priority)
+
+	group, err = radius.Group(ctx, "rad2")
+	require.NoError(t, err)
+	assert.Equal(t, "20mbps", group)
+}
+
+func TestGroupReplies(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	radius := radiusrepo.NewRadiusRepo(client)
+
+	replies, err := radius.GroupReplies(ctx, "10mbps")
+	require.NoError(t, err)
+	assert.Empty(t, replies)
+
+	require.NoError(t, radius.SetGroupReply(ctx, "10mbps", "Mikrotik-Rate-Limit", "5M/5M"))
+	require.NoError(t, radius.SetGroupReply(ctx, "10mbps", "Framed-Pool", "main"))
+	require.NoError(t, radius.SetGroupReply(ctx, "10mbps", "Mikrotik-Rate-Limit", "10M/10M"))
+	require.NoError(t, radius.SetGroupReply(ctx, "20mbps", "Mikrotik-Rate-Limit", "20M/20M"))
+
+	replies, err = radius.GroupReplies(ctx, "10mbps")
+	require.NoError(t, err)
+	require.Len(t, replies, 2)
+	assert.Equal(t, "Mikrotik-Rate-Limit", replies[0].Attribute)
+	assert.Equal(t, "10M/10M", replies[0].Value)
+	assert.Equal(t, "Framed-Pool", replies[1].Attribute)
+	assert.Equal(t, "main", replies[1].Value)
+}
+
+func TestExpiries(t *testing.T) {
+	client, ctx := tests.CreateTestContainerMySQLEntClient(t)
+	defer client.Close()
+
+	radius := radiusrepo.NewRadiusRepo(client)
+	now := time.Date(2025, 11, 14, 13, 0, 0, 0, time.Local)
+
+	require.NoError(t, radius.SetExpiry(ctx, "rad3", now.AddDate(0, 0, -1)))
+	require.NoError(t, radius.SetExpiry(ctx, "rad4", now.AddDate(0, 0, 1)))
+	require.NoError(t, radius.SetExpiry(ctx, "rad5", now.AddDate(0, 0, -2)))
+	require.NoError(t, radius.SetCheck(ctx, "rad6", radiusrepo.AttrExpiration, "tomorrow"))
+
+	// Only the users asked for are returned, and only if they expire before the given time
+	expiries, err := radius.Expiries(ctx, []string{"rad3", "rad4", "rad6", "rad7"}, now)
+	require.NoError(t, err)
+	require.Len(t, expiries, 1)
+	assert.True(t, now.AddDate(0, 0, -1).Equal(expiries["rad3"]))
+
+	expiries, err = radius.Expiries(ctx, nil, now)
+	require.NoError(t, err)
+	assert.Empty(t, expiries)
+}
@@ -0,0 +1,501 @@
+// Package usagerepo rolls the accounting records in radacct up into traffic per client per hour
+// and per day, so usage can be read without scanning every session a client ever had.
+package usagerepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/radacct"
+	"github.com/mikestefanello/pagoda/ent/usagecursor"
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
+	"github.com/rs/zerolog/log"
+)
+
+// rollupBatch is how many sessions RollupSince loads at a time
+const rollupBatch = 500
+
+// errConflict is returned when a session was rolled up by someone else in the meantime
+var errConflict = errors.New("session was rolled up concurrently")
+
+// Totals is traffic in octets, as seen by the NAS: input is what the client sent and output what
+// they received
+type Totals struct {
+	Input  int64 `json:"inputOctets"`
+	Output int64 `json:"outputOctets"`
+}
+
+// Total is the traffic both ways
+func (t Totals) Total() int64 {
+	return t.Input + t.Output
+}
+
+// Download is what the client received
+func (t Totals) Download() int64 {
+	return t.Output
+}
+
+// Upload is what the client sent
+func (t Totals) Upload() int64 {
+	return t.Input
+}
+
+func (t Totals) add(o Totals) Totals {
+	return Totals{Input: t.Input + o.Input, Output: t.Output + o.Output}
+}
+
+// Summary is a client's traffic today, this week, this month and since they first connected
+type Summary struct {
+	Today Totals
+	Week  Totals
+	Month Totals
+	All   Totals
+}
+
+// UserTotals is a client's traffic over a period
+type UserTotals struct {
+	Username string `json:"username"`
+	Totals
+}
+
+// Span is traffic in the hour, day, week or month starting at Start
+type Span struct {
+	Start time.Time
+	Totals
+}
+
+// Period is how traffic is grouped in a Series
+type Period string
+
+const (
+	PeriodDay   Period = "day"
+	PeriodWeek  Period = "week"
+	PeriodMonth Period = "month"
+)
+
+type UsageRepo struct {
+	orm *ent.Client
+}
+
+func NewUsageRepo(orm *ent.Client) *UsageRepo {
+	return &UsageRepo{
+		orm: orm,
+	}
+}
+
+// HourStart is the start of the hour of local time t falls in
+func HourStart(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, time.Local)
+}
+
+// DayStart is the local midnight starting the day t falls in
+func DayStart(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// PeriodStart is the local midnight starting the day, the week from Sunday or the month t falls in
+func PeriodStart(t time.Time, p Period) time.Time {
+	day := DayStart(t)
+	switch p {
+	case PeriodWeek:
+		return day.AddDate(0, 0, -int(day.Weekday()))
+	case PeriodMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day
+}
+
+// Split spreads traffic evenly over the time from one accounting record to the next, by hour of
+// local time. The NAS only reports counters, so an even spread is the best guess at when the traffic
+// happened; with interim updates every few minutes it is close. Traffic with no time to spread over
+// goes to the hour of to.
+func Split(from, to time.Time, t Totals) []Span {
+	if !to.After(from) {
+		return []Span{{Start: HourStart(to), Totals: t}}
+	}
+	var (
+		spans    []Span
+		done     Totals
+		duration = float64(to.Sub(from))
+	)
+	// Hours are stepped through in absolute time so the repeated hour of a DST change is kept apart
+	for start := HourStart(from); start.Before(to); start = start.Add(time.Hour) {
+		end := start.Add(time.Hour)
+		share := Totals{Input: t.Input - done.Input, Output: t.Output - done.Output}
+		if end.Before(to) {
+			elapsed := float64(end.Sub(from)) / duration
+			share = Totals{
+				Input:  int64(math.Round(float64(t.Input)*elapsed)) - done.Input,
+				Output: int64(math.Round(float64(t.Output)*elapsed)) - done.Output,
+			}
+		}
+		done = done.add(share)
+		spans = append(spans, Span{Start: start, Totals: share})
+	}
+	return spans
+}
+
+// Rollup adds what a session's counters grew by since it was last rolled up to the client's hourly
+// and daily usage. Rolling up the same record twice adds nothing.
+func (r *UsageRepo) Rollup(ctx context.Context, row *ent.RadAcct) error {
+	err := r.rollup(ctx, row)
+	if errors.Is(err, errConflict) || ent.IsConstraintError(err) {
+		// Rolled up by the worker and the accounting server at once; the other one's work is kept
+		err = r.rollup(ctx, row)
+	}
+	return err
+}
+
+func (r *UsageRepo) rollup(ctx context.Context, row *ent.RadAcct) error {
+	if row.Acctstarttime == nil {
+		return nil
+	}
+	last := *row.Acctstarttime
+	for _, t := range []*time.Time{row.Acctupdatetime, row.Acctstoptime} {
+		if t != nil && t.After(last) {
+			last = *t
+		}
+	}
+	counters := Totals{Input: deref(row.Acctinputoctets), Output: deref(row.Acctoutputoctets)}
+
+	tx, err := r.orm.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	cursor, err := tx.UsageCursor.Query().
+		Where(usagecursor.AcctuniqueidEQ(row.Acctuniqueid)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	from, rolled := *row.Acctstarttime, Totals{}
+	if cursor != nil {
+		from, rolled = cursor.RolledAt, Totals{Input: cursor.InputOctets, Output: cursor.OutputOctets}
+	}
+	// Counters that went back, e.g. a record resent late, add nothing
+	delta := Totals{Input: max(counters.Input-rolled.Input, 0), Output: max(counters.Output-rolled.Output, 0)}
+	if delta == (Totals{}) && !last.After(from) {
+		return nil
+	}
+
+	days := make(map[time.Time]Totals)
+	for _, span := range Split(from, last, delta) {
+		if span.Totals == (Totals{}) {
+			continue
+		}
+		if err := addHourly(ctx, tx, row.Username, span.Start, span.Totals); err != nil {
+			return err
+		}
+		day := DayStart(span.Start)
+		days[day] = days[day].add(span.Totals)
+	}
+	for day, t := range days {
+		if err := addDaily(ctx, tx, row.Username, day, t); err != nil {
+			return err
+		}
+	}
+
+	rolled = Totals{Input: max(counters.Input, rolled.Input), Output: max(counters.Output, rolled.Output)}
+	rolledAt := from
+	if last.After(from) {
+		rolledAt = last
+	}
+	if cursor == nil {
+		err = tx.UsageCursor.Create().
+			SetAcctuniqueid(row.Acctuniqueid).
+			SetUsername(row.Username).
+			SetInputOctets(rolled.Input).
+			SetOutputOctets(rolled.Output).
+			SetRolledAt(rolledAt).
+			Exec(ctx)
+	} else {
+		// Only moved on from where it was read, so a concurrent roll-up is not counted twice
+		var n int
+		n, err = tx.UsageCursor.Update().
+			Where(
+				usagecursor.ID(cursor.ID),
+				usagecursor.InputOctetsEQ(cursor.InputOctets),
+				usagecursor.OutputOctetsEQ(cursor.OutputOctets),
+				usagecursor.RolledAtEQ(cursor.RolledAt),
+			).
+			SetInputOctets(rolled.Input).
+			SetOutputOctets(rolled.Output).
+			SetRolledAt(rolledAt).
+			Save(ctx)
+		if err == nil && n == 0 {
+			err = errConflict
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func addHourly(ctx context.Context, tx *ent.Tx, username string, hour time.Time, t Totals) error {
+	id, err := tx.UsageHourly.Query().
+		Where(
+			usagehourly.UsernameEQ(username),
+			usagehourly.HourEQ(hour),
+		).
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return tx.UsageHourly.Create().
+			SetUsername(username).
+			SetHour(hour).
+			SetInputOctets(t.Input).
+			SetOutputOctets(t.Output).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.UsageHourly.UpdateOneID(id).
+		AddInputOctets(t.Input).
+		AddOutputOctets(t.Output).
+		Exec(ctx)
+}
+
+func addDaily(ctx context.Context, tx *ent.Tx, username string, day time.Time, t Totals) error {
+	id, err := tx.UsageDaily.Query().
+		Where(
+			usagedaily.UsernameEQ(username),
+			usagedaily.DayEQ(day),
+		).
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		return tx.UsageDaily.Create().
+			SetUsername(username).
+			SetDay(day).
+			SetInputOctets(t.Input).
+			SetOutputOctets(t.Output).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	return tx.UsageDaily.UpdateOneID(id).
+		AddInputOctets(t.Input).
+		AddOutputOctets(t.Output).
+		Exec(ctx)
+}
+
+// RollupSince rolls up every session that is still open or had a record since the given time, and
+// returns how many there were. Sessions that fail are logged and the rest still rolled up.
+func (r *UsageRepo) RollupSince(ctx context.Context, since time.Time) (int, error) {
+	var (
+		count  int
+		errs   []error
+		lastID int64
+	)
+	for {
+		rows, err := r.orm.RadAcct.Query().
+			Where(
+				radacct.IDGT(lastID),
+				radacct.Or(
+					radacct.AcctstoptimeIsNil(),
+					radacct.AcctstoptimeGTE(since),
+					radacct.AcctupdatetimeGTE(since),
+				),
+			).
+			Order(ent.Asc(radacct.FieldID)).
+			Limit(rollupBatch).
+			All(ctx)
+		if err != nil {
+			return count, err
+		}
+		for _, row := range rows {
+			if err := r.Rollup(ctx, row); err != nil {
+				log.Warn().Err(err).Str("acctuniqueid", row.Acctuniqueid).Msg("could not roll up session usage")
+				errs = append(errs, fmt.Errorf("session %s: %w", row.Acctuniqueid, err))
+				continue
+			}
+			count++
+		}
+		if len(rows) < rollupBatch {
+			return count, errors.Join(errs...)
+		}
+		lastID = rows[len(rows)-1].ID
+	}
+}
+
+// Summary is a client's traffic today, this week from Sunday, this month and in total
+func (r *UsageRepo) Summary(ctx context.Context, username string, now time.Time) (Summary, error) {
+	var s Summary
+	today := DayStart(now)
+	week := PeriodStart(now, PeriodWeek)
+	month := PeriodStart(now, PeriodMonth)
+
+	from := week
+	if month.Before(from) {
+		from = month
+	}
+	days, err := r.Daily(ctx, username, from, today.AddDate(0, 0, 1))
+	if err != nil {
+		return s, err
+	}
+	for _, d := range days {
+		t := Totals{Input: d.InputOctets, Output: d.OutputOctets}
+		if !d.Day.Before(today) {
+			s.Today = s.Today.add(t)
+		}
+		if !d.Day.Before(week) {
+			s.Week = s.Week.add(t)
+		}
+		if !d.Day.Before(month) {
+			s.Month = s.Month.add(t)
+		}
+	}
+
+	var all sums
+	err = r.orm.UsageDaily.Query().
+		Where(usagedaily.UsernameEQ(username)).
+		Aggregate(
+			ent.As(ent.Sum(usagedaily.FieldInputOctets), "input"),
+			ent.As(ent.Sum(usagedaily.FieldOutputOctets), "output"),
+		).
+		Scan(ctx, &all)
+	s.All = all.totals()
+	return s, err
+}
+
+// Series is a client's traffic in each of the last n days, weeks or months up to now, oldest first.
+// Periods without traffic are included, so the series has n spans.
+func (r *UsageRepo) Series(ctx context.Context, username string, p Period, n int, now time.Time) ([]Span, error) {
+	if n < 1 {
+		return nil, nil
+	}
+	spans := make([]Span, n)
+	index := make(map[time.Time]int, n)
+	start := PeriodStart(now, p)
+	for i := n - 1; i >= 0; i-- {
+		spans[i].Start = start
+		index[start] = i
+		start = PeriodStart(start.AddDate(0, 0, -1), p)
+	}
+
+	days, err := r.Daily(ctx, username, spans[0].Start, DayStart(now).AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range days {
+		if i, ok := index[PeriodStart(d.Day, p)]; ok {
+			spans[i].Totals = spans[i].add(Totals{Input: d.InputOctets, Output: d.OutputOctets})
+		}
+	}
+	return spans, nil
+}
+
+// Usage is a client's traffic from the start of from's hour until to, e.g. over a billing cycle
+// for a data quota
+func (r *UsageRepo) Usage(ctx context.Context, username string, from, to time.Time) (Totals, error) {
+	var usage sums
+	err := r.orm.UsageHourly.Query().
+		Where(
+			usagehourly.UsernameEQ(username),
+			usagehourly.HourGTE(HourStart(from)),
+			usagehourly.HourLT(to),
+		).
+		Aggregate(
+			ent.As(ent.Sum(usagehourly.FieldInputOctets), "input"),
+			ent.As(ent.Sum(usagehourly.FieldOutputOctets), "output"),
+		).
+		Scan(ctx, &usage)
+	return usage.totals(), err
+}
+
+// sums scans the sums of the octets columns, which are NULL when no rows match
+type sums []struct {
+	Input  sql.NullInt64 `json:"input"`
+	Output sql.NullInt64 `json:"output"`
+}
+
+func (s sums) totals() Totals {
+	if len(s) == 0 {
+		return Totals{}
+	}
+	return Totals{Input: s[0].Input.Int64, Output: s[0].Output.Int64}
+}
+
+// Daily is a client's traffic per day from the day of from until to, oldest first. Days without
+// traffic are left out.
+func (r *UsageRepo) Daily(ctx context.Context, username string, from, to time.Time) ([]*ent.UsageDaily, error) {
+	return r.orm.UsageDaily.Query().
+		Where(
+			usagedaily.UsernameEQ(username),
+			usagedaily.DayGTE(DayStart(from)),
+			usagedaily.DayLT(to),
+		).
+		Order(ent.Asc(usagedaily.FieldDay)).
+		All(ctx)
+}
+
+// Hourly is a client's traffic per hour from the hour of from until to, oldest first. Hours
+// without traffic are left out.
+func (r *UsageRepo) Hourly(ctx context.Context, username string, from, to time.Time) ([]*ent.UsageHourly, error) {
+	return r.orm.UsageHourly.Query().
+		Where(
+			usagehourly.UsernameEQ(username),
+			usagehourly.HourGTE(HourStart(from)),
+			usagehourly.HourLT(to),
+		).
+		Order(ent.Asc(usagehourly.FieldHour)).
+		All(ctx)
+}
+
+// Top is the clients with the most traffic from the day of from until to, heaviest first, at most
+// limit of them or all with 0
+func (r *UsageRepo) Top(ctx context.Context, from, to time.Time, limit int) ([]UserTotals, error) {
+	var rows []struct {
+		Username string `json:"username"`
+		Input    int64  `json:"input"`
+		Output   int64  `json:"output"`
+	}
+	err := r.orm.UsageDaily.Query().
+		Where(
+			usagedaily.DayGTE(DayStart(from)),
+			usagedaily.DayLT(to),
+		).
+		GroupBy(usagedaily.FieldUsername).
+		Aggregate(
+			ent.As(ent.Sum(usagedaily.FieldInputOctets), "input"),
+			ent.As(ent.Sum(usagedaily.FieldOutputOctets), "output"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	top := make([]UserTotals, 0, len(rows))
+	for _, row := range rows {
+		top = append(top, UserTotals{Username: row.Username, Totals: Totals{Input: row.Input, Output: row.Output}})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Total() != top[j].Total() {
+			return top[i].Total() > top[j].Total()
+		}
+		return top[i].Username < top[j].Username
+	})
+	if limit > 0 && len(top) > limit {
+		top = top[:limit]
+	}
+	return top, nil
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
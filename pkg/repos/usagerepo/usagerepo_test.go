@@ -0,0 +1,124 @@
+package usagerepo_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mikestefanello/pagoda/ent/usagedaily"
+	"github.com/mikestefanello/pagoda/ent/usagehourly"
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
+	"github.com/mikestefanello/pagoda/pkg/tests"
+)
+
+func init() {
+	// Register "pgx" as "postgres" explicitly for database/sql
+	sql.Register("postgres", stdlib.GetDefaultDriver())
+}
+
+func TestSplit(t *testing.T) {
+	from := time.Date(2026, 3, 9, 23, 30, 0, 0, time.Local)
+	spans := usagerepo.Split(from, from.Add(2*time.Hour), usagerepo.Totals{Input: 1000, Output: 4001})
+	require.Len(t, spans, 3)
+	assert.Equal(t, time.Date(2026, 3, 9, 23, 0, 0, 0, time.Local), spans[0].Start)
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.Local), spans[1].Start)
+	assert.Equal(t, usagerepo.Totals{Input: 250, Output: 1000}, spans[0].Totals)
+	assert.Equal(t, usagerepo.Totals{Input: 500, Output: 2001}, spans[1].Totals)
+	assert.Equal(t, usagerepo.Totals{Input: 250, Output: 1000}, spans[2].Totals)
+
+	// Nothing is lost to rounding
+	spans = usagerepo.Split(from, from.Add(7*time.Hour+13*time.Minute), usagerepo.Totals{Input: 999_999_937, Output: 7})
+	var sum usagerepo.Totals
+	for _, s := range spans {
+		sum.Input += s.Input
+		sum.Output += s.Output
+	}
+	assert.Equal(t, usagerepo.Totals{Input: 999_999_937, Output: 7}, sum)
+
+	spans = usagerepo.Split(from, from, usagerepo.Totals{Input: 5})
+	require.Len(t, spans, 1)
+	assert.Equal(t, usagerepo.HourStart(from), spans[0].Start)
+}
+
+func TestPeriodStart(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2026, 3, 11, 15, 4, 5, 0, time.Local)
+	assert.Equal(t, time.Date(2026, 3, 11, 0, 0, 0, 0, time.Local), usagerepo.PeriodStart(now, usagerepo.PeriodDay))
+	assert.Equal(t, time.Date(2026, 3, 8, 0, 0, 0, 0, time.Local), usagerepo.PeriodStart(now, usagerepo.PeriodWeek))
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local), usagerepo.PeriodStart(now, usagerepo.PeriodMonth))
+}
+
+func TestRollup(t *testing.T) {
+	client, ctx := tests.CreateTestContainerPostgresEntClient(t)
+	defer client.Close()
+	usageRepo := usagerepo.NewUsageRepo(client)
+
+	start := time.Date(2026, 3, 9, 23, 0, 0, 0, time.Local)
+	row := client.RadAcct.Create().
+		SetAcctsessionid("81a00001").
+		SetAcctuniqueid("0123456789abcdef0123456789abcdef").
+		SetUsername("alice").
+		SetAcctstarttime(start).
+		SetAcctupdatetime(start.Add(30 * time.Minute)).
+		SetAcctinputoctets(600).
+		SetAcctoutputoctets(3000).
+		SetFramedipaddress("100.64.0.9").
+		SetAcctterminatecause("").
+		SaveX(ctx)
+	require.NoError(t, usageRepo.Rollup(ctx, row))
+	// Rolling up the same record again adds nothing
+	require.NoError(t, usageRepo.Rollup(ctx, row))
+
+	// The session runs past midnight and stops an hour later
+	row = row.Update().
+		SetAcctstoptime(start.Add(90 * time.Minute)).
+		SetAcctinputoctets(1800).
+		SetAcctoutputoctets(9000).
+		SaveX(ctx)
+	count, err := usageRepo.RollupSince(ctx, start)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	hours := client.UsageHourly.Query().Order(usagehourly.ByHour()).AllX(ctx)
+	require.Len(t, hours, 2)
+	assert.Equal(t, int64(1200), hours[0].InputOctets)
+	assert.Equal(t, int64(600), hours[1].InputOctets)
+	days := client.UsageDaily.Query().Order(usagedaily.ByDay()).AllX(ctx)
+	require.Len(t, days, 2)
+	assert.Equal(t, int64(6000), days[0].OutputOctets)
+	assert.Equal(t, int64(3000), days[1].OutputOctets)
+
+	summary, err := usageRepo.Summary(ctx, "alice", start.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, usagerepo.Totals{Input: 600, Output: 3000}, summary.Today)
+	assert.Equal(t, usagerepo.Totals{Input: 1800, Output: 9000}, summary.All)
+
+	usage, err := usageRepo.Usage(ctx, "alice", start.Add(time.Hour), start.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3600), usage.Total())
+
+	// Download is what the client received, the NAS's output
+	series, err := usageRepo.Series(ctx, "alice", usagerepo.PeriodDay, 3, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, series, 3)
+	assert.Equal(t, usagerepo.DayStart(start).AddDate(0, 0, -1), series[0].Start)
+	assert.Zero(t, series[0].Total())
+	assert.Equal(t, int64(6000), series[1].Download())
+	assert.Equal(t, int64(1200), series[1].Upload())
+	assert.Equal(t, int64(3000), series[2].Download())
+
+	series, err = usageRepo.Series(ctx, "alice", usagerepo.PeriodMonth, 2, start.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.Local), series[0].Start)
+	assert.Equal(t, int64(10800), series[1].Total())
+
+	top, err := usageRepo.Top(ctx, start, start.AddDate(0, 0, 2), 10)
+	require.NoError(t, err)
+	require.Len(t, top, 1)
+	assert.Equal(t, int64(10800), top[0].Total())
+}
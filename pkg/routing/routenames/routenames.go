@@ -53,10 +53,42 @@ const (
 	RouteNamePaymentProcessorSuccess      = "stripe.success"
 
 	// ISP Features
-	RouteNameTicketCreate    = "ticket.create"
-	RouteNameTicketSubmit    = "ticket.submit"
-	RouteNameRenewPackage    = "package.renew"
-	RouteNameChangePlan      = "package.change"
-	RouteNameAddFunds        = "balance.add"
-	RouteNameToggleAutoRenew = "package.autorenew"
+	RouteNameTicketCreate     = "ticket.create"
+	RouteNameTicketSubmit     = "ticket.submit"
+	RouteNameRenewPackage     = "package.renew"
+	RouteNameAdvancePayment   = "package.prepay"
+	RouteNameChangePlan       = "package.change"
+	RouteNameChangePlanSubmit = "package.change.submit"
+	RouteNameAddFunds         = "balance.add"
+	RouteNameRedeemVoucher    = "balance.voucher"
+	RouteNameToggleAutoRenew  = "package.autorenew"
+
+	RouteNameTopUpResult            = "balance.add.result"
+	RouteNamePaymentGatewayCallback = "payment_gateway.callback"
+	RouteNamePaymentGatewayIPN      = "payment_gateway.ipn"
+
+	RouteNameTxnHistory     = "transactions"
+	RouteNameTxnExport      = "transactions.export"
+	RouteNameTxnReceipt     = "transactions.receipt"
+	RouteNameMonthlyInvoice = "invoices.monthly"
+
+	RouteNameTransfer        = "balance.transfer"
+	RouteNameTransferSubmit  = "balance.transfer.submit"
+	RouteNameTransferConfirm = "balance.transfer.confirm"
+
+	RouteNameUsageChart   = "usage.chart"
+	RouteNameUsageHeatmap = "usage.heatmap"
+
+	RouteNameRefunds      = "refunds"
+	RouteNameRefundSubmit = "refunds.submit"
+
+	RouteNameManualPayment       = "balance.manual"
+	RouteNameManualPaymentSubmit = "balance.manual.submit"
+
+	RouteNamePayForClient         = "pay"
+	RouteNamePayForClientLookup   = "pay.lookup"
+	RouteNamePayForClientPayee    = "pay.payee"
+	RouteNamePayForClientCheckout = "pay.checkout"
+	RouteNamePayForClientQR       = "pay.qr"
+	RouteNamePayForClientResult   = "pay.result"
 )
@@ -1,19 +1,38 @@
 package routes
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
 	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/config"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
 	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/htmx"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/repos/paymentgateway"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
 	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/rs/zerolog/log"
 )
 
 type ispRoutes struct {
-	ctr controller.Controller
+	ctr         controller.Controller
+	billingRepo *billingrepo.BillingRepo
 }
 
-func NewISPRoutes(ctr controller.Controller) *ispRoutes {
+func NewISPRoutes(ctr controller.Controller, billingRepo *billingrepo.BillingRepo) *ispRoutes {
 	return &ispRoutes{
-		ctr: ctr,
+		ctr:         ctr,
+		billingRepo: billingRepo,
 	}
 }
 
@@ -61,19 +80,372 @@ func (c *ispRoutes) CreateTicket(ctx echo.Context) error {
 	return c.ctr.Redirect(ctx, "profile")
 }
 
+// AddFunds starts a balance recharge and sends the client to the chosen payment gateway.
+// The balance is credited when the gateway calls back, see paymentGatewaysRoute.
 func (c *ispRoutes) AddFunds(ctx echo.Context) error {
-	// Implement balance load logic
-	return c.ctr.Redirect(ctx, "profile")
+	var form types.TopUpForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	if form.Submission.HasErrors() {
+		msg.Danger(ctx, "Please enter an amount and choose a payment method.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+	}
+
+	billing := c.ctr.Container.Config.Billing
+	if form.Amount < billing.MinTopUp || (billing.MaxTopUp > 0 && form.Amount > billing.MaxTopUp) {
+		msg.Danger(ctx, fmt.Sprintf("Recharge amount must be %s.", topUpRange(billing)))
+		return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+	}
+
+	gateway, err := c.ctr.Container.PaymentGateways.Get(form.Gateway)
+	if err != nil {
+		msg.Danger(ctx, "The selected payment method is not available.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+	}
+
+	client, err := c.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	txn, err := c.billingRepo.StartTopUp(ctx.Request().Context(), client, form.Amount, gateway.Method())
+	if err != nil {
+		return c.ctr.Fail(err, "failed to start recharge")
+	}
+
+	domain := c.ctr.Container.Config.HTTP.Domain
+	checkout, err := gateway.StartCheckout(ctx.Request().Context(), paymentgateway.CheckoutRequest{
+		Ref:      txn.TransactionRef,
+		Amount:   txn.Amount,
+		Currency: billing.Currency,
+		Customer: paymentgateway.Customer{
+			Username: client.Username,
+			Name:     client.Name,
+			Email:    client.Email,
+			Phone:    client.MobileNumber,
+			Address:  client.AddressLine1,
+			City:     client.City,
+		},
+		CallbackURL: domain + ctx.Echo().Reverse(routeNames.RouteNamePaymentGatewayCallback, gateway.Name()),
+		IPNURL:      domain + ctx.Echo().Reverse(routeNames.RouteNamePaymentGatewayIPN, gateway.Name()),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("gateway", gateway.Name()).Str("ref", txn.TransactionRef).
+			Msg("failed to start gateway checkout")
+		if err := c.billingRepo.FailTopUp(ctx.Request().Context(), txn.TransactionRef, ""); err != nil {
+			log.Error().Err(err).Str("ref", txn.TransactionRef).Msg("failed to mark recharge as failed")
+		}
+		msg.Danger(ctx, fmt.Sprintf("%s is not reachable right now. Please try again later.", gateway.Label()))
+		return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+	}
+
+	return redirectExternal(ctx, checkout.RedirectURL)
 }
 
+// TopUpResult is where clients land after paying at a gateway. The callback has already settled
+// the transaction, so this only reports its outcome.
+func (c *ispRoutes) TopUpResult(ctx echo.Context) error {
+	client, err := c.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	txn, err := c.billingRepo.GetClientTxn(ctx.Request().Context(), client.Username, ctx.QueryParam("ref"))
+	if err != nil {
+		msg.Danger(ctx, "We could not find that payment. If you were charged, please open a support ticket.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+	}
+
+	switch txn.Status {
+	case clienttxn.StatusCompleted:
+		msg.Success(ctx, fmt.Sprintf("%.2f %s was added to your balance.", txn.Amount, c.ctr.Container.Config.Billing.Currency))
+	case clienttxn.StatusPending:
+		msg.Info(ctx, "Your payment is still being processed. Your balance will update once it is confirmed.")
+	default:
+		msg.Danger(ctx, "Your payment was not completed and you have not been charged.")
+	}
+	return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+}
+
+// RenewPackage renews the client's current package for one billing cycle, paid from their balance
 func (c *ispRoutes) RenewPackage(ctx echo.Context) error {
-	// Implement package renewal logic
-	return c.ctr.Redirect(ctx, "profile")
+	var form types.RenewPackageForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	client, err := c.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	// The renewal only goes ahead if the expiry is still the one the client saw, so a double
+	// submit or an auto-renewal that got there first never charges twice
+	var expected *time.Time
+	if form.ExpectedExpiry > 0 {
+		t := time.Unix(form.ExpectedExpiry, 0)
+		expected = &t
+	}
+	renewal, err := c.billingRepo.RenewPackage(ctx.Request().Context(), client.ID, billingrepo.RenewOptions{
+		Type:           clienttxn.TypeRENEWAL,
+		CreatedBy:      client.Username,
+		VerifyExpiry:   true,
+		ExpectedExpiry: expected,
+		CouponCode:     form.Coupon,
+	})
+
+	switch {
+	case err == nil && renewal.Txn.Discount > 0:
+		msg.Success(ctx, fmt.Sprintf("%s renewed with %.2f %s off. Your connection is active until %s.",
+			renewal.Package.Name, renewal.Txn.Discount, c.ctr.Container.Config.Billing.Currency,
+			renewal.Expiry.Format("02 Jan 2006 03:04 PM")))
+	case err == nil:
+		msg.Success(ctx, fmt.Sprintf("%s renewed. Your connection is active until %s.",
+			renewal.Package.Name, renewal.Expiry.Format("02 Jan 2006 03:04 PM")))
+	case couponMessage(err) != "":
+		msg.Danger(ctx, couponMessage(err))
+	case errors.Is(err, billingrepo.ErrInsufficientBalance):
+		msg.Danger(ctx, "Your balance is too low to renew. Please recharge your account first.")
+	case errors.Is(err, billingrepo.ErrAlreadyRenewed):
+		msg.Info(ctx, "Your package has already been renewed for this cycle.")
+	case errors.Is(err, billingrepo.ErrNoPackage):
+		msg.Danger(ctx, "You have no package to renew. Please contact support.")
+	case errors.Is(err, billingrepo.ErrPostpaid):
+		msg.Info(ctx, "Your account is billed monthly, so your package does not need renewing.")
+	default:
+		return c.ctr.Fail(err, "failed to renew package")
+	}
+	return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
 }
 
+// PayInAdvance renews the client's package for one of the configured bundles of several cycles,
+// paid from their balance at the bundle's discount
+func (c *ispRoutes) PayInAdvance(ctx echo.Context) error {
+	var form types.AdvancePaymentForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	var bundle *billingrepo.AdvanceBundle
+	for _, b := range c.ctr.Container.Config.Billing.AdvancePayment.Bundles {
+		if b.Cycles == form.Cycles {
+			bundle = &billingrepo.AdvanceBundle{Cycles: b.Cycles, Discount: b.Discount}
+		}
+	}
+	if form.Submission.HasErrors() || bundle == nil {
+		msg.Danger(ctx, "Please choose one of the advance payment offers.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+	}
+
+	client, err := c.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	var expected *time.Time
+	if form.ExpectedExpiry > 0 {
+		t := time.Unix(form.ExpectedExpiry, 0)
+		expected = &t
+	}
+	renewal, err := c.billingRepo.RenewPackage(ctx.Request().Context(), client.ID, billingrepo.RenewOptions{
+		Type:           clienttxn.TypeADVANCE_PAYMENT,
+		CreatedBy:      client.Username,
+		VerifyExpiry:   true,
+		ExpectedExpiry: expected,
+		Bundle:         bundle,
+	})
+
+	switch {
+	case err == nil:
+		msg.Success(ctx, fmt.Sprintf("%s prepaid for %d cycles. Your connection is active until %s.",
+			renewal.Package.Name, renewal.Cycles, renewal.Expiry.Format("02 Jan 2006 03:04 PM")))
+	case errors.Is(err, billingrepo.ErrInsufficientBalance):
+		msg.Danger(ctx, "Your balance is too low for this advance payment. Please recharge your account first.")
+	case errors.Is(err, billingrepo.ErrAlreadyRenewed):
+		msg.Info(ctx, "Your package was renewed in the meantime. Please review the offer again.")
+	case errors.Is(err, billingrepo.ErrNoPackage):
+		msg.Danger(ctx, "You have no package to renew. Please contact support.")
+	case errors.Is(err, billingrepo.ErrPostpaid):
+		msg.Info(ctx, "Your account is billed monthly, so it cannot be paid in advance.")
+	default:
+		return c.ctr.Fail(err, "failed to make advance payment")
+	}
+	return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+}
+
+// ChangePlan shows the packages a client can switch to. Selecting one previews the price of
+// switching right away next to scheduling it for the next cycle.
 func (c *ispRoutes) ChangePlan(ctx echo.Context) error {
-	// Implement plan change logic
-	return c.ctr.Redirect(ctx, "profile")
+	client, err := c.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	reqCtx := ctx.Request().Context()
+	data := &types.ISPChangePlanData{
+		Client: client,
+	}
+
+	data.Plans, err = c.billingRepo.ActivePlans(reqCtx)
+	if err != nil {
+		return c.ctr.Fail(err, "failed to load packages")
+	}
+	for _, plan := range data.Plans {
+		if plan.ProfileName == client.UserProfile {
+			data.CurrentPackage = plan
+		}
+		if client.NextUserProfile != "" && plan.ProfileName == client.NextUserProfile {
+			data.NextPackage = plan
+		}
+	}
+
+	if planID, err := strconv.Atoi(ctx.QueryParam("plan")); err == nil {
+		data.Coupon = ctx.QueryParam("coupon")
+		quote, err := c.billingRepo.QuotePlanChange(reqCtx, client, planID, data.Coupon)
+		if message := couponMessage(err); message != "" {
+			// Show the price without the coupon and why it could not be applied
+			msg.Danger(ctx, message)
+			quote, err = c.billingRepo.QuotePlanChange(reqCtx, client, planID, "")
+		}
+		switch {
+		case err == nil:
+			data.Selected = quote.To
+			data.ValidUntil = quote.Expiry
+			data.Preview = &types.ISPPlanChangePreview{
+				DaysLeft:     quote.DaysLeft,
+				Credit:       quote.Credit,
+				Charge:       quote.Charge,
+				Discount:     quote.Discount,
+				Tax:          quote.Tax.Tax,
+				TaxLabel:     fmt.Sprintf("%s %g%%", c.billingRepo.Tax().Name, quote.Tax.Rate),
+				TaxInclusive: quote.Tax.Inclusive,
+				Amount:       quote.Amount,
+				CanAfford:    quote.Amount <= billingrepo.AvailableCredit(client),
+			}
+			if quote.Coupon != nil {
+				data.Preview.CouponCode = quote.Coupon.Code
+			}
+		case errors.Is(err, billingrepo.ErrPlanNotFound), errors.Is(err, billingrepo.ErrSamePlan):
+			msg.Info(ctx, "Please choose another package.")
+		case errors.Is(err, billingrepo.ErrNoPackage):
+			msg.Danger(ctx, "You have no package to change. Please contact support.")
+		case errors.Is(err, billingrepo.ErrInGracePeriod):
+			msg.Info(ctx, "Your package has expired. Renew it first, or schedule the change for your next renewal.")
+		default:
+			return c.ctr.Fail(err, "failed to price package change")
+		}
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageChangePlan
+	page.Data = data
+	page.Component = pages.ChangePlan(&page, data)
+	page.HTMX.Request.Boosted = true
+	page.SelectedBottomNavbarItem = domain.BottomNavbarItemProfile
+	page.ShowBottomNavbar = true
+
+	return c.ctr.RenderPage(ctx, page)
+}
+
+// SubmitChangePlan applies a package change now or schedules it for the next cycle
+func (c *ispRoutes) SubmitChangePlan(ctx echo.Context) error {
+	var form types.ChangePlanForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	if form.Submission.HasErrors() {
+		msg.Danger(ctx, "Please choose a package and when it should start.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameChangePlan)
+	}
+
+	client, err := c.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	reqCtx := ctx.Request().Context()
+	if form.Mode == "next" {
+		plan, err := c.billingRepo.SchedulePlanChange(reqCtx, client.ID, form.PlanID, client.Username)
+		switch {
+		case err == nil && plan.ProfileName == client.UserProfile:
+			msg.Info(ctx, "Your scheduled package change was cancelled.")
+		case err == nil:
+			msg.Success(ctx, fmt.Sprintf("You will switch to %s at your next renewal.", plan.Name))
+		case errors.Is(err, billingrepo.ErrPlanNotFound):
+			msg.Danger(ctx, "That package is no longer available.")
+			return c.ctr.Redirect(ctx, routeNames.RouteNameChangePlan)
+		default:
+			return c.ctr.Fail(err, "failed to schedule package change")
+		}
+		return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+	}
+
+	quote, _, err := c.billingRepo.ChangePlanNow(reqCtx, client.ID, form.PlanID, form.Amount, form.Coupon, client.Username)
+	switch {
+	case err == nil:
+		msg.Success(ctx, fmt.Sprintf("You are now on %s.", quote.To.Name))
+	case couponMessage(err) != "":
+		msg.Danger(ctx, couponMessage(err))
+		return c.ctr.RedirectWithDetails(ctx, routeNames.RouteNameChangePlan,
+			fmt.Sprintf("?plan=%d", form.PlanID), http.StatusFound)
+	case errors.Is(err, billingrepo.ErrInsufficientBalance):
+		msg.Danger(ctx, "Your balance is too low for this change. Please recharge your account first.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameChangePlan)
+	case errors.Is(err, billingrepo.ErrPlanChanged):
+		msg.Info(ctx, "The price of this change has been updated. Please review it again.")
+		return c.ctr.RedirectWithDetails(ctx, routeNames.RouteNameChangePlan,
+			fmt.Sprintf("?plan=%d", form.PlanID), http.StatusFound)
+	case errors.Is(err, billingrepo.ErrSamePlan):
+		msg.Info(ctx, "You are already on this package.")
+	case errors.Is(err, billingrepo.ErrPlanNotFound):
+		msg.Danger(ctx, "That package is no longer available.")
+		return c.ctr.Redirect(ctx, routeNames.RouteNameChangePlan)
+	case errors.Is(err, billingrepo.ErrNoPackage):
+		msg.Danger(ctx, "You have no package to change. Please contact support.")
+	case errors.Is(err, billingrepo.ErrInGracePeriod):
+		msg.Info(ctx, "Your package has expired. Renew it first, or schedule the change for your next renewal.")
+	default:
+		return c.ctr.Fail(err, "failed to change package")
+	}
+	return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
 }
 
 func (c *ispRoutes) ToggleAutoRenew(ctx echo.Context) error {
@@ -102,3 +474,39 @@ func (c *ispRoutes) ToggleAutoRenew(ctx echo.Context) error {
 
 	return c.ctr.Redirect(ctx, "profile")
 }
+
+// couponMessage explains to the client why their coupon could not be used, or returns an empty
+// string if err is not about the coupon
+func couponMessage(err error) string {
+	switch {
+	case errors.Is(err, billingrepo.ErrCouponNotFound):
+		return "That coupon code is not valid."
+	case errors.Is(err, billingrepo.ErrCouponExpired):
+		return "That coupon is not valid at this time."
+	case errors.Is(err, billingrepo.ErrCouponNotEligible):
+		return "That coupon does not apply to this package."
+	case errors.Is(err, billingrepo.ErrCouponUsedUp):
+		return "That coupon has already been used up."
+	default:
+		return ""
+	}
+}
+
+// topUpRange describes the amounts a payment may be, leaving out the upper bound when there is none
+func topUpRange(billing config.BillingConfig) string {
+	if billing.MaxTopUp > 0 {
+		return fmt.Sprintf("between %.0f and %.0f %s", billing.MinTopUp, billing.MaxTopUp, billing.Currency)
+	}
+	return fmt.Sprintf("at least %.0f %s", billing.MinTopUp, billing.Currency)
+}
+
+// redirectExternal sends the client to a URL outside the app, such as a payment gateway
+func redirectExternal(ctx echo.Context, url string) error {
+	if htmx.GetRequest(ctx).Enabled {
+		htmx.Response{
+			Redirect: url,
+		}.Apply(ctx)
+		return nil
+	}
+	return ctx.Redirect(http.StatusSeeOther, url)
+}
@@ -110,8 +110,9 @@ func (c *login) Post(ctx echo.Context) error {
 		return c.ctr.Fail(err, "error querying client during login")
 	}
 
-	// Check if client account is active
-	if client.Status != clientuser.StatusActive {
+	// Check if client account is active. Clients in a grace period, suspended ones included, can
+	// still log in to pay.
+	if client.Status == clientuser.StatusInactive {
 		ctx.Logger().Debugf("client account is not active: username=%s, status=%s", username, client.Status)
 		return authFailed("Your account is not active. Please contact support.")
 	}
@@ -181,7 +182,6 @@ func (c *login) Post(ctx echo.Context) error {
 	return c.ctr.Redirect(ctx, routeNames.RouteNameProfile)
 }
 
-
 // redirectAfterLogin redirects a now logged-in user to a previously requested page.
 func redirectAfterLogin(ctx echo.Context) (bool, error) {
 	sess, _ := session.Get("session", ctx)
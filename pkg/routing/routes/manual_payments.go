@@ -0,0 +1,152 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent/manualpayment"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/rs/zerolog/log"
+)
+
+// manualPaymentsShown is how many of a client's recent manual payments are listed
+const manualPaymentsShown = 10
+
+type manualPaymentsRoute struct {
+	ctr         controller.Controller
+	billingRepo *billingrepo.BillingRepo
+	notifier    *notifierrepo.ClientNotifier
+}
+
+func NewManualPaymentsRoute(
+	ctr controller.Controller, billingRepo *billingrepo.BillingRepo, notifier *notifierrepo.ClientNotifier,
+) *manualPaymentsRoute {
+	return &manualPaymentsRoute{
+		ctr:         ctr,
+		billingRepo: billingRepo,
+		notifier:    notifier,
+	}
+}
+
+// Get shows the merchant numbers to send money to and the form to report a payment
+func (r *manualPaymentsRoute) Get(ctx echo.Context) error {
+	client, err := r.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	data := &types.ISPManualPaymentData{
+		Providers: r.providers(),
+	}
+	data.Payments, err = r.billingRepo.ClientManualPayments(ctx.Request().Context(), client.Username, manualPaymentsShown)
+	if err != nil {
+		return r.ctr.Fail(err, "failed to load manual payments")
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageManualPayment
+	page.Data = data
+	page.Component = pages.ManualPayment(&page, data)
+	page.HTMX.Request.Boosted = true
+	page.SelectedBottomNavbarItem = domain.BottomNavbarItemProfile
+	page.ShowBottomNavbar = true
+
+	return r.ctr.RenderPage(ctx, page)
+}
+
+// Submit records a payment the client sent to a merchant number, to be verified
+func (r *manualPaymentsRoute) Submit(ctx echo.Context) error {
+	var form types.ManualPaymentForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	if form.Submission.HasErrors() || !r.offers(form.Provider) {
+		msg.Danger(ctx, "Please choose where you sent the money and enter the sender number, TrxID and amount.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNameManualPayment)
+	}
+
+	client, err := r.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	reqCtx := ctx.Request().Context()
+	payment, matched, err := r.billingRepo.SubmitManualPayment(reqCtx, client, billingrepo.ManualPaymentInput{
+		Provider:     manualpayment.Provider(form.Provider),
+		SenderNumber: form.SenderNumber,
+		TrxID:        form.TrxID,
+		Amount:       form.Amount,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, billingrepo.ErrTrxIDUsed):
+		msg.Danger(ctx, "This TrxID was already submitted. Please check it or contact support.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNameManualPayment)
+	case errors.Is(err, billingrepo.ErrInvalidAmount):
+		msg.Danger(ctx, "The amount must be more than zero.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNameManualPayment)
+	default:
+		return r.ctr.Fail(err, "failed to submit manual payment")
+	}
+
+	currency := r.ctr.Container.Config.Billing.Currency
+	if matched {
+		subject, message := billingrepo.ManualPaymentNotice(payment, currency)
+		if err := r.notifier.NotifyClient(reqCtx, client, subject, message); err != nil {
+			log.Error().Err(err).Str("username", client.Username).Msg("failed to send manual payment notification")
+		}
+		msg.Success(ctx, fmt.Sprintf("%.2f %s was added to your balance.", payment.Amount, currency))
+	} else {
+		msg.Success(ctx, "Thanks, your payment was submitted. We will add it to your balance as soon as it is verified.")
+	}
+	return r.ctr.Redirect(ctx, routeNames.RouteNameManualPayment)
+}
+
+// providers lists the mobile banking services that have a merchant number configured
+func (r *manualPaymentsRoute) providers() []types.ManualPaymentProvider {
+	cfg := r.ctr.Container.Config.Billing.ManualPayment
+	var providers []types.ManualPaymentProvider
+	if cfg.BkashNumber != "" {
+		providers = append(providers, types.ManualPaymentProvider{
+			Value: string(manualpayment.ProviderBkash), Label: "bKash", Number: cfg.BkashNumber,
+		})
+	}
+	if cfg.NagadNumber != "" {
+		providers = append(providers, types.ManualPaymentProvider{
+			Value: string(manualpayment.ProviderNagad), Label: "Nagad", Number: cfg.NagadNumber,
+		})
+	}
+	return providers
+}
+
+func (r *manualPaymentsRoute) offers(provider string) bool {
+	for _, p := range r.providers() {
+		if p.Value == provider {
+			return true
+		}
+	}
+	return false
+}
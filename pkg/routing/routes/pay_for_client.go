@@ -0,0 +1,274 @@
+package routes
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/repos/paymentgateway"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/rs/zerolog/log"
+	"github.com/skip2/go-qrcode"
+)
+
+// payLookupExpiry is how long the link a username lookup leads to stays valid
+const payLookupExpiry = 30 * time.Minute
+
+// payForClientRoute is the public page where relatives and friends pay for a client. Payers only
+// ever see a masked name and the amount due, and the client is carried between pages in a signed
+// token rather than by username.
+type payForClientRoute struct {
+	ctr         controller.Controller
+	billingRepo *billingrepo.BillingRepo
+}
+
+func NewPayForClientRoute(ctr controller.Controller, billingRepo *billingrepo.BillingRepo) *payForClientRoute {
+	return &payForClientRoute{
+		ctr:         ctr,
+		billingRepo: billingRepo,
+	}
+}
+
+// Get asks for the username of the client to pay for
+func (r *payForClientRoute) Get(ctx echo.Context) error {
+	return r.render(ctx, r.data())
+}
+
+// Lookup finds the client by username and moves on to their payment page
+func (r *payForClientRoute) Lookup(ctx echo.Context) error {
+	var form types.PayForClientLookupForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	if form.Submission.HasErrors() {
+		msg.Danger(ctx, "Please enter the username of the account you want to pay for.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClient)
+	}
+
+	payee, err := r.billingRepo.LookupPayee(ctx.Request().Context(), form.Username, ctx.RealIP(), r.limits())
+	switch {
+	case errors.Is(err, billingrepo.ErrTooManyPayRequests):
+		msg.Danger(ctx, "Too many attempts. Please try again later.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClient)
+	case errors.Is(err, billingrepo.ErrPayeeNotFound):
+		msg.Danger(ctx, "We could not find an active account with that username.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClient)
+	case err != nil:
+		return r.ctr.Fail(err, "failed to look up payee")
+	}
+
+	token := billingrepo.PayLinkToken(r.secret(), payee.ClientID, time.Now().Add(payLookupExpiry))
+	return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClientPayee, token)
+}
+
+// Payee shows the masked name and amount due of the client a payment link is for
+func (r *payForClientRoute) Payee(ctx echo.Context) error {
+	token := ctx.Param("token")
+	payee, err := r.payee(ctx, token)
+	if err != nil {
+		return err
+	}
+	if payee == nil {
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClient)
+	}
+
+	data := r.data()
+	data.Payee = &types.PayForClientPayee{
+		Token:      token,
+		MaskedName: payee.MaskedName,
+		AmountDue:  payee.AmountDue,
+	}
+	return r.render(ctx, data)
+}
+
+// Checkout starts a recharge of the client's balance and sends the payer to the chosen gateway
+func (r *payForClientRoute) Checkout(ctx echo.Context) error {
+	token := ctx.Param("token")
+	var form types.PayForClientForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	if form.Submission.HasErrors() {
+		msg.Danger(ctx, "Please enter an amount and choose a payment method.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClientPayee, token)
+	}
+
+	billing := r.ctr.Container.Config.Billing
+	if form.Amount < billing.MinTopUp || (billing.MaxTopUp > 0 && form.Amount > billing.MaxTopUp) {
+		msg.Danger(ctx, fmt.Sprintf("The amount must be %s.", topUpRange(billing)))
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClientPayee, token)
+	}
+
+	gateway, err := r.ctr.Container.PaymentGateways.Get(form.Gateway)
+	if err != nil {
+		msg.Danger(ctx, "The selected payment method is not available.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClientPayee, token)
+	}
+
+	clientID, err := billingrepo.ParsePayLinkToken(r.secret(), token, time.Now())
+	if err != nil {
+		msg.Danger(ctx, "This payment link is invalid or has expired. Please look the account up again.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClient)
+	}
+
+	txn, client, err := r.billingRepo.StartPaymentForClient(ctx.Request().Context(), clientID, form.Amount,
+		gateway.Method(), form.PayerName, ctx.RealIP(), r.limits())
+	switch {
+	case errors.Is(err, billingrepo.ErrTooManyPayRequests):
+		msg.Danger(ctx, "Too many attempts. Please try again later.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClientPayee, token)
+	case errors.Is(err, billingrepo.ErrPayeeNotFound):
+		msg.Danger(ctx, "This account can no longer be paid for online.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClient)
+	case err != nil:
+		return r.ctr.Fail(err, "failed to start payment for client")
+	}
+
+	// Only the payer's own details go to the gateway, whose checkout page they will see
+	domain := r.ctr.Container.Config.HTTP.Domain
+	checkout, err := gateway.StartCheckout(ctx.Request().Context(), paymentgateway.CheckoutRequest{
+		Ref:      txn.TransactionRef,
+		Amount:   txn.Amount,
+		Currency: billing.Currency,
+		Customer: paymentgateway.Customer{
+			Username: client.Username,
+			Name:     form.PayerName,
+			Phone:    form.PayerPhone,
+		},
+		CallbackURL: domain + ctx.Echo().Reverse(routeNames.RouteNamePaymentGatewayCallback, gateway.Name()),
+		IPNURL:      domain + ctx.Echo().Reverse(routeNames.RouteNamePaymentGatewayIPN, gateway.Name()),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("gateway", gateway.Name()).Str("ref", txn.TransactionRef).
+			Msg("failed to start gateway checkout")
+		if err := r.billingRepo.FailTopUp(ctx.Request().Context(), txn.TransactionRef, ""); err != nil {
+			log.Error().Err(err).Str("ref", txn.TransactionRef).Msg("failed to mark recharge as failed")
+		}
+		msg.Danger(ctx, fmt.Sprintf("%s is not reachable right now. Please try again later.", gateway.Label()))
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClientPayee, token)
+	}
+
+	return redirectExternal(ctx, checkout.RedirectURL)
+}
+
+// QR is a QR code of a payment link, for the client to show or print
+func (r *payForClientRoute) QR(ctx echo.Context) error {
+	token := ctx.Param("token")
+	if _, err := billingrepo.ParsePayLinkToken(r.secret(), token, time.Now()); err != nil {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	link := r.ctr.Container.Config.HTTP.Domain + ctx.Echo().Reverse(routeNames.RouteNamePayForClientPayee, token)
+	png, err := qrcode.Encode(link, qrcode.Medium, 256)
+	if err != nil {
+		return r.ctr.Fail(err, "failed to encode payment link")
+	}
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "private, max-age=3600")
+	return ctx.Stream(http.StatusOK, "image/png", bytes.NewReader(png))
+}
+
+// Result is where payers land after paying at a gateway. The callback has already settled the
+// payment, so this only reports its outcome.
+func (r *payForClientRoute) Result(ctx echo.Context) error {
+	txn, err := r.billingRepo.GetPaymentForClient(ctx.Request().Context(), ctx.QueryParam("ref"))
+	if errors.Is(err, billingrepo.ErrTxnNotFound) {
+		msg.Danger(ctx, "We could not find that payment. If you were charged, please contact support.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNamePayForClient)
+	} else if err != nil {
+		return r.ctr.Fail(err, "failed to load payment for client")
+	}
+
+	currency := r.ctr.Container.Config.Billing.Currency
+	switch txn.Status {
+	case clienttxn.StatusCompleted:
+		msg.Success(ctx, fmt.Sprintf("Thank you! %.2f %s was added to the account.", txn.Amount, currency))
+	case clienttxn.StatusPending:
+		msg.Info(ctx, "Your payment is still being processed. The account will be credited once it is confirmed.")
+	default:
+		msg.Danger(ctx, "Your payment was not completed and you have not been charged.")
+	}
+
+	data := r.data()
+	data.Result = txn
+	return r.render(ctx, data)
+}
+
+// payee resolves a payment link, flashing why and returning nil if it cannot be used
+func (r *payForClientRoute) payee(ctx echo.Context, token string) (*billingrepo.Payee, error) {
+	clientID, err := billingrepo.ParsePayLinkToken(r.secret(), token, time.Now())
+	if err != nil {
+		msg.Danger(ctx, "This payment link is invalid or has expired. Please look the account up again.")
+		return nil, nil
+	}
+	payee, err := r.billingRepo.GetPayee(ctx.Request().Context(), clientID)
+	if errors.Is(err, billingrepo.ErrPayeeNotFound) {
+		msg.Danger(ctx, "This account can no longer be paid for online.")
+		return nil, nil
+	} else if err != nil {
+		return nil, r.ctr.Fail(err, "failed to load payee")
+	}
+	return payee, nil
+}
+
+func (r *payForClientRoute) data() *types.PayForClientData {
+	billing := r.ctr.Container.Config.Billing
+	data := &types.PayForClientData{
+		MinTopUp: billing.MinTopUp,
+		MaxTopUp: billing.MaxTopUp,
+		Currency: billing.Currency,
+	}
+	if r.ctr.Container.PaymentGateways != nil {
+		for _, g := range r.ctr.Container.PaymentGateways.All() {
+			data.PaymentGateways = append(data.PaymentGateways, types.PaymentGatewayOption{
+				Name:  g.Name(),
+				Label: g.Label(),
+			})
+		}
+	}
+	return data
+}
+
+func (r *payForClientRoute) render(ctx echo.Context, data *types.PayForClientData) error {
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.LandingPage
+	page.Name = templates.PagePayForClient
+	page.Title = "Pay for someone"
+	page.Data = data
+	page.Component = pages.PayForClient(&page, data)
+	page.HTMX.Request.Boosted = true
+
+	return r.ctr.RenderPage(ctx, page)
+}
+
+func (r *payForClientRoute) secret() string {
+	return r.ctr.Container.Config.App.EncryptionKey
+}
+
+func (r *payForClientRoute) limits() billingrepo.PayLimits {
+	cfg := r.ctr.Container.Config.Billing.PayForClient
+	return billingrepo.PayLimits{
+		MaxRequests: cfg.MaxRequests,
+		Window:      cfg.Window,
+	}
+}
@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/paymentgateway"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/rs/zerolog/log"
+)
+
+type paymentGatewaysRoute struct {
+	ctr         controller.Controller
+	gateways    *paymentgateway.Registry
+	billingRepo *billingrepo.BillingRepo
+}
+
+func NewPaymentGatewaysRoute(
+	ctr controller.Controller, gateways *paymentgateway.Registry, billingRepo *billingrepo.BillingRepo,
+) *paymentGatewaysRoute {
+	return &paymentGatewaysRoute{
+		ctr:         ctr,
+		gateways:    gateways,
+		billingRepo: billingRepo,
+	}
+}
+
+// Callback receives the client's browser back from a gateway. It settles the payment and then
+// hands over to the authenticated result page, since this route has no session. Someone who paid
+// for a client is sent to the public result page instead.
+func (p *paymentGatewaysRoute) Callback(ctx echo.Context) error {
+	result, err := p.settle(ctx)
+	if err != nil {
+		log.Error().Err(err).Str("gateway", ctx.Param("gateway")).Msg("failed to settle gateway callback")
+	}
+
+	ref := ""
+	if result != nil {
+		ref = result.Ref
+	}
+	resultRoute := routeNames.RouteNameTopUpResult
+	if billingrepo.IsPaymentForClientRef(ref) {
+		resultRoute = routeNames.RouteNamePayForClientResult
+	}
+	return ctx.Redirect(http.StatusSeeOther,
+		ctx.Echo().Reverse(resultRoute)+"?ref="+url.QueryEscape(ref))
+}
+
+// IPN receives server-to-server payment notifications
+func (p *paymentGatewaysRoute) IPN(ctx echo.Context) error {
+	result, err := p.settle(ctx)
+	if err != nil {
+		log.Error().Err(err).Str("gateway", ctx.Param("gateway")).Msg("failed to settle gateway IPN")
+		status := http.StatusInternalServerError
+		if errors.Is(err, paymentgateway.ErrInvalidCallback) || errors.Is(err, paymentgateway.ErrGatewayNotFound) ||
+			errors.Is(err, billingrepo.ErrTxnNotFound) || errors.Is(err, billingrepo.ErrAmountMismatch) {
+			status = http.StatusBadRequest
+		}
+		return ctx.JSON(status, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, map[string]string{"status": string(result.Status)})
+}
+
+// settle verifies a callback with its gateway and applies the outcome to the pending recharge
+func (p *paymentGatewaysRoute) settle(ctx echo.Context) (*paymentgateway.Result, error) {
+	gateway, err := p.gateways.Get(ctx.Param("gateway"))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := gateway.VerifyCallback(ctx.Request().Context(), ctx.Request())
+	if err != nil {
+		return nil, err
+	}
+
+	// Settle even if the client closes the browser mid-request
+	settleCtx := context.WithoutCancel(ctx.Request().Context())
+	switch result.Status {
+	case paymentgateway.StatusCompleted:
+		_, err = p.billingRepo.CompleteTopUp(settleCtx, gateway.Method(), result.Ref, result.GatewayRef, result.Amount)
+	case paymentgateway.StatusFailed, paymentgateway.StatusCancelled:
+		err = p.billingRepo.FailTopUp(settleCtx, result.Ref, result.GatewayRef)
+	}
+	return result, err
+}
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentGatewayIPN_RejectsUnverifiedRequests(t *testing.T) {
+	ipn := func(gateway string, form url.Values) *http.Response {
+		resp, err := http.PostForm(srv.URL+c.Web.Reverse(routeNames.RouteNamePaymentGatewayIPN, gateway), form)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := ipn("unknown", url.Values{})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// A forged completion must never reach the ledger
+	resp = ipn("fake", url.Values{
+		"ref":       {"TOP-20250101-00000000"},
+		"amount":    {"500.00"},
+		"status":    {"completed"},
+		"signature": {"forged"},
+	})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestPaymentGatewayCallback_RedirectsToResult(t *testing.T) {
+	client := http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(srv.URL + c.Web.Reverse(routeNames.RouteNamePaymentGatewayCallback, "fake"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusSeeOther, resp.StatusCode)
+	assert.True(t, strings.HasPrefix(resp.Header.Get("Location"), c.Web.Reverse(routeNames.RouteNameTopUpResult)))
+}
@@ -0,0 +1,82 @@
+package routes
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/invoicerepo"
+)
+
+type receiptsRoute struct {
+	ctr         controller.Controller
+	invoiceRepo *invoicerepo.InvoiceRepo
+}
+
+func NewReceiptsRoute(ctr controller.Controller, invoiceRepo *invoicerepo.InvoiceRepo) *receiptsRoute {
+	return &receiptsRoute{
+		ctr:         ctr,
+		invoiceRepo: invoiceRepo,
+	}
+}
+
+// Receipt downloads the PDF receipt of one of the client's completed transactions
+func (r *receiptsRoute) Receipt(ctx echo.Context) error {
+	client, err := r.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	receipt, err := r.invoiceRepo.GetReceipt(ctx.Request().Context(), client.Username, ctx.Param("ref"))
+	if errors.Is(err, invoicerepo.ErrReceiptNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	} else if err != nil {
+		return r.ctr.Fail(err, "failed to load receipt")
+	}
+
+	buf := &bytes.Buffer{}
+	if err := r.invoiceRepo.WriteReceiptPDF(buf, receipt); err != nil {
+		return r.ctr.Fail(err, "failed to render receipt")
+	}
+	return sendPDF(ctx, fmt.Sprintf("%s.pdf", receipt.Number), buf)
+}
+
+// MonthlyInvoice downloads the client's PDF invoice for a month given as YYYY-MM
+func (r *receiptsRoute) MonthlyInvoice(ctx echo.Context) error {
+	client, err := r.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	month, err := time.ParseInLocation("2006-01", ctx.Param("month"), time.Local)
+	if err != nil || month.After(time.Now()) {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	invoice, err := r.invoiceRepo.GetMonthlyInvoice(ctx.Request().Context(), client.Username, month)
+	if err != nil {
+		return r.ctr.Fail(err, "failed to load invoice")
+	}
+
+	buf := &bytes.Buffer{}
+	if err := r.invoiceRepo.WriteInvoicePDF(buf, invoice); err != nil {
+		return r.ctr.Fail(err, "failed to render invoice")
+	}
+	return sendPDF(ctx, fmt.Sprintf("%s.pdf", invoice.Number), buf)
+}
+
+func sendPDF(ctx echo.Context, filename string, buf *bytes.Buffer) error {
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "private, no-store")
+	return ctx.Blob(http.StatusOK, "application/pdf", buf.Bytes())
+}
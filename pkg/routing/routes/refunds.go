@@ -0,0 +1,118 @@
+package routes
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent/refundrequest"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+type refundsRoute struct {
+	ctr         controller.Controller
+	billingRepo *billingrepo.BillingRepo
+}
+
+func NewRefundsRoute(ctr controller.Controller, billingRepo *billingrepo.BillingRepo) *refundsRoute {
+	return &refundsRoute{
+		ctr:         ctr,
+		billingRepo: billingRepo,
+	}
+}
+
+// Get lists the client's refund requests with the form to open a new one
+func (r *refundsRoute) Get(ctx echo.Context) error {
+	client, err := r.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	reqCtx := ctx.Request().Context()
+	data := &types.ISPRefundsData{
+		Balance: client.Balance,
+		PayoutMethods: []types.SelectOption{
+			{Value: string(refundrequest.PayoutMethodBkash), Label: "bKash"},
+			{Value: string(refundrequest.PayoutMethodNagad), Label: "Nagad"},
+			{Value: string(refundrequest.PayoutMethodBankTransfer), Label: "Bank transfer"},
+		},
+	}
+
+	data.Requests, err = r.billingRepo.ClientRefunds(reqCtx, client.Username)
+	if err != nil {
+		return r.ctr.Fail(err, "failed to load refund requests")
+	}
+	data.Charges, err = r.billingRepo.RefundableCharges(reqCtx, client.Username)
+	if err != nil {
+		return r.ctr.Fail(err, "failed to load failed payments")
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageRefunds
+	page.Data = data
+	page.Component = pages.Refunds(&page, data)
+	page.HTMX.Request.Boosted = true
+	page.SelectedBottomNavbarItem = domain.BottomNavbarItemProfile
+	page.ShowBottomNavbar = true
+
+	return r.ctr.RenderPage(ctx, page)
+}
+
+// Submit opens a refund request for review
+func (r *refundsRoute) Submit(ctx echo.Context) error {
+	var form types.RefundForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	if form.Submission.HasErrors() {
+		msg.Danger(ctx, "Please fill in every field of the refund request.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNameRefunds)
+	}
+
+	client, err := r.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	_, err = r.billingRepo.RequestRefund(ctx.Request().Context(), client, billingrepo.RefundInput{
+		Kind:          refundrequest.Kind(form.Kind),
+		Amount:        form.Amount,
+		ChargeRef:     form.ChargeRef,
+		PayoutMethod:  refundrequest.PayoutMethod(form.PayoutMethod),
+		PayoutAccount: form.PayoutAccount,
+		Reason:        form.Reason,
+	})
+	switch {
+	case err == nil:
+		msg.Success(ctx, "Your refund request was sent. We will let you know once it has been reviewed.")
+	case errors.Is(err, billingrepo.ErrRefundOpen):
+		msg.Info(ctx, "You already have a refund request for this under review.")
+	case errors.Is(err, billingrepo.ErrInvalidAmount), errors.Is(err, billingrepo.ErrInsufficientBalance):
+		msg.Danger(ctx, "The refund amount must be more than zero and no more than your balance.")
+	case errors.Is(err, billingrepo.ErrChargeNotRefundable):
+		msg.Danger(ctx, "Please choose one of your failed payments.")
+	default:
+		return r.ctr.Fail(err, "failed to request refund")
+	}
+	return r.ctr.Redirect(ctx, routeNames.RouteNameRefunds)
+}
@@ -12,10 +12,12 @@ import (
 	"github.com/mikestefanello/pagoda/pkg/controller"
 	"github.com/mikestefanello/pagoda/pkg/middleware"
 	"github.com/mikestefanello/pagoda/pkg/repos/emailsmanager"
+	"github.com/mikestefanello/pagoda/pkg/repos/invoicerepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
 	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
 	"github.com/mikestefanello/pagoda/pkg/repos/subscriptions"
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
 	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
 	"github.com/mikestefanello/pagoda/pkg/services"
 	"github.com/ziflex/lecho/v3"
@@ -191,7 +193,6 @@ func BuildRouter(c *services.Container) {
 
 }
 
-
 func externalRoutes(c *services.Container, g *echo.Group, ctr controller.Controller) {
 	subscriptionsRepo := subscriptions.NewSubscriptionsRepo(
 		c.ORM,
@@ -203,12 +204,17 @@ func externalRoutes(c *services.Container, g *echo.Group, ctr controller.Control
 	// Using obfuscation to not get trolls at my payment webhooks. Note, we do check integrity of stripe requests.
 	// TODO: make the first string an env var
 	g.POST("/Q2HBfAY7iid59J1SUN8h1Y3WxJcPWA/payments/webhooks", payments.HandleWebhook).Name = routeNames.RouteNamePaymentProcessorWebhook
+
+	// Balance recharge gateways. Each gateway authenticates its own callbacks with the provider.
+	paymentGateways := NewPaymentGatewaysRoute(ctr, c.PaymentGateways, c.Billing)
+	g.GET("/payments/gateway/:gateway/callback", paymentGateways.Callback).Name = routeNames.RouteNamePaymentGatewayCallback
+	g.POST("/payments/gateway/:gateway/callback", paymentGateways.Callback).Name = routeNames.RouteNamePaymentGatewayCallback
+	g.POST("/payments/gateway/:gateway/ipn", paymentGateways.IPN).Name = routeNames.RouteNamePaymentGatewayIPN
 }
 
 func generalRoutes(c *services.Container, g *echo.Group, ctr controller.Controller) {
 	emailRepo := *emailsmanager.NewEmailSubscriptionRepo(c.ORM)
 
-
 	landingPage := NewLandingPageRoute(ctr)
 	g.GET("/", landingPage.Get).Name = routeNames.RouteNameLandingPage
 
@@ -248,8 +254,6 @@ func generalRoutes(c *services.Container, g *echo.Group, ctr controller.Controll
 	verifyEmailSubscription := NewVerifyEmailSubscriptionRoute(ctr, emailRepo)
 	g.GET("/email/subscription/:token", verifyEmailSubscription.Get).Name = "verify_email_subscription"
 
-
-
 	about := NewAboutUsRoute(ctr)
 	g.GET("/about", about.Get).Name = routeNames.RouteNameAboutUs
 
@@ -260,13 +264,21 @@ func generalRoutes(c *services.Container, g *echo.Group, ctr controller.Controll
 	g.GET("/contact", contact.Get).Name = routeNames.RouteNameContact
 	g.POST("/contact", contact.Post).Name = routeNames.RouteNameContactSubmit
 
+	// Paying for someone else needs no login, only their username or a link they shared
+	payForClient := NewPayForClientRoute(ctr, c.Billing)
+	g.GET("/pay", payForClient.Get).Name = routeNames.RouteNamePayForClient
+	g.POST("/pay", payForClient.Lookup).Name = routeNames.RouteNamePayForClientLookup
+	g.GET("/pay/result", payForClient.Result).Name = routeNames.RouteNamePayForClientResult
+	g.GET("/pay/:token", payForClient.Payee).Name = routeNames.RouteNamePayForClientPayee
+	g.POST("/pay/:token", payForClient.Checkout).Name = routeNames.RouteNamePayForClientCheckout
+	g.GET("/pay/:token/qr", payForClient.QR).Name = routeNames.RouteNamePayForClientQR
+
 	userGroup := g.Group("", middleware.RequireNoAuthentication())
 
 	login := NewLoginRoute(ctr)
 	userGroup.GET("/login", login.Get).Name = routeNames.RouteNameLogin
 	userGroup.POST("/login", login.Post).Name = routeNames.RouteNameLoginSubmit
 
-
 	if ctr.Container.Config.App.Environment != config.EnvProduction {
 		// These facilitate triggering specific errors and seeing what they look like in the UI
 		err := NewErrorHandler(ctr)
@@ -321,7 +333,6 @@ func coreAuthRoutes(c *services.Container, g *echo.Group, ctr controller.Control
 	onboardingGroup.POST("/profile/phone/verification", profile.SubmitPhoneVerificationCode).Name = routeNames.RouteNameSubmitPhoneVerification
 	onboardingGroup.POST("/profile/phone/save", profile.SavePhoneInfo).Name = routeNames.RouteNameUpdatePhoneNum
 
-
 	// TODO: move all pref routes to the preferences route (and not have a gazillion different ..)
 	finishOnboarding := NewOnboardingRoute(ctr, c.ORM, c.Tasks)
 	onboardingGroup.GET("/welcome/finish-onboarding", finishOnboarding.Get).Name = routeNames.RouteNameFinishOnboarding
@@ -344,19 +355,49 @@ func coreAuthRoutes(c *services.Container, g *echo.Group, ctr controller.Control
 	// Auth group is for all routes that are accessible to a fully logged in and onboarded user
 	onboardedGroup := g.Group("", middleware.RequireAuthentication(), middleware.RedirectToOnboardingIfNotComplete())
 
-
-
 	dashboard := NewDashboardRoutes(ctr, &profileRepo)
 	onboardedGroup.GET("/dashboard", dashboard.Get).Name = routeNames.RouteNameDashboard
 
-	isp := NewISPRoutes(ctr)
+	isp := NewISPRoutes(ctr, c.Billing)
 	onboardedGroup.GET("/tickets", isp.GetTickets).Name = routeNames.RouteNameTicketCreate
 	onboardedGroup.POST("/tickets", isp.CreateTicket).Name = routeNames.RouteNameTicketSubmit
 	onboardedGroup.POST("/balance/load", isp.AddFunds).Name = routeNames.RouteNameAddFunds
+	onboardedGroup.GET("/balance/load/result", isp.TopUpResult).Name = routeNames.RouteNameTopUpResult
 	onboardedGroup.POST("/package/renew", isp.RenewPackage).Name = routeNames.RouteNameRenewPackage
+	onboardedGroup.POST("/package/prepay", isp.PayInAdvance).Name = routeNames.RouteNameAdvancePayment
 	onboardedGroup.GET("/package/change", isp.ChangePlan).Name = routeNames.RouteNameChangePlan
+	onboardedGroup.POST("/package/change", isp.SubmitChangePlan).Name = routeNames.RouteNameChangePlanSubmit
 	onboardedGroup.POST("/package/autorenew", isp.ToggleAutoRenew).Name = routeNames.RouteNameToggleAutoRenew
 
+	transactions := NewTransactionsRoute(ctr, c.Billing)
+	onboardedGroup.GET("/transactions", transactions.History).Name = routeNames.RouteNameTxnHistory
+	onboardedGroup.GET("/transactions/export/:format", transactions.Export).Name = routeNames.RouteNameTxnExport
+
+	clientNotifier := notifierrepo.NewClientNotifier(c.Mail, smsSenderRepo)
+	transfer := NewTransferRoute(ctr, c.Billing, clientNotifier)
+	onboardedGroup.GET("/balance/transfer", transfer.Get).Name = routeNames.RouteNameTransfer
+	onboardedGroup.POST("/balance/transfer", transfer.Submit).Name = routeNames.RouteNameTransferSubmit
+	onboardedGroup.POST("/balance/transfer/confirm", transfer.Confirm).Name = routeNames.RouteNameTransferConfirm
+
+	vouchers := NewVouchersRoute(ctr, c.Billing)
+	onboardedGroup.POST("/balance/voucher", vouchers.Redeem).Name = routeNames.RouteNameRedeemVoucher
+
+	manualPayments := NewManualPaymentsRoute(ctr, c.Billing, clientNotifier)
+	onboardedGroup.GET("/balance/manual", manualPayments.Get).Name = routeNames.RouteNameManualPayment
+	onboardedGroup.POST("/balance/manual", manualPayments.Submit).Name = routeNames.RouteNameManualPaymentSubmit
+
+	refunds := NewRefundsRoute(ctr, c.Billing)
+	onboardedGroup.GET("/refunds", refunds.Get).Name = routeNames.RouteNameRefunds
+	onboardedGroup.POST("/refunds", refunds.Submit).Name = routeNames.RouteNameRefundSubmit
+
+	usage := NewUsageRoute(ctr, usagerepo.NewUsageRepo(c.ORM))
+	onboardedGroup.GET("/usage/chart", usage.Chart).Name = routeNames.RouteNameUsageChart
+	onboardedGroup.GET("/usage/heatmap", usage.Heatmap).Name = routeNames.RouteNameUsageHeatmap
+
+	receipts := NewReceiptsRoute(ctr, invoicerepo.NewInvoiceRepo(c.ORM, c.Config))
+	onboardedGroup.GET("/transactions/:ref/receipt", receipts.Receipt).Name = routeNames.RouteNameTxnReceipt
+	onboardedGroup.GET("/invoices/:month", receipts.MonthlyInvoice).Name = routeNames.RouteNameMonthlyInvoice
+
 	uploadPhoto := NewUploadPhotoRoutes(ctr, &profileRepo, storageRepo, c.Config.Storage.PhotosMaxFileSizeMB)
 	onboardedGroup.GET("/uploadPhoto", uploadPhoto.Get).Name = "uploadPhoto"
 	onboardedGroup.POST("/uploadPhoto", uploadPhoto.Post).Name = "uploadPhoto.post"
@@ -26,6 +26,16 @@ func TestMain(m *testing.M) {
 	// Set the environment to test
 	config.SwitchEnvironment(config.EnvTest)
 
+	// The payment gateway tests go through the fake gateway, which is off unless switched on
+	for key, value := range map[string]string{
+		"PAGODA_BILLING_GATEWAYS_FAKE_ENABLED": "true",
+		"PAGODA_BILLING_GATEWAYS_FAKE_SECRET":  "test-secret",
+	} {
+		if err := os.Setenv(key, value); err != nil {
+			panic(err)
+		}
+	}
+
 	// Start a new container
 	c = services.NewContainer()
 
@@ -0,0 +1,285 @@
+package routes
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/invoicerepo"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+)
+
+const (
+	txnsPerPage   = 20
+	txnFilterDate = "2006-01-02"
+	txnExportCSV  = "csv"
+	txnExportJSON = "json"
+)
+
+var (
+	txnTypes = []clienttxn.Type{
+		clienttxn.TypeRECHARGE, clienttxn.TypeRENEWAL, clienttxn.TypeAUTO_RENEWAL, clienttxn.TypeACTIVE,
+		clienttxn.TypePACKAGE_MIGRATION, clienttxn.TypeADVANCE_PAYMENT, clienttxn.TypeREFUND,
+		clienttxn.TypeTRANSFER_REFUND, clienttxn.TypeTRANSFER_RECEIVED, clienttxn.TypeADJUSTMENT,
+		clienttxn.TypePOSTPAID_INVOICE,
+	}
+	txnStatuses = []clienttxn.Status{
+		clienttxn.StatusCompleted, clienttxn.StatusPending, clienttxn.StatusFailed, clienttxn.StatusReversed,
+	}
+	txnMethods = []clienttxn.PaymentMethod{
+		clienttxn.PaymentMethodClientBalance, clienttxn.PaymentMethodGatewayBkash, clienttxn.PaymentMethodGatewayNagad,
+		clienttxn.PaymentMethodGatewaySslcommerz, clienttxn.PaymentMethodGatewayStripe, clienttxn.PaymentMethodGatewayPaypal,
+		clienttxn.PaymentMethodMobileBanking, clienttxn.PaymentMethodBankTransfer, clienttxn.PaymentMethodCard,
+		clienttxn.PaymentMethodCash, clienttxn.PaymentMethodVendorBalance, clienttxn.PaymentMethodFree,
+		clienttxn.PaymentMethodOther,
+	}
+)
+
+type transactionsRoute struct {
+	ctr         controller.Controller
+	billingRepo *billingrepo.BillingRepo
+}
+
+// txnExport is one exported transaction
+type txnExport struct {
+	Ref           string    `json:"transaction_ref"`
+	Date          time.Time `json:"transaction_date"`
+	Type          string    `json:"type"`
+	Status        string    `json:"status"`
+	PaymentMethod string    `json:"payment_method"`
+	Amount        float64   `json:"amount"`
+	TotalBalance  float64   `json:"total_balance"`
+	GatewayRef    string    `json:"gateway_ref,omitempty"`
+	Description   string    `json:"description"`
+}
+
+func NewTransactionsRoute(ctr controller.Controller, billingRepo *billingrepo.BillingRepo) *transactionsRoute {
+	return &transactionsRoute{
+		ctr:         ctr,
+		billingRepo: billingRepo,
+	}
+}
+
+// History lists the client's transactions, a page at a time
+func (t *transactionsRoute) History(ctx echo.Context) error {
+	client, err := t.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	filter, submitted, query := parseTxnFilter(ctx)
+	reqCtx := ctx.Request().Context()
+
+	page := controller.NewPage(ctx)
+	page.Pager = controller.NewPager(ctx, txnsPerPage)
+
+	count, err := t.billingRepo.CountClientTxns(reqCtx, client.Username, filter)
+	if err != nil {
+		return t.ctr.Fail(err, "failed to count transactions")
+	}
+	page.Pager.SetItems(count)
+
+	txns, err := t.billingRepo.ClientTxns(reqCtx, client.Username, filter, page.Pager.GetOffset(), txnsPerPage)
+	if err != nil {
+		return t.ctr.Fail(err, "failed to load transactions")
+	}
+
+	data := &types.ISPTransactionsData{
+		Filter:   submitted,
+		Types:    txnTypeOptions(),
+		Statuses: txnStatusOptions(),
+		Methods:  txnMethodOptions(),
+		Query:    query.Encode(),
+	}
+	for _, txn := range txns {
+		data.Rows = append(data.Rows, types.ISPTxnRow{
+			Txn:         txn,
+			TypeLabel:   invoicerepo.TypeLabel(txn.Type),
+			MethodLabel: invoicerepo.MethodLabel(txn.PaymentMethod),
+			Credit:      invoicerepo.IsCredit(txn),
+		})
+	}
+
+	page.Layout = layouts.Main
+	page.Name = templates.PageTransactions
+	page.Data = data
+	page.Component = pages.Transactions(&page, data)
+	page.HTMX.Request.Boosted = true
+	page.SelectedBottomNavbarItem = domain.BottomNavbarItemProfile
+	page.ShowBottomNavbar = true
+
+	return t.ctr.RenderPage(ctx, page)
+}
+
+// Export downloads every transaction matching the filter as CSV or JSON
+func (t *transactionsRoute) Export(ctx echo.Context) error {
+	client, err := t.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	format := ctx.Param("format")
+	if format != txnExportCSV && format != txnExportJSON {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	filter, _, _ := parseTxnFilter(ctx)
+	txns, err := t.billingRepo.ClientTxns(ctx.Request().Context(), client.Username, filter, 0, 0)
+	if err != nil {
+		return t.ctr.Fail(err, "failed to load transactions")
+	}
+
+	rows := make([]txnExport, 0, len(txns))
+	for _, txn := range txns {
+		rows = append(rows, newTxnExport(txn))
+	}
+
+	filename := fmt.Sprintf("transactions-%s-%s.%s", client.Username, time.Now().Format("20060102"), format)
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "private, no-store")
+
+	if format == txnExportJSON {
+		return ctx.JSON(http.StatusOK, rows)
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	ctx.Response().WriteHeader(http.StatusOK)
+	return writeTxnsCSV(ctx.Response(), rows)
+}
+
+// parseTxnFilter reads the history filter from the query string. Unknown values are dropped, so
+// the returned query only carries the filters that were applied.
+func parseTxnFilter(ctx echo.Context) (billingrepo.TxnFilter, types.ISPTxnFilter, url.Values) {
+	var (
+		filter    billingrepo.TxnFilter
+		submitted types.ISPTxnFilter
+		query     = url.Values{}
+	)
+
+	if v := clienttxn.Type(ctx.QueryParam("type")); v != "" && clienttxn.TypeValidator(v) == nil {
+		filter.Type = v
+		submitted.Type = string(v)
+		query.Set("type", submitted.Type)
+	}
+	if v := clienttxn.Status(ctx.QueryParam("status")); v != "" && clienttxn.StatusValidator(v) == nil {
+		filter.Status = v
+		submitted.Status = string(v)
+		query.Set("status", submitted.Status)
+	}
+	if v := clienttxn.PaymentMethod(ctx.QueryParam("method")); v != "" && clienttxn.PaymentMethodValidator(v) == nil {
+		filter.PaymentMethod = v
+		submitted.Method = string(v)
+		query.Set("method", submitted.Method)
+	}
+	if from, err := time.ParseInLocation(txnFilterDate, ctx.QueryParam("from"), time.Local); err == nil {
+		filter.From = &from
+		submitted.From = from.Format(txnFilterDate)
+		query.Set("from", submitted.From)
+	}
+	if to, err := time.ParseInLocation(txnFilterDate, ctx.QueryParam("to"), time.Local); err == nil {
+		// The end date is inclusive, so filter up to the start of the next day
+		end := to.AddDate(0, 0, 1)
+		filter.To = &end
+		submitted.To = to.Format(txnFilterDate)
+		query.Set("to", submitted.To)
+	}
+	return filter, submitted, query
+}
+
+func newTxnExport(txn *ent.ClientTxn) txnExport {
+	row := txnExport{
+		Ref:          txn.TransactionRef,
+		Date:         txn.TransactionDate,
+		Type:         string(txn.Type),
+		Status:       string(txn.Status),
+		Amount:       txn.Amount,
+		TotalBalance: txn.TotalBalance,
+		GatewayRef:   txn.GatewayRef,
+		Description:  txn.Description,
+	}
+	if txn.PaymentMethod != nil {
+		row.PaymentMethod = string(*txn.PaymentMethod)
+	}
+	return row
+}
+
+func writeTxnsCSV(w io.Writer, rows []txnExport) error {
+	out := csv.NewWriter(w)
+	err := out.Write([]string{
+		"transaction_ref", "transaction_date", "type", "status", "payment_method",
+		"amount", "total_balance", "gateway_ref", "description",
+	})
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		err := out.Write([]string{
+			csvSafe(row.Ref),
+			row.Date.Format(time.RFC3339),
+			row.Type,
+			row.Status,
+			row.PaymentMethod,
+			strconv.FormatFloat(row.Amount, 'f', 2, 64),
+			strconv.FormatFloat(row.TotalBalance, 'f', 2, 64),
+			csvSafe(row.GatewayRef),
+			csvSafe(row.Description),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// csvSafe keeps spreadsheet apps from evaluating free text as a formula
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func txnTypeOptions() []types.SelectOption {
+	options := make([]types.SelectOption, 0, len(txnTypes))
+	for _, v := range txnTypes {
+		options = append(options, types.SelectOption{Value: string(v), Label: invoicerepo.TypeLabel(v)})
+	}
+	return options
+}
+
+func txnStatusOptions() []types.SelectOption {
+	options := make([]types.SelectOption, 0, len(txnStatuses))
+	for _, v := range txnStatuses {
+		options = append(options, types.SelectOption{Value: string(v), Label: invoicerepo.TypeLabel(clienttxn.Type(v))})
+	}
+	return options
+}
+
+func txnMethodOptions() []types.SelectOption {
+	options := make([]types.SelectOption, 0, len(txnMethods))
+	for _, v := range txnMethods {
+		options = append(options, types.SelectOption{Value: string(v), Label: invoicerepo.MethodLabel(&v)})
+	}
+	return options
+}
@@ -0,0 +1,64 @@
+package routes
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTxnFilter(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet,
+		"/transactions?type=RENEWAL&status=bogus&method=gateway_bkash&from=2025-11-01&to=2025-11-30", nil)
+	ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+	filter, submitted, query := parseTxnFilter(ctx)
+	assert.Equal(t, clienttxn.TypeRENEWAL, filter.Type)
+	assert.Equal(t, clienttxn.PaymentMethodGatewayBkash, filter.PaymentMethod)
+
+	// Unknown values are dropped rather than matching nothing
+	assert.Empty(t, filter.Status)
+	assert.Empty(t, submitted.Status)
+	assert.False(t, query.Has("status"))
+
+	// The end date is inclusive
+	require.NotNil(t, filter.From)
+	require.NotNil(t, filter.To)
+	assert.Equal(t, time.Date(2025, 11, 1, 0, 0, 0, 0, time.Local), *filter.From)
+	assert.Equal(t, time.Date(2025, 12, 1, 0, 0, 0, 0, time.Local), *filter.To)
+	assert.Equal(t, "2025-11-30", submitted.To)
+}
+
+func TestTxnTypeOptions(t *testing.T) {
+	options := txnTypeOptions()
+	for _, option := range options {
+		assert.NoError(t, clienttxn.TypeValidator(clienttxn.Type(option.Value)))
+	}
+	assert.Contains(t, options, types.SelectOption{Value: "POSTPAID_INVOICE", Label: "Postpaid invoice"})
+}
+
+func TestWriteTxnsCSV(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := writeTxnsCSV(buf, []txnExport{{
+		Ref:           "RNW-7-20251114130022",
+		Date:          time.Date(2025, 11, 14, 13, 0, 22, 0, time.UTC),
+		Type:          "RENEWAL",
+		Status:        "completed",
+		PaymentMethod: "client_balance",
+		Amount:        500,
+		TotalBalance:  250.5,
+		Description:   "=HYPERLINK(\"x\")",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t,
+		"transaction_ref,transaction_date,type,status,payment_method,amount,total_balance,gateway_ref,description\n"+
+			"RNW-7-20251114130022,2025-11-14T13:00:22Z,RENEWAL,completed,client_balance,500.00,250.50,,\"'=HYPERLINK(\"\"x\"\")\"\n",
+		buf.String())
+}
@@ -0,0 +1,257 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/domain"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/layouts"
+	"github.com/mikestefanello/pagoda/templates/pages"
+	"github.com/rs/zerolog/log"
+)
+
+type transferRoute struct {
+	ctr         controller.Controller
+	billingRepo *billingrepo.BillingRepo
+	notifier    *notifierrepo.ClientNotifier
+}
+
+func NewTransferRoute(
+	ctr controller.Controller, billingRepo *billingrepo.BillingRepo, notifier *notifierrepo.ClientNotifier,
+) *transferRoute {
+	return &transferRoute{
+		ctr:         ctr,
+		billingRepo: billingRepo,
+		notifier:    notifier,
+	}
+}
+
+// Get shows the transfer form, or the code form for the transfer given by ?transfer=
+func (t *transferRoute) Get(ctx echo.Context) error {
+	client, err := t.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	reqCtx := ctx.Request().Context()
+	limits := t.limits()
+	data := &types.ISPTransferData{
+		Balance:     client.Balance,
+		Currency:    t.ctr.Container.Config.Billing.Currency,
+		MinAmount:   limits.MinAmount,
+		MaxAmount:   limits.MaxAmount,
+		DailyAmount: limits.DailyAmount,
+		DailyCount:  limits.DailyCount,
+	}
+
+	usage, err := t.billingRepo.TransferUsageToday(reqCtx, client.Username)
+	if err != nil {
+		return t.ctr.Fail(err, "failed to load transfer usage")
+	}
+	data.SentToday = usage.Amount
+	data.CountToday = usage.Count
+
+	if id, err := strconv.Atoi(ctx.QueryParam("transfer")); err == nil {
+		pending, err := t.billingRepo.GetPendingTransfer(reqCtx, client.Username, id)
+		switch {
+		case err == nil:
+			data.Pending = pending
+			recipient, err := t.ctr.Container.ORM.ClientUser.Query().
+				Where(clientuser.UsernameEQ(pending.ToUsername)).
+				Only(reqCtx)
+			if err != nil {
+				return t.ctr.Fail(err, "failed to load transfer recipient")
+			}
+			data.RecipientName = billingrepo.MaskName(recipient.Name)
+		case errors.Is(err, billingrepo.ErrTransferNotFound):
+			msg.Info(ctx, "That transfer is no longer waiting for confirmation.")
+		default:
+			return t.ctr.Fail(err, "failed to load transfer")
+		}
+	}
+
+	page := controller.NewPage(ctx)
+	page.Layout = layouts.Main
+	page.Name = templates.PageTransfer
+	page.Data = data
+	page.Component = pages.Transfer(&page, data)
+	page.HTMX.Request.Boosted = true
+	page.SelectedBottomNavbarItem = domain.BottomNavbarItemProfile
+	page.ShowBottomNavbar = true
+
+	return t.ctr.RenderPage(ctx, page)
+}
+
+// Submit starts a transfer and sends the sender a one-time code to confirm it
+func (t *transferRoute) Submit(ctx echo.Context) error {
+	var form types.TransferForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	if form.Submission.HasErrors() {
+		msg.Danger(ctx, "Please enter a username and an amount.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	}
+
+	client, err := t.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	reqCtx := ctx.Request().Context()
+	limits := t.limits()
+	currency := t.ctr.Container.Config.Billing.Currency
+	pending, err := t.billingRepo.StartTransfer(reqCtx, client, form.Recipient, form.Amount, limits)
+	switch {
+	case err == nil:
+	case errors.Is(err, billingrepo.ErrTransferToSelf):
+		msg.Danger(ctx, "You cannot transfer to your own account.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrRecipientNotFound):
+		msg.Danger(ctx, "We could not find an account with that username.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrTransferAmount):
+		if limits.MaxAmount > 0 {
+			msg.Danger(ctx, fmt.Sprintf("Transfers must be between %.0f and %.0f %s.",
+				limits.MinAmount, limits.MaxAmount, currency))
+		} else if limits.MinAmount > 0 {
+			msg.Danger(ctx, fmt.Sprintf("Transfers must be at least %.0f %s.", limits.MinAmount, currency))
+		} else {
+			msg.Danger(ctx, "Please enter an amount greater than zero.")
+		}
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrInsufficientBalance):
+		msg.Danger(ctx, "Your balance is too low for this transfer.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrTransferDailyLimit):
+		msg.Danger(ctx, "This transfer would go over your daily transfer limit.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrTransferTooSoon):
+		msg.Info(ctx, "We just sent you a code. Please wait a minute before starting another transfer.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	default:
+		return t.ctr.Fail(err, "failed to start transfer")
+	}
+
+	message := fmt.Sprintf("Your code to confirm the transfer of %.2f %s to %s is %s. It expires in %d minutes. "+
+		"Do not share it with anyone.",
+		pending.Transfer.Amount, currency, pending.Recipient.Username, pending.Code, int(limits.CodeExpiry.Minutes()))
+	if err := t.notifier.NotifyClient(reqCtx, client, "Confirm your balance transfer", message); err != nil {
+		log.Error().Err(err).Str("username", client.Username).Msg("failed to send transfer code")
+		msg.Danger(ctx, "We could not send your confirmation code. Please try again later.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	}
+
+	msg.Info(ctx, "We sent a confirmation code to your phone and email.")
+	return t.ctr.RedirectWithDetails(ctx, routeNames.RouteNameTransfer,
+		fmt.Sprintf("?transfer=%d", pending.Transfer.ID), http.StatusFound)
+}
+
+// Confirm completes a transfer once the sender enters the code, then notifies both parties
+func (t *transferRoute) Confirm(ctx echo.Context) error {
+	var form types.ConfirmTransferForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	retry := fmt.Sprintf("?transfer=%d", form.TransferID)
+	if form.Submission.HasErrors() {
+		msg.Danger(ctx, "Please enter the 6 digit code we sent you.")
+		return t.ctr.RedirectWithDetails(ctx, routeNames.RouteNameTransfer, retry, http.StatusFound)
+	}
+
+	client, err := t.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	reqCtx := ctx.Request().Context()
+	transfer, err := t.billingRepo.ConfirmTransfer(reqCtx, client.Username, form.TransferID, form.Code, t.limits())
+	switch {
+	case err == nil:
+	case errors.Is(err, billingrepo.ErrTransferCodeMismatch):
+		msg.Danger(ctx, "That code is not correct.")
+		return t.ctr.RedirectWithDetails(ctx, routeNames.RouteNameTransfer, retry, http.StatusFound)
+	case errors.Is(err, billingrepo.ErrTransferLocked):
+		msg.Danger(ctx, "Too many incorrect codes. The transfer was cancelled.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrTransferExpired):
+		msg.Danger(ctx, "Your code has expired. Please start the transfer again.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrTransferNotFound):
+		msg.Info(ctx, "That transfer is no longer waiting for confirmation.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrInsufficientBalance):
+		msg.Danger(ctx, "Your balance is too low for this transfer.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrTransferDailyLimit):
+		msg.Danger(ctx, "This transfer would go over your daily transfer limit.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	case errors.Is(err, billingrepo.ErrRecipientNotFound):
+		msg.Danger(ctx, "The recipient's account no longer exists.")
+		return t.ctr.Redirect(ctx, routeNames.RouteNameTransfer)
+	default:
+		return t.ctr.Fail(err, "failed to complete transfer")
+	}
+
+	currency := t.ctr.Container.Config.Billing.Currency
+	amount := transfer.Transfer.Amount
+	t.notify(reqCtx, transfer.Sender, "Balance transfer sent", fmt.Sprintf(
+		"You sent %.2f %s to %s (ref %s). Your balance is now %.2f %s.",
+		amount, currency, transfer.Recipient.Username, transfer.Debit.TransactionRef, transfer.Debit.TotalBalance, currency))
+	t.notify(reqCtx, transfer.Recipient, "Balance transfer received", fmt.Sprintf(
+		"You received %.2f %s from %s (ref %s). Your balance is now %.2f %s.",
+		amount, currency, transfer.Sender.Username, transfer.Credit.TransactionRef, transfer.Credit.TotalBalance, currency))
+
+	msg.Success(ctx, fmt.Sprintf("%.2f %s was sent to %s.", amount, currency, transfer.Recipient.Username))
+	return t.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+}
+
+func (t *transferRoute) notify(ctx context.Context, client *ent.ClientUser, subject, message string) {
+	if err := t.notifier.NotifyClient(ctx, client, subject, message); err != nil {
+		log.Error().Err(err).Str("username", client.Username).Msg("failed to send transfer notification")
+	}
+}
+
+func (t *transferRoute) limits() billingrepo.TransferLimits {
+	cfg := t.ctr.Container.Config.Billing.Transfer
+	return billingrepo.TransferLimits{
+		MinAmount:   cfg.MinAmount,
+		MaxAmount:   cfg.MaxAmount,
+		DailyAmount: cfg.DailyAmount,
+		DailyCount:  cfg.DailyCount,
+		CodeExpiry:  cfg.CodeExpiry,
+		MaxAttempts: cfg.MaxAttempts,
+	}
+}
@@ -0,0 +1,123 @@
+package routes
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
+	"github.com/mikestefanello/pagoda/pkg/services"
+	"github.com/mikestefanello/pagoda/pkg/types"
+	"github.com/mikestefanello/pagoda/templates"
+	"github.com/mikestefanello/pagoda/templates/components"
+)
+
+// usageHeatmapDays is how many days back the heatmap of hourly usage goes
+const usageHeatmapDays = 30
+
+var (
+	// usagePeriods are the periods the usage chart can show, with how many of them it shows
+	usagePeriods = []struct {
+		period usagerepo.Period
+		points int
+		label  string
+	}{
+		{usagerepo.PeriodDay, 30, "Jan 2"},
+		{usagerepo.PeriodWeek, 12, "Jan 2"},
+		{usagerepo.PeriodMonth, 12, "Jan 2006"},
+	}
+)
+
+type usageRoute struct {
+	ctr       controller.Controller
+	usageRepo *usagerepo.UsageRepo
+}
+
+func NewUsageRoute(ctr controller.Controller, usageRepo *usagerepo.UsageRepo) *usageRoute {
+	return &usageRoute{
+		ctr:       ctr,
+		usageRepo: usageRepo,
+	}
+}
+
+// Chart renders the client's download and upload for each of the last 30 days, or the last 12
+// weeks or months with ?period=week or month, as loaded into the dashboard by HTMX
+func (u *usageRoute) Chart(ctx echo.Context) error {
+	client, err := u.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	period := ctx.QueryParam("period")
+	if period == "" {
+		period = string(usagerepo.PeriodDay)
+	}
+	data := &types.ISPUsageChart{Period: period}
+	found := false
+	for _, p := range usagePeriods {
+		data.Periods = append(data.Periods, string(p.period))
+		if string(p.period) != period {
+			continue
+		}
+		found = true
+
+		spans, err := u.usageRepo.Series(ctx.Request().Context(), client.Username, p.period, p.points, time.Now())
+		if err != nil {
+			return u.ctr.Fail(err, "failed to load usage")
+		}
+		for _, span := range spans {
+			point := types.ISPUsagePoint{
+				Label:    span.Start.Format(p.label),
+				ISPUsage: services.ISPUsage(span.Totals),
+			}
+			data.Points = append(data.Points, point)
+			data.Max = max(data.Max, point.Download, point.Upload)
+			data.Totals.Download += point.Download
+			data.Totals.Upload += point.Upload
+		}
+	}
+	if !found {
+		return echo.NewHTTPError(http.StatusBadRequest, "unknown usage period")
+	}
+
+	page := controller.NewPage(ctx)
+	page.Name = templates.PageUsageChart
+	page.Data = data
+	page.Component = components.UsageChart(&page, data)
+	return u.ctr.RenderPage(ctx, page)
+}
+
+// Heatmap renders how much the client used each hour of the last 30 days
+func (u *usageRoute) Heatmap(ctx echo.Context) error {
+	client, err := u.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	now := time.Now()
+	from := usagerepo.DayStart(now).AddDate(0, 0, 1-usageHeatmapDays)
+	rows, err := u.usageRepo.Hourly(ctx.Request().Context(), client.Username, from, now)
+	if err != nil {
+		return u.ctr.Fail(err, "failed to load hourly usage")
+	}
+	hours := make([]types.ISPUsageHour, 0, len(rows))
+	for _, row := range rows {
+		hours = append(hours, types.ISPUsageHour{
+			Date:  row.Hour.Local().Format(time.RFC3339),
+			Value: uint64(row.InputOctets + row.OutputOctets),
+		})
+	}
+
+	page := controller.NewPage(ctx)
+	page.Name = templates.PageUsageHeatmap
+	page.Data = hours
+	page.Component = components.UsageHeatmapComponent(hours, from.Format(time.RFC3339), usageHeatmapDays)
+	return u.ctr.RenderPage(ctx, page)
+}
@@ -0,0 +1,84 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikestefanello/pagoda/pkg/controller"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/msg"
+	routeNames "github.com/mikestefanello/pagoda/pkg/routing/routenames"
+	"github.com/mikestefanello/pagoda/pkg/types"
+)
+
+type vouchersRoute struct {
+	ctr         controller.Controller
+	billingRepo *billingrepo.BillingRepo
+}
+
+func NewVouchersRoute(ctr controller.Controller, billingRepo *billingrepo.BillingRepo) *vouchersRoute {
+	return &vouchersRoute{
+		ctr:         ctr,
+		billingRepo: billingRepo,
+	}
+}
+
+// Redeem credits the client's balance with a prepaid recharge card
+func (r *vouchersRoute) Redeem(ctx echo.Context) error {
+	var form types.VoucherForm
+	if err := ctx.Bind(&form); err != nil {
+		return err
+	}
+
+	if err := form.Submission.Process(ctx, &form); err != nil {
+		return err
+	}
+
+	if form.Submission.HasErrors() {
+		msg.Danger(ctx, "Please enter the PIN printed on your recharge card.")
+		return r.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+	}
+
+	client, err := r.ctr.Container.GetAuthenticatedClient(ctx)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized)
+	}
+
+	limits := r.limits()
+	txn, err := r.billingRepo.RedeemVoucher(ctx.Request().Context(), client, form.PIN, ctx.RealIP(), limits)
+	switch {
+	case err == nil:
+		msg.Success(ctx, fmt.Sprintf("%.2f %s was added to your balance.", txn.Amount, r.ctr.Container.Config.Billing.Currency))
+	case errors.Is(err, billingrepo.ErrVoucherRedeemed):
+		msg.Info(ctx, "You have already redeemed this card.")
+	case errors.Is(err, billingrepo.ErrVoucherLocked):
+		msg.Danger(ctx, fmt.Sprintf("Too many invalid PINs. Please try again in %d minutes or contact support.",
+			int(limits.Window.Minutes())))
+	case errors.Is(err, billingrepo.ErrVoucherInvalid):
+		left, err := r.billingRepo.VoucherAttemptsLeft(ctx.Request().Context(), client.Username, limits)
+		if err != nil {
+			return r.ctr.Fail(err, "failed to count voucher attempts")
+		}
+		message := "That PIN is not valid, or the card was already used or has expired."
+		if left > 0 {
+			message += fmt.Sprintf(" %d attempts left.", left)
+		}
+		msg.Danger(ctx, message)
+	default:
+		return r.ctr.Fail(err, "failed to redeem voucher")
+	}
+	return r.ctr.Redirect(ctx, routeNames.RouteNameProfile)
+}
+
+func (r *vouchersRoute) limits() billingrepo.VoucherLimits {
+	cfg := r.ctr.Container.Config.Billing.Voucher
+	return billingrepo.VoucherLimits{
+		MaxAttempts: cfg.MaxAttempts,
+		Window:      cfg.Window,
+	}
+}
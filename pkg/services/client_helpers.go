@@ -7,10 +7,18 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/ent/clienttxn"
+	"github.com/mikestefanello/pagoda/ent/clientuser"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+	"github.com/mikestefanello/pagoda/ent/invoice"
 	"github.com/mikestefanello/pagoda/ent/packageplan"
 	"github.com/mikestefanello/pagoda/ent/radacct"
 	"github.com/mikestefanello/pagoda/ent/ticket"
 	"github.com/mikestefanello/pagoda/pkg/context"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/invoicerepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/radiusrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
+	"github.com/mikestefanello/pagoda/pkg/routing/routenames"
 	"github.com/mikestefanello/pagoda/pkg/types"
 )
 
@@ -53,6 +61,22 @@ func (c *Container) GetISPProfileData(ctx echo.Context) (*types.ISPProfileData,
 		ValidUntil:       client.PaymentDate,
 	}
 
+	if client.BillingMode == clientuser.BillingModePostpaid {
+		data.Postpaid = &types.ISPPostpaidAccount{
+			AmountDue:       billingrepo.RoundAmount(max(-client.Balance, 0)),
+			CreditLimit:     client.CreditLimit,
+			AvailableCredit: billingrepo.AvailableCredit(client),
+		}
+		data.AvailableBalance = data.Postpaid.AvailableCredit
+		data.Postpaid.Invoices, _ = c.ORM.Invoice.Query().
+			Where(
+				invoice.ClientIDEQ(client.ID),
+				invoice.StatusIn(invoice.StatusOpen, invoice.StatusOverdue),
+			).
+			Order(ent.Asc(invoice.FieldPeriodStart)).
+			All(ctx.Request().Context())
+	}
+
 	// 1. Get current package
 	if client.UserProfile != "" {
 		pkg, err := c.ORM.PackagePlan.Query().
@@ -64,28 +88,76 @@ func (c *Container) GetISPProfileData(ctx echo.Context) (*types.ISPProfileData,
 	}
 
 	// 2. Determining Expiry & Package Status
-	// Query radcheck for Expiration attribute to get strictly accurate expiry
-	var expirationVal string
-	// Using raw SQL because radcheck might not be in Ent schema or we want to be direct
-	err = c.Database.QueryRowContext(ctx.Request().Context(),
-		"SELECT value FROM radcheck WHERE username = ? AND attribute = 'Expiration'",
-		client.Username).Scan(&expirationVal)
+	// The radcheck Expiration is what RADIUS enforces, so it is the accurate expiry
+	if expiry, err := radiusrepo.NewRadiusRepo(c.ORM).Expiry(ctx.Request().Context(), client.Username); err == nil && expiry != nil {
+		data.ValidUntil = expiry
+		data.Renewal.CurrentExpiry = expiry
+	}
 
+	// A grace period pushes the RADIUS expiry, so show the one that was paid for
+	grace, err := c.ORM.GracePeriod.Query().
+		Where(
+			graceperiod.ClientIDEQ(client.ID),
+			graceperiod.EndedAtIsNil(),
+		).
+		First(ctx.Request().Context())
 	if err == nil {
-		// Parse format: "14 Nov 2025 13:00:22"
-		layout := "02 Jan 2006 15:04:05"
-		if t, err := time.Parse(layout, expirationVal); err == nil {
-			data.ValidUntil = &t
+		data.Grace = grace
+		data.ValidUntil = &grace.ExpiredAt
+		data.Renewal.CurrentExpiry = &grace.ExpiredAt
+	}
+
+	// What renewing now would cost, tax included, and until when it would extend access. Postpaid
+	// clients are invoiced instead.
+	if data.CurrentPackage != nil && data.Postpaid == nil {
+		tax := billingrepo.NewTax(c.Config.Billing.Tax)
+		price := data.CurrentPackage.Price
+		data.Renewal.Available = true
+		if client.NextUserProfile != "" && client.NextUserProfile != client.UserProfile {
+			next, err := c.ORM.PackagePlan.Query().
+				Where(
+					packageplan.ProfileNameEQ(client.NextUserProfile),
+					packageplan.IsActiveEQ(true),
+				).
+				First(ctx.Request().Context())
+			if err == nil {
+				data.Renewal.NextPackage = next
+				price = next.Price
+			}
+		}
+		data.Renewal.Price = tax.Gross(billingrepo.ServiceInternet, price)
+		data.Renewal.NewExpiry = billingrepo.NextExpiry(data.Renewal.CurrentExpiry, time.Now(), c.Config.Billing.CycleDays)
+		data.Renewal.CanAfford = client.Balance >= data.Renewal.Price
+
+		for _, b := range c.Config.Billing.AdvancePayment.Bundles {
+			bundle := billingrepo.AdvanceBundle{Cycles: b.Cycles, Discount: b.Discount}
+			bundlePrice := tax.Gross(billingrepo.ServiceInternet, bundle.Price(price))
+			data.Bundles = append(data.Bundles, types.ISPAdvanceBundle{
+				Cycles:    b.Cycles,
+				Discount:  b.Discount,
+				FullPrice: billingrepo.RoundAmount(data.Renewal.Price * float64(b.Cycles)),
+				Price:     bundlePrice,
+				NewExpiry: billingrepo.NextExpiry(data.Renewal.CurrentExpiry, time.Now(), c.Config.Billing.CycleDays*b.Cycles),
+				CanAfford: client.Balance >= bundlePrice,
+			})
 		}
 	}
+	data.CyclesLeft = billingrepo.CyclesLeft(data.ValidUntil, time.Now(), c.Config.Billing.CycleDays)
 
 	// Set status based on the determined expiry date
 	data.PackageStatus = "Active"
 	if data.ValidUntil != nil && time.Now().After(*data.ValidUntil) {
 		data.PackageStatus = "Expired"
 	}
-	// Fallback if client is explicitly inactive in DB
-	if client.Status != "active" {
+	switch client.Status {
+	case "warning":
+		data.PackageStatus = "Grace"
+	case "throttled":
+		data.PackageStatus = "Throttled"
+	case "suspended":
+		data.PackageStatus = "Suspended"
+	case "inactive":
+		// Fallback if client is explicitly inactive in DB
 		data.PackageStatus = "Inactive"
 	}
 
@@ -107,58 +179,53 @@ func (c *Container) GetISPProfileData(ctx echo.Context) (*types.ISPProfileData,
 	// 6. Get Recent tickets
 	data.Tickets, _ = c.GetRecentTickets(ctx, client.ID, 5)
 
-	return data, nil
-}
-
-func (c *Container) GetUsageStats(ctx echo.Context, username string) types.ISPUsageStats {
-	var stats types.ISPUsageStats
-	dbCtx := ctx.Request().Context()
-
-	now := time.Now()
-
-	// Calculate time boundaries
-	todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-	weekStart := todayStart.AddDate(0, 0, -int(now.Weekday())) // Start of current week (Sunday)
-	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-
-	// Helper function to aggregate usage for a time period
-	aggregateUsage := func(startTime time.Time) uint64 {
-		var total uint64
-
-		rows, err := c.ORM.RadAcct.Query().
-			Where(
-				radacct.UsernameEQ(username),
-				radacct.AcctstarttimeGTE(startTime),
-			).
-			All(dbCtx)
-
-		if err != nil {
-			return 0
+	// 7. Payment gateways available for recharging the balance
+	data.MinTopUp = c.Config.Billing.MinTopUp
+	data.MaxTopUp = c.Config.Billing.MaxTopUp
+	if c.PaymentGateways != nil {
+		for _, g := range c.PaymentGateways.All() {
+			data.PaymentGateways = append(data.PaymentGateways, types.PaymentGatewayOption{
+				Name:  g.Name(),
+				Label: g.Label(),
+			})
 		}
+	}
 
-		for _, r := range rows {
-			// Sum download (input octets)
-			if r.Acctinputoctets != nil {
-				total += uint64(*r.Acctinputoctets)
-			}
-			// Sum upload (output octets)
-			if r.Acctoutputoctets != nil {
-				total += uint64(*r.Acctoutputoctets)
-			}
-		}
+	// 8. Link the client can share for someone else to pay for them
+	data.PayLinkToken = billingrepo.PayLinkToken(c.Config.App.EncryptionKey, client.ID,
+		time.Now().Add(c.Config.Billing.PayForClient.LinkExpiry))
+	data.PayLink = c.Config.HTTP.Domain + ctx.Echo().Reverse(routenames.RouteNamePayForClientPayee, data.PayLinkToken)
 
-		return total
+	// 9. Months the client can download an invoice for, latest first
+	month := invoicerepo.MonthStart(time.Now())
+	for i := 0; i < 6; i++ {
+		data.InvoiceMonths = append(data.InvoiceMonths, month.AddDate(0, -i, 0))
 	}
 
-	// Calculate usage for each period
-	stats.Today = aggregateUsage(todayStart)
-	stats.Weekly = aggregateUsage(weekStart)
-	stats.Monthly = aggregateUsage(monthStart)
+	return data, nil
+}
 
-	// Total usage (all time) - could be optimized with a separate query if needed
-	stats.Total = aggregateUsage(time.Time{}) // Unix epoch = all records
+// GetUsageStats reads a client's traffic from the hourly and daily usage rolled up from radacct
+func (c *Container) GetUsageStats(ctx echo.Context, username string) types.ISPUsageStats {
+	summary, err := usagerepo.NewUsageRepo(c.ORM).Summary(ctx.Request().Context(), username, time.Now())
+	if err != nil {
+		ctx.Logger().Errorf("failed to load usage of %s: %v", username, err)
+	}
+
+	return types.ISPUsageStats{
+		Today:   ISPUsage(summary.Today),
+		Weekly:  ISPUsage(summary.Week),
+		Monthly: ISPUsage(summary.Month),
+		Total:   ISPUsage(summary.All),
+	}
+}
 
-	return stats
+// ISPUsage converts traffic as the NAS counts it to traffic from the client's side
+func ISPUsage(t usagerepo.Totals) types.ISPUsage {
+	return types.ISPUsage{
+		Download: uint64(t.Download()),
+		Upload:   uint64(t.Upload()),
+	}
 }
 
 func (c *Container) GetPaymentHistory(ctx echo.Context, username string, limit int) ([]*ent.ClientTxn, error) {
@@ -185,7 +252,6 @@ func (c *Container) GetRecentTickets(ctx echo.Context, clientID int, limit int)
 		All(ctx.Request().Context())
 }
 
-
 // GetClientID retrieves just the client ID from the session
 // Returns 0 if no client is authenticated
 func (c *Container) GetClientID(ctx echo.Context) int {
@@ -25,8 +25,11 @@ import (
 
 	"github.com/mikestefanello/pagoda/config"
 	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/coa"
 	"github.com/mikestefanello/pagoda/pkg/repos/mailer"
 	"github.com/mikestefanello/pagoda/pkg/repos/notifierrepo"
+	"github.com/mikestefanello/pagoda/pkg/repos/paymentgateway"
 	"github.com/mikestefanello/pagoda/pkg/repos/profilerepo"
 	"github.com/mikestefanello/pagoda/pkg/repos/pubsub"
 	storagerepo "github.com/mikestefanello/pagoda/pkg/repos/storage"
@@ -85,6 +88,15 @@ type Container struct {
 
 	// Tasks stores the task client
 	Tasks *TaskClient
+
+	// PaymentGateways holds the gateways clients can top up their balance through
+	PaymentGateways *paymentgateway.Registry
+
+	// CoA pushes plan changes and suspensions to the sessions clients have open on a NAS
+	CoA *coa.Client
+
+	// Billing charges, renews and refunds clients with the configured tax and session updates
+	Billing *billingrepo.BillingRepo
 }
 
 // NewContainer creates and initializes a new Container
@@ -100,6 +112,8 @@ func NewContainer() *Container {
 	// c.initNotifier()
 	c.initMail()
 	c.initPaymentProcessor()
+	c.initCoA()
+	c.initBilling()
 	// c.initTasks()
 	return c
 }
@@ -222,7 +236,7 @@ func (c *Container) getDBAddr(dbName string) string {
 }
 
 func (c *Container) getProdDBAddr(dbName string) string {
-    // Basic implementation for now, add SSL if needed later
+	// Basic implementation for now, add SSL if needed later
 	c.databaseDSN = fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=True",
 		c.Config.Database.User,
 		c.Config.Database.Password,
@@ -323,14 +337,14 @@ func (c *Container) initORM() {
 	// with pre-existing tables (especially the 'clients' table).
 	// If you need to create/update schema for other tables, uncomment and exclude clients:
 	/*
-	if err := c.ORM.Schema.Create(
-		context.Background(),
-		schema.WithDiffHook(renameColumnHook),
-		// migrate.WithDropIndex(true),
-		// migrate.WithDropColumn(true),
-	); err != nil {
-		panic(fmt.Sprintf("failed to create database schema: %v", err))
-	}
+		if err := c.ORM.Schema.Create(
+			context.Background(),
+			schema.WithDiffHook(renameColumnHook),
+			// migrate.WithDropIndex(true),
+			// migrate.WithDropColumn(true),
+		); err != nil {
+			panic(fmt.Sprintf("failed to create database schema: %v", err))
+		}
 	*/
 }
 
@@ -407,6 +421,23 @@ func (c *Container) initMail() {
 
 func (c *Container) initPaymentProcessor() {
 	stripe.Key = c.Config.App.PrivateStripeKey
+	c.PaymentGateways = paymentgateway.NewRegistry(c.Config, nil)
+}
+
+// initCoA initializes the RADIUS dynamic authorization client
+func (c *Container) initCoA() {
+	var err error
+	if c.CoA, err = coa.NewClient(c.ORM, c.Config.Radius); err != nil {
+		panic(fmt.Sprintf("failed to create coa client: %v", err))
+	}
+}
+
+// initBilling initializes the billing repo shared by the web app and the commands
+func (c *Container) initBilling() {
+	c.Billing = billingrepo.NewBillingRepo(c.ORM, c.Config.Billing.CycleDays).
+		WithTax(c.Config.Billing.Tax).
+		WithSessions(c.CoA).
+		WithVoucherSecret(c.Config.App.EncryptionKey)
 }
 
 // initTasks initializes the task client
@@ -0,0 +1,168 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/ent/dunningstep"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeSendDunningReminders = "billing.dunning"
+
+type (
+	// DunningNotifier sends a message to a client, reporting the channels it reached
+	DunningNotifier interface {
+		Notify(ctx context.Context, client *ent.ClientUser, subject, message string) ([]string, error)
+	}
+
+	SendDunningRemindersProcessor struct {
+		billingRepo *billingrepo.BillingRepo
+		notifier    DunningNotifier
+		offsets     []int
+		currency    string
+	}
+
+	// SendDunningRemindersResult is the summary of a run, kept as the task result
+	SendDunningRemindersResult struct {
+		Due  int `json:"due"`
+		Sent int `json:"sent"`
+		// AlreadySent counts steps sent by an earlier run, Settled clients who paid in the meantime
+		AlreadySent int `json:"already_sent"`
+		Settled     int `json:"settled"`
+		Failed      int `json:"failed"`
+	}
+)
+
+func NewSendDunningRemindersProcessor(
+	billingRepo *billingrepo.BillingRepo, notifier DunningNotifier, offsets []int, currency string,
+) *SendDunningRemindersProcessor {
+	return &SendDunningRemindersProcessor{
+		billingRepo: billingRepo,
+		notifier:    notifier,
+		offsets:     offsets,
+		currency:    currency,
+	}
+}
+
+func (s *SendDunningRemindersProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	started := time.Now()
+	notices, err := s.billingRepo.DueDunning(ctx, started, s.offsets)
+	if err != nil {
+		return err
+	}
+
+	result := SendDunningRemindersResult{Due: len(notices)}
+	for _, n := range notices {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		subject, message := DunningMessage(n, s.currency)
+		step, err := s.billingRepo.ClaimDunningStep(ctx, n, subject, message)
+		switch {
+		case errors.Is(err, billingrepo.ErrDunningSettled):
+			result.Settled++
+			continue
+		case err != nil:
+			result.Failed++
+			log.Error().Err(err).Str("username", n.Client.Username).Str("reference", n.Reference).
+				Msg("failed to record dunning reminder")
+			continue
+		case step == nil:
+			result.AlreadySent++
+			continue
+		}
+
+		channels, serr := s.notifier.Notify(ctx, n.Client, subject, message)
+		if serr != nil {
+			result.Failed++
+			log.Error().Err(serr).Str("username", n.Client.Username).Str("reference", n.Reference).
+				Msg("failed to send dunning reminder")
+		} else {
+			result.Sent++
+		}
+		if rerr := s.billingRepo.RecordDunningResult(ctx, step.ID, channels, serr); rerr != nil {
+			log.Error().Err(rerr).Int("step", step.ID).Msg("failed to record dunning result")
+		}
+	}
+
+	log.Info().
+		Int("due", result.Due).
+		Int("sent", result.Sent).
+		Int("already_sent", result.AlreadySent).
+		Int("settled", result.Settled).
+		Int("failed", result.Failed).
+		Dur("took", time.Since(started)).
+		Msg("dunning run finished")
+
+	if w := t.ResultWriter(); w != nil {
+		if b, jerr := json.Marshal(result); jerr == nil {
+			if _, werr := w.Write(b); werr != nil {
+				log.Warn().Err(werr).Msg("failed to store dunning summary")
+			}
+		}
+	}
+
+	return nil
+}
+
+// DunningMessage is the reminder for a step of the dunning schedule, firmer the later the step
+func DunningMessage(n billingrepo.DunningNotice, currency string) (subject, message string) {
+	due := n.DueAt.Format("02 Jan 2006")
+	if n.Kind == dunningstep.KindInvoice {
+		amount := fmt.Sprintf("%.2f %s", n.Amount, currency)
+		switch n.Level {
+		case dunningstep.LevelReminder:
+			return fmt.Sprintf("Invoice %s is due on %s", n.Reference, due), fmt.Sprintf(
+				"Dear %s, this is a reminder that invoice %s of %s is due on %s.",
+				n.Client.Name, n.Reference, amount, due)
+		case dunningstep.LevelDue:
+			return fmt.Sprintf("Invoice %s is due today", n.Reference), fmt.Sprintf(
+				"Dear %s, invoice %s of %s is due today. Please pay it in the portal.",
+				n.Client.Name, n.Reference, amount)
+		case dunningstep.LevelOverdue:
+			return fmt.Sprintf("Invoice %s is overdue", n.Reference), fmt.Sprintf(
+				"Dear %s, invoice %s was due on %s and %s is still unpaid. "+
+					"Please pay it to avoid suspension of your connection.",
+				n.Client.Name, n.Reference, due, amount)
+		default:
+			return fmt.Sprintf("Final notice: invoice %s", n.Reference), fmt.Sprintf(
+				"Dear %s, invoice %s was due on %s and %s is still unpaid. This is our final notice; "+
+					"your connection will be suspended unless you pay now.",
+				n.Client.Name, n.Reference, due, amount)
+		}
+	}
+
+	topUp := ""
+	if short := n.Amount - n.Client.Balance; short > 0 {
+		topUp = fmt.Sprintf(" Your balance is %.2f %s, so please top up at least %.2f %s first.",
+			n.Client.Balance, currency, short, currency)
+	}
+	price := fmt.Sprintf("%.2f %s", n.Amount, currency)
+	switch n.Level {
+	case dunningstep.LevelReminder:
+		return fmt.Sprintf("Your package expires on %s", due), fmt.Sprintf(
+			"Dear %s, your %s package expires on %s. Renewing it costs %s.%s",
+			n.Client.Name, n.Package.Name, due, price, topUp)
+	case dunningstep.LevelDue:
+		return "Your package expires today", fmt.Sprintf(
+			"Dear %s, your %s package expires today. Renew it for %s to stay connected.%s",
+			n.Client.Name, n.Package.Name, price, topUp)
+	case dunningstep.LevelOverdue:
+		return "Your package has expired", fmt.Sprintf(
+			"Dear %s, your %s package expired on %s. Renew it for %s to avoid interruption.%s",
+			n.Client.Name, n.Package.Name, due, price, topUp)
+	default:
+		return "Final notice: renew your package", fmt.Sprintf(
+			"Dear %s, your %s package expired on %s and has not been renewed. This is our final notice; "+
+				"renew it for %s now to keep your connection.%s",
+			n.Client.Name, n.Package.Name, due, price, topUp)
+	}
+}
@@ -0,0 +1,104 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/ent/graceperiod"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeEnforceGracePeriods = "package.grace"
+
+type (
+	EnforceGracePeriodsProcessor struct {
+		billingRepo *billingrepo.BillingRepo
+		notifier    ClientNotifier
+		policy      billingrepo.GracePolicy
+	}
+
+	// EnforceGracePeriodsResult is the summary of a run, kept as the task result
+	EnforceGracePeriodsResult struct {
+		*billingrepo.GraceSummary
+		Notified int `json:"notified"`
+	}
+)
+
+func NewEnforceGracePeriodsProcessor(
+	billingRepo *billingrepo.BillingRepo, notifier ClientNotifier, policy billingrepo.GracePolicy,
+) *EnforceGracePeriodsProcessor {
+	return &EnforceGracePeriodsProcessor{
+		billingRepo: billingRepo,
+		notifier:    notifier,
+		policy:      policy,
+	}
+}
+
+func (e *EnforceGracePeriodsProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	started := time.Now()
+	summary, err := e.billingRepo.EnforceGrace(ctx, e.policy)
+	if err != nil && summary == nil {
+		return err
+	}
+
+	result := EnforceGracePeriodsResult{GraceSummary: summary}
+	for _, c := range summary.Changes {
+		subject, message := graceNotice(c)
+		if nerr := e.notifier.NotifyClient(ctx, c.Client, subject, message); nerr != nil {
+			log.Error().Err(nerr).Str("username", c.Client.Username).Msg("failed to notify client of grace period change")
+			continue
+		}
+		result.Notified++
+	}
+
+	log.Info().
+		Int("expired", summary.Expired).
+		Int("warned", summary.Warned).
+		Int("throttled", summary.Throttled).
+		Int("suspended", summary.Suspended).
+		Int("reactivated", summary.Reactivated).
+		Int("notified", result.Notified).
+		Int("failed", summary.Failed).
+		Dur("took", time.Since(started)).
+		Msg("grace period run finished")
+
+	if w := t.ResultWriter(); w != nil {
+		if b, jerr := json.Marshal(result); jerr == nil {
+			if _, werr := w.Write(b); werr != nil {
+				log.Warn().Err(werr).Msg("failed to store grace period summary")
+			}
+		}
+	}
+
+	return err
+}
+
+// graceNotice is the message telling a client which stage of their grace period they reached
+func graceNotice(c billingrepo.GraceChange) (subject, message string) {
+	until := c.Grace.GraceUntil.Format("02 Jan 2006 03:04 PM")
+	switch {
+	case c.Renewal != nil:
+		return "Your connection is restored", fmt.Sprintf(
+			"Dear %s, your %s package was renewed until %s and your connection is back to full speed.",
+			c.Client.Name, c.Renewal.Package.Name, c.Renewal.Expiry.Format("02 Jan 2006 03:04 PM"))
+	case c.Grace.Stage == graceperiod.StageWarning:
+		return "Your package has expired", fmt.Sprintf(
+			"Dear %s, your package expired on %s. Your connection stays on until %s; "+
+				"please recharge and renew before then to avoid interruption.",
+			c.Client.Name, c.Grace.ExpiredAt.Format("02 Jan 2006 03:04 PM"), until)
+	case c.Grace.Stage == graceperiod.StageThrottled:
+		return "Your connection has been slowed down", fmt.Sprintf(
+			"Dear %s, your package has expired and your speed is now limited. "+
+				"Your connection will be suspended on %s unless you renew.",
+			c.Client.Name, until)
+	default:
+		return "Your connection has been suspended", fmt.Sprintf(
+			"Dear %s, your grace period has ended and your connection is suspended. "+
+				"Log in to the portal and renew your package to restore it.",
+			c.Client.Name)
+	}
+}
@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeCheckLedger = "ledger.check"
+
+// CheckLedgerProcessor checks every client's balance against their transactions. It never
+// repairs anything; the report it keeps as the task result can be reviewed and fed to
+// `go run ./cmd/ledger repair`.
+type CheckLedgerProcessor struct {
+	billingRepo *billingrepo.BillingRepo
+}
+
+func NewCheckLedgerProcessor(billingRepo *billingrepo.BillingRepo) *CheckLedgerProcessor {
+	return &CheckLedgerProcessor{
+		billingRepo: billingRepo,
+	}
+}
+
+func (c *CheckLedgerProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	started := time.Now()
+	report, err := c.billingRepo.CheckLedger(ctx)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[billingrepo.LedgerIssueKind]int)
+	for _, issue := range report.Issues {
+		counts[issue.Kind]++
+	}
+	event := log.Info()
+	if len(report.Issues) > 0 {
+		event = log.Warn()
+	}
+	event.
+		Int("clients", report.ClientsChecked).
+		Int("txns", report.TxnsChecked).
+		Int("clients_with_issues", report.ClientsWithIssues).
+		Interface("issues", counts).
+		Dur("took", time.Since(started)).
+		Msg("ledger check finished")
+
+	if w := t.ResultWriter(); w != nil {
+		if b, jerr := json.Marshal(report); jerr == nil {
+			if _, werr := w.Write(b); werr != nil {
+				log.Warn().Err(werr).Msg("failed to store ledger report")
+			}
+		}
+	}
+
+	return nil
+}
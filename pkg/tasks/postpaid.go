@@ -0,0 +1,101 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const TypePostpaidBilling = "postpaid.billing"
+
+type (
+	PostpaidBillingProcessor struct {
+		billingRepo *billingrepo.BillingRepo
+		notifier    ClientNotifier
+		policy      billingrepo.PostpaidPolicy
+		currency    string
+	}
+
+	// PostpaidBillingResult is the summary of a run, kept as the task result
+	PostpaidBillingResult struct {
+		*billingrepo.PostpaidSummary
+		Notified int `json:"notified"`
+	}
+)
+
+func NewPostpaidBillingProcessor(
+	billingRepo *billingrepo.BillingRepo, notifier ClientNotifier, policy billingrepo.PostpaidPolicy, currency string,
+) *PostpaidBillingProcessor {
+	return &PostpaidBillingProcessor{
+		billingRepo: billingRepo,
+		notifier:    notifier,
+		policy:      policy,
+		currency:    currency,
+	}
+}
+
+func (p *PostpaidBillingProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	started := time.Now()
+	summary, err := p.billingRepo.RunPostpaidBilling(ctx, started, p.policy)
+	if err != nil && summary == nil {
+		return err
+	}
+
+	result := PostpaidBillingResult{PostpaidSummary: summary}
+	notify := func(username string, send func() error) {
+		if nerr := send(); nerr != nil {
+			log.Error().Err(nerr).Str("username", username).Msg("failed to notify postpaid client")
+			return
+		}
+		result.Notified++
+	}
+	for _, i := range summary.Invoices {
+		subject := fmt.Sprintf("Your invoice for %s", i.Invoice.PeriodStart.Format("January 2006"))
+		message := fmt.Sprintf("Dear %s, invoice %s of %.2f %s is due on %s.",
+			i.Client.Name, i.Invoice.Number, i.Invoice.Amount, p.currency, i.Invoice.DueAt.Format("02 Jan 2006"))
+		notify(i.Client.Username, func() error { return p.notifier.NotifyClient(ctx, i.Client, subject, message) })
+	}
+	for _, i := range summary.NewlyOverdue {
+		subject := fmt.Sprintf("Invoice %s is overdue", i.Invoice.Number)
+		message := fmt.Sprintf(
+			"Dear %s, invoice %s of %.2f %s was due on %s and is still unpaid. "+
+				"Please pay it to avoid suspension of your connection.",
+			i.Client.Name, i.Invoice.Number, i.Invoice.Amount-i.Invoice.PaidAmount, p.currency,
+			i.Invoice.DueAt.Format("02 Jan 2006"))
+		notify(i.Client.Username, func() error { return p.notifier.NotifyClient(ctx, i.Client, subject, message) })
+	}
+	for _, c := range summary.SuspendedClients {
+		subject := "Your connection has been suspended"
+		message := fmt.Sprintf(
+			"Dear %s, your connection is suspended for an overdue invoice. Your amount due is %.2f %s; "+
+				"it is restored as soon as you pay.",
+			c.Name, -c.Balance, p.currency)
+		notify(c.Username, func() error { return p.notifier.NotifyClient(ctx, c, subject, message) })
+	}
+
+	log.Info().
+		Time("period", summary.Period).
+		Int("issued", summary.Issued).
+		Float64("billed", summary.Billed).
+		Int("overdue", summary.Overdue).
+		Int("suspended", summary.Suspended).
+		Int("notified", result.Notified).
+		Int("failed", summary.Failed).
+		Dur("took", time.Since(started)).
+		Msg("postpaid billing run finished")
+
+	if w := t.ResultWriter(); w != nil {
+		if b, jerr := json.Marshal(result); jerr == nil {
+			if _, werr := w.Write(b); werr != nil {
+				log.Warn().Err(werr).Msg("failed to store postpaid billing summary")
+			}
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,88 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/ent"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeAutoRenewPackages = "package.auto_renew"
+
+type (
+	// ClientNotifier sends a message to an ISP client
+	ClientNotifier interface {
+		NotifyClient(ctx context.Context, client *ent.ClientUser, subject, message string) error
+	}
+
+	AutoRenewPackagesProcessor struct {
+		billingRepo *billingrepo.BillingRepo
+		notifier    ClientNotifier
+		window      time.Duration
+	}
+
+	// AutoRenewPackagesResult is the summary of a run, kept as the task result
+	AutoRenewPackagesResult struct {
+		*billingrepo.AutoRenewalSummary
+		Notified int `json:"notified"`
+	}
+)
+
+func NewAutoRenewPackagesProcessor(
+	billingRepo *billingrepo.BillingRepo, notifier ClientNotifier, window time.Duration,
+) *AutoRenewPackagesProcessor {
+	return &AutoRenewPackagesProcessor{
+		billingRepo: billingRepo,
+		notifier:    notifier,
+		window:      window,
+	}
+}
+
+func (a *AutoRenewPackagesProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	started := time.Now()
+	summary, err := a.billingRepo.AutoRenew(ctx, a.window)
+	if err != nil && summary == nil {
+		return err
+	}
+
+	result := AutoRenewPackagesResult{AutoRenewalSummary: summary}
+	for _, u := range summary.Unfunded {
+		subject := fmt.Sprintf("Your %s package could not be renewed", u.Package.Name)
+		message := fmt.Sprintf(
+			"Dear %s, your %s package expires on %s and auto renewal needs %.2f, but your balance is %.2f. "+
+				"Please recharge to keep your connection running.",
+			u.Client.Name, u.Package.Name, u.Expiry.Format("02 Jan 2006 03:04 PM"), u.Amount, u.Client.Balance)
+		if nerr := a.notifier.NotifyClient(ctx, u.Client, subject, message); nerr != nil {
+			log.Error().Err(nerr).Str("username", u.Client.Username).Msg("failed to notify client of unfunded auto renewal")
+			continue
+		}
+		result.Notified++
+	}
+
+	log.Info().
+		Int("due", summary.Due).
+		Int("renewed", summary.Renewed).
+		Int("switched", summary.Switched).
+		Int("skipped", summary.Skipped).
+		Int("insufficient_funds", summary.InsufficientFunds).
+		Int("notified", result.Notified).
+		Int("failed", summary.Failed).
+		Float64("collected", summary.Collected).
+		Dur("took", time.Since(started)).
+		Msg("auto renewal run finished")
+
+	if w := t.ResultWriter(); w != nil {
+		if b, jerr := json.Marshal(result); jerr == nil {
+			if _, werr := w.Write(b); werr != nil {
+				log.Warn().Err(werr).Msg("failed to store auto renewal summary")
+			}
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,152 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/ent/settlementrow"
+	"github.com/mikestefanello/pagoda/pkg/repos/billingrepo"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TypeReconcileSettlements = "settlement.reconcile"
+
+	importedBySettlementTask = "settlement-task"
+)
+
+type (
+	// ReconcileSettlementsProcessor imports the settlement files dropped into the inbox and
+	// reconciles the previous day
+	ReconcileSettlementsProcessor struct {
+		billingRepo *billingrepo.BillingRepo
+		inbox       string
+		reportDir   string
+	}
+
+	// ReconcileSettlementsResult is the summary of a run, kept as the task result
+	ReconcileSettlementsResult struct {
+		Imports []*billingrepo.SettlementImport `json:"imports"`
+		// Failed lists the files that could not be imported and were left in the inbox
+		Failed []string                      `json:"failed"`
+		Report *billingrepo.SettlementReport `json:"report"`
+	}
+)
+
+func NewReconcileSettlementsProcessor(
+	billingRepo *billingrepo.BillingRepo, inbox, reportDir string,
+) *ReconcileSettlementsProcessor {
+	return &ReconcileSettlementsProcessor{
+		billingRepo: billingRepo,
+		inbox:       inbox,
+		reportDir:   reportDir,
+	}
+}
+
+func (r *ReconcileSettlementsProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	started := time.Now()
+	result := ReconcileSettlementsResult{}
+
+	for _, gateway := range billingrepo.SettlementGateways {
+		dir := filepath.Join(r.inbox, string(gateway))
+		files, err := filepath.Glob(filepath.Join(dir, "*.csv"))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			imported, err := r.importFile(ctx, gateway, file)
+			if err != nil {
+				log.Error().Err(err).Str("file", file).Msg("failed to import settlement file")
+				result.Failed = append(result.Failed, file)
+				continue
+			}
+			result.Imports = append(result.Imports, imported)
+		}
+	}
+
+	report, err := r.billingRepo.SettlementReport(ctx, time.Now().AddDate(0, 0, -1))
+	if err != nil {
+		return err
+	}
+	result.Report = report
+	if r.reportDir != "" {
+		if err := writeSettlementReport(r.reportDir, report); err != nil {
+			return err
+		}
+	}
+
+	event := log.Info()
+	if len(report.Exceptions) > 0 || len(result.Failed) > 0 {
+		event = log.Warn()
+	}
+	event.
+		Str("day", report.Day).
+		Int("files", len(result.Imports)).
+		Int("failed_files", len(result.Failed)).
+		Int("exceptions", len(report.Exceptions)).
+		Int("unsettled", len(report.Unsettled)).
+		Dur("took", time.Since(started)).
+		Msg("settlement reconciliation finished")
+
+	if w := t.ResultWriter(); w != nil {
+		if b, jerr := json.Marshal(result); jerr == nil {
+			if _, werr := w.Write(b); werr != nil {
+				log.Warn().Err(werr).Msg("failed to store settlement reconciliation result")
+			}
+		}
+	}
+
+	return nil
+}
+
+// importFile imports one settlement file and moves it out of the inbox. Rows without a date are
+// taken to be settled on the day the file was dropped in.
+func (r *ReconcileSettlementsProcessor) importFile(
+	ctx context.Context, gateway settlementrow.Gateway, file string,
+) (*billingrepo.SettlementImport, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := billingrepo.ParseSettlementCSV(f, info.ModTime())
+	if err != nil {
+		return nil, err
+	}
+	imported, err := r.billingRepo.ImportSettlement(ctx, gateway, rows, filepath.Base(file), importedBySettlementTask)
+	if err != nil {
+		return nil, err
+	}
+
+	done := filepath.Join(filepath.Dir(file), "imported")
+	if err := os.MkdirAll(done, 0o750); err != nil {
+		return nil, err
+	}
+	// Rows already imported are skipped, so a file that cannot be moved is harmless to import again
+	target := filepath.Join(done, fmt.Sprintf("%s-%s", time.Now().Format("20060102150405"), filepath.Base(file)))
+	if err := os.Rename(file, target); err != nil {
+		log.Warn().Err(err).Str("file", file).Msg("failed to move imported settlement file")
+	}
+	return imported, nil
+}
+
+func writeSettlementReport(dir string, report *billingrepo.SettlementReport) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "settlement-"+report.Day+".json"), b, 0o640)
+}
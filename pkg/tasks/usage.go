@@ -0,0 +1,41 @@
+package tasks
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/mikestefanello/pagoda/pkg/repos/usagerepo"
+	"github.com/rs/zerolog/log"
+)
+
+const TypeRollupUsage = "usage.rollup"
+
+// RollupUsageProcessor rolls the sessions FreeRADIUS wrote to radacct up into hourly and daily
+// usage. Sessions recorded by cmd/radacct are rolled up as they come in, so for them it only
+// catches up on failures.
+type RollupUsageProcessor struct {
+	usageRepo *usagerepo.UsageRepo
+	lookback  time.Duration
+}
+
+func NewRollupUsageProcessor(usageRepo *usagerepo.UsageRepo, lookback time.Duration) *RollupUsageProcessor {
+	return &RollupUsageProcessor{
+		usageRepo: usageRepo,
+		lookback:  lookback,
+	}
+}
+
+func (p *RollupUsageProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	started := time.Now()
+	count, err := p.usageRepo.RollupSince(ctx, started.Add(-p.lookback))
+	event := log.Info()
+	if err != nil {
+		event = log.Warn().Err(err)
+	}
+	event.
+		Int("sessions", count).
+		Dur("took", time.Since(started)).
+		Msg("usage rollup finished")
+	return err
+}
@@ -10,9 +10,11 @@ import (
 	"testing"
 	"time"
 
+	_ "github.com/go-sql-driver/mysql"
 	"github.com/mikestefanello/pagoda/ent"
 	"github.com/mikestefanello/pagoda/ent/enttest"
 	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/mysql"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"github.com/testcontainers/testcontainers-go/wait"
 	"golang.org/x/exp/rand"
@@ -107,6 +109,36 @@ func CreateTestContainerPostgresEntClient(t *testing.T) (*ent.Client, context.Co
 	return client, ctx
 }
 
+// CreateTestContainerMySQLEntClient starts a MySQL database with the ent schema, for tests of the
+// ISP and FreeRADIUS tables that live in MySQL in production
+func CreateTestContainerMySQLEntClient(t *testing.T) (*ent.Client, context.Context) {
+	ctx := context.Background()
+
+	mysqlContainer, err := mysql.RunContainer(ctx,
+		testcontainers.WithImage("mysql:8.0.36"),
+		mysql.WithDatabase("test-db"),
+		mysql.WithUsername("mysql"),
+		mysql.WithPassword("mysql"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := mysqlContainer.Terminate(ctx); err != nil {
+			t.Fatalf("failed to terminate mysqlContainer: %s", err)
+		}
+	})
+	connStr, err := mysqlContainer.ConnectionString(ctx, "parseTime=true")
+	assert.NoError(t, err)
+
+	client := enttest.Open(t, "mysql", connStr)
+	t.Cleanup(func() {
+		client.Close()
+	})
+	return client, ctx
+}
+
 // CreateUser creates a random user entity
 func CreateRandomUser(orm *ent.Client) (*ent.User, error) {
 	seed := fmt.Sprintf("%d-%d", time.Now().UnixMilli(), rand.Intn(1000000))
@@ -147,3 +179,40 @@ func LinkFriends(ctx context.Context, client *ent.Client, profileID int, matchID
 		}
 	}
 }
+
+// CreateClientUser creates an ISP client with the given username and balance
+func CreateClientUser(ctx context.Context, client *ent.Client, username string, balance float64) *ent.ClientUser {
+	return client.ClientUser.
+		Create().
+		SetName(fmt.Sprintf("Client %s", username)).
+		SetUsername(username).
+		SetPassword("password").
+		SetMobileNumber("01700000000").
+		SetEmail(fmt.Sprintf("%s@localhost.localhost", username)).
+		SetBalance(balance).
+		SetStatus("active").
+		SetCName("test").
+		SetVendorID(1).
+		SetCreatedBy("test").
+		SaveX(ctx)
+}
+
+// CreatePackagePlan creates an active package whose RADIUS profile is its name
+func CreatePackagePlan(ctx context.Context, client *ent.Client, name string, price float64) *ent.PackagePlan {
+	return client.PackagePlan.
+		Create().
+		SetName(name).
+		SetProfileName(name).
+		SetPoolName(fmt.Sprintf("%s-pool", name)).
+		SetPrice(price).
+		SaveX(ctx)
+}
+
+// CreateSubscriber creates an ISP client on the given package with the given balance
+func CreateSubscriber(ctx context.Context, client *ent.Client, username string, balance float64, plan *ent.PackagePlan) *ent.ClientUser {
+	return CreateClientUser(ctx, client, username, balance).
+		Update().
+		SetUserProfile(plan.ProfileName).
+		SetPackagePool(plan.PoolName).
+		SaveX(ctx)
+}
@@ -7,25 +7,110 @@ import (
 )
 
 type ISPProfileData struct {
-	Client          *ent.ClientUser
-	CurrentPackage  *ent.PackagePlan
-	Usage           ISPUsageStats
-	Payments        []*ent.ClientTxn
-	Sessions        []*ent.RadAcct
-	Tickets         []*ent.Ticket
-	Balance         float64
+	Client           *ent.ClientUser
+	CurrentPackage   *ent.PackagePlan
+	Usage            ISPUsageStats
+	Payments         []*ent.ClientTxn
+	Sessions         []*ent.RadAcct
+	Tickets          []*ent.Ticket
+	Balance          float64
 	AvailableBalance float64
-	ValidUntil      *time.Time
-	AutoRenew       bool
-	PackageStatus   string // "Active", "Expired", etc.
+	ValidUntil       *time.Time
+	AutoRenew        bool
+	PackageStatus    string // "Active", "Expired", etc.
 	ConnectionStatus string // "Online", "Offline"
+	PaymentGateways  []PaymentGatewayOption
+	MinTopUp         float64
+	MaxTopUp         float64
+	Renewal          ISPRenewalPreview
+	// Bundles are the advance payments the client can make for their package
+	Bundles []ISPAdvanceBundle
+	// CyclesLeft is how many paid billing cycles remain, counting the current one
+	CyclesLeft int
+	// InvoiceMonths are the months offered for invoice download, latest first
+	InvoiceMonths []time.Time
+	// Grace is the grace period the client is in since their package expired, nil if none
+	Grace *ent.GracePeriod
+	// Postpaid is set for clients billed monthly, who are shown what they owe instead of a balance
+	Postpaid *ISPPostpaidAccount
+	// PayLink is a signed link the client can share for someone else to pay for them, PayLinkToken
+	// the token in it
+	PayLink      string
+	PayLinkToken string
+}
+
+// ISPPostpaidAccount is what a postpaid client owes
+type ISPPostpaidAccount struct {
+	AmountDue       float64
+	CreditLimit     float64
+	AvailableCredit float64
+	// Invoices are the unpaid invoices, oldest first
+	Invoices []*ent.Invoice
+}
+
+// ISPRenewalPreview describes what renewing the current package would do
+type ISPRenewalPreview struct {
+	Available bool
+	// NextPackage is the package scheduled to replace the current one at renewal, if any
+	NextPackage *ent.PackagePlan
+	Price       float64
+	CanAfford   bool
+	// CurrentExpiry is the RADIUS expiry the renewal extends, nil if none is set
+	CurrentExpiry *time.Time
+	NewExpiry     time.Time
+}
+
+// ISPAdvanceBundle is the price of prepaying several cycles of the package being renewed
+type ISPAdvanceBundle struct {
+	Cycles    int
+	Discount  float64
+	FullPrice float64
+	Price     float64
+	NewExpiry time.Time
+	CanAfford bool
+}
+
+type PaymentGatewayOption struct {
+	Name  string
+	Label string
 }
 
 type ISPUsageStats struct {
-	Today   uint64 // in bytes
-	Weekly  uint64
-	Monthly uint64
-	Total   uint64
+	Today   ISPUsage
+	Weekly  ISPUsage
+	Monthly ISPUsage
+	Total   ISPUsage
+}
+
+// ISPUsage is traffic in bytes, from the client's side
+type ISPUsage struct {
+	Download uint64
+	Upload   uint64
+}
+
+func (u ISPUsage) Total() uint64 {
+	return u.Download + u.Upload
+}
+
+// ISPUsageChart is the client's download and upload per day, week or month
+type ISPUsageChart struct {
+	Period  string
+	Periods []string
+	Points  []ISPUsagePoint
+	// Max is the largest download or upload of a point, the full height of the chart
+	Max    uint64
+	Totals ISPUsage
+}
+
+type ISPUsagePoint struct {
+	Label string
+	ISPUsage
+}
+
+// ISPUsageHour is the traffic in the hour starting at Date, as the heatmap reads it
+type ISPUsageHour struct {
+	Date  string `json:"date"`
+	Value uint64 `json:"value"`
 }
 
 type TicketForm struct {
@@ -33,3 +118,199 @@ type TicketForm struct {
 	Description string `form:"description" validate:"required"`
 	Submission  FormSubmission
 }
+
+type TopUpForm struct {
+	Amount     float64 `form:"amount" validate:"required,gt=0"`
+	Gateway    string  `form:"gateway" validate:"required"`
+	Submission FormSubmission
+}
+
+type VoucherForm struct {
+	PIN        string `form:"pin" validate:"required,max=32"`
+	Submission FormSubmission
+}
+
+type RenewPackageForm struct {
+	// ExpectedExpiry is the unix time of the expiry shown to the client, 0 if none
+	ExpectedExpiry int64  `form:"expected_expiry"`
+	Coupon         string `form:"coupon" validate:"max=64"`
+	Submission     FormSubmission
+}
+
+type AdvancePaymentForm struct {
+	Cycles int `form:"cycles" validate:"required,gt=1"`
+	// ExpectedExpiry is the unix time of the expiry shown to the client, 0 if none
+	ExpectedExpiry int64 `form:"expected_expiry"`
+	Submission     FormSubmission
+}
+
+type ISPChangePlanData struct {
+	Client         *ent.ClientUser
+	CurrentPackage *ent.PackagePlan
+	NextPackage    *ent.PackagePlan
+	Plans          []*ent.PackagePlan
+	Selected       *ent.PackagePlan
+	Preview        *ISPPlanChangePreview
+	ValidUntil     *time.Time
+	// Coupon is the coupon code the client entered, kept in the form when it could not be applied
+	Coupon string
+}
+
+// ISPPlanChangePreview is the price of switching to the selected package right away
+type ISPPlanChangePreview struct {
+	DaysLeft int
+	Credit   float64
+	Charge   float64
+	// Discount is what the applied coupon, CouponCode, took off the charge
+	Discount   float64
+	CouponCode string
+	// Tax is the tax on the change, added to it unless TaxInclusive. TaxLabel names it, e.g. VAT 5%.
+	Tax          float64
+	TaxLabel     string
+	TaxInclusive bool
+	// Amount is charged to the client when positive and credited when negative
+	Amount    float64
+	CanAfford bool
+}
+
+type ChangePlanForm struct {
+	PlanID     int     `form:"plan_id" validate:"required"`
+	Mode       string  `form:"mode" validate:"required,oneof=now next"`
+	Amount     float64 `form:"amount"`
+	Coupon     string  `form:"coupon" validate:"max=64"`
+	Submission FormSubmission
+}
+
+// ISPTransactionsData is a page of a client's filtered transaction history
+type ISPTransactionsData struct {
+	Rows     []ISPTxnRow
+	Filter   ISPTxnFilter
+	Types    []SelectOption
+	Statuses []SelectOption
+	Methods  []SelectOption
+	// Query is the encoded filter, carried over to pagination and export links
+	Query string
+}
+
+type ISPTxnRow struct {
+	Txn         *ent.ClientTxn
+	TypeLabel   string
+	MethodLabel string
+	// Credit is set when the transaction added to the balance
+	Credit bool
+}
+
+// ISPTxnFilter holds the filter values as submitted, dates as YYYY-MM-DD
+type ISPTxnFilter struct {
+	Type   string
+	Status string
+	Method string
+	From   string
+	To     string
+}
+
+type SelectOption struct {
+	Value string
+	Label string
+}
+
+// ISPTransferData is the balance transfer page, either the transfer form or, once a transfer is
+// started, the one-time code form
+type ISPTransferData struct {
+	Balance  float64
+	Currency string
+	Pending  *ent.BalanceTransfer
+	// RecipientName is masked so the sender can tell they picked the right account
+	RecipientName string
+	SentToday     float64
+	CountToday    int
+	MinAmount     float64
+	MaxAmount     float64
+	DailyAmount   float64
+	DailyCount    int
+}
+
+type TransferForm struct {
+	Recipient  string  `form:"recipient" validate:"required"`
+	Amount     float64 `form:"amount" validate:"required,gt=0"`
+	Submission FormSubmission
+}
+
+type ConfirmTransferForm struct {
+	TransferID int    `form:"transfer_id" validate:"required"`
+	Code       string `form:"code" validate:"required,numeric,len=6"`
+	Submission FormSubmission
+}
+
+// ISPRefundsData lists a client's refund requests next to the form to open one
+type ISPRefundsData struct {
+	Balance  float64
+	Requests []*ent.RefundRequest
+	// Charges are failed payments the client can ask to get back
+	Charges       []*ent.ClientTxn
+	PayoutMethods []SelectOption
+}
+
+// ISPManualPaymentData shows the merchant numbers clients send money to, the form to report a
+// payment and the client's recent reports
+type ISPManualPaymentData struct {
+	Providers []ManualPaymentProvider
+	Payments  []*ent.ManualPayment
+}
+
+// ManualPaymentProvider is a mobile banking service with the merchant number to send money to
+type ManualPaymentProvider struct {
+	Value  string
+	Label  string
+	Number string
+}
+
+type ManualPaymentForm struct {
+	Provider     string  `form:"provider" validate:"required,oneof=bkash nagad"`
+	SenderNumber string  `form:"sender_number" validate:"required,max=32"`
+	TrxID        string  `form:"trx_id" validate:"required,alphanum,max=64"`
+	Amount       float64 `form:"amount" validate:"required,gt=0"`
+	Submission   FormSubmission
+}
+
+type RefundForm struct {
+	Kind          string  `form:"kind" validate:"required,oneof=balance failed_charge"`
+	Amount        float64 `form:"amount"`
+	ChargeRef     string  `form:"charge_ref"`
+	PayoutMethod  string  `form:"payout_method" validate:"required,oneof=bkash nagad bank_transfer"`
+	PayoutAccount string  `form:"payout_account" validate:"required,max=128"`
+	Reason        string  `form:"reason" validate:"required,max=1000"`
+	Submission    FormSubmission
+}
+
+// PayForClientData is the public page where someone pays for a client. It asks for a username
+// until Payee is set, and shows the outcome once Result is.
+type PayForClientData struct {
+	Payee *PayForClientPayee
+	// Result is the payment the payer came back from the gateway with
+	Result          *ent.ClientTxn
+	PaymentGateways []PaymentGatewayOption
+	MinTopUp        float64
+	MaxTopUp        float64
+	Currency        string
+}
+
+// PayForClientPayee is the client being paid for, identified by Token rather than username
+type PayForClientPayee struct {
+	Token      string
+	MaskedName string
+	AmountDue  float64
+}
+
+type PayForClientLookupForm struct {
+	Username   string `form:"username" validate:"required,max=255"`
+	Submission FormSubmission
+}
+
+type PayForClientForm struct {
+	Amount     float64 `form:"amount" validate:"required,gt=0"`
+	Gateway    string  `form:"gateway" validate:"required"`
+	PayerName  string  `form:"payer_name" validate:"max=100"`
+	PayerPhone string  `form:"payer_phone" validate:"max=32"`
+	Submission FormSubmission
+}
@@ -19,6 +19,14 @@ const (
 	PageDisplayName            Page = "profile.display_name"
 	PageInstallApp             Page = "install_app"
 	PageDashboard              Page = "dashboard"
+	PageChangePlan             Page = "change_plan"
+	PageTransactions           Page = "transactions"
+	PageUsageChart             Page = "usage_chart"
+	PageUsageHeatmap           Page = "usage_heatmap"
+	PageTransfer               Page = "transfer"
+	PageRefunds                Page = "refunds"
+	PageManualPayment          Page = "manual_payment"
+	PagePayForClient           Page = "pay_for_client"
 	PageNotifications          Page = "notifications"
 	PageHealthcheck            Page = "healthcheck"
 	PagePricing                Page = "pricing"